@@ -4,6 +4,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -27,6 +28,7 @@ import (
 	_ "github.com/sivchari/kumo/internal/service/codeguruprofiler"
 	_ "github.com/sivchari/kumo/internal/service/codegurureviewer"
 	_ "github.com/sivchari/kumo/internal/service/cognito"
+	_ "github.com/sivchari/kumo/internal/service/cognitoidentity"
 	_ "github.com/sivchari/kumo/internal/service/comprehend"
 	_ "github.com/sivchari/kumo/internal/service/configservice"
 	_ "github.com/sivchari/kumo/internal/service/dataexchange"
@@ -93,8 +95,13 @@ func main() {
 
 	// Root command starts the server when no CLI subcommand is matched.
 	// Docker uses `kumo --host 0.0.0.0 --port 4566`, so we accept these flags.
-	root.RunE = func(_ *cobra.Command, _ []string) error {
+	root.RunE = func(cmd *cobra.Command, _ []string) error {
 		cfg := server.DefaultConfig()
+
+		if err := applyServerFlags(cmd, &cfg); err != nil {
+			return err
+		}
+
 		srv := server.New(cfg)
 
 		if err := srv.Run(); err != nil {
@@ -104,18 +111,87 @@ func main() {
 		return nil
 	}
 
-	root.Flags().String("host", "", "Server host (use KUMO_HOST env var)")
-	root.Flags().String("port", "", "Server port (use KUMO_PORT env var)")
-
 	serveCmd := &cobra.Command{
 		Use:   "serve",
 		Short: "Start the kumo server",
 		RunE:  root.RunE,
 	}
 
+	for _, cmd := range []*cobra.Command{root, serveCmd} {
+		cmd.Flags().String("host", "", "Server host (overrides KUMO_HOST)")
+		cmd.Flags().String("port", "", "Server port, 0 for a free port (overrides KUMO_PORT)")
+		cmd.Flags().StringSlice("services", nil, "Only mount these services (overrides KUMO_SERVICES)")
+		cmd.Flags().StringSlice("disabled-services", nil, "Do not mount these services (overrides KUMO_DISABLED_SERVICES)")
+		cmd.Flags().Bool("tls", false, "Also serve HTTPS on the server port (overrides KUMO_TLS)")
+		cmd.Flags().String("tls-cert-file", "", "TLS certificate file; a self-signed one is generated when unset (overrides KUMO_TLS_CERT_FILE)")
+		cmd.Flags().String("tls-key-file", "", "TLS private key file (overrides KUMO_TLS_KEY_FILE)")
+		cmd.Flags().StringSlice("cors-allowed-origins", nil, "Origins allowed to call kumo from a browser, * for any (overrides KUMO_CORS_ALLOWED_ORIGINS)")
+		cmd.Flags().String("account-id", "", "Account ID in ARNs and URLs (overrides KUMO_ACCOUNT_ID)")
+		cmd.Flags().String("region", "", "Region of requests that do not name one (overrides KUMO_REGION)")
+		cmd.Flags().Bool("region-isolation", false, "Keep the resources of each region apart (overrides KUMO_REGION_ISOLATION)")
+	}
+
 	root.AddCommand(serveCmd)
 
 	if err := root.Execute(); err != nil {
 		os.Exit(1)
 	}
 }
+
+// applyServerFlags overrides the server configuration with the flags set on the command line.
+func applyServerFlags(cmd *cobra.Command, cfg *server.Config) error {
+	flags := cmd.Flags()
+
+	if flags.Changed("host") {
+		cfg.Host, _ = flags.GetString("host")
+	}
+
+	if flags.Changed("port") {
+		v, _ := flags.GetString("port")
+
+		port, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid port %q: %w", v, err)
+		}
+
+		cfg.Port = port
+	}
+
+	if flags.Changed("services") {
+		cfg.Services, _ = flags.GetStringSlice("services")
+	}
+
+	if flags.Changed("disabled-services") {
+		cfg.DisabledServices, _ = flags.GetStringSlice("disabled-services")
+	}
+
+	if flags.Changed("tls") {
+		cfg.TLS, _ = flags.GetBool("tls")
+	}
+
+	if flags.Changed("tls-cert-file") {
+		cfg.TLSCertFile, _ = flags.GetString("tls-cert-file")
+	}
+
+	if flags.Changed("tls-key-file") {
+		cfg.TLSKeyFile, _ = flags.GetString("tls-key-file")
+	}
+
+	if flags.Changed("cors-allowed-origins") {
+		cfg.CORSAllowedOrigins, _ = flags.GetStringSlice("cors-allowed-origins")
+	}
+
+	if flags.Changed("account-id") {
+		cfg.AccountID, _ = flags.GetString("account-id")
+	}
+
+	if flags.Changed("region") {
+		cfg.Region, _ = flags.GetString("region")
+	}
+
+	if flags.Changed("region-isolation") {
+		cfg.RegionIsolation, _ = flags.GetBool("region-isolation")
+	}
+
+	return nil
+}
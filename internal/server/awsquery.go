@@ -174,12 +174,22 @@ type indexedEntry struct {
 func formToJSON(form map[string][]string) []byte {
 	result := make(map[string]any)
 	indexedArrays := make(map[string][]indexedEntry)
+	messageAttrs := make(map[string]map[string]string)
 
 	for key, values := range form {
 		if key == "Action" || key == "Version" {
 			continue
 		}
 
+		// Message attributes keep their values as strings, e.g. a Number "42".
+		if entry, ok := strings.CutPrefix(key, "MessageAttributes.entry."); ok {
+			if len(values) == 1 {
+				parseMessageAttributeEntry(entry, values[0], messageAttrs)
+			}
+
+			continue
+		}
+
 		// Check for indexed array pattern: Name.1, Name.2, etc.
 		if idx := strings.LastIndex(key, "."); idx > 0 {
 			suffix := key[idx+1:]
@@ -234,6 +244,7 @@ func formToJSON(form map[string][]string) []byte {
 
 	// Handle nested attributes (like Attributes.entry.N.key/value).
 	result = flattenAttributes(result)
+	buildMessageAttributesMap(messageAttrs, result)
 
 	jsonBytes, _ := json.Marshal(result)
 
@@ -325,6 +336,48 @@ func buildAttributesMap(attrs map[string]string, result map[string]any) {
 	}
 }
 
+// parseMessageAttributeEntry parses the N.Name or N.Value.<field> part of a
+// MessageAttributes.entry.N.Name/Value.<field> pattern.
+func parseMessageAttributeEntry(entry, value string, attrs map[string]map[string]string) {
+	idx, field, ok := strings.Cut(entry, ".")
+	if !ok {
+		return
+	}
+
+	if field != "Name" {
+		field, ok = strings.CutPrefix(field, "Value.")
+		if !ok {
+			return
+		}
+	}
+
+	if attrs[idx] == nil {
+		attrs[idx] = make(map[string]string)
+	}
+
+	attrs[idx][field] = value
+}
+
+// buildMessageAttributesMap builds the MessageAttributes map, keyed by attribute
+// name, from parsed entries.
+func buildMessageAttributesMap(attrs map[string]map[string]string, result map[string]any) {
+	msgAttrMap := make(map[string]map[string]string)
+
+	for _, fields := range attrs {
+		name := fields["Name"]
+		if name == "" {
+			continue
+		}
+
+		delete(fields, "Name")
+		msgAttrMap[name] = fields
+	}
+
+	if len(msgAttrMap) > 0 {
+		result["MessageAttributes"] = msgAttrMap
+	}
+}
+
 // writeQueryError writes an AWS Query protocol error response.
 func writeQueryError(w http.ResponseWriter, code, message string) {
 	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
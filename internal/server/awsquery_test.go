@@ -246,3 +246,23 @@ func TestQueryDispatcher_UnknownAction(t *testing.T) {
 		t.Errorf("expected UnknownAction error, got %s", rec.Body.String())
 	}
 }
+
+func TestFormToJSON_MessageAttributes(t *testing.T) {
+	t.Parallel()
+
+	got := formToJSON(map[string][]string{
+		"Action":                         {"Publish"},
+		"Message":                        {"hello"},
+		"MessageAttributes.entry.1.Name": {"count"},
+		"MessageAttributes.entry.1.Value.DataType":    {"Number"},
+		"MessageAttributes.entry.1.Value.StringValue": {"42"},
+		"MessageAttributes.entry.2.Name":              {"color"},
+		"MessageAttributes.entry.2.Value.DataType":    {"String"},
+		"MessageAttributes.entry.2.Value.StringValue": {"true"},
+	})
+
+	want := `{"Message":"hello","MessageAttributes":{"color":{"DataType":"String","StringValue":"true"},"count":{"DataType":"Number","StringValue":"42"}}}`
+	if string(got) != want {
+		t.Errorf("formToJSON() = %s, want %s", got, want)
+	}
+}
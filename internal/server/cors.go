@@ -0,0 +1,190 @@
+package server
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// corsAllowedMethods are the methods allowed by CORS preflight responses.
+const corsAllowedMethods = "GET, HEAD, PUT, POST, DELETE, PATCH, OPTIONS"
+
+// corsAllowedHeaders are the request headers allowed by CORS preflight responses
+// when the browser does not list the headers it is going to send.
+const corsAllowedHeaders = "Authorization, Content-Type, Content-MD5, Range, X-Amz-Date, X-Amz-Security-Token, " +
+	"X-Amz-Target, X-Amz-User-Agent, X-Amz-Content-Sha256, X-Amz-Checksum-Crc32, X-Amz-Sdk-Checksum-Algorithm, " +
+	"Amz-Sdk-Invocation-Id, Amz-Sdk-Request, Smithy-Protocol"
+
+// corsMaxAge is how long, in seconds, browsers may cache a preflight response.
+const corsMaxAge = "7200"
+
+// corsServices lists the services that apply their own CORS rules, such as S3 bucket CORS configurations.
+var corsServices = []string{"s3"}
+
+// corsHandler adds CORS headers to the responses of next, so that browser SDK
+// clients served from the allowed origins can call kumo, and answers preflight requests
+// without passing them to next. Requests to services that apply their own CORS rules
+// are left to them.
+type corsHandler struct {
+	next           http.Handler
+	allowedOrigins []string
+	routeService   func(r *http.Request) string
+}
+
+// newCORSHandler wraps next with CORS support for the given origins. "*" allows every origin.
+// routeService returns the name of the service that a request is routed to, if any.
+func newCORSHandler(next http.Handler, allowedOrigins []string, routeService func(r *http.Request) string) http.Handler {
+	if len(allowedOrigins) == 0 {
+		return next
+	}
+
+	return &corsHandler{
+		next:           next,
+		allowedOrigins: allowedOrigins,
+		routeService:   routeService,
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *corsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		h.next.ServeHTTP(w, r)
+
+		return
+	}
+
+	allowOrigin, ok := h.allowOrigin(origin)
+	if !ok {
+		h.next.ServeHTTP(w, r)
+
+		return
+	}
+
+	// Preflight requests are answered here, so that they never reach the handlers of
+	// the actual requests, such as Lambda function URLs.
+	if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+		if slices.Contains(corsServices, h.routeService(r)) {
+			h.next.ServeHTTP(w, r)
+		} else {
+			writePreflight(w, r, allowOrigin)
+		}
+
+		return
+	}
+
+	// Record the service the request is routed to, to leave it the services with their own CORS rules.
+	info := requestInfoFrom(r.Context())
+	if info == nil {
+		info = &requestInfo{}
+		r = r.WithContext(withRequestInfo(r.Context(), info))
+	}
+
+	h.next.ServeHTTP(&corsResponseWriter{ResponseWriter: w, allowOrigin: allowOrigin, info: info}, r)
+}
+
+// allowOrigin returns the Access-Control-Allow-Origin value for the request origin,
+// and false if the origin is not allowed.
+func (h *corsHandler) allowOrigin(origin string) (string, bool) {
+	if slices.Contains(h.allowedOrigins, "*") {
+		return "*", true
+	}
+
+	if slices.Contains(h.allowedOrigins, origin) {
+		return origin, true
+	}
+
+	return "", false
+}
+
+// writePreflight answers a preflight request, allowing every method and the requested headers.
+func writePreflight(w http.ResponseWriter, r *http.Request, allowOrigin string) {
+	header := w.Header()
+	setCORSOrigin(header, allowOrigin)
+	header.Set("Access-Control-Allow-Methods", corsAllowedMethods)
+
+	if requested := r.Header.Get("Access-Control-Request-Headers"); requested != "" {
+		header.Set("Access-Control-Allow-Headers", requested)
+	} else {
+		header.Set("Access-Control-Allow-Headers", corsAllowedHeaders)
+	}
+
+	header.Set("Access-Control-Max-Age", corsMaxAge)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// setCORSOrigin sets the allowed origin, marking the response as varying by origin when it is not "*".
+func setCORSOrigin(header http.Header, allowOrigin string) {
+	header.Set("Access-Control-Allow-Origin", allowOrigin)
+
+	if allowOrigin != "*" {
+		header.Add("Vary", "Origin")
+	}
+}
+
+// corsResponseWriter adds CORS headers to a response before its header is written.
+type corsResponseWriter struct {
+	http.ResponseWriter
+	allowOrigin string
+	info        *requestInfo
+	wroteHeader bool
+}
+
+// WriteHeader adds the CORS headers, unless the service applies its own, and writes the header.
+func (w *corsResponseWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.addCORSHeaders()
+	}
+
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Write writes the header, if not yet written, and the data.
+func (w *corsResponseWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	return w.ResponseWriter.Write(p) //nolint:wrapcheck // Callers may compare the error with sentinel errors.
+}
+
+// Flush implements http.Flusher for streaming handlers.
+func (w *corsResponseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the underlying response writer for http.ResponseController.
+func (w *corsResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// addCORSHeaders allows the origin and exposes every response header, such as
+// x-amz-request-id and ETag, to the browser.
+func (w *corsResponseWriter) addCORSHeaders() {
+	header := w.Header()
+	if header.Get("Access-Control-Allow-Origin") != "" || slices.Contains(corsServices, w.info.service) {
+		return
+	}
+
+	exposed := make([]string, 0, len(header))
+
+	for name := range header {
+		if !strings.HasPrefix(name, "Access-Control-") {
+			exposed = append(exposed, name)
+		}
+	}
+
+	if len(exposed) > 0 {
+		slices.Sort(exposed)
+		header.Set("Access-Control-Expose-Headers", strings.Join(exposed, ", "))
+	}
+
+	setCORSOrigin(header, w.allowOrigin)
+}
@@ -0,0 +1,210 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+func TestCORSHandler(t *testing.T) {
+	t.Parallel()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/bucket/") {
+			// Act as S3, which applies the CORS rules of the bucket.
+			if info := requestInfoFrom(r.Context()); info != nil {
+				info.service = "s3"
+			}
+
+			if r.Header.Get("Origin") == "https://bucket.example.com" {
+				w.Header().Set("Access-Control-Allow-Origin", "https://bucket.example.com")
+			}
+		}
+
+		w.Header().Set("X-Amz-Request-Id", "request-id")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	// Route the requests under /bucket/ to S3.
+	routeService := func(r *http.Request) string {
+		if strings.HasPrefix(r.URL.Path, "/bucket/") {
+			return "s3"
+		}
+
+		return ""
+	}
+
+	type want struct {
+		status        int
+		allowOrigin   string
+		allowHeaders  string
+		exposeHeaders string
+	}
+
+	tests := []struct {
+		name           string
+		allowedOrigins []string
+		method         string
+		path           string
+		header         map[string]string
+		want           want
+	}{
+		{
+			name:           "request without origin",
+			allowedOrigins: []string{"*"},
+			method:         http.MethodPost,
+			path:           "/",
+			want:           want{status: http.StatusOK},
+		},
+		{
+			name:           "preflight",
+			allowedOrigins: []string{"*"},
+			method:         http.MethodOptions,
+			path:           "/",
+			header: map[string]string{
+				"Origin":                         "http://localhost:3000",
+				"Access-Control-Request-Method":  "POST",
+				"Access-Control-Request-Headers": "authorization,x-amz-date,x-amz-target",
+			},
+			want: want{status: http.StatusNoContent, allowOrigin: "*", allowHeaders: "authorization,x-amz-date,x-amz-target"},
+		},
+		{
+			name:           "actual request",
+			allowedOrigins: []string{"*"},
+			method:         http.MethodPost,
+			path:           "/",
+			header:         map[string]string{"Origin": "http://localhost:3000"},
+			want:           want{status: http.StatusOK, allowOrigin: "*", exposeHeaders: "X-Amz-Request-Id"},
+		},
+		{
+			name:           "listed origin",
+			allowedOrigins: []string{"http://localhost:3000"},
+			method:         http.MethodPost,
+			path:           "/",
+			header:         map[string]string{"Origin": "http://localhost:3000"},
+			want:           want{status: http.StatusOK, allowOrigin: "http://localhost:3000", exposeHeaders: "X-Amz-Request-Id"},
+		},
+		{
+			name:           "unlisted origin",
+			allowedOrigins: []string{"http://localhost:3000"},
+			method:         http.MethodPost,
+			path:           "/",
+			header:         map[string]string{"Origin": "http://localhost:8080"},
+			want:           want{status: http.StatusOK},
+		},
+		{
+			name:           "origin allowed by bucket rules",
+			allowedOrigins: []string{"*"},
+			method:         http.MethodGet,
+			path:           "/bucket/key",
+			header:         map[string]string{"Origin": "https://bucket.example.com"},
+			want:           want{status: http.StatusOK, allowOrigin: "https://bucket.example.com"},
+		},
+		{
+			name:           "origin not allowed by bucket rules",
+			allowedOrigins: []string{"*"},
+			method:         http.MethodGet,
+			path:           "/bucket/key",
+			header:         map[string]string{"Origin": "http://localhost:3000"},
+			want:           want{status: http.StatusOK},
+		},
+		{
+			name:           "preflight to bucket",
+			allowedOrigins: []string{"*"},
+			method:         http.MethodOptions,
+			path:           "/bucket/key",
+			header: map[string]string{
+				"Origin":                        "https://bucket.example.com",
+				"Access-Control-Request-Method": "PUT",
+			},
+			want: want{status: http.StatusOK, allowOrigin: "https://bucket.example.com"},
+		},
+		{
+			name:   "disabled",
+			method: http.MethodPost,
+			path:   "/",
+			header: map[string]string{"Origin": "http://localhost:3000"},
+			want:   want{status: http.StatusOK},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(""))
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+
+			rec := httptest.NewRecorder()
+			newCORSHandler(next, tt.allowedOrigins, routeService).ServeHTTP(rec, req)
+
+			got := want{
+				status:        rec.Code,
+				allowOrigin:   rec.Header().Get("Access-Control-Allow-Origin"),
+				allowHeaders:  rec.Header().Get("Access-Control-Allow-Headers"),
+				exposeHeaders: rec.Header().Get("Access-Control-Expose-Headers"),
+			}
+
+			if got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+// preflightService is a REST service that counts the OPTIONS requests it handles,
+// like the handlers of Lambda function URLs.
+type preflightService struct {
+	calls *int
+}
+
+func (preflightService) Name() string { return "preflight" }
+
+func (s preflightService) RegisterRoutes(r service.Router) {
+	r.HandleFunc("OPTIONS", "/preflight/{path...}", func(w http.ResponseWriter, _ *http.Request) {
+		*s.calls++
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func (preflightService) Reset(context.Context) error { return nil }
+
+func TestCORSPreflightNotRouted(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	srv := New(Config{Services: []string{"preflight"}, LogLevel: slog.LevelError, CORSAllowedOrigins: []string{"*"}})
+	srv.RegisterService(preflightService{calls: &calls})
+
+	preflight := httptest.NewRequest(http.MethodOptions, "/preflight/path", nil)
+	preflight.Header.Set("Origin", "http://localhost:3000")
+	preflight.Header.Set("Access-Control-Request-Method", "POST")
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, preflight)
+
+	if rec.Code != http.StatusNoContent || rec.Header().Get("Access-Control-Allow-Origin") != "*" {
+		t.Errorf("expected the preflight to be answered with 204 and CORS headers, got %d %v", rec.Code, rec.Header())
+	}
+
+	if calls != 0 {
+		t.Errorf("expected the preflight not to reach the service, got %d calls", calls)
+	}
+
+	// OPTIONS requests that are not preflights are still routed to the service.
+	rec = httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodOptions, "/preflight/path", nil))
+
+	if calls != 1 {
+		t.Errorf("expected the OPTIONS request to reach the service, got %d calls", calls)
+	}
+}
@@ -0,0 +1,170 @@
+package server
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ActionMetrics counts the requests to an action of a service.
+type ActionMetrics struct {
+	Calls  int64 `json:"calls"`
+	Errors int64 `json:"errors"` // responses with a 4xx or 5xx status, including injected faults
+	Faults int64 `json:"faults"` // errors injected by a fault rule
+}
+
+// callMetrics counts the requests to each action of each service.
+type callMetrics struct {
+	mu       sync.Mutex
+	services map[string]map[string]*ActionMetrics
+}
+
+// newCallMetrics creates empty call metrics.
+func newCallMetrics() *callMetrics {
+	return &callMetrics{services: make(map[string]map[string]*ActionMetrics)}
+}
+
+// record counts a request to the action of a service that was answered with status.
+func (m *callMetrics) record(svc, action string, status int, fault bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	actions, ok := m.services[svc]
+	if !ok {
+		actions = make(map[string]*ActionMetrics)
+		m.services[svc] = actions
+	}
+
+	counts, ok := actions[action]
+	if !ok {
+		counts = &ActionMetrics{}
+		actions[action] = counts
+	}
+
+	counts.Calls++
+
+	if status >= http.StatusBadRequest {
+		counts.Errors++
+	}
+
+	if fault {
+		counts.Faults++
+	}
+}
+
+// Snapshot returns a copy of the counts, keyed by service name and action.
+func (m *callMetrics) Snapshot() map[string]map[string]ActionMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := make(map[string]map[string]ActionMetrics, len(m.services))
+
+	for svc, actions := range m.services {
+		snapshot[svc] = make(map[string]ActionMetrics, len(actions))
+
+		for action, counts := range actions {
+			snapshot[svc][action] = *counts
+		}
+	}
+
+	return snapshot
+}
+
+// Reset clears every count.
+func (m *callMetrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.services = make(map[string]map[string]*ActionMetrics)
+}
+
+// getMetrics handles GET /kumo/metrics, returning the request counts of every
+// service action as JSON, or in the Prometheus text format with ?format=prometheus.
+func (s *Server) getMetrics(w http.ResponseWriter, r *http.Request) {
+	snapshot := s.router.metrics.Snapshot()
+
+	if r.URL.Query().Get("format") == "prometheus" {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		writePrometheusMetrics(w, snapshot)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(snapshot)
+}
+
+// deleteMetrics handles DELETE /kumo/metrics, clearing the request counts.
+func (s *Server) deleteMetrics(w http.ResponseWriter, _ *http.Request) {
+	s.router.metrics.Reset()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// prometheusMetric describes a counter written by writePrometheusMetrics.
+type prometheusMetric struct {
+	name  string
+	help  string
+	value func(ActionMetrics) int64
+}
+
+var prometheusMetrics = []prometheusMetric{
+	{
+		name:  "kumo_requests_total",
+		help:  "Requests to each action of each service.",
+		value: func(m ActionMetrics) int64 { return m.Calls },
+	},
+	{
+		name:  "kumo_request_errors_total",
+		help:  "Requests to each action of each service answered with a 4xx or 5xx status.",
+		value: func(m ActionMetrics) int64 { return m.Errors },
+	},
+	{
+		name:  "kumo_request_faults_total",
+		help:  "Requests to each action of each service answered with an error injected by a fault rule.",
+		value: func(m ActionMetrics) int64 { return m.Faults },
+	},
+}
+
+// writePrometheusMetrics writes the counts in the Prometheus text exposition format,
+// sorted by service and action.
+func writePrometheusMetrics(w http.ResponseWriter, snapshot map[string]map[string]ActionMetrics) {
+	type series struct {
+		service string
+		action  string
+		counts  ActionMetrics
+	}
+
+	var all []series
+
+	for svc, actions := range snapshot {
+		for action, counts := range actions {
+			all = append(all, series{service: svc, action: action, counts: counts})
+		}
+	}
+
+	slices.SortFunc(all, func(a, b series) int {
+		return cmp.Or(cmp.Compare(a.service, b.service), cmp.Compare(a.action, b.action))
+	})
+
+	for _, metric := range prometheusMetrics {
+		_, _ = fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n", metric.name, metric.help, metric.name)
+
+		for _, s := range all {
+			_, _ = fmt.Fprintf(w, "%s{service=\"%s\",action=\"%s\"} %d\n",
+				metric.name, escapeLabelValue(s.service), escapeLabelValue(s.action), metric.value(s.counts))
+		}
+	}
+}
+
+// labelValueEscaper escapes the characters that Prometheus label values cannot hold as is.
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escapeLabelValue escapes a Prometheus label value.
+func escapeLabelValue(v string) string {
+	return labelValueEscaper.Replace(v)
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMetricsEndpoint(t *testing.T) {
+	t.Parallel()
+
+	srv := New(Config{Services: []string{"scope"}, LogLevel: slog.LevelError})
+	srv.RegisterService(scopeService{})
+
+	serve := func(method, target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+
+		return rec
+	}
+
+	serve(http.MethodGet, "/scope")
+	serve(http.MethodGet, "/scope")
+
+	srv.faults.SetRules([]FaultRule{{Service: "scope", ErrorRate: 1}})
+	serve(http.MethodGet, "/scope")
+
+	rec := serve(http.MethodGet, "/kumo/metrics")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+
+	var metrics map[string]map[string]ActionMetrics
+	if err := json.NewDecoder(rec.Body).Decode(&metrics); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := metrics["scope"]["GET /scope"], (ActionMetrics{Calls: 3, Errors: 1, Faults: 1}); got != want {
+		t.Errorf("expected metrics %+v, got %+v", want, got)
+	}
+
+	if _, ok := metrics["kumo"]; ok || len(metrics) != 1 {
+		t.Errorf("expected only the scope service to be counted, got %v", metrics)
+	}
+
+	rec = serve(http.MethodGet, "/kumo/metrics?format=prometheus")
+
+	for _, line := range []string{
+		"# TYPE kumo_requests_total counter",
+		`kumo_requests_total{service="scope",action="GET /scope"} 3`,
+		`kumo_request_errors_total{service="scope",action="GET /scope"} 1`,
+		`kumo_request_faults_total{service="scope",action="GET /scope"} 1`,
+	} {
+		if !strings.Contains(rec.Body.String(), line+"\n") {
+			t.Errorf("expected line %q in:\n%s", line, rec.Body.String())
+		}
+	}
+
+	if rec := serve(http.MethodDelete, "/kumo/metrics"); rec.Code != http.StatusNoContent {
+		t.Fatalf("expected status 204, got %d", rec.Code)
+	}
+
+	if got := strings.TrimSpace(serve(http.MethodGet, "/kumo/metrics").Body.String()); got != "{}" {
+		t.Errorf("expected no metrics after delete, got %s", got)
+	}
+}
+
+func TestEscapeLabelValue(t *testing.T) {
+	t.Parallel()
+
+	if got, want := escapeLabelValue("a\"b\\c\nd"), `a\"b\\c\nd`; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,545 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// Defaults for errors injected by fault rules.
+const (
+	defaultFaultStatusCode = http.StatusServiceUnavailable
+	defaultFaultErrorCode  = "ServiceUnavailable"
+)
+
+// restXMLServices lists the REST services whose errors are XML rather than JSON.
+var restXMLServices = []string{"cloudfront", "iam", "route53", "s3", "s3control"}
+
+// protocol is the wire protocol of a service entry point. It selects the format of injected errors.
+type protocol int
+
+const (
+	protocolREST protocol = iota
+	protocolJSON
+	protocolQuery
+	protocolCBOR
+)
+
+// FaultRule injects latency or errors into the requests handled by a service.
+type FaultRule struct {
+	// Service is the name of the service, as listed in Config.Services, or "*" for every service.
+	Service string
+	// Action restricts the rule to one action, e.g. "SendMessage". REST services use the
+	// method and route pattern, e.g. "PUT /{bucket}/{key...}". Empty matches every action.
+	Action string
+	// Resource restricts the rule to requests that act on one resource, e.g. a DynamoDB table,
+	// or "*" for any resource. Empty matches every request. Only services implementing
+	// service.ResourceNamer name the resources of their requests.
+	Resource string
+	// Latency is waited before the request is handled.
+	Latency time.Duration
+	// ErrorRate is the probability, between 0 and 1, that the request fails instead of being handled.
+	ErrorRate float64
+	// RequestsPerSecond makes requests fail beyond this many in a second. With a Resource,
+	// the requests are counted per resource. 0 means no limit.
+	RequestsPerSecond int
+	// StatusCode is the HTTP status of injected errors. Defaults to 503.
+	StatusCode int
+	// ErrorCode is the AWS error code of injected errors. Defaults to ServiceUnavailable.
+	ErrorCode string
+}
+
+// faultRuleJSON is the JSON form of FaultRule, with the latency as a duration string such as "250ms".
+type faultRuleJSON struct {
+	Service           string  `json:"service"`
+	Action            string  `json:"action,omitempty"`
+	Resource          string  `json:"resource,omitempty"`
+	Latency           string  `json:"latency,omitempty"`
+	ErrorRate         float64 `json:"errorRate,omitempty"`
+	RequestsPerSecond int     `json:"requestsPerSecond,omitempty"`
+	StatusCode        int     `json:"statusCode,omitempty"`
+	ErrorCode         string  `json:"errorCode,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f FaultRule) MarshalJSON() ([]byte, error) {
+	v := faultRuleJSON{
+		Service:           f.Service,
+		Action:            f.Action,
+		Resource:          f.Resource,
+		ErrorRate:         f.ErrorRate,
+		RequestsPerSecond: f.RequestsPerSecond,
+		StatusCode:        f.StatusCode,
+		ErrorCode:         f.ErrorCode,
+	}
+
+	if f.Latency > 0 {
+		v.Latency = f.Latency.String()
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal fault rule: %w", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *FaultRule) UnmarshalJSON(data []byte) error {
+	var v faultRuleJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("failed to unmarshal fault rule: %w", err)
+	}
+
+	var latency time.Duration
+
+	if v.Latency != "" {
+		d, err := time.ParseDuration(v.Latency)
+		if err != nil {
+			return fmt.Errorf("invalid latency %q: %w", v.Latency, err)
+		}
+
+		latency = d
+	}
+
+	*f = FaultRule{
+		Service:           v.Service,
+		Action:            v.Action,
+		Resource:          v.Resource,
+		Latency:           latency,
+		ErrorRate:         v.ErrorRate,
+		RequestsPerSecond: v.RequestsPerSecond,
+		StatusCode:        v.StatusCode,
+		ErrorCode:         v.ErrorCode,
+	}
+
+	return nil
+}
+
+// validate reports whether the rule can be applied.
+func (f FaultRule) validate() error {
+	switch {
+	case f.Service == "":
+		return errors.New("service is required")
+	case f.Latency < 0:
+		return fmt.Errorf("latency %s must not be negative", f.Latency)
+	case f.ErrorRate < 0 || f.ErrorRate > 1:
+		return fmt.Errorf("errorRate %v must be between 0 and 1", f.ErrorRate)
+	case f.RequestsPerSecond < 0:
+		return fmt.Errorf("requestsPerSecond %d must not be negative", f.RequestsPerSecond)
+	case f.StatusCode != 0 && (f.StatusCode < 400 || f.StatusCode > 599):
+		return fmt.Errorf("statusCode %d must be an error status", f.StatusCode)
+	}
+
+	return nil
+}
+
+// matches reports whether the rule applies to the action of the service.
+func (f FaultRule) matches(svc, action string) bool {
+	return (f.Service == "*" || f.Service == svc) && (f.Action == "" || f.Action == action)
+}
+
+// matchResources returns the resources of a request that the rule applies to.
+func (f FaultRule) matchResources(resources []string) []string {
+	if f.Resource == "*" {
+		return resources
+	}
+
+	if slices.Contains(resources, f.Resource) {
+		return []string{f.Resource}
+	}
+
+	return nil
+}
+
+// parseFaultRules parses a JSON array of fault rules.
+func parseFaultRules(data []byte) ([]FaultRule, error) {
+	var rules []FaultRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse fault rules: %w", err)
+	}
+
+	for i, rule := range rules {
+		if err := rule.validate(); err != nil {
+			return nil, fmt.Errorf("fault rule %d: %w", i, err)
+		}
+	}
+
+	return rules, nil
+}
+
+// faultWindow counts the requests that a rule applies to in the current second.
+type faultWindow struct {
+	start time.Time
+	count int
+}
+
+// faultMatch is a rule that applies to a request.
+type faultMatch struct {
+	FaultRule
+
+	index     int      // position of the rule, which identifies its request counts
+	resources []string // resources of the request the rule applies to
+}
+
+// faultInjector holds the fault rules applied to service requests.
+type faultInjector struct {
+	mu      sync.RWMutex
+	rules   []FaultRule
+	windows map[string]*faultWindow // key: rule index/resource
+	rand    func() float64
+	now     func() time.Time
+}
+
+// newFaultInjector creates a fault injector with the given rules.
+func newFaultInjector(rules []FaultRule) *faultInjector {
+	return &faultInjector{
+		rules:   rules,
+		windows: make(map[string]*faultWindow),
+		rand:    rand.Float64, //nolint:gosec // Fault injection does not need a secure random source.
+		now:     time.Now,
+	}
+}
+
+// Rules returns the current fault rules.
+func (f *faultInjector) Rules() []FaultRule {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return slices.Clone(f.rules)
+}
+
+// SetRules replaces the fault rules and forgets the counted requests.
+func (f *faultInjector) SetRules(rules []FaultRule) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.rules = rules
+	f.windows = make(map[string]*faultWindow)
+}
+
+// match returns the first rule that applies to the action of the service and,
+// for rules restricted to a resource, to one of the resources of the request.
+// resources is only called when such a rule is considered.
+func (f *faultInjector) match(svc, action string, resources func() []string) (faultMatch, bool) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	for i, rule := range f.rules {
+		if !rule.matches(svc, action) {
+			continue
+		}
+
+		if rule.Resource == "" {
+			return faultMatch{FaultRule: rule, index: i}, true
+		}
+
+		if matched := rule.matchResources(resources()); len(matched) > 0 {
+			return faultMatch{FaultRule: rule, index: i, resources: matched}, true
+		}
+	}
+
+	return faultMatch{}, false
+}
+
+// fails counts the request against the limit of the matched rule and reports
+// whether it fails, because it exceeds the limit or by chance.
+func (f *faultInjector) fails(m faultMatch) bool {
+	exceeded := false
+
+	if m.RequestsPerSecond > 0 {
+		f.mu.Lock()
+
+		keys := m.resources
+		if len(keys) == 0 {
+			keys = []string{""}
+		}
+
+		now := f.now()
+
+		for _, resource := range keys {
+			key := fmt.Sprintf("%d/%s", m.index, resource)
+
+			window, ok := f.windows[key]
+			if !ok || now.Sub(window.start) >= time.Second {
+				window = &faultWindow{start: now}
+				f.windows[key] = window
+			}
+
+			window.count++
+
+			if window.count > m.RequestsPerSecond {
+				exceeded = true
+			}
+		}
+
+		f.mu.Unlock()
+	}
+
+	return exceeded || (m.ErrorRate > 0 && f.rand() < m.ErrorRate)
+}
+
+// requestInfo records what a request was routed to, for the request log.
+type requestInfo struct {
+	service string
+	action  string
+	fault   bool
+
+	// signed is the request as signed by the client, when a protocol dispatcher rewrites it.
+	signed *http.Request
+}
+
+type requestInfoKey struct{}
+
+// withRequestInfo returns a context carrying info.
+func withRequestInfo(ctx context.Context, info *requestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// requestInfoFrom returns the request info of the context, or nil if there is none.
+func requestInfoFrom(ctx context.Context) *requestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(*requestInfo)
+
+	return info
+}
+
+// serviceRouter registers the routes of a service so that their requests go
+// through handleServiceRequest.
+type serviceRouter struct {
+	server  *Server
+	service string
+}
+
+// Handle registers a handler for the given method and pattern.
+func (r serviceRouter) Handle(method, pattern string, handler http.HandlerFunc) {
+	action := method + " " + pattern
+	r.server.routeServices[action] = r.service
+
+	next := r.server.inRegion(handler, func(rs *regionServices) http.HandlerFunc {
+		return rs.routes[routeKey(r.service, action)]
+	})
+
+	r.server.router.Handle(method, pattern, func(w http.ResponseWriter, req *http.Request) {
+		r.server.handleServiceRequest(w, req, r.service, protocolREST, action, next)
+	})
+}
+
+// HandleFunc is an alias for Handle for compatibility with service.Router interface.
+func (r serviceRouter) HandleFunc(method, pattern string, handler http.HandlerFunc) {
+	r.Handle(method, pattern, handler)
+}
+
+// routeService returns the name of the service whose route would handle the request,
+// or "" if it is not routed to a service.
+func (s *Server) routeService(r *http.Request) string {
+	return s.routeServices[s.router.match(r)]
+}
+
+// targetAction returns the action of an X-Amz-Target header such as "AmazonSQS.SendMessage".
+func targetAction(r *http.Request) string {
+	target := r.Header.Get("X-Amz-Target")
+	if i := strings.LastIndex(target, "."); i >= 0 {
+		return target[i+1:]
+	}
+
+	return target
+}
+
+// handleServiceRequest attributes the request to the action of the service in the
+// request log, scopes it to the account and region it is made against, verifies
+// its signature and applies the first matching fault rule before calling next.
+func (s *Server) handleServiceRequest(w http.ResponseWriter, r *http.Request, svc string, proto protocol, action string, next http.HandlerFunc) {
+	info := requestInfoFrom(r.Context())
+	if info != nil {
+		info.service = svc
+		info.action = action
+	}
+
+	signed := r
+	if info != nil && info.signed != nil {
+		signed = info.signed
+	}
+
+	r = r.WithContext(service.WithScope(r.Context(), s.config.AccountID, requestRegion(signed, s.config.Region)))
+
+	// kumo-specific endpoints are not AWS APIs.
+	if strings.HasPrefix(r.URL.Path, "/kumo/") {
+		next(w, r)
+
+		return
+	}
+
+	restXML := slices.Contains(restXMLServices, svc)
+
+	if !s.authenticate(w, signed, svc, action, proto, restXML) {
+		return
+	}
+
+	rule, ok := s.faults.match(svc, action, sync.OnceValue(func() []string {
+		return s.requestResources(r, svc)
+	}))
+	if !ok {
+		next(w, r)
+
+		return
+	}
+
+	if rule.Latency > 0 {
+		timer := time.NewTimer(rule.Latency)
+
+		select {
+		case <-timer.C:
+		case <-r.Context().Done():
+			timer.Stop()
+
+			return
+		}
+	}
+
+	if !s.faults.fails(rule) {
+		next(w, r)
+
+		return
+	}
+
+	if info != nil {
+		info.fault = true
+	}
+
+	status := rule.StatusCode
+	if status == 0 {
+		status = defaultFaultStatusCode
+	}
+
+	code := rule.ErrorCode
+	if code == "" {
+		code = defaultFaultErrorCode
+	}
+
+	writeProtocolError(w, proto, restXML, code, "Fault injected by kumo into "+svc+" "+action, status)
+}
+
+// requestResources returns the resources that the request to the service acts on,
+// or nil if the service does not name them.
+func (s *Server) requestResources(r *http.Request, svc string) []string {
+	registered, _ := s.registry.Get(svc)
+
+	if namer, ok := registered.(service.ResourceNamer); ok {
+		return namer.RequestResources(r)
+	}
+
+	return nil
+}
+
+// faultXMLError is the REST XML error body written by writeProtocolError.
+type faultXMLError struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string   `xml:"Code"`
+	Message   string   `xml:"Message"`
+	RequestID string   `xml:"RequestId"`
+}
+
+// faultQueryError is the Query protocol error body written by writeProtocolError.
+type faultQueryError struct {
+	XMLName xml.Name `xml:"ErrorResponse"`
+	Error   struct {
+		Type    string `xml:"Type"`
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	} `xml:"Error"`
+	RequestID string `xml:"RequestId"`
+}
+
+// writeProtocolError writes an error in the format of the protocol, such as an injected fault.
+func writeProtocolError(w http.ResponseWriter, proto protocol, restXML bool, code, message string, status int) {
+	requestID := uuid.New().String()
+
+	w.Header().Set("X-Amzn-ErrorType", code)
+	w.Header().Set("x-amzn-RequestId", requestID)
+
+	switch {
+	case proto == protocolCBOR:
+		WriteCBORError(w, code, message, status)
+	case proto == protocolQuery:
+		body := faultQueryError{RequestID: requestID}
+		body.Error.Type = "Receiver"
+		if status < http.StatusInternalServerError {
+			body.Error.Type = "Sender"
+		}
+
+		body.Error.Code = code
+		body.Error.Message = message
+
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, xml.Header)
+		_ = xml.NewEncoder(w).Encode(body)
+	case proto == protocolREST && restXML:
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, xml.Header)
+		_ = xml.NewEncoder(w).Encode(faultXMLError{Code: code, Message: message, RequestID: requestID})
+	default:
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"__type":  code,
+			"message": message,
+		})
+	}
+}
+
+// getFaults handles GET /kumo/faults, returning the current fault rules.
+func (s *Server) getFaults(w http.ResponseWriter, _ *http.Request) {
+	rules := s.faults.Rules()
+	if rules == nil {
+		rules = []FaultRule{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(rules)
+}
+
+// putFaults handles PUT /kumo/faults, replacing the fault rules with the JSON array in the body.
+func (s *Server) putFaults(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeStateError(w, http.StatusBadRequest, "failed to read request body")
+
+		return
+	}
+
+	rules, err := parseFaultRules(body)
+	if err != nil {
+		writeStateError(w, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	s.faults.SetRules(rules)
+	s.logger.Info("updated fault rules", "count", len(rules))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteFaults handles DELETE /kumo/faults, removing every fault rule.
+func (s *Server) deleteFaults(w http.ResponseWriter, _ *http.Request) {
+	s.faults.SetRules(nil)
+	s.logger.Info("cleared fault rules")
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,251 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// fakeService is a REST service with a single route for middleware tests.
+type fakeService struct{}
+
+func (fakeService) Name() string { return "fake" }
+
+func (fakeService) RegisterRoutes(r service.Router) {
+	r.HandleFunc("GET", "/fake/{id}", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+}
+
+func (fakeService) Reset(_ context.Context) error { return nil }
+
+// RequestResources names the ID in the path as the resource of the request.
+func (fakeService) RequestResources(r *http.Request) []string {
+	return []string{r.PathValue("id")}
+}
+
+// serveFake sends a request for the resource with the ID to the fake service and returns the status.
+func serveFake(srv *Server, id string) int {
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/fake/"+id, nil))
+
+	return rec.Code
+}
+
+func newFaultTestServer(t *testing.T, rules []FaultRule) *Server {
+	t.Helper()
+
+	srv := New(Config{Services: []string{"fake"}, LogLevel: slog.LevelError, Faults: rules})
+	srv.RegisterService(fakeService{})
+
+	return srv
+}
+
+func TestFaultInjection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		rules      []FaultRule
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "no rules",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "default error",
+			rules:      []FaultRule{{Service: "fake", ErrorRate: 1}},
+			wantStatus: http.StatusServiceUnavailable,
+			wantCode:   "ServiceUnavailable",
+		},
+		{
+			name:       "custom error for action",
+			rules:      []FaultRule{{Service: "fake", Action: "GET /fake/{id}", ErrorRate: 1, StatusCode: http.StatusTooManyRequests, ErrorCode: "ThrottlingException"}},
+			wantStatus: http.StatusTooManyRequests,
+			wantCode:   "ThrottlingException",
+		},
+		{
+			name:       "wildcard service",
+			rules:      []FaultRule{{Service: "*", ErrorRate: 1}},
+			wantStatus: http.StatusServiceUnavailable,
+			wantCode:   "ServiceUnavailable",
+		},
+		{
+			name:       "other action",
+			rules:      []FaultRule{{Service: "fake", Action: "PUT /fake/{id}", ErrorRate: 1}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "other service",
+			rules:      []FaultRule{{Service: "s3", ErrorRate: 1}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "latency only",
+			rules:      []FaultRule{{Service: "fake", Latency: time.Millisecond}},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newFaultTestServer(t, tt.rules)
+
+			rec := httptest.NewRecorder()
+			srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/fake/1", nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+
+			if got := rec.Header().Get("X-Amzn-ErrorType"); got != tt.wantCode {
+				t.Errorf("expected error type %q, got %q", tt.wantCode, got)
+			}
+		})
+	}
+}
+
+func TestFaultInjectionResource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		rule  FaultRule
+		want1 int
+		want2 int
+	}{
+		{
+			name:  "named resource",
+			rule:  FaultRule{Service: "fake", Resource: "1", ErrorRate: 1},
+			want1: http.StatusServiceUnavailable,
+			want2: http.StatusOK,
+		},
+		{
+			name:  "any resource",
+			rule:  FaultRule{Service: "fake", Resource: "*", ErrorRate: 1},
+			want1: http.StatusServiceUnavailable,
+			want2: http.StatusServiceUnavailable,
+		},
+		{
+			name:  "service without resources",
+			rule:  FaultRule{Service: "counter", Resource: "*", ErrorRate: 1},
+			want1: http.StatusOK,
+			want2: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newFaultTestServer(t, []FaultRule{tt.rule})
+
+			if got := serveFake(srv, "1"); got != tt.want1 {
+				t.Errorf("expected status %d for resource 1, got %d", tt.want1, got)
+			}
+
+			if got := serveFake(srv, "2"); got != tt.want2 {
+				t.Errorf("expected status %d for resource 2, got %d", tt.want2, got)
+			}
+		})
+	}
+}
+
+func TestFaultInjectionRequestsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	srv := newFaultTestServer(t, []FaultRule{{Service: "fake", Resource: "*", RequestsPerSecond: 2, StatusCode: http.StatusBadRequest, ErrorCode: "ProvisionedThroughputExceededException"}})
+
+	now := time.Now()
+	srv.faults.now = func() time.Time { return now }
+
+	for i, want := range []int{http.StatusOK, http.StatusOK, http.StatusBadRequest} {
+		if got := serveFake(srv, "1"); got != want {
+			t.Errorf("request %d: expected status %d, got %d", i, want, got)
+		}
+	}
+
+	// Each resource has its own limit.
+	if got := serveFake(srv, "2"); got != http.StatusOK {
+		t.Errorf("expected status %d for another resource, got %d", http.StatusOK, got)
+	}
+
+	now = now.Add(time.Second)
+
+	if got := serveFake(srv, "1"); got != http.StatusOK {
+		t.Errorf("expected status %d in the next second, got %d", http.StatusOK, got)
+	}
+}
+
+func TestFaultInjectionLatency(t *testing.T) {
+	t.Parallel()
+
+	latency := 50 * time.Millisecond
+	srv := newFaultTestServer(t, []FaultRule{{Service: "fake", Latency: latency}})
+
+	start := time.Now()
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/fake/1", nil))
+
+	if elapsed := time.Since(start); elapsed < latency {
+		t.Errorf("expected the request to take at least %s, took %s", latency, elapsed)
+	}
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+}
+
+func TestFaultsEndpoint(t *testing.T) {
+	t.Parallel()
+
+	srv := newFaultTestServer(t, nil)
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/kumo/faults", strings.NewReader(`[{"service": "fake", "latency": "10ms", "errorRate": 1.5}]`)))
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d for an invalid rule, got %d", http.StatusBadRequest, rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/kumo/faults", strings.NewReader(`[{"service": "fake", "latency": "10ms", "errorRate": 1}]`)))
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/kumo/faults", nil))
+
+	var rules []FaultRule
+	if err := json.Unmarshal(rec.Body.Bytes(), &rules); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (FaultRule{Service: "fake", Latency: 10 * time.Millisecond, ErrorRate: 1}); len(rules) != 1 || rules[0] != want {
+		t.Errorf("expected rules [%+v], got %+v", want, rules)
+	}
+
+	rec = httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/kumo/faults", nil))
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, rec.Code)
+	}
+
+	if rules := srv.faults.Rules(); len(rules) != 0 {
+		t.Errorf("expected no rules after delete, got %+v", rules)
+	}
+}
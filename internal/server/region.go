@@ -0,0 +1,130 @@
+package server
+
+import (
+	"maps"
+	"net/http"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// regionServices holds the instances of the services created for a region
+// when region isolation is enabled.
+type regionServices struct {
+	registry *service.Registry
+	// routes maps the service name, method and pattern of each REST route to its handler.
+	routes map[string]http.HandlerFunc
+}
+
+// get returns the instance of the named service in the region, or nil if it has none.
+func (rs *regionServices) get(name string) service.Service {
+	svc, ok := rs.registry.Get(name)
+	if !ok {
+		return nil
+	}
+
+	return svc
+}
+
+// regionRouter records the REST routes of a service created for a region.
+type regionRouter struct {
+	routes  map[string]http.HandlerFunc
+	service string
+}
+
+// Handle records the handler for the given method and pattern.
+func (r regionRouter) Handle(method, pattern string, handler http.HandlerFunc) {
+	r.routes[routeKey(r.service, method+" "+pattern)] = handler
+}
+
+// HandleFunc is an alias for Handle for compatibility with service.Router interface.
+func (r regionRouter) HandleFunc(method, pattern string, handler http.HandlerFunc) {
+	r.Handle(method, pattern, handler)
+}
+
+// routeKey returns the key of a REST route of a service in regionServices.routes.
+func routeKey(svc, route string) string {
+	return svc + " " + route
+}
+
+// regionServices returns the services of a region, creating them on first use.
+func (s *Server) regionServices(region string) *regionServices {
+	s.regionsMu.Lock()
+	defer s.regionsMu.Unlock()
+
+	if rs, ok := s.regions[region]; ok {
+		return rs
+	}
+
+	rs := &regionServices{
+		registry: service.NewRegistry(),
+		routes:   make(map[string]http.HandlerFunc),
+	}
+
+	for _, svc := range s.newRegion(region) {
+		if !s.config.ServiceEnabled(svc.Name()) {
+			continue
+		}
+
+		rs.registry.Register(svc)
+		svc.RegisterRoutes(regionRouter{routes: rs.routes, service: svc.Name()})
+	}
+
+	s.regions[region] = rs
+	s.logger.Info("created services for region", "region", region)
+
+	return rs
+}
+
+// createdRegions returns the services of every region created so far, keyed by region.
+func (s *Server) createdRegions() map[string]*regionServices {
+	s.regionsMu.Lock()
+	defer s.regionsMu.Unlock()
+
+	return maps.Clone(s.regions)
+}
+
+// allRegionInstances returns the instances of the given services in every region created so far.
+func (s *Server) allRegionInstances(services []service.Service) []service.Service {
+	s.regionsMu.Lock()
+	defer s.regionsMu.Unlock()
+
+	var instances []service.Service
+
+	for _, rs := range s.regions {
+		for _, svc := range services {
+			if instance := rs.get(svc.Name()); instance != nil {
+				instances = append(instances, instance)
+			}
+		}
+	}
+
+	return instances
+}
+
+// inRegion returns a handler that, when region isolation is enabled, passes the
+// requests made against a region other than the configured one to the handler
+// that lookup finds in the services of that region. Requests to services that
+// have no instance in the region are passed to next, which serves every region.
+// It must be called with the scope of the request attached to its context.
+func (s *Server) inRegion(next http.HandlerFunc, lookup func(rs *regionServices) http.HandlerFunc) http.HandlerFunc {
+	if !s.config.RegionIsolation {
+		return next
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		region := service.Region(r.Context())
+		if region == s.config.Region {
+			next(w, r)
+
+			return
+		}
+
+		if handler := lookup(s.regionServices(region)); handler != nil {
+			handler(w, r)
+
+			return
+		}
+
+		next(w, r)
+	}
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// counterService is a REST service that counts the requests to POST /count.
+type counterService struct {
+	mu    sync.Mutex
+	count int
+}
+
+func (*counterService) Name() string { return "counter" }
+
+func (c *counterService) RegisterRoutes(r service.Router) {
+	r.HandleFunc("POST", "/count", func(w http.ResponseWriter, _ *http.Request) {
+		c.mu.Lock()
+		c.count++
+		c.mu.Unlock()
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	r.HandleFunc("GET", "/count", func(w http.ResponseWriter, _ *http.Request) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		_, _ = w.Write([]byte(strconv.Itoa(c.count)))
+	})
+}
+
+func (c *counterService) Reset(_ context.Context) error {
+	c.mu.Lock()
+	c.count = 0
+	c.mu.Unlock()
+
+	return nil
+}
+
+// newCounterServer returns a server mounting a counterService, which it
+// also creates for each region.
+func newCounterServer(isolation bool) *Server {
+	srv := New(Config{Services: []string{"counter"}, LogLevel: slog.LevelError, Region: "us-east-1", RegionIsolation: isolation})
+	srv.newRegion = func(string) []service.Service {
+		return []service.Service{&counterService{}}
+	}
+	srv.RegisterService(&counterService{})
+
+	return srv
+}
+
+// serveCounter sends a request to the counter service of the server in a region.
+func serveCounter(t *testing.T, srv *Server, method, region string) string {
+	t.Helper()
+
+	req := httptest.NewRequest(method, "/count", nil)
+	req.Host = "counter." + region + ".localhost:4566"
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	return rec.Body.String()
+}
+
+func TestRegionIsolation(t *testing.T) {
+	t.Parallel()
+
+	srv := newCounterServer(true)
+
+	serveCounter(t, srv, http.MethodPost, "us-east-1")
+	serveCounter(t, srv, http.MethodPost, "eu-west-1")
+	serveCounter(t, srv, http.MethodPost, "eu-west-1")
+
+	for region, want := range map[string]string{"us-east-1": "1", "eu-west-1": "2", "ap-northeast-1": "0"} {
+		if got := serveCounter(t, srv, http.MethodGet, region); got != want {
+			t.Errorf("expected count %s in %s, got %s", want, region, got)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/kumo/reset", nil))
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected status 204 from reset, got %d", rec.Code)
+	}
+
+	if got := serveCounter(t, srv, http.MethodGet, "eu-west-1"); got != "0" {
+		t.Errorf("expected count 0 in eu-west-1 after reset, got %s", got)
+	}
+}
+
+func TestRegionIsolationDisabled(t *testing.T) {
+	t.Parallel()
+
+	srv := newCounterServer(false)
+
+	serveCounter(t, srv, http.MethodPost, "us-east-1")
+	serveCounter(t, srv, http.MethodPost, "eu-west-1")
+
+	if got := serveCounter(t, srv, http.MethodGet, "ap-northeast-1"); got != "2" {
+		t.Errorf("expected count 2 shared by every region, got %s", got)
+	}
+}
+
+func TestFaultInjectionInEveryRegion(t *testing.T) {
+	t.Parallel()
+
+	srv := newCounterServer(true)
+	srv.faults.SetRules([]FaultRule{{Service: "counter", ErrorRate: 1}})
+
+	for _, region := range []string{"us-east-1", "eu-west-1"} {
+		req := httptest.NewRequest(http.MethodPost, "/count", nil)
+		req.Host = "counter." + region + ".localhost:4566"
+
+		rec := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusServiceUnavailable {
+			t.Errorf("expected status 503 from the fault rule in %s, got %d", region, rec.Code)
+		}
+	}
+}
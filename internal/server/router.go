@@ -22,6 +22,7 @@ type Router struct {
 	mux           *http.ServeMux
 	routes        []Route
 	prefixRouters map[string]*http.ServeMux // Separate routers for services with prefixes
+	metrics       *callMetrics
 	logger        *slog.Logger
 }
 
@@ -31,6 +32,7 @@ func NewRouter(logger *slog.Logger) *Router {
 		mux:           http.NewServeMux(),
 		routes:        make([]Route, 0),
 		prefixRouters: make(map[string]*http.ServeMux),
+		metrics:       newCallMetrics(),
 		logger:        logger,
 	}
 
@@ -76,7 +78,8 @@ func extractRoutePrefix(pattern string) string {
 	// /service is for RPC v2 CBOR protocol
 	// EventBridge Pipes uses /v1/pipes and /tags paths
 	// EMR Serverless uses /applications paths
-	prefixes := []string{"/kumo", "/lambda", "/2015-03-31", "/eks", "/iam", "/buckets", "/namespaces", "/tables", "/get-table", "/apigateway", "/ses", "/2020-05-31", "/2013-04-01", "/service", "/appsync", "/v1", "/tags", "/applications", "/v20190125", "/scheduler", "/dlm", "/mq", "/v20180820", "/kx", "/kafka", "/create-app", "/describe-app", "/update-app", "/delete-app", "/list-apps", "/create-resiliency-policy", "/describe-resiliency-policy", "/update-resiliency-policy", "/delete-resiliency-policy", "/list-resiliency-policies", "/start-app-assessment", "/describe-app-assessment", "/delete-app-assessment", "/list-app-assessments", "/tag-resource", "/untag-resource", "/list-tags-for-resource", "/schemas", "/matchingworkflows", "/idmappingworkflows", "/providerservices", "/-", "/snapshots", "/apps", "/backup-vaults", "/backup", "/associations", "/codereviews", "/feedback", "/profilingGroups", "/maps", "/places", "/routes", "/geofencing", "/tracking", "/metadata", "/macie", "/allow-lists", "/jobs", "/custom-data-identifiers", "/findingsfilters", "/findings", "/managed-data-identifiers"}
+	// ECR serves the Docker Registry API under /v2
+	prefixes := []string{"/kumo", "/lambda", "/2015-03-31", "/2017-03-31", "/2017-10-31", "/2019-09-25", "/2019-09-30", "/2021-10-31", "/eks", "/iam", "/buckets", "/namespaces", "/tables", "/get-table", "/apigateway", "/ses", "/2020-05-31", "/2013-04-01", "/service", "/appsync", "/v1", "/tags", "/applications", "/v20190125", "/scheduler", "/dlm", "/mq", "/v20180820", "/kx", "/kafka", "/create-app", "/describe-app", "/update-app", "/delete-app", "/list-apps", "/create-resiliency-policy", "/describe-resiliency-policy", "/update-resiliency-policy", "/delete-resiliency-policy", "/list-resiliency-policies", "/start-app-assessment", "/describe-app-assessment", "/delete-app-assessment", "/list-app-assessments", "/tag-resource", "/untag-resource", "/list-tags-for-resource", "/schemas", "/matchingworkflows", "/idmappingworkflows", "/providerservices", "/-", "/snapshots", "/apps", "/backup-vaults", "/backup", "/associations", "/codereviews", "/feedback", "/profilingGroups", "/maps", "/places", "/routes", "/geofencing", "/tracking", "/metadata", "/macie", "/allow-lists", "/jobs", "/custom-data-identifiers", "/findingsfilters", "/findings", "/managed-data-identifiers", "/restapis", "/v2"}
 
 	for _, prefix := range prefixes {
 		if len(pattern) >= len(prefix) && pattern[:len(prefix)] == prefix {
@@ -92,7 +95,7 @@ func (r *Router) HandleFunc(method, pattern string, handler http.HandlerFunc) {
 	r.Handle(method, pattern, handler)
 }
 
-// wrapHandler wraps a handler with logging and request ID injection.
+// wrapHandler wraps a handler with logging, call metrics and request ID injection.
 func (r *Router) wrapHandler(method, pattern string, handler http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
 		start := time.Now()
@@ -116,6 +119,13 @@ func (r *Router) wrapHandler(method, pattern string, handler http.HandlerFunc) h
 		// Wrap response writer to capture status code
 		wrapped := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
 
+		// Let the service handler record the service and action it was routed to.
+		info := requestInfoFrom(req.Context())
+		if info == nil {
+			info = &requestInfo{}
+			req = req.WithContext(withRequestInfo(req.Context(), info))
+		}
+
 		// Call the actual handler
 		handler(wrapped, req)
 
@@ -129,13 +139,13 @@ func (r *Router) wrapHandler(method, pattern string, handler http.HandlerFunc) h
 			"request_id", requestID,
 		}
 
-		// Extract service and action from protocol headers.
-		if target := req.Header.Get("X-Amz-Target"); target != "" {
-			attrs = append(attrs, "target", target)
+		if info.service != "" {
+			attrs = append(attrs, "service", info.service, "action", info.action)
+			r.metrics.record(info.service, info.action, wrapped.statusCode, info.fault)
 		}
 
-		if action := req.URL.Query().Get("Action"); action != "" {
-			attrs = append(attrs, "action", action)
+		if info.fault {
+			attrs = append(attrs, "fault", true)
 		}
 
 		r.logger.Info("request", attrs...)
@@ -158,6 +168,11 @@ func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	r.muxFor(req).ServeHTTP(w, req)
+}
+
+// muxFor returns the ServeMux that routes the request.
+func (r *Router) muxFor(req *http.Request) *http.ServeMux {
 	// Check if the request matches a prefix router first.
 	// Use longest prefix match to avoid short prefixes (e.g., "/apps")
 	// incorrectly capturing longer ones (e.g., "/appsync").
@@ -170,12 +185,18 @@ func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	}
 
 	if bestPrefix != "" {
-		r.prefixRouters[bestPrefix].ServeHTTP(w, req)
-
-		return
+		return r.prefixRouters[bestPrefix]
 	}
 
-	r.mux.ServeHTTP(w, req)
+	return r.mux
+}
+
+// match returns the pattern, such as "GET /{bucket}", of the route that would handle
+// the request, without handling it. It is empty if no route matches.
+func (r *Router) match(req *http.Request) string {
+	_, pattern := r.muxFor(req).Handler(req)
+
+	return pattern
 }
 
 // Routes returns all registered routes.
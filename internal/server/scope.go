@@ -0,0 +1,40 @@
+package server
+
+import (
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// regionPattern matches region names such as "us-east-1" or "us-gov-west-1".
+var regionPattern = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+
+// requestRegion returns the region a request is made against: the region of the
+// sending service for internal requests, else the region of its SigV4 credential
+// scope, else the region named in its host, such as sqs.eu-west-1.amazonaws.com,
+// else defaultRegion.
+func requestRegion(r *http.Request, defaultRegion string) string {
+	if region := service.InternalRequestRegion(r); regionPattern.MatchString(region) {
+		return region
+	}
+
+	if sr, err := parseSigV4Request(r); err == nil && regionPattern.MatchString(sr.region) {
+		return sr.region
+	}
+
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	for label := range strings.SplitSeq(host, ".") {
+		if regionPattern.MatchString(label) {
+			return label
+		}
+	}
+
+	return defaultRegion
+}
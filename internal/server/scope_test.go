@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+func TestRequestRegion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		host           string
+		authorization  string
+		query          string
+		internalRegion string
+		forgedRegion   string
+		want           string
+	}{
+		{
+			name: "default",
+			host: "localhost:4566",
+			want: "us-east-1",
+		},
+		{
+			name:          "credential scope",
+			host:          "localhost:4566",
+			authorization: "AWS4-HMAC-SHA256 Credential=AKID/20240101/eu-west-1/sqs/aws4_request, SignedHeaders=host, Signature=abc",
+			want:          "eu-west-1",
+		},
+		{
+			name:  "presigned credential scope",
+			host:  "localhost:4566",
+			query: "X-Amz-Algorithm=AWS4-HMAC-SHA256&X-Amz-Credential=AKID%2F20240101%2Fap-northeast-1%2Fs3%2Faws4_request&X-Amz-SignedHeaders=host&X-Amz-Signature=abc&X-Amz-Expires=60",
+			want:  "ap-northeast-1",
+		},
+		{
+			name: "host",
+			host: "sqs.us-gov-west-1.localhost:4566",
+			want: "us-gov-west-1",
+		},
+		{
+			name:          "credential scope wins over host",
+			host:          "sqs.eu-central-1.amazonaws.com",
+			authorization: "AWS4-HMAC-SHA256 Credential=AKID/20240101/us-west-2/sqs/aws4_request, SignedHeaders=host, Signature=abc",
+			want:          "us-west-2",
+		},
+		{
+			name:          "invalid credential scope region",
+			host:          "localhost:4566",
+			authorization: "AWS4-HMAC-SHA256 Credential=AKID/20240101/local/sqs/aws4_request, SignedHeaders=host, Signature=abc",
+			want:          "us-east-1",
+		},
+		{
+			name:           "internal request",
+			host:           "localhost:4566",
+			internalRegion: "eu-west-1",
+			want:           "eu-west-1",
+		},
+		{
+			name:         "region header without internal token",
+			host:         "localhost:4566",
+			forgedRegion: "eu-west-1",
+			want:         "us-east-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/?"+tt.query, nil)
+			req.Host = tt.host
+
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+
+			if tt.internalRegion != "" {
+				req = req.WithContext(service.WithScope(req.Context(), "", tt.internalRegion))
+				service.MarkInternalRequest(req)
+			}
+
+			if tt.forgedRegion != "" {
+				req.Header.Set("X-Kumo-Internal-Region", tt.forgedRegion)
+			}
+
+			if got := requestRegion(req, "us-east-1"); got != tt.want {
+				t.Errorf("expected region %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+// scopeService is a REST service that echoes the account and region of its requests.
+type scopeService struct{}
+
+func (scopeService) Name() string { return "scope" }
+
+func (scopeService) RegisterRoutes(r service.Router) {
+	r.HandleFunc("GET", "/scope", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(service.AccountID(r.Context()) + " " + service.Region(r.Context())))
+	})
+}
+
+func (scopeService) Reset(_ context.Context) error { return nil }
+
+func TestHandleServiceRequestScope(t *testing.T) {
+	t.Parallel()
+
+	srv := New(Config{Services: []string{"scope"}, LogLevel: slog.LevelError, AccountID: "123456789012", Region: "eu-west-1"})
+	srv.RegisterService(scopeService{})
+
+	tests := []struct {
+		name string
+		host string
+		want string
+	}{
+		{
+			name: "configured defaults",
+			host: "localhost:4566",
+			want: "123456789012 eu-west-1",
+		},
+		{
+			name: "region from host",
+			host: "scope.ap-southeast-2.localhost:4566",
+			want: "123456789012 ap-southeast-2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/scope", nil)
+			req.Host = tt.host
+
+			rec := httptest.NewRecorder()
+			srv.Handler().ServeHTTP(rec, req)
+
+			if got := rec.Body.String(); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -12,6 +12,10 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -22,31 +26,176 @@ import (
 // Config holds the server configuration.
 type Config struct {
 	Host     string
-	Port     int
+	Port     int // 0 picks a free port
 	LogLevel slog.Level
 	InitDir  string // Directory containing init scripts to execute on startup
+
+	// Services lists the names of the services to mount. When empty, every registered service is mounted.
+	Services []string
+	// DisabledServices lists the names of services that are not mounted, even if listed in Services.
+	DisabledServices []string
+
+	// TLS serves HTTPS alongside plain HTTP on the same port. Unless TLSCertFile and
+	// TLSKeyFile are set, a self-signed CA and a certificate signed by it are generated.
+	TLS         bool
+	TLSCertFile string
+	TLSKeyFile  string
+
+	// Faults injects latency or errors into the requests of the matching services.
+	Faults []FaultRule
+
+	// AccessKeyID and SecretAccessKey, when set, are the only credentials accepted:
+	// the SigV4 signature of every request to a service is verified against them.
+	AccessKeyID     string
+	SecretAccessKey string
+
+	// AccountID is the account that resources are created in, as it appears in ARNs and URLs.
+	AccountID string
+	// Region is the region of requests that name none in their SigV4 credential scope or host.
+	Region string
+	// RegionIsolation keeps the resources of each region apart: requests made against a region
+	// other than Region are served by instances of the services created for that region.
+	RegionIsolation bool
+
+	// CORSAllowedOrigins lists the origins browser clients may call kumo from, or "*" for any.
+	// When empty, no CORS headers are added except those of S3 bucket CORS rules.
+	CORSAllowedOrigins []string
 }
 
-// DefaultConfig returns the default server configuration.
+// DefaultConfig returns the default server configuration,
+// overridden by the KUMO_* environment variables that are set.
 func DefaultConfig() Config {
-	return Config{
+	cfg := Config{
 		Host:     "0.0.0.0",
 		Port:     4566,
 		LogLevel: slog.LevelInfo,
 		InitDir:  os.Getenv("KUMO_INIT_DIR"),
+
+		AccountID: service.DefaultAccountID,
+		Region:    service.DefaultRegion,
+
+		CORSAllowedOrigins: []string{"*"},
+	}
+
+	if host := os.Getenv("KUMO_HOST"); host != "" {
+		cfg.Host = host
+	}
+
+	if port := os.Getenv("KUMO_PORT"); port != "" {
+		if p, err := strconv.Atoi(port); err != nil {
+			logInvalidEnv("KUMO_PORT", err)
+		} else {
+			cfg.Port = p
+		}
+	}
+
+	if level := os.Getenv("KUMO_LOG_LEVEL"); level != "" {
+		if err := cfg.LogLevel.UnmarshalText([]byte(level)); err != nil {
+			logInvalidEnv("KUMO_LOG_LEVEL", err)
+		}
+	}
+
+	cfg.Services = splitList(os.Getenv("KUMO_SERVICES"))
+	cfg.DisabledServices = splitList(os.Getenv("KUMO_DISABLED_SERVICES"))
+
+	cfg.TLS = envBool("KUMO_TLS")
+	cfg.TLSCertFile = os.Getenv("KUMO_TLS_CERT_FILE")
+	cfg.TLSKeyFile = os.Getenv("KUMO_TLS_KEY_FILE")
+
+	if accountID := os.Getenv("KUMO_ACCOUNT_ID"); accountID != "" {
+		cfg.AccountID = accountID
+	}
+
+	if region := os.Getenv("KUMO_REGION"); region != "" {
+		cfg.Region = region
+	}
+
+	cfg.RegionIsolation = envBool("KUMO_REGION_ISOLATION")
+
+	cfg.AccessKeyID = os.Getenv("KUMO_ACCESS_KEY_ID")
+	cfg.SecretAccessKey = os.Getenv("KUMO_SECRET_ACCESS_KEY")
+
+	if origins, ok := os.LookupEnv("KUMO_CORS_ALLOWED_ORIGINS"); ok {
+		cfg.CORSAllowedOrigins = splitList(origins)
 	}
+
+	if faults := os.Getenv("KUMO_FAULTS"); faults != "" {
+		rules, err := parseFaultRules([]byte(faults))
+		if err != nil {
+			logInvalidEnv("KUMO_FAULTS", err)
+		}
+
+		cfg.Faults = rules
+	}
+
+	return cfg
+}
+
+// envBool parses a boolean environment variable, which is false when unset or invalid.
+func envBool(name string) bool {
+	v := os.Getenv(name)
+	if v == "" {
+		return false
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		logInvalidEnv(name, err)
+	}
+
+	return b
+}
+
+// logInvalidEnv reports an environment variable that cannot be parsed and is ignored.
+func logInvalidEnv(name string, err error) {
+	slog.Warn("ignoring invalid environment variable", "name", name, "error", err)
+}
+
+// ServiceEnabled reports whether the service with the given name is mounted.
+func (c Config) ServiceEnabled(name string) bool {
+	if slices.Contains(c.DisabledServices, name) {
+		return false
+	}
+
+	return len(c.Services) == 0 || slices.Contains(c.Services, name)
+}
+
+// TLSEnabled reports whether the server accepts HTTPS connections.
+func (c Config) TLSEnabled() bool {
+	return c.TLS || c.TLSCertFile != ""
+}
+
+// splitList splits a comma-separated list, dropping empty entries.
+func splitList(v string) []string {
+	var names []string
+
+	for name := range strings.SplitSeq(v, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
 }
 
 // Server is the main HTTP server for kumo.
 type Server struct {
 	config          Config
 	router          *Router
+	handler         http.Handler
 	registry        *service.Registry
 	jsonDispatcher  *JSONProtocolDispatcher
 	queryDispatcher *QueryProtocolDispatcher
 	cborDispatcher  *CBORProtocolDispatcher
+	faults          *faultInjector
+	regionsMu       sync.Mutex
+	regions         map[string]*regionServices // services of other regions, with region isolation
+	routeServices   map[string]string          // route pattern -> name of the service it belongs to
+	newRegion       func(region string) []service.Service
 	logger          *slog.Logger
 	server          *http.Server
+	listener        net.Listener
+	caPEM           []byte // CA of the generated TLS certificate
 }
 
 // New creates a new server with the given configuration.
@@ -69,14 +218,50 @@ func New(config Config) *Server {
 		jsonDispatcher:  jsonDispatcher,
 		queryDispatcher: queryDispatcher,
 		cborDispatcher:  cborDispatcher,
-		logger:          logger,
+		faults:          newFaultInjector(config.Faults),
+		regions:         make(map[string]*regionServices),
+		routeServices:   make(map[string]string),
+		newRegion: func(region string) []service.Service {
+			return service.NewRegionServices(region, config.ServiceEnabled)
+		},
+		logger: logger,
 	}
 
-	// Auto-register services from global registry
-	for _, svc := range service.Services() {
+	// Serve browser SDK clients from the allowed origins.
+	srv.handler = newCORSHandler(router, config.CORSAllowedOrigins, srv.routeService)
+
+	// Create the enabled services registered via init(). Disabled services are never created.
+	for _, svc := range service.NewServices(config.ServiceEnabled) {
+		if !config.ServiceEnabled(svc.Name()) {
+			logger.Debug("skipped disabled service", "name", svc.Name())
+
+			continue
+		}
+
 		srv.RegisterService(svc)
 	}
 
+	for _, name := range config.Services {
+		if !slices.Contains(service.ServiceNames(), name) {
+			logger.Warn("unknown service in service list", "name", name)
+		}
+	}
+
+	// Register kumo-specific endpoints for saving, restoring and resetting service state.
+	router.HandleFunc("GET", "/kumo/state", srv.getState)
+	router.HandleFunc("POST", "/kumo/state", srv.restoreState)
+	router.HandleFunc("POST", "/kumo/reset", srv.resetState)
+	router.HandleFunc("GET", "/kumo/tls/ca.pem", srv.getCACertificate)
+
+	// Register kumo-specific endpoints for managing fault injection.
+	router.HandleFunc("GET", "/kumo/faults", srv.getFaults)
+	router.HandleFunc("PUT", "/kumo/faults", srv.putFaults)
+	router.HandleFunc("DELETE", "/kumo/faults", srv.deleteFaults)
+
+	// Register kumo-specific endpoints for the request counts of each service action.
+	router.HandleFunc("GET", "/kumo/metrics", srv.getMetrics)
+	router.HandleFunc("DELETE", "/kumo/metrics", srv.deleteMetrics)
+
 	// Register unified protocol dispatcher for POST /
 	hasJSONServices := len(jsonDispatcher.handlers) > 0
 	hasQueryServices := len(queryDispatcher.handlers) > 0
@@ -103,6 +288,18 @@ func (s *Server) unifiedDispatcher(w http.ResponseWriter, r *http.Request) {
 
 	// Query protocol uses form-urlencoded.
 	if mediaType == "application/x-www-form-urlencoded" {
+		// Keep the request as signed, as the dispatcher converts the form to JSON.
+		if info := requestInfoFrom(r.Context()); info != nil && s.config.AccessKeyID != "" {
+			signed, err := cloneSignedRequest(r)
+			if err != nil {
+				writeQueryError(w, "InvalidParameterValue", "Failed to read request body")
+
+				return
+			}
+
+			info.signed = signed
+		}
+
 		s.queryDispatcher.ServeHTTP(w, r)
 
 		return
@@ -123,23 +320,50 @@ func (s *Server) Router() *Router {
 }
 
 // RegisterService registers a service with the server.
+// Requests to the service are attributed to it in the request log and are subject to fault injection.
+// With region isolation, requests made against other regions are served by the instances of the
+// service that RegisterFactory can create for them.
 func (s *Server) RegisterService(svc service.Service) {
+	name := svc.Name()
+
 	s.registry.Register(svc)
-	svc.RegisterRoutes(s.router)
+	svc.RegisterRoutes(serviceRouter{server: s, service: name})
 
 	// Check if service implements JSON protocol.
 	if jsonSvc, ok := svc.(service.JSONProtocolService); ok {
-		s.jsonDispatcher.Register(jsonSvc.TargetPrefix(), jsonSvc.DispatchAction)
+		dispatch := s.inRegion(jsonSvc.DispatchAction, func(rs *regionServices) http.HandlerFunc {
+			if regional, ok := rs.get(name).(service.JSONProtocolService); ok {
+				return regional.DispatchAction
+			}
+
+			return nil
+		})
+
+		s.jsonDispatcher.Register(jsonSvc.TargetPrefix(), func(w http.ResponseWriter, r *http.Request) {
+			s.handleServiceRequest(w, r, name, protocolJSON, targetAction(r), dispatch)
+		})
 		s.logger.Debug("registered JSON protocol service", "name", svc.Name(), "prefix", jsonSvc.TargetPrefix())
 	}
 
 	// Check if service implements Query protocol.
 	if querySvc, ok := svc.(service.QueryProtocolService); ok {
-		s.queryDispatcher.Register(querySvc.TargetPrefix(), querySvc.DispatchAction)
+		next := s.inRegion(querySvc.DispatchAction, func(rs *regionServices) http.HandlerFunc {
+			if regional, ok := rs.get(name).(service.QueryProtocolService); ok {
+				return regional.DispatchAction
+			}
+
+			return nil
+		})
+
+		dispatch := func(w http.ResponseWriter, r *http.Request) {
+			s.handleServiceRequest(w, r, name, protocolQuery, targetAction(r), next)
+		}
+
+		s.queryDispatcher.Register(querySvc.TargetPrefix(), dispatch)
 
 		// Register each action for proper routing.
 		for _, action := range querySvc.Actions() {
-			s.queryDispatcher.RegisterAction(action, querySvc.TargetPrefix(), querySvc.ServiceIdentifier(), querySvc.DispatchAction)
+			s.queryDispatcher.RegisterAction(action, querySvc.TargetPrefix(), querySvc.ServiceIdentifier(), dispatch)
 		}
 
 		s.logger.Debug("registered Query protocol service",
@@ -151,34 +375,52 @@ func (s *Server) RegisterService(svc service.Service) {
 
 	// Check if service implements CBOR protocol.
 	if cborSvc, ok := svc.(service.CBORProtocolService); ok {
-		s.cborDispatcher.Register(cborSvc.ServiceName(), cborSvc.DispatchCBORAction)
+		s.cborDispatcher.Register(cborSvc.ServiceName(), func(w http.ResponseWriter, r *http.Request, operation string) {
+			next := s.inRegion(func(w http.ResponseWriter, r *http.Request) {
+				cborSvc.DispatchCBORAction(w, r, operation)
+			}, func(rs *regionServices) http.HandlerFunc {
+				regional, ok := rs.get(name).(service.CBORProtocolService)
+				if !ok {
+					return nil
+				}
+
+				return func(w http.ResponseWriter, r *http.Request) {
+					regional.DispatchCBORAction(w, r, operation)
+				}
+			})
+
+			s.handleServiceRequest(w, r, name, protocolCBOR, operation, next)
+		})
 		s.logger.Debug("registered CBOR protocol service", "name", svc.Name(), "serviceName", cborSvc.ServiceName())
 	}
 
 	s.logger.Info("registered service", "name", svc.Name())
 }
 
-// Addr returns the server address.
+// Addr returns the server address. Once the server is listening, it is the
+// address actually bound, which differs from the configured one when Port is 0.
 func (s *Server) Addr() string {
-	return fmt.Sprintf("%s:%d", s.config.Host, s.config.Port)
+	if s.listener != nil {
+		return s.listener.Addr().String()
+	}
+
+	return net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
 }
 
 // Handler returns the HTTP handler for the server.
 // This can be used with httptest.NewServer for in-process testing.
 func (s *Server) Handler() http.Handler {
-	return s.router
+	return s.handler
 }
 
 // Start starts the HTTP server. It accepts an optional readyCh channel that will be
 // closed once the server is listening and ready to accept connections.
 func (s *Server) Start(readyCh ...chan struct{}) error {
 	s.server = &http.Server{
-		Handler:           s.router,
+		Handler:           s.handler,
 		ReadHeaderTimeout: 10 * time.Second,
 	}
 
-	s.logger.Info("starting kumo server", "addr", s.Addr())
-
 	// List registered services
 	for _, name := range s.registry.Names() {
 		s.logger.Info("service available", "name", name)
@@ -189,6 +431,22 @@ func (s *Server) Start(readyCh ...chan struct{}) error {
 		return fmt.Errorf("failed to listen on %s: %w", s.Addr(), err)
 	}
 
+	s.listener = ln
+
+	if s.config.TLSEnabled() {
+		tlsConfig, err := s.tlsConfig()
+		if err != nil {
+			_ = ln.Close()
+
+			return err
+		}
+
+		s.listener = newSniffListener(ln, tlsConfig)
+		ln = s.listener
+	}
+
+	s.logger.Info("starting kumo server", "addr", s.Addr(), "tls", s.config.TLSEnabled())
+
 	// Signal that the server is ready to accept connections.
 	if len(readyCh) > 0 && readyCh[0] != nil {
 		close(readyCh[0])
@@ -205,8 +463,11 @@ func (s *Server) Start(readyCh ...chan struct{}) error {
 func (s *Server) Shutdown(ctx context.Context) error {
 	s.logger.Info("shutting down server")
 
+	services := s.registry.All()
+	services = append(services, s.allRegionInstances(services)...)
+
 	// Save snapshots for services that implement io.Closer.
-	for _, svc := range s.registry.All() {
+	for _, svc := range services {
 		if c, ok := svc.(io.Closer); ok {
 			if err := c.Close(); err != nil {
 				s.logger.Error("failed to save snapshot", "service", svc.Name(), "error", err)
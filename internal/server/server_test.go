@@ -0,0 +1,196 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+func TestDefaultConfigFromEnv(t *testing.T) {
+	t.Setenv("KUMO_HOST", "127.0.0.1")
+	t.Setenv("KUMO_PORT", "0")
+	t.Setenv("KUMO_LOG_LEVEL", "debug")
+	t.Setenv("KUMO_SERVICES", "s3, sqs,,dynamodb")
+	t.Setenv("KUMO_DISABLED_SERVICES", "sqs")
+	t.Setenv("KUMO_CORS_ALLOWED_ORIGINS", "http://localhost:3000,")
+	t.Setenv("KUMO_ACCOUNT_ID", "123456789012")
+	t.Setenv("KUMO_REGION", "eu-west-1")
+	t.Setenv("KUMO_REGION_ISOLATION", "true")
+	t.Setenv("KUMO_FAULTS", `[{"service": "s3", "action": "GetObject", "latency": "100ms", "errorRate": 0.5}]`)
+
+	cfg := DefaultConfig()
+
+	if cfg.Host != "127.0.0.1" {
+		t.Errorf("expected host 127.0.0.1, got %s", cfg.Host)
+	}
+
+	if cfg.Port != 0 {
+		t.Errorf("expected port 0, got %d", cfg.Port)
+	}
+
+	if cfg.LogLevel != slog.LevelDebug {
+		t.Errorf("expected log level debug, got %s", cfg.LogLevel)
+	}
+
+	if want := []string{"s3", "sqs", "dynamodb"}; !slices.Equal(cfg.Services, want) {
+		t.Errorf("expected services %v, got %v", want, cfg.Services)
+	}
+
+	if want := []string{"sqs"}; !slices.Equal(cfg.DisabledServices, want) {
+		t.Errorf("expected disabled services %v, got %v", want, cfg.DisabledServices)
+	}
+
+	if want := []string{"http://localhost:3000"}; !slices.Equal(cfg.CORSAllowedOrigins, want) {
+		t.Errorf("expected CORS allowed origins %v, got %v", want, cfg.CORSAllowedOrigins)
+	}
+
+	if cfg.AccountID != "123456789012" {
+		t.Errorf("expected account ID 123456789012, got %s", cfg.AccountID)
+	}
+
+	if cfg.Region != "eu-west-1" {
+		t.Errorf("expected region eu-west-1, got %s", cfg.Region)
+	}
+
+	if !cfg.RegionIsolation {
+		t.Error("expected region isolation to be enabled")
+	}
+
+	if want := []FaultRule{{Service: "s3", Action: "GetObject", Latency: 100 * time.Millisecond, ErrorRate: 0.5}}; !slices.Equal(cfg.Faults, want) {
+		t.Errorf("expected faults %+v, got %+v", want, cfg.Faults)
+	}
+}
+
+func TestDefaultConfigInvalidEnv(t *testing.T) {
+	t.Setenv("KUMO_PORT", "http")
+	t.Setenv("KUMO_LOG_LEVEL", "verbose")
+	t.Setenv("KUMO_TLS", "yes please")
+	t.Setenv("KUMO_REGION_ISOLATION", "sure")
+	t.Setenv("KUMO_FAULTS", `[{"service": "s3", "errorRate": 2}]`)
+
+	var logs bytes.Buffer
+
+	logger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+	t.Cleanup(func() { slog.SetDefault(logger) })
+
+	cfg := DefaultConfig()
+
+	if cfg.Port != 4566 || cfg.LogLevel != slog.LevelInfo || cfg.TLS || cfg.RegionIsolation || cfg.Faults != nil {
+		t.Errorf("expected the defaults for invalid values, got %+v", cfg)
+	}
+
+	for _, name := range []string{"KUMO_PORT", "KUMO_LOG_LEVEL", "KUMO_TLS", "KUMO_REGION_ISOLATION", "KUMO_FAULTS"} {
+		if !strings.Contains(logs.String(), "name="+name+" ") {
+			t.Errorf("expected invalid %s to be logged, got logs:\n%s", name, logs.String())
+		}
+	}
+}
+
+func TestConfigServiceEnabled(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		config  Config
+		service string
+		want    bool
+	}{
+		{
+			name:    "all services by default",
+			config:  Config{},
+			service: "s3",
+			want:    true,
+		},
+		{
+			name:    "listed service",
+			config:  Config{Services: []string{"s3", "sqs"}},
+			service: "sqs",
+			want:    true,
+		},
+		{
+			name:    "unlisted service",
+			config:  Config{Services: []string{"s3", "sqs"}},
+			service: "dynamodb",
+			want:    false,
+		},
+		{
+			name:    "disabled service",
+			config:  Config{DisabledServices: []string{"s3"}},
+			service: "s3",
+			want:    false,
+		},
+		{
+			name:    "disabled wins over listed",
+			config:  Config{Services: []string{"s3"}, DisabledServices: []string{"s3"}},
+			service: "s3",
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.config.ServiceEnabled(tt.service); got != tt.want {
+				t.Errorf("ServiceEnabled(%q) = %v, want %v", tt.service, got, tt.want)
+			}
+		})
+	}
+}
+
+// namedService is a service without routes.
+type namedService struct {
+	name string
+}
+
+func (s namedService) Name() string { return s.name }
+
+func (namedService) RegisterRoutes(service.Router) {}
+
+func (namedService) Reset(context.Context) error { return nil }
+
+func TestNewCreatesEnabledServicesOnly(t *testing.T) {
+	t.Parallel()
+
+	var enabledCalls, disabledCalls atomic.Int32
+
+	service.RegisterFactory([]string{"factory-enabled"}, func(string) []service.Service {
+		enabledCalls.Add(1)
+
+		return []service.Service{namedService{name: "factory-enabled"}}
+	})
+	service.RegisterFactory([]string{"factory-disabled"}, func(string) []service.Service {
+		disabledCalls.Add(1)
+
+		return []service.Service{namedService{name: "factory-disabled"}}
+	})
+
+	srv := New(Config{
+		Services:         []string{"factory-enabled", "factory-disabled"},
+		DisabledServices: []string{"factory-disabled"},
+		LogLevel:         slog.LevelError,
+		Region:           "us-east-1",
+		RegionIsolation:  true,
+	})
+	srv.regionServices("eu-west-1")
+
+	if got := enabledCalls.Load(); got != 2 {
+		t.Errorf("expected the enabled factory to be called for the default region and eu-west-1, got %d calls", got)
+	}
+
+	if got := disabledCalls.Load(); got != 0 {
+		t.Errorf("expected the disabled factory never to be called, got %d calls", got)
+	}
+
+	if _, ok := srv.registry.Get("factory-enabled"); !ok {
+		t.Error("expected the enabled service to be registered")
+	}
+}
@@ -0,0 +1,366 @@
+package server
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// SigV4 constants.
+const (
+	sigV4Algorithm       = "AWS4-HMAC-SHA256"
+	sigV4TimeFormat      = "20060102T150405Z"
+	sigV4UnsignedPayload = "UNSIGNED-PAYLOAD"
+
+	// sigV4MaxSkew is how far the signing time of a request may be from the server clock.
+	sigV4MaxSkew = 15 * time.Minute
+)
+
+// authErrorKind classifies request authentication failures.
+type authErrorKind int
+
+const (
+	authMissing authErrorKind = iota
+	authUnknownAccessKey
+	authSignatureMismatch
+	authExpired
+	authTimeSkewed
+)
+
+// authError is a request authentication failure.
+type authError struct {
+	kind    authErrorKind
+	message string
+}
+
+// code returns the AWS error code of the failure in the protocol of the service.
+func (e *authError) code(proto protocol, restXML bool) string {
+	switch {
+	case proto == protocolREST && restXML:
+		return [...]string{"AccessDenied", "InvalidAccessKeyId", "SignatureDoesNotMatch", "AccessDenied", "RequestTimeTooSkewed"}[e.kind]
+	case proto == protocolQuery:
+		return [...]string{"MissingAuthenticationToken", "InvalidClientTokenId", "SignatureDoesNotMatch", "SignatureDoesNotMatch", "SignatureDoesNotMatch"}[e.kind]
+	default:
+		return [...]string{"MissingAuthenticationTokenException", "UnrecognizedClientException", "InvalidSignatureException", "InvalidSignatureException", "InvalidSignatureException"}[e.kind]
+	}
+}
+
+// sigV4Request holds the signing parameters of a request, from its Authorization
+// header or, for presigned URLs, its query string.
+type sigV4Request struct {
+	accessKeyID   string
+	date          string // YYYYMMDD
+	region        string
+	service       string
+	signedHeaders []string
+	signature     string
+	amzDate       string
+	expires       time.Duration // presigned URLs only
+	presigned     bool
+}
+
+// anonymousActions lists the actions of each service that AWS accepts without a signature.
+var anonymousActions = map[string][]string{
+	"cognito-idp": {
+		"ChangePassword", "ConfirmDevice", "ConfirmForgotPassword", "ConfirmSignUp", "DeleteUser",
+		"ForgotPassword", "GetUser", "GlobalSignOut", "InitiateAuth", "ResendConfirmationCode",
+		"RespondToAuthChallenge", "RevokeToken", "SignUp", "UpdateUserAttributes", "VerifyUserAttribute",
+	},
+	"cognito-identity": {"GetCredentialsForIdentity", "GetId", "GetOpenIdToken", "UnlinkIdentity"},
+	// The registry API authenticates with the basic auth credentials of an ECR token.
+	"ecr": {
+		"GET /v2/{path...}", "HEAD /v2/{path...}", "POST /v2/{path...}", "PUT /v2/{path...}", "PATCH /v2/{path...}",
+	},
+	// Function URLs with AuthType NONE are public; the function URL handler rejects
+	// unsigned requests to AWS_IAM ones.
+	"lambda": {
+		"GET /lambda-url/{urlID}/{path...}", "HEAD /lambda-url/{urlID}/{path...}", "POST /lambda-url/{urlID}/{path...}",
+		"PUT /lambda-url/{urlID}/{path...}", "PATCH /lambda-url/{urlID}/{path...}", "DELETE /lambda-url/{urlID}/{path...}",
+		"OPTIONS /lambda-url/{urlID}/{path...}",
+	},
+	"sts": {"AssumeRoleWithSAML", "AssumeRoleWithWebIdentity"},
+}
+
+// authenticate verifies the signature of a request to a service when the server
+// is configured with credentials, writing an error in the protocol of the service
+// if it does not match. It reports whether the request may be handled.
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request, svc, action string, proto protocol, restXML bool) bool {
+	if s.config.AccessKeyID == "" || service.IsInternalRequest(r) || slices.Contains(anonymousActions[svc], action) {
+		return true
+	}
+
+	// Browsers send CORS preflight requests without credentials.
+	if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+		return true
+	}
+
+	err := verifySignature(r, s.config.AccessKeyID, s.config.SecretAccessKey, time.Now())
+	if err == nil {
+		return true
+	}
+
+	writeProtocolError(w, proto, restXML, err.code(proto, restXML), err.message, http.StatusForbidden)
+
+	return false
+}
+
+// cloneSignedRequest returns a copy of the request, with its body, for verifying its
+// signature after a protocol dispatcher has rewritten the request.
+func cloneSignedRequest(r *http.Request) (*http.Request, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	signed := r.Clone(r.Context())
+	signed.Body = io.NopCloser(bytes.NewReader(body))
+
+	return signed, nil
+}
+
+// verifySignature checks the SigV4 signature of a request against the given credentials.
+func verifySignature(r *http.Request, accessKeyID, secretAccessKey string, now time.Time) *authError {
+	sr, authErr := parseSigV4Request(r)
+	if authErr != nil {
+		return authErr
+	}
+
+	if sr.accessKeyID != accessKeyID {
+		return &authError{authUnknownAccessKey, "The security token included in the request is invalid."}
+	}
+
+	signingTime, err := time.Parse(sigV4TimeFormat, sr.amzDate)
+	if err != nil {
+		return &authError{authMissing, "Invalid X-Amz-Date " + sr.amzDate}
+	}
+
+	if date := signingTime.Format("20060102"); sr.date != date {
+		return &authError{authSignatureMismatch, fmt.Sprintf("Date in Credential scope does not match YYYYMMDD from ISO-8601 version of date from HTTP: '%s' != '%s', from '%s'.", sr.date, date, sr.amzDate)}
+	}
+
+	if sr.presigned && now.After(signingTime.Add(sr.expires)) {
+		return &authError{authExpired, "Request has expired"}
+	}
+
+	// Requests signed in their headers must be sent within 15 minutes of signing.
+	if !sr.presigned {
+		now = now.UTC()
+
+		switch {
+		case now.Sub(signingTime) > sigV4MaxSkew:
+			return &authError{authTimeSkewed, fmt.Sprintf("Signature expired: %s is now earlier than %s (%s - 15 min.)",
+				sr.amzDate, now.Add(-sigV4MaxSkew).Format(sigV4TimeFormat), now.Format(sigV4TimeFormat))}
+		case signingTime.Sub(now) > sigV4MaxSkew:
+			return &authError{authTimeSkewed, fmt.Sprintf("Signature not yet current: %s is still later than %s (%s + 15 min.)",
+				sr.amzDate, now.Add(sigV4MaxSkew).Format(sigV4TimeFormat), now.Format(sigV4TimeFormat))}
+		}
+	}
+
+	payloadHash, err := requestPayloadHash(r, sr)
+	if err != nil {
+		return &authError{authSignatureMismatch, "Failed to read the request payload"}
+	}
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		canonicalURI(r, sr.service),
+		canonicalQuery(r.URL.Query()),
+		canonicalHeaders(r, sr.signedHeaders),
+		strings.Join(sr.signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+
+	scope := sr.date + "/" + sr.region + "/" + sr.service + "/aws4_request"
+	stringToSign := strings.Join([]string{sigV4Algorithm, sr.amzDate, scope, hashHex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), sr.date)
+	for _, part := range []string{sr.region, sr.service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+
+	want := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	if !hmac.Equal([]byte(want), []byte(sr.signature)) {
+		return &authError{authSignatureMismatch, "The request signature we calculated does not match the signature you provided. Check your AWS Secret Access Key and signing method."}
+	}
+
+	return nil
+}
+
+// parseSigV4Request extracts the signing parameters of a request.
+func parseSigV4Request(r *http.Request) (*sigV4Request, *authError) {
+	query := r.URL.Query()
+
+	var (
+		sr         sigV4Request
+		credential string
+	)
+
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		algorithm, params, _ := strings.Cut(auth, " ")
+		if algorithm != sigV4Algorithm {
+			return nil, &authError{authMissing, "Unsupported authorization type " + algorithm}
+		}
+
+		for param := range strings.SplitSeq(params, ",") {
+			name, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+
+			switch name {
+			case "Credential":
+				credential = value
+			case "SignedHeaders":
+				sr.signedHeaders = strings.Split(value, ";")
+			case "Signature":
+				sr.signature = value
+			}
+		}
+
+		sr.amzDate = r.Header.Get("X-Amz-Date")
+	} else if query.Get("X-Amz-Algorithm") == sigV4Algorithm {
+		credential = query.Get("X-Amz-Credential")
+		sr.signedHeaders = strings.Split(query.Get("X-Amz-SignedHeaders"), ";")
+		sr.signature = query.Get("X-Amz-Signature")
+		sr.amzDate = query.Get("X-Amz-Date")
+		sr.presigned = true
+
+		expires, err := strconv.Atoi(query.Get("X-Amz-Expires"))
+		if err != nil {
+			return nil, &authError{authMissing, "X-Amz-Expires must be a number of seconds"}
+		}
+
+		sr.expires = time.Duration(expires) * time.Second
+	} else {
+		return nil, &authError{authMissing, "Request is missing Authentication Token"}
+	}
+
+	parts := strings.Split(credential, "/")
+	if len(parts) != 5 || parts[4] != "aws4_request" || sr.signature == "" || len(sr.signedHeaders) == 0 {
+		return nil, &authError{authMissing, "Authorization header requires Credential, SignedHeaders and Signature"}
+	}
+
+	sr.accessKeyID, sr.date, sr.region, sr.service = parts[0], parts[1], parts[2], parts[3]
+
+	return &sr, nil
+}
+
+// requestPayloadHash returns the hash of the payload that the request was signed with.
+func requestPayloadHash(r *http.Request, sr *sigV4Request) (string, error) {
+	if hash := r.Header.Get("X-Amz-Content-Sha256"); hash != "" {
+		return hash, nil
+	}
+
+	if sr.presigned && sr.service == "s3" {
+		return sigV4UnsignedPayload, nil
+	}
+
+	if r.Body == nil {
+		return hashHex(nil), nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	return hashHex(body), nil
+}
+
+// canonicalURI returns the URI-encoded path of the request. S3 signs the path as sent;
+// other services encode it a second time.
+func canonicalURI(r *http.Request, signingService string) string {
+	path := r.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	if signingService == "s3" {
+		return path
+	}
+
+	var b strings.Builder
+
+	for i := range len(path) {
+		c := path[i]
+		if c == '/' || isUnreserved(c) {
+			b.WriteByte(c)
+
+			continue
+		}
+
+		b.WriteString("%" + strings.ToUpper(hex.EncodeToString([]byte{c})))
+	}
+
+	return b.String()
+}
+
+// isUnreserved reports whether c is an unreserved URI character.
+func isUnreserved(c byte) bool {
+	return 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '_' || c == '.' || c == '~'
+}
+
+// canonicalQuery returns the sorted, URI-encoded query string without the signature.
+func canonicalQuery(query url.Values) string {
+	query.Del("X-Amz-Signature")
+
+	for _, values := range query {
+		slices.Sort(values)
+	}
+
+	return strings.ReplaceAll(query.Encode(), "+", "%20")
+}
+
+// canonicalHeaders returns the signed headers of the request, one "name:value" per line.
+func canonicalHeaders(r *http.Request, signedHeaders []string) string {
+	var b strings.Builder
+
+	for _, name := range signedHeaders {
+		var values []string
+
+		switch name {
+		case "host":
+			values = []string{r.Host}
+		case "content-length":
+			values = slices.Clone(r.Header.Values(name))
+			if len(values) == 0 {
+				values = []string{strconv.FormatInt(r.ContentLength, 10)}
+			}
+		default:
+			values = slices.Clone(r.Header.Values(name))
+		}
+
+		for i, v := range values {
+			values[i] = strings.Join(strings.Fields(v), " ")
+		}
+
+		b.WriteString(name + ":" + strings.Join(values, ",") + "\n")
+	}
+
+	return b.String()
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+
+	return h.Sum(nil)
+}
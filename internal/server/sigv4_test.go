@@ -0,0 +1,275 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+const (
+	testAccessKeyID     = "AKIAKUMOTEST"
+	testSecretAccessKey = "kumo-secret"
+)
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	signingTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	creds := aws.Credentials{AccessKeyID: testAccessKeyID, SecretAccessKey: testSecretAccessKey}
+
+	sign := func(t *testing.T, creds aws.Credentials, signingService string, r *http.Request, body string, opts ...func(*v4.SignerOptions)) {
+		t.Helper()
+
+		sum := sha256.Sum256([]byte(body))
+		if err := v4.NewSigner(opts...).SignHTTP(context.Background(), creds, r, hex.EncodeToString(sum[:]), signingService, "us-east-1", signingTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	presign := func(t *testing.T, r *http.Request) {
+		t.Helper()
+
+		q := r.URL.Query()
+		q.Set("X-Amz-Expires", "900")
+		r.URL.RawQuery = q.Encode()
+
+		signedURL, _, err := v4.NewSigner(func(o *v4.SignerOptions) { o.DisableURIPathEscaping = true }).
+			PresignHTTP(context.Background(), creds, r, "UNSIGNED-PAYLOAD", "s3", "us-east-1", signingTime)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r.URL, _ = r.URL.Parse(signedURL)
+	}
+
+	tests := []struct {
+		name    string
+		request func(t *testing.T) *http.Request
+		now     time.Time
+		wantErr bool
+		want    authErrorKind
+	}{
+		{
+			name: "json protocol",
+			request: func(t *testing.T) *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "http://localhost:4566/", strings.NewReader(`{"QueueName":"q"}`))
+				r.Header.Set("Content-Type", "application/x-amz-json-1.0")
+				r.Header.Set("X-Amz-Target", "AmazonSQS.CreateQueue")
+				sign(t, creds, "sqs", r, `{"QueueName":"q"}`)
+
+				return r
+			},
+		},
+		{
+			name: "rest path with escaped characters",
+			request: func(t *testing.T) *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "http://localhost:4566/2015-03-31/functions/my%3Afunction/configuration?Qualifier=v1", nil)
+				sign(t, creds, "lambda", r, "")
+
+				return r
+			},
+		},
+		{
+			name: "s3 object key",
+			request: func(t *testing.T) *http.Request {
+				r := httptest.NewRequest(http.MethodPut, "http://localhost:4566/bucket/dir/a%20b%2Bc.txt", strings.NewReader("data"))
+				sum := sha256.Sum256([]byte("data"))
+				r.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(sum[:]))
+				sign(t, creds, "s3", r, "data", func(o *v4.SignerOptions) { o.DisableURIPathEscaping = true })
+
+				return r
+			},
+		},
+		{
+			name: "presigned url",
+			request: func(t *testing.T) *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "http://localhost:4566/bucket/key.txt", nil)
+				presign(t, r)
+
+				return r
+			},
+		},
+		{
+			name: "expired presigned url",
+			request: func(t *testing.T) *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "http://localhost:4566/bucket/key.txt", nil)
+				presign(t, r)
+
+				return r
+			},
+			now:     signingTime.Add(time.Hour),
+			wantErr: true,
+			want:    authExpired,
+		},
+		{
+			name: "signed too long ago",
+			request: func(t *testing.T) *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "http://localhost:4566/", nil)
+				sign(t, creds, "s3", r, "")
+
+				return r
+			},
+			now:     signingTime.Add(16 * time.Minute),
+			wantErr: true,
+			want:    authTimeSkewed,
+		},
+		{
+			name: "signed in the future",
+			request: func(t *testing.T) *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "http://localhost:4566/", nil)
+				sign(t, creds, "s3", r, "")
+
+				return r
+			},
+			now:     signingTime.Add(-16 * time.Minute),
+			wantErr: true,
+			want:    authTimeSkewed,
+		},
+		{
+			name: "credential scope date differs from X-Amz-Date",
+			request: func(t *testing.T) *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "http://localhost:4566/", nil)
+				sign(t, creds, "s3", r, "")
+				r.Header.Set("Authorization", strings.Replace(r.Header.Get("Authorization"), "/20260102/", "/20260101/", 1))
+
+				return r
+			},
+			wantErr: true,
+			want:    authSignatureMismatch,
+		},
+		{
+			name: "missing authorization",
+			request: func(_ *testing.T) *http.Request {
+				return httptest.NewRequest(http.MethodGet, "http://localhost:4566/", nil)
+			},
+			wantErr: true,
+			want:    authMissing,
+		},
+		{
+			name: "unknown access key",
+			request: func(t *testing.T) *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "http://localhost:4566/", nil)
+				sign(t, aws.Credentials{AccessKeyID: "AKIAOTHER", SecretAccessKey: testSecretAccessKey}, "s3", r, "")
+
+				return r
+			},
+			wantErr: true,
+			want:    authUnknownAccessKey,
+		},
+		{
+			name: "wrong secret",
+			request: func(t *testing.T) *http.Request {
+				r := httptest.NewRequest(http.MethodGet, "http://localhost:4566/", nil)
+				sign(t, aws.Credentials{AccessKeyID: testAccessKeyID, SecretAccessKey: "wrong"}, "s3", r, "")
+
+				return r
+			},
+			wantErr: true,
+			want:    authSignatureMismatch,
+		},
+		{
+			name: "tampered body",
+			request: func(t *testing.T) *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "http://localhost:4566/", strings.NewReader(`{"QueueName":"other"}`))
+				sign(t, creds, "sqs", r, `{"QueueName":"q"}`)
+
+				return r
+			},
+			wantErr: true,
+			want:    authSignatureMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := tt.now
+			if now.IsZero() {
+				now = signingTime.Add(time.Minute)
+			}
+
+			err := verifySignature(tt.request(t), testAccessKeyID, testSecretAccessKey, now)
+
+			switch {
+			case !tt.wantErr && err != nil:
+				t.Errorf("expected a valid signature, got %d: %s", err.kind, err.message)
+			case tt.wantErr && err == nil:
+				t.Errorf("expected error kind %d, got a valid signature", tt.want)
+			case tt.wantErr && err.kind != tt.want:
+				t.Errorf("expected error kind %d, got %d: %s", tt.want, err.kind, err.message)
+			}
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	srv := New(Config{Services: []string{"fake"}, LogLevel: slog.LevelError, AccessKeyID: testAccessKeyID, SecretAccessKey: testSecretAccessKey})
+	srv.RegisterService(fakeService{})
+
+	tests := []struct {
+		name     string
+		creds    *aws.Credentials
+		internal bool
+		want     int
+	}{
+		{
+			name: "unsigned",
+			want: http.StatusForbidden,
+		},
+		{
+			name:  "signed",
+			creds: &aws.Credentials{AccessKeyID: testAccessKeyID, SecretAccessKey: testSecretAccessKey},
+			want:  http.StatusOK,
+		},
+		{
+			name:  "wrong secret",
+			creds: &aws.Credentials{AccessKeyID: testAccessKeyID, SecretAccessKey: "wrong"},
+			want:  http.StatusForbidden,
+		},
+		{
+			name:     "internal",
+			internal: true,
+			want:     http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodGet, "http://localhost:4566/fake/1", nil)
+
+			if tt.creds != nil {
+				sum := sha256.Sum256(nil)
+				if err := v4.NewSigner().SignHTTP(context.Background(), *tt.creds, r, hex.EncodeToString(sum[:]), "fake", "us-east-1", time.Now()); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if tt.internal {
+				service.MarkInternalRequest(r)
+			}
+
+			rec := httptest.NewRecorder()
+			srv.Handler().ServeHTTP(rec, r)
+
+			if rec.Code != tt.want {
+				t.Errorf("expected status %d, got %d: %s", tt.want, rec.Code, rec.Body)
+			}
+		})
+	}
+}
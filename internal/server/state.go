@@ -0,0 +1,290 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// stateErrorResponse is the body returned when saving or restoring state fails.
+type stateErrorResponse struct {
+	Message string `json:"message"`
+}
+
+// regionsKey is the key of the state document that maps each region created
+// under region isolation, other than the configured one, to the snapshots of
+// its services.
+const regionsKey = "regions"
+
+// getState handles GET /kumo/state, returning a JSON object that maps service names
+// to their snapshots. Use ?service=s3,dynamodb to only include some services.
+func (s *Server) getState(w http.ResponseWriter, r *http.Request) {
+	var names []string
+	if v := r.URL.Query().Get("service"); v != "" {
+		names = strings.Split(v, ",")
+	}
+
+	state, err := snapshotServices(s.registry, names)
+	if err != nil {
+		writeStateError(w, http.StatusInternalServerError, err.Error())
+
+		return
+	}
+
+	for _, name := range names {
+		if _, ok := state[name]; !ok {
+			writeStateError(w, http.StatusBadRequest, fmt.Sprintf("service %s does not support snapshots", name))
+
+			return
+		}
+	}
+
+	regions := make(map[string]map[string]json.RawMessage)
+
+	for region, rs := range s.createdRegions() {
+		snapshots, err := snapshotServices(rs.registry, names)
+		if err != nil {
+			writeStateError(w, http.StatusInternalServerError, fmt.Sprintf("%s: %v", region, err))
+
+			return
+		}
+
+		regions[region] = snapshots
+	}
+
+	if len(regions) > 0 {
+		data, err := json.Marshal(regions)
+		if err != nil {
+			writeStateError(w, http.StatusInternalServerError, fmt.Sprintf("failed to snapshot regions: %v", err))
+
+			return
+		}
+
+		state[regionsKey] = data
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(state)
+}
+
+// snapshotServices returns the snapshots of the services in a registry that
+// support them, keyed by service name. If names is not empty, only the named
+// services are included.
+func snapshotServices(registry *service.Registry, names []string) (map[string]json.RawMessage, error) {
+	state := make(map[string]json.RawMessage)
+
+	for _, svc := range registry.All() {
+		if len(names) > 0 && !slices.Contains(names, svc.Name()) {
+			continue
+		}
+
+		snapshotter, ok := svc.(service.Snapshotter)
+		if !ok {
+			continue
+		}
+
+		data, err := service.Snapshot(snapshotter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to snapshot %s: %w", svc.Name(), err)
+		}
+
+		state[svc.Name()] = data
+	}
+
+	return state, nil
+}
+
+// stateRestore is the restore of one service instance, planned before any is applied.
+type stateRestore struct {
+	// name is the service name, prefixed with the region for the instances created under region isolation.
+	name        string
+	snapshotter service.Snapshotter
+	// data is the snapshot to restore, or nil to reset an instance the state has no snapshot for.
+	data json.RawMessage
+}
+
+// restoreState handles POST /kumo/state, restoring each service in a body
+// previously returned by GET /kumo/state. Every snapshot is checked before any
+// service is restored, so an invalid body leaves the state unchanged. The
+// instances of the restored services in regions the body has no snapshot for
+// are reset.
+func (s *Server) restoreState(w http.ResponseWriter, r *http.Request) {
+	var state map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&state); err != nil {
+		writeStateError(w, http.StatusBadRequest, fmt.Sprintf("invalid state: %v", err))
+
+		return
+	}
+
+	var regions map[string]map[string]json.RawMessage
+
+	if data, ok := state[regionsKey]; ok {
+		if err := json.Unmarshal(data, &regions); err != nil {
+			writeStateError(w, http.StatusBadRequest, fmt.Sprintf("invalid regions: %v", err))
+
+			return
+		}
+
+		delete(state, regionsKey)
+	}
+
+	// The services with a snapshot in any region are restored in every region.
+	names := slices.Collect(maps.Keys(state))
+	for _, snapshots := range regions {
+		for name := range snapshots {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+
+	restores, err := planRestores(s.registry, "", state, names)
+	if err != nil {
+		writeStateError(w, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	for region := range regions {
+		if !s.config.RegionIsolation || region == s.config.Region || !regionPattern.MatchString(region) {
+			writeStateError(w, http.StatusBadRequest, fmt.Sprintf("unexpected region %s", region))
+
+			return
+		}
+	}
+
+	for region := range regions {
+		s.regionServices(region)
+	}
+
+	for region, rs := range s.createdRegions() {
+		regionRestores, err := planRestores(rs.registry, region, regions[region], names)
+		if err != nil {
+			writeStateError(w, http.StatusBadRequest, err.Error())
+
+			return
+		}
+
+		restores = append(restores, regionRestores...)
+	}
+
+	for _, restore := range restores {
+		if restore.data == nil {
+			continue
+		}
+
+		if err := service.CheckSnapshot(restore.snapshotter, restore.data); err != nil {
+			writeStateError(w, http.StatusBadRequest, fmt.Sprintf("failed to restore %s: %v", restore.name, err))
+
+			return
+		}
+	}
+
+	for _, restore := range restores {
+		if restore.data == nil {
+			err = restore.snapshotter.StateStorage().Reset(r.Context())
+		} else {
+			err = service.Restore(r.Context(), restore.snapshotter, restore.data)
+		}
+
+		if err != nil {
+			writeStateError(w, http.StatusInternalServerError, fmt.Sprintf("failed to restore %s: %v", restore.name, err))
+
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// planRestores returns the restores of the services in a registry for the
+// snapshots in state. The services named in reset that state has no snapshot
+// for are reset instead. region is the region of the registry, or empty for
+// the services of the configured region.
+func planRestores(registry *service.Registry, region string, state map[string]json.RawMessage, reset []string) ([]stateRestore, error) {
+	var restores []stateRestore
+
+	label := func(name string) string {
+		if region == "" {
+			return name
+		}
+
+		return region + "/" + name
+	}
+
+	for name, data := range state {
+		svc, ok := registry.Get(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown service %s", label(name))
+		}
+
+		snapshotter, ok := svc.(service.Snapshotter)
+		if !ok {
+			return nil, fmt.Errorf("service %s does not support snapshots", label(name))
+		}
+
+		restores = append(restores, stateRestore{name: label(name), snapshotter: snapshotter, data: data})
+	}
+
+	for _, name := range reset {
+		if _, ok := state[name]; ok {
+			continue
+		}
+
+		svc, ok := registry.Get(name)
+		if !ok {
+			continue
+		}
+
+		if snapshotter, ok := svc.(service.Snapshotter); ok {
+			restores = append(restores, stateRestore{name: label(name), snapshotter: snapshotter})
+		}
+	}
+
+	return restores, nil
+}
+
+// resetState handles POST /kumo/reset, clearing the state of every service.
+// Use ?service=s3,dynamodb to only reset some services.
+func (s *Server) resetState(w http.ResponseWriter, r *http.Request) {
+	services := s.registry.All()
+
+	if v := r.URL.Query().Get("service"); v != "" {
+		services = nil
+
+		for name := range strings.SplitSeq(v, ",") {
+			svc, ok := s.registry.Get(name)
+			if !ok {
+				writeStateError(w, http.StatusBadRequest, fmt.Sprintf("unknown service %s", name))
+
+				return
+			}
+
+			services = append(services, svc)
+		}
+	}
+
+	services = append(services, s.allRegionInstances(services)...)
+
+	for _, svc := range services {
+		if err := svc.Reset(r.Context()); err != nil {
+			writeStateError(w, http.StatusInternalServerError, fmt.Sprintf("failed to reset %s: %v", svc.Name(), err))
+
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeStateError writes a state endpoint error response.
+func writeStateError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(stateErrorResponse{Message: message})
+}
@@ -0,0 +1,250 @@
+package server
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// tlsRecordTypeHandshake is the first byte sent by a client starting a TLS handshake.
+	tlsRecordTypeHandshake = 0x16
+
+	// sniffTimeout bounds how long a new connection may take to send its first byte.
+	sniffTimeout = 10 * time.Second
+
+	// generatedCertValidity is how long the generated CA and server certificates are valid.
+	generatedCertValidity = 365 * 24 * time.Hour
+)
+
+// tlsConfig returns the TLS configuration for the server, loading the configured
+// certificate or generating a self-signed CA and a server certificate signed by it.
+func (s *Server) tlsConfig() (*tls.Config, error) {
+	if s.config.TLSCertFile != "" || s.config.TLSKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(s.config.TLSCertFile, s.config.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+		}
+
+		return &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}, nil
+	}
+
+	cert, caPEM, err := generateCertificate(s.tlsHostnames())
+	if err != nil {
+		return nil, err
+	}
+
+	s.caPEM = caPEM
+
+	return &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}, nil
+}
+
+// tlsHostnames returns the names and addresses the generated server certificate is valid for.
+func (s *Server) tlsHostnames() []string {
+	hosts := []string{"localhost", "*.localhost", "127.0.0.1", "::1"}
+
+	if h := s.config.Host; h != "" && h != "0.0.0.0" && h != "::" {
+		hosts = append(hosts, h)
+	}
+
+	if h, err := os.Hostname(); err == nil {
+		hosts = append(hosts, h)
+	}
+
+	return hosts
+}
+
+// generateCertificate creates a self-signed CA and a server certificate for hosts
+// signed by it. It returns the server certificate and the PEM-encoded CA certificate.
+func generateCertificate(hosts []string) (tls.Certificate, []byte, error) {
+	now := time.Now()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to generate CA key: %w", err)
+	}
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{Organization: []string{"kumo"}, CommonName: "kumo local CA"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(generatedCertValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to create CA certificate: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to generate server key: %w", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{Organization: []string{"kumo"}, CommonName: hosts[0]},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(generatedCertValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caTemplate, &key.PublicKey, caKey)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to create server certificate: %w", err)
+	}
+
+	cert := tls.Certificate{
+		Certificate: [][]byte{der, caDER},
+		PrivateKey:  key,
+	}
+
+	return cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}), nil
+}
+
+// getCACertificate handles GET /kumo/tls/ca.pem, returning the generated CA
+// certificate so clients can trust the server.
+func (s *Server) getCACertificate(w http.ResponseWriter, _ *http.Request) {
+	if s.caPEM == nil {
+		http.Error(w, "TLS is not enabled with a generated certificate", http.StatusNotFound)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-pem-file")
+	_, _ = w.Write(s.caPEM)
+}
+
+// sniffListener serves TLS and plaintext connections on the same port by
+// peeking at the first byte of each connection, so clients and services
+// calling back into kumo over plain HTTP keep working when TLS is enabled.
+type sniffListener struct {
+	net.Listener
+
+	config *tls.Config
+	conns  chan net.Conn
+	errs   chan error
+	done   chan struct{}
+	once   sync.Once
+}
+
+// newSniffListener wraps ln and starts accepting connections from it.
+func newSniffListener(ln net.Listener, config *tls.Config) *sniffListener {
+	l := &sniffListener{
+		Listener: ln,
+		config:   config,
+		conns:    make(chan net.Conn),
+		errs:     make(chan error),
+		done:     make(chan struct{}),
+	}
+
+	go l.acceptLoop()
+
+	return l
+}
+
+// acceptLoop accepts connections and sniffs each one in its own goroutine
+// so a slow client cannot block others.
+func (l *sniffListener) acceptLoop() {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			select {
+			case l.errs <- err:
+			case <-l.done:
+				return
+			}
+
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+
+			continue
+		}
+
+		go l.sniff(conn)
+	}
+}
+
+// sniff hands conn to Accept, wrapped in a TLS server connection if it starts with a TLS handshake.
+func (l *sniffListener) sniff(conn net.Conn) {
+	_ = conn.SetReadDeadline(time.Now().Add(sniffTimeout))
+
+	r := bufio.NewReader(conn)
+	first, err := r.Peek(1)
+
+	_ = conn.SetReadDeadline(time.Time{})
+
+	if err != nil {
+		_ = conn.Close()
+
+		return
+	}
+
+	var c net.Conn = &bufferedConn{Conn: conn, r: r}
+	if first[0] == tlsRecordTypeHandshake {
+		c = tls.Server(c, l.config)
+	}
+
+	select {
+	case l.conns <- c:
+	case <-l.done:
+		_ = conn.Close()
+	}
+}
+
+// Accept returns the next sniffed connection.
+func (l *sniffListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case err := <-l.errs:
+		return nil, err
+	case <-l.done:
+		return nil, net.ErrClosed
+	}
+}
+
+// Close stops accepting connections.
+func (l *sniffListener) Close() error {
+	l.once.Do(func() { close(l.done) })
+
+	return l.Listener.Close() //nolint:wrapcheck // http.Server compares against net.ErrClosed
+}
+
+// bufferedConn is a net.Conn whose reads first drain the bytes peeked while sniffing.
+type bufferedConn struct {
+	net.Conn
+
+	r *bufio.Reader
+}
+
+// Read reads from the sniffing buffer before the underlying connection.
+func (c *bufferedConn) Read(p []byte) (int, error) {
+	return c.r.Read(p) //nolint:wrapcheck // callers compare against io.EOF
+}
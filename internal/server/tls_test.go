@@ -0,0 +1,79 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+)
+
+func TestSniffListenerServesTLSAndPlaintext(t *testing.T) {
+	t.Parallel()
+
+	cert, caPEM, err := generateCertificate([]string{"localhost", "127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := newSniffListener(ln, &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12})
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.TLS != nil {
+				_, _ = io.WriteString(w, "https")
+
+				return
+			}
+
+			_, _ = io.WriteString(w, "http")
+		}),
+	}
+
+	go func() { _ = srv.Serve(l) }()
+
+	t.Cleanup(func() { _ = srv.Close() })
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		t.Fatal("failed to parse generated CA certificate")
+	}
+
+	httpsClient := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}}}
+
+	tests := []struct {
+		name   string
+		client *http.Client
+		url    string
+		want   string
+	}{
+		{name: "plaintext", client: http.DefaultClient, url: "http://" + ln.Addr().String(), want: "http"},
+		{name: "tls", client: httpsClient, url: "https://" + ln.Addr().String(), want: "https"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := tt.client.Get(tt.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(body) != tt.want {
+				t.Errorf("expected body %q, got %q", tt.want, body)
+			}
+		})
+	}
+}
@@ -165,7 +165,7 @@ func (s *Service) ListCertificates(w http.ResponseWriter, r *http.Request) {
 			NotBefore:               ToAWSTimestampPtr(cert.NotBefore),
 			NotAfter:                ToAWSTimestampPtr(cert.NotAfter),
 			RenewalEligibility:      cert.RenewalEligibility,
-			Exported:                cert.Type == "IMPORTED",
+			Exported:                cert.Type == certificateTypeImported,
 			InUse:                   len(cert.InUseBy) > 0,
 			KeyUsages:               keyUsages,
 			ExtendedKeyUsages:       extendedKeyUsages,
@@ -255,6 +255,35 @@ func (s *Service) ImportCertificate(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// IssueCertificate handles the kumo-specific endpoint that issues a certificate
+// pending validation without waiting for the validation delay.
+func (s *Service) IssueCertificate(w http.ResponseWriter, r *http.Request) {
+	var req IssueCertificateRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameter, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.CertificateArn == "" {
+		writeError(w, errInvalidParameter, "CertificateArn is required", http.StatusBadRequest)
+
+		return
+	}
+
+	cert, err := s.storage.IssueCertificate(r.Context(), req.CertificateArn)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, IssueCertificateResponse{
+		CertificateArn: cert.CertificateArn,
+		Status:         cert.Status,
+	})
+}
+
 // Helper functions.
 
 // readJSONRequest reads and decodes JSON request body.
@@ -0,0 +1,218 @@
+package acm
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// defaultValidationDelay is how long a requested certificate stays PENDING_VALIDATION before it is issued.
+const defaultValidationDelay = 2 * time.Second
+
+// certificateValidity is how long an issued certificate is valid, matching the 395 days used by ACM.
+const certificateValidity = 395 * 24 * time.Hour
+
+// WithValidationDelay sets how long a requested certificate stays PENDING_VALIDATION before it is issued.
+func WithValidationDelay(d time.Duration) Option {
+	return func(s *MemoryStorage) {
+		s.validationDelay = d
+	}
+}
+
+// validationScheduler periodically issues certificates whose validation delay has elapsed,
+// as if their DNS validation records had been found.
+func (s *MemoryStorage) validationScheduler() {
+	ticker := time.NewTicker(max(s.validationDelay/5, 10*time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopScheduler:
+			return
+		case now := <-ticker.C:
+			s.issuePendingCertificates(now)
+		}
+	}
+}
+
+// issuePendingCertificates issues the Amazon-issued certificates that have
+// been pending validation for at least the validation delay.
+func (s *MemoryStorage) issuePendingCertificates(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, cert := range s.Certificates {
+		if cert.Status != certificateStatusPendingValidation || cert.Type != certificateTypeAmazonIssued {
+			continue
+		}
+
+		if now.Sub(cert.CreatedAt) < s.validationDelay {
+			continue
+		}
+
+		// A certificate that cannot be signed stays pending and is retried on the next tick.
+		_ = issueCertificate(cert, now)
+	}
+}
+
+// issueCertificate marks the certificate and its domain validations as
+// successful and signs a certificate body for it.
+func issueCertificate(cert *Certificate, now time.Time) error {
+	notBefore := now
+	notAfter := now.Add(certificateValidity)
+
+	body, err := signCertificate(cert, notBefore, notAfter)
+	if err != nil {
+		return err
+	}
+
+	cert.Status = certificateStatusIssued
+	cert.IssuedAt = &now
+	cert.NotBefore = &notBefore
+	cert.NotAfter = &notAfter
+	cert.Issuer = "Amazon"
+	cert.SignatureAlgorithm = "SHA256WITHRSA"
+	cert.CertificateBody = body
+	cert.RenewalEligibility = "ELIGIBLE"
+
+	for i := range cert.DomainValidationOptions {
+		cert.DomainValidationOptions[i].ValidationStatus = "SUCCESS"
+	}
+
+	return nil
+}
+
+// signCertificate creates a self-signed PEM certificate covering the domains of cert.
+func signCertificate(cert *Certificate, notBefore, notAfter time.Time) (string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	serial, ok := new(big.Int).SetString(cert.Serial, 16)
+	if !ok {
+		serial = big.NewInt(1)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cert.DomainName},
+		Issuer:       pkix.Name{Organization: []string{"Amazon"}},
+		DNSNames:     append([]string{cert.DomainName}, cert.SubjectAlternativeNames...),
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", fmt.Errorf("failed to create certificate: %w", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
+
+// applyCertificateMetadata fills the domain, serial, validity and algorithm
+// fields of an imported certificate from its PEM body. Bodies that do not
+// decode to an X.509 certificate leave cert unchanged.
+func applyCertificateMetadata(cert *Certificate, body []byte) {
+	block, _ := pem.Decode(body)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return
+	}
+
+	parsed, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return
+	}
+
+	domainName := parsed.Subject.CommonName
+	if domainName == "" && len(parsed.DNSNames) > 0 {
+		domainName = parsed.DNSNames[0]
+	}
+
+	if domainName != "" {
+		cert.DomainName = domainName
+	}
+
+	sans := make([]string, 0, len(parsed.DNSNames))
+
+	for _, name := range parsed.DNSNames {
+		if name != cert.DomainName {
+			sans = append(sans, name)
+		}
+	}
+
+	notBefore := parsed.NotBefore
+	notAfter := parsed.NotAfter
+
+	cert.SubjectAlternativeNames = sans
+	cert.Serial = hex.EncodeToString(parsed.SerialNumber.Bytes())
+	cert.Subject = parsed.Subject.String()
+	cert.Issuer = certificateIssuer(parsed)
+	cert.NotBefore = &notBefore
+	cert.NotAfter = &notAfter
+	cert.SignatureAlgorithm = signatureAlgorithmName(parsed.SignatureAlgorithm)
+
+	if keyAlgorithm := keyAlgorithmName(parsed.PublicKey); keyAlgorithm != "" {
+		cert.KeyAlgorithm = keyAlgorithm
+	}
+}
+
+// certificateIssuer returns the display name of the certificate issuer.
+func certificateIssuer(cert *x509.Certificate) string {
+	if len(cert.Issuer.Organization) > 0 {
+		return cert.Issuer.Organization[0]
+	}
+
+	return cert.Issuer.CommonName
+}
+
+// keyAlgorithmName returns the ACM key algorithm name of a public key.
+func keyAlgorithmName(key any) string {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA_%d", k.N.BitLen())
+	case *ecdsa.PublicKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return "EC_prime256v1"
+		case elliptic.P384():
+			return "EC_secp384r1"
+		case elliptic.P521():
+			return "EC_secp521r1"
+		}
+	}
+
+	return ""
+}
+
+// signatureAlgorithmName returns the ACM signature algorithm name, such as SHA256WITHRSA.
+func signatureAlgorithmName(alg x509.SignatureAlgorithm) string {
+	switch alg {
+	case x509.SHA256WithRSA:
+		return "SHA256WITHRSA"
+	case x509.SHA384WithRSA:
+		return "SHA384WITHRSA"
+	case x509.SHA512WithRSA:
+		return "SHA512WITHRSA"
+	case x509.ECDSAWithSHA256:
+		return "SHA256WITHECDSA"
+	case x509.ECDSAWithSHA384:
+		return "SHA384WITHECDSA"
+	case x509.ECDSAWithSHA512:
+		return "SHA512WITHECDSA"
+	default:
+		return strings.ToUpper(alg.String())
+	}
+}
@@ -1,9 +1,11 @@
 package acm
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -12,12 +14,18 @@ import (
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"acm"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
+
+		if delay, err := time.ParseDuration(os.Getenv("KUMO_ACM_VALIDATION_DELAY")); err == nil {
+			opts = append(opts, WithValidationDelay(delay))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
 
 // Service implements the ACM service.
@@ -39,9 +47,10 @@ func (s *Service) Name() string {
 
 // RegisterRoutes registers the ACM routes.
 // Note: ACM uses AWS JSON 1.1 protocol via the JSONProtocolService interface,
-// so no direct routes are registered here.
-func (s *Service) RegisterRoutes(_ service.Router) {
-	// No routes to register - ACM uses JSON protocol dispatcher
+// so only the kumo-specific endpoints are registered here.
+func (s *Service) RegisterRoutes(r service.Router) {
+	// kumo-specific endpoint for testing.
+	r.HandleFunc("POST", "/kumo/acm/issue-certificate", s.IssueCertificate)
 }
 
 // TargetPrefix returns the X-Amz-Target header prefix for ACM.
@@ -62,3 +71,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
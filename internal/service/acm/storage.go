@@ -3,10 +3,13 @@ package acm
 import (
 	"context"
 	"crypto/rand"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -19,8 +22,20 @@ import (
 const (
 	errNotFound         = "ResourceNotFoundException"
 	errInvalidParameter = "ValidationException"
+	errInvalidState     = "InvalidStateException"
 )
 
+// Certificate statuses and types.
+const (
+	certificateStatusPendingValidation = "PENDING_VALIDATION"
+	certificateStatusIssued            = "ISSUED"
+	certificateTypeAmazonIssued        = "AMAZON_ISSUED"
+	certificateTypeImported            = "IMPORTED"
+)
+
+// defaultListMaxItems is the page size used by ListCertificates when MaxItems is not set.
+const defaultListMaxItems = 100
+
 // Storage defines the interface for ACM storage operations.
 type Storage interface {
 	RequestCertificate(ctx context.Context, req *RequestCertificateInput) (*Certificate, error)
@@ -29,6 +44,8 @@ type Storage interface {
 	DeleteCertificate(ctx context.Context, arn string) error
 	GetCertificate(ctx context.Context, arn string) (*Certificate, error)
 	ImportCertificate(ctx context.Context, req *ImportCertificateInput) (*Certificate, error)
+	IssueCertificate(ctx context.Context, arn string) (*Certificate, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -49,15 +66,19 @@ var (
 
 // MemoryStorage implements Storage with in-memory data structures.
 type MemoryStorage struct {
-	mu           sync.RWMutex            `json:"-"`
-	Certificates map[string]*Certificate `json:"certificates"`
-	dataDir      string
+	mu              sync.RWMutex            `json:"-"`
+	Certificates    map[string]*Certificate `json:"certificates"`
+	dataDir         string
+	validationDelay time.Duration
+	stopScheduler   chan struct{}
 }
 
 // NewMemoryStorage creates a new in-memory storage.
 func NewMemoryStorage(opts ...Option) *MemoryStorage {
 	s := &MemoryStorage{
-		Certificates: make(map[string]*Certificate),
+		Certificates:    make(map[string]*Certificate),
+		validationDelay: defaultValidationDelay,
+		stopScheduler:   make(chan struct{}),
 	}
 	for _, o := range opts {
 		o(s)
@@ -67,6 +88,8 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		_ = storage.Load(s.dataDir, "acm", s)
 	}
 
+	go s.validationScheduler()
+
 	return s
 }
 
@@ -107,6 +130,8 @@ func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
 
 // Close saves the storage state to disk if persistence is enabled.
 func (s *MemoryStorage) Close() error {
+	close(s.stopScheduler)
+
 	if s.dataDir == "" {
 		return nil
 	}
@@ -118,6 +143,16 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Certificates = make(map[string]*Certificate)
+
+	return nil
+}
+
 // buildDomainValidations creates domain validation options for the certificate.
 func buildDomainValidations(req *RequestCertificateInput, certID string) []DomainValidation {
 	domains := make([]string, 0, 1+len(req.SubjectAlternativeNames))
@@ -135,7 +170,7 @@ func buildDomainValidations(req *RequestCertificateInput, certID string) []Domai
 		dv := DomainValidation{
 			DomainName:       domain,
 			ValidationDomain: domain,
-			ValidationStatus: "PENDING_VALIDATION",
+			ValidationStatus: certificateStatusPendingValidation,
 			ValidationMethod: validationMethod,
 		}
 
@@ -183,8 +218,8 @@ func (s *MemoryStorage) RequestCertificate(_ context.Context, req *RequestCertif
 		CertificateArn:          arn,
 		DomainName:              req.DomainName,
 		SubjectAlternativeNames: req.SubjectAlternativeNames,
-		Status:                  "PENDING_VALIDATION",
-		Type:                    "AMAZON_ISSUED",
+		Status:                  certificateStatusPendingValidation,
+		Type:                    certificateTypeAmazonIssued,
 		KeyAlgorithm:            keyAlgorithm,
 		Serial:                  hex.EncodeToString(serialBytes),
 		Subject:                 fmt.Sprintf("CN=%s", req.DomainName),
@@ -216,13 +251,13 @@ func (s *MemoryStorage) DescribeCertificate(_ context.Context, arn string) (*Cer
 	return cert, nil
 }
 
-// ListCertificates lists certificates with optional filtering.
-func (s *MemoryStorage) ListCertificates(_ context.Context, statuses []string, maxItems int32, _ string) ([]*Certificate, string, error) {
+// ListCertificates lists certificates with optional filtering, oldest first.
+func (s *MemoryStorage) ListCertificates(_ context.Context, statuses []string, maxItems int32, nextToken string) ([]*Certificate, string, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	if maxItems <= 0 {
-		maxItems = 100
+		maxItems = defaultListMaxItems
 	}
 
 	certs := make([]*Certificate, 0, len(s.Certificates))
@@ -236,12 +271,17 @@ func (s *MemoryStorage) ListCertificates(_ context.Context, statuses []string, m
 		certs = append(certs, cert)
 	}
 
-	// Apply pagination.
-	if len(certs) > int(maxItems) {
-		certs = certs[:maxItems]
-	}
+	slices.SortFunc(certs, func(a, b *Certificate) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.CertificateArn, b.CertificateArn)
+	})
 
-	return certs, "", nil
+	page, next := paginate(certs, nextToken, maxItems)
+
+	return page, next, nil
 }
 
 // DeleteCertificate deletes a certificate.
@@ -275,7 +315,7 @@ func (s *MemoryStorage) GetCertificate(_ context.Context, arn string) (*Certific
 	}
 
 	// Only issued or imported certificates can be retrieved.
-	if cert.Status != "ISSUED" && cert.Type != "IMPORTED" {
+	if cert.Status != certificateStatusIssued && cert.Type != certificateTypeImported {
 		return nil, &Error{
 			Code:    errNotFound,
 			Message: "Certificate is not issued yet",
@@ -305,10 +345,28 @@ func (s *MemoryStorage) ImportCertificate(_ context.Context, req *ImportCertific
 	}
 
 	arn := req.CertificateArn
+	createdAt := time.Now()
+	tags := req.Tags
+
 	if arn == "" {
 		// Generate new ARN.
 		certID := uuid.New().String()
 		arn = fmt.Sprintf("arn:aws:acm:us-east-1:000000000000:certificate/%s", certID)
+	} else {
+		// Reimporting replaces the certificate material of an existing ARN.
+		existing, exists := s.Certificates[arn]
+		if !exists {
+			return nil, &Error{
+				Code:    errNotFound,
+				Message: fmt.Sprintf("Certificate with arn %s not found", arn),
+			}
+		}
+
+		createdAt = existing.CreatedAt
+
+		if len(tags) == 0 {
+			tags = existing.Tags
+		}
 	}
 
 	// Generate serial number.
@@ -320,18 +378,18 @@ func (s *MemoryStorage) ImportCertificate(_ context.Context, req *ImportCertific
 	serial := hex.EncodeToString(serialBytes)
 	now := time.Now()
 
-	// Parse certificate to extract domain (simplified - just use a placeholder).
+	// Certificates that are not valid PEM keep placeholder metadata.
 	domainName := "imported.example.com"
 
 	cert := &Certificate{
 		CertificateArn:     arn,
 		DomainName:         domainName,
-		Status:             "ISSUED",
-		Type:               "IMPORTED",
+		Status:             certificateStatusIssued,
+		Type:               certificateTypeImported,
 		KeyAlgorithm:       "RSA_2048",
 		Serial:             serial,
 		Subject:            fmt.Sprintf("CN=%s", domainName),
-		CreatedAt:          now,
+		CreatedAt:          createdAt,
 		ImportedAt:         &now,
 		IssuedAt:           &now,
 		CertificateBody:    string(req.Certificate),
@@ -339,10 +397,63 @@ func (s *MemoryStorage) ImportCertificate(_ context.Context, req *ImportCertific
 		PrivateKey:         string(req.PrivateKey),
 		RenewalEligibility: "INELIGIBLE",
 		InUseBy:            []string{},
-		Tags:               req.Tags,
+		Tags:               tags,
 	}
 
+	applyCertificateMetadata(cert, req.Certificate)
+
 	s.Certificates[arn] = cert
 
 	return cert, nil
 }
+
+// IssueCertificate issues a certificate that is pending validation without
+// waiting for the validation delay.
+func (s *MemoryStorage) IssueCertificate(_ context.Context, arn string) (*Certificate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cert, exists := s.Certificates[arn]
+	if !exists {
+		return nil, &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("Certificate with arn %s not found", arn),
+		}
+	}
+
+	if cert.Status != certificateStatusPendingValidation {
+		return nil, &Error{
+			Code:    errInvalidState,
+			Message: fmt.Sprintf("Certificate %s is %s, not PENDING_VALIDATION", arn, cert.Status),
+		}
+	}
+
+	if err := issueCertificate(cert, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+// paginate returns the page of items starting at the offset encoded in nextToken
+// and the token for the following page, if any.
+func paginate[T any](items []T, nextToken string, maxItems int32) ([]T, string) {
+	start := 0
+
+	if nextToken != "" {
+		if decoded, err := base64.StdEncoding.DecodeString(nextToken); err == nil {
+			if idx, err := strconv.Atoi(string(decoded)); err == nil && idx >= 0 && idx <= len(items) {
+				start = idx
+			}
+		}
+	}
+
+	end := min(start+int(maxItems), len(items))
+
+	var next string
+	if end < len(items) {
+		next = base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(end)))
+	}
+
+	return items[start:end], next
+}
@@ -240,6 +240,17 @@ type ImportCertificateOutput struct {
 	CertificateArn string `json:"CertificateArn,omitempty"`
 }
 
+// IssueCertificateRequest is the request for the kumo-specific certificate issuing endpoint.
+type IssueCertificateRequest struct {
+	CertificateArn string `json:"CertificateArn"`
+}
+
+// IssueCertificateResponse is the response for the kumo-specific certificate issuing endpoint.
+type IssueCertificateResponse struct {
+	CertificateArn string `json:"CertificateArn"`
+	Status         string `json:"Status"`
+}
+
 // ErrorResponse represents an ACM error response.
 type ErrorResponse struct {
 	Type    string `json:"__type"`
@@ -1,9 +1,9 @@
 package amplify
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"os"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -12,13 +12,16 @@ import (
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"amplify"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
+
+		storage := NewMemoryStorage(opts...)
 
-	storage := NewMemoryStorage(opts...)
-	service.Register(New(storage))
+		return []service.Service{New(storage)}
+	})
 }
 
 // Service implements the Amplify service.
@@ -69,3 +72,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
@@ -43,6 +43,7 @@ type Storage interface {
 	GetBranch(ctx context.Context, appID, branchName string) (*Branch, error)
 	ListBranches(ctx context.Context, appID string) ([]Branch, error)
 	DeleteBranch(ctx context.Context, appID, branchName string) (*Branch, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -138,6 +139,17 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Apps = make(map[string]*App)
+	m.Branches = make(map[string]map[string]*Branch)
+
+	return nil
+}
+
 // CreateApp creates a new Amplify app.
 func (m *MemoryStorage) CreateApp(_ context.Context, input *CreateAppInput) (*App, error) {
 	m.mu.Lock()
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -130,7 +131,7 @@ func (s *Service) GetResources(w http.ResponseWriter, r *http.Request) {
 	parts := strings.Split(path, "/")
 	restAPIID := parts[0]
 
-	resources, nextPosition, err := s.storage.GetResources(r.Context(), restAPIID, 25, "")
+	resources, nextPosition, err := s.storage.GetResources(r.Context(), restAPIID, parseLimit(r), r.URL.Query().Get("position"))
 	if err != nil {
 		handleError(w, err)
 
@@ -456,11 +457,33 @@ func toIntegrationOutput(i *Integration) *IntegrationOutput {
 
 // toDeploymentResponse converts a Deployment to DeploymentResponse.
 func toDeploymentResponse(d *Deployment) *DeploymentResponse {
-	return &DeploymentResponse{
+	resp := &DeploymentResponse{
 		ID:          d.ID,
 		Description: d.Description,
 		CreatedDate: float64(d.CreatedDate.Unix()),
 	}
+
+	for _, r := range d.Resources {
+		if len(r.ResourceMethods) == 0 {
+			continue
+		}
+
+		if resp.APISummary == nil {
+			resp.APISummary = make(map[string]map[string]MethodSnapshot)
+		}
+
+		methods := make(map[string]MethodSnapshot, len(r.ResourceMethods))
+		for httpMethod, m := range r.ResourceMethods {
+			methods[httpMethod] = MethodSnapshot{
+				AuthorizationType: m.AuthorizationType,
+				APIKeyRequired:    m.APIKeyRequired,
+			}
+		}
+
+		resp.APISummary[r.Path] = methods
+	}
+
+	return resp
 }
 
 // toStageResponse converts a Stage to StageResponse.
@@ -586,6 +609,16 @@ func extractRestAPIAndStageName(path string) (restAPIID, stageName string) {
 	return "", ""
 }
 
+// parseLimit returns the limit query parameter, or zero when it is absent or invalid.
+func parseLimit(r *http.Request) int32 {
+	limit, err := strconv.ParseInt(r.URL.Query().Get("limit"), 10, 32)
+	if err != nil {
+		return 0
+	}
+
+	return int32(limit)
+}
+
 // writeResponse writes a JSON response.
 func writeResponse(w http.ResponseWriter, resp any, status int) {
 	w.Header().Set("Content-Type", "application/json")
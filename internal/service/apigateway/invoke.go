@@ -0,0 +1,477 @@
+package apigateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// invokeTimeout bounds a Lambda proxy invocation when the integration sets no timeout.
+const invokeTimeout = 29 * time.Second
+
+// invokeMethods lists the HTTP methods a deployed API can be invoked with.
+var invokeMethods = []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+
+// ProxyEvent is the event sent to a Lambda function by an AWS_PROXY integration.
+type ProxyEvent struct {
+	Resource                        string              `json:"resource"`
+	Path                            string              `json:"path"`
+	HTTPMethod                      string              `json:"httpMethod"`
+	Headers                         map[string]string   `json:"headers"`
+	MultiValueHeaders               map[string][]string `json:"multiValueHeaders"`
+	QueryStringParameters           map[string]string   `json:"queryStringParameters"`
+	MultiValueQueryStringParameters map[string][]string `json:"multiValueQueryStringParameters"`
+	PathParameters                  map[string]string   `json:"pathParameters"`
+	StageVariables                  map[string]string   `json:"stageVariables"`
+	RequestContext                  ProxyRequestContext `json:"requestContext"`
+	Body                            *string             `json:"body"`
+	IsBase64Encoded                 bool                `json:"isBase64Encoded"`
+}
+
+// ProxyRequestContext describes the request in a ProxyEvent.
+type ProxyRequestContext struct {
+	AccountID        string        `json:"accountId"`
+	APIID            string        `json:"apiId"`
+	ResourceID       string        `json:"resourceId"`
+	ResourcePath     string        `json:"resourcePath"`
+	HTTPMethod       string        `json:"httpMethod"`
+	Path             string        `json:"path"`
+	Stage            string        `json:"stage"`
+	RequestID        string        `json:"requestId"`
+	RequestTime      string        `json:"requestTime"`
+	RequestTimeEpoch int64         `json:"requestTimeEpoch"`
+	Protocol         string        `json:"protocol"`
+	Identity         ProxyIdentity `json:"identity"`
+}
+
+// ProxyIdentity describes the caller in a ProxyRequestContext.
+type ProxyIdentity struct {
+	SourceIP  string `json:"sourceIp"`
+	UserAgent string `json:"userAgent"`
+}
+
+// ProxyResponse is the response a Lambda function returns to an AWS_PROXY integration.
+type ProxyResponse struct {
+	StatusCode        int                 `json:"statusCode"`
+	Headers           map[string]string   `json:"headers"`
+	MultiValueHeaders map[string][]string `json:"multiValueHeaders"`
+	Body              string              `json:"body"`
+	IsBase64Encoded   bool                `json:"isBase64Encoded"`
+}
+
+// InvokeAPI handles requests to a deployed stage, routing them to the
+// integration of the matching resource method.
+func (s *Service) InvokeAPI(w http.ResponseWriter, r *http.Request) {
+	restAPIID := r.PathValue("restApiId")
+	stageName := r.PathValue("stageName")
+	requestPath := "/" + r.PathValue("path")
+
+	deployment, err := s.storage.GetStageDeployment(r.Context(), restAPIID, stageName)
+	if err != nil {
+		writeInvokeError(w, http.StatusForbidden, "Missing Authentication Token")
+
+		return
+	}
+
+	resource, pathParams := matchResource(deployment.Resources, requestPath)
+	if resource == nil {
+		writeInvokeError(w, http.StatusForbidden, "Missing Authentication Token")
+
+		return
+	}
+
+	method, ok := resource.ResourceMethods[r.Method]
+	if !ok {
+		method, ok = resource.ResourceMethods["ANY"]
+	}
+
+	if !ok {
+		writeInvokeError(w, http.StatusForbidden, "Missing Authentication Token")
+
+		return
+	}
+
+	if method.MethodIntegration == nil {
+		writeInvokeError(w, http.StatusInternalServerError, "Internal server error")
+
+		return
+	}
+
+	switch method.MethodIntegration.Type {
+	case "AWS_PROXY":
+		s.invokeLambdaProxy(w, r, &invocation{
+			restAPIID:   restAPIID,
+			stageName:   stageName,
+			path:        requestPath,
+			resource:    resource,
+			pathParams:  pathParams,
+			integration: method.MethodIntegration,
+		})
+	case "MOCK":
+		w.WriteHeader(http.StatusOK)
+	default:
+		writeInvokeError(w, http.StatusInternalServerError,
+			fmt.Sprintf("Integration type %s is not supported", method.MethodIntegration.Type))
+	}
+}
+
+// invocation holds the matched route of a request to a deployed API.
+type invocation struct {
+	restAPIID   string
+	stageName   string
+	path        string
+	resource    *Resource
+	pathParams  map[string]string
+	integration *Integration
+}
+
+// invokeLambdaProxy sends the proxy event to the Lambda function of the integration
+// and translates the function's response into the HTTP response.
+func (s *Service) invokeLambdaProxy(w http.ResponseWriter, r *http.Request, inv *invocation) {
+	functionName, qualifier := lambdaFunctionFromURI(inv.integration.URI)
+	if functionName == "" {
+		writeInvokeError(w, http.StatusInternalServerError, "Internal server error")
+
+		return
+	}
+
+	event, err := buildProxyEvent(r, inv)
+	if err != nil {
+		writeInvokeError(w, http.StatusBadRequest, "Failed to read request body")
+
+		return
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		writeInvokeError(w, http.StatusInternalServerError, "Internal server error")
+
+		return
+	}
+
+	timeout := invokeTimeout
+	if inv.integration.TimeoutInMillis > 0 {
+		timeout = time.Duration(inv.integration.TimeoutInMillis) * time.Millisecond
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+
+	result, err := s.invokeFunction(ctx, functionName, qualifier, payload)
+	if err != nil {
+		writeInvokeError(w, http.StatusBadGateway, "Internal server error")
+
+		return
+	}
+
+	writeProxyResponse(w, result)
+}
+
+// invokeFunction calls the Lambda Invoke API of kumo and decodes the proxy response.
+func (s *Service) invokeFunction(ctx context.Context, functionName, qualifier string, payload []byte) (*ProxyResponse, error) {
+	invokeURL := fmt.Sprintf("%s/lambda/2015-03-31/functions/%s/invocations", s.baseURL, url.PathEscape(functionName))
+	if qualifier != "" {
+		invokeURL += "?Qualifier=" + url.QueryEscape(qualifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, invokeURL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invoke request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	service.MarkInternalRequest(req)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke function %s: %w", functionName, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read invoke response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("X-Amz-Function-Error") != "" {
+		return nil, fmt.Errorf("function %s failed with status %d: %s", functionName, resp.StatusCode, body)
+	}
+
+	var result ProxyResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("malformed proxy response from function %s: %w", functionName, err)
+	}
+
+	if result.StatusCode == 0 {
+		return nil, fmt.Errorf("malformed proxy response from function %s: missing statusCode", functionName)
+	}
+
+	return &result, nil
+}
+
+// buildProxyEvent builds the Lambda proxy event for a request.
+func buildProxyEvent(r *http.Request, inv *invocation) (*ProxyEvent, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	now := time.Now().UTC()
+
+	event := &ProxyEvent{
+		Resource:   inv.resource.Path,
+		Path:       inv.path,
+		HTTPMethod: r.Method,
+		RequestContext: ProxyRequestContext{
+			AccountID:        "000000000000",
+			APIID:            inv.restAPIID,
+			ResourceID:       inv.resource.ID,
+			ResourcePath:     inv.resource.Path,
+			HTTPMethod:       r.Method,
+			Path:             "/" + inv.stageName + inv.path,
+			Stage:            inv.stageName,
+			RequestID:        uuid.New().String(),
+			RequestTime:      now.Format("02/Jan/2006:15:04:05 -0700"),
+			RequestTimeEpoch: now.UnixMilli(),
+			Protocol:         r.Proto,
+			Identity: ProxyIdentity{
+				SourceIP:  sourceIP(r),
+				UserAgent: r.UserAgent(),
+			},
+		},
+	}
+
+	if len(r.Header) > 0 {
+		event.Headers = make(map[string]string, len(r.Header))
+		event.MultiValueHeaders = make(map[string][]string, len(r.Header))
+
+		for name, values := range r.Header {
+			event.Headers[name] = values[len(values)-1]
+			event.MultiValueHeaders[name] = values
+		}
+	}
+
+	if query := r.URL.Query(); len(query) > 0 {
+		event.QueryStringParameters = make(map[string]string, len(query))
+		event.MultiValueQueryStringParameters = make(map[string][]string, len(query))
+
+		for name, values := range query {
+			event.QueryStringParameters[name] = values[len(values)-1]
+			event.MultiValueQueryStringParameters[name] = values
+		}
+	}
+
+	if len(inv.pathParams) > 0 {
+		event.PathParameters = inv.pathParams
+	}
+
+	if len(body) > 0 {
+		encoded := string(body)
+		if !utf8.Valid(body) {
+			encoded = base64.StdEncoding.EncodeToString(body)
+			event.IsBase64Encoded = true
+		}
+
+		event.Body = &encoded
+	}
+
+	return event, nil
+}
+
+// writeProxyResponse writes the response returned by a Lambda proxy integration.
+func writeProxyResponse(w http.ResponseWriter, result *ProxyResponse) {
+	body := []byte(result.Body)
+
+	if result.IsBase64Encoded {
+		decoded, err := base64.StdEncoding.DecodeString(result.Body)
+		if err != nil {
+			writeInvokeError(w, http.StatusBadGateway, "Internal server error")
+
+			return
+		}
+
+		body = decoded
+	}
+
+	for name, values := range result.MultiValueHeaders {
+		for _, v := range values {
+			w.Header().Add(name, v)
+		}
+	}
+
+	for name, v := range result.Headers {
+		w.Header().Set(name, v)
+	}
+
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+
+	w.WriteHeader(result.StatusCode)
+	_, _ = w.Write(body)
+}
+
+// matchResource finds the deployed resource whose path matches the request path,
+// returning it with the values of its path parameters. Literal path parts take
+// precedence over {param} parts, which take precedence over greedy {param+} parts.
+func matchResource(resources map[string]*Resource, requestPath string) (*Resource, map[string]string) {
+	children := make(map[string][]*Resource)
+
+	var root *Resource
+
+	for _, r := range resources {
+		if r.Path == "/" {
+			root = r
+
+			continue
+		}
+
+		children[r.ParentID] = append(children[r.ParentID], r)
+	}
+
+	if root == nil {
+		return nil, nil
+	}
+
+	var segments []string
+	if trimmed := strings.Trim(requestPath, "/"); trimmed != "" {
+		segments = strings.Split(trimmed, "/")
+	}
+
+	params := make(map[string]string)
+
+	matched := matchSegments(children, root, segments, params)
+	if matched == nil {
+		return nil, nil
+	}
+
+	return matched, params
+}
+
+// matchSegments matches the remaining path segments against the children of parent.
+func matchSegments(children map[string][]*Resource, parent *Resource, segments []string, params map[string]string) *Resource {
+	if len(segments) == 0 {
+		return parent
+	}
+
+	segment := segments[0]
+
+	// Literal matches first.
+	for _, child := range children[parent.ID] {
+		if child.PathPart == segment {
+			if matched := matchSegments(children, child, segments[1:], params); matched != nil {
+				return matched
+			}
+		}
+	}
+
+	// Single segment parameters next.
+	for _, child := range children[parent.ID] {
+		name, greedy, ok := pathParameter(child.PathPart)
+		if !ok || greedy {
+			continue
+		}
+
+		if matched := matchSegments(children, child, segments[1:], params); matched != nil {
+			params[name], _ = url.PathUnescape(segment)
+
+			return matched
+		}
+	}
+
+	// Greedy parameters consume the rest of the path.
+	for _, child := range children[parent.ID] {
+		if name, greedy, ok := pathParameter(child.PathPart); ok && greedy {
+			params[name] = strings.Join(segments, "/")
+
+			return child
+		}
+	}
+
+	return nil
+}
+
+// pathParameter parses a {name} or {name+} path part.
+func pathParameter(pathPart string) (string, bool, bool) {
+	if !strings.HasPrefix(pathPart, "{") || !strings.HasSuffix(pathPart, "}") {
+		return "", false, false
+	}
+
+	name := pathPart[1 : len(pathPart)-1]
+	if greedy := strings.HasSuffix(name, "+"); greedy {
+		return strings.TrimSuffix(name, "+"), true, true
+	}
+
+	return name, false, true
+}
+
+// lambdaFunctionFromURI extracts the function name and qualifier from an integration URI
+// such as arn:aws:apigateway:us-east-1:lambda:path/2015-03-31/functions/<function ARN>/invocations.
+func lambdaFunctionFromURI(uri string) (string, string) {
+	_, rest, ok := strings.Cut(uri, "/functions/")
+	if !ok {
+		return "", ""
+	}
+
+	functionARN := strings.TrimSuffix(rest, "/invocations")
+
+	// arn:aws:lambda:<region>:<account>:function:<name>[:<qualifier>]
+	parts := strings.Split(functionARN, ":")
+	if len(parts) < 7 || parts[5] != "function" {
+		return functionARN, ""
+	}
+
+	if len(parts) > 7 {
+		return parts[6], parts[7]
+	}
+
+	return parts[6], ""
+}
+
+// sourceIP returns the client IP address of a request.
+func sourceIP(r *http.Request) string {
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		ip, _, _ := strings.Cut(forwarded, ",")
+
+		return strings.TrimSpace(ip)
+	}
+
+	host, _, ok := strings.Cut(r.RemoteAddr, ":")
+	if !ok {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// writeInvokeError writes an error response for a request to a deployed API.
+func writeInvokeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-amzn-ErrorType", errorTypeForStatus(status))
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"message": message})
+}
+
+// errorTypeForStatus returns the x-amzn-ErrorType of an invoke error status.
+func errorTypeForStatus(status int) string {
+	switch status {
+	case http.StatusForbidden:
+		return "MissingAuthenticationTokenException"
+	case http.StatusBadRequest:
+		return "BadRequestException"
+	default:
+		return "InternalServerErrorException"
+	}
+}
@@ -0,0 +1,74 @@
+package apigateway
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestMatchResource(t *testing.T) {
+	resources := map[string]*Resource{
+		"root":   {ID: "root", Path: "/"},
+		"orders": {ID: "orders", ParentID: "root", PathPart: "orders", Path: "/orders"},
+		"order":  {ID: "order", ParentID: "orders", PathPart: "{orderId}", Path: "/orders/{orderId}"},
+		"latest": {ID: "latest", ParentID: "orders", PathPart: "latest", Path: "/orders/latest"},
+		"proxy":  {ID: "proxy", ParentID: "root", PathPart: "{proxy+}", Path: "/{proxy+}"},
+	}
+
+	tests := []struct {
+		path       string
+		wantID     string
+		wantParams map[string]string
+	}{
+		{path: "/", wantID: "root", wantParams: map[string]string{}},
+		{path: "/orders", wantID: "orders", wantParams: map[string]string{}},
+		{path: "/orders/latest", wantID: "latest", wantParams: map[string]string{}},
+		{path: "/orders/42", wantID: "order", wantParams: map[string]string{"orderId": "42"}},
+		{path: "/orders/42/items", wantID: "proxy", wantParams: map[string]string{"proxy": "orders/42/items"}},
+		{path: "/files/a/b", wantID: "proxy", wantParams: map[string]string{"proxy": "files/a/b"}},
+	}
+
+	for _, tt := range tests {
+		resource, params := matchResource(resources, tt.path)
+		if resource == nil {
+			t.Errorf("matchResource(%q) matched nothing, want %s", tt.path, tt.wantID)
+
+			continue
+		}
+
+		if resource.ID != tt.wantID {
+			t.Errorf("matchResource(%q) = %s, want %s", tt.path, resource.ID, tt.wantID)
+		}
+
+		if !maps.Equal(params, tt.wantParams) {
+			t.Errorf("matchResource(%q) params = %v, want %v", tt.path, params, tt.wantParams)
+		}
+	}
+}
+
+func TestLambdaFunctionFromURI(t *testing.T) {
+	tests := []struct {
+		uri           string
+		wantName      string
+		wantQualifier string
+	}{
+		{
+			uri:      "arn:aws:apigateway:us-east-1:lambda:path/2015-03-31/functions/arn:aws:lambda:us-east-1:000000000000:function:my-func/invocations",
+			wantName: "my-func",
+		},
+		{
+			uri:           "arn:aws:apigateway:us-east-1:lambda:path/2015-03-31/functions/arn:aws:lambda:us-east-1:000000000000:function:my-func:live/invocations",
+			wantName:      "my-func",
+			wantQualifier: "live",
+		},
+		{
+			uri: "https://example.com/orders",
+		},
+	}
+
+	for _, tt := range tests {
+		name, qualifier := lambdaFunctionFromURI(tt.uri)
+		if name != tt.wantName || qualifier != tt.wantQualifier {
+			t.Errorf("lambdaFunctionFromURI(%q) = (%q, %q), want (%q, %q)", tt.uri, name, qualifier, tt.wantName, tt.wantQualifier)
+		}
+	}
+}
@@ -2,34 +2,51 @@
 package apigateway
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 
 	"github.com/sivchari/kumo/internal/service"
 )
 
+const defaultBaseURL = "http://localhost:4566"
+
 // Compile-time check that Service implements io.Closer.
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"apigateway"}, func(dataDir string) []service.Service {
+		baseURL := defaultBaseURL
+
+		if port := os.Getenv("KUMO_PORT"); port != "" {
+			baseURL = fmt.Sprintf("http://localhost:%s", port)
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
+
+		return []service.Service{New(NewMemoryStorage(opts...), baseURL)}
+	})
 }
 
 // Service implements the API Gateway service.
 type Service struct {
-	storage Storage
+	storage    Storage
+	baseURL    string
+	httpClient *http.Client
 }
 
 // New creates a new API Gateway service.
-func New(storage Storage) *Service {
+// baseURL is the kumo endpoint used to invoke Lambda functions of proxy integrations.
+func New(storage Storage, baseURL string) *Service {
 	return &Service{
-		storage: storage,
+		storage:    storage,
+		baseURL:    baseURL,
+		httpClient: &http.Client{},
 	}
 }
 
@@ -71,6 +88,11 @@ func (s *Service) RegisterRoutes(r service.Router) {
 	r.HandleFunc("GET", "/apigateway/restapis/{restApiId}/stages", s.GetStages)
 	r.HandleFunc("GET", "/apigateway/restapis/{restApiId}/stages/{stageName}", s.GetStage)
 	r.HandleFunc("DELETE", "/apigateway/restapis/{restApiId}/stages/{stageName}", s.DeleteStage)
+
+	// Invocation of deployed APIs.
+	for _, method := range invokeMethods {
+		r.HandleFunc(method, "/restapis/{restApiId}/stages/{stageName}/{path...}", s.InvokeAPI)
+	}
 }
 
 // Close saves the storage state if persistence is enabled.
@@ -83,3 +105,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
@@ -2,8 +2,12 @@ package apigateway
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -20,8 +24,12 @@ const (
 	errDeploymentNotFound = "NotFoundException"
 	errStageNotFound      = "NotFoundException"
 	errBadRequest         = "BadRequestException"
+	errConflict           = "ConflictException"
 )
 
+// integrationTypes lists the supported integration types.
+var integrationTypes = []string{"AWS", "AWS_PROXY", "HTTP", "HTTP_PROXY", "MOCK"}
+
 // Storage defines the API Gateway storage interface.
 type Storage interface {
 	CreateRestAPI(ctx context.Context, req *CreateRestAPIRequest) (*RestAPI, error)
@@ -49,6 +57,9 @@ type Storage interface {
 	GetStage(ctx context.Context, restAPIID, stageName string) (*Stage, error)
 	GetStages(ctx context.Context, restAPIID string) ([]*Stage, error)
 	DeleteStage(ctx context.Context, restAPIID, stageName string) error
+
+	GetStageDeployment(ctx context.Context, restAPIID, stageName string) (*Deployment, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -146,6 +157,16 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.RestAPIs = make(map[string]*RestAPIData)
+
+	return nil
+}
+
 // CreateRestAPI creates a new REST API.
 func (s *MemoryStorage) CreateRestAPI(_ context.Context, req *CreateRestAPIRequest) (*RestAPI, error) {
 	s.mu.Lock()
@@ -249,6 +270,16 @@ func (s *MemoryStorage) CreateResource(_ context.Context, restAPIID, parentID, p
 		return nil, &ServiceError{Code: errResourceNotFound, Message: "Invalid resource identifier specified"}
 	}
 
+	if pathPart == "" {
+		return nil, &ServiceError{Code: errBadRequest, Message: "Invalid path part specified"}
+	}
+
+	for _, r := range data.Resources {
+		if r.ParentID == parentID && r.PathPart == pathPart {
+			return nil, &ServiceError{Code: errConflict, Message: "Another resource with the same parent already has this name: " + pathPart}
+		}
+	}
+
 	id := generateID()
 	path := buildPath(parent.Path, pathPart)
 
@@ -283,8 +314,8 @@ func (s *MemoryStorage) GetResource(_ context.Context, restAPIID, resourceID str
 	return resource, nil
 }
 
-// GetResources returns all resources for a REST API.
-func (s *MemoryStorage) GetResources(_ context.Context, restAPIID string, limit int32, _ string) ([]*Resource, string, error) {
+// GetResources returns the resources of a REST API ordered by path.
+func (s *MemoryStorage) GetResources(_ context.Context, restAPIID string, limit int32, position string) ([]*Resource, string, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -297,17 +328,18 @@ func (s *MemoryStorage) GetResources(_ context.Context, restAPIID string, limit
 		limit = 25
 	}
 
-	var resources []*Resource
-
+	resources := make([]*Resource, 0, len(data.Resources))
 	for _, r := range data.Resources {
 		resources = append(resources, r)
-
-		if int32(len(resources)) >= limit { //nolint:gosec // slice length bounded by limit parameter
-			break
-		}
 	}
 
-	return resources, "", nil
+	slices.SortFunc(resources, func(a, b *Resource) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	page, next := paginate(resources, position, limit)
+
+	return page, next, nil
 }
 
 // DeleteResource deletes a resource.
@@ -330,11 +362,22 @@ func (s *MemoryStorage) DeleteResource(_ context.Context, restAPIID, resourceID
 		return &ServiceError{Code: errBadRequest, Message: "Cannot delete root resource"}
 	}
 
-	delete(data.Resources, resourceID)
+	deleteResourceTree(data.Resources, resourceID)
 
 	return nil
 }
 
+// deleteResourceTree deletes a resource and all of its descendants.
+func deleteResourceTree(resources map[string]*Resource, resourceID string) {
+	for id, r := range resources {
+		if r.ParentID == resourceID {
+			deleteResourceTree(resources, id)
+		}
+	}
+
+	delete(resources, resourceID)
+}
+
 // PutMethod creates or updates a method.
 func (s *MemoryStorage) PutMethod(_ context.Context, restAPIID, resourceID, httpMethod string, req *PutMethodRequest) (*Method, error) {
 	s.mu.Lock()
@@ -405,6 +448,10 @@ func (s *MemoryStorage) PutIntegration(_ context.Context, restAPIID, resourceID,
 		return nil, &ServiceError{Code: errMethodNotFound, Message: "Invalid method identifier specified"}
 	}
 
+	if err := validateIntegration(req); err != nil {
+		return nil, err
+	}
+
 	integration := &Integration{
 		Type:                req.Type,
 		HTTPMethod:          req.HTTPMethod,
@@ -470,19 +517,25 @@ func (s *MemoryStorage) CreateDeployment(_ context.Context, restAPIID string, re
 		ID:          id,
 		Description: req.Description,
 		CreatedDate: now,
+		Resources:   snapshotResources(data.Resources),
 	}
 
 	data.Deployments[id] = deployment
 
-	// If stage name is specified, create or update the stage.
+	// If stage name is specified, create the stage or point it at the new deployment.
 	if req.StageName != "" {
-		stage := &Stage{
-			StageName:       req.StageName,
-			DeploymentID:    id,
-			CreatedDate:     now,
-			LastUpdatedDate: now,
+		if stage, exists := data.Stages[req.StageName]; exists {
+			stage.DeploymentID = id
+			stage.LastUpdatedDate = now
+		} else {
+			data.Stages[req.StageName] = &Stage{
+				StageName:       req.StageName,
+				DeploymentID:    id,
+				Description:     req.StageDescription,
+				CreatedDate:     now,
+				LastUpdatedDate: now,
+			}
 		}
-		data.Stages[req.StageName] = stage
 	}
 
 	return deployment, nil
@@ -547,6 +600,15 @@ func (s *MemoryStorage) DeleteDeployment(_ context.Context, restAPIID, deploymen
 		return &ServiceError{Code: errDeploymentNotFound, Message: "Invalid deployment identifier specified"}
 	}
 
+	for _, stage := range data.Stages {
+		if stage.DeploymentID == deploymentID {
+			return &ServiceError{
+				Code:    errBadRequest,
+				Message: "Active stages pointing to this deployment must be moved or deleted",
+			}
+		}
+	}
+
 	delete(data.Deployments, deploymentID)
 
 	return nil
@@ -567,6 +629,10 @@ func (s *MemoryStorage) CreateStage(_ context.Context, restAPIID string, req *Cr
 		return nil, &ServiceError{Code: errDeploymentNotFound, Message: "Invalid deployment identifier specified"}
 	}
 
+	if _, exists := data.Stages[req.StageName]; exists {
+		return nil, &ServiceError{Code: errConflict, Message: "Stage already exists"}
+	}
+
 	now := time.Now()
 
 	stage := &Stage{
@@ -613,12 +679,15 @@ func (s *MemoryStorage) GetStages(_ context.Context, restAPIID string) ([]*Stage
 		return nil, &ServiceError{Code: errRestAPINotFound, Message: "Invalid REST API identifier specified"}
 	}
 
-	var stages []*Stage
-
+	stages := make([]*Stage, 0, len(data.Stages))
 	for _, stage := range data.Stages {
 		stages = append(stages, stage)
 	}
 
+	slices.SortFunc(stages, func(a, b *Stage) int {
+		return strings.Compare(a.StageName, b.StageName)
+	})
+
 	return stages, nil
 }
 
@@ -641,6 +710,29 @@ func (s *MemoryStorage) DeleteStage(_ context.Context, restAPIID, stageName stri
 	return nil
 }
 
+// GetStageDeployment returns the deployment a stage currently serves.
+func (s *MemoryStorage) GetStageDeployment(_ context.Context, restAPIID, stageName string) (*Deployment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	data, exists := s.RestAPIs[restAPIID]
+	if !exists {
+		return nil, &ServiceError{Code: errRestAPINotFound, Message: "Invalid REST API identifier specified"}
+	}
+
+	stage, exists := data.Stages[stageName]
+	if !exists {
+		return nil, &ServiceError{Code: errStageNotFound, Message: "Invalid stage identifier specified"}
+	}
+
+	deployment, exists := data.Deployments[stage.DeploymentID]
+	if !exists {
+		return nil, &ServiceError{Code: errDeploymentNotFound, Message: "Invalid deployment identifier specified"}
+	}
+
+	return deployment, nil
+}
+
 // generateID generates a unique ID.
 func generateID() string {
 	return uuid.New().String()[:10]
@@ -654,3 +746,79 @@ func buildPath(parentPath, pathPart string) string {
 
 	return fmt.Sprintf("%s/%s", parentPath, pathPart)
 }
+
+// validateIntegration checks the integration type and the fields it requires.
+func validateIntegration(req *PutIntegrationRequest) error {
+	if !slices.Contains(integrationTypes, req.Type) {
+		return &ServiceError{
+			Code:    errBadRequest,
+			Message: fmt.Sprintf("Invalid integration type specified: %s", req.Type),
+		}
+	}
+
+	if req.Type == "MOCK" {
+		return nil
+	}
+
+	if req.HTTPMethod == "" {
+		return &ServiceError{Code: errBadRequest, Message: "Enumeration value for HttpMethod must be non-empty"}
+	}
+
+	if req.URI == "" {
+		return &ServiceError{Code: errBadRequest, Message: "Invalid HTTP endpoint specified for URI"}
+	}
+
+	return nil
+}
+
+// snapshotResources copies the resource tree so that a deployment keeps serving
+// the methods and integrations that existed when it was created.
+func snapshotResources(resources map[string]*Resource) map[string]*Resource {
+	snapshot := make(map[string]*Resource, len(resources))
+
+	for id, r := range resources {
+		methods := make(map[string]Method, len(r.ResourceMethods))
+
+		for httpMethod, m := range r.ResourceMethods {
+			if m.MethodIntegration != nil {
+				integration := *m.MethodIntegration
+				m.MethodIntegration = &integration
+			}
+
+			methods[httpMethod] = m
+		}
+
+		snapshot[id] = &Resource{
+			ID:              r.ID,
+			ParentID:        r.ParentID,
+			PathPart:        r.PathPart,
+			Path:            r.Path,
+			ResourceMethods: methods,
+		}
+	}
+
+	return snapshot
+}
+
+// paginate returns the page of items starting at the offset encoded in position,
+// along with the position of the following page.
+func paginate[T any](items []T, position string, limit int32) ([]T, string) {
+	start := 0
+
+	if position != "" {
+		if decoded, err := base64.StdEncoding.DecodeString(position); err == nil {
+			if idx, err := strconv.Atoi(string(decoded)); err == nil && idx >= 0 && idx <= len(items) {
+				start = idx
+			}
+		}
+	}
+
+	end := min(start+int(limit), len(items))
+
+	var next string
+	if end < len(items) {
+		next = base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(end)))
+	}
+
+	return items[start:end], next
+}
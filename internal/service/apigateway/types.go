@@ -59,9 +59,10 @@ type Integration struct {
 
 // Deployment represents an API Gateway deployment.
 type Deployment struct {
-	ID          string    `json:"id"`
-	Description string    `json:"description,omitempty"`
-	CreatedDate time.Time `json:"createdDate"`
+	ID          string               `json:"id"`
+	Description string               `json:"description,omitempty"`
+	CreatedDate time.Time            `json:"createdDate"`
+	Resources   map[string]*Resource `json:"resources,omitempty"` // snapshot keyed by resource ID
 }
 
 // Stage represents an API Gateway stage.
@@ -177,15 +178,23 @@ type PutIntegrationRequest struct {
 
 // CreateDeploymentRequest represents a CreateDeployment request.
 type CreateDeploymentRequest struct {
-	StageName   string `json:"stageName,omitempty"`
-	Description string `json:"description,omitempty"`
+	StageName        string `json:"stageName,omitempty"`
+	StageDescription string `json:"stageDescription,omitempty"`
+	Description      string `json:"description,omitempty"`
 }
 
 // DeploymentResponse represents a Deployment response.
 type DeploymentResponse struct {
-	ID          string  `json:"id"`
-	Description string  `json:"description,omitempty"`
-	CreatedDate float64 `json:"createdDate"`
+	ID          string                               `json:"id"`
+	Description string                               `json:"description,omitempty"`
+	CreatedDate float64                              `json:"createdDate"`
+	APISummary  map[string]map[string]MethodSnapshot `json:"apiSummary,omitempty"`
+}
+
+// MethodSnapshot summarizes a deployed method.
+type MethodSnapshot struct {
+	AuthorizationType string `json:"authorizationType,omitempty"`
+	APIKeyRequired    bool   `json:"apiKeyRequired"`
 }
 
 // GetDeploymentsResponse represents a GetDeployments response.
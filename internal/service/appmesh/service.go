@@ -1,9 +1,9 @@
 package appmesh
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"os"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -24,12 +24,14 @@ func New(storage Storage) *Service {
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"appmesh"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
 
 // Name returns the service name.
@@ -88,3 +90,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
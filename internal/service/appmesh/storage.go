@@ -53,6 +53,7 @@ type Storage interface {
 	ListRoutes(ctx context.Context, req *ListRoutesInput) (*ListRoutesOutput, error)
 	UpdateRoute(ctx context.Context, req *UpdateRouteInput) (*RouteData, error)
 	DeleteRoute(ctx context.Context, meshName, virtualRouterName, routeName string) (*RouteData, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -166,6 +167,20 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Meshes = make(map[string]*MeshData)
+	m.VirtualNodes = make(map[string]map[string]*VirtualNodeData)
+	m.VirtualServices = make(map[string]map[string]*VirtualServiceData)
+	m.VirtualRouters = make(map[string]map[string]*VirtualRouterData)
+	m.Routes = make(map[string]map[string]map[string]*RouteData)
+
+	return nil
+}
+
 // --- Mesh Operations ---
 
 // CreateMesh creates a new mesh.
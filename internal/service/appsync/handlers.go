@@ -162,6 +162,39 @@ func (s *Service) CreateDataSource(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// ListDataSources handles the ListDataSources operation.
+func (s *Service) ListDataSources(w http.ResponseWriter, r *http.Request) {
+	apiID := extractPathParam(r, "apiId")
+	if apiID == "" {
+		writeError(w, errInvalidRequest, "apiId is required", http.StatusBadRequest)
+
+		return
+	}
+
+	maxResults, err := parseMaxResults(r)
+	if err != nil {
+		writeError(w, errInvalidRequest, "Invalid maxResults parameter", http.StatusBadRequest)
+
+		return
+	}
+
+	dataSources, nextToken, err := s.storage.ListDataSources(r.Context(), &ListDataSourcesInput{
+		APIID:      apiID,
+		NextToken:  r.URL.Query().Get("nextToken"),
+		MaxResults: maxResults,
+	})
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, ListDataSourcesOutput{
+		DataSources: dataSources,
+		NextToken:   nextToken,
+	})
+}
+
 // CreateResolver handles the CreateResolver operation.
 func (s *Service) CreateResolver(w http.ResponseWriter, r *http.Request) {
 	apiID := extractPathParam(r, "apiId")
@@ -206,6 +239,112 @@ func (s *Service) CreateResolver(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// GetResolver handles the GetResolver operation.
+func (s *Service) GetResolver(w http.ResponseWriter, r *http.Request) {
+	apiID, typeName, fieldName, ok := extractResolverPath(w, r)
+	if !ok {
+		return
+	}
+
+	resolver, err := s.storage.GetResolver(r.Context(), apiID, typeName, fieldName)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, GetResolverOutput{
+		Resolver: resolver,
+	})
+}
+
+// ListResolvers handles the ListResolvers operation.
+func (s *Service) ListResolvers(w http.ResponseWriter, r *http.Request) {
+	apiID := extractPathParam(r, "apiId")
+	if apiID == "" {
+		writeError(w, errInvalidRequest, "apiId is required", http.StatusBadRequest)
+
+		return
+	}
+
+	typeName := extractPathParam(r, "typeName")
+	if typeName == "" {
+		writeError(w, errInvalidRequest, "typeName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	maxResults, err := parseMaxResults(r)
+	if err != nil {
+		writeError(w, errInvalidRequest, "Invalid maxResults parameter", http.StatusBadRequest)
+
+		return
+	}
+
+	resolvers, nextToken, err := s.storage.ListResolvers(r.Context(), &ListResolversInput{
+		APIID:      apiID,
+		TypeName:   typeName,
+		NextToken:  r.URL.Query().Get("nextToken"),
+		MaxResults: maxResults,
+	})
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, ListResolversOutput{
+		Resolvers: resolvers,
+		NextToken: nextToken,
+	})
+}
+
+// UpdateResolver handles the UpdateResolver operation.
+func (s *Service) UpdateResolver(w http.ResponseWriter, r *http.Request) {
+	apiID, typeName, fieldName, ok := extractResolverPath(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateResolverInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	req.APIID = apiID
+	req.TypeName = typeName
+	req.FieldName = fieldName
+
+	resolver, err := s.storage.UpdateResolver(r.Context(), &req)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, UpdateResolverOutput{
+		Resolver: resolver,
+	})
+}
+
+// DeleteResolver handles the DeleteResolver operation.
+func (s *Service) DeleteResolver(w http.ResponseWriter, r *http.Request) {
+	apiID, typeName, fieldName, ok := extractResolverPath(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.storage.DeleteResolver(r.Context(), apiID, typeName, fieldName); err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, struct{}{})
+}
+
 // StartSchemaCreation handles the StartSchemaCreation operation.
 func (s *Service) StartSchemaCreation(w http.ResponseWriter, r *http.Request) {
 	apiID := extractPathParam(r, "apiId")
@@ -250,6 +389,7 @@ func extractPathParam(r *http.Request, param string) string {
 	// - /apis/{apiId}
 	// - /apis/{apiId}/datasources
 	// - /apis/{apiId}/types/{typeName}/resolvers
+	// - /apis/{apiId}/types/{typeName}/resolvers/{fieldName}
 	// - /apis/{apiId}/schemacreation
 	pathValue := r.PathValue(param)
 	if pathValue != "" {
@@ -259,6 +399,35 @@ func extractPathParam(r *http.Request, param string) string {
 	return ""
 }
 
+// extractResolverPath extracts the apiId, typeName and fieldName path parameters
+// of a single resolver, writing an error response when one is missing.
+func extractResolverPath(w http.ResponseWriter, r *http.Request) (string, string, string, bool) {
+	for _, param := range []string{"apiId", "typeName", "fieldName"} {
+		if extractPathParam(r, param) == "" {
+			writeError(w, errInvalidRequest, param+" is required", http.StatusBadRequest)
+
+			return "", "", "", false
+		}
+	}
+
+	return extractPathParam(r, "apiId"), extractPathParam(r, "typeName"), extractPathParam(r, "fieldName"), true
+}
+
+// parseMaxResults parses the optional maxResults query parameter.
+func parseMaxResults(r *http.Request) (int32, error) {
+	maxResultsStr := r.URL.Query().Get("maxResults")
+	if maxResultsStr == "" {
+		return 0, nil
+	}
+
+	val, err := strconv.ParseInt(maxResultsStr, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid maxResults: %w", err)
+	}
+
+	return int32(val), nil
+}
+
 // readJSONRequest reads and decodes JSON request body.
 func readJSONRequest(r *http.Request, v any) error {
 	body, err := io.ReadAll(r.Body)
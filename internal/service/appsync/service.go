@@ -1,9 +1,9 @@
 package appsync
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"os"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -12,12 +12,14 @@ import (
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"appsync"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
 
 // Service implements the AppSync service.
@@ -49,9 +51,14 @@ func (s *Service) RegisterRoutes(r service.Router) {
 
 	// Data source operations.
 	r.HandleFunc("POST", "/appsync/v1/apis/{apiId}/datasources", s.CreateDataSource)
+	r.HandleFunc("GET", "/appsync/v1/apis/{apiId}/datasources", s.ListDataSources)
 
 	// Resolver operations.
 	r.HandleFunc("POST", "/appsync/v1/apis/{apiId}/types/{typeName}/resolvers", s.CreateResolver)
+	r.HandleFunc("GET", "/appsync/v1/apis/{apiId}/types/{typeName}/resolvers", s.ListResolvers)
+	r.HandleFunc("GET", "/appsync/v1/apis/{apiId}/types/{typeName}/resolvers/{fieldName}", s.GetResolver)
+	r.HandleFunc("POST", "/appsync/v1/apis/{apiId}/types/{typeName}/resolvers/{fieldName}", s.UpdateResolver)
+	r.HandleFunc("DELETE", "/appsync/v1/apis/{apiId}/types/{typeName}/resolvers/{fieldName}", s.DeleteResolver)
 
 	// Schema operations.
 	r.HandleFunc("POST", "/appsync/v1/apis/{apiId}/schemacreation", s.StartSchemaCreation)
@@ -67,3 +74,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
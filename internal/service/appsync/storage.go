@@ -28,8 +28,14 @@ type Storage interface {
 	GetGraphqlAPI(ctx context.Context, apiID string) (*GraphqlAPI, error)
 	ListGraphqlAPIs(ctx context.Context, input *ListGraphqlAPIsInput) ([]GraphqlAPI, string, error)
 	CreateDataSource(ctx context.Context, input *CreateDataSourceInput) (*DataSource, error)
+	ListDataSources(ctx context.Context, input *ListDataSourcesInput) ([]DataSource, string, error)
 	CreateResolver(ctx context.Context, input *CreateResolverInput) (*Resolver, error)
+	GetResolver(ctx context.Context, apiID, typeName, fieldName string) (*Resolver, error)
+	ListResolvers(ctx context.Context, input *ListResolversInput) ([]Resolver, string, error)
+	UpdateResolver(ctx context.Context, input *UpdateResolverInput) (*Resolver, error)
+	DeleteResolver(ctx context.Context, apiID, typeName, fieldName string) error
 	StartSchemaCreation(ctx context.Context, apiID string, definition []byte) (*SchemaCreationStatus, error)
+	Reset(ctx context.Context) error
 }
 
 // APIData holds all data associated with a GraphQL API.
@@ -127,6 +133,16 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.APIs = make(map[string]*APIData)
+
+	return nil
+}
+
 // CreateGraphqlAPI creates a new GraphQL API.
 func (s *MemoryStorage) CreateGraphqlAPI(_ context.Context, input *CreateGraphqlAPIInput) (*GraphqlAPI, error) {
 	s.mu.Lock()
@@ -309,6 +325,10 @@ func (s *MemoryStorage) CreateDataSource(_ context.Context, input *CreateDataSou
 		}
 	}
 
+	if err := validateDataSourceConfig(input); err != nil {
+		return nil, err
+	}
+
 	if _, exists := data.DataSources[input.Name]; exists {
 		return nil, &Error{
 			Code:    errConflict,
@@ -367,9 +387,13 @@ func (s *MemoryStorage) CreateResolver(_ context.Context, input *CreateResolverI
 		}
 	}
 
-	resolverKey := fmt.Sprintf("%s:%s", input.TypeName, input.FieldName)
+	if err := checkResolverDataSource(data, input.DataSourceName); err != nil {
+		return nil, err
+	}
+
+	key := resolverKey(input.TypeName, input.FieldName)
 
-	if _, exists := data.Resolvers[resolverKey]; exists {
+	if _, exists := data.Resolvers[key]; exists {
 		return nil, &Error{
 			Code:    errConflict,
 			Message: fmt.Sprintf("Resolver for %s.%s already exists", input.TypeName, input.FieldName),
@@ -396,11 +420,170 @@ func (s *MemoryStorage) CreateResolver(_ context.Context, input *CreateResolverI
 		MetricsConfig:           input.MetricsConfig,
 	}
 
-	data.Resolvers[resolverKey] = resolver
+	data.Resolvers[key] = resolver
+
+	return resolver, nil
+}
+
+// ListDataSources lists the data sources of a GraphQL API with pagination support.
+func (s *MemoryStorage) ListDataSources(_ context.Context, input *ListDataSourcesInput) ([]DataSource, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	data, exists := s.APIs[input.APIID]
+	if !exists {
+		return nil, "", &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("GraphQL API %s not found", input.APIID),
+		}
+	}
+
+	dataSources := make([]DataSource, 0, len(data.DataSources))
+	for _, ds := range data.DataSources {
+		dataSources = append(dataSources, *ds)
+	}
+
+	sort.Slice(dataSources, func(i, j int) bool {
+		return dataSources[i].Name < dataSources[j].Name
+	})
+
+	result, nextToken := paginate(dataSources, input.NextToken, input.MaxResults)
+
+	return result, nextToken, nil
+}
+
+// GetResolver retrieves the resolver attached to a type field.
+func (s *MemoryStorage) GetResolver(_ context.Context, apiID, typeName, fieldName string) (*Resolver, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	data, exists := s.APIs[apiID]
+	if !exists {
+		return nil, &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("GraphQL API %s not found", apiID),
+		}
+	}
+
+	resolver, exists := data.Resolvers[resolverKey(typeName, fieldName)]
+	if !exists {
+		return nil, &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("Resolver for %s.%s not found", typeName, fieldName),
+		}
+	}
+
+	return resolver, nil
+}
+
+// ListResolvers lists the resolvers of a type with pagination support.
+func (s *MemoryStorage) ListResolvers(_ context.Context, input *ListResolversInput) ([]Resolver, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	data, exists := s.APIs[input.APIID]
+	if !exists {
+		return nil, "", &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("GraphQL API %s not found", input.APIID),
+		}
+	}
+
+	resolvers := make([]Resolver, 0)
+
+	for _, resolver := range data.Resolvers {
+		if resolver.TypeName != input.TypeName {
+			continue
+		}
+
+		resolvers = append(resolvers, *resolver)
+	}
+
+	sort.Slice(resolvers, func(i, j int) bool {
+		return resolvers[i].FieldName < resolvers[j].FieldName
+	})
+
+	result, nextToken := paginate(resolvers, input.NextToken, input.MaxResults)
+
+	return result, nextToken, nil
+}
+
+// UpdateResolver replaces the configuration of an existing resolver.
+func (s *MemoryStorage) UpdateResolver(_ context.Context, input *UpdateResolverInput) (*Resolver, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, exists := s.APIs[input.APIID]
+	if !exists {
+		return nil, &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("GraphQL API %s not found", input.APIID),
+		}
+	}
+
+	key := resolverKey(input.TypeName, input.FieldName)
+
+	existing, exists := data.Resolvers[key]
+	if !exists {
+		return nil, &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("Resolver for %s.%s not found", input.TypeName, input.FieldName),
+		}
+	}
+
+	if err := checkResolverDataSource(data, input.DataSourceName); err != nil {
+		return nil, err
+	}
+
+	resolver := &Resolver{
+		TypeName:                input.TypeName,
+		FieldName:               input.FieldName,
+		DataSourceName:          input.DataSourceName,
+		ResolverARN:             existing.ResolverARN,
+		RequestMappingTemplate:  input.RequestMappingTemplate,
+		ResponseMappingTemplate: input.ResponseMappingTemplate,
+		Kind:                    input.Kind,
+		PipelineConfig:          input.PipelineConfig,
+		SyncConfig:              input.SyncConfig,
+		CachingConfig:           input.CachingConfig,
+		MaxBatchSize:            input.MaxBatchSize,
+		Runtime:                 input.Runtime,
+		Code:                    input.Code,
+		MetricsConfig:           input.MetricsConfig,
+	}
+
+	data.Resolvers[key] = resolver
 
 	return resolver, nil
 }
 
+// DeleteResolver deletes the resolver attached to a type field.
+func (s *MemoryStorage) DeleteResolver(_ context.Context, apiID, typeName, fieldName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, exists := s.APIs[apiID]
+	if !exists {
+		return &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("GraphQL API %s not found", apiID),
+		}
+	}
+
+	key := resolverKey(typeName, fieldName)
+
+	if _, exists := data.Resolvers[key]; !exists {
+		return &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("Resolver for %s.%s not found", typeName, fieldName),
+		}
+	}
+
+	delete(data.Resolvers, key)
+
+	return nil
+}
+
 // StartSchemaCreation starts schema creation for a GraphQL API.
 func (s *MemoryStorage) StartSchemaCreation(_ context.Context, apiID string, _ []byte) (*SchemaCreationStatus, error) {
 	s.mu.Lock()
@@ -425,3 +608,81 @@ func (s *MemoryStorage) StartSchemaCreation(_ context.Context, apiID string, _ [
 
 	return status, nil
 }
+
+// resolverKey returns the key under which a resolver is stored.
+func resolverKey(typeName, fieldName string) string {
+	return fmt.Sprintf("%s:%s", typeName, fieldName)
+}
+
+// checkResolverDataSource verifies that the data source a resolver points to exists.
+func checkResolverDataSource(data *APIData, dataSourceName string) error {
+	if dataSourceName == "" {
+		return nil
+	}
+
+	if _, exists := data.DataSources[dataSourceName]; !exists {
+		return &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("Data source %s not found", dataSourceName),
+		}
+	}
+
+	return nil
+}
+
+// validateDataSourceConfig checks the data source type and its type-specific configuration.
+func validateDataSourceConfig(input *CreateDataSourceInput) error {
+	switch input.Type {
+	case DataSourceTypeAWSLambda:
+		if input.LambdaConfig == nil || input.LambdaConfig.LambdaFunctionARN == "" {
+			return &Error{
+				Code:    errInvalidRequest,
+				Message: "lambdaConfig.lambdaFunctionArn is required for AWS_LAMBDA data sources",
+			}
+		}
+	case DataSourceTypeAWSDynamoDB:
+		if input.DynamoDBConfig == nil || input.DynamoDBConfig.TableName == "" {
+			return &Error{
+				Code:    errInvalidRequest,
+				Message: "dynamodbConfig.tableName is required for AMAZON_DYNAMODB data sources",
+			}
+		}
+	case DataSourceTypeNone, DataSourceTypeElasticsearch, DataSourceTypeOpenSearch,
+		DataSourceTypeHTTP, DataSourceTypeRelationalDB, DataSourceTypeEventBridge:
+	default:
+		return &Error{
+			Code:    errInvalidRequest,
+			Message: fmt.Sprintf("Invalid data source type: %s", input.Type),
+		}
+	}
+
+	return nil
+}
+
+// paginate returns the page of items starting at the position encoded in nextToken.
+func paginate[T any](items []T, nextToken string, maxResults int32) ([]T, string) {
+	startIndex := 0
+
+	if nextToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(nextToken)
+		if err == nil {
+			if idx, err := strconv.Atoi(string(decoded)); err == nil && idx >= 0 && idx < len(items) {
+				startIndex = idx
+			}
+		}
+	}
+
+	limit := int(maxResults)
+	if limit <= 0 {
+		limit = defaultMaxResults
+	}
+
+	endIndex := min(startIndex+limit, len(items))
+
+	var token string
+	if endIndex < len(items) {
+		token = base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(endIndex)))
+	}
+
+	return items[startIndex:endIndex], token
+}
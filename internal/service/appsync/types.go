@@ -345,6 +345,61 @@ type CreateResolverOutput struct {
 	Resolver *Resolver `json:"resolver,omitempty"`
 }
 
+// ListDataSourcesInput is the request for ListDataSources.
+type ListDataSourcesInput struct {
+	APIID      string `json:"apiId"`
+	NextToken  string `json:"nextToken,omitempty"`
+	MaxResults int32  `json:"maxResults,omitempty"`
+}
+
+// ListDataSourcesOutput is the response for ListDataSources.
+type ListDataSourcesOutput struct {
+	DataSources []DataSource `json:"dataSources"`
+	NextToken   string       `json:"nextToken,omitempty"`
+}
+
+// GetResolverOutput is the response for GetResolver.
+type GetResolverOutput struct {
+	Resolver *Resolver `json:"resolver,omitempty"`
+}
+
+// ListResolversInput is the request for ListResolvers.
+type ListResolversInput struct {
+	APIID      string `json:"apiId"`
+	TypeName   string `json:"typeName"`
+	NextToken  string `json:"nextToken,omitempty"`
+	MaxResults int32  `json:"maxResults,omitempty"`
+}
+
+// ListResolversOutput is the response for ListResolvers.
+type ListResolversOutput struct {
+	Resolvers []Resolver `json:"resolvers"`
+	NextToken string     `json:"nextToken,omitempty"`
+}
+
+// UpdateResolverInput is the request for UpdateResolver.
+type UpdateResolverInput struct {
+	APIID                   string          `json:"apiId"`
+	TypeName                string          `json:"typeName"`
+	FieldName               string          `json:"fieldName"`
+	DataSourceName          string          `json:"dataSourceName,omitempty"`
+	RequestMappingTemplate  string          `json:"requestMappingTemplate,omitempty"`
+	ResponseMappingTemplate string          `json:"responseMappingTemplate,omitempty"`
+	Kind                    string          `json:"kind,omitempty"`
+	PipelineConfig          *PipelineConfig `json:"pipelineConfig,omitempty"`
+	SyncConfig              *SyncConfig     `json:"syncConfig,omitempty"`
+	CachingConfig           *CachingConfig  `json:"cachingConfig,omitempty"`
+	MaxBatchSize            int32           `json:"maxBatchSize,omitempty"`
+	Runtime                 *RuntimeConfig  `json:"runtime,omitempty"`
+	Code                    string          `json:"code,omitempty"`
+	MetricsConfig           string          `json:"metricsConfig,omitempty"`
+}
+
+// UpdateResolverOutput is the response for UpdateResolver.
+type UpdateResolverOutput struct {
+	Resolver *Resolver `json:"resolver,omitempty"`
+}
+
 // StartSchemaCreationInput is the request for StartSchemaCreation.
 type StartSchemaCreationInput struct {
 	APIID      string `json:"apiId"`
@@ -193,6 +193,224 @@ func (s *Service) DeleteWorkGroup(w http.ResponseWriter, r *http.Request) {
 	writeJSONResponse(w, DeleteWorkGroupResponse{})
 }
 
+// GetWorkGroup handles the GetWorkGroup action.
+func (s *Service) GetWorkGroup(w http.ResponseWriter, r *http.Request) {
+	var req GetWorkGroupRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeAthenaError(w, errInvalidRequestException, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.WorkGroup == "" {
+		writeAthenaError(w, errInvalidRequestException, "WorkGroup is required.", http.StatusBadRequest)
+
+		return
+	}
+
+	wg, err := s.storage.GetWorkGroup(r.Context(), req.WorkGroup)
+	if err != nil {
+		handleAthenaError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, GetWorkGroupResponse{
+		WorkGroup: convertWorkGroupToOutput(wg),
+	})
+}
+
+// ListWorkGroups handles the ListWorkGroups action.
+func (s *Service) ListWorkGroups(w http.ResponseWriter, r *http.Request) {
+	var req ListWorkGroupsRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeAthenaError(w, errInvalidRequestException, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	workGroups, nextToken, err := s.storage.ListWorkGroups(r.Context(), req.NextToken, req.MaxResults)
+	if err != nil {
+		handleAthenaError(w, err)
+
+		return
+	}
+
+	summaries := make([]WorkGroupSummary, 0, len(workGroups))
+	for _, wg := range workGroups {
+		summary := WorkGroupSummary{
+			Name:         wg.Name,
+			State:        string(wg.State),
+			Description:  wg.Description,
+			CreationTime: float64(wg.CreationTime.Unix()),
+		}
+
+		if wg.Configuration != nil {
+			summary.EngineVersion = convertEngineVersionToOutput(wg.Configuration.EngineVersion)
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	writeJSONResponse(w, ListWorkGroupsResponse{
+		WorkGroups: summaries,
+		NextToken:  nextToken,
+	})
+}
+
+// CreateNamedQuery handles the CreateNamedQuery action.
+func (s *Service) CreateNamedQuery(w http.ResponseWriter, r *http.Request) {
+	var req CreateNamedQueryRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeAthenaError(w, errInvalidRequestException, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.Name == "" || req.Database == "" || req.QueryString == "" {
+		writeAthenaError(w, errInvalidRequestException, "Name, Database and QueryString are required.", http.StatusBadRequest)
+
+		return
+	}
+
+	nq, err := s.storage.CreateNamedQuery(r.Context(), &req)
+	if err != nil {
+		handleAthenaError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, CreateNamedQueryResponse{
+		NamedQueryID: nq.NamedQueryID,
+	})
+}
+
+// GetNamedQuery handles the GetNamedQuery action.
+func (s *Service) GetNamedQuery(w http.ResponseWriter, r *http.Request) {
+	var req GetNamedQueryRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeAthenaError(w, errInvalidRequestException, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.NamedQueryID == "" {
+		writeAthenaError(w, errInvalidRequestException, "NamedQueryId is required.", http.StatusBadRequest)
+
+		return
+	}
+
+	nq, err := s.storage.GetNamedQuery(r.Context(), req.NamedQueryID)
+	if err != nil {
+		handleAthenaError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, GetNamedQueryResponse{
+		NamedQuery: &NamedQueryOutput{
+			NamedQueryID: nq.NamedQueryID,
+			Name:         nq.Name,
+			Description:  nq.Description,
+			Database:     nq.Database,
+			QueryString:  nq.QueryString,
+			WorkGroup:    nq.WorkGroup,
+		},
+	})
+}
+
+// ListNamedQueries handles the ListNamedQueries action.
+func (s *Service) ListNamedQueries(w http.ResponseWriter, r *http.Request) {
+	var req ListNamedQueriesRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeAthenaError(w, errInvalidRequestException, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	ids, nextToken, err := s.storage.ListNamedQueries(r.Context(), req.WorkGroup, req.NextToken, req.MaxResults)
+	if err != nil {
+		handleAthenaError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, ListNamedQueriesResponse{
+		NamedQueryIDs: ids,
+		NextToken:     nextToken,
+	})
+}
+
+// DeleteNamedQuery handles the DeleteNamedQuery action.
+func (s *Service) DeleteNamedQuery(w http.ResponseWriter, r *http.Request) {
+	var req DeleteNamedQueryRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeAthenaError(w, errInvalidRequestException, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.NamedQueryID == "" {
+		writeAthenaError(w, errInvalidRequestException, "NamedQueryId is required.", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.DeleteNamedQuery(r.Context(), req.NamedQueryID); err != nil {
+		handleAthenaError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, DeleteNamedQueryResponse{})
+}
+
+// PutStubbedQueryResults handles the kumo-specific endpoint that registers the
+// results returned by queries with the given text.
+func (s *Service) PutStubbedQueryResults(w http.ResponseWriter, r *http.Request) {
+	var req PutStubbedQueryResultsRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeAthenaError(w, errInvalidRequestException, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	header := Row{Data: make([]Datum, 0, len(req.Columns))}
+	columns := make([]ColumnInfo, 0, len(req.Columns))
+
+	for _, col := range req.Columns {
+		typ := col.Type
+		if typ == "" {
+			typ = "varchar"
+		}
+
+		header.Data = append(header.Data, Datum{VarCharValue: col.Name})
+		columns = append(columns, ColumnInfo{Name: col.Name, Label: col.Name, Type: typ, Nullable: "UNKNOWN"})
+	}
+
+	rs := &ResultSet{
+		Rows:              []Row{header},
+		ResultSetMetadata: &ResultSetMetadata{ColumnInfo: columns},
+	}
+
+	for _, values := range req.Rows {
+		row := Row{Data: make([]Datum, 0, len(values))}
+		for _, v := range values {
+			row.Data = append(row.Data, Datum{VarCharValue: v})
+		}
+
+		rs.Rows = append(rs.Rows, row)
+	}
+
+	if err := s.storage.PutStubbedQueryResults(r.Context(), req.QueryString, rs); err != nil {
+		handleAthenaError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, struct{}{})
+}
+
 // DispatchAction routes the request to the appropriate handler based on X-Amz-Target header.
 func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
 	target := r.Header.Get("X-Amz-Target")
@@ -213,6 +431,18 @@ func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
 		s.CreateWorkGroup(w, r)
 	case "DeleteWorkGroup":
 		s.DeleteWorkGroup(w, r)
+	case "GetWorkGroup":
+		s.GetWorkGroup(w, r)
+	case "ListWorkGroups":
+		s.ListWorkGroups(w, r)
+	case "CreateNamedQuery":
+		s.CreateNamedQuery(w, r)
+	case "GetNamedQuery":
+		s.GetNamedQuery(w, r)
+	case "ListNamedQueries":
+		s.ListNamedQueries(w, r)
+	case "DeleteNamedQuery":
+		s.DeleteNamedQuery(w, r)
 	default:
 		writeAthenaError(w, errInvalidAction, "The action "+action+" is not valid", http.StatusBadRequest)
 	}
@@ -257,6 +487,29 @@ func convertQueryExecutionToOutput(qe *QueryExecution) *QueryExecutionOutput {
 	return output
 }
 
+// convertWorkGroupToOutput converts internal WorkGroup to API output.
+func convertWorkGroupToOutput(wg *WorkGroup) *WorkGroupOutput {
+	output := &WorkGroupOutput{
+		Name:         wg.Name,
+		State:        string(wg.State),
+		Description:  wg.Description,
+		CreationTime: float64(wg.CreationTime.Unix()),
+	}
+
+	if cfg := wg.Configuration; cfg != nil {
+		output.Configuration = &WorkGroupConfigurationOutput{
+			ResultConfiguration:             convertResultConfigToOutput(cfg.ResultConfiguration),
+			EnforceWorkGroupConfiguration:   cfg.EnforceWorkGroupConfiguration,
+			PublishCloudWatchMetricsEnabled: cfg.PublishCloudWatchMetricsEnabled,
+			BytesScannedCutoffPerQuery:      cfg.BytesScannedCutoffPerQuery,
+			RequesterPaysEnabled:            cfg.RequesterPaysEnabled,
+			EngineVersion:                   convertEngineVersionToOutput(cfg.EngineVersion),
+		}
+	}
+
+	return output
+}
+
 func convertResultConfigToOutput(cfg *ResultConfiguration) *ResultConfigurationOutput {
 	if cfg == nil {
 		return nil
@@ -0,0 +1,167 @@
+package athena
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// resultUploadTimeout bounds how long writing query results to S3 may take.
+const resultUploadTimeout = 5 * time.Second
+
+var (
+	// literalSelectPattern matches a SELECT statement without a FROM clause, e.g. "SELECT 1, 'a' AS name".
+	literalSelectPattern = regexp.MustCompile(`(?is)^select\s+(.+)$`)
+	// selectItemPattern matches a single literal select item with an optional alias.
+	selectItemPattern = regexp.MustCompile(`(?is)^('(?:[^']|'')*'|-?\d+(?:\.\d+)?|true|false|null)(?:\s+(?:as\s+)?("[^"]+"|\w+))?$`)
+)
+
+// normalizeQuery collapses whitespace and drops a trailing semicolon so that
+// stubbed results match regardless of formatting.
+func normalizeQuery(query string) string {
+	return strings.TrimSuffix(strings.Join(strings.Fields(query), " "), ";")
+}
+
+// resultSetForQuery returns the result set for a query. Stubbed results take
+// precedence, then SELECT statements over literals are evaluated; any other
+// query returns a fixed placeholder result set. The caller must hold the lock.
+func (s *MemoryStorage) resultSetForQuery(query string) *ResultSet {
+	if rs, ok := s.StubbedResults[normalizeQuery(query)]; ok {
+		return rs
+	}
+
+	if rs, ok := literalSelectResultSet(normalizeQuery(query)); ok {
+		return rs
+	}
+
+	return createMockResultSet()
+}
+
+// literalSelectResultSet evaluates a SELECT statement whose items are all literals.
+func literalSelectResultSet(query string) (*ResultSet, bool) {
+	m := literalSelectPattern.FindStringSubmatch(query)
+	if m == nil {
+		return nil, false
+	}
+
+	items := splitSelectItems(m[1])
+	header := Row{Data: make([]Datum, 0, len(items))}
+	values := Row{Data: make([]Datum, 0, len(items))}
+	columns := make([]ColumnInfo, 0, len(items))
+
+	for i, item := range items {
+		im := selectItemPattern.FindStringSubmatch(strings.TrimSpace(item))
+		if im == nil {
+			return nil, false
+		}
+
+		literal, typ := parseLiteral(im[1])
+
+		name := strings.Trim(im[2], `"`)
+		if name == "" {
+			name = "_col" + strconv.Itoa(i)
+		}
+
+		header.Data = append(header.Data, Datum{VarCharValue: name})
+		values.Data = append(values.Data, Datum{VarCharValue: literal})
+		columns = append(columns, ColumnInfo{Name: name, Label: name, Type: typ, Nullable: "UNKNOWN"})
+	}
+
+	return &ResultSet{
+		Rows:              []Row{header, values},
+		ResultSetMetadata: &ResultSetMetadata{ColumnInfo: columns},
+	}, true
+}
+
+// splitSelectItems splits a select list on commas outside string literals.
+func splitSelectItems(list string) []string {
+	var (
+		items   []string
+		start   int
+		inQuote bool
+	)
+
+	for i, r := range list {
+		switch {
+		case r == '\'':
+			inQuote = !inQuote
+		case r == ',' && !inQuote:
+			items = append(items, list[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(items, list[start:])
+}
+
+// parseLiteral returns the string value and Athena type of a SQL literal.
+func parseLiteral(literal string) (string, string) {
+	switch lower := strings.ToLower(literal); {
+	case strings.HasPrefix(literal, "'"):
+		return strings.ReplaceAll(literal[1:len(literal)-1], "''", "'"), "varchar"
+	case lower == "true" || lower == "false":
+		return lower, "boolean"
+	case lower == "null":
+		return "", "unknown"
+	case strings.Contains(literal, "."):
+		return literal, "decimal"
+	default:
+		return literal, "integer"
+	}
+}
+
+// resultsCSV encodes a result set in the CSV format Athena writes to S3,
+// quoting every value.
+func resultsCSV(rs *ResultSet) []byte {
+	var buf bytes.Buffer
+
+	for _, row := range rs.Rows {
+		for i, datum := range row.Data {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			buf.WriteString(`"` + strings.ReplaceAll(datum.VarCharValue, `"`, `""`) + `"`)
+		}
+
+		buf.WriteByte('\n')
+	}
+
+	return buf.Bytes()
+}
+
+// uploadResults writes the query results to the S3 location through the kumo S3 endpoint.
+func (s *MemoryStorage) uploadResults(ctx context.Context, location string, rs *ResultSet) error {
+	bucket, key, ok := strings.Cut(strings.TrimPrefix(location, "s3://"), "/")
+	if !strings.HasPrefix(location, "s3://") || !ok || bucket == "" {
+		return fmt.Errorf("invalid output location %s", location)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.baseURL+"/"+bucket+"/"+key, bytes.NewReader(resultsCSV(rs)))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "text/csv")
+	service.MarkInternalRequest(req)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to verify/create output bucket %s: %w", bucket, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unable to verify/create output bucket %s", bucket)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package athena
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLiteralSelectResultSet(t *testing.T) {
+	rs, ok := literalSelectResultSet("SELECT 1, 'it''s' AS name, 2.5 AS \"ratio\", true")
+	if !ok {
+		t.Fatal("expected literal select to be evaluated")
+	}
+
+	want := "\"_col0\",\"name\",\"ratio\",\"_col3\"\n\"1\",\"it's\",\"2.5\",\"true\"\n"
+	if got := string(resultsCSV(rs)); got != want {
+		t.Errorf("unexpected CSV:\ngot  %q\nwant %q", got, want)
+	}
+
+	types := []string{"integer", "varchar", "decimal", "boolean"}
+	for i, col := range rs.ResultSetMetadata.ColumnInfo {
+		if col.Type != types[i] {
+			t.Errorf("column %d: expected type %s, got %s", i, types[i], col.Type)
+		}
+	}
+
+	if _, ok := literalSelectResultSet("SELECT id FROM users"); ok {
+		t.Error("expected query with FROM clause not to be evaluated")
+	}
+}
+
+func TestQueryExecutionLifecycle(t *testing.T) {
+	s := NewMemoryStorage("", WithQueryTransitionDelay(10*time.Millisecond))
+	t.Cleanup(func() { _ = s.Close() })
+
+	ctx := context.Background()
+
+	if err := s.PutStubbedQueryResults(ctx, "SELECT name FROM users;", &ResultSet{
+		Rows: []Row{
+			{Data: []Datum{{VarCharValue: "name"}}},
+			{Data: []Datum{{VarCharValue: "alice"}}},
+			{Data: []Datum{{VarCharValue: "bob"}}},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	qe, err := s.StartQueryExecution(ctx, "SELECT  name\nFROM users", "", nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if qe.Status.State != QueryExecutionStateQueued {
+		t.Fatalf("expected QUEUED, got %s", qe.Status.State)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+
+	for {
+		got, err := s.GetQueryExecution(ctx, qe.QueryExecutionID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got.Status.State == QueryExecutionStateSucceeded {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("query did not succeed, state %s", got.Status.State)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	page, next, err := s.GetQueryResults(ctx, qe.QueryExecutionID, "", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(page.Rows) != 2 || next == "" {
+		t.Fatalf("expected 2 rows and a next token, got %d rows, token %q", len(page.Rows), next)
+	}
+
+	page, next, err = s.GetQueryResults(ctx, qe.QueryExecutionID, next, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(page.Rows) != 1 || page.Rows[0].Data[0].VarCharValue != "bob" || next != "" {
+		t.Fatalf("unexpected second page: %+v, token %q", page.Rows, next)
+	}
+}
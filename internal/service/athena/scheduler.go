@@ -0,0 +1,128 @@
+package athena
+
+import (
+	"context"
+	"time"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// defaultQueryTransitionDelay is how long a query stays QUEUED and RUNNING before the scheduler advances it.
+const defaultQueryTransitionDelay = 200 * time.Millisecond
+
+// WithQueryTransitionDelay sets how long a query stays QUEUED and RUNNING before the scheduler advances it.
+func WithQueryTransitionDelay(d time.Duration) Option {
+	return func(s *MemoryStorage) {
+		s.transitionDelay = d
+	}
+}
+
+// queryScheduler periodically advances query executions through their lifecycle.
+func (s *MemoryStorage) queryScheduler() {
+	ticker := time.NewTicker(max(s.transitionDelay/5, 10*time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopScheduler:
+			return
+		case now := <-ticker.C:
+			for _, qe := range s.advanceQueries(now) {
+				s.completeQuery(qe)
+			}
+		}
+	}
+}
+
+// completedQuery is a query that finished running and whose results still have to be written.
+type completedQuery struct {
+	id             string
+	outputLocation string
+	region         string
+	results        *ResultSet
+}
+
+// advanceQueries moves queued queries to RUNNING and returns the running
+// queries that have spent the transition delay in that state.
+func (s *MemoryStorage) advanceQueries(now time.Time) []completedQuery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var completed []completedQuery
+
+	for id, qe := range s.QueryExecutions {
+		if qe.Status.State != QueryExecutionStateQueued && qe.Status.State != QueryExecutionStateRunning {
+			continue
+		}
+
+		changed, ok := s.transitions[id]
+		if !ok {
+			// Queries restored from disk start their timer on the first tick.
+			s.transitions[id] = now
+
+			continue
+		}
+
+		if now.Sub(changed) < s.transitionDelay {
+			continue
+		}
+
+		if qe.Status.State == QueryExecutionStateQueued {
+			qe.Status.State = QueryExecutionStateRunning
+			s.transitions[id] = now
+
+			continue
+		}
+
+		// Leave the query RUNNING while its results are written; it is
+		// finished by completeQuery unless it gets cancelled meanwhile.
+		delete(s.transitions, id)
+
+		var outputLocation string
+		if qe.ResultConfiguration != nil {
+			outputLocation = qe.ResultConfiguration.OutputLocation
+		}
+
+		completed = append(completed, completedQuery{
+			id:             id,
+			outputLocation: outputLocation,
+			region:         qe.Region,
+			results:        s.resultSetForQuery(qe.Query),
+		})
+	}
+
+	return completed
+}
+
+// completeQuery writes the query results to S3 and marks the query SUCCEEDED,
+// or FAILED when the results cannot be written.
+func (s *MemoryStorage) completeQuery(cq completedQuery) {
+	var uploadErr error
+	if cq.outputLocation != "" {
+		ctx, cancel := context.WithTimeout(service.WithScope(context.Background(), "", cq.region), resultUploadTimeout)
+		uploadErr = s.uploadResults(ctx, cq.outputLocation, cq.results)
+
+		cancel()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	qe, ok := s.QueryExecutions[cq.id]
+	if !ok || qe.Status.State != QueryExecutionStateRunning {
+		return
+	}
+
+	now := time.Now()
+	qe.Status.CompletionDateTime = &now
+
+	if uploadErr != nil {
+		qe.Status.State = QueryExecutionStateFailed
+		qe.Status.StateChangeReason = uploadErr.Error()
+
+		return
+	}
+
+	qe.Status.State = QueryExecutionStateSucceeded
+	s.QueryResults[cq.id] = cq.results
+}
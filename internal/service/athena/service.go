@@ -2,23 +2,39 @@
 package athena
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/sivchari/kumo/internal/service"
 )
 
+const defaultBaseURL = "http://localhost:4566"
+
 // Compile-time check that Service implements io.Closer.
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"athena"}, func(dataDir string) []service.Service {
+		baseURL := defaultBaseURL
+
+		if port := os.Getenv("KUMO_PORT"); port != "" {
+			baseURL = fmt.Sprintf("http://localhost:%s", port)
+		}
+
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
+
+		if delay, err := time.ParseDuration(os.Getenv("KUMO_ATHENA_QUERY_TRANSITION_DELAY")); err == nil {
+			opts = append(opts, WithQueryTransitionDelay(delay))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(baseURL, opts...))}
+	})
 }
 
 // Service implements the Athena service.
@@ -40,9 +56,10 @@ func (s *Service) Name() string {
 
 // RegisterRoutes registers the Athena routes.
 // Note: Athena uses AWS JSON 1.1 protocol via the JSONProtocolService interface,
-// so no direct routes are registered here.
-func (s *Service) RegisterRoutes(_ service.Router) {
-	// No routes to register - Athena uses JSON protocol dispatcher
+// so only the kumo-specific endpoints are registered here.
+func (s *Service) RegisterRoutes(r service.Router) {
+	// kumo-specific endpoint for testing.
+	r.HandleFunc("POST", "/kumo/athena/query-results", s.PutStubbedQueryResults)
 }
 
 // TargetPrefix returns the X-Amz-Target header prefix for Athena.
@@ -63,3 +80,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
@@ -2,13 +2,19 @@ package athena
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/sivchari/kumo/internal/service"
 	"github.com/sivchari/kumo/internal/storage"
 )
 
@@ -17,6 +23,12 @@ const (
 	errInvalidRequestException = "InvalidRequestException"
 )
 
+// Default page sizes.
+const (
+	defaultQueryResultsMaxResults = 1000
+	defaultListMaxResults         = 50
+)
+
 // Storage defines the interface for Athena storage.
 type Storage interface {
 	StartQueryExecution(ctx context.Context, query string, workGroup string, context *QueryExecutionContext, resultConfig *ResultConfiguration, executionParams []string) (*QueryExecution, error)
@@ -25,7 +37,15 @@ type Storage interface {
 	GetQueryResults(ctx context.Context, queryExecutionID string, nextToken string, maxResults int32) (*ResultSet, string, error)
 	ListQueryExecutions(ctx context.Context, workGroup string, nextToken string, maxResults int32) ([]string, string, error)
 	CreateWorkGroup(ctx context.Context, name string, configuration *WorkGroupConfiguration, description string, tags []Tag) error
+	GetWorkGroup(ctx context.Context, name string) (*WorkGroup, error)
+	ListWorkGroups(ctx context.Context, nextToken string, maxResults int32) ([]*WorkGroup, string, error)
+	CreateNamedQuery(ctx context.Context, req *CreateNamedQueryRequest) (*NamedQuery, error)
+	GetNamedQuery(ctx context.Context, namedQueryID string) (*NamedQuery, error)
+	ListNamedQueries(ctx context.Context, workGroup, nextToken string, maxResults int32) ([]string, string, error)
+	DeleteNamedQuery(ctx context.Context, namedQueryID string) error
+	PutStubbedQueryResults(ctx context.Context, query string, results *ResultSet) error
 	DeleteWorkGroup(ctx context.Context, name string, recursiveDelete bool) error
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -50,23 +70,33 @@ type MemoryStorage struct {
 	QueryExecutions map[string]*QueryExecution `json:"queryExecutions"`
 	WorkGroups      map[string]*WorkGroup      `json:"workGroups"`
 	QueryResults    map[string]*ResultSet      `json:"queryResults"`
+	NamedQueries    map[string]*NamedQuery     `json:"namedQueries"`
+	StubbedResults  map[string]*ResultSet      `json:"stubbedResults"` // key: normalized query string
 	dataDir         string
+	baseURL         string
+	httpClient      *http.Client
+	transitionDelay time.Duration
+	transitions     map[string]time.Time // key: queryExecutionID -> time of last state change
+	stopScheduler   chan struct{}
 }
 
 // NewMemoryStorage creates a new in-memory storage.
-func NewMemoryStorage(opts ...Option) *MemoryStorage {
+// baseURL is the kumo endpoint used to write query results to S3.
+func NewMemoryStorage(baseURL string, opts ...Option) *MemoryStorage {
 	s := &MemoryStorage{
 		QueryExecutions: make(map[string]*QueryExecution),
 		WorkGroups:      make(map[string]*WorkGroup),
 		QueryResults:    make(map[string]*ResultSet),
+		NamedQueries:    make(map[string]*NamedQuery),
+		StubbedResults:  make(map[string]*ResultSet),
+		baseURL:         baseURL,
+		httpClient:      &http.Client{Timeout: resultUploadTimeout},
+		transitionDelay: defaultQueryTransitionDelay,
+		transitions:     make(map[string]time.Time),
+		stopScheduler:   make(chan struct{}),
 	}
 
-	// Create the default "primary" workgroup.
-	s.WorkGroups["primary"] = &WorkGroup{
-		Name:         "primary",
-		State:        WorkGroupStateEnabled,
-		CreationTime: time.Now(),
-	}
+	s.createPrimaryWorkGroup()
 
 	for _, o := range opts {
 		o(s)
@@ -76,6 +106,8 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		_ = storage.Load(s.dataDir, "athena", s)
 	}
 
+	go s.queryScheduler()
+
 	return s
 }
 
@@ -119,11 +151,21 @@ func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
 		s.QueryResults = make(map[string]*ResultSet)
 	}
 
+	if s.NamedQueries == nil {
+		s.NamedQueries = make(map[string]*NamedQuery)
+	}
+
+	if s.StubbedResults == nil {
+		s.StubbedResults = make(map[string]*ResultSet)
+	}
+
 	return nil
 }
 
 // Close saves the storage state to disk if persistence is enabled.
 func (s *MemoryStorage) Close() error {
+	close(s.stopScheduler)
+
 	if s.dataDir == "" {
 		return nil
 	}
@@ -135,8 +177,34 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
-// StartQueryExecution starts a new query execution.
-func (s *MemoryStorage) StartQueryExecution(_ context.Context, query, workGroup string, execContext *QueryExecutionContext, resultConfig *ResultConfiguration, executionParams []string) (*QueryExecution, error) {
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.QueryExecutions = make(map[string]*QueryExecution)
+	s.WorkGroups = make(map[string]*WorkGroup)
+	s.QueryResults = make(map[string]*ResultSet)
+	s.NamedQueries = make(map[string]*NamedQuery)
+	s.StubbedResults = make(map[string]*ResultSet)
+	s.transitions = make(map[string]time.Time)
+	s.createPrimaryWorkGroup()
+
+	return nil
+}
+
+// createPrimaryWorkGroup creates the default "primary" workgroup.
+func (s *MemoryStorage) createPrimaryWorkGroup() {
+	s.WorkGroups["primary"] = &WorkGroup{
+		Name:         "primary",
+		State:        WorkGroupStateEnabled,
+		CreationTime: time.Now(),
+	}
+}
+
+// StartQueryExecution queues a new query execution. The scheduler moves it
+// through RUNNING to SUCCEEDED and writes its results to the output location.
+func (s *MemoryStorage) StartQueryExecution(ctx context.Context, query, workGroup string, execContext *QueryExecutionContext, resultConfig *ResultConfiguration, executionParams []string) (*QueryExecution, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -145,16 +213,33 @@ func (s *MemoryStorage) StartQueryExecution(_ context.Context, query, workGroup
 	}
 
 	// Verify workgroup exists.
-	if _, ok := s.WorkGroups[workGroup]; !ok {
+	wg, ok := s.WorkGroups[workGroup]
+	if !ok {
 		return nil, &ServiceError{
 			Code:    errInvalidRequestException,
 			Message: fmt.Sprintf("WorkGroup %s is not found.", workGroup),
 		}
 	}
 
+	if wg.State == WorkGroupStateDisabled {
+		return nil, &ServiceError{
+			Code:    errInvalidRequestException,
+			Message: fmt.Sprintf("WorkGroup %s is disabled.", workGroup),
+		}
+	}
+
+	resultConfig = effectiveResultConfiguration(wg, resultConfig)
+
 	queryExecutionID := uuid.New().String()
 	now := time.Now()
 
+	// The reported output location is the results file rather than the configured prefix.
+	if resultConfig != nil && resultConfig.OutputLocation != "" {
+		cfg := *resultConfig
+		cfg.OutputLocation = strings.TrimSuffix(cfg.OutputLocation, "/") + "/" + queryExecutionID + ".csv"
+		resultConfig = &cfg
+	}
+
 	qe := &QueryExecution{
 		QueryExecutionID:      queryExecutionID,
 		Query:                 query,
@@ -162,9 +247,8 @@ func (s *MemoryStorage) StartQueryExecution(_ context.Context, query, workGroup
 		ResultConfiguration:   resultConfig,
 		QueryExecutionContext: execContext,
 		Status: &QueryExecutionStatus{
-			State:              QueryExecutionStateSucceeded,
+			State:              QueryExecutionStateQueued,
 			SubmissionDateTime: now,
-			CompletionDateTime: &now,
 		},
 		Statistics: &QueryExecutionStatistics{
 			EngineExecutionTimeInMillis:      100,
@@ -177,6 +261,7 @@ func (s *MemoryStorage) StartQueryExecution(_ context.Context, query, workGroup
 		},
 		WorkGroup:           workGroup,
 		ExecutionParameters: executionParams,
+		Region:              service.Region(ctx),
 		EngineVersion: &EngineVersion{
 			SelectedEngineVersion:  "AUTO",
 			EffectiveEngineVersion: "Athena engine version 3",
@@ -184,11 +269,28 @@ func (s *MemoryStorage) StartQueryExecution(_ context.Context, query, workGroup
 	}
 
 	s.QueryExecutions[queryExecutionID] = qe
-	s.QueryResults[queryExecutionID] = createMockResultSet()
+	s.transitions[queryExecutionID] = now
 
 	return qe, nil
 }
 
+// PutStubbedQueryResults registers the result set returned by queries with the given text.
+func (s *MemoryStorage) PutStubbedQueryResults(_ context.Context, query string, results *ResultSet) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if normalizeQuery(query) == "" {
+		return &ServiceError{
+			Code:    errInvalidRequestException,
+			Message: "QueryString is required.",
+		}
+	}
+
+	s.StubbedResults[normalizeQuery(query)] = results
+
+	return nil
+}
+
 func createMockResultSet() *ResultSet {
 	return &ResultSet{
 		Rows: []Row{
@@ -223,6 +325,8 @@ func (s *MemoryStorage) StopQueryExecution(_ context.Context, queryExecutionID s
 		qe.Status.State = QueryExecutionStateCancelled
 		qe.Status.StateChangeReason = "Query was cancelled by user."
 		qe.Status.CompletionDateTime = &now
+
+		delete(s.transitions, queryExecutionID)
 	}
 
 	return nil
@@ -241,11 +345,16 @@ func (s *MemoryStorage) GetQueryExecution(_ context.Context, queryExecutionID st
 		}
 	}
 
-	return qe, nil
+	// Return a copy since the scheduler keeps updating the status.
+	cp := *qe
+	status := *qe.Status
+	cp.Status = &status
+
+	return &cp, nil
 }
 
-// GetQueryResults retrieves results for a query execution.
-func (s *MemoryStorage) GetQueryResults(_ context.Context, queryExecutionID, _ string, _ int32) (*ResultSet, string, error) {
+// GetQueryResults retrieves a page of results for a query execution.
+func (s *MemoryStorage) GetQueryResults(_ context.Context, queryExecutionID, nextToken string, maxResults int32) (*ResultSet, string, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -273,7 +382,16 @@ func (s *MemoryStorage) GetQueryResults(_ context.Context, queryExecutionID, _ s
 		}, "", nil
 	}
 
-	return rs, "", nil
+	if maxResults <= 0 {
+		maxResults = defaultQueryResultsMaxResults
+	}
+
+	rows, next := paginate(rs.Rows, nextToken, maxResults)
+
+	return &ResultSet{
+		Rows:              rows,
+		ResultSetMetadata: rs.ResultSetMetadata,
+	}, next, nil
 }
 
 // ListQueryExecutions lists query execution IDs.
@@ -358,12 +476,19 @@ func (s *MemoryStorage) DeleteWorkGroup(_ context.Context, name string, recursiv
 		}
 	}
 
-	// Delete query executions if recursive delete.
+	// Delete query executions and named queries if recursive delete.
 	if recursiveDelete {
 		for id, qe := range s.QueryExecutions {
 			if qe.WorkGroup == name {
 				delete(s.QueryExecutions, id)
 				delete(s.QueryResults, id)
+				delete(s.transitions, id)
+			}
+		}
+
+		for id, nq := range s.NamedQueries {
+			if nq.WorkGroup == name {
+				delete(s.NamedQueries, id)
 			}
 		}
 	}
@@ -372,3 +497,190 @@ func (s *MemoryStorage) DeleteWorkGroup(_ context.Context, name string, recursiv
 
 	return nil
 }
+
+// effectiveResultConfiguration returns the result configuration a query runs with.
+// The workgroup output location applies when the request has none, or always
+// when the workgroup enforces its configuration.
+func effectiveResultConfiguration(wg *WorkGroup, requested *ResultConfiguration) *ResultConfiguration {
+	if wg.Configuration == nil || wg.Configuration.ResultConfiguration == nil {
+		return requested
+	}
+
+	if wg.Configuration.EnforceWorkGroupConfiguration || requested == nil || requested.OutputLocation == "" {
+		return wg.Configuration.ResultConfiguration
+	}
+
+	return requested
+}
+
+// GetWorkGroup retrieves a workgroup by name.
+func (s *MemoryStorage) GetWorkGroup(_ context.Context, name string) (*WorkGroup, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	wg, ok := s.WorkGroups[name]
+	if !ok {
+		return nil, &ServiceError{
+			Code:    errInvalidRequestException,
+			Message: fmt.Sprintf("WorkGroup %s is not found.", name),
+		}
+	}
+
+	return wg, nil
+}
+
+// ListWorkGroups lists workgroups ordered by name.
+func (s *MemoryStorage) ListWorkGroups(_ context.Context, nextToken string, maxResults int32) ([]*WorkGroup, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if maxResults <= 0 {
+		maxResults = defaultListMaxResults
+	}
+
+	workGroups := make([]*WorkGroup, 0, len(s.WorkGroups))
+	for _, wg := range s.WorkGroups {
+		workGroups = append(workGroups, wg)
+	}
+
+	slices.SortFunc(workGroups, func(a, b *WorkGroup) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	page, next := paginate(workGroups, nextToken, maxResults)
+
+	return page, next, nil
+}
+
+// CreateNamedQuery saves a query in a workgroup.
+func (s *MemoryStorage) CreateNamedQuery(_ context.Context, req *CreateNamedQueryRequest) (*NamedQuery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workGroup := req.WorkGroup
+	if workGroup == "" {
+		workGroup = "primary"
+	}
+
+	if _, ok := s.WorkGroups[workGroup]; !ok {
+		return nil, &ServiceError{
+			Code:    errInvalidRequestException,
+			Message: fmt.Sprintf("WorkGroup %s is not found.", workGroup),
+		}
+	}
+
+	nq := &NamedQuery{
+		NamedQueryID: uuid.New().String(),
+		Name:         req.Name,
+		Description:  req.Description,
+		Database:     req.Database,
+		QueryString:  req.QueryString,
+		WorkGroup:    workGroup,
+	}
+
+	s.NamedQueries[nq.NamedQueryID] = nq
+
+	return nq, nil
+}
+
+// GetNamedQuery retrieves a named query by ID.
+func (s *MemoryStorage) GetNamedQuery(_ context.Context, namedQueryID string) (*NamedQuery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	nq, ok := s.NamedQueries[namedQueryID]
+	if !ok {
+		return nil, &ServiceError{
+			Code:    errInvalidRequestException,
+			Message: fmt.Sprintf("NamedQuery %s is not found.", namedQueryID),
+		}
+	}
+
+	return nq, nil
+}
+
+// ListNamedQueries lists the IDs of named queries in a workgroup, ordered by name.
+func (s *MemoryStorage) ListNamedQueries(_ context.Context, workGroup, nextToken string, maxResults int32) ([]string, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if workGroup == "" {
+		workGroup = "primary"
+	}
+
+	if _, ok := s.WorkGroups[workGroup]; !ok {
+		return nil, "", &ServiceError{
+			Code:    errInvalidRequestException,
+			Message: fmt.Sprintf("WorkGroup %s is not found.", workGroup),
+		}
+	}
+
+	if maxResults <= 0 {
+		maxResults = defaultListMaxResults
+	}
+
+	queries := make([]*NamedQuery, 0)
+
+	for _, nq := range s.NamedQueries {
+		if nq.WorkGroup == workGroup {
+			queries = append(queries, nq)
+		}
+	}
+
+	slices.SortFunc(queries, func(a, b *NamedQuery) int {
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.NamedQueryID, b.NamedQueryID)
+	})
+
+	page, next := paginate(queries, nextToken, maxResults)
+
+	ids := make([]string, 0, len(page))
+	for _, nq := range page {
+		ids = append(ids, nq.NamedQueryID)
+	}
+
+	return ids, next, nil
+}
+
+// DeleteNamedQuery deletes a named query.
+func (s *MemoryStorage) DeleteNamedQuery(_ context.Context, namedQueryID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.NamedQueries[namedQueryID]; !ok {
+		return &ServiceError{
+			Code:    errInvalidRequestException,
+			Message: fmt.Sprintf("NamedQuery %s is not found.", namedQueryID),
+		}
+	}
+
+	delete(s.NamedQueries, namedQueryID)
+
+	return nil
+}
+
+// paginate returns the page of items starting at the offset encoded in nextToken,
+// along with the token for the following page.
+func paginate[T any](items []T, nextToken string, maxResults int32) ([]T, string) {
+	start := 0
+
+	if nextToken != "" {
+		if decoded, err := base64.StdEncoding.DecodeString(nextToken); err == nil {
+			if idx, err := strconv.Atoi(string(decoded)); err == nil && idx >= 0 && idx <= len(items) {
+				start = idx
+			}
+		}
+	}
+
+	end := min(start+int(maxResults), len(items))
+
+	var next string
+	if end < len(items) {
+		next = base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(end)))
+	}
+
+	return items[start:end], next
+}
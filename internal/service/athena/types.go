@@ -38,6 +38,8 @@ type QueryExecution struct {
 	EngineVersion         *EngineVersion
 	ExecutionParameters   []string
 	SubstatementType      string
+	// Region is the region the query was started in, where its results are written.
+	Region string
 }
 
 // ResultConfiguration represents the result configuration.
@@ -124,6 +126,16 @@ type WorkGroup struct {
 	IdentityCenterApplicationArn string
 }
 
+// NamedQuery represents a saved query.
+type NamedQuery struct {
+	NamedQueryID string
+	Name         string
+	Description  string
+	Database     string
+	QueryString  string
+	WorkGroup    string
+}
+
 // WorkGroupConfiguration represents workgroup configuration.
 type WorkGroupConfiguration struct {
 	ResultConfiguration                     *ResultConfiguration
@@ -392,6 +404,126 @@ type DeleteWorkGroupRequest struct {
 // DeleteWorkGroupResponse is the response for DeleteWorkGroup.
 type DeleteWorkGroupResponse struct{}
 
+// GetWorkGroupRequest is the request for GetWorkGroup.
+type GetWorkGroupRequest struct {
+	WorkGroup string `json:"WorkGroup"`
+}
+
+// GetWorkGroupResponse is the response for GetWorkGroup.
+type GetWorkGroupResponse struct {
+	WorkGroup *WorkGroupOutput `json:"WorkGroup"`
+}
+
+// WorkGroupOutput represents a workgroup in API response.
+type WorkGroupOutput struct {
+	Name          string                        `json:"Name"`
+	State         string                        `json:"State"`
+	Configuration *WorkGroupConfigurationOutput `json:"Configuration,omitempty"`
+	Description   string                        `json:"Description,omitempty"`
+	CreationTime  float64                       `json:"CreationTime"`
+}
+
+// WorkGroupConfigurationOutput represents workgroup configuration in API response.
+type WorkGroupConfigurationOutput struct {
+	ResultConfiguration             *ResultConfigurationOutput `json:"ResultConfiguration,omitempty"`
+	EnforceWorkGroupConfiguration   bool                       `json:"EnforceWorkGroupConfiguration"`
+	PublishCloudWatchMetricsEnabled bool                       `json:"PublishCloudWatchMetricsEnabled"`
+	BytesScannedCutoffPerQuery      int64                      `json:"BytesScannedCutoffPerQuery,omitempty"`
+	RequesterPaysEnabled            bool                       `json:"RequesterPaysEnabled"`
+	EngineVersion                   *EngineVersionOutput       `json:"EngineVersion,omitempty"`
+}
+
+// ListWorkGroupsRequest is the request for ListWorkGroups.
+type ListWorkGroupsRequest struct {
+	NextToken  string `json:"NextToken,omitempty"`
+	MaxResults int32  `json:"MaxResults,omitempty"`
+}
+
+// ListWorkGroupsResponse is the response for ListWorkGroups.
+type ListWorkGroupsResponse struct {
+	WorkGroups []WorkGroupSummary `json:"WorkGroups"`
+	NextToken  string             `json:"NextToken,omitempty"`
+}
+
+// WorkGroupSummary represents a workgroup in ListWorkGroups response.
+type WorkGroupSummary struct {
+	Name          string               `json:"Name"`
+	State         string               `json:"State"`
+	Description   string               `json:"Description,omitempty"`
+	CreationTime  float64              `json:"CreationTime"`
+	EngineVersion *EngineVersionOutput `json:"EngineVersion,omitempty"`
+}
+
+// CreateNamedQueryRequest is the request for CreateNamedQuery.
+type CreateNamedQueryRequest struct {
+	Name               string `json:"Name"`
+	Description        string `json:"Description,omitempty"`
+	Database           string `json:"Database"`
+	QueryString        string `json:"QueryString"`
+	ClientRequestToken string `json:"ClientRequestToken,omitempty"`
+	WorkGroup          string `json:"WorkGroup,omitempty"`
+}
+
+// CreateNamedQueryResponse is the response for CreateNamedQuery.
+type CreateNamedQueryResponse struct {
+	NamedQueryID string `json:"NamedQueryId"`
+}
+
+// GetNamedQueryRequest is the request for GetNamedQuery.
+type GetNamedQueryRequest struct {
+	NamedQueryID string `json:"NamedQueryId"`
+}
+
+// GetNamedQueryResponse is the response for GetNamedQuery.
+type GetNamedQueryResponse struct {
+	NamedQuery *NamedQueryOutput `json:"NamedQuery"`
+}
+
+// NamedQueryOutput represents a named query in API response.
+type NamedQueryOutput struct {
+	NamedQueryID string `json:"NamedQueryId"`
+	Name         string `json:"Name"`
+	Description  string `json:"Description,omitempty"`
+	Database     string `json:"Database"`
+	QueryString  string `json:"QueryString"`
+	WorkGroup    string `json:"WorkGroup,omitempty"`
+}
+
+// ListNamedQueriesRequest is the request for ListNamedQueries.
+type ListNamedQueriesRequest struct {
+	NextToken  string `json:"NextToken,omitempty"`
+	MaxResults int32  `json:"MaxResults,omitempty"`
+	WorkGroup  string `json:"WorkGroup,omitempty"`
+}
+
+// ListNamedQueriesResponse is the response for ListNamedQueries.
+type ListNamedQueriesResponse struct {
+	NamedQueryIDs []string `json:"NamedQueryIds"`
+	NextToken     string   `json:"NextToken,omitempty"`
+}
+
+// DeleteNamedQueryRequest is the request for DeleteNamedQuery.
+type DeleteNamedQueryRequest struct {
+	NamedQueryID string `json:"NamedQueryId"`
+}
+
+// DeleteNamedQueryResponse is the response for DeleteNamedQuery.
+type DeleteNamedQueryResponse struct{}
+
+// PutStubbedQueryResultsRequest is the request for the kumo-specific endpoint
+// that registers the results returned for a query string.
+type PutStubbedQueryResultsRequest struct {
+	QueryString string          `json:"QueryString"`
+	Columns     []StubbedColumn `json:"Columns"`
+	Rows        [][]string      `json:"Rows"`
+}
+
+// StubbedColumn describes a column of stubbed query results.
+type StubbedColumn struct {
+	Name string `json:"Name"`
+	Type string `json:"Type,omitempty"`
+}
+
 // ErrorResponse represents an Athena error response.
 type ErrorResponse struct {
 	Type    string `json:"__type"`
@@ -1,6 +1,7 @@
 package backup
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sync"
@@ -27,6 +28,7 @@ type Storage interface {
 	GetSelection(planID, selectionID string) (*Selection, error)
 	ListSelections(planID string) []SelectionListMember
 	DeleteSelection(planID, selectionID string) error
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -128,6 +130,18 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Vaults = make(map[string]*Vault)
+	m.Plans = make(map[string]*Plan)
+	m.Selections = make(map[string]map[string]*Selection)
+
+	return nil
+}
+
 func epochNow() float64 {
 	return float64(time.Now().Unix())
 }
@@ -0,0 +1,117 @@
+package batch
+
+import (
+	"fmt"
+)
+
+// Array job size limits.
+const (
+	minArraySize = 2
+	maxArraySize = 10000
+)
+
+// isArrayParent reports whether the job is the parent of an array job.
+func isArrayParent(job *Job) bool {
+	return job.ArrayProperties != nil && job.ArrayProperties.Size > 0
+}
+
+// arrayChildID returns the ID of the child job at index within an array job.
+func arrayChildID(parentID string, index int32) string {
+	return fmt.Sprintf("%s:%d", parentID, index)
+}
+
+// newArrayChildren creates the child jobs of an array job. Each child inherits
+// the parent's dependencies; N_TO_N dependencies point at the child with the
+// same index in the referenced array job, and SEQUENTIAL dependencies make each
+// child wait for the previous one.
+func newArrayChildren(parent *Job) []*Job {
+	children := make([]*Job, parent.ArrayProperties.Size)
+
+	for i := range parent.ArrayProperties.Size {
+		childID := arrayChildID(parent.JobID, i)
+
+		var deps []JobDependency
+
+		for _, dep := range parent.DependsOn {
+			switch dep.Type {
+			case ArrayJobDependencyNToN:
+				deps = append(deps, JobDependency{JobID: arrayChildID(dep.JobID, i), Type: dep.Type})
+			case ArrayJobDependencySequential:
+				if i > 0 {
+					deps = append(deps, JobDependency{JobID: arrayChildID(parent.JobID, i-1), Type: dep.Type})
+				}
+			default:
+				deps = append(deps, dep)
+			}
+		}
+
+		children[i] = &Job{
+			ArrayProperties:    &ArrayPropertiesDetail{Index: &i},
+			CreatedAt:          parent.CreatedAt,
+			DependsOn:          deps,
+			JobARN:             arrayChildID(parent.JobARN, i),
+			JobDefinition:      parent.JobDefinition,
+			JobID:              childID,
+			JobName:            parent.JobName,
+			JobQueue:           parent.JobQueue,
+			Parameters:         parent.Parameters,
+			PropagateTags:      parent.PropagateTags,
+			RetryStrategy:      parent.RetryStrategy,
+			SchedulingPriority: parent.SchedulingPriority,
+			ShareIdentifier:    parent.ShareIdentifier,
+			Status:             JobStatusSubmitted,
+			Tags:               parent.Tags,
+			Timeout:            parent.Timeout,
+		}
+	}
+
+	return children
+}
+
+// arrayStatusSummary counts the children of an array job by status.
+func (s *MemoryStorage) arrayStatusSummary(parent *Job) map[string]int32 {
+	summary := map[string]int32{
+		JobStatusSubmitted: 0,
+		JobStatusPending:   0,
+		JobStatusRunnable:  0,
+		JobStatusStarting:  0,
+		JobStatusRunning:   0,
+		JobStatusSucceeded: 0,
+		JobStatusFailed:    0,
+	}
+
+	for i := range parent.ArrayProperties.Size {
+		if child, ok := s.Jobs[arrayChildID(parent.JobID, i)]; ok {
+			summary[child.Status]++
+		}
+	}
+
+	return summary
+}
+
+// jobWithArrayStatus returns a copy of the job, with the status summary filled in for array parents.
+func (s *MemoryStorage) jobWithArrayStatus(job *Job) Job {
+	out := *job
+
+	if isArrayParent(job) {
+		props := *job.ArrayProperties
+		props.StatusSummary = s.arrayStatusSummary(job)
+		out.ArrayProperties = &props
+	}
+
+	return out
+}
+
+// forEachJobInArray calls fn for the children of an array job and then for the
+// job itself. For other jobs fn is called only for the job.
+func (s *MemoryStorage) forEachJobInArray(job *Job, fn func(*Job)) {
+	if isArrayParent(job) {
+		for i := range job.ArrayProperties.Size {
+			if child, ok := s.Jobs[arrayChildID(job.JobID, i)]; ok {
+				fn(child)
+			}
+		}
+	}
+
+	fn(job)
+}
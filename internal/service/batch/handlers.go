@@ -100,6 +100,34 @@ func (s *Service) DescribeComputeEnvironments(w http.ResponseWriter, r *http.Req
 	})
 }
 
+// UpdateComputeEnvironment handles the UpdateComputeEnvironment operation.
+func (s *Service) UpdateComputeEnvironment(w http.ResponseWriter, r *http.Request) {
+	var req UpdateComputeEnvironmentInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.ComputeEnvironment == "" {
+		writeError(w, errInvalidRequest, "computeEnvironment is required", http.StatusBadRequest)
+
+		return
+	}
+
+	ce, err := s.storage.UpdateComputeEnvironment(r.Context(), &req)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, UpdateComputeEnvironmentOutput{
+		ComputeEnvironmentARN:  ce.ComputeEnvironmentARN,
+		ComputeEnvironmentName: ce.ComputeEnvironmentName,
+	})
+}
+
 // CreateJobQueue handles the CreateJobQueue operation.
 func (s *Service) CreateJobQueue(w http.ResponseWriter, r *http.Request) {
 	var req CreateJobQueueInput
@@ -187,6 +215,137 @@ func (s *Service) DescribeJobQueues(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// UpdateJobQueue handles the UpdateJobQueue operation.
+func (s *Service) UpdateJobQueue(w http.ResponseWriter, r *http.Request) {
+	var req UpdateJobQueueInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.JobQueue == "" {
+		writeError(w, errInvalidRequest, "jobQueue is required", http.StatusBadRequest)
+
+		return
+	}
+
+	jq, err := s.storage.UpdateJobQueue(r.Context(), &req)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, UpdateJobQueueOutput{
+		JobQueueARN:  jq.JobQueueARN,
+		JobQueueName: jq.JobQueueName,
+	})
+}
+
+// CreateSchedulingPolicy handles the CreateSchedulingPolicy operation.
+func (s *Service) CreateSchedulingPolicy(w http.ResponseWriter, r *http.Request) {
+	var req CreateSchedulingPolicyInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.Name == "" {
+		writeError(w, errInvalidRequest, "name is required", http.StatusBadRequest)
+
+		return
+	}
+
+	policy, err := s.storage.CreateSchedulingPolicy(r.Context(), &req)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, CreateSchedulingPolicyOutput{
+		ARN:  policy.ARN,
+		Name: policy.Name,
+	})
+}
+
+// DescribeSchedulingPolicies handles the DescribeSchedulingPolicies operation.
+func (s *Service) DescribeSchedulingPolicies(w http.ResponseWriter, r *http.Request) {
+	var req DescribeSchedulingPoliciesInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if len(req.ARNs) == 0 {
+		writeError(w, errInvalidRequest, "arns is required", http.StatusBadRequest)
+
+		return
+	}
+
+	policies, err := s.storage.DescribeSchedulingPolicies(r.Context(), req.ARNs)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, DescribeSchedulingPoliciesOutput{
+		SchedulingPolicies: policies,
+	})
+}
+
+// UpdateSchedulingPolicy handles the UpdateSchedulingPolicy operation.
+func (s *Service) UpdateSchedulingPolicy(w http.ResponseWriter, r *http.Request) {
+	var req UpdateSchedulingPolicyInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.ARN == "" {
+		writeError(w, errInvalidRequest, "arn is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.UpdateSchedulingPolicy(r.Context(), &req); err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, struct{}{})
+}
+
+// DeleteSchedulingPolicy handles the DeleteSchedulingPolicy operation.
+func (s *Service) DeleteSchedulingPolicy(w http.ResponseWriter, r *http.Request) {
+	var req DeleteSchedulingPolicyInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.ARN == "" {
+		writeError(w, errInvalidRequest, "arn is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.DeleteSchedulingPolicy(r.Context(), req.ARN); err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, struct{}{})
+}
+
 // RegisterJobDefinition handles the RegisterJobDefinition operation.
 func (s *Service) RegisterJobDefinition(w http.ResponseWriter, r *http.Request) {
 	var req RegisterJobDefinitionInput
@@ -222,6 +381,52 @@ func (s *Service) RegisterJobDefinition(w http.ResponseWriter, r *http.Request)
 	})
 }
 
+// DescribeJobDefinitions handles the DescribeJobDefinitions operation.
+func (s *Service) DescribeJobDefinitions(w http.ResponseWriter, r *http.Request) {
+	var req DescribeJobDefinitionsInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	jds, nextToken, err := s.storage.DescribeJobDefinitions(r.Context(), &req)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, DescribeJobDefinitionsOutput{
+		JobDefinitions: jds,
+		NextToken:      nextToken,
+	})
+}
+
+// DeregisterJobDefinition handles the DeregisterJobDefinition operation.
+func (s *Service) DeregisterJobDefinition(w http.ResponseWriter, r *http.Request) {
+	var req DeregisterJobDefinitionInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.JobDefinition == "" {
+		writeError(w, errInvalidRequest, "jobDefinition is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.DeregisterJobDefinition(r.Context(), req.JobDefinition); err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, struct{}{})
+}
+
 // SubmitJob handles the SubmitJob operation.
 func (s *Service) SubmitJob(w http.ResponseWriter, r *http.Request) {
 	var req SubmitJobInput
@@ -290,6 +495,58 @@ func (s *Service) DescribeJobs(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// ListJobs handles the ListJobs operation.
+func (s *Service) ListJobs(w http.ResponseWriter, r *http.Request) {
+	var req ListJobsInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	summaries, nextToken, err := s.storage.ListJobs(r.Context(), &req)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, ListJobsOutput{
+		JobSummaryList: summaries,
+		NextToken:      nextToken,
+	})
+}
+
+// CancelJob handles the CancelJob operation.
+func (s *Service) CancelJob(w http.ResponseWriter, r *http.Request) {
+	var req CancelJobInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.JobID == "" {
+		writeError(w, errInvalidRequest, "jobId is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.Reason == "" {
+		writeError(w, errInvalidRequest, "reason is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.CancelJob(r.Context(), req.JobID, req.Reason); err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, struct{}{})
+}
+
 // TerminateJob handles the TerminateJob operation.
 func (s *Service) TerminateJob(w http.ResponseWriter, r *http.Request) {
 	var req TerminateJobInput
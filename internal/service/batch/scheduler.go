@@ -0,0 +1,303 @@
+package batch
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status reasons of VALID compute environments and job queues.
+const (
+	computeEnvironmentHealthyReason = "ComputeEnvironment Healthy"
+	jobQueueHealthyReason           = "JobQueue Healthy"
+)
+
+// defaultJobTransitionDelay is how long a job, compute environment or job queue stays in each status before the scheduler advances it.
+const defaultJobTransitionDelay = 500 * time.Millisecond
+
+// WithJobTransitionDelay sets how long a job, compute environment or job queue stays in each status before the scheduler advances it.
+func WithJobTransitionDelay(d time.Duration) Option {
+	return func(s *MemoryStorage) {
+		s.transitionDelay = d
+	}
+}
+
+// jobScheduler periodically advances compute environments, job queues and jobs through their lifecycle.
+func (s *MemoryStorage) jobScheduler() {
+	ticker := time.NewTicker(max(s.transitionDelay/5, 10*time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopScheduler:
+			return
+		case now := <-ticker.C:
+			s.advanceComputeEnvironments(now)
+			s.advanceJobQueues(now)
+			s.advanceJobs(now)
+		}
+	}
+}
+
+// advanceComputeEnvironments makes the compute environments that have been
+// CREATING or UPDATING for the transition delay VALID, and the ones that have
+// been DELETING as long DELETED. DELETED compute environments are removed
+// after another transition delay.
+func (s *MemoryStorage) advanceComputeEnvironments(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name, ce := range s.ComputeEnvironments {
+		if ce.Status == CEStatusValid || ce.Status == CEStatusInvalid {
+			delete(s.ceTransitions, name)
+
+			continue
+		}
+
+		if !s.transitionDue(s.ceTransitions, name, now) {
+			continue
+		}
+
+		switch ce.Status {
+		case CEStatusCreating, CEStatusUpdating:
+			s.setComputeEnvironmentStatus(ce, CEStatusValid, computeEnvironmentHealthyReason, now)
+		case CEStatusDeleting:
+			s.setComputeEnvironmentStatus(ce, CEStatusDeleted, "", now)
+		case CEStatusDeleted:
+			delete(s.ComputeEnvironments, name)
+			delete(s.ceTransitions, name)
+		}
+	}
+}
+
+// setComputeEnvironmentStatus records a compute environment status change. Must be called under lock.
+func (s *MemoryStorage) setComputeEnvironmentStatus(ce *ComputeEnvironment, status, reason string, now time.Time) {
+	ce.Status = status
+	ce.StatusReason = reason
+	s.ceTransitions[ce.ComputeEnvironmentName] = now
+}
+
+// advanceJobQueues makes the job queues that have been CREATING or UPDATING
+// for the transition delay VALID, and the ones that have been DELETING as
+// long DELETED. DELETED job queues are removed after another transition delay.
+func (s *MemoryStorage) advanceJobQueues(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name, jq := range s.JobQueues {
+		if jq.Status == JQStatusValid || jq.Status == JQStatusInvalid {
+			delete(s.jqTransitions, name)
+
+			continue
+		}
+
+		if !s.transitionDue(s.jqTransitions, name, now) {
+			continue
+		}
+
+		switch jq.Status {
+		case JQStatusCreating, JQStatusUpdating:
+			s.setJobQueueStatus(jq, JQStatusValid, jobQueueHealthyReason, now)
+		case JQStatusDeleting:
+			s.setJobQueueStatus(jq, JQStatusDeleted, "", now)
+		case JQStatusDeleted:
+			delete(s.JobQueues, name)
+			delete(s.jqTransitions, name)
+		}
+	}
+}
+
+// setJobQueueStatus records a job queue status change. Must be called under lock.
+func (s *MemoryStorage) setJobQueueStatus(jq *JobQueue, status, reason string, now time.Time) {
+	jq.Status = status
+	jq.StatusReason = reason
+	s.jqTransitions[jq.JobQueueName] = now
+}
+
+// transitionDue reports whether the resource with the given key has spent the
+// transition delay in its current status. Must be called under lock.
+func (s *MemoryStorage) transitionDue(transitions map[string]time.Time, key string, now time.Time) bool {
+	changed, ok := transitions[key]
+	if !ok {
+		// Resources restored from disk start their timer on the first tick.
+		transitions[key] = now
+
+		return false
+	}
+
+	return now.Sub(changed) >= s.transitionDelay
+}
+
+// advanceJobs moves every job that has spent the transition delay in its
+// current status on to the next one.
+func (s *MemoryStorage) advanceJobs(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, job := range s.Jobs {
+		if isFinalJobStatus(job.Status) {
+			delete(s.transitions, id)
+
+			continue
+		}
+
+		if !s.transitionDue(s.transitions, id, now) {
+			continue
+		}
+
+		if next, reason := s.nextJobStatus(job); next != job.Status {
+			s.setJobStatus(job, next, reason, now)
+		}
+	}
+}
+
+// nextJobStatus returns the status a job moves to on its next transition and
+// the reason for it, if any. Jobs stay PENDING until their queue can schedule
+// them and every job they depend on has succeeded; they fail if a dependency fails.
+func (s *MemoryStorage) nextJobStatus(job *Job) (string, string) {
+	switch job.Status {
+	case JobStatusSubmitted:
+		return JobStatusPending, ""
+	case JobStatusPending:
+		if isArrayParent(job) {
+			return s.arrayParentStatus(job)
+		}
+
+		switch s.dependenciesStatus(job) {
+		case JobStatusFailed:
+			return JobStatusFailed, "Dependent Job failed"
+		case JobStatusSucceeded:
+			if s.jobQueueSchedulable(job.JobQueue) {
+				return JobStatusRunnable, ""
+			}
+		}
+
+		return JobStatusPending, ""
+	case JobStatusRunnable:
+		return JobStatusStarting, ""
+	case JobStatusStarting:
+		return JobStatusRunning, ""
+	case JobStatusRunning:
+		return JobStatusSucceeded, "Essential container in task exited"
+	default:
+		return job.Status, ""
+	}
+}
+
+// arrayParentStatus returns the status of an array job parent, which stays
+// PENDING until all of its children finish.
+func (s *MemoryStorage) arrayParentStatus(job *Job) (string, string) {
+	summary := s.arrayStatusSummary(job)
+
+	switch {
+	case summary[JobStatusSucceeded]+summary[JobStatusFailed] < job.ArrayProperties.Size:
+		return JobStatusPending, ""
+	case summary[JobStatusFailed] > 0:
+		return JobStatusFailed, "Array Child Job failed"
+	default:
+		return JobStatusSucceeded, ""
+	}
+}
+
+// dependenciesStatus summarizes the jobs the given job depends on: FAILED if
+// any of them failed, SUCCEEDED once all of them succeeded, and PENDING otherwise.
+func (s *MemoryStorage) dependenciesStatus(job *Job) string {
+	status := JobStatusSucceeded
+
+	for _, dep := range job.DependsOn {
+		if dep.JobID == "" {
+			continue
+		}
+
+		parent, ok := s.Jobs[dep.JobID]
+		if !ok {
+			continue
+		}
+
+		switch parent.Status {
+		case JobStatusFailed:
+			return JobStatusFailed
+		case JobStatusSucceeded:
+		default:
+			status = JobStatusPending
+		}
+	}
+
+	return status
+}
+
+// setJobStatus records a status change along with the timestamps and attempt
+// details that accompany it.
+func (s *MemoryStorage) setJobStatus(job *Job, status, reason string, now time.Time) {
+	job.Status = status
+	s.transitions[job.JobID] = now
+
+	if reason != "" {
+		job.StatusReason = reason
+	}
+
+	switch status {
+	case JobStatusRunning:
+		job.StartedAt = now.UnixMilli()
+	case JobStatusFailed:
+		job.StoppedAt = now.UnixMilli()
+
+		// A job stopped while its container was running records the interrupted attempt.
+		if job.StartedAt != 0 && !isArrayParent(job) {
+			job.Attempts = append(job.Attempts, newJobAttempt(job, 137))
+		}
+	case JobStatusSucceeded:
+		job.StoppedAt = now.UnixMilli()
+
+		// Array parents have no container of their own; their children carry the attempts.
+		if !isArrayParent(job) {
+			job.Attempts = append(job.Attempts, newJobAttempt(job, 0))
+		}
+	}
+}
+
+// newJobAttempt describes the attempt that just stopped with the given container exit code.
+func newJobAttempt(job *Job, exitCode int32) AttemptDetail {
+	return AttemptDetail{
+		Container: &AttemptContainerDetail{
+			ExitCode:      exitCode,
+			LogStreamName: jobLogStreamName(job),
+			TaskARN:       "arn:aws:ecs:us-east-1:000000000000:task/" + uuid.New().String(),
+		},
+		StartedAt:    job.StartedAt,
+		StatusReason: job.StatusReason,
+		StoppedAt:    job.StoppedAt,
+	}
+}
+
+// jobQueueSchedulable reports whether the queue is enabled and valid and has
+// at least one enabled and valid compute environment.
+func (s *MemoryStorage) jobQueueSchedulable(queue string) bool {
+	jq, ok := s.JobQueues[extractResourceName(queue)]
+	if !ok || jq.State != JQStateEnabled || jq.Status != JQStatusValid {
+		return false
+	}
+
+	for _, order := range jq.ComputeEnvironmentOrder {
+		ce, ok := s.ComputeEnvironments[extractResourceName(order.ComputeEnvironment)]
+		if ok && ce.State == CEStateEnabled && ce.Status == CEStatusValid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isFinalJobStatus reports whether the job has finished.
+func isFinalJobStatus(status string) bool {
+	return status == JobStatusSucceeded || status == JobStatusFailed
+}
+
+// jobLogStreamName returns the CloudWatch Logs stream name for a job's container.
+func jobLogStreamName(job *Job) string {
+	name, _, _ := strings.Cut(extractResourceName(job.JobDefinition), ":")
+
+	return fmt.Sprintf("%s/default/%s", name, strings.ReplaceAll(uuid.New().String(), "-", ""))
+}
@@ -1,9 +1,11 @@
 package batch
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -12,12 +14,18 @@ import (
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"batch"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
+
+		if delay, err := time.ParseDuration(os.Getenv("KUMO_BATCH_JOB_TRANSITION_DELAY")); err == nil {
+			opts = append(opts, WithJobTransitionDelay(delay))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
 
 // Service implements the Batch service.
@@ -44,18 +52,30 @@ func (s *Service) RegisterRoutes(r service.Router) {
 	r.Handle("POST", "/v1/createcomputeenvironment", s.CreateComputeEnvironment)
 	r.Handle("POST", "/v1/deletecomputeenvironment", s.DeleteComputeEnvironment)
 	r.Handle("POST", "/v1/describecomputeenvironments", s.DescribeComputeEnvironments)
+	r.Handle("POST", "/v1/updatecomputeenvironment", s.UpdateComputeEnvironment)
 
 	// Job Queue operations
 	r.Handle("POST", "/v1/createjobqueue", s.CreateJobQueue)
 	r.Handle("POST", "/v1/deletejobqueue", s.DeleteJobQueue)
 	r.Handle("POST", "/v1/describejobqueues", s.DescribeJobQueues)
+	r.Handle("POST", "/v1/updatejobqueue", s.UpdateJobQueue)
+
+	// Scheduling Policy operations
+	r.Handle("POST", "/v1/createschedulingpolicy", s.CreateSchedulingPolicy)
+	r.Handle("POST", "/v1/describeschedulingpolicies", s.DescribeSchedulingPolicies)
+	r.Handle("POST", "/v1/updateschedulingpolicy", s.UpdateSchedulingPolicy)
+	r.Handle("POST", "/v1/deleteschedulingpolicy", s.DeleteSchedulingPolicy)
 
 	// Job Definition operations
 	r.Handle("POST", "/v1/registerjobdefinition", s.RegisterJobDefinition)
+	r.Handle("POST", "/v1/describejobdefinitions", s.DescribeJobDefinitions)
+	r.Handle("POST", "/v1/deregisterjobdefinition", s.DeregisterJobDefinition)
 
 	// Job operations
 	r.Handle("POST", "/v1/submitjob", s.SubmitJob)
 	r.Handle("POST", "/v1/describejobs", s.DescribeJobs)
+	r.Handle("POST", "/v1/listjobs", s.ListJobs)
+	r.Handle("POST", "/v1/canceljob", s.CancelJob)
 	r.Handle("POST", "/v1/terminatejob", s.TerminateJob)
 }
 
@@ -69,3 +89,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
@@ -1,10 +1,16 @@
 package batch
 
 import (
+	"cmp"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -18,18 +24,35 @@ const (
 	errConflict       = "ClientException"
 )
 
+// defaultListJobsMaxResults is the page size used by ListJobs when maxResults is not set.
+const defaultListJobsMaxResults = 100
+
+// defaultDescribeJobDefinitionsMaxResults is the page size used by DescribeJobDefinitions when maxResults is not set.
+const defaultDescribeJobDefinitionsMaxResults = 100
+
 // Storage defines the interface for Batch storage operations.
 type Storage interface {
 	CreateComputeEnvironment(ctx context.Context, input *CreateComputeEnvironmentInput) (*ComputeEnvironment, error)
 	DeleteComputeEnvironment(ctx context.Context, name string) error
 	DescribeComputeEnvironments(ctx context.Context, names []string) ([]ComputeEnvironment, error)
+	UpdateComputeEnvironment(ctx context.Context, input *UpdateComputeEnvironmentInput) (*ComputeEnvironment, error)
 	CreateJobQueue(ctx context.Context, input *CreateJobQueueInput) (*JobQueue, error)
 	DeleteJobQueue(ctx context.Context, name string) error
 	DescribeJobQueues(ctx context.Context, names []string) ([]JobQueue, error)
+	UpdateJobQueue(ctx context.Context, input *UpdateJobQueueInput) (*JobQueue, error)
+	CreateSchedulingPolicy(ctx context.Context, input *CreateSchedulingPolicyInput) (*SchedulingPolicy, error)
+	DescribeSchedulingPolicies(ctx context.Context, arns []string) ([]SchedulingPolicy, error)
+	UpdateSchedulingPolicy(ctx context.Context, input *UpdateSchedulingPolicyInput) error
+	DeleteSchedulingPolicy(ctx context.Context, arn string) error
 	RegisterJobDefinition(ctx context.Context, input *RegisterJobDefinitionInput) (*JobDefinition, error)
+	DescribeJobDefinitions(ctx context.Context, input *DescribeJobDefinitionsInput) ([]JobDefinition, string, error)
+	DeregisterJobDefinition(ctx context.Context, jobDefinition string) error
 	SubmitJob(ctx context.Context, input *SubmitJobInput) (*Job, error)
 	DescribeJobs(ctx context.Context, jobIDs []string) ([]Job, error)
+	ListJobs(ctx context.Context, input *ListJobsInput) ([]JobSummary, string, error)
+	CancelJob(ctx context.Context, jobID, reason string) error
 	TerminateJob(ctx context.Context, jobID, reason string) error
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -53,10 +76,16 @@ type MemoryStorage struct {
 	mu                  sync.RWMutex                   `json:"-"`
 	ComputeEnvironments map[string]*ComputeEnvironment `json:"computeEnvironments"` // key: name
 	JobQueues           map[string]*JobQueue           `json:"jobQueues"`           // key: name
+	SchedulingPolicies  map[string]*SchedulingPolicy   `json:"schedulingPolicies"`  // key: ARN
 	JobDefinitions      map[string]*JobDefinition      `json:"jobDefinitions"`      // key: name:revision
 	Jobs                map[string]*Job                `json:"jobs"`                // key: jobID
 	JobDefRevisions     map[string]int32               `json:"jobDefRevisions"`     // key: name -> latest revision
 	dataDir             string
+	transitionDelay     time.Duration
+	transitions         map[string]time.Time // key: jobID -> time of last status change
+	ceTransitions       map[string]time.Time // key: compute environment name -> time of last status change
+	jqTransitions       map[string]time.Time // key: job queue name -> time of last status change
+	stopScheduler       chan struct{}
 }
 
 // NewMemoryStorage creates a new in-memory storage.
@@ -64,9 +93,15 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 	s := &MemoryStorage{
 		ComputeEnvironments: make(map[string]*ComputeEnvironment),
 		JobQueues:           make(map[string]*JobQueue),
+		SchedulingPolicies:  make(map[string]*SchedulingPolicy),
 		JobDefinitions:      make(map[string]*JobDefinition),
 		Jobs:                make(map[string]*Job),
 		JobDefRevisions:     make(map[string]int32),
+		transitionDelay:     defaultJobTransitionDelay,
+		transitions:         make(map[string]time.Time),
+		ceTransitions:       make(map[string]time.Time),
+		jqTransitions:       make(map[string]time.Time),
+		stopScheduler:       make(chan struct{}),
 	}
 	for _, o := range opts {
 		o(s)
@@ -76,6 +111,8 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		_ = storage.Load(s.dataDir, "batch", s)
 	}
 
+	go s.jobScheduler()
+
 	return s
 }
 
@@ -115,6 +152,10 @@ func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
 		s.JobQueues = make(map[string]*JobQueue)
 	}
 
+	if s.SchedulingPolicies == nil {
+		s.SchedulingPolicies = make(map[string]*SchedulingPolicy)
+	}
+
 	if s.JobDefinitions == nil {
 		s.JobDefinitions = make(map[string]*JobDefinition)
 	}
@@ -130,8 +171,10 @@ func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Close saves the storage state to disk if persistence is enabled.
+// Close stops the job scheduler and saves the storage state to disk if persistence is enabled.
 func (s *MemoryStorage) Close() error {
+	close(s.stopScheduler)
+
 	if s.dataDir == "" {
 		return nil
 	}
@@ -143,7 +186,26 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
-// CreateComputeEnvironment creates a new compute environment.
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ComputeEnvironments = make(map[string]*ComputeEnvironment)
+	s.JobQueues = make(map[string]*JobQueue)
+	s.SchedulingPolicies = make(map[string]*SchedulingPolicy)
+	s.JobDefinitions = make(map[string]*JobDefinition)
+	s.Jobs = make(map[string]*Job)
+	s.JobDefRevisions = make(map[string]int32)
+	s.transitions = make(map[string]time.Time)
+	s.ceTransitions = make(map[string]time.Time)
+	s.jqTransitions = make(map[string]time.Time)
+
+	return nil
+}
+
+// CreateComputeEnvironment creates a new compute environment, which stays
+// CREATING for the transition delay before it becomes VALID.
 func (s *MemoryStorage) CreateComputeEnvironment(_ context.Context, input *CreateComputeEnvironmentInput) (*ComputeEnvironment, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -155,7 +217,7 @@ func (s *MemoryStorage) CreateComputeEnvironment(_ context.Context, input *Creat
 		}
 	}
 
-	if _, exists := s.ComputeEnvironments[input.ComputeEnvironmentName]; exists {
+	if ce, exists := s.ComputeEnvironments[input.ComputeEnvironmentName]; exists && ce.Status != CEStatusDeleted {
 		return nil, &Error{
 			Code:    errConflict,
 			Message: fmt.Sprintf("Compute environment %s already exists", input.ComputeEnvironmentName),
@@ -176,30 +238,39 @@ func (s *MemoryStorage) CreateComputeEnvironment(_ context.Context, input *Creat
 		EksConfiguration:       input.EksConfiguration,
 		ServiceRole:            input.ServiceRole,
 		State:                  state,
-		Status:                 CEStatusValid,
 		Type:                   input.Type,
 		Tags:                   input.Tags,
 		UUID:                   uuid.New().String(),
 	}
 
+	s.setComputeEnvironmentStatus(ce, CEStatusCreating, "", time.Now())
 	s.ComputeEnvironments[input.ComputeEnvironmentName] = ce
 
 	return ce, nil
 }
 
-// DeleteComputeEnvironment deletes a compute environment.
+// DeleteComputeEnvironment deletes a compute environment. It stays DELETING
+// for the transition delay, then DELETED for as long again before it is removed.
 func (s *MemoryStorage) DeleteComputeEnvironment(_ context.Context, name string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if _, exists := s.ComputeEnvironments[name]; !exists {
+	ce, exists := s.ComputeEnvironments[name]
+	if !exists || ce.Status == CEStatusDeleted {
 		return &Error{
 			Code:    errNotFound,
 			Message: fmt.Sprintf("Compute environment %s not found", name),
 		}
 	}
 
-	delete(s.ComputeEnvironments, name)
+	if ce.Status == CEStatusDeleting {
+		return &Error{
+			Code:    errConflict,
+			Message: fmt.Sprintf("Compute environment %s is already being deleted", name),
+		}
+	}
+
+	s.setComputeEnvironmentStatus(ce, CEStatusDeleting, "", time.Now())
 
 	return nil
 }
@@ -228,7 +299,152 @@ func (s *MemoryStorage) DescribeComputeEnvironments(_ context.Context, names []s
 	return result, nil
 }
 
-// CreateJobQueue creates a new job queue.
+// UpdateComputeEnvironment changes the state, compute resources, service role
+// or update policy of a compute environment, which stays UPDATING for the
+// transition delay before it becomes VALID again.
+func (s *MemoryStorage) UpdateComputeEnvironment(_ context.Context, input *UpdateComputeEnvironmentInput) (*ComputeEnvironment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := extractResourceName(input.ComputeEnvironment)
+
+	ce, exists := s.ComputeEnvironments[name]
+	if !exists || ce.Status == CEStatusDeleted {
+		return nil, &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("Compute environment %s not found", name),
+		}
+	}
+
+	if ce.Status != CEStatusValid && ce.Status != CEStatusInvalid {
+		return nil, &Error{
+			Code:    errConflict,
+			Message: fmt.Sprintf("Cannot update compute environment %s while it is %s", name, ce.Status),
+		}
+	}
+
+	if input.State != "" && input.State != CEStateEnabled && input.State != CEStateDisabled {
+		return nil, &Error{
+			Code:    errInvalidRequest,
+			Message: fmt.Sprintf("Invalid state %s, must be one of %s or %s", input.State, CEStateEnabled, CEStateDisabled),
+		}
+	}
+
+	resources := ce.ComputeResources
+
+	if input.ComputeResources != nil {
+		if ce.Type == CETypeUnmanaged {
+			return nil, &Error{
+				Code:    errInvalidRequest,
+				Message: "computeResources cannot be updated for an unmanaged compute environment",
+			}
+		}
+
+		resources = updateComputeResources(resources, input.ComputeResources)
+
+		if resources.MinvCpus > resources.MaxvCpus {
+			return nil, &Error{
+				Code:    errInvalidRequest,
+				Message: "minvCpus must not be greater than maxvCpus",
+			}
+		}
+	}
+
+	ce.ComputeResources = resources
+
+	if input.State != "" {
+		ce.State = input.State
+	}
+
+	if input.ServiceRole != "" {
+		ce.ServiceRole = input.ServiceRole
+	}
+
+	if input.UpdatePolicy != nil {
+		ce.UpdatePolicy = input.UpdatePolicy
+	}
+
+	s.setComputeEnvironmentStatus(ce, CEStatusUpdating, "", time.Now())
+
+	return ce, nil
+}
+
+// updateComputeResources returns a copy of the compute resources with the fields set in the update applied.
+func updateComputeResources(current *ComputeResource, update *ComputeResourceUpdate) *ComputeResource {
+	var resources ComputeResource
+	if current != nil {
+		resources = *current
+	}
+
+	if update.AllocationStrategy != "" {
+		resources.AllocationStrategy = update.AllocationStrategy
+	}
+
+	if update.BidPercentage != nil {
+		resources.BidPercentage = *update.BidPercentage
+	}
+
+	if update.DesiredvCpus != nil {
+		resources.DesiredvCpus = *update.DesiredvCpus
+	}
+
+	if update.Ec2Configuration != nil {
+		resources.Ec2Configuration = update.Ec2Configuration
+	}
+
+	if update.Ec2KeyPair != "" {
+		resources.Ec2KeyPair = update.Ec2KeyPair
+	}
+
+	if update.ImageID != "" {
+		resources.ImageID = update.ImageID
+	}
+
+	if update.InstanceRole != "" {
+		resources.InstanceRole = update.InstanceRole
+	}
+
+	if update.InstanceTypes != nil {
+		resources.InstanceTypes = update.InstanceTypes
+	}
+
+	if update.LaunchTemplate != nil {
+		resources.LaunchTemplate = update.LaunchTemplate
+	}
+
+	if update.MaxvCpus != nil {
+		resources.MaxvCpus = *update.MaxvCpus
+	}
+
+	if update.MinvCpus != nil {
+		resources.MinvCpus = *update.MinvCpus
+	}
+
+	if update.PlacementGroup != "" {
+		resources.PlacementGroup = update.PlacementGroup
+	}
+
+	if update.SecurityGroupIDs != nil {
+		resources.SecurityGroupIDs = update.SecurityGroupIDs
+	}
+
+	if update.Subnets != nil {
+		resources.Subnets = update.Subnets
+	}
+
+	if update.Tags != nil {
+		resources.Tags = update.Tags
+	}
+
+	if update.Type != "" {
+		resources.Type = update.Type
+	}
+
+	return &resources
+}
+
+// CreateJobQueue creates a new job queue, which stays CREATING for the
+// transition delay before it becomes VALID.
 func (s *MemoryStorage) CreateJobQueue(_ context.Context, input *CreateJobQueueInput) (*JobQueue, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -240,13 +456,17 @@ func (s *MemoryStorage) CreateJobQueue(_ context.Context, input *CreateJobQueueI
 		}
 	}
 
-	if _, exists := s.JobQueues[input.JobQueueName]; exists {
+	if jq, exists := s.JobQueues[input.JobQueueName]; exists && jq.Status != JQStatusDeleted {
 		return nil, &Error{
 			Code:    errConflict,
 			Message: fmt.Sprintf("Job queue %s already exists", input.JobQueueName),
 		}
 	}
 
+	if err := s.validateSchedulingPolicyARN(input.SchedulingPolicyARN); err != nil {
+		return nil, err
+	}
+
 	jqARN := fmt.Sprintf("arn:aws:batch:us-east-1:000000000000:job-queue/%s", input.JobQueueName)
 
 	state := input.State
@@ -262,28 +482,37 @@ func (s *MemoryStorage) CreateJobQueue(_ context.Context, input *CreateJobQueueI
 		Priority:                 input.Priority,
 		SchedulingPolicyARN:      input.SchedulingPolicyARN,
 		State:                    state,
-		Status:                   JQStatusValid,
 		Tags:                     input.Tags,
 	}
 
+	s.setJobQueueStatus(jq, JQStatusCreating, "", time.Now())
 	s.JobQueues[input.JobQueueName] = jq
 
 	return jq, nil
 }
 
-// DeleteJobQueue deletes a job queue.
+// DeleteJobQueue deletes a job queue. It stays DELETING for the transition
+// delay, then DELETED for as long again before it is removed.
 func (s *MemoryStorage) DeleteJobQueue(_ context.Context, name string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if _, exists := s.JobQueues[name]; !exists {
+	jq, exists := s.JobQueues[name]
+	if !exists || jq.Status == JQStatusDeleted {
 		return &Error{
 			Code:    errNotFound,
 			Message: fmt.Sprintf("Job queue %s not found", name),
 		}
 	}
 
-	delete(s.JobQueues, name)
+	if jq.Status == JQStatusDeleting {
+		return &Error{
+			Code:    errConflict,
+			Message: fmt.Sprintf("Job queue %s is already being deleted", name),
+		}
+	}
+
+	s.setJobQueueStatus(jq, JQStatusDeleting, "", time.Now())
 
 	return nil
 }
@@ -312,6 +541,203 @@ func (s *MemoryStorage) DescribeJobQueues(_ context.Context, names []string) ([]
 	return result, nil
 }
 
+// UpdateJobQueue changes the state, priority, compute environment order,
+// scheduling policy or job state time limit actions of a job queue, which
+// stays UPDATING for the transition delay before it becomes VALID again.
+func (s *MemoryStorage) UpdateJobQueue(_ context.Context, input *UpdateJobQueueInput) (*JobQueue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := extractResourceName(input.JobQueue)
+
+	jq, exists := s.JobQueues[name]
+	if !exists || jq.Status == JQStatusDeleted {
+		return nil, &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("Job queue %s not found", name),
+		}
+	}
+
+	if jq.Status != JQStatusValid && jq.Status != JQStatusInvalid {
+		return nil, &Error{
+			Code:    errConflict,
+			Message: fmt.Sprintf("Cannot update job queue %s while it is %s", name, jq.Status),
+		}
+	}
+
+	if input.State != "" && input.State != JQStateEnabled && input.State != JQStateDisabled {
+		return nil, &Error{
+			Code:    errInvalidRequest,
+			Message: fmt.Sprintf("Invalid state %s, must be one of %s or %s", input.State, JQStateEnabled, JQStateDisabled),
+		}
+	}
+
+	if err := s.validateSchedulingPolicyARN(input.SchedulingPolicyARN); err != nil {
+		return nil, err
+	}
+
+	if input.State != "" {
+		jq.State = input.State
+	}
+
+	if input.Priority != nil {
+		jq.Priority = *input.Priority
+	}
+
+	if input.ComputeEnvironmentOrder != nil {
+		jq.ComputeEnvironmentOrder = input.ComputeEnvironmentOrder
+	}
+
+	if input.SchedulingPolicyARN != "" {
+		jq.SchedulingPolicyARN = input.SchedulingPolicyARN
+	}
+
+	if input.JobStateTimeLimitActions != nil {
+		jq.JobStateTimeLimitActions = input.JobStateTimeLimitActions
+	}
+
+	s.setJobQueueStatus(jq, JQStatusUpdating, "", time.Now())
+
+	return jq, nil
+}
+
+// validateSchedulingPolicyARN checks that the scheduling policy a job queue
+// refers to exists, if any. Must be called under lock.
+func (s *MemoryStorage) validateSchedulingPolicyARN(arn string) error {
+	if arn == "" {
+		return nil
+	}
+
+	if _, exists := s.SchedulingPolicies[arn]; !exists {
+		return &Error{
+			Code:    errInvalidRequest,
+			Message: fmt.Sprintf("Scheduling policy %s not found", arn),
+		}
+	}
+
+	return nil
+}
+
+// CreateSchedulingPolicy creates a new fair-share scheduling policy.
+func (s *MemoryStorage) CreateSchedulingPolicy(_ context.Context, input *CreateSchedulingPolicyInput) (*SchedulingPolicy, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if input.Name == "" {
+		return nil, &Error{
+			Code:    errInvalidRequest,
+			Message: "name is required",
+		}
+	}
+
+	if err := validateFairsharePolicy(input.FairsharePolicy); err != nil {
+		return nil, err
+	}
+
+	arn := fmt.Sprintf("arn:aws:batch:us-east-1:000000000000:scheduling-policy/%s", input.Name)
+
+	if _, exists := s.SchedulingPolicies[arn]; exists {
+		return nil, &Error{
+			Code:    errConflict,
+			Message: fmt.Sprintf("Scheduling policy %s already exists", input.Name),
+		}
+	}
+
+	policy := &SchedulingPolicy{
+		ARN:             arn,
+		FairsharePolicy: input.FairsharePolicy,
+		Name:            input.Name,
+		Tags:            input.Tags,
+	}
+
+	s.SchedulingPolicies[arn] = policy
+
+	return policy, nil
+}
+
+// DescribeSchedulingPolicies describes the scheduling policies with the given ARNs.
+// Unknown ARNs are skipped.
+func (s *MemoryStorage) DescribeSchedulingPolicies(_ context.Context, arns []string) ([]SchedulingPolicy, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var result []SchedulingPolicy
+
+	for _, arn := range arns {
+		if policy, exists := s.SchedulingPolicies[arn]; exists {
+			result = append(result, *policy)
+		}
+	}
+
+	return result, nil
+}
+
+// UpdateSchedulingPolicy replaces the fair-share settings of a scheduling policy.
+func (s *MemoryStorage) UpdateSchedulingPolicy(_ context.Context, input *UpdateSchedulingPolicyInput) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy, exists := s.SchedulingPolicies[input.ARN]
+	if !exists {
+		return &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("Scheduling policy %s not found", input.ARN),
+		}
+	}
+
+	if err := validateFairsharePolicy(input.FairsharePolicy); err != nil {
+		return err
+	}
+
+	policy.FairsharePolicy = input.FairsharePolicy
+
+	return nil
+}
+
+// DeleteSchedulingPolicy deletes a scheduling policy that no job queue refers to.
+func (s *MemoryStorage) DeleteSchedulingPolicy(_ context.Context, arn string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.SchedulingPolicies[arn]; !exists {
+		return &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("Scheduling policy %s not found", arn),
+		}
+	}
+
+	for _, jq := range s.JobQueues {
+		if jq.SchedulingPolicyARN == arn && jq.Status != JQStatusDeleted {
+			return &Error{
+				Code:    errConflict,
+				Message: fmt.Sprintf("Scheduling policy %s is in use by job queue %s", arn, jq.JobQueueName),
+			}
+		}
+	}
+
+	delete(s.SchedulingPolicies, arn)
+
+	return nil
+}
+
+// validateFairsharePolicy checks that every share in a fair-share policy is identified.
+func validateFairsharePolicy(policy *FairsharePolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	for _, share := range policy.ShareDistribution {
+		if share.ShareIdentifier == "" {
+			return &Error{
+				Code:    errInvalidRequest,
+				Message: "shareIdentifier is required in shareDistribution",
+			}
+		}
+	}
+
+	return nil
+}
+
 // RegisterJobDefinition registers a new job definition.
 func (s *MemoryStorage) RegisterJobDefinition(_ context.Context, input *RegisterJobDefinitionInput) (*JobDefinition, error) {
 	s.mu.Lock()
@@ -350,7 +776,7 @@ func (s *MemoryStorage) RegisterJobDefinition(_ context.Context, input *Register
 		RetryStrategy:        input.RetryStrategy,
 		Revision:             revision,
 		SchedulingPriority:   input.SchedulingPriority,
-		Status:               "ACTIVE",
+		Status:               JobDefStatusActive,
 		Tags:                 input.Tags,
 		Timeout:              input.Timeout,
 		Type:                 input.Type,
@@ -361,6 +787,114 @@ func (s *MemoryStorage) RegisterJobDefinition(_ context.Context, input *Register
 	return jd, nil
 }
 
+// DescribeJobDefinitions describes job definitions, filtered by name, by
+// name:revision or ARN, and by status, sorted by name and revision.
+func (s *MemoryStorage) DescribeJobDefinitions(_ context.Context, input *DescribeJobDefinitionsInput) ([]JobDefinition, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if input.Status != "" && input.Status != JobDefStatusActive && input.Status != JobDefStatusInactive {
+		return nil, "", &Error{
+			Code:    errInvalidRequest,
+			Message: fmt.Sprintf("Invalid status %s, must be one of %s or %s", input.Status, JobDefStatusActive, JobDefStatusInactive),
+		}
+	}
+
+	var jds []*JobDefinition
+
+	for _, jd := range s.JobDefinitions {
+		if input.JobDefinitionName != "" && jd.JobDefinitionName != input.JobDefinitionName {
+			continue
+		}
+
+		if input.Status != "" && jd.Status != input.Status {
+			continue
+		}
+
+		if len(input.JobDefinitions) > 0 && !slices.ContainsFunc(input.JobDefinitions, func(ref string) bool {
+			return jobDefinitionMatches(jd, ref)
+		}) {
+			continue
+		}
+
+		jds = append(jds, jd)
+	}
+
+	slices.SortFunc(jds, func(a, b *JobDefinition) int {
+		return cmp.Or(strings.Compare(a.JobDefinitionName, b.JobDefinitionName), cmp.Compare(a.Revision, b.Revision))
+	})
+
+	startIndex := 0
+
+	if input.NextToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(input.NextToken)
+		if err == nil {
+			if idx, err := strconv.Atoi(string(decoded)); err == nil && idx >= 0 && idx < len(jds) {
+				startIndex = idx
+			}
+		}
+	}
+
+	maxResults := int(input.MaxResults)
+	if maxResults <= 0 {
+		maxResults = defaultDescribeJobDefinitionsMaxResults
+	}
+
+	endIndex := min(startIndex+maxResults, len(jds))
+
+	result := make([]JobDefinition, 0, endIndex-startIndex)
+
+	for _, jd := range jds[startIndex:endIndex] {
+		result = append(result, *jd)
+	}
+
+	var nextToken string
+	if endIndex < len(jds) {
+		nextToken = base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(endIndex)))
+	}
+
+	return result, nextToken, nil
+}
+
+// jobDefinitionMatches reports whether the job definition is the one referred
+// to by a name:revision, an ARN, or a name, which matches every revision.
+func jobDefinitionMatches(jd *JobDefinition, ref string) bool {
+	name, revision, hasRevision := strings.Cut(extractResourceName(ref), ":")
+	if name != jd.JobDefinitionName {
+		return false
+	}
+
+	return !hasRevision || revision == strconv.Itoa(int(jd.Revision))
+}
+
+// DeregisterJobDefinition marks a job definition revision, given as
+// name:revision or ARN, INACTIVE.
+func (s *MemoryStorage) DeregisterJobDefinition(_ context.Context, jobDefinition string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := extractResourceName(jobDefinition)
+
+	if !strings.Contains(key, ":") {
+		return &Error{
+			Code:    errInvalidRequest,
+			Message: "jobDefinition must be a name:revision or an ARN",
+		}
+	}
+
+	jd, exists := s.JobDefinitions[key]
+	if !exists {
+		return &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("Job definition %s not found", key),
+		}
+	}
+
+	jd.Status = JobDefStatusInactive
+
+	return nil
+}
+
 // SubmitJob submits a new job.
 func (s *MemoryStorage) SubmitJob(_ context.Context, input *SubmitJobInput) (*Job, error) {
 	s.mu.Lock()
@@ -387,6 +921,33 @@ func (s *MemoryStorage) SubmitJob(_ context.Context, input *SubmitJobInput) (*Jo
 		}
 	}
 
+	if input.ArrayProperties != nil && (input.ArrayProperties.Size < minArraySize || input.ArrayProperties.Size > maxArraySize) {
+		return nil, &Error{
+			Code:    errInvalidRequest,
+			Message: fmt.Sprintf("Array job size must be between %d and %d", minArraySize, maxArraySize),
+		}
+	}
+
+	for _, dep := range input.DependsOn {
+		if dep.JobID == "" {
+			if dep.Type != ArrayJobDependencySequential || input.ArrayProperties == nil {
+				return nil, &Error{
+					Code:    errInvalidRequest,
+					Message: "jobId is required in dependsOn unless the type is SEQUENTIAL for an array job",
+				}
+			}
+
+			continue
+		}
+
+		if _, exists := s.Jobs[dep.JobID]; !exists {
+			return nil, &Error{
+				Code:    errInvalidRequest,
+				Message: fmt.Sprintf("Job %s in dependsOn not found", dep.JobID),
+			}
+		}
+	}
+
 	jobID := uuid.New().String()
 	jobARN := fmt.Sprintf("arn:aws:batch:us-east-1:000000000000:job/%s", jobID)
 
@@ -408,7 +969,19 @@ func (s *MemoryStorage) SubmitJob(_ context.Context, input *SubmitJobInput) (*Jo
 		Timeout:            input.Timeout,
 	}
 
+	now := time.Now()
+
+	if input.ArrayProperties != nil {
+		job.ArrayProperties = &ArrayPropertiesDetail{Size: input.ArrayProperties.Size}
+
+		for _, child := range newArrayChildren(job) {
+			s.Jobs[child.JobID] = child
+			s.transitions[child.JobID] = now
+		}
+	}
+
 	s.Jobs[jobID] = job
+	s.transitions[jobID] = now
 
 	return job, nil
 }
@@ -422,14 +995,165 @@ func (s *MemoryStorage) DescribeJobs(_ context.Context, jobIDs []string) ([]Job,
 
 	for _, id := range jobIDs {
 		if job, exists := s.Jobs[id]; exists {
-			result = append(result, *job)
+			result = append(result, s.jobWithArrayStatus(job))
 		}
 	}
 
 	return result, nil
 }
 
-// TerminateJob terminates a job.
+// ListJobs lists jobs in a queue, or the children of an array job, filtered by status.
+// Only RUNNING jobs are returned when no status is given.
+func (s *MemoryStorage) ListJobs(_ context.Context, input *ListJobsInput) ([]JobSummary, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if input.JobQueue == "" && input.ArrayJobID == "" && input.MultiNodeJobID == "" {
+		return nil, "", &Error{
+			Code:    errInvalidRequest,
+			Message: "One of jobQueue, arrayJobId or multiNodeJobId is required",
+		}
+	}
+
+	status := input.JobStatus
+	if status == "" {
+		status = JobStatusRunning
+	}
+
+	var jobs []*Job
+
+	switch {
+	case input.ArrayJobID != "":
+		parent, exists := s.Jobs[input.ArrayJobID]
+		if !exists || !isArrayParent(parent) {
+			return nil, "", &Error{
+				Code:    errNotFound,
+				Message: fmt.Sprintf("Array job %s not found", input.ArrayJobID),
+			}
+		}
+
+		for i := range parent.ArrayProperties.Size {
+			if child, ok := s.Jobs[arrayChildID(parent.JobID, i)]; ok {
+				jobs = append(jobs, child)
+			}
+		}
+	case input.MultiNodeJobID != "":
+		// Multi-node parallel jobs are not emulated, so they have no node jobs.
+	default:
+		queue := extractResourceName(input.JobQueue)
+
+		for _, job := range s.Jobs {
+			// Array children are listed through their parent's arrayJobId.
+			if job.ArrayProperties != nil && job.ArrayProperties.Index != nil {
+				continue
+			}
+
+			if extractResourceName(job.JobQueue) == queue {
+				jobs = append(jobs, job)
+			}
+		}
+	}
+
+	jobs = slices.DeleteFunc(jobs, func(job *Job) bool { return job.Status != status })
+
+	slices.SortFunc(jobs, func(a, b *Job) int {
+		if c := cmp.Compare(a.CreatedAt, b.CreatedAt); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.JobID, b.JobID)
+	})
+
+	startIndex := 0
+
+	if input.NextToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(input.NextToken)
+		if err == nil {
+			if idx, err := strconv.Atoi(string(decoded)); err == nil && idx >= 0 && idx < len(jobs) {
+				startIndex = idx
+			}
+		}
+	}
+
+	maxResults := int(input.MaxResults)
+	if maxResults <= 0 {
+		maxResults = defaultListJobsMaxResults
+	}
+
+	endIndex := min(startIndex+maxResults, len(jobs))
+
+	summaries := make([]JobSummary, 0, endIndex-startIndex)
+
+	for _, job := range jobs[startIndex:endIndex] {
+		summaries = append(summaries, jobSummary(job))
+	}
+
+	var nextToken string
+	if endIndex < len(jobs) {
+		nextToken = base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(endIndex)))
+	}
+
+	return summaries, nextToken, nil
+}
+
+// jobSummary converts a job to its ListJobs representation.
+func jobSummary(job *Job) JobSummary {
+	summary := JobSummary{
+		CreatedAt:     job.CreatedAt,
+		JobARN:        job.JobARN,
+		JobDefinition: job.JobDefinition,
+		JobID:         job.JobID,
+		JobName:       job.JobName,
+		StartedAt:     job.StartedAt,
+		Status:        job.Status,
+		StatusReason:  job.StatusReason,
+		StoppedAt:     job.StoppedAt,
+	}
+
+	if job.ArrayProperties != nil {
+		summary.ArrayProperties = &ArrayPropertiesSummary{
+			Index: job.ArrayProperties.Index,
+			Size:  job.ArrayProperties.Size,
+		}
+	}
+
+	if n := len(job.Attempts); n > 0 && job.Attempts[n-1].Container != nil {
+		container := *job.Attempts[n-1].Container
+		summary.Container = &ContainerSummary{
+			ExitCode: &container.ExitCode,
+			Reason:   container.Reason,
+		}
+	}
+
+	return summary
+}
+
+// CancelJob cancels a job that has not started yet. Jobs that have already
+// reached STARTING are left untouched, as in AWS.
+func (s *MemoryStorage) CancelJob(_ context.Context, jobID, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, exists := s.Jobs[jobID]
+	if !exists {
+		return &Error{
+			Code:    errNotFound,
+			Message: fmt.Sprintf("Job %s not found", jobID),
+		}
+	}
+
+	s.forEachJobInArray(job, func(j *Job) {
+		switch j.Status {
+		case JobStatusSubmitted, JobStatusPending, JobStatusRunnable:
+			j.IsCancelled = true
+			s.setJobStatus(j, JobStatusFailed, reason, time.Now())
+		}
+	})
+
+	return nil
+}
+
+// TerminateJob terminates a job in any state that has not finished yet.
 func (s *MemoryStorage) TerminateJob(_ context.Context, jobID, reason string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -442,10 +1166,14 @@ func (s *MemoryStorage) TerminateJob(_ context.Context, jobID, reason string) er
 		}
 	}
 
-	job.Status = JobStatusFailed
-	job.StatusReason = reason
-	job.IsTerminated = true
-	job.StoppedAt = nowMillis()
+	s.forEachJobInArray(job, func(j *Job) {
+		if isFinalJobStatus(j.Status) {
+			return
+		}
+
+		j.IsTerminated = true
+		s.setJobStatus(j, JobStatusFailed, reason, time.Now())
+	})
 
 	return nil
 }
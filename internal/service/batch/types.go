@@ -52,12 +52,24 @@ const (
 	JobStatusFailed    = "FAILED"
 )
 
+// Array job dependency types.
+const (
+	ArrayJobDependencyNToN       = "N_TO_N"
+	ArrayJobDependencySequential = "SEQUENTIAL"
+)
+
 // Job definition types.
 const (
 	JobDefTypeContainer = "container"
 	JobDefTypeMultinode = "multinode"
 )
 
+// Job definition statuses.
+const (
+	JobDefStatusActive   = "ACTIVE"
+	JobDefStatusInactive = "INACTIVE"
+)
+
 // ComputeEnvironment represents a Batch compute environment.
 type ComputeEnvironment struct {
 	ComputeEnvironmentARN  string            `json:"computeEnvironmentArn,omitempty"`
@@ -135,6 +147,27 @@ type JobQueue struct {
 	Tags                     map[string]string         `json:"tags,omitempty"`
 }
 
+// SchedulingPolicy represents a Batch fair-share scheduling policy.
+type SchedulingPolicy struct {
+	ARN             string            `json:"arn,omitempty"`
+	FairsharePolicy *FairsharePolicy  `json:"fairsharePolicy,omitempty"`
+	Name            string            `json:"name,omitempty"`
+	Tags            map[string]string `json:"tags,omitempty"`
+}
+
+// FairsharePolicy represents the fair-share settings of a scheduling policy.
+type FairsharePolicy struct {
+	ComputeReservation int32             `json:"computeReservation,omitempty"`
+	ShareDecaySeconds  int32             `json:"shareDecaySeconds,omitempty"`
+	ShareDistribution  []ShareAttributes `json:"shareDistribution,omitempty"`
+}
+
+// ShareAttributes represents the weight of a share identifier in a fair-share policy.
+type ShareAttributes struct {
+	ShareIdentifier string  `json:"shareIdentifier"`
+	WeightFactor    float32 `json:"weightFactor,omitempty"`
+}
+
 // ComputeEnvironmentOrder represents the order of compute environments.
 type ComputeEnvironmentOrder struct {
 	ComputeEnvironment string `json:"computeEnvironment,omitempty"`
@@ -452,7 +485,7 @@ type Job struct {
 
 // ArrayPropertiesDetail represents array properties detail.
 type ArrayPropertiesDetail struct {
-	Index         int32            `json:"index,omitempty"`
+	Index         *int32           `json:"index,omitempty"`
 	Size          int32            `json:"size,omitempty"`
 	StatusSummary map[string]int32 `json:"statusSummary,omitempty"`
 }
@@ -609,6 +642,42 @@ type DescribeComputeEnvironmentsOutput struct {
 	NextToken           string               `json:"nextToken,omitempty"`
 }
 
+// UpdateComputeEnvironmentInput is the request for UpdateComputeEnvironment.
+type UpdateComputeEnvironmentInput struct {
+	ComputeEnvironment string                 `json:"computeEnvironment"`
+	ComputeResources   *ComputeResourceUpdate `json:"computeResources,omitempty"`
+	ServiceRole        string                 `json:"serviceRole,omitempty"`
+	State              string                 `json:"state,omitempty"`
+	UpdatePolicy       *UpdatePolicy          `json:"updatePolicy,omitempty"`
+}
+
+// ComputeResourceUpdate holds the compute resources to change in UpdateComputeEnvironment.
+// Fields left unset keep their current value.
+type ComputeResourceUpdate struct {
+	AllocationStrategy string             `json:"allocationStrategy,omitempty"`
+	BidPercentage      *int32             `json:"bidPercentage,omitempty"`
+	DesiredvCpus       *int32             `json:"desiredvCpus,omitempty"`
+	Ec2Configuration   []Ec2Configuration `json:"ec2Configuration,omitempty"`
+	Ec2KeyPair         string             `json:"ec2KeyPair,omitempty"`
+	ImageID            string             `json:"imageId,omitempty"`
+	InstanceRole       string             `json:"instanceRole,omitempty"`
+	InstanceTypes      []string           `json:"instanceTypes,omitempty"`
+	LaunchTemplate     *LaunchTemplate    `json:"launchTemplate,omitempty"`
+	MaxvCpus           *int32             `json:"maxvCpus,omitempty"`
+	MinvCpus           *int32             `json:"minvCpus,omitempty"`
+	PlacementGroup     string             `json:"placementGroup,omitempty"`
+	SecurityGroupIDs   []string           `json:"securityGroupIds,omitempty"`
+	Subnets            []string           `json:"subnets,omitempty"`
+	Tags               map[string]string  `json:"tags,omitempty"`
+	Type               string             `json:"type,omitempty"`
+}
+
+// UpdateComputeEnvironmentOutput is the response for UpdateComputeEnvironment.
+type UpdateComputeEnvironmentOutput struct {
+	ComputeEnvironmentARN  string `json:"computeEnvironmentArn,omitempty"`
+	ComputeEnvironmentName string `json:"computeEnvironmentName,omitempty"`
+}
+
 // CreateJobQueueInput is the request for CreateJobQueue.
 type CreateJobQueueInput struct {
 	ComputeEnvironmentOrder  []ComputeEnvironmentOrder `json:"computeEnvironmentOrder"`
@@ -644,6 +713,56 @@ type DescribeJobQueuesOutput struct {
 	NextToken string     `json:"nextToken,omitempty"`
 }
 
+// UpdateJobQueueInput is the request for UpdateJobQueue.
+type UpdateJobQueueInput struct {
+	ComputeEnvironmentOrder  []ComputeEnvironmentOrder `json:"computeEnvironmentOrder,omitempty"`
+	JobQueue                 string                    `json:"jobQueue"`
+	JobStateTimeLimitActions []JobStateTimeLimitAction `json:"jobStateTimeLimitActions,omitempty"`
+	Priority                 *int32                    `json:"priority,omitempty"`
+	SchedulingPolicyARN      string                    `json:"schedulingPolicyArn,omitempty"`
+	State                    string                    `json:"state,omitempty"`
+}
+
+// UpdateJobQueueOutput is the response for UpdateJobQueue.
+type UpdateJobQueueOutput struct {
+	JobQueueARN  string `json:"jobQueueArn,omitempty"`
+	JobQueueName string `json:"jobQueueName,omitempty"`
+}
+
+// CreateSchedulingPolicyInput is the request for CreateSchedulingPolicy.
+type CreateSchedulingPolicyInput struct {
+	FairsharePolicy *FairsharePolicy  `json:"fairsharePolicy,omitempty"`
+	Name            string            `json:"name"`
+	Tags            map[string]string `json:"tags,omitempty"`
+}
+
+// CreateSchedulingPolicyOutput is the response for CreateSchedulingPolicy.
+type CreateSchedulingPolicyOutput struct {
+	ARN  string `json:"arn,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// DescribeSchedulingPoliciesInput is the request for DescribeSchedulingPolicies.
+type DescribeSchedulingPoliciesInput struct {
+	ARNs []string `json:"arns"`
+}
+
+// DescribeSchedulingPoliciesOutput is the response for DescribeSchedulingPolicies.
+type DescribeSchedulingPoliciesOutput struct {
+	SchedulingPolicies []SchedulingPolicy `json:"schedulingPolicies,omitempty"`
+}
+
+// UpdateSchedulingPolicyInput is the request for UpdateSchedulingPolicy.
+type UpdateSchedulingPolicyInput struct {
+	ARN             string           `json:"arn"`
+	FairsharePolicy *FairsharePolicy `json:"fairsharePolicy,omitempty"`
+}
+
+// DeleteSchedulingPolicyInput is the request for DeleteSchedulingPolicy.
+type DeleteSchedulingPolicyInput struct {
+	ARN string `json:"arn"`
+}
+
 // RegisterJobDefinitionInput is the request for RegisterJobDefinition.
 type RegisterJobDefinitionInput struct {
 	ContainerProperties  *ContainerProperties `json:"containerProperties,omitempty"`
@@ -667,6 +786,26 @@ type RegisterJobDefinitionOutput struct {
 	Revision          int32  `json:"revision,omitempty"`
 }
 
+// DescribeJobDefinitionsInput is the request for DescribeJobDefinitions.
+type DescribeJobDefinitionsInput struct {
+	JobDefinitionName string   `json:"jobDefinitionName,omitempty"`
+	JobDefinitions    []string `json:"jobDefinitions,omitempty"`
+	MaxResults        int32    `json:"maxResults,omitempty"`
+	NextToken         string   `json:"nextToken,omitempty"`
+	Status            string   `json:"status,omitempty"`
+}
+
+// DescribeJobDefinitionsOutput is the response for DescribeJobDefinitions.
+type DescribeJobDefinitionsOutput struct {
+	JobDefinitions []JobDefinition `json:"jobDefinitions,omitempty"`
+	NextToken      string          `json:"nextToken,omitempty"`
+}
+
+// DeregisterJobDefinitionInput is the request for DeregisterJobDefinition.
+type DeregisterJobDefinitionInput struct {
+	JobDefinition string `json:"jobDefinition"`
+}
+
 // SubmitJobInput is the request for SubmitJob.
 type SubmitJobInput struct {
 	ArrayProperties            *ArrayProperties       `json:"arrayProperties,omitempty"`
@@ -750,6 +889,55 @@ type DescribeJobsOutput struct {
 	Jobs []Job `json:"jobs,omitempty"`
 }
 
+// ListJobsInput is the request for ListJobs.
+type ListJobsInput struct {
+	ArrayJobID     string `json:"arrayJobId,omitempty"`
+	JobQueue       string `json:"jobQueue,omitempty"`
+	JobStatus      string `json:"jobStatus,omitempty"`
+	MaxResults     int32  `json:"maxResults,omitempty"`
+	MultiNodeJobID string `json:"multiNodeJobId,omitempty"`
+	NextToken      string `json:"nextToken,omitempty"`
+}
+
+// ListJobsOutput is the response for ListJobs.
+type ListJobsOutput struct {
+	JobSummaryList []JobSummary `json:"jobSummaryList"`
+	NextToken      string       `json:"nextToken,omitempty"`
+}
+
+// JobSummary represents a summary of a job.
+type JobSummary struct {
+	ArrayProperties *ArrayPropertiesSummary `json:"arrayProperties,omitempty"`
+	Container       *ContainerSummary       `json:"container,omitempty"`
+	CreatedAt       int64                   `json:"createdAt,omitempty"`
+	JobARN          string                  `json:"jobArn,omitempty"`
+	JobDefinition   string                  `json:"jobDefinition,omitempty"`
+	JobID           string                  `json:"jobId,omitempty"`
+	JobName         string                  `json:"jobName,omitempty"`
+	StartedAt       int64                   `json:"startedAt,omitempty"`
+	Status          string                  `json:"status,omitempty"`
+	StatusReason    string                  `json:"statusReason,omitempty"`
+	StoppedAt       int64                   `json:"stoppedAt,omitempty"`
+}
+
+// ArrayPropertiesSummary represents array properties in a job summary.
+type ArrayPropertiesSummary struct {
+	Index *int32 `json:"index,omitempty"`
+	Size  int32  `json:"size,omitempty"`
+}
+
+// ContainerSummary represents container details in a job summary.
+type ContainerSummary struct {
+	ExitCode *int32 `json:"exitCode,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// CancelJobInput is the request for CancelJob.
+type CancelJobInput struct {
+	JobID  string `json:"jobId"`
+	Reason string `json:"reason"`
+}
+
 // TerminateJobInput is the request for TerminateJob.
 type TerminateJobInput struct {
 	JobID  string `json:"jobId"`
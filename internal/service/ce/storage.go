@@ -43,6 +43,7 @@ type Storage interface {
 
 	// ListCostCategoryDefinitions lists cost category definitions.
 	ListCostCategoryDefinitions(ctx context.Context, req *ListCostCategoryDefinitionsRequest) (*ListCostCategoryDefinitionsResponse, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -132,6 +133,16 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.CostCategories = make(map[string]*CostCategoryDefinition)
+
+	return nil
+}
+
 // GetCostAndUsage retrieves cost and usage data.
 func (s *MemoryStorage) GetCostAndUsage(_ context.Context, req *GetCostAndUsageRequest) (*GetCostAndUsageResponse, error) {
 	if req.TimePeriod.Start == "" || req.TimePeriod.End == "" {
@@ -23,6 +23,7 @@ type Storage interface {
 	DescribeStackResources(ctx context.Context, stackName, logicalResourceID string) ([]*StackResource, error)
 	GetTemplate(ctx context.Context, stackName string) (string, error)
 	ValidateTemplate(ctx context.Context, templateBody string) (*TemplateValidationResult, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -112,6 +113,16 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Stacks = make(map[string]*Stack)
+
+	return nil
+}
+
 // CreateStack creates a new stack.
 func (m *MemoryStorage) CreateStack(_ context.Context, req *CreateStackRequest) (*Stack, error) {
 	m.mu.Lock()
@@ -3,8 +3,11 @@ package cloudfront
 import (
 	"encoding/xml"
 	"errors"
+	"fmt"
 	"io"
+	"maps"
 	"net/http"
+	"slices"
 	"strconv"
 	"time"
 
@@ -16,7 +19,7 @@ const (
 	cloudfrontXmlns = "http://cloudfront.amazonaws.com/doc/2020-05-31/"
 )
 
-// CreateDistribution handles the CreateDistribution operation.
+// CreateDistribution handles the CreateDistribution and CreateDistributionWithTags operations.
 func (s *Service) CreateDistribution(w http.ResponseWriter, r *http.Request) {
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -25,14 +28,28 @@ func (s *Service) CreateDistribution(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var req CreateDistributionRequest
-	if err := xml.Unmarshal(body, &req); err != nil {
+	var (
+		req  CreateDistributionRequest
+		tags map[string]string
+	)
+
+	if r.URL.Query().Has("WithTags") {
+		var withTags CreateDistributionWithTagsRequest
+		if err := xml.Unmarshal(body, &withTags); err != nil {
+			writeCloudFrontError(w, errInvalidArgument, "Invalid request body", http.StatusBadRequest)
+
+			return
+		}
+
+		req = withTags.DistributionConfig
+		tags = convertTagsFromXML(&withTags.Tags)
+	} else if err := xml.Unmarshal(body, &req); err != nil {
 		writeCloudFrontError(w, errInvalidArgument, "Invalid request body", http.StatusBadRequest)
 
 		return
 	}
 
-	dist, err := s.storage.CreateDistribution(r.Context(), &req)
+	dist, err := s.storage.CreateDistribution(r.Context(), &req, tags)
 	if err != nil {
 		handleStorageError(w, err)
 
@@ -234,6 +251,213 @@ func (s *Service) GetDistributionConfig(w http.ResponseWriter, r *http.Request)
 	writeXMLResponse(w, http.StatusOK, resp)
 }
 
+// CreateOriginAccessControl handles the CreateOriginAccessControl operation.
+func (s *Service) CreateOriginAccessControl(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeCloudFrontError(w, errMissingBody, "Request body is missing", http.StatusBadRequest)
+
+		return
+	}
+
+	var req OriginAccessControlConfigXML
+	if err := xml.Unmarshal(body, &req); err != nil {
+		writeCloudFrontError(w, errInvalidArgument, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	oac, err := s.storage.CreateOriginAccessControl(r.Context(), &req)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	w.Header().Set("ETag", oac.ETag)
+	w.Header().Set("Location", "/2020-05-31/origin-access-control/"+oac.ID)
+	writeXMLResponse(w, http.StatusCreated, buildOriginAccessControlXML(oac))
+}
+
+// GetOriginAccessControl handles the GetOriginAccessControl operation.
+func (s *Service) GetOriginAccessControl(w http.ResponseWriter, r *http.Request) {
+	oac, err := s.storage.GetOriginAccessControl(r.Context(), r.PathValue("id"))
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	w.Header().Set("ETag", oac.ETag)
+	writeXMLResponse(w, http.StatusOK, buildOriginAccessControlXML(oac))
+}
+
+// GetOriginAccessControlConfig handles the GetOriginAccessControlConfig operation.
+func (s *Service) GetOriginAccessControlConfig(w http.ResponseWriter, r *http.Request) {
+	oac, err := s.storage.GetOriginAccessControl(r.Context(), r.PathValue("id"))
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	resp := buildOriginAccessControlConfigXML(oac.Config)
+	resp.Xmlns = cloudfrontXmlns
+
+	w.Header().Set("ETag", oac.ETag)
+	writeXMLResponse(w, http.StatusOK, resp)
+}
+
+// ListOriginAccessControls handles the ListOriginAccessControls operation.
+func (s *Service) ListOriginAccessControls(w http.ResponseWriter, r *http.Request) {
+	marker := r.URL.Query().Get("Marker")
+	maxItemsStr := r.URL.Query().Get("MaxItems")
+	maxItems := 100
+
+	if maxItemsStr != "" {
+		if v, err := strconv.Atoi(maxItemsStr); err == nil && v > 0 {
+			maxItems = v
+		}
+	}
+
+	oacs, nextMarker, err := s.storage.ListOriginAccessControls(r.Context(), marker, maxItems)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	resp := buildOriginAccessControlListXML(oacs, marker, maxItems, nextMarker)
+	writeXMLResponse(w, http.StatusOK, resp)
+}
+
+// UpdateOriginAccessControl handles the UpdateOriginAccessControl operation.
+func (s *Service) UpdateOriginAccessControl(w http.ResponseWriter, r *http.Request) {
+	etag := r.Header.Get("If-Match")
+	if etag == "" {
+		writeCloudFrontError(w, errPreconditionFailed, "The If-Match header is required", http.StatusPreconditionFailed)
+
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeCloudFrontError(w, errMissingBody, "Request body is missing", http.StatusBadRequest)
+
+		return
+	}
+
+	var req OriginAccessControlConfigXML
+	if err := xml.Unmarshal(body, &req); err != nil {
+		writeCloudFrontError(w, errInvalidArgument, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	oac, err := s.storage.UpdateOriginAccessControl(r.Context(), r.PathValue("id"), &req, etag)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	w.Header().Set("ETag", oac.ETag)
+	writeXMLResponse(w, http.StatusOK, buildOriginAccessControlXML(oac))
+}
+
+// DeleteOriginAccessControl handles the DeleteOriginAccessControl operation.
+func (s *Service) DeleteOriginAccessControl(w http.ResponseWriter, r *http.Request) {
+	etag := r.Header.Get("If-Match")
+	if etag == "" {
+		writeCloudFrontError(w, errPreconditionFailed, "The If-Match header is required", http.StatusPreconditionFailed)
+
+		return
+	}
+
+	if err := s.storage.DeleteOriginAccessControl(r.Context(), r.PathValue("id"), etag); err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// TagResource handles the TagResource and UntagResource operations, which
+// share a path and are told apart by the Operation query parameter.
+func (s *Service) TagResource(w http.ResponseWriter, r *http.Request) {
+	resource := r.URL.Query().Get("Resource")
+	if resource == "" {
+		writeCloudFrontError(w, errInvalidArgument, "Resource is required", http.StatusBadRequest)
+
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeCloudFrontError(w, errMissingBody, "Request body is missing", http.StatusBadRequest)
+
+		return
+	}
+
+	switch operation := r.URL.Query().Get("Operation"); operation {
+	case "Tag":
+		var req TagsXML
+		if err := xml.Unmarshal(body, &req); err != nil {
+			writeCloudFrontError(w, errInvalidArgument, "Invalid request body", http.StatusBadRequest)
+
+			return
+		}
+
+		err = s.storage.TagResource(r.Context(), resource, convertTagsFromXML(&req))
+	case "Untag":
+		var req TagKeysXML
+		if err := xml.Unmarshal(body, &req); err != nil {
+			writeCloudFrontError(w, errInvalidArgument, "Invalid request body", http.StatusBadRequest)
+
+			return
+		}
+
+		var keys []string
+		if req.Items != nil {
+			keys = req.Items.Key
+		}
+
+		err = s.storage.UntagResource(r.Context(), resource, keys)
+	default:
+		writeCloudFrontError(w, errInvalidArgument, fmt.Sprintf("Invalid Operation: %s", operation), http.StatusBadRequest)
+
+		return
+	}
+
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListTagsForResource handles the ListTagsForResource operation.
+func (s *Service) ListTagsForResource(w http.ResponseWriter, r *http.Request) {
+	resource := r.URL.Query().Get("Resource")
+	if resource == "" {
+		writeCloudFrontError(w, errInvalidArgument, "Resource is required", http.StatusBadRequest)
+
+		return
+	}
+
+	tags, err := s.storage.ListTagsForResource(r.Context(), resource)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeXMLResponse(w, http.StatusOK, buildTagsXML(tags))
+}
+
 // Helper functions.
 
 func writeXMLResponse(w http.ResponseWriter, status int, v any) {
@@ -268,8 +492,10 @@ func handleStorageError(w http.ResponseWriter, err error) {
 		status := http.StatusBadRequest
 
 		switch cfErr.Code {
-		case errDistributionNotFound, errNoSuchInvalidation:
+		case errDistributionNotFound, errNoSuchInvalidation, errNoSuchOriginAccessControl, errNoSuchResource:
 			status = http.StatusNotFound
+		case errOACAlreadyExists, errOACInUse:
+			status = http.StatusConflict
 		case errPreconditionFailed, errInvalidIfMatchVersion:
 			status = http.StatusPreconditionFailed
 		case errAccessDenied:
@@ -525,6 +751,31 @@ func buildDistributionListXML(dists []*Distribution, marker string, maxItems int
 	return result
 }
 
+// buildTagsXML converts tags to TagsXML, sorted by key.
+func buildTagsXML(tags map[string]string) *TagsXML {
+	result := &TagsXML{Xmlns: cloudfrontXmlns, Items: &TagItemsXML{}}
+
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		result.Items.Tag = append(result.Items.Tag, TagXML{Key: k, Value: tags[k]})
+	}
+
+	return result
+}
+
+// convertTagsFromXML converts TagsXML to a map of tag values by key.
+func convertTagsFromXML(tags *TagsXML) map[string]string {
+	if tags.Items == nil {
+		return nil
+	}
+
+	result := make(map[string]string, len(tags.Items.Tag))
+	for _, t := range tags.Items.Tag {
+		result[t.Key] = t.Value
+	}
+
+	return result
+}
+
 func buildDistributionSummaryXML(d *Distribution) DistributionSummaryXML {
 	summary := DistributionSummaryXML{
 		ID:                   d.ID,
@@ -644,3 +895,49 @@ func buildInvalidationXML(inv *Invalidation) *InvalidationXML {
 
 	return result
 }
+
+func buildOriginAccessControlXML(oac *OriginAccessControl) *OriginAccessControlXML {
+	return &OriginAccessControlXML{
+		Xmlns:                     cloudfrontXmlns,
+		ID:                        oac.ID,
+		OriginAccessControlConfig: buildOriginAccessControlConfigXML(oac.Config),
+	}
+}
+
+func buildOriginAccessControlConfigXML(config *OriginAccessControlConfig) *OriginAccessControlConfigXML {
+	return &OriginAccessControlConfigXML{
+		Name:                          config.Name,
+		Description:                   config.Description,
+		SigningProtocol:               config.SigningProtocol,
+		SigningBehavior:               config.SigningBehavior,
+		OriginAccessControlOriginType: config.OriginAccessControlOriginType,
+	}
+}
+
+func buildOriginAccessControlListXML(oacs []*OriginAccessControl, marker string, maxItems int, nextMarker string) *OriginAccessControlListXML {
+	result := &OriginAccessControlListXML{
+		Xmlns:       cloudfrontXmlns,
+		Marker:      marker,
+		NextMarker:  nextMarker,
+		MaxItems:    maxItems,
+		IsTruncated: nextMarker != "",
+		Quantity:    len(oacs),
+	}
+
+	if len(oacs) > 0 {
+		result.Items = &OriginAccessControlSummaryList{}
+
+		for _, oac := range oacs {
+			result.Items.OriginAccessControlSummary = append(result.Items.OriginAccessControlSummary, OriginAccessControlSummaryXML{
+				ID:                            oac.ID,
+				Description:                   oac.Config.Description,
+				Name:                          oac.Config.Name,
+				SigningProtocol:               oac.Config.SigningProtocol,
+				SigningBehavior:               oac.Config.SigningBehavior,
+				OriginAccessControlOriginType: oac.Config.OriginAccessControlOriginType,
+			})
+		}
+	}
+
+	return result
+}
@@ -1,9 +1,9 @@
 package cloudfront
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"os"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -12,12 +12,14 @@ import (
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"cloudfront"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
 
 // Service implements the CloudFront service.
@@ -50,6 +52,18 @@ func (s *Service) RegisterRoutes(r service.Router) {
 	// Invalidation operations.
 	r.Handle("POST", "/2020-05-31/distribution/{id}/invalidation", s.CreateInvalidation)
 	r.Handle("GET", "/2020-05-31/distribution/{id}/invalidation/{invalidationId}", s.GetInvalidation)
+
+	// Origin access control operations.
+	r.Handle("POST", "/2020-05-31/origin-access-control", s.CreateOriginAccessControl)
+	r.Handle("GET", "/2020-05-31/origin-access-control", s.ListOriginAccessControls)
+	r.Handle("GET", "/2020-05-31/origin-access-control/{id}", s.GetOriginAccessControl)
+	r.Handle("GET", "/2020-05-31/origin-access-control/{id}/config", s.GetOriginAccessControlConfig)
+	r.Handle("PUT", "/2020-05-31/origin-access-control/{id}/config", s.UpdateOriginAccessControl)
+	r.Handle("DELETE", "/2020-05-31/origin-access-control/{id}", s.DeleteOriginAccessControl)
+
+	// Tagging operations.
+	r.Handle("POST", "/2020-05-31/tagging", s.TagResource)
+	r.Handle("GET", "/2020-05-31/tagging", s.ListTagsForResource)
 }
 
 // Close saves the storage state if persistence is enabled.
@@ -62,3 +76,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,7 +17,7 @@ import (
 
 // Storage defines the CloudFront storage interface.
 type Storage interface {
-	CreateDistribution(ctx context.Context, config *CreateDistributionRequest) (*Distribution, error)
+	CreateDistribution(ctx context.Context, config *CreateDistributionRequest, tags map[string]string) (*Distribution, error)
 	GetDistribution(ctx context.Context, id string) (*Distribution, error)
 	ListDistributions(ctx context.Context, marker string, maxItems int) ([]*Distribution, string, error)
 	UpdateDistribution(ctx context.Context, id string, config *CreateDistributionRequest, etag string) (*Distribution, error)
@@ -22,6 +25,15 @@ type Storage interface {
 	CreateInvalidation(ctx context.Context, distributionID string, batch *CreateInvalidationRequest) (*Invalidation, error)
 	GetInvalidation(ctx context.Context, distributionID, invalidationID string) (*Invalidation, error)
 	ListInvalidations(ctx context.Context, distributionID, marker string, maxItems int) ([]*Invalidation, string, error)
+	CreateOriginAccessControl(ctx context.Context, config *OriginAccessControlConfigXML) (*OriginAccessControl, error)
+	GetOriginAccessControl(ctx context.Context, id string) (*OriginAccessControl, error)
+	ListOriginAccessControls(ctx context.Context, marker string, maxItems int) ([]*OriginAccessControl, string, error)
+	UpdateOriginAccessControl(ctx context.Context, id string, config *OriginAccessControlConfigXML, etag string) (*OriginAccessControl, error)
+	DeleteOriginAccessControl(ctx context.Context, id, etag string) error
+	TagResource(ctx context.Context, resource string, tags map[string]string) error
+	UntagResource(ctx context.Context, resource string, keys []string) error
+	ListTagsForResource(ctx context.Context, resource string) (map[string]string, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -45,7 +57,9 @@ type MemoryStorage struct {
 	mu            sync.RWMutex                        `json:"-"`
 	Distributions map[string]*Distribution            `json:"distributions"`
 	Invalidations map[string]map[string]*Invalidation `json:"invalidations"` // distributionID -> invalidationID -> Invalidation
-	dataDir       string
+	// OriginAccessControls is keyed by origin access control ID.
+	OriginAccessControls map[string]*OriginAccessControl `json:"originAccessControls"`
+	dataDir              string
 }
 
 // NewMemoryStorage creates a new memory storage.
@@ -53,6 +67,8 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 	s := &MemoryStorage{
 		Distributions: make(map[string]*Distribution),
 		Invalidations: make(map[string]map[string]*Invalidation),
+
+		OriginAccessControls: make(map[string]*OriginAccessControl),
 	}
 	for _, o := range opts {
 		o(s)
@@ -101,6 +117,10 @@ func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
 		s.Invalidations = make(map[string]map[string]*Invalidation)
 	}
 
+	if s.OriginAccessControls == nil {
+		s.OriginAccessControls = make(map[string]*OriginAccessControl)
+	}
+
 	return nil
 }
 
@@ -117,6 +137,18 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Distributions = make(map[string]*Distribution)
+	s.Invalidations = make(map[string]map[string]*Invalidation)
+	s.OriginAccessControls = make(map[string]*OriginAccessControl)
+
+	return nil
+}
+
 // Error represents a CloudFront error.
 type Error struct {
 	Code    string
@@ -127,8 +159,12 @@ func (e *Error) Error() string {
 	return e.Message
 }
 
-// CreateDistribution creates a new distribution.
-func (s *MemoryStorage) CreateDistribution(_ context.Context, config *CreateDistributionRequest) (*Distribution, error) {
+// CreateDistribution creates a new distribution with the given tags.
+func (s *MemoryStorage) CreateDistribution(_ context.Context, config *CreateDistributionRequest, tags map[string]string) (*Distribution, error) {
+	if err := validateTags(tags); err != nil {
+		return nil, err
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -142,6 +178,11 @@ func (s *MemoryStorage) CreateDistribution(_ context.Context, config *CreateDist
 		}
 	}
 
+	origins := convertOriginsFromXML(config.Origins)
+	if err := s.checkOriginAccessControls(origins); err != nil {
+		return nil, err
+	}
+
 	// Generate distribution ID.
 	id := generateDistributionID()
 	etag := generateETag()
@@ -162,13 +203,14 @@ func (s *MemoryStorage) CreateDistribution(_ context.Context, config *CreateDist
 			DefaultRootObject:    config.DefaultRootObject,
 			HTTPVersion:          defaultString(config.HTTPVersion, "http2"),
 			IsIPV6Enabled:        config.IsIPV6Enabled,
-			Origins:              convertOriginsFromXML(config.Origins),
+			Origins:              origins,
 			DefaultCacheBehavior: convertDefaultCacheBehaviorFromXML(config.DefaultCacheBehavior),
 			Aliases:              convertAliasesFromXML(config.Aliases),
 			ViewerCertificate:    convertViewerCertificateFromXML(config.ViewerCertificate),
 		},
 		ActiveTrustedSigners:   &ActiveTrustedSigners{Enabled: false, Quantity: 0},
 		ActiveTrustedKeyGroups: &ActiveTrustedKeyGroups{Enabled: false, Quantity: 0},
+		Tags:                   maps.Clone(tags),
 	}
 
 	s.Distributions[id] = dist
@@ -209,16 +251,14 @@ func (s *MemoryStorage) ListDistributions(_ context.Context, marker string, maxI
 	// Sort by ID for consistent ordering.
 	sortDistributionsByID(dists)
 
-	// Apply marker-based pagination.
+	// Apply marker-based pagination. The page starts after the marker, even
+	// if the distribution it names has been deleted since.
 	startIdx := 0
 
 	if marker != "" {
-		for i, d := range dists {
-			if d.ID == marker {
-				startIdx = i + 1
-
-				break
-			}
+		startIdx = slices.IndexFunc(dists, func(d *Distribution) bool { return d.ID > marker })
+		if startIdx < 0 {
+			startIdx = len(dists)
 		}
 	}
 
@@ -257,6 +297,11 @@ func (s *MemoryStorage) UpdateDistribution(_ context.Context, id string, config
 		}
 	}
 
+	origins := convertOriginsFromXML(config.Origins)
+	if err := s.checkOriginAccessControls(origins); err != nil {
+		return nil, err
+	}
+
 	// Update distribution.
 	newETag := generateETag()
 	dist.ETag = newETag
@@ -270,7 +315,7 @@ func (s *MemoryStorage) UpdateDistribution(_ context.Context, id string, config
 		DefaultRootObject:    config.DefaultRootObject,
 		HTTPVersion:          defaultString(config.HTTPVersion, "http2"),
 		IsIPV6Enabled:        config.IsIPV6Enabled,
-		Origins:              convertOriginsFromXML(config.Origins),
+		Origins:              origins,
 		DefaultCacheBehavior: convertDefaultCacheBehaviorFromXML(config.DefaultCacheBehavior),
 		Aliases:              convertAliasesFromXML(config.Aliases),
 		ViewerCertificate:    convertViewerCertificateFromXML(config.ViewerCertificate),
@@ -432,8 +477,277 @@ func (s *MemoryStorage) ListInvalidations(_ context.Context, distributionID, mar
 	return result, nextMarker, nil
 }
 
+// CreateOriginAccessControl creates a new origin access control.
+func (s *MemoryStorage) CreateOriginAccessControl(_ context.Context, config *OriginAccessControlConfigXML) (*OriginAccessControl, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := validateOriginAccessControlConfig(config); err != nil {
+		return nil, err
+	}
+
+	for _, oac := range s.OriginAccessControls {
+		if oac.Config.Name == config.Name {
+			return nil, &Error{
+				Code:    errOACAlreadyExists,
+				Message: fmt.Sprintf("An origin access control with name %s already exists", config.Name),
+			}
+		}
+	}
+
+	id := generateOriginAccessControlID()
+
+	oac := &OriginAccessControl{
+		ID:     id,
+		ETag:   generateETag(),
+		Config: convertOriginAccessControlConfigFromXML(config),
+	}
+
+	s.OriginAccessControls[id] = oac
+
+	return oac, nil
+}
+
+// GetOriginAccessControl retrieves an origin access control by ID.
+func (s *MemoryStorage) GetOriginAccessControl(_ context.Context, id string) (*OriginAccessControl, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	oac, exists := s.OriginAccessControls[id]
+	if !exists {
+		return nil, noSuchOriginAccessControl(id)
+	}
+
+	return oac, nil
+}
+
+// ListOriginAccessControls lists all origin access controls.
+func (s *MemoryStorage) ListOriginAccessControls(_ context.Context, marker string, maxItems int) ([]*OriginAccessControl, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if maxItems <= 0 {
+		maxItems = 100
+	}
+
+	oacs := make([]*OriginAccessControl, 0, len(s.OriginAccessControls))
+	for _, oac := range s.OriginAccessControls {
+		oacs = append(oacs, oac)
+	}
+
+	// Sort by ID for consistent ordering.
+	slices.SortFunc(oacs, func(a, b *OriginAccessControl) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	// Apply marker-based pagination.
+	startIdx := 0
+
+	if marker != "" {
+		for i, oac := range oacs {
+			if oac.ID == marker {
+				startIdx = i + 1
+
+				break
+			}
+		}
+	}
+
+	// Slice the results.
+	endIdx := min(startIdx+maxItems, len(oacs))
+
+	result := oacs[startIdx:endIdx]
+
+	// Determine next marker.
+	var nextMarker string
+	if endIdx < len(oacs) {
+		nextMarker = oacs[endIdx-1].ID
+	}
+
+	return result, nextMarker, nil
+}
+
+// UpdateOriginAccessControl replaces the configuration of an origin access control.
+func (s *MemoryStorage) UpdateOriginAccessControl(_ context.Context, id string, config *OriginAccessControlConfigXML, etag string) (*OriginAccessControl, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	oac, exists := s.OriginAccessControls[id]
+	if !exists {
+		return nil, noSuchOriginAccessControl(id)
+	}
+
+	// Validate ETag.
+	if oac.ETag != etag {
+		return nil, &Error{
+			Code:    errInvalidIfMatchVersion,
+			Message: "The If-Match version is missing or not valid for the resource",
+		}
+	}
+
+	if err := validateOriginAccessControlConfig(config); err != nil {
+		return nil, err
+	}
+
+	for _, other := range s.OriginAccessControls {
+		if other.ID != id && other.Config.Name == config.Name {
+			return nil, &Error{
+				Code:    errOACAlreadyExists,
+				Message: fmt.Sprintf("An origin access control with name %s already exists", config.Name),
+			}
+		}
+	}
+
+	oac.ETag = generateETag()
+	oac.Config = convertOriginAccessControlConfigFromXML(config)
+
+	return oac, nil
+}
+
+// DeleteOriginAccessControl deletes an origin access control that no distribution uses.
+func (s *MemoryStorage) DeleteOriginAccessControl(_ context.Context, id, etag string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	oac, exists := s.OriginAccessControls[id]
+	if !exists {
+		return noSuchOriginAccessControl(id)
+	}
+
+	// Validate ETag.
+	if oac.ETag != etag {
+		return &Error{
+			Code:    errInvalidIfMatchVersion,
+			Message: "The If-Match version is missing or not valid for the resource",
+		}
+	}
+
+	for _, dist := range s.Distributions {
+		if slices.Contains(originAccessControlIDs(dist.DistributionConfig.Origins), id) {
+			return &Error{
+				Code:    errOACInUse,
+				Message: fmt.Sprintf("The origin access control %s is in use by distribution %s", id, dist.ID),
+			}
+		}
+	}
+
+	delete(s.OriginAccessControls, id)
+
+	return nil
+}
+
+// checkOriginAccessControls reports an error if an origin references an
+// origin access control that does not exist.
+func (s *MemoryStorage) checkOriginAccessControls(origins *Origins) error {
+	for _, id := range originAccessControlIDs(origins) {
+		if _, exists := s.OriginAccessControls[id]; !exists {
+			return noSuchOriginAccessControl(id)
+		}
+	}
+
+	return nil
+}
+
+// TagResource adds or overwrites tags on a distribution.
+func (s *MemoryStorage) TagResource(_ context.Context, resource string, tags map[string]string) error {
+	if err := validateTags(tags); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dist, err := s.taggableResource(resource)
+	if err != nil {
+		return err
+	}
+
+	if dist.Tags == nil {
+		dist.Tags = make(map[string]string, len(tags))
+	}
+
+	maps.Copy(dist.Tags, tags)
+
+	if len(dist.Tags) > maxTagsPerResource {
+		for k := range tags {
+			delete(dist.Tags, k)
+		}
+
+		return &Error{Code: errInvalidTagging, Message: fmt.Sprintf("A resource can have at most %d tags", maxTagsPerResource)}
+	}
+
+	return nil
+}
+
+// UntagResource removes tags from a distribution.
+func (s *MemoryStorage) UntagResource(_ context.Context, resource string, keys []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dist, err := s.taggableResource(resource)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		delete(dist.Tags, k)
+	}
+
+	return nil
+}
+
+// ListTagsForResource returns the tags of a distribution.
+func (s *MemoryStorage) ListTagsForResource(_ context.Context, resource string) (map[string]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	dist, err := s.taggableResource(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	return maps.Clone(dist.Tags), nil
+}
+
+// taggableResource returns the distribution with the given ARN. Must be called under lock.
+func (s *MemoryStorage) taggableResource(resource string) (*Distribution, error) {
+	id, ok := strings.CutPrefix(resource, "arn:aws:cloudfront::000000000000:distribution/")
+	if !ok {
+		return nil, &Error{Code: errInvalidArgument, Message: fmt.Sprintf("Invalid resource ARN: %s", resource)}
+	}
+
+	dist, exists := s.Distributions[id]
+	if !exists {
+		return nil, &Error{Code: errNoSuchResource, Message: fmt.Sprintf("The specified resource %s does not exist", resource)}
+	}
+
+	return dist, nil
+}
+
 // Helper functions.
 
+// maxTagsPerResource is the number of tags a CloudFront resource can have.
+const maxTagsPerResource = 50
+
+// validateTags reports whether the tag keys and values have lengths CloudFront accepts.
+func validateTags(tags map[string]string) error {
+	if len(tags) > maxTagsPerResource {
+		return &Error{Code: errInvalidTagging, Message: fmt.Sprintf("A resource can have at most %d tags", maxTagsPerResource)}
+	}
+
+	for k, v := range tags {
+		if k == "" || len(k) > 128 {
+			return &Error{Code: errInvalidTagging, Message: fmt.Sprintf("Invalid tag key: %q", k)}
+		}
+
+		if len(v) > 256 {
+			return &Error{Code: errInvalidTagging, Message: fmt.Sprintf("Invalid value for tag %q", k)}
+		}
+	}
+
+	return nil
+}
+
 func generateDistributionID() string {
 	return "E" + uuid.New().String()[:13]
 }
@@ -446,6 +760,61 @@ func generateETag() string {
 	return "E" + uuid.New().String()[:32]
 }
 
+func generateOriginAccessControlID() string {
+	return "E" + uuid.New().String()[:13]
+}
+
+func noSuchOriginAccessControl(id string) *Error {
+	return &Error{
+		Code:    errNoSuchOriginAccessControl,
+		Message: fmt.Sprintf("The specified origin access control %s does not exist", id),
+	}
+}
+
+// originAccessControlIDs returns the origin access controls referenced by the origins.
+func originAccessControlIDs(origins *Origins) []string {
+	if origins == nil {
+		return nil
+	}
+
+	var ids []string
+
+	for _, o := range origins.Items {
+		if o.OriginAccessControlID != "" {
+			ids = append(ids, o.OriginAccessControlID)
+		}
+	}
+
+	return ids
+}
+
+// validateOriginAccessControlConfig reports whether the configuration uses the
+// signing protocol, signing behaviors and origin types CloudFront supports.
+func validateOriginAccessControlConfig(config *OriginAccessControlConfigXML) error {
+	switch {
+	case config.Name == "":
+		return &Error{Code: errInvalidArgument, Message: "Name is required"}
+	case config.SigningProtocol != "sigv4":
+		return &Error{Code: errInvalidArgument, Message: fmt.Sprintf("Invalid SigningProtocol: %s", config.SigningProtocol)}
+	case !slices.Contains([]string{"always", "never", "no-override"}, config.SigningBehavior):
+		return &Error{Code: errInvalidArgument, Message: fmt.Sprintf("Invalid SigningBehavior: %s", config.SigningBehavior)}
+	case !slices.Contains([]string{"s3", "mediastore", "mediapackagev2", "lambda"}, config.OriginAccessControlOriginType):
+		return &Error{Code: errInvalidArgument, Message: fmt.Sprintf("Invalid OriginAccessControlOriginType: %s", config.OriginAccessControlOriginType)}
+	}
+
+	return nil
+}
+
+func convertOriginAccessControlConfigFromXML(config *OriginAccessControlConfigXML) *OriginAccessControlConfig {
+	return &OriginAccessControlConfig{
+		Name:                          config.Name,
+		Description:                   config.Description,
+		SigningProtocol:               config.SigningProtocol,
+		SigningBehavior:               config.SigningBehavior,
+		OriginAccessControlOriginType: config.OriginAccessControlOriginType,
+	}
+}
+
 func defaultString(s, def string) string {
 	if s == "" {
 		return def
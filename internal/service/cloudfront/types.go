@@ -17,6 +17,7 @@ type Distribution struct {
 	DistributionConfig     *DistributionConfig
 	ActiveTrustedSigners   *ActiveTrustedSigners
 	ActiveTrustedKeyGroups *ActiveTrustedKeyGroups
+	Tags                   map[string]string
 }
 
 // DistributionConfig represents CloudFront distribution configuration.
@@ -230,6 +231,22 @@ type Paths struct {
 	Items    []string
 }
 
+// OriginAccessControl represents a CloudFront origin access control.
+type OriginAccessControl struct {
+	ID     string
+	ETag   string
+	Config *OriginAccessControlConfig
+}
+
+// OriginAccessControlConfig represents origin access control configuration.
+type OriginAccessControlConfig struct {
+	Name                          string
+	Description                   string
+	SigningProtocol               string
+	SigningBehavior               string
+	OriginAccessControlOriginType string
+}
+
 // XML Response Types
 
 // CreateDistributionResult is the response for CreateDistribution.
@@ -497,6 +514,42 @@ type CreateDistributionRequest struct {
 	IsIPV6Enabled        bool                     `xml:"IsIPV6Enabled,omitempty"`
 }
 
+// CreateDistributionWithTagsRequest represents a CreateDistributionWithTags request body.
+type CreateDistributionWithTagsRequest struct {
+	XMLName            xml.Name                  `xml:"DistributionConfigWithTags"`
+	DistributionConfig CreateDistributionRequest `xml:"DistributionConfig"`
+	Tags               TagsXML                   `xml:"Tags"`
+}
+
+// TagsXML represents a set of resource tags in XML format.
+type TagsXML struct {
+	XMLName xml.Name     `xml:"Tags"`
+	Xmlns   string       `xml:"xmlns,attr,omitempty"`
+	Items   *TagItemsXML `xml:"Items,omitempty"`
+}
+
+// TagItemsXML is a list of tags.
+type TagItemsXML struct {
+	Tag []TagXML `xml:"Tag"`
+}
+
+// TagXML represents a resource tag in XML format.
+type TagXML struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value,omitempty"`
+}
+
+// TagKeysXML represents the keys of the tags to remove from a resource in XML format.
+type TagKeysXML struct {
+	XMLName xml.Name        `xml:"TagKeys"`
+	Items   *TagKeyItemsXML `xml:"Items,omitempty"`
+}
+
+// TagKeyItemsXML is a list of tag keys.
+type TagKeyItemsXML struct {
+	Key []string `xml:"Key"`
+}
+
 // InvalidationXML represents an invalidation in XML format.
 type InvalidationXML struct {
 	XMLName           xml.Name              `xml:"Invalidation"`
@@ -549,6 +602,54 @@ type InvalidationSummaryXML struct {
 	Status     string `xml:"Status"`
 }
 
+// OriginAccessControlConfigXML represents origin access control config in XML
+// format. It is both the request body of CreateOriginAccessControl and
+// UpdateOriginAccessControl and the response of GetOriginAccessControlConfig.
+type OriginAccessControlConfigXML struct {
+	XMLName                       xml.Name `xml:"OriginAccessControlConfig"`
+	Xmlns                         string   `xml:"xmlns,attr,omitempty"`
+	Name                          string   `xml:"Name"`
+	Description                   string   `xml:"Description"`
+	SigningProtocol               string   `xml:"SigningProtocol"`
+	SigningBehavior               string   `xml:"SigningBehavior"`
+	OriginAccessControlOriginType string   `xml:"OriginAccessControlOriginType"`
+}
+
+// OriginAccessControlXML represents an origin access control in XML format.
+type OriginAccessControlXML struct {
+	XMLName                   xml.Name                      `xml:"OriginAccessControl"`
+	Xmlns                     string                        `xml:"xmlns,attr"`
+	ID                        string                        `xml:"Id"`
+	OriginAccessControlConfig *OriginAccessControlConfigXML `xml:"OriginAccessControlConfig"`
+}
+
+// OriginAccessControlListXML represents a list of origin access controls in XML format.
+type OriginAccessControlListXML struct {
+	XMLName     xml.Name                        `xml:"OriginAccessControlList"`
+	Xmlns       string                          `xml:"xmlns,attr"`
+	Marker      string                          `xml:"Marker"`
+	NextMarker  string                          `xml:"NextMarker,omitempty"`
+	MaxItems    int                             `xml:"MaxItems"`
+	IsTruncated bool                            `xml:"IsTruncated"`
+	Quantity    int                             `xml:"Quantity"`
+	Items       *OriginAccessControlSummaryList `xml:"Items,omitempty"`
+}
+
+// OriginAccessControlSummaryList is a list of origin access control summaries.
+type OriginAccessControlSummaryList struct {
+	OriginAccessControlSummary []OriginAccessControlSummaryXML `xml:"OriginAccessControlSummary"`
+}
+
+// OriginAccessControlSummaryXML represents an origin access control summary in XML format.
+type OriginAccessControlSummaryXML struct {
+	ID                            string `xml:"Id"`
+	Description                   string `xml:"Description"`
+	Name                          string `xml:"Name"`
+	SigningProtocol               string `xml:"SigningProtocol"`
+	SigningBehavior               string `xml:"SigningBehavior"`
+	OriginAccessControlOriginType string `xml:"OriginAccessControlOriginType"`
+}
+
 // ErrorResponse represents a CloudFront error response.
 type ErrorResponse struct {
 	XMLName   xml.Name    `xml:"ErrorResponse"`
@@ -574,4 +675,9 @@ const (
 	errPreconditionFailed        = "PreconditionFailed"
 	errInvalidIfMatchVersion     = "InvalidIfMatchVersion"
 	errNoSuchInvalidation        = "NoSuchInvalidation"
+	errNoSuchOriginAccessControl = "NoSuchOriginAccessControl"
+	errOACAlreadyExists          = "OriginAccessControlAlreadyExists"
+	errOACInUse                  = "OriginAccessControlInUse"
+	errNoSuchResource            = "NoSuchResource"
+	errInvalidTagging            = "InvalidTagging"
 )
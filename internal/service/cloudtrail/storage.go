@@ -33,6 +33,7 @@ type Storage interface {
 	StopLogging(ctx context.Context, name string) error
 	LookupEvents(ctx context.Context, req *LookupEventsRequest) ([]*Event, string, error)
 	GetTrailStatus(ctx context.Context, name string) (*Trail, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -126,6 +127,16 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Trails = make(map[string]*Trail)
+
+	return nil
+}
+
 // CreateTrail creates a new trail.
 func (m *MemoryStorage) CreateTrail(_ context.Context, req *CreateTrailRequest) (*Trail, error) {
 	m.mu.Lock()
@@ -1,10 +1,10 @@
 package cloudwatch
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 
 	"github.com/sivchari/kumo/internal/server"
 	"github.com/sivchari/kumo/internal/service"
@@ -14,13 +14,16 @@ import (
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"monitoring"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
+
+		storage := NewMemoryStorage("", opts...)
 
-	storage := NewMemoryStorage("", opts...)
-	service.Register(New(storage))
+		return []service.Service{New(storage)}
+	})
 }
 
 // Service implements the CloudWatch service.
@@ -86,3 +89,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ type Storage interface {
 	PutMetricAlarm(ctx context.Context, req *PutMetricAlarmRequest) error
 	DeleteAlarms(ctx context.Context, alarmNames []string) error
 	DescribeAlarms(ctx context.Context, req *DescribeAlarmsRequest) (*DescribeAlarmsResult, error)
+	Reset(ctx context.Context) error
 }
 
 // MetricKey uniquely identifies a metric.
@@ -171,6 +172,17 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Metrics = make(map[MetricKey]*StoredMetric)
+	s.Alarms = make(map[string]*Alarm)
+
+	return nil
+}
+
 // PutMetricData stores metric data.
 func (s *MemoryStorage) PutMetricData(_ context.Context, namespace string, metricData []MetricDatum) error {
 	s.mu.Lock()
@@ -239,6 +239,235 @@ func (s *Service) DescribeLogStreams(w http.ResponseWriter, r *http.Request) {
 	writeJSONResponse(w, resp)
 }
 
+// TagLogGroup handles the TagLogGroup action.
+func (s *Service) TagLogGroup(w http.ResponseWriter, r *http.Request) {
+	var req TagLogGroupRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeLogsError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.LogGroupName == "" || len(req.Tags) == 0 {
+		writeLogsError(w, errInvalidParameter, "1 validation error detected: Value at 'logGroupName' or 'tags' failed to satisfy constraint: Member must not be null", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.TagLogGroup(r.Context(), req.LogGroupName, req.Tags); err != nil {
+		handleLogsError(w, err)
+
+		return
+	}
+
+	writeEmptyResponse(w)
+}
+
+// UntagLogGroup handles the UntagLogGroup action.
+func (s *Service) UntagLogGroup(w http.ResponseWriter, r *http.Request) {
+	var req UntagLogGroupRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeLogsError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.LogGroupName == "" || len(req.Tags) == 0 {
+		writeLogsError(w, errInvalidParameter, "1 validation error detected: Value at 'logGroupName' or 'tags' failed to satisfy constraint: Member must not be null", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.UntagLogGroup(r.Context(), req.LogGroupName, req.Tags); err != nil {
+		handleLogsError(w, err)
+
+		return
+	}
+
+	writeEmptyResponse(w)
+}
+
+// ListTagsLogGroup handles the ListTagsLogGroup action.
+func (s *Service) ListTagsLogGroup(w http.ResponseWriter, r *http.Request) {
+	var req ListTagsLogGroupRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeLogsError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.LogGroupName == "" {
+		writeLogsError(w, errInvalidParameter, "1 validation error detected: Value at 'logGroupName' failed to satisfy constraint: Member must not be null", http.StatusBadRequest)
+
+		return
+	}
+
+	tags, err := s.storage.ListTagsLogGroup(r.Context(), req.LogGroupName)
+	if err != nil {
+		handleLogsError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, &ListTagsLogGroupResponse{Tags: tags})
+}
+
+// TagResource handles the TagResource action.
+func (s *Service) TagResource(w http.ResponseWriter, r *http.Request) {
+	var req TagResourceRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeLogsError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.ResourceARN == "" || len(req.Tags) == 0 {
+		writeLogsError(w, errInvalidParameter, "1 validation error detected: Value at 'resourceArn' or 'tags' failed to satisfy constraint: Member must not be null", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.TagResource(r.Context(), req.ResourceARN, req.Tags); err != nil {
+		handleLogsError(w, err)
+
+		return
+	}
+
+	writeEmptyResponse(w)
+}
+
+// UntagResource handles the UntagResource action.
+func (s *Service) UntagResource(w http.ResponseWriter, r *http.Request) {
+	var req UntagResourceRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeLogsError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.ResourceARN == "" || len(req.TagKeys) == 0 {
+		writeLogsError(w, errInvalidParameter, "1 validation error detected: Value at 'resourceArn' or 'tagKeys' failed to satisfy constraint: Member must not be null", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.UntagResource(r.Context(), req.ResourceARN, req.TagKeys); err != nil {
+		handleLogsError(w, err)
+
+		return
+	}
+
+	writeEmptyResponse(w)
+}
+
+// ListTagsForResource handles the ListTagsForResource action.
+func (s *Service) ListTagsForResource(w http.ResponseWriter, r *http.Request) {
+	var req ListTagsForResourceRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeLogsError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.ResourceARN == "" {
+		writeLogsError(w, errInvalidParameter, "1 validation error detected: Value at 'resourceArn' failed to satisfy constraint: Member must not be null", http.StatusBadRequest)
+
+		return
+	}
+
+	tags, err := s.storage.ListTagsForResource(r.Context(), req.ResourceARN)
+	if err != nil {
+		handleLogsError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, &ListTagsForResourceResponse{Tags: tags})
+}
+
+// StartQuery handles the StartQuery action.
+func (s *Service) StartQuery(w http.ResponseWriter, r *http.Request) {
+	var req StartQueryRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeLogsError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.QueryString == "" {
+		writeLogsError(w, errInvalidParameter, "1 validation error detected: Value at 'queryString' failed to satisfy constraint: Member must not be null", http.StatusBadRequest)
+
+		return
+	}
+
+	query, err := s.storage.StartQuery(r.Context(), &req)
+	if err != nil {
+		handleLogsError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, &StartQueryResponse{QueryID: query.QueryID})
+}
+
+// GetQueryResults handles the GetQueryResults action.
+func (s *Service) GetQueryResults(w http.ResponseWriter, r *http.Request) {
+	var req GetQueryResultsRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeLogsError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.QueryID == "" {
+		writeLogsError(w, errInvalidParameter, "1 validation error detected: Value at 'queryId' failed to satisfy constraint: Member must not be null", http.StatusBadRequest)
+
+		return
+	}
+
+	query, err := s.storage.GetQueryResults(r.Context(), req.QueryID)
+	if err != nil {
+		handleLogsError(w, err)
+
+		return
+	}
+
+	results := query.Results
+	if results == nil {
+		results = [][]ResultField{}
+	}
+
+	writeJSONResponse(w, &GetQueryResultsResponse{
+		Results:    results,
+		Statistics: query.Statistics,
+		Status:     query.Status,
+	})
+}
+
+// StopQuery handles the StopQuery action.
+func (s *Service) StopQuery(w http.ResponseWriter, r *http.Request) {
+	var req StopQueryRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeLogsError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.QueryID == "" {
+		writeLogsError(w, errInvalidParameter, "1 validation error detected: Value at 'queryId' failed to satisfy constraint: Member must not be null", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.StopQuery(r.Context(), req.QueryID); err != nil {
+		handleLogsError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, &StopQueryResponse{Success: true})
+}
+
 // DispatchAction routes the request to the appropriate handler based on X-Amz-Target header.
 // This method implements the JSONProtocolService interface.
 func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
@@ -264,6 +493,24 @@ func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
 		s.DescribeLogGroups(w, r)
 	case "DescribeLogStreams":
 		s.DescribeLogStreams(w, r)
+	case "TagLogGroup":
+		s.TagLogGroup(w, r)
+	case "UntagLogGroup":
+		s.UntagLogGroup(w, r)
+	case "ListTagsLogGroup":
+		s.ListTagsLogGroup(w, r)
+	case "TagResource":
+		s.TagResource(w, r)
+	case "UntagResource":
+		s.UntagResource(w, r)
+	case "ListTagsForResource":
+		s.ListTagsForResource(w, r)
+	case "StartQuery":
+		s.StartQuery(w, r)
+	case "GetQueryResults":
+		s.GetQueryResults(w, r)
+	case "StopQuery":
+		s.StopQuery(w, r)
 	default:
 		writeLogsError(w, errInvalidAction, "The action "+action+" is not valid for this web service", http.StatusBadRequest)
 	}
@@ -0,0 +1,1090 @@
+package cloudwatchlogs
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Logs Insights query statuses.
+const (
+	queryStatusRunning   = "Running"
+	queryStatusComplete  = "Complete"
+	queryStatusFailed    = "Failed"
+	queryStatusCancelled = "Cancelled"
+)
+
+// defaultQueryLimit is the number of rows a query returns without a limit.
+const defaultQueryLimit = 10000
+
+// insightsTimestampLayout is how Logs Insights formats @timestamp values.
+const insightsTimestampLayout = "2006-01-02 15:04:05.000"
+
+// queryRow is a log event or aggregated row, keyed by field name.
+type queryRow map[string]string
+
+// queryResultSet is the state a query's commands operate on.
+type queryResultSet struct {
+	rows       []queryRow
+	fields     []string
+	aggregated bool
+	matched    int
+}
+
+// insightsCommand is a single command of a query pipeline.
+type insightsCommand interface {
+	apply(rs *queryResultSet)
+}
+
+// insightsQuery is a parsed Logs Insights query.
+type insightsQuery struct {
+	commands []insightsCommand
+}
+
+// malformedQueryError returns the error for a query that cannot be parsed.
+func malformedQueryError(format string, args ...any) error {
+	return &LogsError{
+		Code:    "MalformedQueryException",
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// parseInsightsQuery parses a query of fields, filter, sort, limit and stats
+// commands separated by pipes.
+func parseInsightsQuery(query string) (*insightsQuery, error) {
+	q := &insightsQuery{}
+
+	for _, part := range splitPipeline(query) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, args, _ := strings.Cut(part, " ")
+
+		tokens, err := tokenizeQuery(args)
+		if err != nil {
+			return nil, err
+		}
+
+		var cmd insightsCommand
+
+		switch strings.ToLower(name) {
+		case "fields":
+			cmd, err = parseFieldsCommand(tokens)
+		case "filter":
+			cmd, err = parseFilterCommand(tokens)
+		case "sort":
+			cmd, err = parseSortCommand(tokens)
+		case "limit":
+			cmd, err = parseLimitCommand(tokens)
+		case "stats":
+			cmd, err = parseStatsCommand(tokens)
+		default:
+			return nil, malformedQueryError("unexpected command: %s", name)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		q.commands = append(q.commands, cmd)
+	}
+
+	if len(q.commands) == 0 {
+		return nil, malformedQueryError("query string is empty")
+	}
+
+	return q, nil
+}
+
+// run executes the query against the rows of the scanned log events and
+// returns the result rows as field/value pairs.
+func (q *insightsQuery) run(rows []queryRow, limit int) ([][]ResultField, int) {
+	rs := &queryResultSet{rows: rows, matched: len(rows)}
+
+	for _, cmd := range q.commands {
+		cmd.apply(rs)
+	}
+
+	if len(rs.rows) > limit {
+		rs.rows = rs.rows[:limit]
+	}
+
+	fields := rs.fields
+	if len(fields) == 0 {
+		fields = []string{"@timestamp", "@message"}
+	}
+
+	if !rs.aggregated && !slices.Contains(fields, "@ptr") {
+		fields = append(slices.Clone(fields), "@ptr")
+	}
+
+	results := make([][]ResultField, 0, len(rs.rows))
+
+	for _, row := range rs.rows {
+		result := make([]ResultField, 0, len(fields))
+
+		for _, field := range fields {
+			if value, ok := row[field]; ok {
+				result = append(result, ResultField{Field: field, Value: value})
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, rs.matched
+}
+
+// eventRow returns the fields of a log event: the system fields plus the
+// fields discovered in a JSON message.
+func eventRow(accountID, groupName, streamName string, index int, event *LogEvent) queryRow {
+	row := queryRow{}
+
+	if strings.HasPrefix(strings.TrimSpace(event.Message), "{") {
+		dec := json.NewDecoder(strings.NewReader(event.Message))
+		dec.UseNumber()
+
+		var doc map[string]any
+		if err := dec.Decode(&doc); err == nil {
+			flattenJSONFields(row, "", doc)
+		}
+	}
+
+	ptr := fmt.Sprintf("%s/%s/%d", groupName, streamName, index)
+
+	row["@timestamp"] = time.UnixMilli(event.Timestamp).UTC().Format(insightsTimestampLayout)
+	row["@message"] = event.Message
+	row["@logStream"] = streamName
+	row["@log"] = accountID + ":" + groupName
+	row["@ptr"] = base64.StdEncoding.EncodeToString([]byte(ptr))
+
+	return row
+}
+
+// flattenJSONFields adds the values of a decoded JSON document to row, naming
+// nested fields with dots as Logs Insights does.
+func flattenJSONFields(row queryRow, prefix string, value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			name := key
+			if prefix != "" {
+				name = prefix + "." + key
+			}
+
+			flattenJSONFields(row, name, child)
+		}
+	case []any:
+		for i, child := range v {
+			flattenJSONFields(row, prefix+"."+strconv.Itoa(i), child)
+		}
+	case nil:
+		// Null values are not discovered as fields.
+	case string:
+		row[prefix] = v
+	default:
+		row[prefix] = fmt.Sprint(v)
+	}
+}
+
+// splitPipeline splits a query into commands at the pipes that are not
+// inside a quoted string, regular expression or backquoted field name.
+func splitPipeline(query string) []string {
+	var (
+		parts []string
+		start int
+		quote byte
+	)
+
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'' || c == '/' || c == '`':
+			quote = c
+		case c == '|':
+			parts = append(parts, query[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, query[start:])
+}
+
+// Query token kinds.
+const (
+	tokIdent = iota
+	tokString
+	tokNumber
+	tokRegex
+	tokOperator
+	tokPunct
+)
+
+// queryToken is a lexical token of a query command.
+type queryToken struct {
+	kind int
+	text string
+}
+
+// isKeyword reports whether the token is the given case-insensitive keyword.
+func (t queryToken) isKeyword(keyword string) bool {
+	return t.kind == tokIdent && strings.EqualFold(t.text, keyword)
+}
+
+// tokenizeQuery splits the arguments of a command into tokens.
+func tokenizeQuery(s string) ([]queryToken, error) {
+	var tokens []queryToken
+
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '"' || c == '\'' || c == '/' || c == '`':
+			text, next, err := readQuoted(s, i)
+			if err != nil {
+				return nil, err
+			}
+
+			kind := tokString
+
+			switch c {
+			case '/':
+				kind = tokRegex
+			case '`':
+				kind = tokIdent
+			}
+
+			tokens = append(tokens, queryToken{kind: kind, text: text})
+			i = next
+		case strings.ContainsRune("=!<>", rune(c)):
+			op := string(c)
+			if i+1 < len(s) && (s[i+1] == '=' || (c == '=' && s[i+1] == '~')) {
+				op += string(s[i+1])
+			}
+
+			if op == "!" {
+				return nil, malformedQueryError("unexpected character '!'")
+			}
+
+			tokens = append(tokens, queryToken{kind: tokOperator, text: op})
+			i += len(op)
+		case strings.ContainsRune("(),[]*", rune(c)):
+			tokens = append(tokens, queryToken{kind: tokPunct, text: string(c)})
+			i++
+		case isNumberStart(s, i):
+			j := i + 1
+			for j < len(s) && (isDigit(s[j]) || s[j] == '.') {
+				j++
+			}
+
+			tokens = append(tokens, queryToken{kind: tokNumber, text: s[i:j]})
+			i = j
+		case isIdentChar(c):
+			j := i
+			for j < len(s) && isIdentChar(s[j]) {
+				j++
+			}
+
+			tokens = append(tokens, queryToken{kind: tokIdent, text: s[i:j]})
+			i = j
+		default:
+			return nil, malformedQueryError("unexpected character '%c'", c)
+		}
+	}
+
+	return tokens, nil
+}
+
+// readQuoted reads the quoted string, regular expression or field name that
+// starts at s[start], returning its unescaped text and the index after it.
+func readQuoted(s string, start int) (string, int, error) {
+	quote := s[start]
+
+	var b strings.Builder
+
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				// Regular expressions keep their escapes.
+				if quote == '/' && s[i+1] != '/' {
+					b.WriteByte('\\')
+				}
+
+				b.WriteByte(s[i+1])
+				i++
+			}
+		case quote:
+			return b.String(), i + 1, nil
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return "", 0, malformedQueryError("unterminated %c", quote)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isNumberStart(s string, i int) bool {
+	return isDigit(s[i]) || (s[i] == '-' && i+1 < len(s) && isDigit(s[i+1]))
+}
+
+func isIdentChar(c byte) bool {
+	return c == '@' || c == '_' || c == '.' || c == '-' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// fieldsCommand selects the fields a query returns.
+type fieldsCommand struct {
+	fields []string
+}
+
+func parseFieldsCommand(tokens []queryToken) (insightsCommand, error) {
+	fields, err := parseFieldList(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fieldsCommand{fields: fields}, nil
+}
+
+func (c *fieldsCommand) apply(rs *queryResultSet) {
+	for _, field := range c.fields {
+		if !slices.Contains(rs.fields, field) {
+			rs.fields = append(rs.fields, field)
+		}
+	}
+}
+
+// parseFieldList parses a comma-separated list of field names.
+func parseFieldList(tokens []queryToken) ([]string, error) {
+	var fields []string
+
+	for i := 0; i < len(tokens); i += 2 {
+		if tokens[i].kind != tokIdent {
+			return nil, malformedQueryError("expected a field name, found '%s'", tokens[i].text)
+		}
+
+		fields = append(fields, tokens[i].text)
+
+		if i+1 < len(tokens) && tokens[i+1].text != "," {
+			return nil, malformedQueryError("expected ',', found '%s'", tokens[i+1].text)
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil, malformedQueryError("expected a field name")
+	}
+
+	return fields, nil
+}
+
+// sortKey is a field a sort command orders rows by.
+type sortKey struct {
+	field string
+	desc  bool
+}
+
+// sortCommand orders rows by one or more fields.
+type sortCommand struct {
+	keys []sortKey
+}
+
+func parseSortCommand(tokens []queryToken) (insightsCommand, error) {
+	cmd := &sortCommand{}
+
+	for i := 0; i < len(tokens); {
+		if tokens[i].kind != tokIdent {
+			return nil, malformedQueryError("expected a field name, found '%s'", tokens[i].text)
+		}
+
+		key := sortKey{field: tokens[i].text}
+		i++
+
+		if i < len(tokens) && (tokens[i].isKeyword("asc") || tokens[i].isKeyword("desc")) {
+			key.desc = tokens[i].isKeyword("desc")
+			i++
+		}
+
+		cmd.keys = append(cmd.keys, key)
+
+		if i < len(tokens) {
+			if tokens[i].text != "," {
+				return nil, malformedQueryError("expected ',', found '%s'", tokens[i].text)
+			}
+
+			i++
+		}
+	}
+
+	if len(cmd.keys) == 0 {
+		return nil, malformedQueryError("expected a field name")
+	}
+
+	return cmd, nil
+}
+
+func (c *sortCommand) apply(rs *queryResultSet) {
+	sort.SliceStable(rs.rows, func(i, j int) bool {
+		for _, key := range c.keys {
+			cmp := compareValues(rs.rows[i][key.field], rs.rows[j][key.field])
+			if cmp == 0 {
+				continue
+			}
+
+			if key.desc {
+				return cmp > 0
+			}
+
+			return cmp < 0
+		}
+
+		return false
+	})
+}
+
+// limitCommand caps the number of rows.
+type limitCommand struct {
+	n int
+}
+
+func parseLimitCommand(tokens []queryToken) (insightsCommand, error) {
+	if len(tokens) != 1 || tokens[0].kind != tokNumber {
+		return nil, malformedQueryError("limit expects a positive integer")
+	}
+
+	n, err := strconv.Atoi(tokens[0].text)
+	if err != nil || n < 1 {
+		return nil, malformedQueryError("limit expects a positive integer")
+	}
+
+	return &limitCommand{n: n}, nil
+}
+
+func (c *limitCommand) apply(rs *queryResultSet) {
+	if len(rs.rows) > c.n {
+		rs.rows = rs.rows[:c.n]
+	}
+}
+
+// filterCommand keeps the rows that match a predicate.
+type filterCommand struct {
+	match predicate
+}
+
+func parseFilterCommand(tokens []queryToken) (insightsCommand, error) {
+	p := &exprParser{tokens: tokens}
+
+	match, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.done() {
+		return nil, malformedQueryError("unexpected '%s' in filter", p.peek().text)
+	}
+
+	return &filterCommand{match: match}, nil
+}
+
+func (c *filterCommand) apply(rs *queryResultSet) {
+	kept := rs.rows[:0:0]
+
+	for _, row := range rs.rows {
+		if c.match(row) {
+			kept = append(kept, row)
+		}
+	}
+
+	rs.rows = kept
+	if !rs.aggregated {
+		rs.matched = len(kept)
+	}
+}
+
+// predicate reports whether a row matches a filter expression.
+type predicate func(row queryRow) bool
+
+// operand is a field reference or literal in a filter expression.
+type operand struct {
+	field   string
+	literal string
+	isField bool
+}
+
+// value returns the operand's value in row, and whether it has one.
+func (o operand) value(row queryRow) (string, bool) {
+	if !o.isField {
+		return o.literal, true
+	}
+
+	v, ok := row[o.field]
+
+	return v, ok
+}
+
+// exprParser is a recursive descent parser for filter expressions.
+type exprParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+func (p *exprParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *exprParser) peek() queryToken {
+	if p.done() {
+		return queryToken{}
+	}
+
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() (queryToken, error) {
+	if p.done() {
+		return queryToken{}, malformedQueryError("unexpected end of filter expression")
+	}
+
+	t := p.tokens[p.pos]
+	p.pos++
+
+	return t, nil
+}
+
+func (p *exprParser) expect(text string) error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+
+	if t.text != text {
+		return malformedQueryError("expected '%s', found '%s'", text, t.text)
+	}
+
+	return nil
+}
+
+func (p *exprParser) parseOr() (predicate, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().isKeyword("or") {
+		p.pos++
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		l := left
+		left = func(row queryRow) bool { return l(row) || right(row) }
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseAnd() (predicate, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().isKeyword("and") {
+		p.pos++
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		l := left
+		left = func(row queryRow) bool { return l(row) && right(row) }
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (predicate, error) {
+	switch t := p.peek(); {
+	case t.isKeyword("not"):
+		p.pos++
+
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(row queryRow) bool { return !inner(row) }, nil
+	case t.kind == tokPunct && t.text == "(":
+		p.pos++
+
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+
+		return inner, nil
+	default:
+		return p.parseComparison()
+	}
+}
+
+func (p *exprParser) parseComparison() (predicate, error) {
+	if t := p.peek(); t.isKeyword("ispresent") && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].text == "(" {
+		p.pos += 2
+
+		field, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+
+		return func(row queryRow) bool {
+			_, ok := row[field.text]
+
+			return ok
+		}, nil
+	}
+
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	negate := false
+	if p.peek().isKeyword("not") {
+		negate = true
+		p.pos++
+	}
+
+	var match predicate
+
+	switch t := p.peek(); {
+	case t.isKeyword("like") || (t.kind == tokOperator && t.text == "=~"):
+		p.pos++
+
+		match, err = p.parseLike(left, t.isKeyword("like"))
+	case t.isKeyword("in"):
+		p.pos++
+
+		match, err = p.parseIn(left)
+	case t.kind == tokOperator && !negate:
+		p.pos++
+
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+
+		return comparison(left, t.text, right), nil
+	case negate:
+		return nil, malformedQueryError("expected 'like' or 'in' after 'not'")
+	default:
+		// A bare operand matches rows where it has a non-empty value.
+		return func(row queryRow) bool {
+			v, ok := left.value(row)
+
+			return ok && v != ""
+		}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if negate {
+		m := match
+		match = func(row queryRow) bool { return !m(row) }
+	}
+
+	return match, nil
+}
+
+func (p *exprParser) parseOperand() (operand, error) {
+	t, err := p.next()
+	if err != nil {
+		return operand{}, err
+	}
+
+	switch t.kind {
+	case tokIdent:
+		return operand{field: t.text, isField: true}, nil
+	case tokString, tokNumber:
+		return operand{literal: t.text}, nil
+	default:
+		return operand{}, malformedQueryError("unexpected '%s' in filter", t.text)
+	}
+}
+
+// parseLike parses the pattern of a like or =~ match. With like, a quoted
+// string matches as a substring; a regular expression always matches as one.
+func (p *exprParser) parseLike(left operand, substring bool) (predicate, error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if t.kind != tokRegex && t.kind != tokString {
+		return nil, malformedQueryError("expected a string or regular expression, found '%s'", t.text)
+	}
+
+	if t.kind == tokString && substring {
+		return func(row queryRow) bool {
+			v, ok := left.value(row)
+
+			return ok && strings.Contains(v, t.text)
+		}, nil
+	}
+
+	re, err := regexp.Compile(t.text)
+	if err != nil {
+		return nil, malformedQueryError("invalid regular expression /%s/: %v", t.text, err)
+	}
+
+	return func(row queryRow) bool {
+		v, ok := left.value(row)
+
+		return ok && re.MatchString(v)
+	}, nil
+}
+
+// parseIn parses the list of an in match.
+func (p *exprParser) parseIn(left operand) (predicate, error) {
+	if err := p.expect("["); err != nil {
+		return nil, err
+	}
+
+	var values []string
+
+	for {
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+
+		if t.text == "]" && t.kind == tokPunct {
+			break
+		}
+
+		if t.text == "," && t.kind == tokPunct {
+			continue
+		}
+
+		if t.kind != tokString && t.kind != tokNumber {
+			return nil, malformedQueryError("unexpected '%s' in list", t.text)
+		}
+
+		values = append(values, t.text)
+	}
+
+	return func(row queryRow) bool {
+		v, ok := left.value(row)
+		if !ok {
+			return false
+		}
+
+		return slices.ContainsFunc(values, func(candidate string) bool {
+			return compareValues(v, candidate) == 0
+		})
+	}, nil
+}
+
+// comparison returns a predicate comparing two operands. Rows without a
+// value for either operand never match.
+func comparison(left operand, op string, right operand) predicate {
+	return func(row queryRow) bool {
+		l, ok := left.value(row)
+		if !ok {
+			return false
+		}
+
+		r, ok := right.value(row)
+		if !ok {
+			return false
+		}
+
+		cmp := compareValues(l, r)
+
+		switch op {
+		case "=", "==":
+			return cmp == 0
+		case "!=":
+			return cmp != 0
+		case "<":
+			return cmp < 0
+		case "<=":
+			return cmp <= 0
+		case ">":
+			return cmp > 0
+		case ">=":
+			return cmp >= 0
+		default:
+			return false
+		}
+	}
+}
+
+// compareValues compares two values numerically if both are numbers, and as
+// strings otherwise.
+func compareValues(a, b string) int {
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+
+	if errA == nil && errB == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	return strings.Compare(a, b)
+}
+
+// aggregation is a stats function such as count() or avg(latency).
+type aggregation struct {
+	fn    string
+	field string
+	name  string
+}
+
+// statsCommand aggregates rows, optionally grouped by fields.
+type statsCommand struct {
+	aggregations []aggregation
+	by           []string
+}
+
+func parseStatsCommand(tokens []queryToken) (insightsCommand, error) {
+	cmd := &statsCommand{}
+
+	i := 0
+	for i < len(tokens) {
+		agg, next, err := parseAggregation(tokens, i)
+		if err != nil {
+			return nil, err
+		}
+
+		cmd.aggregations = append(cmd.aggregations, agg)
+		i = next
+
+		if i < len(tokens) && tokens[i].text == "," && tokens[i].kind == tokPunct {
+			i++
+
+			continue
+		}
+
+		break
+	}
+
+	if len(cmd.aggregations) == 0 {
+		return nil, malformedQueryError("stats expects an aggregation function")
+	}
+
+	if i < len(tokens) {
+		if !tokens[i].isKeyword("by") {
+			return nil, malformedQueryError("unexpected '%s' in stats", tokens[i].text)
+		}
+
+		by, err := parseFieldList(tokens[i+1:])
+		if err != nil {
+			return nil, err
+		}
+
+		cmd.by = by
+	}
+
+	return cmd, nil
+}
+
+// parseAggregation parses "fn(field) [as name]" starting at tokens[i].
+func parseAggregation(tokens []queryToken, i int) (aggregation, int, error) {
+	if i+2 >= len(tokens) || tokens[i].kind != tokIdent || tokens[i+1].text != "(" {
+		return aggregation{}, 0, malformedQueryError("stats expects an aggregation function")
+	}
+
+	agg := aggregation{fn: strings.ToLower(tokens[i].text)}
+
+	switch agg.fn {
+	case "count", "sum", "avg", "min", "max":
+	default:
+		return aggregation{}, 0, malformedQueryError("unsupported stats function: %s", tokens[i].text)
+	}
+
+	arg := ""
+	i += 2
+
+	if tokens[i].text != ")" {
+		if tokens[i].kind != tokIdent && tokens[i].text != "*" {
+			return aggregation{}, 0, malformedQueryError("unexpected '%s' in %s()", tokens[i].text, agg.fn)
+		}
+
+		arg = tokens[i].text
+		i++
+	}
+
+	if i >= len(tokens) || tokens[i].text != ")" {
+		return aggregation{}, 0, malformedQueryError("expected ')' after %s(", agg.fn)
+	}
+
+	i++
+
+	if arg != "*" {
+		agg.field = arg
+	}
+
+	if agg.fn != "count" && agg.field == "" {
+		return aggregation{}, 0, malformedQueryError("%s() expects a field", agg.fn)
+	}
+
+	agg.name = agg.fn + "(" + arg + ")"
+
+	if i+1 < len(tokens) && tokens[i].isKeyword("as") && tokens[i+1].kind == tokIdent {
+		agg.name = tokens[i+1].text
+		i += 2
+	}
+
+	return agg, i, nil
+}
+
+// statsGroup accumulates the rows of one group.
+type statsGroup struct {
+	keys queryRow
+	rows []queryRow
+}
+
+func (c *statsCommand) apply(rs *queryResultSet) {
+	groups := make(map[string]*statsGroup)
+
+	for _, row := range rs.rows {
+		keys := queryRow{}
+
+		var id bytes.Buffer
+
+		for _, field := range c.by {
+			if v, ok := row[field]; ok {
+				keys[field] = v
+			}
+
+			id.WriteString(row[field])
+			id.WriteByte(0)
+		}
+
+		g, ok := groups[id.String()]
+		if !ok {
+			g = &statsGroup{keys: keys}
+			groups[id.String()] = g
+		}
+
+		g.rows = append(g.rows, row)
+	}
+
+	// A query without groups reports a single row, even without matches.
+	if len(c.by) == 0 && len(groups) == 0 {
+		groups[""] = &statsGroup{keys: queryRow{}}
+	}
+
+	ids := slices.Sorted(maps.Keys(groups))
+	rows := make([]queryRow, 0, len(ids))
+
+	for _, id := range ids {
+		g := groups[id]
+		row := maps.Clone(g.keys)
+
+		for _, agg := range c.aggregations {
+			if v, ok := agg.compute(g.rows); ok {
+				row[agg.name] = v
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	rs.rows = rows
+	rs.fields = slices.Clone(c.by)
+	rs.aggregated = true
+
+	for _, agg := range c.aggregations {
+		rs.fields = append(rs.fields, agg.name)
+	}
+}
+
+// compute applies the aggregation to the rows of a group. It reports false
+// when the aggregation has no value, e.g. avg() over no numbers.
+func (a aggregation) compute(rows []queryRow) (string, bool) {
+	if a.fn == "count" {
+		n := 0
+
+		for _, row := range rows {
+			if _, ok := row[a.field]; a.field == "" || ok {
+				n++
+			}
+		}
+
+		return strconv.Itoa(n), true
+	}
+
+	var values []float64
+
+	for _, row := range rows {
+		if v, err := strconv.ParseFloat(row[a.field], 64); err == nil {
+			values = append(values, v)
+		}
+	}
+
+	if len(values) == 0 {
+		return "", false
+	}
+
+	var result float64
+
+	switch a.fn {
+	case "sum", "avg":
+		for _, v := range values {
+			result += v
+		}
+
+		if a.fn == "avg" {
+			result /= float64(len(values))
+		}
+	case "min":
+		result = slices.Min(values)
+	case "max":
+		result = slices.Max(values)
+	}
+
+	return strconv.FormatFloat(result, 'f', -1, 64), true
+}
@@ -0,0 +1,139 @@
+package cloudwatchlogs
+
+import (
+	"sort"
+	"time"
+)
+
+// defaultQueryTransitionDelay is how long a query stays Running before the scheduler completes it.
+const defaultQueryTransitionDelay = 200 * time.Millisecond
+
+// WithQueryTransitionDelay sets how long a query stays Running before the scheduler completes it.
+func WithQueryTransitionDelay(d time.Duration) Option {
+	return func(s *MemoryStorage) {
+		s.transitionDelay = d
+	}
+}
+
+// queryScheduler periodically completes running Logs Insights queries.
+func (m *MemoryStorage) queryScheduler() {
+	ticker := time.NewTicker(max(m.transitionDelay/5, 10*time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stopScheduler:
+			return
+		case now := <-ticker.C:
+			m.advanceQueries(now)
+		}
+	}
+}
+
+// advanceQueries runs the queries that have spent the transition delay in
+// the Running state against the current log events and completes them.
+func (m *MemoryStorage) advanceQueries(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, query := range m.Queries {
+		if query.Status != queryStatusRunning {
+			continue
+		}
+
+		started, ok := m.transitions[id]
+		if !ok {
+			// Queries restored from disk start their timer on the first tick.
+			m.transitions[id] = now
+
+			continue
+		}
+
+		if now.Sub(started) < m.transitionDelay {
+			continue
+		}
+
+		delete(m.transitions, id)
+		m.runQuery(query)
+	}
+}
+
+// runQuery executes a query and stores its results, marking it Complete, or
+// Failed when the query cannot be parsed.
+func (m *MemoryStorage) runQuery(query *LogsQuery) {
+	parsed, err := parseInsightsQuery(query.QueryString)
+	if err != nil {
+		query.Status = queryStatusFailed
+
+		return
+	}
+
+	rows, bytesScanned := m.scanQueryEvents(query)
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultQueryLimit
+	}
+
+	results, matched := parsed.run(rows, limit)
+
+	query.Results = results
+	query.Statistics = &QueryStatistics{
+		RecordsMatched: float64(matched),
+		RecordsScanned: float64(len(rows)),
+		BytesScanned:   float64(bytesScanned),
+	}
+	query.Status = queryStatusComplete
+}
+
+// scanQueryEvents returns the rows of the events in the query's log groups
+// and time range, newest first, and the number of bytes scanned.
+func (m *MemoryStorage) scanQueryEvents(query *LogsQuery) ([]queryRow, int) {
+	start := query.StartTime * 1000
+	end := (query.EndTime+1)*1000 - 1
+
+	type scannedEvent struct {
+		timestamp int64
+		row       queryRow
+	}
+
+	var (
+		events       []scannedEvent
+		bytesScanned int
+	)
+
+	for _, groupName := range query.LogGroupNames {
+		groupData, exists := m.LogGroups[groupName]
+		if !exists {
+			continue
+		}
+
+		for streamName, streamData := range groupData.Streams {
+			lo, hi := streamData.eventRange(&start, &end)
+
+			for i := lo; i < hi; i++ {
+				event := streamData.Events[i]
+				bytesScanned += len(event.Message)
+				events = append(events, scannedEvent{
+					timestamp: event.Timestamp,
+					row:       eventRow(arnAccountID(groupData.Group.LogGroupARN), groupName, streamName, i, event),
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].timestamp != events[j].timestamp {
+			return events[i].timestamp > events[j].timestamp
+		}
+
+		return events[i].row["@ptr"] < events[j].row["@ptr"]
+	})
+
+	rows := make([]queryRow, len(events))
+	for i, e := range events {
+		rows[i] = e.row
+	}
+
+	return rows, bytesScanned
+}
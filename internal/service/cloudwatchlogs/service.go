@@ -1,9 +1,11 @@
 package cloudwatchlogs
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -14,12 +16,18 @@ const defaultBaseURL = "http://localhost:4566"
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"logs"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
+
+		if delay, err := time.ParseDuration(os.Getenv("KUMO_LOGS_QUERY_TRANSITION_DELAY")); err == nil {
+			opts = append(opts, WithQueryTransitionDelay(delay))
+		}
 
-	service.Register(New(NewMemoryStorage(defaultBaseURL, opts...), defaultBaseURL))
+		return []service.Service{New(NewMemoryStorage(defaultBaseURL, opts...), defaultBaseURL)}
+	})
 }
 
 // Service implements the CloudWatch Logs service.
@@ -66,3 +74,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"slices"
 	"sort"
 	"strings"
@@ -12,14 +13,14 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/sivchari/kumo/internal/service"
 	"github.com/sivchari/kumo/internal/storage"
 )
 
 const (
-	defaultRegion    = "us-east-1"
-	defaultAccountID = "000000000000"
-	defaultLimit     = 50
-	maxLimit         = 10000
+	defaultLimit    = 50
+	maxLimit        = 10000
+	maxTagsPerGroup = 50
 )
 
 // Storage defines the CloudWatch Logs storage interface.
@@ -33,9 +34,21 @@ type Storage interface {
 	FilterLogEvents(ctx context.Context, req *FilterLogEventsRequest) (*FilterLogEventsResponse, error)
 	DescribeLogGroups(ctx context.Context, req *DescribeLogGroupsRequest) (*DescribeLogGroupsResponse, error)
 	DescribeLogStreams(ctx context.Context, req *DescribeLogStreamsRequest) (*DescribeLogStreamsResponse, error)
+	TagLogGroup(ctx context.Context, name string, tags map[string]string) error
+	UntagLogGroup(ctx context.Context, name string, keys []string) error
+	ListTagsLogGroup(ctx context.Context, name string) (map[string]string, error)
+	TagResource(ctx context.Context, resourceARN string, tags map[string]string) error
+	UntagResource(ctx context.Context, resourceARN string, keys []string) error
+	ListTagsForResource(ctx context.Context, resourceARN string) (map[string]string, error)
+	StartQuery(ctx context.Context, req *StartQueryRequest) (*LogsQuery, error)
+	GetQueryResults(ctx context.Context, queryID string) (*LogsQuery, error)
+	StopQuery(ctx context.Context, queryID string) error
+	Reset(ctx context.Context) error
 }
 
-// LogStreamData holds log stream data with events.
+// LogStreamData holds log stream data with events. Events are kept sorted by
+// timestamp, with events of equal timestamps in insertion order, so reads can
+// locate a time range by binary search.
 type LogStreamData struct {
 	Stream *LogStream  `json:"stream"`
 	Events []*LogEvent `json:"events"`
@@ -65,17 +78,25 @@ var (
 
 // MemoryStorage implements Storage with in-memory data.
 type MemoryStorage struct {
-	mu        sync.RWMutex             `json:"-"`
-	LogGroups map[string]*LogGroupData `json:"logGroups"`
-	baseURL   string
-	dataDir   string
+	mu              sync.RWMutex             `json:"-"`
+	LogGroups       map[string]*LogGroupData `json:"logGroups"`
+	Queries         map[string]*LogsQuery    `json:"queries"`
+	baseURL         string
+	dataDir         string
+	transitionDelay time.Duration
+	transitions     map[string]time.Time // key: queryID -> time the query started running
+	stopScheduler   chan struct{}
 }
 
 // NewMemoryStorage creates a new in-memory CloudWatch Logs storage.
 func NewMemoryStorage(baseURL string, opts ...Option) *MemoryStorage {
 	s := &MemoryStorage{
-		LogGroups: make(map[string]*LogGroupData),
-		baseURL:   baseURL,
+		LogGroups:       make(map[string]*LogGroupData),
+		Queries:         make(map[string]*LogsQuery),
+		baseURL:         baseURL,
+		transitionDelay: defaultQueryTransitionDelay,
+		transitions:     make(map[string]time.Time),
+		stopScheduler:   make(chan struct{}),
 	}
 	for _, o := range opts {
 		o(s)
@@ -85,6 +106,8 @@ func NewMemoryStorage(baseURL string, opts ...Option) *MemoryStorage {
 		_ = storage.Load(s.dataDir, "logs", s)
 	}
 
+	go s.queryScheduler()
+
 	return s
 }
 
@@ -120,11 +143,26 @@ func (m *MemoryStorage) UnmarshalJSON(data []byte) error {
 		m.LogGroups = make(map[string]*LogGroupData)
 	}
 
+	if m.Queries == nil {
+		m.Queries = make(map[string]*LogsQuery)
+	}
+
+	// State saved by older versions may hold events in arrival order.
+	for _, groupData := range m.LogGroups {
+		for _, streamData := range groupData.Streams {
+			sort.SliceStable(streamData.Events, func(i, j int) bool {
+				return streamData.Events[i].Timestamp < streamData.Events[j].Timestamp
+			})
+		}
+	}
+
 	return nil
 }
 
 // Close saves the storage state to disk if persistence is enabled.
 func (m *MemoryStorage) Close() error {
+	close(m.stopScheduler)
+
 	if m.dataDir == "" {
 		return nil
 	}
@@ -136,8 +174,20 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.LogGroups = make(map[string]*LogGroupData)
+	m.Queries = make(map[string]*LogsQuery)
+	m.transitions = make(map[string]time.Time)
+
+	return nil
+}
+
 // CreateLogGroup creates a new log group.
-func (m *MemoryStorage) CreateLogGroup(_ context.Context, req *CreateLogGroupRequest) error {
+func (m *MemoryStorage) CreateLogGroup(ctx context.Context, req *CreateLogGroupRequest) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -151,10 +201,11 @@ func (m *MemoryStorage) CreateLogGroup(_ context.Context, req *CreateLogGroupReq
 	now := time.Now().UnixMilli()
 	logGroup := &LogGroup{
 		LogGroupName:  req.LogGroupName,
-		LogGroupARN:   m.buildLogGroupARN(req.LogGroupName),
+		LogGroupARN:   buildLogGroupARN(ctx, req.LogGroupName),
 		CreationTime:  now,
 		KmsKeyID:      req.KmsKeyID,
 		LogGroupClass: req.LogGroupClass,
+		Tags:          maps.Clone(req.Tags),
 	}
 
 	m.LogGroups[req.LogGroupName] = &LogGroupData{
@@ -207,7 +258,7 @@ func (m *MemoryStorage) CreateLogStream(_ context.Context, groupName, streamName
 		LogStreamName:       streamName,
 		CreationTime:        now,
 		UploadSequenceToken: uuid.New().String(),
-		LogStreamARN:        m.buildLogStreamARN(groupName, streamName),
+		LogStreamARN:        buildLogStreamARN(groupData.Group.LogGroupARN, streamName),
 	}
 
 	groupData.Streams[streamName] = &LogStreamData{
@@ -266,21 +317,16 @@ func (m *MemoryStorage) PutLogEvents(_ context.Context, groupName, streamName st
 
 	now := time.Now().UnixMilli()
 
-	for _, event := range events {
-		logEvent := &LogEvent{
-			Timestamp: event.Timestamp,
-			Message:   event.Message,
-		}
-		streamData.Events = append(streamData.Events, logEvent)
+	streamData.insertEvents(events)
 
+	if len(events) > 0 {
 		// Update stream timestamps
-		if streamData.Stream.FirstEventTimestamp == nil {
-			streamData.Stream.FirstEventTimestamp = &event.Timestamp
-		}
-
-		streamData.Stream.LastEventTimestamp = &event.Timestamp
+		first := streamData.Events[0].Timestamp
+		last := streamData.Events[len(streamData.Events)-1].Timestamp
+		streamData.Stream.FirstEventTimestamp = &first
+		streamData.Stream.LastEventTimestamp = &last
 		streamData.Stream.LastIngestionTime = &now
-		streamData.Stream.StoredBytes += int64(len(event.Message))
+		streamData.Stream.StoredBytes += sumEventBytes(events)
 	}
 
 	// Update group stored bytes
@@ -321,31 +367,20 @@ func (m *MemoryStorage) GetLogEvents(_ context.Context, req *GetLogEventsRequest
 		limit = min(int(*req.Limit), maxLimit)
 	}
 
-	// Filter events by time range
-	filteredEvents := filterEventsByTime(streamData.Events, req.StartTime, req.EndTime)
+	// Events are sorted, so the time range and limit are found without
+	// scanning or sorting the stream.
+	events := streamData.eventsInRange(req.StartTime, req.EndTime)
+	outputEvents := make([]OutputLogEvent, 0, min(len(events), limit))
+	now := time.Now().UnixMilli()
 
-	// Sort events
 	startFromHead := req.StartFromHead != nil && *req.StartFromHead
-	if startFromHead {
-		sort.Slice(filteredEvents, func(i, j int) bool {
-			return filteredEvents[i].Timestamp < filteredEvents[j].Timestamp
-		})
-	} else {
-		sort.Slice(filteredEvents, func(i, j int) bool {
-			return filteredEvents[i].Timestamp > filteredEvents[j].Timestamp
-		})
-	}
-
-	// Apply limit
-	if len(filteredEvents) > limit {
-		filteredEvents = filteredEvents[:limit]
-	}
-
-	// Convert to output format
-	outputEvents := make([]OutputLogEvent, 0, len(filteredEvents))
-	now := time.Now().UnixMilli()
+	for i := range min(len(events), limit) {
+		// Without startFromHead the newest events are returned first.
+		event := events[len(events)-1-i]
+		if startFromHead {
+			event = events[i]
+		}
 
-	for _, event := range filteredEvents {
 		outputEvents = append(outputEvents, OutputLogEvent{
 			Timestamp:     event.Timestamp,
 			Message:       event.Message,
@@ -413,7 +448,7 @@ func (m *MemoryStorage) filterEventsFromStreams(groupData *LogGroupData, req *Fi
 			SearchedCompletely: true,
 		})
 
-		events := m.filterStreamEvents(streamName, streamData.Events, req)
+		events := m.filterStreamEvents(streamName, streamData, req)
 		allEvents = append(allEvents, events...)
 	}
 
@@ -434,13 +469,13 @@ func (m *MemoryStorage) shouldIncludeStream(streamName string, streamNames []str
 }
 
 // filterStreamEvents filters events from a single stream.
-func (m *MemoryStorage) filterStreamEvents(streamName string, events []*LogEvent, req *FilterLogEventsRequest) []FilteredLogEvent {
+func (m *MemoryStorage) filterStreamEvents(streamName string, streamData *LogStreamData, req *FilterLogEventsRequest) []FilteredLogEvent {
 	var result []FilteredLogEvent
 
-	for i, event := range events {
-		if !matchesTimeRange(event.Timestamp, req.StartTime, req.EndTime) {
-			continue
-		}
+	lo, hi := streamData.eventRange(req.StartTime, req.EndTime)
+
+	for i := lo; i < hi; i++ {
+		event := streamData.Events[i]
 
 		if req.FilterPattern != "" && !strings.Contains(event.Message, req.FilterPattern) {
 			continue
@@ -458,19 +493,6 @@ func (m *MemoryStorage) filterStreamEvents(streamName string, events []*LogEvent
 	return result
 }
 
-// matchesTimeRange checks if a timestamp is within the given time range.
-func matchesTimeRange(timestamp int64, startTime, endTime *int64) bool {
-	if startTime != nil && timestamp < *startTime {
-		return false
-	}
-
-	if endTime != nil && timestamp > *endTime {
-		return false
-	}
-
-	return true
-}
-
 // getLimit returns the limit value from the request or the default.
 func getLimit(limit *int32) int {
 	if limit != nil && *limit > 0 {
@@ -715,35 +737,336 @@ func findLogStreamStartIndex(streams []LogStreamResponse, nextToken string) int
 	return 0
 }
 
-// buildLogGroupARN builds an ARN for a log group.
-func (m *MemoryStorage) buildLogGroupARN(name string) string {
+// TagLogGroup adds or overwrites tags on a log group.
+func (m *MemoryStorage) TagLogGroup(_ context.Context, name string, tags map[string]string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	groupData, exists := m.LogGroups[name]
+	if !exists {
+		return logGroupNotFoundError(name)
+	}
+
+	return tagLogGroup(groupData.Group, tags)
+}
+
+// UntagLogGroup removes tags from a log group.
+func (m *MemoryStorage) UntagLogGroup(_ context.Context, name string, keys []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	groupData, exists := m.LogGroups[name]
+	if !exists {
+		return logGroupNotFoundError(name)
+	}
+
+	for _, key := range keys {
+		delete(groupData.Group.Tags, key)
+	}
+
+	return nil
+}
+
+// ListTagsLogGroup returns the tags of a log group.
+func (m *MemoryStorage) ListTagsLogGroup(_ context.Context, name string) (map[string]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	groupData, exists := m.LogGroups[name]
+	if !exists {
+		return nil, logGroupNotFoundError(name)
+	}
+
+	tags := maps.Clone(groupData.Group.Tags)
+	if tags == nil {
+		tags = make(map[string]string)
+	}
+
+	return tags, nil
+}
+
+// TagResource adds or overwrites tags on the log group identified by an ARN.
+func (m *MemoryStorage) TagResource(ctx context.Context, resourceARN string, tags map[string]string) error {
+	name, err := logGroupNameFromARN(resourceARN)
+	if err != nil {
+		return err
+	}
+
+	return m.TagLogGroup(ctx, name, tags)
+}
+
+// UntagResource removes tags from the log group identified by an ARN.
+func (m *MemoryStorage) UntagResource(ctx context.Context, resourceARN string, keys []string) error {
+	name, err := logGroupNameFromARN(resourceARN)
+	if err != nil {
+		return err
+	}
+
+	return m.UntagLogGroup(ctx, name, keys)
+}
+
+// ListTagsForResource returns the tags of the log group identified by an ARN.
+func (m *MemoryStorage) ListTagsForResource(ctx context.Context, resourceARN string) (map[string]string, error) {
+	name, err := logGroupNameFromARN(resourceARN)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.ListTagsLogGroup(ctx, name)
+}
+
+// StartQuery validates a Logs Insights query and schedules it to run.
+func (m *MemoryStorage) StartQuery(_ context.Context, req *StartQueryRequest) (*LogsQuery, error) {
+	if _, err := parseInsightsQuery(req.QueryString); err != nil {
+		return nil, err
+	}
+
+	if req.EndTime < req.StartTime {
+		return nil, &LogsError{
+			Code:    "InvalidParameterException",
+			Message: "endTime must be greater than or equal to startTime",
+		}
+	}
+
+	names, err := queryLogGroupNames(req)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, name := range names {
+		if _, exists := m.LogGroups[name]; !exists {
+			return nil, logGroupNotFoundError(name)
+		}
+	}
+
+	now := time.Now()
+	query := &LogsQuery{
+		QueryID:       uuid.New().String(),
+		QueryString:   req.QueryString,
+		LogGroupNames: names,
+		StartTime:     req.StartTime,
+		EndTime:       req.EndTime,
+		Status:        queryStatusRunning,
+		CreateTime:    now.UnixMilli(),
+	}
+
+	if req.Limit != nil && *req.Limit > 0 {
+		query.Limit = min(int(*req.Limit), maxLimit)
+	}
+
+	m.Queries[query.QueryID] = query
+	m.transitions[query.QueryID] = now
+
+	return copyQuery(query), nil
+}
+
+// GetQueryResults returns a Logs Insights query with its results so far.
+func (m *MemoryStorage) GetQueryResults(_ context.Context, queryID string) (*LogsQuery, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	query, exists := m.Queries[queryID]
+	if !exists {
+		return nil, queryNotFoundError(queryID)
+	}
+
+	return copyQuery(query), nil
+}
+
+// StopQuery cancels a running Logs Insights query.
+func (m *MemoryStorage) StopQuery(_ context.Context, queryID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	query, exists := m.Queries[queryID]
+	if !exists {
+		return queryNotFoundError(queryID)
+	}
+
+	if query.Status != queryStatusRunning {
+		return &LogsError{
+			Code:    "InvalidParameterException",
+			Message: fmt.Sprintf("Query %s is not running: %s", queryID, query.Status),
+		}
+	}
+
+	query.Status = queryStatusCancelled
+	delete(m.transitions, queryID)
+
+	return nil
+}
+
+// queryLogGroupNames returns the log groups a query searches, accepting
+// names and ARNs as log group identifiers.
+func queryLogGroupNames(req *StartQueryRequest) ([]string, error) {
+	var names []string
+
+	if req.LogGroupName != "" {
+		names = append(names, req.LogGroupName)
+	}
+
+	names = append(names, req.LogGroupNames...)
+
+	for _, id := range req.LogGroupIdentifiers {
+		if strings.HasPrefix(id, "arn:") {
+			name, err := logGroupNameFromARN(id)
+			if err != nil {
+				return nil, err
+			}
+
+			id = name
+		}
+
+		names = append(names, id)
+	}
+
+	if len(names) == 0 {
+		return nil, &LogsError{
+			Code:    "InvalidParameterException",
+			Message: "At least one log group must be specified",
+		}
+	}
+
+	slices.Sort(names)
+
+	return slices.Compact(names), nil
+}
+
+// copyQuery returns a copy of a query that is safe to use without the lock.
+func copyQuery(query *LogsQuery) *LogsQuery {
+	c := *query
+	c.LogGroupNames = slices.Clone(query.LogGroupNames)
+	c.Results = slices.Clone(query.Results)
+
+	if query.Statistics != nil {
+		stats := *query.Statistics
+		c.Statistics = &stats
+	}
+
+	return &c
+}
+
+// queryNotFoundError returns the error for a missing query.
+func queryNotFoundError(queryID string) error {
+	return &LogsError{
+		Code:    "ResourceNotFoundException",
+		Message: fmt.Sprintf("The specified query does not exist: %s", queryID),
+	}
+}
+
+// tagLogGroup merges tags into a log group, enforcing the tag limit.
+func tagLogGroup(group *LogGroup, tags map[string]string) error {
+	merged := maps.Clone(group.Tags)
+	if merged == nil {
+		merged = make(map[string]string, len(tags))
+	}
+
+	maps.Copy(merged, tags)
+
+	if len(merged) > maxTagsPerGroup {
+		return &LogsError{
+			Code:    "TooManyTagsException",
+			Message: fmt.Sprintf("A resource can have no more than %d tags.", maxTagsPerGroup),
+		}
+	}
+
+	group.Tags = merged
+
+	return nil
+}
+
+// logGroupNameFromARN extracts the log group name from a log group ARN, with
+// or without the trailing ":*" that DescribeLogGroups reports.
+func logGroupNameFromARN(resourceARN string) (string, error) {
+	// arn:aws:logs:region:account:log-group:name[:*]
+	parts := strings.SplitN(resourceARN, ":", 7)
+	if len(parts) != 7 || parts[0] != "arn" || parts[2] != "logs" || parts[5] != "log-group" {
+		return "", &LogsError{
+			Code:    "InvalidParameterException",
+			Message: fmt.Sprintf("Invalid resource ARN: %s", resourceARN),
+		}
+	}
+
+	return strings.TrimSuffix(parts[6], ":*"), nil
+}
+
+// logGroupNotFoundError returns the error for a missing log group.
+func logGroupNotFoundError(name string) error {
+	return &LogsError{
+		Code:    "ResourceNotFoundException",
+		Message: fmt.Sprintf("The specified log group does not exist: %s", name),
+	}
+}
+
+// buildLogGroupARN builds an ARN for a log group in the account and region of the request.
+func buildLogGroupARN(ctx context.Context, name string) string {
 	return fmt.Sprintf("arn:aws:logs:%s:%s:log-group:%s",
-		defaultRegion, defaultAccountID, name)
+		service.Region(ctx), service.AccountID(ctx), name)
 }
 
-// buildLogStreamARN builds an ARN for a log stream.
-func (m *MemoryStorage) buildLogStreamARN(groupName, streamName string) string {
-	return fmt.Sprintf("arn:aws:logs:%s:%s:log-group:%s:log-stream:%s",
-		defaultRegion, defaultAccountID, groupName, streamName)
+// buildLogStreamARN builds an ARN for a log stream of the log group with the given ARN.
+func buildLogStreamARN(groupARN, streamName string) string {
+	return fmt.Sprintf("%s:log-stream:%s", groupARN, streamName)
 }
 
-// filterEventsByTime filters events by time range.
-func filterEventsByTime(events []*LogEvent, startTime, endTime *int64) []*LogEvent {
-	var result []*LogEvent
+// arnAccountID returns the account ID field of an ARN.
+func arnAccountID(arn string) string {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 5 {
+		return service.DefaultAccountID
+	}
+
+	return parts[4]
+}
 
+// insertEvents adds events to the stream, keeping the events sorted. Events
+// at or after the newest stored event, the common case, are appended.
+func (d *LogStreamData) insertEvents(events []InputLogEvent) {
 	for _, event := range events {
-		if startTime != nil && event.Timestamp < *startTime {
-			continue
+		logEvent := &LogEvent{
+			Timestamp: event.Timestamp,
+			Message:   event.Message,
 		}
 
-		if endTime != nil && event.Timestamp > *endTime {
-			continue
-		}
+		// Insert after any events with the same timestamp.
+		i := sort.Search(len(d.Events), func(i int) bool {
+			return d.Events[i].Timestamp > event.Timestamp
+		})
 
-		result = append(result, event)
+		d.Events = slices.Insert(d.Events, i, logEvent)
 	}
+}
 
-	return result
+// eventRange returns the index range [lo, hi) of the events whose timestamps
+// are within the given time range. Both bounds are optional and inclusive.
+func (d *LogStreamData) eventRange(startTime, endTime *int64) (int, int) {
+	lo, hi := 0, len(d.Events)
+
+	if startTime != nil {
+		lo = sort.Search(len(d.Events), func(i int) bool {
+			return d.Events[i].Timestamp >= *startTime
+		})
+	}
+
+	if endTime != nil {
+		hi = sort.Search(len(d.Events), func(i int) bool {
+			return d.Events[i].Timestamp > *endTime
+		})
+	}
+
+	return lo, max(lo, hi)
+}
+
+// eventsInRange returns the events whose timestamps are within the given
+// time range, oldest first. The result shares the stream's backing array and
+// must only be used while the storage lock is held.
+func (d *LogStreamData) eventsInRange(startTime, endTime *int64) []*LogEvent {
+	lo, hi := d.eventRange(startTime, endTime)
+
+	return d.Events[lo:hi]
 }
 
 // sumEventBytes sums the bytes of all events.
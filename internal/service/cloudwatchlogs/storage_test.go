@@ -0,0 +1,248 @@
+package cloudwatchlogs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func newTestStream(t testing.TB, s *MemoryStorage) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	if err := s.CreateLogGroup(ctx, &CreateLogGroupRequest{LogGroupName: "group"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.CreateLogStream(ctx, "group", "stream"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMemoryStorage_PutLogEvents_OutOfOrder(t *testing.T) {
+	s := NewMemoryStorage("")
+	t.Cleanup(func() { _ = s.Close() })
+
+	newTestStream(t, s)
+
+	ctx := context.Background()
+
+	batches := [][]InputLogEvent{
+		{{Timestamp: 30, Message: "c"}, {Timestamp: 50, Message: "e"}},
+		{{Timestamp: 10, Message: "a"}, {Timestamp: 30, Message: "c2"}},
+		{{Timestamp: 20, Message: "b"}, {Timestamp: 40, Message: "d"}},
+	}
+
+	for _, batch := range batches {
+		if _, err := s.PutLogEvents(ctx, "group", "stream", batch, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		req  *GetLogEventsRequest
+		want []string
+	}{
+		{
+			name: "from head",
+			req:  &GetLogEventsRequest{StartFromHead: ptr(true)},
+			want: []string{"a", "b", "c", "c2", "d", "e"},
+		},
+		{
+			name: "from tail",
+			req:  &GetLogEventsRequest{},
+			want: []string{"e", "d", "c2", "c", "b", "a"},
+		},
+		{
+			name: "time range is inclusive",
+			req:  &GetLogEventsRequest{StartTime: ptr[int64](20), EndTime: ptr[int64](30), StartFromHead: ptr(true)},
+			want: []string{"b", "c", "c2"},
+		},
+		{
+			name: "limit from head",
+			req:  &GetLogEventsRequest{Limit: ptr[int32](2), StartFromHead: ptr(true)},
+			want: []string{"a", "b"},
+		},
+		{
+			name: "limit from tail",
+			req:  &GetLogEventsRequest{StartTime: ptr[int64](15), Limit: ptr[int32](2)},
+			want: []string{"e", "d"},
+		},
+		{
+			name: "empty range",
+			req:  &GetLogEventsRequest{StartTime: ptr[int64](60)},
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.LogGroupName = "group"
+			tt.req.LogStreamName = "stream"
+
+			resp, err := s.GetLogEvents(ctx, tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := make([]string, 0, len(resp.Events))
+			for _, e := range resp.Events {
+				got = append(got, e.Message)
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	streams, err := s.DescribeLogStreams(ctx, &DescribeLogStreamsRequest{LogGroupName: "group"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stream := streams.LogStreams[0]
+	if *stream.FirstEventTimestamp != 10 || *stream.LastEventTimestamp != 50 {
+		t.Errorf("expected event timestamps 10..50, got %d..%d", *stream.FirstEventTimestamp, *stream.LastEventTimestamp)
+	}
+}
+
+func TestMemoryStorage_ConcurrentPutAndGetLogEvents(t *testing.T) {
+	s := NewMemoryStorage("")
+	t.Cleanup(func() { _ = s.Close() })
+
+	newTestStream(t, s)
+
+	const (
+		writers   = 8
+		batches   = 50
+		batchSize = 10
+	)
+
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+
+	for w := range writers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for b := range batches {
+				events := make([]InputLogEvent, batchSize)
+				for i := range events {
+					// Interleave timestamps across writers so most inserts
+					// land in the middle of the stream.
+					events[i] = InputLogEvent{
+						Timestamp: int64((b*batchSize+i)*writers + (writers - 1 - w)),
+						Message:   fmt.Sprintf("w%d-%d-%d", w, b, i),
+					}
+				}
+
+				if _, err := s.PutLogEvents(ctx, "group", "stream", events, ""); err != nil {
+					t.Error(err)
+
+					return
+				}
+			}
+		}()
+	}
+
+	for range writers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range batches {
+				resp, err := s.GetLogEvents(ctx, &GetLogEventsRequest{
+					LogGroupName:  "group",
+					LogStreamName: "stream",
+					Limit:         ptr[int32](maxLimit),
+					StartFromHead: ptr(true),
+				})
+				if err != nil {
+					t.Error(err)
+
+					return
+				}
+
+				for i := 1; i < len(resp.Events); i++ {
+					if resp.Events[i-1].Timestamp > resp.Events[i].Timestamp {
+						t.Errorf("events out of order at %d: %d > %d", i, resp.Events[i-1].Timestamp, resp.Events[i].Timestamp)
+
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	resp, err := s.GetLogEvents(ctx, &GetLogEventsRequest{
+		LogGroupName:  "group",
+		LogStreamName: "stream",
+		Limit:         ptr[int32](maxLimit),
+		StartFromHead: ptr(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := writers * batches * batchSize; len(resp.Events) != want {
+		t.Fatalf("expected %d events, got %d", want, len(resp.Events))
+	}
+
+	for i, e := range resp.Events {
+		if e.Timestamp != int64(i) {
+			t.Fatalf("expected timestamp %d at %d, got %d", i, i, e.Timestamp)
+		}
+	}
+}
+
+// BenchmarkGetLogEvents reads the newest events of streams of growing size.
+// The time per read stays flat as the stream grows, because the time range
+// is located by binary search instead of scanning and sorting the stream.
+func BenchmarkGetLogEvents(b *testing.B) {
+	for _, size := range []int{1_000, 10_000, 100_000} {
+		b.Run(fmt.Sprintf("events=%d", size), func(b *testing.B) {
+			s := NewMemoryStorage("")
+			b.Cleanup(func() { _ = s.Close() })
+
+			newTestStream(b, s)
+
+			ctx := context.Background()
+
+			events := make([]InputLogEvent, size)
+			for i := range events {
+				events[i] = InputLogEvent{Timestamp: int64(i), Message: "message"}
+			}
+
+			if _, err := s.PutLogEvents(ctx, "group", "stream", events, ""); err != nil {
+				b.Fatal(err)
+			}
+
+			start := int64(size / 2)
+			req := &GetLogEventsRequest{
+				LogGroupName:  "group",
+				LogStreamName: "stream",
+				StartTime:     &start,
+			}
+
+			for b.Loop() {
+				if _, err := s.GetLogEvents(ctx, req); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -12,6 +12,7 @@ type LogGroup struct {
 	KmsKeyID          string
 	DataProtection    string
 	LogGroupClass     string
+	Tags              map[string]string
 }
 
 // LogStream represents a log stream in CloudWatch Logs.
@@ -213,6 +214,115 @@ type DescribeLogStreamsResponse struct {
 	NextToken  string              `json:"nextToken,omitempty"`
 }
 
+// TagLogGroupRequest is the request for TagLogGroup.
+type TagLogGroupRequest struct {
+	LogGroupName string            `json:"logGroupName"`
+	Tags         map[string]string `json:"tags"`
+}
+
+// UntagLogGroupRequest is the request for UntagLogGroup.
+type UntagLogGroupRequest struct {
+	LogGroupName string   `json:"logGroupName"`
+	Tags         []string `json:"tags"`
+}
+
+// ListTagsLogGroupRequest is the request for ListTagsLogGroup.
+type ListTagsLogGroupRequest struct {
+	LogGroupName string `json:"logGroupName"`
+}
+
+// ListTagsLogGroupResponse is the response for ListTagsLogGroup.
+type ListTagsLogGroupResponse struct {
+	Tags map[string]string `json:"tags"`
+}
+
+// TagResourceRequest is the request for TagResource.
+type TagResourceRequest struct {
+	ResourceARN string            `json:"resourceArn"`
+	Tags        map[string]string `json:"tags"`
+}
+
+// UntagResourceRequest is the request for UntagResource.
+type UntagResourceRequest struct {
+	ResourceARN string   `json:"resourceArn"`
+	TagKeys     []string `json:"tagKeys"`
+}
+
+// ListTagsForResourceRequest is the request for ListTagsForResource.
+type ListTagsForResourceRequest struct {
+	ResourceARN string `json:"resourceArn"`
+}
+
+// ListTagsForResourceResponse is the response for ListTagsForResource.
+type ListTagsForResourceResponse struct {
+	Tags map[string]string `json:"tags"`
+}
+
+// LogsQuery represents a Logs Insights query.
+type LogsQuery struct {
+	QueryID       string           `json:"queryId"`
+	QueryString   string           `json:"queryString"`
+	LogGroupNames []string         `json:"logGroupNames"`
+	StartTime     int64            `json:"startTime"`
+	EndTime       int64            `json:"endTime"`
+	Limit         int              `json:"limit,omitempty"`
+	Status        string           `json:"status"`
+	CreateTime    int64            `json:"createTime"`
+	Results       [][]ResultField  `json:"results,omitempty"`
+	Statistics    *QueryStatistics `json:"statistics,omitempty"`
+}
+
+// ResultField is a field of a Logs Insights result row.
+type ResultField struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
+// QueryStatistics contains the statistics of a Logs Insights query.
+type QueryStatistics struct {
+	RecordsMatched float64 `json:"recordsMatched"`
+	RecordsScanned float64 `json:"recordsScanned"`
+	BytesScanned   float64 `json:"bytesScanned"`
+}
+
+// StartQueryRequest is the request for StartQuery.
+type StartQueryRequest struct {
+	LogGroupName        string   `json:"logGroupName,omitempty"`
+	LogGroupNames       []string `json:"logGroupNames,omitempty"`
+	LogGroupIdentifiers []string `json:"logGroupIdentifiers,omitempty"`
+	StartTime           int64    `json:"startTime"`
+	EndTime             int64    `json:"endTime"`
+	QueryString         string   `json:"queryString"`
+	Limit               *int32   `json:"limit,omitempty"`
+}
+
+// StartQueryResponse is the response for StartQuery.
+type StartQueryResponse struct {
+	QueryID string `json:"queryId"`
+}
+
+// GetQueryResultsRequest is the request for GetQueryResults.
+type GetQueryResultsRequest struct {
+	QueryID string `json:"queryId"`
+}
+
+// GetQueryResultsResponse is the response for GetQueryResults.
+type GetQueryResultsResponse struct {
+	Results    [][]ResultField  `json:"results"`
+	Statistics *QueryStatistics `json:"statistics,omitempty"`
+	Status     string           `json:"status"`
+}
+
+// StopQueryRequest is the request for StopQuery.
+type StopQueryRequest struct {
+	QueryID string `json:"queryId"`
+}
+
+// StopQueryResponse is the response for StopQuery.
+type StopQueryResponse struct {
+	Success bool `json:"success"`
+}
+
 // ErrorResponse represents a CloudWatch Logs error response.
 type ErrorResponse struct {
 	Type    string `json:"__type"`
@@ -0,0 +1,48 @@
+package codeconnections
+
+import "time"
+
+// defaultHandshakeDelay is how long a new connection stays PENDING before it becomes AVAILABLE.
+const defaultHandshakeDelay = 2 * time.Second
+
+// WithHandshakeDelay sets how long a new connection stays PENDING before it becomes AVAILABLE.
+func WithHandshakeDelay(d time.Duration) Option {
+	return func(s *MemoryStorage) {
+		s.handshakeDelay = d
+	}
+}
+
+// handshakeScheduler periodically makes pending connections available, as if
+// their owner had completed the handshake with the provider.
+func (s *MemoryStorage) handshakeScheduler() {
+	ticker := time.NewTicker(max(s.handshakeDelay/5, 10*time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopScheduler:
+			return
+		case now := <-ticker.C:
+			s.completePendingHandshakes(now)
+		}
+	}
+}
+
+// completePendingHandshakes makes the connections that have been pending for
+// at least the handshake delay available.
+func (s *MemoryStorage) completePendingHandshakes(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, conn := range s.Connections {
+		if conn.ConnectionStatus != ConnectionStatusPending {
+			continue
+		}
+
+		if now.Sub(conn.CreatedAt) < s.handshakeDelay {
+			continue
+		}
+
+		conn.ConnectionStatus = ConnectionStatusAvailable
+	}
+}
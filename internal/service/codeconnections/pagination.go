@@ -0,0 +1,54 @@
+package codeconnections
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+)
+
+// Page size limits of the List operations.
+const (
+	defaultMaxResults = 50
+	maxMaxResults     = 100
+)
+
+// paginate returns the page of items starting at the offset encoded in
+// nextToken, along with the token for the following page. The items must be
+// in a stable order for the offsets to stay meaningful between calls.
+func paginate[T any](items []T, nextToken string, maxResults int32) ([]T, string, error) {
+	limit := defaultMaxResults
+	if maxResults > 0 {
+		limit = min(int(maxResults), maxMaxResults)
+	}
+
+	start := 0
+
+	if nextToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(nextToken)
+		if err != nil {
+			return nil, "", invalidNextTokenError(nextToken)
+		}
+
+		start, err = strconv.Atoi(string(decoded))
+		if err != nil || start < 0 || start > len(items) {
+			return nil, "", invalidNextTokenError(nextToken)
+		}
+	}
+
+	end := min(start+limit, len(items))
+
+	var next string
+	if end < len(items) {
+		next = base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(end)))
+	}
+
+	return items[start:end], next, nil
+}
+
+// invalidNextTokenError returns the error for a token not issued by a List operation.
+func invalidNextTokenError(nextToken string) error {
+	return &ServiceError{
+		Code:    errInvalidInputException,
+		Message: fmt.Sprintf("Invalid NextToken: %s", nextToken),
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -44,6 +46,7 @@ type Storage interface {
 	ListTagsForResource(ctx context.Context, resourceArn string) ([]Tag, error)
 	TagResource(ctx context.Context, resourceArn string, tags []Tag) error
 	UntagResource(ctx context.Context, resourceArn string, tagKeys []string) error
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -71,6 +74,8 @@ type MemoryStorage struct {
 	accountID       string
 	region          string
 	dataDir         string
+	handshakeDelay  time.Duration
+	stopScheduler   chan struct{}
 }
 
 // NewMemoryStorage creates a new in-memory storage.
@@ -81,6 +86,8 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		RepositoryLinks: make(map[string]*RepositoryLink),
 		accountID:       "000000000000",
 		region:          "us-east-1",
+		handshakeDelay:  defaultHandshakeDelay,
+		stopScheduler:   make(chan struct{}),
 	}
 	for _, o := range opts {
 		o(s)
@@ -90,6 +97,8 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		_ = storage.Load(s.dataDir, "codeconnections", s)
 	}
 
+	go s.handshakeScheduler()
+
 	return s
 }
 
@@ -138,6 +147,8 @@ func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
 
 // Close saves the storage state to disk if persistence is enabled.
 func (s *MemoryStorage) Close() error {
+	close(s.stopScheduler)
+
 	if s.dataDir == "" {
 		return nil
 	}
@@ -149,6 +160,18 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Connections = make(map[string]*Connection)
+	s.Hosts = make(map[string]*Host)
+	s.RepositoryLinks = make(map[string]*RepositoryLink)
+
+	return nil
+}
+
 // CreateConnection creates a new connection.
 func (s *MemoryStorage) CreateConnection(_ context.Context, name, providerType, hostArn string, tags []Tag) (*Connection, error) {
 	s.mu.Lock()
@@ -162,6 +185,11 @@ func (s *MemoryStorage) CreateConnection(_ context.Context, name, providerType,
 		tagMap[tag.Key] = tag.Value
 	}
 
+	// A connection to a host uses the host's provider type.
+	if host, ok := s.Hosts[hostArn]; ok && providerType == "" {
+		providerType = string(host.ProviderType)
+	}
+
 	conn := &Connection{
 		ConnectionArn:    connectionArn,
 		ConnectionName:   name,
@@ -175,7 +203,7 @@ func (s *MemoryStorage) CreateConnection(_ context.Context, name, providerType,
 
 	s.Connections[connectionArn] = conn
 
-	return conn, nil
+	return conn.clone(), nil
 }
 
 // GetConnection retrieves a connection by ARN.
@@ -191,7 +219,7 @@ func (s *MemoryStorage) GetConnection(_ context.Context, connectionArn string) (
 		}
 	}
 
-	return conn, nil
+	return conn.clone(), nil
 }
 
 // DeleteConnection deletes a connection.
@@ -211,16 +239,13 @@ func (s *MemoryStorage) DeleteConnection(_ context.Context, connectionArn string
 	return nil
 }
 
-// ListConnections lists connections with optional filters.
-func (s *MemoryStorage) ListConnections(_ context.Context, providerTypeFilter, hostArnFilter, _ string, maxResults int32) ([]*Connection, string, error) {
+// ListConnections lists connections matching the provider type and host
+// filters, oldest first, one page at a time.
+func (s *MemoryStorage) ListConnections(_ context.Context, providerTypeFilter, hostArnFilter, nextToken string, maxResults int32) ([]*Connection, string, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	if maxResults <= 0 {
-		maxResults = 50
-	}
-
-	connections := make([]*Connection, 0)
+	connections := make([]*Connection, 0, len(s.Connections))
 
 	for _, conn := range s.Connections {
 		if providerTypeFilter != "" && string(conn.ProviderType) != providerTypeFilter {
@@ -232,13 +257,27 @@ func (s *MemoryStorage) ListConnections(_ context.Context, providerTypeFilter, h
 		}
 
 		connections = append(connections, conn)
+	}
 
-		if len(connections) >= int(maxResults) {
-			break
+	slices.SortFunc(connections, func(a, b *Connection) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
 		}
+
+		return strings.Compare(a.ConnectionArn, b.ConnectionArn)
+	})
+
+	page, next, err := paginate(connections, nextToken, maxResults)
+	if err != nil {
+		return nil, "", err
+	}
+
+	result := make([]*Connection, 0, len(page))
+	for _, conn := range page {
+		result = append(result, conn.clone())
 	}
 
-	return connections, "", nil
+	return result, next, nil
 }
 
 // CreateHost creates a new host.
@@ -1,6 +1,7 @@
 package codeconnections
 
 import (
+	"maps"
 	"time"
 )
 
@@ -38,6 +39,15 @@ type Connection struct {
 	Tags             map[string]string
 }
 
+// clone returns a copy of the connection that is safe to use without the
+// storage lock, since the status of a stored connection changes over time.
+func (c *Connection) clone() *Connection {
+	cp := *c
+	cp.Tags = maps.Clone(c.Tags)
+
+	return &cp
+}
+
 // Host represents a CodeConnections host.
 type Host struct {
 	HostArn          string
@@ -1,6 +1,7 @@
 package codeguruprofiler
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sync"
@@ -18,6 +19,7 @@ type Storage interface {
 	UpdateProfilingGroup(name string, input *UpdateProfilingGroupInput) (*ProfilingGroup, error)
 	DeleteProfilingGroup(name string) error
 	ListProfilingGroups() []ProfilingGroup
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -107,6 +109,16 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Groups = make(map[string]*ProfilingGroup)
+
+	return nil
+}
+
 // CreateProfilingGroup creates a new profiling group.
 func (m *MemoryStorage) CreateProfilingGroup(input *CreateProfilingGroupInput) *ProfilingGroup {
 	m.mu.Lock()
@@ -1,6 +1,7 @@
 package codegurureviewer
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sync"
@@ -31,6 +32,7 @@ type Storage interface {
 	PutRecommendationFeedback(input *PutRecommendationFeedbackInput) error
 	DescribeRecommendationFeedback(codeReviewArn, recommendationID string) (*RecommendationFeedback, error)
 	ListRecommendationFeedback(codeReviewArn string) []RecommendationFeedback
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -132,6 +134,18 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Associations = make(map[string]*RepositoryAssociation)
+	m.CodeReviews = make(map[string]*CodeReview)
+	m.Feedback = make(map[string]map[string]*RecommendationFeedback)
+
+	return nil
+}
+
 // AssociateRepository creates a new repository association.
 func (m *MemoryStorage) AssociateRepository(input *AssociateRepositoryInput) *RepositoryAssociation {
 	m.mu.Lock()
@@ -16,21 +16,41 @@ type handlerFunc func(http.ResponseWriter, *http.Request)
 // getActionHandlers returns a map of action names to handler functions.
 func (s *Service) getActionHandlers() map[string]handlerFunc {
 	return map[string]handlerFunc{
-		"CreateUserPool":         s.CreateUserPool,
-		"DescribeUserPool":       s.DescribeUserPool,
-		"ListUserPools":          s.ListUserPools,
-		"DeleteUserPool":         s.DeleteUserPool,
-		"CreateUserPoolClient":   s.CreateUserPoolClient,
-		"DescribeUserPoolClient": s.DescribeUserPoolClient,
-		"ListUserPoolClients":    s.ListUserPoolClients,
-		"DeleteUserPoolClient":   s.DeleteUserPoolClient,
-		"AdminCreateUser":        s.AdminCreateUser,
-		"AdminGetUser":           s.AdminGetUser,
-		"AdminDeleteUser":        s.AdminDeleteUser,
-		"ListUsers":              s.ListUsers,
-		"SignUp":                 s.SignUp,
-		"ConfirmSignUp":          s.ConfirmSignUp,
-		"InitiateAuth":           s.InitiateAuth,
+		"CreateUserPool":                   s.CreateUserPool,
+		"DescribeUserPool":                 s.DescribeUserPool,
+		"ListUserPools":                    s.ListUserPools,
+		"DeleteUserPool":                   s.DeleteUserPool,
+		"CreateUserPoolClient":             s.CreateUserPoolClient,
+		"DescribeUserPoolClient":           s.DescribeUserPoolClient,
+		"ListUserPoolClients":              s.ListUserPoolClients,
+		"DeleteUserPoolClient":             s.DeleteUserPoolClient,
+		"AdminCreateUser":                  s.AdminCreateUser,
+		"AdminGetUser":                     s.AdminGetUser,
+		"AdminDeleteUser":                  s.AdminDeleteUser,
+		"ListUsers":                        s.ListUsers,
+		"GetUser":                          s.GetUser,
+		"UpdateUserAttributes":             s.UpdateUserAttributes,
+		"AdminUpdateUserAttributes":        s.AdminUpdateUserAttributes,
+		"GetUserAttributeVerificationCode": s.GetUserAttributeVerificationCode,
+		"VerifyUserAttribute":              s.VerifyUserAttribute,
+		"SignUp":                           s.SignUp,
+		"ConfirmSignUp":                    s.ConfirmSignUp,
+		"ResendConfirmationCode":           s.ResendConfirmationCode,
+		"InitiateAuth":                     s.InitiateAuth,
+		"RespondToAuthChallenge":           s.RespondToAuthChallenge,
+		"AdminInitiateAuth":                s.AdminInitiateAuth,
+		"AdminRespondToAuthChallenge":      s.AdminRespondToAuthChallenge,
+		"ForgotPassword":                   s.ForgotPassword,
+		"ConfirmForgotPassword":            s.ConfirmForgotPassword,
+		"AdminResetUserPassword":           s.AdminResetUserPassword,
+		"AdminSetUserPassword":             s.AdminSetUserPassword,
+		"CreateGroup":                      s.CreateGroup,
+		"GetGroup":                         s.GetGroup,
+		"ListGroups":                       s.ListGroups,
+		"DeleteGroup":                      s.DeleteGroup,
+		"AdminAddUserToGroup":              s.AdminAddUserToGroup,
+		"AdminRemoveUserFromGroup":         s.AdminRemoveUserFromGroup,
+		"AdminListGroupsForUser":           s.AdminListGroupsForUser,
 	}
 }
 
@@ -41,7 +61,7 @@ func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
 
 	handlers := s.getActionHandlers()
 	if handler, ok := handlers[action]; ok {
-		handler(w, r)
+		handler(w, r.WithContext(withIssuerBaseURL(r)))
 
 		return
 	}
@@ -345,36 +365,122 @@ func (s *Service) ListUsers(w http.ResponseWriter, r *http.Request) {
 	writeResponse(w, resp)
 }
 
-// SignUp handles the SignUp API.
-func (s *Service) SignUp(w http.ResponseWriter, r *http.Request) {
-	var req SignUpRequest
+// GetUser handles the GetUser API.
+func (s *Service) GetUser(w http.ResponseWriter, r *http.Request) {
+	var req GetUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
 
 		return
 	}
 
-	user, err := s.storage.SignUp(r.Context(), &req)
+	user, err := s.storage.GetUser(r.Context(), req.AccessToken)
 	if err != nil {
 		handleError(w, err)
 
 		return
 	}
 
-	// Get user sub from attributes.
-	userSub := uuid.New().String()
+	writeResponse(w, &GetUserResponse{
+		Username:       user.Username,
+		UserAttributes: convertAttributes(user.Attributes),
+	})
+}
 
-	for _, attr := range user.Attributes {
-		if attr.Name == "sub" {
-			userSub = attr.Value
+// UpdateUserAttributes handles the UpdateUserAttributes API.
+func (s *Service) UpdateUserAttributes(w http.ResponseWriter, r *http.Request) {
+	var req UpdateUserAttributesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
 
-			break
-		}
+		return
+	}
+
+	deliveries, err := s.storage.UpdateUserAttributes(r.Context(), req.AccessToken, req.UserAttributes)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &UpdateUserAttributesResponse{CodeDeliveryDetailsList: deliveries})
+}
+
+// AdminUpdateUserAttributes handles the AdminUpdateUserAttributes API.
+func (s *Service) AdminUpdateUserAttributes(w http.ResponseWriter, r *http.Request) {
+	var req AdminUpdateUserAttributesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.AdminUpdateUserAttributes(r.Context(), req.UserPoolID, req.Username, req.UserAttributes); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &AdminUpdateUserAttributesResponse{})
+}
+
+// GetUserAttributeVerificationCode handles the GetUserAttributeVerificationCode API.
+func (s *Service) GetUserAttributeVerificationCode(w http.ResponseWriter, r *http.Request) {
+	var req GetUserAttributeVerificationCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	details, err := s.storage.GetUserAttributeVerificationCode(r.Context(), req.AccessToken, req.AttributeName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &GetUserAttributeVerificationCodeResponse{CodeDeliveryDetails: details})
+}
+
+// VerifyUserAttribute handles the VerifyUserAttribute API.
+func (s *Service) VerifyUserAttribute(w http.ResponseWriter, r *http.Request) {
+	var req VerifyUserAttributeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.VerifyUserAttribute(r.Context(), req.AccessToken, req.AttributeName, req.Code); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &VerifyUserAttributeResponse{})
+}
+
+// SignUp handles the SignUp API.
+func (s *Service) SignUp(w http.ResponseWriter, r *http.Request) {
+	var req SignUpRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	user, err := s.storage.SignUp(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
 	}
 
 	resp := &SignUpResponse{
-		UserConfirmed: user.UserStatus == UserStatusConfirmed,
-		UserSub:       userSub,
+		UserConfirmed:       user.UserStatus == UserStatusConfirmed,
+		UserSub:             user.Sub,
+		CodeDeliveryDetails: codeDeliveryDetails(user),
 	}
 
 	writeResponse(w, resp)
@@ -389,7 +495,7 @@ func (s *Service) ConfirmSignUp(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := s.storage.ConfirmSignUp(r.Context(), req.ClientID, req.Username, req.ConfirmationCode); err != nil {
+	if err := s.storage.ConfirmSignUp(r.Context(), &req); err != nil {
 		handleError(w, err)
 
 		return
@@ -398,6 +504,25 @@ func (s *Service) ConfirmSignUp(w http.ResponseWriter, r *http.Request) {
 	writeResponse(w, &ConfirmSignUpResponse{})
 }
 
+// ResendConfirmationCode handles the ResendConfirmationCode API.
+func (s *Service) ResendConfirmationCode(w http.ResponseWriter, r *http.Request) {
+	var req ResendConfirmationCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	details, err := s.storage.ResendConfirmationCode(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &ResendConfirmationCodeResponse{CodeDeliveryDetails: details})
+}
+
 // InitiateAuth handles the InitiateAuth API.
 func (s *Service) InitiateAuth(w http.ResponseWriter, r *http.Request) {
 	var req InitiateAuthRequest
@@ -417,6 +542,307 @@ func (s *Service) InitiateAuth(w http.ResponseWriter, r *http.Request) {
 	writeResponse(w, resp)
 }
 
+// RespondToAuthChallenge handles the RespondToAuthChallenge API.
+func (s *Service) RespondToAuthChallenge(w http.ResponseWriter, r *http.Request) {
+	var req RespondToAuthChallengeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	resp, err := s.storage.RespondToAuthChallenge(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, resp)
+}
+
+// AdminInitiateAuth handles the AdminInitiateAuth API.
+func (s *Service) AdminInitiateAuth(w http.ResponseWriter, r *http.Request) {
+	var req AdminInitiateAuthRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	resp, err := s.storage.AdminInitiateAuth(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, resp)
+}
+
+// AdminRespondToAuthChallenge handles the AdminRespondToAuthChallenge API.
+func (s *Service) AdminRespondToAuthChallenge(w http.ResponseWriter, r *http.Request) {
+	var req AdminRespondToAuthChallengeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	resp, err := s.storage.AdminRespondToAuthChallenge(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, resp)
+}
+
+// ForgotPassword handles the ForgotPassword API.
+func (s *Service) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	details, err := s.storage.ForgotPassword(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &ForgotPasswordResponse{CodeDeliveryDetails: details})
+}
+
+// ConfirmForgotPassword handles the ConfirmForgotPassword API.
+func (s *Service) ConfirmForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req ConfirmForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.ConfirmForgotPassword(r.Context(), &req); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &ConfirmForgotPasswordResponse{})
+}
+
+// AdminResetUserPassword handles the AdminResetUserPassword API.
+func (s *Service) AdminResetUserPassword(w http.ResponseWriter, r *http.Request) {
+	var req AdminResetUserPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.AdminResetUserPassword(r.Context(), req.UserPoolID, req.Username); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &AdminResetUserPasswordResponse{})
+}
+
+// AdminSetUserPassword handles the AdminSetUserPassword API.
+func (s *Service) AdminSetUserPassword(w http.ResponseWriter, r *http.Request) {
+	var req AdminSetUserPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.AdminSetUserPassword(r.Context(), &req); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &AdminSetUserPasswordResponse{})
+}
+
+// CreateGroup handles the CreateGroup API.
+func (s *Service) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	var req CreateGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	group, err := s.storage.CreateGroup(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &CreateGroupResponse{Group: groupToOutput(group)})
+}
+
+// GetGroup handles the GetGroup API.
+func (s *Service) GetGroup(w http.ResponseWriter, r *http.Request) {
+	var req GetGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	group, err := s.storage.GetGroup(r.Context(), req.UserPoolID, req.GroupName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &GetGroupResponse{Group: groupToOutput(group)})
+}
+
+// ListGroups handles the ListGroups API.
+func (s *Service) ListGroups(w http.ResponseWriter, r *http.Request) {
+	var req ListGroupsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	groups, nextToken, err := s.storage.ListGroups(r.Context(), req.UserPoolID, req.Limit, req.NextToken)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &ListGroupsResponse{
+		Groups:    groupsToOutput(groups),
+		NextToken: nextToken,
+	})
+}
+
+// DeleteGroup handles the DeleteGroup API.
+func (s *Service) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+	var req DeleteGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.DeleteGroup(r.Context(), req.UserPoolID, req.GroupName); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &DeleteGroupResponse{})
+}
+
+// AdminAddUserToGroup handles the AdminAddUserToGroup API.
+func (s *Service) AdminAddUserToGroup(w http.ResponseWriter, r *http.Request) {
+	var req AdminAddUserToGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.AdminAddUserToGroup(r.Context(), req.UserPoolID, req.Username, req.GroupName); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &AdminAddUserToGroupResponse{})
+}
+
+// AdminRemoveUserFromGroup handles the AdminRemoveUserFromGroup API.
+func (s *Service) AdminRemoveUserFromGroup(w http.ResponseWriter, r *http.Request) {
+	var req AdminRemoveUserFromGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.AdminRemoveUserFromGroup(r.Context(), req.UserPoolID, req.Username, req.GroupName); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &AdminRemoveUserFromGroupResponse{})
+}
+
+// AdminListGroupsForUser handles the AdminListGroupsForUser API.
+func (s *Service) AdminListGroupsForUser(w http.ResponseWriter, r *http.Request) {
+	var req AdminListGroupsForUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	groups, nextToken, err := s.storage.AdminListGroupsForUser(r.Context(), req.UserPoolID, req.Username, req.Limit, req.NextToken)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &AdminListGroupsForUserResponse{
+		Groups:    groupsToOutput(groups),
+		NextToken: nextToken,
+	})
+}
+
+// GetVerificationCodes returns outstanding verification codes so tests can complete code-based flows.
+func (s *Service) GetVerificationCodes(w http.ResponseWriter, r *http.Request) {
+	codes, err := s.storage.ListVerificationCodes(r.Context())
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(&GetVerificationCodesResponse{VerificationCodes: codes})
+}
+
+// GetJWKS serves the JSON Web Key Set used to verify tokens issued by a user pool.
+func (s *Service) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	pool, err := s.storage.GetUserPool(r.Context(), r.PathValue("userPoolId"))
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	jwks, err := poolJWKSet(pool)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(jwks)
+}
+
 // userPoolToOutput converts a UserPool to UserPoolOutput.
 func userPoolToOutput(pool *UserPool) *UserPoolOutput {
 	output := &UserPoolOutput{
@@ -512,6 +938,30 @@ func userToOutput(user *User) *UserOutput {
 	}
 }
 
+// groupToOutput converts a Group to GroupOutput.
+func groupToOutput(group *Group) *GroupOutput {
+	return &GroupOutput{
+		GroupName:        group.GroupName,
+		UserPoolID:       group.UserPoolID,
+		Description:      group.Description,
+		RoleArn:          group.RoleArn,
+		Precedence:       group.Precedence,
+		CreationDate:     float64(group.CreationDate.Unix()),
+		LastModifiedDate: float64(group.LastModifiedDate.Unix()),
+	}
+}
+
+// groupsToOutput converts a Group slice to a GroupOutput slice.
+func groupsToOutput(groups []*Group) []GroupOutput {
+	outputs := make([]GroupOutput, len(groups))
+
+	for i, group := range groups {
+		outputs[i] = *groupToOutput(group)
+	}
+
+	return outputs
+}
+
 // convertAttributes converts UserAttribute slice to UserAttributeOutput slice.
 func convertAttributes(attrs []UserAttribute) []UserAttributeOutput {
 	if attrs == nil {
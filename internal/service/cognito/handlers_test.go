@@ -0,0 +1,161 @@
+package cognito
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// muxRouter registers the routes of a service on a ServeMux.
+type muxRouter struct {
+	mux *http.ServeMux
+}
+
+func (r muxRouter) Handle(method, pattern string, handler http.HandlerFunc) {
+	r.mux.HandleFunc(method+" "+pattern, handler)
+}
+
+func (r muxRouter) HandleFunc(method, pattern string, handler http.HandlerFunc) {
+	r.Handle(method, pattern, handler)
+}
+
+func TestTokenIssuerServesJWKS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	storage := NewMemoryStorage()
+	svc := New(storage)
+
+	mux := http.NewServeMux()
+	svc.RegisterRoutes(muxRouter{mux: mux})
+	mux.HandleFunc("POST /", svc.DispatchAction)
+
+	// The server listens on a random port, not the default 4566.
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	pool, err := storage.CreateUserPool(ctx, &CreateUserPoolRequest{PoolName: "pool", Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := storage.CreateUserPoolClient(ctx, &CreateUserPoolClientRequest{UserPoolID: pool.ID, ClientName: "app"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := storage.AdminCreateUser(ctx, &AdminCreateUserRequest{UserPoolID: pool.ID, Username: "alice", TemporaryPassword: "TempPassw0rd!"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := storage.AdminSetUserPassword(ctx, &AdminSetUserPasswordRequest{UserPoolID: pool.ID, Username: "alice", Password: "Passw0rd!", Permanent: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := json.Marshal(&InitiateAuthRequest{
+		AuthFlow:       "USER_PASSWORD_AUTH",
+		ClientID:       client.ClientID,
+		AuthParameters: map[string]string{"USERNAME": "alice", "PASSWORD": "Passw0rd!"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req.Header.Set("X-Amz-Target", "AWSCognitoIdentityProviderService.InitiateAuth")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var auth InitiateAuthResponse
+	if err := json.NewDecoder(resp.Body).Decode(&auth); err != nil {
+		t.Fatal(err)
+	}
+
+	if auth.AuthenticationResult == nil {
+		t.Fatalf("expected tokens, got status %d: %+v", resp.StatusCode, auth)
+	}
+
+	parts := strings.Split(auth.AuthenticationResult.AccessToken, ".")
+
+	var header struct {
+		Kid string `json:"kid"`
+	}
+
+	var claims struct {
+		Iss string `json:"iss"`
+	}
+
+	decodeTokenPart(t, parts[0], &header)
+	decodeTokenPart(t, parts[1], &claims)
+
+	if want := srv.URL + "/kumo/cognito-idp/" + pool.ID; claims.Iss != want {
+		t.Fatalf("expected issuer %s, got %s", want, claims.Iss)
+	}
+
+	// Verify the token the way standard verifiers do, with the JWKS found at its issuer.
+	jwksResp, err := http.Get(claims.Iss + "/.well-known/jwks.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer jwksResp.Body.Close()
+
+	var jwks JWKSet
+	if err := json.NewDecoder(jwksResp.Body).Decode(&jwks); err != nil {
+		t.Fatal(err)
+	}
+
+	var key *rsa.PublicKey
+
+	for _, jwk := range jwks.Keys {
+		if jwk.Kid == header.Kid {
+			n, _ := base64.RawURLEncoding.DecodeString(jwk.N)
+			e, _ := base64.RawURLEncoding.DecodeString(jwk.E)
+			key = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		}
+	}
+
+	if key == nil {
+		t.Fatalf("no JWKS key found for kid %s", header.Kid)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+		t.Errorf("failed to verify the token with the JWKS at its issuer: %v", err)
+	}
+}
+
+// decodeTokenPart decodes a base64url-encoded JWT segment into v.
+func decodeTokenPart(t *testing.T, part string, v any) {
+	t.Helper()
+
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatal(err)
+	}
+}
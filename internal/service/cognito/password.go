@@ -0,0 +1,81 @@
+package cognito
+
+import (
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// passwordSymbols is the set of special characters Cognito accepts for RequireSymbols.
+const passwordSymbols = "^$*.[]{}()?\"!@#%&/\\,><':;|_~`=+- "
+
+// phoneNumberPattern matches E.164 phone numbers as required by Cognito.
+var phoneNumberPattern = regexp.MustCompile(`^\+[0-9]{4,15}$`)
+
+// validatePassword checks the password against the pool's password policy.
+// Pools without a password policy accept any password.
+func validatePassword(pool *UserPool, password string) error {
+	if pool.Policies == nil || pool.Policies.PasswordPolicy == nil {
+		return nil
+	}
+
+	policy := pool.Policies.PasswordPolicy
+
+	var reason string
+
+	switch {
+	case len(password) < int(policy.MinimumLength):
+		reason = "Password not long enough"
+	case policy.RequireUppercase && !strings.ContainsFunc(password, unicode.IsUpper):
+		reason = "Password must have uppercase characters"
+	case policy.RequireLowercase && !strings.ContainsFunc(password, unicode.IsLower):
+		reason = "Password must have lowercase characters"
+	case policy.RequireNumbers && !strings.ContainsFunc(password, unicode.IsDigit):
+		reason = "Password must have numeric characters"
+	case policy.RequireSymbols && !strings.ContainsAny(password, passwordSymbols):
+		reason = "Password must have symbol characters"
+	default:
+		return nil
+	}
+
+	return &ServiceError{Code: errInvalidPassword, Message: "Password did not conform with policy: " + reason}
+}
+
+// validateUsername checks that the username matches the pool's UsernameAttributes,
+// which require users to sign up with an email address or phone number.
+func validateUsername(pool *UserPool, username string) error {
+	if len(pool.UsernameAttributes) == 0 {
+		return nil
+	}
+
+	allowsEmail := slices.Contains(pool.UsernameAttributes, "email")
+	allowsPhone := slices.Contains(pool.UsernameAttributes, "phone_number")
+
+	if allowsEmail && isEmail(username) {
+		return nil
+	}
+
+	if allowsPhone && phoneNumberPattern.MatchString(username) {
+		return nil
+	}
+
+	message := "Username should be either an email or a phone number."
+
+	switch {
+	case allowsEmail && !allowsPhone:
+		message = "Username should be an email."
+	case allowsPhone && !allowsEmail:
+		message = "Username should be a phone number."
+	}
+
+	return &ServiceError{Code: errInvalidParameter, Message: message}
+}
+
+// isEmail reports whether s is a bare email address.
+func isEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+
+	return err == nil && addr.Address == s
+}
@@ -0,0 +1,78 @@
+package cognito
+
+import (
+	"testing"
+)
+
+func TestValidatePassword(t *testing.T) {
+	t.Parallel()
+
+	strict := &UserPool{
+		Policies: &UserPoolPolicies{
+			PasswordPolicy: &PasswordPolicy{
+				MinimumLength:    8,
+				RequireUppercase: true,
+				RequireLowercase: true,
+				RequireNumbers:   true,
+				RequireSymbols:   true,
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		pool     *UserPool
+		password string
+		wantErr  bool
+	}{
+		{name: "no policy accepts anything", pool: &UserPool{}, password: "a", wantErr: false},
+		{name: "conforming password", pool: strict, password: "Password123!", wantErr: false},
+		{name: "too short", pool: strict, password: "Pa1!", wantErr: true},
+		{name: "missing uppercase", pool: strict, password: "password123!", wantErr: true},
+		{name: "missing lowercase", pool: strict, password: "PASSWORD123!", wantErr: true},
+		{name: "missing number", pool: strict, password: "Password!!!", wantErr: true},
+		{name: "missing symbol", pool: strict, password: "Password123", wantErr: true},
+		{name: "space counts as symbol", pool: strict, password: "Password 123", wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validatePassword(tt.pool, tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validatePassword(%q) error = %v, wantErr %v", tt.password, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateUsername(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		attributes []string
+		username   string
+		wantErr    bool
+	}{
+		{name: "no username attributes", attributes: nil, username: "alice", wantErr: false},
+		{name: "email required and given", attributes: []string{"email"}, username: "alice@example.com", wantErr: false},
+		{name: "email required but plain name", attributes: []string{"email"}, username: "alice", wantErr: true},
+		{name: "email required but display name form", attributes: []string{"email"}, username: "Alice <alice@example.com>", wantErr: true},
+		{name: "phone required and given", attributes: []string{"phone_number"}, username: "+15555550100", wantErr: false},
+		{name: "phone required but email", attributes: []string{"phone_number"}, username: "alice@example.com", wantErr: true},
+		{name: "either allowed", attributes: []string{"email", "phone_number"}, username: "+15555550100", wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateUsername(&UserPool{UsernameAttributes: tt.attributes}, tt.username)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateUsername(%q) error = %v, wantErr %v", tt.username, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package cognito
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// computeSecretHash returns Base64(HMAC-SHA256(clientSecret, username + clientID)),
+// the SecretHash AWS SDKs send for clients that have a secret.
+func computeSecretHash(client *UserPoolClient, username string) string {
+	mac := hmac.New(sha256.New, []byte(client.ClientSecret))
+	mac.Write([]byte(username + client.ClientID))
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// validateSecretHash checks the SecretHash supplied for a client created with GenerateSecret.
+// Clients without a secret accept any value.
+func validateSecretHash(client *UserPoolClient, username, secretHash string) error {
+	if client.ClientSecret == "" {
+		return nil
+	}
+
+	if secretHash == "" {
+		return &ServiceError{
+			Code:    errNotAuthorized,
+			Message: "Client " + client.ClientID + " is configured for secret but secret was not received",
+		}
+	}
+
+	if !hmac.Equal([]byte(secretHash), []byte(computeSecretHash(client, username))) {
+		return &ServiceError{
+			Code:    errNotAuthorized,
+			Message: "Unable to verify secret hash for client " + client.ClientID,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package cognito
+
+import (
+	"testing"
+)
+
+func TestValidateSecretHash(t *testing.T) {
+	t.Parallel()
+
+	withSecret := &UserPoolClient{ClientID: "client", ClientSecret: "secret"}
+
+	tests := []struct {
+		name       string
+		client     *UserPoolClient
+		username   string
+		secretHash string
+		wantErr    bool
+	}{
+		{name: "client without secret ignores hash", client: &UserPoolClient{ClientID: "client"}, username: "alice", secretHash: "", wantErr: false},
+		{name: "valid hash", client: withSecret, username: "alice", secretHash: "RTsve+FQ659UKyESgvLg9GYmZEL+QjzQsW/OjL77/b0=", wantErr: false},
+		{name: "missing hash", client: withSecret, username: "alice", secretHash: "", wantErr: true},
+		{name: "hash for another user", client: withSecret, username: "bob", secretHash: "RTsve+FQ659UKyESgvLg9GYmZEL+QjzQsW/OjL77/b0=", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateSecretHash(tt.client, tt.username, tt.secretHash)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateSecretHash() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
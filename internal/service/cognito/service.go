@@ -2,9 +2,9 @@
 package cognito
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"os"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -33,21 +33,27 @@ func (s *Service) TargetPrefix() string {
 func (s *Service) JSONProtocol() {}
 
 // RegisterRoutes registers routes for REST-based operations.
-func (s *Service) RegisterRoutes(_ service.Router) {
+func (s *Service) RegisterRoutes(r service.Router) {
 	// Cognito uses AWS JSON protocol with X-Amz-Target header.
-	// Routes are handled by DispatchAction.
+	// API operations are handled by DispatchAction.
+
+	// kumo-specific endpoints for testing.
+	r.HandleFunc("GET", "/kumo/cognito-idp/{userPoolId}/.well-known/jwks.json", s.GetJWKS)
+	r.HandleFunc("GET", "/kumo/cognito-idp/verification-codes", s.GetVerificationCodes)
 }
 
 // Compile-time check that Service implements io.Closer.
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"cognito-idp"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
 
 // Close saves the storage state if persistence is enabled.
@@ -60,3 +66,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
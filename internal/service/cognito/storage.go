@@ -6,6 +6,9 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"slices"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -23,8 +26,26 @@ const (
 	errUsernameExists         = "UsernameExistsException"
 	errNotAuthorized          = "NotAuthorizedException"
 	errInvalidParameter       = "InvalidParameterException"
+	errCodeMismatch           = "CodeMismatchException"
+	errExpiredCode            = "ExpiredCodeException"
+	errPasswordResetRequired  = "PasswordResetRequiredException"
+	errGroupNotFound          = "ResourceNotFoundException"
+	errGroupExists            = "GroupExistsException"
+	errInvalidPassword        = "InvalidPasswordException"
 )
 
+// authSessionTTL is how long a challenge session remains valid.
+const authSessionTTL = 3 * time.Minute
+
+// confirmationCodeTTL is how long a sign-up confirmation code remains valid.
+const confirmationCodeTTL = 24 * time.Hour
+
+// passwordResetCodeTTL is how long a password reset code remains valid.
+const passwordResetCodeTTL = time.Hour
+
+// attributeCodeTTL is how long an attribute verification code remains valid.
+const attributeCodeTTL = 24 * time.Hour
+
 // Storage defines the Cognito storage interface.
 type Storage interface {
 	// User Pool operations.
@@ -44,15 +65,41 @@ type Storage interface {
 	AdminGetUser(ctx context.Context, userPoolID, username string) (*User, error)
 	AdminDeleteUser(ctx context.Context, userPoolID, username string) error
 	ListUsers(ctx context.Context, userPoolID string, limit int32, paginationToken string) ([]*User, string, error)
+	GetUser(ctx context.Context, accessToken string) (*User, error)
+	UpdateUserAttributes(ctx context.Context, accessToken string, attrs []UserAttributeInput) ([]*CodeDeliveryDetails, error)
+	AdminUpdateUserAttributes(ctx context.Context, userPoolID, username string, attrs []UserAttributeInput) error
+	GetUserAttributeVerificationCode(ctx context.Context, accessToken, attributeName string) (*CodeDeliveryDetails, error)
+	VerifyUserAttribute(ctx context.Context, accessToken, attributeName, code string) error
 
 	// Authentication operations.
 	SignUp(ctx context.Context, req *SignUpRequest) (*User, error)
-	ConfirmSignUp(ctx context.Context, clientID, username, code string) error
+	ConfirmSignUp(ctx context.Context, req *ConfirmSignUpRequest) error
+	ResendConfirmationCode(ctx context.Context, req *ResendConfirmationCodeRequest) (*CodeDeliveryDetails, error)
 	InitiateAuth(ctx context.Context, req *InitiateAuthRequest) (*InitiateAuthResponse, error)
+	RespondToAuthChallenge(ctx context.Context, req *RespondToAuthChallengeRequest) (*RespondToAuthChallengeResponse, error)
+	AdminInitiateAuth(ctx context.Context, req *AdminInitiateAuthRequest) (*InitiateAuthResponse, error)
+	AdminRespondToAuthChallenge(ctx context.Context, req *AdminRespondToAuthChallengeRequest) (*RespondToAuthChallengeResponse, error)
+
+	// Password reset operations.
+	ForgotPassword(ctx context.Context, req *ForgotPasswordRequest) (*CodeDeliveryDetails, error)
+	ConfirmForgotPassword(ctx context.Context, req *ConfirmForgotPasswordRequest) error
+	AdminResetUserPassword(ctx context.Context, userPoolID, username string) error
+	AdminSetUserPassword(ctx context.Context, req *AdminSetUserPasswordRequest) error
+	ListVerificationCodes(ctx context.Context) ([]*VerificationCode, error)
+
+	// Group operations.
+	CreateGroup(ctx context.Context, req *CreateGroupRequest) (*Group, error)
+	GetGroup(ctx context.Context, userPoolID, groupName string) (*Group, error)
+	ListGroups(ctx context.Context, userPoolID string, limit int32, nextToken string) ([]*Group, string, error)
+	DeleteGroup(ctx context.Context, userPoolID, groupName string) error
+	AdminAddUserToGroup(ctx context.Context, userPoolID, username, groupName string) error
+	AdminRemoveUserFromGroup(ctx context.Context, userPoolID, username, groupName string) error
+	AdminListGroupsForUser(ctx context.Context, userPoolID, username string, limit int32, nextToken string) ([]*Group, string, error)
 
 	// Helper operations.
 	GetUserPoolByClientID(ctx context.Context, clientID string) (*UserPool, error)
 	GetUserPoolClientByID(ctx context.Context, clientID string) (*UserPoolClient, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -73,21 +120,29 @@ var (
 
 // MemoryStorage implements Storage with in-memory data.
 type MemoryStorage struct {
-	mu                sync.RWMutex                `json:"-"`
-	UserPools         map[string]*UserPool        `json:"userPools"`
-	UserPoolClients   map[string]*UserPoolClient  `json:"userPoolClients"`
-	Users             map[string]map[string]*User `json:"users"`             // userPoolID -> username -> User
-	ConfirmationCodes map[string]string           `json:"confirmationCodes"` // username -> code
-	dataDir           string
+	mu              sync.RWMutex                 `json:"-"`
+	UserPools       map[string]*UserPool         `json:"userPools"`
+	UserPoolClients map[string]*UserPoolClient   `json:"userPoolClients"`
+	Users           map[string]map[string]*User  `json:"users"`          // userPoolID -> username -> User
+	SignUpCodes     map[string]*VerificationCode `json:"signUpCodes"`    // userPoolID/username -> code
+	ResetCodes      map[string]*VerificationCode `json:"resetCodes"`     // userPoolID/username -> code
+	AttributeCodes  map[string]*VerificationCode `json:"attributeCodes"` // userPoolID/username/attribute -> code
+	Groups          map[string]map[string]*Group `json:"groups"`         // userPoolID -> groupName -> Group
+	sessions        map[string]*AuthSession      // session token -> challenge state
+	dataDir         string
 }
 
 // NewMemoryStorage creates a new MemoryStorage.
 func NewMemoryStorage(opts ...Option) *MemoryStorage {
 	s := &MemoryStorage{
-		UserPools:         make(map[string]*UserPool),
-		UserPoolClients:   make(map[string]*UserPoolClient),
-		Users:             make(map[string]map[string]*User),
-		ConfirmationCodes: make(map[string]string),
+		UserPools:       make(map[string]*UserPool),
+		UserPoolClients: make(map[string]*UserPoolClient),
+		Users:           make(map[string]map[string]*User),
+		SignUpCodes:     make(map[string]*VerificationCode),
+		ResetCodes:      make(map[string]*VerificationCode),
+		AttributeCodes:  make(map[string]*VerificationCode),
+		Groups:          make(map[string]map[string]*Group),
+		sessions:        make(map[string]*AuthSession),
 	}
 	for _, o := range opts {
 		o(s)
@@ -140,8 +195,35 @@ func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
 		s.Users = make(map[string]map[string]*User)
 	}
 
-	if s.ConfirmationCodes == nil {
-		s.ConfirmationCodes = make(map[string]string)
+	if s.SignUpCodes == nil {
+		s.SignUpCodes = make(map[string]*VerificationCode)
+	}
+
+	if s.ResetCodes == nil {
+		s.ResetCodes = make(map[string]*VerificationCode)
+	}
+
+	if s.AttributeCodes == nil {
+		s.AttributeCodes = make(map[string]*VerificationCode)
+	}
+
+	if s.Groups == nil {
+		s.Groups = make(map[string]map[string]*Group)
+	}
+
+	// Snapshots taken before token signing was supported lack keys and subs.
+	for _, pool := range s.UserPools {
+		if err := ensureSigningKey(pool); err != nil {
+			return err
+		}
+	}
+
+	for _, users := range s.Users {
+		for _, user := range users {
+			if user.Sub == "" {
+				user.Sub = uuid.New().String()
+			}
+		}
 	}
 
 	return nil
@@ -160,6 +242,23 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.UserPools = make(map[string]*UserPool)
+	s.UserPoolClients = make(map[string]*UserPoolClient)
+	s.Users = make(map[string]map[string]*User)
+	s.SignUpCodes = make(map[string]*VerificationCode)
+	s.ResetCodes = make(map[string]*VerificationCode)
+	s.AttributeCodes = make(map[string]*VerificationCode)
+	s.Groups = make(map[string]map[string]*Group)
+	s.sessions = make(map[string]*AuthSession)
+
+	return nil
+}
+
 // CreateUserPool creates a new user pool.
 func (s *MemoryStorage) CreateUserPool(_ context.Context, req *CreateUserPoolRequest) (*UserPool, error) {
 	s.mu.Lock()
@@ -191,6 +290,10 @@ func (s *MemoryStorage) CreateUserPool(_ context.Context, req *CreateUserPoolReq
 		}
 	}
 
+	if err := ensureSigningKey(pool); err != nil {
+		return nil, err
+	}
+
 	if req.AutoVerifiedAttributes != nil {
 		pool.AutoVerifiedAttrs = req.AutoVerifiedAttributes
 	}
@@ -213,6 +316,7 @@ func (s *MemoryStorage) CreateUserPool(_ context.Context, req *CreateUserPoolReq
 
 	s.UserPools[poolID] = pool
 	s.Users[poolID] = make(map[string]*User)
+	s.Groups[poolID] = make(map[string]*Group)
 
 	return pool, nil
 }
@@ -268,8 +372,9 @@ func (s *MemoryStorage) DeleteUserPool(_ context.Context, userPoolID string) err
 		}
 	}
 
-	// Delete associated users.
+	// Delete associated users and groups.
 	delete(s.Users, userPoolID)
+	delete(s.Groups, userPoolID)
 	delete(s.UserPools, userPoolID)
 
 	return nil
@@ -384,17 +489,29 @@ func (s *MemoryStorage) AdminCreateUser(_ context.Context, req *AdminCreateUserR
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if _, ok := s.UserPools[req.UserPoolID]; !ok {
+	pool, ok := s.UserPools[req.UserPoolID]
+	if !ok {
 		return nil, &ServiceError{Code: errUserPoolNotFound, Message: "User pool not found"}
 	}
 
+	if err := validateUsername(pool, req.Username); err != nil {
+		return nil, err
+	}
+
 	if _, ok := s.Users[req.UserPoolID][req.Username]; ok {
 		return nil, &ServiceError{Code: errUsernameExists, Message: "User already exists"}
 	}
 
+	if req.TemporaryPassword != "" {
+		if err := validatePassword(pool, req.TemporaryPassword); err != nil {
+			return nil, err
+		}
+	}
+
 	now := time.Now()
 	user := &User{
 		Username:         req.Username,
+		Sub:              uuid.New().String(),
 		UserPoolID:       req.UserPoolID,
 		UserCreateDate:   now,
 		UserLastModified: now,
@@ -480,33 +597,228 @@ func (s *MemoryStorage) ListUsers(_ context.Context, userPoolID string, limit in
 	return result, "", nil
 }
 
-// SignUp registers a new user.
-func (s *MemoryStorage) SignUp(_ context.Context, req *SignUpRequest) (*User, error) {
+// GetUser returns the user an access token was issued to.
+func (s *MemoryStorage) GetUser(_ context.Context, accessToken string) (*User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.userForAccessToken(accessToken)
+}
+
+// UpdateUserAttributes updates the attributes of the user an access token was
+// issued to, returning where codes verifying a changed email or phone number
+// were sent.
+func (s *MemoryStorage) UpdateUserAttributes(_ context.Context, accessToken string, attrs []UserAttributeInput) ([]*CodeDeliveryDetails, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	// Find user pool by client ID.
-	var userPoolID string
+	user, err := s.userForAccessToken(accessToken)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, client := range s.UserPoolClients {
-		if client.ClientID == req.ClientID {
-			userPoolID = client.UserPoolID
+	return s.updateUserAttributes(user, attrs)
+}
 
-			break
+// AdminUpdateUserAttributes updates the attributes of a user.
+func (s *MemoryStorage) AdminUpdateUserAttributes(_ context.Context, userPoolID, username string, attrs []UserAttributeInput) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, err := s.findUser(userPoolID, username)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.updateUserAttributes(user, attrs)
+
+	return err
+}
+
+// GetUserAttributeVerificationCode sends a code verifying the email or phone
+// number of the user an access token was issued to.
+func (s *MemoryStorage) GetUserAttributeVerificationCode(_ context.Context, accessToken, attributeName string) (*CodeDeliveryDetails, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, err := s.userForAccessToken(accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	details := attributeDeliveryDetails(user, attributeName)
+	if details == nil {
+		return nil, &ServiceError{Code: errInvalidParameter, Message: "Invalid attribute name: " + attributeName}
+	}
+
+	s.issueAttributeCode(user, attributeName)
+
+	return details, nil
+}
+
+// VerifyUserAttribute checks a code sent for an attribute of the user an access
+// token was issued to and marks the attribute verified.
+func (s *MemoryStorage) VerifyUserAttribute(_ context.Context, accessToken, attributeName, code string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, err := s.userForAccessToken(accessToken)
+	if err != nil {
+		return err
+	}
+
+	key := attributeCodeKey(user.UserPoolID, user.Username, attributeName)
+
+	expected, ok := s.AttributeCodes[key]
+	if !ok || time.Now().After(expected.ExpiresAt) {
+		delete(s.AttributeCodes, key)
+
+		return &ServiceError{Code: errExpiredCode, Message: "Invalid code provided, please request a code again."}
+	}
+
+	if expected.Code != code {
+		return &ServiceError{Code: errCodeMismatch, Message: "Invalid verification code provided, please try again."}
+	}
+
+	setUserAttribute(user, attributeName+"_verified", "true")
+	user.UserLastModified = time.Now()
+
+	delete(s.AttributeCodes, key)
+
+	return nil
+}
+
+// userForAccessToken returns the user a valid, unexpired access token was
+// issued to. Caller must hold the lock.
+func (s *MemoryStorage) userForAccessToken(accessToken string) (*User, error) {
+	invalid := &ServiceError{Code: errNotAuthorized, Message: "Invalid Access Token"}
+
+	poolID, err := tokenPoolID(accessToken)
+	if err != nil {
+		return nil, invalid
+	}
+
+	pool, ok := s.UserPools[poolID]
+	if !ok {
+		return nil, invalid
+	}
+
+	claims, err := verifyJWT(pool, accessToken)
+	if err != nil || claims["token_use"] != "access" {
+		return nil, invalid
+	}
+
+	if exp, _ := claims["exp"].(float64); time.Now().Unix() >= int64(exp) {
+		return nil, &ServiceError{Code: errNotAuthorized, Message: "Access Token has expired"}
+	}
+
+	username, _ := claims["username"].(string)
+
+	user, ok := s.Users[poolID][username]
+	if !ok || user.Sub != claims["sub"] {
+		return nil, &ServiceError{Code: errNotAuthorized, Message: "User does not exist."}
+	}
+
+	return user, nil
+}
+
+// updateUserAttributes sets attributes on the user. A changed email or phone
+// number becomes unverified and is sent a verification code, unless the update
+// also sets its verified flag. Caller must hold the write lock.
+func (s *MemoryStorage) updateUserAttributes(user *User, attrs []UserAttributeInput) ([]*CodeDeliveryDetails, error) {
+	updates := make(map[string]string, len(attrs))
+
+	for _, attr := range attrs {
+		if attr.Name == "sub" {
+			return nil, &ServiceError{Code: errInvalidParameter, Message: "Cannot modify an immutable attribute: sub"}
+		}
+
+		updates[attr.Name] = attr.Value
+	}
+
+	var changed []string
+
+	for _, name := range []string{"email", "phone_number"} {
+		if value, ok := updates[name]; ok && value != userAttribute(user, name) {
+			changed = append(changed, name)
+		}
+	}
+
+	for _, attr := range attrs {
+		setUserAttribute(user, attr.Name, attr.Value)
+	}
+
+	var deliveries []*CodeDeliveryDetails
+
+	for _, name := range changed {
+		if _, ok := updates[name+"_verified"]; ok {
+			continue
+		}
+
+		setUserAttribute(user, name+"_verified", "false")
+
+		if details := attributeDeliveryDetails(user, name); details != nil {
+			s.issueAttributeCode(user, name)
+			deliveries = append(deliveries, details)
 		}
 	}
 
-	if userPoolID == "" {
+	user.UserLastModified = time.Now()
+
+	return deliveries, nil
+}
+
+// issueAttributeCode generates and stores a new code verifying an attribute of
+// the user. Caller must hold the write lock.
+func (s *MemoryStorage) issueAttributeCode(user *User, attributeName string) {
+	s.AttributeCodes[attributeCodeKey(user.UserPoolID, user.Username, attributeName)] = &VerificationCode{
+		UserPoolID:    user.UserPoolID,
+		Username:      user.Username,
+		Type:          VerificationCodeTypeAttributeVerification,
+		Code:          generateCode(),
+		ExpiresAt:     time.Now().Add(attributeCodeTTL),
+		AttributeName: attributeName,
+	}
+}
+
+// attributeCodeKey returns the AttributeCodes key for an attribute of a user.
+func attributeCodeKey(userPoolID, username, attributeName string) string {
+	return userPoolID + "/" + username + "/" + attributeName
+}
+
+// SignUp registers a new user.
+func (s *MemoryStorage) SignUp(_ context.Context, req *SignUpRequest) (*User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	client, ok := s.UserPoolClients[req.ClientID]
+	if !ok {
 		return nil, &ServiceError{Code: errInvalidParameter, Message: "Invalid client ID"}
 	}
 
+	if err := validateSecretHash(client, req.Username, req.SecretHash); err != nil {
+		return nil, err
+	}
+
+	userPoolID := client.UserPoolID
+	pool := s.UserPools[userPoolID]
+
+	if err := validateUsername(pool, req.Username); err != nil {
+		return nil, err
+	}
+
 	if _, ok := s.Users[userPoolID][req.Username]; ok {
 		return nil, &ServiceError{Code: errUsernameExists, Message: "User already exists"}
 	}
 
+	if err := validatePassword(pool, req.Password); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	user := &User{
 		Username:         req.Username,
+		Sub:              uuid.New().String(),
 		UserPoolID:       userPoolID,
 		UserCreateDate:   now,
 		UserLastModified: now,
@@ -525,76 +837,161 @@ func (s *MemoryStorage) SignUp(_ context.Context, req *SignUpRequest) (*User, er
 
 	s.Users[userPoolID][req.Username] = user
 
-	// Generate confirmation code (simulated).
-	s.ConfirmationCodes[req.Username] = "123456"
+	s.issueSignUpCode(user)
 
 	return user, nil
 }
 
 // ConfirmSignUp confirms a user registration.
-func (s *MemoryStorage) ConfirmSignUp(_ context.Context, clientID, username, code string) error {
+func (s *MemoryStorage) ConfirmSignUp(_ context.Context, req *ConfirmSignUpRequest) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	// Find user pool by client ID.
-	var userPoolID string
-
-	for _, client := range s.UserPoolClients {
-		if client.ClientID == clientID {
-			userPoolID = client.UserPoolID
-
-			break
-		}
+	client, ok := s.UserPoolClients[req.ClientID]
+	if !ok {
+		return &ServiceError{Code: errInvalidParameter, Message: "Invalid client ID"}
 	}
 
-	if userPoolID == "" {
-		return &ServiceError{Code: errInvalidParameter, Message: "Invalid client ID"}
+	if err := validateSecretHash(client, req.Username, req.SecretHash); err != nil {
+		return err
 	}
 
-	user, ok := s.Users[userPoolID][username]
+	user, ok := s.Users[client.UserPoolID][req.Username]
 	if !ok {
 		return &ServiceError{Code: errUserNotFound, Message: "User not found"}
 	}
 
-	// In a real implementation, we would verify the code.
-	// For testing, we accept any code or the default "123456".
-	expectedCode := s.ConfirmationCodes[username]
-	if expectedCode != "" && code != expectedCode && code != "123456" {
-		return &ServiceError{Code: errInvalidParameter, Message: "Invalid confirmation code"}
+	if user.UserStatus != UserStatusUnconfirmed {
+		return &ServiceError{Code: errNotAuthorized, Message: "User cannot be confirmed. Current status is " + string(user.UserStatus)}
+	}
+
+	key := resetCodeKey(user.UserPoolID, user.Username)
+
+	code, ok := s.SignUpCodes[key]
+	if !ok || time.Now().After(code.ExpiresAt) {
+		delete(s.SignUpCodes, key)
+
+		return &ServiceError{Code: errExpiredCode, Message: "Invalid code provided, please request a code again."}
+	}
+
+	if code.Code != req.ConfirmationCode {
+		return &ServiceError{Code: errCodeMismatch, Message: "Invalid verification code provided, please try again."}
 	}
 
 	user.UserStatus = UserStatusConfirmed
 	user.UserLastModified = time.Now()
 
-	delete(s.ConfirmationCodes, username)
+	delete(s.SignUpCodes, key)
 
 	return nil
 }
 
-// InitiateAuth initiates authentication.
-func (s *MemoryStorage) InitiateAuth(_ context.Context, req *InitiateAuthRequest) (*InitiateAuthResponse, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+// ResendConfirmationCode issues a new sign-up confirmation code for an unconfirmed user.
+func (s *MemoryStorage) ResendConfirmationCode(_ context.Context, req *ResendConfirmationCodeRequest) (*CodeDeliveryDetails, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	client, ok := s.UserPoolClients[req.ClientID]
+	if !ok {
+		return nil, &ServiceError{Code: errInvalidParameter, Message: "Invalid client ID"}
+	}
 
-	// Find user pool by client ID.
-	var userPoolID string
+	if err := validateSecretHash(client, req.Username, req.SecretHash); err != nil {
+		return nil, err
+	}
 
-	for _, client := range s.UserPoolClients {
-		if client.ClientID == req.ClientID {
-			userPoolID = client.UserPoolID
+	user, ok := s.Users[client.UserPoolID][req.Username]
+	if !ok {
+		return nil, &ServiceError{Code: errUserNotFound, Message: "User not found"}
+	}
 
-			break
+	if user.UserStatus != UserStatusUnconfirmed {
+		return nil, &ServiceError{Code: errInvalidParameter, Message: "User is already confirmed."}
+	}
+
+	details := codeDeliveryDetails(user)
+	if details == nil {
+		return nil, &ServiceError{
+			Code:    errInvalidParameter,
+			Message: "Cannot resend codes. Auto verification not turned on or there is no registered/verified email or phone_number",
 		}
 	}
 
-	if userPoolID == "" {
+	s.issueSignUpCode(user)
+
+	return details, nil
+}
+
+// issueSignUpCode generates and stores a new sign-up confirmation code for the
+// user, replacing any earlier one. Caller must hold the write lock.
+func (s *MemoryStorage) issueSignUpCode(user *User) {
+	s.SignUpCodes[resetCodeKey(user.UserPoolID, user.Username)] = &VerificationCode{
+		UserPoolID: user.UserPoolID,
+		Username:   user.Username,
+		Type:       VerificationCodeTypeSignUpConfirmation,
+		Code:       generateCode(),
+		ExpiresAt:  time.Now().Add(confirmationCodeTTL),
+	}
+}
+
+// InitiateAuth initiates authentication.
+func (s *MemoryStorage) InitiateAuth(ctx context.Context, req *InitiateAuthRequest) (*InitiateAuthResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	client, ok := s.UserPoolClients[req.ClientID]
+	if !ok {
 		return nil, &ServiceError{Code: errInvalidParameter, Message: "Invalid client ID"}
 	}
 
-	username := req.AuthParameters["USERNAME"]
-	password := req.AuthParameters["PASSWORD"]
+	pool, ok := s.UserPools[client.UserPoolID]
+	if !ok {
+		return nil, &ServiceError{Code: errUserPoolNotFound, Message: "User pool not found"}
+	}
+
+	return s.authenticate(ctx, pool, client, req.AuthParameters)
+}
+
+// AdminInitiateAuth initiates authentication on behalf of a user of the given user pool.
+func (s *MemoryStorage) AdminInitiateAuth(ctx context.Context, req *AdminInitiateAuthRequest) (*InitiateAuthResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pool, client, err := s.poolClient(req.UserPoolID, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.authenticate(ctx, pool, client, req.AuthParameters)
+}
+
+// poolClient returns the user pool and one of its clients. Caller must hold the lock.
+func (s *MemoryStorage) poolClient(userPoolID, clientID string) (*UserPool, *UserPoolClient, error) {
+	pool, ok := s.UserPools[userPoolID]
+	if !ok {
+		return nil, nil, &ServiceError{Code: errUserPoolNotFound, Message: "User pool not found"}
+	}
+
+	client, ok := s.UserPoolClients[clientID]
+	if !ok || client.UserPoolID != userPoolID {
+		return nil, nil, &ServiceError{Code: errUserPoolClientNotFound, Message: "User pool client " + clientID + " does not exist."}
+	}
+
+	return pool, client, nil
+}
+
+// authenticate checks the username and password in the auth parameters and
+// either issues tokens or starts the challenge the user must answer first.
+// Caller must hold the write lock.
+func (s *MemoryStorage) authenticate(ctx context.Context, pool *UserPool, client *UserPoolClient, params map[string]string) (*InitiateAuthResponse, error) {
+	username := params["USERNAME"]
+	password := params["PASSWORD"]
+
+	if err := validateSecretHash(client, username, params["SECRET_HASH"]); err != nil {
+		return nil, err
+	}
 
-	user, ok := s.Users[userPoolID][username]
+	user, ok := s.Users[pool.ID][username]
 	if !ok {
 		return nil, &ServiceError{Code: errUserNotFound, Message: "User not found"}
 	}
@@ -607,59 +1004,649 @@ func (s *MemoryStorage) InitiateAuth(_ context.Context, req *InitiateAuthRequest
 		return nil, &ServiceError{Code: errNotAuthorized, Message: "User is not confirmed"}
 	}
 
-	// Generate tokens.
-	accessToken := generateToken()
-	idToken := generateToken()
-	refreshToken := generateToken()
+	if user.UserStatus == UserStatusResetRequired {
+		return nil, &ServiceError{Code: errPasswordResetRequired, Message: "Password reset required for the user"}
+	}
+
+	// Admin-created users must set a new password before receiving tokens.
+	if user.UserStatus == UserStatusForceChangePassword {
+		return s.newPasswordChallenge(client, user)
+	}
+
+	result, err := issueTokens(poolIssuer(issuerBaseURL(ctx), pool), pool, client, user, s.userGroups(user))
+	if err != nil {
+		return nil, err
+	}
 
 	return &InitiateAuthResponse{
-		AuthenticationResult: &AuthenticationResult{
-			AccessToken:  accessToken,
-			ExpiresIn:    3600,
-			TokenType:    "Bearer",
-			RefreshToken: refreshToken,
-			IDToken:      idToken,
-		},
+		AuthenticationResult: result,
 	}, nil
 }
 
-// GetUserPoolByClientID retrieves a user pool by client ID.
-func (s *MemoryStorage) GetUserPoolByClientID(_ context.Context, clientID string) (*UserPool, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+// newPasswordChallenge starts a NEW_PASSWORD_REQUIRED challenge for the user.
+// Caller must hold the write lock.
+func (s *MemoryStorage) newPasswordChallenge(client *UserPoolClient, user *User) (*InitiateAuthResponse, error) {
+	session := generateToken()
+	s.sessions[session] = &AuthSession{
+		ClientID:      client.ClientID,
+		Username:      user.Username,
+		ChallengeName: ChallengeNewPasswordRequired,
+		ExpiresAt:     time.Now().Add(authSessionTTL),
+	}
 
-	client, ok := s.UserPoolClients[clientID]
-	if !ok {
-		return nil, &ServiceError{Code: errUserPoolClientNotFound, Message: "User pool client not found"}
+	userAttributes := make(map[string]string, len(user.Attributes))
+
+	for _, attr := range user.Attributes {
+		userAttributes[attr.Name] = attr.Value
 	}
 
-	pool, ok := s.UserPools[client.UserPoolID]
-	if !ok {
-		return nil, &ServiceError{Code: errUserPoolNotFound, Message: "User pool not found"}
+	attrsJSON, err := json.Marshal(userAttributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal user attributes: %w", err)
 	}
 
-	return pool, nil
+	return &InitiateAuthResponse{
+		ChallengeName: string(ChallengeNewPasswordRequired),
+		Session:       session,
+		ChallengeParameters: map[string]string{
+			"USER_ID_FOR_SRP":    user.Username,
+			"requiredAttributes": "[]",
+			"userAttributes":     string(attrsJSON),
+		},
+	}, nil
 }
 
-// GetUserPoolClientByID retrieves a user pool client by ID.
-func (s *MemoryStorage) GetUserPoolClientByID(_ context.Context, clientID string) (*UserPoolClient, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+// RespondToAuthChallenge completes an authentication challenge.
+func (s *MemoryStorage) RespondToAuthChallenge(ctx context.Context, req *RespondToAuthChallengeRequest) (*RespondToAuthChallengeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	client, ok := s.UserPoolClients[clientID]
-	if !ok {
-		return nil, &ServiceError{Code: errUserPoolClientNotFound, Message: "User pool client not found"}
+	return s.respondToChallenge(ctx, req.Session, req.ClientID, req.ChallengeName, req.ChallengeResponses)
+}
+
+// AdminRespondToAuthChallenge completes an authentication challenge started by AdminInitiateAuth.
+func (s *MemoryStorage) AdminRespondToAuthChallenge(ctx context.Context, req *AdminRespondToAuthChallengeRequest) (*RespondToAuthChallengeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, _, err := s.poolClient(req.UserPoolID, req.ClientID); err != nil {
+		return nil, err
 	}
 
-	return client, nil
+	return s.respondToChallenge(ctx, req.Session, req.ClientID, req.ChallengeName, req.ChallengeResponses)
 }
 
-// generateSecret generates a random client secret.
-func generateSecret() string {
-	b := make([]byte, 32)
-	_, _ = rand.Read(b)
+// respondToChallenge answers the challenge of a session started for the client.
+// Caller must hold the write lock.
+func (s *MemoryStorage) respondToChallenge(ctx context.Context, sessionToken, clientID, challengeName string, responses map[string]string) (*RespondToAuthChallengeResponse, error) {
+	session, ok := s.sessions[sessionToken]
+	if !ok || session.ClientID != clientID {
+		return nil, &ServiceError{Code: errNotAuthorized, Message: "Invalid session for the user."}
+	}
 
-	return base64.StdEncoding.EncodeToString(b)
+	if time.Now().After(session.ExpiresAt) {
+		delete(s.sessions, sessionToken)
+
+		return nil, &ServiceError{Code: errNotAuthorized, Message: "Invalid session for the user, session is expired."}
+	}
+
+	if challengeName != string(session.ChallengeName) {
+		return nil, &ServiceError{Code: errInvalidParameter, Message: "Invalid challenge name: " + challengeName}
+	}
+
+	if username := responses["USERNAME"]; username != "" && username != session.Username {
+		return nil, &ServiceError{Code: errNotAuthorized, Message: "Invalid session for the user."}
+	}
+
+	client, ok := s.UserPoolClients[session.ClientID]
+	if !ok {
+		return nil, &ServiceError{Code: errUserPoolClientNotFound, Message: "User pool client not found"}
+	}
+
+	if err := validateSecretHash(client, session.Username, responses["SECRET_HASH"]); err != nil {
+		return nil, err
+	}
+
+	pool, ok := s.UserPools[client.UserPoolID]
+	if !ok {
+		return nil, &ServiceError{Code: errUserPoolNotFound, Message: "User pool not found"}
+	}
+
+	user, ok := s.Users[pool.ID][session.Username]
+	if !ok {
+		return nil, &ServiceError{Code: errUserNotFound, Message: "User not found"}
+	}
+
+	newPassword := responses["NEW_PASSWORD"]
+	if newPassword == "" {
+		return nil, &ServiceError{Code: errInvalidParameter, Message: "Missing required parameter NEW_PASSWORD"}
+	}
+
+	if err := validatePassword(pool, newPassword); err != nil {
+		return nil, err
+	}
+
+	// Attributes can be supplied alongside the new password as "userAttributes.<name>".
+	for key, value := range responses {
+		if name, ok := strings.CutPrefix(key, "userAttributes."); ok {
+			setUserAttribute(user, name, value)
+		}
+	}
+
+	user.Password = newPassword
+	user.UserStatus = UserStatusConfirmed
+	user.UserLastModified = time.Now()
+
+	delete(s.sessions, sessionToken)
+
+	result, err := issueTokens(poolIssuer(issuerBaseURL(ctx), pool), pool, client, user, s.userGroups(user))
+	if err != nil {
+		return nil, err
+	}
+
+	return &RespondToAuthChallengeResponse{
+		AuthenticationResult: result,
+	}, nil
+}
+
+// ForgotPassword generates a password reset code for the user.
+func (s *MemoryStorage) ForgotPassword(_ context.Context, req *ForgotPasswordRequest) (*CodeDeliveryDetails, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	client, ok := s.UserPoolClients[req.ClientID]
+	if !ok {
+		return nil, &ServiceError{Code: errInvalidParameter, Message: "Invalid client ID"}
+	}
+
+	if err := validateSecretHash(client, req.Username, req.SecretHash); err != nil {
+		return nil, err
+	}
+
+	user, ok := s.Users[client.UserPoolID][req.Username]
+	if !ok {
+		return nil, &ServiceError{Code: errUserNotFound, Message: "User not found"}
+	}
+
+	details := codeDeliveryDetails(user)
+	if details == nil {
+		return nil, &ServiceError{
+			Code:    errInvalidParameter,
+			Message: "Cannot reset password for the user as there is no registered/verified email or phone_number",
+		}
+	}
+
+	s.issueResetCode(user)
+
+	return details, nil
+}
+
+// ConfirmForgotPassword validates the reset code and sets the new password.
+func (s *MemoryStorage) ConfirmForgotPassword(_ context.Context, req *ConfirmForgotPasswordRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	client, ok := s.UserPoolClients[req.ClientID]
+	if !ok {
+		return &ServiceError{Code: errInvalidParameter, Message: "Invalid client ID"}
+	}
+
+	if err := validateSecretHash(client, req.Username, req.SecretHash); err != nil {
+		return err
+	}
+
+	user, ok := s.Users[client.UserPoolID][req.Username]
+	if !ok {
+		return &ServiceError{Code: errUserNotFound, Message: "User not found"}
+	}
+
+	key := resetCodeKey(user.UserPoolID, user.Username)
+
+	code, ok := s.ResetCodes[key]
+	if !ok || time.Now().After(code.ExpiresAt) {
+		delete(s.ResetCodes, key)
+
+		return &ServiceError{Code: errExpiredCode, Message: "Invalid code provided, please request a code again."}
+	}
+
+	if code.Code != req.ConfirmationCode {
+		return &ServiceError{Code: errCodeMismatch, Message: "Invalid verification code provided, please try again."}
+	}
+
+	if err := validatePassword(s.UserPools[user.UserPoolID], req.Password); err != nil {
+		return err
+	}
+
+	user.Password = req.Password
+	user.UserStatus = UserStatusConfirmed
+	user.UserLastModified = time.Now()
+
+	delete(s.ResetCodes, key)
+
+	return nil
+}
+
+// AdminResetUserPassword marks the user as requiring a password reset and issues a reset code.
+func (s *MemoryStorage) AdminResetUserPassword(_ context.Context, userPoolID, username string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	users, ok := s.Users[userPoolID]
+	if !ok {
+		return &ServiceError{Code: errUserPoolNotFound, Message: "User pool not found"}
+	}
+
+	user, ok := users[username]
+	if !ok {
+		return &ServiceError{Code: errUserNotFound, Message: "User not found"}
+	}
+
+	user.UserStatus = UserStatusResetRequired
+	user.UserLastModified = time.Now()
+
+	s.issueResetCode(user)
+
+	return nil
+}
+
+// AdminSetUserPassword sets the password of a user. A permanent password
+// confirms the user; a temporary one requires the user to change it at the
+// next sign-in.
+func (s *MemoryStorage) AdminSetUserPassword(_ context.Context, req *AdminSetUserPasswordRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pool, ok := s.UserPools[req.UserPoolID]
+	if !ok {
+		return &ServiceError{Code: errUserPoolNotFound, Message: "User pool not found"}
+	}
+
+	user, ok := s.Users[req.UserPoolID][req.Username]
+	if !ok {
+		return &ServiceError{Code: errUserNotFound, Message: "User not found"}
+	}
+
+	if err := validatePassword(pool, req.Password); err != nil {
+		return err
+	}
+
+	user.Password = req.Password
+	user.UserStatus = UserStatusForceChangePassword
+	user.UserLastModified = time.Now()
+
+	if req.Permanent {
+		user.UserStatus = UserStatusConfirmed
+
+		delete(s.ResetCodes, resetCodeKey(user.UserPoolID, user.Username))
+	}
+
+	return nil
+}
+
+// ListVerificationCodes returns all outstanding verification codes.
+func (s *MemoryStorage) ListVerificationCodes(_ context.Context) ([]*VerificationCode, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	codes := make([]*VerificationCode, 0, len(s.SignUpCodes)+len(s.ResetCodes)+len(s.AttributeCodes))
+
+	for _, code := range s.SignUpCodes {
+		codes = append(codes, code)
+	}
+
+	for _, code := range s.ResetCodes {
+		codes = append(codes, code)
+	}
+
+	for _, code := range s.AttributeCodes {
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
+
+// issueResetCode generates and stores a new password reset code for the user.
+// Caller must hold the write lock.
+func (s *MemoryStorage) issueResetCode(user *User) {
+	s.ResetCodes[resetCodeKey(user.UserPoolID, user.Username)] = &VerificationCode{
+		UserPoolID: user.UserPoolID,
+		Username:   user.Username,
+		Type:       VerificationCodeTypePasswordReset,
+		Code:       generateCode(),
+		ExpiresAt:  time.Now().Add(passwordResetCodeTTL),
+	}
+}
+
+// resetCodeKey returns the SignUpCodes and ResetCodes key for a user.
+func resetCodeKey(userPoolID, username string) string {
+	return userPoolID + "/" + username
+}
+
+// codeDeliveryDetails returns where a code for the user would be delivered,
+// preferring email over SMS. It returns nil if the user has neither.
+func codeDeliveryDetails(user *User) *CodeDeliveryDetails {
+	if details := attributeDeliveryDetails(user, "email"); details != nil {
+		return details
+	}
+
+	return attributeDeliveryDetails(user, "phone_number")
+}
+
+// attributeDeliveryDetails returns where a code verifying the email or
+// phone_number attribute of the user would be delivered. It returns nil if the
+// user does not have the attribute.
+func attributeDeliveryDetails(user *User, name string) *CodeDeliveryDetails {
+	value := userAttribute(user, name)
+	if value == "" {
+		return nil
+	}
+
+	switch name {
+	case "email":
+		return &CodeDeliveryDetails{
+			AttributeName:  name,
+			DeliveryMedium: "EMAIL",
+			Destination:    maskEmail(value),
+		}
+	case "phone_number":
+		return &CodeDeliveryDetails{
+			AttributeName:  name,
+			DeliveryMedium: "SMS",
+			Destination:    maskPhone(value),
+		}
+	default:
+		return nil
+	}
+}
+
+// maskEmail masks an email address the way Cognito does (e.g., "j***@e***").
+func maskEmail(email string) string {
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok || local == "" || domain == "" {
+		return "***"
+	}
+
+	return local[:1] + "***@" + domain[:1] + "***"
+}
+
+// maskPhone masks all but the last four digits of a phone number.
+func maskPhone(phone string) string {
+	if len(phone) <= 4 {
+		return phone
+	}
+
+	return "+*******" + phone[len(phone)-4:]
+}
+
+// CreateGroup creates a new group in a user pool.
+func (s *MemoryStorage) CreateGroup(_ context.Context, req *CreateGroupRequest) (*Group, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.UserPools[req.UserPoolID]; !ok {
+		return nil, &ServiceError{Code: errUserPoolNotFound, Message: "User pool not found"}
+	}
+
+	groups := s.Groups[req.UserPoolID]
+	if groups == nil {
+		groups = make(map[string]*Group)
+		s.Groups[req.UserPoolID] = groups
+	}
+
+	if _, ok := groups[req.GroupName]; ok {
+		return nil, &ServiceError{Code: errGroupExists, Message: "A group with the name " + req.GroupName + " already exists."}
+	}
+
+	now := time.Now()
+	group := &Group{
+		GroupName:        req.GroupName,
+		UserPoolID:       req.UserPoolID,
+		Description:      req.Description,
+		RoleArn:          req.RoleArn,
+		Precedence:       req.Precedence,
+		CreationDate:     now,
+		LastModifiedDate: now,
+	}
+
+	groups[req.GroupName] = group
+
+	return group, nil
+}
+
+// GetGroup retrieves a group.
+func (s *MemoryStorage) GetGroup(_ context.Context, userPoolID, groupName string) (*Group, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.findGroup(userPoolID, groupName)
+}
+
+// ListGroups lists groups in a user pool, ordered by name.
+func (s *MemoryStorage) ListGroups(_ context.Context, userPoolID string, limit int32, nextToken string) ([]*Group, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.UserPools[userPoolID]; !ok {
+		return nil, "", &ServiceError{Code: errUserPoolNotFound, Message: "User pool not found"}
+	}
+
+	groups := make([]*Group, 0, len(s.Groups[userPoolID]))
+
+	for _, group := range s.Groups[userPoolID] {
+		groups = append(groups, group)
+	}
+
+	groups, next := paginateGroups(groups, limit, nextToken)
+
+	return groups, next, nil
+}
+
+// DeleteGroup deletes a group and removes all memberships.
+func (s *MemoryStorage) DeleteGroup(_ context.Context, userPoolID, groupName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.findGroup(userPoolID, groupName); err != nil {
+		return err
+	}
+
+	delete(s.Groups[userPoolID], groupName)
+
+	for _, user := range s.Users[userPoolID] {
+		user.Groups = slices.DeleteFunc(user.Groups, func(name string) bool { return name == groupName })
+	}
+
+	return nil
+}
+
+// AdminAddUserToGroup adds a user to a group.
+func (s *MemoryStorage) AdminAddUserToGroup(_ context.Context, userPoolID, username, groupName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, err := s.findUser(userPoolID, username)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.findGroup(userPoolID, groupName); err != nil {
+		return err
+	}
+
+	if !slices.Contains(user.Groups, groupName) {
+		user.Groups = append(user.Groups, groupName)
+	}
+
+	return nil
+}
+
+// AdminRemoveUserFromGroup removes a user from a group.
+func (s *MemoryStorage) AdminRemoveUserFromGroup(_ context.Context, userPoolID, username, groupName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, err := s.findUser(userPoolID, username)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.findGroup(userPoolID, groupName); err != nil {
+		return err
+	}
+
+	user.Groups = slices.DeleteFunc(user.Groups, func(name string) bool { return name == groupName })
+
+	return nil
+}
+
+// AdminListGroupsForUser lists the groups a user belongs to, ordered by name.
+func (s *MemoryStorage) AdminListGroupsForUser(_ context.Context, userPoolID, username string, limit int32, nextToken string) ([]*Group, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	user, err := s.findUser(userPoolID, username)
+	if err != nil {
+		return nil, "", err
+	}
+
+	groups, next := paginateGroups(s.userGroups(user), limit, nextToken)
+
+	return groups, next, nil
+}
+
+// findUser looks up a user. Caller must hold the lock.
+func (s *MemoryStorage) findUser(userPoolID, username string) (*User, error) {
+	users, ok := s.Users[userPoolID]
+	if !ok {
+		return nil, &ServiceError{Code: errUserPoolNotFound, Message: "User pool not found"}
+	}
+
+	user, ok := users[username]
+	if !ok {
+		return nil, &ServiceError{Code: errUserNotFound, Message: "User not found"}
+	}
+
+	return user, nil
+}
+
+// findGroup looks up a group. Caller must hold the lock.
+func (s *MemoryStorage) findGroup(userPoolID, groupName string) (*Group, error) {
+	if _, ok := s.UserPools[userPoolID]; !ok {
+		return nil, &ServiceError{Code: errUserPoolNotFound, Message: "User pool not found"}
+	}
+
+	group, ok := s.Groups[userPoolID][groupName]
+	if !ok {
+		return nil, &ServiceError{Code: errGroupNotFound, Message: "Group not found."}
+	}
+
+	return group, nil
+}
+
+// userGroups returns the groups the user belongs to. Caller must hold the lock.
+func (s *MemoryStorage) userGroups(user *User) []*Group {
+	groups := make([]*Group, 0, len(user.Groups))
+
+	for _, name := range user.Groups {
+		if group, ok := s.Groups[user.UserPoolID][name]; ok {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+// paginateGroups sorts groups by name and returns the page starting after nextToken.
+func paginateGroups(groups []*Group, limit int32, nextToken string) ([]*Group, string) {
+	if limit <= 0 {
+		limit = 60
+	}
+
+	slices.SortFunc(groups, func(a, b *Group) int { return strings.Compare(a.GroupName, b.GroupName) })
+
+	start := 0
+	if nextToken != "" {
+		start = sort.Search(len(groups), func(i int) bool { return groups[i].GroupName > nextToken })
+	}
+
+	end := min(start+int(limit), len(groups))
+	page := groups[start:end]
+
+	if end < len(groups) {
+		return page, page[len(page)-1].GroupName
+	}
+
+	return page, ""
+}
+
+// GetUserPoolByClientID retrieves a user pool by client ID.
+func (s *MemoryStorage) GetUserPoolByClientID(_ context.Context, clientID string) (*UserPool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	client, ok := s.UserPoolClients[clientID]
+	if !ok {
+		return nil, &ServiceError{Code: errUserPoolClientNotFound, Message: "User pool client not found"}
+	}
+
+	pool, ok := s.UserPools[client.UserPoolID]
+	if !ok {
+		return nil, &ServiceError{Code: errUserPoolNotFound, Message: "User pool not found"}
+	}
+
+	return pool, nil
+}
+
+// GetUserPoolClientByID retrieves a user pool client by ID.
+func (s *MemoryStorage) GetUserPoolClientByID(_ context.Context, clientID string) (*UserPoolClient, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	client, ok := s.UserPoolClients[clientID]
+	if !ok {
+		return nil, &ServiceError{Code: errUserPoolClientNotFound, Message: "User pool client not found"}
+	}
+
+	return client, nil
+}
+
+// setUserAttribute sets or replaces an attribute on the user.
+func setUserAttribute(user *User, name, value string) {
+	for i, attr := range user.Attributes {
+		if attr.Name == name {
+			user.Attributes[i].Value = value
+
+			return
+		}
+	}
+
+	user.Attributes = append(user.Attributes, UserAttribute{Name: name, Value: value})
+}
+
+// userAttribute returns the value of an attribute of the user, or "" if it is not set.
+func userAttribute(user *User, name string) string {
+	for _, attr := range user.Attributes {
+		if attr.Name == name {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
+// generateSecret generates a random client secret.
+func generateSecret() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// generateCode generates a random six-digit verification code.
+func generateCode() string {
+	n, _ := rand.Int(rand.Reader, big.NewInt(1000000))
+
+	return fmt.Sprintf("%06d", n.Int64())
 }
 
 // generateToken generates a random token.
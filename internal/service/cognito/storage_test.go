@@ -0,0 +1,57 @@
+package cognito
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRespondToAuthChallenge_DeletedClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := NewMemoryStorage()
+
+	pool, err := s.CreateUserPool(ctx, &CreateUserPoolRequest{PoolName: "pool", Region: "us-east-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := s.CreateUserPoolClient(ctx, &CreateUserPoolClientRequest{UserPoolID: pool.ID, ClientName: "app"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.AdminCreateUser(ctx, &AdminCreateUserRequest{UserPoolID: pool.ID, Username: "alice", TemporaryPassword: "TempPassw0rd!"}); err != nil {
+		t.Fatal(err)
+	}
+
+	auth, err := s.InitiateAuth(ctx, &InitiateAuthRequest{
+		AuthFlow:       "USER_PASSWORD_AUTH",
+		ClientID:       client.ClientID,
+		AuthParameters: map[string]string{"USERNAME": "alice", "PASSWORD": "TempPassw0rd!"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if auth.ChallengeName != "NEW_PASSWORD_REQUIRED" {
+		t.Fatalf("expected NEW_PASSWORD_REQUIRED challenge, got %q", auth.ChallengeName)
+	}
+
+	if err := s.DeleteUserPoolClient(ctx, pool.ID, client.ClientID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = s.RespondToAuthChallenge(ctx, &RespondToAuthChallengeRequest{
+		ChallengeName:      "NEW_PASSWORD_REQUIRED",
+		ClientID:           client.ClientID,
+		Session:            auth.Session,
+		ChallengeResponses: map[string]string{"USERNAME": "alice", "NEW_PASSWORD": "NewPassw0rd!"},
+	})
+
+	var serviceErr *ServiceError
+	if !errors.As(err, &serviceErr) || serviceErr.Code != errUserPoolClientNotFound {
+		t.Errorf("expected %s, got %v", errUserPoolClientNotFound, err)
+	}
+}
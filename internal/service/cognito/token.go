@@ -0,0 +1,346 @@
+package cognito
+
+import (
+	"cmp"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// signingKeyBits is the RSA key size used for user pool token signing keys.
+const signingKeyBits = 2048
+
+// JWK represents a single JSON Web Key.
+type JWK struct {
+	Alg string `json:"alg"`
+	E   string `json:"e"`
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	Use string `json:"use"`
+}
+
+// JWKSet represents the JSON Web Key Set served for a user pool.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// newSigningKey generates a new RSA signing key and returns it PEM-encoded along with its key ID.
+func newSigningKey() (string, string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, signingKeyBits)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate signing key: %w", err)
+	}
+
+	block := &pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}
+
+	return string(pem.EncodeToMemory(block)), uuid.New().String(), nil
+}
+
+// parseSigningKey decodes a PEM-encoded RSA private key.
+func parseSigningKey(data string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("invalid signing key")
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing key: %w", err)
+	}
+
+	return key, nil
+}
+
+// ensureSigningKey assigns a signing key to the pool if it does not have one yet.
+func ensureSigningKey(pool *UserPool) error {
+	if pool.SigningKey != "" {
+		return nil
+	}
+
+	key, kid, err := newSigningKey()
+	if err != nil {
+		return err
+	}
+
+	pool.SigningKey = key
+	pool.SigningKeyID = kid
+
+	return nil
+}
+
+// poolJWKSet returns the public JSON Web Key Set for the pool.
+func poolJWKSet(pool *UserPool) (*JWKSet, error) {
+	key, err := parseSigningKey(pool.SigningKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JWKSet{
+		Keys: []JWK{
+			{
+				Alg: "RS256",
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+				Kid: pool.SigningKeyID,
+				Kty: "RSA",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				Use: "sig",
+			},
+		},
+	}, nil
+}
+
+// defaultBaseURL is the URL token issuers are built from when the URL kumo was reached at is unknown.
+const defaultBaseURL = "http://localhost:4566"
+
+// issuerBaseURLKey is the context key of the URL a request reached kumo at.
+type issuerBaseURLKey struct{}
+
+// withIssuerBaseURL returns a context carrying the URL the request reached kumo at,
+// built from its scheme and Host header, which the issuer of tokens it obtains is built from.
+func withIssuerBaseURL(r *http.Request) context.Context {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	return context.WithValue(r.Context(), issuerBaseURLKey{}, scheme+"://"+r.Host)
+}
+
+// issuerBaseURL returns the URL carried by withIssuerBaseURL, or defaultBaseURL.
+func issuerBaseURL(ctx context.Context) string {
+	if baseURL, ok := ctx.Value(issuerBaseURLKey{}).(string); ok && baseURL != "" {
+		return baseURL
+	}
+
+	return defaultBaseURL
+}
+
+// poolIssuer returns the token issuer URL for the pool. Unlike AWS, where it is
+// https://cognito-idp.{region}.amazonaws.com/{userPoolId}, it points at kumo, so that
+// verifiers looking up the JWKS at {issuer}/.well-known/jwks.json find the pool keys.
+func poolIssuer(baseURL string, pool *UserPool) string {
+	return baseURL + "/kumo/cognito-idp/" + pool.ID
+}
+
+// signJWT signs the claims with the pool signing key using RS256.
+func signJWT(pool *UserPool, claims map[string]any) (string, error) {
+	key, err := parseSigningKey(pool.SigningKey)
+	if err != nil {
+		return "", err
+	}
+
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"kid": pool.SigningKeyID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal header: %w", err)
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal claims: %w", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// tokenPoolID returns the ID of the user pool that issued the token, read from
+// its unverified iss claim so that the pool key can verify it.
+func tokenPoolID(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", errors.New("malformed token")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("failed to decode token payload: %w", err)
+	}
+
+	var claims struct {
+		Iss string `json:"iss"`
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("failed to unmarshal token payload: %w", err)
+	}
+
+	i := strings.LastIndex(claims.Iss, "/")
+	if i < 0 || i == len(claims.Iss)-1 {
+		return "", errors.New("token has no issuer")
+	}
+
+	poolID := claims.Iss[i+1:]
+
+	return poolID, nil
+}
+
+// verifyJWT checks the RS256 signature of a token against the pool signing key
+// and returns its claims.
+func verifyJWT(pool *UserPool, token string) (map[string]any, error) {
+	key, err := parseSigningKey(pool.SigningKey)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode token signature: %w", err)
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], sig); err != nil {
+		return nil, fmt.Errorf("invalid token signature: %w", err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode token payload: %w", err)
+	}
+
+	var claims map[string]any
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token payload: %w", err)
+	}
+
+	return claims, nil
+}
+
+// issueTokens builds a signed access/ID token pair and an opaque refresh token for the user,
+// issued by issuer.
+// Group membership is surfaced in the cognito:groups claim, ordered by precedence.
+func issueTokens(issuer string, pool *UserPool, client *UserPoolClient, user *User, groups []*Group) (*AuthenticationResult, error) {
+	now := time.Now()
+	originJTI := uuid.New().String()
+	accessExpiry := time.Duration(client.AccessTokenValidity) * time.Minute
+	idExpiry := time.Duration(client.IDTokenValidity) * time.Minute
+
+	accessClaims := map[string]any{
+		"sub":        user.Sub,
+		"iss":        issuer,
+		"client_id":  client.ClientID,
+		"origin_jti": originJTI,
+		"event_id":   uuid.New().String(),
+		"token_use":  "access",
+		"scope":      "aws.cognito.signin.user.admin",
+		"auth_time":  now.Unix(),
+		"exp":        now.Add(accessExpiry).Unix(),
+		"iat":        now.Unix(),
+		"jti":        uuid.New().String(),
+		"username":   user.Username,
+	}
+
+	idClaims := map[string]any{
+		"sub":              user.Sub,
+		"iss":              issuer,
+		"aud":              client.ClientID,
+		"cognito:username": user.Username,
+		"origin_jti":       originJTI,
+		"event_id":         accessClaims["event_id"],
+		"token_use":        "id",
+		"auth_time":        now.Unix(),
+		"exp":              now.Add(idExpiry).Unix(),
+		"iat":              now.Unix(),
+		"jti":              uuid.New().String(),
+	}
+
+	if len(groups) > 0 {
+		names := groupNamesByPrecedence(groups)
+		accessClaims["cognito:groups"] = names
+		idClaims["cognito:groups"] = names
+	}
+
+	for _, attr := range user.Attributes {
+		if _, ok := idClaims[attr.Name]; ok {
+			continue
+		}
+
+		switch attr.Value {
+		case "true":
+			idClaims[attr.Name] = true
+		case "false":
+			idClaims[attr.Name] = false
+		default:
+			idClaims[attr.Name] = attr.Value
+		}
+	}
+
+	accessToken, err := signJWT(pool, accessClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	idToken, err := signJWT(pool, idClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthenticationResult{
+		AccessToken:  accessToken,
+		ExpiresIn:    int32(accessExpiry.Seconds()),
+		TokenType:    "Bearer",
+		RefreshToken: generateToken(),
+		IDToken:      idToken,
+	}, nil
+}
+
+// groupNamesByPrecedence returns group names ordered by precedence, lowest first.
+// Groups without a precedence sort after those with one.
+func groupNamesByPrecedence(groups []*Group) []string {
+	sorted := slices.Clone(groups)
+	slices.SortStableFunc(sorted, func(a, b *Group) int {
+		switch {
+		case a.Precedence == nil && b.Precedence == nil:
+			return strings.Compare(a.GroupName, b.GroupName)
+		case a.Precedence == nil:
+			return 1
+		case b.Precedence == nil:
+			return -1
+		case *a.Precedence != *b.Precedence:
+			return cmp.Compare(*a.Precedence, *b.Precedence)
+		default:
+			return strings.Compare(a.GroupName, b.GroupName)
+		}
+	})
+
+	names := make([]string, len(sorted))
+
+	for i, group := range sorted {
+		names[i] = group.GroupName
+	}
+
+	return names
+}
@@ -0,0 +1,54 @@
+package cognito
+
+import (
+	"testing"
+)
+
+func TestVerifyJWT(t *testing.T) {
+	t.Parallel()
+
+	pool := &UserPool{ID: "us-east-1_abc123"}
+	if err := ensureSigningKey(pool); err != nil {
+		t.Fatal(err)
+	}
+
+	other := &UserPool{ID: "us-east-1_other"}
+	if err := ensureSigningKey(other); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := signJWT(pool, map[string]any{"iss": poolIssuer(defaultBaseURL, pool), "token_use": "access"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	poolID, err := tokenPoolID(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if poolID != pool.ID {
+		t.Errorf("tokenPoolID() = %s, want %s", poolID, pool.ID)
+	}
+
+	claims, err := verifyJWT(pool, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if claims["token_use"] != "access" {
+		t.Errorf("expected the access token_use claim, got %v", claims["token_use"])
+	}
+
+	if _, err := verifyJWT(other, token); err == nil {
+		t.Error("expected an error verifying with another pool's key")
+	}
+
+	if _, err := verifyJWT(pool, token[:len(token)-4]+"AAAA"); err == nil {
+		t.Error("expected an error verifying a tampered signature")
+	}
+
+	if _, err := tokenPoolID("not-a-token"); err == nil {
+		t.Error("expected an error for a malformed token")
+	}
+}
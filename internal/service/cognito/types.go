@@ -63,6 +63,8 @@ type UserPool struct {
 	UsernameAttributes []string
 	MFAConfiguration   string
 	EmailConfiguration *EmailConfiguration
+	SigningKey         string
+	SigningKeyID       string
 }
 
 // UserPoolPolicies represents user pool policies.
@@ -124,6 +126,7 @@ type UserPoolClient struct {
 // User represents a user in a user pool.
 type User struct {
 	Username         string
+	Sub              string
 	UserPoolID       string
 	Attributes       []UserAttribute
 	UserCreateDate   time.Time
@@ -132,6 +135,47 @@ type User struct {
 	UserStatus       UserStatus
 	Password         string
 	MFAOptions       []MFAOption
+	Groups           []string
+}
+
+// Group represents a user pool group.
+type Group struct {
+	GroupName        string
+	UserPoolID       string
+	Description      string
+	RoleArn          string
+	Precedence       *int32
+	CreationDate     time.Time
+	LastModifiedDate time.Time
+}
+
+// AuthSession represents an in-progress authentication challenge.
+type AuthSession struct {
+	ClientID      string
+	Username      string
+	ChallengeName ChallengeNameType
+	ExpiresAt     time.Time
+}
+
+// VerificationCodeType represents what a verification code is used for.
+type VerificationCodeType string
+
+// Verification code types.
+const (
+	VerificationCodeTypeSignUpConfirmation    VerificationCodeType = "SIGN_UP_CONFIRMATION"
+	VerificationCodeTypePasswordReset         VerificationCodeType = "PASSWORD_RESET"
+	VerificationCodeTypeAttributeVerification VerificationCodeType = "ATTRIBUTE_VERIFICATION"
+)
+
+// VerificationCode represents a code delivered to a user out of band.
+type VerificationCode struct {
+	UserPoolID string               `json:"UserPoolId"`
+	Username   string               `json:"Username"`
+	Type       VerificationCodeType `json:"Type"`
+	Code       string               `json:"Code"`
+	// AttributeName is the attribute an ATTRIBUTE_VERIFICATION code verifies.
+	AttributeName string `json:"AttributeName,omitempty"`
+	ExpiresAt  time.Time            `json:"ExpiresAt"`
 }
 
 // UserAttribute represents a user attribute.
@@ -417,6 +461,60 @@ type AdminGetUserResponse struct {
 	MFAOptions           []MFAOptionOutput     `json:"MFAOptions,omitempty"`
 }
 
+// GetUserRequest is the request for GetUser.
+type GetUserRequest struct {
+	AccessToken string `json:"AccessToken"`
+}
+
+// GetUserResponse is the response for GetUser.
+type GetUserResponse struct {
+	Username       string                `json:"Username"`
+	UserAttributes []UserAttributeOutput `json:"UserAttributes"`
+	MFAOptions     []MFAOptionOutput     `json:"MFAOptions,omitempty"`
+}
+
+// UpdateUserAttributesRequest is the request for UpdateUserAttributes.
+type UpdateUserAttributesRequest struct {
+	AccessToken    string               `json:"AccessToken"`
+	UserAttributes []UserAttributeInput `json:"UserAttributes"`
+}
+
+// UpdateUserAttributesResponse is the response for UpdateUserAttributes.
+type UpdateUserAttributesResponse struct {
+	CodeDeliveryDetailsList []*CodeDeliveryDetails `json:"CodeDeliveryDetailsList,omitempty"`
+}
+
+// AdminUpdateUserAttributesRequest is the request for AdminUpdateUserAttributes.
+type AdminUpdateUserAttributesRequest struct {
+	UserPoolID     string               `json:"UserPoolId"`
+	Username       string               `json:"Username"`
+	UserAttributes []UserAttributeInput `json:"UserAttributes"`
+}
+
+// AdminUpdateUserAttributesResponse is the response for AdminUpdateUserAttributes.
+type AdminUpdateUserAttributesResponse struct{}
+
+// GetUserAttributeVerificationCodeRequest is the request for GetUserAttributeVerificationCode.
+type GetUserAttributeVerificationCodeRequest struct {
+	AccessToken   string `json:"AccessToken"`
+	AttributeName string `json:"AttributeName"`
+}
+
+// GetUserAttributeVerificationCodeResponse is the response for GetUserAttributeVerificationCode.
+type GetUserAttributeVerificationCodeResponse struct {
+	CodeDeliveryDetails *CodeDeliveryDetails `json:"CodeDeliveryDetails"`
+}
+
+// VerifyUserAttributeRequest is the request for VerifyUserAttribute.
+type VerifyUserAttributeRequest struct {
+	AccessToken   string `json:"AccessToken"`
+	AttributeName string `json:"AttributeName"`
+	Code          string `json:"Code"`
+}
+
+// VerifyUserAttributeResponse is the response for VerifyUserAttribute.
+type VerifyUserAttributeResponse struct{}
+
 // AdminDeleteUserRequest is the request for AdminDeleteUser.
 type AdminDeleteUserRequest struct {
 	UserPoolID string `json:"UserPoolId"`
@@ -452,8 +550,9 @@ type SignUpRequest struct {
 
 // SignUpResponse is the response for SignUp.
 type SignUpResponse struct {
-	UserConfirmed bool   `json:"UserConfirmed"`
-	UserSub       string `json:"UserSub"`
+	UserConfirmed       bool                 `json:"UserConfirmed"`
+	UserSub             string               `json:"UserSub"`
+	CodeDeliveryDetails *CodeDeliveryDetails `json:"CodeDeliveryDetails,omitempty"`
 }
 
 // ConfirmSignUpRequest is the request for ConfirmSignUp.
@@ -467,6 +566,18 @@ type ConfirmSignUpRequest struct {
 // ConfirmSignUpResponse is the response for ConfirmSignUp.
 type ConfirmSignUpResponse struct{}
 
+// ResendConfirmationCodeRequest is the request for ResendConfirmationCode.
+type ResendConfirmationCodeRequest struct {
+	ClientID   string `json:"ClientId"`
+	Username   string `json:"Username"`
+	SecretHash string `json:"SecretHash,omitempty"`
+}
+
+// ResendConfirmationCodeResponse is the response for ResendConfirmationCode.
+type ResendConfirmationCodeResponse struct {
+	CodeDeliveryDetails *CodeDeliveryDetails `json:"CodeDeliveryDetails"`
+}
+
 // InitiateAuthRequest is the request for InitiateAuth.
 type InitiateAuthRequest struct {
 	AuthFlow       string            `json:"AuthFlow"`
@@ -507,6 +618,171 @@ type RespondToAuthChallengeResponse struct {
 	AuthenticationResult *AuthenticationResult `json:"AuthenticationResult,omitempty"`
 }
 
+// AdminInitiateAuthRequest is the request for AdminInitiateAuth.
+type AdminInitiateAuthRequest struct {
+	UserPoolID     string            `json:"UserPoolId"`
+	ClientID       string            `json:"ClientId"`
+	AuthFlow       string            `json:"AuthFlow"`
+	AuthParameters map[string]string `json:"AuthParameters,omitempty"`
+}
+
+// AdminRespondToAuthChallengeRequest is the request for AdminRespondToAuthChallenge.
+type AdminRespondToAuthChallengeRequest struct {
+	UserPoolID         string            `json:"UserPoolId"`
+	ClientID           string            `json:"ClientId"`
+	ChallengeName      string            `json:"ChallengeName"`
+	ChallengeResponses map[string]string `json:"ChallengeResponses,omitempty"`
+	Session            string            `json:"Session,omitempty"`
+}
+
+// ForgotPasswordRequest is the request for ForgotPassword.
+type ForgotPasswordRequest struct {
+	ClientID   string `json:"ClientId"`
+	Username   string `json:"Username"`
+	SecretHash string `json:"SecretHash,omitempty"`
+}
+
+// CodeDeliveryDetails describes where a verification code was sent.
+type CodeDeliveryDetails struct {
+	AttributeName  string `json:"AttributeName"`
+	DeliveryMedium string `json:"DeliveryMedium"`
+	Destination    string `json:"Destination"`
+}
+
+// ForgotPasswordResponse is the response for ForgotPassword.
+type ForgotPasswordResponse struct {
+	CodeDeliveryDetails *CodeDeliveryDetails `json:"CodeDeliveryDetails"`
+}
+
+// ConfirmForgotPasswordRequest is the request for ConfirmForgotPassword.
+type ConfirmForgotPasswordRequest struct {
+	ClientID         string `json:"ClientId"`
+	Username         string `json:"Username"`
+	ConfirmationCode string `json:"ConfirmationCode"`
+	Password         string `json:"Password"`
+	SecretHash       string `json:"SecretHash,omitempty"`
+}
+
+// ConfirmForgotPasswordResponse is the response for ConfirmForgotPassword.
+type ConfirmForgotPasswordResponse struct{}
+
+// AdminResetUserPasswordRequest is the request for AdminResetUserPassword.
+type AdminResetUserPasswordRequest struct {
+	UserPoolID string `json:"UserPoolId"`
+	Username   string `json:"Username"`
+}
+
+// AdminResetUserPasswordResponse is the response for AdminResetUserPassword.
+type AdminResetUserPasswordResponse struct{}
+
+// AdminSetUserPasswordRequest is the request for AdminSetUserPassword.
+type AdminSetUserPasswordRequest struct {
+	UserPoolID string `json:"UserPoolId"`
+	Username   string `json:"Username"`
+	Password   string `json:"Password"`
+	Permanent  bool   `json:"Permanent,omitempty"`
+}
+
+// AdminSetUserPasswordResponse is the response for AdminSetUserPassword.
+type AdminSetUserPasswordResponse struct{}
+
+// GetVerificationCodesResponse is the response for the kumo-specific verification codes endpoint.
+type GetVerificationCodesResponse struct {
+	VerificationCodes []*VerificationCode `json:"VerificationCodes"`
+}
+
+// CreateGroupRequest is the request for CreateGroup.
+type CreateGroupRequest struct {
+	UserPoolID  string `json:"UserPoolId"`
+	GroupName   string `json:"GroupName"`
+	Description string `json:"Description,omitempty"`
+	RoleArn     string `json:"RoleArn,omitempty"`
+	Precedence  *int32 `json:"Precedence,omitempty"`
+}
+
+// CreateGroupResponse is the response for CreateGroup.
+type CreateGroupResponse struct {
+	Group *GroupOutput `json:"Group"`
+}
+
+// GroupOutput represents a group in API responses.
+type GroupOutput struct {
+	GroupName        string  `json:"GroupName"`
+	UserPoolID       string  `json:"UserPoolId"`
+	Description      string  `json:"Description,omitempty"`
+	RoleArn          string  `json:"RoleArn,omitempty"`
+	Precedence       *int32  `json:"Precedence,omitempty"`
+	CreationDate     float64 `json:"CreationDate"`
+	LastModifiedDate float64 `json:"LastModifiedDate"`
+}
+
+// GetGroupRequest is the request for GetGroup.
+type GetGroupRequest struct {
+	UserPoolID string `json:"UserPoolId"`
+	GroupName  string `json:"GroupName"`
+}
+
+// GetGroupResponse is the response for GetGroup.
+type GetGroupResponse struct {
+	Group *GroupOutput `json:"Group"`
+}
+
+// ListGroupsRequest is the request for ListGroups.
+type ListGroupsRequest struct {
+	UserPoolID string `json:"UserPoolId"`
+	Limit      int32  `json:"Limit,omitempty"`
+	NextToken  string `json:"NextToken,omitempty"`
+}
+
+// ListGroupsResponse is the response for ListGroups.
+type ListGroupsResponse struct {
+	Groups    []GroupOutput `json:"Groups"`
+	NextToken string        `json:"NextToken,omitempty"`
+}
+
+// DeleteGroupRequest is the request for DeleteGroup.
+type DeleteGroupRequest struct {
+	UserPoolID string `json:"UserPoolId"`
+	GroupName  string `json:"GroupName"`
+}
+
+// DeleteGroupResponse is the response for DeleteGroup.
+type DeleteGroupResponse struct{}
+
+// AdminAddUserToGroupRequest is the request for AdminAddUserToGroup.
+type AdminAddUserToGroupRequest struct {
+	UserPoolID string `json:"UserPoolId"`
+	Username   string `json:"Username"`
+	GroupName  string `json:"GroupName"`
+}
+
+// AdminAddUserToGroupResponse is the response for AdminAddUserToGroup.
+type AdminAddUserToGroupResponse struct{}
+
+// AdminRemoveUserFromGroupRequest is the request for AdminRemoveUserFromGroup.
+type AdminRemoveUserFromGroupRequest struct {
+	UserPoolID string `json:"UserPoolId"`
+	Username   string `json:"Username"`
+	GroupName  string `json:"GroupName"`
+}
+
+// AdminRemoveUserFromGroupResponse is the response for AdminRemoveUserFromGroup.
+type AdminRemoveUserFromGroupResponse struct{}
+
+// AdminListGroupsForUserRequest is the request for AdminListGroupsForUser.
+type AdminListGroupsForUserRequest struct {
+	UserPoolID string `json:"UserPoolId"`
+	Username   string `json:"Username"`
+	Limit      int32  `json:"Limit,omitempty"`
+	NextToken  string `json:"NextToken,omitempty"`
+}
+
+// AdminListGroupsForUserResponse is the response for AdminListGroupsForUser.
+type AdminListGroupsForUserResponse struct {
+	Groups    []GroupOutput `json:"Groups"`
+	NextToken string        `json:"NextToken,omitempty"`
+}
+
 // ErrorResponse represents a Cognito error response.
 type ErrorResponse struct {
 	Type    string `json:"__type"`
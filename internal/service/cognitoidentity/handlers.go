@@ -0,0 +1,311 @@
+package cognitoidentity
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// handlerFunc is a type alias for handler functions.
+type handlerFunc func(http.ResponseWriter, *http.Request)
+
+// getActionHandlers returns a map of action names to handler functions.
+func (s *Service) getActionHandlers() map[string]handlerFunc {
+	return map[string]handlerFunc{
+		"CreateIdentityPool":        s.CreateIdentityPool,
+		"DescribeIdentityPool":      s.DescribeIdentityPool,
+		"ListIdentityPools":         s.ListIdentityPools,
+		"DeleteIdentityPool":        s.DeleteIdentityPool,
+		"SetIdentityPoolRoles":      s.SetIdentityPoolRoles,
+		"GetIdentityPoolRoles":      s.GetIdentityPoolRoles,
+		"GetId":                     s.GetID,
+		"GetCredentialsForIdentity": s.GetCredentialsForIdentity,
+	}
+}
+
+// DispatchAction dispatches the request to the appropriate handler.
+func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
+	target := r.Header.Get("X-Amz-Target")
+	action := strings.TrimPrefix(target, "AWSCognitoIdentityService.")
+
+	handlers := s.getActionHandlers()
+	if handler, ok := handlers[action]; ok {
+		handler(w, r)
+
+		return
+	}
+
+	writeError(w, "InvalidAction", "The action "+action+" is not valid for this endpoint.", http.StatusBadRequest)
+}
+
+// CreateIdentityPool handles the CreateIdentityPool API.
+func (s *Service) CreateIdentityPool(w http.ResponseWriter, r *http.Request) {
+	var req CreateIdentityPoolRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	region, err := extractRegion(r)
+	if err != nil {
+		writeError(w, "ValidationException", err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	req.Region = region
+
+	pool, err := s.storage.CreateIdentityPool(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, identityPoolToOutput(pool))
+}
+
+// DescribeIdentityPool handles the DescribeIdentityPool API.
+func (s *Service) DescribeIdentityPool(w http.ResponseWriter, r *http.Request) {
+	var req DescribeIdentityPoolRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	pool, err := s.storage.DescribeIdentityPool(r.Context(), req.IdentityPoolID)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, identityPoolToOutput(pool))
+}
+
+// ListIdentityPools handles the ListIdentityPools API.
+func (s *Service) ListIdentityPools(w http.ResponseWriter, r *http.Request) {
+	var req ListIdentityPoolsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	pools, nextToken, err := s.storage.ListIdentityPools(r.Context(), req.MaxResults, req.NextToken)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	descriptions := make([]IdentityPoolShortDescription, len(pools))
+
+	for i, pool := range pools {
+		descriptions[i] = IdentityPoolShortDescription{
+			IdentityPoolID:   pool.IdentityPoolID,
+			IdentityPoolName: pool.IdentityPoolName,
+		}
+	}
+
+	resp := &ListIdentityPoolsResponse{
+		IdentityPools: descriptions,
+		NextToken:     nextToken,
+	}
+
+	writeResponse(w, resp)
+}
+
+// DeleteIdentityPool handles the DeleteIdentityPool API.
+func (s *Service) DeleteIdentityPool(w http.ResponseWriter, r *http.Request) {
+	var req DeleteIdentityPoolRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.DeleteIdentityPool(r.Context(), req.IdentityPoolID); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, struct{}{})
+}
+
+// SetIdentityPoolRoles handles the SetIdentityPoolRoles API.
+func (s *Service) SetIdentityPoolRoles(w http.ResponseWriter, r *http.Request) {
+	var req SetIdentityPoolRolesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.SetIdentityPoolRoles(r.Context(), req.IdentityPoolID, req.Roles); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, struct{}{})
+}
+
+// GetIdentityPoolRoles handles the GetIdentityPoolRoles API.
+func (s *Service) GetIdentityPoolRoles(w http.ResponseWriter, r *http.Request) {
+	var req GetIdentityPoolRolesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	pool, err := s.storage.DescribeIdentityPool(r.Context(), req.IdentityPoolID)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	resp := &GetIdentityPoolRolesResponse{
+		IdentityPoolID: pool.IdentityPoolID,
+		Roles:          pool.Roles,
+	}
+
+	writeResponse(w, resp)
+}
+
+// GetID handles the GetId API.
+func (s *Service) GetID(w http.ResponseWriter, r *http.Request) {
+	var req GetIDRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	identity, err := s.storage.GetID(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &GetIDResponse{IdentityID: identity.IdentityID})
+}
+
+// GetCredentialsForIdentity handles the GetCredentialsForIdentity API.
+func (s *Service) GetCredentialsForIdentity(w http.ResponseWriter, r *http.Request) {
+	var req GetCredentialsForIdentityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	creds, err := s.storage.GetCredentialsForIdentity(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	resp := &GetCredentialsForIdentityResponse{
+		IdentityID:  req.IdentityID,
+		Credentials: creds,
+	}
+
+	writeResponse(w, resp)
+}
+
+// identityPoolToOutput converts an IdentityPool to its API representation.
+func identityPoolToOutput(pool *IdentityPool) *IdentityPoolOutput {
+	return &IdentityPoolOutput{
+		IdentityPoolID:                 pool.IdentityPoolID,
+		IdentityPoolName:               pool.IdentityPoolName,
+		AllowUnauthenticatedIdentities: pool.AllowUnauthenticatedIdentities,
+		AllowClassicFlow:               pool.AllowClassicFlow,
+		SupportedLoginProviders:        pool.SupportedLoginProviders,
+		DeveloperProviderName:          pool.DeveloperProviderName,
+		OpenIDConnectProviderARNs:      pool.OpenIDConnectProviderARNs,
+		CognitoIdentityProviders:       pool.CognitoIdentityProviders,
+		SamlProviderARNs:               pool.SamlProviderARNs,
+		IdentityPoolTags:               pool.IdentityPoolTags,
+	}
+}
+
+// writeResponse writes a JSON response.
+func writeResponse(w http.ResponseWriter, resp any) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	w.Header().Set("x-amzn-RequestId", uuid.New().String())
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// writeError writes an error response.
+func writeError(w http.ResponseWriter, code, message string, status int) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	w.Header().Set("x-amzn-RequestId", uuid.New().String())
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(&ErrorResponse{
+		Type:    code,
+		Message: message,
+	})
+}
+
+// handleError handles service errors.
+func handleError(w http.ResponseWriter, err error) {
+	var svcErr *ServiceError
+	if errors.As(err, &svcErr) {
+		status := getErrorStatus(svcErr.Code)
+		writeError(w, svcErr.Code, svcErr.Message, status)
+
+		return
+	}
+
+	writeError(w, "InternalErrorException", err.Error(), http.StatusInternalServerError)
+}
+
+// getErrorStatus returns the HTTP status code for a given error code.
+func getErrorStatus(code string) int {
+	switch code {
+	case errResourceNotFound:
+		return http.StatusNotFound
+	case errNotAuthorized:
+		return http.StatusUnauthorized
+	case errResourceConflict:
+		return http.StatusConflict
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// extractRegion extracts the AWS region from the Authorization header.
+// The header format is: AWS4-HMAC-SHA256 Credential=AKID/DATE/REGION/SERVICE/aws4_request, ...
+func extractRegion(r *http.Request) (string, error) {
+	auth := r.Header.Get("Authorization")
+
+	credIdx := strings.Index(auth, "Credential=")
+	if credIdx == -1 {
+		return "", errors.New("missing Credential in Authorization header")
+	}
+
+	credVal := auth[credIdx+len("Credential="):]
+	if commaIdx := strings.Index(credVal, ","); commaIdx != -1 {
+		credVal = credVal[:commaIdx]
+	}
+
+	// Format: AKID/DATE/REGION/SERVICE/aws4_request
+	parts := strings.Split(credVal, "/")
+	if len(parts) < 3 {
+		return "", errors.New("invalid Credential format in Authorization header")
+	}
+
+	return parts[2], nil
+}
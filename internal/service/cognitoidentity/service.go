@@ -0,0 +1,78 @@
+// Package cognitoidentity provides AWS Cognito Identity (federated identity pool) service emulation.
+package cognitoidentity
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// Service implements the Cognito Identity service.
+type Service struct {
+	storage Storage
+}
+
+// New creates a new Cognito Identity service.
+func New(storage Storage) *Service {
+	return &Service{storage: storage}
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "cognito-identity"
+}
+
+// TargetPrefix returns the AWS JSON target prefix.
+func (s *Service) TargetPrefix() string {
+	return "AWSCognitoIdentityService"
+}
+
+// JSONProtocol marks this service as using AWS JSON 1.1 protocol.
+func (s *Service) JSONProtocol() {}
+
+// RegisterRoutes registers routes for REST-based operations.
+func (s *Service) RegisterRoutes(_ service.Router) {
+	// Cognito Identity uses AWS JSON protocol with X-Amz-Target header.
+	// Routes are handled by DispatchAction.
+}
+
+// Compile-time check that Service implements io.Closer.
+var _ io.Closer = (*Service)(nil)
+
+func init() {
+	service.RegisterFactory([]string{"cognito-identity"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
+
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
+}
+
+// Close saves the storage state if persistence is enabled.
+func (s *Service) Close() error {
+	if c, ok := s.storage.(io.Closer); ok {
+		if err := c.Close(); err != nil {
+			return fmt.Errorf("failed to close storage: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
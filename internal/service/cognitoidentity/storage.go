@@ -0,0 +1,432 @@
+package cognitoidentity
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sivchari/kumo/internal/storage"
+)
+
+// Error codes.
+const (
+	errResourceNotFound  = "ResourceNotFoundException"
+	errNotAuthorized     = "NotAuthorizedException"
+	errInvalidParameter  = "InvalidParameterException"
+	errInvalidPoolConfig = "InvalidIdentityPoolConfigurationException"
+	errResourceConflict  = "ResourceConflictException"
+)
+
+// credentialsValidity is how long credentials from GetCredentialsForIdentity remain valid.
+const credentialsValidity = time.Hour
+
+// Storage defines the Cognito Identity storage interface.
+type Storage interface {
+	// Identity pool operations.
+	CreateIdentityPool(ctx context.Context, req *CreateIdentityPoolRequest) (*IdentityPool, error)
+	DescribeIdentityPool(ctx context.Context, identityPoolID string) (*IdentityPool, error)
+	ListIdentityPools(ctx context.Context, maxResults int32, nextToken string) ([]*IdentityPool, string, error)
+	DeleteIdentityPool(ctx context.Context, identityPoolID string) error
+	SetIdentityPoolRoles(ctx context.Context, identityPoolID string, roles map[string]string) error
+
+	// Identity operations.
+	GetID(ctx context.Context, req *GetIDRequest) (*Identity, error)
+	GetCredentialsForIdentity(ctx context.Context, req *GetCredentialsForIdentityRequest) (*Credentials, error)
+	Reset(ctx context.Context) error
+}
+
+// Option is a configuration option for MemoryStorage.
+type Option func(*MemoryStorage)
+
+// WithDataDir enables persistent storage in the specified directory.
+func WithDataDir(dir string) Option {
+	return func(s *MemoryStorage) {
+		s.dataDir = dir
+	}
+}
+
+// Compile-time interface checks.
+var (
+	_ json.Marshaler   = (*MemoryStorage)(nil)
+	_ json.Unmarshaler = (*MemoryStorage)(nil)
+)
+
+// MemoryStorage implements Storage with in-memory data.
+type MemoryStorage struct {
+	mu              sync.RWMutex             `json:"-"`
+	IdentityPools   map[string]*IdentityPool `json:"identityPools"`
+	Identities      map[string]*Identity     `json:"identities"`
+	LoginIdentities map[string]string        `json:"loginIdentities"` // identityPoolID/provider/subject -> identityID
+	dataDir         string
+}
+
+// NewMemoryStorage creates a new MemoryStorage.
+func NewMemoryStorage(opts ...Option) *MemoryStorage {
+	s := &MemoryStorage{
+		IdentityPools:   make(map[string]*IdentityPool),
+		Identities:      make(map[string]*Identity),
+		LoginIdentities: make(map[string]string),
+	}
+	for _, o := range opts {
+		o(s)
+	}
+
+	if s.dataDir != "" {
+		_ = storage.Load(s.dataDir, "cognito-identity", s)
+	}
+
+	return s
+}
+
+// MarshalJSON serializes the storage state to JSON.
+func (s *MemoryStorage) MarshalJSON() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	type Alias MemoryStorage
+
+	data, err := json.Marshal(&struct{ *Alias }{Alias: (*Alias)(s)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal: %w", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON restores the storage state from JSON.
+func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	type Alias MemoryStorage
+
+	aux := &struct{ *Alias }{Alias: (*Alias)(s)}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+
+	if s.IdentityPools == nil {
+		s.IdentityPools = make(map[string]*IdentityPool)
+	}
+
+	if s.Identities == nil {
+		s.Identities = make(map[string]*Identity)
+	}
+
+	if s.LoginIdentities == nil {
+		s.LoginIdentities = make(map[string]string)
+	}
+
+	return nil
+}
+
+// Close saves the storage state to disk if persistence is enabled.
+func (s *MemoryStorage) Close() error {
+	if s.dataDir == "" {
+		return nil
+	}
+
+	if err := storage.Save(s.dataDir, "cognito-identity", s); err != nil {
+		return fmt.Errorf("failed to save: %w", err)
+	}
+
+	return nil
+}
+
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.IdentityPools = make(map[string]*IdentityPool)
+	s.Identities = make(map[string]*Identity)
+	s.LoginIdentities = make(map[string]string)
+
+	return nil
+}
+
+// CreateIdentityPool creates a new identity pool.
+func (s *MemoryStorage) CreateIdentityPool(_ context.Context, req *CreateIdentityPoolRequest) (*IdentityPool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if req.IdentityPoolName == "" {
+		return nil, &ServiceError{Code: errInvalidParameter, Message: "IdentityPoolName is required"}
+	}
+
+	for _, pool := range s.IdentityPools {
+		if pool.IdentityPoolName == req.IdentityPoolName {
+			return nil, &ServiceError{Code: errResourceConflict, Message: "An identity pool with the name " + req.IdentityPoolName + " already exists"}
+		}
+	}
+
+	pool := &IdentityPool{
+		IdentityPoolID:                 req.Region + ":" + uuid.New().String(),
+		IdentityPoolName:               req.IdentityPoolName,
+		AllowUnauthenticatedIdentities: req.AllowUnauthenticatedIdentities,
+		AllowClassicFlow:               req.AllowClassicFlow,
+		SupportedLoginProviders:        req.SupportedLoginProviders,
+		DeveloperProviderName:          req.DeveloperProviderName,
+		OpenIDConnectProviderARNs:      req.OpenIDConnectProviderARNs,
+		CognitoIdentityProviders:       req.CognitoIdentityProviders,
+		SamlProviderARNs:               req.SamlProviderARNs,
+		IdentityPoolTags:               req.IdentityPoolTags,
+	}
+
+	s.IdentityPools[pool.IdentityPoolID] = pool
+
+	return pool, nil
+}
+
+// DescribeIdentityPool retrieves an identity pool.
+func (s *MemoryStorage) DescribeIdentityPool(_ context.Context, identityPoolID string) (*IdentityPool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	pool, ok := s.IdentityPools[identityPoolID]
+	if !ok {
+		return nil, &ServiceError{Code: errResourceNotFound, Message: "IdentityPool '" + identityPoolID + "' not found."}
+	}
+
+	return pool, nil
+}
+
+// ListIdentityPools lists identity pools ordered by ID.
+func (s *MemoryStorage) ListIdentityPools(_ context.Context, maxResults int32, nextToken string) ([]*IdentityPool, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if maxResults <= 0 {
+		maxResults = 60
+	}
+
+	pools := make([]*IdentityPool, 0, len(s.IdentityPools))
+
+	for _, pool := range s.IdentityPools {
+		pools = append(pools, pool)
+	}
+
+	slices.SortFunc(pools, func(a, b *IdentityPool) int { return strings.Compare(a.IdentityPoolID, b.IdentityPoolID) })
+
+	start := 0
+	if nextToken != "" {
+		start = sort.Search(len(pools), func(i int) bool { return pools[i].IdentityPoolID > nextToken })
+	}
+
+	end := min(start+int(maxResults), len(pools))
+	page := pools[start:end]
+
+	if end < len(pools) {
+		return page, page[len(page)-1].IdentityPoolID, nil
+	}
+
+	return page, "", nil
+}
+
+// DeleteIdentityPool deletes an identity pool and its identities.
+func (s *MemoryStorage) DeleteIdentityPool(_ context.Context, identityPoolID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.IdentityPools[identityPoolID]; !ok {
+		return &ServiceError{Code: errResourceNotFound, Message: "IdentityPool '" + identityPoolID + "' not found."}
+	}
+
+	for id, identity := range s.Identities {
+		if identity.IdentityPoolID == identityPoolID {
+			delete(s.Identities, id)
+		}
+	}
+
+	for key := range s.LoginIdentities {
+		if strings.HasPrefix(key, identityPoolID+"/") {
+			delete(s.LoginIdentities, key)
+		}
+	}
+
+	delete(s.IdentityPools, identityPoolID)
+
+	return nil
+}
+
+// SetIdentityPoolRoles sets the authenticated and unauthenticated roles for an identity pool.
+func (s *MemoryStorage) SetIdentityPoolRoles(_ context.Context, identityPoolID string, roles map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pool, ok := s.IdentityPools[identityPoolID]
+	if !ok {
+		return &ServiceError{Code: errResourceNotFound, Message: "IdentityPool '" + identityPoolID + "' not found."}
+	}
+
+	for key := range roles {
+		if key != "authenticated" && key != "unauthenticated" {
+			return &ServiceError{Code: errInvalidParameter, Message: "Invalid role type: " + key}
+		}
+	}
+
+	pool.Roles = roles
+
+	return nil
+}
+
+// GetID returns the identity for the given logins, creating it on first use.
+// The same logins always resolve to the same identity; unauthenticated
+// callers receive a new identity each time.
+func (s *MemoryStorage) GetID(_ context.Context, req *GetIDRequest) (*Identity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pool, ok := s.IdentityPools[req.IdentityPoolID]
+	if !ok {
+		return nil, &ServiceError{Code: errResourceNotFound, Message: "IdentityPool '" + req.IdentityPoolID + "' not found."}
+	}
+
+	if len(req.Logins) == 0 && !pool.AllowUnauthenticatedIdentities {
+		return nil, &ServiceError{Code: errNotAuthorized, Message: "Unauthenticated access is not supported for this identity pool."}
+	}
+
+	keys := loginKeys(pool.IdentityPoolID, req.Logins)
+
+	for _, key := range keys {
+		if identityID, ok := s.LoginIdentities[key]; ok {
+			return s.Identities[identityID], nil
+		}
+	}
+
+	region, _, _ := strings.Cut(pool.IdentityPoolID, ":")
+	now := time.Now()
+	identity := &Identity{
+		IdentityID:       region + ":" + uuid.New().String(),
+		IdentityPoolID:   pool.IdentityPoolID,
+		Logins:           slices.Sorted(maps.Keys(req.Logins)),
+		CreationDate:     now,
+		LastModifiedDate: now,
+	}
+
+	s.Identities[identity.IdentityID] = identity
+
+	for _, key := range keys {
+		s.LoginIdentities[key] = identity.IdentityID
+	}
+
+	return identity, nil
+}
+
+// GetCredentialsForIdentity returns temporary credentials for the identity's role.
+func (s *MemoryStorage) GetCredentialsForIdentity(_ context.Context, req *GetCredentialsForIdentityRequest) (*Credentials, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	identity, ok := s.Identities[req.IdentityID]
+	if !ok {
+		return nil, &ServiceError{Code: errResourceNotFound, Message: "Identity '" + req.IdentityID + "' not found."}
+	}
+
+	pool, ok := s.IdentityPools[identity.IdentityPoolID]
+	if !ok {
+		return nil, &ServiceError{Code: errResourceNotFound, Message: "IdentityPool '" + identity.IdentityPoolID + "' not found."}
+	}
+
+	// Authenticated identities must present a login linked to them.
+	if len(identity.Logins) > 0 {
+		linked := false
+
+		for _, key := range loginKeys(pool.IdentityPoolID, req.Logins) {
+			if s.LoginIdentities[key] == identity.IdentityID {
+				linked = true
+
+				break
+			}
+		}
+
+		if !linked {
+			return nil, &ServiceError{Code: errNotAuthorized, Message: "Invalid login token. Logins don't match the identity."}
+		}
+	}
+
+	roleType := "unauthenticated"
+	if len(req.Logins) > 0 {
+		roleType = "authenticated"
+	} else if !pool.AllowUnauthenticatedIdentities {
+		return nil, &ServiceError{Code: errNotAuthorized, Message: "Unauthenticated access is not supported for this identity pool."}
+	}
+
+	roleArn := pool.Roles[roleType]
+	if req.CustomRoleArn != "" && roleType == "authenticated" {
+		roleArn = req.CustomRoleArn
+	}
+
+	if roleArn == "" {
+		return nil, &ServiceError{Code: errInvalidPoolConfig, Message: "Invalid identity pool configuration. Check assigned IAM roles for this pool."}
+	}
+
+	return generateCredentials(), nil
+}
+
+// loginKeys returns the LoginIdentities keys for the given logins.
+// Tokens that are JWTs are keyed by their subject so that refreshed tokens
+// for the same user map to the same identity.
+func loginKeys(identityPoolID string, logins map[string]string) []string {
+	keys := make([]string, 0, len(logins))
+
+	for _, provider := range slices.Sorted(maps.Keys(logins)) {
+		keys = append(keys, identityPoolID+"/"+provider+"/"+tokenSubject(logins[provider]))
+	}
+
+	return keys
+}
+
+// tokenSubject returns the sub claim of a JWT, or the token itself if it is not a JWT.
+func tokenSubject(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return token
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return token
+	}
+
+	var claims struct {
+		Sub string `json:"sub"`
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Sub == "" {
+		return token
+	}
+
+	return claims.Sub
+}
+
+// generateCredentials generates temporary credentials in the same form as STS.
+func generateCredentials() *Credentials {
+	expiration := time.Now().Add(credentialsValidity)
+
+	return &Credentials{
+		AccessKeyID:  "ASIA" + randomHex(16),
+		SecretKey:    randomHex(40),
+		SessionToken: randomHex(64),
+		Expiration:   float64(expiration.Unix()),
+	}
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)[:n]
+}
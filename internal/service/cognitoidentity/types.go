@@ -0,0 +1,159 @@
+package cognitoidentity
+
+import (
+	"time"
+)
+
+// IdentityPool represents a Cognito identity pool.
+type IdentityPool struct {
+	IdentityPoolID                 string
+	IdentityPoolName               string
+	AllowUnauthenticatedIdentities bool
+	AllowClassicFlow               bool
+	SupportedLoginProviders        map[string]string
+	DeveloperProviderName          string
+	OpenIDConnectProviderARNs      []string
+	CognitoIdentityProviders       []CognitoIdentityProvider
+	SamlProviderARNs               []string
+	IdentityPoolTags               map[string]string
+	Roles                          map[string]string
+}
+
+// CognitoIdentityProvider represents a user pool client linked to an identity pool.
+type CognitoIdentityProvider struct {
+	ProviderName         string `json:"ProviderName,omitempty"`
+	ClientID             string `json:"ClientId,omitempty"`
+	ServerSideTokenCheck bool   `json:"ServerSideTokenCheck,omitempty"`
+}
+
+// Identity represents an identity within an identity pool.
+type Identity struct {
+	IdentityID       string
+	IdentityPoolID   string
+	Logins           []string
+	CreationDate     time.Time
+	LastModifiedDate time.Time
+}
+
+// CreateIdentityPoolRequest is the request for CreateIdentityPool.
+type CreateIdentityPoolRequest struct {
+	IdentityPoolName               string                    `json:"IdentityPoolName"`
+	AllowUnauthenticatedIdentities bool                      `json:"AllowUnauthenticatedIdentities"`
+	AllowClassicFlow               bool                      `json:"AllowClassicFlow,omitempty"`
+	SupportedLoginProviders        map[string]string         `json:"SupportedLoginProviders,omitempty"`
+	DeveloperProviderName          string                    `json:"DeveloperProviderName,omitempty"`
+	OpenIDConnectProviderARNs      []string                  `json:"OpenIdConnectProviderARNs,omitempty"`
+	CognitoIdentityProviders       []CognitoIdentityProvider `json:"CognitoIdentityProviders,omitempty"`
+	SamlProviderARNs               []string                  `json:"SamlProviderARNs,omitempty"`
+	IdentityPoolTags               map[string]string         `json:"IdentityPoolTags,omitempty"`
+	Region                         string                    `json:"-"`
+}
+
+// IdentityPoolOutput represents an identity pool in API responses.
+type IdentityPoolOutput struct {
+	IdentityPoolID                 string                    `json:"IdentityPoolId"`
+	IdentityPoolName               string                    `json:"IdentityPoolName"`
+	AllowUnauthenticatedIdentities bool                      `json:"AllowUnauthenticatedIdentities"`
+	AllowClassicFlow               bool                      `json:"AllowClassicFlow"`
+	SupportedLoginProviders        map[string]string         `json:"SupportedLoginProviders,omitempty"`
+	DeveloperProviderName          string                    `json:"DeveloperProviderName,omitempty"`
+	OpenIDConnectProviderARNs      []string                  `json:"OpenIdConnectProviderARNs,omitempty"`
+	CognitoIdentityProviders       []CognitoIdentityProvider `json:"CognitoIdentityProviders,omitempty"`
+	SamlProviderARNs               []string                  `json:"SamlProviderARNs,omitempty"`
+	IdentityPoolTags               map[string]string         `json:"IdentityPoolTags,omitempty"`
+}
+
+// DescribeIdentityPoolRequest is the request for DescribeIdentityPool.
+type DescribeIdentityPoolRequest struct {
+	IdentityPoolID string `json:"IdentityPoolId"`
+}
+
+// ListIdentityPoolsRequest is the request for ListIdentityPools.
+type ListIdentityPoolsRequest struct {
+	MaxResults int32  `json:"MaxResults"`
+	NextToken  string `json:"NextToken,omitempty"`
+}
+
+// IdentityPoolShortDescription is a summary of an identity pool.
+type IdentityPoolShortDescription struct {
+	IdentityPoolID   string `json:"IdentityPoolId"`
+	IdentityPoolName string `json:"IdentityPoolName"`
+}
+
+// ListIdentityPoolsResponse is the response for ListIdentityPools.
+type ListIdentityPoolsResponse struct {
+	IdentityPools []IdentityPoolShortDescription `json:"IdentityPools"`
+	NextToken     string                         `json:"NextToken,omitempty"`
+}
+
+// DeleteIdentityPoolRequest is the request for DeleteIdentityPool.
+type DeleteIdentityPoolRequest struct {
+	IdentityPoolID string `json:"IdentityPoolId"`
+}
+
+// SetIdentityPoolRolesRequest is the request for SetIdentityPoolRoles.
+type SetIdentityPoolRolesRequest struct {
+	IdentityPoolID string            `json:"IdentityPoolId"`
+	Roles          map[string]string `json:"Roles"`
+}
+
+// GetIdentityPoolRolesRequest is the request for GetIdentityPoolRoles.
+type GetIdentityPoolRolesRequest struct {
+	IdentityPoolID string `json:"IdentityPoolId"`
+}
+
+// GetIdentityPoolRolesResponse is the response for GetIdentityPoolRoles.
+type GetIdentityPoolRolesResponse struct {
+	IdentityPoolID string            `json:"IdentityPoolId"`
+	Roles          map[string]string `json:"Roles,omitempty"`
+}
+
+// GetIDRequest is the request for GetId.
+type GetIDRequest struct {
+	AccountID      string            `json:"AccountId,omitempty"`
+	IdentityPoolID string            `json:"IdentityPoolId"`
+	Logins         map[string]string `json:"Logins,omitempty"`
+}
+
+// GetIDResponse is the response for GetId.
+type GetIDResponse struct {
+	IdentityID string `json:"IdentityId"`
+}
+
+// GetCredentialsForIdentityRequest is the request for GetCredentialsForIdentity.
+type GetCredentialsForIdentityRequest struct {
+	IdentityID    string            `json:"IdentityId"`
+	Logins        map[string]string `json:"Logins,omitempty"`
+	CustomRoleArn string            `json:"CustomRoleArn,omitempty"`
+}
+
+// Credentials represents temporary AWS credentials.
+type Credentials struct {
+	AccessKeyID  string  `json:"AccessKeyId"`
+	SecretKey    string  `json:"SecretKey"`
+	SessionToken string  `json:"SessionToken"`
+	Expiration   float64 `json:"Expiration"`
+}
+
+// GetCredentialsForIdentityResponse is the response for GetCredentialsForIdentity.
+type GetCredentialsForIdentityResponse struct {
+	IdentityID  string       `json:"IdentityId"`
+	Credentials *Credentials `json:"Credentials"`
+}
+
+// ErrorResponse represents a Cognito Identity error response.
+type ErrorResponse struct {
+	Type    string `json:"__type"`
+	Message string `json:"message"`
+}
+
+// ServiceError represents a Cognito Identity service error.
+type ServiceError struct {
+	Code    string
+	Message string
+}
+
+// Error implements the error interface.
+func (e *ServiceError) Error() string {
+	return e.Message
+}
@@ -1,6 +1,7 @@
 package comprehend
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -14,7 +15,9 @@ var (
 )
 
 func init() {
-	service.Register(New())
+	service.RegisterFactory([]string{"comprehend"}, func(_ string) []service.Service {
+		return []service.Service{New()}
+	})
 }
 
 // Service implements the AWS Comprehend service.
@@ -63,6 +66,12 @@ func (s *Service) RegisterRoutes(_ service.Router) {
 	// No routes to register - Comprehend uses JSON protocol dispatcher
 }
 
+// Reset clears the service state. Comprehend analyzes text without storing
+// anything, so there is nothing to clear.
+func (s *Service) Reset(_ context.Context) error {
+	return nil
+}
+
 // TargetPrefix returns the X-Amz-Target header prefix for Comprehend.
 func (s *Service) TargetPrefix() string {
 	return "Comprehend_20171127"
@@ -40,6 +40,7 @@ type Storage interface {
 	DeleteConfigRule(ctx context.Context, name string) error
 	DescribeConfigRules(ctx context.Context, names []string) ([]*ConfigRule, error)
 	GetComplianceDetailsByConfigRule(ctx context.Context, req *GetComplianceDetailsByConfigRuleRequest) ([]*EvaluationResult, string, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -145,6 +146,18 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Recorders = make(map[string]*ConfigurationRecorder)
+	m.RecorderStatuses = make(map[string]*ConfigurationRecorderStatus)
+	m.Rules = make(map[string]*ConfigRule)
+
+	return nil
+}
+
 // PutConfigurationRecorder creates or updates a configuration recorder.
 func (m *MemoryStorage) PutConfigurationRecorder(_ context.Context, req *PutConfigurationRecorderRequest) error {
 	m.mu.Lock()
@@ -1,6 +1,7 @@
 package dataexchange
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sync"
@@ -28,6 +29,7 @@ type Storage interface {
 	CreateJob(input *CreateJobInput) *Job
 	GetJob(id string) (*Job, error)
 	ListJobs() []Job
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -129,6 +131,18 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.DataSets = make(map[string]*DataSet)
+	m.Revisions = make(map[string]map[string]*Revision)
+	m.Jobs = make(map[string]*Job)
+
+	return nil
+}
+
 // CreateDataSet creates a new data set.
 func (m *MemoryStorage) CreateDataSet(input *CreateDataSetInput) *DataSet {
 	m.mu.Lock()
@@ -33,6 +33,7 @@ type Storage interface {
 	GetLifecyclePolicies(ctx context.Context, policyIDs []string, state string, resourceTypes, targetTags []string) ([]*LifecyclePolicySummary, error)
 	UpdateLifecyclePolicy(ctx context.Context, policyID string, req *UpdateLifecyclePolicyRequest) error
 	DeleteLifecyclePolicy(ctx context.Context, policyID string) error
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -126,6 +127,16 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Policies = make(map[string]*LifecyclePolicy)
+
+	return nil
+}
+
 // CreateLifecyclePolicy creates a new lifecycle policy.
 func (m *MemoryStorage) CreateLifecyclePolicy(_ context.Context, req *CreateLifecyclePolicyRequest) (*LifecyclePolicy, error) {
 	m.mu.Lock()
@@ -29,6 +29,7 @@ type Storage interface {
 	CreateDBInstance(ctx context.Context, input *CreateDBInstanceInput) (*DBInstance, error)
 	DeleteDBInstance(ctx context.Context, identifier string, skipFinalSnapshot bool) (*DBInstance, error)
 	DescribeDBInstances(ctx context.Context, identifier string) ([]DBInstance, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -124,6 +125,17 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Clusters = make(map[string]*DBCluster)
+	m.Instances = make(map[string]*DBInstance)
+
+	return nil
+}
+
 // CreateDBCluster creates a new DocumentDB DB cluster.
 func (m *MemoryStorage) CreateDBCluster(_ context.Context, input *CreateDBClusterInput) (*DBCluster, error) {
 	m.mu.Lock()
@@ -1,10 +1,10 @@
 package ds
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -13,16 +13,18 @@ import (
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"ds"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
 
-	svc := &Service{
-		storage: NewMemoryStorage(opts...),
-	}
+		svc := &Service{
+			storage: NewMemoryStorage(opts...),
+		}
 
-	service.Register(svc)
+		return []service.Service{svc}
+	})
 }
 
 // Service implements the Directory Service.
@@ -89,3 +91,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
@@ -21,6 +21,7 @@ type Storage interface {
 	CreateSnapshot(ctx context.Context, directoryID, name string) (*Snapshot, error)
 	DescribeSnapshots(ctx context.Context, directoryID string, snapshotIDs []string, limit int, nextToken string) ([]*Snapshot, string, error)
 	DeleteSnapshot(ctx context.Context, snapshotID string) error
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -120,6 +121,17 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Directories = make(map[string]*Directory)
+	s.Snapshots = make(map[string]*Snapshot)
+
+	return nil
+}
+
 // CreateDirectory creates a new directory.
 func (s *MemoryStorage) CreateDirectory(_ context.Context, req *CreateDirectoryRequest) (*Directory, error) {
 	s.mu.Lock()
@@ -0,0 +1,122 @@
+package dynamodb
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestUpdateItemReturnValues(t *testing.T) {
+	t.Parallel()
+
+	s := NewMemoryStorage("http://localhost:4566")
+	ctx := context.Background()
+
+	_, err := s.CreateTable(ctx, &CreateTableRequest{
+		TableName: "test-return-values",
+		KeySchema: []KeySchemaElement{
+			{AttributeName: "PK", KeyType: "HASH"},
+		},
+		AttributeDefinitions: []AttributeDefinition{
+			{AttributeName: "PK", AttributeType: "S"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		pk           string
+		returnValues string
+		want         map[string]string
+	}{
+		{"old", ReturnValuesUpdatedOld, map[string]string{"change": "1", "drop": "x"}},
+		{"new", ReturnValuesUpdatedNew, map[string]string{"change": "2", "added": "y"}},
+	}
+
+	for _, tt := range tests {
+		item := Item{"PK": {S: ptr(tt.pk)}, "keep": {S: ptr("same")}, "change": {N: ptr("1")}, "drop": {S: ptr("x")}}
+		if _, err := s.PutItem(ctx, "test-return-values", item, false, ConditionInput{}); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := s.UpdateItem(ctx, "test-return-values", Item{"PK": {S: ptr(tt.pk)}},
+			"SET change = :c, added = :a, keep = :k REMOVE drop",
+			nil,
+			map[string]AttributeValue{":c": {N: ptr("2")}, ":a": {S: ptr("y")}, ":k": {S: ptr("same")}},
+			tt.returnValues,
+			ConditionInput{},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: expected %v, got %+v", tt.returnValues, tt.want, got)
+
+			continue
+		}
+
+		for name, want := range tt.want {
+			av := got[name]
+
+			var value string
+
+			switch {
+			case av.S != nil:
+				value = *av.S
+			case av.N != nil:
+				value = *av.N
+			}
+
+			if value != want {
+				t.Errorf("%s: expected %s=%s, got %+v", tt.returnValues, name, want, av)
+			}
+		}
+	}
+}
+
+func TestWriteConsumedCapacity(t *testing.T) {
+	t.Parallel()
+
+	storage := NewMemoryStorage("http://localhost:4566")
+	s := New(storage)
+	ctx := context.Background()
+
+	_, err := storage.CreateTable(ctx, &CreateTableRequest{
+		TableName: "test-capacity",
+		KeySchema: []KeySchemaElement{
+			{AttributeName: "PK", KeyType: "HASH"},
+		},
+		AttributeDefinitions: []AttributeDefinition{
+			{AttributeName: "PK", AttributeType: "S"},
+			{AttributeName: "GSK", AttributeType: "S"},
+		},
+		GlobalSecondaryIndexes: []GlobalSecondaryIndex{
+			{IndexName: "by-gsk", KeySchema: []KeySchemaElement{{AttributeName: "GSK", KeyType: "HASH"}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	small := Item{"PK": {S: ptr("a")}}
+	big := Item{"PK": {S: ptr("b")}, "GSK": {S: ptr("g")}, "blob": {S: ptr(strings.Repeat("x", 2500))}}
+
+	if got := s.writeConsumedCapacity(ctx, "test-capacity", ReturnConsumedCapacityNone, small); got != nil {
+		t.Errorf("expected no capacity for NONE, got %+v", got)
+	}
+
+	if got := s.writeConsumedCapacity(ctx, "test-capacity", ReturnConsumedCapacityTotal, small); got == nil || got.CapacityUnits != 1 {
+		t.Errorf("expected 1 unit for a small item, got %+v", got)
+	}
+
+	got := s.writeConsumedCapacity(ctx, "test-capacity", ReturnConsumedCapacityIndexes, small, big)
+	if got == nil || got.Table == nil || got.Table.CapacityUnits != 3 {
+		t.Fatalf("expected 3 table units for a 2.5KB item, got %+v", got)
+	}
+
+	if got.GlobalSecondaryIndexes["by-gsk"].CapacityUnits != 3 || got.CapacityUnits != 6 {
+		t.Errorf("expected the index write to be charged, got %+v", got)
+	}
+}
@@ -1,6 +1,8 @@
 package dynamodb
 
 import (
+	"cmp"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -32,6 +34,12 @@ func (s *Service) CreateTable(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if msg := validateStreamSpecification(req.StreamSpecification); msg != "" {
+		writeDynamoDBError(w, "ValidationException", msg, http.StatusBadRequest)
+
+		return
+	}
+
 	table, err := s.storage.CreateTable(r.Context(), &req)
 	if err != nil {
 		var tErr *TableError
@@ -85,6 +93,46 @@ func (s *Service) DeleteTable(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// UpdateTable handles the UpdateTable action.
+func (s *Service) UpdateTable(w http.ResponseWriter, r *http.Request) {
+	var req UpdateTableRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeDynamoDBError(w, "SerializationException", "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.TableName == "" {
+		writeDynamoDBError(w, "ValidationException", "TableName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if msg := validateStreamSpecification(req.StreamSpecification); msg != "" {
+		writeDynamoDBError(w, "ValidationException", msg, http.StatusBadRequest)
+
+		return
+	}
+
+	table, err := s.storage.UpdateTable(r.Context(), &req)
+	if err != nil {
+		var tErr *TableError
+		if errors.As(err, &tErr) {
+			writeDynamoDBError(w, tErr.Code, tErr.Message, http.StatusBadRequest)
+
+			return
+		}
+
+		writeDynamoDBError(w, "InternalServerError", "Internal server error", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSONResponse(w, UpdateTableResponse{
+		TableDescription: tableToDescription(table),
+	})
+}
+
 // ListTables handles the ListTables action.
 func (s *Service) ListTables(w http.ResponseWriter, r *http.Request) {
 	var req ListTablesRequest
@@ -162,6 +210,12 @@ func (s *Service) PutItem(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if msg := validateWriteReturnParams(req.ReturnValues, req.ReturnConsumedCapacity, ReturnValuesAllOld); msg != "" {
+		writeDynamoDBError(w, "ValidationException", msg, http.StatusBadRequest)
+
+		return
+	}
+
 	returnOld := req.ReturnValues == ReturnValuesAllOld
 
 	cond := ConditionInput{
@@ -190,7 +244,8 @@ func (s *Service) PutItem(w http.ResponseWriter, r *http.Request) {
 	}
 
 	writeJSONResponse(w, PutItemResponse{
-		Attributes: oldItem,
+		Attributes:       oldItem,
+		ConsumedCapacity: s.writeConsumedCapacity(r.Context(), req.TableName, req.ReturnConsumedCapacity, req.Item, oldItem),
 	})
 }
 
@@ -255,6 +310,12 @@ func (s *Service) DeleteItem(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if msg := validateWriteReturnParams(req.ReturnValues, req.ReturnConsumedCapacity, ReturnValuesAllOld); msg != "" {
+		writeDynamoDBError(w, "ValidationException", msg, http.StatusBadRequest)
+
+		return
+	}
+
 	returnOld := req.ReturnValues == ReturnValuesAllOld
 
 	cond := ConditionInput{
@@ -283,7 +344,8 @@ func (s *Service) DeleteItem(w http.ResponseWriter, r *http.Request) {
 	}
 
 	writeJSONResponse(w, DeleteItemResponse{
-		Attributes: oldItem,
+		Attributes:       oldItem,
+		ConsumedCapacity: s.writeConsumedCapacity(r.Context(), req.TableName, req.ReturnConsumedCapacity, req.Key, oldItem),
 	})
 }
 
@@ -308,6 +370,14 @@ func (s *Service) UpdateItem(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	msg := validateWriteReturnParams(req.ReturnValues, req.ReturnConsumedCapacity,
+		ReturnValuesAllOld, ReturnValuesAllNew, ReturnValuesUpdatedOld, ReturnValuesUpdatedNew)
+	if msg != "" {
+		writeDynamoDBError(w, "ValidationException", msg, http.StatusBadRequest)
+
+		return
+	}
+
 	// Convert legacy AttributeUpdates to UpdateExpression if needed.
 	if req.UpdateExpression == "" && len(req.AttributeUpdates) > 0 {
 		convertAttributeUpdates(&req)
@@ -348,7 +418,8 @@ func (s *Service) UpdateItem(w http.ResponseWriter, r *http.Request) {
 	}
 
 	writeJSONResponse(w, UpdateItemResponse{
-		Attributes: result,
+		Attributes:       result,
+		ConsumedCapacity: s.writeConsumedCapacity(r.Context(), req.TableName, req.ReturnConsumedCapacity, req.Key, result),
 	})
 }
 
@@ -420,15 +491,13 @@ func (s *Service) Scan(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	items, lastKey, scannedCount, err := s.storage.Scan(
-		r.Context(),
-		req.TableName,
-		req.FilterExpression,
-		req.ExpressionAttributeNames,
-		req.ExpressionAttributeValues,
-		req.Limit,
-		req.ExclusiveStartKey,
-	)
+	if msg := validateScanSegments(req.Segment, req.TotalSegments); msg != "" {
+		writeDynamoDBError(w, "ValidationException", msg, http.StatusBadRequest)
+
+		return
+	}
+
+	items, lastKey, scannedCount, err := s.storage.Scan(r.Context(), &req)
 	if err != nil {
 		var tErr *TableError
 		if errors.As(err, &tErr) {
@@ -442,12 +511,41 @@ func (s *Service) Scan(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	writeJSONResponse(w, ScanResponse{
-		Items:            items,
+	resp := ScanResponse{
 		Count:            len(items),
 		ScannedCount:     scannedCount,
 		LastEvaluatedKey: lastKey,
-	})
+	}
+
+	if req.Select != SelectCount {
+		resp.Items = make([]Item, len(items))
+		for i, item := range items {
+			resp.Items[i] = projectItem(item, req.ProjectionExpression, req.ExpressionAttributeNames)
+		}
+	}
+
+	writeJSONResponse(w, resp)
+}
+
+// validateScanSegments checks the Segment and TotalSegments of a parallel scan. It
+// returns a message describing the first invalid parameter, or "" if they are valid.
+func validateScanSegments(segment, totalSegments *int) string {
+	switch {
+	case segment == nil && totalSegments == nil:
+		return ""
+	case segment == nil:
+		return "The Segment parameter is required but was not present in the request when parameter TotalSegments is present"
+	case totalSegments == nil:
+		return "The TotalSegments parameter is required but was not present in the request when Segment parameter is present"
+	case *totalSegments < 1 || *totalSegments > maxTotalSegments:
+		return fmt.Sprintf("1 validation error detected: Value '%d' at 'totalSegments' failed to satisfy constraint: "+
+			"Member must have value between 1 and %d", *totalSegments, maxTotalSegments)
+	case *segment < 0 || *segment >= *totalSegments:
+		return fmt.Sprintf("The Segment parameter is zero-based and must be less than parameter TotalSegments: "+
+			"Segment: %d is not less than TotalSegments: %d", *segment, *totalSegments)
+	}
+
+	return ""
 }
 
 // tableToDescription converts a Table to TableDescription.
@@ -465,6 +563,9 @@ func tableToDescription(table *Table) TableDescription {
 		ItemCount:                 table.ItemCount,
 		TableSizeBytes:            table.TableSizeBytes,
 		DeletionProtectionEnabled: table.DeletionProtection,
+		StreamSpecification:       table.StreamSpecification,
+		LatestStreamARN:           table.LatestStreamARN,
+		LatestStreamLabel:         table.LatestStreamLabel,
 	}
 
 	if table.ProvisionedThroughput != nil {
@@ -485,7 +586,7 @@ func tableToDescription(table *Table) TableDescription {
 			IndexName:      gsi.IndexName,
 			KeySchema:      gsi.KeySchema,
 			Projection:     gsi.Projection,
-			IndexStatus:    "ACTIVE",
+			IndexStatus:    cmp.Or(table.IndexStatuses[gsi.IndexName], tableStatusActive),
 			IndexArn:       fmt.Sprintf("%s/index/%s", table.TableARN, gsi.IndexName),
 			ItemCount:      table.ItemCount,
 			IndexSizeBytes: table.TableSizeBytes,
@@ -725,22 +826,25 @@ func (s *Service) TransactGetItems(w http.ResponseWriter, r *http.Request) {
 // actionHandlers returns a map of action names to handler functions.
 func (s *Service) actionHandlers() map[string]func(http.ResponseWriter, *http.Request) {
 	return map[string]func(http.ResponseWriter, *http.Request){
-		"CreateTable":        s.CreateTable,
-		"DeleteTable":        s.DeleteTable,
-		"ListTables":         s.ListTables,
-		"DescribeTable":      s.DescribeTable,
-		"PutItem":            s.PutItem,
-		"GetItem":            s.GetItem,
-		"DeleteItem":         s.DeleteItem,
-		"UpdateItem":         s.UpdateItem,
-		"Query":              s.Query,
-		"Scan":               s.Scan,
-		"UpdateTimeToLive":   s.UpdateTimeToLive,
-		"DescribeTimeToLive": s.DescribeTimeToLive,
-		"TransactWriteItems": s.TransactWriteItems,
-		"TransactGetItems":   s.TransactGetItems,
-		"BatchWriteItem":     s.BatchWriteItem,
-		"BatchGetItem":       s.BatchGetItem,
+		"CreateTable":           s.CreateTable,
+		"DeleteTable":           s.DeleteTable,
+		"ListTables":            s.ListTables,
+		"DescribeTable":         s.DescribeTable,
+		"UpdateTable":           s.UpdateTable,
+		"PutItem":               s.PutItem,
+		"GetItem":               s.GetItem,
+		"DeleteItem":            s.DeleteItem,
+		"UpdateItem":            s.UpdateItem,
+		"Query":                 s.Query,
+		"Scan":                  s.Scan,
+		"UpdateTimeToLive":      s.UpdateTimeToLive,
+		"DescribeTimeToLive":    s.DescribeTimeToLive,
+		"TransactWriteItems":    s.TransactWriteItems,
+		"TransactGetItems":      s.TransactGetItems,
+		"BatchWriteItem":        s.BatchWriteItem,
+		"BatchGetItem":          s.BatchGetItem,
+		"ExecuteStatement":      s.ExecuteStatement,
+		"BatchExecuteStatement": s.BatchExecuteStatement,
 	}
 }
 
@@ -830,6 +934,143 @@ func (s *Service) BatchGetItem(w http.ResponseWriter, r *http.Request) {
 	writeJSONResponse(w, BatchGetItemResponse{Responses: responses})
 }
 
+// ExecuteStatement handles the ExecuteStatement action.
+func (s *Service) ExecuteStatement(w http.ResponseWriter, r *http.Request) {
+	var req ExecuteStatementRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeDynamoDBError(w, "SerializationException", "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.Statement == "" {
+		writeDynamoDBError(w, "ValidationException", "Statement is required", http.StatusBadRequest)
+
+		return
+	}
+
+	items, lastKey, err := s.executeStatementRequest(r.Context(), &req)
+	if err != nil {
+		var tErr *TableError
+		if errors.As(err, &tErr) {
+			status := http.StatusBadRequest
+			if tErr.Code == ErrCodeConditionalCheckFailed {
+				status = http.StatusConflict
+			}
+
+			writeDynamoDBError(w, tErr.Code, tErr.Message, status)
+
+			return
+		}
+
+		writeDynamoDBError(w, "InternalServerError", "Internal server error", http.StatusInternalServerError)
+
+		return
+	}
+
+	nextToken, err := encodeStatementToken(lastKey)
+	if err != nil {
+		writeDynamoDBError(w, "InternalServerError", "Internal server error", http.StatusInternalServerError)
+
+		return
+	}
+
+	if items == nil {
+		items = []Item{}
+	}
+
+	writeJSONResponse(w, ExecuteStatementResponse{
+		Items:            items,
+		NextToken:        nextToken,
+		LastEvaluatedKey: lastKey,
+	})
+}
+
+// executeStatementRequest parses and runs the statement of an ExecuteStatement request.
+func (s *Service) executeStatementRequest(ctx context.Context, req *ExecuteStatementRequest) ([]Item, Item, error) {
+	stmt, err := parsePartiQL(req.Statement, req.Parameters)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	startKey, err := decodeStatementToken(req.NextToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.executeStatement(ctx, stmt, req.Limit, startKey)
+}
+
+// BatchExecuteStatement handles the BatchExecuteStatement action.
+// Each statement reads or writes a single item and fails on its own.
+func (s *Service) BatchExecuteStatement(w http.ResponseWriter, r *http.Request) {
+	var req BatchExecuteStatementRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeDynamoDBError(w, "SerializationException", "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if len(req.Statements) == 0 || len(req.Statements) > maxBatchStatements {
+		writeDynamoDBError(w, "ValidationException",
+			fmt.Sprintf("Member must have length less than or equal to %d and greater than or equal to 1", maxBatchStatements),
+			http.StatusBadRequest)
+
+		return
+	}
+
+	responses := make([]BatchStatementResponse, len(req.Statements))
+
+	for i, statement := range req.Statements {
+		responses[i] = s.executeBatchStatement(r.Context(), &statement)
+	}
+
+	writeJSONResponse(w, BatchExecuteStatementResponse{Responses: responses})
+}
+
+// executeBatchStatement runs a single statement of a BatchExecuteStatement request.
+// A SELECT must pin the whole primary key, so it returns at most one item.
+func (s *Service) executeBatchStatement(ctx context.Context, req *BatchStatementRequest) BatchStatementResponse {
+	stmt, err := parsePartiQL(req.Statement, req.Parameters)
+	if err != nil {
+		return batchStatementError("", err)
+	}
+
+	var items []Item
+
+	if stmt.kind == partiqlSelect {
+		items, err = s.selectItemByKey(ctx, stmt)
+	} else {
+		items, _, err = s.executeStatement(ctx, stmt, 0, nil)
+	}
+
+	if err != nil {
+		return batchStatementError(stmt.table, err)
+	}
+
+	resp := BatchStatementResponse{TableName: stmt.table}
+	if len(items) > 0 {
+		resp.Item = items[0]
+	}
+
+	return resp
+}
+
+// batchStatementError converts an error into a failed BatchStatementResponse.
+func batchStatementError(tableName string, err error) BatchStatementResponse {
+	code, message := "InternalServerError", "Internal server error"
+
+	var tErr *TableError
+	if errors.As(err, &tErr) {
+		code, message = batchStatementErrorCode(tErr.Code), tErr.Message
+	}
+
+	return BatchStatementResponse{
+		TableName: tableName,
+		Error:     &BatchStatementError{Code: code, Message: message},
+	}
+}
+
 // DispatchAction routes the request to the appropriate handler based on X-Amz-Target header.
 // This method implements the JSONProtocolService interface.
 func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,1243 @@
+package dynamodb
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PartiQL statement kinds.
+const (
+	partiqlSelect = "SELECT"
+	partiqlInsert = "INSERT"
+	partiqlUpdate = "UPDATE"
+	partiqlDelete = "DELETE"
+)
+
+// maxBatchStatements is the maximum number of statements in a BatchExecuteStatement request.
+const maxBatchStatements = 25
+
+// partiqlStatement is a parsed PartiQL statement, translated into the
+// expressions that the item storage already evaluates.
+type partiqlStatement struct {
+	kind  string
+	table string
+	index string
+	// projection is a projection expression, or "" for SELECT *.
+	projection string
+	// where is the WHERE clause as a condition expression.
+	where string
+	// keyValues holds the attributes the WHERE clause pins with a top-level
+	// equality, and whereOnlyEqualities reports whether it contains nothing else.
+	keyValues           Item
+	whereOnlyEqualities bool
+	// item is the item of an INSERT.
+	item Item
+	// update is the SET and REMOVE clauses of an UPDATE as an update expression.
+	update       string
+	returnValues string
+	values       map[string]AttributeValue
+}
+
+// partiqlTokenKind classifies a PartiQL token.
+type partiqlTokenKind int
+
+const (
+	partiqlIdent partiqlTokenKind = iota
+	partiqlQuotedIdent
+	partiqlString
+	partiqlNumber
+	partiqlParam
+	partiqlPunct
+	partiqlOperator
+)
+
+type partiqlToken struct {
+	kind partiqlTokenKind
+	text string
+}
+
+// partiqlError returns the error DynamoDB reports for a malformed statement.
+func partiqlError(format string, args ...any) error {
+	return &TableError{
+		Code:    "ValidationException",
+		Message: "Statement wasn't well formed, can't be processed: " + fmt.Sprintf(format, args...),
+	}
+}
+
+// lexPartiQL splits a statement into tokens.
+//
+//nolint:cyclop,funlen // A lexer is one switch over the character classes.
+func lexPartiQL(statement string) ([]partiqlToken, error) {
+	var tokens []partiqlToken
+
+	for i := 0; i < len(statement); {
+		ch := statement[i]
+
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r' || ch == ';':
+			i++
+		case ch == '\'' || ch == '"':
+			var sb strings.Builder
+
+			j := i + 1
+
+			for ; j < len(statement); j++ {
+				if statement[j] != ch {
+					sb.WriteByte(statement[j])
+
+					continue
+				}
+
+				// A doubled quote is an escaped quote.
+				if j+1 < len(statement) && statement[j+1] == ch {
+					sb.WriteByte(ch)
+					j++
+
+					continue
+				}
+
+				break
+			}
+
+			if j >= len(statement) {
+				return nil, partiqlError("unterminated quoted string")
+			}
+
+			kind := partiqlString
+			if ch == '"' {
+				kind = partiqlQuotedIdent
+			}
+
+			tokens = append(tokens, partiqlToken{kind: kind, text: sb.String()})
+			i = j + 1
+		case isDigit(ch) || (ch == '-' && i+1 < len(statement) && isDigit(statement[i+1]) && !followsOperand(tokens)):
+			j := i + 1
+			for j < len(statement) && (isDigit(statement[j]) || strings.ContainsRune(".eE", rune(statement[j])) ||
+				((statement[j] == '+' || statement[j] == '-') && (statement[j-1] == 'e' || statement[j-1] == 'E'))) {
+				j++
+			}
+
+			tokens = append(tokens, partiqlToken{kind: partiqlNumber, text: statement[i:j]})
+			i = j
+		case isIdentStart(ch):
+			j := i + 1
+			for j < len(statement) && (isIdentStart(statement[j]) || isDigit(statement[j])) {
+				j++
+			}
+
+			tokens = append(tokens, partiqlToken{kind: partiqlIdent, text: statement[i:j]})
+			i = j
+		case ch == '?':
+			tokens = append(tokens, partiqlToken{kind: partiqlParam, text: "?"})
+			i++
+		case strings.HasPrefix(statement[i:], "<<") || strings.HasPrefix(statement[i:], ">>"):
+			tokens = append(tokens, partiqlToken{kind: partiqlPunct, text: statement[i : i+2]})
+			i += 2
+		case strings.HasPrefix(statement[i:], "<>") || strings.HasPrefix(statement[i:], "<=") || strings.HasPrefix(statement[i:], ">="):
+			tokens = append(tokens, partiqlToken{kind: partiqlOperator, text: statement[i : i+2]})
+			i += 2
+		case strings.HasPrefix(statement[i:], "!="):
+			tokens = append(tokens, partiqlToken{kind: partiqlOperator, text: "<>"})
+			i += 2
+		case ch == '=' || ch == '<' || ch == '>':
+			tokens = append(tokens, partiqlToken{kind: partiqlOperator, text: string(ch)})
+			i++
+		case strings.ContainsRune("()[]{},.:*+-", rune(ch)):
+			tokens = append(tokens, partiqlToken{kind: partiqlPunct, text: string(ch)})
+			i++
+		default:
+			return nil, partiqlError("unexpected character %q", ch)
+		}
+	}
+
+	return tokens, nil
+}
+
+func isDigit(ch byte) bool {
+	return ch >= '0' && ch <= '9'
+}
+
+func isIdentStart(ch byte) bool {
+	return ch == '_' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z')
+}
+
+// followsOperand reports whether the last token ends an operand, in which
+// case a following '-' is a subtraction rather than the sign of a number.
+func followsOperand(tokens []partiqlToken) bool {
+	if len(tokens) == 0 {
+		return false
+	}
+
+	last := tokens[len(tokens)-1]
+
+	switch last.kind {
+	case partiqlIdent, partiqlQuotedIdent, partiqlString, partiqlNumber, partiqlParam:
+		return true
+	case partiqlPunct:
+		return last.text == ")" || last.text == "]"
+	default:
+		return false
+	}
+}
+
+// partiqlParser parses a tokenized statement. Literals and parameters are
+// replaced by expression attribute value placeholders as they are read.
+type partiqlParser struct {
+	tokens []partiqlToken
+	pos    int
+	params []AttributeValue
+	used   int
+	values map[string]AttributeValue
+}
+
+// parsePartiQL parses a PartiQL statement with its positional parameters.
+func parsePartiQL(statement string, params []AttributeValue) (*partiqlStatement, error) {
+	tokens, err := lexPartiQL(statement)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &partiqlParser{tokens: tokens, params: params, values: make(map[string]AttributeValue)}
+
+	var stmt *partiqlStatement
+
+	switch {
+	case p.acceptKeyword(partiqlSelect):
+		stmt, err = p.parseSelect()
+	case p.acceptKeyword(partiqlInsert):
+		stmt, err = p.parseInsert()
+	case p.acceptKeyword(partiqlUpdate):
+		stmt, err = p.parseUpdate()
+	case p.acceptKeyword(partiqlDelete):
+		stmt, err = p.parseDelete()
+	default:
+		return nil, partiqlError("unsupported statement")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if tok, ok := p.peek(); ok {
+		return nil, partiqlError("unexpected token %q", tok.text)
+	}
+
+	if p.used != len(params) {
+		return nil, &TableError{
+			Code:    "ValidationException",
+			Message: "Number of parameters in request and statement don't match.",
+		}
+	}
+
+	stmt.values = p.values
+
+	return stmt, nil
+}
+
+func (p *partiqlParser) peek() (partiqlToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return partiqlToken{}, false
+	}
+
+	return p.tokens[p.pos], true
+}
+
+func (p *partiqlParser) next() (partiqlToken, bool) {
+	tok, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+
+	return tok, ok
+}
+
+func (p *partiqlParser) isKeyword(keyword string) bool {
+	tok, ok := p.peek()
+
+	return ok && tok.kind == partiqlIdent && strings.EqualFold(tok.text, keyword)
+}
+
+func (p *partiqlParser) acceptKeyword(keyword string) bool {
+	if p.isKeyword(keyword) {
+		p.pos++
+
+		return true
+	}
+
+	return false
+}
+
+func (p *partiqlParser) expectKeyword(keyword string) error {
+	if !p.acceptKeyword(keyword) {
+		return partiqlError("expected %s", keyword)
+	}
+
+	return nil
+}
+
+func (p *partiqlParser) isPunct(punct string) bool {
+	tok, ok := p.peek()
+
+	return ok && tok.kind == partiqlPunct && tok.text == punct
+}
+
+func (p *partiqlParser) acceptPunct(punct string) bool {
+	if p.isPunct(punct) {
+		p.pos++
+
+		return true
+	}
+
+	return false
+}
+
+func (p *partiqlParser) expectPunct(punct string) error {
+	if !p.acceptPunct(punct) {
+		return partiqlError("expected %q", punct)
+	}
+
+	return nil
+}
+
+// parseName parses a bare or double-quoted identifier.
+func (p *partiqlParser) parseName() (string, error) {
+	tok, ok := p.next()
+	if !ok || (tok.kind != partiqlIdent && tok.kind != partiqlQuotedIdent) {
+		return "", partiqlError("expected an identifier")
+	}
+
+	return tok.text, nil
+}
+
+// parseTable parses a table name, optionally followed by an index name.
+func (p *partiqlParser) parseTable() (string, string, error) {
+	table, err := p.parseName()
+	if err != nil {
+		return "", "", err
+	}
+
+	if !p.acceptPunct(".") {
+		return table, "", nil
+	}
+
+	index, err := p.parseName()
+	if err != nil {
+		return "", "", err
+	}
+
+	return table, index, nil
+}
+
+// parsePath parses a document path such as a.b[0], given its first element.
+func (p *partiqlParser) parsePath(first string) (string, error) {
+	path := first
+
+	for {
+		switch {
+		case p.acceptPunct("."):
+			name, err := p.parseName()
+			if err != nil {
+				return "", err
+			}
+
+			path += "." + name
+		case p.acceptPunct("["):
+			tok, ok := p.next()
+			if !ok || tok.kind != partiqlNumber {
+				return "", partiqlError("expected a list index")
+			}
+
+			if err := p.expectPunct("]"); err != nil {
+				return "", err
+			}
+
+			path += "[" + tok.text + "]"
+		default:
+			return path, nil
+		}
+	}
+}
+
+// placeholder stores a value and returns its expression attribute value placeholder.
+//
+//nolint:gocritic // hugeParam: AttributeValue is stored by value.
+func (p *partiqlParser) placeholder(av AttributeValue) string {
+	name := ":p" + strconv.Itoa(len(p.values))
+	p.values[name] = av
+
+	return name
+}
+
+// isValueStart reports whether the next token starts a literal or parameter.
+func (p *partiqlParser) isValueStart() bool {
+	tok, ok := p.peek()
+	if !ok {
+		return false
+	}
+
+	switch tok.kind {
+	case partiqlString, partiqlNumber, partiqlParam:
+		return true
+	case partiqlPunct:
+		return tok.text == "{" || tok.text == "[" || tok.text == "<<"
+	case partiqlIdent:
+		return p.isKeyword("TRUE") || p.isKeyword("FALSE") || p.isKeyword("NULL")
+	default:
+		return false
+	}
+}
+
+// parseValue parses a literal or a parameter.
+//
+//nolint:cyclop,funlen // One case per PartiQL literal form.
+func (p *partiqlParser) parseValue() (AttributeValue, error) {
+	tok, ok := p.next()
+	if !ok {
+		return AttributeValue{}, partiqlError("expected a value")
+	}
+
+	switch tok.kind {
+	case partiqlString:
+		return AttributeValue{S: &tok.text}, nil
+	case partiqlNumber:
+		if _, err := strconv.ParseFloat(tok.text, 64); err != nil {
+			return AttributeValue{}, partiqlError("invalid number %s", tok.text)
+		}
+
+		return AttributeValue{N: &tok.text}, nil
+	case partiqlParam:
+		if p.used >= len(p.params) {
+			return AttributeValue{}, &TableError{
+				Code:    "ValidationException",
+				Message: "Number of parameters in request and statement don't match.",
+			}
+		}
+
+		p.used++
+
+		return p.params[p.used-1], nil
+	case partiqlIdent:
+		switch strings.ToUpper(tok.text) {
+		case "TRUE", "FALSE":
+			b := strings.EqualFold(tok.text, "TRUE")
+
+			return AttributeValue{BOOL: &b}, nil
+		case "NULL":
+			null := true
+
+			return AttributeValue{NULL: &null}, nil
+		}
+	case partiqlPunct:
+		switch tok.text {
+		case "{":
+			m := make(map[string]*AttributeValue)
+
+			for !p.acceptPunct("}") {
+				if len(m) > 0 {
+					if err := p.expectPunct(","); err != nil {
+						return AttributeValue{}, err
+					}
+				}
+
+				keyTok, ok := p.next()
+				if !ok || (keyTok.kind != partiqlString && keyTok.kind != partiqlQuotedIdent) {
+					return AttributeValue{}, partiqlError("expected a quoted map key")
+				}
+
+				if err := p.expectPunct(":"); err != nil {
+					return AttributeValue{}, err
+				}
+
+				v, err := p.parseValue()
+				if err != nil {
+					return AttributeValue{}, err
+				}
+
+				m[keyTok.text] = &v
+			}
+
+			return AttributeValue{M: m}, nil
+		case "[":
+			list := []*AttributeValue{}
+
+			for !p.acceptPunct("]") {
+				if len(list) > 0 {
+					if err := p.expectPunct(","); err != nil {
+						return AttributeValue{}, err
+					}
+				}
+
+				v, err := p.parseValue()
+				if err != nil {
+					return AttributeValue{}, err
+				}
+
+				list = append(list, &v)
+			}
+
+			return AttributeValue{L: list}, nil
+		case "<<":
+			return p.parseSet()
+		}
+	}
+
+	return AttributeValue{}, partiqlError("unexpected token %q", tok.text)
+}
+
+// parseSet parses the elements of a set literal after its opening <<.
+func (p *partiqlParser) parseSet() (AttributeValue, error) {
+	var set AttributeValue
+
+	for first := true; !p.acceptPunct(">>"); first = false {
+		if !first {
+			if err := p.expectPunct(","); err != nil {
+				return AttributeValue{}, err
+			}
+		}
+
+		v, err := p.parseValue()
+		if err != nil {
+			return AttributeValue{}, err
+		}
+
+		switch {
+		case v.S != nil && set.NS == nil && set.BS == nil:
+			set.SS = append(set.SS, *v.S)
+		case v.N != nil && set.SS == nil && set.BS == nil:
+			set.NS = append(set.NS, *v.N)
+		case v.B != nil && set.SS == nil && set.NS == nil:
+			set.BS = append(set.BS, v.B)
+		default:
+			return AttributeValue{}, partiqlError("set elements must all be strings, numbers or binaries")
+		}
+	}
+
+	if set.SS == nil && set.NS == nil && set.BS == nil {
+		return AttributeValue{}, partiqlError("a set must not be empty")
+	}
+
+	return set, nil
+}
+
+// parseOperand parses a path or a value and returns it as an expression operand.
+func (p *partiqlParser) parseOperand() (string, error) {
+	if p.isValueStart() {
+		v, err := p.parseValue()
+		if err != nil {
+			return "", err
+		}
+
+		return p.placeholder(v), nil
+	}
+
+	name, err := p.parseName()
+	if err != nil {
+		return "", err
+	}
+
+	return p.parsePath(name)
+}
+
+// parseSelect parses SELECT projection FROM table[.index] [WHERE condition].
+func (p *partiqlParser) parseSelect() (*partiqlStatement, error) {
+	stmt := &partiqlStatement{kind: partiqlSelect}
+
+	if !p.acceptPunct("*") {
+		var paths []string
+
+		for {
+			name, err := p.parseName()
+			if err != nil {
+				return nil, err
+			}
+
+			path, err := p.parsePath(name)
+			if err != nil {
+				return nil, err
+			}
+
+			paths = append(paths, path)
+
+			if !p.acceptPunct(",") {
+				break
+			}
+		}
+
+		stmt.projection = strings.Join(paths, ", ")
+	}
+
+	if err := p.expectKeyword("FROM"); err != nil {
+		return nil, err
+	}
+
+	var err error
+
+	stmt.table, stmt.index, err = p.parseTable()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.acceptKeyword("WHERE") {
+		if err := p.parseWhere(stmt); err != nil {
+			return nil, err
+		}
+	}
+
+	return stmt, nil
+}
+
+// parseInsert parses INSERT INTO table VALUE {...}.
+func (p *partiqlParser) parseInsert() (*partiqlStatement, error) {
+	stmt := &partiqlStatement{kind: partiqlInsert}
+
+	if err := p.expectKeyword("INTO"); err != nil {
+		return nil, err
+	}
+
+	table, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	stmt.table = table
+
+	if err := p.expectKeyword("VALUE"); err != nil {
+		return nil, err
+	}
+
+	v, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	if v.M == nil {
+		return nil, partiqlError("INSERT value must be a map")
+	}
+
+	stmt.item = make(Item, len(v.M))
+	for name, av := range v.M {
+		stmt.item[name] = *av
+	}
+
+	return stmt, nil
+}
+
+// parseUpdate parses UPDATE table SET ... REMOVE ... WHERE condition [RETURNING ...].
+func (p *partiqlParser) parseUpdate() (*partiqlStatement, error) {
+	stmt := &partiqlStatement{kind: partiqlUpdate}
+
+	table, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	stmt.table = table
+
+	var sets, removes []string
+
+	for !p.isKeyword("WHERE") {
+		switch {
+		case p.acceptKeyword("SET"):
+			assignment, err := p.parseAssignment()
+			if err != nil {
+				return nil, err
+			}
+
+			sets = append(sets, assignment)
+		case p.acceptKeyword("REMOVE"):
+			name, err := p.parseName()
+			if err != nil {
+				return nil, err
+			}
+
+			path, err := p.parsePath(name)
+			if err != nil {
+				return nil, err
+			}
+
+			removes = append(removes, path)
+		case p.acceptPunct(","):
+			// Another assignment of the same SET clause.
+			if len(sets) == 0 {
+				return nil, partiqlError("unexpected token \",\"")
+			}
+
+			assignment, err := p.parseAssignment()
+			if err != nil {
+				return nil, err
+			}
+
+			sets = append(sets, assignment)
+		default:
+			return nil, partiqlError("expected SET, REMOVE or WHERE")
+		}
+	}
+
+	if len(sets) == 0 && len(removes) == 0 {
+		return nil, partiqlError("UPDATE requires a SET or REMOVE clause")
+	}
+
+	var clauses []string
+	if len(sets) > 0 {
+		clauses = append(clauses, "SET "+strings.Join(sets, ", "))
+	}
+
+	if len(removes) > 0 {
+		clauses = append(clauses, "REMOVE "+strings.Join(removes, ", "))
+	}
+
+	stmt.update = strings.Join(clauses, " ")
+
+	p.pos++ // WHERE
+
+	if err := p.parseWhere(stmt); err != nil {
+		return nil, err
+	}
+
+	if err := p.parseReturning(stmt, ReturnValuesAllOld, ReturnValuesAllNew, ReturnValuesUpdatedOld, ReturnValuesUpdatedNew); err != nil {
+		return nil, err
+	}
+
+	return stmt, nil
+}
+
+// parseAssignment parses path = operand [(+|-) operand].
+func (p *partiqlParser) parseAssignment() (string, error) {
+	name, err := p.parseName()
+	if err != nil {
+		return "", err
+	}
+
+	path, err := p.parsePath(name)
+	if err != nil {
+		return "", err
+	}
+
+	if tok, ok := p.next(); !ok || tok.text != "=" {
+		return "", partiqlError("expected \"=\"")
+	}
+
+	operand, err := p.parseOperand()
+	if err != nil {
+		return "", err
+	}
+
+	for _, op := range []string{"+", "-"} {
+		if p.acceptPunct(op) {
+			right, err := p.parseOperand()
+			if err != nil {
+				return "", err
+			}
+
+			return path + " = " + operand + " " + op + " " + right, nil
+		}
+	}
+
+	return path + " = " + operand, nil
+}
+
+// parseDelete parses DELETE FROM table WHERE condition [RETURNING ALL OLD *].
+func (p *partiqlParser) parseDelete() (*partiqlStatement, error) {
+	stmt := &partiqlStatement{kind: partiqlDelete}
+
+	if err := p.expectKeyword("FROM"); err != nil {
+		return nil, err
+	}
+
+	table, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	stmt.table = table
+
+	if err := p.expectKeyword("WHERE"); err != nil {
+		return nil, err
+	}
+
+	if err := p.parseWhere(stmt); err != nil {
+		return nil, err
+	}
+
+	if err := p.parseReturning(stmt, ReturnValuesAllOld); err != nil {
+		return nil, err
+	}
+
+	return stmt, nil
+}
+
+// parseReturning parses an optional RETURNING clause, RETURNING [ALL | MODIFIED] [OLD | NEW] *,
+// whose MODIFIED OLD and MODIFIED NEW values are UpdateItem's UPDATED_OLD and UPDATED_NEW.
+func (p *partiqlParser) parseReturning(stmt *partiqlStatement, allowed ...string) error {
+	if !p.acceptKeyword("RETURNING") {
+		return nil
+	}
+
+	var scope string
+
+	switch {
+	case p.acceptKeyword("ALL"):
+		scope = "ALL"
+	case p.acceptKeyword("MODIFIED"):
+		scope = "UPDATED"
+	default:
+		return partiqlError("expected ALL or MODIFIED after RETURNING")
+	}
+
+	var image string
+
+	switch {
+	case p.acceptKeyword("OLD"):
+		image = "OLD"
+	case p.acceptKeyword("NEW"):
+		image = "NEW"
+	default:
+		return partiqlError("expected OLD or NEW after RETURNING")
+	}
+
+	returnValues := scope + "_" + image
+	if validateReturnValues(returnValues, allowed...) != "" {
+		return partiqlError("unsupported RETURNING %s %s", strings.Replace(scope, "UPDATED", "MODIFIED", 1), image)
+	}
+
+	if err := p.expectPunct("*"); err != nil {
+		return err
+	}
+
+	stmt.returnValues = returnValues
+
+	return nil
+}
+
+// parseWhere translates a WHERE clause into a condition expression and
+// collects the attributes it pins with top-level equalities.
+//
+//nolint:cyclop,funlen // One case per condition token.
+func (p *partiqlParser) parseWhere(stmt *partiqlStatement) error {
+	var (
+		parts []string
+		depth int
+		hasOr bool
+	)
+
+	stmt.keyValues = make(Item)
+	stmt.whereOnlyEqualities = true
+
+	for {
+		tok, ok := p.peek()
+		if !ok || p.isKeyword("RETURNING") {
+			break
+		}
+
+		// Anything but AND and pinning equalities is a further condition.
+		onlyEqualities := stmt.whereOnlyEqualities
+		if tok.kind != partiqlIdent || !strings.EqualFold(tok.text, "AND") {
+			stmt.whereOnlyEqualities = false
+		}
+
+		switch {
+		case p.isValueStart():
+			operand, err := p.parseOperand()
+			if err != nil {
+				return err
+			}
+
+			parts = append(parts, operand)
+		case tok.kind == partiqlOperator:
+			p.pos++
+			parts = append(parts, tok.text)
+		case tok.kind == partiqlPunct && (tok.text == "(" || tok.text == ")" || tok.text == ","):
+			p.pos++
+			parts = append(parts, tok.text)
+
+			switch tok.text {
+			case "(":
+				depth++
+			case ")":
+				depth--
+			}
+		case tok.kind == partiqlIdent && isConditionKeyword(tok.text):
+			p.pos++
+
+			switch keyword := strings.ToUpper(tok.text); keyword {
+			case "IS":
+				if err := p.parseIs(parts); err != nil {
+					return err
+				}
+			case "IN":
+				if err := p.parseIn(parts); err != nil {
+					return err
+				}
+			default:
+				hasOr = hasOr || keyword == "OR"
+				parts = append(parts, keyword)
+			}
+		case tok.kind == partiqlIdent && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].text == "(":
+			p.pos += 2
+			depth++
+
+			parts = append(parts, strings.ToLower(tok.text)+"(")
+		default:
+			startsConjunct := len(parts) == 0 || parts[len(parts)-1] == "AND"
+
+			operand, err := p.parseOperand()
+			if err != nil {
+				return err
+			}
+
+			parts = append(parts, operand)
+
+			// A top-level "path = value" conjunct pins an attribute.
+			if depth == 0 && startsConjunct && p.isEqualityConjunct() {
+				p.pos++
+
+				value, err := p.parseOperand()
+				if err != nil {
+					return err
+				}
+
+				parts = append(parts, "=", value)
+				stmt.keyValues[operand] = p.values[value]
+				stmt.whereOnlyEqualities = onlyEqualities
+			}
+		}
+	}
+
+	if len(parts) == 0 {
+		return partiqlError("WHERE requires a condition")
+	}
+
+	if depth != 0 {
+		return partiqlError("unbalanced parentheses")
+	}
+
+	if hasOr {
+		stmt.keyValues = make(Item)
+		stmt.whereOnlyEqualities = false
+	}
+
+	stmt.where = joinConditionParts(parts)
+
+	return nil
+}
+
+// isEqualityConjunct reports whether the next tokens are "= value" with a
+// scalar value, followed by the end of the condition, AND, or RETURNING.
+func (p *partiqlParser) isEqualityConjunct() bool {
+	if p.pos+1 >= len(p.tokens) || p.tokens[p.pos].text != "=" {
+		return false
+	}
+
+	switch p.tokens[p.pos+1].kind {
+	case partiqlString, partiqlNumber, partiqlParam:
+	default:
+		return false
+	}
+
+	if p.pos+2 >= len(p.tokens) {
+		return true
+	}
+
+	after := p.tokens[p.pos+2]
+
+	return after.kind == partiqlIdent && (strings.EqualFold(after.text, "AND") || strings.EqualFold(after.text, "RETURNING"))
+}
+
+// parseIs rewrites "path IS [NOT] MISSING" as an attribute_exists check.
+func (p *partiqlParser) parseIs(parts []string) error {
+	if len(parts) == 0 {
+		return partiqlError("IS requires an attribute")
+	}
+
+	not := p.acceptKeyword("NOT")
+
+	if !p.acceptKeyword("MISSING") {
+		return partiqlError("only IS [NOT] MISSING is supported")
+	}
+
+	fn := "attribute_not_exists("
+	if not {
+		fn = "attribute_exists("
+	}
+
+	parts[len(parts)-1] = fn + parts[len(parts)-1] + ")"
+
+	return nil
+}
+
+// parseIn rewrites "path IN [v1, v2]" as a disjunction of equalities.
+func (p *partiqlParser) parseIn(parts []string) error {
+	if len(parts) == 0 {
+		return partiqlError("IN requires an attribute")
+	}
+
+	closing := "]"
+	if p.acceptPunct("(") {
+		closing = ")"
+	} else if err := p.expectPunct("["); err != nil {
+		return err
+	}
+
+	path := parts[len(parts)-1]
+
+	var alternatives []string
+
+	for !p.acceptPunct(closing) {
+		if len(alternatives) > 0 {
+			if err := p.expectPunct(","); err != nil {
+				return err
+			}
+		}
+
+		v, err := p.parseValue()
+		if err != nil {
+			return err
+		}
+
+		alternatives = append(alternatives, path+" = "+p.placeholder(v))
+	}
+
+	if len(alternatives) == 0 {
+		return partiqlError("IN requires at least one value")
+	}
+
+	parts[len(parts)-1] = "(" + strings.Join(alternatives, " OR ") + ")"
+
+	return nil
+}
+
+// isConditionKeyword reports whether an identifier is a WHERE clause keyword.
+func isConditionKeyword(word string) bool {
+	switch strings.ToUpper(word) {
+	case "AND", "OR", "NOT", "BETWEEN", "IS", "IN":
+		return true
+	default:
+		return false
+	}
+}
+
+// joinConditionParts joins condition parts with spaces, keeping function
+// arguments and parentheses tight so the condition evaluator can parse them.
+func joinConditionParts(parts []string) string {
+	var sb strings.Builder
+
+	for i, part := range parts {
+		if i > 0 && part != ")" && part != "," && !strings.HasSuffix(parts[i-1], "(") {
+			sb.WriteByte(' ')
+		}
+
+		sb.WriteString(part)
+	}
+
+	return sb.String()
+}
+
+// executeStatement runs a parsed statement. SELECT returns the matching items
+// and the key to continue from; writes return the item selected by RETURNING.
+//
+//nolint:cyclop,funlen // One case per statement kind.
+func (s *Service) executeStatement(ctx context.Context, stmt *partiqlStatement, limit int, startKey Item) ([]Item, Item, error) {
+	table, err := s.storage.DescribeTable(ctx, stmt.table)
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck // TableError is returned to the client as is.
+	}
+
+	cond := ConditionInput{Expression: stmt.where, ExprValues: stmt.values}
+
+	switch stmt.kind {
+	case partiqlSelect:
+		// Scan ignores a filter it cannot evaluate, so reject it up front.
+		if _, err := evaluateCondition(Item{}, cond); err != nil {
+			return nil, nil, partiqlError("%s", err)
+		}
+
+		filter := stmt.where
+
+		if stmt.index != "" {
+			keySchema, err := resolveKeySchema(table, stmt.index)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			// Only items carrying the index keys are in the index.
+			var exists []string
+			for _, ks := range keySchema {
+				exists = append(exists, "attribute_exists("+ks.AttributeName+")")
+			}
+
+			if filter != "" {
+				exists = append(exists, "("+filter+")")
+			}
+
+			filter = strings.Join(exists, " AND ")
+		}
+
+		items, lastKey, _, err := s.storage.Scan(ctx, &ScanRequest{
+			TableName:                 stmt.table,
+			FilterExpression:          filter,
+			ExpressionAttributeValues: stmt.values,
+			Limit:                     limit,
+			ExclusiveStartKey:         startKey,
+		})
+		if err != nil {
+			return nil, nil, err //nolint:wrapcheck // TableError is returned to the client as is.
+		}
+
+		for i, item := range items {
+			items[i] = projectItem(item, stmt.projection, nil)
+		}
+
+		return items, lastKey, nil
+	case partiqlInsert:
+		var missing []string
+
+		for _, ks := range table.KeySchema {
+			if _, ok := stmt.item[ks.AttributeName]; !ok {
+				missing = append(missing, ks.AttributeName)
+			}
+		}
+
+		if len(missing) > 0 {
+			return nil, nil, &TableError{
+				Code:    "ValidationException",
+				Message: "One or more parameter values were invalid: Missing the key " + strings.Join(missing, ", ") + " in the item",
+			}
+		}
+
+		_, err := s.storage.PutItem(ctx, stmt.table, stmt.item, false, ConditionInput{
+			Expression: "attribute_not_exists(" + table.KeySchema[0].AttributeName + ")",
+		})
+
+		var tErr *TableError
+		if errors.As(err, &tErr) && tErr.Code == ErrCodeConditionalCheckFailed {
+			return nil, nil, &TableError{Code: "DuplicateItemException", Message: "Duplicate primary key exists in table"}
+		}
+
+		return nil, nil, err
+	}
+
+	key, err := statementKey(table, stmt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item Item
+
+	if stmt.kind == partiqlUpdate {
+		item, err = s.storage.UpdateItem(ctx, stmt.table, key, stmt.update, nil, stmt.values, stmt.returnValues, cond)
+	} else {
+		// Deleting an absent item by its key alone succeeds, as with DeleteItem.
+		if stmt.whereOnlyEqualities && len(stmt.keyValues) == len(key) {
+			cond = ConditionInput{}
+		}
+
+		item, err = s.storage.DeleteItem(ctx, stmt.table, key, stmt.returnValues == ReturnValuesAllOld, cond)
+	}
+
+	if err != nil || item == nil {
+		return nil, nil, err
+	}
+
+	return []Item{item}, nil, nil
+}
+
+// selectItemByKey runs a SELECT that pins the whole primary key, as the
+// statements of a BatchExecuteStatement request must.
+func (s *Service) selectItemByKey(ctx context.Context, stmt *partiqlStatement) ([]Item, error) {
+	table, err := s.storage.DescribeTable(ctx, stmt.table)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // TableError is returned to the client as is.
+	}
+
+	if stmt.index != "" {
+		return nil, &TableError{Code: "ValidationException", Message: "Batch SELECT statements must not use an index"}
+	}
+
+	key, err := statementKey(table, stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.storage.GetItem(ctx, stmt.table, key)
+	if err != nil || item == nil {
+		return nil, err //nolint:wrapcheck // TableError is returned to the client as is.
+	}
+
+	ok, err := evaluateCondition(item, ConditionInput{Expression: stmt.where, ExprValues: stmt.values})
+	if err != nil {
+		return nil, partiqlError("%s", err)
+	}
+
+	if !ok {
+		return nil, nil
+	}
+
+	return []Item{projectItem(item, stmt.projection, nil)}, nil
+}
+
+// statementKey returns the primary key that an UPDATE or DELETE statement
+// pins with equalities in its WHERE clause.
+func statementKey(table *Table, stmt *partiqlStatement) (Item, error) {
+	key := make(Item, len(table.KeySchema))
+
+	for _, ks := range table.KeySchema {
+		av, ok := stmt.keyValues[ks.AttributeName]
+		if !ok {
+			return nil, &TableError{
+				Code:    "ValidationException",
+				Message: "Where clause does not contain a mandatory equality on all key attributes",
+			}
+		}
+
+		key[ks.AttributeName] = av
+	}
+
+	return key, nil
+}
+
+// encodeStatementToken encodes the key a SELECT stopped at as a NextToken.
+func encodeStatementToken(lastKey Item) (string, error) {
+	if lastKey == nil {
+		return "", nil
+	}
+
+	data, err := json.Marshal(lastKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode next token: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// decodeStatementToken decodes a NextToken returned by encodeStatementToken.
+func decodeStatementToken(token string) (Item, error) {
+	if token == "" {
+		return nil, nil //nolint:nilnil // No token means starting from the beginning.
+	}
+
+	data, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, &TableError{Code: "ValidationException", Message: "Invalid NextToken"}
+	}
+
+	var key Item
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, &TableError{Code: "ValidationException", Message: "Invalid NextToken"}
+	}
+
+	return key, nil
+}
+
+// batchStatementErrorCode maps an exception code to a BatchStatementError code.
+func batchStatementErrorCode(code string) string {
+	if code == "ValidationException" {
+		return "ValidationError"
+	}
+
+	return strings.TrimSuffix(code, "Exception")
+}
@@ -0,0 +1,208 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestParsePartiQL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		statement string
+		params    []AttributeValue
+		want      partiqlStatement
+	}{
+		{
+			statement: `SELECT * FROM "users" WHERE pk = 'a' AND age >= 20`,
+			want: partiqlStatement{
+				kind:  partiqlSelect,
+				table: "users",
+				where: "pk = :p0 AND age >= :p1",
+			},
+		},
+		{
+			statement: `SELECT name, address.city FROM users."by-name" WHERE begins_with(name, ?) OR tags IS MISSING`,
+			params:    []AttributeValue{{S: ptr("A")}},
+			want: partiqlStatement{
+				kind:       partiqlSelect,
+				table:      "users",
+				index:      "by-name",
+				projection: "name, address.city",
+				where:      "begins_with(name, :p0) OR attribute_not_exists(tags)",
+			},
+		},
+		{
+			statement: `SELECT * FROM users WHERE status IN ['a', 'b']`,
+			want: partiqlStatement{
+				kind:  partiqlSelect,
+				table: "users",
+				where: "(status = :p0 OR status = :p1)",
+			},
+		},
+		{
+			statement: `UPDATE users SET age = age + 1 SET name = ? REMOVE nickname WHERE pk = 'a' RETURNING MODIFIED NEW *`,
+			params:    []AttributeValue{{S: ptr("Bob")}},
+			want: partiqlStatement{
+				kind:         partiqlUpdate,
+				table:        "users",
+				update:       "SET age = age + :p0, name = :p1 REMOVE nickname",
+				where:        "pk = :p2",
+				returnValues: ReturnValuesUpdatedNew,
+			},
+		},
+		{
+			statement: `DELETE FROM users WHERE pk = ? AND sk = -1`,
+			params:    []AttributeValue{{S: ptr("a")}},
+			want: partiqlStatement{
+				kind:  partiqlDelete,
+				table: "users",
+				where: "pk = :p0 AND sk = :p1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := parsePartiQL(tt.statement, tt.params)
+		if err != nil {
+			t.Errorf("%s: %v", tt.statement, err)
+
+			continue
+		}
+
+		if got.kind != tt.want.kind || got.table != tt.want.table || got.index != tt.want.index ||
+			got.projection != tt.want.projection || got.where != tt.want.where ||
+			got.update != tt.want.update || got.returnValues != tt.want.returnValues {
+			t.Errorf("%s:\ngot  %+v\nwant %+v", tt.statement, *got, tt.want)
+		}
+	}
+
+	for _, statement := range []string{
+		`SELECT * FROM`,
+		`SELECT * FROM users WHERE pk = ?`,
+		`INSERT INTO users VALUE 'a'`,
+		`UPDATE users WHERE pk = 'a'`,
+		`DELETE FROM users WHERE pk = 'a' RETURNING ALL NEW *`,
+		`DELETE FROM users WHERE pk = 'a' RETURNING ALL_OLD *`,
+		`UPDATE users SET age = 1 WHERE pk = 'a' RETURNING MODIFIED_NEW *`,
+		`MERGE INTO users`,
+	} {
+		if _, err := parsePartiQL(statement, nil); err == nil {
+			t.Errorf("%s: expected an error", statement)
+		}
+	}
+}
+
+//nolint:funlen // Test function exercises each statement kind in sequence.
+func TestExecuteStatement(t *testing.T) {
+	t.Parallel()
+
+	storage := NewMemoryStorage("http://localhost:4566")
+	s := New(storage)
+	ctx := context.Background()
+
+	_, err := storage.CreateTable(ctx, &CreateTableRequest{
+		TableName: "test-partiql",
+		KeySchema: []KeySchemaElement{
+			{AttributeName: "pk", KeyType: "HASH"},
+			{AttributeName: "sk", KeyType: "RANGE"},
+		},
+		AttributeDefinitions: []AttributeDefinition{
+			{AttributeName: "pk", AttributeType: "S"},
+			{AttributeName: "sk", AttributeType: "N"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	run := func(statement string, params ...AttributeValue) ([]Item, error) {
+		stmt, err := parsePartiQL(statement, params)
+		if err != nil {
+			return nil, err
+		}
+
+		items, _, err := s.executeStatement(ctx, stmt, 0, nil)
+
+		return items, err
+	}
+
+	for _, statement := range []string{
+		`INSERT INTO "test-partiql" VALUE {'pk': 'a', 'sk': 1, 'n': 10}`,
+		`INSERT INTO "test-partiql" VALUE {'pk': 'a', 'sk': 2, 'n': 20}`,
+		`INSERT INTO "test-partiql" VALUE {'pk': 'b', 'sk': 1, 'n': 30}`,
+	} {
+		if _, err := run(statement); err != nil {
+			t.Fatalf("%s: %v", statement, err)
+		}
+	}
+
+	var tErr *TableError
+
+	_, err = run(`INSERT INTO "test-partiql" VALUE {'pk': 'a', 'sk': 1}`)
+	if !errors.As(err, &tErr) || tErr.Code != "DuplicateItemException" {
+		t.Errorf("expected DuplicateItemException, got %v", err)
+	}
+
+	items, err := run(`SELECT n FROM "test-partiql" WHERE pk = ? AND n > 5`, AttributeValue{S: ptr("a")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 || len(items[0]) != 1 || *items[0]["n"].N != "10" {
+		t.Errorf("expected the n of both a items, got %+v", items)
+	}
+
+	items, err = run(`UPDATE "test-partiql" SET n = n + 5 WHERE pk = 'a' AND sk = 1 RETURNING ALL NEW *`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 || *items[0]["n"].N != "15" {
+		t.Errorf("expected the updated item, got %+v", items)
+	}
+
+	_, err = run(`UPDATE "test-partiql" SET n = 1 WHERE pk = 'c' AND sk = 1`)
+	if !errors.As(err, &tErr) || tErr.Code != ErrCodeConditionalCheckFailed {
+		t.Errorf("expected ConditionalCheckFailedException updating a missing item, got %v", err)
+	}
+
+	_, err = run(`UPDATE "test-partiql" SET n = 1 WHERE pk = 'a'`)
+	if !errors.As(err, &tErr) || tErr.Code != "ValidationException" {
+		t.Errorf("expected ValidationException without the full key, got %v", err)
+	}
+
+	_, err = run(`DELETE FROM "test-partiql" WHERE pk = 'b' AND sk = 1 AND n = 0`)
+	if !errors.As(err, &tErr) || tErr.Code != ErrCodeConditionalCheckFailed {
+		t.Errorf("expected ConditionalCheckFailedException, got %v", err)
+	}
+
+	items, err = run(`DELETE FROM "test-partiql" WHERE pk = 'b' AND sk = 1 RETURNING ALL OLD *`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 || *items[0]["n"].N != "30" {
+		t.Errorf("expected the deleted item, got %+v", items)
+	}
+
+	if _, err := run(`DELETE FROM "test-partiql" WHERE pk = 'b' AND sk = 1`); err != nil {
+		t.Errorf("expected deleting a missing item by key to succeed, got %v", err)
+	}
+
+	resp := s.executeBatchStatement(ctx, &BatchStatementRequest{
+		Statement:  `SELECT * FROM "test-partiql" WHERE pk = ? AND sk = ?`,
+		Parameters: []AttributeValue{{S: ptr("a")}, {N: ptr("2")}},
+	})
+	if resp.Error != nil || resp.Item == nil || *resp.Item["n"].N != "20" {
+		t.Errorf("expected the batch SELECT to return item a/2, got %+v", resp)
+	}
+
+	resp = s.executeBatchStatement(ctx, &BatchStatementRequest{
+		Statement: `INSERT INTO "test-partiql" VALUE {'pk': 'a', 'sk': 2}`,
+	})
+	if resp.Error == nil || resp.Error.Code != "DuplicateItem" {
+		t.Errorf("expected a DuplicateItem error, got %+v", resp)
+	}
+}
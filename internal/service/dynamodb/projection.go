@@ -0,0 +1,164 @@
+package dynamodb
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// pathElement is an element of a document path: an attribute name or a list index.
+type pathElement struct {
+	name    string
+	index   int
+	isIndex bool
+}
+
+// key returns the element as it appears in a document path.
+func (e pathElement) key() string {
+	if e.isIndex {
+		return "[" + strconv.Itoa(e.index) + "]"
+	}
+
+	return "." + e.name
+}
+
+// parseDocumentPath parses a document path such as #a.b[1].c, resolving expression
+// attribute names. It returns nil if the path is malformed.
+func parseDocumentPath(path string, names map[string]string) []pathElement {
+	var elems []pathElement
+
+	for part := range strings.SplitSeq(path, ".") {
+		name, indexes, _ := strings.Cut(part, "[")
+		if name == "" {
+			return nil
+		}
+
+		if resolved, ok := names[name]; ok {
+			name = resolved
+		}
+
+		elems = append(elems, pathElement{name: name})
+
+		if indexes == "" {
+			continue
+		}
+
+		for idx := range strings.SplitSeq(strings.TrimSuffix(indexes, "]"), "][") {
+			n, err := strconv.Atoi(idx)
+			if err != nil || n < 0 {
+				return nil
+			}
+
+			elems = append(elems, pathElement{index: n, isIndex: true})
+		}
+	}
+
+	return elems
+}
+
+// lookupDocumentPath returns the value at a document path of an item.
+func lookupDocumentPath(item Item, path []pathElement) (AttributeValue, bool) {
+	val, ok := item[path[0].name]
+	if !ok {
+		return AttributeValue{}, false
+	}
+
+	for _, elem := range path[1:] {
+		var next *AttributeValue
+
+		switch {
+		case elem.isIndex && elem.index < len(val.L):
+			next = val.L[elem.index]
+		case !elem.isIndex && val.M != nil:
+			next = val.M[elem.name]
+		}
+
+		if next == nil {
+			return AttributeValue{}, false
+		}
+
+		val = *next
+	}
+
+	return val, true
+}
+
+// projectItem returns the attributes of an item selected by a projection expression.
+// Nested attributes keep their enclosing maps, and list elements are returned in a
+// list of only the selected elements. It returns the item unchanged if the projection
+// expression is empty.
+func projectItem(item Item, projection string, names map[string]string) Item {
+	if projection == "" || item == nil {
+		return item
+	}
+
+	result := make(Item)
+	// Projected maps and lists, by their document path, and the top-level attributes
+	// that are built from them.
+	containers := make(map[string]*AttributeValue)
+	nested := make(map[string]bool)
+	listIndexes := make(map[*AttributeValue][]int)
+
+	for expr := range strings.SplitSeq(projection, ",") {
+		path := parseDocumentPath(strings.TrimSpace(expr), names)
+		if len(path) == 0 {
+			continue
+		}
+
+		value, ok := lookupDocumentPath(item, path)
+		if !ok {
+			continue
+		}
+
+		if len(path) == 1 {
+			result[path[0].name] = value
+
+			continue
+		}
+
+		key := path[0].key()
+
+		parent, ok := containers[key]
+		if !ok {
+			parent = &AttributeValue{}
+			containers[key] = parent
+		}
+
+		for i, elem := range path[1:] {
+			key += elem.key()
+
+			child, ok := containers[key]
+			if !ok {
+				child = &AttributeValue{}
+				if i == len(path)-2 {
+					*child = value
+				}
+
+				containers[key] = child
+
+				if elem.isIndex {
+					// Selected list elements are kept in index order.
+					pos, _ := slices.BinarySearch(listIndexes[parent], elem.index)
+					listIndexes[parent] = slices.Insert(listIndexes[parent], pos, elem.index)
+					parent.L = slices.Insert(parent.L, pos, child)
+				} else {
+					if parent.M == nil {
+						parent.M = make(map[string]*AttributeValue)
+					}
+
+					parent.M[elem.name] = child
+				}
+			}
+
+			parent = child
+		}
+
+		nested[path[0].name] = true
+	}
+
+	for name := range nested {
+		result[name] = *containers[pathElement{name: name}.key()]
+	}
+
+	return result
+}
@@ -0,0 +1,35 @@
+package dynamodb
+
+import "testing"
+
+func TestProjectItem(t *testing.T) {
+	t.Parallel()
+
+	item := Item{
+		"id":   {S: ptr("1")},
+		"name": {S: ptr("alice")},
+		"address": {M: map[string]*AttributeValue{
+			"city": {S: ptr("Tokyo")},
+			"zip":  {S: ptr("100-0001")},
+		}},
+		"tags": {L: []*AttributeValue{{S: ptr("a")}, {S: ptr("b")}, {S: ptr("c")}}},
+	}
+
+	got := projectItem(item, "id, #a.city, tags[2], tags[0], missing", map[string]string{"#a": "address"})
+
+	if len(got) != 3 || *got["id"].S != "1" {
+		t.Fatalf("unexpected projection: %+v", got)
+	}
+
+	if addr := got["address"].M; len(addr) != 1 || *addr["city"].S != "Tokyo" {
+		t.Errorf("expected only address.city, got %+v", addr)
+	}
+
+	if tags := got["tags"].L; len(tags) != 2 || *tags[0].S != "a" || *tags[1].S != "c" {
+		t.Errorf("expected the selected tags, got %+v", tags)
+	}
+
+	if projectItem(item, "", nil)["name"].S == nil {
+		t.Error("expected an empty projection to return the whole item")
+	}
+}
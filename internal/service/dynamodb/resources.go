@@ -0,0 +1,71 @@
+package dynamodb
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// Compile-time check that Service names the tables of its requests for fault rules.
+var _ service.ResourceNamer = (*Service)(nil)
+
+// tablesRequest holds the fields that name the tables a request acts on.
+type tablesRequest struct {
+	TableName     string                     `json:"TableName"`
+	RequestItems  map[string]json.RawMessage `json:"RequestItems"`
+	TransactItems []map[string]struct {
+		TableName string `json:"TableName"`
+	} `json:"TransactItems"`
+}
+
+// tableNames returns the distinct tables named by the request.
+func (r *tablesRequest) tableNames() []string {
+	var names []string
+
+	add := func(name string) {
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	add(r.TableName)
+
+	for name := range r.RequestItems {
+		add(name)
+	}
+
+	for _, item := range r.TransactItems {
+		for _, op := range item {
+			add(op.TableName)
+		}
+	}
+
+	return names
+}
+
+// RequestResources returns the tables the request acts on, so that fault rules
+// can throttle the requests to a table. The request body is left in place for
+// the action handler.
+func (s *Service) RequestResources(r *http.Request) []string {
+	if r.Body == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	var req tablesRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil
+	}
+
+	return req.tableNames()
+}
@@ -0,0 +1,66 @@
+package dynamodb
+
+import (
+	"io"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRequestResources(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{
+			name: "table name",
+			body: `{"TableName": "orders", "Key": {"pk": {"S": "1"}}}`,
+			want: []string{"orders"},
+		},
+		{
+			name: "batch request items",
+			body: `{"RequestItems": {"orders": {}}}`,
+			want: []string{"orders"},
+		},
+		{
+			name: "transact items",
+			body: `{"TransactItems": [{"Put": {"TableName": "orders"}}, {"Update": {"TableName": "customers"}}, {"Delete": {"TableName": "orders"}}]}`,
+			want: []string{"orders", "customers"},
+		},
+		{
+			name: "no table",
+			body: `{}`,
+		},
+		{
+			name: "invalid JSON",
+			body: `{`,
+		},
+	}
+
+	svc := New(NewMemoryStorage(defaultBaseURL))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest("POST", "/", strings.NewReader(tt.body))
+
+			if got := svc.RequestResources(req); !slices.Equal(got, tt.want) {
+				t.Errorf("expected tables %v, got %v", tt.want, got)
+			}
+
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(body) != tt.body {
+				t.Errorf("expected the body to be left in place, got %q", body)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package dynamodb
+
+import (
+	"maps"
+	"slices"
+	"time"
+)
+
+// Table and index statuses.
+const (
+	tableStatusCreating = "CREATING"
+	tableStatusUpdating = "UPDATING"
+	tableStatusDeleting = "DELETING"
+	tableStatusActive   = "ACTIVE"
+)
+
+// defaultTableTransitionDelay is how long a table or index stays CREATING, UPDATING or DELETING before the scheduler advances it.
+const defaultTableTransitionDelay = 500 * time.Millisecond
+
+// WithTableTransitionDelay sets how long a table or index stays CREATING, UPDATING or DELETING before the scheduler advances it.
+func WithTableTransitionDelay(d time.Duration) Option {
+	return func(s *MemoryStorage) {
+		s.transitionDelay = d
+	}
+}
+
+// tableScheduler periodically advances tables through their lifecycle.
+func (m *MemoryStorage) tableScheduler() {
+	ticker := time.NewTicker(max(m.transitionDelay/5, 10*time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stopScheduler:
+			return
+		case now := <-ticker.C:
+			m.advanceTables(now)
+		}
+	}
+}
+
+// advanceTables activates the tables and indexes that have been CREATING or
+// UPDATING for the transition delay, and removes the tables and indexes that
+// have been DELETING as long.
+func (m *MemoryStorage) advanceTables(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for name := range m.deleting {
+		if m.transitionDue(name, now) {
+			delete(m.deleting, name)
+		}
+	}
+
+	for name, td := range m.Tables {
+		if !tablePending(td.Table) || !m.transitionDue(name, now) {
+			continue
+		}
+
+		activateTable(td.Table)
+	}
+}
+
+// transitionDue reports whether the table has been in its status for the
+// transition delay, forgetting when it entered the status if so. Must be
+// called under lock.
+func (m *MemoryStorage) transitionDue(name string, now time.Time) bool {
+	changed, ok := m.transitions[name]
+	if !ok {
+		// Tables restored from disk start their timer on the first tick.
+		m.transitions[name] = now
+
+		return false
+	}
+
+	if now.Sub(changed) < m.transitionDelay {
+		return false
+	}
+
+	delete(m.transitions, name)
+
+	return true
+}
+
+// startTransition records that a table entered a status the scheduler advances. Must be called under lock.
+func (m *MemoryStorage) startTransition(name string) {
+	m.transitions[name] = time.Now()
+}
+
+// tablePending reports whether the table or one of its indexes is not ACTIVE.
+func tablePending(table *Table) bool {
+	if table.TableStatus != tableStatusActive {
+		return true
+	}
+
+	for _, status := range table.IndexStatuses {
+		if status != tableStatusActive {
+			return true
+		}
+	}
+
+	return false
+}
+
+// activateTable makes a table and its indexes ACTIVE, removing the indexes that are DELETING.
+func activateTable(table *Table) {
+	table.TableStatus = tableStatusActive
+
+	table.GlobalSecondaryIndexes = slices.DeleteFunc(slices.Clone(table.GlobalSecondaryIndexes), func(gsi GlobalSecondaryIndex) bool {
+		return table.IndexStatuses[gsi.IndexName] == tableStatusDeleting
+	})
+
+	maps.DeleteFunc(table.IndexStatuses, func(_, status string) bool {
+		return status == tableStatusDeleting
+	})
+
+	for name := range table.IndexStatuses {
+		table.IndexStatuses[name] = tableStatusActive
+	}
+}
@@ -0,0 +1,228 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// advance runs the scheduler twice, delay apart, so that every pending table is due.
+func advance(s *MemoryStorage) {
+	now := time.Now().Add(s.transitionDelay)
+	s.advanceTables(now)
+	s.advanceTables(now.Add(s.transitionDelay))
+}
+
+func describeTable(t *testing.T, s *MemoryStorage, name string) *Table {
+	t.Helper()
+
+	table, err := s.DescribeTable(context.Background(), name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return table
+}
+
+func TestTableLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := NewMemoryStorage("http://localhost:4566")
+
+	t.Cleanup(func() { _ = s.Close() })
+
+	table, err := s.CreateTable(ctx, &CreateTableRequest{
+		TableName:            "lifecycle",
+		KeySchema:            []KeySchemaElement{{AttributeName: "pk", KeyType: "HASH"}},
+		AttributeDefinitions: []AttributeDefinition{{AttributeName: "pk", AttributeType: "S"}},
+		BillingMode:          BillingModePayPerRequest,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if table.TableStatus != tableStatusCreating {
+		t.Errorf("expected CREATING after CreateTable, got %s", table.TableStatus)
+	}
+
+	advance(s)
+
+	if got := describeTable(t, s, "lifecycle").TableStatus; got != tableStatusActive {
+		t.Errorf("expected ACTIVE after the transition delay, got %s", got)
+	}
+
+	if _, err := s.DeleteTable(ctx, "lifecycle"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := describeTable(t, s, "lifecycle").TableStatus; got != tableStatusDeleting {
+		t.Errorf("expected DELETING after DeleteTable, got %s", got)
+	}
+
+	var tErr *TableError
+	if _, err := s.DeleteTable(ctx, "lifecycle"); !errors.As(err, &tErr) || tErr.Code != "ResourceInUseException" {
+		t.Errorf("expected ResourceInUseException deleting a DELETING table, got %v", err)
+	}
+
+	advance(s)
+
+	if _, err := s.DescribeTable(ctx, "lifecycle"); !errors.As(err, &tErr) || tErr.Code != "ResourceNotFoundException" {
+		t.Errorf("expected ResourceNotFoundException after the table is removed, got %v", err)
+	}
+}
+
+//nolint:funlen // Test function exercises multiple UpdateTable scenarios.
+func TestUpdateTable(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	newTable := func(t *testing.T) *MemoryStorage {
+		t.Helper()
+
+		s := NewMemoryStorage("http://localhost:4566")
+
+		t.Cleanup(func() { _ = s.Close() })
+
+		if _, err := s.CreateTable(ctx, &CreateTableRequest{
+			TableName:             "orders",
+			KeySchema:             []KeySchemaElement{{AttributeName: "pk", KeyType: "HASH"}},
+			AttributeDefinitions:  []AttributeDefinition{{AttributeName: "pk", AttributeType: "S"}},
+			ProvisionedThroughput: &ProvisionedThroughput{ReadCapacityUnits: 5, WriteCapacityUnits: 5},
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		return s
+	}
+
+	t.Run("rejects updates while the table is CREATING", func(t *testing.T) {
+		t.Parallel()
+
+		s := newTable(t)
+
+		_, err := s.UpdateTable(ctx, &UpdateTableRequest{
+			TableName:             "orders",
+			ProvisionedThroughput: &ProvisionedThroughput{ReadCapacityUnits: 10, WriteCapacityUnits: 10},
+		})
+
+		var tErr *TableError
+		if !errors.As(err, &tErr) || tErr.Code != "ResourceInUseException" {
+			t.Errorf("expected ResourceInUseException, got %v", err)
+		}
+	})
+
+	t.Run("updates the throughput", func(t *testing.T) {
+		t.Parallel()
+
+		s := newTable(t)
+		advance(s)
+
+		table, err := s.UpdateTable(ctx, &UpdateTableRequest{
+			TableName:             "orders",
+			ProvisionedThroughput: &ProvisionedThroughput{ReadCapacityUnits: 10, WriteCapacityUnits: 20},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if table.TableStatus != tableStatusUpdating {
+			t.Errorf("expected UPDATING, got %s", table.TableStatus)
+		}
+
+		if table.ProvisionedThroughput.WriteCapacityUnits != 20 {
+			t.Errorf("expected 20 write capacity units, got %d", table.ProvisionedThroughput.WriteCapacityUnits)
+		}
+
+		advance(s)
+
+		if got := describeTable(t, s, "orders").TableStatus; got != tableStatusActive {
+			t.Errorf("expected ACTIVE after the transition delay, got %s", got)
+		}
+
+		var tErr *TableError
+		if _, err := s.UpdateTable(ctx, &UpdateTableRequest{
+			TableName:             "orders",
+			ProvisionedThroughput: &ProvisionedThroughput{ReadCapacityUnits: 10, WriteCapacityUnits: 20},
+		}); !errors.As(err, &tErr) || tErr.Code != "ValidationException" {
+			t.Errorf("expected ValidationException for unchanged throughput, got %v", err)
+		}
+	})
+
+	t.Run("adds and removes a GSI", func(t *testing.T) {
+		t.Parallel()
+
+		s := newTable(t)
+		advance(s)
+
+		table, err := s.UpdateTable(ctx, &UpdateTableRequest{
+			TableName:            "orders",
+			AttributeDefinitions: []AttributeDefinition{{AttributeName: "customer", AttributeType: "S"}},
+			GlobalSecondaryIndexUpdates: []GlobalSecondaryIndexUpdate{{
+				Create: &GlobalSecondaryIndex{
+					IndexName:             "by-customer",
+					KeySchema:             []KeySchemaElement{{AttributeName: "customer", KeyType: "HASH"}},
+					Projection:            Projection{ProjectionType: "ALL"},
+					ProvisionedThroughput: &ProvisionedThroughput{ReadCapacityUnits: 1, WriteCapacityUnits: 1},
+				},
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := table.IndexStatuses["by-customer"]; got != tableStatusCreating {
+			t.Errorf("expected the new index to be CREATING, got %s", got)
+		}
+
+		advance(s)
+
+		if got := describeTable(t, s, "orders").IndexStatuses["by-customer"]; got != tableStatusActive {
+			t.Errorf("expected the new index to be ACTIVE, got %s", got)
+		}
+
+		table, err = s.UpdateTable(ctx, &UpdateTableRequest{
+			TableName:                   "orders",
+			GlobalSecondaryIndexUpdates: []GlobalSecondaryIndexUpdate{{Delete: &DeleteGlobalSecondaryIndexAction{IndexName: "by-customer"}}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := table.IndexStatuses["by-customer"]; got != tableStatusDeleting {
+			t.Errorf("expected the index to be DELETING, got %s", got)
+		}
+
+		advance(s)
+
+		if gsis := describeTable(t, s, "orders").GlobalSecondaryIndexes; len(gsis) != 0 {
+			t.Errorf("expected the index to be removed, got %v", gsis)
+		}
+	})
+
+	t.Run("rejects a GSI keyed on an undefined attribute", func(t *testing.T) {
+		t.Parallel()
+
+		s := newTable(t)
+		advance(s)
+
+		_, err := s.UpdateTable(ctx, &UpdateTableRequest{
+			TableName: "orders",
+			GlobalSecondaryIndexUpdates: []GlobalSecondaryIndexUpdate{{
+				Create: &GlobalSecondaryIndex{
+					IndexName:             "by-customer",
+					KeySchema:             []KeySchemaElement{{AttributeName: "customer", KeyType: "HASH"}},
+					Projection:            Projection{ProjectionType: "ALL"},
+					ProvisionedThroughput: &ProvisionedThroughput{ReadCapacityUnits: 1, WriteCapacityUnits: 1},
+				},
+			}},
+		})
+
+		var tErr *TableError
+		if !errors.As(err, &tErr) || tErr.Code != "ValidationException" {
+			t.Errorf("expected ValidationException, got %v", err)
+		}
+	})
+}
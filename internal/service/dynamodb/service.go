@@ -1,9 +1,11 @@
 package dynamodb
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -14,12 +16,20 @@ var _ io.Closer = (*Service)(nil)
 const defaultBaseURL = "http://localhost:4566"
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"dynamodb", "dynamodbstreams"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
+
+		if delay, err := time.ParseDuration(os.Getenv("KUMO_DYNAMODB_TABLE_TRANSITION_DELAY")); err == nil {
+			opts = append(opts, WithTableTransitionDelay(delay))
+		}
+
+		storage := NewMemoryStorage(defaultBaseURL, opts...)
 
-	service.Register(New(NewMemoryStorage(defaultBaseURL, opts...)))
+		return []service.Service{New(storage), NewStreams(storage)}
+	})
 }
 
 // Service implements the DynamoDB service.
@@ -64,3 +74,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package dynamodb
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	src := New(NewMemoryStorage("http://localhost:4566"))
+
+	_, err := src.storage.CreateTable(ctx, &CreateTableRequest{
+		TableName: "snapshot-table",
+		KeySchema: []KeySchemaElement{
+			{AttributeName: "pk", KeyType: "HASH"},
+		},
+		AttributeDefinitions: []AttributeDefinition{
+			{AttributeName: "pk", AttributeType: "S"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item := Item{"pk": {S: ptr("user#1")}, "age": {N: ptr("30")}, "tags": {SS: []string{"a", "b"}}}
+	if _, err := src.storage.PutItem(ctx, "snapshot-table", item, false, ConditionInput{}); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := service.Snapshot(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dst := New(NewMemoryStorage("http://localhost:4566"))
+	if err := service.Restore(ctx, dst, data); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := dst.storage.GetItem(ctx, "snapshot-table", Item{"pk": {S: ptr("user#1")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got["age"].N == nil || *got["age"].N != "30" {
+		t.Errorf("expected age 30, got %+v", got["age"])
+	}
+
+	if len(got["tags"].SS) != 2 {
+		t.Errorf("expected 2 tags, got %+v", got["tags"])
+	}
+
+	restored, err := service.Snapshot(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(restored, data) {
+		t.Errorf("snapshot changed after restore:\ngot  %s\nwant %s", restored, data)
+	}
+}
@@ -1,9 +1,13 @@
 package dynamodb
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"maps"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -12,32 +16,34 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/sivchari/kumo/internal/service"
 	"github.com/sivchari/kumo/internal/storage"
 )
 
-const (
-	defaultRegion    = "us-east-1"
-	defaultAccountID = "000000000000"
-)
-
 // Storage defines the interface for DynamoDB storage operations.
 type Storage interface {
 	CreateTable(ctx context.Context, req *CreateTableRequest) (*Table, error)
 	DeleteTable(ctx context.Context, tableName string) (*Table, error)
 	ListTables(ctx context.Context, exclusiveStartTableName string, limit int) ([]string, string, error)
 	DescribeTable(ctx context.Context, tableName string) (*Table, error)
+	UpdateTable(ctx context.Context, req *UpdateTableRequest) (*Table, error)
 	PutItem(ctx context.Context, tableName string, item Item, returnOld bool, cond ConditionInput) (Item, error)
 	GetItem(ctx context.Context, tableName string, key Item) (Item, error)
 	DeleteItem(ctx context.Context, tableName string, key Item, returnOld bool, cond ConditionInput) (Item, error)
 	UpdateItem(ctx context.Context, tableName string, key Item, updateExpr string, exprNames map[string]string, exprValues map[string]AttributeValue, returnValues string, cond ConditionInput) (Item, error)
 	Query(ctx context.Context, tableName, indexName string, keyCondExpr string, filterExpr string, exprNames map[string]string, exprValues map[string]AttributeValue, limit int, exclusiveStartKey Item, scanForward bool) ([]Item, Item, int, error)
-	Scan(ctx context.Context, tableName string, filterExpr string, exprNames map[string]string, exprValues map[string]AttributeValue, limit int, exclusiveStartKey Item) ([]Item, Item, int, error)
+	Scan(ctx context.Context, req *ScanRequest) ([]Item, Item, int, error)
 	TransactWriteItems(ctx context.Context, items []TransactWriteItem) ([]CancellationReason, error)
 	TransactGetItems(ctx context.Context, items []TransactGetItem) ([]Item, error)
 	BatchWriteItem(ctx context.Context, requestItems map[string][]WriteRequest) (map[string][]WriteRequest, error)
 	BatchGetItem(ctx context.Context, requestItems map[string]KeysAndAttributes) (map[string][]Item, error)
 	UpdateTimeToLive(ctx context.Context, tableName, attributeName string, enabled bool) error
 	DescribeTimeToLive(ctx context.Context, tableName string) (string, bool, error)
+	ListStreams(ctx context.Context, tableName, exclusiveStartStreamARN string, limit int) ([]StreamSummary, string, error)
+	DescribeStream(ctx context.Context, streamARN string) (*StreamDescription, error)
+	GetShardIterator(ctx context.Context, streamARN, shardID, iteratorType, sequenceNumber string) (string, error)
+	GetStreamRecords(ctx context.Context, shardIterator string, limit int) ([]StreamRecord, string, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -58,11 +64,19 @@ var (
 
 // MemoryStorage implements Storage with in-memory data.
 type MemoryStorage struct {
-	mu      sync.RWMutex          `json:"-"`
-	Tables  map[string]*tableData `json:"tables"`
-	baseURL string
-	dataDir string
-	stopTTL chan struct{}
+	mu              sync.RWMutex                   `json:"-"`
+	Tables          map[string]*tableData          `json:"tables"`
+	Streams         map[string]*tableStream        `json:"streams"`
+	streamIterators map[string]*streamIteratorData `json:"-"`
+	// deleting holds the tables that are DELETING until the scheduler removes them.
+	deleting        map[string]*Table
+	baseURL         string
+	dataDir         string
+	stopTTL         chan struct{}
+	transitionDelay time.Duration
+	// transitions records when each table entered a status that the scheduler advances.
+	transitions   map[string]time.Time
+	stopScheduler chan struct{}
 }
 
 type tableData struct {
@@ -73,9 +87,15 @@ type tableData struct {
 // NewMemoryStorage creates a new in-memory DynamoDB storage.
 func NewMemoryStorage(baseURL string, opts ...Option) *MemoryStorage {
 	s := &MemoryStorage{
-		Tables:  make(map[string]*tableData),
-		baseURL: baseURL,
-		stopTTL: make(chan struct{}),
+		Tables:          make(map[string]*tableData),
+		Streams:         make(map[string]*tableStream),
+		streamIterators: make(map[string]*streamIteratorData),
+		deleting:        make(map[string]*Table),
+		baseURL:         baseURL,
+		stopTTL:         make(chan struct{}),
+		transitionDelay: defaultTableTransitionDelay,
+		transitions:     make(map[string]time.Time),
+		stopScheduler:   make(chan struct{}),
 	}
 	for _, o := range opts {
 		o(s)
@@ -86,6 +106,7 @@ func NewMemoryStorage(baseURL string, opts ...Option) *MemoryStorage {
 	}
 
 	go s.ttlReaper()
+	go s.tableScheduler()
 
 	return s
 }
@@ -138,6 +159,7 @@ func (m *MemoryStorage) deleteExpiredItems() {
 		}
 
 		for _, key := range keysToDelete {
+			m.recordStreamChange(td, td.Items[key], nil, ttlIdentity)
 			delete(td.Items, key)
 		}
 	}
@@ -175,11 +197,17 @@ func (m *MemoryStorage) UnmarshalJSON(data []byte) error {
 		m.Tables = make(map[string]*tableData)
 	}
 
+	if m.Streams == nil {
+		m.Streams = make(map[string]*tableStream)
+	}
+
 	return nil
 }
 
-// Close saves the storage state to disk if persistence is enabled.
+// Close stops the table scheduler and saves the storage state to disk if persistence is enabled.
 func (m *MemoryStorage) Close() error {
+	close(m.stopScheduler)
+
 	if m.dataDir == "" {
 		return nil
 	}
@@ -191,12 +219,26 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Tables = make(map[string]*tableData)
+	m.Streams = make(map[string]*tableStream)
+	m.streamIterators = make(map[string]*streamIteratorData)
+	m.deleting = make(map[string]*Table)
+	m.transitions = make(map[string]time.Time)
+
+	return nil
+}
+
 // CreateTable creates a new table.
-func (m *MemoryStorage) CreateTable(_ context.Context, req *CreateTableRequest) (*Table, error) {
+func (m *MemoryStorage) CreateTable(ctx context.Context, req *CreateTableRequest) (*Table, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if _, exists := m.Tables[req.TableName]; exists {
+	if _, exists := m.Tables[req.TableName]; exists || m.deleting[req.TableName] != nil {
 		return nil, &TableError{
 			Code:    "ResourceInUseException",
 			Message: fmt.Sprintf("Table already exists: %s", req.TableName),
@@ -205,7 +247,7 @@ func (m *MemoryStorage) CreateTable(_ context.Context, req *CreateTableRequest)
 
 	billingMode := req.BillingMode
 	if billingMode == "" {
-		billingMode = "PROVISIONED"
+		billingMode = BillingModeProvisioned
 	}
 
 	table := &Table{
@@ -216,20 +258,31 @@ func (m *MemoryStorage) CreateTable(_ context.Context, req *CreateTableRequest)
 		GlobalSecondaryIndexes: req.GlobalSecondaryIndexes,
 		LocalSecondaryIndexes:  req.LocalSecondaryIndexes,
 		CreationDateTime:       time.Now(),
-		TableStatus:            "ACTIVE",
+		TableStatus:            tableStatusCreating,
 		ItemCount:              0,
 		TableSizeBytes:         0,
-		TableARN:               fmt.Sprintf("arn:aws:dynamodb:%s:%s:table/%s", defaultRegion, defaultAccountID, req.TableName),
+		TableARN:               fmt.Sprintf("arn:aws:dynamodb:%s:%s:table/%s", service.Region(ctx), service.AccountID(ctx), req.TableName),
 		BillingMode:            billingMode,
 		DeletionProtection:     req.DeletionProtectionEnabled,
+		StreamSpecification:    req.StreamSpecification,
+		IndexStatuses:          make(map[string]string, len(req.GlobalSecondaryIndexes)),
+	}
+
+	for _, gsi := range req.GlobalSecondaryIndexes {
+		table.IndexStatuses[gsi.IndexName] = tableStatusCreating
+	}
+
+	if table.StreamSpecification != nil && table.StreamSpecification.StreamEnabled {
+		m.newTableStream(table)
 	}
 
 	m.Tables[req.TableName] = &tableData{
 		Table: table,
 		Items: make(map[string]Item),
 	}
+	m.startTransition(req.TableName)
 
-	return table, nil
+	return cloneTable(table), nil
 }
 
 // DeleteTable deletes a table.
@@ -237,6 +290,13 @@ func (m *MemoryStorage) DeleteTable(_ context.Context, tableName string) (*Table
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	if _, ok := m.deleting[tableName]; ok {
+		return nil, &TableError{
+			Code:    "ResourceInUseException",
+			Message: "Attempt to change a resource which is still in use: Table is being deleted: " + tableName,
+		}
+	}
+
 	td, exists := m.Tables[tableName]
 	if !exists {
 		return nil, &TableError{
@@ -245,12 +305,17 @@ func (m *MemoryStorage) DeleteTable(_ context.Context, tableName string) (*Table
 		}
 	}
 
+	// The table stops serving items at once, but is described and listed as
+	// DELETING until the scheduler removes it.
 	table := td.Table
-	table.TableStatus = "DELETING"
+	table.TableStatus = tableStatusDeleting
 
+	m.disableTableStream(table)
 	delete(m.Tables, tableName)
+	m.deleting[tableName] = table
+	m.startTransition(tableName)
 
-	return table, nil
+	return cloneTable(table), nil
 }
 
 // ListTables lists all tables.
@@ -262,11 +327,15 @@ func (m *MemoryStorage) ListTables(_ context.Context, exclusiveStartTableName st
 		limit = 100
 	}
 
-	names := make([]string, 0, len(m.Tables))
+	names := make([]string, 0, len(m.Tables)+len(m.deleting))
 	for name := range m.Tables {
 		names = append(names, name)
 	}
 
+	for name := range m.deleting {
+		names = append(names, name)
+	}
+
 	sort.Strings(names)
 
 	// Apply exclusive start.
@@ -298,11 +367,15 @@ func (m *MemoryStorage) ListTables(_ context.Context, exclusiveStartTableName st
 	return result, lastEvaluated, nil
 }
 
-// DescribeTable describes a table.
+// DescribeTable describes a table, including one that is DELETING.
 func (m *MemoryStorage) DescribeTable(_ context.Context, tableName string) (*Table, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
+	if table, ok := m.deleting[tableName]; ok {
+		return cloneTable(table), nil
+	}
+
 	td, exists := m.Tables[tableName]
 	if !exists {
 		return nil, &TableError{
@@ -311,10 +384,268 @@ func (m *MemoryStorage) DescribeTable(_ context.Context, tableName string) (*Tab
 		}
 	}
 
-	// Update item count.
-	td.Table.ItemCount = int64(len(td.Items))
+	table := cloneTable(td.Table)
+	table.ItemCount = int64(len(td.Items))
+	table.TableSizeBytes = 0
+
+	for _, item := range td.Items {
+		table.TableSizeBytes += int64(itemSize(item))
+	}
+
+	return table, nil
+}
+
+// UpdateTable changes the billing mode, provisioned throughput, GSIs, stream or
+// deletion protection of an ACTIVE table. Changes to the throughput or GSIs leave
+// the table UPDATING, and the indexes CREATING, UPDATING or DELETING, until the
+// scheduler advances them.
+func (m *MemoryStorage) UpdateTable(_ context.Context, req *UpdateTableRequest) (*Table, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.deleting[req.TableName]; ok {
+		return nil, &TableError{
+			Code:    "ResourceInUseException",
+			Message: "Attempt to change a resource which is still in use: Table is being deleted: " + req.TableName,
+		}
+	}
+
+	td, exists := m.Tables[req.TableName]
+	if !exists {
+		return nil, &TableError{
+			Code:    "ResourceNotFoundException",
+			Message: fmt.Sprintf("Requested resource not found: Table: %s not found", req.TableName),
+		}
+	}
+
+	table := td.Table
+	if tablePending(table) {
+		return nil, &TableError{
+			Code:    "ResourceInUseException",
+			Message: fmt.Sprintf("Attempt to change a resource which is still in use: Table is being %s: %s", tableStatusVerb(table.TableStatus), table.Name),
+		}
+	}
+
+	if err := validateTableUpdate(table, req); err != nil {
+		return nil, err
+	}
+
+	updating := false
+
+	if req.BillingMode != "" && req.BillingMode != table.BillingMode {
+		table.BillingMode = req.BillingMode
+		updating = true
+
+		if req.BillingMode == BillingModePayPerRequest {
+			table.ProvisionedThroughput = nil
+		}
+	}
+
+	if req.ProvisionedThroughput != nil {
+		throughput := *req.ProvisionedThroughput
+		table.ProvisionedThroughput = &throughput
+		updating = true
+	}
+
+	table.AttributeDefinitions = mergeAttributeDefinitions(table.AttributeDefinitions, req.AttributeDefinitions)
+
+	if table.IndexStatuses == nil {
+		table.IndexStatuses = make(map[string]string)
+	}
+
+	for _, update := range req.GlobalSecondaryIndexUpdates {
+		updating = true
+
+		switch {
+		case update.Create != nil:
+			table.GlobalSecondaryIndexes = append(table.GlobalSecondaryIndexes, *update.Create)
+			table.IndexStatuses[update.Create.IndexName] = tableStatusCreating
+		case update.Update != nil:
+			i := gsiIndex(table, update.Update.IndexName)
+			throughput := *update.Update.ProvisionedThroughput
+			table.GlobalSecondaryIndexes[i].ProvisionedThroughput = &throughput
+			table.IndexStatuses[update.Update.IndexName] = tableStatusUpdating
+		case update.Delete != nil:
+			table.IndexStatuses[update.Delete.IndexName] = tableStatusDeleting
+		}
+	}
+
+	if spec := req.StreamSpecification; spec != nil {
+		if spec.StreamEnabled {
+			table.StreamSpecification = spec
+			m.newTableStream(table)
+		} else {
+			m.disableTableStream(table)
+			table.StreamSpecification = spec
+		}
+	}
+
+	if req.DeletionProtectionEnabled != nil {
+		table.DeletionProtection = *req.DeletionProtectionEnabled
+	}
+
+	if updating {
+		table.TableStatus = tableStatusUpdating
+		m.startTransition(table.Name)
+	}
+
+	return cloneTable(table), nil
+}
+
+// validateTableUpdate checks that an UpdateTable request can be applied to the table.
+//
+//nolint:gocognit,cyclop,funlen // Each parameter of UpdateTable has its own checks.
+func validateTableUpdate(table *Table, req *UpdateTableRequest) error {
+	invalid := func(format string, args ...any) error {
+		return &TableError{Code: "ValidationException", Message: "One or more parameter values were invalid: " + fmt.Sprintf(format, args...)}
+	}
+
+	if req.BillingMode == "" && req.ProvisionedThroughput == nil && len(req.GlobalSecondaryIndexUpdates) == 0 &&
+		req.StreamSpecification == nil && req.DeletionProtectionEnabled == nil {
+		return &TableError{
+			Code:    "ValidationException",
+			Message: "At least one of ProvisionedThroughput, BillingMode, UpdateStreamEnabled, GlobalSecondaryIndexUpdates or SSESpecification or ReplicaUpdates or DeletionProtectionEnabled is required",
+		}
+	}
+
+	billingMode := table.BillingMode
+	if req.BillingMode != "" {
+		billingMode = req.BillingMode
+	}
+
+	switch {
+	case billingMode == BillingModePayPerRequest && req.ProvisionedThroughput != nil:
+		return invalid("Neither ReadCapacityUnits nor WriteCapacityUnits can be specified when BillingMode is PAY_PER_REQUEST")
+	case billingMode != table.BillingMode && billingMode != BillingModePayPerRequest && req.ProvisionedThroughput == nil:
+		return &TableError{Code: "ValidationException", Message: "ProvisionedThroughput must be specified when BillingMode is PROVISIONED"}
+	}
+
+	if current, requested := table.ProvisionedThroughput, req.ProvisionedThroughput; current != nil && requested != nil && *current == *requested &&
+		billingMode == table.BillingMode {
+		return &TableError{
+			Code: "ValidationException",
+			Message: fmt.Sprintf("The provisioned throughput for the table will not change. The requested value equals the current value. "+
+				"Current ReadCapacityUnits provisioned for the table: %d. Requested ReadCapacityUnits: %d. "+
+				"Current WriteCapacityUnits provisioned for the table: %d. Requested WriteCapacityUnits: %d. "+
+				"Refer to the Amazon DynamoDB Developer Guide for current limits and how to request higher limits.",
+				current.ReadCapacityUnits, requested.ReadCapacityUnits, current.WriteCapacityUnits, requested.WriteCapacityUnits),
+		}
+	}
+
+	onlineChanges := 0
+	definitions := mergeAttributeDefinitions(table.AttributeDefinitions, req.AttributeDefinitions)
+
+	for _, update := range req.GlobalSecondaryIndexUpdates {
+		switch {
+		case update.Create != nil:
+			onlineChanges++
+
+			gsi := update.Create
+			if gsiIndex(table, gsi.IndexName) >= 0 || slices.ContainsFunc(table.LocalSecondaryIndexes, func(lsi LocalSecondaryIndex) bool {
+				return lsi.IndexName == gsi.IndexName
+			}) {
+				return invalid("Table already has an index named %s", gsi.IndexName)
+			}
+
+			for _, key := range gsi.KeySchema {
+				if !slices.ContainsFunc(definitions, func(def AttributeDefinition) bool { return def.AttributeName == key.AttributeName }) {
+					return invalid("Some index key attributes are not defined in AttributeDefinitions. Keys: [%s], AttributeDefinitions: [%s]",
+						key.AttributeName, attributeDefinitionNames(definitions))
+				}
+			}
+
+			if billingMode != BillingModePayPerRequest && gsi.ProvisionedThroughput == nil {
+				return invalid("ProvisionedThroughput should not be null for index: %s", gsi.IndexName)
+			}
+		case update.Update != nil:
+			if gsiIndex(table, update.Update.IndexName) < 0 {
+				return &TableError{Code: "ResourceNotFoundException", Message: "Requested resource not found: Index: " + update.Update.IndexName}
+			}
+
+			if update.Update.ProvisionedThroughput == nil {
+				return invalid("ProvisionedThroughput should not be null for index: %s", update.Update.IndexName)
+			}
+		case update.Delete != nil:
+			onlineChanges++
+
+			if gsiIndex(table, update.Delete.IndexName) < 0 {
+				return &TableError{Code: "ResourceNotFoundException", Message: "Requested resource not found: Index: " + update.Delete.IndexName}
+			}
+		default:
+			return &TableError{Code: "ValidationException", Message: "One of Create, Update or Delete is required in a GlobalSecondaryIndexUpdate"}
+		}
+	}
+
+	if onlineChanges > 1 {
+		return &TableError{
+			Code:    "LimitExceededException",
+			Message: "Subscriber limit exceeded: Only 1 online index can be created or deleted simultaneously per table",
+		}
+	}
+
+	if spec := req.StreamSpecification; spec != nil {
+		enabled := table.StreamSpecification != nil && table.StreamSpecification.StreamEnabled
+
+		switch {
+		case spec.StreamEnabled && enabled:
+			return &TableError{Code: "ValidationException", Message: "Table already has an enabled stream: " + table.TableARN}
+		case !spec.StreamEnabled && !enabled:
+			return &TableError{Code: "ValidationException", Message: "Table already has no stream enabled: " + table.TableARN}
+		}
+	}
+
+	return nil
+}
+
+// gsiIndex returns the position of the named GSI in the table, or -1 if it has none.
+func gsiIndex(table *Table, name string) int {
+	return slices.IndexFunc(table.GlobalSecondaryIndexes, func(gsi GlobalSecondaryIndex) bool {
+		return gsi.IndexName == name
+	})
+}
+
+// mergeAttributeDefinitions adds the definitions of attributes that are not yet defined.
+func mergeAttributeDefinitions(defs, added []AttributeDefinition) []AttributeDefinition {
+	merged := slices.Clone(defs)
+
+	for _, def := range added {
+		if !slices.ContainsFunc(merged, func(d AttributeDefinition) bool { return d.AttributeName == def.AttributeName }) {
+			merged = append(merged, def)
+		}
+	}
+
+	return merged
+}
+
+// attributeDefinitionNames returns the comma-separated names of attribute definitions.
+func attributeDefinitionNames(defs []AttributeDefinition) string {
+	names := make([]string, len(defs))
+	for i, def := range defs {
+		names[i] = def.AttributeName
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// tableStatusVerb returns the verb of ResourceInUseException messages for a table status.
+func tableStatusVerb(status string) string {
+	switch status {
+	case tableStatusCreating:
+		return "created"
+	case tableStatusDeleting:
+		return "deleted"
+	default:
+		return "updated"
+	}
+}
+
+// cloneTable returns a copy of a table that the scheduler does not change.
+func cloneTable(table *Table) *Table {
+	clone := *table
+	clone.GlobalSecondaryIndexes = slices.Clone(table.GlobalSecondaryIndexes)
+	clone.IndexStatuses = maps.Clone(table.IndexStatuses)
 
-	return td.Table, nil
+	return &clone
 }
 
 // PutItem puts an item into a table.
@@ -357,6 +688,7 @@ func (m *MemoryStorage) PutItem(_ context.Context, tableName string, item Item,
 	}
 
 	td.Items[key] = m.copyItem(item)
+	m.recordStreamChange(td, existingItem, item, nil)
 
 	return oldItem, nil
 }
@@ -423,6 +755,7 @@ func (m *MemoryStorage) DeleteItem(_ context.Context, tableName string, key Item
 			oldItem = m.copyItem(existingItem)
 		}
 
+		m.recordStreamChange(td, existingItem, nil, nil)
 		delete(td.Items, keyStr)
 	}
 
@@ -477,6 +810,7 @@ func (m *MemoryStorage) UpdateItem(_ context.Context, tableName string, key Item
 	}
 
 	td.Items[keyStr] = item
+	m.recordStreamChange(td, oldItem, item, nil)
 
 	// Return based on returnValues.
 	switch returnValues {
@@ -484,19 +818,48 @@ func (m *MemoryStorage) UpdateItem(_ context.Context, tableName string, key Item
 		return oldItem, nil
 	case ReturnValuesAllNew:
 		return m.copyItem(item), nil
-	case ReturnValuesUpdatedOld, ReturnValuesUpdatedNew:
-		// Simplified: return all attributes.
-		if returnValues == ReturnValuesUpdatedOld {
-			return oldItem, nil
-		}
-
-		return m.copyItem(item), nil
+	case ReturnValuesUpdatedOld:
+		return m.copyItem(updatedAttributes(oldItem, item)), nil
+	case ReturnValuesUpdatedNew:
+		return m.copyItem(updatedAttributes(item, oldItem)), nil
 	default:
 		//nolint:nilnil // DynamoDB returns nil when ReturnValues is NONE (valid behavior).
 		return nil, nil
 	}
 }
 
+// updatedAttributes returns the top-level attributes of from whose values
+// differ in to, which is what UPDATED_OLD and UPDATED_NEW report.
+//
+//nolint:gocritic // rangeValCopy: AttributeValue is compared by value.
+func updatedAttributes(from, to Item) Item {
+	var result Item
+
+	for name, av := range from {
+		if other, ok := to[name]; ok && sameAttributeValue(av, other) {
+			continue
+		}
+
+		if result == nil {
+			result = make(Item)
+		}
+
+		result[name] = av
+	}
+
+	return result
+}
+
+// sameAttributeValue reports whether two attribute values are deeply equal.
+//
+//nolint:gocritic // hugeParam: AttributeValue passed by value for comparison.
+func sameAttributeValue(a, b AttributeValue) bool {
+	aJSON, errA := json.Marshal(a)
+	bJSON, errB := json.Marshal(b)
+
+	return errA == nil && errB == nil && bytes.Equal(aJSON, bJSON)
+}
+
 // resolveKeySchema returns the key schema for the given index name.
 // If indexName is empty, the table's key schema is returned.
 // It searches both GSIs and LSIs.
@@ -662,75 +1025,81 @@ func (m *MemoryStorage) Query(_ context.Context, tableName, indexName, keyCondEx
 	return results, lastEvaluatedKey, scannedCount, nil
 }
 
-// Scan scans items from a table.
-//
-//nolint:funlen // Scan requires pagination logic that exceeds line limit.
-func (m *MemoryStorage) Scan(_ context.Context, tableName, filterExpr string, exprNames map[string]string, exprValues map[string]AttributeValue, limit int, exclusiveStartKey Item) ([]Item, Item, int, error) {
+// Scan scans items from a table, or from the segment of a parallel scan, in key order.
+// Limit bounds the number of items evaluated before the filter expression is applied,
+// and the last evaluated key is returned if the scan stopped before the end.
+func (m *MemoryStorage) Scan(_ context.Context, req *ScanRequest) ([]Item, Item, int, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	td, exists := m.Tables[tableName]
+	td, exists := m.Tables[req.TableName]
 	if !exists {
 		return nil, nil, 0, &TableError{
 			Code:    "ResourceNotFoundException",
-			Message: fmt.Sprintf("Requested resource not found: Table: %s not found", tableName),
+			Message: fmt.Sprintf("Requested resource not found: Table: %s not found", req.TableName),
 		}
 	}
 
-	// Collect all items.
-	var results []Item
+	type keyedItem struct {
+		key  string
+		item Item
+	}
 
-	scannedCount := 0
+	candidates := make([]keyedItem, 0, len(td.Items))
 
 	for _, item := range td.Items {
-		scannedCount++
-
-		// Apply filter expression.
-		if filterExpr != "" && !m.evaluateFilterExpression(item, filterExpr, exprNames, exprValues) {
+		if req.TotalSegments != nil && req.Segment != nil && m.scanSegment(td.Table, item, *req.TotalSegments) != *req.Segment {
 			continue
 		}
 
-		results = append(results, m.copyItem(item))
+		candidates = append(candidates, keyedItem{key: m.serializeKey(td.Table, item), item: item})
 	}
 
 	// Sort by key for consistent pagination.
-	sort.Slice(results, func(i, j int) bool {
-		keyI := m.serializeKey(td.Table, results[i])
-		keyJ := m.serializeKey(td.Table, results[j])
-
-		return keyI < keyJ
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].key < candidates[j].key
 	})
 
-	// Apply pagination.
-	startIdx := 0
+	if req.ExclusiveStartKey != nil {
+		startKey := m.serializeKey(td.Table, req.ExclusiveStartKey)
+		startIdx := sort.Search(len(candidates), func(i int) bool {
+			return candidates[i].key > startKey
+		})
+		candidates = candidates[startIdx:]
+	}
 
-	if exclusiveStartKey != nil {
-		startKeyStr := m.serializeKey(td.Table, exclusiveStartKey)
+	var lastEvaluatedKey Item
 
-		for i, item := range results {
-			itemKeyStr := m.serializeKey(td.Table, item)
-			if itemKeyStr == startKeyStr {
-				startIdx = i + 1
+	if req.Limit > 0 && len(candidates) > req.Limit {
+		candidates = candidates[:req.Limit]
+		lastEvaluatedKey = m.extractKey(td.Table, candidates[len(candidates)-1].item)
+	}
 
-				break
-			}
+	results := make([]Item, 0, len(candidates))
+
+	for _, c := range candidates {
+		if req.FilterExpression != "" && !m.evaluateFilterExpression(c.item, req.FilterExpression, req.ExpressionAttributeNames, req.ExpressionAttributeValues) {
+			continue
 		}
-	}
 
-	if startIdx >= len(results) {
-		return []Item{}, nil, scannedCount, nil
+		results = append(results, m.copyItem(c.item))
 	}
 
-	results = results[startIdx:]
+	return results, lastEvaluatedKey, len(candidates), nil
+}
 
-	var lastEvaluatedKey Item
+// scanSegment returns the segment of a parallel scan with totalSegments segments that an
+// item belongs to. Items with the same partition key are in the same segment.
+func (m *MemoryStorage) scanSegment(table *Table, item Item, totalSegments int) int {
+	h := fnv.New32a()
 
-	if limit > 0 && len(results) > limit {
-		results = results[:limit]
-		lastEvaluatedKey = m.extractKey(td.Table, results[len(results)-1])
+	for _, ks := range table.KeySchema {
+		if ks.KeyType == "HASH" {
+			_, _ = h.Write([]byte(m.serializeAttributeValue(item[ks.AttributeName])))
+		}
 	}
 
-	return results, lastEvaluatedKey, scannedCount, nil
+	return int(h.Sum32() % uint32(totalSegments)) //nolint:gosec // totalSegments is validated to be between 1 and 1000000
 }
 
 // serializeKey creates a string key from the primary key attributes.
@@ -1498,17 +1867,21 @@ func (m *MemoryStorage) applyTransactWriteItem(twi TransactWriteItem) {
 	case twi.Put != nil:
 		td := m.Tables[twi.Put.TableName]
 		key := m.serializeKey(td.Table, twi.Put.Item)
+		m.recordStreamChange(td, td.Items[key], twi.Put.Item, nil)
 		td.Items[key] = m.copyItem(twi.Put.Item)
 
 	case twi.Delete != nil:
 		td := m.Tables[twi.Delete.TableName]
 		key := m.serializeKey(td.Table, twi.Delete.Key)
+		m.recordStreamChange(td, td.Items[key], nil, nil)
 		delete(td.Items, key)
 
 	case twi.Update != nil:
 		td := m.Tables[twi.Update.TableName]
 		key := m.serializeKey(td.Table, twi.Update.Key)
 
+		oldItem := m.copyItem(td.Items[key])
+
 		item, ok := td.Items[key]
 		if !ok {
 			item = m.copyItem(twi.Update.Key)
@@ -1519,6 +1892,7 @@ func (m *MemoryStorage) applyTransactWriteItem(twi TransactWriteItem) {
 		}
 
 		td.Items[key] = item
+		m.recordStreamChange(td, oldItem, item, nil)
 	case twi.ConditionCheck != nil:
 	}
 }
@@ -1570,9 +1944,11 @@ func (m *MemoryStorage) BatchWriteItem(_ context.Context, requestItems map[strin
 			switch {
 			case req.PutRequest != nil:
 				key := m.serializeKey(td.Table, req.PutRequest.Item)
+				m.recordStreamChange(td, td.Items[key], req.PutRequest.Item, nil)
 				td.Items[key] = m.copyItem(req.PutRequest.Item)
 			case req.DeleteRequest != nil:
 				key := m.serializeKey(td.Table, req.DeleteRequest.Key)
+				m.recordStreamChange(td, td.Items[key], nil, nil)
 				delete(td.Items, key)
 			}
 		}
@@ -0,0 +1,105 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+//nolint:funlen // Test function exercises multiple Scan scenarios.
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	s := NewMemoryStorage("http://localhost:4566")
+	ctx := context.Background()
+
+	_, err := s.CreateTable(ctx, &CreateTableRequest{
+		TableName: "test-scan",
+		KeySchema: []KeySchemaElement{
+			{AttributeName: "PK", KeyType: "HASH"},
+		},
+		AttributeDefinitions: []AttributeDefinition{
+			{AttributeName: "PK", AttributeType: "S"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range 10 {
+		item := Item{"PK": {S: ptr(fmt.Sprintf("item%02d", i))}, "n": {N: ptr(fmt.Sprintf("%d", i))}}
+		if _, err := s.PutItem(ctx, "test-scan", item, false, ConditionInput{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("Limit bounds the items evaluated before filtering", func(t *testing.T) {
+		t.Parallel()
+
+		results, lastKey, scanned, err := s.Scan(ctx, &ScanRequest{
+			TableName:                 "test-scan",
+			FilterExpression:          "n >= :min",
+			ExpressionAttributeValues: map[string]AttributeValue{":min": {N: ptr("3")}},
+			Limit:                     4,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(results) != 1 || scanned != 4 {
+			t.Errorf("expected 1 result of 4 scanned, got %d of %d", len(results), scanned)
+		}
+
+		if lastKey == nil || *lastKey["PK"].S != "item03" {
+			t.Errorf("expected last evaluated key item03, got %+v", lastKey)
+		}
+	})
+
+	t.Run("pages cover every item once", func(t *testing.T) {
+		t.Parallel()
+
+		seen := make(map[string]bool)
+
+		var startKey Item
+
+		for {
+			results, lastKey, _, err := s.Scan(ctx, &ScanRequest{TableName: "test-scan", Limit: 3, ExclusiveStartKey: startKey})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, item := range results {
+				seen[*item["PK"].S] = true
+			}
+
+			if lastKey == nil {
+				break
+			}
+
+			startKey = lastKey
+		}
+
+		if len(seen) != 10 {
+			t.Errorf("expected 10 items, got %d", len(seen))
+		}
+	})
+
+	t.Run("segments partition the table", func(t *testing.T) {
+		t.Parallel()
+
+		total := 0
+
+		for segment := range 3 {
+			results, _, _, err := s.Scan(ctx, &ScanRequest{TableName: "test-scan", Segment: ptr(segment), TotalSegments: ptr(3)})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			total += len(results)
+		}
+
+		if total != 10 {
+			t.Errorf("expected 10 items across segments, got %d", total)
+		}
+	})
+}
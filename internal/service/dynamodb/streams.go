@@ -0,0 +1,447 @@
+package dynamodb
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// Stream event names.
+const (
+	streamEventInsert = "INSERT"
+	streamEventModify = "MODIFY"
+	streamEventRemove = "REMOVE"
+)
+
+const (
+	// streamRetention is how long stream records are kept.
+	streamRetention = 24 * time.Hour
+	// streamIteratorExpiration is how long a shard iterator stays valid.
+	streamIteratorExpiration = 15 * time.Minute
+	// maxStreamRecordsPerGet is the maximum number of records returned by GetRecords.
+	maxStreamRecordsPerGet = 1000
+	// streamLabelFormat is the format of stream labels, the creation time of the stream.
+	streamLabelFormat = "2006-01-02T15:04:05.000"
+)
+
+// ttlIdentity is the user identity of records for items deleted by Time to Live.
+var ttlIdentity = &StreamIdentity{PrincipalID: "dynamodb.amazonaws.com", Type: "Service"}
+
+// tableStream holds the records of a table's stream. A stream has a single shard,
+// which is closed when the table is deleted.
+type tableStream struct {
+	ARN          string             `json:"arn"`
+	Label        string             `json:"label"`
+	TableName    string             `json:"tableName"`
+	KeySchema    []KeySchemaElement `json:"keySchema"`
+	ViewType     string             `json:"viewType"`
+	Enabled      bool               `json:"enabled"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	ShardID      string             `json:"shardId"`
+	Records      []StreamRecord     `json:"records"`
+	LastSequence int64              `json:"lastSequence"`
+}
+
+// streamIteratorData holds shard iterator state. position is the sequence number of
+// the next record to read.
+type streamIteratorData struct {
+	streamARN string
+	position  int64
+	expiresAt time.Time
+}
+
+// validStreamViewTypes are the supported StreamViewType values.
+var validStreamViewTypes = []string{
+	StreamViewTypeKeysOnly, StreamViewTypeNewImage, StreamViewTypeOldImage, StreamViewTypeNewAndOldImages,
+}
+
+// validateStreamSpecification checks the StreamSpecification of a table.
+func validateStreamSpecification(spec *StreamSpecification) string {
+	if spec == nil {
+		return ""
+	}
+
+	if spec.StreamEnabled && !slices.Contains(validStreamViewTypes, spec.StreamViewType) {
+		return "1 validation error detected: Value '" + spec.StreamViewType +
+			"' at 'streamSpecification.streamViewType' failed to satisfy constraint: " +
+			"Member must satisfy enum value set: [NEW_IMAGE, OLD_IMAGE, NEW_AND_OLD_IMAGES, KEYS_ONLY]"
+	}
+
+	if !spec.StreamEnabled && spec.StreamViewType != "" {
+		return "StreamSpecification: StreamViewType cannot be set when StreamEnabled is false"
+	}
+
+	return ""
+}
+
+// newTableStream creates the stream of a table. Must be called under lock.
+func (m *MemoryStorage) newTableStream(table *Table) {
+	now := time.Now().UTC()
+	label := now.Format(streamLabelFormat)
+
+	stream := &tableStream{
+		ARN:       fmt.Sprintf("%s/stream/%s", table.TableARN, label),
+		Label:     label,
+		TableName: table.Name,
+		KeySchema: table.KeySchema,
+		ViewType:  table.StreamSpecification.StreamViewType,
+		Enabled:   true,
+		CreatedAt: now,
+		ShardID:   fmt.Sprintf("shardId-%020d-%s", now.UnixMilli(), uuid.New().String()[:8]),
+	}
+
+	m.Streams[stream.ARN] = stream
+	table.LatestStreamARN = stream.ARN
+	table.LatestStreamLabel = stream.Label
+}
+
+// disableTableStream closes the stream of a deleted table. Its records stay readable
+// until they expire. Must be called under lock.
+func (m *MemoryStorage) disableTableStream(table *Table) {
+	if stream, ok := m.Streams[table.LatestStreamARN]; ok {
+		stream.Enabled = false
+	}
+}
+
+// recordStreamChange appends a record of a change to an item to the table's stream.
+// oldItem is nil for an inserted item and newItem is nil for a removed item. Must be
+// called under lock.
+func (m *MemoryStorage) recordStreamChange(td *tableData, oldItem, newItem Item, identity *StreamIdentity) {
+	stream, ok := m.Streams[td.Table.LatestStreamARN]
+	if !ok || !stream.Enabled {
+		return
+	}
+
+	var eventName string
+
+	switch {
+	case oldItem == nil && newItem == nil:
+		return
+	case oldItem == nil:
+		eventName = streamEventInsert
+	case newItem == nil:
+		eventName = streamEventRemove
+	default:
+		// Writes that leave an item unchanged are not recorded.
+		if reflect.DeepEqual(oldItem, newItem) {
+			return
+		}
+
+		eventName = streamEventModify
+	}
+
+	keySource := newItem
+	if keySource == nil {
+		keySource = oldItem
+	}
+
+	data := StreamRecordData{
+		ApproximateCreationDateTime: float64(time.Now().Unix()),
+		Keys:                        m.copyItem(m.extractKey(td.Table, keySource)),
+		StreamViewType:              stream.ViewType,
+	}
+
+	if stream.ViewType == StreamViewTypeNewImage || stream.ViewType == StreamViewTypeNewAndOldImages {
+		data.NewImage = m.copyItem(newItem)
+	}
+
+	if stream.ViewType == StreamViewTypeOldImage || stream.ViewType == StreamViewTypeNewAndOldImages {
+		data.OldImage = m.copyItem(oldItem)
+	}
+
+	stream.LastSequence++
+	data.SequenceNumber = formatStreamSequence(stream.LastSequence)
+	data.SizeBytes = streamRecordSize(data)
+
+	stream.Records = append(stream.Records, StreamRecord{
+		EventID:      strings.ReplaceAll(uuid.New().String(), "-", ""),
+		EventName:    eventName,
+		EventVersion: "1.1",
+		EventSource:  "aws:dynamodb",
+		AWSRegion:    arnRegion(stream.ARN),
+		DynamoDB:     data,
+		UserIdentity: identity,
+	})
+
+	stream.trim(time.Now())
+}
+
+// arnRegion returns the region field of an ARN.
+func arnRegion(arn string) string {
+	parts := strings.SplitN(arn, ":", 5)
+	if len(parts) < 5 || parts[3] == "" {
+		return service.DefaultRegion
+	}
+
+	return parts[3]
+}
+
+// trim removes the records that are older than the retention period.
+func (s *tableStream) trim(now time.Time) {
+	cutoff := float64(now.Add(-streamRetention).Unix())
+
+	i := sort.Search(len(s.Records), func(i int) bool {
+		return s.Records[i].DynamoDB.ApproximateCreationDateTime >= cutoff
+	})
+
+	s.Records = s.Records[i:]
+}
+
+// trimHorizon returns the sequence number of the oldest record in the shard.
+func (s *tableStream) trimHorizon() int64 {
+	if len(s.Records) == 0 {
+		return s.LastSequence + 1
+	}
+
+	seq, _ := strconv.ParseInt(s.Records[0].DynamoDB.SequenceNumber, 10, 64)
+
+	return seq
+}
+
+// description returns the stream in DescribeStream responses.
+func (s *tableStream) description() *StreamDescription {
+	status := "ENABLED"
+	shardRange := SequenceNumberRange{StartingSequenceNumber: formatStreamSequence(1)}
+
+	if !s.Enabled {
+		status = "DISABLED"
+
+		if s.LastSequence > 0 {
+			shardRange.EndingSequenceNumber = formatStreamSequence(s.LastSequence)
+		}
+	}
+
+	return &StreamDescription{
+		StreamARN:               s.ARN,
+		StreamLabel:             s.Label,
+		StreamStatus:            status,
+		StreamViewType:          s.ViewType,
+		CreationRequestDateTime: float64(s.CreatedAt.Unix()),
+		TableName:               s.TableName,
+		KeySchema:               s.KeySchema,
+		Shards:                  []StreamShard{{ShardID: s.ShardID, SequenceNumberRange: shardRange}},
+	}
+}
+
+// formatStreamSequence returns a sequence number as a fixed-width string, so that
+// sequence numbers sort in order.
+func formatStreamSequence(seq int64) string {
+	return fmt.Sprintf("%021d", seq)
+}
+
+// streamRecordSize returns the approximate size of the keys and images of a record.
+func streamRecordSize(data StreamRecordData) int64 {
+	var size int64
+
+	for _, item := range []Item{data.Keys, data.NewImage, data.OldImage} {
+		if item == nil {
+			continue
+		}
+
+		b, err := json.Marshal(item)
+		if err == nil {
+			size += int64(len(b))
+		}
+	}
+
+	return size
+}
+
+// ListStreams lists the streams of all tables, or of a table if tableName is set.
+func (m *MemoryStorage) ListStreams(_ context.Context, tableName, exclusiveStartStreamARN string, limit int) ([]StreamSummary, string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if limit <= 0 {
+		limit = 100
+	}
+
+	arns := make([]string, 0, len(m.Streams))
+
+	for arn, stream := range m.Streams {
+		if tableName == "" || stream.TableName == tableName {
+			arns = append(arns, arn)
+		}
+	}
+
+	sort.Strings(arns)
+
+	if exclusiveStartStreamARN != "" {
+		i := sort.SearchStrings(arns, exclusiveStartStreamARN)
+		if i < len(arns) && arns[i] == exclusiveStartStreamARN {
+			i++
+		}
+
+		arns = arns[i:]
+	}
+
+	var lastEvaluated string
+
+	if len(arns) > limit {
+		arns = arns[:limit]
+		lastEvaluated = arns[limit-1]
+	}
+
+	streams := make([]StreamSummary, 0, len(arns))
+
+	for _, arn := range arns {
+		stream := m.Streams[arn]
+		streams = append(streams, StreamSummary{StreamARN: arn, StreamLabel: stream.Label, TableName: stream.TableName})
+	}
+
+	return streams, lastEvaluated, nil
+}
+
+// DescribeStream returns a stream and its shard.
+func (m *MemoryStorage) DescribeStream(_ context.Context, streamARN string) (*StreamDescription, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	stream, ok := m.Streams[streamARN]
+	if !ok {
+		return nil, streamNotFoundError(streamARN)
+	}
+
+	return stream.description(), nil
+}
+
+// GetShardIterator returns an iterator for reading the records of a shard.
+func (m *MemoryStorage) GetShardIterator(_ context.Context, streamARN, shardID, iteratorType, sequenceNumber string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stream, ok := m.Streams[streamARN]
+	if !ok {
+		return "", streamNotFoundError(streamARN)
+	}
+
+	if shardID != stream.ShardID {
+		return "", &TableError{
+			Code:    "ResourceNotFoundException",
+			Message: fmt.Sprintf("Requested resource not found: Shard does not exist: %s", shardID),
+		}
+	}
+
+	var position int64
+
+	switch iteratorType {
+	case ShardIteratorTypeTrimHorizon:
+		position = stream.trimHorizon()
+	case ShardIteratorTypeLatest:
+		position = stream.LastSequence + 1
+	case ShardIteratorTypeAtSequenceNumber, ShardIteratorTypeAfterSequenceNumber:
+		seq, err := strconv.ParseInt(sequenceNumber, 10, 64)
+		if err != nil || seq <= 0 || seq > stream.LastSequence {
+			return "", &TableError{
+				Code:    "ValidationException",
+				Message: fmt.Sprintf("Invalid SequenceNumber: %s", sequenceNumber),
+			}
+		}
+
+		if seq < stream.trimHorizon() {
+			return "", &TableError{
+				Code:    "TrimmedDataAccessException",
+				Message: "The operation attempted to read past the oldest stream record in a shard.",
+			}
+		}
+
+		position = seq
+		if iteratorType == ShardIteratorTypeAfterSequenceNumber {
+			position++
+		}
+	default:
+		return "", &TableError{
+			Code:    "ValidationException",
+			Message: fmt.Sprintf("Invalid ShardIteratorType: %s", iteratorType),
+		}
+	}
+
+	return m.newStreamIterator(stream, position), nil
+}
+
+// GetStreamRecords returns the records of a shard from a shard iterator, and the
+// iterator for the next records. The next iterator is empty once a closed shard has
+// been read to its end.
+func (m *MemoryStorage) GetStreamRecords(_ context.Context, shardIterator string, limit int) ([]StreamRecord, string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	iter, ok := m.streamIterators[shardIterator]
+	if !ok {
+		return nil, "", &TableError{Code: "ValidationException", Message: "Invalid ShardIterator"}
+	}
+
+	delete(m.streamIterators, shardIterator)
+
+	if time.Now().After(iter.expiresAt) {
+		return nil, "", &TableError{
+			Code:    "ExpiredIteratorException",
+			Message: "Iterator expired. The iterator was created more than 15 minutes ago.",
+		}
+	}
+
+	stream, ok := m.Streams[iter.streamARN]
+	if !ok {
+		return nil, "", streamNotFoundError(iter.streamARN)
+	}
+
+	if iter.position < stream.trimHorizon() {
+		return nil, "", &TableError{
+			Code:    "TrimmedDataAccessException",
+			Message: "The operation attempted to read past the oldest stream record in a shard.",
+		}
+	}
+
+	if limit <= 0 || limit > maxStreamRecordsPerGet {
+		limit = maxStreamRecordsPerGet
+	}
+
+	// Records have consecutive sequence numbers from the trim horizon.
+	start := int(iter.position - stream.trimHorizon())
+	end := min(start+limit, len(stream.Records))
+
+	records := make([]StreamRecord, 0, max(end-start, 0))
+	if start < end {
+		records = append(records, stream.Records[start:end]...)
+	}
+
+	next := iter.position + int64(len(records))
+	if !stream.Enabled && next > stream.LastSequence {
+		return records, "", nil
+	}
+
+	return records, m.newStreamIterator(stream, next), nil
+}
+
+// newStreamIterator registers a shard iterator that reads from a sequence number.
+// Must be called under lock.
+func (m *MemoryStorage) newStreamIterator(stream *tableStream, position int64) string {
+	id := fmt.Sprintf("%s|%s|%d|%d", stream.ARN, stream.ShardID, position, time.Now().UnixNano())
+	iterator := base64.StdEncoding.EncodeToString([]byte(id))
+
+	m.streamIterators[iterator] = &streamIteratorData{
+		streamARN: stream.ARN,
+		position:  position,
+		expiresAt: time.Now().Add(streamIteratorExpiration),
+	}
+
+	return iterator
+}
+
+func streamNotFoundError(streamARN string) error {
+	return &TableError{
+		Code:    "ResourceNotFoundException",
+		Message: fmt.Sprintf("Requested resource not found: Stream: %s not found", streamARN),
+	}
+}
@@ -0,0 +1,191 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// StreamsService implements the DynamoDB Streams service. It reads the streams
+// that the DynamoDB service records, so both share the same storage.
+type StreamsService struct {
+	storage Storage
+}
+
+// NewStreams creates a new DynamoDB Streams service.
+func NewStreams(storage Storage) *StreamsService {
+	return &StreamsService{
+		storage: storage,
+	}
+}
+
+// Name returns the service name.
+func (s *StreamsService) Name() string {
+	return "dynamodbstreams"
+}
+
+// RegisterRoutes registers the DynamoDB Streams routes.
+// Note: DynamoDB Streams uses AWS JSON 1.0 protocol via the JSONProtocolService interface,
+// so no direct routes are registered here.
+func (s *StreamsService) RegisterRoutes(_ service.Router) {
+	// No routes to register - DynamoDB Streams uses JSON protocol dispatcher
+}
+
+// TargetPrefix returns the X-Amz-Target header prefix for DynamoDB Streams.
+func (s *StreamsService) TargetPrefix() string {
+	return "DynamoDBStreams_20120810"
+}
+
+// JSONProtocol is a marker method that indicates DynamoDB Streams uses AWS JSON 1.0 protocol.
+func (s *StreamsService) JSONProtocol() {}
+
+// Reset does nothing: streams are part of the DynamoDB storage and are cleared with it.
+func (s *StreamsService) Reset(_ context.Context) error {
+	return nil
+}
+
+// DispatchAction routes the request to the appropriate handler based on X-Amz-Target header.
+// This method implements the JSONProtocolService interface.
+func (s *StreamsService) DispatchAction(w http.ResponseWriter, r *http.Request) {
+	target := r.Header.Get("X-Amz-Target")
+	action := strings.TrimPrefix(target, "DynamoDBStreams_20120810.")
+
+	handlers := map[string]func(http.ResponseWriter, *http.Request){
+		"ListStreams":      s.ListStreams,
+		"DescribeStream":   s.DescribeStream,
+		"GetShardIterator": s.GetShardIterator,
+		"GetRecords":       s.GetRecords,
+	}
+
+	handler, ok := handlers[action]
+	if !ok {
+		writeDynamoDBError(w, "UnknownOperationException", "The action "+action+" is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	handler(w, r)
+}
+
+// ListStreams handles the ListStreams action.
+func (s *StreamsService) ListStreams(w http.ResponseWriter, r *http.Request) {
+	var req ListStreamsRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeDynamoDBError(w, "SerializationException", "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	streams, lastEvaluated, err := s.storage.ListStreams(r.Context(), req.TableName, req.ExclusiveStartStreamARN, req.Limit)
+	if err != nil {
+		writeStreamsError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, ListStreamsResponse{
+		Streams:                streams,
+		LastEvaluatedStreamARN: lastEvaluated,
+	})
+}
+
+// DescribeStream handles the DescribeStream action.
+func (s *StreamsService) DescribeStream(w http.ResponseWriter, r *http.Request) {
+	var req DescribeStreamRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeDynamoDBError(w, "SerializationException", "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.StreamARN == "" {
+		writeDynamoDBError(w, "ValidationException", "StreamArn is required", http.StatusBadRequest)
+
+		return
+	}
+
+	desc, err := s.storage.DescribeStream(r.Context(), req.StreamARN)
+	if err != nil {
+		writeStreamsError(w, err)
+
+		return
+	}
+
+	// A stream has a single shard, so paging past it returns no shards.
+	if req.ExclusiveStartShardID != "" {
+		desc.Shards = []StreamShard{}
+	}
+
+	writeJSONResponse(w, DescribeStreamResponse{StreamDescription: desc})
+}
+
+// GetShardIterator handles the GetShardIterator action.
+func (s *StreamsService) GetShardIterator(w http.ResponseWriter, r *http.Request) {
+	var req GetShardIteratorRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeDynamoDBError(w, "SerializationException", "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.StreamARN == "" || req.ShardID == "" || req.ShardIteratorType == "" {
+		writeDynamoDBError(w, "ValidationException", "StreamArn, ShardId and ShardIteratorType are required", http.StatusBadRequest)
+
+		return
+	}
+
+	iterator, err := s.storage.GetShardIterator(r.Context(), req.StreamARN, req.ShardID, req.ShardIteratorType, req.SequenceNumber)
+	if err != nil {
+		writeStreamsError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, GetShardIteratorResponse{ShardIterator: iterator})
+}
+
+// GetRecords handles the GetRecords action.
+func (s *StreamsService) GetRecords(w http.ResponseWriter, r *http.Request) {
+	var req GetRecordsRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeDynamoDBError(w, "SerializationException", "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.ShardIterator == "" {
+		writeDynamoDBError(w, "ValidationException", "ShardIterator is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.Limit < 0 || req.Limit > maxStreamRecordsPerGet {
+		writeDynamoDBError(w, "ValidationException", "Limit must be between 1 and 1000", http.StatusBadRequest)
+
+		return
+	}
+
+	records, next, err := s.storage.GetStreamRecords(r.Context(), req.ShardIterator, req.Limit)
+	if err != nil {
+		writeStreamsError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, GetRecordsResponse{Records: records, NextShardIterator: next})
+}
+
+// writeStreamsError writes a storage error as a DynamoDB Streams error response.
+func writeStreamsError(w http.ResponseWriter, err error) {
+	var tErr *TableError
+	if errors.As(err, &tErr) {
+		writeDynamoDBError(w, tErr.Code, tErr.Message, http.StatusBadRequest)
+
+		return
+	}
+
+	writeDynamoDBError(w, "InternalServerError", "Internal server error", http.StatusInternalServerError)
+}
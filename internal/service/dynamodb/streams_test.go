@@ -0,0 +1,198 @@
+package dynamodb
+
+import (
+	"context"
+	"testing"
+)
+
+func createStreamTable(t *testing.T, s *MemoryStorage, name, viewType string) *Table {
+	t.Helper()
+
+	table, err := s.CreateTable(context.Background(), &CreateTableRequest{
+		TableName:            name,
+		KeySchema:            []KeySchemaElement{{AttributeName: "pk", KeyType: "HASH"}},
+		AttributeDefinitions: []AttributeDefinition{{AttributeName: "pk", AttributeType: "S"}},
+		StreamSpecification:  &StreamSpecification{StreamEnabled: true, StreamViewType: viewType},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return table
+}
+
+func readStream(t *testing.T, s *MemoryStorage, table *Table) ([]StreamRecord, string) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	desc, err := s.DescribeStream(ctx, table.LatestStreamARN)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	iterator, err := s.GetShardIterator(ctx, desc.StreamARN, desc.Shards[0].ShardID, ShardIteratorTypeTrimHorizon, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records, next, err := s.GetStreamRecords(ctx, iterator, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return records, next
+}
+
+//nolint:funlen // Test function exercises multiple stream scenarios.
+func TestStreams(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("records inserts, modifications and removals", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewMemoryStorage("http://localhost:4566")
+		table := createStreamTable(t, s, "stream-images", StreamViewTypeNewAndOldImages)
+
+		key := Item{"pk": {S: ptr("a")}}
+
+		if _, err := s.PutItem(ctx, table.Name, Item{"pk": {S: ptr("a")}, "n": {N: ptr("1")}}, false, ConditionInput{}); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := s.UpdateItem(ctx, table.Name, key, "SET n = :n", nil, map[string]AttributeValue{":n": {N: ptr("2")}}, "", ConditionInput{}); err != nil {
+			t.Fatal(err)
+		}
+
+		// A write that leaves the item unchanged is not recorded.
+		if _, err := s.PutItem(ctx, table.Name, Item{"pk": {S: ptr("a")}, "n": {N: ptr("2")}}, false, ConditionInput{}); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := s.DeleteItem(ctx, table.Name, key, false, ConditionInput{}); err != nil {
+			t.Fatal(err)
+		}
+
+		records, next := readStream(t, s, table)
+		if next == "" {
+			t.Error("expected a next shard iterator for an open shard")
+		}
+
+		want := []string{streamEventInsert, streamEventModify, streamEventRemove}
+		if len(records) != len(want) {
+			t.Fatalf("expected %d records, got %d", len(want), len(records))
+		}
+
+		for i, record := range records {
+			if record.EventName != want[i] {
+				t.Errorf("record %d: expected %s, got %s", i, want[i], record.EventName)
+			}
+		}
+
+		modify := records[1].DynamoDB
+		if *modify.OldImage["n"].N != "1" || *modify.NewImage["n"].N != "2" {
+			t.Errorf("expected old image n=1 and new image n=2, got %+v", modify)
+		}
+
+		if records[2].DynamoDB.NewImage != nil || *records[2].DynamoDB.OldImage["n"].N != "2" {
+			t.Errorf("expected only an old image for the removal, got %+v", records[2].DynamoDB)
+		}
+	})
+
+	t.Run("KEYS_ONLY records omit images", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewMemoryStorage("http://localhost:4566")
+		table := createStreamTable(t, s, "stream-keys", StreamViewTypeKeysOnly)
+
+		if _, err := s.PutItem(ctx, table.Name, Item{"pk": {S: ptr("a")}, "n": {N: ptr("1")}}, false, ConditionInput{}); err != nil {
+			t.Fatal(err)
+		}
+
+		records, _ := readStream(t, s, table)
+		if len(records) != 1 {
+			t.Fatalf("expected 1 record, got %d", len(records))
+		}
+
+		data := records[0].DynamoDB
+		if *data.Keys["pk"].S != "a" || data.NewImage != nil || data.OldImage != nil {
+			t.Errorf("expected only keys, got %+v", data)
+		}
+	})
+
+	t.Run("deleting the table closes the shard", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewMemoryStorage("http://localhost:4566")
+		table := createStreamTable(t, s, "stream-closed", StreamViewTypeNewImage)
+
+		if _, err := s.PutItem(ctx, table.Name, Item{"pk": {S: ptr("a")}}, false, ConditionInput{}); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := s.DeleteTable(ctx, table.Name); err != nil {
+			t.Fatal(err)
+		}
+
+		desc, err := s.DescribeStream(ctx, table.LatestStreamARN)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if desc.StreamStatus != "DISABLED" || desc.Shards[0].SequenceNumberRange.EndingSequenceNumber == "" {
+			t.Errorf("expected a disabled stream with a closed shard, got %+v", desc)
+		}
+
+		records, next := readStream(t, s, table)
+		if len(records) != 1 || next != "" {
+			t.Errorf("expected 1 record and no next iterator, got %d and %q", len(records), next)
+		}
+	})
+
+	t.Run("iterators read from a sequence number", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewMemoryStorage("http://localhost:4566")
+		table := createStreamTable(t, s, "stream-sequence", StreamViewTypeNewImage)
+
+		for _, pk := range []string{"a", "b", "c"} {
+			if _, err := s.PutItem(ctx, table.Name, Item{"pk": {S: ptr(pk)}}, false, ConditionInput{}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		records, _ := readStream(t, s, table)
+		desc, _ := s.DescribeStream(ctx, table.LatestStreamARN)
+
+		iterator, err := s.GetShardIterator(ctx, desc.StreamARN, desc.Shards[0].ShardID,
+			ShardIteratorTypeAfterSequenceNumber, records[0].DynamoDB.SequenceNumber)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, _, err := s.GetStreamRecords(ctx, iterator, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(got) != 1 || *got[0].DynamoDB.Keys["pk"].S != "b" {
+			t.Errorf("expected the record for b, got %+v", got)
+		}
+
+		latest, err := s.GetShardIterator(ctx, desc.StreamARN, desc.Shards[0].ShardID, ShardIteratorTypeLatest, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, _, err = s.GetStreamRecords(ctx, latest, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(got) != 0 {
+			t.Errorf("expected no records after LATEST, got %d", len(got))
+		}
+	})
+}
@@ -9,12 +9,50 @@ import (
 
 // ReturnValues constants for DynamoDB operations.
 const (
+	ReturnValuesNone       = "NONE"
 	ReturnValuesAllOld     = "ALL_OLD"
 	ReturnValuesAllNew     = "ALL_NEW"
 	ReturnValuesUpdatedOld = "UPDATED_OLD"
 	ReturnValuesUpdatedNew = "UPDATED_NEW"
 )
 
+// ReturnConsumedCapacity constants for DynamoDB operations.
+const (
+	ReturnConsumedCapacityNone    = "NONE"
+	ReturnConsumedCapacityTotal   = "TOTAL"
+	ReturnConsumedCapacityIndexes = "INDEXES"
+)
+
+// Select constants for Query and Scan.
+const (
+	SelectCount = "COUNT"
+)
+
+// BillingMode constants for DynamoDB tables.
+const (
+	BillingModeProvisioned   = "PROVISIONED"
+	BillingModePayPerRequest = "PAY_PER_REQUEST"
+)
+
+// maxTotalSegments is the maximum number of segments of a parallel scan.
+const maxTotalSegments = 1000000
+
+// StreamViewType constants for DynamoDB Streams.
+const (
+	StreamViewTypeKeysOnly        = "KEYS_ONLY"
+	StreamViewTypeNewImage        = "NEW_IMAGE"
+	StreamViewTypeOldImage        = "OLD_IMAGE"
+	StreamViewTypeNewAndOldImages = "NEW_AND_OLD_IMAGES"
+)
+
+// ShardIteratorType constants for DynamoDB Streams.
+const (
+	ShardIteratorTypeTrimHorizon         = "TRIM_HORIZON"
+	ShardIteratorTypeLatest              = "LATEST"
+	ShardIteratorTypeAtSequenceNumber    = "AT_SEQUENCE_NUMBER"
+	ShardIteratorTypeAfterSequenceNumber = "AFTER_SEQUENCE_NUMBER"
+)
+
 // Error code constants.
 const (
 	ErrCodeConditionalCheckFailed = "ConditionalCheckFailedException"
@@ -210,6 +248,16 @@ type Table struct {
 	DeletionProtection     bool
 	TTLAttributeName       string
 	TTLEnabled             bool
+	StreamSpecification    *StreamSpecification
+	LatestStreamARN        string
+	LatestStreamLabel      string
+	IndexStatuses          map[string]string // GSI name -> IndexStatus
+}
+
+// StreamSpecification represents the DynamoDB Streams settings of a table.
+type StreamSpecification struct {
+	StreamEnabled  bool   `json:"StreamEnabled"`
+	StreamViewType string `json:"StreamViewType,omitempty"`
 }
 
 // TableDescription represents a table description in responses.
@@ -228,6 +276,9 @@ type TableDescription struct {
 	TableSizeBytes            int64                             `json:"TableSizeBytes"`
 	BillingModeSummary        *BillingModeSummary               `json:"BillingModeSummary,omitempty"`
 	DeletionProtectionEnabled bool                              `json:"DeletionProtectionEnabled"`
+	StreamSpecification       *StreamSpecification              `json:"StreamSpecification,omitempty"`
+	LatestStreamARN           string                            `json:"LatestStreamArn,omitempty"`
+	LatestStreamLabel         string                            `json:"LatestStreamLabel,omitempty"`
 }
 
 // BillingModeSummary represents billing mode summary.
@@ -251,6 +302,7 @@ type CreateTableRequest struct {
 	LocalSecondaryIndexes     []LocalSecondaryIndex  `json:"LocalSecondaryIndexes,omitempty"`
 	BillingMode               string                 `json:"BillingMode,omitempty"`
 	DeletionProtectionEnabled bool                   `json:"DeletionProtectionEnabled,omitempty"`
+	StreamSpecification       *StreamSpecification   `json:"StreamSpecification,omitempty"`
 }
 
 // CreateTableResponse is the response for CreateTable.
@@ -290,6 +342,40 @@ type DescribeTableResponse struct {
 	Table TableDescription `json:"Table"`
 }
 
+// UpdateTableRequest is the request for UpdateTable.
+type UpdateTableRequest struct {
+	TableName                   string                       `json:"TableName"`
+	AttributeDefinitions        []AttributeDefinition        `json:"AttributeDefinitions,omitempty"`
+	BillingMode                 string                       `json:"BillingMode,omitempty"`
+	ProvisionedThroughput       *ProvisionedThroughput       `json:"ProvisionedThroughput,omitempty"`
+	GlobalSecondaryIndexUpdates []GlobalSecondaryIndexUpdate `json:"GlobalSecondaryIndexUpdates,omitempty"`
+	StreamSpecification         *StreamSpecification         `json:"StreamSpecification,omitempty"`
+	DeletionProtectionEnabled   *bool                        `json:"DeletionProtectionEnabled,omitempty"`
+}
+
+// GlobalSecondaryIndexUpdate creates, updates or deletes a GSI in UpdateTable requests.
+type GlobalSecondaryIndexUpdate struct {
+	Create *GlobalSecondaryIndex             `json:"Create,omitempty"`
+	Update *UpdateGlobalSecondaryIndexAction `json:"Update,omitempty"`
+	Delete *DeleteGlobalSecondaryIndexAction `json:"Delete,omitempty"`
+}
+
+// UpdateGlobalSecondaryIndexAction changes the provisioned throughput of a GSI.
+type UpdateGlobalSecondaryIndexAction struct {
+	IndexName             string                 `json:"IndexName"`
+	ProvisionedThroughput *ProvisionedThroughput `json:"ProvisionedThroughput,omitempty"`
+}
+
+// DeleteGlobalSecondaryIndexAction deletes a GSI.
+type DeleteGlobalSecondaryIndexAction struct {
+	IndexName string `json:"IndexName"`
+}
+
+// UpdateTableResponse is the response for UpdateTable.
+type UpdateTableResponse struct {
+	TableDescription TableDescription `json:"TableDescription"`
+}
+
 // PutItemRequest is the request for PutItem.
 type PutItemRequest struct {
 	TableName                 string                    `json:"TableName"`
@@ -297,12 +383,14 @@ type PutItemRequest struct {
 	ConditionExpression       string                    `json:"ConditionExpression,omitempty"`
 	ExpressionAttributeNames  map[string]string         `json:"ExpressionAttributeNames,omitempty"`
 	ExpressionAttributeValues map[string]AttributeValue `json:"ExpressionAttributeValues,omitempty"`
+	ReturnConsumedCapacity    string                    `json:"ReturnConsumedCapacity,omitempty"`
 	ReturnValues              string                    `json:"ReturnValues,omitempty"`
 }
 
 // PutItemResponse is the response for PutItem.
 type PutItemResponse struct {
-	Attributes Item `json:"Attributes,omitempty"`
+	Attributes       Item              `json:"Attributes,omitempty"`
+	ConsumedCapacity *ConsumedCapacity `json:"ConsumedCapacity,omitempty"`
 }
 
 // GetItemRequest is the request for GetItem.
@@ -326,12 +414,14 @@ type DeleteItemRequest struct {
 	ConditionExpression       string                    `json:"ConditionExpression,omitempty"`
 	ExpressionAttributeNames  map[string]string         `json:"ExpressionAttributeNames,omitempty"`
 	ExpressionAttributeValues map[string]AttributeValue `json:"ExpressionAttributeValues,omitempty"`
+	ReturnConsumedCapacity    string                    `json:"ReturnConsumedCapacity,omitempty"`
 	ReturnValues              string                    `json:"ReturnValues,omitempty"`
 }
 
 // DeleteItemResponse is the response for DeleteItem.
 type DeleteItemResponse struct {
-	Attributes Item `json:"Attributes,omitempty"`
+	Attributes       Item              `json:"Attributes,omitempty"`
+	ConsumedCapacity *ConsumedCapacity `json:"ConsumedCapacity,omitempty"`
 }
 
 // AttributeValueUpdate represents a legacy AttributeUpdates entry.
@@ -349,12 +439,32 @@ type UpdateItemRequest struct {
 	ExpressionAttributeNames  map[string]string               `json:"ExpressionAttributeNames,omitempty"`
 	ExpressionAttributeValues map[string]AttributeValue       `json:"ExpressionAttributeValues,omitempty"`
 	AttributeUpdates          map[string]AttributeValueUpdate `json:"AttributeUpdates,omitempty"`
+	ReturnConsumedCapacity    string                          `json:"ReturnConsumedCapacity,omitempty"`
 	ReturnValues              string                          `json:"ReturnValues,omitempty"`
 }
 
 // UpdateItemResponse is the response for UpdateItem.
 type UpdateItemResponse struct {
-	Attributes Item `json:"Attributes,omitempty"`
+	Attributes       Item              `json:"Attributes,omitempty"`
+	ConsumedCapacity *ConsumedCapacity `json:"ConsumedCapacity,omitempty"`
+}
+
+// Capacity represents the capacity units consumed on a table or an index.
+type Capacity struct {
+	CapacityUnits      float64 `json:"CapacityUnits"`
+	ReadCapacityUnits  float64 `json:"ReadCapacityUnits,omitempty"`
+	WriteCapacityUnits float64 `json:"WriteCapacityUnits,omitempty"`
+}
+
+// ConsumedCapacity represents the capacity units consumed by an operation.
+type ConsumedCapacity struct {
+	TableName              string              `json:"TableName"`
+	CapacityUnits          float64             `json:"CapacityUnits"`
+	ReadCapacityUnits      float64             `json:"ReadCapacityUnits,omitempty"`
+	WriteCapacityUnits     float64             `json:"WriteCapacityUnits,omitempty"`
+	Table                  *Capacity           `json:"Table,omitempty"`
+	GlobalSecondaryIndexes map[string]Capacity `json:"GlobalSecondaryIndexes,omitempty"`
+	LocalSecondaryIndexes  map[string]Capacity `json:"LocalSecondaryIndexes,omitempty"`
 }
 
 // QueryRequest is the request for Query.
@@ -582,6 +692,54 @@ type BatchGetItemResponse struct {
 	UnprocessedKeys map[string]KeysAndAttributes `json:"UnprocessedKeys,omitempty"`
 }
 
+// ExecuteStatementRequest is the request for ExecuteStatement.
+type ExecuteStatementRequest struct {
+	Statement              string           `json:"Statement"`
+	Parameters             []AttributeValue `json:"Parameters,omitempty"`
+	ConsistentRead         bool             `json:"ConsistentRead,omitempty"`
+	Limit                  int              `json:"Limit,omitempty"`
+	NextToken              string           `json:"NextToken,omitempty"`
+	ReturnConsumedCapacity string           `json:"ReturnConsumedCapacity,omitempty"`
+}
+
+// ExecuteStatementResponse is the response for ExecuteStatement.
+type ExecuteStatementResponse struct {
+	Items            []Item `json:"Items"`
+	NextToken        string `json:"NextToken,omitempty"`
+	LastEvaluatedKey Item   `json:"LastEvaluatedKey,omitempty"`
+}
+
+// BatchExecuteStatementRequest is the request for BatchExecuteStatement.
+type BatchExecuteStatementRequest struct {
+	Statements             []BatchStatementRequest `json:"Statements"`
+	ReturnConsumedCapacity string                  `json:"ReturnConsumedCapacity,omitempty"`
+}
+
+// BatchStatementRequest is a single statement of a BatchExecuteStatement request.
+type BatchStatementRequest struct {
+	Statement      string           `json:"Statement"`
+	Parameters     []AttributeValue `json:"Parameters,omitempty"`
+	ConsistentRead bool             `json:"ConsistentRead,omitempty"`
+}
+
+// BatchExecuteStatementResponse is the response for BatchExecuteStatement.
+type BatchExecuteStatementResponse struct {
+	Responses []BatchStatementResponse `json:"Responses"`
+}
+
+// BatchStatementResponse is the result of a single statement of a batch.
+type BatchStatementResponse struct {
+	TableName string               `json:"TableName,omitempty"`
+	Item      Item                 `json:"Item,omitempty"`
+	Error     *BatchStatementError `json:"Error,omitempty"`
+}
+
+// BatchStatementError is the error of a single statement of a batch.
+type BatchStatementError struct {
+	Code    string `json:"Code"`
+	Message string `json:"Message"`
+}
+
 // ErrorResponse represents a DynamoDB error response in JSON format.
 type ErrorResponse struct {
 	Type    string `json:"__type"`
@@ -598,3 +756,114 @@ type TableError struct {
 func (e *TableError) Error() string {
 	return e.Message
 }
+
+// StreamRecord represents a change to an item captured by DynamoDB Streams.
+type StreamRecord struct {
+	EventID      string           `json:"eventID"`
+	EventName    string           `json:"eventName"`
+	EventVersion string           `json:"eventVersion"`
+	EventSource  string           `json:"eventSource"`
+	AWSRegion    string           `json:"awsRegion"`
+	DynamoDB     StreamRecordData `json:"dynamodb"`
+	UserIdentity *StreamIdentity  `json:"userIdentity,omitempty"`
+}
+
+// StreamRecordData represents the keys and item images of a stream record.
+type StreamRecordData struct {
+	ApproximateCreationDateTime float64 `json:"ApproximateCreationDateTime"`
+	Keys                        Item    `json:"Keys"`
+	NewImage                    Item    `json:"NewImage,omitempty"`
+	OldImage                    Item    `json:"OldImage,omitempty"`
+	SequenceNumber              string  `json:"SequenceNumber"`
+	SizeBytes                   int64   `json:"SizeBytes"`
+	StreamViewType              string  `json:"StreamViewType"`
+}
+
+// StreamIdentity represents the principal that made a change, such as the TTL process.
+type StreamIdentity struct {
+	PrincipalID string `json:"PrincipalId"`
+	Type        string `json:"Type"`
+}
+
+// StreamSummary represents a stream in ListStreams responses.
+type StreamSummary struct {
+	StreamARN   string `json:"StreamArn"`
+	StreamLabel string `json:"StreamLabel"`
+	TableName   string `json:"TableName"`
+}
+
+// StreamDescription represents a stream in DescribeStream responses.
+type StreamDescription struct {
+	StreamARN               string             `json:"StreamArn"`
+	StreamLabel             string             `json:"StreamLabel"`
+	StreamStatus            string             `json:"StreamStatus"`
+	StreamViewType          string             `json:"StreamViewType"`
+	CreationRequestDateTime float64            `json:"CreationRequestDateTime"`
+	TableName               string             `json:"TableName"`
+	KeySchema               []KeySchemaElement `json:"KeySchema"`
+	Shards                  []StreamShard      `json:"Shards"`
+	LastEvaluatedShardID    string             `json:"LastEvaluatedShardId,omitempty"`
+}
+
+// StreamShard represents a shard of a stream.
+type StreamShard struct {
+	ShardID             string              `json:"ShardId"`
+	SequenceNumberRange SequenceNumberRange `json:"SequenceNumberRange"`
+	ParentShardID       string              `json:"ParentShardId,omitempty"`
+}
+
+// SequenceNumberRange represents the range of sequence numbers of a shard.
+type SequenceNumberRange struct {
+	StartingSequenceNumber string `json:"StartingSequenceNumber,omitempty"`
+	EndingSequenceNumber   string `json:"EndingSequenceNumber,omitempty"`
+}
+
+// ListStreamsRequest is the request for ListStreams.
+type ListStreamsRequest struct {
+	TableName               string `json:"TableName,omitempty"`
+	Limit                   int    `json:"Limit,omitempty"`
+	ExclusiveStartStreamARN string `json:"ExclusiveStartStreamArn,omitempty"`
+}
+
+// ListStreamsResponse is the response for ListStreams.
+type ListStreamsResponse struct {
+	Streams                []StreamSummary `json:"Streams"`
+	LastEvaluatedStreamARN string          `json:"LastEvaluatedStreamArn,omitempty"`
+}
+
+// DescribeStreamRequest is the request for DescribeStream.
+type DescribeStreamRequest struct {
+	StreamARN             string `json:"StreamArn"`
+	Limit                 int    `json:"Limit,omitempty"`
+	ExclusiveStartShardID string `json:"ExclusiveStartShardId,omitempty"`
+}
+
+// DescribeStreamResponse is the response for DescribeStream.
+type DescribeStreamResponse struct {
+	StreamDescription *StreamDescription `json:"StreamDescription"`
+}
+
+// GetShardIteratorRequest is the request for GetShardIterator.
+type GetShardIteratorRequest struct {
+	StreamARN         string `json:"StreamArn"`
+	ShardID           string `json:"ShardId"`
+	ShardIteratorType string `json:"ShardIteratorType"`
+	SequenceNumber    string `json:"SequenceNumber,omitempty"`
+}
+
+// GetShardIteratorResponse is the response for GetShardIterator.
+type GetShardIteratorResponse struct {
+	ShardIterator string `json:"ShardIterator"`
+}
+
+// GetRecordsRequest is the request for GetRecords.
+type GetRecordsRequest struct {
+	ShardIterator string `json:"ShardIterator"`
+	Limit         int    `json:"Limit,omitempty"`
+}
+
+// GetRecordsResponse is the response for GetRecords.
+type GetRecordsResponse struct {
+	Records           []StreamRecord `json:"Records"`
+	NextShardIterator string         `json:"NextShardIterator,omitempty"`
+}
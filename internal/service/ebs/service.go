@@ -1,9 +1,9 @@
 package ebs
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"os"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -49,11 +49,27 @@ func (s *Service) Close() error {
 	return nil
 }
 
-func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
 	}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+	return nil
+}
+
+func init() {
+	service.RegisterFactory([]string{"ebs"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
+
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
@@ -39,6 +39,7 @@ type Storage interface {
 	PutSnapshotBlock(ctx context.Context, snapshotID string, blockIndex int32, data []byte, checksum string) error
 	GetSnapshotBlock(ctx context.Context, snapshotID string, blockIndex int32) ([]byte, string, error)
 	ListChangedBlocks(ctx context.Context, firstSnapshotID, secondSnapshotID string) (*ListChangedBlocksResponse, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -140,6 +141,17 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Snapshots = make(map[string]*Snapshot)
+	m.Blocks = make(map[string]map[int32]*blockData)
+
+	return nil
+}
+
 // StartSnapshot starts a new snapshot.
 func (m *MemoryStorage) StartSnapshot(_ context.Context, req *StartSnapshotRequest) (*Snapshot, error) {
 	m.mu.Lock()
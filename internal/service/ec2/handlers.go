@@ -321,7 +321,7 @@ func (s *Service) CreateKeyPair(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	kp, err := s.storage.CreateKeyPair(r.Context(), req.KeyName, req.KeyType)
+	kp, err := s.storage.CreateKeyPair(r.Context(), &req)
 	if err != nil {
 		handleError(w, err)
 
@@ -335,6 +335,39 @@ func (s *Service) CreateKeyPair(w http.ResponseWriter, r *http.Request) {
 		KeyFingerprint: kp.KeyFingerprint,
 		KeyMaterial:    kp.KeyMaterial,
 		KeyPairID:      kp.KeyPairID,
+		TagSet:         convertToXMLTagSet(kp.Tags),
+	})
+}
+
+// ImportKeyPair handles the ImportKeyPair action.
+func (s *Service) ImportKeyPair(w http.ResponseWriter, r *http.Request) {
+	var req ImportKeyPairRequest
+	if err := readEC2JSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.KeyName == "" || len(req.PublicKeyMaterial) == 0 {
+		writeError(w, errInvalidParameter, "KeyName and PublicKeyMaterial are required", http.StatusBadRequest)
+
+		return
+	}
+
+	kp, err := s.storage.ImportKeyPair(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeEC2XMLResponse(w, XMLImportKeyPairResponse{
+		Xmlns:          ec2XMLNS,
+		RequestID:      uuid.New().String(),
+		KeyName:        kp.KeyName,
+		KeyFingerprint: kp.KeyFingerprint,
+		KeyPairID:      kp.KeyPairID,
+		TagSet:         convertToXMLTagSet(kp.Tags),
 	})
 }
 
@@ -376,7 +409,7 @@ func (s *Service) DescribeKeyPairs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	keyPairs, err := s.storage.DescribeKeyPairs(r.Context(), req.KeyNames, req.KeyPairIDs)
+	keyPairs, err := s.storage.DescribeKeyPairs(r.Context(), req.KeyNames, req.KeyPairIDs, req.Filters)
 	if err != nil {
 		handleError(w, err)
 
@@ -385,11 +418,20 @@ func (s *Service) DescribeKeyPairs(w http.ResponseWriter, r *http.Request) {
 
 	xmlKeyPairs := make([]XMLKeyPairInfo, 0, len(keyPairs))
 	for _, kp := range keyPairs {
-		xmlKeyPairs = append(xmlKeyPairs, XMLKeyPairInfo{
+		info := XMLKeyPairInfo{
 			KeyName:        kp.KeyName,
 			KeyFingerprint: kp.KeyFingerprint,
 			KeyPairID:      kp.KeyPairID,
-		})
+			KeyType:        kp.KeyType,
+			CreateTime:     kp.CreateTime.Format("2006-01-02T15:04:05.000Z"),
+			TagSet:         convertToXMLTagSet(kp.Tags),
+		}
+
+		if req.IncludePublicKey {
+			info.PublicKey = kp.PublicKey
+		}
+
+		xmlKeyPairs = append(xmlKeyPairs, info)
 	}
 
 	writeEC2XMLResponse(w, XMLDescribeKeyPairsResponse{
@@ -465,7 +507,7 @@ func (s *Service) DescribeVpcs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	vpcs, err := s.storage.DescribeVpcs(r.Context(), req.VpcIDs)
+	vpcs, err := s.storage.DescribeVpcs(r.Context(), req.VpcIDs, req.Filters)
 	if err != nil {
 		handleError(w, err)
 
@@ -556,7 +598,7 @@ func (s *Service) DescribeSubnets(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	subnets, err := s.storage.DescribeSubnets(r.Context(), req.SubnetIDs, nil)
+	subnets, err := s.storage.DescribeSubnets(r.Context(), req.SubnetIDs, req.Filters)
 	if err != nil {
 		handleError(w, err)
 
@@ -752,9 +794,66 @@ func (s *Service) AssociateRouteTable(w http.ResponseWriter, r *http.Request) {
 	}
 
 	writeEC2XMLResponse(w, XMLAssociateRouteTableResponse{
-		Xmlns:         ec2XMLNS,
-		RequestID:     uuid.New().String(),
-		AssociationID: associationID,
+		Xmlns:            ec2XMLNS,
+		RequestID:        uuid.New().String(),
+		AssociationID:    associationID,
+		AssociationState: XMLAssociationStateBlock{State: routeTableAssociationStateAssociated},
+	})
+}
+
+// DisassociateRouteTable handles the DisassociateRouteTable action.
+func (s *Service) DisassociateRouteTable(w http.ResponseWriter, r *http.Request) {
+	var req DisassociateRouteTableRequest
+	if err := readEC2JSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.AssociationID == "" {
+		writeError(w, errInvalidParameter, "AssociationId is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.DisassociateRouteTable(r.Context(), req.AssociationID); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeEC2XMLResponse(w, XMLDisassociateRouteTableResponse{
+		Xmlns:     ec2XMLNS,
+		RequestID: uuid.New().String(),
+		Return:    true,
+	})
+}
+
+// DeleteRouteTable handles the DeleteRouteTable action.
+func (s *Service) DeleteRouteTable(w http.ResponseWriter, r *http.Request) {
+	var req DeleteRouteTableRequest
+	if err := readEC2JSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.RouteTableID == "" {
+		writeError(w, errInvalidParameter, "RouteTableId is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.DeleteRouteTable(r.Context(), req.RouteTableID); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeEC2XMLResponse(w, XMLDeleteRouteTableResponse{
+		Xmlns:     ec2XMLNS,
+		RequestID: uuid.New().String(),
+		Return:    true,
 	})
 }
 
@@ -767,7 +866,7 @@ func (s *Service) DescribeRouteTables(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	rts, err := s.storage.DescribeRouteTables(r.Context(), req.RouteTableIDs)
+	rts, err := s.storage.DescribeRouteTables(r.Context(), req.RouteTableIDs, req.Filters)
 	if err != nil {
 		handleError(w, err)
 
@@ -843,6 +942,291 @@ func (s *Service) DescribeNatGateways(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// CreateVolume handles the CreateVolume action.
+func (s *Service) CreateVolume(w http.ResponseWriter, r *http.Request) {
+	var req CreateVolumeRequest
+	if err := readEC2JSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	volume, err := s.storage.CreateVolume(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeEC2XMLResponse(w, XMLCreateVolumeResponse{
+		Xmlns:     ec2XMLNS,
+		RequestID: uuid.New().String(),
+		XMLVolume: convertToXMLVolume(volume),
+	})
+}
+
+// DeleteVolume handles the DeleteVolume action.
+func (s *Service) DeleteVolume(w http.ResponseWriter, r *http.Request) {
+	var req DeleteVolumeRequest
+	if err := readEC2JSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.VolumeID == "" {
+		writeError(w, errInvalidParameter, "VolumeId is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.DeleteVolume(r.Context(), req.VolumeID); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeEC2XMLResponse(w, XMLDeleteVolumeResponse{
+		Xmlns:     ec2XMLNS,
+		RequestID: uuid.New().String(),
+		Return:    true,
+	})
+}
+
+// DescribeVolumes handles the DescribeVolumes action.
+func (s *Service) DescribeVolumes(w http.ResponseWriter, r *http.Request) {
+	var req DescribeVolumesRequest
+	if err := readEC2JSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	volumes, err := s.storage.DescribeVolumes(r.Context(), req.VolumeIDs, req.Filters)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	xmlVolumes := make([]XMLVolume, 0, len(volumes))
+	for _, volume := range volumes {
+		xmlVolumes = append(xmlVolumes, convertToXMLVolume(volume))
+	}
+
+	writeEC2XMLResponse(w, XMLDescribeVolumesResponse{
+		Xmlns:     ec2XMLNS,
+		RequestID: uuid.New().String(),
+		VolumeSet: XMLVolumeSet{Items: xmlVolumes},
+	})
+}
+
+// AttachVolume handles the AttachVolume action.
+func (s *Service) AttachVolume(w http.ResponseWriter, r *http.Request) {
+	var req AttachVolumeRequest
+	if err := readEC2JSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.VolumeID == "" || req.InstanceID == "" || req.Device == "" {
+		writeError(w, errInvalidParameter, "VolumeId, InstanceId and Device are required", http.StatusBadRequest)
+
+		return
+	}
+
+	attachment, err := s.storage.AttachVolume(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeEC2XMLResponse(w, XMLAttachVolumeResponse{
+		Xmlns:               ec2XMLNS,
+		RequestID:           uuid.New().String(),
+		XMLVolumeAttachment: convertToXMLVolumeAttachment(attachment),
+	})
+}
+
+// DetachVolume handles the DetachVolume action.
+func (s *Service) DetachVolume(w http.ResponseWriter, r *http.Request) {
+	var req DetachVolumeRequest
+	if err := readEC2JSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.VolumeID == "" {
+		writeError(w, errInvalidParameter, "VolumeId is required", http.StatusBadRequest)
+
+		return
+	}
+
+	attachment, err := s.storage.DetachVolume(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeEC2XMLResponse(w, XMLDetachVolumeResponse{
+		Xmlns:               ec2XMLNS,
+		RequestID:           uuid.New().String(),
+		XMLVolumeAttachment: convertToXMLVolumeAttachment(attachment),
+	})
+}
+
+// AllocateAddress handles the AllocateAddress action.
+func (s *Service) AllocateAddress(w http.ResponseWriter, r *http.Request) {
+	var req AllocateAddressRequest
+	if err := readEC2JSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	address, err := s.storage.AllocateAddress(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeEC2XMLResponse(w, XMLAllocateAddressResponse{
+		Xmlns:              ec2XMLNS,
+		RequestID:          uuid.New().String(),
+		PublicIP:           address.PublicIP,
+		Domain:             address.Domain,
+		AllocationID:       address.AllocationID,
+		PublicIpv4Pool:     publicIpv4Pool,
+		NetworkBorderGroup: address.NetworkBorderGroup,
+		TagSet:             convertToXMLTagSet(address.Tags),
+	})
+}
+
+// DescribeAddresses handles the DescribeAddresses action.
+func (s *Service) DescribeAddresses(w http.ResponseWriter, r *http.Request) {
+	var req DescribeAddressesRequest
+	if err := readEC2JSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	addresses, err := s.storage.DescribeAddresses(r.Context(), req.PublicIPs, req.AllocationIDs, req.Filters)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	items := make([]XMLAddress, 0, len(addresses))
+	for _, address := range addresses {
+		items = append(items, convertToXMLAddress(address))
+	}
+
+	writeEC2XMLResponse(w, XMLDescribeAddressesResponse{
+		Xmlns:        ec2XMLNS,
+		RequestID:    uuid.New().String(),
+		AddressesSet: XMLAddressSet{Items: items},
+	})
+}
+
+// ReleaseAddress handles the ReleaseAddress action.
+func (s *Service) ReleaseAddress(w http.ResponseWriter, r *http.Request) {
+	var req ReleaseAddressRequest
+	if err := readEC2JSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.AllocationID == "" && req.PublicIP == "" {
+		writeError(w, errInvalidParameter, "AllocationId or PublicIp is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.ReleaseAddress(r.Context(), req.AllocationID, req.PublicIP); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeEC2XMLResponse(w, XMLReleaseAddressResponse{
+		Xmlns:     ec2XMLNS,
+		RequestID: uuid.New().String(),
+		Return:    true,
+	})
+}
+
+// AssociateAddress handles the AssociateAddress action.
+func (s *Service) AssociateAddress(w http.ResponseWriter, r *http.Request) {
+	var req AssociateAddressRequest
+	if err := readEC2JSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.AllocationID == "" && req.PublicIP == "" {
+		writeError(w, errInvalidParameter, "AllocationId or PublicIp is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.InstanceID == "" {
+		writeError(w, errInvalidParameter, "InstanceId is required", http.StatusBadRequest)
+
+		return
+	}
+
+	associationID, err := s.storage.AssociateAddress(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeEC2XMLResponse(w, XMLAssociateAddressResponse{
+		Xmlns:         ec2XMLNS,
+		RequestID:     uuid.New().String(),
+		Return:        true,
+		AssociationID: associationID,
+	})
+}
+
+// DisassociateAddress handles the DisassociateAddress action.
+func (s *Service) DisassociateAddress(w http.ResponseWriter, r *http.Request) {
+	var req DisassociateAddressRequest
+	if err := readEC2JSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.AssociationID == "" && req.PublicIP == "" {
+		writeError(w, errInvalidParameter, "AssociationId or PublicIp is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.DisassociateAddress(r.Context(), req.AssociationID, req.PublicIP); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeEC2XMLResponse(w, XMLDisassociateAddressResponse{
+		Xmlns:     ec2XMLNS,
+		RequestID: uuid.New().String(),
+		Return:    true,
+	})
+}
+
 // DispatchAction routes the request to the appropriate handler based on Action parameter.
 func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
 	action := extractAction(r)
@@ -873,6 +1257,7 @@ func (s *Service) getActionHandler(action string) func(http.ResponseWriter, *htt
 		"AuthorizeSecurityGroupEgress":  s.AuthorizeSecurityGroupEgress,
 		// Key pair operations
 		"CreateKeyPair":    s.CreateKeyPair,
+		"ImportKeyPair":    s.ImportKeyPair,
 		"DeleteKeyPair":    s.DeleteKeyPair,
 		"DescribeKeyPairs": s.DescribeKeyPairs,
 		// VPC operations
@@ -888,13 +1273,27 @@ func (s *Service) getActionHandler(action string) func(http.ResponseWriter, *htt
 		"AttachInternetGateway":    s.AttachInternetGateway,
 		"DescribeInternetGateways": s.DescribeInternetGateways,
 		// Route table operations
-		"CreateRouteTable":    s.CreateRouteTable,
-		"CreateRoute":         s.CreateRoute,
-		"AssociateRouteTable": s.AssociateRouteTable,
-		"DescribeRouteTables": s.DescribeRouteTables,
+		"CreateRouteTable":       s.CreateRouteTable,
+		"CreateRoute":            s.CreateRoute,
+		"AssociateRouteTable":    s.AssociateRouteTable,
+		"DisassociateRouteTable": s.DisassociateRouteTable,
+		"DeleteRouteTable":       s.DeleteRouteTable,
+		"DescribeRouteTables":    s.DescribeRouteTables,
 		// NAT gateway operations
 		"CreateNatGateway":    s.CreateNatGateway,
 		"DescribeNatGateways": s.DescribeNatGateways,
+		// Volume operations
+		"CreateVolume":    s.CreateVolume,
+		"DeleteVolume":    s.DeleteVolume,
+		"DescribeVolumes": s.DescribeVolumes,
+		"AttachVolume":    s.AttachVolume,
+		"DetachVolume":    s.DetachVolume,
+		// Elastic IP operations
+		"AllocateAddress":     s.AllocateAddress,
+		"DescribeAddresses":   s.DescribeAddresses,
+		"ReleaseAddress":      s.ReleaseAddress,
+		"AssociateAddress":    s.AssociateAddress,
+		"DisassociateAddress": s.DisassociateAddress,
 	}
 
 	return handlers[action]
@@ -1077,7 +1476,13 @@ func convertToXMLRouteTable(rt *RouteTable) XMLRouteTable {
 
 	associations := make([]XMLRouteTableAssociation, 0, len(rt.Associations))
 	for _, a := range rt.Associations {
-		associations = append(associations, XMLRouteTableAssociation(a))
+		associations = append(associations, XMLRouteTableAssociation{
+			RouteTableAssociationID: a.RouteTableAssociationID,
+			RouteTableID:            a.RouteTableID,
+			SubnetID:                a.SubnetID,
+			Main:                    a.Main,
+			AssociationState:        XMLAssociationStateBlock{State: a.State},
+		})
 	}
 
 	return XMLRouteTable{
@@ -1105,3 +1510,63 @@ func convertToXMLNatGateway(natgw *NatGateway) XMLNatGateway {
 		TagSet:           XMLTagSet{Items: tags},
 	}
 }
+
+// convertToXMLVolume converts a Volume to XMLVolume.
+func convertToXMLVolume(volume *Volume) XMLVolume {
+	attachments := make([]XMLVolumeAttachment, 0, len(volume.Attachments))
+	for i := range volume.Attachments {
+		attachments = append(attachments, convertToXMLVolumeAttachment(&volume.Attachments[i]))
+	}
+
+	return XMLVolume{
+		VolumeID:         volume.VolumeID,
+		Size:             volume.Size,
+		AvailabilityZone: volume.AvailabilityZone,
+		Status:           volume.State,
+		CreateTime:       volume.CreateTime.Format("2006-01-02T15:04:05.000Z"),
+		AttachmentSet:    XMLVolumeAttachmentSet{Items: attachments},
+		VolumeType:       volume.VolumeType,
+		Iops:             volume.Iops,
+		Throughput:       volume.Throughput,
+		Encrypted:        volume.Encrypted,
+		KmsKeyID:         volume.KmsKeyID,
+		TagSet:           convertToXMLTagSet(volume.Tags),
+	}
+}
+
+// convertToXMLVolumeAttachment converts a VolumeAttachment to XMLVolumeAttachment.
+func convertToXMLVolumeAttachment(attachment *VolumeAttachment) XMLVolumeAttachment {
+	return XMLVolumeAttachment{
+		VolumeID:            attachment.VolumeID,
+		InstanceID:          attachment.InstanceID,
+		Device:              attachment.Device,
+		Status:              attachment.State,
+		AttachTime:          attachment.AttachTime.Format("2006-01-02T15:04:05.000Z"),
+		DeleteOnTermination: attachment.DeleteOnTermination,
+	}
+}
+
+// convertToXMLAddress converts an Address to XMLAddress.
+func convertToXMLAddress(address *Address) XMLAddress {
+	return XMLAddress{
+		PublicIP:           address.PublicIP,
+		AllocationID:       address.AllocationID,
+		Domain:             address.Domain,
+		InstanceID:         address.InstanceID,
+		AssociationID:      address.AssociationID,
+		PrivateIPAddress:   address.PrivateIPAddress,
+		PublicIpv4Pool:     publicIpv4Pool,
+		NetworkBorderGroup: address.NetworkBorderGroup,
+		TagSet:             convertToXMLTagSet(address.Tags),
+	}
+}
+
+// convertToXMLTagSet converts tags to XMLTagSet.
+func convertToXMLTagSet(tags []Tag) XMLTagSet {
+	items := make([]XMLTag, 0, len(tags))
+	for _, t := range tags {
+		items = append(items, XMLTag(t))
+	}
+
+	return XMLTagSet{Items: items}
+}
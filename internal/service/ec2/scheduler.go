@@ -0,0 +1,127 @@
+package ec2
+
+import (
+	"slices"
+	"time"
+)
+
+// Volume states.
+const (
+	VolumeStateCreating  = "creating"
+	VolumeStateAvailable = "available"
+	VolumeStateInUse     = "in-use"
+)
+
+// Volume attachment states.
+const (
+	VolumeAttachmentStateAttaching = "attaching"
+	VolumeAttachmentStateAttached  = "attached"
+	VolumeAttachmentStateDetaching = "detaching"
+)
+
+// defaultVolumeTransitionDelay is how long volumes stay creating, attaching or detaching before the scheduler advances them.
+const defaultVolumeTransitionDelay = 500 * time.Millisecond
+
+// WithVolumeTransitionDelay sets how long volumes stay creating, attaching or detaching before the scheduler advances them.
+func WithVolumeTransitionDelay(d time.Duration) Option {
+	return func(s *MemoryStorage) {
+		s.volumeTransitionDelay = d
+	}
+}
+
+// volumeScheduler periodically advances volumes through their lifecycle.
+func (m *MemoryStorage) volumeScheduler() {
+	ticker := time.NewTicker(max(m.volumeTransitionDelay/5, 10*time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stopScheduler:
+			return
+		case now := <-ticker.C:
+			m.advanceVolumes(now)
+		}
+	}
+}
+
+// advanceVolumes makes the volumes that have been creating for the transition
+// delay available, completes the attachments that have been attaching as long,
+// and removes the attachments that have been detaching as long.
+func (m *MemoryStorage) advanceVolumes(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, volume := range m.Volumes {
+		if !m.due(id, volumePending(volume), now) {
+			continue
+		}
+
+		if volume.State == VolumeStateCreating {
+			volume.State = VolumeStateAvailable
+		}
+
+		for i := range volume.Attachments {
+			if volume.Attachments[i].State == VolumeAttachmentStateAttaching {
+				volume.Attachments[i].State = VolumeAttachmentStateAttached
+			}
+		}
+
+		volume.Attachments = slices.DeleteFunc(volume.Attachments, func(a VolumeAttachment) bool {
+			return a.State == VolumeAttachmentStateDetaching
+		})
+
+		if volume.State == VolumeStateInUse && len(volume.Attachments) == 0 {
+			volume.State = VolumeStateAvailable
+		}
+	}
+
+	// Drop the timers of volumes that no longer exist.
+	for id := range m.transitions {
+		if _, ok := m.Volumes[id]; !ok {
+			delete(m.transitions, id)
+		}
+	}
+}
+
+// due reports whether a pending volume has spent the transition delay in its
+// status. Volumes restored from disk start their timer on the first tick.
+func (m *MemoryStorage) due(id string, pending bool, now time.Time) bool {
+	if !pending {
+		return false
+	}
+
+	changed, ok := m.transitions[id]
+	if !ok {
+		m.transitions[id] = now
+
+		return false
+	}
+
+	if now.Sub(changed) < m.volumeTransitionDelay {
+		return false
+	}
+
+	delete(m.transitions, id)
+
+	return true
+}
+
+// startTransition marks a volume as having just entered a status the scheduler advances.
+func (m *MemoryStorage) startTransition(id string) {
+	m.transitions[id] = time.Now()
+}
+
+// volumePending reports whether the volume is creating, or one of its attachments is attaching or detaching.
+func volumePending(volume *Volume) bool {
+	if volume.State == VolumeStateCreating {
+		return true
+	}
+
+	for _, a := range volume.Attachments {
+		if a.State != VolumeAttachmentStateAttached {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,9 +1,11 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -12,12 +14,18 @@ import (
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"ec2"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
+
+		if delay, err := time.ParseDuration(os.Getenv("KUMO_EC2_VOLUME_TRANSITION_DELAY")); err == nil {
+			opts = append(opts, WithVolumeTransitionDelay(delay))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
 
 // Service implements the EC2 service.
@@ -67,6 +75,7 @@ func (s *Service) Actions() []string {
 		"AuthorizeSecurityGroupEgress",
 		// Key Pair operations
 		"CreateKeyPair",
+		"ImportKeyPair",
 		"DeleteKeyPair",
 		"DescribeKeyPairs",
 		// VPC operations
@@ -85,10 +94,24 @@ func (s *Service) Actions() []string {
 		"CreateRouteTable",
 		"CreateRoute",
 		"AssociateRouteTable",
+		"DisassociateRouteTable",
+		"DeleteRouteTable",
 		"DescribeRouteTables",
 		// NAT Gateway operations
 		"CreateNatGateway",
 		"DescribeNatGateways",
+		// Volume operations
+		"CreateVolume",
+		"DeleteVolume",
+		"DescribeVolumes",
+		"AttachVolume",
+		"DetachVolume",
+		// Elastic IP operations
+		"AllocateAddress",
+		"DescribeAddresses",
+		"ReleaseAddress",
+		"AssociateAddress",
+		"DisassociateAddress",
 	}
 }
 
@@ -110,3 +133,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
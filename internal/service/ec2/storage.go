@@ -1,15 +1,24 @@
 package ec2
 
 import (
+	"cmp"
 	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/md5"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha1"
 	"crypto/sha256"
 	"crypto/x509"
-	"encoding/hex"
+	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -73,14 +82,15 @@ type Storage interface {
 	AuthorizeSecurityGroupEgress(ctx context.Context, groupID string, permissions []IPPermission) error
 
 	// Key Pair operations
-	CreateKeyPair(ctx context.Context, keyName, keyType string) (*KeyPair, error)
+	CreateKeyPair(ctx context.Context, req *CreateKeyPairRequest) (*KeyPair, error)
+	ImportKeyPair(ctx context.Context, req *ImportKeyPairRequest) (*KeyPair, error)
 	DeleteKeyPair(ctx context.Context, keyName, keyPairID string) error
-	DescribeKeyPairs(ctx context.Context, keyNames, keyPairIDs []string) ([]*KeyPair, error)
+	DescribeKeyPairs(ctx context.Context, keyNames, keyPairIDs []string, filters map[string][]string) ([]*KeyPair, error)
 
 	// VPC operations
 	CreateVpc(ctx context.Context, req *CreateVpcRequest) (*Vpc, error)
 	DeleteVpc(ctx context.Context, vpcID string) error
-	DescribeVpcs(ctx context.Context, vpcIDs []string) ([]*Vpc, error)
+	DescribeVpcs(ctx context.Context, vpcIDs []string, filters map[string][]string) ([]*Vpc, error)
 
 	// Subnet operations
 	CreateSubnet(ctx context.Context, req *CreateSubnetRequest) (*Subnet, error)
@@ -96,11 +106,28 @@ type Storage interface {
 	CreateRouteTable(ctx context.Context, req *CreateRouteTableRequest) (*RouteTable, error)
 	CreateRoute(ctx context.Context, req *CreateRouteRequest) error
 	AssociateRouteTable(ctx context.Context, req *AssociateRouteTableRequest) (string, error)
-	DescribeRouteTables(ctx context.Context, rtbIDs []string) ([]*RouteTable, error)
+	DisassociateRouteTable(ctx context.Context, associationID string) error
+	DeleteRouteTable(ctx context.Context, rtbID string) error
+	DescribeRouteTables(ctx context.Context, rtbIDs []string, filters map[string][]string) ([]*RouteTable, error)
 
 	// NAT Gateway operations
 	CreateNatGateway(ctx context.Context, req *CreateNatGatewayRequest) (*NatGateway, error)
 	DescribeNatGateways(ctx context.Context, natgwIDs []string) ([]*NatGateway, error)
+
+	// Volume operations
+	CreateVolume(ctx context.Context, req *CreateVolumeRequest) (*Volume, error)
+	DeleteVolume(ctx context.Context, volumeID string) error
+	DescribeVolumes(ctx context.Context, volumeIDs []string, filters map[string][]string) ([]*Volume, error)
+	AttachVolume(ctx context.Context, req *AttachVolumeRequest) (*VolumeAttachment, error)
+	DetachVolume(ctx context.Context, req *DetachVolumeRequest) (*VolumeAttachment, error)
+
+	// Elastic IP operations
+	AllocateAddress(ctx context.Context, req *AllocateAddressRequest) (*Address, error)
+	DescribeAddresses(ctx context.Context, publicIPs, allocationIDs []string, filters map[string][]string) ([]*Address, error)
+	ReleaseAddress(ctx context.Context, allocationID, publicIP string) error
+	AssociateAddress(ctx context.Context, req *AssociateAddressRequest) (string, error)
+	DisassociateAddress(ctx context.Context, associationID, publicIP string) error
+	Reset(ctx context.Context) error
 }
 
 // InstanceStateChange represents an instance state change.
@@ -129,7 +156,14 @@ type MemoryStorage struct {
 	InternetGateways map[string]*InternetGateway `json:"internetGateways"`
 	RouteTables      map[string]*RouteTable      `json:"routeTables"`
 	NatGateways      map[string]*NatGateway      `json:"natGateways"`
+	Volumes          map[string]*Volume          `json:"volumes"`
+	Addresses        map[string]*Address         `json:"addresses"`
 	dataDir          string
+
+	// transitions records when each volume entered a status the scheduler advances.
+	transitions           map[string]time.Time
+	volumeTransitionDelay time.Duration
+	stopScheduler         chan struct{}
 }
 
 // NewMemoryStorage creates a new in-memory EC2 storage.
@@ -144,6 +178,12 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		InternetGateways: make(map[string]*InternetGateway),
 		RouteTables:      make(map[string]*RouteTable),
 		NatGateways:      make(map[string]*NatGateway),
+		Volumes:          make(map[string]*Volume),
+		Addresses:        make(map[string]*Address),
+
+		transitions:           make(map[string]time.Time),
+		volumeTransitionDelay: defaultVolumeTransitionDelay,
+		stopScheduler:         make(chan struct{}),
 	}
 	for _, o := range opts {
 		o(s)
@@ -153,6 +193,8 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		_ = storage.Load(s.dataDir, "ec2", s)
 	}
 
+	go s.volumeScheduler()
+
 	return s
 }
 
@@ -220,11 +262,21 @@ func (m *MemoryStorage) UnmarshalJSON(data []byte) error {
 		m.NatGateways = make(map[string]*NatGateway)
 	}
 
+	if m.Volumes == nil {
+		m.Volumes = make(map[string]*Volume)
+	}
+
+	if m.Addresses == nil {
+		m.Addresses = make(map[string]*Address)
+	}
+
 	return nil
 }
 
 // Close saves the storage state to disk if persistence is enabled.
 func (m *MemoryStorage) Close() error {
+	close(m.stopScheduler)
+
 	if m.dataDir == "" {
 		return nil
 	}
@@ -236,6 +288,27 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Instances = make(map[string]*Instance)
+	m.Reservations = make(map[string]*Reservation)
+	m.SecurityGroups = make(map[string]*SecurityGroup)
+	m.KeyPairs = make(map[string]*KeyPair)
+	m.Vpcs = make(map[string]*Vpc)
+	m.Subnets = make(map[string]*Subnet)
+	m.InternetGateways = make(map[string]*InternetGateway)
+	m.RouteTables = make(map[string]*RouteTable)
+	m.NatGateways = make(map[string]*NatGateway)
+	m.Volumes = make(map[string]*Volume)
+	m.Addresses = make(map[string]*Address)
+	m.transitions = make(map[string]time.Time)
+
+	return nil
+}
+
 // RunInstances creates new EC2 instances.
 func (m *MemoryStorage) RunInstances(_ context.Context, req *RunInstancesRequest) ([]*Instance, string, error) {
 	m.mu.Lock()
@@ -247,6 +320,12 @@ func (m *MemoryStorage) RunInstances(_ context.Context, req *RunInstancesRequest
 		count = 1
 	}
 
+	if req.KeyName != "" {
+		if _, err := m.findKeyPairByName(req.KeyName); err != nil {
+			return nil, "", err
+		}
+	}
+
 	reservationID := "r-" + generateID()
 	instances := make([]*Instance, 0, count)
 
@@ -293,6 +372,9 @@ func (m *MemoryStorage) TerminateInstances(_ context.Context, instanceIDs []stri
 		prevState := instance.State
 		instance.State = InstanceState{Code: InstanceStateTerminated, Name: InstanceStateNameTerminated}
 
+		m.detachInstanceVolumes(id)
+		m.disassociateInstanceAddress(instance)
+
 		changes = append(changes, InstanceStateChange{
 			InstanceID:    id,
 			CurrentState:  instance.State,
@@ -507,49 +589,129 @@ func (m *MemoryStorage) AuthorizeSecurityGroupEgress(_ context.Context, groupID
 	return nil
 }
 
-// CreateKeyPair creates a new key pair.
-func (m *MemoryStorage) CreateKeyPair(_ context.Context, keyName, _ string) (*KeyPair, error) {
+// CreateKeyPair creates a new key pair, generating its private key.
+func (m *MemoryStorage) CreateKeyPair(_ context.Context, req *CreateKeyPairRequest) (*KeyPair, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	for _, kp := range m.KeyPairs {
-		if kp.KeyName == keyName {
-			return nil, &Error{
-				Code:    "InvalidKeyPair.Duplicate",
-				Message: fmt.Sprintf("The keypair '%s' already exists", keyName),
-			}
-		}
+	if err := m.checkKeyNameAvailable(req.KeyName); err != nil {
+		return nil, err
 	}
 
-	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
-	if err != nil {
-		return nil, &Error{
-			Code:    "InternalError",
-			Message: "Failed to generate key pair",
-		}
+	keyType := req.KeyType
+	if keyType == "" {
+		keyType = KeyTypeRSA
 	}
 
-	pubKey, err := ssh.NewPublicKey(&privateKey.PublicKey)
-	if err != nil {
+	var (
+		privateKey  crypto.Signer
+		fingerprint string
+		keyMaterial []byte
+	)
+
+	switch keyType {
+	case KeyTypeRSA:
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return nil, &Error{Code: "InternalError", Message: "Failed to generate key pair"}
+		}
+
+		der, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+		if err != nil {
+			return nil, &Error{Code: "InternalError", Message: "Failed to encode private key"}
+		}
+
+		// AWS fingerprints the RSA keys it generates with the SHA-1 digest of the private key.
+		digest := sha1.Sum(der) //nolint:gosec // AWS defines the fingerprint as a SHA-1 digest.
+		privateKey, fingerprint = rsaKey, colonHex(digest[:])
+		keyMaterial = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)})
+	case KeyTypeED25519:
+		_, edKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, &Error{Code: "InternalError", Message: "Failed to generate key pair"}
+		}
+
+		block, err := ssh.MarshalPrivateKey(edKey, "")
+		if err != nil {
+			return nil, &Error{Code: "InternalError", Message: "Failed to encode private key"}
+		}
+
+		privateKey, keyMaterial = edKey, pem.EncodeToMemory(block)
+	default:
 		return nil, &Error{
-			Code:    "InternalError",
-			Message: "Failed to generate public key",
+			Code:    errInvalidParameter,
+			Message: fmt.Sprintf("Value (%s) for parameter keyType is invalid. Valid values are rsa and ed25519.", keyType),
 		}
 	}
 
-	fingerprint := generateFingerprint(pubKey.Marshal())
+	pubKey, err := ssh.NewPublicKey(privateKey.Public())
+	if err != nil {
+		return nil, &Error{Code: "InternalError", Message: "Failed to generate public key"}
+	}
 
-	privateKeyPEM := pem.EncodeToMemory(&pem.Block{
-		Type:  "RSA PRIVATE KEY",
-		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
-	})
+	if fingerprint == "" {
+		fingerprint = sha256Fingerprint(pubKey)
+	}
 
 	kp := &KeyPair{
-		KeyName:        keyName,
+		KeyName:        req.KeyName,
 		KeyFingerprint: fingerprint,
 		KeyPairID:      "key-" + generateID(),
-		KeyMaterial:    string(privateKeyPEM),
+		KeyType:        keyType,
+		KeyMaterial:    string(keyMaterial),
+		PublicKey:      authorizedKey(pubKey, req.KeyName),
 		CreateTime:     time.Now(),
+		Tags:           req.Tags,
+	}
+
+	m.KeyPairs[kp.KeyPairID] = kp
+
+	return kp, nil
+}
+
+// ImportKeyPair imports the public key of a key pair generated elsewhere.
+func (m *MemoryStorage) ImportKeyPair(_ context.Context, req *ImportKeyPairRequest) (*KeyPair, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkKeyNameAvailable(req.KeyName); err != nil {
+		return nil, err
+	}
+
+	invalidKey := &Error{Code: "InvalidKey.Format", Message: "Key is not in valid OpenSSH public key format"}
+
+	pubKey, _, _, _, err := ssh.ParseAuthorizedKey(req.PublicKeyMaterial)
+	if err != nil {
+		return nil, invalidKey
+	}
+
+	kp := &KeyPair{
+		KeyName:    req.KeyName,
+		KeyPairID:  "key-" + generateID(),
+		PublicKey:  authorizedKey(pubKey, req.KeyName),
+		CreateTime: time.Now(),
+		Tags:       req.Tags,
+	}
+
+	switch pubKey.Type() {
+	case ssh.KeyAlgoRSA:
+		cryptoKey, ok := pubKey.(ssh.CryptoPublicKey)
+		if !ok {
+			return nil, invalidKey
+		}
+
+		der, err := x509.MarshalPKIXPublicKey(cryptoKey.CryptoPublicKey())
+		if err != nil {
+			return nil, invalidKey
+		}
+
+		// AWS fingerprints imported RSA keys with the MD5 digest of the public key.
+		digest := md5.Sum(der) //nolint:gosec // AWS defines the fingerprint as an MD5 digest.
+		kp.KeyType, kp.KeyFingerprint = KeyTypeRSA, colonHex(digest[:])
+	case ssh.KeyAlgoED25519:
+		kp.KeyType, kp.KeyFingerprint = KeyTypeED25519, sha256Fingerprint(pubKey)
+	default:
+		return nil, invalidKey
 	}
 
 	m.KeyPairs[kp.KeyPairID] = kp
@@ -557,6 +719,18 @@ func (m *MemoryStorage) CreateKeyPair(_ context.Context, keyName, _ string) (*Ke
 	return kp, nil
 }
 
+// checkKeyNameAvailable returns InvalidKeyPair.Duplicate if a key pair is named keyName. Must be called under lock.
+func (m *MemoryStorage) checkKeyNameAvailable(keyName string) error {
+	if _, err := m.findKeyPairByName(keyName); err == nil {
+		return &Error{
+			Code:    "InvalidKeyPair.Duplicate",
+			Message: fmt.Sprintf("The keypair '%s' already exists", keyName),
+		}
+	}
+
+	return nil
+}
+
 // DeleteKeyPair deletes a key pair.
 func (m *MemoryStorage) DeleteKeyPair(_ context.Context, keyName, keyPairID string) error {
 	m.mu.Lock()
@@ -590,20 +764,52 @@ func (m *MemoryStorage) DeleteKeyPair(_ context.Context, keyName, keyPairID stri
 }
 
 // DescribeKeyPairs describes key pairs.
-func (m *MemoryStorage) DescribeKeyPairs(_ context.Context, keyNames, keyPairIDs []string) ([]*KeyPair, error) {
+func (m *MemoryStorage) DescribeKeyPairs(_ context.Context, keyNames, keyPairIDs []string, filters map[string][]string) ([]*KeyPair, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
+	var keyPairs []*KeyPair
+
 	if len(keyNames) == 0 && len(keyPairIDs) == 0 {
-		return m.listAllKeyPairs(), nil
+		keyPairs = m.listAllKeyPairs()
+	} else {
+		keyPairSet, err := m.collectKeyPairs(keyNames, keyPairIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		keyPairs = m.keyPairSetToSlice(keyPairSet)
 	}
 
-	keyPairSet, err := m.collectKeyPairs(keyNames, keyPairIDs)
-	if err != nil {
-		return nil, err
+	return slices.DeleteFunc(keyPairs, func(kp *KeyPair) bool {
+		return !matchKeyPairFilters(kp, filters)
+	}), nil
+}
+
+// matchKeyPairFilters checks if a key pair matches the given filters.
+func matchKeyPairFilters(kp *KeyPair, filters map[string][]string) bool {
+	for key, values := range filters {
+		var got []string
+
+		switch key {
+		case "key-pair-id":
+			got = []string{kp.KeyPairID}
+		case "key-name":
+			got = []string{kp.KeyName}
+		case "fingerprint":
+			got = []string{kp.KeyFingerprint}
+		case "key-type":
+			got = []string{kp.KeyType}
+		default:
+			got = tagFilterValues(kp.Tags, key)
+		}
+
+		if !matchAny(got, values) {
+			return false
+		}
 	}
 
-	return m.keyPairSetToSlice(keyPairSet), nil
+	return true
 }
 
 // listAllKeyPairs returns all key pairs without KeyMaterial.
@@ -676,7 +882,10 @@ func copyKeyPairInfo(kp *KeyPair) *KeyPair {
 		KeyName:        kp.KeyName,
 		KeyFingerprint: kp.KeyFingerprint,
 		KeyPairID:      kp.KeyPairID,
+		KeyType:        kp.KeyType,
+		PublicKey:      kp.PublicKey,
 		CreateTime:     kp.CreateTime,
+		Tags:           slices.Clone(kp.Tags),
 	}
 }
 
@@ -742,25 +951,53 @@ func randByte() int {
 	return int(b[0])
 }
 
-// generateFingerprint generates a fingerprint from a public key.
-func generateFingerprint(pubKey []byte) string {
-	hash := sha256.Sum256(pubKey)
+// sha256Fingerprint returns the base64 SHA-256 digest of a public key, which
+// is how AWS fingerprints ED25519 keys.
+func sha256Fingerprint(pubKey ssh.PublicKey) string {
+	digest := sha256.Sum256(pubKey.Marshal())
+
+	return base64.StdEncoding.EncodeToString(digest[:])
+}
+
+// colonHex formats a digest as colon-separated hex bytes.
+func colonHex(digest []byte) string {
+	parts := make([]string, 0, len(digest))
+	for _, b := range digest {
+		parts = append(parts, fmt.Sprintf("%02x", b))
+	}
+
+	return strings.Join(parts, ":")
+}
 
-	return hex.EncodeToString(hash[:])
+// authorizedKey formats a public key as an OpenSSH authorized_keys line commented with the key name.
+func authorizedKey(pubKey ssh.PublicKey, keyName string) string {
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pubKey))) + " " + keyName
 }
 
-// CreateVpc creates a new VPC.
+// CreateVpc creates a new VPC along with its main route table.
 func (m *MemoryStorage) CreateVpc(_ context.Context, req *CreateVpcRequest) (*Vpc, error) {
+	prefix, err := parseCidrBlock(req.CidrBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if prefix.Bits() < 16 || prefix.Bits() > 28 {
+		return nil, &Error{
+			Code:    "InvalidVpc.Range",
+			Message: fmt.Sprintf("The CIDR '%s' is invalid.", req.CidrBlock),
+		}
+	}
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	vpc := &Vpc{
 		VpcID:           "vpc-" + generateID(),
-		CidrBlock:       req.CidrBlock,
+		CidrBlock:       prefix.String(),
 		State:           "available",
 		IsDefault:       false,
 		InstanceTenancy: req.InstanceTenancy,
-		Tags:            []Tag{},
+		Tags:            tagsOrEmpty(req.Tags),
 	}
 
 	if vpc.InstanceTenancy == "" {
@@ -769,10 +1006,18 @@ func (m *MemoryStorage) CreateVpc(_ context.Context, req *CreateVpcRequest) (*Vp
 
 	m.Vpcs[vpc.VpcID] = vpc
 
+	mainRouteTable := m.newRouteTable(vpc, nil)
+	mainRouteTable.Associations = append(mainRouteTable.Associations, RouteTableAssociation{
+		RouteTableAssociationID: "rtbassoc-" + generateID(),
+		RouteTableID:            mainRouteTable.RouteTableID,
+		Main:                    true,
+		State:                   routeTableAssociationStateAssociated,
+	})
+
 	return vpc, nil
 }
 
-// DeleteVpc deletes a VPC.
+// DeleteVpc deletes a VPC along with its main route table.
 func (m *MemoryStorage) DeleteVpc(_ context.Context, vpcID string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -784,41 +1029,55 @@ func (m *MemoryStorage) DeleteVpc(_ context.Context, vpcID string) error {
 		}
 	}
 
+	dependencyViolation := &Error{
+		Code:    "DependencyViolation",
+		Message: "The vpc has dependencies and cannot be deleted",
+	}
+
 	// Check for dependencies
 	for _, subnet := range m.Subnets {
 		if subnet.VpcID == vpcID {
-			return &Error{
-				Code:    "DependencyViolation",
-				Message: "The vpc has dependencies and cannot be deleted",
-			}
+			return dependencyViolation
 		}
 	}
 
 	for _, igw := range m.InternetGateways {
 		for _, attachment := range igw.Attachments {
 			if attachment.VpcID == vpcID {
-				return &Error{
-					Code:    "DependencyViolation",
-					Message: "The vpc has dependencies and cannot be deleted",
-				}
+				return dependencyViolation
 			}
 		}
 	}
 
+	for _, rt := range m.RouteTables {
+		if rt.VpcID == vpcID && !isMainRouteTable(rt) {
+			return dependencyViolation
+		}
+	}
+
+	for id, rt := range m.RouteTables {
+		if rt.VpcID == vpcID {
+			delete(m.RouteTables, id)
+		}
+	}
+
 	delete(m.Vpcs, vpcID)
 
 	return nil
 }
 
 // DescribeVpcs describes VPCs.
-func (m *MemoryStorage) DescribeVpcs(_ context.Context, vpcIDs []string) ([]*Vpc, error) {
+func (m *MemoryStorage) DescribeVpcs(_ context.Context, vpcIDs []string, filters map[string][]string) ([]*Vpc, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	if len(vpcIDs) == 0 {
 		vpcs := make([]*Vpc, 0, len(m.Vpcs))
+
 		for _, vpc := range m.Vpcs {
-			vpcs = append(vpcs, vpc)
+			if matchVpcFilters(vpc, filters) {
+				vpcs = append(vpcs, vpc)
+			}
 		}
 
 		return vpcs, nil
@@ -835,33 +1094,68 @@ func (m *MemoryStorage) DescribeVpcs(_ context.Context, vpcIDs []string) ([]*Vpc
 			}
 		}
 
-		vpcs = append(vpcs, vpc)
+		if matchVpcFilters(vpc, filters) {
+			vpcs = append(vpcs, vpc)
+		}
 	}
 
 	return vpcs, nil
 }
 
+// matchVpcFilters checks if a VPC matches the given filters.
+func matchVpcFilters(vpc *Vpc, filters map[string][]string) bool {
+	for key, values := range filters {
+		var got []string
+
+		switch key {
+		case "vpc-id":
+			got = []string{vpc.VpcID}
+		case "cidr", "cidr-block-association.cidr-block":
+			got = []string{vpc.CidrBlock}
+		case "state":
+			got = []string{vpc.State}
+		case "is-default":
+			got = []string{strconv.FormatBool(vpc.IsDefault)}
+		default:
+			got = tagFilterValues(vpc.Tags, key)
+		}
+
+		if !matchAny(got, values) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // CreateSubnet creates a new subnet.
 func (m *MemoryStorage) CreateSubnet(_ context.Context, req *CreateSubnetRequest) (*Subnet, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if _, exists := m.Vpcs[req.VpcID]; !exists {
+	vpc, exists := m.Vpcs[req.VpcID]
+	if !exists {
 		return nil, &Error{
 			Code:    "InvalidVpcID.NotFound",
 			Message: fmt.Sprintf("The vpc ID '%s' does not exist", req.VpcID),
 		}
 	}
 
+	prefix, err := m.subnetCidrBlock(vpc, req.CidrBlock)
+	if err != nil {
+		return nil, err
+	}
+
 	subnet := &Subnet{
-		SubnetID:                "subnet-" + generateID(),
-		VpcID:                   req.VpcID,
-		CidrBlock:               req.CidrBlock,
-		AvailabilityZone:        req.AvailabilityZone,
-		AvailableIPAddressCount: 251, // Default available IPs for /24
+		SubnetID:         "subnet-" + generateID(),
+		VpcID:            req.VpcID,
+		CidrBlock:        prefix.String(),
+		AvailabilityZone: req.AvailabilityZone,
+		// AWS reserves the first four and the last address of every subnet.
+		AvailableIPAddressCount: 1<<(32-prefix.Bits()) - 5,
 		State:                   "available",
 		MapPublicIPOnLaunch:     false,
-		Tags:                    []Tag{},
+		Tags:                    tagsOrEmpty(req.Tags),
 	}
 
 	if subnet.AvailabilityZone == "" {
@@ -873,6 +1167,36 @@ func (m *MemoryStorage) CreateSubnet(_ context.Context, req *CreateSubnetRequest
 	return subnet, nil
 }
 
+// subnetCidrBlock validates the CIDR block of a new subnet of the VPC: it must
+// lie within the VPC CIDR block and not overlap the other subnets of the VPC.
+// Must be called under lock.
+func (m *MemoryStorage) subnetCidrBlock(vpc *Vpc, cidrBlock string) (netip.Prefix, error) {
+	prefix, err := parseCidrBlock(cidrBlock)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	vpcPrefix := netip.MustParsePrefix(vpc.CidrBlock)
+
+	if prefix.Bits() < vpcPrefix.Bits() || prefix.Bits() > 28 || !vpcPrefix.Contains(prefix.Addr()) {
+		return netip.Prefix{}, &Error{
+			Code:    "InvalidSubnet.Range",
+			Message: fmt.Sprintf("The CIDR '%s' is invalid.", cidrBlock),
+		}
+	}
+
+	for _, other := range m.Subnets {
+		if other.VpcID == vpc.VpcID && netip.MustParsePrefix(other.CidrBlock).Overlaps(prefix) {
+			return netip.Prefix{}, &Error{
+				Code:    "InvalidSubnet.Conflict",
+				Message: fmt.Sprintf("The CIDR '%s' conflicts with another subnet", cidrBlock),
+			}
+		}
+	}
+
+	return prefix, nil
+}
+
 // DeleteSubnet deletes a subnet.
 func (m *MemoryStorage) DeleteSubnet(_ context.Context, subnetID string) error {
 	m.mu.Lock()
@@ -887,6 +1211,13 @@ func (m *MemoryStorage) DeleteSubnet(_ context.Context, subnetID string) error {
 
 	delete(m.Subnets, subnetID)
 
+	// Deleting a subnet removes its route table association.
+	for _, rt := range m.RouteTables {
+		rt.Associations = slices.DeleteFunc(rt.Associations, func(a RouteTableAssociation) bool {
+			return a.SubnetID == subnetID
+		})
+	}
+
 	return nil
 }
 
@@ -926,20 +1257,26 @@ func (m *MemoryStorage) DescribeSubnets(_ context.Context, subnetIDs []string, f
 
 // matchSubnetFilters checks if a subnet matches the given filters.
 func (m *MemoryStorage) matchSubnetFilters(subnet *Subnet, filters map[string][]string) bool {
-	if len(filters) == 0 {
-		return true
-	}
-
 	for key, values := range filters {
+		var got []string
+
 		switch key {
+		case "subnet-id":
+			got = []string{subnet.SubnetID}
 		case "vpc-id":
-			if !containsString(values, subnet.VpcID) {
-				return false
-			}
+			got = []string{subnet.VpcID}
 		case "availability-zone":
-			if !containsString(values, subnet.AvailabilityZone) {
-				return false
-			}
+			got = []string{subnet.AvailabilityZone}
+		case "cidr-block", "cidr", "cidrBlock":
+			got = []string{subnet.CidrBlock}
+		case "state":
+			got = []string{subnet.State}
+		default:
+			got = tagFilterValues(subnet.Tags, key)
+		}
+
+		if !matchAny(got, values) {
+			return false
 		}
 	}
 
@@ -1036,30 +1373,43 @@ func (m *MemoryStorage) CreateRouteTable(_ context.Context, req *CreateRouteTabl
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if _, exists := m.Vpcs[req.VpcID]; !exists {
+	vpc, exists := m.Vpcs[req.VpcID]
+	if !exists {
 		return nil, &Error{
 			Code:    "InvalidVpcID.NotFound",
 			Message: fmt.Sprintf("The vpc ID '%s' does not exist", req.VpcID),
 		}
 	}
 
+	return m.newRouteTable(vpc, req.Tags), nil
+}
+
+// newRouteTable stores a new route table of the VPC with the local route to
+// the VPC CIDR block. Must be called under lock.
+func (m *MemoryStorage) newRouteTable(vpc *Vpc, tags []Tag) *RouteTable {
 	rt := &RouteTable{
 		RouteTableID: "rtb-" + generateID(),
-		VpcID:        req.VpcID,
+		VpcID:        vpc.VpcID,
 		Routes: []Route{
 			{
-				DestinationCidrBlock: "local",
+				DestinationCidrBlock: vpc.CidrBlock,
 				GatewayID:            "local",
 				State:                "active",
+				Origin:               "CreateRouteTable",
 			},
 		},
 		Associations: []RouteTableAssociation{},
-		Tags:         []Tag{},
+		Tags:         tagsOrEmpty(tags),
 	}
 
 	m.RouteTables[rt.RouteTableID] = rt
 
-	return rt, nil
+	return rt
+}
+
+// isMainRouteTable reports whether the route table is the main route table of its VPC.
+func isMainRouteTable(rt *RouteTable) bool {
+	return slices.ContainsFunc(rt.Associations, func(a RouteTableAssociation) bool { return a.Main })
 }
 
 // CreateRoute creates a route in a route table.
@@ -1090,6 +1440,7 @@ func (m *MemoryStorage) CreateRoute(_ context.Context, req *CreateRouteRequest)
 		GatewayID:            req.GatewayID,
 		NatGatewayID:         req.NatGatewayID,
 		State:                "active",
+		Origin:               "CreateRoute",
 	}
 
 	rt.Routes = append(rt.Routes, route)
@@ -1110,33 +1461,112 @@ func (m *MemoryStorage) AssociateRouteTable(_ context.Context, req *AssociateRou
 		}
 	}
 
-	if _, exists := m.Subnets[req.SubnetID]; !exists {
+	subnet, exists := m.Subnets[req.SubnetID]
+	if !exists {
 		return "", &Error{
 			Code:    "InvalidSubnetID.NotFound",
 			Message: fmt.Sprintf("The subnet ID '%s' does not exist", req.SubnetID),
 		}
 	}
 
+	if subnet.VpcID != rt.VpcID {
+		return "", &Error{
+			Code:    errInvalidParameter,
+			Message: fmt.Sprintf("Route table %s and subnet %s belong to different networks", req.RouteTableID, req.SubnetID),
+		}
+	}
+
+	for _, other := range m.RouteTables {
+		for _, a := range other.Associations {
+			if a.SubnetID == req.SubnetID {
+				return "", &Error{
+					Code:    "Resource.AlreadyAssociated",
+					Message: fmt.Sprintf("the specified association for route table %s conflicts with an existing association", req.RouteTableID),
+				}
+			}
+		}
+	}
+
 	associationID := "rtbassoc-" + generateID()
 	rt.Associations = append(rt.Associations, RouteTableAssociation{
 		RouteTableAssociationID: associationID,
 		RouteTableID:            req.RouteTableID,
 		SubnetID:                req.SubnetID,
 		Main:                    false,
+		State:                   routeTableAssociationStateAssociated,
 	})
 
 	return associationID, nil
 }
 
+// DisassociateRouteTable removes the association of a route table with a subnet.
+func (m *MemoryStorage) DisassociateRouteTable(_ context.Context, associationID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, rt := range m.RouteTables {
+		i := slices.IndexFunc(rt.Associations, func(a RouteTableAssociation) bool {
+			return a.RouteTableAssociationID == associationID
+		})
+		if i < 0 {
+			continue
+		}
+
+		if rt.Associations[i].Main {
+			return &Error{
+				Code:    errInvalidParameter,
+				Message: fmt.Sprintf("The association '%s' is the main route table association and cannot be disassociated", associationID),
+			}
+		}
+
+		rt.Associations = slices.Delete(rt.Associations, i, i+1)
+
+		return nil
+	}
+
+	return &Error{
+		Code:    "InvalidAssociationID.NotFound",
+		Message: fmt.Sprintf("The association ID '%s' does not exist", associationID),
+	}
+}
+
+// DeleteRouteTable deletes a route table that is neither main nor associated with a subnet.
+func (m *MemoryStorage) DeleteRouteTable(_ context.Context, rtbID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rt, exists := m.RouteTables[rtbID]
+	if !exists {
+		return &Error{
+			Code:    "InvalidRouteTableID.NotFound",
+			Message: fmt.Sprintf("The routeTable ID '%s' does not exist", rtbID),
+		}
+	}
+
+	if len(rt.Associations) > 0 {
+		return &Error{
+			Code:    "DependencyViolation",
+			Message: fmt.Sprintf("The routeTable '%s' has dependencies and cannot be deleted.", rtbID),
+		}
+	}
+
+	delete(m.RouteTables, rtbID)
+
+	return nil
+}
+
 // DescribeRouteTables describes route tables.
-func (m *MemoryStorage) DescribeRouteTables(_ context.Context, rtbIDs []string) ([]*RouteTable, error) {
+func (m *MemoryStorage) DescribeRouteTables(_ context.Context, rtbIDs []string, filters map[string][]string) ([]*RouteTable, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	if len(rtbIDs) == 0 {
 		rts := make([]*RouteTable, 0, len(m.RouteTables))
+
 		for _, rt := range m.RouteTables {
-			rts = append(rts, rt)
+			if matchRouteTableFilters(rt, filters) {
+				rts = append(rts, rt)
+			}
 		}
 
 		return rts, nil
@@ -1153,12 +1583,50 @@ func (m *MemoryStorage) DescribeRouteTables(_ context.Context, rtbIDs []string)
 			}
 		}
 
-		rts = append(rts, rt)
+		if matchRouteTableFilters(rt, filters) {
+			rts = append(rts, rt)
+		}
 	}
 
 	return rts, nil
 }
 
+// matchRouteTableFilters checks if a route table matches the given filters.
+func matchRouteTableFilters(rt *RouteTable, filters map[string][]string) bool {
+	for key, values := range filters {
+		var got []string
+
+		switch key {
+		case "route-table-id":
+			got = []string{rt.RouteTableID}
+		case "vpc-id":
+			got = []string{rt.VpcID}
+		case "association.main":
+			got = []string{strconv.FormatBool(isMainRouteTable(rt))}
+		case "association.subnet-id":
+			for _, a := range rt.Associations {
+				got = append(got, a.SubnetID)
+			}
+		case "association.route-table-association-id":
+			for _, a := range rt.Associations {
+				got = append(got, a.RouteTableAssociationID)
+			}
+		case "route.destination-cidr-block":
+			for _, r := range rt.Routes {
+				got = append(got, r.DestinationCidrBlock)
+			}
+		default:
+			got = tagFilterValues(rt.Tags, key)
+		}
+
+		if !matchAny(got, values) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // CreateNatGateway creates a new NAT gateway.
 func (m *MemoryStorage) CreateNatGateway(_ context.Context, req *CreateNatGatewayRequest) (*NatGateway, error) {
 	m.mu.Lock()
@@ -1233,3 +1701,644 @@ func containsString(slice []string, s string) bool {
 
 	return false
 }
+
+// volumeSizeLimits holds the minimum and maximum size in GiB of each volume type.
+var volumeSizeLimits = map[string][2]int{
+	"standard": {1, 1024},
+	"gp2":      {1, 16384},
+	"gp3":      {1, 16384},
+	"io1":      {4, 16384},
+	"io2":      {4, 65536},
+	"st1":      {125, 16384},
+	"sc1":      {125, 16384},
+}
+
+// CreateVolume creates a new EBS volume, which stays creating until the scheduler makes it available.
+func (m *MemoryStorage) CreateVolume(_ context.Context, req *CreateVolumeRequest) (*Volume, error) {
+	if err := validateCreateVolume(req); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	volumeType := req.VolumeType
+	if volumeType == "" {
+		volumeType = "gp2"
+	}
+
+	volume := &Volume{
+		VolumeID:         "vol-" + generateID(),
+		Size:             req.Size,
+		AvailabilityZone: req.AvailabilityZone,
+		VolumeType:       volumeType,
+		Iops:             req.Iops,
+		Throughput:       req.Throughput,
+		Encrypted:        req.Encrypted || req.KmsKeyID != "",
+		KmsKeyID:         req.KmsKeyID,
+		State:            VolumeStateCreating,
+		CreateTime:       time.Now(),
+		Attachments:      []VolumeAttachment{},
+		Tags:             req.Tags,
+	}
+
+	switch volumeType {
+	case "gp2":
+		// gp2 volumes get 3 IOPS per GiB, between 100 and 16000.
+		volume.Iops = min(max(3*req.Size, 100), 16000)
+	case "gp3":
+		volume.Iops = cmp.Or(req.Iops, 3000)
+		volume.Throughput = cmp.Or(req.Throughput, 125)
+	}
+
+	m.Volumes[volume.VolumeID] = volume
+	m.startTransition(volume.VolumeID)
+
+	return cloneVolume(volume), nil
+}
+
+// validateCreateVolume checks the size, type and IOPS of a CreateVolume request.
+func validateCreateVolume(req *CreateVolumeRequest) error {
+	if req.AvailabilityZone == "" {
+		return &Error{Code: "MissingParameter", Message: "The request must contain the parameter AvailabilityZone"}
+	}
+
+	if req.SnapshotID != "" {
+		return &Error{
+			Code:    "InvalidSnapshot.NotFound",
+			Message: fmt.Sprintf("The snapshot '%s' does not exist.", req.SnapshotID),
+		}
+	}
+
+	if req.Size == 0 {
+		return &Error{Code: "MissingParameter", Message: "The request must contain the parameter size or snapshotId"}
+	}
+
+	volumeType := cmp.Or(req.VolumeType, "gp2")
+
+	limits, ok := volumeSizeLimits[volumeType]
+	if !ok {
+		return &Error{
+			Code:    errInvalidParameter,
+			Message: fmt.Sprintf("Value (%s) for parameter volumeType is invalid.", volumeType),
+		}
+	}
+
+	if req.Size < limits[0] || req.Size > limits[1] {
+		return &Error{
+			Code:    errInvalidParameter,
+			Message: fmt.Sprintf("Volume of %dGiB is out of range for %s volumes; the size must be between %d and %d GiB.", req.Size, volumeType, limits[0], limits[1]),
+		}
+	}
+
+	if (volumeType == "io1" || volumeType == "io2") && req.Iops == 0 {
+		return &Error{
+			Code:    "MissingParameter",
+			Message: fmt.Sprintf("The parameter iops must be specified for %s volumes.", volumeType),
+		}
+	}
+
+	return nil
+}
+
+// DeleteVolume deletes an available EBS volume.
+func (m *MemoryStorage) DeleteVolume(_ context.Context, volumeID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	volume, err := m.volume(volumeID)
+	if err != nil {
+		return err
+	}
+
+	switch volume.State {
+	case VolumeStateAvailable:
+	case VolumeStateInUse:
+		return &Error{
+			Code:    "VolumeInUse",
+			Message: fmt.Sprintf("Volume %s is currently attached to %s", volumeID, volume.Attachments[0].InstanceID),
+		}
+	default:
+		return &Error{
+			Code:    "IncorrectState",
+			Message: fmt.Sprintf("The volume '%s' is '%s'", volumeID, volume.State),
+		}
+	}
+
+	delete(m.Volumes, volumeID)
+	delete(m.transitions, volumeID)
+
+	return nil
+}
+
+// DescribeVolumes describes EBS volumes.
+func (m *MemoryStorage) DescribeVolumes(_ context.Context, volumeIDs []string, filters map[string][]string) ([]*Volume, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var volumes []*Volume
+
+	if len(volumeIDs) == 0 {
+		for _, volume := range m.Volumes {
+			if matchVolumeFilters(volume, filters) {
+				volumes = append(volumes, cloneVolume(volume))
+			}
+		}
+
+		return volumes, nil
+	}
+
+	for _, id := range volumeIDs {
+		volume, err := m.volume(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if matchVolumeFilters(volume, filters) {
+			volumes = append(volumes, cloneVolume(volume))
+		}
+	}
+
+	return volumes, nil
+}
+
+// AttachVolume attaches an available volume to an instance. The volume is
+// in-use at once, and the attachment stays attaching until the scheduler
+// completes it.
+func (m *MemoryStorage) AttachVolume(_ context.Context, req *AttachVolumeRequest) (*VolumeAttachment, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	volume, err := m.volume(req.VolumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	instance, exists := m.Instances[req.InstanceID]
+	if !exists {
+		return nil, &Error{
+			Code:    "InvalidInstanceID.NotFound",
+			Message: fmt.Sprintf("The instance ID '%s' does not exist", req.InstanceID),
+		}
+	}
+
+	if instance.State.Name != InstanceStateNameRunning && instance.State.Name != InstanceStateNameStopped {
+		return nil, &Error{
+			Code:    "IncorrectInstanceState",
+			Message: fmt.Sprintf("The instance '%s' is not 'running' or 'stopped'", req.InstanceID),
+		}
+	}
+
+	if volume.State != VolumeStateAvailable {
+		return nil, &Error{
+			Code:    "IncorrectState",
+			Message: fmt.Sprintf("The volume '%s' is not 'available'", req.VolumeID),
+		}
+	}
+
+	for _, other := range m.Volumes {
+		for _, a := range other.Attachments {
+			if a.InstanceID == req.InstanceID && a.Device == req.Device {
+				return nil, &Error{
+					Code:    errInvalidParameter,
+					Message: fmt.Sprintf("Invalid value '%s' for unixDevice. Attachment point %s is already in use", req.Device, req.Device),
+				}
+			}
+		}
+	}
+
+	attachment := VolumeAttachment{
+		VolumeID:   req.VolumeID,
+		InstanceID: req.InstanceID,
+		Device:     req.Device,
+		State:      VolumeAttachmentStateAttaching,
+		AttachTime: time.Now(),
+	}
+
+	volume.State = VolumeStateInUse
+	volume.Attachments = []VolumeAttachment{attachment}
+	m.startTransition(volume.VolumeID)
+
+	return &attachment, nil
+}
+
+// DetachVolume detaches an attached volume from its instance. The attachment
+// stays detaching until the scheduler removes it and makes the volume available.
+func (m *MemoryStorage) DetachVolume(_ context.Context, req *DetachVolumeRequest) (*VolumeAttachment, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	volume, err := m.volume(req.VolumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if volume.State != VolumeStateInUse || volume.Attachments[0].State == VolumeAttachmentStateDetaching {
+		return nil, &Error{
+			Code:    "IncorrectState",
+			Message: fmt.Sprintf("Volume '%s' is in the '%s' state.", req.VolumeID, volume.State),
+		}
+	}
+
+	attachment := &volume.Attachments[0]
+
+	if (req.InstanceID != "" && req.InstanceID != attachment.InstanceID) || (req.Device != "" && req.Device != attachment.Device) {
+		return nil, &Error{
+			Code:    "InvalidAttachment.NotFound",
+			Message: fmt.Sprintf("Volume '%s' is not attached to instance '%s' at '%s'", req.VolumeID, cmp.Or(req.InstanceID, attachment.InstanceID), cmp.Or(req.Device, attachment.Device)),
+		}
+	}
+
+	attachment.State = VolumeAttachmentStateDetaching
+	m.startTransition(volume.VolumeID)
+
+	detached := *attachment
+
+	return &detached, nil
+}
+
+// detachInstanceVolumes detaches the volumes of a terminated instance. Must be called under lock.
+func (m *MemoryStorage) detachInstanceVolumes(instanceID string) {
+	for id, volume := range m.Volumes {
+		if len(volume.Attachments) == 0 || volume.Attachments[0].InstanceID != instanceID {
+			continue
+		}
+
+		volume.State = VolumeStateAvailable
+		volume.Attachments = []VolumeAttachment{}
+		delete(m.transitions, id)
+	}
+}
+
+// volume returns the volume with the given ID. Must be called under lock.
+func (m *MemoryStorage) volume(volumeID string) (*Volume, error) {
+	volume, exists := m.Volumes[volumeID]
+	if !exists {
+		return nil, &Error{
+			Code:    "InvalidVolume.NotFound",
+			Message: fmt.Sprintf("The volume '%s' does not exist.", volumeID),
+		}
+	}
+
+	return volume, nil
+}
+
+// matchVolumeFilters checks if a volume matches the given filters.
+func matchVolumeFilters(volume *Volume, filters map[string][]string) bool {
+	for key, values := range filters {
+		if !matchAny(volumeFilterValues(volume, key), values) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// volumeFilterValues returns the values a volume has for a DescribeVolumes filter.
+func volumeFilterValues(volume *Volume, key string) []string {
+	var values []string
+
+	switch key {
+	case "volume-id":
+		values = append(values, volume.VolumeID)
+	case "status":
+		values = append(values, volume.State)
+	case "size":
+		values = append(values, strconv.Itoa(volume.Size))
+	case "availability-zone":
+		values = append(values, volume.AvailabilityZone)
+	case "volume-type":
+		values = append(values, volume.VolumeType)
+	case "encrypted":
+		values = append(values, strconv.FormatBool(volume.Encrypted))
+	case "attachment.instance-id":
+		for _, a := range volume.Attachments {
+			values = append(values, a.InstanceID)
+		}
+	case "attachment.device":
+		for _, a := range volume.Attachments {
+			values = append(values, a.Device)
+		}
+	case "attachment.status":
+		for _, a := range volume.Attachments {
+			values = append(values, a.State)
+		}
+	default:
+		values = tagFilterValues(volume.Tags, key)
+	}
+
+	return values
+}
+
+// matchAny reports whether any of the values a resource has for a filter is one of the values the filter accepts.
+func matchAny(got, values []string) bool {
+	return slices.ContainsFunc(got, func(v string) bool { return containsString(values, v) })
+}
+
+// tagFilterValues returns the values tags have for a tag:Key or tag-key filter.
+func tagFilterValues(tags []Tag, key string) []string {
+	var values []string
+
+	tagKey, isTagFilter := strings.CutPrefix(key, "tag:")
+
+	for _, t := range tags {
+		switch {
+		case isTagFilter && t.Key == tagKey:
+			values = append(values, t.Value)
+		case key == "tag-key":
+			values = append(values, t.Key)
+		}
+	}
+
+	return values
+}
+
+// cloneVolume returns a copy of a volume that the scheduler will not modify.
+func cloneVolume(volume *Volume) *Volume {
+	clone := *volume
+	clone.Attachments = slices.Clone(volume.Attachments)
+	clone.Tags = slices.Clone(volume.Tags)
+
+	return &clone
+}
+
+// routeTableAssociationStateAssociated is the state of a route table association once it is in effect.
+const routeTableAssociationStateAssociated = "associated"
+
+// parseCidrBlock parses an IPv4 CIDR block, masking any host bits.
+func parseCidrBlock(cidrBlock string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(cidrBlock)
+	if err != nil || !prefix.Addr().Is4() {
+		return netip.Prefix{}, &Error{
+			Code:    errInvalidParameter,
+			Message: fmt.Sprintf("Value (%s) for parameter cidrBlock is invalid. This is not a valid CIDR block.", cidrBlock),
+		}
+	}
+
+	return prefix.Masked(), nil
+}
+
+// tagsOrEmpty returns the tags, or an empty list if there are none.
+func tagsOrEmpty(tags []Tag) []Tag {
+	if tags == nil {
+		return []Tag{}
+	}
+
+	return tags
+}
+
+// AllocateAddress allocates an Elastic IP address.
+func (m *MemoryStorage) AllocateAddress(_ context.Context, req *AllocateAddressRequest) (*Address, error) {
+	if req.Domain != "" && req.Domain != "vpc" && req.Domain != "standard" {
+		return nil, &Error{
+			Code:    errInvalidParameter,
+			Message: fmt.Sprintf("Value (%s) for parameter domain is invalid.", req.Domain),
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	address := &Address{
+		AllocationID:       "eipalloc-" + generateID(),
+		PublicIP:           m.generatePublicIP(),
+		Domain:             cmp.Or(req.Domain, "vpc"),
+		NetworkBorderGroup: "us-east-1",
+		Tags:               tagsOrEmpty(req.Tags),
+	}
+
+	m.Addresses[address.AllocationID] = address
+
+	return address, nil
+}
+
+// generatePublicIP generates a random public IP address that no Elastic IP uses. Must be called under lock.
+func (m *MemoryStorage) generatePublicIP() string {
+	for {
+		ip := fmt.Sprintf("54.%d.%d.%d", randByte(), randByte(), randByte())
+		if _, err := m.findAddress("", ip); err != nil {
+			return ip
+		}
+	}
+}
+
+// DescribeAddresses describes Elastic IP addresses.
+func (m *MemoryStorage) DescribeAddresses(_ context.Context, publicIPs, allocationIDs []string, filters map[string][]string) ([]*Address, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var addresses []*Address
+
+	if len(publicIPs) == 0 && len(allocationIDs) == 0 {
+		for _, address := range m.Addresses {
+			if matchAddressFilters(address, filters) {
+				addresses = append(addresses, cloneAddress(address))
+			}
+		}
+
+		return addresses, nil
+	}
+
+	for _, ip := range publicIPs {
+		address, err := m.findAddress("", ip)
+		if err != nil {
+			return nil, err
+		}
+
+		if matchAddressFilters(address, filters) {
+			addresses = append(addresses, cloneAddress(address))
+		}
+	}
+
+	for _, id := range allocationIDs {
+		address, err := m.findAddress(id, "")
+		if err != nil {
+			return nil, err
+		}
+
+		if matchAddressFilters(address, filters) {
+			addresses = append(addresses, cloneAddress(address))
+		}
+	}
+
+	return addresses, nil
+}
+
+// ReleaseAddress releases an Elastic IP address that is not associated.
+func (m *MemoryStorage) ReleaseAddress(_ context.Context, allocationID, publicIP string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	address, err := m.findAddress(allocationID, publicIP)
+	if err != nil {
+		return err
+	}
+
+	if address.AssociationID != "" {
+		return &Error{
+			Code:    "InvalidIPAddress.InUse",
+			Message: fmt.Sprintf("Address '%s' is in use.", address.PublicIP),
+		}
+	}
+
+	delete(m.Addresses, address.AllocationID)
+
+	return nil
+}
+
+// AssociateAddress associates an Elastic IP address with an instance, which
+// takes the address as its public IP. An address already associated with the
+// instance is disassociated.
+func (m *MemoryStorage) AssociateAddress(_ context.Context, req *AssociateAddressRequest) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	address, err := m.findAddress(req.AllocationID, req.PublicIP)
+	if err != nil {
+		return "", err
+	}
+
+	instance, exists := m.Instances[req.InstanceID]
+	if !exists {
+		return "", &Error{
+			Code:    "InvalidInstanceID.NotFound",
+			Message: fmt.Sprintf("The instance ID '%s' does not exist", req.InstanceID),
+		}
+	}
+
+	if instance.State.Name != InstanceStateNameRunning && instance.State.Name != InstanceStateNameStopped {
+		return "", &Error{
+			Code:    "IncorrectInstanceState",
+			Message: fmt.Sprintf("The instance '%s' is not in a valid state for this operation.", req.InstanceID),
+		}
+	}
+
+	if address.AssociationID != "" && address.InstanceID != req.InstanceID && !req.AllowReassociation {
+		return "", &Error{
+			Code:    "Resource.AlreadyAssociated",
+			Message: fmt.Sprintf("resource %s is already associated with associate-id %s", address.AllocationID, address.AssociationID),
+		}
+	}
+
+	if address.InstanceID != "" {
+		if previous, ok := m.Instances[address.InstanceID]; ok {
+			m.disassociateInstanceAddress(previous)
+		}
+	}
+
+	m.disassociateInstanceAddress(instance)
+
+	address.AssociationID = "eipassoc-" + generateID()
+	address.InstanceID = instance.InstanceID
+	address.PrivateIPAddress = instance.PrivateIPAddress
+	instance.PublicIPAddress = address.PublicIP
+
+	return address.AssociationID, nil
+}
+
+// DisassociateAddress disassociates an Elastic IP address from its instance.
+func (m *MemoryStorage) DisassociateAddress(_ context.Context, associationID, publicIP string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, address := range m.Addresses {
+		if (associationID != "" && address.AssociationID == associationID) || (associationID == "" && address.PublicIP == publicIP) {
+			if address.AssociationID == "" {
+				break
+			}
+
+			if instance, ok := m.Instances[address.InstanceID]; ok {
+				m.disassociateInstanceAddress(instance)
+			}
+
+			return nil
+		}
+	}
+
+	return &Error{
+		Code:    "InvalidAssociationID.NotFound",
+		Message: fmt.Sprintf("The association ID '%s' does not exist", cmp.Or(associationID, publicIP)),
+	}
+}
+
+// disassociateInstanceAddress disassociates the Elastic IP address associated
+// with the instance, if any, removing the instance public IP. Must be called
+// under lock.
+func (m *MemoryStorage) disassociateInstanceAddress(instance *Instance) {
+	for _, address := range m.Addresses {
+		if address.InstanceID != instance.InstanceID {
+			continue
+		}
+
+		address.AssociationID = ""
+		address.InstanceID = ""
+		address.PrivateIPAddress = ""
+		instance.PublicIPAddress = ""
+	}
+}
+
+// findAddress finds an Elastic IP address by allocation ID, or by public IP if no allocation ID is given. Must be called under lock.
+func (m *MemoryStorage) findAddress(allocationID, publicIP string) (*Address, error) {
+	if allocationID != "" {
+		address, exists := m.Addresses[allocationID]
+		if !exists {
+			return nil, &Error{
+				Code:    "InvalidAllocationID.NotFound",
+				Message: fmt.Sprintf("The allocation ID '%s' does not exist", allocationID),
+			}
+		}
+
+		return address, nil
+	}
+
+	for _, address := range m.Addresses {
+		if address.PublicIP == publicIP {
+			return address, nil
+		}
+	}
+
+	return nil, &Error{
+		Code:    "InvalidAddress.NotFound",
+		Message: fmt.Sprintf("Address '%s' not found.", publicIP),
+	}
+}
+
+// matchAddressFilters checks if an Elastic IP address matches the given filters.
+func matchAddressFilters(address *Address, filters map[string][]string) bool {
+	for key, values := range filters {
+		var got []string
+
+		switch key {
+		case "allocation-id":
+			got = []string{address.AllocationID}
+		case "association-id":
+			got = []string{address.AssociationID}
+		case "instance-id":
+			got = []string{address.InstanceID}
+		case "public-ip":
+			got = []string{address.PublicIP}
+		case "private-ip-address":
+			got = []string{address.PrivateIPAddress}
+		case "domain":
+			got = []string{address.Domain}
+		case "network-border-group":
+			got = []string{address.NetworkBorderGroup}
+		default:
+			got = tagFilterValues(address.Tags, key)
+		}
+
+		if !matchAny(got, values) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// cloneAddress returns a copy of an Elastic IP address.
+func cloneAddress(address *Address) *Address {
+	clone := *address
+	clone.Tags = slices.Clone(address.Tags)
+
+	return &clone
+}
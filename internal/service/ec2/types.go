@@ -1,7 +1,10 @@
 package ec2
 
 import (
+	"encoding/json"
 	"encoding/xml"
+	"fmt"
+	"strconv"
 	"time"
 )
 
@@ -67,11 +70,19 @@ type KeyPair struct {
 	KeyName        string
 	KeyFingerprint string
 	KeyPairID      string
+	KeyType        string
 	KeyMaterial    string
+	PublicKey      string
 	CreateTime     time.Time
 	Tags           []Tag
 }
 
+// Key pair types.
+const (
+	KeyTypeRSA     = "rsa"
+	KeyTypeED25519 = "ed25519"
+)
+
 // RunInstancesRequest represents a RunInstances request.
 type RunInstancesRequest struct {
 	ImageID          string   `json:"ImageId"`
@@ -133,6 +144,43 @@ type AuthorizeSecurityGroupEgressRequest struct {
 type CreateKeyPairRequest struct {
 	KeyName string `json:"KeyName"`
 	KeyType string `json:"KeyType,omitempty"`
+	Tags    []Tag  `json:"-"`
+}
+
+// UnmarshalJSON decodes the request and collects the tags of its key pair tag specifications.
+func (r *CreateKeyPairRequest) UnmarshalJSON(data []byte) error {
+	type alias CreateKeyPairRequest
+
+	params, err := decodeQueryRequest(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+
+	r.Tags = parseTagSpecifications(params, "key-pair")
+
+	return nil
+}
+
+// ImportKeyPairRequest represents an ImportKeyPair request.
+type ImportKeyPairRequest struct {
+	KeyName string `json:"KeyName"`
+	// PublicKeyMaterial is the OpenSSH public key, which the query protocol sends base64 encoded.
+	PublicKeyMaterial []byte `json:"PublicKeyMaterial"`
+	Tags              []Tag  `json:"-"`
+}
+
+// UnmarshalJSON decodes the request and collects the tags of its key pair tag specifications.
+func (r *ImportKeyPairRequest) UnmarshalJSON(data []byte) error {
+	type alias ImportKeyPairRequest
+
+	params, err := decodeQueryRequest(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+
+	r.Tags = parseTagSpecifications(params, "key-pair")
+
+	return nil
 }
 
 // DeleteKeyPairRequest represents a DeleteKeyPair request.
@@ -143,8 +191,24 @@ type DeleteKeyPairRequest struct {
 
 // DescribeKeyPairsRequest represents a DescribeKeyPairs request.
 type DescribeKeyPairsRequest struct {
-	KeyNames   []string `json:"KeyNames,omitempty"`
-	KeyPairIDs []string `json:"KeyPairIds,omitempty"`
+	KeyNames         []string            `json:"KeyNames,omitempty"`
+	KeyPairIDs       []string            `json:"KeyPairIds,omitempty"`
+	IncludePublicKey bool                `json:"IncludePublicKey,omitempty"`
+	Filters          map[string][]string `json:"-"`
+}
+
+// UnmarshalJSON decodes the request and collects its filters.
+func (r *DescribeKeyPairsRequest) UnmarshalJSON(data []byte) error {
+	type alias DescribeKeyPairsRequest
+
+	params, err := decodeQueryRequest(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+
+	r.Filters = parseFilters(params)
+
+	return nil
 }
 
 // XML Response types for EC2.
@@ -285,13 +349,25 @@ type XMLAuthorizeSecurityGroupEgressResponse struct {
 
 // XMLCreateKeyPairResponse is the XML response for CreateKeyPair.
 type XMLCreateKeyPairResponse struct {
-	XMLName        xml.Name `xml:"CreateKeyPairResponse"`
-	Xmlns          string   `xml:"xmlns,attr"`
-	RequestID      string   `xml:"requestId"`
-	KeyName        string   `xml:"keyName"`
-	KeyFingerprint string   `xml:"keyFingerprint"`
-	KeyMaterial    string   `xml:"keyMaterial"`
-	KeyPairID      string   `xml:"keyPairId"`
+	XMLName        xml.Name  `xml:"CreateKeyPairResponse"`
+	Xmlns          string    `xml:"xmlns,attr"`
+	RequestID      string    `xml:"requestId"`
+	KeyName        string    `xml:"keyName"`
+	KeyFingerprint string    `xml:"keyFingerprint"`
+	KeyMaterial    string    `xml:"keyMaterial"`
+	KeyPairID      string    `xml:"keyPairId"`
+	TagSet         XMLTagSet `xml:"tagSet"`
+}
+
+// XMLImportKeyPairResponse is the XML response for ImportKeyPair.
+type XMLImportKeyPairResponse struct {
+	XMLName        xml.Name  `xml:"ImportKeyPairResponse"`
+	Xmlns          string    `xml:"xmlns,attr"`
+	RequestID      string    `xml:"requestId"`
+	KeyName        string    `xml:"keyName"`
+	KeyFingerprint string    `xml:"keyFingerprint"`
+	KeyPairID      string    `xml:"keyPairId"`
+	TagSet         XMLTagSet `xml:"tagSet"`
 }
 
 // XMLDeleteKeyPairResponse is the XML response for DeleteKeyPair.
@@ -317,9 +393,13 @@ type XMLKeyPairSet struct {
 
 // XMLKeyPairInfo represents a key pair in XML format.
 type XMLKeyPairInfo struct {
-	KeyName        string `xml:"keyName"`
-	KeyFingerprint string `xml:"keyFingerprint"`
-	KeyPairID      string `xml:"keyPairId"`
+	KeyName        string    `xml:"keyName"`
+	KeyFingerprint string    `xml:"keyFingerprint"`
+	KeyPairID      string    `xml:"keyPairId"`
+	KeyType        string    `xml:"keyType"`
+	PublicKey      string    `xml:"publicKey,omitempty"`
+	CreateTime     string    `xml:"createTime"`
+	TagSet         XMLTagSet `xml:"tagSet"`
 }
 
 // XMLErrorResponse is the XML error response for EC2.
@@ -412,6 +492,7 @@ type RouteTableAssociation struct {
 	RouteTableID            string
 	SubnetID                string
 	Main                    bool
+	State                   string
 }
 
 // NatGateway represents a NAT gateway.
@@ -431,6 +512,21 @@ type NatGateway struct {
 type CreateVpcRequest struct {
 	CidrBlock       string `json:"CidrBlock"`
 	InstanceTenancy string `json:"InstanceTenancy,omitempty"`
+	Tags            []Tag  `json:"-"`
+}
+
+// UnmarshalJSON decodes the request and collects the tags of its vpc tag specifications.
+func (r *CreateVpcRequest) UnmarshalJSON(data []byte) error {
+	type alias CreateVpcRequest
+
+	params, err := decodeQueryRequest(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+
+	r.Tags = parseTagSpecifications(params, "vpc")
+
+	return nil
 }
 
 // DeleteVpcRequest represents a DeleteVpc request.
@@ -440,7 +536,22 @@ type DeleteVpcRequest struct {
 
 // DescribeVpcsRequest represents a DescribeVpcs request.
 type DescribeVpcsRequest struct {
-	VpcIDs []string `json:"VpcIds,omitempty"`
+	VpcIDs  []string            `json:"VpcIds,omitempty"`
+	Filters map[string][]string `json:"-"`
+}
+
+// UnmarshalJSON decodes the request and collects its filters.
+func (r *DescribeVpcsRequest) UnmarshalJSON(data []byte) error {
+	type alias DescribeVpcsRequest
+
+	params, err := decodeQueryRequest(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+
+	r.Filters = parseFilters(params)
+
+	return nil
 }
 
 // CreateSubnetRequest represents a CreateSubnet request.
@@ -448,6 +559,21 @@ type CreateSubnetRequest struct {
 	VpcID            string `json:"VpcId"`
 	CidrBlock        string `json:"CidrBlock"`
 	AvailabilityZone string `json:"AvailabilityZone,omitempty"`
+	Tags             []Tag  `json:"-"`
+}
+
+// UnmarshalJSON decodes the request and collects the tags of its subnet tag specifications.
+func (r *CreateSubnetRequest) UnmarshalJSON(data []byte) error {
+	type alias CreateSubnetRequest
+
+	params, err := decodeQueryRequest(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+
+	r.Tags = parseTagSpecifications(params, "subnet")
+
+	return nil
 }
 
 // DeleteSubnetRequest represents a DeleteSubnet request.
@@ -457,7 +583,22 @@ type DeleteSubnetRequest struct {
 
 // DescribeSubnetsRequest represents a DescribeSubnets request.
 type DescribeSubnetsRequest struct {
-	SubnetIDs []string `json:"SubnetIds,omitempty"`
+	SubnetIDs []string            `json:"SubnetIds,omitempty"`
+	Filters   map[string][]string `json:"-"`
+}
+
+// UnmarshalJSON decodes the request and collects its filters.
+func (r *DescribeSubnetsRequest) UnmarshalJSON(data []byte) error {
+	type alias DescribeSubnetsRequest
+
+	params, err := decodeQueryRequest(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+
+	r.Filters = parseFilters(params)
+
+	return nil
 }
 
 // CreateInternetGatewayRequest represents a CreateInternetGateway request.
@@ -472,6 +613,21 @@ type AttachInternetGatewayRequest struct {
 // CreateRouteTableRequest represents a CreateRouteTable request.
 type CreateRouteTableRequest struct {
 	VpcID string `json:"VpcId"`
+	Tags  []Tag  `json:"-"`
+}
+
+// UnmarshalJSON decodes the request and collects the tags of its route table tag specifications.
+func (r *CreateRouteTableRequest) UnmarshalJSON(data []byte) error {
+	type alias CreateRouteTableRequest
+
+	params, err := decodeQueryRequest(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+
+	r.Tags = parseTagSpecifications(params, "route-table")
+
+	return nil
 }
 
 // CreateRouteRequest represents a CreateRoute request.
@@ -488,6 +644,16 @@ type AssociateRouteTableRequest struct {
 	SubnetID     string `json:"SubnetId"`
 }
 
+// DisassociateRouteTableRequest represents a DisassociateRouteTable request.
+type DisassociateRouteTableRequest struct {
+	AssociationID string `json:"AssociationId"`
+}
+
+// DeleteRouteTableRequest represents a DeleteRouteTable request.
+type DeleteRouteTableRequest struct {
+	RouteTableID string `json:"RouteTableId"`
+}
+
 // CreateNatGatewayRequest represents a CreateNatGateway request.
 type CreateNatGatewayRequest struct {
 	SubnetID         string `json:"SubnetId"`
@@ -660,10 +826,32 @@ type XMLRouteTableAssociationSet struct {
 
 // XMLRouteTableAssociation represents a route table association in XML format.
 type XMLRouteTableAssociation struct {
-	RouteTableAssociationID string `xml:"routeTableAssociationId"`
-	RouteTableID            string `xml:"routeTableId"`
-	SubnetID                string `xml:"subnetId,omitempty"`
-	Main                    bool   `xml:"main"`
+	RouteTableAssociationID string                   `xml:"routeTableAssociationId"`
+	RouteTableID            string                   `xml:"routeTableId"`
+	SubnetID                string                   `xml:"subnetId,omitempty"`
+	Main                    bool                     `xml:"main"`
+	AssociationState        XMLAssociationStateBlock `xml:"associationState"`
+}
+
+// XMLAssociationStateBlock represents the state of a route table association in XML format.
+type XMLAssociationStateBlock struct {
+	State string `xml:"state"`
+}
+
+// XMLDisassociateRouteTableResponse is the XML response for DisassociateRouteTable.
+type XMLDisassociateRouteTableResponse struct {
+	XMLName   xml.Name `xml:"DisassociateRouteTableResponse"`
+	Xmlns     string   `xml:"xmlns,attr"`
+	RequestID string   `xml:"requestId"`
+	Return    bool     `xml:"return"`
+}
+
+// XMLDeleteRouteTableResponse is the XML response for DeleteRouteTable.
+type XMLDeleteRouteTableResponse struct {
+	XMLName   xml.Name `xml:"DeleteRouteTableResponse"`
+	Xmlns     string   `xml:"xmlns,attr"`
+	RequestID string   `xml:"requestId"`
+	Return    bool     `xml:"return"`
 }
 
 // XMLCreateRouteResponse is the XML response for CreateRoute.
@@ -676,10 +864,11 @@ type XMLCreateRouteResponse struct {
 
 // XMLAssociateRouteTableResponse is the XML response for AssociateRouteTable.
 type XMLAssociateRouteTableResponse struct {
-	XMLName       xml.Name `xml:"AssociateRouteTableResponse"`
-	Xmlns         string   `xml:"xmlns,attr"`
-	RequestID     string   `xml:"requestId"`
-	AssociationID string   `xml:"associationId"`
+	XMLName          xml.Name                 `xml:"AssociateRouteTableResponse"`
+	Xmlns            string                   `xml:"xmlns,attr"`
+	RequestID        string                   `xml:"requestId"`
+	AssociationID    string                   `xml:"associationId"`
+	AssociationState XMLAssociationStateBlock `xml:"associationState"`
 }
 
 // XMLCreateNatGatewayResponse is the XML response for CreateNatGateway.
@@ -707,7 +896,22 @@ type DescribeInternetGatewaysRequest struct {
 
 // DescribeRouteTablesRequest represents a DescribeRouteTables request.
 type DescribeRouteTablesRequest struct {
-	RouteTableIDs []string `json:"RouteTableIds,omitempty"`
+	RouteTableIDs []string            `json:"RouteTableIds,omitempty"`
+	Filters       map[string][]string `json:"-"`
+}
+
+// UnmarshalJSON decodes the request and collects its filters.
+func (r *DescribeRouteTablesRequest) UnmarshalJSON(data []byte) error {
+	type alias DescribeRouteTablesRequest
+
+	params, err := decodeQueryRequest(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+
+	r.Filters = parseFilters(params)
+
+	return nil
 }
 
 // DescribeNatGatewaysRequest represents a DescribeNatGateways request.
@@ -753,3 +957,410 @@ type XMLDescribeNatGatewaysResponse struct {
 type XMLNatGatewaySet struct {
 	Items []XMLNatGateway `xml:"item"`
 }
+
+// Volume Domain Types
+
+// Volume represents an EBS volume.
+type Volume struct {
+	VolumeID         string
+	Size             int
+	AvailabilityZone string
+	VolumeType       string
+	Iops             int
+	Throughput       int
+	Encrypted        bool
+	KmsKeyID         string
+	State            string
+	CreateTime       time.Time
+	Attachments      []VolumeAttachment
+	Tags             []Tag
+}
+
+// VolumeAttachment represents the attachment of a volume to an instance.
+type VolumeAttachment struct {
+	VolumeID            string
+	InstanceID          string
+	Device              string
+	State               string
+	AttachTime          time.Time
+	DeleteOnTermination bool
+}
+
+// Volume Request Types
+
+// CreateVolumeRequest represents a CreateVolume request.
+type CreateVolumeRequest struct {
+	AvailabilityZone string `json:"AvailabilityZone"`
+	Size             int    `json:"Size,omitempty"`
+	VolumeType       string `json:"VolumeType,omitempty"`
+	Iops             int    `json:"Iops,omitempty"`
+	Throughput       int    `json:"Throughput,omitempty"`
+	Encrypted        bool   `json:"Encrypted,omitempty"`
+	KmsKeyID         string `json:"KmsKeyId,omitempty"`
+	SnapshotID       string `json:"SnapshotId,omitempty"`
+	Tags             []Tag  `json:"-"`
+}
+
+// UnmarshalJSON decodes the request and collects the tags of its volume tag specifications.
+func (r *CreateVolumeRequest) UnmarshalJSON(data []byte) error {
+	type alias CreateVolumeRequest
+
+	params, err := decodeQueryRequest(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+
+	r.Tags = parseTagSpecifications(params, "volume")
+
+	return nil
+}
+
+// DeleteVolumeRequest represents a DeleteVolume request.
+type DeleteVolumeRequest struct {
+	VolumeID string `json:"VolumeId"`
+}
+
+// DescribeVolumesRequest represents a DescribeVolumes request.
+type DescribeVolumesRequest struct {
+	VolumeIDs []string            `json:"VolumeIds,omitempty"`
+	Filters   map[string][]string `json:"-"`
+}
+
+// UnmarshalJSON decodes the request and collects its filters.
+func (r *DescribeVolumesRequest) UnmarshalJSON(data []byte) error {
+	type alias DescribeVolumesRequest
+
+	params, err := decodeQueryRequest(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+
+	r.Filters = parseFilters(params)
+
+	return nil
+}
+
+// AttachVolumeRequest represents an AttachVolume request.
+type AttachVolumeRequest struct {
+	VolumeID   string `json:"VolumeId"`
+	InstanceID string `json:"InstanceId"`
+	Device     string `json:"Device"`
+}
+
+// DetachVolumeRequest represents a DetachVolume request.
+type DetachVolumeRequest struct {
+	VolumeID   string `json:"VolumeId"`
+	InstanceID string `json:"InstanceId,omitempty"`
+	Device     string `json:"Device,omitempty"`
+}
+
+// Volume XML Response Types
+
+// XMLCreateVolumeResponse is the XML response for CreateVolume.
+type XMLCreateVolumeResponse struct {
+	XMLName   xml.Name `xml:"CreateVolumeResponse"`
+	Xmlns     string   `xml:"xmlns,attr"`
+	RequestID string   `xml:"requestId"`
+	XMLVolume
+}
+
+// XMLVolume represents a volume in XML format.
+type XMLVolume struct {
+	VolumeID           string                 `xml:"volumeId"`
+	Size               int                    `xml:"size"`
+	SnapshotID         string                 `xml:"snapshotId"`
+	AvailabilityZone   string                 `xml:"availabilityZone"`
+	Status             string                 `xml:"status"`
+	CreateTime         string                 `xml:"createTime"`
+	AttachmentSet      XMLVolumeAttachmentSet `xml:"attachmentSet"`
+	VolumeType         string                 `xml:"volumeType"`
+	Iops               int                    `xml:"iops,omitempty"`
+	Throughput         int                    `xml:"throughput,omitempty"`
+	Encrypted          bool                   `xml:"encrypted"`
+	KmsKeyID           string                 `xml:"kmsKeyId,omitempty"`
+	MultiAttachEnabled bool                   `xml:"multiAttachEnabled"`
+	TagSet             XMLTagSet              `xml:"tagSet"`
+}
+
+// XMLVolumeAttachmentSet contains a list of volume attachments.
+type XMLVolumeAttachmentSet struct {
+	Items []XMLVolumeAttachment `xml:"item"`
+}
+
+// XMLVolumeAttachment represents a volume attachment in XML format.
+type XMLVolumeAttachment struct {
+	VolumeID            string `xml:"volumeId"`
+	InstanceID          string `xml:"instanceId"`
+	Device              string `xml:"device"`
+	Status              string `xml:"status"`
+	AttachTime          string `xml:"attachTime"`
+	DeleteOnTermination bool   `xml:"deleteOnTermination"`
+}
+
+// XMLDescribeVolumesResponse is the XML response for DescribeVolumes.
+type XMLDescribeVolumesResponse struct {
+	XMLName   xml.Name     `xml:"DescribeVolumesResponse"`
+	Xmlns     string       `xml:"xmlns,attr"`
+	RequestID string       `xml:"requestId"`
+	VolumeSet XMLVolumeSet `xml:"volumeSet"`
+}
+
+// XMLVolumeSet contains a list of volumes.
+type XMLVolumeSet struct {
+	Items []XMLVolume `xml:"item"`
+}
+
+// XMLDeleteVolumeResponse is the XML response for DeleteVolume.
+type XMLDeleteVolumeResponse struct {
+	XMLName   xml.Name `xml:"DeleteVolumeResponse"`
+	Xmlns     string   `xml:"xmlns,attr"`
+	RequestID string   `xml:"requestId"`
+	Return    bool     `xml:"return"`
+}
+
+// XMLAttachVolumeResponse is the XML response for AttachVolume.
+type XMLAttachVolumeResponse struct {
+	XMLName   xml.Name `xml:"AttachVolumeResponse"`
+	Xmlns     string   `xml:"xmlns,attr"`
+	RequestID string   `xml:"requestId"`
+	XMLVolumeAttachment
+}
+
+// XMLDetachVolumeResponse is the XML response for DetachVolume.
+type XMLDetachVolumeResponse struct {
+	XMLName   xml.Name `xml:"DetachVolumeResponse"`
+	Xmlns     string   `xml:"xmlns,attr"`
+	RequestID string   `xml:"requestId"`
+	XMLVolumeAttachment
+}
+
+// decodeQueryRequest decodes a query request converted to JSON into v, which
+// must not implement json.Unmarshaler, and returns its raw parameters so that
+// nested parameters like Filter.N.Name can be read.
+func decodeQueryRequest(data []byte, v any) (map[string]any, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal request: %w", err)
+	}
+
+	var params map[string]any
+	if err := json.Unmarshal(data, &params); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal query parameters: %w", err)
+	}
+
+	return params, nil
+}
+
+// parseFilters collects the Filter.N.Name and Filter.N.Value.M parameters into
+// a map of filter names to the values they accept.
+func parseFilters(params map[string]any) map[string][]string {
+	filters := make(map[string][]string)
+
+	for n := 1; ; n++ {
+		prefix := "Filter." + strconv.Itoa(n)
+
+		name, ok := params[prefix+".Name"]
+		if !ok {
+			break
+		}
+
+		values, _ := params[prefix+".Values"].([]any)
+		for _, v := range values {
+			filters[paramString(name)] = append(filters[paramString(name)], paramString(v))
+		}
+	}
+
+	return filters
+}
+
+// parseTagSpecifications collects the tags of the TagSpecification.N parameters
+// that apply to resourceType.
+func parseTagSpecifications(params map[string]any, resourceType string) []Tag {
+	var tags []Tag
+
+	for n := 1; ; n++ {
+		prefix := "TagSpecification." + strconv.Itoa(n)
+
+		rt, ok := params[prefix+".ResourceType"]
+		if !ok {
+			break
+		}
+
+		if paramString(rt) != resourceType {
+			continue
+		}
+
+		for m := 1; ; m++ {
+			tagPrefix := prefix + ".Tag." + strconv.Itoa(m)
+
+			key, ok := params[tagPrefix+".Key"]
+			if !ok {
+				break
+			}
+
+			tags = append(tags, Tag{Key: paramString(key), Value: paramString(params[tagPrefix+".Value"])})
+		}
+	}
+
+	return tags
+}
+
+// paramString formats a query parameter value, which the query protocol
+// conversion may have turned into a number or a boolean, back into a string.
+func paramString(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Elastic IP Domain Types
+
+// publicIpv4Pool is the pool that Elastic IP addresses are allocated from.
+const publicIpv4Pool = "amazon"
+
+// Address represents an Elastic IP address.
+type Address struct {
+	AllocationID       string
+	PublicIP           string
+	Domain             string
+	AssociationID      string
+	InstanceID         string
+	PrivateIPAddress   string
+	NetworkBorderGroup string
+	Tags               []Tag
+}
+
+// Elastic IP Request Types
+
+// AllocateAddressRequest represents an AllocateAddress request.
+type AllocateAddressRequest struct {
+	Domain string `json:"Domain,omitempty"`
+	Tags   []Tag  `json:"-"`
+}
+
+// UnmarshalJSON decodes the request and collects the tags of its Elastic IP tag specifications.
+func (r *AllocateAddressRequest) UnmarshalJSON(data []byte) error {
+	type alias AllocateAddressRequest
+
+	params, err := decodeQueryRequest(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+
+	r.Tags = parseTagSpecifications(params, "elastic-ip")
+
+	return nil
+}
+
+// DescribeAddressesRequest represents a DescribeAddresses request.
+type DescribeAddressesRequest struct {
+	PublicIPs     []string            `json:"PublicIps,omitempty"`
+	AllocationIDs []string            `json:"AllocationIds,omitempty"`
+	Filters       map[string][]string `json:"-"`
+}
+
+// UnmarshalJSON decodes the request and collects its filters.
+func (r *DescribeAddressesRequest) UnmarshalJSON(data []byte) error {
+	type alias DescribeAddressesRequest
+
+	params, err := decodeQueryRequest(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+
+	r.Filters = parseFilters(params)
+
+	return nil
+}
+
+// ReleaseAddressRequest represents a ReleaseAddress request.
+type ReleaseAddressRequest struct {
+	AllocationID string `json:"AllocationId,omitempty"`
+	PublicIP     string `json:"PublicIp,omitempty"`
+}
+
+// AssociateAddressRequest represents an AssociateAddress request.
+type AssociateAddressRequest struct {
+	AllocationID       string `json:"AllocationId,omitempty"`
+	PublicIP           string `json:"PublicIp,omitempty"`
+	InstanceID         string `json:"InstanceId"`
+	AllowReassociation bool   `json:"AllowReassociation,omitempty"`
+}
+
+// DisassociateAddressRequest represents a DisassociateAddress request.
+type DisassociateAddressRequest struct {
+	AssociationID string `json:"AssociationId,omitempty"`
+	PublicIP      string `json:"PublicIp,omitempty"`
+}
+
+// Elastic IP XML Response Types
+
+// XMLAllocateAddressResponse is the XML response for AllocateAddress.
+type XMLAllocateAddressResponse struct {
+	XMLName            xml.Name  `xml:"AllocateAddressResponse"`
+	Xmlns              string    `xml:"xmlns,attr"`
+	RequestID          string    `xml:"requestId"`
+	PublicIP           string    `xml:"publicIp"`
+	Domain             string    `xml:"domain"`
+	AllocationID       string    `xml:"allocationId"`
+	PublicIpv4Pool     string    `xml:"publicIpv4Pool"`
+	NetworkBorderGroup string    `xml:"networkBorderGroup"`
+	TagSet             XMLTagSet `xml:"tagSet"`
+}
+
+// XMLDescribeAddressesResponse is the XML response for DescribeAddresses.
+type XMLDescribeAddressesResponse struct {
+	XMLName      xml.Name      `xml:"DescribeAddressesResponse"`
+	Xmlns        string        `xml:"xmlns,attr"`
+	RequestID    string        `xml:"requestId"`
+	AddressesSet XMLAddressSet `xml:"addressesSet"`
+}
+
+// XMLAddressSet contains a list of Elastic IP addresses.
+type XMLAddressSet struct {
+	Items []XMLAddress `xml:"item"`
+}
+
+// XMLAddress represents an Elastic IP address in XML format.
+type XMLAddress struct {
+	PublicIP           string    `xml:"publicIp"`
+	AllocationID       string    `xml:"allocationId"`
+	Domain             string    `xml:"domain"`
+	InstanceID         string    `xml:"instanceId,omitempty"`
+	AssociationID      string    `xml:"associationId,omitempty"`
+	PrivateIPAddress   string    `xml:"privateIpAddress,omitempty"`
+	PublicIpv4Pool     string    `xml:"publicIpv4Pool"`
+	NetworkBorderGroup string    `xml:"networkBorderGroup"`
+	TagSet             XMLTagSet `xml:"tagSet"`
+}
+
+// XMLReleaseAddressResponse is the XML response for ReleaseAddress.
+type XMLReleaseAddressResponse struct {
+	XMLName   xml.Name `xml:"ReleaseAddressResponse"`
+	Xmlns     string   `xml:"xmlns,attr"`
+	RequestID string   `xml:"requestId"`
+	Return    bool     `xml:"return"`
+}
+
+// XMLAssociateAddressResponse is the XML response for AssociateAddress.
+type XMLAssociateAddressResponse struct {
+	XMLName       xml.Name `xml:"AssociateAddressResponse"`
+	Xmlns         string   `xml:"xmlns,attr"`
+	RequestID     string   `xml:"requestId"`
+	Return        bool     `xml:"return"`
+	AssociationID string   `xml:"associationId"`
+}
+
+// XMLDisassociateAddressResponse is the XML response for DisassociateAddress.
+type XMLDisassociateAddressResponse struct {
+	XMLName   xml.Name `xml:"DisassociateAddressResponse"`
+	Xmlns     string   `xml:"xmlns,attr"`
+	RequestID string   `xml:"requestId"`
+	Return    bool     `xml:"return"`
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
@@ -15,14 +16,19 @@ type handlerFunc func(http.ResponseWriter, *http.Request)
 // getActionHandlers returns a map of action names to handler functions.
 func (s *Service) getActionHandlers() map[string]handlerFunc {
 	return map[string]handlerFunc{
-		"CreateRepository":      s.CreateRepository,
-		"DeleteRepository":      s.DeleteRepository,
-		"DescribeRepositories":  s.DescribeRepositories,
-		"ListImages":            s.ListImages,
-		"PutImage":              s.PutImage,
-		"BatchGetImage":         s.BatchGetImage,
-		"BatchDeleteImage":      s.BatchDeleteImage,
-		"GetAuthorizationToken": s.GetAuthorizationToken,
+		"CreateRepository":            s.CreateRepository,
+		"DeleteRepository":            s.DeleteRepository,
+		"DescribeRepositories":        s.DescribeRepositories,
+		"ListImages":                  s.ListImages,
+		"PutImage":                    s.PutImage,
+		"BatchGetImage":               s.BatchGetImage,
+		"BatchDeleteImage":            s.BatchDeleteImage,
+		"GetAuthorizationToken":       s.GetAuthorizationToken,
+		"PutLifecyclePolicy":          s.PutLifecyclePolicy,
+		"GetLifecyclePolicy":          s.GetLifecyclePolicy,
+		"DeleteLifecyclePolicy":       s.DeleteLifecyclePolicy,
+		"StartLifecyclePolicyPreview": s.StartLifecyclePolicyPreview,
+		"GetLifecyclePolicyPreview":   s.GetLifecyclePolicyPreview,
 	}
 }
 
@@ -247,6 +253,188 @@ func (s *Service) GetAuthorizationToken(w http.ResponseWriter, r *http.Request)
 	writeResponse(w, resp)
 }
 
+// PutLifecyclePolicy handles the PutLifecyclePolicy API.
+func (s *Service) PutLifecyclePolicy(w http.ResponseWriter, r *http.Request) {
+	var req PutLifecyclePolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	policy, err := s.storage.PutLifecyclePolicy(r.Context(), req.RepositoryName, req.LifecyclePolicyText)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	resp := &PutLifecyclePolicyResponse{
+		RegistryID:          policy.RegistryID,
+		RepositoryName:      policy.RepositoryName,
+		LifecyclePolicyText: policy.LifecyclePolicyText,
+	}
+
+	writeResponse(w, resp)
+}
+
+// GetLifecyclePolicy handles the GetLifecyclePolicy API.
+func (s *Service) GetLifecyclePolicy(w http.ResponseWriter, r *http.Request) {
+	var req GetLifecyclePolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	policy, err := s.storage.GetLifecyclePolicy(r.Context(), req.RepositoryName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	resp := &GetLifecyclePolicyResponse{
+		RegistryID:          policy.RegistryID,
+		RepositoryName:      policy.RepositoryName,
+		LifecyclePolicyText: policy.LifecyclePolicyText,
+		LastEvaluatedAt:     float64(policy.LastEvaluatedAt.Unix()),
+	}
+
+	writeResponse(w, resp)
+}
+
+// DeleteLifecyclePolicy handles the DeleteLifecyclePolicy API.
+func (s *Service) DeleteLifecyclePolicy(w http.ResponseWriter, r *http.Request) {
+	var req DeleteLifecyclePolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	policy, err := s.storage.DeleteLifecyclePolicy(r.Context(), req.RepositoryName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	resp := &DeleteLifecyclePolicyResponse{
+		RegistryID:          policy.RegistryID,
+		RepositoryName:      policy.RepositoryName,
+		LifecyclePolicyText: policy.LifecyclePolicyText,
+		LastEvaluatedAt:     float64(policy.LastEvaluatedAt.Unix()),
+	}
+
+	writeResponse(w, resp)
+}
+
+// StartLifecyclePolicyPreview handles the StartLifecyclePolicyPreview API.
+func (s *Service) StartLifecyclePolicyPreview(w http.ResponseWriter, r *http.Request) {
+	var req StartLifecyclePolicyPreviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	preview, err := s.storage.StartLifecyclePolicyPreview(r.Context(), req.RepositoryName, req.LifecyclePolicyText)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	// The preview is evaluated synchronously, but AWS always reports a
+	// freshly started preview as in progress.
+	resp := &StartLifecyclePolicyPreviewResponse{
+		RegistryID:          preview.RegistryID,
+		RepositoryName:      preview.RepositoryName,
+		LifecyclePolicyText: preview.LifecyclePolicyText,
+		Status:              previewStatusInProgress,
+	}
+
+	writeResponse(w, resp)
+}
+
+// GetLifecyclePolicyPreview handles the GetLifecyclePolicyPreview API.
+func (s *Service) GetLifecyclePolicyPreview(w http.ResponseWriter, r *http.Request) {
+	var req GetLifecyclePolicyPreviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	preview, err := s.storage.GetLifecyclePolicyPreview(r.Context(), req.RepositoryName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	results := filterPreviewResults(preview.Results, &req)
+
+	resp := &GetLifecyclePolicyPreviewResponse{
+		RegistryID:          preview.RegistryID,
+		RepositoryName:      preview.RepositoryName,
+		LifecyclePolicyText: preview.LifecyclePolicyText,
+		Status:              preview.Status,
+		PreviewResults:      results,
+		Summary: &LifecyclePolicyPreviewSummary{
+			ExpiringImageTotalCount: len(preview.Results),
+		},
+	}
+
+	writeResponse(w, resp)
+}
+
+// filterPreviewResults applies the imageIds and tagStatus filters of a
+// GetLifecyclePolicyPreview request to the preview results.
+func filterPreviewResults(results []LifecyclePolicyPreviewResult, req *GetLifecyclePolicyPreviewRequest) []LifecyclePolicyPreviewResult {
+	filtered := make([]LifecyclePolicyPreviewResult, 0, len(results))
+
+	for _, result := range results {
+		if len(req.ImageIDs) > 0 && !previewResultMatchesImageIDs(&result, req.ImageIDs) {
+			continue
+		}
+
+		if req.Filter != nil {
+			switch req.Filter.TagStatus {
+			case "TAGGED":
+				if len(result.ImageTags) == 0 {
+					continue
+				}
+			case "UNTAGGED":
+				if len(result.ImageTags) > 0 {
+					continue
+				}
+			}
+		}
+
+		filtered = append(filtered, result)
+	}
+
+	return filtered
+}
+
+// previewResultMatchesImageIDs reports whether a preview result refers to
+// one of the given images.
+func previewResultMatchesImageIDs(result *LifecyclePolicyPreviewResult, imageIDs []ImageIdentifier) bool {
+	for _, id := range imageIDs {
+		if id.ImageDigest != "" && id.ImageDigest == result.ImageDigest {
+			return true
+		}
+
+		if id.ImageTag != "" && slices.Contains(result.ImageTags, id.ImageTag) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // toRepositoryOutput converts a Repository to RepositoryOutput.
 func toRepositoryOutput(repo *Repository) *RepositoryOutput {
 	return &RepositoryOutput{
@@ -306,7 +494,7 @@ func handleError(w http.ResponseWriter, err error) {
 // getErrorStatus returns the HTTP status code for a given error code.
 func getErrorStatus(code string) int {
 	switch code {
-	case errRepositoryNotFound, errImageNotFound:
+	case errRepositoryNotFound, errImageNotFound, errLifecyclePolicyNotFound, errLifecyclePolicyPreviewNotFound:
 		return http.StatusNotFound
 	case errRepositoryAlreadyExists:
 		return http.StatusConflict
@@ -0,0 +1,254 @@
+package ecr
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Lifecycle policy selection and action values.
+const (
+	tagStatusTagged   = "tagged"
+	tagStatusUntagged = "untagged"
+	tagStatusAny      = "any"
+
+	countTypeImageCountMoreThan = "imageCountMoreThan"
+	countTypeSinceImagePushed   = "sinceImagePushed"
+	countUnitDays               = "days"
+
+	lifecycleActionExpire = "expire"
+)
+
+// Lifecycle policy preview statuses.
+const (
+	previewStatusInProgress = "IN_PROGRESS"
+	previewStatusComplete   = "COMPLETE"
+)
+
+// lifecyclePolicyDocument is the parsed form of a lifecycle policy text.
+type lifecyclePolicyDocument struct {
+	Rules []lifecycleRule `json:"rules"`
+}
+
+// lifecycleRule is a single rule of a lifecycle policy.
+type lifecycleRule struct {
+	RulePriority int                `json:"rulePriority"`
+	Description  string             `json:"description,omitempty"`
+	Selection    lifecycleSelection `json:"selection"`
+	Action       lifecycleAction    `json:"action"`
+}
+
+// lifecycleSelection describes which images a lifecycle rule applies to.
+type lifecycleSelection struct {
+	TagStatus      string   `json:"tagStatus"`
+	TagPrefixList  []string `json:"tagPrefixList,omitempty"`
+	TagPatternList []string `json:"tagPatternList,omitempty"`
+	CountType      string   `json:"countType"`
+	CountUnit      string   `json:"countUnit,omitempty"`
+	CountNumber    int      `json:"countNumber"`
+}
+
+// lifecycleAction is the action a lifecycle rule takes on selected images.
+type lifecycleAction struct {
+	Type string `json:"type"`
+}
+
+// parseLifecyclePolicy parses and validates a lifecycle policy text.
+func parseLifecyclePolicy(text string) (*lifecyclePolicyDocument, error) {
+	var doc lifecyclePolicyDocument
+
+	if err := json.Unmarshal([]byte(text), &doc); err != nil {
+		return nil, lifecyclePolicyValidationError("invalid JSON: " + err.Error())
+	}
+
+	if len(doc.Rules) == 0 {
+		return nil, lifecyclePolicyValidationError("at least one rule is required")
+	}
+
+	priorities := make(map[int]bool, len(doc.Rules))
+	maxPriority := 0
+
+	for i := range doc.Rules {
+		rule := &doc.Rules[i]
+
+		if err := validateLifecycleRule(rule); err != nil {
+			return nil, err
+		}
+
+		if priorities[rule.RulePriority] {
+			return nil, lifecyclePolicyValidationError(fmt.Sprintf("rulePriority %d is used more than once", rule.RulePriority))
+		}
+
+		priorities[rule.RulePriority] = true
+		maxPriority = max(maxPriority, rule.RulePriority)
+	}
+
+	for _, rule := range doc.Rules {
+		if rule.Selection.TagStatus == tagStatusAny && rule.RulePriority != maxPriority {
+			return nil, lifecyclePolicyValidationError("a rule with tagStatus 'any' must have the highest rulePriority")
+		}
+	}
+
+	sort.Slice(doc.Rules, func(i, j int) bool {
+		return doc.Rules[i].RulePriority < doc.Rules[j].RulePriority
+	})
+
+	return &doc, nil
+}
+
+// validateLifecycleRule validates a single lifecycle rule.
+func validateLifecycleRule(rule *lifecycleRule) error {
+	if rule.RulePriority < 1 {
+		return lifecyclePolicyValidationError("rulePriority must be a positive integer")
+	}
+
+	sel := rule.Selection
+
+	switch sel.TagStatus {
+	case tagStatusTagged:
+		if len(sel.TagPrefixList) == 0 && len(sel.TagPatternList) == 0 {
+			return lifecyclePolicyValidationError("tagPrefixList or tagPatternList is required when tagStatus is 'tagged'")
+		}
+
+		if len(sel.TagPrefixList) > 0 && len(sel.TagPatternList) > 0 {
+			return lifecyclePolicyValidationError("tagPrefixList and tagPatternList cannot both be specified")
+		}
+	case tagStatusUntagged, tagStatusAny:
+		if len(sel.TagPrefixList) > 0 || len(sel.TagPatternList) > 0 {
+			return lifecyclePolicyValidationError("tagPrefixList and tagPatternList are only allowed when tagStatus is 'tagged'")
+		}
+	default:
+		return lifecyclePolicyValidationError(fmt.Sprintf("invalid tagStatus '%s'", sel.TagStatus))
+	}
+
+	switch sel.CountType {
+	case countTypeImageCountMoreThan:
+		if sel.CountUnit != "" {
+			return lifecyclePolicyValidationError("countUnit is not allowed when countType is 'imageCountMoreThan'")
+		}
+	case countTypeSinceImagePushed:
+		if sel.CountUnit != countUnitDays {
+			return lifecyclePolicyValidationError("countUnit must be 'days' when countType is 'sinceImagePushed'")
+		}
+	default:
+		return lifecyclePolicyValidationError(fmt.Sprintf("invalid countType '%s'", sel.CountType))
+	}
+
+	if sel.CountNumber < 1 {
+		return lifecyclePolicyValidationError("countNumber must be a positive integer")
+	}
+
+	if rule.Action.Type != lifecycleActionExpire {
+		return lifecyclePolicyValidationError(fmt.Sprintf("invalid action type '%s'", rule.Action.Type))
+	}
+
+	return nil
+}
+
+// lifecyclePolicyValidationError builds the error returned for an invalid
+// lifecycle policy.
+func lifecyclePolicyValidationError(reason string) error {
+	return &ServiceError{
+		Code: errInvalidParameter,
+		Message: "Invalid parameter at 'LifecyclePolicyText' failed to satisfy constraint: " +
+			"'Lifecycle policy validation failure: " + reason + "'",
+	}
+}
+
+// evaluateLifecyclePolicy returns the images the policy would expire. Rules
+// are applied in priority order, and an image selected by one rule is never
+// considered by a rule with a lower priority, whether or not it expired.
+func evaluateLifecyclePolicy(doc *lifecyclePolicyDocument, images []*Image, now time.Time) []LifecyclePolicyPreviewResult {
+	sorted := make([]*Image, len(images))
+	copy(sorted, images)
+
+	// Newest first, so imageCountMoreThan keeps the most recent pushes.
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].PushedAt.After(sorted[j].PushedAt)
+	})
+
+	claimed := make(map[string]bool, len(sorted))
+
+	var results []LifecyclePolicyPreviewResult
+
+	for _, rule := range doc.Rules {
+		var selected []*Image
+
+		for _, img := range sorted {
+			if claimed[img.ImageDigest] || !rule.Selection.matches(img) {
+				continue
+			}
+
+			claimed[img.ImageDigest] = true
+
+			selected = append(selected, img)
+		}
+
+		for i, img := range selected {
+			if !rule.Selection.expires(i, img, now) {
+				continue
+			}
+
+			results = append(results, LifecyclePolicyPreviewResult{
+				ImageTags:           imageTags(img),
+				ImageDigest:         img.ImageDigest,
+				ImagePushedAt:       float64(img.PushedAt.Unix()),
+				Action:              &LifecyclePolicyRuleAction{Type: strings.ToUpper(rule.Action.Type)},
+				AppliedRulePriority: rule.RulePriority,
+			})
+		}
+	}
+
+	return results
+}
+
+// matches reports whether the image satisfies the selection's tag criteria.
+func (sel *lifecycleSelection) matches(img *Image) bool {
+	tags := imageTags(img)
+
+	switch sel.TagStatus {
+	case tagStatusUntagged:
+		return len(tags) == 0
+	case tagStatusTagged:
+		for _, tag := range tags {
+			for _, prefix := range sel.TagPrefixList {
+				if strings.HasPrefix(tag, prefix) {
+					return true
+				}
+			}
+
+			for _, pattern := range sel.TagPatternList {
+				if ok, _ := path.Match(pattern, tag); ok {
+					return true
+				}
+			}
+		}
+
+		return false
+	default:
+		return true
+	}
+}
+
+// expires reports whether a selected image is expired by the selection's
+// count criteria. rank is the image's position among the rule's selected
+// images, newest first.
+func (sel *lifecycleSelection) expires(rank int, img *Image, now time.Time) bool {
+	if sel.CountType == countTypeImageCountMoreThan {
+		return rank >= sel.CountNumber
+	}
+
+	return now.Sub(img.PushedAt) > time.Duration(sel.CountNumber)*24*time.Hour
+}
+
+// imageTags returns the tags of an image.
+func imageTags(img *Image) []string {
+	if img.ImageID == nil || img.ImageID.ImageTag == "" {
+		return nil
+	}
+
+	return []string{img.ImageID.ImageTag}
+}
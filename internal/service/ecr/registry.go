@@ -0,0 +1,414 @@
+package ecr
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Registry API error codes.
+const (
+	registryErrUnauthorized        = "UNAUTHORIZED"
+	registryErrDenied              = "DENIED"
+	registryErrNameUnknown         = "NAME_UNKNOWN"
+	registryErrBlobUnknown         = "BLOB_UNKNOWN"
+	registryErrBlobUploadUnknown   = "BLOB_UPLOAD_UNKNOWN"
+	registryErrDigestInvalid       = "DIGEST_INVALID"
+	registryErrManifestUnknown     = "MANIFEST_UNKNOWN"
+	registryErrManifestInvalid     = "MANIFEST_INVALID"
+	registryErrManifestBlobUnknown = "MANIFEST_BLOB_UNKNOWN"
+	registryErrUnsupported         = "UNSUPPORTED"
+)
+
+// defaultManifestMediaType is the media type of manifests that do not declare one.
+const defaultManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+
+// registryErrorResponse is the error body of the registry API.
+type registryErrorResponse struct {
+	Errors []registryError `json:"errors"`
+}
+
+// registryError is a single error of the registry API.
+type registryError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// registryManifest holds the fields of an image manifest or image index that
+// name the content it references.
+type registryManifest struct {
+	MediaType string                `json:"mediaType"`
+	Config    *registryDescriptor   `json:"config"`
+	Layers    []registryDescriptor  `json:"layers"`
+	Manifests []*registryDescriptor `json:"manifests"`
+}
+
+// registryDescriptor references content by digest.
+type registryDescriptor struct {
+	Digest string `json:"digest"`
+}
+
+// Registry handles the Docker Registry HTTP API V2 under /v2/, which docker
+// uses to push and pull the images of the repositories. Requests must carry
+// the basic auth credentials of a token returned by GetAuthorizationToken.
+func (s *Service) Registry(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Docker-Distribution-API-Version", "registry/2.0")
+
+	if !s.authenticateRegistry(w, r) {
+		return
+	}
+
+	path := r.PathValue("path")
+	if path == "" {
+		writeRegistryJSON(w, http.StatusOK, struct{}{})
+
+		return
+	}
+
+	name, kind, ref, ok := parseRegistryPath(path)
+
+	switch {
+	case !ok:
+		writeRegistryError(w, registryErrUnsupported, "The operation is unsupported.", http.StatusNotFound)
+	case kind == "manifests" && (r.Method == http.MethodGet || r.Method == http.MethodHead):
+		s.getManifest(w, r, name, ref)
+	case kind == "manifests" && r.Method == http.MethodPut:
+		s.putManifest(w, r, name, ref)
+	case kind == "blobs" && (r.Method == http.MethodGet || r.Method == http.MethodHead):
+		s.getBlob(w, r, name, ref)
+	case kind == "uploads" && r.Method == http.MethodPost && ref == "":
+		s.startBlobUpload(w, r, name)
+	case kind == "uploads" && r.Method == http.MethodPatch:
+		s.appendBlobUpload(w, r, name, ref)
+	case kind == "uploads" && r.Method == http.MethodPut:
+		s.completeBlobUpload(w, r, name, ref)
+	default:
+		writeRegistryError(w, registryErrUnsupported, "The operation is unsupported.", http.StatusMethodNotAllowed)
+	}
+}
+
+// authenticateRegistry checks the basic auth credentials of a registry
+// request, writing a challenge if they are missing or not accepted.
+func (s *Service) authenticateRegistry(w http.ResponseWriter, r *http.Request) bool {
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		w.Header().Set("WWW-Authenticate", `Basic realm="kumo",service="ecr.amazonaws.com"`)
+		writeRegistryError(w, registryErrUnauthorized, "Not Authorized", http.StatusUnauthorized)
+
+		return false
+	}
+
+	if err := s.storage.ValidateAuthorizationToken(r.Context(), username, password); err != nil {
+		w.Header().Set("WWW-Authenticate", `Basic realm="kumo",service="ecr.amazonaws.com"`)
+		writeRegistryError(w, registryErrDenied, err.Error(), http.StatusUnauthorized)
+
+		return false
+	}
+
+	return true
+}
+
+// getManifest handles GET and HEAD /v2/{name}/manifests/{reference}.
+func (s *Service) getManifest(w http.ResponseWriter, r *http.Request, name, ref string) {
+	id := ImageIdentifier{ImageTag: ref}
+	if strings.HasPrefix(ref, "sha256:") {
+		id = ImageIdentifier{ImageDigest: ref}
+	}
+
+	images, _, err := s.storage.BatchGetImage(r.Context(), name, []ImageIdentifier{id})
+	if err != nil {
+		handleRegistryError(w, err)
+
+		return
+	}
+
+	if len(images) == 0 {
+		writeRegistryError(w, registryErrManifestUnknown, "Requested image not found", http.StatusNotFound)
+
+		return
+	}
+
+	img := images[0]
+
+	var manifest registryManifest
+
+	_ = json.Unmarshal([]byte(img.ImageManifest), &manifest)
+
+	mediaType := manifest.MediaType
+	if mediaType == "" {
+		mediaType = defaultManifestMediaType
+	}
+
+	w.Header().Set("Content-Type", mediaType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(img.ImageManifest)))
+	w.Header().Set("Docker-Content-Digest", img.ImageDigest)
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method == http.MethodGet {
+		_, _ = io.WriteString(w, img.ImageManifest)
+	}
+}
+
+// putManifest handles PUT /v2/{name}/manifests/{reference}. The content the
+// manifest references must have been pushed to the repository first.
+func (s *Service) putManifest(w http.ResponseWriter, r *http.Request, name, ref string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeRegistryError(w, registryErrManifestInvalid, "Failed to read the manifest", http.StatusBadRequest)
+
+		return
+	}
+
+	var manifest registryManifest
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		writeRegistryError(w, registryErrManifestInvalid, "The manifest is not valid JSON", http.StatusBadRequest)
+
+		return
+	}
+
+	digest := calculateDigest(string(body))
+
+	tag := ref
+	if strings.HasPrefix(ref, "sha256:") {
+		if ref != digest {
+			writeRegistryError(w, registryErrDigestInvalid, "The manifest digest does not match "+ref, http.StatusBadRequest)
+
+			return
+		}
+
+		tag = ""
+	}
+
+	if err := s.checkManifestContent(r, name, &manifest); err != nil {
+		handleRegistryError(w, err)
+
+		return
+	}
+
+	if _, err := s.storage.PutImage(r.Context(), name, string(body), tag); err != nil {
+		handleRegistryError(w, err)
+
+		return
+	}
+
+	w.Header().Set("Location", "/v2/"+name+"/manifests/"+digest)
+	w.Header().Set("Docker-Content-Digest", digest)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// checkManifestContent checks that the blobs, or for an image index the
+// manifests, referenced by the manifest exist in the repository.
+func (s *Service) checkManifestContent(r *http.Request, name string, manifest *registryManifest) error {
+	for _, m := range manifest.Manifests {
+		images, _, err := s.storage.BatchGetImage(r.Context(), name, []ImageIdentifier{{ImageDigest: m.Digest}})
+		if err != nil {
+			return err
+		}
+
+		if len(images) == 0 {
+			return &ServiceError{Code: registryErrManifestBlobUnknown, Message: "The manifest with digest '" + m.Digest + "' does not exist"}
+		}
+	}
+
+	digests := make([]string, 0, len(manifest.Layers)+1)
+	if manifest.Config != nil {
+		digests = append(digests, manifest.Config.Digest)
+	}
+
+	for _, layer := range manifest.Layers {
+		digests = append(digests, layer.Digest)
+	}
+
+	for _, digest := range digests {
+		if _, err := s.storage.GetBlob(r.Context(), name, digest); err != nil {
+			var svcErr *ServiceError
+			if errors.As(err, &svcErr) && svcErr.Code == errLayersNotFound {
+				return &ServiceError{Code: registryErrManifestBlobUnknown, Message: svcErr.Message}
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getBlob handles GET and HEAD /v2/{name}/blobs/{digest}.
+func (s *Service) getBlob(w http.ResponseWriter, r *http.Request, name, digest string) {
+	data, err := s.storage.GetBlob(r.Context(), name, digest)
+	if err != nil {
+		handleRegistryError(w, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Header().Set("Docker-Content-Digest", digest)
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method == http.MethodGet {
+		_, _ = w.Write(data)
+	}
+}
+
+// startBlobUpload handles POST /v2/{name}/blobs/uploads/. It mounts the blob
+// from another repository when asked with mount and from, pushes the body as
+// the whole blob when given its digest, and otherwise starts an upload that
+// the client pushes in chunks.
+func (s *Service) startBlobUpload(w http.ResponseWriter, r *http.Request, name string) {
+	query := r.URL.Query()
+
+	if mount, from := query.Get("mount"), query.Get("from"); mount != "" && from != "" {
+		if err := s.storage.MountBlob(r.Context(), name, from, mount); err == nil {
+			writeBlobCreated(w, name, mount)
+
+			return
+		}
+	}
+
+	uploadID, err := s.storage.StartBlobUpload(r.Context(), name)
+	if err != nil {
+		handleRegistryError(w, err)
+
+		return
+	}
+
+	if digest := query.Get("digest"); digest != "" {
+		s.finishBlobUpload(w, r, name, uploadID, digest)
+
+		return
+	}
+
+	writeBlobUploadAccepted(w, name, uploadID, 0)
+}
+
+// appendBlobUpload handles PATCH /v2/{name}/blobs/uploads/{uploadID}.
+func (s *Service) appendBlobUpload(w http.ResponseWriter, r *http.Request, name, uploadID string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeRegistryError(w, registryErrBlobUploadUnknown, "Failed to read the blob", http.StatusBadRequest)
+
+		return
+	}
+
+	size, err := s.storage.AppendBlobUpload(r.Context(), name, uploadID, body)
+	if err != nil {
+		handleRegistryError(w, err)
+
+		return
+	}
+
+	writeBlobUploadAccepted(w, name, uploadID, size)
+}
+
+// completeBlobUpload handles PUT /v2/{name}/blobs/uploads/{uploadID}?digest=.
+func (s *Service) completeBlobUpload(w http.ResponseWriter, r *http.Request, name, uploadID string) {
+	digest := r.URL.Query().Get("digest")
+	if digest == "" {
+		writeRegistryError(w, registryErrDigestInvalid, "The digest parameter is required", http.StatusBadRequest)
+
+		return
+	}
+
+	s.finishBlobUpload(w, r, name, uploadID, digest)
+}
+
+// finishBlobUpload stores the blob of an upload, with the request body as its last chunk.
+func (s *Service) finishBlobUpload(w http.ResponseWriter, r *http.Request, name, uploadID, digest string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeRegistryError(w, registryErrBlobUploadUnknown, "Failed to read the blob", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.CompleteBlobUpload(r.Context(), name, uploadID, digest, body); err != nil {
+		handleRegistryError(w, err)
+
+		return
+	}
+
+	writeBlobCreated(w, name, digest)
+}
+
+// parseRegistryPath splits the path of a registry request below /v2/, such
+// as "team/app/blobs/uploads/{uploadID}", into the repository name, the kind
+// of resource ("manifests", "blobs" or "uploads") and its reference.
+func parseRegistryPath(path string) (string, string, string, bool) {
+	for _, kind := range []string{"uploads", "blobs", "manifests"} {
+		sep := "/" + kind + "/"
+		if kind == "uploads" {
+			sep = "/blobs/uploads/"
+		}
+
+		if i := strings.LastIndex(path, sep); i > 0 {
+			return path[:i], kind, path[i+len(sep):], true
+		}
+	}
+
+	return "", "", "", false
+}
+
+// writeBlobCreated writes the response for a blob that was stored.
+func writeBlobCreated(w http.ResponseWriter, name, digest string) {
+	w.Header().Set("Location", "/v2/"+name+"/blobs/"+digest)
+	w.Header().Set("Docker-Content-Digest", digest)
+	w.Header().Set("Content-Length", "0")
+	w.WriteHeader(http.StatusCreated)
+}
+
+// writeBlobUploadAccepted writes the response for a blob upload that awaits
+// more chunks, with the number of bytes uploaded so far.
+func writeBlobUploadAccepted(w http.ResponseWriter, name, uploadID string, size int) {
+	w.Header().Set("Location", "/v2/"+name+"/blobs/uploads/"+uploadID)
+	w.Header().Set("Docker-Upload-UUID", uploadID)
+	w.Header().Set("Range", "0-"+strconv.Itoa(max(size-1, 0)))
+	w.Header().Set("Content-Length", "0")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// handleRegistryError writes a storage error in the format of the registry API.
+func handleRegistryError(w http.ResponseWriter, err error) {
+	var svcErr *ServiceError
+	if !errors.As(err, &svcErr) {
+		writeRegistryError(w, registryErrUnsupported, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	switch svcErr.Code {
+	case errRepositoryNotFound:
+		writeRegistryError(w, registryErrNameUnknown, svcErr.Message, http.StatusNotFound)
+	case errLayersNotFound:
+		writeRegistryError(w, registryErrBlobUnknown, svcErr.Message, http.StatusNotFound)
+	case errUploadNotFound:
+		writeRegistryError(w, registryErrBlobUploadUnknown, svcErr.Message, http.StatusNotFound)
+	case errInvalidLayer:
+		writeRegistryError(w, registryErrDigestInvalid, svcErr.Message, http.StatusBadRequest)
+	case errImageNotFound:
+		writeRegistryError(w, registryErrManifestUnknown, svcErr.Message, http.StatusNotFound)
+	default:
+		writeRegistryError(w, svcErr.Code, svcErr.Message, http.StatusBadRequest)
+	}
+}
+
+// writeRegistryError writes an error of the registry API.
+func writeRegistryError(w http.ResponseWriter, code, message string, status int) {
+	writeRegistryJSON(w, status, registryErrorResponse{
+		Errors: []registryError{{Code: code, Message: message}},
+	})
+}
+
+// writeRegistryJSON writes a JSON response of the registry API.
+func writeRegistryJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("x-amzn-RequestId", uuid.New().String())
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
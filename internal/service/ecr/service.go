@@ -2,6 +2,7 @@
 package ecr
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -9,6 +10,8 @@ import (
 	"github.com/sivchari/kumo/internal/service"
 )
 
+const defaultBaseURL = "http://localhost:4566"
+
 // Compile-time check that Service implements io.Closer.
 var _ io.Closer = (*Service)(nil)
 
@@ -38,18 +41,29 @@ func (s *Service) TargetPrefix() string {
 func (s *Service) JSONProtocol() {}
 
 // RegisterRoutes registers routes for the service.
-func (s *Service) RegisterRoutes(_ service.Router) {
-	// ECR uses AWS JSON 1.1 protocol with X-Amz-Target header.
-	// Routes are dispatched by the server based on X-Amz-Target.
+// ECR uses AWS JSON 1.1 protocol with X-Amz-Target header, dispatched by the
+// server based on X-Amz-Target, so only the registry API is registered here.
+func (s *Service) RegisterRoutes(r service.Router) {
+	for _, method := range []string{"GET", "HEAD", "POST", "PUT", "PATCH"} {
+		r.HandleFunc(method, "/v2/{path...}", s.Registry)
+	}
 }
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"ecr"}, func(dataDir string) []service.Service {
+		baseURL := defaultBaseURL
+
+		if port := os.Getenv("KUMO_PORT"); port != "" {
+			baseURL = fmt.Sprintf("http://localhost:%s", port)
+		}
+
+		opts := []Option{WithBaseURL(baseURL)}
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
 
 // Close saves the storage state if persistence is enabled.
@@ -62,3 +76,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/sivchari/kumo/internal/storage"
 )
 
@@ -19,8 +21,20 @@ const (
 	errRepositoryAlreadyExists = "RepositoryAlreadyExistsException"
 	errImageNotFound           = "ImageNotFoundException"
 	errInvalidParameter        = "InvalidParameterException"
+
+	errLifecyclePolicyNotFound        = "LifecyclePolicyNotFoundException"
+	errLifecyclePolicyPreviewNotFound = "LifecyclePolicyPreviewNotFoundException"
+
+	errLayersNotFound  = "LayersNotFoundException"
+	errUploadNotFound  = "UploadNotFoundException"
+	errInvalidLayer    = "InvalidLayerException"
+	errTokenInvalid    = "InvalidAuthorizationTokenException"
+	errTokenHasExpired = "ExpiredAuthorizationTokenException"
 )
 
+// authorizationTokenTTL is how long a token returned by GetAuthorizationToken is accepted.
+const authorizationTokenTTL = 12 * time.Hour
+
 // Storage defines the ECR storage interface.
 type Storage interface {
 	CreateRepository(ctx context.Context, req *CreateRepositoryRequest) (*Repository, error)
@@ -31,7 +45,19 @@ type Storage interface {
 	BatchGetImage(ctx context.Context, repositoryName string, imageIDs []ImageIdentifier) ([]*Image, []ImageFailure, error)
 	BatchDeleteImage(ctx context.Context, repositoryName string, imageIDs []ImageIdentifier) ([]ImageIdentifier, []ImageFailure, error)
 	GetAuthorizationToken(ctx context.Context) ([]AuthorizationData, error)
+	ValidateAuthorizationToken(ctx context.Context, username, password string) error
+	GetBlob(ctx context.Context, repositoryName, digest string) ([]byte, error)
+	MountBlob(ctx context.Context, repositoryName, fromRepositoryName, digest string) error
+	StartBlobUpload(ctx context.Context, repositoryName string) (string, error)
+	AppendBlobUpload(ctx context.Context, repositoryName, uploadID string, data []byte) (int, error)
+	CompleteBlobUpload(ctx context.Context, repositoryName, uploadID, digest string, data []byte) error
+	PutLifecyclePolicy(ctx context.Context, repositoryName, policyText string) (*LifecyclePolicy, error)
+	GetLifecyclePolicy(ctx context.Context, repositoryName string) (*LifecyclePolicy, error)
+	DeleteLifecyclePolicy(ctx context.Context, repositoryName string) (*LifecyclePolicy, error)
+	StartLifecyclePolicyPreview(ctx context.Context, repositoryName, policyText string) (*LifecyclePolicyPreview, error)
+	GetLifecyclePolicyPreview(ctx context.Context, repositoryName string) (*LifecyclePolicyPreview, error)
 	DispatchAction(action string) bool
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -44,6 +70,13 @@ func WithDataDir(dir string) Option {
 	}
 }
 
+// WithBaseURL sets the URL of kumo, returned as the registry endpoint.
+func WithBaseURL(url string) Option {
+	return func(s *MemoryStorage) {
+		s.baseURL = url
+	}
+}
+
 // Compile-time interface checks.
 var (
 	_ json.Marshaler   = (*MemoryStorage)(nil)
@@ -56,13 +89,26 @@ type MemoryStorage struct {
 	Repositories map[string]*repositoryData `json:"repositories"`
 	region       string
 	accountID    string
+	baseURL      string
 	dataDir      string
+	tokens       map[string]time.Time   // key: token password, value: expiry
+	uploads      map[string]*blobUpload // key: upload ID
 }
 
-// repositoryData holds repository information and its images.
+// repositoryData holds repository information, its images and its lifecycle
+// policy.
 type repositoryData struct {
-	Repository *Repository       `json:"repository"`
-	Images     map[string]*Image `json:"images"`
+	Repository      *Repository             `json:"repository"`
+	Images          map[string]*Image       `json:"images"`
+	LifecyclePolicy *LifecyclePolicy        `json:"lifecyclePolicy,omitempty"`
+	PolicyPreview   *LifecyclePolicyPreview `json:"policyPreview,omitempty"`
+	Blobs           map[string][]byte       `json:"blobs,omitempty"` // key: digest
+}
+
+// blobUpload is a blob being pushed to a repository through the registry API.
+type blobUpload struct {
+	repositoryName string
+	data           []byte
 }
 
 // NewMemoryStorage creates a new in-memory storage.
@@ -71,6 +117,9 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		Repositories: make(map[string]*repositoryData),
 		region:       "us-east-1",
 		accountID:    "000000000000",
+		baseURL:      "http://localhost:4566",
+		tokens:       make(map[string]time.Time),
+		uploads:      make(map[string]*blobUpload),
 	}
 	for _, o := range opts {
 		o(s)
@@ -131,6 +180,18 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Repositories = make(map[string]*repositoryData)
+	s.tokens = make(map[string]time.Time)
+	s.uploads = make(map[string]*blobUpload)
+
+	return nil
+}
+
 // CreateRepository creates a new repository.
 func (s *MemoryStorage) CreateRepository(_ context.Context, req *CreateRepositoryRequest) (*Repository, error) {
 	s.mu.Lock()
@@ -290,6 +351,15 @@ func (s *MemoryStorage) PutImage(_ context.Context, repositoryName, imageManifes
 
 	digest := calculateDigest(imageManifest)
 
+	// A tag names one image at a time, so pushing it again moves it.
+	if imageTag != "" {
+		for _, other := range rd.Images {
+			if other.ImageDigest != digest && other.ImageID.ImageTag == imageTag {
+				other.ImageID.ImageTag = ""
+			}
+		}
+	}
+
 	img := &Image{
 		RegistryID:     s.accountID,
 		RepositoryName: repositoryName,
@@ -390,20 +460,337 @@ func (s *MemoryStorage) BatchDeleteImage(_ context.Context, repositoryName strin
 	return deleted, failures, nil
 }
 
-// GetAuthorizationToken returns authorization tokens.
+// GetAuthorizationToken issues a token for the registry API. The token is the
+// base64 encoding of "AWS:<password>", the basic auth credentials the
+// registry accepts until the token expires.
 func (s *MemoryStorage) GetAuthorizationToken(_ context.Context) ([]AuthorizationData, error) {
-	token := base64.StdEncoding.EncodeToString([]byte("AWS:password"))
-	expiresAt := time.Now().Add(12 * time.Hour)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+
+	for password, expiresAt := range s.tokens {
+		if now.After(expiresAt) {
+			delete(s.tokens, password)
+		}
+	}
+
+	password := uuid.New().String()
+	expiresAt := now.Add(authorizationTokenTTL)
+	s.tokens[password] = expiresAt
 
 	return []AuthorizationData{
 		{
-			AuthorizationToken: token,
+			AuthorizationToken: base64.StdEncoding.EncodeToString([]byte("AWS:" + password)),
 			ExpiresAt:          float64(expiresAt.Unix()),
-			ProxyEndpoint:      fmt.Sprintf("https://%s.dkr.ecr.%s.amazonaws.com", s.accountID, s.region),
+			ProxyEndpoint:      s.baseURL,
 		},
 	}, nil
 }
 
+// ValidateAuthorizationToken checks basic auth credentials taken from a
+// token issued by GetAuthorizationToken.
+func (s *MemoryStorage) ValidateAuthorizationToken(_ context.Context, username, password string) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	expiresAt, exists := s.tokens[password]
+	if username != "AWS" || !exists {
+		return &ServiceError{Code: errTokenInvalid, Message: "Your authorization token is invalid."}
+	}
+
+	if time.Now().After(expiresAt) {
+		return &ServiceError{Code: errTokenHasExpired, Message: "Your authorization token has expired. Reauthenticate and try again."}
+	}
+
+	return nil
+}
+
+// GetBlob returns the content of a blob pushed to a repository.
+func (s *MemoryStorage) GetBlob(_ context.Context, repositoryName, digest string) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rd, exists := s.Repositories[repositoryName]
+	if !exists {
+		return nil, s.repositoryNotFoundError(repositoryName)
+	}
+
+	data, exists := rd.Blobs[digest]
+	if !exists {
+		return nil, s.layerNotFoundError(repositoryName, digest)
+	}
+
+	return data, nil
+}
+
+// MountBlob makes a blob of another repository available in a repository
+// without uploading it again.
+func (s *MemoryStorage) MountBlob(_ context.Context, repositoryName, fromRepositoryName, digest string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rd, exists := s.Repositories[repositoryName]
+	if !exists {
+		return s.repositoryNotFoundError(repositoryName)
+	}
+
+	from, exists := s.Repositories[fromRepositoryName]
+	if !exists {
+		return s.repositoryNotFoundError(fromRepositoryName)
+	}
+
+	data, exists := from.Blobs[digest]
+	if !exists {
+		return s.layerNotFoundError(fromRepositoryName, digest)
+	}
+
+	rd.putBlob(digest, data)
+
+	return nil
+}
+
+// StartBlobUpload starts pushing a blob to a repository and returns the upload ID.
+func (s *MemoryStorage) StartBlobUpload(_ context.Context, repositoryName string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.Repositories[repositoryName]; !exists {
+		return "", s.repositoryNotFoundError(repositoryName)
+	}
+
+	uploadID := uuid.New().String()
+	s.uploads[uploadID] = &blobUpload{repositoryName: repositoryName}
+
+	return uploadID, nil
+}
+
+// AppendBlobUpload appends a chunk to a blob upload and returns the number
+// of bytes uploaded so far.
+func (s *MemoryStorage) AppendBlobUpload(_ context.Context, repositoryName, uploadID string, data []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	upload, err := s.blobUpload(repositoryName, uploadID)
+	if err != nil {
+		return 0, err
+	}
+
+	upload.data = append(upload.data, data...)
+
+	return len(upload.data), nil
+}
+
+// CompleteBlobUpload appends the last chunk to a blob upload and stores the
+// blob, which must match the digest.
+func (s *MemoryStorage) CompleteBlobUpload(_ context.Context, repositoryName, uploadID, digest string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	upload, err := s.blobUpload(repositoryName, uploadID)
+	if err != nil {
+		return err
+	}
+
+	content := append(upload.data, data...)
+	if calculated := calculateDigest(string(content)); calculated != digest {
+		return &ServiceError{
+			Code:    errInvalidLayer,
+			Message: fmt.Sprintf("The layer digest '%s' does not match the calculated digest '%s'", digest, calculated),
+		}
+	}
+
+	delete(s.uploads, uploadID)
+
+	rd, exists := s.Repositories[repositoryName]
+	if !exists {
+		return s.repositoryNotFoundError(repositoryName)
+	}
+
+	rd.putBlob(digest, content)
+
+	return nil
+}
+
+// blobUpload returns an upload in progress to the repository.
+func (s *MemoryStorage) blobUpload(repositoryName, uploadID string) (*blobUpload, error) {
+	upload, exists := s.uploads[uploadID]
+	if !exists || upload.repositoryName != repositoryName {
+		return nil, &ServiceError{
+			Code:    errUploadNotFound,
+			Message: fmt.Sprintf("The upload with id '%s' does not exist", uploadID),
+		}
+	}
+
+	return upload, nil
+}
+
+// putBlob stores a blob in the repository.
+func (rd *repositoryData) putBlob(digest string, data []byte) {
+	if rd.Blobs == nil {
+		rd.Blobs = make(map[string][]byte)
+	}
+
+	rd.Blobs[digest] = data
+}
+
+// PutLifecyclePolicy validates and stores the lifecycle policy of a repository.
+func (s *MemoryStorage) PutLifecyclePolicy(_ context.Context, repositoryName, policyText string) (*LifecyclePolicy, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rd, exists := s.Repositories[repositoryName]
+	if !exists {
+		return nil, s.repositoryNotFoundError(repositoryName)
+	}
+
+	if _, err := parseLifecyclePolicy(policyText); err != nil {
+		return nil, err
+	}
+
+	rd.LifecyclePolicy = &LifecyclePolicy{
+		RegistryID:          s.accountID,
+		RepositoryName:      repositoryName,
+		LifecyclePolicyText: policyText,
+		LastEvaluatedAt:     time.Now(),
+	}
+
+	policy := *rd.LifecyclePolicy
+
+	return &policy, nil
+}
+
+// GetLifecyclePolicy returns the lifecycle policy of a repository.
+func (s *MemoryStorage) GetLifecyclePolicy(_ context.Context, repositoryName string) (*LifecyclePolicy, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rd, exists := s.Repositories[repositoryName]
+	if !exists {
+		return nil, s.repositoryNotFoundError(repositoryName)
+	}
+
+	if rd.LifecyclePolicy == nil {
+		return nil, s.lifecyclePolicyNotFoundError(repositoryName)
+	}
+
+	policy := *rd.LifecyclePolicy
+
+	return &policy, nil
+}
+
+// DeleteLifecyclePolicy removes the lifecycle policy of a repository.
+func (s *MemoryStorage) DeleteLifecyclePolicy(_ context.Context, repositoryName string) (*LifecyclePolicy, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rd, exists := s.Repositories[repositoryName]
+	if !exists {
+		return nil, s.repositoryNotFoundError(repositoryName)
+	}
+
+	if rd.LifecyclePolicy == nil {
+		return nil, s.lifecyclePolicyNotFoundError(repositoryName)
+	}
+
+	policy := rd.LifecyclePolicy
+	rd.LifecyclePolicy = nil
+
+	return policy, nil
+}
+
+// StartLifecyclePolicyPreview evaluates a lifecycle policy against the images
+// of a repository without expiring them. If policyText is empty, the
+// repository's stored policy is evaluated.
+func (s *MemoryStorage) StartLifecyclePolicyPreview(_ context.Context, repositoryName, policyText string) (*LifecyclePolicyPreview, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rd, exists := s.Repositories[repositoryName]
+	if !exists {
+		return nil, s.repositoryNotFoundError(repositoryName)
+	}
+
+	if policyText == "" {
+		if rd.LifecyclePolicy == nil {
+			return nil, s.lifecyclePolicyNotFoundError(repositoryName)
+		}
+
+		policyText = rd.LifecyclePolicy.LifecyclePolicyText
+	}
+
+	doc, err := parseLifecyclePolicy(policyText)
+	if err != nil {
+		return nil, err
+	}
+
+	images := make([]*Image, 0, len(rd.Images))
+	for _, img := range rd.Images {
+		images = append(images, img)
+	}
+
+	rd.PolicyPreview = &LifecyclePolicyPreview{
+		RegistryID:          s.accountID,
+		RepositoryName:      repositoryName,
+		LifecyclePolicyText: policyText,
+		Status:              previewStatusComplete,
+		Results:             evaluateLifecyclePolicy(doc, images, time.Now()),
+	}
+
+	preview := *rd.PolicyPreview
+
+	return &preview, nil
+}
+
+// GetLifecyclePolicyPreview returns the most recent lifecycle policy preview
+// of a repository.
+func (s *MemoryStorage) GetLifecyclePolicyPreview(_ context.Context, repositoryName string) (*LifecyclePolicyPreview, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rd, exists := s.Repositories[repositoryName]
+	if !exists {
+		return nil, s.repositoryNotFoundError(repositoryName)
+	}
+
+	if rd.PolicyPreview == nil {
+		return nil, &ServiceError{
+			Code: errLifecyclePolicyPreviewNotFound,
+			Message: fmt.Sprintf("There is no ongoing or completed lifecycle policy preview for the repository with name '%s' in the registry with id '%s'",
+				repositoryName, s.accountID),
+		}
+	}
+
+	preview := *rd.PolicyPreview
+
+	return &preview, nil
+}
+
+// repositoryNotFoundError returns the error for a missing repository.
+func (s *MemoryStorage) repositoryNotFoundError(repositoryName string) error {
+	return &ServiceError{
+		Code:    errRepositoryNotFound,
+		Message: fmt.Sprintf("The repository with name '%s' does not exist in the registry with id '%s'", repositoryName, s.accountID),
+	}
+}
+
+// layerNotFoundError returns the error for a blob missing from a repository.
+func (s *MemoryStorage) layerNotFoundError(repositoryName, digest string) error {
+	return &ServiceError{
+		Code:    errLayersNotFound,
+		Message: fmt.Sprintf("The layer with digest '%s' does not exist in the repository with name '%s'", digest, repositoryName),
+	}
+}
+
+// lifecyclePolicyNotFoundError returns the error for a repository without a
+// lifecycle policy.
+func (s *MemoryStorage) lifecyclePolicyNotFoundError(repositoryName string) error {
+	return &ServiceError{
+		Code:    errLifecyclePolicyNotFound,
+		Message: fmt.Sprintf("Lifecycle policy does not exist for the repository with name '%s' in the registry with id '%s'", repositoryName, s.accountID),
+	}
+}
+
 // DispatchAction checks if the action is valid.
 func (s *MemoryStorage) DispatchAction(_ string) bool {
 	return true
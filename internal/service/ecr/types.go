@@ -204,6 +204,125 @@ type AuthorizationData struct {
 	ProxyEndpoint      string  `json:"proxyEndpoint"`
 }
 
+// LifecyclePolicy represents the lifecycle policy of a repository.
+type LifecyclePolicy struct {
+	RegistryID          string
+	RepositoryName      string
+	LifecyclePolicyText string
+	LastEvaluatedAt     time.Time
+}
+
+// LifecyclePolicyPreview represents the result of a lifecycle policy preview.
+type LifecyclePolicyPreview struct {
+	RegistryID          string
+	RepositoryName      string
+	LifecyclePolicyText string
+	Status              string
+	Results             []LifecyclePolicyPreviewResult
+}
+
+// LifecyclePolicyPreviewResult describes an image a lifecycle policy would act on.
+type LifecyclePolicyPreviewResult struct {
+	ImageTags           []string                   `json:"imageTags,omitempty"`
+	ImageDigest         string                     `json:"imageDigest,omitempty"`
+	ImagePushedAt       float64                    `json:"imagePushedAt,omitempty"`
+	Action              *LifecyclePolicyRuleAction `json:"action,omitempty"`
+	AppliedRulePriority int                        `json:"appliedRulePriority,omitempty"`
+}
+
+// LifecyclePolicyRuleAction is the action a lifecycle policy rule takes.
+type LifecyclePolicyRuleAction struct {
+	Type string `json:"type,omitempty"`
+}
+
+// PutLifecyclePolicyRequest is the request for PutLifecyclePolicy.
+type PutLifecyclePolicyRequest struct {
+	RepositoryName      string `json:"repositoryName"`
+	LifecyclePolicyText string `json:"lifecyclePolicyText"`
+	RegistryID          string `json:"registryId,omitempty"`
+}
+
+// PutLifecyclePolicyResponse is the response for PutLifecyclePolicy.
+type PutLifecyclePolicyResponse struct {
+	RegistryID          string `json:"registryId"`
+	RepositoryName      string `json:"repositoryName"`
+	LifecyclePolicyText string `json:"lifecyclePolicyText"`
+}
+
+// GetLifecyclePolicyRequest is the request for GetLifecyclePolicy.
+type GetLifecyclePolicyRequest struct {
+	RepositoryName string `json:"repositoryName"`
+	RegistryID     string `json:"registryId,omitempty"`
+}
+
+// GetLifecyclePolicyResponse is the response for GetLifecyclePolicy.
+type GetLifecyclePolicyResponse struct {
+	RegistryID          string  `json:"registryId"`
+	RepositoryName      string  `json:"repositoryName"`
+	LifecyclePolicyText string  `json:"lifecyclePolicyText"`
+	LastEvaluatedAt     float64 `json:"lastEvaluatedAt"`
+}
+
+// DeleteLifecyclePolicyRequest is the request for DeleteLifecyclePolicy.
+type DeleteLifecyclePolicyRequest struct {
+	RepositoryName string `json:"repositoryName"`
+	RegistryID     string `json:"registryId,omitempty"`
+}
+
+// DeleteLifecyclePolicyResponse is the response for DeleteLifecyclePolicy.
+type DeleteLifecyclePolicyResponse struct {
+	RegistryID          string  `json:"registryId"`
+	RepositoryName      string  `json:"repositoryName"`
+	LifecyclePolicyText string  `json:"lifecyclePolicyText"`
+	LastEvaluatedAt     float64 `json:"lastEvaluatedAt"`
+}
+
+// StartLifecyclePolicyPreviewRequest is the request for StartLifecyclePolicyPreview.
+type StartLifecyclePolicyPreviewRequest struct {
+	RepositoryName      string `json:"repositoryName"`
+	LifecyclePolicyText string `json:"lifecyclePolicyText,omitempty"`
+	RegistryID          string `json:"registryId,omitempty"`
+}
+
+// StartLifecyclePolicyPreviewResponse is the response for StartLifecyclePolicyPreview.
+type StartLifecyclePolicyPreviewResponse struct {
+	RegistryID          string `json:"registryId"`
+	RepositoryName      string `json:"repositoryName"`
+	LifecyclePolicyText string `json:"lifecyclePolicyText"`
+	Status              string `json:"status"`
+}
+
+// GetLifecyclePolicyPreviewRequest is the request for GetLifecyclePolicyPreview.
+type GetLifecyclePolicyPreviewRequest struct {
+	RepositoryName string                        `json:"repositoryName"`
+	RegistryID     string                        `json:"registryId,omitempty"`
+	ImageIDs       []ImageIdentifier             `json:"imageIds,omitempty"`
+	NextToken      string                        `json:"nextToken,omitempty"`
+	MaxResults     int32                         `json:"maxResults,omitempty"`
+	Filter         *LifecyclePolicyPreviewFilter `json:"filter,omitempty"`
+}
+
+// LifecyclePolicyPreviewFilter is the filter for GetLifecyclePolicyPreview.
+type LifecyclePolicyPreviewFilter struct {
+	TagStatus string `json:"tagStatus,omitempty"`
+}
+
+// GetLifecyclePolicyPreviewResponse is the response for GetLifecyclePolicyPreview.
+type GetLifecyclePolicyPreviewResponse struct {
+	RegistryID          string                         `json:"registryId"`
+	RepositoryName      string                         `json:"repositoryName"`
+	LifecyclePolicyText string                         `json:"lifecyclePolicyText"`
+	Status              string                         `json:"status"`
+	PreviewResults      []LifecyclePolicyPreviewResult `json:"previewResults"`
+	Summary             *LifecyclePolicyPreviewSummary `json:"summary,omitempty"`
+	NextToken           string                         `json:"nextToken,omitempty"`
+}
+
+// LifecyclePolicyPreviewSummary summarizes a lifecycle policy preview.
+type LifecyclePolicyPreviewSummary struct {
+	ExpiringImageTotalCount int `json:"expiringImageTotalCount"`
+}
+
 // ErrorResponse represents an error response.
 type ErrorResponse struct {
 	Type    string `json:"__type"`
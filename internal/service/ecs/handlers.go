@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
@@ -31,6 +32,10 @@ func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
 		s.RegisterTaskDefinition(w, r)
 	case "DeregisterTaskDefinition":
 		s.DeregisterTaskDefinition(w, r)
+	case "DescribeTaskDefinition":
+		s.DescribeTaskDefinition(w, r)
+	case "ListTaskDefinitions":
+		s.ListTaskDefinitions(w, r)
 	case "RunTask":
 		s.RunTask(w, r)
 	case "StopTask":
@@ -236,6 +241,98 @@ func (s *Service) DeregisterTaskDefinition(w http.ResponseWriter, r *http.Reques
 	})
 }
 
+// DescribeTaskDefinition handles the DescribeTaskDefinition action.
+func (s *Service) DescribeTaskDefinition(w http.ResponseWriter, r *http.Request) {
+	var req DescribeTaskDefinitionRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeECSError(w, "SerializationException", "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.TaskDefinition == "" {
+		writeECSError(w, "InvalidParameterException", "TaskDefinition is required", http.StatusBadRequest)
+
+		return
+	}
+
+	taskDef, err := s.storage.DescribeTaskDefinition(r.Context(), req.TaskDefinition)
+	if err != nil {
+		var ecsErr *Error
+		if errors.As(err, &ecsErr) {
+			writeECSError(w, ecsErr.Code, ecsErr.Message, http.StatusBadRequest)
+
+			return
+		}
+
+		writeECSError(w, "InternalServerError", "Internal server error", http.StatusInternalServerError)
+
+		return
+	}
+
+	resp := DescribeTaskDefinitionResponse{
+		TaskDefinition: taskDef,
+	}
+
+	// Tags are only returned when asked for.
+	if slices.Contains(req.Include, "TAGS") {
+		resp.Tags = taskDef.Tags
+	}
+
+	writeJSONResponse(w, resp)
+}
+
+// ListTaskDefinitions handles the ListTaskDefinitions action.
+func (s *Service) ListTaskDefinitions(w http.ResponseWriter, r *http.Request) {
+	var req ListTaskDefinitionsRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeECSError(w, "SerializationException", "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.MaxResults != 0 && (req.MaxResults < 1 || req.MaxResults > 100) {
+		writeECSError(w, "InvalidParameterException", "maxResults must be between 1 and 100", http.StatusBadRequest)
+
+		return
+	}
+
+	switch req.Status {
+	case "", "ACTIVE", "INACTIVE", "DELETE_IN_PROGRESS":
+	default:
+		writeECSError(w, "InvalidParameterException", "status must be one of ACTIVE, INACTIVE or DELETE_IN_PROGRESS", http.StatusBadRequest)
+
+		return
+	}
+
+	switch req.Sort {
+	case "", "ASC", "DESC":
+	default:
+		writeECSError(w, "InvalidParameterException", "sort must be ASC or DESC", http.StatusBadRequest)
+
+		return
+	}
+
+	arns, nextToken, err := s.storage.ListTaskDefinitions(r.Context(), &req)
+	if err != nil {
+		var ecsErr *Error
+		if errors.As(err, &ecsErr) {
+			writeECSError(w, ecsErr.Code, ecsErr.Message, http.StatusBadRequest)
+
+			return
+		}
+
+		writeECSError(w, "InternalServerError", "Internal server error", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSONResponse(w, ListTaskDefinitionsResponse{
+		TaskDefinitionArns: arns,
+		NextToken:          nextToken,
+	})
+}
+
 // RunTask handles the RunTask action.
 func (s *Service) RunTask(w http.ResponseWriter, r *http.Request) {
 	var req RunTaskRequest
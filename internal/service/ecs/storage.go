@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -36,6 +38,8 @@ type Storage interface {
 
 	RegisterTaskDefinition(ctx context.Context, req *RegisterTaskDefinitionRequest) (*TaskDefinition, error)
 	DeregisterTaskDefinition(ctx context.Context, taskDefinition string) (*TaskDefinition, error)
+	DescribeTaskDefinition(ctx context.Context, taskDefinition string) (*TaskDefinition, error)
+	ListTaskDefinitions(ctx context.Context, req *ListTaskDefinitionsRequest) ([]string, string, error)
 
 	RunTask(ctx context.Context, req *RunTaskRequest) ([]Task, []Failure, error)
 	StopTask(ctx context.Context, cluster, task, reason string) (*Task, error)
@@ -44,6 +48,7 @@ type Storage interface {
 	CreateService(ctx context.Context, req *CreateServiceRequest) (*ServiceResource, error)
 	DeleteService(ctx context.Context, cluster, service string, force bool) (*ServiceResource, error)
 	UpdateService(ctx context.Context, req *UpdateServiceRequest) (*ServiceResource, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -157,6 +162,20 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Clusters = make(map[string]*Cluster)
+	m.TaskDefinitions = make(map[string]*TaskDefinition)
+	m.TaskDefFamilies = make(map[string][]string)
+	m.Tasks = make(map[string]*Task)
+	m.Services = make(map[string]*ServiceResource)
+
+	return nil
+}
+
 func generateID() string {
 	return uuid.New().String()[:8]
 }
@@ -321,6 +340,7 @@ func (m *MemoryStorage) RegisterTaskDefinition(_ context.Context, req *RegisterT
 		ExecutionRoleArn:        req.ExecutionRoleArn,
 		TaskRoleArn:             req.TaskRoleArn,
 		Tags:                    req.Tags,
+		RegisteredAt:            newTimestamp(),
 	}
 
 	m.TaskDefinitions[arn] = td
@@ -329,11 +349,20 @@ func (m *MemoryStorage) RegisterTaskDefinition(_ context.Context, req *RegisterT
 	return td, nil
 }
 
-// DeregisterTaskDefinition deregisters a task definition.
+// DeregisterTaskDefinition marks a task definition revision INACTIVE. The
+// revision stays describable, but new tasks and services cannot use it.
 func (m *MemoryStorage) DeregisterTaskDefinition(_ context.Context, taskDefinition string) (*TaskDefinition, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	// A revision must be given explicitly; a bare family is rejected.
+	if !strings.HasPrefix(taskDefinition, "arn:") && !strings.Contains(taskDefinition, ":") {
+		return nil, &Error{
+			Code:    "ClientException",
+			Message: "Invalid revision number. Number: " + taskDefinition,
+		}
+	}
+
 	arn := m.resolveTaskDefinitionArn(taskDefinition)
 
 	td, ok := m.TaskDefinitions[arn]
@@ -344,11 +373,104 @@ func (m *MemoryStorage) DeregisterTaskDefinition(_ context.Context, taskDefiniti
 		}
 	}
 
-	td.Status = statusInactive
+	if td.Status != statusInactive {
+		td.Status = statusInactive
+		td.DeregisteredAt = newTimestamp()
+	}
 
 	return td, nil
 }
 
+// DescribeTaskDefinition describes a task definition given as a family, a
+// family:revision pair, or an ARN. A bare family resolves to its latest ACTIVE
+// revision; an explicit revision is returned whatever its status.
+func (m *MemoryStorage) DescribeTaskDefinition(_ context.Context, taskDefinition string) (*TaskDefinition, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	td, ok := m.TaskDefinitions[m.resolveTaskDefinitionArn(taskDefinition)]
+	if !ok {
+		return nil, &Error{
+			Code:    "ClientException",
+			Message: "Unable to describe task definition.",
+		}
+	}
+
+	return td, nil
+}
+
+// ListTaskDefinitions lists task definition ARNs, filtered by family prefix
+// and status and sorted by family and revision.
+func (m *MemoryStorage) ListTaskDefinitions(_ context.Context, req *ListTaskDefinitionsRequest) ([]string, string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	status := req.Status
+	if status == "" {
+		status = statusActive
+	}
+
+	matched := make([]*TaskDefinition, 0, len(m.TaskDefinitions))
+
+	for _, td := range m.TaskDefinitions {
+		if td.Status != status {
+			continue
+		}
+
+		if req.FamilyPrefix != "" && !strings.HasPrefix(td.Family, req.FamilyPrefix) {
+			continue
+		}
+
+		matched = append(matched, td)
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		a, b := matched[i], matched[j]
+		if req.Sort == "DESC" {
+			a, b = b, a
+		}
+
+		if a.Family != b.Family {
+			return a.Family < b.Family
+		}
+
+		return a.Revision < b.Revision
+	})
+
+	start := 0
+
+	if req.NextToken != "" {
+		offset, err := strconv.Atoi(req.NextToken)
+		if err != nil || offset < 0 || offset > len(matched) {
+			return nil, "", &Error{
+				Code:    "InvalidParameterException",
+				Message: "Invalid nextToken",
+			}
+		}
+
+		start = offset
+	}
+
+	maxResults := req.MaxResults
+	if maxResults <= 0 {
+		maxResults = 100
+	}
+
+	end := min(start+maxResults, len(matched))
+
+	arns := make([]string, 0, end-start)
+	for _, td := range matched[start:end] {
+		arns = append(arns, td.TaskDefinitionArn)
+	}
+
+	var nextToken string
+	if end < len(matched) {
+		nextToken = strconv.Itoa(end)
+	}
+
+	return arns, nextToken, nil
+}
+
 // RunTask runs a task.
 func (m *MemoryStorage) RunTask(_ context.Context, req *RunTaskRequest) ([]Task, []Failure, error) {
 	m.mu.Lock()
@@ -416,6 +538,13 @@ func (m *MemoryStorage) getTaskDefinitionForRun(taskDef string) (*TaskDefinition
 		}
 	}
 
+	if td.Status == statusInactive {
+		return nil, &Error{
+			Code:    "ClientException",
+			Message: "TaskDefinition is inactive",
+		}
+	}
+
 	return td, nil
 }
 
@@ -721,8 +850,15 @@ func (m *MemoryStorage) resolveTaskDefinitionArn(taskDefinition string) string {
 		return fmt.Sprintf("arn:aws:ecs:%s:%s:task-definition/%s", defaultRegion, defaultAccountID, taskDefinition)
 	}
 
-	// Try to find latest revision.
-	if arns, ok := m.TaskDefFamilies[taskDefinition]; ok && len(arns) > 0 {
+	// A bare family resolves to its latest ACTIVE revision.
+	arns := m.TaskDefFamilies[taskDefinition]
+	for i := len(arns) - 1; i >= 0; i-- {
+		if td, ok := m.TaskDefinitions[arns[i]]; ok && td.Status == statusActive {
+			return arns[i]
+		}
+	}
+
+	if len(arns) > 0 {
 		return arns[len(arns)-1]
 	}
 
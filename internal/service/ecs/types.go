@@ -65,6 +65,8 @@ type TaskDefinition struct {
 	ExecutionRoleArn        string                `json:"executionRoleArn,omitempty"`
 	TaskRoleArn             string                `json:"taskRoleArn,omitempty"`
 	Tags                    []Tag                 `json:"tags,omitempty"`
+	RegisteredAt            *Timestamp            `json:"registeredAt,omitempty"`
+	DeregisteredAt          *Timestamp            `json:"deregisteredAt,omitempty"`
 }
 
 // ContainerDefinition represents a container in a task definition.
@@ -206,6 +208,21 @@ type DeregisterTaskDefinitionRequest struct {
 	TaskDefinition string `json:"taskDefinition"`
 }
 
+// DescribeTaskDefinitionRequest represents a DescribeTaskDefinition request.
+type DescribeTaskDefinitionRequest struct {
+	TaskDefinition string   `json:"taskDefinition"`
+	Include        []string `json:"include,omitempty"`
+}
+
+// ListTaskDefinitionsRequest represents a ListTaskDefinitions request.
+type ListTaskDefinitionsRequest struct {
+	FamilyPrefix string `json:"familyPrefix,omitempty"`
+	Status       string `json:"status,omitempty"`
+	Sort         string `json:"sort,omitempty"`
+	MaxResults   int    `json:"maxResults,omitempty"`
+	NextToken    string `json:"nextToken,omitempty"`
+}
+
 // RunTaskRequest represents a RunTask request.
 type RunTaskRequest struct {
 	Cluster        string `json:"cluster,omitempty"`
@@ -289,6 +306,18 @@ type DeregisterTaskDefinitionResponse struct {
 	TaskDefinition *TaskDefinition `json:"taskDefinition"`
 }
 
+// DescribeTaskDefinitionResponse represents a DescribeTaskDefinition response.
+type DescribeTaskDefinitionResponse struct {
+	TaskDefinition *TaskDefinition `json:"taskDefinition"`
+	Tags           []Tag           `json:"tags,omitempty"`
+}
+
+// ListTaskDefinitionsResponse represents a ListTaskDefinitions response.
+type ListTaskDefinitionsResponse struct {
+	TaskDefinitionArns []string `json:"taskDefinitionArns"`
+	NextToken          string   `json:"nextToken,omitempty"`
+}
+
 // RunTaskResponse represents a RunTask response.
 type RunTaskResponse struct {
 	Tasks    []Task    `json:"tasks"`
@@ -13,6 +13,7 @@ const (
 	errInvalidParameter    = "InvalidParameterException"
 	errResourceNotFound    = "ResourceNotFoundException"
 	errResourceInUse       = "ResourceInUseException"
+	errInvalidRequest      = "InvalidRequestException"
 	errInternalServerError = "InternalServerError"
 )
 
@@ -21,6 +22,7 @@ const (
 	pathPrefixEKS      = "eks"
 	pathPrefixClusters = "clusters"
 	pathPrefixNodeGrps = "node-groups"
+	pathPrefixFargate  = "fargate-profiles"
 )
 
 // CreateCluster handles the CreateCluster operation.
@@ -253,6 +255,136 @@ func (s *Service) ListNodegroups(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, resp)
 }
 
+// CreateFargateProfile handles the CreateFargateProfile operation.
+func (s *Service) CreateFargateProfile(w http.ResponseWriter, r *http.Request) {
+	clusterName := extractClusterName(r.URL.Path)
+	if clusterName == "" {
+		writeError(w, http.StatusBadRequest, errInvalidParameter, "Cluster name is required")
+
+		return
+	}
+
+	var req CreateFargateProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, errInvalidParameter, "Invalid request body")
+
+		return
+	}
+
+	req.ClusterName = clusterName
+
+	if req.FargateProfileName == "" {
+		writeError(w, http.StatusBadRequest, errInvalidParameter, "Fargate profile name is required")
+
+		return
+	}
+
+	if req.PodExecutionRoleArn == "" {
+		writeError(w, http.StatusBadRequest, errInvalidParameter, "Pod execution role ARN is required")
+
+		return
+	}
+
+	if len(req.Selectors) == 0 || len(req.Selectors) > 5 {
+		writeError(w, http.StatusBadRequest, errInvalidParameter, "Between 1 and 5 selectors are required")
+
+		return
+	}
+
+	for _, selector := range req.Selectors {
+		if selector.Namespace == "" {
+			writeError(w, http.StatusBadRequest, errInvalidParameter, "Each selector requires a namespace")
+
+			return
+		}
+	}
+
+	profile, err := s.storage.CreateFargateProfile(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeJSON(w, &CreateFargateProfileResponse{FargateProfile: profile})
+}
+
+// DeleteFargateProfile handles the DeleteFargateProfile operation.
+func (s *Service) DeleteFargateProfile(w http.ResponseWriter, r *http.Request) {
+	clusterName, profileName := extractClusterAndFargateProfileName(r.URL.Path)
+	if clusterName == "" || profileName == "" {
+		writeError(w, http.StatusBadRequest, errInvalidParameter, "Cluster name and Fargate profile name are required")
+
+		return
+	}
+
+	profile, err := s.storage.DeleteFargateProfile(r.Context(), clusterName, profileName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeJSON(w, &DeleteFargateProfileResponse{FargateProfile: profile})
+}
+
+// DescribeFargateProfile handles the DescribeFargateProfile operation.
+func (s *Service) DescribeFargateProfile(w http.ResponseWriter, r *http.Request) {
+	clusterName, profileName := extractClusterAndFargateProfileName(r.URL.Path)
+	if clusterName == "" || profileName == "" {
+		writeError(w, http.StatusBadRequest, errInvalidParameter, "Cluster name and Fargate profile name are required")
+
+		return
+	}
+
+	profile, err := s.storage.DescribeFargateProfile(r.Context(), clusterName, profileName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeJSON(w, &DescribeFargateProfileResponse{FargateProfile: profile})
+}
+
+// ListFargateProfiles handles the ListFargateProfiles operation.
+func (s *Service) ListFargateProfiles(w http.ResponseWriter, r *http.Request) {
+	clusterName := extractClusterName(r.URL.Path)
+	if clusterName == "" {
+		writeError(w, http.StatusBadRequest, errInvalidParameter, "Cluster name is required")
+
+		return
+	}
+
+	const maxResultsLimit = 100
+
+	maxResults := maxResultsLimit
+
+	if v := r.URL.Query().Get("maxResults"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			maxResults = min(n, maxResultsLimit)
+		}
+	}
+
+	nextToken := r.URL.Query().Get("nextToken")
+
+	profiles, next, err := s.storage.ListFargateProfiles(r.Context(), clusterName, maxResults, nextToken)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	resp := &ListFargateProfilesResponse{
+		FargateProfileNames: profiles,
+	}
+	if next != "" {
+		resp.NextToken = &next
+	}
+
+	writeJSON(w, resp)
+}
+
 // extractClusterName extracts the cluster name from the URL path.
 // Expected paths: /eks/clusters/{name} or /eks/clusters/{name}/node-groups...
 func extractClusterName(path string) string {
@@ -282,6 +414,19 @@ func extractClusterAndNodegroupName(path string) (string, string) {
 	return "", ""
 }
 
+// extractClusterAndFargateProfileName extracts both cluster and Fargate profile names from the URL path.
+// Expected path: /eks/clusters/{clusterName}/fargate-profiles/{fargateProfileName}.
+func extractClusterAndFargateProfileName(path string) (string, string) {
+	path = strings.TrimPrefix(path, "/")
+	parts := strings.Split(path, "/")
+
+	if len(parts) >= 5 && parts[0] == pathPrefixEKS && parts[1] == pathPrefixClusters && parts[3] == pathPrefixFargate {
+		return parts[2], parts[4]
+	}
+
+	return "", ""
+}
+
 // writeJSON writes a JSON response.
 func writeJSON(w http.ResponseWriter, v any) {
 	w.Header().Set("Content-Type", "application/json")
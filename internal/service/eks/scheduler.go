@@ -0,0 +1,160 @@
+package eks
+
+import (
+	"strings"
+	"time"
+)
+
+// defaultTransitionDelay is how long clusters, node groups and Fargate profiles stay CREATING or DELETING before the scheduler advances them.
+const defaultTransitionDelay = 500 * time.Millisecond
+
+// WithTransitionDelay sets how long clusters, node groups and Fargate profiles stay CREATING or DELETING before the scheduler advances them.
+func WithTransitionDelay(d time.Duration) Option {
+	return func(s *MemoryStorage) {
+		s.transitionDelay = d
+	}
+}
+
+// clusterKey, nodegroupKey and fargateProfileKey identify resources in the transitions map.
+func clusterKey(name string) string {
+	return "cluster/" + name
+}
+
+func nodegroupKey(clusterName, nodegroupName string) string {
+	return "nodegroup/" + clusterName + "/" + nodegroupName
+}
+
+func fargateProfileKey(clusterName, fargateProfileName string) string {
+	return "fargateprofile/" + clusterName + "/" + fargateProfileName
+}
+
+// transitionScheduler periodically advances clusters, node groups and Fargate profiles through their lifecycle.
+func (s *MemoryStorage) transitionScheduler() {
+	ticker := time.NewTicker(max(s.transitionDelay/5, 10*time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopScheduler:
+			return
+		case now := <-ticker.C:
+			s.advanceResources(now)
+		}
+	}
+}
+
+// advanceResources activates the clusters, node groups and Fargate profiles that have been
+// CREATING for the transition delay and removes those that have been DELETING
+// as long.
+func (s *MemoryStorage) advanceResources(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name, cluster := range s.Clusters {
+		if !s.due(clusterKey(name), cluster.Status, now) {
+			continue
+		}
+
+		if cluster.Status == statusDeleting {
+			delete(s.Clusters, name)
+			delete(s.Nodegroups, name)
+			delete(s.FargateProfiles, name)
+
+			continue
+		}
+
+		cluster.Status = statusActive
+	}
+
+	for clusterName, nodegroups := range s.Nodegroups {
+		for name, nodegroup := range nodegroups {
+			if !s.due(nodegroupKey(clusterName, name), nodegroup.Status, now) {
+				continue
+			}
+
+			if nodegroup.Status == statusDeleting {
+				delete(nodegroups, name)
+
+				continue
+			}
+
+			nodegroup.Status = statusActive
+		}
+	}
+
+	for clusterName, profiles := range s.FargateProfiles {
+		for name, profile := range profiles {
+			if !s.due(fargateProfileKey(clusterName, name), profile.Status, now) {
+				continue
+			}
+
+			if profile.Status == statusDeleting {
+				delete(profiles, name)
+
+				continue
+			}
+
+			profile.Status = statusActive
+		}
+	}
+
+	// Drop the timers of resources that no longer exist.
+	for key := range s.transitions {
+		if !s.exists(key) {
+			delete(s.transitions, key)
+		}
+	}
+}
+
+// due reports whether a CREATING or DELETING resource has spent the transition
+// delay in that status. Resources restored from disk start their timer on the
+// first tick.
+func (s *MemoryStorage) due(key, status string, now time.Time) bool {
+	if status != statusCreating && status != statusDeleting {
+		return false
+	}
+
+	changed, ok := s.transitions[key]
+	if !ok {
+		s.transitions[key] = now
+
+		return false
+	}
+
+	if now.Sub(changed) < s.transitionDelay {
+		return false
+	}
+
+	delete(s.transitions, key)
+
+	return true
+}
+
+// exists reports whether the resource identified by a transitions key is still stored.
+func (s *MemoryStorage) exists(key string) bool {
+	kind, rest, _ := strings.Cut(key, "/")
+
+	switch kind {
+	case "cluster":
+		_, ok := s.Clusters[rest]
+
+		return ok
+	case "nodegroup":
+		clusterName, name, _ := strings.Cut(rest, "/")
+		_, ok := s.Nodegroups[clusterName][name]
+
+		return ok
+	case "fargateprofile":
+		clusterName, name, _ := strings.Cut(rest, "/")
+		_, ok := s.FargateProfiles[clusterName][name]
+
+		return ok
+	default:
+		return false
+	}
+}
+
+// startTransition marks a resource as having just entered CREATING or DELETING.
+func (s *MemoryStorage) startTransition(key string) {
+	s.transitions[key] = time.Now()
+}
@@ -2,10 +2,12 @@
 package eks
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -14,12 +16,18 @@ import (
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"eks"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
+
+		if delay, err := time.ParseDuration(os.Getenv("KUMO_EKS_TRANSITION_DELAY")); err == nil {
+			opts = append(opts, WithTransitionDelay(delay))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
 
 // Service implements the EKS service.
@@ -50,6 +58,12 @@ func (s *Service) RegisterRoutes(r service.Router) {
 	r.HandleFunc("DELETE", "/eks/clusters/{name}/node-groups/{nodegroupName}", s.DeleteNodegroup)
 	r.HandleFunc("GET", "/eks/clusters/{name}/node-groups/{nodegroupName}", s.DescribeNodegroup)
 	r.HandleFunc("GET", "/eks/clusters/{name}/node-groups", s.ListNodegroups)
+
+	// Fargate profile operations
+	r.HandleFunc("POST", "/eks/clusters/{name}/fargate-profiles", s.CreateFargateProfile)
+	r.HandleFunc("DELETE", "/eks/clusters/{name}/fargate-profiles/{fargateProfileName}", s.DeleteFargateProfile)
+	r.HandleFunc("GET", "/eks/clusters/{name}/fargate-profiles/{fargateProfileName}", s.DescribeFargateProfile)
+	r.HandleFunc("GET", "/eks/clusters/{name}/fargate-profiles", s.ListFargateProfiles)
 }
 
 // handleClusterGet handles GET requests to /clusters/{name}.
@@ -68,3 +82,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -32,6 +33,11 @@ type Storage interface {
 	DeleteNodegroup(ctx context.Context, clusterName, nodegroupName string) (*Nodegroup, error)
 	DescribeNodegroup(ctx context.Context, clusterName, nodegroupName string) (*Nodegroup, error)
 	ListNodegroups(ctx context.Context, clusterName string, maxResults int, nextToken string) ([]string, string, error)
+	CreateFargateProfile(ctx context.Context, req *CreateFargateProfileRequest) (*FargateProfile, error)
+	DeleteFargateProfile(ctx context.Context, clusterName, fargateProfileName string) (*FargateProfile, error)
+	DescribeFargateProfile(ctx context.Context, clusterName, fargateProfileName string) (*FargateProfile, error)
+	ListFargateProfiles(ctx context.Context, clusterName string, maxResults int, nextToken string) ([]string, string, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -55,18 +61,30 @@ type MemoryStorage struct {
 	mu         sync.RWMutex                     `json:"-"`
 	Clusters   map[string]*Cluster              `json:"clusters"`
 	Nodegroups map[string]map[string]*Nodegroup `json:"nodegroups"`
-	region     string
-	accountID  string
-	dataDir    string
+	// FargateProfiles holds the Fargate profiles of each cluster, keyed by cluster name and then profile name.
+	FargateProfiles map[string]map[string]*FargateProfile `json:"fargateProfiles"`
+	region          string
+	accountID       string
+	dataDir         string
+
+	transitionDelay time.Duration
+	// transitions records when each CREATING or DELETING resource entered that status.
+	transitions   map[string]time.Time
+	stopScheduler chan struct{}
 }
 
 // NewMemoryStorage creates a new MemoryStorage.
 func NewMemoryStorage(opts ...Option) *MemoryStorage {
 	s := &MemoryStorage{
-		Clusters:   make(map[string]*Cluster),
-		Nodegroups: make(map[string]map[string]*Nodegroup),
-		region:     "us-east-1",
-		accountID:  "123456789012",
+		Clusters:        make(map[string]*Cluster),
+		Nodegroups:      make(map[string]map[string]*Nodegroup),
+		FargateProfiles: make(map[string]map[string]*FargateProfile),
+		region:          "us-east-1",
+		accountID:       "123456789012",
+
+		transitionDelay: defaultTransitionDelay,
+		transitions:     make(map[string]time.Time),
+		stopScheduler:   make(chan struct{}),
 	}
 	for _, o := range opts {
 		o(s)
@@ -76,6 +94,8 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		_ = storage.Load(s.dataDir, "eks", s)
 	}
 
+	go s.transitionScheduler()
+
 	return s
 }
 
@@ -115,11 +135,17 @@ func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
 		s.Nodegroups = make(map[string]map[string]*Nodegroup)
 	}
 
+	if s.FargateProfiles == nil {
+		s.FargateProfiles = make(map[string]map[string]*FargateProfile)
+	}
+
 	return nil
 }
 
 // Close saves the storage state to disk if persistence is enabled.
 func (s *MemoryStorage) Close() error {
+	close(s.stopScheduler)
+
 	if s.dataDir == "" {
 		return nil
 	}
@@ -131,6 +157,19 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Clusters = make(map[string]*Cluster)
+	s.Nodegroups = make(map[string]map[string]*Nodegroup)
+	s.FargateProfiles = make(map[string]map[string]*FargateProfile)
+	s.transitions = make(map[string]time.Time)
+
+	return nil
+}
+
 // CreateCluster creates a new EKS cluster.
 func (s *MemoryStorage) CreateCluster(_ context.Context, req *CreateClusterRequest) (*Cluster, error) {
 	s.mu.Lock()
@@ -144,12 +183,13 @@ func (s *MemoryStorage) CreateCluster(_ context.Context, req *CreateClusterReque
 	}
 
 	cluster := s.buildCluster(req)
-	cluster.Status = statusActive
 
 	s.Clusters[req.Name] = cluster
 	s.Nodegroups[req.Name] = make(map[string]*Nodegroup)
+	s.FargateProfiles[req.Name] = make(map[string]*FargateProfile)
+	s.startTransition(clusterKey(req.Name))
 
-	return cluster, nil
+	return cloneCluster(cluster), nil
 }
 
 // buildCluster builds a Cluster from a CreateClusterRequest.
@@ -228,7 +268,7 @@ func (s *MemoryStorage) buildVpcConfig(req *VpcConfigRequest) *VpcConfigResponse
 	}
 }
 
-// DeleteCluster deletes an EKS cluster.
+// DeleteCluster marks an EKS cluster DELETING; the scheduler removes it after the transition delay.
 func (s *MemoryStorage) DeleteCluster(_ context.Context, name string) (*Cluster, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -249,12 +289,19 @@ func (s *MemoryStorage) DeleteCluster(_ context.Context, name string) (*Cluster,
 		}
 	}
 
-	cluster.Status = statusDeleting
+	if profiles := s.FargateProfiles[name]; len(profiles) > 0 {
+		return nil, &Error{
+			Code:    "ResourceInUseException",
+			Message: "Cluster has Fargate profiles attached",
+		}
+	}
 
-	delete(s.Clusters, name)
-	delete(s.Nodegroups, name)
+	if cluster.Status != statusDeleting {
+		cluster.Status = statusDeleting
+		s.startTransition(clusterKey(name))
+	}
 
-	return cluster, nil
+	return cloneCluster(cluster), nil
 }
 
 // DescribeCluster describes an EKS cluster.
@@ -270,7 +317,7 @@ func (s *MemoryStorage) DescribeCluster(_ context.Context, name string) (*Cluste
 		}
 	}
 
-	return cluster, nil
+	return cloneCluster(cluster), nil
 }
 
 // ListClusters lists all EKS clusters.
@@ -299,6 +346,13 @@ func (s *MemoryStorage) CreateNodegroup(_ context.Context, req *CreateNodegroupR
 		}
 	}
 
+	if cluster.Status != statusActive {
+		return nil, &Error{
+			Code:    errInvalidRequest,
+			Message: fmt.Sprintf("Cluster '%s' is not in ACTIVE status", req.ClusterName),
+		}
+	}
+
 	if _, exists := s.Nodegroups[req.ClusterName][req.NodegroupName]; exists {
 		return nil, &Error{
 			Code:    "ResourceInUseException",
@@ -307,11 +361,11 @@ func (s *MemoryStorage) CreateNodegroup(_ context.Context, req *CreateNodegroupR
 	}
 
 	nodegroup := s.buildNodegroup(req, cluster.Version)
-	nodegroup.Status = statusActive
 
 	s.Nodegroups[req.ClusterName][req.NodegroupName] = nodegroup
+	s.startTransition(nodegroupKey(req.ClusterName, req.NodegroupName))
 
-	return nodegroup, nil
+	return cloneNodegroup(nodegroup), nil
 }
 
 // buildNodegroup builds a Nodegroup from a CreateNodegroupRequest.
@@ -372,7 +426,7 @@ func (s *MemoryStorage) buildNodegroup(req *CreateNodegroupRequest, clusterVersi
 	}
 }
 
-// DeleteNodegroup deletes an EKS node group.
+// DeleteNodegroup marks an EKS node group DELETING; the scheduler removes it after the transition delay.
 func (s *MemoryStorage) DeleteNodegroup(_ context.Context, clusterName, nodegroupName string) (*Nodegroup, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -400,11 +454,14 @@ func (s *MemoryStorage) DeleteNodegroup(_ context.Context, clusterName, nodegrou
 		}
 	}
 
-	nodegroup.Status = statusDeleting
-
-	delete(s.Nodegroups[clusterName], nodegroupName)
+	if nodegroup.Status != statusDeleting {
+		now := NewEpochTime(time.Now())
+		nodegroup.Status = statusDeleting
+		nodegroup.ModifiedAt = &now
+		s.startTransition(nodegroupKey(clusterName, nodegroupName))
+	}
 
-	return nodegroup, nil
+	return cloneNodegroup(nodegroup), nil
 }
 
 // DescribeNodegroup describes an EKS node group.
@@ -435,7 +492,7 @@ func (s *MemoryStorage) DescribeNodegroup(_ context.Context, clusterName, nodegr
 		}
 	}
 
-	return nodegroup, nil
+	return cloneNodegroup(nodegroup), nil
 }
 
 // ListNodegroups lists all EKS node groups for a cluster.
@@ -462,3 +519,164 @@ func (s *MemoryStorage) ListNodegroups(_ context.Context, clusterName string, _
 
 	return names, "", nil
 }
+
+// CreateFargateProfile creates a new EKS Fargate profile.
+func (s *MemoryStorage) CreateFargateProfile(_ context.Context, req *CreateFargateProfileRequest) (*FargateProfile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cluster, exists := s.Clusters[req.ClusterName]
+	if !exists {
+		return nil, &Error{
+			Code:    "ResourceNotFoundException",
+			Message: fmt.Sprintf("No cluster found for name: %s", req.ClusterName),
+		}
+	}
+
+	if cluster.Status != statusActive {
+		return nil, &Error{
+			Code:    errInvalidRequest,
+			Message: fmt.Sprintf("Cluster '%s' is not in ACTIVE status", req.ClusterName),
+		}
+	}
+
+	profiles := s.FargateProfiles[req.ClusterName]
+	if profiles == nil {
+		profiles = make(map[string]*FargateProfile)
+		s.FargateProfiles[req.ClusterName] = profiles
+	}
+
+	if _, exists := profiles[req.FargateProfileName]; exists {
+		return nil, &Error{
+			Code:    "ResourceInUseException",
+			Message: fmt.Sprintf("A Fargate Profile already exists with this name in this cluster: %s", req.FargateProfileName),
+		}
+	}
+
+	// EKS creates or deletes one Fargate profile per cluster at a time.
+	for _, profile := range profiles {
+		if profile.Status == statusCreating || profile.Status == statusDeleting {
+			return nil, &Error{
+				Code: errInvalidRequest,
+				Message: fmt.Sprintf("Cannot create Fargate Profile %s because cluster %s currently has Fargate profile %s in status %s",
+					req.FargateProfileName, req.ClusterName, profile.FargateProfileName, profile.Status),
+			}
+		}
+	}
+
+	now := NewEpochTime(time.Now())
+	profile := &FargateProfile{
+		FargateProfileName: req.FargateProfileName,
+		FargateProfileArn: fmt.Sprintf("arn:aws:eks:%s:%s:fargateprofile/%s/%s/%s",
+			s.region, s.accountID, req.ClusterName, req.FargateProfileName, uuid.New().String()[:8]),
+		ClusterName:         req.ClusterName,
+		CreatedAt:           &now,
+		PodExecutionRoleArn: req.PodExecutionRoleArn,
+		Subnets:             req.Subnets,
+		Selectors:           req.Selectors,
+		Status:              statusCreating,
+		Tags:                req.Tags,
+		Health:              &FargateProfileHealth{Issues: []Issue{}},
+	}
+
+	profiles[req.FargateProfileName] = profile
+	s.startTransition(fargateProfileKey(req.ClusterName, req.FargateProfileName))
+
+	return cloneFargateProfile(profile), nil
+}
+
+// DeleteFargateProfile marks an EKS Fargate profile DELETING; the scheduler removes it after the transition delay.
+func (s *MemoryStorage) DeleteFargateProfile(_ context.Context, clusterName, fargateProfileName string) (*FargateProfile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	profile, err := s.getFargateProfile(clusterName, fargateProfileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile.Status != statusDeleting {
+		profile.Status = statusDeleting
+		s.startTransition(fargateProfileKey(clusterName, fargateProfileName))
+	}
+
+	return cloneFargateProfile(profile), nil
+}
+
+// DescribeFargateProfile describes an EKS Fargate profile.
+func (s *MemoryStorage) DescribeFargateProfile(_ context.Context, clusterName, fargateProfileName string) (*FargateProfile, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	profile, err := s.getFargateProfile(clusterName, fargateProfileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloneFargateProfile(profile), nil
+}
+
+// ListFargateProfiles lists the Fargate profile names of a cluster.
+func (s *MemoryStorage) ListFargateProfiles(_ context.Context, clusterName string, _ int, _ string) ([]string, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, exists := s.Clusters[clusterName]; !exists {
+		return nil, "", &Error{
+			Code:    "ResourceNotFoundException",
+			Message: fmt.Sprintf("No cluster found for name: %s", clusterName),
+		}
+	}
+
+	profiles := s.FargateProfiles[clusterName]
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names, "", nil
+}
+
+// getFargateProfile returns a stored Fargate profile or a ResourceNotFoundException.
+func (s *MemoryStorage) getFargateProfile(clusterName, fargateProfileName string) (*FargateProfile, error) {
+	if _, exists := s.Clusters[clusterName]; !exists {
+		return nil, &Error{
+			Code:    "ResourceNotFoundException",
+			Message: fmt.Sprintf("No cluster found for name: %s", clusterName),
+		}
+	}
+
+	profile, exists := s.FargateProfiles[clusterName][fargateProfileName]
+	if !exists {
+		return nil, &Error{
+			Code:    "ResourceNotFoundException",
+			Message: fmt.Sprintf("No Fargate Profile found with name: %s", fargateProfileName),
+		}
+	}
+
+	return profile, nil
+}
+
+// cloneCluster returns a copy of a cluster that the scheduler will not mutate.
+func cloneCluster(cluster *Cluster) *Cluster {
+	c := *cluster
+
+	return &c
+}
+
+// cloneNodegroup returns a copy of a node group that the scheduler will not mutate.
+func cloneNodegroup(nodegroup *Nodegroup) *Nodegroup {
+	n := *nodegroup
+
+	return &n
+}
+
+// cloneFargateProfile returns a copy of a Fargate profile that the scheduler will not mutate.
+func cloneFargateProfile(profile *FargateProfile) *FargateProfile {
+	p := *profile
+
+	return &p
+}
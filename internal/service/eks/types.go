@@ -208,6 +208,31 @@ type Nodegroup struct {
 	Tags           map[string]string   `json:"tags,omitempty"`
 }
 
+// FargateProfile represents an EKS Fargate profile.
+type FargateProfile struct {
+	FargateProfileName  string                   `json:"fargateProfileName"`
+	FargateProfileArn   string                   `json:"fargateProfileArn"`
+	ClusterName         string                   `json:"clusterName"`
+	CreatedAt           *EpochTime               `json:"createdAt,omitempty"`
+	PodExecutionRoleArn string                   `json:"podExecutionRoleArn"`
+	Subnets             []string                 `json:"subnets,omitempty"`
+	Selectors           []FargateProfileSelector `json:"selectors"`
+	Status              string                   `json:"status"`
+	Tags                map[string]string        `json:"tags,omitempty"`
+	Health              *FargateProfileHealth    `json:"health,omitempty"`
+}
+
+// FargateProfileSelector selects the pods that run on Fargate.
+type FargateProfileSelector struct {
+	Namespace string            `json:"namespace"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// FargateProfileHealth represents the health status of a Fargate profile.
+type FargateProfileHealth struct {
+	Issues []Issue `json:"issues"`
+}
+
 // NodegroupScaling represents the scaling configuration for a node group.
 type NodegroupScaling struct {
 	MinSize     *int `json:"minSize,omitempty"`
@@ -343,6 +368,17 @@ type CreateNodegroupRequest struct {
 	ReleaseVersion     string              `json:"releaseVersion,omitempty"`
 }
 
+// CreateFargateProfileRequest represents a CreateFargateProfile request.
+type CreateFargateProfileRequest struct {
+	ClusterName         string                   `json:"-"`
+	FargateProfileName  string                   `json:"fargateProfileName"`
+	PodExecutionRoleArn string                   `json:"podExecutionRoleArn"`
+	Subnets             []string                 `json:"subnets,omitempty"`
+	Selectors           []FargateProfileSelector `json:"selectors,omitempty"`
+	ClientRequestToken  string                   `json:"clientRequestToken,omitempty"`
+	Tags                map[string]string        `json:"tags,omitempty"`
+}
+
 // Response types.
 
 // CreateClusterResponse represents a CreateCluster response.
@@ -387,6 +423,27 @@ type ListNodegroupsResponse struct {
 	NextToken  *string  `json:"nextToken"`
 }
 
+// CreateFargateProfileResponse represents a CreateFargateProfile response.
+type CreateFargateProfileResponse struct {
+	FargateProfile *FargateProfile `json:"fargateProfile"`
+}
+
+// DeleteFargateProfileResponse represents a DeleteFargateProfile response.
+type DeleteFargateProfileResponse struct {
+	FargateProfile *FargateProfile `json:"fargateProfile"`
+}
+
+// DescribeFargateProfileResponse represents a DescribeFargateProfile response.
+type DescribeFargateProfileResponse struct {
+	FargateProfile *FargateProfile `json:"fargateProfile"`
+}
+
+// ListFargateProfilesResponse represents a ListFargateProfiles response.
+type ListFargateProfilesResponse struct {
+	FargateProfileNames []string `json:"fargateProfileNames"`
+	NextToken           *string  `json:"nextToken"`
+}
+
 // Error types.
 
 // Error represents an EKS error.
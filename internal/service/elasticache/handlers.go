@@ -34,6 +34,22 @@ func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
 		s.DeleteReplicationGroup(w, r)
 	case "DescribeReplicationGroups":
 		s.DescribeReplicationGroups(w, r)
+	case "CreateCacheParameterGroup":
+		s.CreateCacheParameterGroup(w, r)
+	case "DescribeCacheParameterGroups":
+		s.DescribeCacheParameterGroups(w, r)
+	case "ModifyCacheParameterGroup":
+		s.ModifyCacheParameterGroup(w, r)
+	case "DeleteCacheParameterGroup":
+		s.DeleteCacheParameterGroup(w, r)
+	case "CreateCacheSubnetGroup":
+		s.CreateCacheSubnetGroup(w, r)
+	case "DescribeCacheSubnetGroups":
+		s.DescribeCacheSubnetGroups(w, r)
+	case "ModifyCacheSubnetGroup":
+		s.ModifyCacheSubnetGroup(w, r)
+	case "DeleteCacheSubnetGroup":
+		s.DeleteCacheSubnetGroup(w, r)
 	default:
 		writeError(w, errInvalidParameterValue, fmt.Sprintf("The action '%s' is not valid", action), http.StatusBadRequest)
 	}
@@ -258,6 +274,266 @@ func (s *Service) DescribeReplicationGroups(w http.ResponseWriter, r *http.Reque
 	})
 }
 
+// CreateCacheParameterGroup handles the CreateCacheParameterGroup action.
+func (s *Service) CreateCacheParameterGroup(w http.ResponseWriter, r *http.Request) {
+	var req CreateCacheParameterGroupInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.CacheParameterGroupName == "" {
+		writeError(w, errInvalidParameterValue, "CacheParameterGroupName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.CacheParameterGroupFamily == "" {
+		writeError(w, errInvalidParameterValue, "CacheParameterGroupFamily is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.Description == "" {
+		writeError(w, errInvalidParameterValue, "Description is required", http.StatusBadRequest)
+
+		return
+	}
+
+	group, err := s.storage.CreateCacheParameterGroup(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeXMLResponse(w, XMLCreateCacheParameterGroupResponse{
+		Xmlns:               elasticacheXMLNS,
+		CacheParameterGroup: convertToXMLCacheParameterGroup(group),
+		RequestID:           uuid.New().String(),
+	})
+}
+
+// DescribeCacheParameterGroups handles the DescribeCacheParameterGroups action.
+func (s *Service) DescribeCacheParameterGroups(w http.ResponseWriter, r *http.Request) {
+	var req DescribeCacheParameterGroupsInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	groups, err := s.storage.DescribeCacheParameterGroups(r.Context(), req.CacheParameterGroupName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	xmlGroups := make([]XMLCacheParameterGroup, 0, len(groups))
+	for i := range groups {
+		xmlGroups = append(xmlGroups, convertToXMLCacheParameterGroup(&groups[i]))
+	}
+
+	writeXMLResponse(w, XMLDescribeCacheParameterGroupsResponse{
+		Xmlns:                elasticacheXMLNS,
+		CacheParameterGroups: XMLCacheParameterGroups{Items: xmlGroups},
+		RequestID:            uuid.New().String(),
+	})
+}
+
+// ModifyCacheParameterGroup handles the ModifyCacheParameterGroup action.
+func (s *Service) ModifyCacheParameterGroup(w http.ResponseWriter, r *http.Request) {
+	var req ModifyCacheParameterGroupInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.CacheParameterGroupName == "" {
+		writeError(w, errInvalidParameterValue, "CacheParameterGroupName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	req.ParameterNameValues = parseParameterNameValues(r)
+	if len(req.ParameterNameValues) == 0 {
+		writeError(w, errInvalidParameterValue, "ParameterNameValues is required", http.StatusBadRequest)
+
+		return
+	}
+
+	group, err := s.storage.ModifyCacheParameterGroup(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeXMLResponse(w, XMLModifyCacheParameterGroupResponse{
+		Xmlns:                   elasticacheXMLNS,
+		CacheParameterGroupName: group.CacheParameterGroupName,
+		RequestID:               uuid.New().String(),
+	})
+}
+
+// DeleteCacheParameterGroup handles the DeleteCacheParameterGroup action.
+func (s *Service) DeleteCacheParameterGroup(w http.ResponseWriter, r *http.Request) {
+	var req DeleteCacheParameterGroupInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.CacheParameterGroupName == "" {
+		writeError(w, errInvalidParameterValue, "CacheParameterGroupName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.DeleteCacheParameterGroup(r.Context(), req.CacheParameterGroupName); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeXMLResponse(w, XMLDeleteCacheParameterGroupResponse{
+		Xmlns:     elasticacheXMLNS,
+		RequestID: uuid.New().String(),
+	})
+}
+
+// CreateCacheSubnetGroup handles the CreateCacheSubnetGroup action.
+func (s *Service) CreateCacheSubnetGroup(w http.ResponseWriter, r *http.Request) {
+	var req CreateCacheSubnetGroupInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.CacheSubnetGroupName == "" {
+		writeError(w, errInvalidParameterValue, "CacheSubnetGroupName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.CacheSubnetGroupDescription == "" {
+		writeError(w, errInvalidParameterValue, "CacheSubnetGroupDescription is required", http.StatusBadRequest)
+
+		return
+	}
+
+	req.SubnetIDs = parseFormList(r, "SubnetIds.SubnetIdentifier")
+	if len(req.SubnetIDs) == 0 {
+		writeError(w, errInvalidParameterValue, "SubnetIds is required", http.StatusBadRequest)
+
+		return
+	}
+
+	group, err := s.storage.CreateCacheSubnetGroup(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeXMLResponse(w, XMLCreateCacheSubnetGroupResponse{
+		Xmlns:            elasticacheXMLNS,
+		CacheSubnetGroup: convertToXMLCacheSubnetGroup(group),
+		RequestID:        uuid.New().String(),
+	})
+}
+
+// DescribeCacheSubnetGroups handles the DescribeCacheSubnetGroups action.
+func (s *Service) DescribeCacheSubnetGroups(w http.ResponseWriter, r *http.Request) {
+	var req DescribeCacheSubnetGroupsInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	groups, err := s.storage.DescribeCacheSubnetGroups(r.Context(), req.CacheSubnetGroupName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	xmlGroups := make([]XMLCacheSubnetGroup, 0, len(groups))
+	for i := range groups {
+		xmlGroups = append(xmlGroups, convertToXMLCacheSubnetGroup(&groups[i]))
+	}
+
+	writeXMLResponse(w, XMLDescribeCacheSubnetGroupsResponse{
+		Xmlns:             elasticacheXMLNS,
+		CacheSubnetGroups: XMLCacheSubnetGroups{Items: xmlGroups},
+		RequestID:         uuid.New().String(),
+	})
+}
+
+// ModifyCacheSubnetGroup handles the ModifyCacheSubnetGroup action.
+func (s *Service) ModifyCacheSubnetGroup(w http.ResponseWriter, r *http.Request) {
+	var req ModifyCacheSubnetGroupInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.CacheSubnetGroupName == "" {
+		writeError(w, errInvalidParameterValue, "CacheSubnetGroupName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	req.SubnetIDs = parseFormList(r, "SubnetIds.SubnetIdentifier")
+
+	group, err := s.storage.ModifyCacheSubnetGroup(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeXMLResponse(w, XMLModifyCacheSubnetGroupResponse{
+		Xmlns:            elasticacheXMLNS,
+		CacheSubnetGroup: convertToXMLCacheSubnetGroup(group),
+		RequestID:        uuid.New().String(),
+	})
+}
+
+// DeleteCacheSubnetGroup handles the DeleteCacheSubnetGroup action.
+func (s *Service) DeleteCacheSubnetGroup(w http.ResponseWriter, r *http.Request) {
+	var req DeleteCacheSubnetGroupInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.CacheSubnetGroupName == "" {
+		writeError(w, errInvalidParameterValue, "CacheSubnetGroupName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.DeleteCacheSubnetGroup(r.Context(), req.CacheSubnetGroupName); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeXMLResponse(w, XMLDeleteCacheSubnetGroupResponse{
+		Xmlns:     elasticacheXMLNS,
+		RequestID: uuid.New().String(),
+	})
+}
+
 // Helper functions.
 
 func extractAction(r *http.Request) string {
@@ -271,6 +547,45 @@ func extractAction(r *http.Request) string {
 	return r.URL.Query().Get("Action")
 }
 
+// parseFormList returns the members of a Query protocol list such as
+// SubnetIds.SubnetIdentifier.N, which the JSON request body does not carry.
+func parseFormList(r *http.Request, prefix string) []string {
+	var values []string
+
+	for i := 1; ; i++ {
+		value := r.Form.Get(fmt.Sprintf("%s.%d", prefix, i))
+		if value == "" {
+			break
+		}
+
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// parseParameterNameValues returns the parameters of a ModifyCacheParameterGroup request,
+// sent as ParameterNameValues.ParameterNameValue.N.ParameterName and ParameterValue.
+func parseParameterNameValues(r *http.Request) []ParameterNameValue {
+	var params []ParameterNameValue
+
+	for i := 1; ; i++ {
+		prefix := fmt.Sprintf("ParameterNameValues.ParameterNameValue.%d.", i)
+
+		name := r.Form.Get(prefix + "ParameterName")
+		if name == "" {
+			break
+		}
+
+		params = append(params, ParameterNameValue{
+			ParameterName:  name,
+			ParameterValue: r.Form.Get(prefix + "ParameterValue"),
+		})
+	}
+
+	return params
+}
+
 func readJSONRequest(r *http.Request, v any) error {
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -316,7 +631,8 @@ func handleError(w http.ResponseWriter, err error) {
 	var ecErr *Error
 	if errors.As(err, &ecErr) {
 		status := http.StatusBadRequest
-		if ecErr.Code == errCacheClusterNotFound || ecErr.Code == errReplicationGroupNotFound {
+		switch ecErr.Code {
+		case errCacheClusterNotFound, errReplicationGroupNotFound, errCacheParameterGroupNotFound, errCacheSubnetGroupNotFound:
 			status = http.StatusNotFound
 		}
 
@@ -349,6 +665,14 @@ func convertToXMLCacheCluster(cluster *CacheCluster) XMLCacheCluster {
 		securityGroups = append(securityGroups, XMLSecurityGroupMembership(sg))
 	}
 
+	var parameterGroup *XMLCacheParameterGroupStatus
+	if cluster.CacheParameterGroup != nil {
+		parameterGroup = &XMLCacheParameterGroupStatus{
+			CacheParameterGroupName: cluster.CacheParameterGroup.CacheParameterGroupName,
+			ParameterApplyStatus:    cluster.CacheParameterGroup.ParameterApplyStatus,
+		}
+	}
+
 	return XMLCacheCluster{
 		CacheClusterID:             cluster.CacheClusterID,
 		CacheClusterStatus:         cluster.CacheClusterStatus,
@@ -366,6 +690,7 @@ func convertToXMLCacheCluster(cluster *CacheCluster) XMLCacheCluster {
 		ARN:                        cluster.ARN,
 		CacheNodes:                 XMLCacheNodes{Items: cacheNodes},
 		SecurityGroups:             XMLSecurityGroups{Items: securityGroups},
+		CacheParameterGroup:        parameterGroup,
 		ConfigurationEndpoint:      configEndpoint,
 	}
 }
@@ -474,6 +799,33 @@ func convertToXMLNodeGroupMember(m *NodeGroupMember) XMLNodeGroupMember {
 	}
 }
 
+func convertToXMLCacheParameterGroup(group *CacheParameterGroup) XMLCacheParameterGroup {
+	return XMLCacheParameterGroup{
+		CacheParameterGroupName:   group.CacheParameterGroupName,
+		CacheParameterGroupFamily: group.CacheParameterGroupFamily,
+		Description:               group.Description,
+		ARN:                       group.ARN,
+	}
+}
+
+func convertToXMLCacheSubnetGroup(group *CacheSubnetGroup) XMLCacheSubnetGroup {
+	subnets := make([]XMLSubnet, 0, len(group.Subnets))
+	for _, subnet := range group.Subnets {
+		subnets = append(subnets, XMLSubnet{
+			SubnetIdentifier:       subnet.SubnetIdentifier,
+			SubnetAvailabilityZone: XMLAvailabilityZone{Name: subnet.SubnetAvailabilityZone},
+		})
+	}
+
+	return XMLCacheSubnetGroup{
+		CacheSubnetGroupName:        group.CacheSubnetGroupName,
+		CacheSubnetGroupDescription: group.CacheSubnetGroupDescription,
+		VpcID:                       group.VpcID,
+		Subnets:                     XMLSubnets{Items: subnets},
+		ARN:                         group.ARN,
+	}
+}
+
 // XML response types.
 
 // XMLCreateCacheClusterResponse is the XML response for CreateCacheCluster.
@@ -532,25 +884,94 @@ type XMLDescribeReplicationGroupsResponse struct {
 	RequestID         string               `xml:"ResponseMetadata>RequestId"`
 }
 
+// XMLCreateCacheParameterGroupResponse is the XML response for CreateCacheParameterGroup.
+type XMLCreateCacheParameterGroupResponse struct {
+	XMLName             xml.Name               `xml:"CreateCacheParameterGroupResponse"`
+	Xmlns               string                 `xml:"xmlns,attr"`
+	CacheParameterGroup XMLCacheParameterGroup `xml:"CreateCacheParameterGroupResult>CacheParameterGroup"`
+	RequestID           string                 `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLDescribeCacheParameterGroupsResponse is the XML response for DescribeCacheParameterGroups.
+type XMLDescribeCacheParameterGroupsResponse struct {
+	XMLName              xml.Name                `xml:"DescribeCacheParameterGroupsResponse"`
+	Xmlns                string                  `xml:"xmlns,attr"`
+	CacheParameterGroups XMLCacheParameterGroups `xml:"DescribeCacheParameterGroupsResult>CacheParameterGroups"`
+	RequestID            string                  `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLModifyCacheParameterGroupResponse is the XML response for ModifyCacheParameterGroup.
+type XMLModifyCacheParameterGroupResponse struct {
+	XMLName                 xml.Name `xml:"ModifyCacheParameterGroupResponse"`
+	Xmlns                   string   `xml:"xmlns,attr"`
+	CacheParameterGroupName string   `xml:"ModifyCacheParameterGroupResult>CacheParameterGroupName"`
+	RequestID               string   `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLDeleteCacheParameterGroupResponse is the XML response for DeleteCacheParameterGroup.
+type XMLDeleteCacheParameterGroupResponse struct {
+	XMLName   xml.Name `xml:"DeleteCacheParameterGroupResponse"`
+	Xmlns     string   `xml:"xmlns,attr"`
+	RequestID string   `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLCreateCacheSubnetGroupResponse is the XML response for CreateCacheSubnetGroup.
+type XMLCreateCacheSubnetGroupResponse struct {
+	XMLName          xml.Name            `xml:"CreateCacheSubnetGroupResponse"`
+	Xmlns            string              `xml:"xmlns,attr"`
+	CacheSubnetGroup XMLCacheSubnetGroup `xml:"CreateCacheSubnetGroupResult>CacheSubnetGroup"`
+	RequestID        string              `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLDescribeCacheSubnetGroupsResponse is the XML response for DescribeCacheSubnetGroups.
+type XMLDescribeCacheSubnetGroupsResponse struct {
+	XMLName           xml.Name             `xml:"DescribeCacheSubnetGroupsResponse"`
+	Xmlns             string               `xml:"xmlns,attr"`
+	CacheSubnetGroups XMLCacheSubnetGroups `xml:"DescribeCacheSubnetGroupsResult>CacheSubnetGroups"`
+	RequestID         string               `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLModifyCacheSubnetGroupResponse is the XML response for ModifyCacheSubnetGroup.
+type XMLModifyCacheSubnetGroupResponse struct {
+	XMLName          xml.Name            `xml:"ModifyCacheSubnetGroupResponse"`
+	Xmlns            string              `xml:"xmlns,attr"`
+	CacheSubnetGroup XMLCacheSubnetGroup `xml:"ModifyCacheSubnetGroupResult>CacheSubnetGroup"`
+	RequestID        string              `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLDeleteCacheSubnetGroupResponse is the XML response for DeleteCacheSubnetGroup.
+type XMLDeleteCacheSubnetGroupResponse struct {
+	XMLName   xml.Name `xml:"DeleteCacheSubnetGroupResponse"`
+	Xmlns     string   `xml:"xmlns,attr"`
+	RequestID string   `xml:"ResponseMetadata>RequestId"`
+}
+
 // XMLCacheCluster is the XML representation of a cache cluster.
 type XMLCacheCluster struct {
-	CacheClusterID             string            `xml:"CacheClusterId"`
-	CacheClusterStatus         string            `xml:"CacheClusterStatus"`
-	CacheNodeType              string            `xml:"CacheNodeType"`
-	Engine                     string            `xml:"Engine"`
-	EngineVersion              string            `xml:"EngineVersion,omitempty"`
-	NumCacheNodes              int32             `xml:"NumCacheNodes"`
-	PreferredAvailabilityZone  string            `xml:"PreferredAvailabilityZone,omitempty"`
-	CacheClusterCreateTime     string            `xml:"CacheClusterCreateTime"`
-	PreferredMaintenanceWindow string            `xml:"PreferredMaintenanceWindow,omitempty"`
-	CacheSubnetGroupName       string            `xml:"CacheSubnetGroupName,omitempty"`
-	AutoMinorVersionUpgrade    bool              `xml:"AutoMinorVersionUpgrade"`
-	SnapshotRetentionLimit     int32             `xml:"SnapshotRetentionLimit"`
-	SnapshotWindow             string            `xml:"SnapshotWindow,omitempty"`
-	ARN                        string            `xml:"ARN"`
-	CacheNodes                 XMLCacheNodes     `xml:"CacheNodes"`
-	SecurityGroups             XMLSecurityGroups `xml:"SecurityGroups"`
-	ConfigurationEndpoint      *XMLEndpoint      `xml:"ConfigurationEndpoint,omitempty"`
+	CacheClusterID             string                        `xml:"CacheClusterId"`
+	CacheClusterStatus         string                        `xml:"CacheClusterStatus"`
+	CacheNodeType              string                        `xml:"CacheNodeType"`
+	Engine                     string                        `xml:"Engine"`
+	EngineVersion              string                        `xml:"EngineVersion,omitempty"`
+	NumCacheNodes              int32                         `xml:"NumCacheNodes"`
+	PreferredAvailabilityZone  string                        `xml:"PreferredAvailabilityZone,omitempty"`
+	CacheClusterCreateTime     string                        `xml:"CacheClusterCreateTime"`
+	PreferredMaintenanceWindow string                        `xml:"PreferredMaintenanceWindow,omitempty"`
+	CacheSubnetGroupName       string                        `xml:"CacheSubnetGroupName,omitempty"`
+	AutoMinorVersionUpgrade    bool                          `xml:"AutoMinorVersionUpgrade"`
+	SnapshotRetentionLimit     int32                         `xml:"SnapshotRetentionLimit"`
+	SnapshotWindow             string                        `xml:"SnapshotWindow,omitempty"`
+	ARN                        string                        `xml:"ARN"`
+	CacheNodes                 XMLCacheNodes                 `xml:"CacheNodes"`
+	SecurityGroups             XMLSecurityGroups             `xml:"SecurityGroups"`
+	CacheParameterGroup        *XMLCacheParameterGroupStatus `xml:"CacheParameterGroup,omitempty"`
+	ConfigurationEndpoint      *XMLEndpoint                  `xml:"ConfigurationEndpoint,omitempty"`
+}
+
+// XMLCacheParameterGroupStatus is the XML representation of the status of a cache cluster's parameter group.
+type XMLCacheParameterGroupStatus struct {
+	CacheParameterGroupName string `xml:"CacheParameterGroupName"`
+	ParameterApplyStatus    string `xml:"ParameterApplyStatus"`
 }
 
 // XMLCacheClusters is a list of XML cache clusters.
@@ -651,6 +1072,49 @@ type XMLNodeGroupMembers struct {
 	Items []XMLNodeGroupMember `xml:"NodeGroupMember"`
 }
 
+// XMLCacheParameterGroup is the XML representation of a cache parameter group.
+type XMLCacheParameterGroup struct {
+	CacheParameterGroupName   string `xml:"CacheParameterGroupName"`
+	CacheParameterGroupFamily string `xml:"CacheParameterGroupFamily"`
+	Description               string `xml:"Description"`
+	ARN                       string `xml:"ARN"`
+}
+
+// XMLCacheParameterGroups is a list of XML cache parameter groups.
+type XMLCacheParameterGroups struct {
+	Items []XMLCacheParameterGroup `xml:"CacheParameterGroup"`
+}
+
+// XMLCacheSubnetGroup is the XML representation of a cache subnet group.
+type XMLCacheSubnetGroup struct {
+	CacheSubnetGroupName        string     `xml:"CacheSubnetGroupName"`
+	CacheSubnetGroupDescription string     `xml:"CacheSubnetGroupDescription"`
+	VpcID                       string     `xml:"VpcId,omitempty"`
+	Subnets                     XMLSubnets `xml:"Subnets"`
+	ARN                         string     `xml:"ARN"`
+}
+
+// XMLCacheSubnetGroups is a list of XML cache subnet groups.
+type XMLCacheSubnetGroups struct {
+	Items []XMLCacheSubnetGroup `xml:"CacheSubnetGroup"`
+}
+
+// XMLSubnets is a list of XML subnets.
+type XMLSubnets struct {
+	Items []XMLSubnet `xml:"Subnet"`
+}
+
+// XMLSubnet is the XML representation of a subnet of a cache subnet group.
+type XMLSubnet struct {
+	SubnetIdentifier       string              `xml:"SubnetIdentifier"`
+	SubnetAvailabilityZone XMLAvailabilityZone `xml:"SubnetAvailabilityZone"`
+}
+
+// XMLAvailabilityZone is the XML representation of an availability zone.
+type XMLAvailabilityZone struct {
+	Name string `xml:"Name"`
+}
+
 // XMLErrorResponse is the XML error response.
 type XMLErrorResponse struct {
 	XMLName   xml.Name `xml:"ErrorResponse"`
@@ -1,9 +1,9 @@
 package elasticache
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"os"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -12,12 +12,14 @@ import (
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"elasticache"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
 
 // Service implements the ElastiCache service.
@@ -59,6 +61,14 @@ func (s *Service) Actions() []string {
 		"CreateReplicationGroup",
 		"DeleteReplicationGroup",
 		"DescribeReplicationGroups",
+		"CreateCacheParameterGroup",
+		"DescribeCacheParameterGroups",
+		"ModifyCacheParameterGroup",
+		"DeleteCacheParameterGroup",
+		"CreateCacheSubnetGroup",
+		"DescribeCacheSubnetGroups",
+		"ModifyCacheSubnetGroup",
+		"DeleteCacheSubnetGroup",
 	}
 }
 
@@ -80,3 +90,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,6 +28,15 @@ type Storage interface {
 	CreateReplicationGroup(ctx context.Context, input *CreateReplicationGroupInput) (*ReplicationGroup, error)
 	DeleteReplicationGroup(ctx context.Context, groupID string) (*ReplicationGroup, error)
 	DescribeReplicationGroups(ctx context.Context, groupID string) ([]ReplicationGroup, error)
+	CreateCacheParameterGroup(ctx context.Context, input *CreateCacheParameterGroupInput) (*CacheParameterGroup, error)
+	DescribeCacheParameterGroups(ctx context.Context, name string) ([]CacheParameterGroup, error)
+	ModifyCacheParameterGroup(ctx context.Context, input *ModifyCacheParameterGroupInput) (*CacheParameterGroup, error)
+	DeleteCacheParameterGroup(ctx context.Context, name string) error
+	CreateCacheSubnetGroup(ctx context.Context, input *CreateCacheSubnetGroupInput) (*CacheSubnetGroup, error)
+	DescribeCacheSubnetGroups(ctx context.Context, name string) ([]CacheSubnetGroup, error)
+	ModifyCacheSubnetGroup(ctx context.Context, input *ModifyCacheSubnetGroupInput) (*CacheSubnetGroup, error)
+	DeleteCacheSubnetGroup(ctx context.Context, name string) error
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -46,9 +57,11 @@ var (
 
 // MemoryStorage implements Storage with in-memory data.
 type MemoryStorage struct {
-	mu                sync.RWMutex                 `json:"-"`
-	CacheClusters     map[string]*CacheCluster     `json:"cacheClusters"`
-	ReplicationGroups map[string]*ReplicationGroup `json:"replicationGroups"`
+	mu                sync.RWMutex                    `json:"-"`
+	CacheClusters     map[string]*CacheCluster        `json:"cacheClusters"`
+	ReplicationGroups map[string]*ReplicationGroup    `json:"replicationGroups"`
+	ParameterGroups   map[string]*CacheParameterGroup `json:"parameterGroups"`
+	SubnetGroups      map[string]*CacheSubnetGroup    `json:"subnetGroups"`
 	dataDir           string
 }
 
@@ -57,6 +70,8 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 	s := &MemoryStorage{
 		CacheClusters:     make(map[string]*CacheCluster),
 		ReplicationGroups: make(map[string]*ReplicationGroup),
+		ParameterGroups:   make(map[string]*CacheParameterGroup),
+		SubnetGroups:      make(map[string]*CacheSubnetGroup),
 	}
 	for _, o := range opts {
 		o(s)
@@ -105,6 +120,14 @@ func (m *MemoryStorage) UnmarshalJSON(data []byte) error {
 		m.ReplicationGroups = make(map[string]*ReplicationGroup)
 	}
 
+	if m.ParameterGroups == nil {
+		m.ParameterGroups = make(map[string]*CacheParameterGroup)
+	}
+
+	if m.SubnetGroups == nil {
+		m.SubnetGroups = make(map[string]*CacheSubnetGroup)
+	}
+
 	return nil
 }
 
@@ -121,6 +144,19 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.CacheClusters = make(map[string]*CacheCluster)
+	m.ReplicationGroups = make(map[string]*ReplicationGroup)
+	m.ParameterGroups = make(map[string]*CacheParameterGroup)
+	m.SubnetGroups = make(map[string]*CacheSubnetGroup)
+
+	return nil
+}
+
 // CreateCacheCluster creates a new cache cluster.
 func (m *MemoryStorage) CreateCacheCluster(_ context.Context, input *CreateCacheClusterInput) (*CacheCluster, error) {
 	m.mu.Lock()
@@ -133,6 +169,10 @@ func (m *MemoryStorage) CreateCacheCluster(_ context.Context, input *CreateCache
 		}
 	}
 
+	if err := m.checkGroupReferences(input.CacheSubnetGroupName, input.CacheParameterGroupName); err != nil {
+		return nil, err
+	}
+
 	cluster := m.buildCacheCluster(input)
 	m.CacheClusters[input.CacheClusterID] = cluster
 
@@ -180,6 +220,13 @@ func (m *MemoryStorage) buildCacheCluster(input *CreateCacheClusterInput) *Cache
 		},
 	}
 
+	if input.CacheParameterGroupName != "" {
+		cluster.CacheParameterGroup = &CacheParameterGroupStatus{
+			CacheParameterGroupName: input.CacheParameterGroupName,
+			ParameterApplyStatus:    "in-sync",
+		}
+	}
+
 	return cluster
 }
 
@@ -324,6 +371,10 @@ func (m *MemoryStorage) CreateReplicationGroup(_ context.Context, input *CreateR
 		}
 	}
 
+	if err := m.checkGroupReferences(input.CacheSubnetGroupName, input.CacheParameterGroupName); err != nil {
+		return nil, err
+	}
+
 	group := m.buildReplicationGroup(input)
 	m.ReplicationGroups[input.ReplicationGroupID] = group
 
@@ -354,6 +405,8 @@ func (m *MemoryStorage) buildReplicationGroup(input *CreateReplicationGroupInput
 		ReplicationGroupCreateTime: now,
 		AutoMinorVersionUpgrade:    input.AutoMinorVersionUpgrade,
 		PreferredMaintenanceWindow: input.PreferredMaintenanceWindow,
+		CacheSubnetGroupName:       input.CacheSubnetGroupName,
+		CacheParameterGroupName:    input.CacheParameterGroupName,
 		ConfigurationEndpoint: &Endpoint{
 			Address: fmt.Sprintf("%s.%s.clustercfg.%s.cache.amazonaws.com", input.ReplicationGroupID, generateID(), defaultRegion),
 			Port:    port,
@@ -469,6 +522,260 @@ func (m *MemoryStorage) DescribeReplicationGroups(_ context.Context, groupID str
 	return groups, nil
 }
 
+// CreateCacheParameterGroup creates a cache parameter group.
+func (m *MemoryStorage) CreateCacheParameterGroup(_ context.Context, input *CreateCacheParameterGroupInput) (*CacheParameterGroup, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.ParameterGroups[input.CacheParameterGroupName]; exists {
+		return nil, &Error{
+			Code:    errCacheParameterGroupExists,
+			Message: fmt.Sprintf("Cache parameter group already exists: %s", input.CacheParameterGroupName),
+		}
+	}
+
+	group := &CacheParameterGroup{
+		CacheParameterGroupName:   input.CacheParameterGroupName,
+		CacheParameterGroupFamily: input.CacheParameterGroupFamily,
+		Description:               input.Description,
+		ARN:                       m.parameterGroupArn(input.CacheParameterGroupName),
+		Parameters:                make(map[string]string),
+	}
+
+	m.ParameterGroups[input.CacheParameterGroupName] = group
+
+	return group, nil
+}
+
+// DescribeCacheParameterGroups describes cache parameter groups, sorted by name.
+func (m *MemoryStorage) DescribeCacheParameterGroups(_ context.Context, name string) ([]CacheParameterGroup, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if name != "" {
+		group, err := m.parameterGroup(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return []CacheParameterGroup{*group}, nil
+	}
+
+	groups := make([]CacheParameterGroup, 0, len(m.ParameterGroups))
+	for _, group := range m.ParameterGroups {
+		groups = append(groups, *group)
+	}
+
+	slices.SortFunc(groups, func(a, b CacheParameterGroup) int {
+		return strings.Compare(a.CacheParameterGroupName, b.CacheParameterGroupName)
+	})
+
+	return groups, nil
+}
+
+// ModifyCacheParameterGroup sets parameter values of a cache parameter group.
+func (m *MemoryStorage) ModifyCacheParameterGroup(_ context.Context, input *ModifyCacheParameterGroupInput) (*CacheParameterGroup, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	group, err := m.parameterGroup(input.CacheParameterGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, param := range input.ParameterNameValues {
+		group.Parameters[param.ParameterName] = param.ParameterValue
+	}
+
+	return group, nil
+}
+
+// DeleteCacheParameterGroup deletes a cache parameter group that no cache cluster or replication group uses.
+func (m *MemoryStorage) DeleteCacheParameterGroup(_ context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, err := m.parameterGroup(name); err != nil {
+		return err
+	}
+
+	inUse := false
+
+	for _, cluster := range m.CacheClusters {
+		if cluster.CacheParameterGroup != nil && cluster.CacheParameterGroup.CacheParameterGroupName == name {
+			inUse = true
+		}
+	}
+
+	for _, group := range m.ReplicationGroups {
+		if group.CacheParameterGroupName == name {
+			inUse = true
+		}
+	}
+
+	if inUse {
+		return &Error{
+			Code:    errInvalidCacheParamGroupState,
+			Message: fmt.Sprintf("Cache parameter group %s is still in use by one or more cache clusters or replication groups", name),
+		}
+	}
+
+	delete(m.ParameterGroups, name)
+
+	return nil
+}
+
+// CreateCacheSubnetGroup creates a cache subnet group.
+func (m *MemoryStorage) CreateCacheSubnetGroup(_ context.Context, input *CreateCacheSubnetGroupInput) (*CacheSubnetGroup, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.SubnetGroups[input.CacheSubnetGroupName]; exists {
+		return nil, &Error{
+			Code:    errCacheSubnetGroupExists,
+			Message: fmt.Sprintf("Cache subnet group already exists: %s", input.CacheSubnetGroupName),
+		}
+	}
+
+	group := &CacheSubnetGroup{
+		CacheSubnetGroupName:        input.CacheSubnetGroupName,
+		CacheSubnetGroupDescription: input.CacheSubnetGroupDescription,
+		Subnets:                     buildSubnets(input.SubnetIDs),
+		ARN:                         m.subnetGroupArn(input.CacheSubnetGroupName),
+	}
+
+	m.SubnetGroups[input.CacheSubnetGroupName] = group
+
+	return cloneCacheSubnetGroup(group), nil
+}
+
+// DescribeCacheSubnetGroups describes cache subnet groups, sorted by name.
+func (m *MemoryStorage) DescribeCacheSubnetGroups(_ context.Context, name string) ([]CacheSubnetGroup, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if name != "" {
+		group, err := m.subnetGroup(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return []CacheSubnetGroup{*cloneCacheSubnetGroup(group)}, nil
+	}
+
+	groups := make([]CacheSubnetGroup, 0, len(m.SubnetGroups))
+	for _, group := range m.SubnetGroups {
+		groups = append(groups, *cloneCacheSubnetGroup(group))
+	}
+
+	slices.SortFunc(groups, func(a, b CacheSubnetGroup) int {
+		return strings.Compare(a.CacheSubnetGroupName, b.CacheSubnetGroupName)
+	})
+
+	return groups, nil
+}
+
+// ModifyCacheSubnetGroup replaces the description and subnets of a cache subnet group, where given.
+func (m *MemoryStorage) ModifyCacheSubnetGroup(_ context.Context, input *ModifyCacheSubnetGroupInput) (*CacheSubnetGroup, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	group, err := m.subnetGroup(input.CacheSubnetGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.CacheSubnetGroupDescription != "" {
+		group.CacheSubnetGroupDescription = input.CacheSubnetGroupDescription
+	}
+
+	if len(input.SubnetIDs) > 0 {
+		group.Subnets = buildSubnets(input.SubnetIDs)
+	}
+
+	return cloneCacheSubnetGroup(group), nil
+}
+
+// DeleteCacheSubnetGroup deletes a cache subnet group that no cache cluster or replication group uses.
+func (m *MemoryStorage) DeleteCacheSubnetGroup(_ context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, err := m.subnetGroup(name); err != nil {
+		return err
+	}
+
+	inUse := false
+
+	for _, cluster := range m.CacheClusters {
+		if cluster.CacheSubnetGroupName == name {
+			inUse = true
+		}
+	}
+
+	for _, group := range m.ReplicationGroups {
+		if group.CacheSubnetGroupName == name {
+			inUse = true
+		}
+	}
+
+	if inUse {
+		return &Error{
+			Code:    errCacheSubnetGroupInUse,
+			Message: fmt.Sprintf("Cache subnet group %s is still in use by one or more cache clusters or replication groups", name),
+		}
+	}
+
+	delete(m.SubnetGroups, name)
+
+	return nil
+}
+
+// parameterGroup returns the cache parameter group with the name.
+func (m *MemoryStorage) parameterGroup(name string) (*CacheParameterGroup, error) {
+	group, exists := m.ParameterGroups[name]
+	if !exists {
+		return nil, &Error{
+			Code:    errCacheParameterGroupNotFound,
+			Message: fmt.Sprintf("Cache parameter group not found: %s", name),
+		}
+	}
+
+	return group, nil
+}
+
+// subnetGroup returns the cache subnet group with the name.
+func (m *MemoryStorage) subnetGroup(name string) (*CacheSubnetGroup, error) {
+	group, exists := m.SubnetGroups[name]
+	if !exists {
+		return nil, &Error{
+			Code:    errCacheSubnetGroupNotFound,
+			Message: fmt.Sprintf("Cache subnet group not found: %s", name),
+		}
+	}
+
+	return group, nil
+}
+
+// checkGroupReferences reports an error if a cache subnet group or cache
+// parameter group referenced by a new cache cluster or replication group does
+// not exist.
+func (m *MemoryStorage) checkGroupReferences(subnetGroupName, parameterGroupName string) error {
+	if subnetGroupName != "" {
+		if _, err := m.subnetGroup(subnetGroupName); err != nil {
+			return err
+		}
+	}
+
+	if parameterGroupName != "" {
+		if _, err := m.parameterGroup(parameterGroupName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Helper functions.
 
 func (m *MemoryStorage) cacheClusterArn(clusterID string) string {
@@ -479,6 +786,14 @@ func (m *MemoryStorage) replicationGroupArn(groupID string) string {
 	return fmt.Sprintf("arn:aws:elasticache:%s:%s:replicationgroup:%s", defaultRegion, defaultAccountID, groupID)
 }
 
+func (m *MemoryStorage) parameterGroupArn(name string) string {
+	return fmt.Sprintf("arn:aws:elasticache:%s:%s:parametergroup:%s", defaultRegion, defaultAccountID, name)
+}
+
+func (m *MemoryStorage) subnetGroupArn(name string) string {
+	return fmt.Sprintf("arn:aws:elasticache:%s:%s:subnetgroup:%s", defaultRegion, defaultAccountID, name)
+}
+
 func (m *MemoryStorage) getDefaultPort(engine string) int32 {
 	switch engine {
 	case "redis", "valkey":
@@ -510,6 +825,30 @@ func buildSecurityGroups(sgIDs []string) []SecurityGroupMembership {
 	return groups
 }
 
+// buildSubnets returns the subnets of a cache subnet group, spreading them
+// across the availability zones of the region.
+func buildSubnets(subnetIDs []string) []Subnet {
+	zones := []string{"a", "b", "c"}
+
+	subnets := make([]Subnet, 0, len(subnetIDs))
+	for i, subnetID := range subnetIDs {
+		subnets = append(subnets, Subnet{
+			SubnetIdentifier:       subnetID,
+			SubnetAvailabilityZone: defaultRegion + zones[i%len(zones)],
+		})
+	}
+
+	return subnets
+}
+
+// cloneCacheSubnetGroup returns a copy of a cache subnet group that later modifications will not change.
+func cloneCacheSubnetGroup(group *CacheSubnetGroup) *CacheSubnetGroup {
+	g := *group
+	g.Subnets = slices.Clone(group.Subnets)
+
+	return &g
+}
+
 func automaticFailoverStatus(enabled bool) string {
 	if enabled {
 		return "enabled"
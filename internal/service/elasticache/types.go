@@ -58,6 +58,8 @@ type ReplicationGroup struct {
 	ReplicationGroupCreateTime time.Time
 	AutoMinorVersionUpgrade    bool
 	PreferredMaintenanceWindow string
+	CacheSubnetGroupName       string
+	CacheParameterGroupName    string
 }
 
 // NodeGroup represents a node group in a replication group.
@@ -103,6 +105,37 @@ type CacheParameterGroupStatus struct {
 	CacheNodeIDsToReboot    []string
 }
 
+// CacheParameterGroup represents an ElastiCache cache parameter group.
+type CacheParameterGroup struct {
+	CacheParameterGroupName   string
+	CacheParameterGroupFamily string
+	Description               string
+	ARN                       string
+	// Parameters holds the parameter values set by ModifyCacheParameterGroup, keyed by parameter name.
+	Parameters map[string]string
+}
+
+// ParameterNameValue represents a parameter value to set in a cache parameter group.
+type ParameterNameValue struct {
+	ParameterName  string
+	ParameterValue string
+}
+
+// CacheSubnetGroup represents an ElastiCache cache subnet group.
+type CacheSubnetGroup struct {
+	CacheSubnetGroupName        string
+	CacheSubnetGroupDescription string
+	VpcID                       string
+	Subnets                     []Subnet
+	ARN                         string
+}
+
+// Subnet represents a subnet of a cache subnet group.
+type Subnet struct {
+	SubnetIdentifier       string
+	SubnetAvailabilityZone string
+}
+
 // Request types.
 
 // CreateCacheClusterInput represents the input for CreateCacheCluster.
@@ -115,6 +148,7 @@ type CreateCacheClusterInput struct {
 	PreferredAvailabilityZone  string   `json:"PreferredAvailabilityZone,omitempty"`
 	PreferredMaintenanceWindow string   `json:"PreferredMaintenanceWindow,omitempty"`
 	CacheSubnetGroupName       string   `json:"CacheSubnetGroupName,omitempty"`
+	CacheParameterGroupName    string   `json:"CacheParameterGroupName,omitempty"`
 	SecurityGroupIDs           []string `json:"SecurityGroupIds,omitempty"`
 	AutoMinorVersionUpgrade    bool     `json:"AutoMinorVersionUpgrade,omitempty"`
 	SnapshotRetentionLimit     int32    `json:"SnapshotRetentionLimit,omitempty"`
@@ -187,6 +221,7 @@ type CreateReplicationGroupInput struct {
 	Engine                      string   `json:"Engine,omitempty"`
 	EngineVersion               string   `json:"EngineVersion,omitempty"`
 	CacheSubnetGroupName        string   `json:"CacheSubnetGroupName,omitempty"`
+	CacheParameterGroupName     string   `json:"CacheParameterGroupName,omitempty"`
 	SecurityGroupIDs            []string `json:"SecurityGroupIds,omitempty"`
 	PreferredMaintenanceWindow  string   `json:"PreferredMaintenanceWindow,omitempty"`
 	SnapshotRetentionLimit      int32    `json:"SnapshotRetentionLimit,omitempty"`
@@ -227,6 +262,60 @@ type DescribeReplicationGroupsOutput struct {
 	Marker            string             `json:"Marker,omitempty"`
 }
 
+// CreateCacheParameterGroupInput represents the input for CreateCacheParameterGroup.
+type CreateCacheParameterGroupInput struct {
+	CacheParameterGroupName   string `json:"CacheParameterGroupName"`
+	CacheParameterGroupFamily string `json:"CacheParameterGroupFamily"`
+	Description               string `json:"Description"`
+}
+
+// DescribeCacheParameterGroupsInput represents the input for DescribeCacheParameterGroups.
+type DescribeCacheParameterGroupsInput struct {
+	CacheParameterGroupName string `json:"CacheParameterGroupName,omitempty"`
+	MaxRecords              int32  `json:"MaxRecords,omitempty"`
+	Marker                  string `json:"Marker,omitempty"`
+}
+
+// ModifyCacheParameterGroupInput represents the input for ModifyCacheParameterGroup.
+type ModifyCacheParameterGroupInput struct {
+	CacheParameterGroupName string `json:"CacheParameterGroupName"`
+	// ParameterNameValues is read from the ParameterNameValues.ParameterNameValue.N query parameters.
+	ParameterNameValues []ParameterNameValue `json:"-"`
+}
+
+// DeleteCacheParameterGroupInput represents the input for DeleteCacheParameterGroup.
+type DeleteCacheParameterGroupInput struct {
+	CacheParameterGroupName string `json:"CacheParameterGroupName"`
+}
+
+// CreateCacheSubnetGroupInput represents the input for CreateCacheSubnetGroup.
+type CreateCacheSubnetGroupInput struct {
+	CacheSubnetGroupName        string `json:"CacheSubnetGroupName"`
+	CacheSubnetGroupDescription string `json:"CacheSubnetGroupDescription"`
+	// SubnetIDs is read from the SubnetIds.SubnetIdentifier.N query parameters.
+	SubnetIDs []string `json:"-"`
+}
+
+// DescribeCacheSubnetGroupsInput represents the input for DescribeCacheSubnetGroups.
+type DescribeCacheSubnetGroupsInput struct {
+	CacheSubnetGroupName string `json:"CacheSubnetGroupName,omitempty"`
+	MaxRecords           int32  `json:"MaxRecords,omitempty"`
+	Marker               string `json:"Marker,omitempty"`
+}
+
+// ModifyCacheSubnetGroupInput represents the input for ModifyCacheSubnetGroup.
+type ModifyCacheSubnetGroupInput struct {
+	CacheSubnetGroupName        string `json:"CacheSubnetGroupName"`
+	CacheSubnetGroupDescription string `json:"CacheSubnetGroupDescription,omitempty"`
+	// SubnetIDs is read from the SubnetIds.SubnetIdentifier.N query parameters.
+	SubnetIDs []string `json:"-"`
+}
+
+// DeleteCacheSubnetGroupInput represents the input for DeleteCacheSubnetGroup.
+type DeleteCacheSubnetGroupInput struct {
+	CacheSubnetGroupName string `json:"CacheSubnetGroupName"`
+}
+
 // Error types.
 
 // Error represents an ElastiCache error.
@@ -248,6 +337,12 @@ const (
 	errReplicationGroupAlreadyExists = "ReplicationGroupAlreadyExistsFault"
 	errInvalidCacheClusterState      = "InvalidCacheClusterStateFault"
 	errInvalidReplicationGroupState  = "InvalidReplicationGroupStateFault"
+	errCacheParameterGroupNotFound   = "CacheParameterGroupNotFound"
+	errCacheParameterGroupExists     = "CacheParameterGroupAlreadyExists"
+	errInvalidCacheParamGroupState   = "InvalidCacheParameterGroupState"
+	errCacheSubnetGroupNotFound      = "CacheSubnetGroupNotFoundFault"
+	errCacheSubnetGroupExists        = "CacheSubnetGroupAlreadyExists"
+	errCacheSubnetGroupInUse         = "CacheSubnetGroupInUse"
 	errInvalidParameterValue         = "InvalidParameterValue"
 	errInvalidParameterCombination   = "InvalidParameterCombination"
 )
@@ -41,6 +41,7 @@ type Storage interface {
 	CreateEnvironment(ctx context.Context, req *CreateEnvironmentInput) (*EnvironmentDescription, error)
 	DescribeEnvironments(ctx context.Context, appName string, envNames []string) ([]EnvironmentDescription, error)
 	TerminateEnvironment(ctx context.Context, envName string) (*EnvironmentDescription, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -136,6 +137,17 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Applications = make(map[string]*ApplicationDescription)
+	m.Environments = make(map[string]*EnvironmentDescription)
+
+	return nil
+}
+
 // CreateApplication creates a new application.
 func (m *MemoryStorage) CreateApplication(_ context.Context, req *CreateApplicationInput) (*ApplicationDescription, error) {
 	m.mu.Lock()
@@ -33,6 +33,7 @@ type Storage interface {
 
 	CreateListener(ctx context.Context, req *CreateListenerRequest) (*Listener, error)
 	DeleteListener(ctx context.Context, listenerArn string) error
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -140,6 +141,19 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.LoadBalancers = make(map[string]*LoadBalancer)
+	m.TargetGroups = make(map[string]*TargetGroup)
+	m.Listeners = make(map[string]*Listener)
+	m.Targets = make(map[string][]Target)
+
+	return nil
+}
+
 // loadBalancerDefaults holds default values for load balancer creation.
 type loadBalancerDefaults struct {
 	lbType        string
@@ -29,6 +29,7 @@ type Storage interface {
 	GetJobRun(ctx context.Context, applicationID, jobRunID string) (*JobRun, error)
 	ListJobRuns(ctx context.Context, req *ListJobRunsInput) (*ListJobRunsOutput, error)
 	CancelJobRun(ctx context.Context, applicationID, jobRunID string) (*JobRun, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -124,6 +125,17 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Applications = make(map[string]*Application)
+	m.JobRuns = make(map[string]map[string]*JobRun)
+
+	return nil
+}
+
 const (
 	accountID = "123456789012"
 	region    = "us-east-1"
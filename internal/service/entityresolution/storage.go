@@ -33,6 +33,7 @@ type Storage interface {
 	ListIDMappingWorkflows(ctx context.Context) ([]IDMappingWorkflowSummary, error)
 
 	ListProviderServices(ctx context.Context) ([]ProviderService, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -134,6 +135,18 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Schemas = make(map[string]*SchemaMapping)
+	m.MatchingWorkflows = make(map[string]*MatchingWorkflow)
+	m.IDMappingWorkflows = make(map[string]*IDMappingWorkflow)
+
+	return nil
+}
+
 // CreateSchemaMapping creates a new schema mapping.
 func (m *MemoryStorage) CreateSchemaMapping(_ context.Context, req *CreateSchemaMappingRequest) (*SchemaMapping, error) {
 	m.mu.Lock()
@@ -0,0 +1,270 @@
+package eventbridge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// archiveEvent captures an event into every archive of its event bus whose event pattern matches. Must be called under lock.
+func (s *MemoryStorage) archiveEvent(eventID, eventBusName string, entry *PutEventsRequestEntry) {
+	bus, exists := s.EventBuses[eventBusName]
+	if !exists {
+		return
+	}
+
+	now := time.Now()
+
+	eventTime := now
+	if entry.Time != nil {
+		eventTime = entry.Time.Time
+	}
+
+	for _, archive := range s.Archives {
+		if archive.EventSourceArn != bus.Arn || !matchEventPattern(archive.EventPattern, entry) {
+			continue
+		}
+
+		archive.Events = append(archive.Events, ArchivedEvent{
+			EventID: eventID,
+			Time:    eventTime,
+			Entry:   *entry,
+		})
+
+		if archive.RetentionDays > 0 {
+			cutoff := now.AddDate(0, 0, -int(archive.RetentionDays))
+			archive.Events = slices.DeleteFunc(archive.Events, func(e ArchivedEvent) bool {
+				return e.Time.Before(cutoff)
+			})
+		}
+	}
+}
+
+// CreateArchive creates an archive that captures events sent to an event bus.
+func (s *MemoryStorage) CreateArchive(ctx context.Context, req *CreateArchiveRequest) (*Archive, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.Archives[req.ArchiveName]; exists {
+		return nil, &ServiceError{Code: errArchiveAlreadyExists, Message: "Archive already exists"}
+	}
+
+	if s.eventBusByArn(req.EventSourceArn) == nil {
+		return nil, &ServiceError{Code: errEventBusNotFound, Message: "Event bus not found"}
+	}
+
+	if req.EventPattern != "" && !json.Valid([]byte(req.EventPattern)) {
+		return nil, &ServiceError{Code: errInvalidEventPattern, Message: "Event pattern is not valid"}
+	}
+
+	archive := &Archive{
+		Name:           req.ArchiveName,
+		Arn:            fmt.Sprintf("arn:aws:events:%s:%s:archive/%s", service.Region(ctx), service.AccountID(ctx), req.ArchiveName),
+		EventSourceArn: req.EventSourceArn,
+		Description:    req.Description,
+		EventPattern:   req.EventPattern,
+		RetentionDays:  req.RetentionDays,
+		State:          ArchiveStateEnabled,
+		CreationTime:   time.Now(),
+	}
+
+	s.Archives[req.ArchiveName] = archive
+
+	return cloneArchive(archive), nil
+}
+
+// DescribeArchive describes an archive.
+func (s *MemoryStorage) DescribeArchive(_ context.Context, name string) (*Archive, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	archive, exists := s.Archives[name]
+	if !exists {
+		return nil, &ServiceError{Code: errArchiveNotFound, Message: "Archive not found"}
+	}
+
+	return cloneArchive(archive), nil
+}
+
+// ListArchives lists archives sorted by name.
+func (s *MemoryStorage) ListArchives(_ context.Context, req *ListArchivesRequest) ([]*Archive, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	var result []*Archive
+
+	for _, name := range slices.Sorted(maps.Keys(s.Archives)) {
+		archive := s.Archives[name]
+
+		if req.NamePrefix != "" && !strings.HasPrefix(archive.Name, req.NamePrefix) {
+			continue
+		}
+
+		if req.EventSourceArn != "" && archive.EventSourceArn != req.EventSourceArn {
+			continue
+		}
+
+		if req.State != "" && archive.State != req.State {
+			continue
+		}
+
+		result = append(result, cloneArchive(archive))
+
+		if int32(len(result)) >= limit { //nolint:gosec // slice length bounded by limit parameter
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// StartReplay replays the archived events within a time range through the rules of the destination event bus.
+func (s *MemoryStorage) StartReplay(ctx context.Context, req *StartReplayRequest) (*Replay, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.Replays[req.ReplayName]; exists {
+		return nil, &ServiceError{Code: errReplayAlreadyExists, Message: "Replay already exists"}
+	}
+
+	var archive *Archive
+
+	for _, a := range s.Archives {
+		if a.Arn == req.EventSourceArn {
+			archive = a
+
+			break
+		}
+	}
+
+	if archive == nil {
+		return nil, &ServiceError{Code: errArchiveNotFound, Message: "Archive not found"}
+	}
+
+	if !req.EventEndTime.After(req.EventStartTime.Time) {
+		return nil, &ServiceError{Code: errInvalidParameter, Message: "EventEndTime must be after EventStartTime"}
+	}
+
+	if req.Destination.Arn != archive.EventSourceArn {
+		return nil, &ServiceError{Code: errInvalidParameter, Message: "Destination must be the event bus the archive was created for"}
+	}
+
+	bus := s.eventBusByArn(req.Destination.Arn)
+	if bus == nil {
+		return nil, &ServiceError{Code: errEventBusNotFound, Message: "Event bus not found"}
+	}
+
+	now := time.Now()
+	replay := &Replay{
+		Name:            req.ReplayName,
+		Arn:             fmt.Sprintf("arn:aws:events:%s:%s:replay/%s", service.Region(ctx), service.AccountID(ctx), req.ReplayName),
+		Description:     req.Description,
+		EventSourceArn:  req.EventSourceArn,
+		Destination:     req.Destination,
+		EventStartTime:  req.EventStartTime.Time,
+		EventEndTime:    req.EventEndTime.Time,
+		ReplayStartTime: now,
+	}
+
+	events := slices.Clone(archive.Events)
+	slices.SortStableFunc(events, func(a, b ArchivedEvent) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	for _, event := range events {
+		if event.Time.Before(replay.EventStartTime) || !event.Time.Before(replay.EventEndTime) {
+			continue
+		}
+
+		s.deliverToRules(ctx, event.EventID, bus.Name, &event.Entry, replay)
+		replay.EventLastReplayedTime = event.Time
+	}
+
+	// Events are replayed synchronously, so the replay has completed by the time it is returned.
+	replay.State = ReplayStateCompleted
+	replay.ReplayEndTime = time.Now()
+
+	s.Replays[req.ReplayName] = replay
+
+	return replay, nil
+}
+
+// DescribeReplay describes a replay.
+func (s *MemoryStorage) DescribeReplay(_ context.Context, name string) (*Replay, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	replay, exists := s.Replays[name]
+	if !exists {
+		return nil, &ServiceError{Code: errReplayNotFound, Message: "Replay not found"}
+	}
+
+	return replay, nil
+}
+
+// ListReplays lists replays sorted by name.
+func (s *MemoryStorage) ListReplays(_ context.Context, req *ListReplaysRequest) ([]*Replay, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	var result []*Replay
+
+	for _, name := range slices.Sorted(maps.Keys(s.Replays)) {
+		replay := s.Replays[name]
+
+		if req.NamePrefix != "" && !strings.HasPrefix(replay.Name, req.NamePrefix) {
+			continue
+		}
+
+		if req.EventSourceArn != "" && replay.EventSourceArn != req.EventSourceArn {
+			continue
+		}
+
+		if req.State != "" && replay.State != req.State {
+			continue
+		}
+
+		result = append(result, replay)
+
+		if int32(len(result)) >= limit { //nolint:gosec // slice length bounded by limit parameter
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// eventBusByArn returns the event bus with the given ARN, or nil. Must be called under lock.
+func (s *MemoryStorage) eventBusByArn(arn string) *EventBus {
+	for _, bus := range s.EventBuses {
+		if bus.Arn == arn {
+			return bus
+		}
+	}
+
+	return nil
+}
+
+// cloneArchive returns a copy of an archive that does not share its events, which keep changing as events are put.
+func cloneArchive(archive *Archive) *Archive {
+	clone := *archive
+	clone.Events = slices.Clone(archive.Events)
+
+	return &clone
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -33,6 +34,12 @@ func (s *Service) getActionHandlers() map[string]handlerFunc {
 		"CreateApiDestination":   s.CreateAPIDestination,
 		"DescribeApiDestination": s.DescribeAPIDestination,
 		"DeleteApiDestination":   s.DeleteAPIDestination,
+		"CreateArchive":          s.CreateArchive,
+		"DescribeArchive":        s.DescribeArchive,
+		"ListArchives":           s.ListArchives,
+		"StartReplay":            s.StartReplay,
+		"DescribeReplay":         s.DescribeReplay,
+		"ListReplays":            s.ListReplays,
 	}
 }
 
@@ -523,6 +530,189 @@ func (s *Service) DeleteAPIDestination(w http.ResponseWriter, r *http.Request) {
 	writeResponse(w, &DeleteAPIDestinationResponse{})
 }
 
+// CreateArchive handles the CreateArchive API.
+func (s *Service) CreateArchive(w http.ResponseWriter, r *http.Request) {
+	var req CreateArchiveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	archive, err := s.storage.CreateArchive(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &CreateArchiveResponse{
+		ArchiveArn:   archive.Arn,
+		State:        archive.State,
+		CreationTime: float64(archive.CreationTime.Unix()),
+	})
+}
+
+// DescribeArchive handles the DescribeArchive API.
+func (s *Service) DescribeArchive(w http.ResponseWriter, r *http.Request) {
+	var req DescribeArchiveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	archive, err := s.storage.DescribeArchive(r.Context(), req.ArchiveName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &DescribeArchiveResponse{
+		ArchiveName:    archive.Name,
+		ArchiveArn:     archive.Arn,
+		EventSourceArn: archive.EventSourceArn,
+		Description:    archive.Description,
+		EventPattern:   archive.EventPattern,
+		State:          archive.State,
+		RetentionDays:  archive.RetentionDays,
+		SizeBytes:      archive.SizeBytes(),
+		EventCount:     int64(len(archive.Events)),
+		CreationTime:   float64(archive.CreationTime.Unix()),
+	})
+}
+
+// ListArchives handles the ListArchives API.
+func (s *Service) ListArchives(w http.ResponseWriter, r *http.Request) {
+	var req ListArchivesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	archives, err := s.storage.ListArchives(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	outputs := make([]ArchiveOutput, len(archives))
+
+	for i, archive := range archives {
+		outputs[i] = ArchiveOutput{
+			ArchiveName:    archive.Name,
+			EventSourceArn: archive.EventSourceArn,
+			State:          archive.State,
+			RetentionDays:  archive.RetentionDays,
+			SizeBytes:      archive.SizeBytes(),
+			EventCount:     int64(len(archive.Events)),
+			CreationTime:   float64(archive.CreationTime.Unix()),
+		}
+	}
+
+	writeResponse(w, &ListArchivesResponse{Archives: outputs})
+}
+
+// StartReplay handles the StartReplay API.
+func (s *Service) StartReplay(w http.ResponseWriter, r *http.Request) {
+	var req StartReplayRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	replay, err := s.storage.StartReplay(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &StartReplayResponse{
+		ReplayArn:       replay.Arn,
+		State:           replay.State,
+		ReplayStartTime: float64(replay.ReplayStartTime.Unix()),
+	})
+}
+
+// DescribeReplay handles the DescribeReplay API.
+func (s *Service) DescribeReplay(w http.ResponseWriter, r *http.Request) {
+	var req DescribeReplayRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	replay, err := s.storage.DescribeReplay(r.Context(), req.ReplayName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &DescribeReplayResponse{
+		ReplayName:            replay.Name,
+		ReplayArn:             replay.Arn,
+		Description:           replay.Description,
+		State:                 replay.State,
+		EventSourceArn:        replay.EventSourceArn,
+		Destination:           &replay.Destination,
+		EventStartTime:        float64(replay.EventStartTime.Unix()),
+		EventEndTime:          float64(replay.EventEndTime.Unix()),
+		EventLastReplayedTime: epochSeconds(replay.EventLastReplayedTime),
+		ReplayStartTime:       float64(replay.ReplayStartTime.Unix()),
+		ReplayEndTime:         epochSeconds(replay.ReplayEndTime),
+	})
+}
+
+// ListReplays handles the ListReplays API.
+func (s *Service) ListReplays(w http.ResponseWriter, r *http.Request) {
+	var req ListReplaysRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	replays, err := s.storage.ListReplays(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	outputs := make([]ReplayOutput, len(replays))
+
+	for i, replay := range replays {
+		outputs[i] = ReplayOutput{
+			ReplayName:            replay.Name,
+			EventSourceArn:        replay.EventSourceArn,
+			State:                 replay.State,
+			EventStartTime:        float64(replay.EventStartTime.Unix()),
+			EventEndTime:          float64(replay.EventEndTime.Unix()),
+			EventLastReplayedTime: epochSeconds(replay.EventLastReplayedTime),
+			ReplayStartTime:       float64(replay.ReplayStartTime.Unix()),
+			ReplayEndTime:         epochSeconds(replay.ReplayEndTime),
+		}
+	}
+
+	writeResponse(w, &ListReplaysResponse{Replays: outputs})
+}
+
+// epochSeconds converts a time to epoch seconds, leaving an unset time as zero so it is omitted from responses.
+func epochSeconds(t time.Time) float64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return float64(t.Unix())
+}
+
 // GetDeliveredEvents returns events that were matched against rules and delivered to targets.
 // This is a kumo-specific endpoint for test verification.
 func (s *Service) GetDeliveredEvents(w http.ResponseWriter, r *http.Request) {
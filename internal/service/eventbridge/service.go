@@ -2,6 +2,7 @@
 package eventbridge
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -44,28 +45,30 @@ func (s *Service) RegisterRoutes(r service.Router) {
 }
 
 func init() {
-	baseURL := defaultBaseURL
-
-	if host := os.Getenv("KUMO_HOST"); host != "" {
-		port := os.Getenv("KUMO_PORT")
-		if port == "" {
-			port = "4566"
+	service.RegisterFactory([]string{"events"}, func(dataDir string) []service.Service {
+		baseURL := defaultBaseURL
+
+		if host := os.Getenv("KUMO_HOST"); host != "" {
+			port := os.Getenv("KUMO_PORT")
+			if port == "" {
+				port = "4566"
+			}
+
+			baseURL = fmt.Sprintf("http://%s:%s", host, port)
+		} else if port := os.Getenv("KUMO_PORT"); port != "" {
+			baseURL = fmt.Sprintf("http://localhost:%s", port)
 		}
 
-		baseURL = fmt.Sprintf("http://%s:%s", host, port)
-	} else if port := os.Getenv("KUMO_PORT"); port != "" {
-		baseURL = fmt.Sprintf("http://localhost:%s", port)
-	}
-
-	var opts []Option
+		var opts []Option
 
-	opts = append(opts, WithBaseURL(baseURL))
+		opts = append(opts, WithBaseURL(baseURL))
 
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
 
 // Close saves the storage state if persistence is enabled.
@@ -78,3 +81,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/sivchari/kumo/internal/service"
 	"github.com/sivchari/kumo/internal/storage"
 )
 
@@ -25,7 +26,12 @@ const (
 	errEventBusNotFound      = "ResourceNotFoundException"
 	errEventBusAlreadyExists = "ResourceAlreadyExistsException"
 	errRuleNotFound          = "ResourceNotFoundException"
+	errArchiveNotFound       = "ResourceNotFoundException"
+	errArchiveAlreadyExists  = "ResourceAlreadyExistsException"
+	errReplayNotFound        = "ResourceNotFoundException"
+	errReplayAlreadyExists   = "ResourceAlreadyExistsException"
 	errInvalidParameter      = "ValidationException"
+	errInvalidEventPattern   = "InvalidEventPatternException"
 )
 
 // Storage defines the EventBridge storage interface.
@@ -61,8 +67,19 @@ type Storage interface {
 	DescribeAPIDestination(ctx context.Context, name string) (*APIDestination, error)
 	DeleteAPIDestination(ctx context.Context, name string) error
 
+	// Archive operations.
+	CreateArchive(ctx context.Context, req *CreateArchiveRequest) (*Archive, error)
+	DescribeArchive(ctx context.Context, name string) (*Archive, error)
+	ListArchives(ctx context.Context, req *ListArchivesRequest) ([]*Archive, error)
+
+	// Replay operations.
+	StartReplay(ctx context.Context, req *StartReplayRequest) (*Replay, error)
+	DescribeReplay(ctx context.Context, name string) (*Replay, error)
+	ListReplays(ctx context.Context, req *ListReplaysRequest) ([]*Replay, error)
+
 	// DispatchAction dispatches the request to the appropriate handler.
 	DispatchAction(action string) bool
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -97,8 +114,8 @@ type MemoryStorage struct {
 	Connections     map[string]*Connection          `json:"connections"`
 	APIDestinations map[string]*APIDestination      `json:"apiDestinations"`
 	DeliveredEvents []DeliveredEvent                `json:"deliveredEvents"`
-	region          string
-	accountID       string
+	Archives        map[string]*Archive             `json:"archives"`
+	Replays         map[string]*Replay              `json:"replays"`
 	dataDir         string
 	baseURL         string
 	logger          *slog.Logger
@@ -112,8 +129,8 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		Targets:         make(map[string]map[string][]*Target),
 		Connections:     make(map[string]*Connection),
 		APIDestinations: make(map[string]*APIDestination),
-		region:          "us-east-1",
-		accountID:       "000000000000",
+		Archives:        make(map[string]*Archive),
+		Replays:         make(map[string]*Replay),
 		baseURL:         "http://localhost:4566",
 		logger:          slog.New(slog.NewTextHandler(os.Stdout, nil)),
 	}
@@ -127,14 +144,7 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 
 	// Create default event bus if not present.
 	if _, exists := s.EventBuses[defaultEventBusName]; !exists {
-		now := time.Now()
-		s.EventBuses[defaultEventBusName] = &EventBus{
-			Name:         defaultEventBusName,
-			Arn:          fmt.Sprintf("arn:aws:events:%s:%s:event-bus/%s", s.region, s.accountID, defaultEventBusName),
-			CreationTime: now,
-			LastModified: now,
-		}
-		s.Rules[defaultEventBusName] = make(map[string]*Rule)
+		s.createDefaultEventBus()
 	}
 
 	return s
@@ -180,6 +190,14 @@ func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
 		s.Targets = make(map[string]map[string][]*Target)
 	}
 
+	if s.Archives == nil {
+		s.Archives = make(map[string]*Archive)
+	}
+
+	if s.Replays == nil {
+		s.Replays = make(map[string]*Replay)
+	}
+
 	return nil
 }
 
@@ -196,8 +214,38 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.EventBuses = make(map[string]*EventBus)
+	s.Rules = make(map[string]map[string]*Rule)
+	s.Targets = make(map[string]map[string][]*Target)
+	s.Connections = make(map[string]*Connection)
+	s.APIDestinations = make(map[string]*APIDestination)
+	s.DeliveredEvents = nil
+	s.Archives = make(map[string]*Archive)
+	s.Replays = make(map[string]*Replay)
+	s.createDefaultEventBus()
+
+	return nil
+}
+
+// createDefaultEventBus creates the default event bus.
+func (s *MemoryStorage) createDefaultEventBus() {
+	now := time.Now()
+	s.EventBuses[defaultEventBusName] = &EventBus{
+		Name:         defaultEventBusName,
+		Arn:          fmt.Sprintf("arn:aws:events:%s:%s:event-bus/%s", service.DefaultRegion, service.DefaultAccountID, defaultEventBusName),
+		CreationTime: now,
+		LastModified: now,
+	}
+	s.Rules[defaultEventBusName] = make(map[string]*Rule)
+}
+
 // CreateEventBus creates a new event bus.
-func (s *MemoryStorage) CreateEventBus(_ context.Context, req *CreateEventBusRequest) (*EventBus, error) {
+func (s *MemoryStorage) CreateEventBus(ctx context.Context, req *CreateEventBusRequest) (*EventBus, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -208,7 +256,7 @@ func (s *MemoryStorage) CreateEventBus(_ context.Context, req *CreateEventBusReq
 	now := time.Now()
 	eventBus := &EventBus{
 		Name:         req.Name,
-		Arn:          fmt.Sprintf("arn:aws:events:%s:%s:event-bus/%s", s.region, s.accountID, req.Name),
+		Arn:          fmt.Sprintf("arn:aws:events:%s:%s:event-bus/%s", service.Region(ctx), service.AccountID(ctx), req.Name),
 		Description:  req.Description,
 		CreationTime: now,
 		LastModified: now,
@@ -288,7 +336,7 @@ func (s *MemoryStorage) ListEventBuses(_ context.Context, namePrefix string, lim
 }
 
 // PutRule creates or updates a rule.
-func (s *MemoryStorage) PutRule(_ context.Context, req *PutRuleRequest) (*Rule, error) {
+func (s *MemoryStorage) PutRule(ctx context.Context, req *PutRuleRequest) (*Rule, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -310,7 +358,7 @@ func (s *MemoryStorage) PutRule(_ context.Context, req *PutRuleRequest) (*Rule,
 
 	rule := &Rule{
 		Name:               req.Name,
-		Arn:                fmt.Sprintf("arn:aws:events:%s:%s:rule/%s/%s", s.region, s.accountID, eventBusName, req.Name),
+		Arn:                fmt.Sprintf("arn:aws:events:%s:%s:rule/%s/%s", service.Region(ctx), service.AccountID(ctx), eventBusName, req.Name),
 		EventBusName:       eventBusName,
 		EventPattern:       req.EventPattern,
 		ScheduleExpression: req.ScheduleExpression,
@@ -535,7 +583,7 @@ func (s *MemoryStorage) ListTargetsByRule(_ context.Context, eventBusName, ruleN
 }
 
 // PutEvents puts events to the event bus, matches against rules, and records deliveries.
-func (s *MemoryStorage) PutEvents(_ context.Context, entries []PutEventsRequestEntry) ([]PutEventsResultEntry, error) {
+func (s *MemoryStorage) PutEvents(ctx context.Context, entries []PutEventsRequestEntry) ([]PutEventsResultEntry, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -550,24 +598,40 @@ func (s *MemoryStorage) PutEvents(_ context.Context, entries []PutEventsRequestE
 			eventBusName = defaultEventBusName
 		}
 
-		s.matchAndDeliver(eventID, eventBusName, &entry)
+		s.matchAndDeliver(ctx, eventID, eventBusName, &entry)
 	}
 
 	return results, nil
 }
 
-// matchAndDeliver matches an event against rules, records deliveries, and performs HTTP delivery for API destinations. Must be called under lock.
-func (s *MemoryStorage) matchAndDeliver(eventID, eventBusName string, entry *PutEventsRequestEntry) {
+// matchAndDeliver archives an event and delivers it to the matching rules of its event bus. Must be called under lock.
+func (s *MemoryStorage) matchAndDeliver(ctx context.Context, eventID, eventBusName string, entry *PutEventsRequestEntry) {
+	s.archiveEvent(eventID, eventBusName, entry)
+	s.deliverToRules(ctx, eventID, eventBusName, entry, nil)
+}
+
+// deliverToRules matches an event against rules, records deliveries, and performs HTTP delivery for API destinations.
+// A replayed event is only delivered to the rules the replay is filtered to. Must be called under lock.
+func (s *MemoryStorage) deliverToRules(ctx context.Context, eventID, eventBusName string, entry *PutEventsRequestEntry, replay *Replay) {
 	rules, exists := s.Rules[eventBusName]
 	if !exists {
 		return
 	}
 
+	var replayName string
+	if replay != nil {
+		replayName = replay.Name
+	}
+
 	for _, rule := range rules {
 		if rule.State != RuleStateEnabled {
 			continue
 		}
 
+		if replay != nil && !replay.Destination.includesRule(rule.Arn) {
+			continue
+		}
+
 		if !matchEventPattern(rule.EventPattern, entry) {
 			continue
 		}
@@ -591,9 +655,10 @@ func (s *MemoryStorage) matchAndDeliver(eventID, eventBusName string, entry *Put
 				TargetID:     target.ID,
 				TargetArn:    target.Arn,
 				Time:         eventTime,
+				ReplayName:   replayName,
 			})
 
-			payload := s.buildEventPayload(eventID, eventBusName, target, entry)
+			payload := s.buildEventPayload(ctx, eventID, eventBusName, target, entry, replayName)
 
 			// Deliver to API Destination via HTTP if the target ARN is an API destination.
 			if dest := s.resolveAPIDestination(target.Arn); dest != nil {
@@ -608,16 +673,16 @@ func (s *MemoryStorage) matchAndDeliver(eventID, eventBusName string, entry *Put
 	}
 }
 
-// buildEventPayload builds the CloudWatch Events envelope for delivery.
-func (s *MemoryStorage) buildEventPayload(eventID, eventBusName string, target *Target, entry *PutEventsRequestEntry) []byte {
+// buildEventPayload builds the CloudWatch Events envelope for delivery. Replayed events carry the name of their replay.
+func (s *MemoryStorage) buildEventPayload(ctx context.Context, eventID, eventBusName string, target *Target, entry *PutEventsRequestEntry, replayName string) []byte {
 	payload := map[string]any{
 		"version":     "0",
 		"id":          eventID,
 		"source":      entry.Source,
 		"detail-type": entry.DetailType,
 		"detail":      json.RawMessage(entry.Detail),
-		"region":      s.region,
-		"account":     s.accountID,
+		"region":      service.Region(ctx),
+		"account":     service.AccountID(ctx),
 		"time":        time.Now().Format(time.RFC3339),
 	}
 
@@ -625,6 +690,10 @@ func (s *MemoryStorage) buildEventPayload(eventID, eventBusName string, target *
 		payload["event-bus-name"] = eventBusName
 	}
 
+	if replayName != "" {
+		payload["replay-name"] = replayName
+	}
+
 	body, err := json.Marshal(payload)
 	if err != nil {
 		s.logger.Error("failed to marshal event payload", "error", err)
@@ -729,7 +798,7 @@ func (s *MemoryStorage) deliverToSQS(target *Target, payload []byte) {
 		return
 	}
 
-	// Extract queue name from ARN: arn:aws:sqs:region:account:queue-name
+	// Extract account and queue name from ARN: arn:aws:sqs:region:account:queue-name
 	parts := strings.Split(target.Arn, ":")
 	if len(parts) < 6 {
 		s.logger.Error("invalid SQS ARN", "arn", target.Arn)
@@ -738,7 +807,7 @@ func (s *MemoryStorage) deliverToSQS(target *Target, payload []byte) {
 	}
 
 	queueName := parts[len(parts)-1]
-	sqsEndpoint := fmt.Sprintf("%s/000000000000/%s", s.baseURL, queueName)
+	sqsEndpoint := fmt.Sprintf("%s/%s/%s", s.baseURL, parts[4], queueName)
 
 	reqBody := map[string]any{
 		"QueueUrl":    sqsEndpoint,
@@ -752,7 +821,10 @@ func (s *MemoryStorage) deliverToSQS(target *Target, payload []byte) {
 		return
 	}
 
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.baseURL+"/", bytes.NewReader(body))
+	// The queue is reached in the account and region of its ARN.
+	ctx := service.WithScope(context.Background(), parts[4], parts[3])
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+"/", bytes.NewReader(body))
 	if err != nil {
 		s.logger.Error("failed to create SQS request", "error", err, "queue", queueName)
 
@@ -761,6 +833,7 @@ func (s *MemoryStorage) deliverToSQS(target *Target, payload []byte) {
 
 	req.Header.Set("Content-Type", "application/x-amz-json-1.0")
 	req.Header.Set("X-Amz-Target", "AmazonSQS.SendMessage")
+	service.MarkInternalRequest(req)
 
 	client := &http.Client{Timeout: 5 * time.Second}
 
@@ -811,7 +884,7 @@ func (s *MemoryStorage) GetDeliveredEvents(_ context.Context) []DeliveredEvent {
 }
 
 // CreateConnection creates a new connection.
-func (s *MemoryStorage) CreateConnection(_ context.Context, req *CreateConnectionRequest) (*Connection, error) {
+func (s *MemoryStorage) CreateConnection(ctx context.Context, req *CreateConnectionRequest) (*Connection, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -822,7 +895,7 @@ func (s *MemoryStorage) CreateConnection(_ context.Context, req *CreateConnectio
 	now := time.Now()
 	conn := &Connection{
 		Name:               req.Name,
-		Arn:                fmt.Sprintf("arn:aws:events:%s:%s:connection/%s", s.region, s.accountID, req.Name),
+		Arn:                fmt.Sprintf("arn:aws:events:%s:%s:connection/%s", service.Region(ctx), service.AccountID(ctx), req.Name),
 		ConnectionState:    "AUTHORIZED",
 		AuthorizationType:  req.AuthorizationType,
 		AuthParameters:     req.AuthParameters,
@@ -865,7 +938,7 @@ func (s *MemoryStorage) DeleteConnection(_ context.Context, name string) (*Conne
 }
 
 // CreateAPIDestination creates a new API destination.
-func (s *MemoryStorage) CreateAPIDestination(_ context.Context, req *CreateAPIDestinationRequest) (*APIDestination, error) {
+func (s *MemoryStorage) CreateAPIDestination(ctx context.Context, req *CreateAPIDestinationRequest) (*APIDestination, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -881,7 +954,7 @@ func (s *MemoryStorage) CreateAPIDestination(_ context.Context, req *CreateAPIDe
 	now := time.Now()
 	dest := &APIDestination{
 		Name:                         req.Name,
-		Arn:                          fmt.Sprintf("arn:aws:events:%s:%s:api-destination/%s", s.region, s.accountID, req.Name),
+		Arn:                          fmt.Sprintf("arn:aws:events:%s:%s:api-destination/%s", service.Region(ctx), service.AccountID(ctx), req.Name),
 		ConnectionArn:                req.ConnectionArn,
 		InvocationEndpoint:           req.InvocationEndpoint,
 		HTTPMethod:                   req.HTTPMethod,
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"slices"
 	"time"
 )
 
@@ -340,6 +341,7 @@ type DeliveredEvent struct {
 	TargetID     string `json:"TargetId"`
 	TargetArn    string `json:"TargetArn"`
 	Time         string `json:"Time,omitempty"`
+	ReplayName   string `json:"ReplayName,omitempty"`
 }
 
 // Connection represents an EventBridge connection.
@@ -518,3 +520,196 @@ type ServiceError struct {
 func (e *ServiceError) Error() string {
 	return e.Message
 }
+
+// Archive states.
+const (
+	ArchiveStateEnabled = "ENABLED"
+)
+
+// Replay states.
+const (
+	ReplayStateCompleted = "COMPLETED"
+)
+
+// Archive represents an event archive.
+type Archive struct {
+	Name           string          `json:"name"`
+	Arn            string          `json:"arn"`
+	EventSourceArn string          `json:"eventSourceArn"`
+	Description    string          `json:"description,omitempty"`
+	EventPattern   string          `json:"eventPattern,omitempty"`
+	RetentionDays  int32           `json:"retentionDays,omitempty"`
+	State          string          `json:"state"`
+	CreationTime   time.Time       `json:"creationTime"`
+	Events         []ArchivedEvent `json:"events,omitempty"`
+}
+
+// ArchivedEvent represents an event captured by an archive.
+type ArchivedEvent struct {
+	EventID string                `json:"eventId"`
+	Time    time.Time             `json:"time"`
+	Entry   PutEventsRequestEntry `json:"entry"`
+}
+
+// SizeBytes returns the total size of the event details in the archive.
+func (a *Archive) SizeBytes() int64 {
+	var size int64
+
+	for _, event := range a.Events {
+		size += int64(len(event.Entry.Detail))
+	}
+
+	return size
+}
+
+// Replay represents a replay of archived events.
+type Replay struct {
+	Name                  string            `json:"name"`
+	Arn                   string            `json:"arn"`
+	Description           string            `json:"description,omitempty"`
+	EventSourceArn        string            `json:"eventSourceArn"`
+	Destination           ReplayDestination `json:"destination"`
+	EventStartTime        time.Time         `json:"eventStartTime"`
+	EventEndTime          time.Time         `json:"eventEndTime"`
+	EventLastReplayedTime time.Time         `json:"eventLastReplayedTime"`
+	State                 string            `json:"state"`
+	ReplayStartTime       time.Time         `json:"replayStartTime"`
+	ReplayEndTime         time.Time         `json:"replayEndTime"`
+}
+
+// ReplayDestination represents the event bus and rules a replay delivers events to.
+type ReplayDestination struct {
+	Arn        string   `json:"Arn"`
+	FilterArns []string `json:"FilterArns,omitempty"`
+}
+
+// includesRule reports whether the destination delivers to the rule, which it
+// does for every rule unless it is filtered to specific rules.
+func (d *ReplayDestination) includesRule(ruleArn string) bool {
+	return len(d.FilterArns) == 0 || slices.Contains(d.FilterArns, ruleArn)
+}
+
+// CreateArchiveRequest is the request for CreateArchive.
+type CreateArchiveRequest struct {
+	ArchiveName    string `json:"ArchiveName"`
+	EventSourceArn string `json:"EventSourceArn"`
+	Description    string `json:"Description,omitempty"`
+	EventPattern   string `json:"EventPattern,omitempty"`
+	RetentionDays  int32  `json:"RetentionDays,omitempty"`
+}
+
+// CreateArchiveResponse is the response for CreateArchive.
+type CreateArchiveResponse struct {
+	ArchiveArn   string  `json:"ArchiveArn"`
+	State        string  `json:"State"`
+	CreationTime float64 `json:"CreationTime"`
+}
+
+// DescribeArchiveRequest is the request for DescribeArchive.
+type DescribeArchiveRequest struct {
+	ArchiveName string `json:"ArchiveName"`
+}
+
+// DescribeArchiveResponse is the response for DescribeArchive.
+type DescribeArchiveResponse struct {
+	ArchiveName    string  `json:"ArchiveName"`
+	ArchiveArn     string  `json:"ArchiveArn"`
+	EventSourceArn string  `json:"EventSourceArn"`
+	Description    string  `json:"Description,omitempty"`
+	EventPattern   string  `json:"EventPattern,omitempty"`
+	State          string  `json:"State"`
+	RetentionDays  int32   `json:"RetentionDays"`
+	SizeBytes      int64   `json:"SizeBytes"`
+	EventCount     int64   `json:"EventCount"`
+	CreationTime   float64 `json:"CreationTime"`
+}
+
+// ListArchivesRequest is the request for ListArchives.
+type ListArchivesRequest struct {
+	NamePrefix     string `json:"NamePrefix,omitempty"`
+	EventSourceArn string `json:"EventSourceArn,omitempty"`
+	State          string `json:"State,omitempty"`
+	Limit          int32  `json:"Limit,omitempty"`
+	NextToken      string `json:"NextToken,omitempty"`
+}
+
+// ArchiveOutput represents an archive in API responses.
+type ArchiveOutput struct {
+	ArchiveName    string  `json:"ArchiveName"`
+	EventSourceArn string  `json:"EventSourceArn"`
+	State          string  `json:"State"`
+	RetentionDays  int32   `json:"RetentionDays"`
+	SizeBytes      int64   `json:"SizeBytes"`
+	EventCount     int64   `json:"EventCount"`
+	CreationTime   float64 `json:"CreationTime"`
+}
+
+// ListArchivesResponse is the response for ListArchives.
+type ListArchivesResponse struct {
+	Archives  []ArchiveOutput `json:"Archives"`
+	NextToken string          `json:"NextToken,omitempty"`
+}
+
+// StartReplayRequest is the request for StartReplay.
+type StartReplayRequest struct {
+	ReplayName     string            `json:"ReplayName"`
+	Description    string            `json:"Description,omitempty"`
+	EventSourceArn string            `json:"EventSourceArn"`
+	EventStartTime EpochTime         `json:"EventStartTime"`
+	EventEndTime   EpochTime         `json:"EventEndTime"`
+	Destination    ReplayDestination `json:"Destination"`
+}
+
+// StartReplayResponse is the response for StartReplay.
+type StartReplayResponse struct {
+	ReplayArn       string  `json:"ReplayArn"`
+	State           string  `json:"State"`
+	ReplayStartTime float64 `json:"ReplayStartTime"`
+}
+
+// DescribeReplayRequest is the request for DescribeReplay.
+type DescribeReplayRequest struct {
+	ReplayName string `json:"ReplayName"`
+}
+
+// DescribeReplayResponse is the response for DescribeReplay.
+type DescribeReplayResponse struct {
+	ReplayName            string             `json:"ReplayName"`
+	ReplayArn             string             `json:"ReplayArn"`
+	Description           string             `json:"Description,omitempty"`
+	State                 string             `json:"State"`
+	EventSourceArn        string             `json:"EventSourceArn"`
+	Destination           *ReplayDestination `json:"Destination"`
+	EventStartTime        float64            `json:"EventStartTime"`
+	EventEndTime          float64            `json:"EventEndTime"`
+	EventLastReplayedTime float64            `json:"EventLastReplayedTime,omitempty"`
+	ReplayStartTime       float64            `json:"ReplayStartTime"`
+	ReplayEndTime         float64            `json:"ReplayEndTime,omitempty"`
+}
+
+// ListReplaysRequest is the request for ListReplays.
+type ListReplaysRequest struct {
+	NamePrefix     string `json:"NamePrefix,omitempty"`
+	EventSourceArn string `json:"EventSourceArn,omitempty"`
+	State          string `json:"State,omitempty"`
+	Limit          int32  `json:"Limit,omitempty"`
+	NextToken      string `json:"NextToken,omitempty"`
+}
+
+// ReplayOutput represents a replay in API responses.
+type ReplayOutput struct {
+	ReplayName            string  `json:"ReplayName"`
+	EventSourceArn        string  `json:"EventSourceArn"`
+	State                 string  `json:"State"`
+	EventStartTime        float64 `json:"EventStartTime"`
+	EventEndTime          float64 `json:"EventEndTime"`
+	EventLastReplayedTime float64 `json:"EventLastReplayedTime,omitempty"`
+	ReplayStartTime       float64 `json:"ReplayStartTime"`
+	ReplayEndTime         float64 `json:"ReplayEndTime,omitempty"`
+}
+
+// ListReplaysResponse is the response for ListReplays.
+type ListReplaysResponse struct {
+	Replays   []ReplayOutput `json:"Replays"`
+	NextToken string         `json:"NextToken,omitempty"`
+}
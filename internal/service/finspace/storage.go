@@ -48,6 +48,7 @@ type Storage interface {
 	TagResource(ctx context.Context, resourceARN string, tags map[string]string) error
 	UntagResource(ctx context.Context, resourceARN string, tagKeys []string) error
 	ListTagsForResource(ctx context.Context, resourceARN string) (map[string]string, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -159,6 +160,19 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Environments = make(map[string]*KxEnvironment)
+	s.Databases = make(map[string]*KxDatabase)
+	s.Users = make(map[string]*KxUser)
+	s.Tags = make(map[string]map[string]string)
+
+	return nil
+}
+
 // CreateKxEnvironment creates a new kdb environment.
 func (s *MemoryStorage) CreateKxEnvironment(_ context.Context, req *CreateKxEnvironmentRequest) (*CreateKxEnvironmentResponse, error) {
 	s.mu.Lock()
@@ -23,6 +23,7 @@ type Storage interface {
 	PutRecord(ctx context.Context, streamName string, record Record) (string, error)
 	PutRecordBatch(ctx context.Context, streamName string, records []Record) ([]PutRecordBatchResponseEntry, int32, error)
 	UpdateDestination(ctx context.Context, input *UpdateDestinationInput) error
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -125,6 +126,16 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Streams = make(map[string]*StreamData)
+
+	return nil
+}
+
 // CreateDeliveryStream creates a new delivery stream.
 func (s *MemoryStorage) CreateDeliveryStream(_ context.Context, input *CreateDeliveryStreamInput) (*DeliveryStream, error) {
 	s.mu.Lock()
@@ -39,6 +39,7 @@ type Storage interface {
 	DescribeForecast(ctx context.Context, forecastArn string) (*Forecast, error)
 	ListForecasts(ctx context.Context, maxResults *int32, nextToken string, filters []Filter) ([]*ForecastSummary, string, error)
 	DeleteForecast(ctx context.Context, forecastArn string) error
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -150,6 +151,19 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Datasets = make(map[string]*Dataset)
+	m.DatasetGroups = make(map[string]*DatasetGroup)
+	m.Predictors = make(map[string]*Predictor)
+	m.Forecasts = make(map[string]*Forecast)
+
+	return nil
+}
+
 // Dataset operations.
 
 // CreateDataset creates a new dataset.
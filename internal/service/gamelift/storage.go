@@ -76,6 +76,7 @@ type Storage interface {
 	CreatePlayerSession(ctx context.Context, gameSessionID, playerID string) (*PlayerSession, error)
 	CreatePlayerSessions(ctx context.Context, gameSessionID string, playerIDs []string) ([]*PlayerSession, error)
 	DescribePlayerSessions(ctx context.Context, gameSessionID, playerSessionID, playerID string) ([]*PlayerSession, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -187,6 +188,19 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Builds = make(map[string]*Build)
+	m.Fleets = make(map[string]*Fleet)
+	m.GameSessions = make(map[string]*GameSession)
+	m.PlayerSessions = make(map[string]*PlayerSession)
+
+	return nil
+}
+
 // CreateBuild creates a new build.
 func (m *MemoryStorage) CreateBuild(_ context.Context, req *CreateBuildRequest) (*Build, error) {
 	m.mu.Lock()
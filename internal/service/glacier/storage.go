@@ -33,6 +33,7 @@ type Storage interface {
 	DescribeVault(ctx context.Context, vaultName string) (*Vault, error)
 	DeleteVault(ctx context.Context, vaultName string) error
 	ListVaults(ctx context.Context) ([]Vault, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -122,6 +123,16 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Vaults = make(map[string]*Vault)
+
+	return nil
+}
+
 // CreateVault creates a new vault.
 func (m *MemoryStorage) CreateVault(_ context.Context, vaultName string) (*Vault, error) {
 	m.mu.Lock()
@@ -45,6 +45,7 @@ type Storage interface {
 	ListEndpointGroups(ctx context.Context, listenerArn string, maxResults int32, nextToken string) ([]*EndpointGroup, string, error)
 	UpdateEndpointGroup(ctx context.Context, req *UpdateEndpointGroupRequest) (*EndpointGroup, error)
 	DeleteEndpointGroup(ctx context.Context, arn string) error
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -146,6 +147,18 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Accelerators = make(map[string]*Accelerator)
+	s.Listeners = make(map[string]*Listener)
+	s.EndpointGroups = make(map[string]*EndpointGroup)
+
+	return nil
+}
+
 // CreateAccelerator creates a new accelerator.
 func (s *MemoryStorage) CreateAccelerator(_ context.Context, req *CreateAcceleratorRequest) (*Accelerator, error) {
 	s.mu.Lock()
@@ -0,0 +1,574 @@
+package glue
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// compileNamePattern compiles a GetTables name expression.
+// Glue accepts both regular expressions ("sales_.*") and Hive-style patterns
+// ("sales_*", "orders|customers"), so a bare "*" is treated as ".*".
+// The pattern must match the whole name and is case-insensitive.
+// An empty expression returns a nil pattern, which matches everything.
+func compileNamePattern(expression string) (*regexp.Regexp, error) {
+	if expression == "" {
+		return nil, nil //nolint:nilnil // nil pattern means no filtering
+	}
+
+	var b strings.Builder
+
+	for i, r := range expression {
+		if r == '*' && (i == 0 || expression[i-1] != '.') {
+			b.WriteString(".*")
+
+			continue
+		}
+
+		b.WriteRune(r)
+	}
+
+	pattern, err := regexp.Compile("(?i)^(?:" + b.String() + ")$")
+	if err != nil {
+		return nil, &Error{
+			Code:    errInvalidInput,
+			Message: "Invalid expression: " + expression,
+		}
+	}
+
+	return pattern, nil
+}
+
+// partitionPredicate is a compiled GetPartitions expression evaluated against partition values.
+type partitionPredicate interface {
+	eval(values []string) bool
+}
+
+type andPredicate struct {
+	left, right partitionPredicate
+}
+
+func (p andPredicate) eval(values []string) bool {
+	return p.left.eval(values) && p.right.eval(values)
+}
+
+type orPredicate struct {
+	left, right partitionPredicate
+}
+
+func (p orPredicate) eval(values []string) bool {
+	return p.left.eval(values) || p.right.eval(values)
+}
+
+type notPredicate struct {
+	inner partitionPredicate
+}
+
+func (p notPredicate) eval(values []string) bool {
+	return !p.inner.eval(values)
+}
+
+// partitionColumn identifies a partition key by its position in the partition values.
+type partitionColumn struct {
+	index   int
+	numeric bool
+}
+
+// value returns the column value. ok is false if the partition has no value for
+// the column, which happens when partition keys are added to the table after the
+// partition was created; such a partition matches no predicate on the column.
+func (c partitionColumn) value(values []string) (value string, ok bool) {
+	if c.index >= len(values) {
+		return "", false
+	}
+
+	return values[c.index], true
+}
+
+// compare compares the column value with a literal, numerically for numeric key types.
+// ok is false if the partition has no value for the column.
+func (c partitionColumn) compare(values []string, literal string) (result int, ok bool) {
+	value, ok := c.value(values)
+	if !ok {
+		return 0, false
+	}
+
+	if c.numeric {
+		a, errA := strconv.ParseFloat(value, 64)
+		b, errB := strconv.ParseFloat(literal, 64)
+
+		if errA == nil && errB == nil {
+			return cmp.Compare(a, b), true
+		}
+	}
+
+	return strings.Compare(value, literal), true
+}
+
+type comparePredicate struct {
+	column  partitionColumn
+	op      string
+	literal string
+}
+
+func (p comparePredicate) eval(values []string) bool {
+	c, ok := p.column.compare(values, p.literal)
+	if !ok {
+		return false
+	}
+
+	switch p.op {
+	case "=":
+		return c == 0
+	case "!=", "<>":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	default: // ">="
+		return c >= 0
+	}
+}
+
+type inPredicate struct {
+	column   partitionColumn
+	literals []string
+}
+
+func (p inPredicate) eval(values []string) bool {
+	return slices.ContainsFunc(p.literals, func(literal string) bool {
+		c, ok := p.column.compare(values, literal)
+
+		return ok && c == 0
+	})
+}
+
+type likePredicate struct {
+	column  partitionColumn
+	pattern *regexp.Regexp
+}
+
+func (p likePredicate) eval(values []string) bool {
+	value, ok := p.column.value(values)
+
+	return ok && p.pattern.MatchString(value)
+}
+
+// constPredicate is used for IS NULL checks; partition values are never null.
+type constPredicate bool
+
+func (p constPredicate) eval([]string) bool {
+	return bool(p)
+}
+
+// numericColumnTypes lists the Hive column types compared numerically.
+var numericColumnTypes = []string{"tinyint", "smallint", "int", "integer", "bigint", "float", "double", "decimal"}
+
+// parsePartitionExpression compiles a GetPartitions expression such as
+// "year = '2024' AND month BETWEEN '01' AND '06'" against the table partition keys.
+// Supported operators are =, !=, <>, <, <=, >, >=, [NOT] BETWEEN, [NOT] IN, [NOT] LIKE,
+// IS [NOT] NULL, AND, OR, NOT and parentheses.
+// An empty expression returns a nil predicate, which matches every partition.
+func parsePartitionExpression(expression string, keys []Column) (partitionPredicate, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, nil //nolint:nilnil // nil predicate means no filtering
+	}
+
+	tokens, err := tokenizeExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &expressionParser{tokens: tokens, keys: keys}
+
+	predicate, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, invalidExpressionError("unexpected %q", tok.text)
+	}
+
+	return predicate, nil
+}
+
+func invalidExpressionError(format string, args ...any) error {
+	return &Error{
+		Code:    errInvalidInput,
+		Message: "Invalid expression: " + fmt.Sprintf(format, args...),
+	}
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenSymbol
+)
+
+type expressionToken struct {
+	kind tokenKind
+	text string
+}
+
+// tokenizeExpression splits an expression into identifiers, literals and operators.
+func tokenizeExpression(expression string) ([]expressionToken, error) {
+	var tokens []expressionToken
+
+	for i := 0; i < len(expression); {
+		c := expression[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'':
+			var b strings.Builder
+
+			i++
+
+			for {
+				if i >= len(expression) {
+					return nil, invalidExpressionError("unterminated string literal")
+				}
+
+				if expression[i] == '\'' {
+					// A doubled quote is an escaped quote.
+					if i+1 < len(expression) && expression[i+1] == '\'' {
+						b.WriteByte('\'')
+
+						i += 2
+
+						continue
+					}
+
+					i++
+
+					break
+				}
+
+				b.WriteByte(expression[i])
+				i++
+			}
+
+			tokens = append(tokens, expressionToken{kind: tokenString, text: b.String()})
+		case c == '`':
+			end := strings.IndexByte(expression[i+1:], '`')
+			if end < 0 {
+				return nil, invalidExpressionError("unterminated quoted identifier")
+			}
+
+			tokens = append(tokens, expressionToken{kind: tokenIdent, text: expression[i+1 : i+1+end]})
+			i += end + 2
+		case isDigit(c) || (c == '-' && i+1 < len(expression) && isDigit(expression[i+1])):
+			start := i
+
+			i++
+
+			for i < len(expression) && (isDigit(expression[i]) || expression[i] == '.') {
+				i++
+			}
+
+			tokens = append(tokens, expressionToken{kind: tokenNumber, text: expression[start:i]})
+		case isIdentChar(c):
+			start := i
+
+			for i < len(expression) && isIdentChar(expression[i]) {
+				i++
+			}
+
+			tokens = append(tokens, expressionToken{kind: tokenIdent, text: expression[start:i]})
+		default:
+			op := string(c)
+			if i+1 < len(expression) && slices.Contains([]string{"<=", ">=", "<>", "!="}, expression[i:i+2]) {
+				op = expression[i : i+2]
+			}
+
+			if !slices.Contains([]string{"=", "<", ">", "<=", ">=", "<>", "!=", "(", ")", ","}, op) {
+				return nil, invalidExpressionError("unexpected character %q", c)
+			}
+
+			tokens = append(tokens, expressionToken{kind: tokenSymbol, text: op})
+			i += len(op)
+		}
+	}
+
+	return append(tokens, expressionToken{kind: tokenEOF}), nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// expressionParser is a recursive descent parser over expression tokens.
+type expressionParser struct {
+	tokens []expressionToken
+	pos    int
+	keys   []Column
+}
+
+func (p *expressionParser) peek() expressionToken {
+	return p.tokens[p.pos]
+}
+
+func (p *expressionParser) next() expressionToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+
+	return tok
+}
+
+// acceptKeyword consumes the next token if it is the given keyword.
+func (p *expressionParser) acceptKeyword(keyword string) bool {
+	if tok := p.peek(); tok.kind == tokenIdent && strings.EqualFold(tok.text, keyword) {
+		p.pos++
+
+		return true
+	}
+
+	return false
+}
+
+// acceptSymbol consumes the next token if it is the given symbol.
+func (p *expressionParser) acceptSymbol(symbol string) bool {
+	if tok := p.peek(); tok.kind == tokenSymbol && tok.text == symbol {
+		p.pos++
+
+		return true
+	}
+
+	return false
+}
+
+func (p *expressionParser) parseOr() (partitionPredicate, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.acceptKeyword("OR") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = orPredicate{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *expressionParser) parseAnd() (partitionPredicate, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.acceptKeyword("AND") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		left = andPredicate{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *expressionParser) parseNot() (partitionPredicate, error) {
+	if p.acceptKeyword("NOT") {
+		inner, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		return notPredicate{inner: inner}, nil
+	}
+
+	if p.acceptSymbol("(") {
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.acceptSymbol(")") {
+			return nil, invalidExpressionError("missing closing parenthesis")
+		}
+
+		return inner, nil
+	}
+
+	return p.parseCondition()
+}
+
+// parseCondition parses a single condition on a partition key.
+func (p *expressionParser) parseCondition() (partitionPredicate, error) {
+	column, err := p.parseColumn()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.kind == tokenSymbol {
+		if !slices.Contains([]string{"=", "!=", "<>", "<", "<=", ">", ">="}, tok.text) {
+			return nil, invalidExpressionError("unexpected %q", tok.text)
+		}
+
+		p.pos++
+
+		literal, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+
+		return comparePredicate{column: column, op: tok.text, literal: literal}, nil
+	}
+
+	if p.acceptKeyword("IS") {
+		negate := p.acceptKeyword("NOT")
+
+		if !p.acceptKeyword("NULL") {
+			return nil, invalidExpressionError("expected NULL after IS")
+		}
+
+		return constPredicate(negate), nil
+	}
+
+	negate := p.acceptKeyword("NOT")
+
+	var predicate partitionPredicate
+
+	switch {
+	case p.acceptKeyword("BETWEEN"):
+		predicate, err = p.parseBetween(column)
+	case p.acceptKeyword("IN"):
+		predicate, err = p.parseIn(column)
+	case p.acceptKeyword("LIKE"):
+		predicate, err = p.parseLike(column)
+	default:
+		return nil, invalidExpressionError("unexpected %q", p.peek().text)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if negate {
+		return notPredicate{inner: predicate}, nil
+	}
+
+	return predicate, nil
+}
+
+func (p *expressionParser) parseBetween(column partitionColumn) (partitionPredicate, error) {
+	low, err := p.parseLiteral()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.acceptKeyword("AND") {
+		return nil, invalidExpressionError("expected AND in BETWEEN")
+	}
+
+	high, err := p.parseLiteral()
+	if err != nil {
+		return nil, err
+	}
+
+	return andPredicate{
+		left:  comparePredicate{column: column, op: ">=", literal: low},
+		right: comparePredicate{column: column, op: "<=", literal: high},
+	}, nil
+}
+
+func (p *expressionParser) parseIn(column partitionColumn) (partitionPredicate, error) {
+	if !p.acceptSymbol("(") {
+		return nil, invalidExpressionError("expected ( after IN")
+	}
+
+	var literals []string
+
+	for {
+		literal, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+
+		literals = append(literals, literal)
+
+		if p.acceptSymbol(")") {
+			return inPredicate{column: column, literals: literals}, nil
+		}
+
+		if !p.acceptSymbol(",") {
+			return nil, invalidExpressionError("expected , or ) in IN list")
+		}
+	}
+}
+
+func (p *expressionParser) parseLike(column partitionColumn) (partitionPredicate, error) {
+	literal, err := p.parseLiteral()
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+
+	for _, r := range literal {
+		switch r {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	return likePredicate{column: column, pattern: regexp.MustCompile("^" + b.String() + "$")}, nil
+}
+
+// parseColumn resolves an identifier to a partition key.
+func (p *expressionParser) parseColumn() (partitionColumn, error) {
+	tok := p.next()
+	if tok.kind != tokenIdent {
+		return partitionColumn{}, invalidExpressionError("expected partition key, got %q", tok.text)
+	}
+
+	for i, key := range p.keys {
+		if strings.EqualFold(key.Name, tok.text) {
+			typ := strings.ToLower(key.Type)
+			if idx := strings.IndexByte(typ, '('); idx >= 0 {
+				typ = typ[:idx]
+			}
+
+			return partitionColumn{index: i, numeric: slices.Contains(numericColumnTypes, typ)}, nil
+		}
+	}
+
+	return partitionColumn{}, invalidExpressionError("unknown partition key %q", tok.text)
+}
+
+func (p *expressionParser) parseLiteral() (string, error) {
+	tok := p.next()
+	if tok.kind != tokenString && tok.kind != tokenNumber {
+		return "", invalidExpressionError("expected literal, got %q", tok.text)
+	}
+
+	return tok.text, nil
+}
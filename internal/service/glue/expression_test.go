@@ -0,0 +1,99 @@
+package glue
+
+import "testing"
+
+func TestCompileNamePattern(t *testing.T) {
+	tests := []struct {
+		expression string
+		name       string
+		want       bool
+	}{
+		{expression: "", name: "orders", want: true},
+		{expression: "orders", name: "orders", want: true},
+		{expression: "orders", name: "orders_2024", want: false},
+		{expression: "orders*", name: "orders_2024", want: true},
+		{expression: "orders.*", name: "orders_2024", want: true},
+		{expression: "*_2024", name: "orders_2024", want: true},
+		{expression: "orders|customers", name: "customers", want: true},
+		{expression: "ORDERS", name: "orders", want: true},
+		{expression: "sales*", name: "orders", want: false},
+	}
+
+	for _, tt := range tests {
+		pattern, err := compileNamePattern(tt.expression)
+		if err != nil {
+			t.Fatalf("compileNamePattern(%q) returned error: %v", tt.expression, err)
+		}
+
+		got := pattern == nil || pattern.MatchString(tt.name)
+		if got != tt.want {
+			t.Errorf("compileNamePattern(%q) match %q = %v, want %v", tt.expression, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCompileNamePattern_Invalid(t *testing.T) {
+	if _, err := compileNamePattern("orders("); err == nil {
+		t.Fatal("expected error for invalid expression")
+	}
+}
+
+func TestParsePartitionExpression(t *testing.T) {
+	keys := []Column{
+		{Name: "year", Type: "int"},
+		{Name: "month", Type: "string"},
+		{Name: "region", Type: "string"},
+	}
+
+	tests := []struct {
+		expression string
+		values     []string
+		want       bool
+	}{
+		{expression: "", values: []string{"2024", "01", "us"}, want: true},
+		{expression: "year = 2024", values: []string{"2024", "01", "us"}, want: true},
+		{expression: "year = '2024'", values: []string{"2023", "01", "us"}, want: false},
+		{expression: "year > 999", values: []string{"2024", "01", "us"}, want: true},
+		{expression: "month > '9'", values: []string{"2024", "10", "us"}, want: false},
+		{expression: "year = 2024 AND month >= '06'", values: []string{"2024", "07", "us"}, want: true},
+		{expression: "year = 2024 AND month >= '06'", values: []string{"2024", "05", "us"}, want: false},
+		{expression: "region = 'eu' OR region = 'us'", values: []string{"2024", "01", "us"}, want: true},
+		{expression: "NOT (region = 'us')", values: []string{"2024", "01", "us"}, want: false},
+		{expression: "month BETWEEN '03' AND '05'", values: []string{"2024", "04", "us"}, want: true},
+		{expression: "month NOT BETWEEN '03' AND '05'", values: []string{"2024", "04", "us"}, want: false},
+		{expression: "region IN ('eu', 'ap')", values: []string{"2024", "01", "ap"}, want: true},
+		{expression: "region NOT IN ('eu', 'ap')", values: []string{"2024", "01", "ap"}, want: false},
+		{expression: "region LIKE 'u%'", values: []string{"2024", "01", "us"}, want: true},
+		{expression: "region <> 'us'", values: []string{"2024", "01", "us"}, want: false},
+		{expression: "REGION is not null", values: []string{"2024", "01", "us"}, want: true},
+	}
+
+	for _, tt := range tests {
+		predicate, err := parsePartitionExpression(tt.expression, keys)
+		if err != nil {
+			t.Fatalf("parsePartitionExpression(%q) returned error: %v", tt.expression, err)
+		}
+
+		got := predicate == nil || predicate.eval(tt.values)
+		if got != tt.want {
+			t.Errorf("parsePartitionExpression(%q) eval %v = %v, want %v", tt.expression, tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestParsePartitionExpression_Invalid(t *testing.T) {
+	keys := []Column{{Name: "year", Type: "int"}}
+
+	for _, expression := range []string{
+		"unknown = 1",
+		"year =",
+		"year = 'unterminated",
+		"(year = 1",
+		"year IN (1, 2",
+		"year = 1 year = 2",
+	} {
+		if _, err := parsePartitionExpression(expression, keys); err == nil {
+			t.Errorf("parsePartitionExpression(%q) expected error", expression)
+		}
+	}
+}
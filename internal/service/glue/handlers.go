@@ -45,14 +45,34 @@ func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
 		s.GetTable(w, r)
 	case "GetTables":
 		s.GetTables(w, r)
+	case "UpdateTable":
+		s.UpdateTable(w, r)
 	case "DeleteTable":
 		s.DeleteTable(w, r)
+	case "CreatePartition":
+		s.CreatePartition(w, r)
+	case "BatchCreatePartition":
+		s.BatchCreatePartition(w, r)
+	case "GetPartition":
+		s.GetPartition(w, r)
+	case "GetPartitions":
+		s.GetPartitions(w, r)
+	case "DeletePartition":
+		s.DeletePartition(w, r)
 	case "CreateJob":
 		s.CreateJob(w, r)
 	case "DeleteJob":
 		s.DeleteJob(w, r)
+	case "GetJob":
+		s.GetJob(w, r)
 	case "StartJobRun":
 		s.StartJobRun(w, r)
+	case "GetJobRun":
+		s.GetJobRun(w, r)
+	case "GetJobRuns":
+		s.GetJobRuns(w, r)
+	case "BatchStopJobRun":
+		s.BatchStopJobRun(w, r)
 	default:
 		writeError(w, errInvalidInput, fmt.Sprintf("Unknown operation: %s", operation), http.StatusBadRequest)
 	}
@@ -105,15 +125,7 @@ func (s *Service) GetDatabase(w http.ResponseWriter, r *http.Request) {
 	}
 
 	writeJSONResponse(w, GetDatabaseOutput{
-		Database: &DatabaseResponse{
-			Name:            db.Name,
-			Description:     db.Description,
-			LocationURI:     db.LocationURI,
-			Parameters:      db.Parameters,
-			CreateTime:      ToAWSTimestamp(db.CreateTime).Ptr(),
-			CatalogID:       db.CatalogID,
-			CreateTableMode: db.CreateTableMode,
-		},
+		Database: databaseToResponse(db),
 	})
 }
 
@@ -136,15 +148,7 @@ func (s *Service) GetDatabases(w http.ResponseWriter, r *http.Request) {
 	dbResponses := make([]*DatabaseResponse, 0, len(databases))
 
 	for _, db := range databases {
-		dbResponses = append(dbResponses, &DatabaseResponse{
-			Name:            db.Name,
-			Description:     db.Description,
-			LocationURI:     db.LocationURI,
-			Parameters:      db.Parameters,
-			CreateTime:      ToAWSTimestamp(db.CreateTime).Ptr(),
-			CatalogID:       db.CatalogID,
-			CreateTableMode: db.CreateTableMode,
-		})
+		dbResponses = append(dbResponses, databaseToResponse(db))
 	}
 
 	writeJSONResponse(w, GetDatabasesOutput{
@@ -236,24 +240,7 @@ func (s *Service) GetTable(w http.ResponseWriter, r *http.Request) {
 	}
 
 	writeJSONResponse(w, GetTableOutput{
-		Table: &TableResponse{
-			Name:              table.Name,
-			DatabaseName:      table.DatabaseName,
-			Description:       table.Description,
-			Owner:             table.Owner,
-			CreateTime:        ToAWSTimestamp(table.CreateTime).Ptr(),
-			UpdateTime:        ToAWSTimestamp(table.UpdateTime).Ptr(),
-			LastAccessTime:    ToAWSTimestampPtr(table.LastAccessTime),
-			LastAnalyzedTime:  ToAWSTimestampPtr(table.LastAnalyzedTime),
-			Retention:         table.Retention,
-			StorageDescriptor: table.StorageDescriptor,
-			PartitionKeys:     table.PartitionKeys,
-			ViewOriginalText:  table.ViewOriginalText,
-			ViewExpandedText:  table.ViewExpandedText,
-			TableType:         table.TableType,
-			Parameters:        table.Parameters,
-			CatalogID:         table.CatalogID,
-		},
+		Table: tableToResponse(table),
 	})
 }
 
@@ -272,7 +259,7 @@ func (s *Service) GetTables(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tables, nextToken, err := s.storage.GetTables(r.Context(), req.CatalogID, req.DatabaseName, req.MaxResults, req.NextToken)
+	tables, nextToken, err := s.storage.GetTables(r.Context(), req.CatalogID, req.DatabaseName, req.Expression, req.MaxResults, req.NextToken)
 	if err != nil {
 		handleStorageError(w, err)
 
@@ -282,24 +269,7 @@ func (s *Service) GetTables(w http.ResponseWriter, r *http.Request) {
 	tableResponses := make([]*TableResponse, 0, len(tables))
 
 	for _, table := range tables {
-		tableResponses = append(tableResponses, &TableResponse{
-			Name:              table.Name,
-			DatabaseName:      table.DatabaseName,
-			Description:       table.Description,
-			Owner:             table.Owner,
-			CreateTime:        ToAWSTimestamp(table.CreateTime).Ptr(),
-			UpdateTime:        ToAWSTimestamp(table.UpdateTime).Ptr(),
-			LastAccessTime:    ToAWSTimestampPtr(table.LastAccessTime),
-			LastAnalyzedTime:  ToAWSTimestampPtr(table.LastAnalyzedTime),
-			Retention:         table.Retention,
-			StorageDescriptor: table.StorageDescriptor,
-			PartitionKeys:     table.PartitionKeys,
-			ViewOriginalText:  table.ViewOriginalText,
-			ViewExpandedText:  table.ViewExpandedText,
-			TableType:         table.TableType,
-			Parameters:        table.Parameters,
-			CatalogID:         table.CatalogID,
-		})
+		tableResponses = append(tableResponses, tableToResponse(table))
 	}
 
 	writeJSONResponse(w, GetTablesOutput{
@@ -308,6 +278,36 @@ func (s *Service) GetTables(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// UpdateTable handles the UpdateTable operation.
+func (s *Service) UpdateTable(w http.ResponseWriter, r *http.Request) {
+	var req UpdateTableInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidInput, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DatabaseName == "" {
+		writeError(w, errInvalidInput, "DatabaseName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.TableInput == nil {
+		writeError(w, errInvalidInput, "TableInput is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.UpdateTable(r.Context(), req.CatalogID, req.DatabaseName, req.TableInput); err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, struct{}{})
+}
+
 // DeleteTable handles the DeleteTable operation.
 func (s *Service) DeleteTable(w http.ResponseWriter, r *http.Request) {
 	var req DeleteTableInput
@@ -338,6 +338,148 @@ func (s *Service) DeleteTable(w http.ResponseWriter, r *http.Request) {
 	writeJSONResponse(w, struct{}{})
 }
 
+// CreatePartition handles the CreatePartition operation.
+func (s *Service) CreatePartition(w http.ResponseWriter, r *http.Request) {
+	var req CreatePartitionInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidInput, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DatabaseName == "" || req.TableName == "" {
+		writeError(w, errInvalidInput, "DatabaseName and TableName are required", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.PartitionInput == nil {
+		writeError(w, errInvalidInput, "PartitionInput is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.CreatePartition(r.Context(), req.CatalogID, req.DatabaseName, req.TableName, req.PartitionInput); err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, struct{}{})
+}
+
+// BatchCreatePartition handles the BatchCreatePartition operation.
+func (s *Service) BatchCreatePartition(w http.ResponseWriter, r *http.Request) {
+	var req BatchCreatePartitionInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidInput, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DatabaseName == "" || req.TableName == "" {
+		writeError(w, errInvalidInput, "DatabaseName and TableName are required", http.StatusBadRequest)
+
+		return
+	}
+
+	errs, err := s.storage.BatchCreatePartition(r.Context(), req.CatalogID, req.DatabaseName, req.TableName, req.PartitionInputList)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, BatchCreatePartitionOutput{
+		Errors: errs,
+	})
+}
+
+// GetPartition handles the GetPartition operation.
+func (s *Service) GetPartition(w http.ResponseWriter, r *http.Request) {
+	var req GetPartitionInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidInput, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DatabaseName == "" || req.TableName == "" {
+		writeError(w, errInvalidInput, "DatabaseName and TableName are required", http.StatusBadRequest)
+
+		return
+	}
+
+	partition, err := s.storage.GetPartition(r.Context(), req.CatalogID, req.DatabaseName, req.TableName, req.PartitionValues)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, GetPartitionOutput{
+		Partition: partitionToResponse(partition),
+	})
+}
+
+// GetPartitions handles the GetPartitions operation.
+func (s *Service) GetPartitions(w http.ResponseWriter, r *http.Request) {
+	var req GetPartitionsInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidInput, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DatabaseName == "" || req.TableName == "" {
+		writeError(w, errInvalidInput, "DatabaseName and TableName are required", http.StatusBadRequest)
+
+		return
+	}
+
+	partitions, nextToken, err := s.storage.GetPartitions(r.Context(), req.CatalogID, req.DatabaseName, req.TableName, req.Expression, req.MaxResults, req.NextToken)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	partitionResponses := make([]*PartitionResponse, 0, len(partitions))
+
+	for _, partition := range partitions {
+		partitionResponses = append(partitionResponses, partitionToResponse(partition))
+	}
+
+	writeJSONResponse(w, GetPartitionsOutput{
+		Partitions: partitionResponses,
+		NextToken:  nextToken,
+	})
+}
+
+// DeletePartition handles the DeletePartition operation.
+func (s *Service) DeletePartition(w http.ResponseWriter, r *http.Request) {
+	var req DeletePartitionInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidInput, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DatabaseName == "" || req.TableName == "" {
+		writeError(w, errInvalidInput, "DatabaseName and TableName are required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.DeletePartition(r.Context(), req.CatalogID, req.DatabaseName, req.TableName, req.PartitionValues); err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, struct{}{})
+}
+
 // CreateJob handles the CreateJob operation.
 func (s *Service) CreateJob(w http.ResponseWriter, r *http.Request) {
 	var req CreateJobInput
@@ -383,6 +525,33 @@ func (s *Service) DeleteJob(w http.ResponseWriter, r *http.Request) {
 	writeJSONResponse(w, DeleteJobOutput(req))
 }
 
+// GetJob handles the GetJob operation.
+func (s *Service) GetJob(w http.ResponseWriter, r *http.Request) {
+	var req GetJobInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidInput, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.JobName == "" {
+		writeError(w, errInvalidInput, "JobName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	job, err := s.storage.GetJob(r.Context(), req.JobName)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, GetJobOutput{
+		Job: jobToResponse(job),
+	})
+}
+
 // StartJobRun handles the StartJobRun operation.
 func (s *Service) StartJobRun(w http.ResponseWriter, r *http.Request) {
 	var req StartJobRunInput
@@ -410,8 +579,193 @@ func (s *Service) StartJobRun(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// GetJobRun handles the GetJobRun operation.
+func (s *Service) GetJobRun(w http.ResponseWriter, r *http.Request) {
+	var req GetJobRunInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidInput, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.JobName == "" || req.RunID == "" {
+		writeError(w, errInvalidInput, "JobName and RunId are required", http.StatusBadRequest)
+
+		return
+	}
+
+	jobRun, err := s.storage.GetJobRun(r.Context(), req.JobName, req.RunID)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, GetJobRunOutput{
+		JobRun: jobRunToResponse(jobRun),
+	})
+}
+
+// GetJobRuns handles the GetJobRuns operation.
+func (s *Service) GetJobRuns(w http.ResponseWriter, r *http.Request) {
+	var req GetJobRunsInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidInput, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.JobName == "" {
+		writeError(w, errInvalidInput, "JobName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	jobRuns, nextToken, err := s.storage.GetJobRuns(r.Context(), req.JobName, req.MaxResults, req.NextToken)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	responses := make([]*JobRunResponse, len(jobRuns))
+	for i, jobRun := range jobRuns {
+		responses[i] = jobRunToResponse(jobRun)
+	}
+
+	writeJSONResponse(w, GetJobRunsOutput{
+		JobRuns:   responses,
+		NextToken: nextToken,
+	})
+}
+
+// BatchStopJobRun handles the BatchStopJobRun operation.
+func (s *Service) BatchStopJobRun(w http.ResponseWriter, r *http.Request) {
+	var req BatchStopJobRunInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidInput, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.JobName == "" || len(req.JobRunIDs) == 0 {
+		writeError(w, errInvalidInput, "JobName and JobRunIds are required", http.StatusBadRequest)
+
+		return
+	}
+
+	submissions, errs, err := s.storage.BatchStopJobRun(r.Context(), req.JobName, req.JobRunIDs)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, BatchStopJobRunOutput{
+		SuccessfulSubmissions: submissions,
+		Errors:                errs,
+	})
+}
+
 // Helper functions.
 
+// partitionToResponse converts a partition to its API representation.
+func partitionToResponse(partition *Partition) *PartitionResponse {
+	return &PartitionResponse{
+		Values:            partition.Values,
+		DatabaseName:      partition.DatabaseName,
+		TableName:         partition.TableName,
+		CreationTime:      ToAWSTimestamp(partition.CreationTime).Ptr(),
+		LastAccessTime:    ToAWSTimestampPtr(partition.LastAccessTime),
+		LastAnalyzedTime:  ToAWSTimestampPtr(partition.LastAnalyzedTime),
+		StorageDescriptor: partition.StorageDescriptor,
+		Parameters:        partition.Parameters,
+		CatalogID:         partition.CatalogID,
+	}
+}
+
+// databaseToResponse converts a database to its API representation.
+func databaseToResponse(db *Database) *DatabaseResponse {
+	return &DatabaseResponse{
+		Name:            db.Name,
+		Description:     db.Description,
+		LocationURI:     db.LocationURI,
+		Parameters:      db.Parameters,
+		CreateTime:      ToAWSTimestamp(db.CreateTime).Ptr(),
+		CatalogID:       db.CatalogID,
+		CreateTableMode: db.CreateTableMode,
+	}
+}
+
+// tableToResponse converts a table to its API representation.
+func tableToResponse(table *Table) *TableResponse {
+	return &TableResponse{
+		Name:              table.Name,
+		DatabaseName:      table.DatabaseName,
+		Description:       table.Description,
+		Owner:             table.Owner,
+		CreateTime:        ToAWSTimestamp(table.CreateTime).Ptr(),
+		UpdateTime:        ToAWSTimestamp(table.UpdateTime).Ptr(),
+		LastAccessTime:    ToAWSTimestampPtr(table.LastAccessTime),
+		LastAnalyzedTime:  ToAWSTimestampPtr(table.LastAnalyzedTime),
+		Retention:         table.Retention,
+		StorageDescriptor: table.StorageDescriptor,
+		PartitionKeys:     table.PartitionKeys,
+		ViewOriginalText:  table.ViewOriginalText,
+		ViewExpandedText:  table.ViewExpandedText,
+		TableType:         table.TableType,
+		Parameters:        table.Parameters,
+		CatalogID:         table.CatalogID,
+	}
+}
+
+// jobToResponse converts a job to its API representation.
+func jobToResponse(job *Job) *JobResponse {
+	return &JobResponse{
+		Name:                    job.Name,
+		Description:             job.Description,
+		Role:                    job.Role,
+		Command:                 job.Command,
+		DefaultArguments:        job.DefaultArguments,
+		NonOverridableArguments: job.NonOverridableArguments,
+		MaxRetries:              job.MaxRetries,
+		AllocatedCapacity:       job.AllocatedCapacity,
+		Timeout:                 job.Timeout,
+		MaxCapacity:             job.MaxCapacity,
+		WorkerType:              job.WorkerType,
+		NumberOfWorkers:         job.NumberOfWorkers,
+		GlueVersion:             job.GlueVersion,
+		CreatedOn:               ToAWSTimestamp(job.CreatedOn).Ptr(),
+		LastModifiedOn:          ToAWSTimestamp(job.LastModifiedOn).Ptr(),
+		ExecutionProperty:       job.ExecutionProperty,
+	}
+}
+
+// jobRunToResponse converts a job run to its API representation.
+func jobRunToResponse(jobRun *JobRun) *JobRunResponse {
+	return &JobRunResponse{
+		ID:                jobRun.ID,
+		Attempt:           jobRun.Attempt,
+		PreviousRunID:     jobRun.PreviousRunID,
+		TriggerName:       jobRun.TriggerName,
+		JobName:           jobRun.JobName,
+		StartedOn:         ToAWSTimestamp(jobRun.StartedOn).Ptr(),
+		LastModifiedOn:    ToAWSTimestamp(jobRun.LastModifiedOn).Ptr(),
+		CompletedOn:       ToAWSTimestampPtr(jobRun.CompletedOn),
+		JobRunState:       jobRun.JobRunState,
+		Arguments:         jobRun.Arguments,
+		ErrorMessage:      jobRun.ErrorMessage,
+		PredecessorRuns:   jobRun.PredecessorRuns,
+		AllocatedCapacity: jobRun.AllocatedCapacity,
+		ExecutionTime:     jobRun.ExecutionTime,
+		Timeout:           jobRun.Timeout,
+		MaxCapacity:       jobRun.MaxCapacity,
+		WorkerType:        jobRun.WorkerType,
+		NumberOfWorkers:   jobRun.NumberOfWorkers,
+		GlueVersion:       jobRun.GlueVersion,
+	}
+}
+
 // readJSONRequest reads and decodes JSON request body.
 func readJSONRequest(r *http.Request, v any) error {
 	body, err := io.ReadAll(r.Body)
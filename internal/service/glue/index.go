@@ -0,0 +1,96 @@
+package glue
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// nameIndex is a list of names kept sorted case-insensitively, so that listing
+// and name-prefix lookups are served by binary search instead of a full scan.
+type nameIndex []string
+
+// compareNames orders names case-insensitively, breaking ties by exact name.
+func compareNames(a, b string) int {
+	return cmp.Or(strings.Compare(strings.ToLower(a), strings.ToLower(b)), strings.Compare(a, b))
+}
+
+// insert adds a name to the index, keeping it sorted.
+func (x nameIndex) insert(name string) nameIndex {
+	i, found := slices.BinarySearchFunc(x, name, compareNames)
+	if found {
+		return x
+	}
+
+	return slices.Insert(x, i, name)
+}
+
+// remove deletes a name from the index.
+func (x nameIndex) remove(name string) nameIndex {
+	i, found := slices.BinarySearchFunc(x, name, compareNames)
+	if !found {
+		return x
+	}
+
+	return slices.Delete(x, i, i+1)
+}
+
+// withPrefix returns the names that start with prefix, ignoring case.
+// The result shares storage with the index.
+func (x nameIndex) withPrefix(prefix string) nameIndex {
+	if prefix == "" {
+		return x
+	}
+
+	prefix = strings.ToLower(prefix)
+
+	lo := sort.Search(len(x), func(i int) bool {
+		return strings.ToLower(x[i]) >= prefix
+	})
+	hi := lo + sort.Search(len(x)-lo, func(i int) bool {
+		return !strings.HasPrefix(strings.ToLower(x[lo+i]), prefix)
+	})
+
+	return x[lo:hi]
+}
+
+// expressionPrefix returns the literal prefix every name matching a GetTables
+// expression must start with. complete reports whether the expression is just
+// the prefix followed by a wildcard, in which case every name with the prefix matches.
+func expressionPrefix(expression string) (prefix string, complete bool) {
+	// An alternation can match names that do not share the leading literal.
+	if strings.Contains(expression, "|") {
+		return "", false
+	}
+
+	end := strings.IndexAny(expression, `.*+?()[]{}^$\`)
+	if end < 0 {
+		return expression, false
+	}
+
+	rest := expression[end:]
+
+	// A quantifier makes the preceding character optional or repeatable.
+	if strings.ContainsAny(rest[:1], "*+?{") && rest != "*" {
+		return expression[:max(end-1, 0)], false
+	}
+
+	return expression[:end], rest == "*" || rest == ".*"
+}
+
+// rebuildIndexes rebuilds the database and table name indexes from the stored resources. Must be called under lock.
+func (s *MemoryStorage) rebuildIndexes() {
+	s.databaseIndex = make(map[string]nameIndex)
+	s.tableIndex = make(map[string]nameIndex)
+
+	for _, db := range s.Databases {
+		key := catalogKey(db.CatalogID)
+		s.databaseIndex[key] = s.databaseIndex[key].insert(db.Name)
+	}
+
+	for _, table := range s.Tables {
+		key := databaseKey(table.CatalogID, table.DatabaseName)
+		s.tableIndex[key] = s.tableIndex[key].insert(table.Name)
+	}
+}
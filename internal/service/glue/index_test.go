@@ -0,0 +1,56 @@
+package glue
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestExpressionPrefix(t *testing.T) {
+	tests := []struct {
+		expression string
+		prefix     string
+		complete   bool
+	}{
+		{expression: "", prefix: "", complete: false},
+		{expression: "orders", prefix: "orders", complete: false},
+		{expression: "orders*", prefix: "orders", complete: true},
+		{expression: "orders.*", prefix: "orders", complete: true},
+		{expression: "orders_20.*_v2", prefix: "orders_20", complete: false},
+		{expression: "orders?", prefix: "order", complete: false},
+		{expression: "*_2024", prefix: "", complete: false},
+		{expression: "orders|customers", prefix: "", complete: false},
+	}
+
+	for _, tt := range tests {
+		prefix, complete := expressionPrefix(tt.expression)
+		if prefix != tt.prefix || complete != tt.complete {
+			t.Errorf("expressionPrefix(%q) = (%q, %v), want (%q, %v)", tt.expression, prefix, complete, tt.prefix, tt.complete)
+		}
+	}
+}
+
+func TestNameIndex(t *testing.T) {
+	var index nameIndex
+
+	for _, name := range []string{"orders", "Customers", "order_items", "customers_v2", "orders"} {
+		index = index.insert(name)
+	}
+
+	if want := (nameIndex{"Customers", "customers_v2", "order_items", "orders"}); !slices.Equal(index, want) {
+		t.Fatalf("index = %v, want %v", index, want)
+	}
+
+	if got, want := index.withPrefix("CUSTOMERS"), (nameIndex{"Customers", "customers_v2"}); !slices.Equal(got, want) {
+		t.Errorf("withPrefix(CUSTOMERS) = %v, want %v", got, want)
+	}
+
+	if got := index.withPrefix("sales"); len(got) != 0 {
+		t.Errorf("withPrefix(sales) = %v, want none", got)
+	}
+
+	index = index.remove("order_items")
+
+	if got, want := index.withPrefix("order"), (nameIndex{"orders"}); !slices.Equal(got, want) {
+		t.Errorf("withPrefix(order) after remove = %v, want %v", got, want)
+	}
+}
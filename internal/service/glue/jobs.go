@@ -0,0 +1,74 @@
+package glue
+
+import (
+	"maps"
+	"slices"
+	"time"
+)
+
+// defaultJobRunTransitionDelay is how long a job run spends STARTING and RUNNING before it succeeds.
+const defaultJobRunTransitionDelay = 500 * time.Millisecond
+
+// WithJobRunTransitionDelay sets how long a job run spends STARTING and RUNNING before it succeeds.
+func WithJobRunTransitionDelay(d time.Duration) Option {
+	return func(s *MemoryStorage) {
+		s.transitionDelay = d
+	}
+}
+
+// jobRunScheduler periodically advances started job runs.
+func (s *MemoryStorage) jobRunScheduler() {
+	ticker := time.NewTicker(max(s.transitionDelay/5, 10*time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopScheduler:
+			return
+		case now := <-ticker.C:
+			s.advanceJobRuns(now)
+		}
+	}
+}
+
+// advanceJobRuns moves each job run from STARTING to RUNNING, and from
+// RUNNING to SUCCEEDED, once it has spent the transition delay in its state.
+func (s *MemoryStorage) advanceJobRuns(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, run := range s.JobRuns {
+		elapsed := now.Sub(run.StartedOn)
+
+		switch {
+		case run.JobRunState == JobRunStateStarting && elapsed >= s.transitionDelay:
+			run.JobRunState = JobRunStateRunning
+			run.LastModifiedOn = now
+		case run.JobRunState == JobRunStateRunning && elapsed >= 2*s.transitionDelay:
+			run.JobRunState = JobRunStateSucceeded
+			run.complete(now)
+		}
+	}
+}
+
+// isFinished reports whether the job run has reached a terminal state.
+func (r *JobRun) isFinished() bool {
+	return r.JobRunState == JobRunStateSucceeded || r.JobRunState == JobRunStateStopped
+}
+
+// complete records the end of the job run and the whole seconds it ran for.
+func (r *JobRun) complete(now time.Time) {
+	r.CompletedOn = &now
+	r.LastModifiedOn = now
+	r.ExecutionTime = int32((now.Sub(r.StartedOn) + time.Second - 1) / time.Second)
+}
+
+// clone returns a copy of the job run, so that it can be read after the
+// storage lock is released while the scheduler advances the original.
+func (r *JobRun) clone() *JobRun {
+	cp := *r
+	cp.Arguments = maps.Clone(r.Arguments)
+	cp.PredecessorRuns = slices.Clone(r.PredecessorRuns)
+
+	return &cp
+}
@@ -0,0 +1,33 @@
+package glue
+
+import (
+	"encoding/base64"
+	"strconv"
+)
+
+// paginate returns the page of items starting at the offset encoded in nextToken,
+// along with the token for the following page.
+func paginate[T any](items []T, maxResults int32, nextToken string) ([]T, string) {
+	if maxResults <= 0 {
+		maxResults = defaultMaxResults
+	}
+
+	start := 0
+
+	if nextToken != "" {
+		if decoded, err := base64.StdEncoding.DecodeString(nextToken); err == nil {
+			if offset, err := strconv.Atoi(string(decoded)); err == nil && offset >= 0 && offset <= len(items) {
+				start = offset
+			}
+		}
+	}
+
+	end := min(start+int(maxResults), len(items))
+
+	var next string
+	if end < len(items) {
+		next = base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(end)))
+	}
+
+	return items[start:end], next
+}
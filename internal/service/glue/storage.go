@@ -1,9 +1,13 @@
 package glue
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -19,7 +23,14 @@ const (
 	errInvalidInput   = "InvalidInputException"
 )
 
-const defaultCatalogID = "default"
+const (
+	defaultCatalogID  = "default"
+	defaultMaxResults = 100
+
+	// partitionValuesSeparator joins partition values into a map key.
+	// It is a control character so it cannot collide with real values.
+	partitionValuesSeparator = "\x1f"
+)
 
 // Storage defines the interface for Glue storage operations.
 type Storage interface {
@@ -30,12 +41,24 @@ type Storage interface {
 
 	CreateTable(ctx context.Context, catalogID, databaseName string, input *TableInput) error
 	GetTable(ctx context.Context, catalogID, databaseName, name string) (*Table, error)
-	GetTables(ctx context.Context, catalogID, databaseName string, maxResults int32, nextToken string) ([]*Table, string, error)
+	GetTables(ctx context.Context, catalogID, databaseName, expression string, maxResults int32, nextToken string) ([]*Table, string, error)
+	UpdateTable(ctx context.Context, catalogID, databaseName string, input *TableInput) error
 	DeleteTable(ctx context.Context, catalogID, databaseName, name string) error
 
+	CreatePartition(ctx context.Context, catalogID, databaseName, tableName string, input *PartitionInput) error
+	BatchCreatePartition(ctx context.Context, catalogID, databaseName, tableName string, inputs []*PartitionInput) ([]PartitionError, error)
+	GetPartition(ctx context.Context, catalogID, databaseName, tableName string, values []string) (*Partition, error)
+	GetPartitions(ctx context.Context, catalogID, databaseName, tableName, expression string, maxResults int32, nextToken string) ([]*Partition, string, error)
+	DeletePartition(ctx context.Context, catalogID, databaseName, tableName string, values []string) error
+
 	CreateJob(ctx context.Context, input *CreateJobInput) (*Job, error)
 	DeleteJob(ctx context.Context, jobName string) error
+	GetJob(ctx context.Context, jobName string) (*Job, error)
 	StartJobRun(ctx context.Context, input *StartJobRunInput) (*JobRun, error)
+	GetJobRun(ctx context.Context, jobName, runID string) (*JobRun, error)
+	GetJobRuns(ctx context.Context, jobName string, maxResults int32, nextToken string) ([]*JobRun, string, error)
+	BatchStopJobRun(ctx context.Context, jobName string, runIDs []string) ([]BatchStopJobRunSuccessfulSubmission, []BatchStopJobRunError, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -56,21 +79,29 @@ var (
 
 // MemoryStorage implements Storage with in-memory data structures.
 type MemoryStorage struct {
-	mu        sync.RWMutex         `json:"-"`
-	Databases map[string]*Database `json:"databases"` // key: catalogID/databaseName
-	Tables    map[string]*Table    `json:"tables"`    // key: catalogID/databaseName/tableName
-	Jobs      map[string]*Job      `json:"jobs"`      // key: jobName
-	JobRuns   map[string]*JobRun   `json:"jobRuns"`   // key: jobRunID
-	dataDir   string
+	mu              sync.RWMutex         `json:"-"`
+	Databases       map[string]*Database `json:"databases"` // key: catalogID/databaseName
+	Tables          map[string]*Table    `json:"tables"`    // key: catalogID/databaseName/tableName
+	Jobs            map[string]*Job      `json:"jobs"`      // key: jobName
+	JobRuns         map[string]*JobRun   `json:"jobRuns"`   // key: jobRunID
+	databaseIndex   map[string]nameIndex // key: catalogID
+	tableIndex      map[string]nameIndex // key: catalogID/databaseName
+	dataDir         string
+	transitionDelay time.Duration
+	stopScheduler   chan struct{}
 }
 
 // NewMemoryStorage creates a new in-memory storage.
 func NewMemoryStorage(opts ...Option) *MemoryStorage {
 	s := &MemoryStorage{
-		Databases: make(map[string]*Database),
-		Tables:    make(map[string]*Table),
-		Jobs:      make(map[string]*Job),
-		JobRuns:   make(map[string]*JobRun),
+		Databases:       make(map[string]*Database),
+		Tables:          make(map[string]*Table),
+		Jobs:            make(map[string]*Job),
+		JobRuns:         make(map[string]*JobRun),
+		databaseIndex:   make(map[string]nameIndex),
+		tableIndex:      make(map[string]nameIndex),
+		transitionDelay: defaultJobRunTransitionDelay,
+		stopScheduler:   make(chan struct{}),
 	}
 	for _, o := range opts {
 		o(s)
@@ -80,6 +111,8 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		_ = storage.Load(s.dataDir, "glue", s)
 	}
 
+	go s.jobRunScheduler()
+
 	return s
 }
 
@@ -127,11 +160,15 @@ func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
 		s.JobRuns = make(map[string]*JobRun)
 	}
 
+	s.rebuildIndexes()
+
 	return nil
 }
 
 // Close saves the storage state to disk if persistence is enabled.
 func (s *MemoryStorage) Close() error {
+	close(s.stopScheduler)
+
 	if s.dataDir == "" {
 		return nil
 	}
@@ -143,20 +180,35 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
-func databaseKey(catalogID, name string) string {
-	if catalogID == "" {
-		catalogID = defaultCatalogID
-	}
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	return catalogID + "/" + name
+	s.Databases = make(map[string]*Database)
+	s.Tables = make(map[string]*Table)
+	s.Jobs = make(map[string]*Job)
+	s.JobRuns = make(map[string]*JobRun)
+	s.databaseIndex = make(map[string]nameIndex)
+	s.tableIndex = make(map[string]nameIndex)
+
+	return nil
 }
 
-func tableKey(catalogID, databaseName, tableName string) string {
+func catalogKey(catalogID string) string {
 	if catalogID == "" {
-		catalogID = defaultCatalogID
+		return defaultCatalogID
 	}
 
-	return catalogID + "/" + databaseName + "/" + tableName
+	return catalogID
+}
+
+func databaseKey(catalogID, name string) string {
+	return catalogKey(catalogID) + "/" + name
+}
+
+func tableKey(catalogID, databaseName, tableName string) string {
+	return databaseKey(catalogID, databaseName) + "/" + tableName
 }
 
 // CreateDatabase creates a new database.
@@ -191,6 +243,7 @@ func (s *MemoryStorage) CreateDatabase(_ context.Context, catalogID string, inpu
 	}
 
 	s.Databases[key] = db
+	s.databaseIndex[catalogKey(catalogID)] = s.databaseIndex[catalogKey(catalogID)].insert(input.Name)
 
 	return nil
 }
@@ -213,33 +266,19 @@ func (s *MemoryStorage) GetDatabase(_ context.Context, catalogID, name string) (
 	return db, nil
 }
 
-// GetDatabases lists all databases.
-func (s *MemoryStorage) GetDatabases(_ context.Context, catalogID string, maxResults int32, _ string) ([]*Database, string, error) {
+// GetDatabases lists databases in the catalog ordered by name.
+func (s *MemoryStorage) GetDatabases(_ context.Context, catalogID string, maxResults int32, nextToken string) ([]*Database, string, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	if maxResults <= 0 {
-		maxResults = 100
-	}
-
-	if catalogID == "" {
-		catalogID = defaultCatalogID
-	}
-
-	prefix := catalogID + "/"
-	databases := make([]*Database, 0)
+	names, next := paginate(s.databaseIndex[catalogKey(catalogID)], maxResults, nextToken)
+	databases := make([]*Database, len(names))
 
-	for key, db := range s.Databases {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			databases = append(databases, db)
-
-			if len(databases) >= int(maxResults) {
-				break
-			}
-		}
+	for i, name := range names {
+		databases[i] = s.Databases[databaseKey(catalogID, name)]
 	}
 
-	return databases, "", nil
+	return databases, next, nil
 }
 
 // DeleteDatabase deletes a database.
@@ -257,6 +296,14 @@ func (s *MemoryStorage) DeleteDatabase(_ context.Context, catalogID, name string
 	}
 
 	delete(s.Databases, key)
+	s.databaseIndex[catalogKey(catalogID)] = s.databaseIndex[catalogKey(catalogID)].remove(name)
+
+	// Tables belong to their database and are removed with it.
+	for _, tableName := range s.tableIndex[key] {
+		delete(s.Tables, tableKey(catalogID, name, tableName))
+	}
+
+	delete(s.tableIndex, key)
 
 	return nil
 }
@@ -307,9 +354,11 @@ func (s *MemoryStorage) CreateTable(_ context.Context, catalogID, databaseName s
 		TableType:         input.TableType,
 		Parameters:        input.Parameters,
 		CatalogID:         catalogID,
+		Partitions:        make(map[string]*Partition),
 	}
 
 	s.Tables[key] = table
+	s.tableIndex[dbKey] = s.tableIndex[dbKey].insert(input.Name)
 
 	return nil
 }
@@ -332,33 +381,81 @@ func (s *MemoryStorage) GetTable(_ context.Context, catalogID, databaseName, nam
 	return table, nil
 }
 
-// GetTables lists tables in a database.
-func (s *MemoryStorage) GetTables(_ context.Context, catalogID, databaseName string, maxResults int32, _ string) ([]*Table, string, error) {
+// GetTables lists tables in a database ordered by name.
+// When expression is set, only tables whose names match the pattern are returned.
+// Tables are looked up through the database's name index, narrowed to the
+// expression's literal prefix, so large catalogs are not scanned in full.
+func (s *MemoryStorage) GetTables(_ context.Context, catalogID, databaseName, expression string, maxResults int32, nextToken string) ([]*Table, string, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	if maxResults <= 0 {
-		maxResults = 100
+	dbKey := databaseKey(catalogID, databaseName)
+
+	if _, exists := s.Databases[dbKey]; !exists {
+		return nil, "", &Error{
+			Code:    errEntityNotFound,
+			Message: fmt.Sprintf("Database %s not found", databaseName),
+		}
 	}
 
-	if catalogID == "" {
-		catalogID = defaultCatalogID
+	pattern, err := compileNamePattern(expression)
+	if err != nil {
+		return nil, "", err
 	}
 
-	prefix := catalogID + "/" + databaseName + "/"
-	tables := make([]*Table, 0)
+	prefix, complete := expressionPrefix(expression)
+	names := s.tableIndex[dbKey].withPrefix(prefix)
 
-	for key, table := range s.Tables {
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			tables = append(tables, table)
+	if pattern != nil && !complete {
+		names = slices.DeleteFunc(slices.Clone(names), func(name string) bool {
+			return !pattern.MatchString(name)
+		})
+	}
 
-			if len(tables) >= int(maxResults) {
-				break
-			}
+	page, next := paginate(names, maxResults, nextToken)
+	tables := make([]*Table, len(page))
+
+	for i, name := range page {
+		tables[i] = s.Tables[tableKey(catalogID, databaseName, name)]
+	}
+
+	return tables, next, nil
+}
+
+// UpdateTable replaces the definition of an existing table.
+func (s *MemoryStorage) UpdateTable(_ context.Context, catalogID, databaseName string, input *TableInput) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if input.Name == "" {
+		return &Error{
+			Code:    errInvalidInput,
+			Message: "Table name is required",
+		}
+	}
+
+	key := tableKey(catalogID, databaseName, input.Name)
+
+	table, exists := s.Tables[key]
+	if !exists {
+		return &Error{
+			Code:    errEntityNotFound,
+			Message: fmt.Sprintf("Table %s not found", input.Name),
 		}
 	}
 
-	return tables, "", nil
+	table.Description = input.Description
+	table.Owner = input.Owner
+	table.UpdateTime = time.Now()
+	table.Retention = input.Retention
+	table.StorageDescriptor = input.StorageDescriptor
+	table.PartitionKeys = input.PartitionKeys
+	table.ViewOriginalText = input.ViewOriginalText
+	table.ViewExpandedText = input.ViewExpandedText
+	table.TableType = input.TableType
+	table.Parameters = input.Parameters
+
+	return nil
 }
 
 // DeleteTable deletes a table.
@@ -377,6 +474,194 @@ func (s *MemoryStorage) DeleteTable(_ context.Context, catalogID, databaseName,
 
 	delete(s.Tables, key)
 
+	dbKey := databaseKey(catalogID, databaseName)
+	s.tableIndex[dbKey] = s.tableIndex[dbKey].remove(name)
+
+	return nil
+}
+
+// lookupTable returns the table or an EntityNotFoundException. The caller must hold the lock.
+func (s *MemoryStorage) lookupTable(catalogID, databaseName, tableName string) (*Table, error) {
+	if _, exists := s.Databases[databaseKey(catalogID, databaseName)]; !exists {
+		return nil, &Error{
+			Code:    errEntityNotFound,
+			Message: fmt.Sprintf("Database %s not found", databaseName),
+		}
+	}
+
+	table, exists := s.Tables[tableKey(catalogID, databaseName, tableName)]
+	if !exists {
+		return nil, &Error{
+			Code:    errEntityNotFound,
+			Message: fmt.Sprintf("Table %s not found", tableName),
+		}
+	}
+
+	if table.Partitions == nil {
+		table.Partitions = make(map[string]*Partition)
+	}
+
+	return table, nil
+}
+
+// addPartition validates and stores a partition on the table. The caller must hold the lock.
+func addPartition(table *Table, input *PartitionInput) error {
+	if len(input.Values) != len(table.PartitionKeys) {
+		return &Error{
+			Code: errInvalidInput,
+			Message: fmt.Sprintf("The number of partition values (%d) does not match the number of partition keys (%d)",
+				len(input.Values), len(table.PartitionKeys)),
+		}
+	}
+
+	key := strings.Join(input.Values, partitionValuesSeparator)
+
+	if _, exists := table.Partitions[key]; exists {
+		return &Error{
+			Code:    errAlreadyExists,
+			Message: "Partition already exists.",
+		}
+	}
+
+	table.Partitions[key] = &Partition{
+		Values:            input.Values,
+		DatabaseName:      table.DatabaseName,
+		TableName:         table.Name,
+		CreationTime:      time.Now(),
+		StorageDescriptor: input.StorageDescriptor,
+		Parameters:        input.Parameters,
+		CatalogID:         table.CatalogID,
+	}
+
+	return nil
+}
+
+// CreatePartition creates a new partition on a table.
+func (s *MemoryStorage) CreatePartition(_ context.Context, catalogID, databaseName, tableName string, input *PartitionInput) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.lookupTable(catalogID, databaseName, tableName)
+	if err != nil {
+		return err
+	}
+
+	return addPartition(table, input)
+}
+
+// BatchCreatePartition creates multiple partitions on a table.
+// Partitions that cannot be created are reported individually instead of failing the request.
+func (s *MemoryStorage) BatchCreatePartition(_ context.Context, catalogID, databaseName, tableName string, inputs []*PartitionInput) ([]PartitionError, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.lookupTable(catalogID, databaseName, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []PartitionError
+
+	for _, input := range inputs {
+		err := addPartition(table, input)
+		if err == nil {
+			continue
+		}
+
+		var glueErr *Error
+		if !errors.As(err, &glueErr) {
+			return nil, err
+		}
+
+		errs = append(errs, PartitionError{
+			PartitionValues: input.Values,
+			ErrorDetail: &ErrorDetail{
+				ErrorCode:    glueErr.Code,
+				ErrorMessage: glueErr.Message,
+			},
+		})
+	}
+
+	return errs, nil
+}
+
+// GetPartition retrieves a partition by its values.
+func (s *MemoryStorage) GetPartition(_ context.Context, catalogID, databaseName, tableName string, values []string) (*Partition, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.lookupTable(catalogID, databaseName, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	partition, exists := table.Partitions[strings.Join(values, partitionValuesSeparator)]
+	if !exists {
+		return nil, &Error{
+			Code:    errEntityNotFound,
+			Message: "Cannot find partition.",
+		}
+	}
+
+	return partition, nil
+}
+
+// GetPartitions lists the partitions of a table ordered by their values.
+// When expression is set, only partitions satisfying the predicate are returned.
+func (s *MemoryStorage) GetPartitions(_ context.Context, catalogID, databaseName, tableName, expression string, maxResults int32, nextToken string) ([]*Partition, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.lookupTable(catalogID, databaseName, tableName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	predicate, err := parsePartitionExpression(expression, table.PartitionKeys)
+	if err != nil {
+		return nil, "", err
+	}
+
+	partitions := make([]*Partition, 0, len(table.Partitions))
+
+	for _, partition := range table.Partitions {
+		if predicate != nil && !predicate.eval(partition.Values) {
+			continue
+		}
+
+		partitions = append(partitions, partition)
+	}
+
+	slices.SortFunc(partitions, func(a, b *Partition) int {
+		return slices.Compare(a.Values, b.Values)
+	})
+
+	page, next := paginate(partitions, maxResults, nextToken)
+
+	return page, next, nil
+}
+
+// DeletePartition deletes a partition by its values.
+func (s *MemoryStorage) DeletePartition(_ context.Context, catalogID, databaseName, tableName string, values []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.lookupTable(catalogID, databaseName, tableName)
+	if err != nil {
+		return err
+	}
+
+	key := strings.Join(values, partitionValuesSeparator)
+
+	if _, exists := table.Partitions[key]; !exists {
+		return &Error{
+			Code:    errEntityNotFound,
+			Message: "Cannot find partition.",
+		}
+	}
+
+	delete(table.Partitions, key)
+
 	return nil
 }
 
@@ -448,7 +733,9 @@ func (s *MemoryStorage) DeleteJob(_ context.Context, jobName string) error {
 	return nil
 }
 
-// StartJobRun starts a job run.
+// StartJobRun starts a job run. The run starts in the STARTING state and the
+// scheduler advances it to RUNNING and then SUCCEEDED. Passing the ID of a
+// previous run of the job retries that run.
 func (s *MemoryStorage) StartJobRun(_ context.Context, input *StartJobRunInput) (*JobRun, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -461,29 +748,143 @@ func (s *MemoryStorage) StartJobRun(_ context.Context, input *StartJobRunInput)
 		}
 	}
 
-	runID := input.JobRunID
-	if runID == "" {
-		runID = "jr_" + uuid.New().String()
+	if input.JobRunID != "" {
+		if previous, exists := s.JobRuns[input.JobRunID]; !exists || previous.JobName != input.JobName {
+			return nil, &Error{
+				Code:    errEntityNotFound,
+				Message: fmt.Sprintf("Job run %s not found", input.JobRunID),
+			}
+		}
 	}
 
 	now := time.Now()
 	jobRun := &JobRun{
-		ID:                runID,
+		ID:                "jr_" + uuid.New().String(),
 		Attempt:           0,
+		PreviousRunID:     input.JobRunID,
 		JobName:           input.JobName,
 		StartedOn:         now,
 		LastModifiedOn:    now,
-		JobRunState:       "RUNNING",
+		JobRunState:       JobRunStateStarting,
 		Arguments:         input.Arguments,
 		AllocatedCapacity: input.AllocatedCapacity,
-		Timeout:           input.Timeout,
+		Timeout:           cmp.Or(input.Timeout, job.Timeout),
 		MaxCapacity:       input.MaxCapacity,
-		WorkerType:        input.WorkerType,
-		NumberOfWorkers:   input.NumberOfWorkers,
+		WorkerType:        cmp.Or(input.WorkerType, job.WorkerType),
+		NumberOfWorkers:   cmp.Or(input.NumberOfWorkers, job.NumberOfWorkers),
 		GlueVersion:       job.GlueVersion,
 	}
 
-	s.JobRuns[runID] = jobRun
+	s.JobRuns[jobRun.ID] = jobRun
+
+	return jobRun.clone(), nil
+}
+
+// GetJob retrieves a job.
+func (s *MemoryStorage) GetJob(_ context.Context, jobName string) (*Job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	job, exists := s.Jobs[jobName]
+	if !exists {
+		return nil, &Error{
+			Code:    errEntityNotFound,
+			Message: fmt.Sprintf("Job %s not found", jobName),
+		}
+	}
+
+	return job, nil
+}
+
+// GetJobRun retrieves a run of a job.
+func (s *MemoryStorage) GetJobRun(_ context.Context, jobName, runID string) (*JobRun, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	run, exists := s.JobRuns[runID]
+	if !exists || run.JobName != jobName {
+		return nil, &Error{
+			Code:    errEntityNotFound,
+			Message: fmt.Sprintf("Job run %s not found", runID),
+		}
+	}
+
+	return run.clone(), nil
+}
+
+// GetJobRuns lists the runs of a job, most recently started first.
+func (s *MemoryStorage) GetJobRuns(_ context.Context, jobName string, maxResults int32, nextToken string) ([]*JobRun, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, exists := s.Jobs[jobName]; !exists {
+		return nil, "", &Error{
+			Code:    errEntityNotFound,
+			Message: fmt.Sprintf("Job %s not found", jobName),
+		}
+	}
+
+	runs := make([]*JobRun, 0)
+
+	for _, run := range s.JobRuns {
+		if run.JobName == jobName {
+			runs = append(runs, run.clone())
+		}
+	}
+
+	slices.SortFunc(runs, func(a, b *JobRun) int {
+		if c := b.StartedOn.Compare(a.StartedOn); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	page, next := paginate(runs, maxResults, nextToken)
+
+	return page, next, nil
+}
+
+// BatchStopJobRun stops runs of a job. Runs that are unknown or have already
+// finished are reported as errors.
+func (s *MemoryStorage) BatchStopJobRun(_ context.Context, jobName string, runIDs []string) ([]BatchStopJobRunSuccessfulSubmission, []BatchStopJobRunError, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.Jobs[jobName]; !exists {
+		return nil, nil, &Error{
+			Code:    errEntityNotFound,
+			Message: fmt.Sprintf("Job %s not found", jobName),
+		}
+	}
+
+	now := time.Now()
+	submissions := make([]BatchStopJobRunSuccessfulSubmission, 0, len(runIDs))
+	errs := make([]BatchStopJobRunError, 0)
+
+	for _, runID := range runIDs {
+		run, exists := s.JobRuns[runID]
+
+		switch {
+		case !exists || run.JobName != jobName:
+			errs = append(errs, BatchStopJobRunError{
+				JobName:     jobName,
+				JobRunID:    runID,
+				ErrorDetail: &ErrorDetail{ErrorCode: errEntityNotFound, ErrorMessage: fmt.Sprintf("Job run %s not found", runID)},
+			})
+		case run.isFinished():
+			errs = append(errs, BatchStopJobRunError{
+				JobName:     jobName,
+				JobRunID:    runID,
+				ErrorDetail: &ErrorDetail{ErrorCode: errInvalidInput, ErrorMessage: fmt.Sprintf("Job run %s is already %s", runID, run.JobRunState)},
+			})
+		default:
+			run.JobRunState = JobRunStateStopped
+			run.complete(now)
+
+			submissions = append(submissions, BatchStopJobRunSuccessfulSubmission{JobName: jobName, JobRunID: runID})
+		}
+	}
 
-	return jobRun, nil
+	return submissions, errs, nil
 }
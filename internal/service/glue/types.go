@@ -82,6 +82,27 @@ type Table struct {
 	TableType         string
 	Parameters        map[string]string
 	CatalogID         string
+	Partitions        map[string]*Partition // key: partition values joined by partitionValuesSeparator
+}
+
+// Partition represents a partition of a Glue table.
+type Partition struct {
+	Values            []string
+	DatabaseName      string
+	TableName         string
+	CreationTime      time.Time
+	LastAccessTime    *time.Time
+	LastAnalyzedTime  *time.Time
+	StorageDescriptor *StorageDescriptor
+	Parameters        map[string]string
+	CatalogID         string
+}
+
+// PartitionInput represents input for creating a partition.
+type PartitionInput struct {
+	Values            []string           `json:"Values"`
+	StorageDescriptor *StorageDescriptor `json:"StorageDescriptor,omitempty"`
+	Parameters        map[string]string  `json:"Parameters,omitempty"`
 }
 
 // TableInput represents input for creating/updating a table.
@@ -297,6 +318,14 @@ type GetTablesOutput struct {
 	NextToken string           `json:"NextToken,omitempty"`
 }
 
+// UpdateTableInput is the request for UpdateTable.
+type UpdateTableInput struct {
+	CatalogID    string      `json:"CatalogId,omitempty"`
+	DatabaseName string      `json:"DatabaseName"`
+	TableInput   *TableInput `json:"TableInput"`
+	SkipArchive  bool        `json:"SkipArchive,omitempty"`
+}
+
 // DeleteTableInput is the request for DeleteTable.
 type DeleteTableInput struct {
 	CatalogID    string `json:"CatalogId,omitempty"`
@@ -304,6 +333,89 @@ type DeleteTableInput struct {
 	Name         string `json:"Name"`
 }
 
+// CreatePartitionInput is the request for CreatePartition.
+type CreatePartitionInput struct {
+	CatalogID      string          `json:"CatalogId,omitempty"`
+	DatabaseName   string          `json:"DatabaseName"`
+	TableName      string          `json:"TableName"`
+	PartitionInput *PartitionInput `json:"PartitionInput"`
+}
+
+// BatchCreatePartitionInput is the request for BatchCreatePartition.
+type BatchCreatePartitionInput struct {
+	CatalogID          string            `json:"CatalogId,omitempty"`
+	DatabaseName       string            `json:"DatabaseName"`
+	TableName          string            `json:"TableName"`
+	PartitionInputList []*PartitionInput `json:"PartitionInputList"`
+}
+
+// BatchCreatePartitionOutput is the response for BatchCreatePartition.
+type BatchCreatePartitionOutput struct {
+	Errors []PartitionError `json:"Errors,omitempty"`
+}
+
+// PartitionError describes why a partition in a batch request failed.
+type PartitionError struct {
+	PartitionValues []string     `json:"PartitionValues,omitempty"`
+	ErrorDetail     *ErrorDetail `json:"ErrorDetail,omitempty"`
+}
+
+// ErrorDetail contains details about an error.
+type ErrorDetail struct {
+	ErrorCode    string `json:"ErrorCode,omitempty"`
+	ErrorMessage string `json:"ErrorMessage,omitempty"`
+}
+
+// GetPartitionInput is the request for GetPartition.
+type GetPartitionInput struct {
+	CatalogID       string   `json:"CatalogId,omitempty"`
+	DatabaseName    string   `json:"DatabaseName"`
+	TableName       string   `json:"TableName"`
+	PartitionValues []string `json:"PartitionValues"`
+}
+
+// GetPartitionOutput is the response for GetPartition.
+type GetPartitionOutput struct {
+	Partition *PartitionResponse `json:"Partition,omitempty"`
+}
+
+// PartitionResponse represents a partition in API responses.
+type PartitionResponse struct {
+	Values            []string           `json:"Values,omitempty"`
+	DatabaseName      string             `json:"DatabaseName,omitempty"`
+	TableName         string             `json:"TableName,omitempty"`
+	CreationTime      *AWSTimestamp      `json:"CreationTime,omitempty"`
+	LastAccessTime    *AWSTimestamp      `json:"LastAccessTime,omitempty"`
+	LastAnalyzedTime  *AWSTimestamp      `json:"LastAnalyzedTime,omitempty"`
+	StorageDescriptor *StorageDescriptor `json:"StorageDescriptor,omitempty"`
+	Parameters        map[string]string  `json:"Parameters,omitempty"`
+	CatalogID         string             `json:"CatalogId,omitempty"`
+}
+
+// GetPartitionsInput is the request for GetPartitions.
+type GetPartitionsInput struct {
+	CatalogID    string `json:"CatalogId,omitempty"`
+	DatabaseName string `json:"DatabaseName"`
+	TableName    string `json:"TableName"`
+	Expression   string `json:"Expression,omitempty"`
+	NextToken    string `json:"NextToken,omitempty"`
+	MaxResults   int32  `json:"MaxResults,omitempty"`
+}
+
+// GetPartitionsOutput is the response for GetPartitions.
+type GetPartitionsOutput struct {
+	Partitions []*PartitionResponse `json:"Partitions"`
+	NextToken  string               `json:"NextToken,omitempty"`
+}
+
+// DeletePartitionInput is the request for DeletePartition.
+type DeletePartitionInput struct {
+	CatalogID       string   `json:"CatalogId,omitempty"`
+	DatabaseName    string   `json:"DatabaseName"`
+	TableName       string   `json:"TableName"`
+	PartitionValues []string `json:"PartitionValues"`
+}
+
 // CreateJobInput is the request for CreateJob.
 type CreateJobInput struct {
 	Name                    string             `json:"Name"`
@@ -360,6 +472,117 @@ type StartJobRunOutput struct {
 	JobRunID string `json:"JobRunId,omitempty"`
 }
 
+// Job run states.
+const (
+	JobRunStateStarting  = "STARTING"
+	JobRunStateRunning   = "RUNNING"
+	JobRunStateStopped   = "STOPPED"
+	JobRunStateSucceeded = "SUCCEEDED"
+)
+
+// GetJobInput is the request for GetJob.
+type GetJobInput struct {
+	JobName string `json:"JobName"`
+}
+
+// GetJobOutput is the response for GetJob.
+type GetJobOutput struct {
+	Job *JobResponse `json:"Job,omitempty"`
+}
+
+// JobResponse is the API representation of a job.
+type JobResponse struct {
+	Name                    string             `json:"Name"`
+	Description             string             `json:"Description,omitempty"`
+	Role                    string             `json:"Role"`
+	Command                 *JobCommand        `json:"Command,omitempty"`
+	DefaultArguments        map[string]string  `json:"DefaultArguments,omitempty"`
+	NonOverridableArguments map[string]string  `json:"NonOverridableArguments,omitempty"`
+	MaxRetries              int32              `json:"MaxRetries"`
+	AllocatedCapacity       int32              `json:"AllocatedCapacity,omitempty"`
+	Timeout                 int32              `json:"Timeout,omitempty"`
+	MaxCapacity             float64            `json:"MaxCapacity,omitempty"`
+	WorkerType              string             `json:"WorkerType,omitempty"`
+	NumberOfWorkers         int32              `json:"NumberOfWorkers,omitempty"`
+	GlueVersion             string             `json:"GlueVersion,omitempty"`
+	CreatedOn               *AWSTimestamp      `json:"CreatedOn,omitempty"`
+	LastModifiedOn          *AWSTimestamp      `json:"LastModifiedOn,omitempty"`
+	ExecutionProperty       *ExecutionProperty `json:"ExecutionProperty,omitempty"`
+}
+
+// GetJobRunInput is the request for GetJobRun.
+type GetJobRunInput struct {
+	JobName              string `json:"JobName"`
+	RunID                string `json:"RunId"`
+	PredecessorsIncluded bool   `json:"PredecessorsIncluded,omitempty"`
+}
+
+// GetJobRunOutput is the response for GetJobRun.
+type GetJobRunOutput struct {
+	JobRun *JobRunResponse `json:"JobRun,omitempty"`
+}
+
+// GetJobRunsInput is the request for GetJobRuns.
+type GetJobRunsInput struct {
+	JobName    string `json:"JobName"`
+	MaxResults int32  `json:"MaxResults,omitempty"`
+	NextToken  string `json:"NextToken,omitempty"`
+}
+
+// GetJobRunsOutput is the response for GetJobRuns.
+type GetJobRunsOutput struct {
+	JobRuns   []*JobRunResponse `json:"JobRuns"`
+	NextToken string            `json:"NextToken,omitempty"`
+}
+
+// JobRunResponse is the API representation of a job run.
+type JobRunResponse struct {
+	ID                string            `json:"Id"`
+	Attempt           int32             `json:"Attempt"`
+	PreviousRunID     string            `json:"PreviousRunId,omitempty"`
+	TriggerName       string            `json:"TriggerName,omitempty"`
+	JobName           string            `json:"JobName"`
+	StartedOn         *AWSTimestamp     `json:"StartedOn,omitempty"`
+	LastModifiedOn    *AWSTimestamp     `json:"LastModifiedOn,omitempty"`
+	CompletedOn       *AWSTimestamp     `json:"CompletedOn,omitempty"`
+	JobRunState       string            `json:"JobRunState"`
+	Arguments         map[string]string `json:"Arguments,omitempty"`
+	ErrorMessage      string            `json:"ErrorMessage,omitempty"`
+	PredecessorRuns   []Predecessor     `json:"PredecessorRuns,omitempty"`
+	AllocatedCapacity int32             `json:"AllocatedCapacity,omitempty"`
+	ExecutionTime     int32             `json:"ExecutionTime"`
+	Timeout           int32             `json:"Timeout,omitempty"`
+	MaxCapacity       float64           `json:"MaxCapacity,omitempty"`
+	WorkerType        string            `json:"WorkerType,omitempty"`
+	NumberOfWorkers   int32             `json:"NumberOfWorkers,omitempty"`
+	GlueVersion       string            `json:"GlueVersion,omitempty"`
+}
+
+// BatchStopJobRunInput is the request for BatchStopJobRun.
+type BatchStopJobRunInput struct {
+	JobName   string   `json:"JobName"`
+	JobRunIDs []string `json:"JobRunIds"`
+}
+
+// BatchStopJobRunOutput is the response for BatchStopJobRun.
+type BatchStopJobRunOutput struct {
+	SuccessfulSubmissions []BatchStopJobRunSuccessfulSubmission `json:"SuccessfulSubmissions"`
+	Errors                []BatchStopJobRunError                `json:"Errors"`
+}
+
+// BatchStopJobRunSuccessfulSubmission identifies a job run that was stopped.
+type BatchStopJobRunSuccessfulSubmission struct {
+	JobName  string `json:"JobName"`
+	JobRunID string `json:"JobRunId"`
+}
+
+// BatchStopJobRunError identifies a job run that could not be stopped.
+type BatchStopJobRunError struct {
+	JobName     string       `json:"JobName"`
+	JobRunID    string       `json:"JobRunId"`
+	ErrorDetail *ErrorDetail `json:"ErrorDetail,omitempty"`
+}
+
 // ErrorResponse represents a Glue error response.
 type ErrorResponse struct {
 	Type    string `json:"__type"`
@@ -485,6 +485,40 @@ func (s *Service) ListAccessKeys(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// UpdateAccessKey handles the UpdateAccessKey action.
+func (s *Service) UpdateAccessKey(w http.ResponseWriter, r *http.Request) {
+	userName := getFormValue(r, "UserName")
+	if userName == "" {
+		writeIAMError(w, errInvalidParameter, "UserName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	accessKeyID := getFormValue(r, "AccessKeyId")
+	if accessKeyID == "" {
+		writeIAMError(w, errInvalidParameter, "AccessKeyId is required", http.StatusBadRequest)
+
+		return
+	}
+
+	status := getFormValue(r, "Status")
+	if status != accessKeyActive && status != accessKeyInactive {
+		writeIAMError(w, errInvalidParameter, fmt.Sprintf("Status must be %s or %s", accessKeyActive, accessKeyInactive), http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.UpdateAccessKey(r.Context(), userName, accessKeyID, status); err != nil {
+		handleIAMError(w, err)
+
+		return
+	}
+
+	writeIAMXMLResponse(w, UpdateAccessKeyResponse{
+		ResponseMetadata: ResponseMetadata{RequestID: uuid.New().String()},
+	})
+}
+
 // DispatchAction routes the request to the appropriate handler based on Action parameter.
 func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
 	action := extractAction(r)
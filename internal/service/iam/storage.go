@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -16,9 +17,10 @@ import (
 )
 
 const (
-	defaultPath     = "/"
-	defaultMaxItems = 100
-	accessKeyActive = "Active"
+	defaultPath       = "/"
+	defaultMaxItems   = 100
+	accessKeyActive   = "Active"
+	accessKeyInactive = "Inactive"
 )
 
 // Error codes.
@@ -54,6 +56,8 @@ type Storage interface {
 	CreateAccessKey(ctx context.Context, userName string) (*AccessKey, error)
 	DeleteAccessKey(ctx context.Context, userName, accessKeyID string) error
 	ListAccessKeys(ctx context.Context, userName string, maxItems int) ([]AccessKeyMetadata, error)
+	UpdateAccessKey(ctx context.Context, userName, accessKeyID, status string) error
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -163,6 +167,19 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Users = make(map[string]*User)
+	s.Roles = make(map[string]*Role)
+	s.Policies = make(map[string]*Policy)
+	s.AccessKeys = make(map[string]map[string]*AccessKey)
+
+	return nil
+}
+
 // CreateUser creates a new IAM user.
 func (s *MemoryStorage) CreateUser(_ context.Context, req *CreateUserRequest) (*User, error) {
 	s.mu.Lock()
@@ -737,7 +754,7 @@ func (s *MemoryStorage) ListAccessKeys(_ context.Context, userName string, maxIt
 		maxItems = defaultMaxItems
 	}
 
-	keys := make([]AccessKeyMetadata, 0)
+	keys := make([]AccessKeyMetadata, 0, len(s.AccessKeys[userName]))
 
 	for _, key := range s.AccessKeys[userName] {
 		keys = append(keys, AccessKeyMetadata{
@@ -746,15 +763,49 @@ func (s *MemoryStorage) ListAccessKeys(_ context.Context, userName string, maxIt
 			UserName:    key.UserName,
 			CreateDate:  key.CreateDate,
 		})
+	}
 
-		if len(keys) >= maxItems {
-			break
+	// Oldest first, so rotation tooling sees the key it should retire first.
+	sort.Slice(keys, func(i, j int) bool {
+		if !keys[i].CreateDate.Equal(keys[j].CreateDate) {
+			return keys[i].CreateDate.Before(keys[j].CreateDate)
 		}
+
+		return keys[i].AccessKeyID < keys[j].AccessKeyID
+	})
+
+	if len(keys) > maxItems {
+		keys = keys[:maxItems]
 	}
 
 	return keys, nil
 }
 
+// UpdateAccessKey changes the status of an access key to Active or Inactive.
+func (s *MemoryStorage) UpdateAccessKey(_ context.Context, userName, accessKeyID, status string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.Users[userName]; !exists {
+		return &Error{
+			Code:    errNoSuchEntity,
+			Message: fmt.Sprintf("The user with name %s cannot be found.", userName),
+		}
+	}
+
+	key, exists := s.AccessKeys[userName][accessKeyID]
+	if !exists {
+		return &Error{
+			Code:    errNoSuchEntity,
+			Message: fmt.Sprintf("The Access Key with id %s cannot be found.", accessKeyID),
+		}
+	}
+
+	key.Status = status
+
+	return nil
+}
+
 // generateID generates a unique ID with a prefix.
 func generateID(prefix string) string {
 	return prefix + strings.ToUpper(uuid.New().String()[:17])
@@ -197,6 +197,13 @@ type ListAccessKeysRequest struct {
 	MaxItems int    `xml:"MaxItems"`
 }
 
+// UpdateAccessKeyRequest represents an UpdateAccessKey request.
+type UpdateAccessKeyRequest struct {
+	UserName    string `xml:"UserName"`
+	AccessKeyID string `xml:"AccessKeyId"`
+	Status      string `xml:"Status"`
+}
+
 // Response types.
 
 // CreateUserResponse represents a CreateUser response.
@@ -355,6 +362,11 @@ type DeleteAccessKeyResponse struct {
 	ResponseMetadata ResponseMetadata `xml:"ResponseMetadata"`
 }
 
+// UpdateAccessKeyResponse represents an UpdateAccessKey response.
+type UpdateAccessKeyResponse struct {
+	ResponseMetadata ResponseMetadata `xml:"ResponseMetadata"`
+}
+
 // ListAccessKeysResponse represents a ListAccessKeys response.
 type ListAccessKeysResponse struct {
 	ListAccessKeysResult ListAccessKeysResult `xml:"ListAccessKeysResult"`
@@ -2,6 +2,7 @@
 package service
 
 import (
+	"context"
 	"net/http"
 )
 
@@ -12,6 +13,26 @@ type Service interface {
 
 	// RegisterRoutes registers the service's routes with the router.
 	RegisterRoutes(r Router)
+
+	// Reset clears all state held by the service, as if it had just started.
+	Reset(ctx context.Context) error
+}
+
+// Snapshotter is an optional interface for services whose state can be saved and restored.
+// The server uses it to expose the state of every service through the /kumo/state endpoint.
+// Use Snapshot and Restore to save and restore the state.
+type Snapshotter interface {
+	// StateStorage returns the storage holding the service state.
+	StateStorage() StateStorage
+}
+
+// ResourceNamer is an optional interface for services whose requests name the resources
+// they act on, such as the tables of a DynamoDB request. The server uses it to match
+// fault rules restricted to a resource.
+type ResourceNamer interface {
+	// RequestResources returns the names of the resources the request acts on.
+	// It must leave the request body in place for the handler.
+	RequestResources(r *http.Request) []string
 }
 
 // Router is the interface for registering HTTP routes.
@@ -38,6 +38,7 @@ type Storage interface {
 	ListClusters(ctx context.Context, maxResults int, nextToken string) ([]ClusterInfo, string, error)
 	GetBootstrapBrokers(ctx context.Context, clusterArn string) (*GetBootstrapBrokersResponse, error)
 	UpdateClusterConfiguration(ctx context.Context, clusterArn string, req *UpdateClusterConfigurationRequest) (*UpdateClusterConfigurationResponse, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -131,6 +132,16 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Clusters = make(map[string]*ClusterInfo)
+
+	return nil
+}
+
 // CreateCluster creates a new MSK cluster.
 func (s *MemoryStorage) CreateCluster(_ context.Context, req *CreateClusterRequest) (*CreateClusterResponse, error) {
 	s.mu.Lock()
@@ -16,15 +16,18 @@ type handlerFunc func(http.ResponseWriter, *http.Request)
 // getActionHandlers returns a map of action names to handler functions.
 func (s *Service) getActionHandlers() map[string]handlerFunc {
 	return map[string]handlerFunc{
-		"CreateStream":     s.CreateStream,
-		"DeleteStream":     s.DeleteStream,
-		"DescribeStream":   s.DescribeStream,
-		"ListStreams":      s.ListStreams,
-		"ListShards":       s.ListShards,
-		"PutRecord":        s.PutRecord,
-		"PutRecords":       s.PutRecords,
-		"GetShardIterator": s.GetShardIterator,
-		"GetRecords":       s.GetRecords,
+		"CreateStream":          s.CreateStream,
+		"DeleteStream":          s.DeleteStream,
+		"DescribeStream":        s.DescribeStream,
+		"DescribeStreamSummary": s.DescribeStreamSummary,
+		"ListStreams":           s.ListStreams,
+		"ListShards":            s.ListShards,
+		"PutRecord":             s.PutRecord,
+		"PutRecords":            s.PutRecords,
+		"GetShardIterator":      s.GetShardIterator,
+		"GetRecords":            s.GetRecords,
+		"SplitShard":            s.SplitShard,
+		"MergeShards":           s.MergeShards,
 	}
 }
 
@@ -144,6 +147,49 @@ func (s *Service) DescribeStream(w http.ResponseWriter, r *http.Request) {
 	writeResponse(w, resp)
 }
 
+// DescribeStreamSummary handles the DescribeStreamSummary API.
+func (s *Service) DescribeStreamSummary(w http.ResponseWriter, r *http.Request) {
+	var req DescribeStreamSummaryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	streamName := req.StreamName
+	if streamName == "" && req.StreamARN != "" {
+		parts := strings.Split(req.StreamARN, "/")
+		if len(parts) >= 2 {
+			streamName = parts[len(parts)-1]
+		}
+	}
+
+	stream, err := s.storage.DescribeStreamSummary(r.Context(), streamName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	resp := &DescribeStreamSummaryResponse{
+		StreamDescriptionSummary: StreamDescriptionSummary{
+			StreamName:              stream.StreamName,
+			StreamARN:               stream.StreamARN,
+			StreamStatus:            string(stream.StreamStatus),
+			StreamModeDetails:       stream.StreamModeDetails,
+			RetentionPeriodHours:    stream.RetentionPeriodHours,
+			StreamCreationTimestamp: float64(stream.StreamCreationTimestamp.Unix()),
+			EnhancedMonitoring:      stream.EnhancedMonitoring,
+			EncryptionType:          stream.EncryptionType,
+			KeyID:                   stream.KeyID,
+			OpenShardCount:          stream.OpenShardCount,
+			ConsumerCount:           stream.ConsumerCount,
+		},
+	}
+
+	writeResponse(w, resp)
+}
+
 // ListStreams handles the ListStreams API.
 func (s *Service) ListStreams(w http.ResponseWriter, r *http.Request) {
 	var req ListStreamsRequest
@@ -342,7 +388,7 @@ func (s *Service) GetRecords(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	records, nextIterator, millisBehind, err := s.storage.GetRecords(r.Context(), req.ShardIterator, req.Limit)
+	records, nextIterator, millisBehind, children, err := s.storage.GetRecords(r.Context(), req.ShardIterator, req.Limit)
 	if err != nil {
 		handleError(w, err)
 
@@ -364,11 +410,64 @@ func (s *Service) GetRecords(w http.ResponseWriter, r *http.Request) {
 		Records:            recordOutputs,
 		NextShardIterator:  nextIterator,
 		MillisBehindLatest: millisBehind,
+		ChildShards:        children,
 	}
 
 	writeResponse(w, resp)
 }
 
+// SplitShard handles the SplitShard API.
+func (s *Service) SplitShard(w http.ResponseWriter, r *http.Request) {
+	var req SplitShardRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	streamName := req.StreamName
+	if streamName == "" && req.StreamARN != "" {
+		parts := strings.Split(req.StreamARN, "/")
+		if len(parts) >= 2 {
+			streamName = parts[len(parts)-1]
+		}
+	}
+
+	if err := s.storage.SplitShard(r.Context(), streamName, req.ShardToSplit, req.NewStartingHashKey); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &SplitShardResponse{})
+}
+
+// MergeShards handles the MergeShards API.
+func (s *Service) MergeShards(w http.ResponseWriter, r *http.Request) {
+	var req MergeShardsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	streamName := req.StreamName
+	if streamName == "" && req.StreamARN != "" {
+		parts := strings.Split(req.StreamARN, "/")
+		if len(parts) >= 2 {
+			streamName = parts[len(parts)-1]
+		}
+	}
+
+	if err := s.storage.MergeShards(r.Context(), streamName, req.ShardToMerge, req.AdjacentShardToMerge); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeResponse(w, &MergeShardsResponse{})
+}
+
 // writeResponse writes a JSON response.
 func writeResponse(w http.ResponseWriter, resp any) {
 	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
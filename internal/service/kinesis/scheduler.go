@@ -0,0 +1,64 @@
+package kinesis
+
+import "time"
+
+// defaultStreamTransitionDelay is how long a stream stays CREATING, UPDATING or DELETING before the scheduler advances it.
+const defaultStreamTransitionDelay = 500 * time.Millisecond
+
+// WithStreamTransitionDelay sets how long a stream stays CREATING, UPDATING or DELETING before the scheduler advances it.
+func WithStreamTransitionDelay(d time.Duration) Option {
+	return func(s *MemoryStorage) {
+		s.transitionDelay = d
+	}
+}
+
+// streamScheduler periodically advances streams through their lifecycle.
+func (s *MemoryStorage) streamScheduler() {
+	ticker := time.NewTicker(max(s.transitionDelay/5, 10*time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopScheduler:
+			return
+		case now := <-ticker.C:
+			s.advanceStreams(now)
+		}
+	}
+}
+
+// advanceStreams activates the streams that have been CREATING or UPDATING
+// for the transition delay and removes the streams that have been DELETING as long.
+func (s *MemoryStorage) advanceStreams(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name, sd := range s.Streams {
+		status := sd.Stream.StreamStatus
+		if status == StreamStatusActive {
+			continue
+		}
+
+		changed, ok := s.transitions[name]
+		if !ok {
+			// Streams restored from disk start their timer on the first tick.
+			s.transitions[name] = now
+
+			continue
+		}
+
+		if now.Sub(changed) < s.transitionDelay {
+			continue
+		}
+
+		delete(s.transitions, name)
+
+		if status == StreamStatusDeleting {
+			delete(s.Streams, name)
+
+			continue
+		}
+
+		sd.Stream.StreamStatus = StreamStatusActive
+	}
+}
@@ -9,10 +9,12 @@ import (
 	"fmt"
 	"math/big"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/sivchari/kumo/internal/service"
 	"github.com/sivchari/kumo/internal/storage"
 )
 
@@ -38,6 +40,7 @@ type Storage interface {
 	CreateStream(ctx context.Context, req *CreateStreamRequest) error
 	DeleteStream(ctx context.Context, streamName string) error
 	DescribeStream(ctx context.Context, streamName string, limit int32, exclusiveStartShardID string) (*Stream, []*Shard, bool, error)
+	DescribeStreamSummary(ctx context.Context, streamName string) (*Stream, error)
 	ListStreams(ctx context.Context, exclusiveStartStreamName string, limit int32) ([]*Stream, bool, error)
 	ListShards(ctx context.Context, streamName string, nextToken string, maxResults int32) ([]*Shard, string, error)
 
@@ -45,10 +48,15 @@ type Storage interface {
 	PutRecord(ctx context.Context, streamName string, data []byte, partitionKey string, explicitHashKey string) (string, string, error)
 	PutRecords(ctx context.Context, streamName string, records []PutRecordsRequestEntry) ([]PutRecordsResultEntry, int32, error)
 	GetShardIterator(ctx context.Context, streamName, shardID, iteratorType string, startingSeqNum string, timestamp float64) (string, error)
-	GetRecords(ctx context.Context, shardIterator string, limit int32) ([]*Record, string, int64, error)
+	GetRecords(ctx context.Context, shardIterator string, limit int32) ([]*Record, string, int64, []ChildShard, error)
+
+	// Resharding operations.
+	SplitShard(ctx context.Context, streamName, shardToSplit, newStartingHashKey string) error
+	MergeShards(ctx context.Context, streamName, shardToMerge, adjacentShardToMerge string) error
 
 	// DispatchAction dispatches the request to the appropriate handler.
 	DispatchAction(action string) bool
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -72,10 +80,12 @@ type MemoryStorage struct {
 	mu              sync.RWMutex                  `json:"-"`
 	Streams         map[string]*StreamData        `json:"streams"`
 	shardIterators  map[string]*shardIteratorData `json:"-"`
-	region          string
-	accountID       string
-	SequenceCounter uint64 `json:"sequenceCounter"`
+	SequenceCounter uint64                        `json:"sequenceCounter"`
 	dataDir         string
+	transitionDelay time.Duration
+	// transitions records when each CREATING, UPDATING or DELETING stream entered that status.
+	transitions   map[string]time.Time
+	stopScheduler chan struct{}
 }
 
 // StreamData holds stream information and its shards.
@@ -101,10 +111,11 @@ type shardIteratorData struct {
 // NewMemoryStorage creates a new in-memory storage.
 func NewMemoryStorage(opts ...Option) *MemoryStorage {
 	s := &MemoryStorage{
-		Streams:        make(map[string]*StreamData),
-		shardIterators: make(map[string]*shardIteratorData),
-		region:         "us-east-1",
-		accountID:      "000000000000",
+		Streams:         make(map[string]*StreamData),
+		shardIterators:  make(map[string]*shardIteratorData),
+		transitionDelay: defaultStreamTransitionDelay,
+		transitions:     make(map[string]time.Time),
+		stopScheduler:   make(chan struct{}),
 	}
 	for _, o := range opts {
 		o(s)
@@ -114,6 +125,8 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		_ = storage.Load(s.dataDir, "kinesis", s)
 	}
 
+	go s.streamScheduler()
+
 	return s
 }
 
@@ -158,6 +171,8 @@ func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
 
 // Close saves the storage state to disk if persistence is enabled.
 func (s *MemoryStorage) Close() error {
+	close(s.stopScheduler)
+
 	if s.dataDir == "" {
 		return nil
 	}
@@ -169,8 +184,21 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Streams = make(map[string]*StreamData)
+	s.shardIterators = make(map[string]*shardIteratorData)
+	s.transitions = make(map[string]time.Time)
+	s.SequenceCounter = 0
+
+	return nil
+}
+
 // CreateStream creates a new stream.
-func (s *MemoryStorage) CreateStream(_ context.Context, req *CreateStreamRequest) error {
+func (s *MemoryStorage) CreateStream(ctx context.Context, req *CreateStreamRequest) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -186,8 +214,8 @@ func (s *MemoryStorage) CreateStream(_ context.Context, req *CreateStreamRequest
 	now := time.Now()
 	stream := &Stream{
 		StreamName:              req.StreamName,
-		StreamARN:               fmt.Sprintf("arn:aws:kinesis:%s:%s:stream/%s", s.region, s.accountID, req.StreamName),
-		StreamStatus:            StreamStatusActive,
+		StreamARN:               fmt.Sprintf("arn:aws:kinesis:%s:%s:stream/%s", service.Region(ctx), service.AccountID(ctx), req.StreamName),
+		StreamStatus:            StreamStatusCreating,
 		ShardCount:              shardCount,
 		RetentionPeriodHours:    defaultRetentionHours,
 		StreamCreationTimestamp: now,
@@ -226,20 +254,25 @@ func (s *MemoryStorage) CreateStream(_ context.Context, req *CreateStreamRequest
 		Stream: stream,
 		Shards: shards,
 	}
+	s.transitions[req.StreamName] = now
 
 	return nil
 }
 
-// DeleteStream deletes a stream.
+// DeleteStream marks a stream DELETING; the scheduler removes it after the transition delay.
 func (s *MemoryStorage) DeleteStream(_ context.Context, streamName string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if _, exists := s.Streams[streamName]; !exists {
+	sd, exists := s.Streams[streamName]
+	if !exists {
 		return &ServiceError{Code: errResourceNotFound, Message: "Stream not found"}
 	}
 
-	delete(s.Streams, streamName)
+	if sd.Stream.StreamStatus != StreamStatusDeleting {
+		sd.Stream.StreamStatus = StreamStatusDeleting
+		s.transitions[streamName] = time.Now()
+	}
 
 	return nil
 }
@@ -290,7 +323,24 @@ func (s *MemoryStorage) DescribeStream(_ context.Context, streamName string, lim
 		hasMoreShards = true
 	}
 
-	return sd.Stream, shards[startIndex:endIndex], hasMoreShards, nil
+	stream := *sd.Stream
+
+	return &stream, shards[startIndex:endIndex], hasMoreShards, nil
+}
+
+// DescribeStreamSummary describes a stream without its shards.
+func (s *MemoryStorage) DescribeStreamSummary(_ context.Context, streamName string) (*Stream, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sd, exists := s.Streams[streamName]
+	if !exists {
+		return nil, &ServiceError{Code: errResourceNotFound, Message: "Stream not found"}
+	}
+
+	stream := *sd.Stream
+
+	return &stream, nil
 }
 
 // ListStreams lists all streams.
@@ -334,7 +384,8 @@ func (s *MemoryStorage) ListStreams(_ context.Context, exclusiveStartStreamName
 
 	streams := make([]*Stream, endIndex-startIndex)
 	for i, name := range names[startIndex:endIndex] {
-		streams[i] = s.Streams[name].Stream
+		stream := *s.Streams[name].Stream
+		streams[i] = &stream
 	}
 
 	return streams, hasMoreStreams, nil
@@ -381,6 +432,10 @@ func (s *MemoryStorage) PutRecord(_ context.Context, streamName string, data []b
 		return "", "", &ServiceError{Code: errResourceNotFound, Message: "Stream not found"}
 	}
 
+	if err := s.checkStreamActive(sd.Stream); err != nil {
+		return "", "", err
+	}
+
 	// Determine shard based on hash key.
 	hashKey := explicitHashKey
 	if hashKey == "" {
@@ -415,6 +470,10 @@ func (s *MemoryStorage) PutRecords(_ context.Context, streamName string, records
 		return nil, 0, &ServiceError{Code: errResourceNotFound, Message: "Stream not found"}
 	}
 
+	if err := s.checkStreamActive(sd.Stream); err != nil {
+		return nil, 0, err
+	}
+
 	results := make([]PutRecordsResultEntry, len(records))
 
 	for i, entry := range records {
@@ -470,6 +529,10 @@ func (s *MemoryStorage) GetShardIterator(_ context.Context, streamName, shardID,
 		return "", &ServiceError{Code: errResourceNotFound, Message: "Stream not found"}
 	}
 
+	if err := s.checkStreamActive(sd.Stream); err != nil {
+		return "", err
+	}
+
 	shardData, exists := sd.Shards[shardID]
 	if !exists {
 		return "", &ServiceError{Code: errResourceNotFound, Message: "Shard not found"}
@@ -504,29 +567,29 @@ func (s *MemoryStorage) GetShardIterator(_ context.Context, streamName, shardID,
 }
 
 // GetRecords gets records from a shard.
-func (s *MemoryStorage) GetRecords(_ context.Context, shardIterator string, limit int32) ([]*Record, string, int64, error) {
+func (s *MemoryStorage) GetRecords(_ context.Context, shardIterator string, limit int32) ([]*Record, string, int64, []ChildShard, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	iterData, exists := s.shardIterators[shardIterator]
 	if !exists {
-		return nil, "", 0, &ServiceError{Code: errInvalidArgument, Message: "Invalid shard iterator"}
+		return nil, "", 0, nil, &ServiceError{Code: errInvalidArgument, Message: "Invalid shard iterator"}
 	}
 
 	if time.Now().After(iterData.expiresAt) {
 		delete(s.shardIterators, shardIterator)
 
-		return nil, "", 0, &ServiceError{Code: errExpiredIterator, Message: "Shard iterator has expired"}
+		return nil, "", 0, nil, &ServiceError{Code: errExpiredIterator, Message: "Shard iterator has expired"}
 	}
 
 	sd, exists := s.Streams[iterData.streamName]
 	if !exists {
-		return nil, "", 0, &ServiceError{Code: errResourceNotFound, Message: "Stream not found"}
+		return nil, "", 0, nil, &ServiceError{Code: errResourceNotFound, Message: "Stream not found"}
 	}
 
 	shardData, exists := sd.Shards[iterData.shardID]
 	if !exists {
-		return nil, "", 0, &ServiceError{Code: errResourceNotFound, Message: "Shard not found"}
+		return nil, "", 0, nil, &ServiceError{Code: errResourceNotFound, Message: "Shard not found"}
 	}
 
 	if limit <= 0 || limit > maxRecordsPerGet {
@@ -539,9 +602,16 @@ func (s *MemoryStorage) GetRecords(_ context.Context, shardIterator string, limi
 	records := make([]*Record, endPos-startPos)
 	copy(records, shardData.Records[startPos:endPos])
 
-	// Create next iterator.
 	delete(s.shardIterators, shardIterator)
 
+	// A closed shard that has been read to the end has no next iterator;
+	// consumers continue with its child shards instead.
+	if shardData.Shard.SequenceNumberRange.EndingSequenceNumber != nil && endPos == len(shardData.Records) {
+		return records, "", 0, childShards(sd, iterData.shardID), nil
+	}
+
+	// Create next iterator.
+
 	nextIteratorID := fmt.Sprintf("%s:%s:%d:%d", iterData.streamName, iterData.shardID, endPos, time.Now().UnixNano())
 	nextIterator := base64.StdEncoding.EncodeToString([]byte(nextIteratorID))
 
@@ -552,7 +622,109 @@ func (s *MemoryStorage) GetRecords(_ context.Context, shardIterator string, limi
 		expiresAt:  time.Now().Add(shardIteratorExpiration),
 	}
 
-	return records, nextIterator, 0, nil
+	return records, nextIterator, 0, nil, nil
+}
+
+// SplitShard splits an open shard into two child shards at newStartingHashKey.
+func (s *MemoryStorage) SplitShard(_ context.Context, streamName, shardToSplit, newStartingHashKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sd, err := s.reshardableStream(streamName)
+	if err != nil {
+		return err
+	}
+
+	parent, err := openShard(sd, shardToSplit)
+	if err != nil {
+		return err
+	}
+
+	splitKey, ok := new(big.Int).SetString(newStartingHashKey, 10)
+	if !ok {
+		return &ServiceError{Code: errInvalidArgument, Message: "NewStartingHashKey must be a decimal integer"}
+	}
+
+	startKey, _ := new(big.Int).SetString(parent.HashKeyRange.StartingHashKey, 10)
+	endKey, _ := new(big.Int).SetString(parent.HashKeyRange.EndingHashKey, 10)
+
+	if splitKey.Cmp(startKey) <= 0 || splitKey.Cmp(endKey) > 0 {
+		return &ServiceError{
+			Code: errInvalidArgument,
+			Message: fmt.Sprintf("NewStartingHashKey %s is outside the hash key range %s-%s of shard %s",
+				newStartingHashKey, parent.HashKeyRange.StartingHashKey, parent.HashKeyRange.EndingHashKey, shardToSplit),
+		}
+	}
+
+	s.closeShard(parent)
+
+	lowerEnd := new(big.Int).Sub(splitKey, big.NewInt(1))
+
+	s.addChildShard(sd, shardToSplit, "", HashKeyRange{
+		StartingHashKey: parent.HashKeyRange.StartingHashKey,
+		EndingHashKey:   lowerEnd.String(),
+	})
+	s.addChildShard(sd, shardToSplit, "", HashKeyRange{
+		StartingHashKey: splitKey.String(),
+		EndingHashKey:   parent.HashKeyRange.EndingHashKey,
+	})
+
+	sd.Stream.OpenShardCount++
+	sd.Stream.ShardCount = sd.Stream.OpenShardCount
+	s.startUpdate(sd)
+
+	return nil
+}
+
+// MergeShards merges two adjacent open shards into one child shard.
+func (s *MemoryStorage) MergeShards(_ context.Context, streamName, shardToMerge, adjacentShardToMerge string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sd, err := s.reshardableStream(streamName)
+	if err != nil {
+		return err
+	}
+
+	parent, err := openShard(sd, shardToMerge)
+	if err != nil {
+		return err
+	}
+
+	adjacent, err := openShard(sd, adjacentShardToMerge)
+	if err != nil {
+		return err
+	}
+
+	lower, upper := parent, adjacent
+	if hashKeyLess(upper.HashKeyRange.StartingHashKey, lower.HashKeyRange.StartingHashKey) {
+		lower, upper = upper, lower
+	}
+
+	lowerEnd, _ := new(big.Int).SetString(lower.HashKeyRange.EndingHashKey, 10)
+	upperStart, _ := new(big.Int).SetString(upper.HashKeyRange.StartingHashKey, 10)
+
+	if new(big.Int).Add(lowerEnd, big.NewInt(1)).Cmp(upperStart) != 0 {
+		return &ServiceError{
+			Code: errInvalidArgument,
+			Message: fmt.Sprintf("Shards %s and %s in stream %s under account %s are not an adjacent pair of shards eligible for merging",
+				shardToMerge, adjacentShardToMerge, streamName, streamAccountID(sd.Stream)),
+		}
+	}
+
+	s.closeShard(parent)
+	s.closeShard(adjacent)
+
+	s.addChildShard(sd, shardToMerge, adjacentShardToMerge, HashKeyRange{
+		StartingHashKey: lower.HashKeyRange.StartingHashKey,
+		EndingHashKey:   upper.HashKeyRange.EndingHashKey,
+	})
+
+	sd.Stream.OpenShardCount--
+	sd.Stream.ShardCount = sd.Stream.OpenShardCount
+	s.startUpdate(sd)
+
+	return nil
 }
 
 // DispatchAction checks if the action is valid.
@@ -562,6 +734,133 @@ func (s *MemoryStorage) DispatchAction(_ string) bool {
 
 // Helper functions.
 
+// reshardableStream returns a stream that can be split or merged: it must
+// exist and be ACTIVE, since Kinesis runs one resharding at a time.
+func (s *MemoryStorage) reshardableStream(streamName string) (*StreamData, error) {
+	sd, exists := s.Streams[streamName]
+	if !exists {
+		return nil, &ServiceError{Code: errResourceNotFound, Message: "Stream not found"}
+	}
+
+	if sd.Stream.StreamStatus != StreamStatusActive {
+		return nil, &ServiceError{
+			Code: errResourceInUse,
+			Message: fmt.Sprintf("Stream %s under account %s not ACTIVE, instead in state %s",
+				streamName, streamAccountID(sd.Stream), sd.Stream.StreamStatus),
+		}
+	}
+
+	return sd, nil
+}
+
+// openShard returns a shard of the stream that has not been closed by resharding.
+func openShard(sd *StreamData, shardID string) (*Shard, error) {
+	shardData, exists := sd.Shards[shardID]
+	if !exists {
+		return nil, &ServiceError{Code: errResourceNotFound, Message: "Shard not found"}
+	}
+
+	if shardData.Shard.SequenceNumberRange.EndingSequenceNumber != nil {
+		return nil, &ServiceError{
+			Code:    errResourceInUse,
+			Message: fmt.Sprintf("Shard %s in stream %s is not open", shardID, sd.Stream.StreamName),
+		}
+	}
+
+	return shardData.Shard, nil
+}
+
+// closeShard ends the shard's sequence number range so it accepts no more records.
+func (s *MemoryStorage) closeShard(shard *Shard) {
+	seqNum := s.nextSequenceNumber()
+	shard.SequenceNumberRange.EndingSequenceNumber = &seqNum
+}
+
+// addChildShard adds an open shard created by resharding the given parents.
+func (s *MemoryStorage) addChildShard(sd *StreamData, parentShardID, adjacentParentShardID string, hashKeyRange HashKeyRange) {
+	shardID := fmt.Sprintf("shardId-%012d", len(sd.Shards))
+
+	sd.Shards[shardID] = &ShardData{
+		Shard: &Shard{
+			ShardID:               shardID,
+			ParentShardID:         parentShardID,
+			AdjacentParentShardID: adjacentParentShardID,
+			HashKeyRange:          hashKeyRange,
+			SequenceNumberRange: SequenceNumberRange{
+				StartingSequenceNumber: s.nextSequenceNumber(),
+			},
+		},
+		Records: make([]*Record, 0),
+	}
+}
+
+// startUpdate marks the stream UPDATING; the scheduler makes it ACTIVE again after the transition delay.
+func (s *MemoryStorage) startUpdate(sd *StreamData) {
+	sd.Stream.StreamStatus = StreamStatusUpdating
+	s.transitions[sd.Stream.StreamName] = time.Now()
+}
+
+// childShards returns the shards created by resharding the given parent shard.
+func childShards(sd *StreamData, parentShardID string) []ChildShard {
+	var children []ChildShard
+
+	for _, shardData := range sd.Shards {
+		shard := shardData.Shard
+		if shard.ParentShardID != parentShardID && shard.AdjacentParentShardID != parentShardID {
+			continue
+		}
+
+		parents := []string{shard.ParentShardID}
+		if shard.AdjacentParentShardID != "" {
+			parents = append(parents, shard.AdjacentParentShardID)
+		}
+
+		children = append(children, ChildShard{
+			ShardID:      shard.ShardID,
+			ParentShards: parents,
+			HashKeyRange: shard.HashKeyRange,
+		})
+	}
+
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].ShardID < children[j].ShardID
+	})
+
+	return children
+}
+
+// hashKeyLess reports whether hash key a is smaller than hash key b.
+func hashKeyLess(a, b string) bool {
+	aKey, _ := new(big.Int).SetString(a, 10)
+	bKey, _ := new(big.Int).SetString(b, 10)
+
+	return aKey.Cmp(bKey) < 0
+}
+
+// streamAccountID returns the account that owns a stream, taken from its ARN.
+func streamAccountID(stream *Stream) string {
+	parts := strings.SplitN(stream.StreamARN, ":", 6)
+	if len(parts) < 6 || parts[4] == "" {
+		return service.DefaultAccountID
+	}
+
+	return parts[4]
+}
+
+// checkStreamActive returns the error Kinesis reports for writing to or
+// reading from a stream that is not ACTIVE.
+func (s *MemoryStorage) checkStreamActive(stream *Stream) error {
+	if stream.StreamStatus == StreamStatusActive || stream.StreamStatus == StreamStatusUpdating {
+		return nil
+	}
+
+	return &ServiceError{
+		Code: errResourceInUse,
+		Message: fmt.Sprintf("Stream %s under account %s not ACTIVE, instead in state %s",
+			stream.StreamName, streamAccountID(stream), stream.StreamStatus),
+	}
+}
+
 func (s *MemoryStorage) nextSequenceNumber() string {
 	seq := atomic.AddUint64(&s.SequenceCounter, 1)
 
@@ -573,6 +872,11 @@ func (s *MemoryStorage) findShardForHashKey(sd *StreamData, hashKey string) stri
 	hashKeyBig.SetString(hashKey, 10)
 
 	for shardID, shardData := range sd.Shards {
+		// Closed parent shards no longer accept records.
+		if shardData.Shard.SequenceNumberRange.EndingSequenceNumber != nil {
+			continue
+		}
+
 		startKey := new(big.Int)
 		endKey := new(big.Int)
 
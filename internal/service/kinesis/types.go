@@ -132,6 +132,32 @@ type StreamDescription struct {
 	ConsumerCount           int32              `json:"ConsumerCount,omitempty"`
 }
 
+// DescribeStreamSummaryRequest is the request for DescribeStreamSummary.
+type DescribeStreamSummaryRequest struct {
+	StreamName string `json:"StreamName,omitempty"`
+	StreamARN  string `json:"StreamARN,omitempty"`
+}
+
+// DescribeStreamSummaryResponse is the response for DescribeStreamSummary.
+type DescribeStreamSummaryResponse struct {
+	StreamDescriptionSummary StreamDescriptionSummary `json:"StreamDescriptionSummary"`
+}
+
+// StreamDescriptionSummary contains stream details without the shard list.
+type StreamDescriptionSummary struct {
+	StreamName              string             `json:"StreamName"`
+	StreamARN               string             `json:"StreamARN"`
+	StreamStatus            string             `json:"StreamStatus"`
+	StreamModeDetails       *StreamModeDetails `json:"StreamModeDetails,omitempty"`
+	RetentionPeriodHours    int32              `json:"RetentionPeriodHours"`
+	StreamCreationTimestamp float64            `json:"StreamCreationTimestamp"`
+	EnhancedMonitoring      []EnhancedMetrics  `json:"EnhancedMonitoring"`
+	EncryptionType          string             `json:"EncryptionType,omitempty"`
+	KeyID                   string             `json:"KeyId,omitempty"`
+	OpenShardCount          int32              `json:"OpenShardCount"`
+	ConsumerCount           int32              `json:"ConsumerCount"`
+}
+
 // ShardOutput is the output representation of a shard.
 type ShardOutput struct {
 	ShardID               string              `json:"ShardId"`
@@ -265,6 +291,28 @@ type GetRecordsResponse struct {
 	ChildShards        []ChildShard   `json:"ChildShards,omitempty"`
 }
 
+// SplitShardRequest is the request for SplitShard.
+type SplitShardRequest struct {
+	StreamName         string `json:"StreamName,omitempty"`
+	StreamARN          string `json:"StreamARN,omitempty"`
+	ShardToSplit       string `json:"ShardToSplit"`
+	NewStartingHashKey string `json:"NewStartingHashKey"`
+}
+
+// SplitShardResponse is the response for SplitShard.
+type SplitShardResponse struct{}
+
+// MergeShardsRequest is the request for MergeShards.
+type MergeShardsRequest struct {
+	StreamName           string `json:"StreamName,omitempty"`
+	StreamARN            string `json:"StreamARN,omitempty"`
+	ShardToMerge         string `json:"ShardToMerge"`
+	AdjacentShardToMerge string `json:"AdjacentShardToMerge"`
+}
+
+// MergeShardsResponse is the response for MergeShards.
+type MergeShardsResponse struct{}
+
 // RecordOutput is the output representation of a record.
 type RecordOutput struct {
 	Data                        string  `json:"Data"`
@@ -8,6 +8,8 @@ import (
 	"strings"
 
 	"github.com/google/uuid"
+
+	"github.com/sivchari/kumo/internal/service"
 )
 
 // handlerFunc is a type alias for handler functions.
@@ -22,12 +24,17 @@ func (s *Service) getActionHandlers() map[string]handlerFunc {
 		"EnableKey":           s.EnableKey,
 		"DisableKey":          s.DisableKey,
 		"ScheduleKeyDeletion": s.ScheduleKeyDeletion,
+		"CancelKeyDeletion":   s.CancelKeyDeletion,
 		"Encrypt":             s.Encrypt,
 		"Decrypt":             s.Decrypt,
 		"GenerateDataKey":     s.GenerateDataKey,
 		"CreateAlias":         s.CreateAlias,
 		"DeleteAlias":         s.DeleteAlias,
 		"ListAliases":         s.ListAliases,
+		"CreateGrant":         s.CreateGrant,
+		"ListGrants":          s.ListGrants,
+		"RevokeGrant":         s.RevokeGrant,
+		"RetireGrant":         s.RetireGrant,
 	}
 }
 
@@ -187,6 +194,25 @@ func (s *Service) ScheduleKeyDeletion(w http.ResponseWriter, r *http.Request) {
 	writeKMSResponse(w, resp)
 }
 
+// CancelKeyDeletion handles the CancelKeyDeletion API.
+func (s *Service) CancelKeyDeletion(w http.ResponseWriter, r *http.Request) {
+	var req CancelKeyDeletionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeKMSError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	key, err := s.storage.CancelKeyDeletion(r.Context(), req.KeyID)
+	if err != nil {
+		handleKMSError(w, err)
+
+		return
+	}
+
+	writeKMSResponse(w, &CancelKeyDeletionResponse{KeyID: key.Arn})
+}
+
 // Encrypt handles the Encrypt API.
 func (s *Service) Encrypt(w http.ResponseWriter, r *http.Request) {
 	var req EncryptRequest
@@ -355,10 +381,110 @@ func (s *Service) ListAliases(w http.ResponseWriter, r *http.Request) {
 	writeKMSResponse(w, resp)
 }
 
+// CreateGrant handles the CreateGrant API.
+func (s *Service) CreateGrant(w http.ResponseWriter, r *http.Request) {
+	var req CreateGrantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeKMSError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	grant, err := s.storage.CreateGrant(r.Context(), &req)
+	if err != nil {
+		handleKMSError(w, err)
+
+		return
+	}
+
+	resp := &CreateGrantResponse{
+		GrantID:    grant.GrantID,
+		GrantToken: grant.GrantToken,
+	}
+
+	writeKMSResponse(w, resp)
+}
+
+// ListGrants handles the ListGrants API.
+func (s *Service) ListGrants(w http.ResponseWriter, r *http.Request) {
+	var req ListGrantsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeKMSError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	grants, nextMarker, err := s.storage.ListGrants(r.Context(), &req)
+	if err != nil {
+		handleKMSError(w, err)
+
+		return
+	}
+
+	grantEntries := make([]GrantListEntry, 0, len(grants))
+	for _, grant := range grants {
+		grantEntries = append(grantEntries, GrantListEntry{
+			KeyID:             grant.KeyArn,
+			GrantID:           grant.GrantID,
+			Name:              grant.Name,
+			CreationDate:      float64(grant.CreationDate.Unix()),
+			GranteePrincipal:  grant.GranteePrincipal,
+			RetiringPrincipal: grant.RetiringPrincipal,
+			IssuingAccount:    grant.IssuingAccount,
+			Operations:        grant.Operations,
+			Constraints:       grant.Constraints,
+		})
+	}
+
+	resp := &ListGrantsResponse{
+		Grants:     grantEntries,
+		NextMarker: nextMarker,
+		Truncated:  nextMarker != "",
+	}
+
+	writeKMSResponse(w, resp)
+}
+
+// RevokeGrant handles the RevokeGrant API.
+func (s *Service) RevokeGrant(w http.ResponseWriter, r *http.Request) {
+	var req RevokeGrantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeKMSError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.RevokeGrant(r.Context(), req.KeyID, req.GrantID); err != nil {
+		handleKMSError(w, err)
+
+		return
+	}
+
+	writeKMSResponse(w, &RevokeGrantResponse{})
+}
+
+// RetireGrant handles the RetireGrant API.
+func (s *Service) RetireGrant(w http.ResponseWriter, r *http.Request) {
+	var req RetireGrantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeKMSError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.RetireGrant(r.Context(), req.GrantToken, req.KeyID, req.GrantID); err != nil {
+		handleKMSError(w, err)
+
+		return
+	}
+
+	writeKMSResponse(w, &RetireGrantResponse{})
+}
+
 // keyToMetadata converts a Key to KeyMetadata.
 func keyToMetadata(key *Key) *KeyMetadata {
 	metadata := &KeyMetadata{
-		AWSAccountID: defaultAccountID,
+		AWSAccountID: keyAccountID(key),
 		KeyID:        key.KeyID,
 		Arn:          key.Arn,
 		CreationDate: float64(key.CreationDate.Unix()),
@@ -385,6 +511,16 @@ func keyToMetadata(key *Key) *KeyMetadata {
 	return metadata
 }
 
+// keyAccountID returns the account that owns a key, taken from its ARN.
+func keyAccountID(key *Key) string {
+	parts := strings.SplitN(key.Arn, ":", 6)
+	if len(parts) < 6 || parts[4] == "" {
+		return service.DefaultAccountID
+	}
+
+	return parts[4]
+}
+
 // writeKMSResponse writes a JSON response.
 func writeKMSResponse(w http.ResponseWriter, resp any) {
 	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
@@ -5,22 +5,22 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/sivchari/kumo/internal/service"
 	"github.com/sivchari/kumo/internal/storage"
 )
 
-const (
-	defaultRegion    = "us-east-1"
-	defaultAccountID = "000000000000"
-)
-
 // Error codes.
 const (
 	errNotFound          = "NotFoundException"
@@ -32,8 +32,20 @@ const (
 	errIncorrectKey      = "IncorrectKeyException"
 	errDisabled          = "DisabledException"
 	errInvalidKeyUsage   = "InvalidKeyUsageException"
+	errValidation        = "ValidationException"
+	errInvalidGrantID    = "InvalidGrantIdException"
+	errInvalidGrantToken = "InvalidGrantTokenException"
+	errInvalidMarker     = "InvalidMarkerException"
 )
 
+// grantOperations lists the operations a grant may allow.
+var grantOperations = []string{
+	"Decrypt", "Encrypt", "GenerateDataKey", "GenerateDataKeyWithoutPlaintext",
+	"ReEncryptFrom", "ReEncryptTo", "Sign", "Verify", "GetPublicKey",
+	"CreateGrant", "RetireGrant", "DescribeKey", "GenerateDataKeyPair",
+	"GenerateDataKeyPairWithoutPlaintext", "GenerateMac", "VerifyMac", "DeriveSharedSecret",
+}
+
 // determineKeySize returns the key size based on key spec or number of bytes.
 func determineKeySize(keySpec string, numberOfBytes int32) int32 {
 	switch keySpec {
@@ -59,6 +71,7 @@ type Storage interface {
 	EnableKey(ctx context.Context, keyID string) error
 	DisableKey(ctx context.Context, keyID string) error
 	ScheduleKeyDeletion(ctx context.Context, keyID string, pendingWindowInDays int32) (*Key, error)
+	CancelKeyDeletion(ctx context.Context, keyID string) (*Key, error)
 
 	// Cryptographic operations.
 	Encrypt(ctx context.Context, keyID string, plaintext []byte, encryptionContext map[string]string) ([]byte, error)
@@ -70,6 +83,13 @@ type Storage interface {
 	DeleteAlias(ctx context.Context, aliasName string) error
 	ListAliases(ctx context.Context, keyID string, limit int32, marker string) ([]*Alias, string, error)
 	GetAlias(ctx context.Context, aliasName string) (*Alias, error)
+
+	// Grant operations.
+	CreateGrant(ctx context.Context, req *CreateGrantRequest) (*Grant, error)
+	ListGrants(ctx context.Context, req *ListGrantsRequest) ([]*Grant, string, error)
+	RevokeGrant(ctx context.Context, keyID, grantID string) error
+	RetireGrant(ctx context.Context, grantToken, keyID, grantID string) error
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -93,7 +113,7 @@ type MemoryStorage struct {
 	mu      sync.RWMutex      `json:"-"`
 	Keys    map[string]*Key   `json:"keys"`    // keyID -> Key
 	Aliases map[string]*Alias `json:"aliases"` // aliasName -> Alias
-	region  string
+	Grants  map[string]*Grant `json:"grants"`  // grantID -> Grant
 	dataDir string
 }
 
@@ -102,7 +122,7 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 	s := &MemoryStorage{
 		Keys:    make(map[string]*Key),
 		Aliases: make(map[string]*Alias),
-		region:  defaultRegion,
+		Grants:  make(map[string]*Grant),
 	}
 	for _, o := range opts {
 		o(s)
@@ -151,6 +171,10 @@ func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
 		s.Aliases = make(map[string]*Alias)
 	}
 
+	if s.Grants == nil {
+		s.Grants = make(map[string]*Grant)
+	}
+
 	return nil
 }
 
@@ -167,13 +191,25 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Keys = make(map[string]*Key)
+	s.Aliases = make(map[string]*Alias)
+	s.Grants = make(map[string]*Grant)
+
+	return nil
+}
+
 // CreateKey creates a new KMS key.
-func (s *MemoryStorage) CreateKey(_ context.Context, req *CreateKeyRequest) (*Key, error) {
+func (s *MemoryStorage) CreateKey(ctx context.Context, req *CreateKeyRequest) (*Key, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	keyID := uuid.New().String()
-	arn := fmt.Sprintf("arn:aws:kms:%s:%s:key/%s", s.region, defaultAccountID, keyID)
+	arn := fmt.Sprintf("arn:aws:kms:%s:%s:key/%s", service.Region(ctx), service.AccountID(ctx), keyID)
 
 	// Generate random key material (256-bit for AES-256).
 	keyMaterial := make([]byte, 32)
@@ -362,6 +398,51 @@ func (s *MemoryStorage) ScheduleKeyDeletion(_ context.Context, keyID string, pen
 	return key, nil
 }
 
+// CancelKeyDeletion cancels the scheduled deletion of a key. The key is left
+// disabled, as it was while pending deletion.
+func (s *MemoryStorage) CancelKeyDeletion(_ context.Context, keyID string) (*Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, err := s.getKeyLocked(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.KeyState != KeyStatePendingDeletion {
+		return nil, &ServiceError{
+			Code:    errInvalidKeyState,
+			Message: key.Arn + " is not pending deletion.",
+		}
+	}
+
+	key.KeyState = KeyStateDisabled
+	key.DeletionDate = nil
+	key.PendingDeletionWindow = 0
+
+	return key, nil
+}
+
+// checkKeyUsable returns the error for a cryptographic operation on a key
+// that is not enabled: DisabledException for a disabled key and
+// KMSInvalidStateException for any other state, such as pending deletion.
+func checkKeyUsable(key *Key) error {
+	switch key.KeyState {
+	case KeyStateEnabled:
+		return nil
+	case KeyStateDisabled:
+		return &ServiceError{
+			Code:    errDisabled,
+			Message: key.Arn + " is disabled.",
+		}
+	default:
+		return &ServiceError{
+			Code:    errInvalidKeyState,
+			Message: fmt.Sprintf("%s is %s and cannot be used.", key.Arn, key.KeyState),
+		}
+	}
+}
+
 // Encrypt encrypts plaintext using a key.
 func (s *MemoryStorage) Encrypt(_ context.Context, keyID string, plaintext []byte, _ map[string]string) ([]byte, error) {
 	s.mu.RLock()
@@ -372,11 +453,8 @@ func (s *MemoryStorage) Encrypt(_ context.Context, keyID string, plaintext []byt
 		return nil, err
 	}
 
-	if key.KeyState != KeyStateEnabled {
-		return nil, &ServiceError{
-			Code:    errDisabled,
-			Message: "Key " + keyID + " is disabled.",
-		}
+	if err := checkKeyUsable(key); err != nil {
+		return nil, err
 	}
 
 	if key.KeyUsage != KeyUsageEncryptDecrypt {
@@ -441,11 +519,8 @@ func (s *MemoryStorage) Decrypt(_ context.Context, ciphertextBlob []byte, _ map[
 		return nil, "", err
 	}
 
-	if key.KeyState != KeyStateEnabled {
-		return nil, "", &ServiceError{
-			Code:    errDisabled,
-			Message: "Key " + embeddedKeyID + " is disabled.",
-		}
+	if err := checkKeyUsable(key); err != nil {
+		return nil, "", err
 	}
 
 	// Use AES-GCM for decryption.
@@ -485,11 +560,8 @@ func (s *MemoryStorage) GenerateDataKey(_ context.Context, keyID, keySpec string
 		return nil, nil, err
 	}
 
-	if key.KeyState != KeyStateEnabled {
-		return nil, nil, &ServiceError{
-			Code:    errDisabled,
-			Message: "Key " + keyID + " is disabled.",
-		}
+	if err := checkKeyUsable(key); err != nil {
+		return nil, nil, err
 	}
 
 	if key.KeyUsage != KeyUsageEncryptDecrypt {
@@ -533,7 +605,7 @@ func (s *MemoryStorage) GenerateDataKey(_ context.Context, keyID, keySpec string
 }
 
 // CreateAlias creates an alias for a key.
-func (s *MemoryStorage) CreateAlias(_ context.Context, aliasName, targetKeyID string) error {
+func (s *MemoryStorage) CreateAlias(ctx context.Context, aliasName, targetKeyID string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -553,7 +625,7 @@ func (s *MemoryStorage) CreateAlias(_ context.Context, aliasName, targetKeyID st
 		return err
 	}
 
-	aliasArn := fmt.Sprintf("arn:aws:kms:%s:%s:%s", s.region, defaultAccountID, aliasName)
+	aliasArn := fmt.Sprintf("arn:aws:kms:%s:%s:%s", service.Region(ctx), service.AccountID(ctx), aliasName)
 	now := time.Now()
 
 	s.Aliases[aliasName] = &Alias{
@@ -629,3 +701,224 @@ func (s *MemoryStorage) GetAlias(_ context.Context, aliasName string) (*Alias, e
 
 	return alias, nil
 }
+
+// CreateGrant creates a grant on a key. Creating a grant with the same name
+// and parameters as an existing grant on the key returns the existing grant.
+func (s *MemoryStorage) CreateGrant(_ context.Context, req *CreateGrantRequest) (*Grant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, err := s.getKeyLocked(req.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.KeyState == KeyStatePendingDeletion {
+		return nil, &ServiceError{Code: errInvalidKeyState, Message: key.Arn + " is pending deletion."}
+	}
+
+	if req.GranteePrincipal == "" {
+		return nil, &ServiceError{Code: errValidation, Message: "GranteePrincipal is required."}
+	}
+
+	if len(req.Operations) == 0 {
+		return nil, &ServiceError{Code: errValidation, Message: "Operations is required."}
+	}
+
+	for _, op := range req.Operations {
+		if !slices.Contains(grantOperations, op) {
+			return nil, &ServiceError{Code: errValidation, Message: "Operation " + op + " is not a valid grant operation."}
+		}
+	}
+
+	if req.Name != "" {
+		for _, grant := range s.Grants {
+			if grant.KeyID == key.KeyID && grant.Name == req.Name && sameGrantParameters(grant, req) {
+				return grant, nil
+			}
+		}
+	}
+
+	grantID, err := randomHex(32)
+	if err != nil {
+		return nil, &ServiceError{Code: errDependencyTimeout, Message: "Failed to generate grant ID"}
+	}
+
+	token := make([]byte, 96)
+	if _, err := io.ReadFull(rand.Reader, token); err != nil {
+		return nil, &ServiceError{Code: errDependencyTimeout, Message: "Failed to generate grant token"}
+	}
+
+	grant := &Grant{
+		GrantID:           grantID,
+		GrantToken:        base64.RawURLEncoding.EncodeToString(token),
+		KeyID:             key.KeyID,
+		KeyArn:            key.Arn,
+		Name:              req.Name,
+		GranteePrincipal:  req.GranteePrincipal,
+		RetiringPrincipal: req.RetiringPrincipal,
+		IssuingAccount:    "arn:aws:iam::" + keyAccountID(key) + ":root",
+		Operations:        slices.Clone(req.Operations),
+		Constraints:       req.Constraints,
+		CreationDate:      time.Now(),
+	}
+
+	s.Grants[grantID] = grant
+
+	return grant, nil
+}
+
+// sameGrantParameters reports whether a grant was created with the parameters of req.
+func sameGrantParameters(grant *Grant, req *CreateGrantRequest) bool {
+	if grant.GranteePrincipal != req.GranteePrincipal || grant.RetiringPrincipal != req.RetiringPrincipal {
+		return false
+	}
+
+	a, b := slices.Clone(grant.Operations), slices.Clone(req.Operations)
+	slices.Sort(a)
+	slices.Sort(b)
+
+	if !slices.Equal(a, b) {
+		return false
+	}
+
+	encoded := func(c *GrantConstraints) string {
+		data, _ := json.Marshal(c)
+
+		return string(data)
+	}
+
+	return encoded(grant.Constraints) == encoded(req.Constraints)
+}
+
+// randomHex returns n random bytes encoded as hex.
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return "", fmt.Errorf("failed to read random bytes: %w", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// ListGrants lists the grants on a key, oldest first. The marker is the ID
+// of the first grant of the next page.
+func (s *MemoryStorage) ListGrants(_ context.Context, req *ListGrantsRequest) ([]*Grant, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, err := s.getKeyLocked(req.KeyID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 50
+	}
+
+	grants := make([]*Grant, 0)
+
+	for _, grant := range s.Grants {
+		if grant.KeyID != key.KeyID {
+			continue
+		}
+
+		if req.GrantID != "" && grant.GrantID != req.GrantID {
+			continue
+		}
+
+		if req.GranteePrincipal != "" && grant.GranteePrincipal != req.GranteePrincipal {
+			continue
+		}
+
+		grants = append(grants, grant)
+	}
+
+	sort.Slice(grants, func(i, j int) bool {
+		if !grants[i].CreationDate.Equal(grants[j].CreationDate) {
+			return grants[i].CreationDate.Before(grants[j].CreationDate)
+		}
+
+		return grants[i].GrantID < grants[j].GrantID
+	})
+
+	start := 0
+
+	if req.Marker != "" {
+		start = slices.IndexFunc(grants, func(g *Grant) bool { return g.GrantID == req.Marker })
+		if start < 0 {
+			return nil, "", &ServiceError{Code: errInvalidMarker, Message: "Marker " + req.Marker + " is invalid."}
+		}
+	}
+
+	end := min(start+limit, len(grants))
+
+	nextMarker := ""
+	if end < len(grants) {
+		nextMarker = grants[end].GrantID
+	}
+
+	return grants[start:end], nextMarker, nil
+}
+
+// RevokeGrant deletes a grant from a key.
+func (s *MemoryStorage) RevokeGrant(_ context.Context, keyID, grantID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, err := s.getKeyLocked(keyID)
+	if err != nil {
+		return err
+	}
+
+	if grantID == "" {
+		return &ServiceError{Code: errValidation, Message: "GrantId is required."}
+	}
+
+	grant, ok := s.Grants[grantID]
+	if !ok || grant.KeyID != key.KeyID {
+		return &ServiceError{Code: errNotFound, Message: "Grant " + grantID + " is not found."}
+	}
+
+	delete(s.Grants, grantID)
+
+	return nil
+}
+
+// RetireGrant deletes a grant identified either by its token or by its key
+// and grant ID.
+func (s *MemoryStorage) RetireGrant(_ context.Context, grantToken, keyID, grantID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if grantToken != "" {
+		for id, grant := range s.Grants {
+			if grant.GrantToken == grantToken {
+				delete(s.Grants, id)
+
+				return nil
+			}
+		}
+
+		return &ServiceError{Code: errInvalidGrantToken, Message: "Grant token is invalid."}
+	}
+
+	if keyID == "" || grantID == "" {
+		return &ServiceError{Code: errValidation, Message: "Either GrantToken or both KeyId and GrantId are required."}
+	}
+
+	key, err := s.getKeyLocked(keyID)
+	if err != nil {
+		return err
+	}
+
+	grant, ok := s.Grants[grantID]
+	if !ok || grant.KeyID != key.KeyID {
+		return &ServiceError{Code: errInvalidGrantID, Message: "Grant " + grantID + " is not found."}
+	}
+
+	delete(s.Grants, grantID)
+
+	return nil
+}
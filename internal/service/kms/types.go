@@ -100,6 +100,27 @@ type Alias struct {
 	LastUpdatedDate time.Time
 }
 
+// Grant represents a grant that allows a principal to use a KMS key.
+type Grant struct {
+	GrantID           string
+	GrantToken        string
+	KeyID             string
+	KeyArn            string
+	Name              string
+	GranteePrincipal  string
+	RetiringPrincipal string
+	IssuingAccount    string
+	Operations        []string
+	Constraints       *GrantConstraints
+	CreationDate      time.Time
+}
+
+// GrantConstraints limits a grant to requests with a matching encryption context.
+type GrantConstraints struct {
+	EncryptionContextSubset map[string]string `json:"EncryptionContextSubset,omitempty"`
+	EncryptionContextEquals map[string]string `json:"EncryptionContextEquals,omitempty"`
+}
+
 // CreateKeyRequest is the request for CreateKey.
 type CreateKeyRequest struct {
 	Description         string `json:"Description,omitempty"`
@@ -230,6 +251,16 @@ type ScheduleKeyDeletionResponse struct {
 	PendingWindowInDays int32   `json:"PendingWindowInDays,omitempty"`
 }
 
+// CancelKeyDeletionRequest is the request for CancelKeyDeletion.
+type CancelKeyDeletionRequest struct {
+	KeyID string `json:"KeyId"`
+}
+
+// CancelKeyDeletionResponse is the response for CancelKeyDeletion.
+type CancelKeyDeletionResponse struct {
+	KeyID string `json:"KeyId"`
+}
+
 // EncryptRequest is the request for Encrypt.
 type EncryptRequest struct {
 	KeyID               string            `json:"KeyId"`
@@ -331,6 +362,71 @@ type AliasListEntry struct {
 	LastUpdatedDate float64 `json:"LastUpdatedDate,omitempty"`
 }
 
+// CreateGrantRequest is the request for CreateGrant.
+type CreateGrantRequest struct {
+	KeyID             string            `json:"KeyId"`
+	GranteePrincipal  string            `json:"GranteePrincipal"`
+	RetiringPrincipal string            `json:"RetiringPrincipal,omitempty"`
+	Operations        []string          `json:"Operations"`
+	Constraints       *GrantConstraints `json:"Constraints,omitempty"`
+	GrantTokens       []string          `json:"GrantTokens,omitempty"`
+	Name              string            `json:"Name,omitempty"`
+}
+
+// CreateGrantResponse is the response for CreateGrant.
+type CreateGrantResponse struct {
+	GrantID    string `json:"GrantId"`
+	GrantToken string `json:"GrantToken"`
+}
+
+// ListGrantsRequest is the request for ListGrants.
+type ListGrantsRequest struct {
+	KeyID            string `json:"KeyId"`
+	GrantID          string `json:"GrantId,omitempty"`
+	GranteePrincipal string `json:"GranteePrincipal,omitempty"`
+	Limit            int32  `json:"Limit,omitempty"`
+	Marker           string `json:"Marker,omitempty"`
+}
+
+// ListGrantsResponse is the response for ListGrants.
+type ListGrantsResponse struct {
+	Grants     []GrantListEntry `json:"Grants"`
+	NextMarker string           `json:"NextMarker,omitempty"`
+	Truncated  bool             `json:"Truncated"`
+}
+
+// GrantListEntry represents a grant in ListGrants responses.
+type GrantListEntry struct {
+	KeyID             string            `json:"KeyId"`
+	GrantID           string            `json:"GrantId"`
+	Name              string            `json:"Name,omitempty"`
+	CreationDate      float64           `json:"CreationDate"`
+	GranteePrincipal  string            `json:"GranteePrincipal"`
+	RetiringPrincipal string            `json:"RetiringPrincipal,omitempty"`
+	IssuingAccount    string            `json:"IssuingAccount"`
+	Operations        []string          `json:"Operations"`
+	Constraints       *GrantConstraints `json:"Constraints,omitempty"`
+}
+
+// RevokeGrantRequest is the request for RevokeGrant.
+type RevokeGrantRequest struct {
+	KeyID   string `json:"KeyId"`
+	GrantID string `json:"GrantId"`
+}
+
+// RevokeGrantResponse is the response for RevokeGrant.
+type RevokeGrantResponse struct{}
+
+// RetireGrantRequest is the request for RetireGrant.
+type RetireGrantRequest struct {
+	GrantToken string `json:"GrantToken,omitempty"`
+	KeyID      string `json:"KeyId,omitempty"`
+	GrantID    string `json:"GrantId,omitempty"`
+}
+
+// RetireGrantResponse is the response for RetireGrant.
+type RetireGrantResponse struct{}
+
 // ErrorResponse represents a KMS error response.
 type ErrorResponse struct {
 	Type    string `json:"__type"`
@@ -0,0 +1,306 @@
+package lambda
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// defaultMaximumRetryAttempts is how many times Lambda retries a failed asynchronous
+// invocation when the function has no EventInvokeConfig.
+const defaultMaximumRetryAttempts = 2
+
+// asyncRetryDelay is the delay before the first retry of a failed asynchronous invocation,
+// doubled for each further retry. Lambda waits minutes between retries; kumo retries
+// sooner so that tests do not have to wait.
+var asyncRetryDelay = time.Second
+
+// asyncInvocation is an event queued for asynchronous invocation, with the settings of
+// the function at the time it was queued.
+type asyncInvocation struct {
+	requestID    string
+	functionName string
+	functionArn  string
+	version      string
+	endpoint     string
+	runtime      *runtimeContext
+	payload      []byte
+	queuedAt     time.Time
+	maxRetries   int
+	maxAge       time.Duration
+	onSuccess    string
+	onFailure    string
+	deadLetter   string
+}
+
+// invocationRecord is the record Lambda sends to the destinations of asynchronous invocations.
+type invocationRecord struct {
+	Version         string                 `json:"version"`
+	Timestamp       string                 `json:"timestamp"`
+	RequestContext  invocationContext      `json:"requestContext"`
+	RequestPayload  json.RawMessage        `json:"requestPayload"`
+	ResponseContext invocationResponseInfo `json:"responseContext"`
+	ResponsePayload json.RawMessage        `json:"responsePayload,omitempty"`
+}
+
+type invocationContext struct {
+	RequestID              string `json:"requestId"`
+	FunctionArn            string `json:"functionArn"`
+	Condition              string `json:"condition"`
+	ApproximateInvokeCount int    `json:"approximateInvokeCount"`
+}
+
+type invocationResponseInfo struct {
+	StatusCode      int    `json:"statusCode"`
+	ExecutedVersion string `json:"executedVersion"`
+	FunctionError   string `json:"functionError,omitempty"`
+}
+
+// newAsyncInvocation queues payload for the function under the request ID of its runtime context.
+func newAsyncInvocation(fn *Function, rc *runtimeContext, payload []byte) *asyncInvocation {
+	inv := &asyncInvocation{
+		requestID:    rc.requestID,
+		functionName: fn.FunctionName,
+		functionArn:  qualifiedArn(fn),
+		version:      fn.Version,
+		endpoint:     fn.InvokeEndpoint,
+		runtime:      rc,
+		payload:      bytes.Clone(payload),
+		queuedAt:     time.Now(),
+		maxRetries:   defaultMaximumRetryAttempts,
+	}
+
+	if fn.DeadLetterConfig != nil {
+		inv.deadLetter = fn.DeadLetterConfig.TargetArn
+	}
+
+	cfg := fn.EventInvokeConfig
+	if cfg == nil {
+		return inv
+	}
+
+	if cfg.MaximumRetryAttempts != nil {
+		inv.maxRetries = *cfg.MaximumRetryAttempts
+	}
+
+	if cfg.MaximumEventAgeInSeconds != nil {
+		inv.maxAge = time.Duration(*cfg.MaximumEventAgeInSeconds) * time.Second
+	}
+
+	if dc := cfg.DestinationConfig; dc != nil {
+		if dc.OnSuccess != nil {
+			inv.onSuccess = dc.OnSuccess.Destination
+		}
+
+		if dc.OnFailure != nil {
+			inv.onFailure = dc.OnFailure.Destination
+		}
+	}
+
+	return inv
+}
+
+// invokeAsync invokes the function in the background, retrying failed attempts, and
+// sends the outcome to the configured destination or dead-letter queue.
+func (s *Service) invokeAsync(inv *asyncInvocation) {
+	go s.runAsyncInvocation(inv)
+}
+
+func (s *Service) runAsyncInvocation(inv *asyncInvocation) {
+	var (
+		result    invocationResult
+		attempts  int
+		condition = "RetriesExhausted"
+	)
+
+	for attempt := 0; attempt <= inv.maxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(asyncRetryDelay << (attempt - 1))
+
+			if inv.maxAge > 0 && time.Since(inv.queuedAt) > inv.maxAge {
+				condition = "EventAgeExceeded"
+
+				break
+			}
+		}
+
+		attempts++
+
+		// A throttled attempt is retried like a failed one.
+		if s.acquireConcurrency(context.Background(), inv.functionName) {
+			result = postInvocation(context.Background(), inv.endpoint, inv.runtime, inv.payload)
+			s.releaseConcurrency(inv.functionName)
+		} else {
+			result = invocationResult{statusCode: http.StatusTooManyRequests, err: errThrottled}
+		}
+
+		if !result.failed() {
+			if inv.onSuccess != "" {
+				s.deliverRecord(inv.onSuccess, inv.record("Success", attempts, &result))
+			}
+
+			return
+		}
+
+		slog.Warn("async invoke failed", "function", inv.functionArn, "attempt", attempts,
+			"status", result.statusCode, "error", result.err)
+	}
+
+	switch {
+	case inv.onFailure != "":
+		s.deliverRecord(inv.onFailure, inv.record(condition, attempts, &result))
+	case inv.deadLetter != "":
+		s.deliverDeadLetter(inv, &result)
+	}
+}
+
+// record builds the destination record of the invocation.
+func (inv *asyncInvocation) record(condition string, attempts int, result *invocationResult) *invocationRecord {
+	rec := &invocationRecord{
+		Version:   "1.0",
+		Timestamp: time.Now().UTC().Format(time.RFC3339Nano),
+		RequestContext: invocationContext{
+			RequestID:              inv.requestID,
+			FunctionArn:            inv.functionArn,
+			Condition:              condition,
+			ApproximateInvokeCount: attempts,
+		},
+		RequestPayload:  jsonOrString(inv.payload),
+		ResponseContext: invocationResponseInfo{StatusCode: http.StatusOK, ExecutedVersion: inv.version},
+		ResponsePayload: jsonOrString(result.payload),
+	}
+
+	if result.failed() {
+		rec.ResponseContext.FunctionError = "Unhandled"
+	}
+
+	if result.err != nil {
+		rec.ResponsePayload = jsonOrString([]byte(result.err.Error()))
+	}
+
+	return rec
+}
+
+// jsonOrString returns data as raw JSON, quoting it when it is not valid JSON.
+func jsonOrString(data []byte) json.RawMessage {
+	if len(data) == 0 {
+		return nil
+	}
+
+	if json.Valid(data) {
+		return data
+	}
+
+	quoted, _ := json.Marshal(string(data))
+
+	return quoted
+}
+
+// deliverRecord sends an invocation record to an SQS queue or SNS topic destination.
+func (s *Service) deliverRecord(arn string, rec *invocationRecord) {
+	body, err := json.Marshal(rec)
+	if err != nil {
+		slog.Error("failed to marshal invocation record", "error", err)
+
+		return
+	}
+
+	s.deliver(arn, string(body), nil)
+}
+
+// deliverDeadLetter sends the event of a failed invocation to the dead-letter queue or
+// topic, with the request ID and error as message attributes.
+func (s *Service) deliverDeadLetter(inv *asyncInvocation, result *invocationResult) {
+	errorMessage := string(result.payload)
+	if result.err != nil {
+		errorMessage = result.err.Error()
+	}
+
+	s.deliver(inv.deadLetter, string(inv.payload), map[string]string{
+		"RequestID":    inv.requestID,
+		"ErrorCode":    strconv.Itoa(result.statusCode),
+		"ErrorMessage": errorMessage,
+	})
+}
+
+// deliver sends a message to an SQS queue or SNS topic via the local kumo endpoint.
+func (s *Service) deliver(arn, message string, attributes map[string]string) {
+	// arn:aws:{sqs|sns}:region:account:name
+	parts := strings.Split(arn, ":")
+	if len(parts) < 6 {
+		slog.Error("invalid destination ARN", "arn", arn)
+
+		return
+	}
+
+	messageAttributes := make(map[string]any, len(attributes))
+	for name, value := range attributes {
+		messageAttributes[name] = map[string]string{"DataType": "String", "StringValue": value}
+	}
+
+	var (
+		target  string
+		reqBody map[string]any
+	)
+
+	switch parts[2] {
+	case "sqs":
+		target = "AmazonSQS.SendMessage"
+		reqBody = map[string]any{
+			"QueueUrl":          fmt.Sprintf("%s/%s/%s", s.baseURL, parts[4], parts[5]),
+			"MessageBody":       message,
+			"MessageAttributes": messageAttributes,
+		}
+	case "sns":
+		target = "AmazonSimpleNotificationService.Publish"
+		reqBody = map[string]any{
+			"TopicArn":          arn,
+			"Message":           message,
+			"MessageAttributes": messageAttributes,
+		}
+	default:
+		slog.Warn("unsupported asynchronous invocation destination", "arn", arn)
+
+		return
+	}
+
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		slog.Error("failed to marshal destination request", "error", err)
+
+		return
+	}
+
+	// The destination is reached in the account and region of its ARN.
+	ctx := service.WithScope(context.Background(), parts[4], parts[3])
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+"/", bytes.NewReader(body))
+	if err != nil {
+		slog.Error("failed to create destination request", "error", err, "arn", arn)
+
+		return
+	}
+
+	req.Header.Set("Content-Type", "application/x-amz-json-1.0")
+	req.Header.Set("X-Amz-Target", target)
+	service.MarkInternalRequest(req)
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		slog.Error("failed to deliver to destination", "error", err, "arn", arn)
+
+		return
+	}
+
+	_ = resp.Body.Close()
+}
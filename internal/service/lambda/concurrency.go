@@ -0,0 +1,61 @@
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// errThrottled is the outcome of an asynchronous invocation attempt rejected because the
+// function was already running at its reserved concurrency.
+var errThrottled = errors.New("function throttled: reserved concurrency exceeded")
+
+// acquireConcurrency counts an invocation of a function as in flight, unless the function
+// already has as many invocations in flight as its reserved concurrency, in which case it
+// returns false. A successful call must be paired with releaseConcurrency.
+func (s *Service) acquireConcurrency(ctx context.Context, name string) bool {
+	limit := -1
+
+	if cfg, err := s.storage.GetFunctionConcurrency(ctx, name); err == nil && cfg.ReservedConcurrentExecutions != nil {
+		limit = *cfg.ReservedConcurrentExecutions
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if limit >= 0 && s.inFlight[name] >= limit {
+		return false
+	}
+
+	s.inFlight[name]++
+
+	return true
+}
+
+// releaseConcurrency ends an invocation counted by acquireConcurrency.
+func (s *Service) releaseConcurrency(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inFlight[name]--
+	if s.inFlight[name] <= 0 {
+		delete(s.inFlight, name)
+	}
+}
+
+// writeThrottledError writes the TooManyRequestsException returned when an invocation
+// exceeds the reserved concurrency of a function.
+func writeThrottledError(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Amzn-Requestid", uuid.New().String())
+	w.Header().Set("X-Amzn-Errortype", ErrTooManyRequests)
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"Type":    "User",
+		"Message": "Rate Exceeded.",
+		"Reason":  "ReservedFunctionConcurrentInvocationLimitExceeded",
+	})
+}
@@ -0,0 +1,258 @@
+package lambda
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// functionURLMethods lists the HTTP methods a function URL can be invoked with.
+var functionURLMethods = []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+
+// FunctionURLEvent is the event, in payload format version 2.0, sent to a function
+// by its function URL.
+type FunctionURLEvent struct {
+	Version               string                    `json:"version"`
+	RouteKey              string                    `json:"routeKey"`
+	RawPath               string                    `json:"rawPath"`
+	RawQueryString        string                    `json:"rawQueryString"`
+	Cookies               []string                  `json:"cookies,omitempty"`
+	Headers               map[string]string         `json:"headers"`
+	QueryStringParameters map[string]string         `json:"queryStringParameters,omitempty"`
+	RequestContext        FunctionURLRequestContext `json:"requestContext"`
+	Body                  string                    `json:"body,omitempty"`
+	IsBase64Encoded       bool                      `json:"isBase64Encoded"`
+}
+
+// FunctionURLRequestContext describes the request in a FunctionURLEvent.
+type FunctionURLRequestContext struct {
+	AccountID    string              `json:"accountId"`
+	APIID        string              `json:"apiId"`
+	DomainName   string              `json:"domainName"`
+	DomainPrefix string              `json:"domainPrefix"`
+	HTTP         FunctionURLHTTPInfo `json:"http"`
+	RequestID    string              `json:"requestId"`
+	RouteKey     string              `json:"routeKey"`
+	Stage        string              `json:"stage"`
+	Time         string              `json:"time"`
+	TimeEpoch    int64               `json:"timeEpoch"`
+}
+
+// FunctionURLHTTPInfo describes the HTTP request in a FunctionURLRequestContext.
+type FunctionURLHTTPInfo struct {
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Protocol  string `json:"protocol"`
+	SourceIP  string `json:"sourceIp"`
+	UserAgent string `json:"userAgent"`
+}
+
+// FunctionURLResponse is the response a function returns to its function URL. A
+// response without a statusCode is returned as the body of a 200 response.
+type FunctionURLResponse struct {
+	StatusCode      int               `json:"statusCode"`
+	Headers         map[string]string `json:"headers"`
+	Cookies         []string          `json:"cookies"`
+	Body            string            `json:"body"`
+	IsBase64Encoded bool              `json:"isBase64Encoded"`
+}
+
+// InvokeFunctionURL handles requests to a function URL, invoking the function with a
+// function URL event and translating its response into the HTTP response.
+func (s *Service) InvokeFunctionURL(w http.ResponseWriter, r *http.Request) {
+	urlID := r.PathValue("urlID")
+
+	fn, err := s.storage.GetFunctionByURLID(r.Context(), urlID)
+	if err != nil {
+		writeFunctionURLError(w, http.StatusNotFound, "Not Found")
+
+		return
+	}
+
+	// Requests to AWS_IAM function URLs must be signed. kumo does not verify the signature.
+	if fn.URLConfig.AuthType == "AWS_IAM" && r.Header.Get("Authorization") == "" && r.URL.Query().Get("X-Amz-Signature") == "" {
+		writeFunctionURLError(w, http.StatusForbidden, "Forbidden")
+
+		return
+	}
+
+	if fn.InvokeEndpoint == "" {
+		writeFunctionURLError(w, http.StatusBadGateway, "Internal Server Error")
+
+		return
+	}
+
+	event, err := buildFunctionURLEvent(r, urlID)
+	if err != nil {
+		writeFunctionURLError(w, http.StatusBadRequest, "Bad Request")
+
+		return
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		writeFunctionURLError(w, http.StatusInternalServerError, "Internal Server Error")
+
+		return
+	}
+
+	if !s.acquireConcurrency(r.Context(), fn.FunctionName) {
+		writeFunctionURLError(w, http.StatusTooManyRequests, "Rate Exceeded.")
+
+		return
+	}
+
+	defer s.releaseConcurrency(fn.FunctionName)
+
+	rc := newRuntimeContext(fn, event.RequestContext.RequestID, fn.FunctionArn)
+
+	result := postInvocation(r.Context(), fn.InvokeEndpoint, rc, payload)
+	if result.failed() {
+		writeFunctionURLError(w, http.StatusBadGateway, "Internal Server Error")
+
+		return
+	}
+
+	writeFunctionURLResponse(w, result.payload)
+}
+
+// buildFunctionURLEvent builds the function URL event for a request.
+func buildFunctionURLEvent(r *http.Request, urlID string) (*FunctionURLEvent, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	now := time.Now().UTC()
+	path := "/" + r.PathValue("path")
+
+	event := &FunctionURLEvent{
+		Version:        "2.0",
+		RouteKey:       "$default",
+		RawPath:        path,
+		RawQueryString: r.URL.RawQuery,
+		Headers:        make(map[string]string, len(r.Header)),
+		RequestContext: FunctionURLRequestContext{
+			AccountID:    "anonymous",
+			APIID:        urlID,
+			DomainName:   r.Host,
+			DomainPrefix: urlID,
+			HTTP: FunctionURLHTTPInfo{
+				Method:    r.Method,
+				Path:      path,
+				Protocol:  r.Proto,
+				SourceIP:  sourceIP(r),
+				UserAgent: r.UserAgent(),
+			},
+			RequestID: uuid.New().String(),
+			RouteKey:  "$default",
+			Stage:     "$default",
+			Time:      now.Format("02/Jan/2006:15:04:05 -0700"),
+			TimeEpoch: now.UnixMilli(),
+		},
+	}
+
+	// Function URL events carry lowercase header names, with repeated headers joined by commas,
+	// and the cookies separately.
+	for name, values := range r.Header {
+		if name == "Cookie" {
+			for _, v := range values {
+				for cookie := range strings.SplitSeq(v, ";") {
+					event.Cookies = append(event.Cookies, strings.TrimSpace(cookie))
+				}
+			}
+
+			continue
+		}
+
+		event.Headers[strings.ToLower(name)] = strings.Join(values, ",")
+	}
+
+	if query := r.URL.Query(); len(query) > 0 {
+		event.QueryStringParameters = make(map[string]string, len(query))
+
+		for name, values := range query {
+			event.QueryStringParameters[name] = strings.Join(values, ",")
+		}
+	}
+
+	if len(body) > 0 {
+		event.Body = string(body)
+		if !utf8.Valid(body) {
+			event.Body = base64.StdEncoding.EncodeToString(body)
+			event.IsBase64Encoded = true
+		}
+	}
+
+	return event, nil
+}
+
+// writeFunctionURLResponse writes the response returned by a function to its function URL.
+func writeFunctionURLResponse(w http.ResponseWriter, payload []byte) {
+	var result FunctionURLResponse
+	if err := json.Unmarshal(payload, &result); err != nil || result.StatusCode == 0 {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(payload)
+
+		return
+	}
+
+	body := []byte(result.Body)
+
+	if result.IsBase64Encoded {
+		decoded, err := base64.StdEncoding.DecodeString(result.Body)
+		if err != nil {
+			writeFunctionURLError(w, http.StatusBadGateway, "Internal Server Error")
+
+			return
+		}
+
+		body = decoded
+	}
+
+	for name, v := range result.Headers {
+		w.Header().Set(name, v)
+	}
+
+	for _, cookie := range result.Cookies {
+		w.Header().Add("Set-Cookie", cookie)
+	}
+
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+
+	w.WriteHeader(result.StatusCode)
+	_, _ = w.Write(body)
+}
+
+// sourceIP returns the client IP address of a request.
+func sourceIP(r *http.Request) string {
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		ip, _, _ := strings.Cut(forwarded, ",")
+
+		return strings.TrimSpace(ip)
+	}
+
+	host, _, ok := strings.Cut(r.RemoteAddr, ":")
+	if !ok {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// writeFunctionURLError writes an error response for a request to a function URL.
+func writeFunctionURLError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"Message": message})
+}
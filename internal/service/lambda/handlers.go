@@ -7,16 +7,21 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log/slog"
 	"net/http"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 const pathSegmentFunctions = "functions"
 
+// aliasNamePattern matches alias names, which cannot be version numbers.
+var aliasNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]*[a-zA-Z_-][a-zA-Z0-9_-]*$`)
+
 // CreateFunction handles the CreateFunction API.
 func (s *Service) CreateFunction(w http.ResponseWriter, r *http.Request) {
 	var req CreateFunctionRequest
@@ -70,7 +75,9 @@ func (s *Service) GetFunction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	fn, err := s.storage.GetFunction(r.Context(), functionName)
+	functionName, qualifier := splitQualifier(functionName, r.URL.Query().Get("Qualifier"))
+
+	fn, err := s.storage.GetFunctionVersion(r.Context(), functionName, qualifier)
 	if err != nil {
 		var lambdaErr *FunctionError
 		if errors.As(err, &lambdaErr) {
@@ -97,6 +104,15 @@ func (s *Service) GetFunction(w http.ResponseWriter, r *http.Request) {
 		},
 	}
 
+	if concurrency, err := s.storage.GetFunctionConcurrency(r.Context(), functionName); err == nil && concurrency.ReservedConcurrentExecutions != nil {
+		resp.Concurrency = concurrency
+	}
+
+	// Tags belong to the function, not to its versions, so they are read through the unqualified ARN.
+	if tags, err := s.storage.ListTags(r.Context(), strings.TrimSuffix(fn.FunctionArn, ":"+fn.Version)); err == nil && len(tags) > 0 {
+		resp.Tags = tags
+	}
+
 	writeJSONResponse(w, http.StatusOK, resp)
 }
 
@@ -251,7 +267,9 @@ func (s *Service) Invoke(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	fn, err := s.storage.GetFunction(r.Context(), functionName)
+	functionName, qualifier := splitQualifier(functionName, r.URL.Query().Get("Qualifier"))
+
+	fn, err := s.storage.GetFunctionVersion(r.Context(), functionName, qualifier)
 	if err != nil {
 		handleGetFunctionError(w, err)
 
@@ -277,17 +295,27 @@ func (s *Service) Invoke(w http.ResponseWriter, r *http.Request) {
 		invocationType = "RequestResponse"
 	}
 
+	rc := newRuntimeContext(fn, uuid.New().String(), invokedFunctionArn(fn, qualifier))
+
 	switch invocationType {
 	case "DryRun":
-		writeInvokeHeaders(w)
+		writeInvokeHeaders(w, fn.Version, rc.requestID)
 		w.WriteHeader(http.StatusNoContent)
 	case "Event":
-		s.invokeAsync(functionName, fn.InvokeEndpoint, payload)
-		writeInvokeHeaders(w)
+		writeInvokeHeaders(w, fn.Version, rc.requestID)
+		s.invokeAsync(newAsyncInvocation(fn, rc, payload))
 		w.WriteHeader(http.StatusAccepted)
 		_, _ = w.Write([]byte("{}"))
 	default:
-		s.invokeSync(r.Context(), w, fn.InvokeEndpoint, payload)
+		if !s.acquireConcurrency(r.Context(), fn.FunctionName) {
+			writeThrottledError(w)
+
+			return
+		}
+
+		defer s.releaseConcurrency(fn.FunctionName)
+
+		s.invokeSync(r.Context(), w, fn, rc, payload, r.Header.Get("X-Amz-Log-Type") == "Tail")
 	}
 }
 
@@ -309,46 +337,16 @@ func handleGetFunctionError(w http.ResponseWriter, err error) {
 }
 
 // writeInvokeHeaders writes common invoke response headers.
-func writeInvokeHeaders(w http.ResponseWriter) {
+func writeInvokeHeaders(w http.ResponseWriter, version, requestID string) {
 	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Amz-Executed-Version", "$LATEST")
-	w.Header().Set("X-Amz-Request-Id", uuid.New().String())
+	w.Header().Set("X-Amz-Executed-Version", version)
+	w.Header().Set("X-Amz-Request-Id", requestID)
 }
 
-// invokeAsync invokes the function asynchronously.
-func (s *Service) invokeAsync(functionName, endpoint string, payload []byte) {
-	payloadCopy := make([]byte, len(payload))
-	copy(payloadCopy, payload)
-
-	go func() {
-		req, err := http.NewRequestWithContext(
-			context.Background(),
-			http.MethodPost,
-			endpoint,
-			bytes.NewReader(payloadCopy),
-		)
-		if err != nil {
-			slog.Error("async invoke failed to create request", "function", functionName, "error", err)
-
-			return
-		}
-
-		req.Header.Set("Content-Type", "application/json")
-
-		resp, err := http.DefaultClient.Do(req)
-		if err != nil {
-			slog.Error("async invoke failed", "function", functionName, "error", err)
-
-			return
-		}
-
-		_ = resp.Body.Close()
-	}()
-}
-
-// invokeSync invokes the function synchronously and writes the response.
-func (s *Service) invokeSync(ctx context.Context, w http.ResponseWriter, endpoint string, payload []byte) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+// invokeSync invokes the function synchronously and writes the response, with the
+// tail of the invocation log if tail is set.
+func (s *Service) invokeSync(ctx context.Context, w http.ResponseWriter, fn *Function, rc *runtimeContext, payload []byte, tail bool) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fn.InvokeEndpoint, bytes.NewReader(payload))
 	if err != nil {
 		writeFunctionError(w, ErrServiceException,
 			fmt.Sprintf("Failed to create request: %v", err), http.StatusInternalServerError)
@@ -357,6 +355,9 @@ func (s *Service) invokeSync(ctx context.Context, w http.ResponseWriter, endpoin
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	rc.setHeaders(req.Header)
+
+	start := time.Now()
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
@@ -376,7 +377,12 @@ func (s *Service) invokeSync(ctx context.Context, w http.ResponseWriter, endpoin
 		return
 	}
 
-	writeInvokeHeaders(w)
+	writeInvokeHeaders(w, fn.Version, rc.requestID)
+
+	if tail {
+		w.Header().Set("X-Amz-Log-Result", logTail(fn, rc, resp.Header.Get(headerRuntimeLogs), time.Since(start)))
+	}
+
 	w.WriteHeader(http.StatusOK)
 
 	if len(respBody) == 0 {
@@ -426,51 +432,69 @@ func extractFunctionNameFromInvokePath(path string) string {
 	return ""
 }
 
+// splitQualifier splits a function name, partial ARN or ARN, such as my-function:prod,
+// into the function name and its version or alias qualifier. A Qualifier parameter
+// takes precedence over the qualifier of the name.
+func splitQualifier(nameOrArn, qualifier string) (string, string) {
+	parts := strings.Split(nameOrArn, ":")
+	if i := slices.Index(parts, "function"); i >= 0 && i+1 < len(parts) {
+		parts = parts[i+1:]
+	}
+
+	if qualifier == "" && len(parts) > 1 {
+		qualifier = parts[1]
+	}
+
+	return parts[0], qualifier
+}
+
 // functionToCreateResponse converts a Function to CreateFunctionResponse.
 func functionToCreateResponse(fn *Function) *CreateFunctionResponse {
 	return &CreateFunctionResponse{
-		FunctionName:    fn.FunctionName,
-		FunctionArn:     fn.FunctionArn,
-		Runtime:         fn.Runtime,
-		Role:            fn.Role,
-		Handler:         fn.Handler,
-		CodeSize:        fn.CodeSize,
-		Description:     fn.Description,
-		Timeout:         fn.Timeout,
-		MemorySize:      fn.MemorySize,
-		LastModified:    fn.LastModified.Format("2006-01-02T15:04:05.000+0000"),
-		CodeSha256:      fn.CodeSha256,
-		Version:         fn.Version,
-		State:           fn.State,
-		StateReason:     fn.StateReason,
-		StateReasonCode: fn.StateReasonCode,
-		PackageType:     fn.PackageType,
-		Architectures:   fn.Architectures,
-		Environment:     fn.Environment,
+		FunctionName:     fn.FunctionName,
+		FunctionArn:      fn.FunctionArn,
+		Runtime:          fn.Runtime,
+		Role:             fn.Role,
+		Handler:          fn.Handler,
+		CodeSize:         fn.CodeSize,
+		Description:      fn.Description,
+		Timeout:          fn.Timeout,
+		MemorySize:       fn.MemorySize,
+		LastModified:     fn.LastModified.Format("2006-01-02T15:04:05.000+0000"),
+		CodeSha256:       fn.CodeSha256,
+		Version:          fn.Version,
+		State:            fn.State,
+		StateReason:      fn.StateReason,
+		StateReasonCode:  fn.StateReasonCode,
+		PackageType:      fn.PackageType,
+		Architectures:    fn.Architectures,
+		Environment:      fn.Environment,
+		DeadLetterConfig: fn.DeadLetterConfig,
 	}
 }
 
 // functionToConfiguration converts a Function to FunctionConfiguration.
 func functionToConfiguration(fn *Function) *FunctionConfiguration {
 	return &FunctionConfiguration{
-		FunctionName:    fn.FunctionName,
-		FunctionArn:     fn.FunctionArn,
-		Runtime:         fn.Runtime,
-		Role:            fn.Role,
-		Handler:         fn.Handler,
-		CodeSize:        fn.CodeSize,
-		Description:     fn.Description,
-		Timeout:         fn.Timeout,
-		MemorySize:      fn.MemorySize,
-		LastModified:    fn.LastModified.Format("2006-01-02T15:04:05.000+0000"),
-		CodeSha256:      fn.CodeSha256,
-		Version:         fn.Version,
-		State:           fn.State,
-		StateReason:     fn.StateReason,
-		StateReasonCode: fn.StateReasonCode,
-		PackageType:     fn.PackageType,
-		Architectures:   fn.Architectures,
-		Environment:     fn.Environment,
+		FunctionName:     fn.FunctionName,
+		FunctionArn:      fn.FunctionArn,
+		Runtime:          fn.Runtime,
+		Role:             fn.Role,
+		Handler:          fn.Handler,
+		CodeSize:         fn.CodeSize,
+		Description:      fn.Description,
+		Timeout:          fn.Timeout,
+		MemorySize:       fn.MemorySize,
+		LastModified:     fn.LastModified.Format("2006-01-02T15:04:05.000+0000"),
+		CodeSha256:       fn.CodeSha256,
+		Version:          fn.Version,
+		State:            fn.State,
+		StateReason:      fn.StateReason,
+		StateReasonCode:  fn.StateReasonCode,
+		PackageType:      fn.PackageType,
+		Architectures:    fn.Architectures,
+		Environment:      fn.Environment,
+		DeadLetterConfig: fn.DeadLetterConfig,
 	}
 }
 
@@ -494,6 +518,423 @@ func writeFunctionError(w http.ResponseWriter, errType, message string, status i
 	})
 }
 
+// PublishVersion handles the PublishVersion API.
+func (s *Service) PublishVersion(w http.ResponseWriter, r *http.Request) {
+	var req PublishVersionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeFunctionError(w, ErrInvalidParameterValue, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	fn, err := s.storage.PublishVersion(r.Context(), r.PathValue("functionName"), &req)
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusCreated, functionToConfiguration(fn))
+}
+
+// ListVersionsByFunction handles the ListVersionsByFunction API.
+func (s *Service) ListVersionsByFunction(w http.ResponseWriter, r *http.Request) {
+	versions, err := s.storage.ListVersionsByFunction(r.Context(), r.PathValue("functionName"))
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	versions, nextMarker := paginate(r, versions, func(fn *Function) string { return fn.Version })
+
+	configs := make([]*FunctionConfiguration, 0, len(versions))
+	for _, fn := range versions {
+		configs = append(configs, functionToConfiguration(fn))
+	}
+
+	writeJSONResponse(w, http.StatusOK, &ListVersionsByFunctionResponse{
+		Versions:   configs,
+		NextMarker: nextMarker,
+	})
+}
+
+// CreateAlias handles the CreateAlias API.
+func (s *Service) CreateAlias(w http.ResponseWriter, r *http.Request) {
+	var req AliasRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeFunctionError(w, ErrInvalidParameterValue, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if !aliasNamePattern.MatchString(req.Name) || req.FunctionVersion == "" {
+		writeFunctionError(w, ErrInvalidParameterValue,
+			"Name must be a non-numeric alias name and FunctionVersion is required", http.StatusBadRequest)
+
+		return
+	}
+
+	alias, err := s.storage.CreateAlias(r.Context(), r.PathValue("functionName"), &req)
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusCreated, alias)
+}
+
+// GetAlias handles the GetAlias API.
+func (s *Service) GetAlias(w http.ResponseWriter, r *http.Request) {
+	alias, err := s.storage.GetAlias(r.Context(), r.PathValue("functionName"), r.PathValue("aliasName"))
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, alias)
+}
+
+// UpdateAlias handles the UpdateAlias API.
+func (s *Service) UpdateAlias(w http.ResponseWriter, r *http.Request) {
+	var req AliasRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeFunctionError(w, ErrInvalidParameterValue, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	alias, err := s.storage.UpdateAlias(r.Context(), r.PathValue("functionName"), r.PathValue("aliasName"), &req)
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, alias)
+}
+
+// DeleteAlias handles the DeleteAlias API.
+func (s *Service) DeleteAlias(w http.ResponseWriter, r *http.Request) {
+	if err := s.storage.DeleteAlias(r.Context(), r.PathValue("functionName"), r.PathValue("aliasName")); err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListAliases handles the ListAliases API.
+func (s *Service) ListAliases(w http.ResponseWriter, r *http.Request) {
+	aliases, err := s.storage.ListAliases(r.Context(), r.PathValue("functionName"), r.URL.Query().Get("FunctionVersion"))
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	aliases, nextMarker := paginate(r, aliases, func(a *Alias) string { return a.Name })
+
+	writeJSONResponse(w, http.StatusOK, &ListAliasesResponse{
+		Aliases:    aliases,
+		NextMarker: nextMarker,
+	})
+}
+
+// paginate returns the page of items after the Marker parameter of the request, of at
+// most MaxItems items, and the marker of the next page. The marker is the key of the
+// last item of a page.
+func paginate[T any](r *http.Request, items []T, key func(T) string) ([]T, string) {
+	maxItems := 50
+
+	if parsed, err := strconv.Atoi(r.URL.Query().Get("MaxItems")); err == nil && parsed > 0 {
+		maxItems = parsed
+	}
+
+	if marker := r.URL.Query().Get("Marker"); marker != "" {
+		if i := slices.IndexFunc(items, func(item T) bool { return key(item) == marker }); i >= 0 {
+			items = items[i+1:]
+		}
+	}
+
+	if len(items) <= maxItems {
+		return items, ""
+	}
+
+	return items[:maxItems], key(items[maxItems-1])
+}
+
+// PutFunctionEventInvokeConfig handles the PutFunctionEventInvokeConfig API.
+func (s *Service) PutFunctionEventInvokeConfig(w http.ResponseWriter, r *http.Request) {
+	s.writeEventInvokeConfig(w, r, s.storage.PutFunctionEventInvokeConfig)
+}
+
+// UpdateFunctionEventInvokeConfig handles the UpdateFunctionEventInvokeConfig API.
+func (s *Service) UpdateFunctionEventInvokeConfig(w http.ResponseWriter, r *http.Request) {
+	s.writeEventInvokeConfig(w, r, s.storage.UpdateFunctionEventInvokeConfig)
+}
+
+// writeEventInvokeConfig validates an asynchronous invocation configuration, stores it with
+// store and writes the stored configuration.
+func (s *Service) writeEventInvokeConfig(w http.ResponseWriter, r *http.Request,
+	store func(context.Context, string, *PutFunctionEventInvokeConfigRequest) (*EventInvokeConfig, error),
+) {
+	var req PutFunctionEventInvokeConfigRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeFunctionError(w, ErrInvalidParameterValue, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.MaximumRetryAttempts != nil && (*req.MaximumRetryAttempts < 0 || *req.MaximumRetryAttempts > 2) {
+		writeFunctionError(w, ErrInvalidParameterValue,
+			"MaximumRetryAttempts must be between 0 and 2", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.MaximumEventAgeInSeconds != nil && (*req.MaximumEventAgeInSeconds < 60 || *req.MaximumEventAgeInSeconds > 21600) {
+		writeFunctionError(w, ErrInvalidParameterValue,
+			"MaximumEventAgeInSeconds must be between 60 and 21600", http.StatusBadRequest)
+
+		return
+	}
+
+	cfg, err := store(r.Context(), r.PathValue("functionName"), &req)
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, cfg)
+}
+
+// GetFunctionEventInvokeConfig handles the GetFunctionEventInvokeConfig API.
+func (s *Service) GetFunctionEventInvokeConfig(w http.ResponseWriter, r *http.Request) {
+	cfg, err := s.storage.GetFunctionEventInvokeConfig(r.Context(), r.PathValue("functionName"))
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, cfg)
+}
+
+// DeleteFunctionEventInvokeConfig handles the DeleteFunctionEventInvokeConfig API.
+func (s *Service) DeleteFunctionEventInvokeConfig(w http.ResponseWriter, r *http.Request) {
+	if err := s.storage.DeleteFunctionEventInvokeConfig(r.Context(), r.PathValue("functionName")); err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PutFunctionConcurrency handles the PutFunctionConcurrency API.
+func (s *Service) PutFunctionConcurrency(w http.ResponseWriter, r *http.Request) {
+	var req Concurrency
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeFunctionError(w, ErrInvalidParameterValue, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.ReservedConcurrentExecutions == nil || *req.ReservedConcurrentExecutions < 0 {
+		writeFunctionError(w, ErrInvalidParameterValue,
+			"ReservedConcurrentExecutions must be greater than or equal to 0", http.StatusBadRequest)
+
+		return
+	}
+
+	concurrency, err := s.storage.PutFunctionConcurrency(r.Context(), r.PathValue("functionName"), *req.ReservedConcurrentExecutions)
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, concurrency)
+}
+
+// GetFunctionConcurrency handles the GetFunctionConcurrency API.
+func (s *Service) GetFunctionConcurrency(w http.ResponseWriter, r *http.Request) {
+	concurrency, err := s.storage.GetFunctionConcurrency(r.Context(), r.PathValue("functionName"))
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, concurrency)
+}
+
+// DeleteFunctionConcurrency handles the DeleteFunctionConcurrency API.
+func (s *Service) DeleteFunctionConcurrency(w http.ResponseWriter, r *http.Request) {
+	if err := s.storage.DeleteFunctionConcurrency(r.Context(), r.PathValue("functionName")); err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PutProvisionedConcurrencyConfig handles the PutProvisionedConcurrencyConfig API.
+func (s *Service) PutProvisionedConcurrencyConfig(w http.ResponseWriter, r *http.Request) {
+	qualifier := r.URL.Query().Get("Qualifier")
+	if qualifier == "" {
+		writeFunctionError(w, ErrInvalidParameterValue, "Qualifier is required", http.StatusBadRequest)
+
+		return
+	}
+
+	var req PutProvisionedConcurrencyConfigRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeFunctionError(w, ErrInvalidParameterValue, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.ProvisionedConcurrentExecutions < 1 {
+		writeFunctionError(w, ErrInvalidParameterValue,
+			"ProvisionedConcurrentExecutions must be greater than or equal to 1", http.StatusBadRequest)
+
+		return
+	}
+
+	cfg, err := s.storage.PutProvisionedConcurrencyConfig(r.Context(), r.PathValue("functionName"), qualifier, &req)
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusAccepted, cfg)
+}
+
+// GetProvisionedConcurrencyConfig handles the GetProvisionedConcurrencyConfig API.
+func (s *Service) GetProvisionedConcurrencyConfig(w http.ResponseWriter, r *http.Request) {
+	qualifier := r.URL.Query().Get("Qualifier")
+	if qualifier == "" {
+		writeFunctionError(w, ErrInvalidParameterValue, "Qualifier is required", http.StatusBadRequest)
+
+		return
+	}
+
+	cfg, err := s.storage.GetProvisionedConcurrencyConfig(r.Context(), r.PathValue("functionName"), qualifier)
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, cfg)
+}
+
+// CreateFunctionURLConfig handles the CreateFunctionUrlConfig API.
+func (s *Service) CreateFunctionURLConfig(w http.ResponseWriter, r *http.Request) {
+	var req FunctionURLConfigRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeFunctionError(w, ErrInvalidParameterValue, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.AuthType == "" {
+		writeFunctionError(w, ErrInvalidParameterValue, "AuthType is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if !validFunctionURLConfig(w, &req) {
+		return
+	}
+
+	cfg, err := s.storage.CreateFunctionURLConfig(r.Context(), r.PathValue("functionName"), &req)
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusCreated, cfg)
+}
+
+// GetFunctionURLConfig handles the GetFunctionUrlConfig API.
+func (s *Service) GetFunctionURLConfig(w http.ResponseWriter, r *http.Request) {
+	cfg, err := s.storage.GetFunctionURLConfig(r.Context(), r.PathValue("functionName"))
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, cfg)
+}
+
+// UpdateFunctionURLConfig handles the UpdateFunctionUrlConfig API.
+func (s *Service) UpdateFunctionURLConfig(w http.ResponseWriter, r *http.Request) {
+	var req FunctionURLConfigRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeFunctionError(w, ErrInvalidParameterValue, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if !validFunctionURLConfig(w, &req) {
+		return
+	}
+
+	cfg, err := s.storage.UpdateFunctionURLConfig(r.Context(), r.PathValue("functionName"), &req)
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, cfg)
+}
+
+// DeleteFunctionURLConfig handles the DeleteFunctionUrlConfig API.
+func (s *Service) DeleteFunctionURLConfig(w http.ResponseWriter, r *http.Request) {
+	if err := s.storage.DeleteFunctionURLConfig(r.Context(), r.PathValue("functionName")); err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validFunctionURLConfig validates the settings of a function URL, writing an error if they are invalid.
+func validFunctionURLConfig(w http.ResponseWriter, req *FunctionURLConfigRequest) bool {
+	switch req.AuthType {
+	case "", "NONE", "AWS_IAM":
+	default:
+		writeFunctionError(w, ErrInvalidParameterValue,
+			fmt.Sprintf("AuthType %s is not supported. Valid values are NONE and AWS_IAM", req.AuthType), http.StatusBadRequest)
+
+		return false
+	}
+
+	switch req.InvokeMode {
+	case "", "BUFFERED", "RESPONSE_STREAM":
+	default:
+		writeFunctionError(w, ErrInvalidParameterValue,
+			fmt.Sprintf("InvokeMode %s is not supported. Valid values are BUFFERED and RESPONSE_STREAM", req.InvokeMode), http.StatusBadRequest)
+
+		return false
+	}
+
+	return true
+}
+
 // CreateEventSourceMapping handles the CreateEventSourceMapping API.
 func (s *Service) CreateEventSourceMapping(w http.ResponseWriter, r *http.Request) {
 	var req CreateEventSourceMappingRequest
@@ -620,13 +1061,71 @@ func (s *Service) UpdateEventSourceMapping(w http.ResponseWriter, r *http.Reques
 	writeJSONResponse(w, http.StatusOK, mapping)
 }
 
+// TagResource handles the TagResource API.
+func (s *Service) TagResource(w http.ResponseWriter, r *http.Request) {
+	var req TagResourceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeFunctionError(w, ErrInvalidParameterValue, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if len(req.Tags) == 0 {
+		writeFunctionError(w, ErrInvalidParameterValue, "Tags is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.TagResource(r.Context(), r.PathValue("arn"), req.Tags); err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UntagResource handles the UntagResource API.
+func (s *Service) UntagResource(w http.ResponseWriter, r *http.Request) {
+	tagKeys := r.URL.Query()["tagKeys"]
+	if len(tagKeys) == 0 {
+		writeFunctionError(w, ErrInvalidParameterValue, "TagKeys is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.UntagResource(r.Context(), r.PathValue("arn"), tagKeys); err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListTags handles the ListTags API.
+func (s *Service) ListTags(w http.ResponseWriter, r *http.Request) {
+	tags, err := s.storage.ListTags(r.Context(), r.PathValue("arn"))
+	if err != nil {
+		handleFunctionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, &ListTagsResponse{Tags: tags})
+}
+
 // handleFunctionError handles FunctionError and writes appropriate response.
 func handleFunctionError(w http.ResponseWriter, err error) {
 	var lambdaErr *FunctionError
 	if errors.As(err, &lambdaErr) {
 		status := http.StatusBadRequest
-		if lambdaErr.Type == ErrResourceNotFound {
+
+		switch lambdaErr.Type {
+		case ErrResourceNotFound, ErrProvisionedConcurrencyConfigNotFound:
 			status = http.StatusNotFound
+		case ErrResourceConflict:
+			status = http.StatusConflict
 		}
 
 		writeFunctionError(w, lambdaErr.Type, lambdaErr.Message, status)
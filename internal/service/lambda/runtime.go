@@ -0,0 +1,171 @@
+package lambda
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// Headers that pass the invocation context to the invoke endpoint of a function. The
+// Lambda-Runtime-* headers are those of the Lambda runtime API.
+const (
+	headerRuntimeRequestID   = "Lambda-Runtime-Aws-Request-Id"
+	headerRuntimeFunctionArn = "Lambda-Runtime-Invoked-Function-Arn"
+	headerRuntimeDeadline    = "Lambda-Runtime-Deadline-Ms"
+	headerRuntimeEnvironment = "X-Kumo-Lambda-Environment"
+)
+
+// headerRuntimeLogs is the response header in which an invoke endpoint may return the
+// base64-encoded log output of an invocation, for log tails.
+const headerRuntimeLogs = "X-Kumo-Lambda-Logs"
+
+// maxLogTail is the size of the log tail returned by Invoke with X-Amz-Log-Type: Tail.
+const maxLogTail = 4096
+
+// runtimeContext is what a Lambda runtime would know about an invocation: its request ID,
+// the ARN it was invoked with, its timeout, and the environment of the function.
+type runtimeContext struct {
+	requestID   string
+	invokedArn  string
+	timeout     time.Duration
+	environment map[string]string
+}
+
+// newRuntimeContext returns the runtime context of an invocation of a version of a
+// function. The environment has the variables of the function and the reserved
+// variables that Lambda sets, such as AWS_LAMBDA_FUNCTION_NAME.
+func newRuntimeContext(fn *Function, requestID, invokedArn string) *runtimeContext {
+	region := service.DefaultRegion
+	if parts := strings.Split(fn.FunctionArn, ":"); len(parts) > 3 {
+		region = parts[3]
+	}
+
+	environment := make(map[string]string)
+	if fn.Environment != nil {
+		maps.Copy(environment, fn.Environment.Variables)
+	}
+
+	maps.Copy(environment, map[string]string{
+		"AWS_LAMBDA_FUNCTION_NAME":        fn.FunctionName,
+		"AWS_LAMBDA_FUNCTION_VERSION":     fn.Version,
+		"AWS_LAMBDA_FUNCTION_MEMORY_SIZE": strconv.Itoa(fn.MemorySize),
+		"AWS_LAMBDA_LOG_GROUP_NAME":       "/aws/lambda/" + fn.FunctionName,
+		"AWS_REGION":                      region,
+		"AWS_DEFAULT_REGION":              region,
+		"_HANDLER":                        fn.Handler,
+	})
+
+	if fn.Runtime != "" {
+		environment["AWS_EXECUTION_ENV"] = "AWS_Lambda_" + fn.Runtime
+	}
+
+	return &runtimeContext{
+		requestID:   requestID,
+		invokedArn:  invokedArn,
+		timeout:     time.Duration(fn.Timeout) * time.Second,
+		environment: environment,
+	}
+}
+
+// setHeaders sets the runtime context headers of a request to the invoke endpoint. The
+// deadline is the function timeout from now; the environment is base64-encoded JSON.
+func (rc *runtimeContext) setHeaders(h http.Header) {
+	h.Set(headerRuntimeRequestID, rc.requestID)
+	h.Set(headerRuntimeFunctionArn, rc.invokedArn)
+	h.Set(headerRuntimeDeadline, strconv.FormatInt(time.Now().Add(rc.timeout).UnixMilli(), 10))
+
+	if environment, err := json.Marshal(rc.environment); err == nil {
+		h.Set(headerRuntimeEnvironment, base64.StdEncoding.EncodeToString(environment))
+	}
+}
+
+// invokedFunctionArn returns the ARN a function was invoked with: unqualified, or
+// qualified with the version or alias of the invocation.
+func invokedFunctionArn(fn *Function, qualifier string) string {
+	arn := fn.FunctionArn
+	if fn.Version != "$LATEST" {
+		arn = arn[:strings.LastIndex(arn, ":")]
+	}
+
+	if qualifier != "" {
+		arn += ":" + qualifier
+	}
+
+	return arn
+}
+
+// invocationResult is the outcome of a request to the invoke endpoint of a function.
+type invocationResult struct {
+	statusCode int
+	payload    []byte
+	err        error
+}
+
+// failed reports whether the invocation failed, so that an asynchronous invocation is retried.
+func (r *invocationResult) failed() bool {
+	return r.err != nil || r.statusCode >= http.StatusMultipleChoices
+}
+
+// postInvocation sends the payload to the invoke endpoint of the function.
+func postInvocation(ctx context.Context, endpoint string, rc *runtimeContext, payload []byte) invocationResult {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return invocationResult{err: fmt.Errorf("failed to create request: %w", err)}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	rc.setHeaders(req.Header)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return invocationResult{err: fmt.Errorf("failed to invoke endpoint: %w", err)}
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return invocationResult{err: fmt.Errorf("failed to read response: %w", err)}
+	}
+
+	return invocationResult{statusCode: resp.StatusCode, payload: body}
+}
+
+// logTail returns the base64-encoded last 4 KB of the log of an invocation: the log output
+// returned by the invoke endpoint between the START, END and REPORT lines that Lambda writes.
+func logTail(fn *Function, rc *runtimeContext, output string, duration time.Duration) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "START RequestId: %s Version: %s\n", rc.requestID, fn.Version)
+
+	if decoded, err := base64.StdEncoding.DecodeString(output); err == nil && len(decoded) > 0 {
+		b.Write(decoded)
+
+		if !bytes.HasSuffix(decoded, []byte("\n")) {
+			b.WriteString("\n")
+		}
+	}
+
+	ms := float64(duration.Microseconds()) / 1000
+	fmt.Fprintf(&b, "END RequestId: %s\n", rc.requestID)
+	fmt.Fprintf(&b, "REPORT RequestId: %s\tDuration: %.2f ms\tBilled Duration: %d ms\tMemory Size: %d MB\tMax Memory Used: %d MB\t\n",
+		rc.requestID, ms, int(math.Ceil(ms)), fn.MemorySize, fn.MemorySize)
+
+	log := b.String()
+	if len(log) > maxLogTail {
+		log = log[len(log)-maxLogTail:]
+	}
+
+	return base64.StdEncoding.EncodeToString([]byte(log))
+}
@@ -1,9 +1,10 @@
 package lambda
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"os"
+	"sync"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -14,25 +15,31 @@ const defaultBaseURL = "http://localhost:4566"
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"lambda"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
 
-	service.Register(New(NewMemoryStorage(defaultBaseURL, opts...), defaultBaseURL))
+		return []service.Service{New(NewMemoryStorage(defaultBaseURL, opts...), defaultBaseURL)}
+	})
 }
 
 // Service implements the Lambda service.
 type Service struct {
 	storage Storage
 	baseURL string
+
+	mu       sync.Mutex
+	inFlight map[string]int // invocations in flight by function name
 }
 
 // New creates a new Lambda service.
 func New(storage Storage, baseURL string) *Service {
 	return &Service{
-		storage: storage,
-		baseURL: baseURL,
+		storage:  storage,
+		baseURL:  baseURL,
+		inFlight: make(map[string]int),
 	}
 }
 
@@ -52,11 +59,39 @@ func (s *Service) RegisterRoutes(r service.Router) {
 		r.Handle("PUT", prefix+"/2015-03-31/functions/{functionName}/code", s.UpdateFunctionCode)
 		r.Handle("PUT", prefix+"/2015-03-31/functions/{functionName}/configuration", s.UpdateFunctionConfiguration)
 		r.Handle("POST", prefix+"/2015-03-31/functions/{functionName}/invocations", s.Invoke)
+		r.Handle("POST", prefix+"/2015-03-31/functions/{functionName}/versions", s.PublishVersion)
+		r.Handle("GET", prefix+"/2015-03-31/functions/{functionName}/versions", s.ListVersionsByFunction)
+		r.Handle("POST", prefix+"/2015-03-31/functions/{functionName}/aliases", s.CreateAlias)
+		r.Handle("GET", prefix+"/2015-03-31/functions/{functionName}/aliases", s.ListAliases)
+		r.Handle("GET", prefix+"/2015-03-31/functions/{functionName}/aliases/{aliasName}", s.GetAlias)
+		r.Handle("PUT", prefix+"/2015-03-31/functions/{functionName}/aliases/{aliasName}", s.UpdateAlias)
+		r.Handle("DELETE", prefix+"/2015-03-31/functions/{functionName}/aliases/{aliasName}", s.DeleteAlias)
+		r.Handle("POST", prefix+"/2021-10-31/functions/{functionName}/url", s.CreateFunctionURLConfig)
+		r.Handle("GET", prefix+"/2021-10-31/functions/{functionName}/url", s.GetFunctionURLConfig)
+		r.Handle("PUT", prefix+"/2021-10-31/functions/{functionName}/url", s.UpdateFunctionURLConfig)
+		r.Handle("DELETE", prefix+"/2021-10-31/functions/{functionName}/url", s.DeleteFunctionURLConfig)
+		r.Handle("PUT", prefix+"/2017-10-31/functions/{functionName}/concurrency", s.PutFunctionConcurrency)
+		r.Handle("GET", prefix+"/2019-09-30/functions/{functionName}/concurrency", s.GetFunctionConcurrency)
+		r.Handle("DELETE", prefix+"/2017-10-31/functions/{functionName}/concurrency", s.DeleteFunctionConcurrency)
+		r.Handle("PUT", prefix+"/2019-09-30/functions/{functionName}/provisioned-concurrency", s.PutProvisionedConcurrencyConfig)
+		r.Handle("GET", prefix+"/2019-09-30/functions/{functionName}/provisioned-concurrency", s.GetProvisionedConcurrencyConfig)
+		r.Handle("PUT", prefix+"/2019-09-25/functions/{functionName}/event-invoke-config", s.PutFunctionEventInvokeConfig)
+		r.Handle("POST", prefix+"/2019-09-25/functions/{functionName}/event-invoke-config", s.UpdateFunctionEventInvokeConfig)
+		r.Handle("GET", prefix+"/2019-09-25/functions/{functionName}/event-invoke-config", s.GetFunctionEventInvokeConfig)
+		r.Handle("DELETE", prefix+"/2019-09-25/functions/{functionName}/event-invoke-config", s.DeleteFunctionEventInvokeConfig)
 		r.Handle("POST", prefix+"/2015-03-31/event-source-mappings", s.CreateEventSourceMapping)
 		r.Handle("GET", prefix+"/2015-03-31/event-source-mappings", s.ListEventSourceMappings)
 		r.Handle("GET", prefix+"/2015-03-31/event-source-mappings/{uuid}", s.GetEventSourceMapping)
 		r.Handle("PUT", prefix+"/2015-03-31/event-source-mappings/{uuid}", s.UpdateEventSourceMapping)
 		r.Handle("DELETE", prefix+"/2015-03-31/event-source-mappings/{uuid}", s.DeleteEventSourceMapping)
+		r.Handle("POST", prefix+"/2017-03-31/tags/{arn...}", s.TagResource)
+		r.Handle("DELETE", prefix+"/2017-03-31/tags/{arn...}", s.UntagResource)
+		r.Handle("GET", prefix+"/2017-03-31/tags/{arn...}", s.ListTags)
+	}
+
+	// Function URLs are served at {baseURL}/lambda-url/{urlID}/.
+	for _, method := range functionURLMethods {
+		r.Handle(method, functionURLPath+"{urlID}/{path...}", s.InvokeFunctionURL)
 	}
 }
 
@@ -70,3 +105,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
@@ -6,9 +6,17 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
+
+	"github.com/sivchari/kumo/internal/service"
 	"github.com/sivchari/kumo/internal/storage"
 )
 
@@ -21,12 +29,48 @@ type Storage interface {
 	UpdateFunctionCode(ctx context.Context, name string, req *UpdateFunctionCodeRequest) (*Function, error)
 	UpdateFunctionConfiguration(ctx context.Context, name string, req *UpdateFunctionConfigurationRequest) (*Function, error)
 
+	// Versions and aliases
+	GetFunctionVersion(ctx context.Context, name, qualifier string) (*Function, error)
+	PublishVersion(ctx context.Context, name string, req *PublishVersionRequest) (*Function, error)
+	ListVersionsByFunction(ctx context.Context, name string) ([]*Function, error)
+	CreateAlias(ctx context.Context, name string, req *AliasRequest) (*Alias, error)
+	GetAlias(ctx context.Context, name, alias string) (*Alias, error)
+	UpdateAlias(ctx context.Context, name, alias string, req *AliasRequest) (*Alias, error)
+	DeleteAlias(ctx context.Context, name, alias string) error
+	ListAliases(ctx context.Context, name, functionVersion string) ([]*Alias, error)
+
+	// Asynchronous invocation configuration
+	PutFunctionEventInvokeConfig(ctx context.Context, name string, req *PutFunctionEventInvokeConfigRequest) (*EventInvokeConfig, error)
+	UpdateFunctionEventInvokeConfig(ctx context.Context, name string, req *PutFunctionEventInvokeConfigRequest) (*EventInvokeConfig, error)
+	GetFunctionEventInvokeConfig(ctx context.Context, name string) (*EventInvokeConfig, error)
+	DeleteFunctionEventInvokeConfig(ctx context.Context, name string) error
+
+	// Concurrency
+	PutFunctionConcurrency(ctx context.Context, name string, reserved int) (*Concurrency, error)
+	GetFunctionConcurrency(ctx context.Context, name string) (*Concurrency, error)
+	DeleteFunctionConcurrency(ctx context.Context, name string) error
+	PutProvisionedConcurrencyConfig(ctx context.Context, name, qualifier string, req *PutProvisionedConcurrencyConfigRequest) (*ProvisionedConcurrencyConfig, error)
+	GetProvisionedConcurrencyConfig(ctx context.Context, name, qualifier string) (*ProvisionedConcurrencyConfig, error)
+
+	// Function URL operations
+	CreateFunctionURLConfig(ctx context.Context, name string, req *FunctionURLConfigRequest) (*FunctionURLConfig, error)
+	GetFunctionURLConfig(ctx context.Context, name string) (*FunctionURLConfig, error)
+	UpdateFunctionURLConfig(ctx context.Context, name string, req *FunctionURLConfigRequest) (*FunctionURLConfig, error)
+	DeleteFunctionURLConfig(ctx context.Context, name string) error
+	GetFunctionByURLID(ctx context.Context, urlID string) (*Function, error)
+
 	// EventSourceMapping operations
 	CreateEventSourceMapping(ctx context.Context, req *CreateEventSourceMappingRequest) (*EventSourceMapping, error)
 	GetEventSourceMapping(ctx context.Context, uuid string) (*EventSourceMapping, error)
 	DeleteEventSourceMapping(ctx context.Context, uuid string) error
 	ListEventSourceMappings(ctx context.Context, functionName, eventSourceArn, marker string, maxItems int) ([]*EventSourceMapping, string, error)
 	UpdateEventSourceMapping(ctx context.Context, uuid string, req *UpdateEventSourceMappingRequest) (*EventSourceMapping, error)
+
+	// Tagging
+	TagResource(ctx context.Context, arn string, tags map[string]string) error
+	UntagResource(ctx context.Context, arn string, tagKeys []string) error
+	ListTags(ctx context.Context, arn string) (map[string]string, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -51,8 +95,6 @@ type MemoryStorage struct {
 	Functions           map[string]*Function           `json:"functions"`
 	EventSourceMappings map[string]*EventSourceMapping `json:"eventSourceMappings"`
 	baseURL             string
-	region              string
-	accountID           string
 	dataDir             string
 }
 
@@ -62,8 +104,6 @@ func NewMemoryStorage(baseURL string, opts ...Option) *MemoryStorage {
 		Functions:           make(map[string]*Function),
 		EventSourceMappings: make(map[string]*EventSourceMapping),
 		baseURL:             baseURL,
-		region:              "us-east-1",
-		accountID:           "000000000000",
 	}
 	for _, o := range opts {
 		o(s)
@@ -128,8 +168,19 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Functions = make(map[string]*Function)
+	s.EventSourceMappings = make(map[string]*EventSourceMapping)
+
+	return nil
+}
+
 // CreateFunction creates a new Lambda function.
-func (s *MemoryStorage) CreateFunction(_ context.Context, req *CreateFunctionRequest) (*Function, error) {
+func (s *MemoryStorage) CreateFunction(ctx context.Context, req *CreateFunctionRequest) (*Function, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -140,14 +191,15 @@ func (s *MemoryStorage) CreateFunction(_ context.Context, req *CreateFunctionReq
 		}
 	}
 
-	fn := s.buildFunction(req)
+	fn := s.buildFunction(ctx, req)
 	s.Functions[req.FunctionName] = fn
 
 	return fn, nil
 }
 
-// buildFunction creates a Function from a CreateFunctionRequest with defaults applied.
-func (s *MemoryStorage) buildFunction(req *CreateFunctionRequest) *Function {
+// buildFunction creates a Function from a CreateFunctionRequest with defaults applied,
+// in the account and region of the request.
+func (s *MemoryStorage) buildFunction(ctx context.Context, req *CreateFunctionRequest) *Function {
 	codeHash := sha256.Sum256(req.Code.ZipFile)
 	codeSha256 := base64.StdEncoding.EncodeToString(codeHash[:])
 
@@ -172,23 +224,25 @@ func (s *MemoryStorage) buildFunction(req *CreateFunctionRequest) *Function {
 	}
 
 	return &Function{
-		FunctionName:   req.FunctionName,
-		FunctionArn:    fmt.Sprintf("arn:aws:lambda:%s:%s:function:%s", s.region, s.accountID, req.FunctionName),
-		Runtime:        req.Runtime,
-		Role:           req.Role,
-		Handler:        req.Handler,
-		Description:    req.Description,
-		Timeout:        timeout,
-		MemorySize:     memorySize,
-		CodeSize:       int64(len(req.Code.ZipFile)),
-		CodeSha256:     codeSha256,
-		Version:        "$LATEST",
-		LastModified:   time.Now().UTC(),
-		State:          "Active",
-		PackageType:    packageType,
-		Architectures:  architectures,
-		Environment:    req.Environment,
-		InvokeEndpoint: req.InvokeEndpoint,
+		FunctionName:     req.FunctionName,
+		FunctionArn:      fmt.Sprintf("arn:aws:lambda:%s:%s:function:%s", service.Region(ctx), service.AccountID(ctx), req.FunctionName),
+		Runtime:          req.Runtime,
+		Role:             req.Role,
+		Handler:          req.Handler,
+		Description:      req.Description,
+		Timeout:          timeout,
+		MemorySize:       memorySize,
+		CodeSize:         int64(len(req.Code.ZipFile)),
+		CodeSha256:       codeSha256,
+		Version:          "$LATEST",
+		LastModified:     time.Now().UTC(),
+		State:            "Active",
+		PackageType:      packageType,
+		Architectures:    architectures,
+		Environment:      req.Environment,
+		InvokeEndpoint:   req.InvokeEndpoint,
+		DeadLetterConfig: req.DeadLetterConfig,
+		Tags:             maps.Clone(req.Tags),
 		Code: &FunctionCode{
 			ZipFile:         req.Code.ZipFile,
 			S3Bucket:        req.Code.S3Bucket,
@@ -365,11 +419,743 @@ func (s *MemoryStorage) UpdateFunctionConfiguration(_ context.Context, name stri
 		fn.InvokeEndpoint = req.InvokeEndpoint
 	}
 
+	if req.DeadLetterConfig != nil {
+		fn.DeadLetterConfig = req.DeadLetterConfig
+	}
+
 	fn.LastModified = time.Now().UTC()
 
 	return fn, nil
 }
 
+// GetFunctionVersion retrieves the version of a function that a qualifier refers to:
+// $LATEST when it is empty, a published version, or the version an alias points to.
+func (s *MemoryStorage) GetFunctionVersion(_ context.Context, name, qualifier string) (*Function, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fn, exists := s.Functions[name]
+	if !exists {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	if alias, ok := fn.Aliases[qualifier]; ok {
+		qualifier = alias.routeVersion()
+	}
+
+	version := functionVersion(fn, qualifier)
+	if version == nil {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s:%s", fn.FunctionArn, qualifier),
+		}
+	}
+
+	return version, nil
+}
+
+// PublishVersion publishes a snapshot of the code and configuration of $LATEST as a new
+// version, or returns the latest version if $LATEST has not changed since it was published.
+func (s *MemoryStorage) PublishVersion(_ context.Context, name string, req *PublishVersionRequest) (*Function, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, exists := s.Functions[name]
+	if !exists {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	if req.CodeSha256 != "" && req.CodeSha256 != fn.CodeSha256 {
+		return nil, &FunctionError{
+			Type:    ErrInvalidParameterValue,
+			Message: fmt.Sprintf("CodeSHA256 (%s) is different from current CodeSHA256 in $LATEST (%s). Please try again with the CodeSHA256 in $LATEST.", req.CodeSha256, fn.CodeSha256),
+		}
+	}
+
+	if n := len(fn.Versions); n > 0 && fn.Versions[n-1].LastModified.Equal(fn.LastModified) {
+		return fn.Versions[n-1], nil
+	}
+
+	version := *fn
+	version.Version = strconv.Itoa(len(fn.Versions) + 1)
+	version.FunctionArn = fn.FunctionArn + ":" + version.Version
+	version.Code = &FunctionCode{}
+	*version.Code = *fn.Code
+	version.Architectures = slices.Clone(fn.Architectures)
+	version.EventInvokeConfig = nil
+	version.URLConfig = nil
+	version.Versions = nil
+	version.Aliases = nil
+	version.ReservedConcurrentExecutions = nil
+	version.ProvisionedConcurrency = nil
+	version.Tags = nil
+
+	if req.Description != "" {
+		version.Description = req.Description
+	}
+
+	fn.Versions = append(fn.Versions, &version)
+
+	return &version, nil
+}
+
+// ListVersionsByFunction lists $LATEST and the published versions of a function.
+func (s *MemoryStorage) ListVersionsByFunction(_ context.Context, name string) ([]*Function, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fn, exists := s.Functions[name]
+	if !exists {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	return append([]*Function{fn}, fn.Versions...), nil
+}
+
+// CreateAlias creates an alias for a version of a function.
+func (s *MemoryStorage) CreateAlias(_ context.Context, name string, req *AliasRequest) (*Alias, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, exists := s.Functions[name]
+	if !exists {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	if _, exists := fn.Aliases[req.Name]; exists {
+		return nil, &FunctionError{
+			Type:    ErrResourceConflict,
+			Message: fmt.Sprintf("Alias already exists: %s:%s", fn.FunctionArn, req.Name),
+		}
+	}
+
+	if err := validateAliasVersions(fn, req.FunctionVersion, req.RoutingConfig); err != nil {
+		return nil, err
+	}
+
+	alias := &Alias{
+		AliasArn:        fn.FunctionArn + ":" + req.Name,
+		Name:            req.Name,
+		FunctionVersion: req.FunctionVersion,
+		Description:     req.Description,
+		RoutingConfig:   req.RoutingConfig,
+		RevisionID:      uuid.NewString(),
+	}
+
+	if fn.Aliases == nil {
+		fn.Aliases = make(map[string]*Alias)
+	}
+
+	fn.Aliases[req.Name] = alias
+
+	return alias, nil
+}
+
+// GetAlias retrieves an alias of a function.
+func (s *MemoryStorage) GetAlias(_ context.Context, name, alias string) (*Alias, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, a, err := s.functionAlias(name, alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// UpdateAlias updates the given settings of an alias of a function.
+func (s *MemoryStorage) UpdateAlias(_ context.Context, name, alias string, req *AliasRequest) (*Alias, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, a, err := s.functionAlias(name, alias)
+	if err != nil {
+		return nil, err
+	}
+
+	functionVersion := a.FunctionVersion
+	if req.FunctionVersion != "" {
+		functionVersion = req.FunctionVersion
+	}
+
+	routingConfig := a.RoutingConfig
+	if req.RoutingConfig != nil {
+		routingConfig = req.RoutingConfig
+	}
+
+	if err := validateAliasVersions(fn, functionVersion, routingConfig); err != nil {
+		return nil, err
+	}
+
+	a.FunctionVersion = functionVersion
+	a.RoutingConfig = routingConfig
+
+	if req.Description != "" {
+		a.Description = req.Description
+	}
+
+	a.RevisionID = uuid.NewString()
+
+	return a, nil
+}
+
+// DeleteAlias deletes an alias of a function.
+func (s *MemoryStorage) DeleteAlias(_ context.Context, name, alias string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, _, err := s.functionAlias(name, alias)
+	if err != nil {
+		return err
+	}
+
+	delete(fn.Aliases, alias)
+	delete(fn.ProvisionedConcurrency, alias)
+
+	return nil
+}
+
+// ListAliases lists the aliases of a function, sorted by name, optionally only those
+// pointing to the given version.
+func (s *MemoryStorage) ListAliases(_ context.Context, name, functionVersion string) ([]*Alias, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fn, exists := s.Functions[name]
+	if !exists {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	aliases := make([]*Alias, 0, len(fn.Aliases))
+
+	for _, a := range fn.Aliases {
+		if functionVersion == "" || a.FunctionVersion == functionVersion {
+			aliases = append(aliases, a)
+		}
+	}
+
+	slices.SortFunc(aliases, func(a, b *Alias) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return aliases, nil
+}
+
+// functionAlias returns a function and one of its aliases. The caller must hold the lock.
+func (s *MemoryStorage) functionAlias(name, alias string) (*Function, *Alias, error) {
+	fn, exists := s.Functions[name]
+	if !exists {
+		return nil, nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	a, exists := fn.Aliases[alias]
+	if !exists {
+		return nil, nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Alias not found: %s:%s", fn.FunctionArn, alias),
+		}
+	}
+
+	return fn, a, nil
+}
+
+// functionVersion returns $LATEST or a published version of a function, or nil if it does not exist.
+func functionVersion(fn *Function, version string) *Function {
+	if version == "" || version == "$LATEST" {
+		return fn
+	}
+
+	n, err := strconv.Atoi(version)
+	if err != nil || n < 1 || n > len(fn.Versions) {
+		return nil
+	}
+
+	return fn.Versions[n-1]
+}
+
+// qualifiedArn returns the ARN of a function version including its qualifier, which the
+// ARN of $LATEST omits.
+func qualifiedArn(fn *Function) string {
+	if fn.Version == "$LATEST" {
+		return fn.FunctionArn + ":$LATEST"
+	}
+
+	return fn.FunctionArn
+}
+
+// validateAliasVersions checks that the versions an alias points to exist.
+func validateAliasVersions(fn *Function, version string, routing *AliasRoutingConfig) error {
+	if functionVersion(fn, version) == nil {
+		return &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s:%s", fn.FunctionArn, version),
+		}
+	}
+
+	if routing == nil {
+		return nil
+	}
+
+	for v, weight := range routing.AdditionalVersionWeights {
+		if v == "$LATEST" || functionVersion(fn, v) == nil {
+			return &FunctionError{
+				Type:    ErrInvalidParameterValue,
+				Message: fmt.Sprintf("Invalid additional version %s in the routing configuration", v),
+			}
+		}
+
+		if weight < 0 || weight > 1 {
+			return &FunctionError{
+				Type:    ErrInvalidParameterValue,
+				Message: "Additional version weights must be between 0.0 and 1.0",
+			}
+		}
+	}
+
+	return nil
+}
+
+// routeVersion returns the version that an invocation of the alias goes to, shifting
+// invocations to the additional versions of its routing configuration by their weights.
+func (a *Alias) routeVersion() string {
+	if a.RoutingConfig == nil {
+		return a.FunctionVersion
+	}
+
+	r := rand.Float64() //nolint:gosec // Traffic shifting does not need a secure random number.
+
+	for v, weight := range a.RoutingConfig.AdditionalVersionWeights {
+		if r < weight {
+			return v
+		}
+
+		r -= weight
+	}
+
+	return a.FunctionVersion
+}
+
+// PutFunctionEventInvokeConfig replaces the asynchronous invocation configuration of a function.
+func (s *MemoryStorage) PutFunctionEventInvokeConfig(_ context.Context, name string, req *PutFunctionEventInvokeConfigRequest) (*EventInvokeConfig, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, exists := s.Functions[name]
+	if !exists {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	fn.EventInvokeConfig = &EventInvokeConfig{
+		FunctionArn:              qualifiedArn(fn),
+		MaximumRetryAttempts:     req.MaximumRetryAttempts,
+		MaximumEventAgeInSeconds: req.MaximumEventAgeInSeconds,
+		DestinationConfig:        req.DestinationConfig,
+		LastModified:             toUnixTimestamp(time.Now()),
+	}
+
+	return fn.EventInvokeConfig, nil
+}
+
+// UpdateFunctionEventInvokeConfig updates the given settings of the asynchronous
+// invocation configuration of a function, creating it if needed.
+func (s *MemoryStorage) UpdateFunctionEventInvokeConfig(_ context.Context, name string, req *PutFunctionEventInvokeConfigRequest) (*EventInvokeConfig, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, exists := s.Functions[name]
+	if !exists {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	cfg := fn.EventInvokeConfig
+	if cfg == nil {
+		cfg = &EventInvokeConfig{FunctionArn: qualifiedArn(fn)}
+	}
+
+	if req.MaximumRetryAttempts != nil {
+		cfg.MaximumRetryAttempts = req.MaximumRetryAttempts
+	}
+
+	if req.MaximumEventAgeInSeconds != nil {
+		cfg.MaximumEventAgeInSeconds = req.MaximumEventAgeInSeconds
+	}
+
+	if req.DestinationConfig != nil {
+		cfg.DestinationConfig = req.DestinationConfig
+	}
+
+	cfg.LastModified = toUnixTimestamp(time.Now())
+	fn.EventInvokeConfig = cfg
+
+	return cfg, nil
+}
+
+// GetFunctionEventInvokeConfig retrieves the asynchronous invocation configuration of a function.
+func (s *MemoryStorage) GetFunctionEventInvokeConfig(_ context.Context, name string) (*EventInvokeConfig, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fn, exists := s.Functions[name]
+	if !exists {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	if fn.EventInvokeConfig == nil {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("The function %s doesn't have an EventInvokeConfig", fn.FunctionArn),
+		}
+	}
+
+	return fn.EventInvokeConfig, nil
+}
+
+// DeleteFunctionEventInvokeConfig deletes the asynchronous invocation configuration of a function.
+func (s *MemoryStorage) DeleteFunctionEventInvokeConfig(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, exists := s.Functions[name]
+	if !exists {
+		return &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	if fn.EventInvokeConfig == nil {
+		return &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("The function %s doesn't have an EventInvokeConfig", fn.FunctionArn),
+		}
+	}
+
+	fn.EventInvokeConfig = nil
+
+	return nil
+}
+
+// PutFunctionConcurrency sets the reserved concurrency of a function.
+func (s *MemoryStorage) PutFunctionConcurrency(_ context.Context, name string, reserved int) (*Concurrency, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, exists := s.Functions[name]
+	if !exists {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	if provisioned := provisionedConcurrency(fn, ""); reserved < provisioned {
+		return nil, &FunctionError{
+			Type:    ErrInvalidParameterValue,
+			Message: fmt.Sprintf("ReservedConcurrentExecutions %d should not be lower than the function's total provisioned concurrency %d", reserved, provisioned),
+		}
+	}
+
+	fn.ReservedConcurrentExecutions = &reserved
+
+	return &Concurrency{ReservedConcurrentExecutions: fn.ReservedConcurrentExecutions}, nil
+}
+
+// GetFunctionConcurrency retrieves the reserved concurrency of a function, which is
+// unset when the function uses the unreserved concurrency of the account.
+func (s *MemoryStorage) GetFunctionConcurrency(_ context.Context, name string) (*Concurrency, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fn, exists := s.Functions[name]
+	if !exists {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	return &Concurrency{ReservedConcurrentExecutions: fn.ReservedConcurrentExecutions}, nil
+}
+
+// DeleteFunctionConcurrency removes the reserved concurrency of a function.
+func (s *MemoryStorage) DeleteFunctionConcurrency(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, exists := s.Functions[name]
+	if !exists {
+		return &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	fn.ReservedConcurrentExecutions = nil
+
+	return nil
+}
+
+// PutProvisionedConcurrencyConfig sets the provisioned concurrency of a published version
+// or alias of a function. kumo has nothing to initialize, so it is ready immediately.
+func (s *MemoryStorage) PutProvisionedConcurrencyConfig(_ context.Context, name, qualifier string, req *PutProvisionedConcurrencyConfigRequest) (*ProvisionedConcurrencyConfig, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, exists := s.Functions[name]
+	if !exists {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	if qualifier == "$LATEST" {
+		return nil, &FunctionError{
+			Type:    ErrInvalidParameterValue,
+			Message: "Provisioned Concurrency Configs cannot be applied to unpublished function versions.",
+		}
+	}
+
+	if _, ok := fn.Aliases[qualifier]; !ok && functionVersion(fn, qualifier) == nil {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s:%s", fn.FunctionArn, qualifier),
+		}
+	}
+
+	requested := req.ProvisionedConcurrentExecutions
+	if reserved := fn.ReservedConcurrentExecutions; reserved != nil && provisionedConcurrency(fn, qualifier)+requested > *reserved {
+		return nil, &FunctionError{
+			Type:    ErrInvalidParameterValue,
+			Message: fmt.Sprintf("Requested Provisioned Concurrency should not be greater than the reservedConcurrentExecution for function: %d", *reserved),
+		}
+	}
+
+	cfg := &ProvisionedConcurrencyConfig{
+		RequestedProvisionedConcurrentExecutions: requested,
+		AvailableProvisionedConcurrentExecutions: requested,
+		AllocatedProvisionedConcurrentExecutions: requested,
+		Status:                                   "READY",
+		LastModified:                             time.Now().UTC().Format("2006-01-02T15:04:05.000+0000"),
+	}
+
+	if fn.ProvisionedConcurrency == nil {
+		fn.ProvisionedConcurrency = make(map[string]*ProvisionedConcurrencyConfig)
+	}
+
+	fn.ProvisionedConcurrency[qualifier] = cfg
+
+	return cfg, nil
+}
+
+// GetProvisionedConcurrencyConfig retrieves the provisioned concurrency of a version or
+// alias of a function.
+func (s *MemoryStorage) GetProvisionedConcurrencyConfig(_ context.Context, name, qualifier string) (*ProvisionedConcurrencyConfig, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fn, exists := s.Functions[name]
+	if !exists {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	cfg, exists := fn.ProvisionedConcurrency[qualifier]
+	if !exists {
+		return nil, &FunctionError{
+			Type:    ErrProvisionedConcurrencyConfigNotFound,
+			Message: fmt.Sprintf("No Provisioned Concurrency Config found for this function: %s:%s", fn.FunctionArn, qualifier),
+		}
+	}
+
+	return cfg, nil
+}
+
+// provisionedConcurrency returns the total provisioned concurrency of the versions and
+// aliases of a function, except the given qualifier.
+func provisionedConcurrency(fn *Function, except string) int {
+	total := 0
+
+	for qualifier, cfg := range fn.ProvisionedConcurrency {
+		if qualifier != except {
+			total += cfg.RequestedProvisionedConcurrentExecutions
+		}
+	}
+
+	return total
+}
+
+// functionURLPath is the path under which kumo serves function URLs, followed by the URL ID.
+const functionURLPath = "/lambda-url/"
+
+// CreateFunctionURLConfig creates the function URL of a function.
+func (s *MemoryStorage) CreateFunctionURLConfig(_ context.Context, name string, req *FunctionURLConfigRequest) (*FunctionURLConfig, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, exists := s.Functions[name]
+	if !exists {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	if fn.URLConfig != nil {
+		return nil, &FunctionError{
+			Type:    ErrResourceConflict,
+			Message: fmt.Sprintf("Failed to create function url config for [functionArn = %s]. Error message:  FunctionUrlConfig exists for this Lambda function", fn.FunctionArn),
+		}
+	}
+
+	invokeMode := req.InvokeMode
+	if invokeMode == "" {
+		invokeMode = "BUFFERED"
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+	urlID := strings.ReplaceAll(uuid.NewString(), "-", "")
+
+	fn.URLConfig = &FunctionURLConfig{
+		FunctionURL:      s.baseURL + functionURLPath + urlID + "/",
+		FunctionArn:      fn.FunctionArn,
+		AuthType:         req.AuthType,
+		Cors:             req.Cors,
+		InvokeMode:       invokeMode,
+		CreationTime:     now,
+		LastModifiedTime: now,
+	}
+
+	return fn.URLConfig, nil
+}
+
+// GetFunctionURLConfig retrieves the function URL of a function.
+func (s *MemoryStorage) GetFunctionURLConfig(_ context.Context, name string) (*FunctionURLConfig, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fn, err := s.functionWithURL(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return fn.URLConfig, nil
+}
+
+// UpdateFunctionURLConfig updates the given settings of the function URL of a function.
+func (s *MemoryStorage) UpdateFunctionURLConfig(_ context.Context, name string, req *FunctionURLConfigRequest) (*FunctionURLConfig, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, err := s.functionWithURL(name)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := fn.URLConfig
+
+	if req.AuthType != "" {
+		cfg.AuthType = req.AuthType
+	}
+
+	if req.Cors != nil {
+		cfg.Cors = req.Cors
+	}
+
+	if req.InvokeMode != "" {
+		cfg.InvokeMode = req.InvokeMode
+	}
+
+	cfg.LastModifiedTime = time.Now().UTC().Format(time.RFC3339Nano)
+
+	return cfg, nil
+}
+
+// DeleteFunctionURLConfig deletes the function URL of a function.
+func (s *MemoryStorage) DeleteFunctionURLConfig(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, err := s.functionWithURL(name)
+	if err != nil {
+		return err
+	}
+
+	fn.URLConfig = nil
+
+	return nil
+}
+
+// GetFunctionByURLID retrieves the function served at the function URL with the given ID.
+func (s *MemoryStorage) GetFunctionByURLID(_ context.Context, urlID string) (*Function, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	suffix := functionURLPath + urlID + "/"
+
+	for _, fn := range s.Functions {
+		if fn.URLConfig != nil && strings.HasSuffix(fn.URLConfig.FunctionURL, suffix) {
+			return fn, nil
+		}
+	}
+
+	return nil, &FunctionError{
+		Type:    ErrResourceNotFound,
+		Message: fmt.Sprintf("Function URL not found: %s", urlID),
+	}
+}
+
+// functionWithURL returns the function with the given name if it has a function URL.
+// The caller must hold the lock.
+func (s *MemoryStorage) functionWithURL(name string) (*Function, error) {
+	fn, exists := s.Functions[name]
+	if !exists {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: fmt.Sprintf("Function not found: %s", name),
+		}
+	}
+
+	if fn.URLConfig == nil {
+		return nil, &FunctionError{
+			Type:    ErrResourceNotFound,
+			Message: "The resource you requested does not exist.",
+		}
+	}
+
+	return fn, nil
+}
+
 // CreateEventSourceMapping creates a new event source mapping.
 func (s *MemoryStorage) CreateEventSourceMapping(_ context.Context, req *CreateEventSourceMappingRequest) (*EventSourceMapping, error) {
 	s.mu.Lock()
@@ -560,6 +1346,75 @@ func (s *MemoryStorage) UpdateEventSourceMapping(_ context.Context, uuid string,
 	return mapping, nil
 }
 
+// TagResource adds tags to a function, replacing the values of existing keys.
+func (s *MemoryStorage) TagResource(_ context.Context, arn string, tags map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, err := s.functionByArn(arn)
+	if err != nil {
+		return err
+	}
+
+	if fn.Tags == nil {
+		fn.Tags = make(map[string]string, len(tags))
+	}
+
+	maps.Copy(fn.Tags, tags)
+
+	return nil
+}
+
+// UntagResource removes tags from a function. Keys the function has no tag for are ignored.
+func (s *MemoryStorage) UntagResource(_ context.Context, arn string, tagKeys []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, err := s.functionByArn(arn)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range tagKeys {
+		delete(fn.Tags, key)
+	}
+
+	return nil
+}
+
+// ListTags returns a copy of the tags of a function.
+func (s *MemoryStorage) ListTags(_ context.Context, arn string) (map[string]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fn, err := s.functionByArn(arn)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := maps.Clone(fn.Tags)
+	if tags == nil {
+		tags = make(map[string]string)
+	}
+
+	return tags, nil
+}
+
+// functionByArn returns the function with the given unqualified ARN. Must be called under lock.
+func (s *MemoryStorage) functionByArn(arn string) (*Function, error) {
+	parts := strings.Split(arn, ":")
+	if len(parts) == 7 && parts[5] == "function" {
+		if fn, exists := s.Functions[parts[6]]; exists && fn.FunctionArn == arn {
+			return fn, nil
+		}
+	}
+
+	return nil, &FunctionError{
+		Type:    ErrResourceNotFound,
+		Message: fmt.Sprintf("Function not found: %s", arn),
+	}
+}
+
 // generateUUID generates a UUID for event source mapping.
 func generateUUID() string {
 	return fmt.Sprintf("%08x-%04x-%04x-%04x-%012x",
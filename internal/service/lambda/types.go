@@ -7,26 +7,158 @@ import (
 
 // Function represents a Lambda function.
 type Function struct {
-	FunctionName    string
-	FunctionArn     string
-	Runtime         string
-	Role            string
-	Handler         string
-	Description     string
-	Timeout         int
-	MemorySize      int
-	CodeSize        int64
-	CodeSha256      string
-	Version         string
-	LastModified    time.Time
-	State           string
-	StateReason     string
-	StateReasonCode string
-	PackageType     string
-	Architectures   []string
-	Environment     *Environment
-	Code            *FunctionCode
-	InvokeEndpoint  string // kumo extension: HTTP endpoint to proxy invocations
+	FunctionName      string
+	FunctionArn       string
+	Runtime           string
+	Role              string
+	Handler           string
+	Description       string
+	Timeout           int
+	MemorySize        int
+	CodeSize          int64
+	CodeSha256        string
+	Version           string
+	LastModified      time.Time
+	State             string
+	StateReason       string
+	StateReasonCode   string
+	PackageType       string
+	Architectures     []string
+	Environment       *Environment
+	Code              *FunctionCode
+	InvokeEndpoint    string // kumo extension: HTTP endpoint to proxy invocations
+	DeadLetterConfig  *DeadLetterConfig
+	EventInvokeConfig *EventInvokeConfig
+	URLConfig         *FunctionURLConfig
+	Versions          []*Function // published versions, oldest first
+	Aliases           map[string]*Alias
+	Tags              map[string]string
+
+	ReservedConcurrentExecutions *int
+	ProvisionedConcurrency       map[string]*ProvisionedConcurrencyConfig // by version or alias
+}
+
+// Alias is a named pointer to a version of a function.
+type Alias struct {
+	AliasArn        string              `json:"AliasArn"`
+	Name            string              `json:"Name"`
+	FunctionVersion string              `json:"FunctionVersion"`
+	Description     string              `json:"Description,omitempty"`
+	RoutingConfig   *AliasRoutingConfig `json:"RoutingConfig,omitempty"`
+	RevisionID      string              `json:"RevisionId"`
+}
+
+// AliasRoutingConfig shifts a share of the invocations of an alias to another version.
+type AliasRoutingConfig struct {
+	AdditionalVersionWeights map[string]float64 `json:"AdditionalVersionWeights,omitempty"`
+}
+
+// AliasRequest is the request for CreateAlias and UpdateAlias.
+type AliasRequest struct {
+	Name            string              `json:"Name,omitempty"`
+	FunctionVersion string              `json:"FunctionVersion,omitempty"`
+	Description     string              `json:"Description,omitempty"`
+	RoutingConfig   *AliasRoutingConfig `json:"RoutingConfig,omitempty"`
+}
+
+// ListAliasesResponse is the response for ListAliases.
+type ListAliasesResponse struct {
+	Aliases    []*Alias `json:"Aliases"`
+	NextMarker string   `json:"NextMarker,omitempty"`
+}
+
+// PublishVersionRequest is the request for PublishVersion.
+type PublishVersionRequest struct {
+	CodeSha256  string `json:"CodeSha256,omitempty"`
+	Description string `json:"Description,omitempty"`
+}
+
+// ListVersionsByFunctionResponse is the response for ListVersionsByFunction.
+type ListVersionsByFunctionResponse struct {
+	Versions   []*FunctionConfiguration `json:"Versions"`
+	NextMarker string                   `json:"NextMarker,omitempty"`
+}
+
+// Concurrency is the reserved concurrency of a function, the request and response of
+// PutFunctionConcurrency and the response of GetFunctionConcurrency.
+type Concurrency struct {
+	ReservedConcurrentExecutions *int `json:"ReservedConcurrentExecutions,omitempty"`
+}
+
+// ProvisionedConcurrencyConfig is the provisioned concurrency of a version or alias.
+type ProvisionedConcurrencyConfig struct {
+	RequestedProvisionedConcurrentExecutions int    `json:"RequestedProvisionedConcurrentExecutions"`
+	AvailableProvisionedConcurrentExecutions int    `json:"AvailableProvisionedConcurrentExecutions"`
+	AllocatedProvisionedConcurrentExecutions int    `json:"AllocatedProvisionedConcurrentExecutions"`
+	Status                                   string `json:"Status"`
+	StatusReason                             string `json:"StatusReason,omitempty"`
+	LastModified                             string `json:"LastModified"`
+}
+
+// PutProvisionedConcurrencyConfigRequest is the request for PutProvisionedConcurrencyConfig.
+type PutProvisionedConcurrencyConfigRequest struct {
+	ProvisionedConcurrentExecutions int `json:"ProvisionedConcurrentExecutions"`
+}
+
+// DeadLetterConfig is the queue or topic that receives events that fail asynchronous invocation.
+type DeadLetterConfig struct {
+	TargetArn string `json:"TargetArn,omitempty"`
+}
+
+// EventInvokeConfig configures the retries and destinations of asynchronous invocations.
+type EventInvokeConfig struct {
+	FunctionArn              string             `json:"FunctionArn"`
+	MaximumRetryAttempts     *int               `json:"MaximumRetryAttempts,omitempty"`
+	MaximumEventAgeInSeconds *int               `json:"MaximumEventAgeInSeconds,omitempty"`
+	DestinationConfig        *DestinationConfig `json:"DestinationConfig,omitempty"`
+	LastModified             float64            `json:"LastModified"`
+}
+
+// DestinationConfig holds the destinations of asynchronous invocation records.
+type DestinationConfig struct {
+	OnSuccess *Destination `json:"OnSuccess,omitempty"`
+	OnFailure *Destination `json:"OnFailure,omitempty"`
+}
+
+// Destination is the ARN of an SQS queue or SNS topic that receives invocation records.
+type Destination struct {
+	Destination string `json:"Destination,omitempty"`
+}
+
+// FunctionURLConfig is the configuration of the HTTPS endpoint of a function.
+type FunctionURLConfig struct {
+	FunctionURL      string `json:"FunctionUrl"`
+	FunctionArn      string `json:"FunctionArn"`
+	AuthType         string `json:"AuthType"`
+	Cors             *Cors  `json:"Cors,omitempty"`
+	InvokeMode       string `json:"InvokeMode"`
+	CreationTime     string `json:"CreationTime"`
+	LastModifiedTime string `json:"LastModifiedTime"`
+}
+
+// Cors is the cross-origin resource sharing configuration of a function URL.
+type Cors struct {
+	AllowCredentials *bool    `json:"AllowCredentials,omitempty"`
+	AllowHeaders     []string `json:"AllowHeaders,omitempty"`
+	AllowMethods     []string `json:"AllowMethods,omitempty"`
+	AllowOrigins     []string `json:"AllowOrigins,omitempty"`
+	ExposeHeaders    []string `json:"ExposeHeaders,omitempty"`
+	MaxAge           *int     `json:"MaxAge,omitempty"`
+}
+
+// FunctionURLConfigRequest is the request for CreateFunctionUrlConfig and UpdateFunctionUrlConfig.
+type FunctionURLConfigRequest struct {
+	AuthType   string `json:"AuthType,omitempty"`
+	Cors       *Cors  `json:"Cors,omitempty"`
+	InvokeMode string `json:"InvokeMode,omitempty"`
+}
+
+// PutFunctionEventInvokeConfigRequest is the request for PutFunctionEventInvokeConfig
+// and UpdateFunctionEventInvokeConfig.
+type PutFunctionEventInvokeConfigRequest struct {
+	MaximumRetryAttempts     *int               `json:"MaximumRetryAttempts,omitempty"`
+	MaximumEventAgeInSeconds *int               `json:"MaximumEventAgeInSeconds,omitempty"`
+	DestinationConfig        *DestinationConfig `json:"DestinationConfig,omitempty"`
 }
 
 // Environment represents the function's environment variables.
@@ -45,42 +177,44 @@ type FunctionCode struct {
 
 // CreateFunctionRequest is the request for CreateFunction.
 type CreateFunctionRequest struct {
-	FunctionName   string            `json:"FunctionName"`
-	Runtime        string            `json:"Runtime,omitempty"`
-	Role           string            `json:"Role"`
-	Handler        string            `json:"Handler,omitempty"`
-	Code           FunctionCode      `json:"Code"`
-	Description    string            `json:"Description,omitempty"`
-	Timeout        int               `json:"Timeout,omitempty"`
-	MemorySize     int               `json:"MemorySize,omitempty"`
-	Publish        bool              `json:"Publish,omitempty"`
-	PackageType    string            `json:"PackageType,omitempty"`
-	Architectures  []string          `json:"Architectures,omitempty"`
-	Environment    *Environment      `json:"Environment,omitempty"`
-	Tags           map[string]string `json:"Tags,omitempty"`
-	InvokeEndpoint string            `json:"InvokeEndpoint,omitempty"` // kumo extension
+	FunctionName     string            `json:"FunctionName"`
+	Runtime          string            `json:"Runtime,omitempty"`
+	Role             string            `json:"Role"`
+	Handler          string            `json:"Handler,omitempty"`
+	Code             FunctionCode      `json:"Code"`
+	Description      string            `json:"Description,omitempty"`
+	Timeout          int               `json:"Timeout,omitempty"`
+	MemorySize       int               `json:"MemorySize,omitempty"`
+	Publish          bool              `json:"Publish,omitempty"`
+	PackageType      string            `json:"PackageType,omitempty"`
+	Architectures    []string          `json:"Architectures,omitempty"`
+	Environment      *Environment      `json:"Environment,omitempty"`
+	Tags             map[string]string `json:"Tags,omitempty"`
+	DeadLetterConfig *DeadLetterConfig `json:"DeadLetterConfig,omitempty"`
+	InvokeEndpoint   string            `json:"InvokeEndpoint,omitempty"` // kumo extension
 }
 
 // CreateFunctionResponse is the response for CreateFunction.
 type CreateFunctionResponse struct {
-	FunctionName    string       `json:"FunctionName"`
-	FunctionArn     string       `json:"FunctionArn"`
-	Runtime         string       `json:"Runtime,omitempty"`
-	Role            string       `json:"Role"`
-	Handler         string       `json:"Handler,omitempty"`
-	CodeSize        int64        `json:"CodeSize"`
-	Description     string       `json:"Description,omitempty"`
-	Timeout         int          `json:"Timeout"`
-	MemorySize      int          `json:"MemorySize"`
-	LastModified    string       `json:"LastModified"`
-	CodeSha256      string       `json:"CodeSha256"`
-	Version         string       `json:"Version"`
-	State           string       `json:"State,omitempty"`
-	StateReason     string       `json:"StateReason,omitempty"`
-	StateReasonCode string       `json:"StateReasonCode,omitempty"`
-	PackageType     string       `json:"PackageType,omitempty"`
-	Architectures   []string     `json:"Architectures,omitempty"`
-	Environment     *Environment `json:"Environment,omitempty"`
+	FunctionName     string            `json:"FunctionName"`
+	FunctionArn      string            `json:"FunctionArn"`
+	Runtime          string            `json:"Runtime,omitempty"`
+	Role             string            `json:"Role"`
+	Handler          string            `json:"Handler,omitempty"`
+	CodeSize         int64             `json:"CodeSize"`
+	Description      string            `json:"Description,omitempty"`
+	Timeout          int               `json:"Timeout"`
+	MemorySize       int               `json:"MemorySize"`
+	LastModified     string            `json:"LastModified"`
+	CodeSha256       string            `json:"CodeSha256"`
+	Version          string            `json:"Version"`
+	State            string            `json:"State,omitempty"`
+	StateReason      string            `json:"StateReason,omitempty"`
+	StateReasonCode  string            `json:"StateReasonCode,omitempty"`
+	PackageType      string            `json:"PackageType,omitempty"`
+	Architectures    []string          `json:"Architectures,omitempty"`
+	Environment      *Environment      `json:"Environment,omitempty"`
+	DeadLetterConfig *DeadLetterConfig `json:"DeadLetterConfig,omitempty"`
 }
 
 // GetFunctionResponse is the response for GetFunction.
@@ -88,28 +222,30 @@ type GetFunctionResponse struct {
 	Configuration *FunctionConfiguration `json:"Configuration"`
 	Code          *FunctionCodeLocation  `json:"Code,omitempty"`
 	Tags          map[string]string      `json:"Tags,omitempty"`
+	Concurrency   *Concurrency           `json:"Concurrency,omitempty"`
 }
 
 // FunctionConfiguration contains function configuration details.
 type FunctionConfiguration struct {
-	FunctionName    string       `json:"FunctionName"`
-	FunctionArn     string       `json:"FunctionArn"`
-	Runtime         string       `json:"Runtime,omitempty"`
-	Role            string       `json:"Role"`
-	Handler         string       `json:"Handler,omitempty"`
-	CodeSize        int64        `json:"CodeSize"`
-	Description     string       `json:"Description,omitempty"`
-	Timeout         int          `json:"Timeout"`
-	MemorySize      int          `json:"MemorySize"`
-	LastModified    string       `json:"LastModified"`
-	CodeSha256      string       `json:"CodeSha256"`
-	Version         string       `json:"Version"`
-	State           string       `json:"State,omitempty"`
-	StateReason     string       `json:"StateReason,omitempty"`
-	StateReasonCode string       `json:"StateReasonCode,omitempty"`
-	PackageType     string       `json:"PackageType,omitempty"`
-	Architectures   []string     `json:"Architectures,omitempty"`
-	Environment     *Environment `json:"Environment,omitempty"`
+	FunctionName     string            `json:"FunctionName"`
+	FunctionArn      string            `json:"FunctionArn"`
+	Runtime          string            `json:"Runtime,omitempty"`
+	Role             string            `json:"Role"`
+	Handler          string            `json:"Handler,omitempty"`
+	CodeSize         int64             `json:"CodeSize"`
+	Description      string            `json:"Description,omitempty"`
+	Timeout          int               `json:"Timeout"`
+	MemorySize       int               `json:"MemorySize"`
+	LastModified     string            `json:"LastModified"`
+	CodeSha256       string            `json:"CodeSha256"`
+	Version          string            `json:"Version"`
+	State            string            `json:"State,omitempty"`
+	StateReason      string            `json:"StateReason,omitempty"`
+	StateReasonCode  string            `json:"StateReasonCode,omitempty"`
+	PackageType      string            `json:"PackageType,omitempty"`
+	Architectures    []string          `json:"Architectures,omitempty"`
+	Environment      *Environment      `json:"Environment,omitempty"`
+	DeadLetterConfig *DeadLetterConfig `json:"DeadLetterConfig,omitempty"`
 }
 
 // FunctionCodeLocation contains the location of the function code.
@@ -155,14 +291,25 @@ type UpdateFunctionCodeRequest struct {
 
 // UpdateFunctionConfigurationRequest is the request for UpdateFunctionConfiguration.
 type UpdateFunctionConfigurationRequest struct {
-	Description    string       `json:"Description,omitempty"`
-	Handler        string       `json:"Handler,omitempty"`
-	MemorySize     int          `json:"MemorySize,omitempty"`
-	Role           string       `json:"Role,omitempty"`
-	Runtime        string       `json:"Runtime,omitempty"`
-	Timeout        int          `json:"Timeout,omitempty"`
-	Environment    *Environment `json:"Environment,omitempty"`
-	InvokeEndpoint string       `json:"InvokeEndpoint,omitempty"` // kumo extension
+	Description      string            `json:"Description,omitempty"`
+	Handler          string            `json:"Handler,omitempty"`
+	MemorySize       int               `json:"MemorySize,omitempty"`
+	Role             string            `json:"Role,omitempty"`
+	Runtime          string            `json:"Runtime,omitempty"`
+	Timeout          int               `json:"Timeout,omitempty"`
+	Environment      *Environment      `json:"Environment,omitempty"`
+	DeadLetterConfig *DeadLetterConfig `json:"DeadLetterConfig,omitempty"`
+	InvokeEndpoint   string            `json:"InvokeEndpoint,omitempty"` // kumo extension
+}
+
+// TagResourceRequest is the request for TagResource.
+type TagResourceRequest struct {
+	Tags map[string]string `json:"Tags"`
+}
+
+// ListTagsResponse is the response for ListTags.
+type ListTagsResponse struct {
+	Tags map[string]string `json:"Tags"`
 }
 
 // FunctionError represents a Lambda error.
@@ -182,6 +329,9 @@ const (
 	ErrResourceConflict      = "ResourceConflictException"
 	ErrInvalidParameterValue = "InvalidParameterValueException"
 	ErrServiceException      = "ServiceException"
+	ErrTooManyRequests       = "TooManyRequestsException"
+
+	ErrProvisionedConcurrencyConfigNotFound = "ProvisionedConcurrencyConfigNotFoundException"
 )
 
 // EventSourceMapping represents a Lambda event source mapping.
@@ -66,6 +66,7 @@ type Storage interface {
 	UpdateTracker(ctx context.Context, name string, req *UpdateTrackerRequest) (*UpdateTrackerResponse, error)
 	DeleteTracker(ctx context.Context, name string) error
 	ListTrackers(ctx context.Context, maxResults *int32, nextToken string) (*ListTrackersResponse, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -183,6 +184,20 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Maps = make(map[string]*MapResource)
+	m.PlaceIndexes = make(map[string]*PlaceIndex)
+	m.RouteCalculators = make(map[string]*RouteCalculator)
+	m.GeofenceCollections = make(map[string]*GeofenceCollection)
+	m.Trackers = make(map[string]*Tracker)
+
+	return nil
+}
+
 // --- Map operations ---
 
 // CreateMap creates a new map resource.
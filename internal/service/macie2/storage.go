@@ -71,6 +71,7 @@ type Storage interface {
 	// Findings operations
 	GetFindings(ctx context.Context, req *GetFindingsRequest) (*GetFindingsResponse, error)
 	ListFindings(ctx context.Context, req *ListFindingsRequest) (*ListFindingsResponse, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -189,6 +190,21 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Session = nil
+	m.AllowLists = make(map[string]*AllowList)
+	m.ClassificationJobs = make(map[string]*ClassificationJob)
+	m.CustomDataIdentifiers = make(map[string]*CustomDataIdentifier)
+	m.FindingsFilters = make(map[string]*FindingsFilter)
+	m.Findings = make(map[string]*Finding)
+
+	return nil
+}
+
 // --- Macie session operations ---
 
 // EnableMacie enables Amazon Macie for the account.
@@ -49,6 +49,7 @@ type Storage interface {
 	CreateACL(ctx context.Context, req *CreateACLRequest) (*ACL, error)
 	DescribeACLs(ctx context.Context, aclName string) ([]ACL, error)
 	DeleteACL(ctx context.Context, aclName string) (*ACL, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -150,6 +151,18 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Clusters = make(map[string]*Cluster)
+	m.Users = make(map[string]*User)
+	m.Acls = make(map[string]*ACL)
+
+	return nil
+}
+
 // buildClusterBase creates a Cluster with core fields from a CreateClusterRequest.
 func buildClusterBase(req *CreateClusterRequest) *Cluster {
 	numShards := int32(1)
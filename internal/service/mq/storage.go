@@ -22,6 +22,7 @@ type Storage interface {
 	UpdateBroker(ctx context.Context, brokerID string, req *UpdateBrokerRequest) (*Broker, error)
 	CreateConfiguration(ctx context.Context, req *CreateConfigurationRequest) (*Configuration, error)
 	GetConfiguration(ctx context.Context, configID string) (*Configuration, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -121,6 +122,17 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Brokers = make(map[string]*Broker)
+	s.Configurations = make(map[string]*Configuration)
+
+	return nil
+}
+
 // CreateBroker creates a new broker.
 func (s *MemoryStorage) CreateBroker(_ context.Context, req *CreateBrokerRequest) (*Broker, error) {
 	s.mu.Lock()
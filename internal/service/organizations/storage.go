@@ -89,6 +89,7 @@ type Storage interface {
 
 	// Root operations
 	ListRoots(ctx context.Context, maxResults int32, nextToken string) ([]*Root, string, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -211,6 +212,23 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Organization = nil
+	m.Root = nil
+	m.Accounts = make(map[string]*Account)
+	m.OrganizationalUnits = make(map[string]*OrganizationalUnit)
+	m.OuParents = make(map[string]string)
+	m.Policies = make(map[string]*Policy)
+	m.PolicyAttachments = make(map[string]map[string]bool)
+	m.initializeDefaultPolicy()
+
+	return nil
+}
+
 func (m *MemoryStorage) initializeDefaultPolicy() {
 	// Create a default full access SCP.
 	defaultSCPID := "p-FullAWSAccess"
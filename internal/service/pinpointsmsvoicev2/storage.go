@@ -21,6 +21,7 @@ const (
 type Storage interface {
 	SendTextMessage(ctx context.Context, req *SendTextMessageInput) (string, error)
 	GetSentTextMessages(ctx context.Context) ([]*SentTextMessage, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -110,6 +111,16 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.SentTextMessages = nil
+
+	return nil
+}
+
 // SendTextMessage sends a text message (stores it for testing).
 func (s *MemoryStorage) SendTextMessage(_ context.Context, req *SendTextMessageInput) (string, error) {
 	s.mu.Lock()
@@ -43,6 +43,7 @@ type Storage interface {
 
 	// ListTagsForResource lists tags for a pipe.
 	ListTagsForResource(ctx context.Context, arn string) (map[string]string, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -132,6 +133,16 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Pipes = make(map[string]*Pipe)
+
+	return nil
+}
+
 const (
 	accountID = "123456789012"
 	region    = "us-east-1"
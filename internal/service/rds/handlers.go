@@ -44,6 +44,24 @@ func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
 		s.CreateDBSnapshot(w, r)
 	case "DeleteDBSnapshot":
 		s.DeleteDBSnapshot(w, r)
+	case "CreateDBParameterGroup":
+		s.CreateDBParameterGroup(w, r)
+	case "DescribeDBParameterGroups":
+		s.DescribeDBParameterGroups(w, r)
+	case "ModifyDBParameterGroup":
+		s.ModifyDBParameterGroup(w, r)
+	case "DeleteDBParameterGroup":
+		s.DeleteDBParameterGroup(w, r)
+	case "DescribeDBParameters":
+		s.DescribeDBParameters(w, r)
+	case "CreateDBSubnetGroup":
+		s.CreateDBSubnetGroup(w, r)
+	case "DescribeDBSubnetGroups":
+		s.DescribeDBSubnetGroups(w, r)
+	case "ModifyDBSubnetGroup":
+		s.ModifyDBSubnetGroup(w, r)
+	case "DeleteDBSubnetGroup":
+		s.DeleteDBSubnetGroup(w, r)
 	default:
 		writeError(w, errInvalidParameterValue, fmt.Sprintf("The action '%s' is not valid", action), http.StatusBadRequest)
 	}
@@ -419,6 +437,316 @@ func (s *Service) DeleteDBSnapshot(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// CreateDBParameterGroup handles the CreateDBParameterGroup action.
+func (s *Service) CreateDBParameterGroup(w http.ResponseWriter, r *http.Request) {
+	var req CreateDBParameterGroupInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DBParameterGroupName == "" {
+		writeError(w, errInvalidParameterValue, "DBParameterGroupName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DBParameterGroupFamily == "" {
+		writeError(w, errInvalidParameterValue, "DBParameterGroupFamily is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.Description == "" {
+		writeError(w, errInvalidParameterValue, "Description is required", http.StatusBadRequest)
+
+		return
+	}
+
+	group, err := s.storage.CreateDBParameterGroup(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeXMLResponse(w, XMLCreateDBParameterGroupResponse{
+		Xmlns:            rdsXMLNS,
+		DBParameterGroup: convertToXMLDBParameterGroup(group),
+		RequestID:        uuid.New().String(),
+	})
+}
+
+// DescribeDBParameterGroups handles the DescribeDBParameterGroups action.
+func (s *Service) DescribeDBParameterGroups(w http.ResponseWriter, r *http.Request) {
+	var req DescribeDBParameterGroupsInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	groups, err := s.storage.DescribeDBParameterGroups(r.Context(), req.DBParameterGroupName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	xmlGroups := make([]XMLDBParameterGroup, 0, len(groups))
+	for i := range groups {
+		xmlGroups = append(xmlGroups, convertToXMLDBParameterGroup(&groups[i]))
+	}
+
+	writeXMLResponse(w, XMLDescribeDBParameterGroupsResponse{
+		Xmlns:             rdsXMLNS,
+		DBParameterGroups: XMLDBParameterGroups{Items: xmlGroups},
+		RequestID:         uuid.New().String(),
+	})
+}
+
+// ModifyDBParameterGroup handles the ModifyDBParameterGroup action.
+func (s *Service) ModifyDBParameterGroup(w http.ResponseWriter, r *http.Request) {
+	var req ModifyDBParameterGroupInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DBParameterGroupName == "" {
+		writeError(w, errInvalidParameterValue, "DBParameterGroupName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	req.Parameters = parseParameters(r)
+	if len(req.Parameters) == 0 {
+		writeError(w, errInvalidParameterValue, "Parameters is required", http.StatusBadRequest)
+
+		return
+	}
+
+	group, err := s.storage.ModifyDBParameterGroup(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeXMLResponse(w, XMLModifyDBParameterGroupResponse{
+		Xmlns:                rdsXMLNS,
+		DBParameterGroupName: group.DBParameterGroupName,
+		RequestID:            uuid.New().String(),
+	})
+}
+
+// DeleteDBParameterGroup handles the DeleteDBParameterGroup action.
+func (s *Service) DeleteDBParameterGroup(w http.ResponseWriter, r *http.Request) {
+	var req DeleteDBParameterGroupInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DBParameterGroupName == "" {
+		writeError(w, errInvalidParameterValue, "DBParameterGroupName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.DeleteDBParameterGroup(r.Context(), req.DBParameterGroupName); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeXMLResponse(w, XMLDeleteDBParameterGroupResponse{
+		Xmlns:     rdsXMLNS,
+		RequestID: uuid.New().String(),
+	})
+}
+
+// DescribeDBParameters handles the DescribeDBParameters action. Only the
+// parameters set by ModifyDBParameterGroup are returned, with source user.
+func (s *Service) DescribeDBParameters(w http.ResponseWriter, r *http.Request) {
+	var req DescribeDBParametersInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DBParameterGroupName == "" {
+		writeError(w, errInvalidParameterValue, "DBParameterGroupName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	params, err := s.storage.DescribeDBParameters(r.Context(), req.DBParameterGroupName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	if req.Source != "" && req.Source != parameterSourceUser {
+		params = nil
+	}
+
+	xmlParams := make([]XMLParameter, 0, len(params))
+	for _, param := range params {
+		xmlParams = append(xmlParams, XMLParameter{
+			ParameterName:  param.ParameterName,
+			ParameterValue: param.ParameterValue,
+			ApplyMethod:    param.ApplyMethod,
+			Source:         parameterSourceUser,
+			IsModifiable:   true,
+		})
+	}
+
+	writeXMLResponse(w, XMLDescribeDBParametersResponse{
+		Xmlns:      rdsXMLNS,
+		Parameters: XMLParameters{Items: xmlParams},
+		RequestID:  uuid.New().String(),
+	})
+}
+
+// CreateDBSubnetGroup handles the CreateDBSubnetGroup action.
+func (s *Service) CreateDBSubnetGroup(w http.ResponseWriter, r *http.Request) {
+	var req CreateDBSubnetGroupInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DBSubnetGroupName == "" {
+		writeError(w, errInvalidParameterValue, "DBSubnetGroupName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DBSubnetGroupDescription == "" {
+		writeError(w, errInvalidParameterValue, "DBSubnetGroupDescription is required", http.StatusBadRequest)
+
+		return
+	}
+
+	req.SubnetIDs = parseFormList(r, "SubnetIds.SubnetIdentifier")
+	if len(req.SubnetIDs) == 0 {
+		writeError(w, errInvalidParameterValue, "SubnetIds is required", http.StatusBadRequest)
+
+		return
+	}
+
+	group, err := s.storage.CreateDBSubnetGroup(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeXMLResponse(w, XMLCreateDBSubnetGroupResponse{
+		Xmlns:         rdsXMLNS,
+		DBSubnetGroup: convertToXMLDBSubnetGroup(group),
+		RequestID:     uuid.New().String(),
+	})
+}
+
+// DescribeDBSubnetGroups handles the DescribeDBSubnetGroups action.
+func (s *Service) DescribeDBSubnetGroups(w http.ResponseWriter, r *http.Request) {
+	var req DescribeDBSubnetGroupsInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	groups, err := s.storage.DescribeDBSubnetGroups(r.Context(), req.DBSubnetGroupName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	xmlGroups := make([]XMLDBSubnetGroup, 0, len(groups))
+	for i := range groups {
+		xmlGroups = append(xmlGroups, convertToXMLDBSubnetGroup(&groups[i]))
+	}
+
+	writeXMLResponse(w, XMLDescribeDBSubnetGroupsResponse{
+		Xmlns:          rdsXMLNS,
+		DBSubnetGroups: XMLDBSubnetGroups{Items: xmlGroups},
+		RequestID:      uuid.New().String(),
+	})
+}
+
+// ModifyDBSubnetGroup handles the ModifyDBSubnetGroup action.
+func (s *Service) ModifyDBSubnetGroup(w http.ResponseWriter, r *http.Request) {
+	var req ModifyDBSubnetGroupInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DBSubnetGroupName == "" {
+		writeError(w, errInvalidParameterValue, "DBSubnetGroupName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	req.SubnetIDs = parseFormList(r, "SubnetIds.SubnetIdentifier")
+	if len(req.SubnetIDs) == 0 {
+		writeError(w, errInvalidParameterValue, "SubnetIds is required", http.StatusBadRequest)
+
+		return
+	}
+
+	group, err := s.storage.ModifyDBSubnetGroup(r.Context(), &req)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeXMLResponse(w, XMLModifyDBSubnetGroupResponse{
+		Xmlns:         rdsXMLNS,
+		DBSubnetGroup: convertToXMLDBSubnetGroup(group),
+		RequestID:     uuid.New().String(),
+	})
+}
+
+// DeleteDBSubnetGroup handles the DeleteDBSubnetGroup action.
+func (s *Service) DeleteDBSubnetGroup(w http.ResponseWriter, r *http.Request) {
+	var req DeleteDBSubnetGroupInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameterValue, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DBSubnetGroupName == "" {
+		writeError(w, errInvalidParameterValue, "DBSubnetGroupName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.DeleteDBSubnetGroup(r.Context(), req.DBSubnetGroupName); err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeXMLResponse(w, XMLDeleteDBSubnetGroupResponse{
+		Xmlns:     rdsXMLNS,
+		RequestID: uuid.New().String(),
+	})
+}
+
 // Helper functions.
 
 func extractAction(r *http.Request) string {
@@ -432,6 +760,48 @@ func extractAction(r *http.Request) string {
 	return r.URL.Query().Get("Action")
 }
 
+// parseFormList returns the members of a Query protocol list such as
+// SubnetIds.SubnetIdentifier.1, SubnetIds.SubnetIdentifier.2 and so on. The
+// JSON body built from the form does not keep these lists, so they are read
+// from the form the Query protocol dispatcher parsed.
+func parseFormList(r *http.Request, prefix string) []string {
+	var values []string
+
+	for i := 1; ; i++ {
+		value := r.Form.Get(fmt.Sprintf("%s.%d", prefix, i))
+		if value == "" {
+			break
+		}
+
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// parseParameters returns the parameters of a ModifyDBParameterGroup request,
+// sent as Parameters.Parameter.N.ParameterName and its sibling fields.
+func parseParameters(r *http.Request) []Parameter {
+	var params []Parameter
+
+	for i := 1; ; i++ {
+		prefix := fmt.Sprintf("Parameters.Parameter.%d.", i)
+
+		name := r.Form.Get(prefix + "ParameterName")
+		if name == "" {
+			break
+		}
+
+		params = append(params, Parameter{
+			ParameterName:  name,
+			ParameterValue: r.Form.Get(prefix + "ParameterValue"),
+			ApplyMethod:    r.Form.Get(prefix + "ApplyMethod"),
+		})
+	}
+
+	return params
+}
+
 func readJSONRequest(r *http.Request, v any) error {
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -477,7 +847,8 @@ func handleError(w http.ResponseWriter, err error) {
 	var rdsErr *Error
 	if errors.As(err, &rdsErr) {
 		status := http.StatusBadRequest
-		if rdsErr.Code == errDBInstanceNotFound || rdsErr.Code == errDBClusterNotFound || rdsErr.Code == errDBSnapshotNotFound {
+		switch rdsErr.Code {
+		case errDBInstanceNotFound, errDBClusterNotFound, errDBSnapshotNotFound, errDBParameterGroupNotFound, errDBSubnetGroupNotFound:
 			status = http.StatusNotFound
 		}
 
@@ -506,6 +877,20 @@ func convertToXMLDBInstance(inst *DBInstance) XMLDBInstance {
 		vpcSecurityGroups = append(vpcSecurityGroups, XMLVpcSecurityGroupMembership(sg))
 	}
 
+	var subnetGroup *XMLDBSubnetGroup
+	if inst.DBSubnetGroup != nil {
+		g := convertToXMLDBSubnetGroup(inst.DBSubnetGroup)
+		subnetGroup = &g
+	}
+
+	var parameterGroups *XMLDBParameterGroupStatuses
+	if len(inst.DBParameterGroups) > 0 {
+		parameterGroups = &XMLDBParameterGroupStatuses{}
+		for _, pg := range inst.DBParameterGroups {
+			parameterGroups.Items = append(parameterGroups.Items, XMLDBParameterGroupStatus(pg))
+		}
+	}
+
 	return XMLDBInstance{
 		DBInstanceIdentifier:       inst.DBInstanceIdentifier,
 		DBInstanceClass:            inst.DBInstanceClass,
@@ -528,6 +913,8 @@ func convertToXMLDBInstance(inst *DBInstance) XMLDBInstance {
 		StorageEncrypted:           inst.StorageEncrypted,
 		DeletionProtection:         inst.DeletionProtection,
 		VpcSecurityGroups:          XMLVpcSecurityGroups{Items: vpcSecurityGroups},
+		DBSubnetGroup:              subnetGroup,
+		DBParameterGroups:          parameterGroups,
 	}
 }
 
@@ -546,24 +933,55 @@ func convertToXMLDBCluster(cluster *DBCluster) XMLDBCluster {
 	}
 
 	return XMLDBCluster{
-		DBClusterIdentifier: cluster.DBClusterIdentifier,
-		DBClusterArn:        cluster.DBClusterArn,
-		Engine:              cluster.Engine,
-		EngineVersion:       cluster.EngineVersion,
-		Status:              cluster.Status,
-		MasterUsername:      cluster.MasterUsername,
-		DatabaseName:        cluster.DatabaseName,
-		Endpoint:            cluster.Endpoint,
-		ReaderEndpoint:      cluster.ReaderEndpoint,
-		Port:                cluster.Port,
-		AllocatedStorage:    cluster.AllocatedStorage,
-		ClusterCreateTime:   cluster.ClusterCreateTime.Format("2006-01-02T15:04:05.000Z"),
-		MultiAZ:             cluster.MultiAZ,
-		AvailabilityZones:   XMLAvailabilityZones{Items: cluster.AvailabilityZones},
-		DBClusterMembers:    XMLDBClusterMembers{Items: members},
-		VpcSecurityGroups:   XMLVpcSecurityGroups{Items: vpcSecurityGroups},
-		StorageEncrypted:    cluster.StorageEncrypted,
-		DeletionProtection:  cluster.DeletionProtection,
+		DBClusterIdentifier:   cluster.DBClusterIdentifier,
+		DBClusterArn:          cluster.DBClusterArn,
+		Engine:                cluster.Engine,
+		EngineVersion:         cluster.EngineVersion,
+		Status:                cluster.Status,
+		MasterUsername:        cluster.MasterUsername,
+		DatabaseName:          cluster.DatabaseName,
+		Endpoint:              cluster.Endpoint,
+		ReaderEndpoint:        cluster.ReaderEndpoint,
+		Port:                  cluster.Port,
+		AllocatedStorage:      cluster.AllocatedStorage,
+		ClusterCreateTime:     cluster.ClusterCreateTime.Format("2006-01-02T15:04:05.000Z"),
+		MultiAZ:               cluster.MultiAZ,
+		AvailabilityZones:     XMLAvailabilityZones{Items: cluster.AvailabilityZones},
+		DBClusterMembers:      XMLDBClusterMembers{Items: members},
+		DBSubnetGroup:         cluster.DBSubnetGroup,
+		BackupRetentionPeriod: cluster.BackupRetentionPeriod,
+		VpcSecurityGroups:     XMLVpcSecurityGroups{Items: vpcSecurityGroups},
+		StorageEncrypted:      cluster.StorageEncrypted,
+		DeletionProtection:    cluster.DeletionProtection,
+	}
+}
+
+func convertToXMLDBParameterGroup(group *DBParameterGroup) XMLDBParameterGroup {
+	return XMLDBParameterGroup{
+		DBParameterGroupName:   group.DBParameterGroupName,
+		DBParameterGroupFamily: group.DBParameterGroupFamily,
+		Description:            group.Description,
+		DBParameterGroupArn:    group.DBParameterGroupArn,
+	}
+}
+
+func convertToXMLDBSubnetGroup(group *DBSubnetGroup) XMLDBSubnetGroup {
+	subnets := make([]XMLSubnet, 0, len(group.Subnets))
+	for _, subnet := range group.Subnets {
+		subnets = append(subnets, XMLSubnet{
+			SubnetIdentifier:       subnet.SubnetIdentifier,
+			SubnetAvailabilityZone: XMLAvailabilityZone{Name: subnet.SubnetAvailabilityZone},
+			SubnetStatus:           subnet.SubnetStatus,
+		})
+	}
+
+	return XMLDBSubnetGroup{
+		DBSubnetGroupName:        group.DBSubnetGroupName,
+		DBSubnetGroupDescription: group.DBSubnetGroupDescription,
+		VpcID:                    group.VpcID,
+		SubnetGroupStatus:        group.SubnetGroupStatus,
+		Subnets:                  XMLSubnets{Items: subnets},
+		DBSubnetGroupArn:         group.DBSubnetGroupArn,
 	}
 }
 
@@ -686,27 +1104,29 @@ type XMLDeleteDBSnapshotResponse struct {
 
 // XMLDBInstance is the XML representation of a DB instance.
 type XMLDBInstance struct {
-	DBInstanceIdentifier       string               `xml:"DBInstanceIdentifier"`
-	DBInstanceClass            string               `xml:"DBInstanceClass"`
-	Engine                     string               `xml:"Engine"`
-	EngineVersion              string               `xml:"EngineVersion,omitempty"`
-	DBInstanceStatus           string               `xml:"DBInstanceStatus"`
-	MasterUsername             string               `xml:"MasterUsername,omitempty"`
-	DBName                     string               `xml:"DBName,omitempty"`
-	Endpoint                   *XMLEndpoint         `xml:"Endpoint,omitempty"`
-	AllocatedStorage           int32                `xml:"AllocatedStorage"`
-	InstanceCreateTime         string               `xml:"InstanceCreateTime"`
-	DBInstanceArn              string               `xml:"DBInstanceArn"`
-	StorageType                string               `xml:"StorageType,omitempty"`
-	MultiAZ                    bool                 `xml:"MultiAZ"`
-	AvailabilityZone           string               `xml:"AvailabilityZone,omitempty"`
-	BackupRetentionPeriod      int32                `xml:"BackupRetentionPeriod"`
-	PreferredBackupWindow      string               `xml:"PreferredBackupWindow,omitempty"`
-	PreferredMaintenanceWindow string               `xml:"PreferredMaintenanceWindow,omitempty"`
-	PubliclyAccessible         bool                 `xml:"PubliclyAccessible"`
-	StorageEncrypted           bool                 `xml:"StorageEncrypted"`
-	DeletionProtection         bool                 `xml:"DeletionProtection"`
-	VpcSecurityGroups          XMLVpcSecurityGroups `xml:"VpcSecurityGroups"`
+	DBInstanceIdentifier       string                       `xml:"DBInstanceIdentifier"`
+	DBInstanceClass            string                       `xml:"DBInstanceClass"`
+	Engine                     string                       `xml:"Engine"`
+	EngineVersion              string                       `xml:"EngineVersion,omitempty"`
+	DBInstanceStatus           string                       `xml:"DBInstanceStatus"`
+	MasterUsername             string                       `xml:"MasterUsername,omitempty"`
+	DBName                     string                       `xml:"DBName,omitempty"`
+	Endpoint                   *XMLEndpoint                 `xml:"Endpoint,omitempty"`
+	AllocatedStorage           int32                        `xml:"AllocatedStorage"`
+	InstanceCreateTime         string                       `xml:"InstanceCreateTime"`
+	DBInstanceArn              string                       `xml:"DBInstanceArn"`
+	StorageType                string                       `xml:"StorageType,omitempty"`
+	MultiAZ                    bool                         `xml:"MultiAZ"`
+	AvailabilityZone           string                       `xml:"AvailabilityZone,omitempty"`
+	BackupRetentionPeriod      int32                        `xml:"BackupRetentionPeriod"`
+	PreferredBackupWindow      string                       `xml:"PreferredBackupWindow,omitempty"`
+	PreferredMaintenanceWindow string                       `xml:"PreferredMaintenanceWindow,omitempty"`
+	PubliclyAccessible         bool                         `xml:"PubliclyAccessible"`
+	StorageEncrypted           bool                         `xml:"StorageEncrypted"`
+	DeletionProtection         bool                         `xml:"DeletionProtection"`
+	VpcSecurityGroups          XMLVpcSecurityGroups         `xml:"VpcSecurityGroups"`
+	DBSubnetGroup              *XMLDBSubnetGroup            `xml:"DBSubnetGroup,omitempty"`
+	DBParameterGroups          *XMLDBParameterGroupStatuses `xml:"DBParameterGroups,omitempty"`
 }
 
 // XMLDBInstances is a list of XML DB instances.
@@ -734,24 +1154,26 @@ type XMLVpcSecurityGroupMembership struct {
 
 // XMLDBCluster is the XML representation of a DB cluster.
 type XMLDBCluster struct {
-	DBClusterIdentifier string               `xml:"DBClusterIdentifier"`
-	DBClusterArn        string               `xml:"DBClusterArn"`
-	Engine              string               `xml:"Engine"`
-	EngineVersion       string               `xml:"EngineVersion,omitempty"`
-	Status              string               `xml:"Status"`
-	MasterUsername      string               `xml:"MasterUsername,omitempty"`
-	DatabaseName        string               `xml:"DatabaseName,omitempty"`
-	Endpoint            string               `xml:"Endpoint"`
-	ReaderEndpoint      string               `xml:"ReaderEndpoint"`
-	Port                int32                `xml:"Port"`
-	AllocatedStorage    int32                `xml:"AllocatedStorage"`
-	ClusterCreateTime   string               `xml:"ClusterCreateTime"`
-	MultiAZ             bool                 `xml:"MultiAZ"`
-	AvailabilityZones   XMLAvailabilityZones `xml:"AvailabilityZones"`
-	DBClusterMembers    XMLDBClusterMembers  `xml:"DBClusterMembers"`
-	VpcSecurityGroups   XMLVpcSecurityGroups `xml:"VpcSecurityGroups"`
-	StorageEncrypted    bool                 `xml:"StorageEncrypted"`
-	DeletionProtection  bool                 `xml:"DeletionProtection"`
+	DBClusterIdentifier   string               `xml:"DBClusterIdentifier"`
+	DBClusterArn          string               `xml:"DBClusterArn"`
+	Engine                string               `xml:"Engine"`
+	EngineVersion         string               `xml:"EngineVersion,omitempty"`
+	Status                string               `xml:"Status"`
+	MasterUsername        string               `xml:"MasterUsername,omitempty"`
+	DatabaseName          string               `xml:"DatabaseName,omitempty"`
+	Endpoint              string               `xml:"Endpoint"`
+	ReaderEndpoint        string               `xml:"ReaderEndpoint"`
+	Port                  int32                `xml:"Port"`
+	AllocatedStorage      int32                `xml:"AllocatedStorage"`
+	ClusterCreateTime     string               `xml:"ClusterCreateTime"`
+	MultiAZ               bool                 `xml:"MultiAZ"`
+	AvailabilityZones     XMLAvailabilityZones `xml:"AvailabilityZones"`
+	DBClusterMembers      XMLDBClusterMembers  `xml:"DBClusterMembers"`
+	DBSubnetGroup         string               `xml:"DBSubnetGroup,omitempty"`
+	BackupRetentionPeriod int32                `xml:"BackupRetentionPeriod"`
+	VpcSecurityGroups     XMLVpcSecurityGroups `xml:"VpcSecurityGroups"`
+	StorageEncrypted      bool                 `xml:"StorageEncrypted"`
+	DeletionProtection    bool                 `xml:"DeletionProtection"`
 }
 
 // XMLDBClusters is a list of XML DB clusters.
@@ -793,6 +1215,146 @@ type XMLDBSnapshot struct {
 	Encrypted            bool   `xml:"Encrypted"`
 }
 
+// XMLCreateDBParameterGroupResponse is the XML response for CreateDBParameterGroup.
+type XMLCreateDBParameterGroupResponse struct {
+	XMLName          xml.Name            `xml:"CreateDBParameterGroupResponse"`
+	Xmlns            string              `xml:"xmlns,attr"`
+	DBParameterGroup XMLDBParameterGroup `xml:"CreateDBParameterGroupResult>DBParameterGroup"`
+	RequestID        string              `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLDescribeDBParameterGroupsResponse is the XML response for DescribeDBParameterGroups.
+type XMLDescribeDBParameterGroupsResponse struct {
+	XMLName           xml.Name             `xml:"DescribeDBParameterGroupsResponse"`
+	Xmlns             string               `xml:"xmlns,attr"`
+	DBParameterGroups XMLDBParameterGroups `xml:"DescribeDBParameterGroupsResult>DBParameterGroups"`
+	RequestID         string               `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLModifyDBParameterGroupResponse is the XML response for ModifyDBParameterGroup.
+type XMLModifyDBParameterGroupResponse struct {
+	XMLName              xml.Name `xml:"ModifyDBParameterGroupResponse"`
+	Xmlns                string   `xml:"xmlns,attr"`
+	DBParameterGroupName string   `xml:"ModifyDBParameterGroupResult>DBParameterGroupName"`
+	RequestID            string   `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLDeleteDBParameterGroupResponse is the XML response for DeleteDBParameterGroup.
+type XMLDeleteDBParameterGroupResponse struct {
+	XMLName   xml.Name `xml:"DeleteDBParameterGroupResponse"`
+	Xmlns     string   `xml:"xmlns,attr"`
+	RequestID string   `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLDescribeDBParametersResponse is the XML response for DescribeDBParameters.
+type XMLDescribeDBParametersResponse struct {
+	XMLName    xml.Name      `xml:"DescribeDBParametersResponse"`
+	Xmlns      string        `xml:"xmlns,attr"`
+	Parameters XMLParameters `xml:"DescribeDBParametersResult>Parameters"`
+	RequestID  string        `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLCreateDBSubnetGroupResponse is the XML response for CreateDBSubnetGroup.
+type XMLCreateDBSubnetGroupResponse struct {
+	XMLName       xml.Name         `xml:"CreateDBSubnetGroupResponse"`
+	Xmlns         string           `xml:"xmlns,attr"`
+	DBSubnetGroup XMLDBSubnetGroup `xml:"CreateDBSubnetGroupResult>DBSubnetGroup"`
+	RequestID     string           `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLDescribeDBSubnetGroupsResponse is the XML response for DescribeDBSubnetGroups.
+type XMLDescribeDBSubnetGroupsResponse struct {
+	XMLName        xml.Name          `xml:"DescribeDBSubnetGroupsResponse"`
+	Xmlns          string            `xml:"xmlns,attr"`
+	DBSubnetGroups XMLDBSubnetGroups `xml:"DescribeDBSubnetGroupsResult>DBSubnetGroups"`
+	RequestID      string            `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLModifyDBSubnetGroupResponse is the XML response for ModifyDBSubnetGroup.
+type XMLModifyDBSubnetGroupResponse struct {
+	XMLName       xml.Name         `xml:"ModifyDBSubnetGroupResponse"`
+	Xmlns         string           `xml:"xmlns,attr"`
+	DBSubnetGroup XMLDBSubnetGroup `xml:"ModifyDBSubnetGroupResult>DBSubnetGroup"`
+	RequestID     string           `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLDeleteDBSubnetGroupResponse is the XML response for DeleteDBSubnetGroup.
+type XMLDeleteDBSubnetGroupResponse struct {
+	XMLName   xml.Name `xml:"DeleteDBSubnetGroupResponse"`
+	Xmlns     string   `xml:"xmlns,attr"`
+	RequestID string   `xml:"ResponseMetadata>RequestId"`
+}
+
+// XMLDBParameterGroup is the XML representation of a DB parameter group.
+type XMLDBParameterGroup struct {
+	DBParameterGroupName   string `xml:"DBParameterGroupName"`
+	DBParameterGroupFamily string `xml:"DBParameterGroupFamily"`
+	Description            string `xml:"Description"`
+	DBParameterGroupArn    string `xml:"DBParameterGroupArn"`
+}
+
+// XMLDBParameterGroups is a list of XML DB parameter groups.
+type XMLDBParameterGroups struct {
+	Items []XMLDBParameterGroup `xml:"DBParameterGroup"`
+}
+
+// XMLDBParameterGroupStatus is the XML representation of the DB parameter group of a DB instance.
+type XMLDBParameterGroupStatus struct {
+	DBParameterGroupName string `xml:"DBParameterGroupName"`
+	ParameterApplyStatus string `xml:"ParameterApplyStatus"`
+}
+
+// XMLDBParameterGroupStatuses is a list of the DB parameter groups of a DB instance.
+type XMLDBParameterGroupStatuses struct {
+	Items []XMLDBParameterGroupStatus `xml:"DBParameterGroup"`
+}
+
+// XMLParameter is the XML representation of a DB parameter.
+type XMLParameter struct {
+	ParameterName  string `xml:"ParameterName"`
+	ParameterValue string `xml:"ParameterValue,omitempty"`
+	ApplyMethod    string `xml:"ApplyMethod,omitempty"`
+	Source         string `xml:"Source"`
+	IsModifiable   bool   `xml:"IsModifiable"`
+}
+
+// XMLParameters is a list of XML DB parameters.
+type XMLParameters struct {
+	Items []XMLParameter `xml:"Parameter"`
+}
+
+// XMLDBSubnetGroup is the XML representation of a DB subnet group.
+type XMLDBSubnetGroup struct {
+	DBSubnetGroupName        string     `xml:"DBSubnetGroupName"`
+	DBSubnetGroupDescription string     `xml:"DBSubnetGroupDescription"`
+	VpcID                    string     `xml:"VpcId,omitempty"`
+	SubnetGroupStatus        string     `xml:"SubnetGroupStatus"`
+	Subnets                  XMLSubnets `xml:"Subnets"`
+	DBSubnetGroupArn         string     `xml:"DBSubnetGroupArn"`
+}
+
+// XMLDBSubnetGroups is a list of XML DB subnet groups.
+type XMLDBSubnetGroups struct {
+	Items []XMLDBSubnetGroup `xml:"DBSubnetGroup"`
+}
+
+// XMLSubnets is a list of XML subnets.
+type XMLSubnets struct {
+	Items []XMLSubnet `xml:"Subnet"`
+}
+
+// XMLSubnet is the XML representation of a subnet of a DB subnet group.
+type XMLSubnet struct {
+	SubnetIdentifier       string              `xml:"SubnetIdentifier"`
+	SubnetAvailabilityZone XMLAvailabilityZone `xml:"SubnetAvailabilityZone"`
+	SubnetStatus           string              `xml:"SubnetStatus"`
+}
+
+// XMLAvailabilityZone is the XML representation of an availability zone.
+type XMLAvailabilityZone struct {
+	Name string `xml:"Name"`
+}
+
 // XMLErrorResponse is the XML error response.
 type XMLErrorResponse struct {
 	XMLName   xml.Name `xml:"ErrorResponse"`
@@ -0,0 +1,77 @@
+package rds
+
+import "time"
+
+// defaultClusterTransitionDelay is how long DB clusters stay creating or upgrading before the scheduler makes them available.
+const defaultClusterTransitionDelay = 500 * time.Millisecond
+
+// WithClusterTransitionDelay sets how long DB clusters stay creating or upgrading before the scheduler makes them available.
+func WithClusterTransitionDelay(d time.Duration) Option {
+	return func(m *MemoryStorage) {
+		m.clusterTransitionDelay = d
+	}
+}
+
+// clusterScheduler periodically makes creating and upgrading DB clusters available.
+func (m *MemoryStorage) clusterScheduler() {
+	ticker := time.NewTicker(max(m.clusterTransitionDelay/5, 10*time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stopScheduler:
+			return
+		case now := <-ticker.C:
+			m.advanceClusters(now)
+		}
+	}
+}
+
+// advanceClusters makes the DB clusters that have been creating or upgrading
+// for the transition delay available.
+func (m *MemoryStorage) advanceClusters(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for identifier, cluster := range m.Clusters {
+		if m.due(identifier, cluster.Status, now) {
+			cluster.Status = DBClusterStatusAvailable
+		}
+	}
+
+	// Drop the timers of clusters that no longer exist.
+	for identifier := range m.transitions {
+		if _, ok := m.Clusters[identifier]; !ok {
+			delete(m.transitions, identifier)
+		}
+	}
+}
+
+// due reports whether a creating or upgrading DB cluster has spent the
+// transition delay in that status. Clusters restored from disk start their
+// timer on the first tick.
+func (m *MemoryStorage) due(identifier, status string, now time.Time) bool {
+	if status != DBClusterStatusCreating && status != DBClusterStatusUpgrading {
+		return false
+	}
+
+	changed, ok := m.transitions[identifier]
+	if !ok {
+		m.transitions[identifier] = now
+
+		return false
+	}
+
+	if now.Sub(changed) < m.clusterTransitionDelay {
+		return false
+	}
+
+	delete(m.transitions, identifier)
+
+	return true
+}
+
+// startTransition marks a DB cluster as having just entered creating or upgrading.
+func (m *MemoryStorage) startTransition(identifier string) {
+	m.transitions[identifier] = time.Now()
+}
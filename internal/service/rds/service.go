@@ -1,9 +1,11 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -12,13 +14,20 @@ import (
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"rds"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
+
+		if delay, err := time.ParseDuration(os.Getenv("KUMO_RDS_CLUSTER_TRANSITION_DELAY")); err == nil {
+			opts = append(opts, WithClusterTransitionDelay(delay))
+		}
+
+		storage := NewMemoryStorage(opts...)
 
-	storage := NewMemoryStorage(opts...)
-	service.Register(New(storage))
+		return []service.Service{New(storage)}
+	})
 }
 
 // Service implements the RDS service.
@@ -65,6 +74,15 @@ func (s *Service) Actions() []string {
 		"ModifyDBCluster",
 		"CreateDBSnapshot",
 		"DeleteDBSnapshot",
+		"CreateDBParameterGroup",
+		"DescribeDBParameterGroups",
+		"ModifyDBParameterGroup",
+		"DeleteDBParameterGroup",
+		"DescribeDBParameters",
+		"CreateDBSubnetGroup",
+		"DescribeDBSubnetGroups",
+		"ModifyDBSubnetGroup",
+		"DeleteDBSubnetGroup",
 	}
 }
 
@@ -86,3 +104,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
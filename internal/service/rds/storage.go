@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,6 +20,13 @@ const (
 	defaultRegion    = "us-east-1"
 )
 
+// DB cluster backup retention limits, in days.
+const (
+	defaultBackupRetentionPeriod int32 = 1
+	minBackupRetentionPeriod     int32 = 1
+	maxBackupRetentionPeriod     int32 = 35
+)
+
 // Storage defines the RDS storage interface.
 type Storage interface {
 	CreateDBInstance(ctx context.Context, input *CreateDBInstanceInput) (*DBInstance, error)
@@ -31,6 +41,16 @@ type Storage interface {
 	ModifyDBCluster(ctx context.Context, input *ModifyDBClusterInput) (*DBCluster, error)
 	CreateDBSnapshot(ctx context.Context, input *CreateDBSnapshotInput) (*DBSnapshot, error)
 	DeleteDBSnapshot(ctx context.Context, identifier string) (*DBSnapshot, error)
+	CreateDBParameterGroup(ctx context.Context, input *CreateDBParameterGroupInput) (*DBParameterGroup, error)
+	DescribeDBParameterGroups(ctx context.Context, name string) ([]DBParameterGroup, error)
+	ModifyDBParameterGroup(ctx context.Context, input *ModifyDBParameterGroupInput) (*DBParameterGroup, error)
+	DeleteDBParameterGroup(ctx context.Context, name string) error
+	DescribeDBParameters(ctx context.Context, name string) ([]Parameter, error)
+	CreateDBSubnetGroup(ctx context.Context, input *CreateDBSubnetGroupInput) (*DBSubnetGroup, error)
+	DescribeDBSubnetGroups(ctx context.Context, name string) ([]DBSubnetGroup, error)
+	ModifyDBSubnetGroup(ctx context.Context, input *ModifyDBSubnetGroupInput) (*DBSubnetGroup, error)
+	DeleteDBSubnetGroup(ctx context.Context, name string) error
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -55,7 +75,15 @@ type MemoryStorage struct {
 	Instances map[string]*DBInstance `json:"instances"`
 	Clusters  map[string]*DBCluster  `json:"clusters"`
 	Snapshots map[string]*DBSnapshot `json:"snapshots"`
-	dataDir   string
+	// ParameterGroups and SubnetGroups are keyed by group name.
+	ParameterGroups map[string]*DBParameterGroup `json:"parameterGroups"`
+	SubnetGroups    map[string]*DBSubnetGroup    `json:"subnetGroups"`
+	dataDir         string
+
+	clusterTransitionDelay time.Duration
+	// transitions records when each creating or upgrading DB cluster entered that status.
+	transitions   map[string]time.Time
+	stopScheduler chan struct{}
 }
 
 // NewMemoryStorage creates a new MemoryStorage.
@@ -64,6 +92,13 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		Instances: make(map[string]*DBInstance),
 		Clusters:  make(map[string]*DBCluster),
 		Snapshots: make(map[string]*DBSnapshot),
+
+		ParameterGroups: make(map[string]*DBParameterGroup),
+		SubnetGroups:    make(map[string]*DBSubnetGroup),
+
+		clusterTransitionDelay: defaultClusterTransitionDelay,
+		transitions:            make(map[string]time.Time),
+		stopScheduler:          make(chan struct{}),
 	}
 	for _, o := range opts {
 		o(s)
@@ -73,6 +108,8 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		_ = storage.Load(s.dataDir, "rds", s)
 	}
 
+	go s.clusterScheduler()
+
 	return s
 }
 
@@ -116,11 +153,21 @@ func (m *MemoryStorage) UnmarshalJSON(data []byte) error {
 		m.Snapshots = make(map[string]*DBSnapshot)
 	}
 
+	if m.ParameterGroups == nil {
+		m.ParameterGroups = make(map[string]*DBParameterGroup)
+	}
+
+	if m.SubnetGroups == nil {
+		m.SubnetGroups = make(map[string]*DBSubnetGroup)
+	}
+
 	return nil
 }
 
 // Close saves the storage state to disk if persistence is enabled.
 func (m *MemoryStorage) Close() error {
+	close(m.stopScheduler)
+
 	if m.dataDir == "" {
 		return nil
 	}
@@ -132,6 +179,21 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Instances = make(map[string]*DBInstance)
+	m.Clusters = make(map[string]*DBCluster)
+	m.Snapshots = make(map[string]*DBSnapshot)
+	m.ParameterGroups = make(map[string]*DBParameterGroup)
+	m.SubnetGroups = make(map[string]*DBSubnetGroup)
+	m.transitions = make(map[string]time.Time)
+
+	return nil
+}
+
 // CreateDBInstance creates a new DB instance.
 func (m *MemoryStorage) CreateDBInstance(_ context.Context, input *CreateDBInstanceInput) (*DBInstance, error) {
 	m.mu.Lock()
@@ -144,6 +206,10 @@ func (m *MemoryStorage) CreateDBInstance(_ context.Context, input *CreateDBInsta
 		}
 	}
 
+	if err := m.checkGroupReferences(input.DBSubnetGroupName, input.DBParameterGroupName); err != nil {
+		return nil, err
+	}
+
 	instance := m.buildDBInstance(input)
 	m.Instances[input.DBInstanceIdentifier] = instance
 
@@ -194,6 +260,16 @@ func (m *MemoryStorage) buildDBInstance(input *CreateDBInstanceInput) *DBInstanc
 		},
 	}
 
+	if group, ok := m.SubnetGroups[input.DBSubnetGroupName]; ok {
+		instance.DBSubnetGroup = cloneDBSubnetGroup(group)
+	}
+
+	if input.DBParameterGroupName != "" {
+		instance.DBParameterGroups = []DBParameterGroupStatus{
+			{DBParameterGroupName: input.DBParameterGroupName, ParameterApplyStatus: "in-sync"},
+		}
+	}
+
 	return instance
 }
 
@@ -368,6 +444,19 @@ func (m *MemoryStorage) CreateDBCluster(_ context.Context, input *CreateDBCluste
 		}
 	}
 
+	backupRetentionPeriod := input.BackupRetentionPeriod
+	if backupRetentionPeriod == 0 {
+		backupRetentionPeriod = defaultBackupRetentionPeriod
+	}
+
+	if err := validateBackupRetentionPeriod(backupRetentionPeriod); err != nil {
+		return nil, err
+	}
+
+	if err := m.checkGroupReferences(input.DBSubnetGroupName, ""); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 
 	port := input.Port
@@ -376,22 +465,24 @@ func (m *MemoryStorage) CreateDBCluster(_ context.Context, input *CreateDBCluste
 	}
 
 	cluster := &DBCluster{
-		DBClusterIdentifier: input.DBClusterIdentifier,
-		DBClusterArn:        m.dbClusterArn(input.DBClusterIdentifier),
-		Engine:              input.Engine,
-		EngineVersion:       input.EngineVersion,
-		Status:              DBClusterStatusAvailable,
-		MasterUsername:      input.MasterUsername,
-		DatabaseName:        input.DatabaseName,
-		Endpoint:            fmt.Sprintf("%s.cluster-%s.%s.rds.amazonaws.com", input.DBClusterIdentifier, generateID(), defaultRegion),
-		ReaderEndpoint:      fmt.Sprintf("%s.cluster-ro-%s.%s.rds.amazonaws.com", input.DBClusterIdentifier, generateID(), defaultRegion),
-		Port:                port,
-		AllocatedStorage:    input.AllocatedStorage,
-		ClusterCreateTime:   now,
-		AvailabilityZones:   input.AvailabilityZones,
-		StorageEncrypted:    input.StorageEncrypted,
-		DeletionProtection:  input.DeletionProtection,
-		Tags:                input.Tags,
+		DBClusterIdentifier:   input.DBClusterIdentifier,
+		DBClusterArn:          m.dbClusterArn(input.DBClusterIdentifier),
+		Engine:                input.Engine,
+		EngineVersion:         input.EngineVersion,
+		Status:                DBClusterStatusCreating,
+		MasterUsername:        input.MasterUsername,
+		DatabaseName:          input.DatabaseName,
+		Endpoint:              fmt.Sprintf("%s.cluster-%s.%s.rds.amazonaws.com", input.DBClusterIdentifier, generateID(), defaultRegion),
+		ReaderEndpoint:        fmt.Sprintf("%s.cluster-ro-%s.%s.rds.amazonaws.com", input.DBClusterIdentifier, generateID(), defaultRegion),
+		Port:                  port,
+		AllocatedStorage:      input.AllocatedStorage,
+		ClusterCreateTime:     now,
+		AvailabilityZones:     input.AvailabilityZones,
+		DBSubnetGroup:         input.DBSubnetGroupName,
+		BackupRetentionPeriod: backupRetentionPeriod,
+		StorageEncrypted:      input.StorageEncrypted,
+		DeletionProtection:    input.DeletionProtection,
+		Tags:                  input.Tags,
 	}
 
 	if len(input.AvailabilityZones) == 0 {
@@ -408,8 +499,9 @@ func (m *MemoryStorage) CreateDBCluster(_ context.Context, input *CreateDBCluste
 	}
 
 	m.Clusters[input.DBClusterIdentifier] = cluster
+	m.startTransition(input.DBClusterIdentifier)
 
-	return cluster, nil
+	return cloneDBCluster(cluster), nil
 }
 
 // DeleteDBCluster deletes a DB cluster.
@@ -428,6 +520,7 @@ func (m *MemoryStorage) DeleteDBCluster(_ context.Context, identifier string, _
 	cluster.Status = DBClusterStatusDeleting
 
 	delete(m.Clusters, identifier)
+	delete(m.transitions, identifier)
 
 	return cluster, nil
 }
@@ -446,18 +539,19 @@ func (m *MemoryStorage) DescribeDBClusters(_ context.Context, identifier string)
 			}
 		}
 
-		return []DBCluster{*cluster}, nil
+		return []DBCluster{*cloneDBCluster(cluster)}, nil
 	}
 
 	clusters := make([]DBCluster, 0, len(m.Clusters))
 	for _, cluster := range m.Clusters {
-		clusters = append(clusters, *cluster)
+		clusters = append(clusters, *cloneDBCluster(cluster))
 	}
 
 	return clusters, nil
 }
 
-// ModifyDBCluster modifies a DB cluster.
+// ModifyDBCluster modifies a DB cluster. Changing the engine version puts the
+// cluster in upgrading until the scheduler makes it available again.
 func (m *MemoryStorage) ModifyDBCluster(_ context.Context, input *ModifyDBClusterInput) (*DBCluster, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -470,8 +564,25 @@ func (m *MemoryStorage) ModifyDBCluster(_ context.Context, input *ModifyDBCluste
 		}
 	}
 
-	if input.EngineVersion != "" {
+	if cluster.Status != DBClusterStatusAvailable {
+		return nil, &Error{
+			Code:    errInvalidDBClusterState,
+			Message: fmt.Sprintf("DB cluster %s is not in available state: %s", input.DBClusterIdentifier, cluster.Status),
+		}
+	}
+
+	if input.BackupRetentionPeriod != nil {
+		if err := validateBackupRetentionPeriod(*input.BackupRetentionPeriod); err != nil {
+			return nil, err
+		}
+
+		cluster.BackupRetentionPeriod = *input.BackupRetentionPeriod
+	}
+
+	if input.EngineVersion != "" && input.EngineVersion != cluster.EngineVersion {
 		cluster.EngineVersion = input.EngineVersion
+		cluster.Status = DBClusterStatusUpgrading
+		m.startTransition(input.DBClusterIdentifier)
 	}
 
 	if input.Port != nil {
@@ -486,7 +597,7 @@ func (m *MemoryStorage) ModifyDBCluster(_ context.Context, input *ModifyDBCluste
 		cluster.VpcSecurityGroups = buildVpcSecurityGroups(input.VpcSecurityGroupIDs)
 	}
 
-	return cluster, nil
+	return cloneDBCluster(cluster), nil
 }
 
 // CreateDBSnapshot creates a DB snapshot.
@@ -553,6 +664,269 @@ func (m *MemoryStorage) DeleteDBSnapshot(_ context.Context, identifier string) (
 
 // Helper functions.
 
+// CreateDBParameterGroup creates a DB parameter group.
+func (m *MemoryStorage) CreateDBParameterGroup(_ context.Context, input *CreateDBParameterGroupInput) (*DBParameterGroup, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.ParameterGroups[input.DBParameterGroupName]; exists {
+		return nil, &Error{
+			Code:    errDBParameterGroupExists,
+			Message: fmt.Sprintf("Parameter group %s already exists", input.DBParameterGroupName),
+		}
+	}
+
+	group := &DBParameterGroup{
+		DBParameterGroupName:   input.DBParameterGroupName,
+		DBParameterGroupFamily: input.DBParameterGroupFamily,
+		Description:            input.Description,
+		DBParameterGroupArn:    m.dbParameterGroupArn(input.DBParameterGroupName),
+		Parameters:             make(map[string]Parameter),
+		Tags:                   input.Tags,
+	}
+
+	m.ParameterGroups[input.DBParameterGroupName] = group
+
+	return group, nil
+}
+
+// DescribeDBParameterGroups describes DB parameter groups.
+func (m *MemoryStorage) DescribeDBParameterGroups(_ context.Context, name string) ([]DBParameterGroup, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if name != "" {
+		group, err := m.parameterGroup(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return []DBParameterGroup{*group}, nil
+	}
+
+	groups := make([]DBParameterGroup, 0, len(m.ParameterGroups))
+	for _, group := range m.ParameterGroups {
+		groups = append(groups, *group)
+	}
+
+	slices.SortFunc(groups, func(a, b DBParameterGroup) int {
+		return strings.Compare(a.DBParameterGroupName, b.DBParameterGroupName)
+	})
+
+	return groups, nil
+}
+
+// ModifyDBParameterGroup sets parameters of a DB parameter group.
+func (m *MemoryStorage) ModifyDBParameterGroup(_ context.Context, input *ModifyDBParameterGroupInput) (*DBParameterGroup, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	group, err := m.parameterGroup(input.DBParameterGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, param := range input.Parameters {
+		group.Parameters[param.ParameterName] = param
+	}
+
+	return group, nil
+}
+
+// DeleteDBParameterGroup deletes a DB parameter group that no DB instance uses.
+func (m *MemoryStorage) DeleteDBParameterGroup(_ context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, err := m.parameterGroup(name); err != nil {
+		return err
+	}
+
+	for _, instance := range m.Instances {
+		for _, status := range instance.DBParameterGroups {
+			if status.DBParameterGroupName == name {
+				return &Error{
+					Code:    errInvalidDBParameterGroupState,
+					Message: fmt.Sprintf("One or more database instances are still members of this parameter group %s", name),
+				}
+			}
+		}
+	}
+
+	delete(m.ParameterGroups, name)
+
+	return nil
+}
+
+// DescribeDBParameters returns the parameters set on a DB parameter group, sorted by name.
+func (m *MemoryStorage) DescribeDBParameters(_ context.Context, name string) ([]Parameter, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	group, err := m.parameterGroup(name)
+	if err != nil {
+		return nil, err
+	}
+
+	params := slices.Collect(maps.Values(group.Parameters))
+
+	slices.SortFunc(params, func(a, b Parameter) int {
+		return strings.Compare(a.ParameterName, b.ParameterName)
+	})
+
+	return params, nil
+}
+
+// CreateDBSubnetGroup creates a DB subnet group.
+func (m *MemoryStorage) CreateDBSubnetGroup(_ context.Context, input *CreateDBSubnetGroupInput) (*DBSubnetGroup, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.SubnetGroups[input.DBSubnetGroupName]; exists {
+		return nil, &Error{
+			Code:    errDBSubnetGroupAlreadyExists,
+			Message: fmt.Sprintf("The DB subnet group '%s' already exists", input.DBSubnetGroupName),
+		}
+	}
+
+	group := &DBSubnetGroup{
+		DBSubnetGroupName:        input.DBSubnetGroupName,
+		DBSubnetGroupDescription: input.DBSubnetGroupDescription,
+		DBSubnetGroupArn:         m.dbSubnetGroupArn(input.DBSubnetGroupName),
+		SubnetGroupStatus:        DBSubnetGroupStatusComplete,
+		Subnets:                  buildSubnets(input.SubnetIDs),
+		Tags:                     input.Tags,
+	}
+
+	m.SubnetGroups[input.DBSubnetGroupName] = group
+
+	return cloneDBSubnetGroup(group), nil
+}
+
+// DescribeDBSubnetGroups describes DB subnet groups.
+func (m *MemoryStorage) DescribeDBSubnetGroups(_ context.Context, name string) ([]DBSubnetGroup, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if name != "" {
+		group, err := m.subnetGroup(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return []DBSubnetGroup{*cloneDBSubnetGroup(group)}, nil
+	}
+
+	groups := make([]DBSubnetGroup, 0, len(m.SubnetGroups))
+	for _, group := range m.SubnetGroups {
+		groups = append(groups, *cloneDBSubnetGroup(group))
+	}
+
+	slices.SortFunc(groups, func(a, b DBSubnetGroup) int {
+		return strings.Compare(a.DBSubnetGroupName, b.DBSubnetGroupName)
+	})
+
+	return groups, nil
+}
+
+// ModifyDBSubnetGroup replaces the subnets and, if given, the description of a DB subnet group.
+func (m *MemoryStorage) ModifyDBSubnetGroup(_ context.Context, input *ModifyDBSubnetGroupInput) (*DBSubnetGroup, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	group, err := m.subnetGroup(input.DBSubnetGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.DBSubnetGroupDescription != "" {
+		group.DBSubnetGroupDescription = input.DBSubnetGroupDescription
+	}
+
+	group.Subnets = buildSubnets(input.SubnetIDs)
+
+	return cloneDBSubnetGroup(group), nil
+}
+
+// DeleteDBSubnetGroup deletes a DB subnet group that no DB instance or cluster uses.
+func (m *MemoryStorage) DeleteDBSubnetGroup(_ context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, err := m.subnetGroup(name); err != nil {
+		return err
+	}
+
+	inUse := false
+
+	for _, instance := range m.Instances {
+		if instance.DBSubnetGroup != nil && instance.DBSubnetGroup.DBSubnetGroupName == name {
+			inUse = true
+		}
+	}
+
+	for _, cluster := range m.Clusters {
+		if cluster.DBSubnetGroup == name {
+			inUse = true
+		}
+	}
+
+	if inUse {
+		return &Error{
+			Code:    errInvalidDBSubnetGroupState,
+			Message: fmt.Sprintf("Cannot delete the subnet group '%s' because at least one database instance or cluster is still using it", name),
+		}
+	}
+
+	delete(m.SubnetGroups, name)
+
+	return nil
+}
+
+// parameterGroup returns the DB parameter group with the name.
+func (m *MemoryStorage) parameterGroup(name string) (*DBParameterGroup, error) {
+	group, exists := m.ParameterGroups[name]
+	if !exists {
+		return nil, &Error{
+			Code:    errDBParameterGroupNotFound,
+			Message: fmt.Sprintf("DBParameterGroup not found: %s", name),
+		}
+	}
+
+	return group, nil
+}
+
+// subnetGroup returns the DB subnet group with the name.
+func (m *MemoryStorage) subnetGroup(name string) (*DBSubnetGroup, error) {
+	group, exists := m.SubnetGroups[name]
+	if !exists {
+		return nil, &Error{
+			Code:    errDBSubnetGroupNotFound,
+			Message: fmt.Sprintf("DBSubnetGroup not found: %s", name),
+		}
+	}
+
+	return group, nil
+}
+
+// checkGroupReferences reports an error if a DB subnet group or DB parameter
+// group referenced by a new DB instance or cluster does not exist.
+func (m *MemoryStorage) checkGroupReferences(subnetGroupName, parameterGroupName string) error {
+	if subnetGroupName != "" {
+		if _, err := m.subnetGroup(subnetGroupName); err != nil {
+			return err
+		}
+	}
+
+	if parameterGroupName != "" {
+		if _, err := m.parameterGroup(parameterGroupName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *MemoryStorage) dbInstanceArn(identifier string) string {
 	return fmt.Sprintf("arn:aws:rds:%s:%s:db:%s", defaultRegion, defaultAccountID, identifier)
 }
@@ -561,6 +935,14 @@ func (m *MemoryStorage) dbClusterArn(identifier string) string {
 	return fmt.Sprintf("arn:aws:rds:%s:%s:cluster:%s", defaultRegion, defaultAccountID, identifier)
 }
 
+func (m *MemoryStorage) dbParameterGroupArn(name string) string {
+	return fmt.Sprintf("arn:aws:rds:%s:%s:pg:%s", defaultRegion, defaultAccountID, name)
+}
+
+func (m *MemoryStorage) dbSubnetGroupArn(name string) string {
+	return fmt.Sprintf("arn:aws:rds:%s:%s:subgrp:%s", defaultRegion, defaultAccountID, name)
+}
+
 func (m *MemoryStorage) dbSnapshotArn(identifier string) string {
 	return fmt.Sprintf("arn:aws:rds:%s:%s:snapshot:%s", defaultRegion, defaultAccountID, identifier)
 }
@@ -599,3 +981,47 @@ func buildVpcSecurityGroups(sgIDs []string) []VpcSecurityGroupMembership {
 
 	return groups
 }
+
+// validateBackupRetentionPeriod reports whether a DB cluster backup retention period is within 1 to 35 days.
+func validateBackupRetentionPeriod(days int32) error {
+	if days < minBackupRetentionPeriod || days > maxBackupRetentionPeriod {
+		return &Error{
+			Code:    errInvalidParameterValue,
+			Message: fmt.Sprintf("BackupRetentionPeriod must be between %d and %d: %d", minBackupRetentionPeriod, maxBackupRetentionPeriod, days),
+		}
+	}
+
+	return nil
+}
+
+// cloneDBCluster returns a copy of a DB cluster that the scheduler will not mutate.
+func cloneDBCluster(cluster *DBCluster) *DBCluster {
+	c := *cluster
+
+	return &c
+}
+
+// buildSubnets returns the subnets of a DB subnet group, spreading them across
+// the availability zones of the region.
+func buildSubnets(subnetIDs []string) []Subnet {
+	zones := []string{"a", "b", "c"}
+
+	subnets := make([]Subnet, 0, len(subnetIDs))
+	for i, subnetID := range subnetIDs {
+		subnets = append(subnets, Subnet{
+			SubnetIdentifier:       subnetID,
+			SubnetAvailabilityZone: defaultRegion + zones[i%len(zones)],
+			SubnetStatus:           "Active",
+		})
+	}
+
+	return subnets
+}
+
+// cloneDBSubnetGroup returns a copy of a DB subnet group that later modifications will not change.
+func cloneDBSubnetGroup(group *DBSubnetGroup) *DBSubnetGroup {
+	g := *group
+	g.Subnets = slices.Clone(group.Subnets)
+
+	return &g
+}
@@ -34,25 +34,27 @@ type DBInstance struct {
 
 // DBCluster represents an RDS database cluster.
 type DBCluster struct {
-	DBClusterIdentifier string
-	DBClusterArn        string
-	Engine              string
-	EngineVersion       string
-	Status              string
-	MasterUsername      string
-	DatabaseName        string
-	Endpoint            string
-	ReaderEndpoint      string
-	Port                int32
-	AllocatedStorage    int32
-	ClusterCreateTime   time.Time
-	MultiAZ             bool
-	AvailabilityZones   []string
-	DBClusterMembers    []DBClusterMember
-	VpcSecurityGroups   []VpcSecurityGroupMembership
-	StorageEncrypted    bool
-	DeletionProtection  bool
-	Tags                []Tag
+	DBClusterIdentifier   string
+	DBClusterArn          string
+	Engine                string
+	EngineVersion         string
+	Status                string
+	MasterUsername        string
+	DatabaseName          string
+	Endpoint              string
+	ReaderEndpoint        string
+	Port                  int32
+	AllocatedStorage      int32
+	ClusterCreateTime     time.Time
+	MultiAZ               bool
+	AvailabilityZones     []string
+	DBClusterMembers      []DBClusterMember
+	DBSubnetGroup         string
+	BackupRetentionPeriod int32
+	VpcSecurityGroups     []VpcSecurityGroupMembership
+	StorageEncrypted      bool
+	DeletionProtection    bool
+	Tags                  []Tag
 }
 
 // DBSnapshot represents an RDS database snapshot.
@@ -83,10 +85,38 @@ type Endpoint struct {
 
 // DBSubnetGroup represents a DB subnet group.
 type DBSubnetGroup struct {
-	DBSubnetGroupName        string `json:"DBSubnetGroupName,omitempty"`
-	DBSubnetGroupDescription string `json:"DBSubnetGroupDescription,omitempty"`
-	VpcID                    string `json:"VpcId,omitempty"`
-	SubnetGroupStatus        string `json:"SubnetGroupStatus,omitempty"`
+	DBSubnetGroupName        string   `json:"DBSubnetGroupName,omitempty"`
+	DBSubnetGroupDescription string   `json:"DBSubnetGroupDescription,omitempty"`
+	DBSubnetGroupArn         string   `json:"DBSubnetGroupArn,omitempty"`
+	VpcID                    string   `json:"VpcId,omitempty"`
+	SubnetGroupStatus        string   `json:"SubnetGroupStatus,omitempty"`
+	Subnets                  []Subnet `json:"Subnets,omitempty"`
+	Tags                     []Tag    `json:"Tags,omitempty"`
+}
+
+// Subnet represents a subnet of a DB subnet group.
+type Subnet struct {
+	SubnetIdentifier       string `json:"SubnetIdentifier,omitempty"`
+	SubnetAvailabilityZone string `json:"SubnetAvailabilityZone,omitempty"`
+	SubnetStatus           string `json:"SubnetStatus,omitempty"`
+}
+
+// DBParameterGroup represents a DB parameter group.
+type DBParameterGroup struct {
+	DBParameterGroupName   string `json:"DBParameterGroupName,omitempty"`
+	DBParameterGroupFamily string `json:"DBParameterGroupFamily,omitempty"`
+	Description            string `json:"Description,omitempty"`
+	DBParameterGroupArn    string `json:"DBParameterGroupArn,omitempty"`
+	// Parameters holds the parameters set by ModifyDBParameterGroup, keyed by parameter name.
+	Parameters map[string]Parameter `json:"Parameters,omitempty"`
+	Tags       []Tag                `json:"Tags,omitempty"`
+}
+
+// Parameter represents a parameter of a DB parameter group.
+type Parameter struct {
+	ParameterName  string `json:"ParameterName,omitempty"`
+	ParameterValue string `json:"ParameterValue,omitempty"`
+	ApplyMethod    string `json:"ApplyMethod,omitempty"`
 }
 
 // VpcSecurityGroupMembership represents a VPC security group membership.
@@ -130,6 +160,7 @@ type CreateDBInstanceInput struct {
 	MultiAZ                    bool     `json:"MultiAZ,omitempty"`
 	AvailabilityZone           string   `json:"AvailabilityZone,omitempty"`
 	DBSubnetGroupName          string   `json:"DBSubnetGroupName,omitempty"`
+	DBParameterGroupName       string   `json:"DBParameterGroupName,omitempty"`
 	VpcSecurityGroupIDs        []string `json:"VpcSecurityGroupIDs,omitempty"`
 	BackupRetentionPeriod      int32    `json:"BackupRetentionPeriod,omitempty"`
 	PreferredBackupWindow      string   `json:"PreferredBackupWindow,omitempty"`
@@ -217,19 +248,21 @@ type StopDBInstanceOutput struct {
 
 // CreateDBClusterInput represents the input for CreateDBCluster.
 type CreateDBClusterInput struct {
-	DBClusterIdentifier string   `json:"DBClusterIdentifier"`
-	Engine              string   `json:"Engine"`
-	EngineVersion       string   `json:"EngineVersion,omitempty"`
-	MasterUsername      string   `json:"MasterUsername,omitempty"`
-	MasterUserPassword  string   `json:"MasterUserPassword,omitempty"`
-	DatabaseName        string   `json:"DatabaseName,omitempty"`
-	Port                int32    `json:"Port,omitempty"`
-	AllocatedStorage    int32    `json:"AllocatedStorage,omitempty"`
-	AvailabilityZones   []string `json:"AvailabilityZones,omitempty"`
-	VpcSecurityGroupIDs []string `json:"VpcSecurityGroupIDs,omitempty"`
-	StorageEncrypted    bool     `json:"StorageEncrypted,omitempty"`
-	DeletionProtection  bool     `json:"DeletionProtection,omitempty"`
-	Tags                []Tag    `json:"Tags,omitempty"`
+	DBClusterIdentifier   string   `json:"DBClusterIdentifier"`
+	Engine                string   `json:"Engine"`
+	EngineVersion         string   `json:"EngineVersion,omitempty"`
+	MasterUsername        string   `json:"MasterUsername,omitempty"`
+	MasterUserPassword    string   `json:"MasterUserPassword,omitempty"`
+	DatabaseName          string   `json:"DatabaseName,omitempty"`
+	Port                  int32    `json:"Port,omitempty"`
+	AllocatedStorage      int32    `json:"AllocatedStorage,omitempty"`
+	AvailabilityZones     []string `json:"AvailabilityZones,omitempty"`
+	DBSubnetGroupName     string   `json:"DBSubnetGroupName,omitempty"`
+	VpcSecurityGroupIDs   []string `json:"VpcSecurityGroupIDs,omitempty"`
+	BackupRetentionPeriod int32    `json:"BackupRetentionPeriod,omitempty"`
+	StorageEncrypted      bool     `json:"StorageEncrypted,omitempty"`
+	DeletionProtection    bool     `json:"DeletionProtection,omitempty"`
+	Tags                  []Tag    `json:"Tags,omitempty"`
 }
 
 // CreateDBClusterOutput represents the output for CreateDBCluster.
@@ -264,13 +297,14 @@ type DescribeDBClustersOutput struct {
 
 // ModifyDBClusterInput represents the input for ModifyDBCluster.
 type ModifyDBClusterInput struct {
-	DBClusterIdentifier string   `json:"DBClusterIdentifier"`
-	EngineVersion       string   `json:"EngineVersion,omitempty"`
-	MasterUserPassword  string   `json:"MasterUserPassword,omitempty"`
-	Port                *int32   `json:"Port,omitempty"`
-	DeletionProtection  *bool    `json:"DeletionProtection,omitempty"`
-	VpcSecurityGroupIDs []string `json:"VpcSecurityGroupIDs,omitempty"`
-	ApplyImmediately    bool     `json:"ApplyImmediately,omitempty"`
+	DBClusterIdentifier   string   `json:"DBClusterIdentifier"`
+	EngineVersion         string   `json:"EngineVersion,omitempty"`
+	MasterUserPassword    string   `json:"MasterUserPassword,omitempty"`
+	Port                  *int32   `json:"Port,omitempty"`
+	BackupRetentionPeriod *int32   `json:"BackupRetentionPeriod,omitempty"`
+	DeletionProtection    *bool    `json:"DeletionProtection,omitempty"`
+	VpcSecurityGroupIDs   []string `json:"VpcSecurityGroupIDs,omitempty"`
+	ApplyImmediately      bool     `json:"ApplyImmediately,omitempty"`
 }
 
 // CreateDBSnapshotInput represents the input for CreateDBSnapshot.
@@ -295,6 +329,70 @@ type DeleteDBSnapshotOutput struct {
 	DBSnapshot *DBSnapshot `json:"DBSnapshot,omitempty"`
 }
 
+// CreateDBParameterGroupInput represents the input for CreateDBParameterGroup.
+type CreateDBParameterGroupInput struct {
+	DBParameterGroupName   string `json:"DBParameterGroupName"`
+	DBParameterGroupFamily string `json:"DBParameterGroupFamily"`
+	Description            string `json:"Description"`
+	Tags                   []Tag  `json:"Tags,omitempty"`
+}
+
+// DescribeDBParameterGroupsInput represents the input for DescribeDBParameterGroups.
+type DescribeDBParameterGroupsInput struct {
+	DBParameterGroupName string `json:"DBParameterGroupName,omitempty"`
+	MaxRecords           int32  `json:"MaxRecords,omitempty"`
+	Marker               string `json:"Marker,omitempty"`
+}
+
+// ModifyDBParameterGroupInput represents the input for ModifyDBParameterGroup.
+// Parameters is read from the Parameters.Parameter.N.* query parameters.
+type ModifyDBParameterGroupInput struct {
+	DBParameterGroupName string      `json:"DBParameterGroupName"`
+	Parameters           []Parameter `json:"-"`
+}
+
+// DeleteDBParameterGroupInput represents the input for DeleteDBParameterGroup.
+type DeleteDBParameterGroupInput struct {
+	DBParameterGroupName string `json:"DBParameterGroupName"`
+}
+
+// DescribeDBParametersInput represents the input for DescribeDBParameters.
+type DescribeDBParametersInput struct {
+	DBParameterGroupName string `json:"DBParameterGroupName"`
+	Source               string `json:"Source,omitempty"`
+	MaxRecords           int32  `json:"MaxRecords,omitempty"`
+	Marker               string `json:"Marker,omitempty"`
+}
+
+// CreateDBSubnetGroupInput represents the input for CreateDBSubnetGroup.
+// SubnetIDs is read from the SubnetIds.SubnetIdentifier.N query parameters.
+type CreateDBSubnetGroupInput struct {
+	DBSubnetGroupName        string   `json:"DBSubnetGroupName"`
+	DBSubnetGroupDescription string   `json:"DBSubnetGroupDescription"`
+	SubnetIDs                []string `json:"-"`
+	Tags                     []Tag    `json:"Tags,omitempty"`
+}
+
+// DescribeDBSubnetGroupsInput represents the input for DescribeDBSubnetGroups.
+type DescribeDBSubnetGroupsInput struct {
+	DBSubnetGroupName string `json:"DBSubnetGroupName,omitempty"`
+	MaxRecords        int32  `json:"MaxRecords,omitempty"`
+	Marker            string `json:"Marker,omitempty"`
+}
+
+// ModifyDBSubnetGroupInput represents the input for ModifyDBSubnetGroup.
+// SubnetIDs is read from the SubnetIds.SubnetIdentifier.N query parameters.
+type ModifyDBSubnetGroupInput struct {
+	DBSubnetGroupName        string   `json:"DBSubnetGroupName"`
+	DBSubnetGroupDescription string   `json:"DBSubnetGroupDescription,omitempty"`
+	SubnetIDs                []string `json:"-"`
+}
+
+// DeleteDBSubnetGroupInput represents the input for DeleteDBSubnetGroup.
+type DeleteDBSubnetGroupInput struct {
+	DBSubnetGroupName string `json:"DBSubnetGroupName"`
+}
+
 // Error types.
 
 // Error represents an RDS error.
@@ -316,16 +414,22 @@ type ErrorResponse struct {
 
 // Error codes.
 const (
-	errDBInstanceNotFound          = "DBInstanceNotFoundFault"
-	errDBInstanceAlreadyExists     = "DBInstanceAlreadyExistsFault"
-	errDBClusterNotFound           = "DBClusterNotFoundFault"
-	errDBClusterAlreadyExists      = "DBClusterAlreadyExistsFault"
-	errDBSnapshotNotFound          = "DBSnapshotNotFoundFault"
-	errDBSnapshotAlreadyExists     = "DBSnapshotAlreadyExistsFault"
-	errInvalidDBInstanceState      = "InvalidDBInstanceStateFault"
-	errInvalidDBClusterState       = "InvalidDBClusterStateFault"
-	errInvalidParameterValue       = "InvalidParameterValue"
-	errInvalidParameterCombination = "InvalidParameterCombination"
+	errDBInstanceNotFound           = "DBInstanceNotFoundFault"
+	errDBInstanceAlreadyExists      = "DBInstanceAlreadyExistsFault"
+	errDBClusterNotFound            = "DBClusterNotFoundFault"
+	errDBClusterAlreadyExists       = "DBClusterAlreadyExistsFault"
+	errDBSnapshotNotFound           = "DBSnapshotNotFoundFault"
+	errDBSnapshotAlreadyExists      = "DBSnapshotAlreadyExistsFault"
+	errInvalidDBInstanceState       = "InvalidDBInstanceStateFault"
+	errInvalidDBClusterState        = "InvalidDBClusterStateFault"
+	errInvalidParameterValue        = "InvalidParameterValue"
+	errInvalidParameterCombination  = "InvalidParameterCombination"
+	errDBParameterGroupNotFound     = "DBParameterGroupNotFound"
+	errDBParameterGroupExists       = "DBParameterGroupAlreadyExists"
+	errInvalidDBParameterGroupState = "InvalidDBParameterGroupState"
+	errDBSubnetGroupNotFound        = "DBSubnetGroupNotFoundFault"
+	errDBSubnetGroupAlreadyExists   = "DBSubnetGroupAlreadyExists"
+	errInvalidDBSubnetGroupState    = "InvalidDBSubnetGroupStateFault"
 )
 
 // DB instance states.
@@ -344,8 +448,15 @@ const (
 	DBClusterStatusAvailable = "available"
 	DBClusterStatusCreating  = "creating"
 	DBClusterStatusDeleting  = "deleting"
+	DBClusterStatusUpgrading = "upgrading"
 )
 
+// DBSubnetGroupStatusComplete is the status of every DB subnet group.
+const DBSubnetGroupStatusComplete = "Complete"
+
+// parameterSourceUser is the source of parameters set by ModifyDBParameterGroup.
+const parameterSourceUser = "user"
+
 // DB snapshot states.
 const (
 	DBSnapshotStatusAvailable = "available"
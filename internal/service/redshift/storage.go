@@ -29,6 +29,7 @@ type Storage interface {
 	CreateClusterSnapshot(ctx context.Context, input *CreateClusterSnapshotInput) (*ClusterSnapshot, error)
 	DeleteClusterSnapshot(ctx context.Context, identifier string) (*ClusterSnapshot, error)
 	DescribeClusterSnapshots(ctx context.Context, clusterIdentifier, snapshotIdentifier string) ([]ClusterSnapshot, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -124,6 +125,17 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Clusters = make(map[string]*Cluster)
+	m.Snapshots = make(map[string]*ClusterSnapshot)
+
+	return nil
+}
+
 // CreateCluster creates a new Redshift cluster.
 func (m *MemoryStorage) CreateCluster(_ context.Context, input *CreateClusterInput) (*Cluster, error) {
 	m.mu.Lock()
@@ -1,21 +1,87 @@
 package service
 
 import (
+	"os"
+	"path/filepath"
+	"slices"
 	"sync"
 )
 
-// globalRegistry is the default registry for auto-registration via init().
-var globalRegistry = NewRegistry()
+// globalFactories holds the factories passed to RegisterFactory.
+var (
+	factoriesMu     sync.Mutex
+	globalFactories []registeredFactory
+)
+
+// Factory creates the services of a package, backed by new storage that is
+// persisted in dataDir when it is not empty. Services that share storage,
+// such as DynamoDB and DynamoDB Streams, are created by the same factory.
+type Factory func(dataDir string) []Service
+
+// registeredFactory is a factory and the names of the services it creates.
+type registeredFactory struct {
+	names  []string
+	create Factory
+}
 
-// Register adds a service to the global registry.
+// RegisterFactory registers f as the factory of the services with the given names.
+// The services are created by NewServices and NewRegionServices only when one of
+// them is enabled, so that disabled services hold no storage and run no background work.
 // This is typically called from init() in each service package.
-func Register(svc Service) {
-	globalRegistry.Register(svc)
+func RegisterFactory(names []string, f Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	globalFactories = append(globalFactories, registeredFactory{names: names, create: f})
+}
+
+// NewServices creates the services registered with RegisterFactory whose factory
+// creates a service that enabled reports true for. Their state is persisted in
+// KUMO_DATA_DIR when it is set.
+func NewServices(enabled func(name string) bool) []Service {
+	return newServices(os.Getenv("KUMO_DATA_DIR"), enabled)
+}
+
+// NewRegionServices is like NewServices, but creates new instances holding the
+// resources of a region apart from those of the services created by NewServices.
+// When KUMO_DATA_DIR is set, their state is persisted in a subdirectory named after the region.
+func NewRegionServices(region string, enabled func(name string) bool) []Service {
+	dataDir := os.Getenv("KUMO_DATA_DIR")
+	if dataDir != "" {
+		dataDir = filepath.Join(dataDir, region)
+	}
+
+	return newServices(dataDir, enabled)
 }
 
-// Services returns all services from the global registry.
-func Services() []Service {
-	return globalRegistry.All()
+// newServices calls the registered factories of the enabled services.
+func newServices(dataDir string, enabled func(name string) bool) []Service {
+	factoriesMu.Lock()
+	factories := slices.Clone(globalFactories)
+	factoriesMu.Unlock()
+
+	var services []Service
+
+	for _, f := range factories {
+		if slices.ContainsFunc(f.names, enabled) {
+			services = append(services, f.create(dataDir)...)
+		}
+	}
+
+	return services
+}
+
+// ServiceNames returns the names of the services registered with RegisterFactory.
+func ServiceNames() []string {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	var names []string
+	for _, f := range globalFactories {
+		names = append(names, f.names...)
+	}
+
+	return names
 }
 
 // Registry manages service registration and discovery.
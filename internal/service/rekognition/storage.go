@@ -53,6 +53,7 @@ type Storage interface {
 	DetectText(ctx context.Context, req *DetectTextRequest) (*DetectTextResponse, error)
 	RecognizeCelebrities(ctx context.Context, req *RecognizeCelebritiesRequest) (*RecognizeCelebritiesResponse, error)
 	DetectModerationLabels(ctx context.Context, req *DetectModerationLabelsRequest) (*DetectModerationLabelsResponse, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -142,6 +143,16 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Collections = make(map[string]*Collection)
+
+	return nil
+}
+
 // CreateCollection creates a new collection.
 func (s *MemoryStorage) CreateCollection(_ context.Context, req *CreateCollectionRequest) (*CreateCollectionResponse, error) {
 	if req.CollectionID == "" {
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"crypto/rand"
+	"net/http"
+)
+
+// internalRequestHeader carries the token that marks the requests kumo services send to each other.
+const internalRequestHeader = "X-Kumo-Internal-Token"
+
+// internalRegionHeader carries the region of the service that sent an internal request.
+// Internal requests are not signed, so their region cannot be taken from the signature.
+const internalRegionHeader = "X-Kumo-Internal-Region"
+
+// internalRequestToken is generated at startup, so that clients cannot mark their requests as internal.
+var internalRequestToken = rand.Text()
+
+// MarkInternalRequest marks a request that a service sends to another kumo service.
+// Internal requests are not subject to signature verification, as they are not signed,
+// and are made against the region of the request's context.
+func MarkInternalRequest(req *http.Request) {
+	req.Header.Set(internalRequestHeader, internalRequestToken)
+	req.Header.Set(internalRegionHeader, Region(req.Context()))
+}
+
+// IsInternalRequest reports whether the request was sent by a kumo service.
+func IsInternalRequest(r *http.Request) bool {
+	return r.Header.Get(internalRequestHeader) == internalRequestToken
+}
+
+// InternalRequestRegion returns the region an internal request is made against,
+// or "" if the request is not internal.
+func InternalRequestRegion(r *http.Request) string {
+	if !IsInternalRequest(r) {
+		return ""
+	}
+
+	return r.Header.Get(internalRegionHeader)
+}
@@ -1,6 +1,7 @@
 package resiliencehub
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"maps"
@@ -43,6 +44,7 @@ type Storage interface {
 	TagResource(resourceARN string, tags map[string]string) error
 	UntagResource(resourceARN string, tagKeys []string) error
 	ListTagsForResource(resourceARN string) (map[string]string, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -150,6 +152,19 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Apps = make(map[string]*App)
+	s.Policies = make(map[string]*ResiliencyPolicy)
+	s.Assessments = make(map[string]*AppAssessment)
+	s.Tags = make(map[string]map[string]string)
+
+	return nil
+}
+
 // CreateApp creates a new application.
 func (s *MemoryStorage) CreateApp(req *CreateAppRequest) (*App, error) {
 	s.mu.Lock()
@@ -1,6 +1,7 @@
 package route53
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -37,6 +38,7 @@ type Storage interface {
 	ChangeRecordSets(hostedZoneID string, changes []Change) error
 	PutChange(change *ChangeInfo) error
 	GetChange(id string) (*ChangeInfo, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -138,6 +140,18 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.HostedZones = make(map[string]*HostedZone)
+	s.RecordSets = make(map[string][]ResourceRecordSet)
+	s.Changes = make(map[string]*ChangeInfo)
+
+	return nil
+}
+
 // CreateHostedZone creates a new hosted zone.
 func (s *MemoryStorage) CreateHostedZone(zone *HostedZone) error {
 	s.mu.Lock()
@@ -39,6 +39,7 @@ type Storage interface {
 	AssociateResolverRule(ctx context.Context, req *AssociateResolverRuleRequest) (*ResolverRuleAssociation, error)
 	DisassociateResolverRule(ctx context.Context, ruleID, vpcID string) (*ResolverRuleAssociation, error)
 	ListResolverRuleAssociations(ctx context.Context, maxResults int, nextToken string) ([]*ResolverRuleAssociation, string, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -144,6 +145,18 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Endpoints = make(map[string]*ResolverEndpoint)
+	s.Rules = make(map[string]*ResolverRule)
+	s.Associations = make(map[string]*ResolverRuleAssociation)
+
+	return nil
+}
+
 // CreateResolverEndpoint creates a new resolver endpoint.
 func (s *MemoryStorage) CreateResolverEndpoint(_ context.Context, req *CreateResolverEndpointRequest) (*ResolverEndpoint, error) {
 	s.mu.Lock()
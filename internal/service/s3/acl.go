@@ -0,0 +1,115 @@
+package s3
+
+import "slices"
+
+// ownerID is the canonical user ID of the account that owns every bucket and object.
+const ownerID = "owner-id"
+
+// headerACL is the canned ACL header. PutObject and CopyObject pass it to storage in
+// the object metadata, like the encryption headers.
+const headerACL = "X-Amz-Acl"
+
+// Grantee types and the URIs of the predefined groups.
+const (
+	granteeCanonicalUser = "CanonicalUser"
+	granteeGroup         = "Group"
+	granteeEmail         = "AmazonCustomerByEmail"
+
+	groupAllUsers           = "http://acs.amazonaws.com/groups/global/AllUsers"
+	groupAuthenticatedUsers = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+	groupLogDelivery        = "http://acs.amazonaws.com/groups/s3/LogDelivery"
+
+	xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+)
+
+// aclPermissions are the permissions a grant may give.
+var aclPermissions = []string{"FULL_CONTROL", "WRITE", "WRITE_ACP", "READ", "READ_ACP"}
+
+// ownerGrant gives the owner full control, which every canned ACL includes.
+func ownerGrant() Grant {
+	return Grant{Grantee: Grantee{Type: granteeCanonicalUser, ID: ownerID}, Permission: "FULL_CONTROL"}
+}
+
+func groupGrant(uri, permission string) Grant {
+	return Grant{Grantee: Grantee{Type: granteeGroup, URI: uri}, Permission: permission}
+}
+
+// cannedACLGrants returns the grants of a canned ACL, and false if it is unknown.
+// The bucket-owner ACLs only grant the owner, since every object belongs to the bucket owner.
+func cannedACLGrants(acl string) ([]Grant, bool) {
+	switch acl {
+	case "private", "bucket-owner-read", "bucket-owner-full-control", "aws-exec-read":
+		return []Grant{ownerGrant()}, true
+	case "public-read":
+		return []Grant{ownerGrant(), groupGrant(groupAllUsers, "READ")}, true
+	case "public-read-write":
+		return []Grant{ownerGrant(), groupGrant(groupAllUsers, "READ"), groupGrant(groupAllUsers, "WRITE")}, true
+	case "authenticated-read":
+		return []Grant{ownerGrant(), groupGrant(groupAuthenticatedUsers, "READ")}, true
+	case "log-delivery-write":
+		return []Grant{ownerGrant(), groupGrant(groupLogDelivery, "WRITE"), groupGrant(groupLogDelivery, "READ_ACP")}, true
+	default:
+		return nil, false
+	}
+}
+
+// normalizeGrants validates the grants of a request body and sets their grantee
+// types, which are attributes the XML decoder does not map.
+func normalizeGrants(grants []Grant) ([]Grant, bool) {
+	normalized := make([]Grant, 0, len(grants))
+
+	for _, g := range grants {
+		if !slices.Contains(aclPermissions, g.Permission) {
+			return nil, false
+		}
+
+		switch {
+		case g.Grantee.ID != "":
+			g.Grantee.Type = granteeCanonicalUser
+		case g.Grantee.URI != "":
+			g.Grantee.Type = granteeGroup
+		case g.Grantee.EmailAddress != "":
+			g.Grantee.Type = granteeEmail
+		default:
+			return nil, false
+		}
+
+		g.Grantee.XMLNSXSI = ""
+		normalized = append(normalized, g)
+	}
+
+	return normalized, true
+}
+
+// newAccessControlPolicy returns the access control policy of a bucket or object
+// with the given grants, defaulting to the owner having full control.
+func newAccessControlPolicy(grants []Grant) AccessControlPolicy {
+	if grants == nil {
+		grants = []Grant{ownerGrant()}
+	}
+
+	grants = slices.Clone(grants)
+	for i := range grants {
+		grants[i].Grantee.XMLNSXSI = xsiNamespace
+	}
+
+	return AccessControlPolicy{
+		Xmlns:             s3Namespace,
+		Owner:             Owner{ID: ownerID},
+		AccessControlList: AccessControlList{Grants: grants},
+	}
+}
+
+// takeACL removes the canned ACL from the metadata of a stored object and returns its grants.
+func takeACL(metadata map[string]string) []Grant {
+	acl, ok := metadata[headerACL]
+	if !ok {
+		return nil
+	}
+
+	delete(metadata, headerACL)
+
+	grants, _ := cannedACLGrants(acl)
+
+	return grants
+}
@@ -3,9 +3,11 @@ package s3
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"encoding/xml"
 	"errors"
 	"io"
+	"maps"
 	"net/http"
 	"strconv"
 	"strings"
@@ -117,9 +119,50 @@ func (s *Service) handleBucketGet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, ok := r.URL.Query()["policy"]; ok {
+		s.GetBucketPolicy(w, r)
+
+		return
+	}
+
+	if _, ok := r.URL.Query()["acl"]; ok {
+		s.GetBucketACL(w, r)
+
+		return
+	}
+
+	if _, ok := r.URL.Query()["website"]; ok {
+		s.GetBucketWebsite(w, r)
+
+		return
+	}
+
+	if _, ok := r.URL.Query()["notification"]; ok {
+		s.GetBucketNotificationConfiguration(w, r)
+
+		return
+	}
+
 	s.ListObjects(w, r)
 }
 
+// handleBucketDelete dispatches DELETE /{bucket} requests based on query parameters.
+func (s *Service) handleBucketDelete(w http.ResponseWriter, r *http.Request) {
+	if _, ok := r.URL.Query()["policy"]; ok {
+		s.DeleteBucketPolicy(w, r)
+
+		return
+	}
+
+	if _, ok := r.URL.Query()["website"]; ok {
+		s.DeleteBucketWebsite(w, r)
+
+		return
+	}
+
+	s.DeleteBucket(w, r)
+}
+
 // handleBucketPost dispatches POST /{bucket} requests based on query parameters.
 func (s *Service) handleBucketPost(w http.ResponseWriter, r *http.Request) {
 	if _, ok := r.URL.Query()["delete"]; ok {
@@ -141,6 +184,12 @@ func (s *Service) handleObjectPut(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if r.URL.Query().Has("acl") {
+		s.PutObjectACL(w, r)
+
+		return
+	}
+
 	if r.URL.Query().Get("uploadId") != "" && r.URL.Query().Get("partNumber") != "" {
 		s.UploadPart(w, r)
 
@@ -166,12 +215,30 @@ func (s *Service) handleObjectGet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if r.URL.Query().Has("acl") {
+		s.GetObjectACL(w, r)
+
+		return
+	}
+
 	if r.URL.Query().Get("uploadId") != "" {
 		s.ListParts(w, r)
 
 		return
 	}
 
+	if r.URL.Query().Has("attributes") {
+		s.GetObjectAttributes(w, r)
+
+		return
+	}
+
+	if r.PathValue("key") == "" {
+		s.getWebsiteIndex(w, r)
+
+		return
+	}
+
 	s.GetObject(w, r)
 }
 
@@ -228,7 +295,7 @@ func (s *Service) ListBuckets(w http.ResponseWriter, r *http.Request) {
 			Bucket: bucketInfos,
 		},
 		Owner: Owner{
-			ID: "owner-id",
+			ID: ownerID,
 		},
 	}
 
@@ -244,6 +311,17 @@ func (s *Service) CreateBucket(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var grants []Grant
+
+	if acl := r.Header.Get(headerACL); acl != "" {
+		var ok bool
+		if grants, ok = cannedACLGrants(acl); !ok {
+			writeS3Error(w, r, "InvalidArgument", "Invalid canned ACL "+acl, http.StatusBadRequest)
+
+			return
+		}
+	}
+
 	err := s.storage.CreateBucket(r.Context(), bucket)
 	if err != nil {
 		var bucketErr *BucketError
@@ -263,6 +341,14 @@ func (s *Service) CreateBucket(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if grants != nil {
+		if err := s.storage.PutBucketACL(r.Context(), bucket, grants); err != nil {
+			writeS3Error(w, r, "InternalError", "Internal server error", http.StatusInternalServerError)
+
+			return
+		}
+	}
+
 	w.Header().Set("Location", "/"+bucket)
 	w.WriteHeader(http.StatusOK)
 }
@@ -421,6 +507,22 @@ func (s *Service) PutObject(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if encErr := parseEncryptionHeaders(r.Header, metadata); encErr != nil {
+		writeS3Error(w, r, encErr.code, encErr.message, encErr.status)
+
+		return
+	}
+
+	if acl := r.Header.Get(headerACL); acl != "" {
+		if _, ok := cannedACLGrants(acl); !ok {
+			writeS3Error(w, r, "InvalidArgument", "Invalid canned ACL "+acl, http.StatusBadRequest)
+
+			return
+		}
+
+		metadata[headerACL] = acl
+	}
+
 	obj, err := s.storage.PutObject(r.Context(), bucket, key, r.Body, metadata)
 	if err != nil {
 		var bucketErr *BucketError
@@ -441,10 +543,12 @@ func (s *Service) PutObject(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("x-amz-version-id", obj.VersionID)
 	}
 
+	writeEncryptionHeaders(w.Header(), obj.Encryption)
 	w.WriteHeader(http.StatusOK)
 
 	// Emit EventBridge notification if enabled.
-	go s.emitObjectCreatedEvent(context.Background(), bucket, key, obj.Size, obj.ETag)
+	go s.emitObjectCreatedEvent(context.WithoutCancel(r.Context()), bucket, key, obj.Size, obj.ETag)
+	go s.notifyObjectEvent(context.WithoutCancel(r.Context()), bucket, eventObjectCreatedPut, key, obj.Size, obj.ETag, obj.VersionID)
 }
 
 // CopyObject handles PUT /{bucket}/{key} with X-Amz-Copy-Source header.
@@ -468,7 +572,35 @@ func (s *Service) CopyObject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	dstObj, err := s.storage.PutObject(r.Context(), dstBucket, dstKey, bytes.NewReader(srcObj.Body), srcObj.Metadata)
+	if encErr := checkCustomerKey(r.Header, srcObj, headerCopySourceSSECustomerKeyMD5); encErr != nil {
+		writeS3Error(w, r, encErr.code, encErr.message, encErr.status)
+
+		return
+	}
+
+	// The copy is encrypted as the request specifies, not as the source object.
+	metadata := maps.Clone(srcObj.Metadata)
+	if metadata == nil {
+		metadata = make(map[string]string)
+	}
+
+	if encErr := parseEncryptionHeaders(r.Header, metadata); encErr != nil {
+		writeS3Error(w, r, encErr.code, encErr.message, encErr.status)
+
+		return
+	}
+
+	if acl := r.Header.Get(headerACL); acl != "" {
+		if _, ok := cannedACLGrants(acl); !ok {
+			writeS3Error(w, r, "InvalidArgument", "Invalid canned ACL "+acl, http.StatusBadRequest)
+
+			return
+		}
+
+		metadata[headerACL] = acl
+	}
+
+	dstObj, err := s.storage.PutObject(r.Context(), dstBucket, dstKey, bytes.NewReader(srcObj.Body), metadata)
 	if err != nil {
 		var bucketErr *BucketError
 		if errors.As(err, &bucketErr) {
@@ -487,9 +619,11 @@ func (s *Service) CopyObject(w http.ResponseWriter, r *http.Request) {
 		LastModified: dstObj.LastModified.Format(timeFormatISO),
 	}
 
+	writeEncryptionHeaders(w.Header(), dstObj.Encryption)
 	writeXMLResponse(w, result)
 
-	go s.emitObjectCreatedEvent(context.Background(), dstBucket, dstKey, dstObj.Size, dstObj.ETag)
+	go s.emitObjectCreatedEvent(context.WithoutCancel(r.Context()), dstBucket, dstKey, dstObj.Size, dstObj.ETag)
+	go s.notifyObjectEvent(context.WithoutCancel(r.Context()), dstBucket, eventObjectCreatedCopy, dstKey, dstObj.Size, dstObj.ETag, dstObj.VersionID)
 }
 
 // parseCopySource parses the X-Amz-Copy-Source header value.
@@ -544,9 +678,110 @@ func (s *Service) GetObject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if encErr := checkCustomerKey(r.Header, obj, headerSSECustomerKeyMD5); encErr != nil {
+		writeS3Error(w, r, encErr.code, encErr.message, encErr.status)
+
+		return
+	}
+
 	writeObjectResponse(w, obj)
 }
 
+// GetObjectAttributes handles GET /{bucket}/{key...}?attributes, returning the
+// attributes listed in the x-amz-object-attributes header.
+func (s *Service) GetObjectAttributes(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+	key := r.PathValue("key")
+
+	var (
+		obj *Object
+		err error
+	)
+
+	if versionID := r.URL.Query().Get("versionId"); versionID != "" {
+		obj, err = s.storage.GetObjectVersion(r.Context(), bucket, key, versionID)
+	} else {
+		obj, err = s.storage.GetObject(r.Context(), bucket, key)
+	}
+
+	if err != nil {
+		handleGetObjectError(w, r, err)
+
+		return
+	}
+
+	if encErr := checkCustomerKey(r.Header, obj, headerSSECustomerKeyMD5); encErr != nil {
+		writeS3Error(w, r, encErr.code, encErr.message, encErr.status)
+
+		return
+	}
+
+	result := GetObjectAttributesOutput{Xmlns: s3Namespace}
+
+	for _, values := range r.Header.Values("X-Amz-Object-Attributes") {
+		for attr := range strings.SplitSeq(values, ",") {
+			switch strings.TrimSpace(attr) {
+			case "ETag":
+				result.ETag = strings.Trim(obj.ETag, `"`)
+			case "ObjectSize":
+				result.ObjectSize = &obj.Size
+			case "StorageClass":
+				result.StorageClass = "STANDARD"
+			case "ObjectParts":
+				result.ObjectParts = objectAttributeParts(r, obj.Parts)
+			case "Checksum":
+				// Checksums are not computed.
+			default:
+				writeS3Error(w, r, "InvalidArgument", "Invalid attribute name specified.", http.StatusBadRequest)
+
+				return
+			}
+		}
+	}
+
+	w.Header().Set("Last-Modified", obj.LastModified.UTC().Format(timeFormatHTTP))
+
+	if obj.VersionID != "" {
+		w.Header().Set("x-amz-version-id", obj.VersionID)
+	}
+
+	writeXMLResponse(w, result)
+}
+
+// objectAttributeParts returns the page of parts selected by the max-parts and
+// part-number-marker parameters, or nil for objects that were not uploaded in parts.
+func objectAttributeParts(r *http.Request, parts []ObjectPart) *ObjectAttributeParts {
+	if len(parts) == 0 {
+		return nil
+	}
+
+	maxParts := parseMaxKeys(r.Header.Get("X-Amz-Max-Parts"))
+	marker, _ := strconv.Atoi(r.Header.Get("X-Amz-Part-Number-Marker"))
+
+	result := &ObjectAttributeParts{
+		PartsCount:       len(parts),
+		PartNumberMarker: marker,
+		MaxParts:         maxParts,
+	}
+
+	for _, part := range parts {
+		if part.PartNumber <= marker {
+			continue
+		}
+
+		if len(result.Parts) == maxParts {
+			result.IsTruncated = true
+
+			break
+		}
+
+		result.Parts = append(result.Parts, ObjectAttributePart{PartNumber: part.PartNumber, Size: part.Size})
+		result.NextPartNumberMarker = part.PartNumber
+	}
+
+	return result
+}
+
 // handleGetObjectError handles errors from GetObject/GetObjectVersion.
 func handleGetObjectError(w http.ResponseWriter, r *http.Request, err error) {
 	var bucketErr *BucketError
@@ -577,6 +812,8 @@ func writeObjectResponse(w http.ResponseWriter, obj *Object) {
 		w.Header().Set("x-amz-version-id", obj.VersionID)
 	}
 
+	writeEncryptionHeaders(w.Header(), obj.Encryption)
+
 	for k, v := range obj.Metadata {
 		if k != "Content-Type" {
 			w.Header().Set("x-amz-meta-"+k, v)
@@ -642,6 +879,9 @@ func (s *Service) DeleteObject(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.WriteHeader(http.StatusNoContent)
+
+	eventName, eventVersionID := removedEvent(deleteMarker)
+	go s.notifyObjectEvent(context.WithoutCancel(r.Context()), bucket, eventName, key, 0, "", eventVersionID)
 }
 
 // DeleteObjects handles POST /{bucket}?delete - delete multiple objects.
@@ -701,6 +941,9 @@ func (s *Service) deleteOneObject(ctx context.Context, bucket string, obj Delete
 		return
 	}
 
+	eventName, eventVersionID := removedEvent(deleteMarker)
+	go s.notifyObjectEvent(context.WithoutCancel(ctx), bucket, eventName, obj.Key, 0, "", eventVersionID)
+
 	if quiet {
 		return
 	}
@@ -753,10 +996,18 @@ func (s *Service) HeadObject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// HEAD responses have no body, so only the status reports an SSE-C key mismatch.
+	if encErr := checkCustomerKey(r.Header, obj, headerSSECustomerKeyMD5); encErr != nil {
+		w.WriteHeader(encErr.status)
+
+		return
+	}
+
 	w.Header().Set("Content-Type", obj.ContentType)
 	w.Header().Set("Content-Length", strconv.FormatInt(obj.Size, 10))
 	w.Header().Set("ETag", obj.ETag)
 	w.Header().Set("Last-Modified", obj.LastModified.UTC().Format(timeFormatHTTP))
+	writeEncryptionHeaders(w.Header(), obj.Encryption)
 
 	// Set metadata headers
 	for k, v := range obj.Metadata {
@@ -923,7 +1174,7 @@ func toObjectVersionInfo(obj *Object, isLatest bool) ObjectVersionInfo {
 		ETag:         obj.ETag,
 		Size:         obj.Size,
 		StorageClass: "STANDARD",
-		Owner:        Owner{ID: "owner-id"},
+		Owner:        Owner{ID: ownerID},
 	}
 }
 
@@ -934,7 +1185,7 @@ func toDeleteMarkerInfo(obj *Object, isLatest bool) DeleteMarkerInfo {
 		VersionID:    obj.VersionID,
 		IsLatest:     isLatest,
 		LastModified: obj.LastModified.Format(timeFormatISO),
-		Owner:        Owner{ID: "owner-id"},
+		Owner:        Owner{ID: ownerID},
 	}
 }
 
@@ -968,6 +1219,24 @@ func (s *Service) handleBucketPut(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, ok := r.URL.Query()["policy"]; ok {
+		s.PutBucketPolicy(w, r)
+
+		return
+	}
+
+	if _, ok := r.URL.Query()["acl"]; ok {
+		s.PutBucketACL(w, r)
+
+		return
+	}
+
+	if _, ok := r.URL.Query()["website"]; ok {
+		s.PutBucketWebsite(w, r)
+
+		return
+	}
+
 	s.CreateBucket(w, r)
 }
 
@@ -1209,6 +1478,8 @@ func (s *Service) CompleteMultipartUpload(w http.ResponseWriter, r *http.Request
 	}
 
 	writeXMLResponse(w, result)
+
+	go s.notifyObjectEvent(context.WithoutCancel(r.Context()), bucket, eventObjectCreatedCompleteMultipartUpload, key, obj.Size, obj.ETag, obj.VersionID)
 }
 
 // AbortMultipartUpload handles DELETE /{bucket}/{key}?uploadId={uploadId} - abort a multipart upload.
@@ -1254,16 +1525,19 @@ func (s *Service) ListMultipartUploads(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	prefix := r.URL.Query().Get("prefix")
+	query := r.URL.Query()
+	prefix := query.Get("prefix")
+	keyMarker := query.Get("key-marker")
+	uploadIDMarker := query.Get("upload-id-marker")
 	maxUploads := 1000
 
-	if maxUploadsStr := r.URL.Query().Get("max-uploads"); maxUploadsStr != "" {
+	if maxUploadsStr := query.Get("max-uploads"); maxUploadsStr != "" {
 		if mu, err := strconv.Atoi(maxUploadsStr); err == nil && mu > 0 {
 			maxUploads = mu
 		}
 	}
 
-	uploads, err := s.storage.ListMultipartUploads(r.Context(), bucket, prefix, maxUploads)
+	uploads, truncated, err := s.storage.ListMultipartUploads(r.Context(), bucket, prefix, keyMarker, uploadIDMarker, maxUploads)
 	if err != nil {
 		var bucketErr *BucketError
 		if errors.As(err, &bucketErr) {
@@ -1287,11 +1561,20 @@ func (s *Service) ListMultipartUploads(w http.ResponseWriter, r *http.Request) {
 	}
 
 	result := ListMultipartUploadsResult{
-		Xmlns:       s3Namespace,
-		Bucket:      bucket,
-		MaxUploads:  maxUploads,
-		IsTruncated: false,
-		Uploads:     uploadInfos,
+		Xmlns:          s3Namespace,
+		Bucket:         bucket,
+		Prefix:         prefix,
+		KeyMarker:      keyMarker,
+		UploadIDMarker: uploadIDMarker,
+		MaxUploads:     maxUploads,
+		IsTruncated:    truncated,
+		Uploads:        uploadInfos,
+	}
+
+	if len(uploads) > 0 {
+		last := uploads[len(uploads)-1]
+		result.NextKeyMarker = last.Key
+		result.NextUploadIDMarker = last.UploadID
 	}
 
 	writeXMLResponse(w, result)
@@ -1329,7 +1612,20 @@ func (s *Service) ListParts(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	parts, err := s.storage.ListParts(r.Context(), bucket, key, uploadID, maxParts)
+	var partNumberMarker int
+
+	if markerStr := r.URL.Query().Get("part-number-marker"); markerStr != "" {
+		marker, err := strconv.Atoi(markerStr)
+		if err != nil || marker < 0 {
+			writeS3Error(w, r, "InvalidArgument", "Invalid part-number-marker", http.StatusBadRequest)
+
+			return
+		}
+
+		partNumberMarker = marker
+	}
+
+	parts, truncated, err := s.storage.ListParts(r.Context(), bucket, key, uploadID, partNumberMarker, maxParts)
 	if err != nil {
 		handleMultipartError(w, r, err)
 
@@ -1347,13 +1643,18 @@ func (s *Service) ListParts(w http.ResponseWriter, r *http.Request) {
 	}
 
 	result := ListPartsResult{
-		Xmlns:       s3Namespace,
-		Bucket:      bucket,
-		Key:         key,
-		UploadID:    uploadID,
-		MaxParts:    maxParts,
-		IsTruncated: false,
-		Parts:       partInfos,
+		Xmlns:            s3Namespace,
+		Bucket:           bucket,
+		Key:              key,
+		UploadID:         uploadID,
+		PartNumberMarker: partNumberMarker,
+		MaxParts:         maxParts,
+		IsTruncated:      truncated,
+		Parts:            partInfos,
+	}
+
+	if len(parts) > 0 {
+		result.NextPartNumberMarker = parts[len(parts)-1].PartNumber
 	}
 
 	writeXMLResponse(w, result)
@@ -1363,20 +1664,44 @@ func (s *Service) ListParts(w http.ResponseWriter, r *http.Request) {
 func (s *Service) PutBucketNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
 	bucket := r.PathValue("bucket")
 
+	// An empty body clears the configuration.
 	var config NotificationConfiguration
-	if err := xml.NewDecoder(r.Body).Decode(&config); err != nil {
-		// Accept even if body is empty or malformed (AWS is lenient).
-		w.WriteHeader(http.StatusOK)
+	if err := xml.NewDecoder(r.Body).Decode(&config); err != nil && !errors.Is(err, io.EOF) {
+		writeS3Error(w, r, "MalformedXML", "The XML you provided was not well-formed", http.StatusBadRequest)
 
 		return
 	}
 
-	enabled := config.EventBridgeConfig != nil
-	s.storage.SetEventBridgeNotification(r.Context(), bucket, enabled)
+	if msg := normalizeNotificationConfiguration(&config); msg != "" {
+		writeS3Error(w, r, "InvalidArgument", msg, http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.PutBucketNotificationConfiguration(r.Context(), bucket, &config); err != nil {
+		handleBucketConfigError(w, r, err)
+
+		return
+	}
 
 	w.WriteHeader(http.StatusOK)
 }
 
+// GetBucketNotificationConfiguration handles GET /{bucket}?notification.
+func (s *Service) GetBucketNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	config, err := s.storage.GetBucketNotificationConfiguration(r.Context(), r.PathValue("bucket"))
+	if err != nil {
+		handleBucketConfigError(w, r, err)
+
+		return
+	}
+
+	result := *config
+	result.Xmlns = s3Namespace
+
+	writeXMLResponse(w, result)
+}
+
 // PutBucketCors handles PUT /{bucket}?cors.
 func (s *Service) PutBucketCors(w http.ResponseWriter, r *http.Request) {
 	bucket := r.PathValue("bucket")
@@ -1393,6 +1718,230 @@ func (s *Service) PutBucketCors(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// PutBucketPolicy handles PUT /{bucket}?policy.
+func (s *Service) PutBucketPolicy(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeS3Error(w, r, "InternalError", "Internal server error", http.StatusInternalServerError)
+
+		return
+	}
+
+	if !json.Valid(body) {
+		writeS3Error(w, r, "MalformedPolicy", "Policies must be valid JSON and the first byte must be '{'", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.PutBucketPolicy(r.Context(), bucket, string(body)); err != nil {
+		handleBucketConfigError(w, r, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetBucketPolicy handles GET /{bucket}?policy, returning the policy document as it was put.
+func (s *Service) GetBucketPolicy(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	policy, err := s.storage.GetBucketPolicy(r.Context(), bucket)
+	if err != nil {
+		handleBucketConfigError(w, r, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	_, _ = io.WriteString(w, policy)
+}
+
+// DeleteBucketPolicy handles DELETE /{bucket}?policy.
+func (s *Service) DeleteBucketPolicy(w http.ResponseWriter, r *http.Request) {
+	bucket := r.PathValue("bucket")
+
+	if err := s.storage.DeleteBucketPolicy(r.Context(), bucket); err != nil {
+		handleBucketConfigError(w, r, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PutBucketACL handles PUT /{bucket}?acl.
+func (s *Service) PutBucketACL(w http.ResponseWriter, r *http.Request) {
+	grants, ok := parseACLRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.storage.PutBucketACL(r.Context(), r.PathValue("bucket"), grants); err != nil {
+		handleGetObjectError(w, r, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetBucketACL handles GET /{bucket}?acl.
+func (s *Service) GetBucketACL(w http.ResponseWriter, r *http.Request) {
+	grants, err := s.storage.GetBucketACL(r.Context(), r.PathValue("bucket"))
+	if err != nil {
+		handleGetObjectError(w, r, err)
+
+		return
+	}
+
+	writeXMLResponse(w, newAccessControlPolicy(grants))
+}
+
+// PutObjectACL handles PUT /{bucket}/{key...}?acl.
+func (s *Service) PutObjectACL(w http.ResponseWriter, r *http.Request) {
+	grants, ok := parseACLRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.storage.PutObjectACL(r.Context(), r.PathValue("bucket"), r.PathValue("key"), grants); err != nil {
+		handleGetObjectError(w, r, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetObjectACL handles GET /{bucket}/{key...}?acl.
+func (s *Service) GetObjectACL(w http.ResponseWriter, r *http.Request) {
+	grants, err := s.storage.GetObjectACL(r.Context(), r.PathValue("bucket"), r.PathValue("key"))
+	if err != nil {
+		handleGetObjectError(w, r, err)
+
+		return
+	}
+
+	writeXMLResponse(w, newAccessControlPolicy(grants))
+}
+
+// parseACLRequest returns the grants of a PutBucketACL or PutObjectACL request, from
+// its canned ACL header or its AccessControlPolicy body, writing an error if they are invalid.
+func parseACLRequest(w http.ResponseWriter, r *http.Request) ([]Grant, bool) {
+	if acl := r.Header.Get(headerACL); acl != "" {
+		grants, ok := cannedACLGrants(acl)
+		if !ok {
+			writeS3Error(w, r, "InvalidArgument", "Invalid canned ACL "+acl, http.StatusBadRequest)
+		}
+
+		return grants, ok
+	}
+
+	var policy AccessControlPolicy
+	if err := xml.NewDecoder(r.Body).Decode(&policy); err != nil {
+		writeS3Error(w, r, "MalformedACLError", "The XML you provided was not well-formed or did not validate against our published schema", http.StatusBadRequest)
+
+		return nil, false
+	}
+
+	grants, ok := normalizeGrants(policy.AccessControlList.Grants)
+	if !ok {
+		writeS3Error(w, r, "MalformedACLError", "The XML you provided was not well-formed or did not validate against our published schema", http.StatusBadRequest)
+	}
+
+	return grants, ok
+}
+
+// PutBucketWebsite handles PUT /{bucket}?website.
+func (s *Service) PutBucketWebsite(w http.ResponseWriter, r *http.Request) {
+	var config WebsiteConfiguration
+	if err := xml.NewDecoder(r.Body).Decode(&config); err != nil {
+		writeS3Error(w, r, "MalformedXML", "The XML you provided was not well-formed", http.StatusBadRequest)
+
+		return
+	}
+
+	switch {
+	case config.RedirectAllRequestsTo != nil:
+		if config.RedirectAllRequestsTo.HostName == "" {
+			writeS3Error(w, r, "InvalidArgument", "RedirectAllRequestsTo requires a HostName", http.StatusBadRequest)
+
+			return
+		}
+	case config.IndexDocument == nil || config.IndexDocument.Suffix == "" || strings.Contains(config.IndexDocument.Suffix, "/"):
+		writeS3Error(w, r, "InvalidArgument", "A value for IndexDocument Suffix must be provided if RedirectAllRequestsTo is empty", http.StatusBadRequest)
+
+		return
+	}
+
+	config.Xmlns = ""
+
+	if err := s.storage.PutBucketWebsite(r.Context(), r.PathValue("bucket"), &config); err != nil {
+		handleBucketConfigError(w, r, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetBucketWebsite handles GET /{bucket}?website.
+func (s *Service) GetBucketWebsite(w http.ResponseWriter, r *http.Request) {
+	config, err := s.storage.GetBucketWebsite(r.Context(), r.PathValue("bucket"))
+	if err != nil {
+		handleBucketConfigError(w, r, err)
+
+		return
+	}
+
+	result := *config
+	result.Xmlns = s3Namespace
+
+	writeXMLResponse(w, result)
+}
+
+// DeleteBucketWebsite handles DELETE /{bucket}?website.
+func (s *Service) DeleteBucketWebsite(w http.ResponseWriter, r *http.Request) {
+	if err := s.storage.DeleteBucketWebsite(r.Context(), r.PathValue("bucket")); err != nil {
+		handleBucketConfigError(w, r, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getWebsiteIndex handles GET /{bucket}/, serving the index document of a bucket
+// configured for website hosting.
+func (s *Service) getWebsiteIndex(w http.ResponseWriter, r *http.Request) {
+	config, err := s.storage.GetBucketWebsite(r.Context(), r.PathValue("bucket"))
+	if err != nil || config.IndexDocument == nil {
+		writeS3Error(w, r, "InvalidArgument", "Invalid key", http.StatusBadRequest)
+
+		return
+	}
+
+	r.SetPathValue("key", config.IndexDocument.Suffix)
+	s.GetObject(w, r)
+}
+
+// handleBucketConfigError handles errors from bucket configuration operations such as policies and websites.
+func handleBucketConfigError(w http.ResponseWriter, r *http.Request, err error) {
+	var bucketErr *BucketError
+	if errors.As(err, &bucketErr) {
+		writeS3Error(w, r, bucketErr.Code, bucketErr.Message, http.StatusNotFound)
+
+		return
+	}
+
+	writeS3Error(w, r, "InternalError", "Internal server error", http.StatusInternalServerError)
+}
+
 // handleMultipartError handles errors from multipart upload operations.
 func handleMultipartError(w http.ResponseWriter, r *http.Request, err error) {
 	var bucketErr *BucketError
@@ -1405,7 +1954,9 @@ func handleMultipartError(w http.ResponseWriter, r *http.Request, err error) {
 	var multipartErr *MultipartError
 	if errors.As(err, &multipartErr) {
 		status := http.StatusNotFound
-		if multipartErr.Code == "InvalidPart" {
+
+		switch multipartErr.Code {
+		case "InvalidPart", "InvalidPartOrder", "MalformedXML":
 			status = http.StatusBadRequest
 		}
 
@@ -0,0 +1,375 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// Event names of the notifications that kumo sends, without the s3: prefix that
+// notification configurations use.
+const (
+	eventObjectCreatedPut                     = "ObjectCreated:Put"
+	eventObjectCreatedCopy                    = "ObjectCreated:Copy"
+	eventObjectCreatedCompleteMultipartUpload = "ObjectCreated:CompleteMultipartUpload"
+	eventObjectRemovedDelete                  = "ObjectRemoved:Delete"
+	eventObjectRemovedDeleteMarkerCreated     = "ObjectRemoved:DeleteMarkerCreated"
+)
+
+// notificationRegion is the region reported in event records.
+const notificationRegion = "us-east-1"
+
+// notificationEvents are the event types that a notification configuration may subscribe to.
+var notificationEvents = []string{
+	"s3:ObjectCreated:*", "s3:ObjectCreated:Put", "s3:ObjectCreated:Post", "s3:ObjectCreated:Copy",
+	"s3:ObjectCreated:CompleteMultipartUpload",
+	"s3:ObjectRemoved:*", "s3:ObjectRemoved:Delete", "s3:ObjectRemoved:DeleteMarkerCreated",
+	"s3:ObjectRestore:*", "s3:ObjectRestore:Post", "s3:ObjectRestore:Completed", "s3:ObjectRestore:Delete",
+	"s3:ObjectTagging:*", "s3:ObjectTagging:Put", "s3:ObjectTagging:Delete", "s3:ObjectAcl:Put",
+	"s3:LifecycleExpiration:*", "s3:LifecycleExpiration:Delete", "s3:LifecycleExpiration:DeleteMarkerCreated",
+	"s3:LifecycleTransition", "s3:IntelligentTiering", "s3:ReducedRedundancyLostObject",
+	"s3:Replication:*", "s3:Replication:OperationFailedReplication", "s3:Replication:OperationMissedThreshold",
+	"s3:Replication:OperationReplicatedAfterThreshold", "s3:Replication:OperationNotTracked",
+}
+
+// notificationDestination is a topic, queue or function of a notification configuration.
+type notificationDestination struct {
+	id     string
+	arn    string
+	events []string
+	filter *NotificationFilter
+}
+
+// eventRecords is the message that notifications deliver.
+type eventRecords struct {
+	Records []eventRecord `json:"Records"`
+}
+
+type eventRecord struct {
+	EventVersion      string            `json:"eventVersion"`
+	EventSource       string            `json:"eventSource"`
+	AWSRegion         string            `json:"awsRegion"`
+	EventTime         string            `json:"eventTime"`
+	EventName         string            `json:"eventName"`
+	UserIdentity      eventIdentity     `json:"userIdentity"`
+	RequestParameters map[string]string `json:"requestParameters"`
+	ResponseElements  map[string]string `json:"responseElements"`
+	S3                eventEntity       `json:"s3"`
+}
+
+type eventIdentity struct {
+	PrincipalID string `json:"principalId"`
+}
+
+type eventEntity struct {
+	SchemaVersion   string      `json:"s3SchemaVersion"`
+	ConfigurationID string      `json:"configurationId"`
+	Bucket          eventBucket `json:"bucket"`
+	Object          eventObject `json:"object"`
+}
+
+type eventBucket struct {
+	Name          string        `json:"name"`
+	OwnerIdentity eventIdentity `json:"ownerIdentity"`
+	ARN           string        `json:"arn"`
+}
+
+type eventObject struct {
+	Key       string `json:"key"`
+	Size      int64  `json:"size,omitempty"`
+	ETag      string `json:"eTag,omitempty"`
+	VersionID string `json:"versionId,omitempty"`
+	Sequencer string `json:"sequencer"`
+}
+
+// normalizeNotificationConfiguration validates the destinations of a notification
+// configuration and assigns IDs to those without one. It returns a message describing
+// the first invalid destination, or "" if the configuration is valid.
+func normalizeNotificationConfiguration(config *NotificationConfiguration) string {
+	config.Xmlns = ""
+
+	for i := range config.TopicConfigurations {
+		c := &config.TopicConfigurations[i]
+		if msg := normalizeDestination(&c.ID, c.TopicArn, c.Events, c.Filter); msg != "" {
+			return msg
+		}
+	}
+
+	for i := range config.QueueConfigurations {
+		c := &config.QueueConfigurations[i]
+		if msg := normalizeDestination(&c.ID, c.QueueArn, c.Events, c.Filter); msg != "" {
+			return msg
+		}
+	}
+
+	for i := range config.LambdaFunctionConfigurations {
+		c := &config.LambdaFunctionConfigurations[i]
+		if msg := normalizeDestination(&c.ID, c.LambdaFunctionArn, c.Events, c.Filter); msg != "" {
+			return msg
+		}
+	}
+
+	return ""
+}
+
+func normalizeDestination(id *string, arn string, events []string, filter *NotificationFilter) string {
+	if !strings.HasPrefix(arn, "arn:") {
+		return "The ARN is not well formed"
+	}
+
+	if len(events) == 0 {
+		return "The notification configuration must have at least one event"
+	}
+
+	for _, e := range events {
+		if !slices.Contains(notificationEvents, e) {
+			return "The event is not supported for notifications"
+		}
+	}
+
+	if filter != nil {
+		seen := make(map[string]bool)
+
+		for _, rule := range filter.S3Key.FilterRules {
+			name := strings.ToLower(rule.Name)
+			if (name != "prefix" && name != "suffix") || seen[name] {
+				return "filter rule name must be either prefix or suffix"
+			}
+
+			seen[name] = true
+		}
+	}
+
+	if *id == "" {
+		*id = uuid.New().String()
+	}
+
+	return ""
+}
+
+// destinations returns the topics, queues and functions of a notification configuration.
+func (c *NotificationConfiguration) destinations() []notificationDestination {
+	var dests []notificationDestination
+
+	for _, t := range c.TopicConfigurations {
+		dests = append(dests, notificationDestination{t.ID, t.TopicArn, t.Events, t.Filter})
+	}
+
+	for _, q := range c.QueueConfigurations {
+		dests = append(dests, notificationDestination{q.ID, q.QueueArn, q.Events, q.Filter})
+	}
+
+	for _, f := range c.LambdaFunctionConfigurations {
+		dests = append(dests, notificationDestination{f.ID, f.LambdaFunctionArn, f.Events, f.Filter})
+	}
+
+	return dests
+}
+
+// matches reports whether the destination subscribes to an event for an object key.
+// Events ending in * match every event of their type.
+func (d *notificationDestination) matches(eventName, key string) bool {
+	subscribed := slices.ContainsFunc(d.events, func(e string) bool {
+		if prefix, ok := strings.CutSuffix(e, "*"); ok {
+			return strings.HasPrefix("s3:"+eventName, prefix)
+		}
+
+		return e == "s3:"+eventName
+	})
+	if !subscribed {
+		return false
+	}
+
+	if d.filter == nil {
+		return true
+	}
+
+	for _, rule := range d.filter.S3Key.FilterRules {
+		switch strings.ToLower(rule.Name) {
+		case "prefix":
+			if !strings.HasPrefix(key, rule.Value) {
+				return false
+			}
+		case "suffix":
+			if !strings.HasSuffix(key, rule.Value) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// removedEvent returns the event name and version ID of a delete that returned deleteMarker.
+func removedEvent(deleteMarker *Object) (string, string) {
+	if deleteMarker == nil {
+		return eventObjectRemovedDelete, ""
+	}
+
+	if deleteMarker.IsDeleteMarker {
+		return eventObjectRemovedDeleteMarkerCreated, deleteMarker.VersionID
+	}
+
+	return eventObjectRemovedDelete, deleteMarker.VersionID
+}
+
+// notifyObjectEvent sends an event record for an object to the destinations of the
+// bucket's notification configuration that subscribe to the event.
+func (s *Service) notifyObjectEvent(ctx context.Context, bucket, eventName, key string, size int64, etag, versionID string) {
+	config, err := s.storage.GetBucketNotificationConfiguration(ctx, bucket)
+	if err != nil {
+		return
+	}
+
+	now := time.Now().UTC()
+
+	for _, dest := range config.destinations() {
+		if !dest.matches(eventName, key) {
+			continue
+		}
+
+		record := eventRecord{
+			EventVersion:      "2.1",
+			EventSource:       "aws:s3",
+			AWSRegion:         notificationRegion,
+			EventTime:         now.Format(timeFormatISO),
+			EventName:         eventName,
+			UserIdentity:      eventIdentity{PrincipalID: ownerID},
+			RequestParameters: map[string]string{"sourceIPAddress": "127.0.0.1"},
+			ResponseElements: map[string]string{
+				"x-amz-request-id": uuid.New().String(),
+				"x-amz-id-2":       uuid.New().String(),
+			},
+			S3: eventEntity{
+				SchemaVersion:   "1.0",
+				ConfigurationID: dest.id,
+				Bucket: eventBucket{
+					Name:          bucket,
+					OwnerIdentity: eventIdentity{PrincipalID: ownerID},
+					ARN:           "arn:aws:s3:::" + bucket,
+				},
+				Object: eventObject{
+					Key:       eventKey(key),
+					Size:      size,
+					ETag:      strings.Trim(etag, `"`),
+					VersionID: versionID,
+					Sequencer: fmt.Sprintf("%016X", now.UnixNano()),
+				},
+			},
+		}
+
+		body, err := json.Marshal(eventRecords{Records: []eventRecord{record}})
+		if err != nil {
+			s.logger.Error("failed to marshal S3 event record", "error", err)
+
+			continue
+		}
+
+		s.deliverNotification(ctx, dest.arn, body)
+	}
+}
+
+// eventKey URL-encodes an object key as event records do, with spaces as + and
+// slashes unencoded.
+func eventKey(key string) string {
+	return strings.ReplaceAll(url.QueryEscape(key), "%2F", "/")
+}
+
+// deliverNotification sends an event record to an SNS topic, SQS queue or Lambda
+// function via the local kumo endpoint.
+func (s *Service) deliverNotification(ctx context.Context, arn string, body []byte) {
+	// arn:aws:{sns|sqs}:region:account:name or arn:aws:lambda:region:account:function:name[:qualifier]
+	parts := strings.Split(arn, ":")
+	if len(parts) < 6 {
+		s.logger.Error("invalid notification destination ARN", "arn", arn)
+
+		return
+	}
+
+	var (
+		req *http.Request
+		err error
+	)
+
+	switch parts[2] {
+	case "sqs":
+		req, err = newTargetRequest(ctx, s.baseURL, "AmazonSQS.SendMessage", map[string]string{
+			"QueueUrl":    fmt.Sprintf("%s/%s/%s", s.baseURL, parts[4], parts[5]),
+			"MessageBody": string(body),
+		})
+	case "sns":
+		req, err = newTargetRequest(ctx, s.baseURL, "AmazonSimpleNotificationService.Publish", map[string]string{
+			"TopicArn": arn,
+			"Subject":  "Amazon S3 Notification",
+			"Message":  string(body),
+		})
+	case "lambda":
+		if len(parts) < 7 {
+			s.logger.Error("invalid notification destination ARN", "arn", arn)
+
+			return
+		}
+
+		invokeURL := fmt.Sprintf("%s/lambda/2015-03-31/functions/%s/invocations", s.baseURL, url.PathEscape(parts[6]))
+		if len(parts) > 7 {
+			invokeURL += "?Qualifier=" + url.QueryEscape(parts[7])
+		}
+
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, invokeURL, bytes.NewReader(body))
+		if err == nil {
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Amz-Invocation-Type", "Event")
+		}
+	default:
+		s.logger.Warn("unsupported notification destination", "arn", arn)
+
+		return
+	}
+
+	if err != nil {
+		s.logger.Error("failed to create notification request", "error", err, "arn", arn)
+
+		return
+	}
+
+	service.MarkInternalRequest(req)
+
+	resp, err := (&http.Client{Timeout: 5 * time.Second}).Do(req)
+	if err != nil {
+		s.logger.Error("failed to deliver S3 event notification", "error", err, "arn", arn)
+
+		return
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	s.logger.Info("delivered S3 event notification", "arn", arn, "status", resp.StatusCode)
+}
+
+// newTargetRequest returns a JSON protocol request to a kumo service.
+func newTargetRequest(ctx context.Context, baseURL, target string, payload any) (*http.Request, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-amz-json-1.0")
+	req.Header.Set("X-Amz-Target", target)
+
+	return req, nil
+}
@@ -22,18 +22,20 @@ const defaultBaseURL = "http://localhost:4566"
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	baseURL := defaultBaseURL
+	service.RegisterFactory([]string{"s3"}, func(dataDir string) []service.Service {
+		baseURL := defaultBaseURL
 
-	if port := os.Getenv("KUMO_PORT"); port != "" {
-		baseURL = fmt.Sprintf("http://localhost:%s", port)
-	}
+		if port := os.Getenv("KUMO_PORT"); port != "" {
+			baseURL = fmt.Sprintf("http://localhost:%s", port)
+		}
 
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...), baseURL))
+		return []service.Service{New(NewMemoryStorage(opts...), baseURL)}
+	})
 }
 
 // Service implements the S3 service.
@@ -62,7 +64,7 @@ func (s *Service) RegisterRoutes(r service.Router) {
 	// Bucket operations
 	r.Handle("GET", "/", s.ListBuckets)
 	r.Handle("PUT", "/{bucket}", s.handleBucketPut)
-	r.Handle("DELETE", "/{bucket}", s.DeleteBucket)
+	r.Handle("DELETE", "/{bucket}", s.handleBucketDelete)
 	r.Handle("HEAD", "/{bucket}", s.HeadBucket)
 
 	// Bucket-level GET handles ListObjects, ListMultipartUploads, versioning queries
@@ -91,6 +93,20 @@ func (s *Service) Close() error {
 	return nil
 }
 
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
+
 // emitObjectCreatedEvent sends an S3 Object Created event to EventBridge.
 func (s *Service) emitObjectCreatedEvent(ctx context.Context, bucket, key string, size int64, etag string) {
 	if !s.storage.IsEventBridgeEnabled(ctx, bucket) {
@@ -131,6 +147,7 @@ func (s *Service) putEvents(ctx context.Context, body []byte, bucket, key string
 
 	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
 	req.Header.Set("X-Amz-Target", "AWSEvents.PutEvents")
+	service.MarkInternalRequest(req)
 
 	resp, err := (&http.Client{Timeout: 5 * time.Second}).Do(req)
 	if err != nil {
@@ -0,0 +1,183 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec // MD5 is required for S3 ETag calculation per AWS specification
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	src := New(NewMemoryStorage(), "http://localhost:4566")
+
+	if err := src.storage.CreateBucket(ctx, "snapshot-bucket"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := src.storage.PutObject(ctx, "snapshot-bucket", "dir/hello.txt", bytes.NewReader([]byte("hello")), map[string]string{"owner": "kumo"}); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := service.Snapshot(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dst := New(NewMemoryStorage(), "http://localhost:4566")
+	if err := dst.storage.CreateBucket(ctx, "created-after-snapshot"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.Restore(ctx, dst, []byte(`{"Buckets":`)); err == nil {
+		t.Fatal("expected an invalid snapshot to be rejected")
+	}
+
+	if _, err := dst.storage.GetBucketVersioning(ctx, "created-after-snapshot"); err != nil {
+		t.Fatalf("expected a rejected snapshot to leave the storage unchanged: %v", err)
+	}
+
+	if err := service.Restore(ctx, dst, data); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := dst.storage.GetBucketVersioning(ctx, "created-after-snapshot"); err == nil {
+		t.Error("expected a bucket created after the snapshot to be removed by restore")
+	}
+
+	obj, err := dst.storage.GetObject(ctx, "snapshot-bucket", "dir/hello.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(obj.Body) != "hello" {
+		t.Errorf("expected body %q, got %q", "hello", obj.Body)
+	}
+
+	if obj.Metadata["owner"] != "kumo" {
+		t.Errorf("expected metadata owner=kumo, got %v", obj.Metadata)
+	}
+
+	restored, err := service.Snapshot(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(restored, data) {
+		t.Errorf("snapshot changed after restore:\ngot  %s\nwant %s", restored, data)
+	}
+}
+
+func TestETag(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	storage := NewMemoryStorage()
+
+	if err := storage.CreateBucket(ctx, "etag-bucket"); err != nil {
+		t.Fatal(err)
+	}
+
+	md5Hex := func(data []byte) string {
+		sum := md5.Sum(data) //nolint:gosec // MD5 is required for S3 ETag calculation per AWS specification
+
+		return hex.EncodeToString(sum[:])
+	}
+
+	body := []byte("hello, etag")
+
+	obj, err := storage.PutObject(ctx, "etag-bucket", "single", bytes.NewReader(body), map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `"` + md5Hex(body) + `"`; obj.ETag != want {
+		t.Errorf("single-part ETag = %s, want %s", obj.ETag, want)
+	}
+
+	upload, err := storage.CreateMultipartUpload(ctx, "etag-bucket", "multi")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	partBodies := [][]byte{[]byte("first part"), []byte("second part"), []byte("third")}
+
+	var (
+		requests  []PartRequest
+		partMD5s  []byte
+		wholeBody []byte
+	)
+
+	for i, partBody := range partBodies {
+		part, err := storage.UploadPart(ctx, "etag-bucket", "multi", upload.UploadID, i+1, bytes.NewReader(partBody))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if want := `"` + md5Hex(partBody) + `"`; part.ETag != want {
+			t.Errorf("part %d ETag = %s, want %s", i+1, part.ETag, want)
+		}
+
+		requests = append(requests, PartRequest{PartNumber: i + 1, ETag: part.ETag})
+		sum := md5.Sum(partBody) //nolint:gosec // MD5 is required for S3 ETag calculation per AWS specification
+		partMD5s = append(partMD5s, sum[:]...)
+		wholeBody = append(wholeBody, partBody...)
+	}
+
+	reversed := []PartRequest{requests[1], requests[0]}
+	if _, err := storage.CompleteMultipartUpload(ctx, "etag-bucket", "multi", upload.UploadID, reversed); err == nil {
+		t.Error("expected InvalidPartOrder for parts in descending order")
+	}
+
+	obj, err = storage.CompleteMultipartUpload(ctx, "etag-bucket", "multi", upload.UploadID, requests)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantMultipart := fmt.Sprintf(`"%s-%d"`, md5Hex(partMD5s), len(partBodies))
+	if obj.ETag != wantMultipart {
+		t.Errorf("multipart ETag = %s, want %s", obj.ETag, wantMultipart)
+	}
+
+	if !bytes.Equal(obj.Body, wholeBody) {
+		t.Errorf("multipart body = %q, want %q", obj.Body, wholeBody)
+	}
+
+	want := map[string]string{
+		"single": `"` + md5Hex(body) + `"`,
+		"multi":  wantMultipart,
+	}
+
+	for key, etag := range want {
+		head, err := storage.HeadObject(ctx, "etag-bucket", key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := storage.GetObject(ctx, "etag-bucket", key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if head.ETag != etag || got.ETag != etag {
+			t.Errorf("%s: HeadObject ETag = %s, GetObject ETag = %s, want %s", key, head.ETag, got.ETag, etag)
+		}
+	}
+
+	objects, _, err := storage.ListObjects(ctx, "etag-bucket", "", "", 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, o := range objects {
+		if o.ETag != want[o.Key] {
+			t.Errorf("%s: ListObjects ETag = %s, want %s", o.Key, o.ETag, want[o.Key])
+		}
+	}
+}
@@ -49,18 +49,37 @@ type Storage interface {
 	UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int, body io.Reader) (*Part, error)
 	CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string, parts []PartRequest) (*Object, error)
 	AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error
-	ListMultipartUploads(ctx context.Context, bucket, prefix string, maxUploads int) ([]*MultipartUpload, error)
-	ListParts(ctx context.Context, bucket, key, uploadID string, maxParts int) ([]*Part, error)
+	ListMultipartUploads(ctx context.Context, bucket, prefix, keyMarker, uploadIDMarker string, maxUploads int) ([]*MultipartUpload, bool, error)
+	ListParts(ctx context.Context, bucket, key, uploadID string, partNumberMarker, maxParts int) ([]*Part, bool, error)
 
 	// Object tagging
 	PutObjectTagging(ctx context.Context, bucket, key string, tags map[string]string) error
 	GetObjectTagging(ctx context.Context, bucket, key string) (map[string]string, error)
 
 	// Notification and CORS
-	SetEventBridgeNotification(ctx context.Context, bucket string, enabled bool)
+	PutBucketNotificationConfiguration(ctx context.Context, bucket string, config *NotificationConfiguration) error
+	GetBucketNotificationConfiguration(ctx context.Context, bucket string) (*NotificationConfiguration, error)
 	IsEventBridgeEnabled(ctx context.Context, bucket string) bool
 	SetCORSConfiguration(ctx context.Context, bucket string, rules []CORSRule)
 	GetCORSRules(ctx context.Context, bucket string) []CORSRule
+
+	// Bucket policy
+	PutBucketPolicy(ctx context.Context, bucket, policy string) error
+	GetBucketPolicy(ctx context.Context, bucket string) (string, error)
+	DeleteBucketPolicy(ctx context.Context, bucket string) error
+
+	// Access control lists
+	PutBucketACL(ctx context.Context, bucket string, grants []Grant) error
+	GetBucketACL(ctx context.Context, bucket string) ([]Grant, error)
+	PutObjectACL(ctx context.Context, bucket, key string, grants []Grant) error
+	GetObjectACL(ctx context.Context, bucket, key string) ([]Grant, error)
+
+	// Website hosting
+	PutBucketWebsite(ctx context.Context, bucket string, config *WebsiteConfiguration) error
+	GetBucketWebsite(ctx context.Context, bucket string) (*WebsiteConfiguration, error)
+	DeleteBucketWebsite(ctx context.Context, bucket string) error
+
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -90,13 +109,17 @@ type MemoryStorage struct {
 type MemoryBucket struct {
 	Name               string                      `json:"name"`
 	CreationDate       time.Time                   `json:"creationDate"`
-	Objects            map[string]*Object          `json:"objects"`             // current/latest version per key
-	Versions           map[string][]*Object        `json:"versions"`            // all versions per key (newest first)
-	VersioningStatus   string                      `json:"versioningStatus"`    // "", "Enabled", "Suspended"
-	VersionIDCounter   uint64                      `json:"versionIdcounter"`    // counter for generating version IDs
-	MultipartUploads   map[string]*MultipartUpload `json:"-"`                   // uploadID -> MultipartUpload
-	EventBridgeEnabled bool                        `json:"eventBridgeEnabled"`  // EventBridge notification
-	CORSRules          []CORSRule                  `json:"corsRules,omitempty"` // CORS configuration
+	Objects            map[string]*Object          `json:"objects"`                // current/latest version per key
+	Versions           map[string][]*Object        `json:"versions"`               // all versions per key (newest first)
+	VersioningStatus   string                      `json:"versioningStatus"`       // "", "Enabled", "Suspended"
+	VersionIDCounter   uint64                      `json:"versionIdcounter"`       // counter for generating version IDs
+	MultipartUploads   map[string]*MultipartUpload `json:"-"`                      // uploadID -> MultipartUpload
+	EventBridgeEnabled bool                        `json:"eventBridgeEnabled"`     // EventBridge notification
+	CORSRules          []CORSRule                  `json:"corsRules,omitempty"`    // CORS configuration
+	Policy             string                      `json:"policy,omitempty"`       // bucket policy JSON document
+	ACL                []Grant                     `json:"acl,omitempty"`          // nil grants the owner full control
+	Website            *WebsiteConfiguration       `json:"website,omitempty"`      // website hosting configuration
+	Notification       *NotificationConfiguration  `json:"notification,omitempty"` // event notification configuration
 }
 
 // NewMemoryStorage creates a new in-memory S3 storage.
@@ -163,6 +186,16 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Buckets = make(map[string]*MemoryBucket)
+
+	return nil
+}
+
 // CreateBucket creates a new bucket.
 func (s *MemoryStorage) CreateBucket(_ context.Context, name string) error {
 	s.mu.Lock()
@@ -264,6 +297,9 @@ func (s *MemoryStorage) PutObject(_ context.Context, bucket, key string, body io
 		if ct, ok := metadata["Content-Type"]; ok {
 			obj.ContentType = ct
 		}
+
+		obj.Encryption = takeEncryption(metadata)
+		obj.ACL = takeACL(metadata)
 	}
 
 	if obj.ContentType == "" {
@@ -515,6 +551,7 @@ func (s *MemoryStorage) HeadObject(_ context.Context, bucket, key string) (*Obje
 		Size:         obj.Size,
 		LastModified: obj.LastModified,
 		Metadata:     obj.Metadata,
+		Encryption:   obj.Encryption,
 	}, nil
 }
 
@@ -871,10 +908,21 @@ func (s *MemoryStorage) CompleteMultipartUpload(_ context.Context, bucket, key,
 		return nil, &MultipartError{Code: "NoSuchUpload", Message: "The specified upload does not exist", UploadID: uploadID}
 	}
 
+	if len(parts) == 0 {
+		return nil, &MultipartError{Code: "MalformedXML", Message: "The XML you provided was not well-formed or did not validate against our published schema", UploadID: uploadID}
+	}
+
 	// Validate and assemble parts
 	var combinedBody []byte
 
-	for _, pr := range parts {
+	objectParts := make([]ObjectPart, 0, len(parts))
+
+	for i, pr := range parts {
+		// The multipart ETag depends on the part order, which must be ascending as on AWS.
+		if i > 0 && pr.PartNumber <= parts[i-1].PartNumber {
+			return nil, &MultipartError{Code: "InvalidPartOrder", Message: "The list of parts was not in ascending order. Parts must be ordered by part number.", UploadID: uploadID}
+		}
+
 		part, ok := upload.Parts[pr.PartNumber]
 		if !ok {
 			return nil, &MultipartError{Code: "InvalidPart", Message: "One or more of the specified parts could not be found", UploadID: uploadID}
@@ -886,6 +934,7 @@ func (s *MemoryStorage) CompleteMultipartUpload(_ context.Context, bucket, key,
 		}
 
 		combinedBody = append(combinedBody, part.Body...)
+		objectParts = append(objectParts, ObjectPart{PartNumber: part.PartNumber, Size: part.Size})
 	}
 
 	// Calculate final ETag (MD5 of MD5s + "-" + number of parts)
@@ -898,6 +947,7 @@ func (s *MemoryStorage) CompleteMultipartUpload(_ context.Context, bucket, key,
 		Size:         int64(len(combinedBody)),
 		LastModified: time.Now(),
 		ContentType:  "application/octet-stream",
+		Parts:        objectParts,
 	}
 
 	b.Objects[key] = obj
@@ -930,14 +980,15 @@ func (s *MemoryStorage) AbortMultipartUpload(_ context.Context, bucket, key, upl
 	return nil
 }
 
-// ListMultipartUploads lists in-progress multipart uploads.
-func (s *MemoryStorage) ListMultipartUploads(_ context.Context, bucket, prefix string, maxUploads int) ([]*MultipartUpload, error) {
+// ListMultipartUploads lists in-progress multipart uploads after the given markers,
+// ordered by key and upload ID. It reports whether more uploads follow.
+func (s *MemoryStorage) ListMultipartUploads(_ context.Context, bucket, prefix, keyMarker, uploadIDMarker string, maxUploads int) ([]*MultipartUpload, bool, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	b, exists := s.Buckets[bucket]
 	if !exists {
-		return nil, &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
+		return nil, false, &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
 	}
 
 	if maxUploads <= 0 {
@@ -947,13 +998,16 @@ func (s *MemoryStorage) ListMultipartUploads(_ context.Context, bucket, prefix s
 	uploads := make([]*MultipartUpload, 0)
 
 	for _, upload := range b.MultipartUploads {
-		if prefix == "" || strings.HasPrefix(upload.Key, prefix) {
-			uploads = append(uploads, upload)
+		if prefix != "" && !strings.HasPrefix(upload.Key, prefix) {
+			continue
 		}
 
-		if len(uploads) >= maxUploads {
-			break
+		// Without an upload ID marker, every upload of the marker key is skipped.
+		if upload.Key < keyMarker || (upload.Key == keyMarker && (uploadIDMarker == "" || upload.UploadID <= uploadIDMarker)) {
+			continue
 		}
+
+		uploads = append(uploads, upload)
 	}
 
 	// Sort by key and then by upload ID for consistent ordering
@@ -965,26 +1019,31 @@ func (s *MemoryStorage) ListMultipartUploads(_ context.Context, bucket, prefix s
 		return uploads[i].UploadID < uploads[j].UploadID
 	})
 
-	return uploads, nil
+	if len(uploads) > maxUploads {
+		return uploads[:maxUploads], true, nil
+	}
+
+	return uploads, false, nil
 }
 
-// ListParts lists the parts that have been uploaded for a multipart upload.
-func (s *MemoryStorage) ListParts(_ context.Context, bucket, key, uploadID string, maxParts int) ([]*Part, error) {
+// ListParts lists the parts that have been uploaded for a multipart upload after
+// the given part number. It reports whether more parts follow.
+func (s *MemoryStorage) ListParts(_ context.Context, bucket, key, uploadID string, partNumberMarker, maxParts int) ([]*Part, bool, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	b, exists := s.Buckets[bucket]
 	if !exists {
-		return nil, &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
+		return nil, false, &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
 	}
 
 	upload, exists := b.MultipartUploads[uploadID]
 	if !exists {
-		return nil, &MultipartError{Code: "NoSuchUpload", Message: "The specified upload does not exist", UploadID: uploadID}
+		return nil, false, &MultipartError{Code: "NoSuchUpload", Message: "The specified upload does not exist", UploadID: uploadID}
 	}
 
 	if upload.Key != key {
-		return nil, &MultipartError{Code: "NoSuchUpload", Message: "The specified upload does not exist", UploadID: uploadID}
+		return nil, false, &MultipartError{Code: "NoSuchUpload", Message: "The specified upload does not exist", UploadID: uploadID}
 	}
 
 	if maxParts <= 0 {
@@ -992,8 +1051,11 @@ func (s *MemoryStorage) ListParts(_ context.Context, bucket, key, uploadID strin
 	}
 
 	parts := make([]*Part, 0, len(upload.Parts))
+
 	for _, part := range upload.Parts {
-		parts = append(parts, part)
+		if part.PartNumber > partNumberMarker {
+			parts = append(parts, part)
+		}
 	}
 
 	// Sort by part number
@@ -1001,12 +1063,11 @@ func (s *MemoryStorage) ListParts(_ context.Context, bucket, key, uploadID strin
 		return parts[i].PartNumber < parts[j].PartNumber
 	})
 
-	// Limit to maxParts
 	if len(parts) > maxParts {
-		parts = parts[:maxParts]
+		return parts[:maxParts], true, nil
 	}
 
-	return parts, nil
+	return parts, false, nil
 }
 
 // generateUploadID generates a unique upload ID.
@@ -1049,14 +1110,49 @@ func calculateMultipartETag(partRequests []PartRequest, parts map[int]*Part) str
 	return fmt.Sprintf("%q", fmt.Sprintf("%s-%d", hex.EncodeToString(finalHash[:]), len(partRequests)))
 }
 
-// SetEventBridgeNotification enables or disables EventBridge notification for a bucket.
-func (s *MemoryStorage) SetEventBridgeNotification(_ context.Context, bucket string, enabled bool) {
+// PutBucketNotificationConfiguration replaces the notification configuration of a bucket,
+// which also enables or disables EventBridge notification.
+func (s *MemoryStorage) PutBucketNotificationConfiguration(_ context.Context, bucket string, config *NotificationConfiguration) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if b, exists := s.Buckets[bucket]; exists {
-		b.EventBridgeEnabled = enabled
+	b, exists := s.Buckets[bucket]
+	if !exists {
+		return &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
 	}
+
+	b.Notification = config
+	b.EventBridgeEnabled = config.EventBridgeConfig != nil
+
+	return nil
+}
+
+// GetBucketNotificationConfiguration returns the notification configuration of a bucket,
+// which is empty if none was set.
+func (s *MemoryStorage) GetBucketNotificationConfiguration(_ context.Context, bucket string) (*NotificationConfiguration, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	b, exists := s.Buckets[bucket]
+	if !exists {
+		return nil, &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
+	}
+
+	if b.Notification == nil {
+		return &NotificationConfiguration{EventBridgeConfig: eventBridgeConfig(b.EventBridgeEnabled)}, nil
+	}
+
+	return b.Notification, nil
+}
+
+// eventBridgeConfig returns the EventBridge configuration of a bucket with EventBridge
+// notification enabled, and nil otherwise.
+func eventBridgeConfig(enabled bool) *EventBridgeConfig {
+	if !enabled {
+		return nil
+	}
+
+	return &EventBridgeConfig{}
 }
 
 // IsEventBridgeEnabled returns whether EventBridge notification is enabled for a bucket.
@@ -1092,3 +1188,163 @@ func (s *MemoryStorage) GetCORSRules(_ context.Context, bucket string) []CORSRul
 
 	return nil
 }
+
+// PutBucketPolicy sets the policy document of a bucket.
+func (s *MemoryStorage) PutBucketPolicy(_ context.Context, bucket, policy string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, exists := s.Buckets[bucket]
+	if !exists {
+		return &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
+	}
+
+	b.Policy = policy
+
+	return nil
+}
+
+// GetBucketPolicy returns the policy document of a bucket.
+func (s *MemoryStorage) GetBucketPolicy(_ context.Context, bucket string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	b, exists := s.Buckets[bucket]
+	if !exists {
+		return "", &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
+	}
+
+	if b.Policy == "" {
+		return "", &BucketError{Code: "NoSuchBucketPolicy", Message: "The bucket policy does not exist", BucketName: bucket}
+	}
+
+	return b.Policy, nil
+}
+
+// DeleteBucketPolicy removes the policy document of a bucket.
+func (s *MemoryStorage) DeleteBucketPolicy(_ context.Context, bucket string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, exists := s.Buckets[bucket]
+	if !exists {
+		return &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
+	}
+
+	b.Policy = ""
+
+	return nil
+}
+
+// PutBucketACL sets the access control list of a bucket.
+func (s *MemoryStorage) PutBucketACL(_ context.Context, bucket string, grants []Grant) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, exists := s.Buckets[bucket]
+	if !exists {
+		return &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
+	}
+
+	b.ACL = grants
+
+	return nil
+}
+
+// GetBucketACL returns the access control list of a bucket.
+func (s *MemoryStorage) GetBucketACL(_ context.Context, bucket string) ([]Grant, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	b, exists := s.Buckets[bucket]
+	if !exists {
+		return nil, &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
+	}
+
+	return b.ACL, nil
+}
+
+// PutObjectACL sets the access control list of the current version of an object.
+func (s *MemoryStorage) PutObjectACL(_ context.Context, bucket, key string, grants []Grant) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, exists := s.Buckets[bucket]
+	if !exists {
+		return &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
+	}
+
+	obj, exists := b.Objects[key]
+	if !exists || obj.IsDeleteMarker {
+		return &ObjectError{Code: "NoSuchKey", Message: "The specified key does not exist.", Key: key}
+	}
+
+	obj.ACL = grants
+
+	return nil
+}
+
+// GetObjectACL returns the access control list of the current version of an object.
+func (s *MemoryStorage) GetObjectACL(_ context.Context, bucket, key string) ([]Grant, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	b, exists := s.Buckets[bucket]
+	if !exists {
+		return nil, &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
+	}
+
+	obj, exists := b.Objects[key]
+	if !exists || obj.IsDeleteMarker {
+		return nil, &ObjectError{Code: "NoSuchKey", Message: "The specified key does not exist.", Key: key}
+	}
+
+	return obj.ACL, nil
+}
+
+// PutBucketWebsite sets the website configuration of a bucket.
+func (s *MemoryStorage) PutBucketWebsite(_ context.Context, bucket string, config *WebsiteConfiguration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, exists := s.Buckets[bucket]
+	if !exists {
+		return &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
+	}
+
+	b.Website = config
+
+	return nil
+}
+
+// GetBucketWebsite returns the website configuration of a bucket.
+func (s *MemoryStorage) GetBucketWebsite(_ context.Context, bucket string) (*WebsiteConfiguration, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	b, exists := s.Buckets[bucket]
+	if !exists {
+		return nil, &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
+	}
+
+	if b.Website == nil {
+		return nil, &BucketError{Code: "NoSuchWebsiteConfiguration", Message: "The specified bucket does not have a website configuration", BucketName: bucket}
+	}
+
+	return b.Website, nil
+}
+
+// DeleteBucketWebsite removes the website configuration of a bucket.
+func (s *MemoryStorage) DeleteBucketWebsite(_ context.Context, bucket string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, exists := s.Buckets[bucket]
+	if !exists {
+		return &BucketError{Code: "NoSuchBucket", Message: "The specified bucket does not exist", BucketName: bucket}
+	}
+
+	b.Website = nil
+
+	return nil
+}
@@ -24,6 +24,23 @@ type Object struct {
 	Tags           map[string]string
 	VersionID      string
 	IsDeleteMarker bool
+	Encryption     ObjectEncryption
+	ACL            []Grant      // nil grants the owner full control
+	Parts          []ObjectPart // parts of an object completed from a multipart upload
+}
+
+// ObjectPart is a part of an object completed from a multipart upload.
+type ObjectPart struct {
+	PartNumber int
+	Size       int64
+}
+
+// ObjectEncryption holds the server-side encryption settings an object was stored with.
+type ObjectEncryption struct {
+	ServerSideEncryption string // AES256, aws:kms or aws:kms:dsse
+	KMSKeyID             string
+	CustomerAlgorithm    string // SSE-C only
+	CustomerKeyMD5       string // SSE-C only; the key itself is never stored
 }
 
 // Tagging represents the XML structure for S3 object tagging.
@@ -58,6 +75,35 @@ type Owner struct {
 	ID string `xml:"ID"`
 }
 
+// AccessControlPolicy is the request and response body of the ACL operations.
+type AccessControlPolicy struct {
+	XMLName           xml.Name          `xml:"AccessControlPolicy"`
+	Xmlns             string            `xml:"xmlns,attr,omitempty"`
+	Owner             Owner             `xml:"Owner"`
+	AccessControlList AccessControlList `xml:"AccessControlList"`
+}
+
+// AccessControlList is the list of grants of an access control policy.
+type AccessControlList struct {
+	Grants []Grant `xml:"Grant"`
+}
+
+// Grant gives a permission to a grantee.
+type Grant struct {
+	Grantee    Grantee `xml:"Grantee"`
+	Permission string  `xml:"Permission"`
+}
+
+// Grantee is the user or group a grant applies to. Type is CanonicalUser, Group
+// or AmazonCustomerByEmail, and tells which of ID, URI and EmailAddress is set.
+type Grantee struct {
+	XMLNSXSI     string `xml:"xmlns:xsi,attr,omitempty"`
+	Type         string `xml:"xsi:type,attr,omitempty"`
+	ID           string `xml:"ID,omitempty"`
+	URI          string `xml:"URI,omitempty"`
+	EmailAddress string `xml:"EmailAddress,omitempty"`
+}
+
 // Buckets is a list of buckets.
 type Buckets struct {
 	Bucket []BucketInfo `xml:"Bucket"`
@@ -253,6 +299,7 @@ type ListMultipartUploadsResult struct {
 	XMLName            xml.Name       `xml:"ListMultipartUploadsResult"`
 	Xmlns              string         `xml:"xmlns,attr"`
 	Bucket             string         `xml:"Bucket"`
+	Prefix             string         `xml:"Prefix,omitempty"`
 	KeyMarker          string         `xml:"KeyMarker,omitempty"`
 	UploadIDMarker     string         `xml:"UploadIdMarker,omitempty"`
 	NextKeyMarker      string         `xml:"NextKeyMarker,omitempty"`
@@ -284,6 +331,32 @@ type ListPartsResult struct {
 	Parts                []PartInfo `xml:"Part"`
 }
 
+// GetObjectAttributesOutput is the response for GetObjectAttributes. Only the requested attributes are set.
+type GetObjectAttributesOutput struct {
+	XMLName      xml.Name              `xml:"GetObjectAttributesOutput"`
+	Xmlns        string                `xml:"xmlns,attr"`
+	ETag         string                `xml:"ETag,omitempty"`
+	ObjectParts  *ObjectAttributeParts `xml:"ObjectParts,omitempty"`
+	StorageClass string                `xml:"StorageClass,omitempty"`
+	ObjectSize   *int64                `xml:"ObjectSize,omitempty"`
+}
+
+// ObjectAttributeParts lists the parts of a multipart object in GetObjectAttributes.
+type ObjectAttributeParts struct {
+	PartsCount           int                   `xml:"PartsCount"`
+	PartNumberMarker     int                   `xml:"PartNumberMarker"`
+	NextPartNumberMarker int                   `xml:"NextPartNumberMarker"`
+	MaxParts             int                   `xml:"MaxParts"`
+	IsTruncated          bool                  `xml:"IsTruncated"`
+	Parts                []ObjectAttributePart `xml:"Part"`
+}
+
+// ObjectAttributePart is a part in GetObjectAttributes.
+type ObjectAttributePart struct {
+	PartNumber int   `xml:"PartNumber"`
+	Size       int64 `xml:"Size"`
+}
+
 // PartInfo represents a part in the list parts response.
 type PartInfo struct {
 	PartNumber   int    `xml:"PartNumber"`
@@ -309,13 +382,57 @@ type CopyPartResult struct {
 
 // NotificationConfiguration represents S3 bucket notification configuration.
 type NotificationConfiguration struct {
-	XMLName           xml.Name           `xml:"NotificationConfiguration"`
-	EventBridgeConfig *EventBridgeConfig `xml:"EventBridgeConfiguration,omitempty"`
+	XMLName                      xml.Name                      `json:"-"                                      xml:"NotificationConfiguration"`
+	Xmlns                        string                        `json:"-"                                      xml:"xmlns,attr,omitempty"`
+	TopicConfigurations          []TopicConfiguration          `json:"topicConfigurations,omitempty"          xml:"TopicConfiguration,omitempty"`
+	QueueConfigurations          []QueueConfiguration          `json:"queueConfigurations,omitempty"          xml:"QueueConfiguration,omitempty"`
+	LambdaFunctionConfigurations []LambdaFunctionConfiguration `json:"lambdaFunctionConfigurations,omitempty" xml:"CloudFunctionConfiguration,omitempty"`
+	EventBridgeConfig            *EventBridgeConfig            `json:"eventBridgeConfig,omitempty"            xml:"EventBridgeConfiguration,omitempty"`
 }
 
 // EventBridgeConfig represents EventBridge notification configuration.
 type EventBridgeConfig struct{}
 
+// TopicConfiguration publishes the events of a bucket to an SNS topic.
+type TopicConfiguration struct {
+	ID       string              `json:"id"               xml:"Id"`
+	TopicArn string              `json:"topicArn"         xml:"Topic"`
+	Events   []string            `json:"events"           xml:"Event"`
+	Filter   *NotificationFilter `json:"filter,omitempty" xml:"Filter,omitempty"`
+}
+
+// QueueConfiguration sends the events of a bucket to an SQS queue.
+type QueueConfiguration struct {
+	ID       string              `json:"id"               xml:"Id"`
+	QueueArn string              `json:"queueArn"         xml:"Queue"`
+	Events   []string            `json:"events"           xml:"Event"`
+	Filter   *NotificationFilter `json:"filter,omitempty" xml:"Filter,omitempty"`
+}
+
+// LambdaFunctionConfiguration invokes a Lambda function with the events of a bucket.
+type LambdaFunctionConfiguration struct {
+	ID                string              `json:"id"                xml:"Id"`
+	LambdaFunctionArn string              `json:"lambdaFunctionArn" xml:"CloudFunction"`
+	Events            []string            `json:"events"            xml:"Event"`
+	Filter            *NotificationFilter `json:"filter,omitempty"  xml:"Filter,omitempty"`
+}
+
+// NotificationFilter limits a notification to the objects whose keys match its rules.
+type NotificationFilter struct {
+	S3Key S3KeyFilter `json:"s3Key" xml:"S3Key"`
+}
+
+// S3KeyFilter holds the prefix and suffix rules of a notification filter.
+type S3KeyFilter struct {
+	FilterRules []FilterRule `json:"filterRules" xml:"FilterRule"`
+}
+
+// FilterRule is a prefix or suffix that object keys must have.
+type FilterRule struct {
+	Name  string `json:"name"  xml:"Name"`
+	Value string `json:"value" xml:"Value"`
+}
+
 // CORSConfiguration represents S3 bucket CORS configuration (XML request body).
 type CORSConfiguration struct {
 	XMLName   xml.Name   `xml:"CORSConfiguration"`
@@ -330,3 +447,50 @@ type CORSRule struct {
 	ExposeHeaders  []string `json:"exposeHeaders,omitempty"  xml:"ExposeHeader"`
 	MaxAgeSeconds  int      `json:"maxAgeSeconds,omitempty"  xml:"MaxAgeSeconds"`
 }
+
+// WebsiteConfiguration represents S3 bucket website configuration (XML request and response body).
+type WebsiteConfiguration struct {
+	XMLName               xml.Name               `json:"-"                               xml:"WebsiteConfiguration"`
+	Xmlns                 string                 `json:"-"                               xml:"xmlns,attr,omitempty"`
+	ErrorDocument         *ErrorDocument         `json:"errorDocument,omitempty"         xml:"ErrorDocument,omitempty"`
+	IndexDocument         *IndexDocument         `json:"indexDocument,omitempty"         xml:"IndexDocument,omitempty"`
+	RedirectAllRequestsTo *RedirectAllRequestsTo `json:"redirectAllRequestsTo,omitempty" xml:"RedirectAllRequestsTo,omitempty"`
+	RoutingRules          []RoutingRule          `json:"routingRules,omitempty"          xml:"RoutingRules>RoutingRule,omitempty"`
+}
+
+// ErrorDocument is the object returned when a website request fails.
+type ErrorDocument struct {
+	Key string `json:"key" xml:"Key"`
+}
+
+// IndexDocument is the object suffix appended to website requests for directories.
+type IndexDocument struct {
+	Suffix string `json:"suffix" xml:"Suffix"`
+}
+
+// RedirectAllRequestsTo redirects every website request to another host.
+type RedirectAllRequestsTo struct {
+	HostName string `json:"hostName"           xml:"HostName"`
+	Protocol string `json:"protocol,omitempty" xml:"Protocol,omitempty"`
+}
+
+// RoutingRule redirects the website requests that match its condition.
+type RoutingRule struct {
+	Condition *RoutingRuleCondition `json:"condition,omitempty" xml:"Condition,omitempty"`
+	Redirect  RoutingRuleRedirect   `json:"redirect"            xml:"Redirect"`
+}
+
+// RoutingRuleCondition is the condition of a routing rule.
+type RoutingRuleCondition struct {
+	HTTPErrorCodeReturnedEquals string `json:"httpErrorCodeReturnedEquals,omitempty" xml:"HttpErrorCodeReturnedEquals,omitempty"`
+	KeyPrefixEquals             string `json:"keyPrefixEquals,omitempty"             xml:"KeyPrefixEquals,omitempty"`
+}
+
+// RoutingRuleRedirect is where a routing rule redirects requests to.
+type RoutingRuleRedirect struct {
+	HostName             string `json:"hostName,omitempty"             xml:"HostName,omitempty"`
+	HTTPRedirectCode     string `json:"httpRedirectCode,omitempty"     xml:"HttpRedirectCode,omitempty"`
+	Protocol             string `json:"protocol,omitempty"             xml:"Protocol,omitempty"`
+	ReplaceKeyPrefixWith string `json:"replaceKeyPrefixWith,omitempty" xml:"ReplaceKeyPrefixWith,omitempty"`
+	ReplaceKeyWith       string `json:"replaceKeyWith,omitempty"       xml:"ReplaceKeyWith,omitempty"`
+}
@@ -21,6 +21,7 @@ type Storage interface {
 	GetAccessPoint(ctx context.Context, accountID, name string) (*AccessPoint, error)
 	DeleteAccessPoint(ctx context.Context, accountID, name string) error
 	ListAccessPoints(ctx context.Context, accountID, bucket string, maxResults int, nextToken string) ([]*AccessPoint, string, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -116,6 +117,17 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.PublicAccessBlocks = make(map[string]*PublicAccessBlockConfiguration)
+	s.AccessPoints = make(map[string]map[string]*AccessPoint)
+
+	return nil
+}
+
 // GetPublicAccessBlock retrieves the public access block configuration for an account.
 func (s *MemoryStorage) GetPublicAccessBlock(_ context.Context, accountID string) (*PublicAccessBlockConfiguration, error) {
 	s.mu.RLock()
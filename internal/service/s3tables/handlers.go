@@ -77,7 +77,9 @@ func (s *Service) GetTableBucket(w http.ResponseWriter, r *http.Request) {
 
 	writeJSON(w, &GetTableBucketResponse{
 		Arn:       bucket.Arn,
+		ID:        bucket.ID,
 		Name:      bucket.Name,
+		Type:      bucket.Type,
 		OwnerID:   bucket.OwnerID,
 		CreatedAt: bucket.CreatedAt,
 	})
@@ -205,8 +207,9 @@ func (s *Service) ListNamespaces(w http.ResponseWriter, r *http.Request) {
 	}
 
 	prefix := r.URL.Query().Get("prefix")
+	continuationToken := r.URL.Query().Get("continuationToken")
 
-	namespaces, err := s.storage.ListNamespaces(r.Context(), tableBucketArn, prefix, maxNamespaces)
+	namespaces, nextToken, err := s.storage.ListNamespaces(r.Context(), tableBucketArn, prefix, continuationToken, maxNamespaces)
 	if err != nil {
 		handleError(w, err)
 
@@ -214,7 +217,8 @@ func (s *Service) ListNamespaces(w http.ResponseWriter, r *http.Request) {
 	}
 
 	writeJSON(w, &ListNamespacesResponse{
-		Namespaces: namespaces,
+		Namespaces:        namespaces,
+		ContinuationToken: nextToken,
 	})
 }
 
@@ -246,7 +250,7 @@ func (s *Service) CreateTable(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	table, err := s.storage.CreateTable(r.Context(), tableBucketArn, namespace, req.Name, req.Format)
+	table, err := s.storage.CreateTable(r.Context(), tableBucketArn, namespace, req.Name, req.Format, req.Metadata)
 	if err != nil {
 		handleError(w, err)
 
@@ -268,7 +272,9 @@ func (s *Service) DeleteTable(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := s.storage.DeleteTable(r.Context(), tableBucketArn, namespace, tableName); err != nil {
+	versionToken := r.URL.Query().Get("versionToken")
+
+	if err := s.storage.DeleteTable(r.Context(), tableBucketArn, namespace, tableName, versionToken); err != nil {
 		handleError(w, err)
 
 		return
@@ -278,14 +284,18 @@ func (s *Service) DeleteTable(w http.ResponseWriter, r *http.Request) {
 }
 
 // GetTable handles the GetTable operation.
-// SDK sends: GET /get-table?tableBucketARN=...&namespace=...&name=...
+// SDK sends: GET /get-table?tableBucketARN=...&namespace=...&name=... or GET /get-table?tableArn=...
 func (s *Service) GetTable(w http.ResponseWriter, r *http.Request) {
 	tableBucketArn := r.URL.Query().Get("tableBucketARN")
 	namespace := r.URL.Query().Get("namespace")
 	tableName := r.URL.Query().Get("name")
 
+	if tableArn := r.URL.Query().Get("tableArn"); tableArn != "" {
+		tableBucketArn, namespace, tableName = parseTableARN(tableArn)
+	}
+
 	if tableBucketArn == "" || namespace == "" || tableName == "" {
-		writeError(w, http.StatusBadRequest, errBadRequest, "Table bucket ARN, namespace, and table name are required")
+		writeError(w, http.StatusBadRequest, errBadRequest, "Table ARN, or table bucket ARN, namespace, and table name are required")
 
 		return
 	}
@@ -315,9 +325,37 @@ func (s *Service) GetTable(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// GetTableMetadataLocation handles the GetTableMetadataLocation operation.
+func (s *Service) GetTableMetadataLocation(w http.ResponseWriter, r *http.Request) {
+	tableBucketArn, namespace, tableName := extractFullTableParams(getURLPath(r))
+	if tableBucketArn == "" || namespace == "" || tableName == "" {
+		writeError(w, http.StatusBadRequest, errBadRequest, "Table bucket ARN, namespace, and table name are required")
+
+		return
+	}
+
+	table, err := s.storage.GetTable(r.Context(), tableBucketArn, namespace, tableName)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	writeJSON(w, &GetTableMetadataLocationResponse{
+		VersionToken:      table.VersionToken,
+		MetadataLocation:  table.MetadataLocation,
+		WarehouseLocation: table.WarehouseLocation,
+	})
+}
+
 // ListTables handles the ListTables operation.
+// SDK sends: GET /tables/{tableBucketARN}?namespace=...
 func (s *Service) ListTables(w http.ResponseWriter, r *http.Request) {
 	tableBucketArn, namespace := extractTablePathParams(getURLPath(r))
+	if namespace == "" {
+		namespace = r.URL.Query().Get("namespace")
+	}
+
 	if tableBucketArn == "" {
 		writeError(w, http.StatusBadRequest, errBadRequest, "Table bucket ARN is required")
 
@@ -333,8 +371,9 @@ func (s *Service) ListTables(w http.ResponseWriter, r *http.Request) {
 	}
 
 	prefix := r.URL.Query().Get("prefix")
+	continuationToken := r.URL.Query().Get("continuationToken")
 
-	tables, err := s.storage.ListTables(r.Context(), tableBucketArn, namespace, prefix, maxTables)
+	tables, nextToken, err := s.storage.ListTables(r.Context(), tableBucketArn, namespace, prefix, continuationToken, maxTables)
 	if err != nil {
 		handleError(w, err)
 
@@ -342,7 +381,8 @@ func (s *Service) ListTables(w http.ResponseWriter, r *http.Request) {
 	}
 
 	writeJSON(w, &ListTablesResponse{
-		Tables: tables,
+		Tables:            tables,
+		ContinuationToken: nextToken,
 	})
 }
 
@@ -470,6 +510,23 @@ func extractFullTableParams(path string) (string, string, string) {
 	return "", "", ""
 }
 
+// parseTableARN splits a table ARN into its table bucket ARN, namespace, and
+// table name.
+func parseTableARN(tableArn string) (string, string, string) {
+	// ARN format: arn:aws:s3tables:region:account:bucket/bucket-name/table/namespace/table-name
+	tableBucketArn, rest, found := strings.Cut(tableArn, "/table/")
+	if !found {
+		return "", "", ""
+	}
+
+	namespace, tableName, found := strings.Cut(rest, "/")
+	if !found {
+		return "", "", ""
+	}
+
+	return tableBucketArn, namespace, tableName
+}
+
 // writeJSON writes a JSON response.
 func writeJSON(w http.ResponseWriter, v any) {
 	w.Header().Set("Content-Type", "application/json")
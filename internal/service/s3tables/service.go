@@ -2,9 +2,9 @@
 package s3tables
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"os"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -13,12 +13,14 @@ import (
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"s3tables"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
 
 // Service implements the S3 Tables service.
@@ -55,6 +57,7 @@ func (s *Service) RegisterRoutes(r service.Router) {
 	r.HandleFunc("PUT", "/tables/{tableBucketARN}/{namespace}", s.CreateTable)
 	r.HandleFunc("DELETE", "/tables/{tableBucketARN}/{namespace}/{tableName}", s.DeleteTable)
 	r.HandleFunc("GET", "/get-table", s.GetTable)
+	r.HandleFunc("GET", "/tables/{tableBucketARN}/{namespace}/{tableName}/metadata-location", s.GetTableMetadataLocation)
 	r.HandleFunc("GET", "/tables/{tableBucketARN}/{namespace}", s.ListTables)
 	r.HandleFunc("GET", "/tables/{tableBucketARN}", s.ListTables)
 }
@@ -69,3 +72,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
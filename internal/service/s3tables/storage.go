@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -31,13 +32,14 @@ type Storage interface {
 	CreateNamespace(ctx context.Context, tableBucketArn string, namespace []string) (*Namespace, error)
 	DeleteNamespace(ctx context.Context, tableBucketArn, namespace string) error
 	GetNamespace(ctx context.Context, tableBucketArn, namespace string) (*Namespace, error)
-	ListNamespaces(ctx context.Context, tableBucketArn, prefix string, maxNamespaces int) ([]NamespaceSummary, error)
+	ListNamespaces(ctx context.Context, tableBucketArn, prefix, continuationToken string, maxNamespaces int) ([]NamespaceSummary, string, error)
 
 	// Table operations
-	CreateTable(ctx context.Context, tableBucketArn, namespace, name, format string) (*Table, error)
-	DeleteTable(ctx context.Context, tableBucketArn, namespace, name string) error
+	CreateTable(ctx context.Context, tableBucketArn, namespace, name, format string, metadata *TableMetadata) (*Table, error)
+	DeleteTable(ctx context.Context, tableBucketArn, namespace, name, versionToken string) error
 	GetTable(ctx context.Context, tableBucketArn, namespace, name string) (*Table, error)
-	ListTables(ctx context.Context, tableBucketArn, namespace, prefix string, maxTables int) ([]TableSummary, error)
+	ListTables(ctx context.Context, tableBucketArn, namespace, prefix, continuationToken string, maxTables int) ([]TableSummary, string, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -139,6 +141,18 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.TableBuckets = make(map[string]*TableBucket)
+	s.Namespaces = make(map[string]map[string]*Namespace)
+	s.Tables = make(map[string]map[string]*Table)
+
+	return nil
+}
+
 // CreateTableBucket creates a new table bucket.
 func (s *MemoryStorage) CreateTableBucket(_ context.Context, name string) (*TableBucket, error) {
 	s.mu.Lock()
@@ -244,37 +258,9 @@ func (s *MemoryStorage) ListTableBuckets(_ context.Context, prefix, continuation
 	// Sort by name for consistent ordering
 	sortTableBucketSummaries(allBuckets)
 
-	// Apply continuation token (skip buckets until we find the marker)
-	startIdx := 0
-
-	if continuationToken != "" {
-		for i, bucket := range allBuckets {
-			if bucket.Name == continuationToken {
-				startIdx = i + 1
-
-				break
-			}
-		}
-	}
-
-	// Apply pagination
-	if startIdx >= len(allBuckets) {
-		return []TableBucketSummary{}, "", nil
-	}
-
-	endIdx := startIdx + maxBuckets
-	if endIdx > len(allBuckets) {
-		endIdx = len(allBuckets)
-	}
-
-	result := allBuckets[startIdx:endIdx]
-
-	// Set next continuation token if there are more results
-	var nextToken string
-
-	if endIdx < len(allBuckets) {
-		nextToken = result[len(result)-1].Name
-	}
+	result, nextToken := paginate(allBuckets, continuationToken, maxBuckets, func(b TableBucketSummary) string {
+		return b.Name
+	})
 
 	return result, nextToken, nil
 }
@@ -373,13 +359,13 @@ func (s *MemoryStorage) GetNamespace(_ context.Context, tableBucketArn, namespac
 	return ns, nil
 }
 
-// ListNamespaces lists all namespaces in a table bucket.
-func (s *MemoryStorage) ListNamespaces(_ context.Context, tableBucketArn, prefix string, maxNamespaces int) ([]NamespaceSummary, error) {
+// ListNamespaces lists the namespaces in a table bucket with pagination support.
+func (s *MemoryStorage) ListNamespaces(_ context.Context, tableBucketArn, prefix, continuationToken string, maxNamespaces int) ([]NamespaceSummary, string, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	if _, exists := s.TableBuckets[tableBucketArn]; !exists {
-		return nil, &Error{
+		return nil, "", &Error{
 			Code:    errNotFound,
 			Message: fmt.Sprintf("Table bucket '%s' not found", tableBucketArn),
 		}
@@ -403,17 +389,31 @@ func (s *MemoryStorage) ListNamespaces(_ context.Context, tableBucketArn, prefix
 			CreatedBy: ns.CreatedBy,
 			OwnerID:   ns.OwnerID,
 		})
+	}
+
+	sort.Slice(namespaces, func(i, j int) bool {
+		return namespaceKey(namespaces[i]) < namespaceKey(namespaces[j])
+	})
 
-		if len(namespaces) >= maxNamespaces {
-			break
+	result, nextToken := paginate(namespaces, continuationToken, maxNamespaces, namespaceKey)
+
+	return result, nextToken, nil
+}
+
+// CreateTable creates a new table. The table gets its own warehouse location,
+// and when Iceberg metadata is given, the location of its first metadata file.
+func (s *MemoryStorage) CreateTable(_ context.Context, tableBucketArn, namespace, name, format string, metadata *TableMetadata) (*Table, error) {
+	if format != tableFormatIceberg {
+		return nil, &Error{
+			Code:    errBadRequest,
+			Message: fmt.Sprintf("Unsupported table format '%s'", format),
 		}
 	}
 
-	return namespaces, nil
-}
+	if err := validateTableMetadata(metadata); err != nil {
+		return nil, err
+	}
 
-// CreateTable creates a new table.
-func (s *MemoryStorage) CreateTable(_ context.Context, tableBucketArn, namespace, name, format string) (*Table, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -451,19 +451,32 @@ func (s *MemoryStorage) CreateTable(_ context.Context, tableBucketArn, namespace
 	now := time.Now().UTC()
 	versionToken := uuid.New().String()
 
+	// Every table is backed by its own S3 bucket, addressed by an alias
+	// ending in --table-s3.
+	warehouseLocation := fmt.Sprintf("s3://%s--table-s3", strings.ReplaceAll(uuid.New().String(), "-", ""))
+
+	var metadataLocation string
+
+	if metadata != nil {
+		metadataLocation = fmt.Sprintf("%s/metadata/00000-%s.metadata.json", warehouseLocation, uuid.New().String())
+	}
+
 	table := &Table{
-		Arn:            tableArn,
-		Name:           name,
-		Namespace:      namespace,
-		TableBucketArn: tableBucketArn,
-		Type:           "customer",
-		Format:         format,
-		VersionToken:   versionToken,
-		CreatedAt:      now,
-		CreatedBy:      defaultAccountID,
-		ModifiedAt:     now,
-		ModifiedBy:     defaultAccountID,
-		OwnerID:        defaultAccountID,
+		Arn:               tableArn,
+		Name:              name,
+		Namespace:         namespace,
+		TableBucketArn:    tableBucketArn,
+		Type:              "customer",
+		Format:            format,
+		VersionToken:      versionToken,
+		MetadataLocation:  metadataLocation,
+		WarehouseLocation: warehouseLocation,
+		Metadata:          metadata,
+		CreatedAt:         now,
+		CreatedBy:         defaultAccountID,
+		ModifiedAt:        now,
+		ModifiedBy:        defaultAccountID,
+		OwnerID:           defaultAccountID,
 	}
 
 	s.Tables[tableKey][name] = table
@@ -471,8 +484,9 @@ func (s *MemoryStorage) CreateTable(_ context.Context, tableBucketArn, namespace
 	return table, nil
 }
 
-// DeleteTable deletes a table.
-func (s *MemoryStorage) DeleteTable(_ context.Context, tableBucketArn, namespace, name string) error {
+// DeleteTable deletes a table. A non-empty version token must match the
+// table's current version token.
+func (s *MemoryStorage) DeleteTable(_ context.Context, tableBucketArn, namespace, name, versionToken string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -484,13 +498,22 @@ func (s *MemoryStorage) DeleteTable(_ context.Context, tableBucketArn, namespace
 	}
 
 	tableKey := tableBucketArn + "/" + namespace
-	if _, exists := s.Tables[tableKey][name]; !exists {
+
+	table, exists := s.Tables[tableKey][name]
+	if !exists {
 		return &Error{
 			Code:    errNotFound,
 			Message: fmt.Sprintf("Table '%s' not found in namespace '%s'", name, namespace),
 		}
 	}
 
+	if versionToken != "" && versionToken != table.VersionToken {
+		return &Error{
+			Code:    errConflict,
+			Message: "Provided version token does not match the table version token",
+		}
+	}
+
 	delete(s.Tables[tableKey], name)
 
 	return nil
@@ -521,55 +544,38 @@ func (s *MemoryStorage) GetTable(_ context.Context, tableBucketArn, namespace, n
 	return table, nil
 }
 
-// ListTables lists all tables in a namespace.
-func (s *MemoryStorage) ListTables(_ context.Context, tableBucketArn, namespace, prefix string, maxTables int) ([]TableSummary, error) {
+// ListTables lists the tables in a table bucket, or in one of its namespaces,
+// with pagination support.
+func (s *MemoryStorage) ListTables(_ context.Context, tableBucketArn, namespace, prefix, continuationToken string, maxTables int) ([]TableSummary, string, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	if _, exists := s.TableBuckets[tableBucketArn]; !exists {
-		return nil, &Error{
+		return nil, "", &Error{
 			Code:    errNotFound,
 			Message: fmt.Sprintf("Table bucket '%s' not found", tableBucketArn),
 		}
 	}
 
-	if maxTables <= 0 {
-		maxTables = defaultMaxItems
-	}
-
 	if namespace != "" {
-		return s.listTablesInNamespace(tableBucketArn, namespace, prefix, maxTables), nil
-	}
-
-	return s.listTablesAcrossNamespaces(tableBucketArn, prefix, maxTables), nil
-}
-
-// listTablesInNamespace lists tables in a specific namespace.
-func (s *MemoryStorage) listTablesInNamespace(tableBucketArn, namespace, prefix string, maxTables int) []TableSummary {
-	tables := make([]TableSummary, 0)
-	tableKey := tableBucketArn + "/" + namespace
-
-	for _, table := range s.Tables[tableKey] {
-		if prefix != "" && !strings.HasPrefix(table.Name, prefix) {
-			continue
-		}
-
-		tables = append(tables, tableToSummary(table))
-
-		if len(tables) >= maxTables {
-			break
+		if _, exists := s.Namespaces[tableBucketArn][namespace]; !exists {
+			return nil, "", &Error{
+				Code:    errNotFound,
+				Message: fmt.Sprintf("Namespace '%s' not found", namespace),
+			}
 		}
 	}
 
-	return tables
-}
+	if maxTables <= 0 {
+		maxTables = defaultMaxItems
+	}
 
-// listTablesAcrossNamespaces lists tables across all namespaces.
-func (s *MemoryStorage) listTablesAcrossNamespaces(tableBucketArn, prefix string, maxTables int) []TableSummary {
 	tables := make([]TableSummary, 0)
 
+	keyPrefix := tableBucketArn + "/"
+
 	for key, tablemap := range s.Tables {
-		if !strings.HasPrefix(key, tableBucketArn+"/") {
+		if !strings.HasPrefix(key, keyPrefix) || (namespace != "" && key != keyPrefix+namespace) {
 			continue
 		}
 
@@ -579,14 +585,16 @@ func (s *MemoryStorage) listTablesAcrossNamespaces(tableBucketArn, prefix string
 			}
 
 			tables = append(tables, tableToSummary(table))
-
-			if len(tables) >= maxTables {
-				return tables
-			}
 		}
 	}
 
-	return tables
+	sort.Slice(tables, func(i, j int) bool {
+		return tableKey(tables[i]) < tableKey(tables[j])
+	})
+
+	result, nextToken := paginate(tables, continuationToken, maxTables, tableKey)
+
+	return result, nextToken, nil
 }
 
 // tableToSummary converts a Table to TableSummary.
@@ -612,6 +620,104 @@ func extractBucketNameFromArn(arn string) string {
 	return ""
 }
 
+// icebergPrimitiveTypes are the Iceberg primitive types a schema field may use
+// besides the parameterized decimal(P,S) and fixed[L].
+var icebergPrimitiveTypes = map[string]bool{
+	"boolean":     true,
+	"int":         true,
+	"long":        true,
+	"float":       true,
+	"double":      true,
+	"date":        true,
+	"time":        true,
+	"timestamp":   true,
+	"timestamptz": true,
+	"string":      true,
+	"uuid":        true,
+	"binary":      true,
+}
+
+// validateTableMetadata checks that the Iceberg schema of the table metadata,
+// if any, has uniquely named fields of known types.
+func validateTableMetadata(metadata *TableMetadata) error {
+	if metadata == nil {
+		return nil
+	}
+
+	if metadata.Iceberg == nil {
+		return &Error{Code: errBadRequest, Message: "Table metadata must contain an Iceberg schema"}
+	}
+
+	fields := metadata.Iceberg.Schema.Fields
+	if len(fields) == 0 {
+		return &Error{Code: errBadRequest, Message: "Iceberg schema must contain at least one field"}
+	}
+
+	seen := make(map[string]bool, len(fields))
+
+	for _, field := range fields {
+		if field.Name == "" {
+			return &Error{Code: errBadRequest, Message: "Iceberg schema field name is required"}
+		}
+
+		if seen[field.Name] {
+			return &Error{Code: errBadRequest, Message: fmt.Sprintf("Duplicate Iceberg schema field '%s'", field.Name)}
+		}
+
+		seen[field.Name] = true
+
+		fieldType := strings.ToLower(field.Type)
+		if !icebergPrimitiveTypes[fieldType] && !strings.HasPrefix(fieldType, "decimal(") && !strings.HasPrefix(fieldType, "fixed[") {
+			return &Error{Code: errBadRequest, Message: fmt.Sprintf("Unsupported type '%s' for Iceberg schema field '%s'", field.Type, field.Name)}
+		}
+	}
+
+	return nil
+}
+
+// namespaceKey returns the dotted name of a namespace, which orders
+// namespaces and serves as their continuation token.
+func namespaceKey(ns NamespaceSummary) string {
+	return strings.Join(ns.Namespace, ".")
+}
+
+// tableKey returns the namespace-qualified name of a table, which orders
+// tables and serves as their continuation token.
+func tableKey(table TableSummary) string {
+	return strings.Join(table.Namespace, ".") + "/" + table.Name
+}
+
+// paginate returns the page of sorted items following the item whose key is
+// the continuation token, and the token for the next page, if any.
+func paginate[T any](items []T, continuationToken string, maxItems int, key func(T) string) ([]T, string) {
+	startIdx := 0
+
+	if continuationToken != "" {
+		for i, item := range items {
+			if key(item) == continuationToken {
+				startIdx = i + 1
+
+				break
+			}
+		}
+	}
+
+	if startIdx >= len(items) {
+		return []T{}, ""
+	}
+
+	endIdx := min(startIdx+maxItems, len(items))
+	result := items[startIdx:endIdx]
+
+	var nextToken string
+
+	if endIdx < len(items) {
+		nextToken = key(result[len(result)-1])
+	}
+
+	return result, nextToken
+}
+
 // sortTableBucketSummaries sorts table bucket summaries by name in ascending order.
 func sortTableBucketSummaries(buckets []TableBucketSummary) {
 	for i := range len(buckets) {
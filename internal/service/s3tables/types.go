@@ -15,20 +15,43 @@ type TableBucket struct {
 
 // Table represents an S3 table.
 type Table struct {
-	Arn               string    `json:"tableARN"` //nolint:tagliatelle // AWS API uses tableARN
-	Name              string    `json:"name"`
-	Namespace         string    `json:"namespace"`
-	TableBucketArn    string    `json:"tableBucketARN"` //nolint:tagliatelle // AWS API uses tableBucketARN
-	Type              string    `json:"type"`
-	Format            string    `json:"format"`
-	VersionToken      string    `json:"versionToken"`
-	MetadataLocation  string    `json:"metadataLocation,omitempty"`
-	WarehouseLocation string    `json:"warehouseLocation,omitempty"`
-	CreatedAt         time.Time `json:"createdAt"`
-	CreatedBy         string    `json:"createdBy"`
-	ModifiedAt        time.Time `json:"modifiedAt"`
-	ModifiedBy        string    `json:"modifiedBy"`
-	OwnerID           string    `json:"ownerAccountId"`
+	Arn               string         `json:"tableARN"` //nolint:tagliatelle // AWS API uses tableARN
+	Name              string         `json:"name"`
+	Namespace         string         `json:"namespace"`
+	TableBucketArn    string         `json:"tableBucketARN"` //nolint:tagliatelle // AWS API uses tableBucketARN
+	Type              string         `json:"type"`
+	Format            string         `json:"format"`
+	VersionToken      string         `json:"versionToken"`
+	MetadataLocation  string         `json:"metadataLocation,omitempty"`
+	WarehouseLocation string         `json:"warehouseLocation,omitempty"`
+	Metadata          *TableMetadata `json:"metadata,omitempty"`
+	CreatedAt         time.Time      `json:"createdAt"`
+	CreatedBy         string         `json:"createdBy"`
+	ModifiedAt        time.Time      `json:"modifiedAt"`
+	ModifiedBy        string         `json:"modifiedBy"`
+	OwnerID           string         `json:"ownerAccountId"`
+}
+
+// TableMetadata represents the metadata of a table.
+type TableMetadata struct {
+	Iceberg *IcebergMetadata `json:"iceberg,omitempty"`
+}
+
+// IcebergMetadata represents the metadata of an Iceberg table.
+type IcebergMetadata struct {
+	Schema IcebergSchema `json:"schema"`
+}
+
+// IcebergSchema represents the schema of an Iceberg table.
+type IcebergSchema struct {
+	Fields []SchemaField `json:"fields"`
+}
+
+// SchemaField represents a column of an Iceberg table schema.
+type SchemaField struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Required bool   `json:"required,omitempty"`
 }
 
 // Namespace represents an S3 Tables namespace.
@@ -63,7 +86,9 @@ type GetTableBucketRequest struct {
 // GetTableBucketResponse represents a GetTableBucket response.
 type GetTableBucketResponse struct {
 	Arn       string    `json:"arn"`
+	ID        string    `json:"tableBucketId"`
 	Name      string    `json:"name"`
+	Type      string    `json:"type"`
 	OwnerID   string    `json:"ownerAccountId"`
 	CreatedAt time.Time `json:"createdAt"`
 }
@@ -148,10 +173,11 @@ type NamespaceSummary struct {
 
 // CreateTableRequest represents a CreateTable request.
 type CreateTableRequest struct {
-	TableBucketArn string `json:"tableBucketARN"` //nolint:tagliatelle // AWS API uses tableBucketARN
-	Namespace      string `json:"namespace"`
-	Name           string `json:"name"`
-	Format         string `json:"format"`
+	TableBucketArn string         `json:"tableBucketARN"` //nolint:tagliatelle // AWS API uses tableBucketARN
+	Namespace      string         `json:"namespace"`
+	Name           string         `json:"name"`
+	Format         string         `json:"format"`
+	Metadata       *TableMetadata `json:"metadata,omitempty"`
 }
 
 // CreateTableResponse represents a CreateTable response.
@@ -193,6 +219,13 @@ type GetTableResponse struct {
 	OwnerID           string    `json:"ownerAccountId"`
 }
 
+// GetTableMetadataLocationResponse represents a GetTableMetadataLocation response.
+type GetTableMetadataLocationResponse struct {
+	VersionToken      string `json:"versionToken"`
+	MetadataLocation  string `json:"metadataLocation,omitempty"`
+	WarehouseLocation string `json:"warehouseLocation"`
+}
+
 // ListTablesRequest represents a ListTables request.
 type ListTablesRequest struct {
 	TableBucketArn    string `json:"tableBucketARN"` //nolint:tagliatelle // AWS API uses tableBucketARN
@@ -229,6 +262,11 @@ func (e *Error) Error() string {
 	return e.Message
 }
 
+// Table formats.
+const (
+	tableFormatIceberg = "ICEBERG"
+)
+
 // Error codes.
 const (
 	errNotFound      = "NotFoundException"
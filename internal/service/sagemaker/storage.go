@@ -53,6 +53,7 @@ type Storage interface {
 	CreateEndpoint(ctx context.Context, req *CreateEndpointRequest) (*Endpoint, error)
 	DeleteEndpoint(ctx context.Context, name string) error
 	DescribeEndpoint(ctx context.Context, name string) (*Endpoint, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -164,6 +165,19 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.NotebookInstances = make(map[string]*NotebookInstance)
+	m.TrainingJobs = make(map[string]*TrainingJob)
+	m.Models = make(map[string]*Model)
+	m.Endpoints = make(map[string]*Endpoint)
+
+	return nil
+}
+
 // CreateNotebookInstance creates a new notebook instance.
 func (m *MemoryStorage) CreateNotebookInstance(_ context.Context, req *CreateNotebookInstanceRequest) (*NotebookInstance, error) {
 	m.mu.Lock()
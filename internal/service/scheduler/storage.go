@@ -39,6 +39,7 @@ type Storage interface {
 	GetScheduleGroup(ctx context.Context, name string) (*ScheduleGroup, error)
 	DeleteScheduleGroup(ctx context.Context, name string) error
 	ListScheduleGroups(ctx context.Context, limit int32) ([]*ScheduleGroup, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -84,13 +85,7 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		_ = storage.Load(ms.dataDir, "scheduler", ms)
 	}
 
-	// Create default schedule group.
-	ms.ScheduleGroups[defaultGroupName] = &ScheduleGroup{
-		Name:         defaultGroupName,
-		ARN:          generateScheduleGroupARN(defaultRegion, defaultAccountID, defaultGroupName),
-		State:        ScheduleGroupStateActive,
-		CreationDate: time.Now(),
-	}
+	ms.createDefaultScheduleGroup()
 
 	return ms
 }
@@ -147,6 +142,28 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Schedules = make(map[string]*Schedule)
+	m.ScheduleGroups = make(map[string]*ScheduleGroup)
+	m.createDefaultScheduleGroup()
+
+	return nil
+}
+
+// createDefaultScheduleGroup creates the default schedule group.
+func (m *MemoryStorage) createDefaultScheduleGroup() {
+	m.ScheduleGroups[defaultGroupName] = &ScheduleGroup{
+		Name:         defaultGroupName,
+		ARN:          generateScheduleGroupARN(defaultRegion, defaultAccountID, defaultGroupName),
+		State:        ScheduleGroupStateActive,
+		CreationDate: time.Now(),
+	}
+}
+
 // CreateSchedule creates a new schedule.
 func (m *MemoryStorage) CreateSchedule(_ context.Context, name string, req *CreateScheduleRequest) (*Schedule, error) {
 	m.mu.Lock()
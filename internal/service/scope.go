@@ -0,0 +1,41 @@
+package service
+
+import "context"
+
+// Default account and region of requests that carry no scope, such as those
+// served before the server attaches one or work done in the background.
+const (
+	DefaultAccountID = "000000000000"
+	DefaultRegion    = "us-east-1"
+)
+
+type scopeKey struct{}
+
+// scope is the AWS account and region a request is made against.
+type scope struct {
+	accountID string
+	region    string
+}
+
+// WithScope returns a context for requests made against the given account and region.
+func WithScope(ctx context.Context, accountID, region string) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope{accountID: accountID, region: region})
+}
+
+// AccountID returns the account ID of the request, or DefaultAccountID if it has none.
+func AccountID(ctx context.Context) string {
+	if s, ok := ctx.Value(scopeKey{}).(scope); ok && s.accountID != "" {
+		return s.accountID
+	}
+
+	return DefaultAccountID
+}
+
+// Region returns the region of the request, or DefaultRegion if it has none.
+func Region(ctx context.Context) string {
+	if s, ok := ctx.Value(scopeKey{}).(scope); ok && s.region != "" {
+		return s.region
+	}
+
+	return DefaultRegion
+}
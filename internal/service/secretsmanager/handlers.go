@@ -25,6 +25,9 @@ const (
 	errInvalidParameter     = "InvalidParameterException"
 	errInternalServiceError = "InternalServiceError"
 	errInvalidAction        = "InvalidAction"
+	errInvalidRequest       = "InvalidRequestException"
+	errMalformedPolicy      = "MalformedPolicyDocumentException"
+	errPublicPolicy         = "PublicPolicyException"
 )
 
 // CreateSecret handles the CreateSecret action.
@@ -484,6 +487,16 @@ func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
 		s.UpdateSecret(w, r)
 	case "GetRandomPassword":
 		s.GetRandomPassword(w, r)
+	case "UpdateSecretVersionStage":
+		s.UpdateSecretVersionStage(w, r)
+	case "RotateSecret":
+		s.RotateSecret(w, r)
+	case "PutResourcePolicy":
+		s.PutResourcePolicy(w, r)
+	case "GetResourcePolicy":
+		s.GetResourcePolicy(w, r)
+	case "DeleteResourcePolicy":
+		s.DeleteResourcePolicy(w, r)
 	default:
 		writeSecretsManagerError(w, errInvalidAction, "The action "+action+" is not valid", http.StatusBadRequest)
 	}
@@ -0,0 +1,216 @@
+package secretsmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+// policyDocument is the part of an IAM policy document that is validated.
+type policyDocument struct {
+	Version   string           `json:"Version"`
+	Statement policyStatements `json:"Statement"`
+}
+
+// policyStatements accepts a single statement or a list of statements.
+type policyStatements []policyStatement
+
+// UnmarshalJSON decodes a statement object or an array of statements.
+func (p *policyStatements) UnmarshalJSON(data []byte) error {
+	var single policyStatement
+	if err := json.Unmarshal(data, &single); err == nil {
+		*p = policyStatements{single}
+
+		return nil
+	}
+
+	var list []policyStatement
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("failed to unmarshal policy statements: %w", err)
+	}
+
+	*p = list
+
+	return nil
+}
+
+// policyStatement is a statement of a policy document.
+type policyStatement struct {
+	Effect    string          `json:"Effect"`
+	Principal json.RawMessage `json:"Principal"`
+	Action    json.RawMessage `json:"Action"`
+	Condition json.RawMessage `json:"Condition"`
+}
+
+// validatePolicyDocument checks that a resource policy is a JSON policy
+// document whose statements each have an effect, a principal and an action,
+// and returns a description of the first problem found.
+func validatePolicyDocument(policy string) (*policyDocument, string) {
+	var doc policyDocument
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, "The resource policy is not a valid JSON document."
+	}
+
+	if len(doc.Statement) == 0 {
+		return nil, "The resource policy must contain at least one statement."
+	}
+
+	for _, stmt := range doc.Statement {
+		if stmt.Effect != "Allow" && stmt.Effect != "Deny" {
+			return nil, "Each policy statement must have an Effect of Allow or Deny."
+		}
+
+		if len(stmt.Principal) == 0 {
+			return nil, "Each policy statement in a resource policy must have a Principal."
+		}
+
+		if len(stmt.Action) == 0 {
+			return nil, "Each policy statement must have an Action."
+		}
+	}
+
+	return &doc, ""
+}
+
+// grantsPublicAccess reports whether a policy allows access to any principal
+// without a condition that narrows it.
+func (doc *policyDocument) grantsPublicAccess() bool {
+	for _, stmt := range doc.Statement {
+		if stmt.Effect != "Allow" || len(stmt.Condition) > 0 {
+			continue
+		}
+
+		if isWildcardPrincipal(stmt.Principal) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isWildcardPrincipal reports whether a principal is "*" or {"AWS": "*"}.
+func isWildcardPrincipal(principal json.RawMessage) bool {
+	var name string
+	if err := json.Unmarshal(principal, &name); err == nil {
+		return name == "*"
+	}
+
+	var principals map[string]json.RawMessage
+	if err := json.Unmarshal(principal, &principals); err != nil {
+		return false
+	}
+
+	aws, ok := principals["AWS"]
+	if !ok {
+		return false
+	}
+
+	var arns []string
+	if err := json.Unmarshal(aws, &name); err == nil {
+		arns = []string{name}
+	} else if err := json.Unmarshal(aws, &arns); err != nil {
+		return false
+	}
+
+	return slices.Contains(arns, "*")
+}
+
+// PutResourcePolicy handles the PutResourcePolicy action.
+func (s *Service) PutResourcePolicy(w http.ResponseWriter, r *http.Request) {
+	var req PutResourcePolicyRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeSecretsManagerError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.SecretID == "" || req.ResourcePolicy == "" {
+		writeSecretsManagerError(w, errInvalidParameter, "You must provide values for the SecretId and ResourcePolicy parameters.", http.StatusBadRequest)
+
+		return
+	}
+
+	doc, problem := validatePolicyDocument(req.ResourcePolicy)
+	if doc == nil {
+		writeSecretsManagerError(w, errMalformedPolicy, problem, http.StatusBadRequest)
+
+		return
+	}
+
+	// BlockPublicPolicy defaults to true.
+	if (req.BlockPublicPolicy == nil || *req.BlockPublicPolicy) && doc.grantsPublicAccess() {
+		writeSecretsManagerError(w, errPublicPolicy, "The resource policy grants public access to the secret.", http.StatusBadRequest)
+
+		return
+	}
+
+	secret, err := s.storage.PutResourcePolicy(r.Context(), req.SecretID, req.ResourcePolicy)
+	if err != nil {
+		writeStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, PutResourcePolicyResponse{
+		ARN:  secret.ARN,
+		Name: secret.Name,
+	})
+}
+
+// GetResourcePolicy handles the GetResourcePolicy action.
+func (s *Service) GetResourcePolicy(w http.ResponseWriter, r *http.Request) {
+	var req GetResourcePolicyRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeSecretsManagerError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.SecretID == "" {
+		writeSecretsManagerError(w, errInvalidParameter, "You must provide a value for the SecretId parameter.", http.StatusBadRequest)
+
+		return
+	}
+
+	secret, policy, err := s.storage.GetResourcePolicy(r.Context(), req.SecretID)
+	if err != nil {
+		writeStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, GetResourcePolicyResponse{
+		ARN:            secret.ARN,
+		Name:           secret.Name,
+		ResourcePolicy: policy,
+	})
+}
+
+// DeleteResourcePolicy handles the DeleteResourcePolicy action.
+func (s *Service) DeleteResourcePolicy(w http.ResponseWriter, r *http.Request) {
+	var req DeleteResourcePolicyRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeSecretsManagerError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.SecretID == "" {
+		writeSecretsManagerError(w, errInvalidParameter, "You must provide a value for the SecretId parameter.", http.StatusBadRequest)
+
+		return
+	}
+
+	secret, err := s.storage.DeleteResourcePolicy(r.Context(), req.SecretID)
+	if err != nil {
+		writeStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, DeleteResourcePolicyResponse{
+		ARN:  secret.ARN,
+		Name: secret.Name,
+	})
+}
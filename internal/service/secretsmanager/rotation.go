@@ -0,0 +1,191 @@
+package secretsmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// rotationSteps are the steps of the rotation protocol, in the order the
+// rotation function is invoked with them.
+var rotationSteps = []string{"createSecret", "setSecret", "testSecret", "finishSecret"}
+
+// rotationStepTimeout bounds a single invocation of a rotation function.
+const rotationStepTimeout = 30 * time.Second
+
+// RotateSecret handles the RotateSecret action. The rotation function is
+// invoked with each rotation step in turn before the response is written, so
+// the secret is rotated once the call returns.
+func (s *Service) RotateSecret(w http.ResponseWriter, r *http.Request) {
+	var req RotateSecretRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeSecretsManagerError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.SecretID == "" {
+		writeSecretsManagerError(w, errInvalidParameter, "You must provide a value for the SecretId parameter.", http.StatusBadRequest)
+
+		return
+	}
+
+	token := req.ClientRequestToken
+	if token == "" {
+		token = uuid.New().String()
+	}
+
+	secret, err := s.storage.StartRotation(r.Context(), &req, token)
+	if err != nil {
+		writeStorageError(w, err)
+
+		return
+	}
+
+	resp := RotateSecretResponse{
+		ARN:  secret.ARN,
+		Name: secret.Name,
+	}
+
+	if req.RotateImmediately != nil && !*req.RotateImmediately {
+		writeJSONResponse(w, resp)
+
+		return
+	}
+
+	for _, step := range rotationSteps {
+		if err := s.invokeRotationStep(r.Context(), secret.RotationLambdaARN, &rotationEvent{
+			Step:               step,
+			SecretID:           secret.ARN,
+			ClientRequestToken: token,
+		}); err != nil {
+			writeSecretsManagerError(w, errInvalidRequest,
+				fmt.Sprintf("Rotation failed at the %s step: %v", step, err), http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	if _, err := s.storage.FinishRotation(r.Context(), secret.ARN, token); err != nil {
+		writeStorageError(w, err)
+
+		return
+	}
+
+	resp.VersionID = token
+
+	writeJSONResponse(w, resp)
+}
+
+// invokeRotationStep synchronously invokes a rotation function through the
+// local Lambda endpoint.
+func (s *Service) invokeRotationStep(ctx context.Context, functionARN string, event *rotationEvent) error {
+	// arn:aws:lambda:region:account:function:name[:qualifier], or a bare function name.
+	name, qualifier := functionARN, ""
+
+	if parts := strings.Split(functionARN, ":"); len(parts) >= 7 && parts[0] == "arn" {
+		name = parts[6]
+
+		if len(parts) > 7 {
+			qualifier = parts[7]
+		}
+	}
+
+	invokeURL := fmt.Sprintf("%s/lambda/2015-03-31/functions/%s/invocations", s.baseURL, url.PathEscape(name))
+	if qualifier != "" {
+		invokeURL += "?Qualifier=" + url.QueryEscape(qualifier)
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal rotation event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, rotationStepTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, invokeURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	service.MarkInternalRequest(req)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to invoke rotation function: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	payload, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("X-Amz-Function-Error") != "" {
+		return fmt.Errorf("rotation function %s returned status %d: %s", functionARN, resp.StatusCode, bytes.TrimSpace(payload))
+	}
+
+	return nil
+}
+
+// UpdateSecretVersionStage handles the UpdateSecretVersionStage action.
+func (s *Service) UpdateSecretVersionStage(w http.ResponseWriter, r *http.Request) {
+	var req UpdateSecretVersionStageRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeSecretsManagerError(w, errInvalidParameter, "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.SecretID == "" || req.VersionStage == "" {
+		writeSecretsManagerError(w, errInvalidParameter, "You must provide values for the SecretId and VersionStage parameters.", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.MoveToVersionID == "" && req.RemoveFromVersionID == "" {
+		writeSecretsManagerError(w, errInvalidParameter, "You must provide MoveToVersionId or RemoveFromVersionId.", http.StatusBadRequest)
+
+		return
+	}
+
+	secret, err := s.storage.UpdateSecretVersionStage(r.Context(), &req)
+	if err != nil {
+		writeStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, UpdateSecretVersionStageResponse{
+		ARN:  secret.ARN,
+		Name: secret.Name,
+	})
+}
+
+// writeStorageError writes the response for an error returned by the storage.
+func writeStorageError(w http.ResponseWriter, err error) {
+	var sErr *SecretError
+	if errors.As(err, &sErr) {
+		status := http.StatusBadRequest
+		if sErr.Code == errResourceNotFound {
+			status = http.StatusNotFound
+		}
+
+		writeSecretsManagerError(w, sErr.Code, sErr.Message, status)
+
+		return
+	}
+
+	writeSecretsManagerError(w, errInternalServiceError, "Internal server error", http.StatusInternalServerError)
+}
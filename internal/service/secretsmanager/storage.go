@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"slices"
 	"sort"
 	"strings"
@@ -13,15 +12,15 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/sivchari/kumo/internal/service"
 	"github.com/sivchari/kumo/internal/storage"
 )
 
 const (
-	defaultRegion         = "us-east-1"
-	defaultAccountID      = "000000000000"
 	defaultRecoveryWindow = 30
 	stageCurrent          = "AWSCURRENT"
 	stagePrevious         = "AWSPREVIOUS"
+	stagePending          = "AWSPENDING"
 )
 
 // Storage defines the Secrets Manager storage interface.
@@ -33,6 +32,13 @@ type Storage interface {
 	ListSecrets(ctx context.Context, maxResults int, nextToken string, includePlannedDeletion bool) ([]*Secret, string, error)
 	DescribeSecret(ctx context.Context, secretID string) (*Secret, error)
 	UpdateSecret(ctx context.Context, req *UpdateSecretRequest) (*Secret, *SecretVersion, error)
+	UpdateSecretVersionStage(ctx context.Context, req *UpdateSecretVersionStageRequest) (*Secret, error)
+	StartRotation(ctx context.Context, req *RotateSecretRequest, token string) (*Secret, error)
+	FinishRotation(ctx context.Context, secretID, token string) (*Secret, error)
+	PutResourcePolicy(ctx context.Context, secretID, policy string) (*Secret, error)
+	GetResourcePolicy(ctx context.Context, secretID string) (*Secret, string, error)
+	DeleteResourcePolicy(ctx context.Context, secretID string) (*Secret, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -124,8 +130,18 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Secrets = make(map[string]*Secret)
+
+	return nil
+}
+
 // CreateSecret creates a new secret.
-func (m *MemoryStorage) CreateSecret(_ context.Context, req *CreateSecretRequest) (*Secret, error) {
+func (m *MemoryStorage) CreateSecret(ctx context.Context, req *CreateSecretRequest) (*Secret, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -140,7 +156,7 @@ func (m *MemoryStorage) CreateSecret(_ context.Context, req *CreateSecretRequest
 	versionID := uuid.New().String()
 
 	secret := &Secret{
-		ARN:             m.buildARN(req.Name),
+		ARN:             m.buildARN(ctx, req.Name),
 		Name:            req.Name,
 		Description:     req.Description,
 		KmsKeyID:        req.KmsKeyID,
@@ -200,6 +216,9 @@ func (m *MemoryStorage) GetSecretValue(_ context.Context, secretID, versionID, v
 
 	if versionID != "" {
 		version = secret.VersionIDs[versionID]
+		if version != nil && versionStage != "" && !slices.Contains(version.VersionStages, versionStage) {
+			version = nil
+		}
 	} else {
 		// Find version by stage.
 		for _, v := range secret.VersionIDs {
@@ -257,35 +276,22 @@ func (m *MemoryStorage) PutSecretValue(_ context.Context, secretID, clientToken,
 		versionStages = []string{stageCurrent}
 	}
 
-	// Remove AWSCURRENT stage from previous version.
-	for _, v := range secret.VersionIDs {
-		newStages := make([]string, 0)
-
-		for _, stage := range v.VersionStages {
-			if stage != stageCurrent {
-				newStages = append(newStages, stage)
-			} else {
-				// Add AWSPREVIOUS to the old current version.
-				newStages = append(newStages, stagePrevious)
-			}
-		}
-
-		v.VersionStages = newStages
-	}
-
 	version := &SecretVersion{
-		VersionID:     versionID,
-		SecretString:  secretString,
-		SecretBinary:  secretBinary,
-		VersionStages: versionStages,
-		CreatedDate:   now,
-		KmsKeyID:      secret.KmsKeyID,
+		VersionID:    versionID,
+		SecretString: secretString,
+		SecretBinary: secretBinary,
+		CreatedDate:  now,
+		KmsKeyID:     secret.KmsKeyID,
 	}
 
 	secret.VersionIDs[versionID] = version
-	secret.VersionID = versionID
-	secret.SecretString = secretString
-	secret.SecretBinary = secretBinary
+
+	// A staging label is attached to one version at a time, so the new
+	// version takes its labels from the versions that carried them.
+	for _, stage := range versionStages {
+		attachStage(secret, stage, versionID)
+	}
+
 	secret.LastChangedDate = now
 
 	return secret, version, nil
@@ -439,40 +445,265 @@ func (m *MemoryStorage) createNewVersionIfNeeded(secret *Secret, req *UpdateSecr
 		versionID = uuid.New().String()
 	}
 
-	m.rotateVersionStages(secret)
-
 	version := &SecretVersion{
-		VersionID:     versionID,
-		SecretString:  req.SecretString,
-		SecretBinary:  req.SecretBinary,
-		VersionStages: []string{stageCurrent},
-		CreatedDate:   now,
-		KmsKeyID:      secret.KmsKeyID,
+		VersionID:    versionID,
+		SecretString: req.SecretString,
+		SecretBinary: req.SecretBinary,
+		CreatedDate:  now,
+		KmsKeyID:     secret.KmsKeyID,
 	}
 
 	secret.VersionIDs[versionID] = version
-	secret.VersionID = versionID
-	secret.SecretString = req.SecretString
-	secret.SecretBinary = req.SecretBinary
+	attachStage(secret, stageCurrent, versionID)
 
 	return version
 }
 
-// rotateVersionStages moves AWSCURRENT to AWSPREVIOUS for all versions.
-func (m *MemoryStorage) rotateVersionStages(secret *Secret) {
+// attachStage moves a staging label to a version, removing it from the version
+// that carried it. Moving AWSCURRENT also moves AWSPREVIOUS to the version
+// that was current and updates the secret's current value.
+func attachStage(secret *Secret, stage, versionID string) {
+	target := secret.VersionIDs[versionID]
+	if slices.Contains(target.VersionStages, stage) {
+		return
+	}
+
+	previous := stageOwner(secret, stage)
+	if previous != nil {
+		detachStage(previous, stage)
+	}
+
+	target.VersionStages = append(target.VersionStages, stage)
+
+	if stage != stageCurrent {
+		return
+	}
+
+	if previous != nil {
+		attachStage(secret, stagePrevious, previous.VersionID)
+	}
+
+	detachStage(target, stagePrevious)
+
+	secret.VersionID = target.VersionID
+	secret.SecretString = target.SecretString
+	secret.SecretBinary = target.SecretBinary
+}
+
+// detachStage removes a staging label from a version.
+func detachStage(version *SecretVersion, stage string) {
+	version.VersionStages = slices.DeleteFunc(version.VersionStages, func(s string) bool { return s == stage })
+}
+
+// stageOwner returns the version that carries a staging label, or nil.
+func stageOwner(secret *Secret, stage string) *SecretVersion {
 	for _, v := range secret.VersionIDs {
-		newStages := make([]string, 0)
+		if slices.Contains(v.VersionStages, stage) {
+			return v
+		}
+	}
 
-		for _, stage := range v.VersionStages {
-			if stage != stageCurrent {
-				newStages = append(newStages, stage)
-			} else {
-				newStages = append(newStages, stagePrevious)
-			}
+	return nil
+}
+
+// UpdateSecretVersionStage moves a staging label between versions of a secret.
+func (m *MemoryStorage) UpdateSecretVersionStage(_ context.Context, req *UpdateSecretVersionStageRequest) (*Secret, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	secret, err := m.findActiveSecret(req.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := stageOwner(secret, req.VersionStage)
+
+	if req.RemoveFromVersionID != "" && (owner == nil || owner.VersionID != req.RemoveFromVersionID) {
+		return nil, &SecretError{
+			Code:    "InvalidParameterException",
+			Message: fmt.Sprintf("The staging label %s is not attached to version %s.", req.VersionStage, req.RemoveFromVersionID),
+		}
+	}
+
+	if req.MoveToVersionID == "" {
+		if owner != nil && req.RemoveFromVersionID != "" {
+			detachStage(owner, req.VersionStage)
+		}
+
+		return secret, nil
+	}
+
+	if _, ok := secret.VersionIDs[req.MoveToVersionID]; !ok {
+		return nil, &SecretError{
+			Code:    "ResourceNotFoundException",
+			Message: fmt.Sprintf("Secrets Manager can't find the specified secret version: %s", req.MoveToVersionID),
+		}
+	}
+
+	if owner != nil && owner.VersionID != req.MoveToVersionID && owner.VersionID != req.RemoveFromVersionID {
+		return nil, &SecretError{
+			Code: "InvalidParameterException",
+			Message: fmt.Sprintf("The staging label %s is currently attached to version %s. You must specify it in RemoveFromVersionId.",
+				req.VersionStage, owner.VersionID),
+		}
+	}
+
+	attachStage(secret, req.VersionStage, req.MoveToVersionID)
+	secret.LastChangedDate = time.Now()
+
+	return secret, nil
+}
+
+// StartRotation stores the rotation configuration of a secret before its
+// rotation function is invoked. A rotation that left an AWSPENDING version
+// behind must be retried with the same token.
+func (m *MemoryStorage) StartRotation(_ context.Context, req *RotateSecretRequest, token string) (*Secret, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	secret, err := m.findActiveSecret(req.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	lambdaARN := req.RotationLambdaARN
+	if lambdaARN == "" {
+		lambdaARN = secret.RotationLambdaARN
+	}
+
+	if lambdaARN == "" {
+		return nil, &SecretError{
+			Code:    "InvalidRequestException",
+			Message: "No Lambda rotation function ARN is associated with this secret.",
+		}
+	}
+
+	if pending := stageOwner(secret, stagePending); pending != nil && pending.VersionID != token &&
+		!slices.Contains(pending.VersionStages, stageCurrent) {
+		return nil, &SecretError{
+			Code:    "InvalidRequestException",
+			Message: "A previous rotation isn't complete. That rotation will be reattempted.",
 		}
+	}
+
+	secret.RotationEnabled = true
+	secret.RotationLambdaARN = lambdaARN
 
-		v.VersionStages = newStages
+	if req.RotationRules != nil {
+		secret.RotationRules = req.RotationRules
 	}
+
+	secret.NextRotationDate = nextRotationDate(secret.RotationRules, time.Now())
+
+	return secret, nil
+}
+
+// FinishRotation records a completed rotation once the rotation function has
+// made the version identified by token current.
+func (m *MemoryStorage) FinishRotation(_ context.Context, secretID, token string) (*Secret, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	secret, err := m.findActiveSecret(secretID)
+	if err != nil {
+		return nil, err
+	}
+
+	version, ok := secret.VersionIDs[token]
+	if !ok || !slices.Contains(version.VersionStages, stageCurrent) {
+		return nil, &SecretError{
+			Code:    "InvalidRequestException",
+			Message: fmt.Sprintf("The rotation function didn't make version %s the %s version.", token, stageCurrent),
+		}
+	}
+
+	detachStage(version, stagePending)
+
+	now := time.Now()
+	secret.LastRotationDate = &now
+	secret.NextRotationDate = nextRotationDate(secret.RotationRules, now)
+
+	return secret, nil
+}
+
+// nextRotationDate returns when a secret rotated at from is next due for
+// rotation, or nil when its rules don't schedule one.
+func nextRotationDate(rules *RotationRules, from time.Time) *time.Time {
+	if rules == nil || rules.AutomaticallyAfterDays <= 0 {
+		return nil
+	}
+
+	next := from.AddDate(0, 0, int(rules.AutomaticallyAfterDays))
+
+	return &next
+}
+
+// PutResourcePolicy attaches a resource policy to a secret, replacing any
+// existing policy.
+func (m *MemoryStorage) PutResourcePolicy(_ context.Context, secretID, policy string) (*Secret, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	secret, err := m.findActiveSecret(secretID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret.ResourcePolicy = policy
+
+	return secret, nil
+}
+
+// GetResourcePolicy returns the resource policy attached to a secret, which
+// is empty when the secret has none.
+func (m *MemoryStorage) GetResourcePolicy(_ context.Context, secretID string) (*Secret, string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	secret := m.findSecret(secretID)
+	if secret == nil {
+		return nil, "", &SecretError{
+			Code:    "ResourceNotFoundException",
+			Message: fmt.Sprintf("Secrets Manager can't find the specified secret: %s", secretID),
+		}
+	}
+
+	return secret, secret.ResourcePolicy, nil
+}
+
+// DeleteResourcePolicy removes the resource policy from a secret.
+func (m *MemoryStorage) DeleteResourcePolicy(_ context.Context, secretID string) (*Secret, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	secret, err := m.findActiveSecret(secretID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret.ResourcePolicy = ""
+
+	return secret, nil
+}
+
+// findActiveSecret finds a secret by name or ARN that is not scheduled for deletion.
+func (m *MemoryStorage) findActiveSecret(secretID string) (*Secret, error) {
+	secret := m.findSecret(secretID)
+	if secret == nil {
+		return nil, &SecretError{
+			Code:    "ResourceNotFoundException",
+			Message: fmt.Sprintf("Secrets Manager can't find the specified secret: %s", secretID),
+		}
+	}
+
+	if secret.DeletedDate != nil {
+		return nil, &SecretError{
+			Code:    "InvalidRequestException",
+			Message: "You can't perform this operation on a secret that's scheduled for deletion.",
+		}
+	}
+
+	return secret, nil
 }
 
 // findSecret finds a secret by name or ARN.
@@ -505,15 +736,10 @@ func (m *MemoryStorage) findSecret(secretID string) *Secret {
 	return nil
 }
 
-// buildARN builds an ARN for a secret.
-func (m *MemoryStorage) buildARN(name string) string {
-	region := os.Getenv("AWS_DEFAULT_REGION")
-	if region == "" {
-		region = defaultRegion
-	}
-
+// buildARN builds an ARN for a secret in the account and region of the request.
+func (m *MemoryStorage) buildARN(ctx context.Context, name string) string {
 	suffix := uuid.New().String()[:6]
 
 	return fmt.Sprintf("arn:aws:secretsmanager:%s:%s:secret:%s-%s",
-		region, defaultAccountID, name, suffix)
+		service.Region(ctx), service.AccountID(ctx), name, suffix)
 }
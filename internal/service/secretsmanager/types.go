@@ -33,6 +33,7 @@ type Secret struct {
 	Type                           string
 	ExternalSecretRotationMetadata []ExternalSecretRotationMetadataItem
 	ExternalSecretRotationRoleArn  string
+	ResourcePolicy                 string
 }
 
 // SecretVersion represents a version of a secret.
@@ -232,6 +233,79 @@ type UpdateSecretResponse struct {
 	VersionID string `json:"VersionId,omitempty"`
 }
 
+// UpdateSecretVersionStageRequest is the request for UpdateSecretVersionStage.
+type UpdateSecretVersionStageRequest struct {
+	SecretID            string `json:"SecretId"`
+	VersionStage        string `json:"VersionStage"`
+	MoveToVersionID     string `json:"MoveToVersionId,omitempty"`
+	RemoveFromVersionID string `json:"RemoveFromVersionId,omitempty"`
+}
+
+// UpdateSecretVersionStageResponse is the response for UpdateSecretVersionStage.
+type UpdateSecretVersionStageResponse struct {
+	ARN  string `json:"ARN"`
+	Name string `json:"Name"`
+}
+
+// RotateSecretRequest is the request for RotateSecret.
+type RotateSecretRequest struct {
+	SecretID           string         `json:"SecretId"`
+	ClientRequestToken string         `json:"ClientRequestToken,omitempty"`
+	RotationLambdaARN  string         `json:"RotationLambdaARN,omitempty"`
+	RotationRules      *RotationRules `json:"RotationRules,omitempty"`
+	RotateImmediately  *bool          `json:"RotateImmediately,omitempty"`
+}
+
+// RotateSecretResponse is the response for RotateSecret.
+type RotateSecretResponse struct {
+	ARN       string `json:"ARN"`
+	Name      string `json:"Name"`
+	VersionID string `json:"VersionId,omitempty"`
+}
+
+// PutResourcePolicyRequest is the request for PutResourcePolicy.
+type PutResourcePolicyRequest struct {
+	SecretID          string `json:"SecretId"`
+	ResourcePolicy    string `json:"ResourcePolicy"`
+	BlockPublicPolicy *bool  `json:"BlockPublicPolicy,omitempty"`
+}
+
+// PutResourcePolicyResponse is the response for PutResourcePolicy.
+type PutResourcePolicyResponse struct {
+	ARN  string `json:"ARN"`
+	Name string `json:"Name"`
+}
+
+// GetResourcePolicyRequest is the request for GetResourcePolicy.
+type GetResourcePolicyRequest struct {
+	SecretID string `json:"SecretId"`
+}
+
+// GetResourcePolicyResponse is the response for GetResourcePolicy.
+type GetResourcePolicyResponse struct {
+	ARN            string `json:"ARN"`
+	Name           string `json:"Name"`
+	ResourcePolicy string `json:"ResourcePolicy,omitempty"`
+}
+
+// DeleteResourcePolicyRequest is the request for DeleteResourcePolicy.
+type DeleteResourcePolicyRequest struct {
+	SecretID string `json:"SecretId"`
+}
+
+// DeleteResourcePolicyResponse is the response for DeleteResourcePolicy.
+type DeleteResourcePolicyResponse struct {
+	ARN  string `json:"ARN"`
+	Name string `json:"Name"`
+}
+
+// rotationEvent is the event a rotation function receives for each rotation step.
+type rotationEvent struct {
+	Step               string `json:"Step"`
+	SecretID           string `json:"SecretId"`
+	ClientRequestToken string `json:"ClientRequestToken"`
+}
+
 // GetRandomPasswordRequest is the request for GetRandomPassword.
 type GetRandomPasswordRequest struct {
 	ExcludeCharacters       string `json:"ExcludeCharacters,omitempty"`
@@ -46,6 +46,7 @@ type Storage interface {
 	TagResource(ctx context.Context, resourceARN string, tags []*Tag) error
 	UntagResource(ctx context.Context, resourceARN string, tagKeys []string) error
 	ListTagsForResource(ctx context.Context, resourceARN string) ([]*Tag, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -157,6 +158,19 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.DataLakes = make(map[string]*DataLake)
+	s.Subscribers = make(map[string]*Subscriber)
+	s.LogSources = make(map[string]*LogSource)
+	s.Tags = make(map[string][]*Tag)
+
+	return nil
+}
+
 // CreateDataLake creates new data lakes.
 func (s *MemoryStorage) CreateDataLake(_ context.Context, req *CreateDataLakeRequest) ([]*DataLake, error) {
 	s.mu.Lock()
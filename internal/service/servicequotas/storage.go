@@ -60,6 +60,7 @@ type Storage interface {
 	RequestServiceQuotaIncrease(ctx context.Context, serviceCode, quotaCode string, desiredValue float64) (*QuotaChangeRequest, error)
 	GetRequestedServiceQuotaChange(ctx context.Context, requestID string) (*QuotaChangeRequest, error)
 	ListRequestedServiceQuotaChangeHistory(ctx context.Context, serviceCode, quotaCode, status string, maxResults int32, nextToken string) ([]*QuotaChangeRequest, string, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -174,6 +175,20 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Services = make(map[string]*ServiceInfo)
+	m.Quotas = make(map[string]map[string]*ServiceQuota)
+	m.DefaultQuotas = make(map[string]map[string]*ServiceQuota)
+	m.Requests = make(map[string]*QuotaChangeRequest)
+	m.initializeDefaultData()
+
+	return nil
+}
+
 // initializeDefaultData sets up predefined services and quotas.
 func (m *MemoryStorage) initializeDefaultData() {
 	m.initializeServices()
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -285,9 +286,118 @@ func (s *Service) SendEmail(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// PutSuppressedDestination handles the PutSuppressedDestination operation.
+func (s *Service) PutSuppressedDestination(w http.ResponseWriter, r *http.Request) {
+	var req PutSuppressedDestinationRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidParameter, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.PutSuppressedDestination(r.Context(), &req); err != nil {
+		writeSuppressionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, nil)
+}
+
+// GetSuppressedDestination handles the GetSuppressedDestination operation.
+func (s *Service) GetSuppressedDestination(w http.ResponseWriter, r *http.Request) {
+	emailAddress := extractPathParam(r.URL.Path, "/ses/v2/email/suppression/addresses/")
+	if emailAddress == "" {
+		writeError(w, errInvalidParameter, "EmailAddress is required", http.StatusBadRequest)
+
+		return
+	}
+
+	dest, err := s.storage.GetSuppressedDestination(r.Context(), emailAddress)
+	if err != nil {
+		writeSuppressionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, GetSuppressedDestinationResponse{
+		SuppressedDestination: &SuppressedDestinationOutput{
+			EmailAddress:   dest.EmailAddress,
+			Reason:         dest.Reason,
+			LastUpdateTime: float64(dest.LastUpdateTime.UnixMilli()) / 1000,
+		},
+	})
+}
+
+// ListSuppressedDestinations handles the ListSuppressedDestinations operation.
+func (s *Service) ListSuppressedDestinations(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	req := &ListSuppressedDestinationsRequest{
+		Reasons:   query["Reason"],
+		NextToken: query.Get("NextToken"),
+		PageSize:  parsePageSize(query.Get("PageSize")),
+	}
+
+	for name, target := range map[string]*time.Time{"StartDate": &req.StartDate, "EndDate": &req.EndDate} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			writeError(w, errBadRequest, "Invalid "+name, http.StatusBadRequest)
+
+			return
+		}
+
+		*target = t
+	}
+
+	dests, nextToken, err := s.storage.ListSuppressedDestinations(r.Context(), req)
+	if err != nil {
+		writeSuppressionError(w, err)
+
+		return
+	}
+
+	summaries := make([]SuppressedDestinationSummary, 0, len(dests))
+	for _, dest := range dests {
+		summaries = append(summaries, SuppressedDestinationSummary{
+			EmailAddress:   dest.EmailAddress,
+			Reason:         dest.Reason,
+			LastUpdateTime: float64(dest.LastUpdateTime.UnixMilli()) / 1000,
+		})
+	}
+
+	writeJSONResponse(w, ListSuppressedDestinationsResponse{
+		SuppressedDestinationSummaries: summaries,
+		NextToken:                      nextToken,
+	})
+}
+
+// DeleteSuppressedDestination handles the DeleteSuppressedDestination operation.
+func (s *Service) DeleteSuppressedDestination(w http.ResponseWriter, r *http.Request) {
+	emailAddress := extractPathParam(r.URL.Path, "/ses/v2/email/suppression/addresses/")
+	if emailAddress == "" {
+		writeError(w, errInvalidParameter, "EmailAddress is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.DeleteSuppressedDestination(r.Context(), emailAddress); err != nil {
+		writeSuppressionError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, nil)
+}
+
 // GetSentEmails handles the GetSentEmails operation.
 func (s *Service) GetSentEmails(w http.ResponseWriter, r *http.Request) {
-	emails, err := s.storage.GetSentEmails(r.Context())
+	emails, err := s.storage.GetSentEmails(r.Context(), r.URL.Query().Get("destination"))
 	if err != nil {
 		writeError(w, "InternalServiceError", "Internal server error", http.StatusInternalServerError)
 
@@ -301,6 +411,23 @@ func (s *Service) GetSentEmails(w http.ResponseWriter, r *http.Request) {
 
 // Helper functions.
 
+// writeSuppressionError writes the error response for a suppression list operation.
+func writeSuppressionError(w http.ResponseWriter, err error) {
+	var sErr *IdentityError
+	if errors.As(err, &sErr) {
+		status := http.StatusBadRequest
+		if sErr.Code == errNotFound {
+			status = http.StatusNotFound
+		}
+
+		writeError(w, sErr.Code, sErr.Message, status)
+
+		return
+	}
+
+	writeError(w, "InternalServiceError", "Internal server error", http.StatusInternalServerError)
+}
+
 // readJSONRequest reads and decodes JSON request body.
 func readJSONRequest(r *http.Request, v any) error {
 	body, err := io.ReadAll(r.Body)
@@ -1,9 +1,9 @@
 package sesv2
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"os"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -12,12 +12,14 @@ import (
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"sesv2"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
 
 // Service implements the SES v2 service.
@@ -55,6 +57,12 @@ func (s *Service) RegisterRoutes(r service.Router) {
 	// Send Email route.
 	r.HandleFunc("POST", "/ses/v2/email/outbound-emails", s.SendEmail)
 
+	// Suppression list routes.
+	r.HandleFunc("PUT", "/ses/v2/email/suppression/addresses", s.PutSuppressedDestination)
+	r.HandleFunc("GET", "/ses/v2/email/suppression/addresses", s.ListSuppressedDestinations)
+	r.HandleFunc("GET", "/ses/v2/email/suppression/addresses/{emailAddress}", s.GetSuppressedDestination)
+	r.HandleFunc("DELETE", "/ses/v2/email/suppression/addresses/{emailAddress}", s.DeleteSuppressedDestination)
+
 	// kumo-specific endpoint for testing.
 	r.HandleFunc("GET", "/kumo/ses/v2/sent-emails", s.GetSentEmails)
 }
@@ -69,3 +77,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
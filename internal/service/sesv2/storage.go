@@ -43,8 +43,15 @@ type Storage interface {
 	// Send Email.
 	SendEmail(ctx context.Context, req *SendEmailRequest) (string, error)
 
+	// Suppression list operations.
+	PutSuppressedDestination(ctx context.Context, req *PutSuppressedDestinationRequest) error
+	GetSuppressedDestination(ctx context.Context, emailAddress string) (*SuppressedDestination, error)
+	ListSuppressedDestinations(ctx context.Context, req *ListSuppressedDestinationsRequest) ([]*SuppressedDestination, string, error)
+	DeleteSuppressedDestination(ctx context.Context, emailAddress string) error
+
 	// Get sent emails (for testing purposes).
-	GetSentEmails(ctx context.Context) ([]*SentEmail, error)
+	GetSentEmails(ctx context.Context, destination string) ([]*SentEmail, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -65,10 +72,11 @@ var (
 
 // MemoryStorage implements Storage with in-memory data structures.
 type MemoryStorage struct {
-	mu                sync.RWMutex                 `json:"-"`
-	EmailIdentities   map[string]*EmailIdentity    `json:"emailIdentities"`
-	ConfigurationSets map[string]*ConfigurationSet `json:"configurationSets"`
-	SentEmails        []*SentEmail                 `json:"sentEmails"`
+	mu                sync.RWMutex                      `json:"-"`
+	EmailIdentities   map[string]*EmailIdentity         `json:"emailIdentities"`
+	ConfigurationSets map[string]*ConfigurationSet      `json:"configurationSets"`
+	SentEmails        []*SentEmail                      `json:"sentEmails"`
+	Suppressed        map[string]*SuppressedDestination `json:"suppressed"` // key: lowercased email address
 	dataDir           string
 }
 
@@ -78,6 +86,7 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		EmailIdentities:   make(map[string]*EmailIdentity),
 		ConfigurationSets: make(map[string]*ConfigurationSet),
 		SentEmails:        make([]*SentEmail, 0),
+		Suppressed:        make(map[string]*SuppressedDestination),
 	}
 	for _, o := range opts {
 		o(s)
@@ -126,6 +135,10 @@ func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
 		s.ConfigurationSets = make(map[string]*ConfigurationSet)
 	}
 
+	if s.Suppressed == nil {
+		s.Suppressed = make(map[string]*SuppressedDestination)
+	}
+
 	return nil
 }
 
@@ -142,6 +155,19 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.EmailIdentities = make(map[string]*EmailIdentity)
+	s.ConfigurationSets = make(map[string]*ConfigurationSet)
+	s.SentEmails = nil
+	s.Suppressed = make(map[string]*SuppressedDestination)
+
+	return nil
+}
+
 // CreateEmailIdentity creates a new email identity.
 func (s *MemoryStorage) CreateEmailIdentity(_ context.Context, req *CreateEmailIdentityRequest) (*EmailIdentity, error) {
 	s.mu.Lock()
@@ -380,11 +406,15 @@ func (s *MemoryStorage) SendEmail(_ context.Context, req *SendEmailRequest) (str
 		subject, body, htmlBody = extractSimpleEmailContent(req.Content.Simple)
 	}
 
+	// Recipients on the suppression list are dropped without failing the request.
+	destination, suppressed := s.removeSuppressed(destination)
+
 	// Store the sent email.
 	sentEmail := &SentEmail{
 		MessageID:            messageID,
 		FromEmailAddress:     req.FromEmailAddress,
 		Destination:          destination,
+		SuppressedRecipients: suppressed,
 		Subject:              subject,
 		Body:                 body,
 		HTMLBody:             htmlBody,
@@ -398,12 +428,43 @@ func (s *MemoryStorage) SendEmail(_ context.Context, req *SendEmailRequest) (str
 	return messageID, nil
 }
 
-// GetSentEmails returns all sent emails (for testing).
-func (s *MemoryStorage) GetSentEmails(_ context.Context) ([]*SentEmail, error) {
+// GetSentEmails returns sent emails (for testing).
+// When destination is set, only emails addressed to it via To, Cc or Bcc are returned.
+func (s *MemoryStorage) GetSentEmails(_ context.Context, destination string) ([]*SentEmail, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	return s.SentEmails, nil
+	emails := make([]*SentEmail, 0, len(s.SentEmails))
+
+	for _, email := range s.SentEmails {
+		if destination == "" || hasRecipient(email.Destination, destination) {
+			emails = append(emails, email)
+		}
+	}
+
+	return emails, nil
+}
+
+// hasRecipient reports whether address is one of the destination's recipients.
+// Addresses are compared case-insensitively and may include a display name.
+func hasRecipient(dest *Destination, address string) bool {
+	if dest == nil {
+		return false
+	}
+
+	for _, addresses := range [][]string{dest.ToAddresses, dest.CcAddresses, dest.BccAddresses} {
+		for _, a := range addresses {
+			if parsed, err := mail.ParseAddress(a); err == nil {
+				a = parsed.Address
+			}
+
+			if strings.EqualFold(a, address) {
+				return true
+			}
+		}
+	}
+
+	return false
 }
 
 // extractRawEmailDestination parses an RFC 2822 MIME message and extracts destination addresses.
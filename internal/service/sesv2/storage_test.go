@@ -38,7 +38,7 @@ func TestSendEmail_RawEmailWithoutDestination(t *testing.T) {
 	}
 
 	// Verify that the sent email was stored
-	sentEmails, err := storage.GetSentEmails(ctx)
+	sentEmails, err := storage.GetSentEmails(ctx, "")
 	if err != nil {
 		t.Fatalf("failed to get sent emails: %v", err)
 	}
@@ -105,3 +105,99 @@ func TestSendEmail_SimpleEmailWithoutDestination_ShouldFail(t *testing.T) {
 		t.Errorf("expected 'Destination is required', got '%s'", identityErr.Message)
 	}
 }
+
+func TestGetSentEmails_FilterByDestination(t *testing.T) {
+	storage := &MemoryStorage{}
+	ctx := context.Background()
+
+	destinations := []*Destination{
+		{ToAddresses: []string{"alice@example.com"}},
+		{ToAddresses: []string{"bob@example.com"}, CcAddresses: []string{"Alice <ALICE@example.com>"}},
+		{BccAddresses: []string{"carol@example.com"}},
+	}
+
+	for _, dest := range destinations {
+		_, err := storage.SendEmail(ctx, &SendEmailRequest{
+			FromEmailAddress: "sender@example.com",
+			Destination:      dest,
+			Content: &EmailContent{
+				Simple: &SimpleEmail{
+					Subject: &Content{Data: "Test"},
+					Body:    &Body{Text: &Content{Data: "Test body"}},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	tests := []struct {
+		destination string
+		want        int
+	}{
+		{destination: "", want: 3},
+		{destination: "alice@example.com", want: 2},
+		{destination: "carol@example.com", want: 1},
+		{destination: "dave@example.com", want: 0},
+	}
+
+	for _, tt := range tests {
+		emails, err := storage.GetSentEmails(ctx, tt.destination)
+		if err != nil {
+			t.Fatalf("failed to get sent emails: %v", err)
+		}
+
+		if len(emails) != tt.want {
+			t.Errorf("GetSentEmails(%q) returned %d emails, want %d", tt.destination, len(emails), tt.want)
+		}
+	}
+}
+
+func TestSendEmail_SkipsSuppressedRecipients(t *testing.T) {
+	storage := &MemoryStorage{}
+	ctx := context.Background()
+
+	err := storage.PutSuppressedDestination(ctx, &PutSuppressedDestinationRequest{
+		EmailAddress: "bounce@example.com",
+		Reason:       SuppressionReasonBounce,
+	})
+	if err != nil {
+		t.Fatalf("failed to put suppressed destination: %v", err)
+	}
+
+	_, err = storage.SendEmail(ctx, &SendEmailRequest{
+		FromEmailAddress: "sender@example.com",
+		Destination: &Destination{
+			ToAddresses: []string{"ok@example.com", "Bounce <BOUNCE@example.com>"},
+		},
+		Content: &EmailContent{
+			Simple: &SimpleEmail{
+				Subject: &Content{Data: "Test"},
+				Body:    &Body{Text: &Content{Data: "Test body"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	emails, err := storage.GetSentEmails(ctx, "")
+	if err != nil {
+		t.Fatalf("failed to get sent emails: %v", err)
+	}
+
+	if len(emails) != 1 {
+		t.Fatalf("expected 1 sent email, got %d", len(emails))
+	}
+
+	email := emails[0]
+	if len(email.Destination.ToAddresses) != 1 || email.Destination.ToAddresses[0] != "ok@example.com" {
+		t.Errorf("expected To: ok@example.com, got %v", email.Destination.ToAddresses)
+	}
+
+	want := []SuppressedRecipient{{EmailAddress: "BOUNCE@example.com", Reason: SuppressionReasonBounce}}
+	if len(email.SuppressedRecipients) != 1 || email.SuppressedRecipients[0] != want[0] {
+		t.Errorf("expected suppressed recipients %v, got %v", want, email.SuppressedRecipients)
+	}
+}
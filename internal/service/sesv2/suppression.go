@@ -0,0 +1,176 @@
+package sesv2
+
+import (
+	"context"
+	"encoding/base64"
+	"net/mail"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Suppression list reasons.
+const (
+	SuppressionReasonBounce    = "BOUNCE"
+	SuppressionReasonComplaint = "COMPLAINT"
+)
+
+// PutSuppressedDestination adds an email address to the suppression list, or updates its reason.
+func (s *MemoryStorage) PutSuppressedDestination(_ context.Context, req *PutSuppressedDestinationRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if req.EmailAddress == "" {
+		return &IdentityError{
+			Code:    errBadRequest,
+			Message: "EmailAddress is required",
+		}
+	}
+
+	if req.Reason != SuppressionReasonBounce && req.Reason != SuppressionReasonComplaint {
+		return &IdentityError{
+			Code:    errBadRequest,
+			Message: "Reason must be BOUNCE or COMPLAINT",
+		}
+	}
+
+	if s.Suppressed == nil {
+		s.Suppressed = make(map[string]*SuppressedDestination)
+	}
+
+	s.Suppressed[strings.ToLower(req.EmailAddress)] = &SuppressedDestination{
+		EmailAddress:   req.EmailAddress,
+		Reason:         req.Reason,
+		LastUpdateTime: time.Now(),
+	}
+
+	return nil
+}
+
+// GetSuppressedDestination retrieves an email address from the suppression list.
+func (s *MemoryStorage) GetSuppressedDestination(_ context.Context, emailAddress string) (*SuppressedDestination, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	dest, exists := s.Suppressed[strings.ToLower(emailAddress)]
+	if !exists {
+		return nil, &IdentityError{
+			Code:    errNotFound,
+			Message: "Email address " + emailAddress + " does not exist on your suppression list",
+		}
+	}
+
+	return dest, nil
+}
+
+// ListSuppressedDestinations lists suppressed email addresses ordered by address.
+func (s *MemoryStorage) ListSuppressedDestinations(_ context.Context, req *ListSuppressedDestinationsRequest) ([]*SuppressedDestination, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	dests := make([]*SuppressedDestination, 0, len(s.Suppressed))
+
+	for _, dest := range s.Suppressed {
+		if len(req.Reasons) > 0 && !slices.Contains(req.Reasons, dest.Reason) {
+			continue
+		}
+
+		if !req.StartDate.IsZero() && dest.LastUpdateTime.Before(req.StartDate) {
+			continue
+		}
+
+		if !req.EndDate.IsZero() && dest.LastUpdateTime.After(req.EndDate) {
+			continue
+		}
+
+		dests = append(dests, dest)
+	}
+
+	slices.SortFunc(dests, func(a, b *SuppressedDestination) int {
+		return strings.Compare(strings.ToLower(a.EmailAddress), strings.ToLower(b.EmailAddress))
+	})
+
+	startIndex := 0
+
+	if req.NextToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(req.NextToken)
+		if err == nil {
+			if idx, err := strconv.Atoi(string(decoded)); err == nil && idx >= 0 && idx < len(dests) {
+				startIndex = idx
+			}
+		}
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+
+	endIndex := min(startIndex+pageSize, len(dests))
+
+	var nextToken string
+	if endIndex < len(dests) {
+		nextToken = base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(endIndex)))
+	}
+
+	return dests[startIndex:endIndex], nextToken, nil
+}
+
+// DeleteSuppressedDestination removes an email address from the suppression list.
+func (s *MemoryStorage) DeleteSuppressedDestination(_ context.Context, emailAddress string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := strings.ToLower(emailAddress)
+
+	if _, exists := s.Suppressed[key]; !exists {
+		return &IdentityError{
+			Code:    errNotFound,
+			Message: "Email address " + emailAddress + " does not exist on your suppression list",
+		}
+	}
+
+	delete(s.Suppressed, key)
+
+	return nil
+}
+
+// removeSuppressed returns a copy of dest without recipients on the suppression list,
+// along with the recipients that were removed.
+func (s *MemoryStorage) removeSuppressed(dest *Destination) (*Destination, []SuppressedRecipient) {
+	if dest == nil || len(s.Suppressed) == 0 {
+		return dest, nil
+	}
+
+	var suppressed []SuppressedRecipient
+
+	keep := func(addresses []string) []string {
+		var kept []string
+
+		for _, a := range addresses {
+			address := a
+			if parsed, err := mail.ParseAddress(a); err == nil {
+				address = parsed.Address
+			}
+
+			if entry, ok := s.Suppressed[strings.ToLower(address)]; ok {
+				suppressed = append(suppressed, SuppressedRecipient{EmailAddress: address, Reason: entry.Reason})
+
+				continue
+			}
+
+			kept = append(kept, a)
+		}
+
+		return kept
+	}
+
+	filtered := &Destination{
+		ToAddresses:  keep(dest.ToAddresses),
+		CcAddresses:  keep(dest.CcAddresses),
+		BccAddresses: keep(dest.BccAddresses),
+	}
+
+	return filtered, suppressed
+}
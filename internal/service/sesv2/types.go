@@ -60,9 +60,23 @@ type SentEmail struct {
 	Subject              string       `json:"Subject,omitempty"`
 	Body                 string       `json:"Body,omitempty"`
 	HTMLBody             string       `json:"HTMLBody,omitempty"`
-	RawData              []byte       `json:"RawData,omitempty"`
-	ConfigurationSetName string       `json:"ConfigurationSetName,omitempty"`
-	SentAt               time.Time    `json:"SentAt"`
+	RawData              []byte                `json:"RawData,omitempty"`
+	ConfigurationSetName string                `json:"ConfigurationSetName,omitempty"`
+	SuppressedRecipients []SuppressedRecipient `json:"SuppressedRecipients,omitempty"`
+	SentAt               time.Time             `json:"SentAt"`
+}
+
+// SuppressedRecipient records a recipient that SendEmail skipped because it is on the suppression list.
+type SuppressedRecipient struct {
+	EmailAddress string `json:"EmailAddress"`
+	Reason       string `json:"Reason"`
+}
+
+// SuppressedDestination represents an email address on the account-level suppression list.
+type SuppressedDestination struct {
+	EmailAddress   string
+	Reason         string
+	LastUpdateTime time.Time
 }
 
 // Destination represents email destinations.
@@ -226,6 +240,46 @@ type GetSentEmailsResponse struct {
 	SentEmails []*SentEmail `json:"SentEmails"`
 }
 
+// PutSuppressedDestinationRequest is the request for PutSuppressedDestination.
+type PutSuppressedDestinationRequest struct {
+	EmailAddress string `json:"EmailAddress"`
+	Reason       string `json:"Reason"`
+}
+
+// SuppressedDestinationOutput represents a suppressed destination in API responses.
+type SuppressedDestinationOutput struct {
+	EmailAddress   string  `json:"EmailAddress"`
+	Reason         string  `json:"Reason"`
+	LastUpdateTime float64 `json:"LastUpdateTime"`
+}
+
+// GetSuppressedDestinationResponse is the response for GetSuppressedDestination.
+type GetSuppressedDestinationResponse struct {
+	SuppressedDestination *SuppressedDestinationOutput `json:"SuppressedDestination"`
+}
+
+// ListSuppressedDestinationsRequest holds the query parameters for ListSuppressedDestinations.
+type ListSuppressedDestinationsRequest struct {
+	Reasons   []string
+	StartDate time.Time
+	EndDate   time.Time
+	NextToken string
+	PageSize  int32
+}
+
+// SuppressedDestinationSummary is a summary of a suppressed destination.
+type SuppressedDestinationSummary struct {
+	EmailAddress   string  `json:"EmailAddress"`
+	Reason         string  `json:"Reason"`
+	LastUpdateTime float64 `json:"LastUpdateTime"`
+}
+
+// ListSuppressedDestinationsResponse is the response for ListSuppressedDestinations.
+type ListSuppressedDestinationsResponse struct {
+	SuppressedDestinationSummaries []SuppressedDestinationSummary `json:"SuppressedDestinationSummaries"`
+	NextToken                      string                         `json:"NextToken,omitempty"`
+}
+
 // ErrorResponse represents an SES error response.
 type ErrorResponse struct {
 	Type    string `json:"__type"`
@@ -20,6 +20,7 @@ func (s *Service) getActionHandlers() map[string]handlerFunc {
 		"DescribeStateMachine": s.DescribeStateMachine,
 		"ListStateMachines":    s.ListStateMachines,
 		"StartExecution":       s.StartExecution,
+		"StartSyncExecution":   s.StartSyncExecution,
 		"StopExecution":        s.StopExecution,
 		"DescribeExecution":    s.DescribeExecution,
 		"ListExecutions":       s.ListExecutions,
@@ -179,6 +180,46 @@ func (s *Service) StartExecution(w http.ResponseWriter, r *http.Request) {
 	writeResponse(w, resp)
 }
 
+// StartSyncExecution handles the StartSyncExecution API.
+func (s *Service) StartSyncExecution(w http.ResponseWriter, r *http.Request) {
+	var req StartSyncExecutionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "ValidationException", "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	exec, err := s.storage.StartSyncExecution(r.Context(), req.StateMachineArn, req.Name, req.Input, req.TraceHeader)
+	if err != nil {
+		handleError(w, err)
+
+		return
+	}
+
+	resp := &StartSyncExecutionResponse{
+		ExecutionArn:    exec.ExecutionArn,
+		StateMachineArn: exec.StateMachineArn,
+		Name:            exec.Name,
+		Status:          string(exec.Status),
+		StartDate:       float64(exec.StartDate.Unix()),
+		StopDate:        float64(exec.StopDate.Unix()),
+		Input:           exec.Input,
+		InputDetails:    exec.InputDetails,
+		Output:          exec.Output,
+		OutputDetails:   exec.OutputDetails,
+		Error:           exec.Error,
+		Cause:           exec.Cause,
+		TraceHeader:     exec.TraceHeader,
+		// Express executions are billed in 100ms increments with at least 64MB of memory.
+		BillingDetails: &BillingDetails{
+			BilledDurationInMilliseconds: 100,
+			BilledMemoryUsedInMB:         64,
+		},
+	}
+
+	writeResponse(w, resp)
+}
+
 // StopExecution handles the StopExecution API.
 func (s *Service) StopExecution(w http.ResponseWriter, r *http.Request) {
 	var req StopExecutionRequest
@@ -4,25 +4,27 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/sivchari/kumo/internal/service"
 	"github.com/sivchari/kumo/internal/storage"
 )
 
 // Error codes.
 const (
-	errStateMachineDoesNotExist  = "StateMachineDoesNotExist"
-	errStateMachineAlreadyExists = "StateMachineAlreadyExists"
-	errExecutionDoesNotExist     = "ExecutionDoesNotExist"
-	errExecutionAlreadyExists    = "ExecutionAlreadyExists"
-	errInvalidArn                = "InvalidArn"
-	errInvalidDefinition         = "InvalidDefinition"
+	errStateMachineDoesNotExist     = "StateMachineDoesNotExist"
+	errStateMachineAlreadyExists    = "StateMachineAlreadyExists"
+	errExecutionDoesNotExist        = "ExecutionDoesNotExist"
+	errExecutionAlreadyExists       = "ExecutionAlreadyExists"
+	errInvalidArn                   = "InvalidArn"
+	errInvalidDefinition            = "InvalidDefinition"
+	errStateMachineTypeNotSupported = "StateMachineTypeNotSupported"
 )
 
 // Storage defines the Step Functions storage interface.
@@ -35,6 +37,7 @@ type Storage interface {
 
 	// Execution operations.
 	StartExecution(ctx context.Context, stateMachineArn, name, input, traceHeader string) (*Execution, error)
+	StartSyncExecution(ctx context.Context, stateMachineArn, name, input, traceHeader string) (*Execution, error)
 	StopExecution(ctx context.Context, executionArn, errorCode, cause string) (*Execution, error)
 	DescribeExecution(ctx context.Context, executionArn string) (*Execution, error)
 	ListExecutions(ctx context.Context, stateMachineArn, statusFilter string, maxResults int32, nextToken string) ([]*Execution, string, error)
@@ -42,6 +45,7 @@ type Storage interface {
 
 	// DispatchAction dispatches the request to the appropriate handler.
 	DispatchAction(action string) bool
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -65,9 +69,7 @@ type MemoryStorage struct {
 	mu            sync.RWMutex              `json:"-"`
 	StateMachines map[string]*StateMachine  `json:"stateMachines"`
 	Executions    map[string]*ExecutionData `json:"executions"`
-	region        string
-	accountID     string
-	EventCounter  int64 `json:"eventCounter"`
+	EventCounter  int64                     `json:"eventCounter"`
 	dataDir       string
 }
 
@@ -79,16 +81,9 @@ type ExecutionData struct {
 
 // NewMemoryStorage creates a new in-memory storage.
 func NewMemoryStorage(opts ...Option) *MemoryStorage {
-	region := os.Getenv("AWS_DEFAULT_REGION")
-	if region == "" {
-		region = "us-east-1"
-	}
-
 	s := &MemoryStorage{
 		StateMachines: make(map[string]*StateMachine),
 		Executions:    make(map[string]*ExecutionData),
-		region:        region,
-		accountID:     "000000000000",
 	}
 	for _, o := range opts {
 		o(s)
@@ -153,12 +148,24 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.StateMachines = make(map[string]*StateMachine)
+	s.Executions = make(map[string]*ExecutionData)
+	s.EventCounter = 0
+
+	return nil
+}
+
 // CreateStateMachine creates a new state machine.
-func (s *MemoryStorage) CreateStateMachine(_ context.Context, req *CreateStateMachineRequest) (*StateMachine, error) {
+func (s *MemoryStorage) CreateStateMachine(ctx context.Context, req *CreateStateMachineRequest) (*StateMachine, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	arn := fmt.Sprintf("arn:aws:states:%s:%s:stateMachine:%s", s.region, s.accountID, req.Name)
+	arn := fmt.Sprintf("arn:aws:states:%s:%s:stateMachine:%s", service.Region(ctx), service.AccountID(ctx), req.Name)
 
 	if _, exists := s.StateMachines[arn]; exists {
 		return nil, &ServiceError{Code: errStateMachineAlreadyExists, Message: "State machine already exists"}
@@ -256,7 +263,8 @@ func (s *MemoryStorage) StartExecution(_ context.Context, stateMachineArn, name,
 		execName = uuid.New().String()
 	}
 
-	executionArn := fmt.Sprintf("arn:aws:states:%s:%s:Execution:%s:%s", s.region, s.accountID, sm.Name, execName)
+	// Executions live in the account and region of their state machine.
+	executionArn := strings.Replace(sm.StateMachineArn, ":stateMachine:", ":Execution:", 1) + ":" + execName
 
 	if _, exists := s.Executions[executionArn]; exists {
 		return nil, &ServiceError{Code: errExecutionAlreadyExists, Message: "Execution already exists"}
@@ -276,6 +284,39 @@ func (s *MemoryStorage) StartExecution(_ context.Context, stateMachineArn, name,
 	return exec, nil
 }
 
+// StartSyncExecution runs an execution of an Express state machine to completion.
+// Like Express executions on AWS, it is not recorded and cannot be described afterwards.
+func (s *MemoryStorage) StartSyncExecution(_ context.Context, stateMachineArn, name, input, traceHeader string) (*Execution, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sm, exists := s.StateMachines[stateMachineArn]
+	if !exists {
+		return nil, &ServiceError{Code: errStateMachineDoesNotExist, Message: "State machine does not exist"}
+	}
+
+	if sm.Type != StateMachineTypeExpress {
+		return nil, &ServiceError{Code: errStateMachineTypeNotSupported, Message: "This operation is not supported by this type of state machine"}
+	}
+
+	execName := name
+	if execName == "" {
+		execName = uuid.New().String()
+	}
+
+	executionArn := strings.Replace(sm.StateMachineArn, ":stateMachine:", ":express:", 1) + ":" + execName + ":" + uuid.New().String()
+
+	now := time.Now()
+	exec := s.createExecution(executionArn, stateMachineArn, execName, input, traceHeader, now)
+
+	exec.Status = ExecutionStatusSucceeded
+	exec.StopDate = &now
+	exec.Output = input
+	exec.OutputDetails = &CloudWatchEventsExecutionDataDetails{Included: true}
+
+	return exec, nil
+}
+
 // createExecution creates a new execution object.
 func (s *MemoryStorage) createExecution(arn, smArn, name, input, traceHeader string, now time.Time) *Execution {
 	return &Execution{
@@ -244,6 +244,38 @@ type StartExecutionResponse struct {
 	StartDate    float64 `json:"startDate"`
 }
 
+// StartSyncExecutionRequest is the request for StartSyncExecution.
+type StartSyncExecutionRequest struct {
+	StateMachineArn string `json:"stateMachineArn"`
+	Name            string `json:"name,omitempty"`
+	Input           string `json:"input,omitempty"`
+	TraceHeader     string `json:"traceHeader,omitempty"`
+}
+
+// StartSyncExecutionResponse is the response for StartSyncExecution.
+type StartSyncExecutionResponse struct {
+	ExecutionArn    string                                `json:"executionArn"`
+	StateMachineArn string                                `json:"stateMachineArn"`
+	Name            string                                `json:"name"`
+	Status          string                                `json:"status"`
+	StartDate       float64                               `json:"startDate"`
+	StopDate        float64                               `json:"stopDate"`
+	Input           string                                `json:"input,omitempty"`
+	InputDetails    *CloudWatchEventsExecutionDataDetails `json:"inputDetails,omitempty"`
+	Output          string                                `json:"output,omitempty"`
+	OutputDetails   *CloudWatchEventsExecutionDataDetails `json:"outputDetails,omitempty"`
+	Error           string                                `json:"error,omitempty"`
+	Cause           string                                `json:"cause,omitempty"`
+	TraceHeader     string                                `json:"traceHeader,omitempty"`
+	BillingDetails  *BillingDetails                       `json:"billingDetails,omitempty"`
+}
+
+// BillingDetails contains the billed duration and memory of an Express execution.
+type BillingDetails struct {
+	BilledDurationInMilliseconds int64 `json:"billedDurationInMilliseconds"`
+	BilledMemoryUsedInMB         int64 `json:"billedMemoryUsedInMB"`
+}
+
 // StopExecutionRequest is the request for StopExecution.
 type StopExecutionRequest struct {
 	ExecutionArn string `json:"executionArn"`
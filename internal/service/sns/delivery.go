@@ -0,0 +1,142 @@
+package sns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sivchari/kumo/internal/service"
+)
+
+// SendMessage parameters passed to SQSPublisher.PublishToSQS for FIFO queues.
+const (
+	sqsParamMessageGroupID         = "MessageGroupId"
+	sqsParamMessageDeduplicationID = "MessageDeduplicationId"
+)
+
+// Compile-time interface check.
+var _ SQSPublisher = (*endpointSQSPublisher)(nil)
+
+// endpointSQSPublisher delivers messages to SQS via the local kumo endpoint.
+type endpointSQSPublisher struct {
+	baseURL string
+	client  *http.Client
+}
+
+// newEndpointSQSPublisher creates an SQSPublisher that sends to the kumo
+// endpoint at baseURL.
+func newEndpointSQSPublisher(baseURL string) *endpointSQSPublisher {
+	return &endpointSQSPublisher{
+		baseURL: baseURL,
+		client:  &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// PublishToSQS sends a message to the queue. The attributes hold additional
+// SendMessage parameters such as MessageGroupId.
+func (p *endpointSQSPublisher) PublishToSQS(ctx context.Context, queueURL, messageBody string, attributes map[string]string) error {
+	payload := map[string]string{
+		"QueueUrl":    queueURL,
+		"MessageBody": messageBody,
+	}
+
+	for _, key := range []string{sqsParamMessageGroupID, sqsParamMessageDeduplicationID} {
+		if v := attributes[key]; v != "" {
+			payload[key] = v
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-amz-json-1.0")
+	req.Header.Set("X-Amz-Target", "AmazonSQS.SendMessage")
+	service.MarkInternalRequest(req)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to send message: status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// notification is the JSON envelope SNS delivers to subscribers unless raw
+// message delivery is enabled.
+type notification struct {
+	Type              string                           `json:"Type"`
+	MessageID         string                           `json:"MessageId"`
+	SequenceNumber    string                           `json:"SequenceNumber,omitempty"`
+	TopicArn          string                           `json:"TopicArn"`
+	Subject           string                           `json:"Subject,omitempty"`
+	Message           string                           `json:"Message"`
+	Timestamp         string                           `json:"Timestamp"`
+	MessageAttributes map[string]notificationAttribute `json:"MessageAttributes,omitempty"`
+}
+
+// notificationAttribute is a message attribute in a notification envelope.
+type notificationAttribute struct {
+	Type  string `json:"Type"`
+	Value string `json:"Value"`
+}
+
+// buildNotification returns the body delivered to a subscription.
+func buildNotification(sub *Subscription, msg *publishedMessage) (string, error) {
+	if sub.SubscriptionAttributes["RawMessageDelivery"] == "true" {
+		return msg.Message, nil
+	}
+
+	n := &notification{
+		Type:           "Notification",
+		MessageID:      msg.MessageID,
+		SequenceNumber: msg.SequenceNumber,
+		TopicArn:       sub.TopicARN,
+		Subject:        msg.Subject,
+		Message:        msg.Message,
+		Timestamp:      msg.Timestamp.UTC().Format("2006-01-02T15:04:05.000Z"),
+	}
+
+	if len(msg.Attributes) > 0 {
+		n.MessageAttributes = make(map[string]notificationAttribute, len(msg.Attributes))
+
+		for name, attr := range msg.Attributes {
+			n.MessageAttributes[name] = notificationAttribute{Type: attr.DataType, Value: attr.StringValue}
+		}
+	}
+
+	data, err := json.Marshal(n)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// sqsQueueURL returns the queue URL of an SQS subscription endpoint, which is
+// either a queue ARN or already a queue URL.
+func sqsQueueURL(baseURL, endpoint string) string {
+	// arn:aws:sqs:region:account:name
+	parts := strings.Split(endpoint, ":")
+	if len(parts) != 6 || parts[0] != "arn" {
+		return endpoint
+	}
+
+	return fmt.Sprintf("%s/%s/%s", baseURL, parts[4], parts[5])
+}
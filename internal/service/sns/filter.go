@@ -0,0 +1,223 @@
+package sns
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Subscription attributes that select the messages delivered to a subscription.
+const (
+	attrFilterPolicy      = "FilterPolicy"
+	attrFilterPolicyScope = "FilterPolicyScope"
+
+	filterPolicyScopeMessageBody = "MessageBody"
+)
+
+// parseFilterPolicy parses the JSON filter policy of a subscription.
+func parseFilterPolicy(policy string) (map[string]any, error) {
+	var parsed map[string]any
+	if err := json.Unmarshal([]byte(policy), &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse filter policy: %w", err)
+	}
+
+	return parsed, nil
+}
+
+// matchesFilterPolicy reports whether the message passes the filter policy of the
+// subscription. Subscriptions without a filter policy receive every message.
+func (sub *Subscription) matchesFilterPolicy(msg *publishedMessage) bool {
+	policy := sub.SubscriptionAttributes[attrFilterPolicy]
+	if policy == "" {
+		return true
+	}
+
+	parsed, err := parseFilterPolicy(policy)
+	if err != nil {
+		return false
+	}
+
+	if sub.SubscriptionAttributes[attrFilterPolicyScope] == filterPolicyScopeMessageBody {
+		var body map[string]any
+		if err := json.Unmarshal([]byte(msg.Message), &body); err != nil {
+			return false
+		}
+
+		return matchPolicy(parsed, body)
+	}
+
+	return matchPolicy(parsed, messageAttributeValues(msg.Attributes))
+}
+
+// messageAttributeValues returns the values of message attributes as filter
+// policies see them: strings, numbers and arrays.
+func messageAttributeValues(attributes map[string]MessageAttribute) map[string]any {
+	values := make(map[string]any, len(attributes))
+
+	for name, attr := range attributes {
+		switch {
+		case strings.HasPrefix(attr.DataType, "Number"):
+			if n, err := strconv.ParseFloat(attr.StringValue, 64); err == nil {
+				values[name] = n
+			}
+		case attr.DataType == "String.Array":
+			var arr []any
+			if err := json.Unmarshal([]byte(attr.StringValue), &arr); err == nil {
+				values[name] = arr
+			}
+		case strings.HasPrefix(attr.DataType, "String"):
+			values[name] = attr.StringValue
+		}
+	}
+
+	return values
+}
+
+// matchPolicy reports whether every key of the policy matches the values.
+// Nested policy objects match nested values of message bodies.
+func matchPolicy(policy, values map[string]any) bool {
+	for key, conditions := range policy {
+		value, present := values[key]
+
+		switch c := conditions.(type) {
+		case map[string]any:
+			nested, ok := value.(map[string]any)
+			if !ok || !matchPolicy(c, nested) {
+				return false
+			}
+		case []any:
+			if !matchConditions(c, value, present) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchConditions reports whether any of the conditions matches the value, or
+// one of its elements when it is an array.
+func matchConditions(conditions []any, value any, present bool) bool {
+	candidates, ok := value.([]any)
+	if !ok {
+		candidates = []any{value}
+	}
+
+	for _, condition := range conditions {
+		if exists, ok := existsCondition(condition); ok {
+			if exists == present {
+				return true
+			}
+
+			continue
+		}
+
+		if !present {
+			continue
+		}
+
+		if slices.ContainsFunc(candidates, func(v any) bool { return matchCondition(condition, v) }) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// existsCondition returns the operand of an {"exists": bool} condition.
+func existsCondition(condition any) (bool, bool) {
+	op, ok := condition.(map[string]any)
+	if !ok {
+		return false, false
+	}
+
+	exists, ok := op["exists"].(bool)
+
+	return exists, ok
+}
+
+// matchCondition reports whether a single value matches an exact value or a
+// prefix, suffix, anything-but or numeric condition.
+func matchCondition(condition, value any) bool {
+	op, ok := condition.(map[string]any)
+	if !ok {
+		return condition == value
+	}
+
+	s, isString := value.(string)
+
+	if prefix, ok := op["prefix"].(string); ok {
+		return isString && strings.HasPrefix(s, prefix)
+	}
+
+	if suffix, ok := op["suffix"].(string); ok {
+		return isString && strings.HasSuffix(s, suffix)
+	}
+
+	if excluded, ok := op["anything-but"]; ok {
+		return !matchAnythingBut(excluded, value)
+	}
+
+	if numeric, ok := op["numeric"].([]any); ok {
+		n, ok := value.(float64)
+
+		return ok && matchNumeric(numeric, n)
+	}
+
+	return false
+}
+
+// matchAnythingBut reports whether the value is excluded by an anything-but operand:
+// a value, a list of values or a prefix condition.
+func matchAnythingBut(excluded, value any) bool {
+	switch e := excluded.(type) {
+	case []any:
+		return slices.Contains(e, value)
+	case map[string]any:
+		return matchCondition(e, value)
+	default:
+		return e == value
+	}
+}
+
+// matchNumeric reports whether n satisfies every comparison of a numeric
+// condition such as [">", 0, "<=", 5].
+func matchNumeric(comparisons []any, n float64) bool {
+	if len(comparisons)%2 != 0 {
+		return false
+	}
+
+	for i := 0; i < len(comparisons); i += 2 {
+		op, _ := comparisons[i].(string)
+
+		operand, ok := comparisons[i+1].(float64)
+		if !ok {
+			return false
+		}
+
+		var match bool
+
+		switch op {
+		case "=":
+			match = n == operand
+		case "<":
+			match = n < operand
+		case "<=":
+			match = n <= operand
+		case ">":
+			match = n > operand
+		case ">=":
+			match = n >= operand
+		}
+
+		if !match {
+			return false
+		}
+	}
+
+	return true
+}
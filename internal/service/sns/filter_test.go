@@ -0,0 +1,71 @@
+package sns
+
+import "testing"
+
+func TestSubscriptionMatchesFilterPolicy(t *testing.T) {
+	t.Parallel()
+
+	attributes := map[string]MessageAttribute{
+		"color": {DataType: "String", StringValue: "red"},
+		"size":  {DataType: "Number", StringValue: "42"},
+		"tags":  {DataType: "String.Array", StringValue: `["sale", "new"]`},
+	}
+
+	tests := []struct {
+		name   string
+		policy string
+		scope  string
+		body   string
+		want   bool
+	}{
+		{name: "no policy", want: true},
+		{name: "exact string", policy: `{"color": ["blue", "red"]}`, want: true},
+		{name: "other string", policy: `{"color": ["blue"]}`, want: false},
+		{name: "missing attribute", policy: `{"shape": ["round"]}`, want: false},
+		{name: "every key must match", policy: `{"color": ["red"], "size": [7]}`, want: false},
+		{name: "exact number", policy: `{"size": [42]}`, want: true},
+		{name: "numeric range", policy: `{"size": [{"numeric": [">", 10, "<=", 42]}]}`, want: true},
+		{name: "numeric out of range", policy: `{"size": [{"numeric": ["<", 10]}]}`, want: false},
+		{name: "prefix", policy: `{"color": [{"prefix": "re"}]}`, want: true},
+		{name: "anything-but", policy: `{"color": [{"anything-but": ["red", "blue"]}]}`, want: false},
+		{name: "exists", policy: `{"color": [{"exists": true}]}`, want: true},
+		{name: "not exists", policy: `{"shape": [{"exists": false}]}`, want: true},
+		{name: "array element", policy: `{"tags": ["new"]}`, want: true},
+		{name: "invalid policy", policy: `[`, want: false},
+		{
+			name:   "message body",
+			policy: `{"order": {"status": ["shipped"]}}`,
+			scope:  filterPolicyScopeMessageBody,
+			body:   `{"order": {"status": "shipped"}}`,
+			want:   true,
+		},
+		{
+			name:   "message body mismatch",
+			policy: `{"order": {"status": ["shipped"]}}`,
+			scope:  filterPolicyScopeMessageBody,
+			body:   `{"order": {"status": "pending"}}`,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sub := &Subscription{SubscriptionAttributes: map[string]string{}}
+			if tt.policy != "" {
+				sub.SubscriptionAttributes[attrFilterPolicy] = tt.policy
+			}
+
+			if tt.scope != "" {
+				sub.SubscriptionAttributes[attrFilterPolicyScope] = tt.scope
+			}
+
+			msg := &publishedMessage{Message: tt.body, Attributes: attributes}
+
+			if got := sub.matchesFilterPolicy(msg); got != tt.want {
+				t.Errorf("matchesFilterPolicy(%s) = %v, want %v", tt.policy, got, tt.want)
+			}
+		})
+	}
+}
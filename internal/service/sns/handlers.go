@@ -262,7 +262,7 @@ func (s *Service) Publish(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	messageID, err := s.storage.Publish(r.Context(), topicARN, req.Message, req.Subject, req.MessageAttributes)
+	result, err := s.storage.Publish(r.Context(), topicARN, &req)
 	if err != nil {
 		var sErr *TopicError
 		if errors.As(err, &sErr) {
@@ -284,7 +284,8 @@ func (s *Service) Publish(w http.ResponseWriter, r *http.Request) {
 	writeXMLResponse(w, XMLPublishResponse{
 		Xmlns: snsXMLNS,
 		PublishResult: XMLPublishResult{
-			MessageID: messageID,
+			MessageID:      result.MessageID,
+			SequenceNumber: result.SequenceNumber,
 		},
 		ResponseMetadata: ResponseMetadata{
 			RequestID: uuid.New().String(),
@@ -292,6 +293,23 @@ func (s *Service) Publish(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// GetDeliveries returns the outcome of delivering each published message to each subscription.
+// This is a kumo-specific endpoint for test verification.
+func (s *Service) GetDeliveries(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	deliveries, err := s.storage.GetDeliveries(r.Context(), query.Get("topicArn"), query.Get("subscriptionArn"))
+	if err != nil {
+		writeTopicError(w, errInternalServiceError, "Internal server error", http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(GetDeliveriesResponse{Deliveries: deliveries})
+}
+
 // ListSubscriptions handles the ListSubscriptions action.
 func (s *Service) ListSubscriptions(w http.ResponseWriter, r *http.Request) {
 	var req ListSubscriptionsRequest
@@ -1,6 +1,7 @@
 package sns
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -8,17 +9,29 @@ import (
 	"github.com/sivchari/kumo/internal/service"
 )
 
+const defaultBaseURL = "http://localhost:4566"
+
 // Compile-time check that Service implements io.Closer.
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"sns"}, func(dataDir string) []service.Service {
+		baseURL := defaultBaseURL
+
+		if port := os.Getenv("KUMO_PORT"); port != "" {
+			baseURL = fmt.Sprintf("http://localhost:%s", port)
+		}
 
-	storage := NewMemoryStorage("", opts...)
-	service.Register(New(storage))
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
+
+		storage := NewMemoryStorage(baseURL, opts...)
+		storage.SetSQSPublisher(newEndpointSQSPublisher(baseURL))
+
+		return []service.Service{New(storage)}
+	})
 }
 
 // Service implements the SNS service.
@@ -39,9 +52,10 @@ func (s *Service) Name() string {
 }
 
 // RegisterRoutes registers routes with the router.
-// SNS uses Query protocol, so routes are registered via DispatchAction.
-func (s *Service) RegisterRoutes(_ service.Router) {
-	// SNS uses Query protocol, routing is handled by DispatchAction.
+// SNS uses Query protocol, so API actions are routed via DispatchAction.
+func (s *Service) RegisterRoutes(r service.Router) {
+	// kumo-specific endpoint for test verification.
+	r.HandleFunc("GET", "/kumo/sns/deliveries", s.GetDeliveries)
 }
 
 // TargetPrefix returns the X-Amz-Target header prefix for SNS.
@@ -87,3 +101,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
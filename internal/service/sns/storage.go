@@ -2,8 +2,11 @@ package sns
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -11,19 +14,31 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/sivchari/kumo/internal/service"
 	"github.com/sivchari/kumo/internal/storage"
 )
 
-const (
-	defaultRegion    = "us-east-1"
-	defaultAccountID = "000000000000"
-)
-
 // SQSPublisher is an interface for publishing messages to SQS.
 type SQSPublisher interface {
 	PublishToSQS(ctx context.Context, queueURL, messageBody string, attributes map[string]string) error
 }
 
+// fifoDeduplicationInterval is how long a FIFO topic remembers a
+// deduplication ID.
+const fifoDeduplicationInterval = 5 * time.Minute
+
+// publishedMessage is a message being delivered to the subscriptions of a topic.
+type publishedMessage struct {
+	MessageID       string
+	SequenceNumber  string
+	Subject         string
+	Message         string
+	Timestamp       time.Time
+	Attributes      map[string]MessageAttribute
+	MessageGroupID  string
+	DeduplicationID string
+}
+
 // Storage defines the SNS storage interface.
 type Storage interface {
 	CreateTopic(ctx context.Context, name string, attributes map[string]string) (*Topic, error)
@@ -31,9 +46,11 @@ type Storage interface {
 	ListTopics(ctx context.Context, nextToken string) ([]*Topic, string, error)
 	Subscribe(ctx context.Context, topicARN, protocol, endpoint string, attributes map[string]string) (*Subscription, error)
 	Unsubscribe(ctx context.Context, subscriptionARN string) error
-	Publish(ctx context.Context, topicARN, message, subject string, attributes map[string]MessageAttribute) (string, error)
+	Publish(ctx context.Context, topicARN string, req *PublishRequest) (*PublishResponse, error)
 	ListSubscriptions(ctx context.Context, nextToken string) ([]*Subscription, string, error)
 	ListSubscriptionsByTopic(ctx context.Context, topicARN, nextToken string) ([]*Subscription, string, error)
+	GetDeliveries(ctx context.Context, topicARN, subscriptionARN string) ([]Delivery, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -52,14 +69,22 @@ var (
 	_ json.Unmarshaler = (*MemoryStorage)(nil)
 )
 
+// maxDeliveriesPerTopic is the number of deliveries of each topic kept in the delivery log.
+const maxDeliveriesPerTopic = 100
+
 // MemoryStorage implements Storage with in-memory data.
 type MemoryStorage struct {
 	mu            sync.RWMutex             `json:"-"`
 	Topics        map[string]*Topic        `json:"topics"`        // keyed by ARN
 	Subscriptions map[string]*Subscription `json:"subscriptions"` // keyed by ARN
+	Deliveries    []Delivery               `json:"deliveries"`
 	baseURL       string
 	SqsPublisher  SQSPublisher `json:"-"`
 	dataDir       string
+
+	// fifoMu serializes publishes to FIFO topics, so that messages are
+	// delivered in the order their sequence numbers were assigned.
+	fifoMu sync.Mutex
 }
 
 // NewMemoryStorage creates a new in-memory SNS storage.
@@ -132,17 +157,39 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Topics = make(map[string]*Topic)
+	m.Subscriptions = make(map[string]*Subscription)
+	m.Deliveries = nil
+
+	return nil
+}
+
 // SetSQSPublisher sets the SQS publisher for SNS to SQS integration.
 func (m *MemoryStorage) SetSQSPublisher(publisher SQSPublisher) {
 	m.SqsPublisher = publisher
 }
 
 // CreateTopic creates a new topic.
-func (m *MemoryStorage) CreateTopic(_ context.Context, name string, attributes map[string]string) (*Topic, error) {
+func (m *MemoryStorage) CreateTopic(ctx context.Context, name string, attributes map[string]string) (*Topic, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	arn := m.buildTopicARN(name)
+	// A .fifo suffix makes a FIFO topic, as it does for SQS queues.
+	isFifo := strings.HasSuffix(name, ".fifo")
+	if attributes["FifoTopic"] == "true" && !isFifo {
+		return nil, &TopicError{
+			Code: "InvalidParameter",
+			Message: "Invalid parameter: Fifo Topic names must end with .fifo and must be made up of only uppercase " +
+				"and lowercase ASCII letters, numbers, underscores, and hyphens, and must be between 1 and 256 characters long.",
+		}
+	}
+
+	arn := buildTopicARN(ctx, name)
 
 	// Return existing topic if it exists.
 	if topic, exists := m.Topics[arn]; exists {
@@ -150,11 +197,13 @@ func (m *MemoryStorage) CreateTopic(_ context.Context, name string, attributes m
 	}
 
 	topic := &Topic{
-		ARN:           arn,
-		Name:          name,
-		CreatedTime:   time.Now(),
-		Attributes:    attributes,
-		Subscriptions: make(map[string]*Subscription),
+		ARN:                       arn,
+		Name:                      name,
+		CreatedTime:               time.Now(),
+		Attributes:                attributes,
+		Subscriptions:             make(map[string]*Subscription),
+		FifoTopic:                 isFifo,
+		ContentBasedDeduplication: attributes["ContentBasedDeduplication"] == "true",
 	}
 
 	if attributes != nil {
@@ -233,7 +282,7 @@ func (m *MemoryStorage) ListTopics(_ context.Context, nextToken string) ([]*Topi
 }
 
 // Subscribe creates a subscription.
-func (m *MemoryStorage) Subscribe(_ context.Context, topicARN, protocol, endpoint string, attributes map[string]string) (*Subscription, error) {
+func (m *MemoryStorage) Subscribe(ctx context.Context, topicARN, protocol, endpoint string, attributes map[string]string) (*Subscription, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -259,14 +308,27 @@ func (m *MemoryStorage) Subscribe(_ context.Context, topicARN, protocol, endpoin
 		}
 	}
 
-	subscriptionARN := m.buildSubscriptionARN(topicARN)
+	if err := validateFifoSubscription(topic, protocol, endpoint); err != nil {
+		return nil, err
+	}
+
+	if policy := attributes[attrFilterPolicy]; policy != "" {
+		if _, err := parseFilterPolicy(policy); err != nil {
+			return nil, &TopicError{
+				Code:    "InvalidParameter",
+				Message: "Invalid parameter: FilterPolicy: failed to parse JSON",
+			}
+		}
+	}
+
+	subscriptionARN := buildSubscriptionARN(topicARN)
 
 	subscription := &Subscription{
 		ARN:                    subscriptionARN,
 		TopicARN:               topicARN,
 		Protocol:               protocol,
 		Endpoint:               endpoint,
-		Owner:                  defaultAccountID,
+		Owner:                  service.AccountID(ctx),
 		SubscriptionAttributes: attributes,
 	}
 
@@ -305,52 +367,196 @@ func (m *MemoryStorage) Unsubscribe(_ context.Context, subscriptionARN string) e
 }
 
 // Publish publishes a message to a topic.
-func (m *MemoryStorage) Publish(ctx context.Context, topicARN, message, subject string, attributes map[string]MessageAttribute) (string, error) {
+func (m *MemoryStorage) Publish(ctx context.Context, topicARN string, req *PublishRequest) (*PublishResponse, error) {
 	m.mu.RLock()
-
 	topic, exists := m.Topics[topicARN]
+	isFifo := exists && topic.FifoTopic
+	m.mu.RUnlock()
+
 	if !exists {
-		m.mu.RUnlock()
+		return nil, &TopicError{
+			Code:    "NotFound",
+			Message: fmt.Sprintf("Topic does not exist: %s", topicARN),
+		}
+	}
 
-		return "", &TopicError{
+	if isFifo {
+		m.fifoMu.Lock()
+		defer m.fifoMu.Unlock()
+	}
+
+	msg, subscriptions, err := m.preparePublish(topicARN, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deliver to all subscriptions whose filter policy the message passes,
+	// recording the outcome of each and continuing past failures. A duplicate
+	// FIFO message has no subscriptions.
+	for _, sub := range subscriptions {
+		if !sub.matchesFilterPolicy(msg) {
+			m.recordDelivery(sub, msg, DeliveryStatusFiltered, nil)
+
+			continue
+		}
+
+		err := m.deliverMessage(ctx, sub, msg)
+		m.recordDelivery(sub, msg, DeliveryStatusSuccess, err)
+	}
+
+	return &PublishResponse{
+		MessageID:      msg.MessageID,
+		SequenceNumber: msg.SequenceNumber,
+	}, nil
+}
+
+// preparePublish builds the message to publish to a topic and returns the
+// subscriptions to deliver it to. For FIFO topics it enforces the message
+// group and deduplication IDs, assigns a sequence number, and returns no
+// subscriptions for a message already published within the deduplication
+// interval.
+func (m *MemoryStorage) preparePublish(topicARN string, req *PublishRequest) (*publishedMessage, []*Subscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	topic, exists := m.Topics[topicARN]
+	if !exists {
+		return nil, nil, &TopicError{
 			Code:    "NotFound",
 			Message: fmt.Sprintf("Topic does not exist: %s", topicARN),
 		}
 	}
 
-	// Copy subscriptions while holding read lock.
+	now := time.Now()
+	msg := &publishedMessage{
+		MessageID:      uuid.New().String(),
+		Subject:        req.Subject,
+		Message:        req.Message,
+		Timestamp:      now,
+		Attributes:     req.MessageAttributes,
+		MessageGroupID: req.MessageGroupID,
+	}
+
+	if topic.FifoTopic {
+		duplicate, err := topic.assignSequence(msg, req.MessageDeduplicationID, now)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if duplicate {
+			return msg, nil, nil
+		}
+	}
+
+	// Copy subscriptions while holding the lock, in a stable order.
 	subscriptions := make([]*Subscription, 0, len(topic.Subscriptions))
 	for _, sub := range topic.Subscriptions {
 		subscriptions = append(subscriptions, sub)
 	}
-	m.mu.RUnlock()
 
-	messageID := uuid.New().String()
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].ARN < subscriptions[j].ARN
+	})
 
-	// Deliver to all subscriptions.
-	for _, sub := range subscriptions {
-		if err := m.deliverMessage(ctx, sub, message, subject, messageID, attributes); err != nil {
-			// Log error but continue delivering to other subscriptions.
-			continue
+	return msg, subscriptions, nil
+}
+
+// assignSequence validates a message published to a FIFO topic and assigns
+// its sequence number. If the deduplication ID was seen within the
+// deduplication interval, msg takes the original message's ID and sequence
+// number and assignSequence reports a duplicate.
+func (t *Topic) assignSequence(msg *publishedMessage, deduplicationID string, now time.Time) (bool, error) {
+	if msg.MessageGroupID == "" {
+		return false, &TopicError{
+			Code:    "InvalidParameter",
+			Message: "Invalid parameter: The MessageGroupId parameter is required for FIFO topics",
+		}
+	}
+
+	if deduplicationID == "" {
+		if !t.ContentBasedDeduplication {
+			return false, &TopicError{
+				Code:    "InvalidParameter",
+				Message: "Invalid parameter: The topic should either have ContentBasedDeduplication enabled or MessageDeduplicationId provided explicitly",
+			}
+		}
+
+		hash := sha256.Sum256([]byte(msg.Message))
+		deduplicationID = hex.EncodeToString(hash[:])
+	}
+
+	msg.DeduplicationID = deduplicationID
+
+	if t.DeduplicationCache == nil {
+		t.DeduplicationCache = make(map[string]DeduplicationEntry)
+	}
+
+	// Clean up expired deduplication entries.
+	for id, entry := range t.DeduplicationCache {
+		if now.After(entry.ExpiresAt) {
+			delete(t.DeduplicationCache, id)
 		}
 	}
 
-	return messageID, nil
+	if entry, exists := t.DeduplicationCache[deduplicationID]; exists {
+		msg.MessageID = entry.MessageID
+		msg.SequenceNumber = entry.SequenceNumber
+
+		return true, nil
+	}
+
+	t.SequenceCounter++
+	msg.SequenceNumber = fmt.Sprintf("%020d", t.SequenceCounter)
+
+	t.DeduplicationCache[deduplicationID] = DeduplicationEntry{
+		MessageID:      msg.MessageID,
+		SequenceNumber: msg.SequenceNumber,
+		ExpiresAt:      now.Add(fifoDeduplicationInterval),
+	}
+
+	return false, nil
+}
+
+// validateFifoSubscription checks that FIFO topics are only subscribed to by
+// FIFO SQS queues, and FIFO SQS queues only subscribe to FIFO topics.
+func validateFifoSubscription(topic *Topic, protocol, endpoint string) error {
+	fifoQueue := protocol == "sqs" && strings.HasSuffix(endpoint, ".fifo")
+
+	switch {
+	case topic.FifoTopic && !fifoQueue:
+		return &TopicError{
+			Code:    "InvalidParameter",
+			Message: "Invalid parameter: Endpoint Reason: FIFO SNS Topics currently only support FIFO SQS queues as endpoints",
+		}
+	case !topic.FifoTopic && fifoQueue:
+		return &TopicError{
+			Code:    "InvalidParameter",
+			Message: "Invalid parameter: Endpoint Reason: FIFO SQS Queues can not be subscribed to standard SNS topics",
+		}
+	default:
+		return nil
+	}
 }
 
 // deliverMessage delivers a message to a subscription.
-func (m *MemoryStorage) deliverMessage(ctx context.Context, sub *Subscription, message, subject, messageID string, _ map[string]MessageAttribute) error {
+func (m *MemoryStorage) deliverMessage(ctx context.Context, sub *Subscription, msg *publishedMessage) error {
 	switch sub.Protocol {
 	case "sqs":
 		if m.SqsPublisher != nil {
-			attrs := map[string]string{
-				"MessageId": messageID,
+			body, err := buildNotification(sub, msg)
+			if err != nil {
+				return err
 			}
-			if subject != "" {
-				attrs["Subject"] = subject
+
+			var params map[string]string
+			if msg.DeduplicationID != "" {
+				params = map[string]string{
+					sqsParamMessageGroupID:         msg.MessageGroupID,
+					sqsParamMessageDeduplicationID: msg.DeduplicationID,
+				}
 			}
 
-			if err := m.SqsPublisher.PublishToSQS(ctx, sub.Endpoint, message, attrs); err != nil {
+			if err := m.SqsPublisher.PublishToSQS(ctx, sqsQueueURL(m.baseURL, sub.Endpoint), body, params); err != nil {
 				return fmt.Errorf("failed to publish to SQS: %w", err)
 			}
 
@@ -367,6 +573,69 @@ func (m *MemoryStorage) deliverMessage(ctx context.Context, sub *Subscription, m
 	return nil
 }
 
+// recordDelivery appends the outcome of delivering a message to a subscription to the
+// delivery log, with a FAILURE status if err is set. Only the last maxDeliveriesPerTopic
+// deliveries of each topic are kept.
+func (m *MemoryStorage) recordDelivery(sub *Subscription, msg *publishedMessage, status string, err error) {
+	delivery := Delivery{
+		MessageID:       msg.MessageID,
+		TopicARN:        sub.TopicARN,
+		SubscriptionARN: sub.ARN,
+		Protocol:        sub.Protocol,
+		Endpoint:        sub.Endpoint,
+		Status:          status,
+		DeliveredAt:     time.Now(),
+	}
+
+	if err != nil {
+		delivery.Status = DeliveryStatusFailure
+		delivery.Error = err.Error()
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Deliveries = append(m.Deliveries, delivery)
+
+	count := 0
+	for _, d := range m.Deliveries {
+		if d.TopicARN == delivery.TopicARN {
+			count++
+		}
+	}
+
+	if count > maxDeliveriesPerTopic {
+		oldest := slices.IndexFunc(m.Deliveries, func(d Delivery) bool {
+			return d.TopicARN == delivery.TopicARN
+		})
+		m.Deliveries = slices.Delete(m.Deliveries, oldest, oldest+1)
+	}
+}
+
+// GetDeliveries returns the delivery log (for testing), oldest first.
+// When topicARN or subscriptionARN is set, only deliveries of that topic or to
+// that subscription are returned.
+func (m *MemoryStorage) GetDeliveries(_ context.Context, topicARN, subscriptionARN string) ([]Delivery, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	deliveries := make([]Delivery, 0, len(m.Deliveries))
+
+	for _, delivery := range m.Deliveries {
+		if topicARN != "" && delivery.TopicARN != topicARN {
+			continue
+		}
+
+		if subscriptionARN != "" && delivery.SubscriptionARN != subscriptionARN {
+			continue
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
 // ListSubscriptions returns all subscriptions.
 func (m *MemoryStorage) ListSubscriptions(_ context.Context, nextToken string) ([]*Subscription, string, error) {
 	m.mu.RLock()
@@ -457,17 +726,12 @@ func (m *MemoryStorage) ListSubscriptionsByTopic(_ context.Context, topicARN, ne
 	return result, newNextToken, nil
 }
 
-// buildTopicARN builds an ARN for a topic.
-func (m *MemoryStorage) buildTopicARN(name string) string {
-	return fmt.Sprintf("arn:aws:sns:%s:%s:%s", defaultRegion, defaultAccountID, name)
+// buildTopicARN builds an ARN for a topic in the account and region of the request.
+func buildTopicARN(ctx context.Context, name string) string {
+	return fmt.Sprintf("arn:aws:sns:%s:%s:%s", service.Region(ctx), service.AccountID(ctx), name)
 }
 
-// buildSubscriptionARN builds an ARN for a subscription.
-func (m *MemoryStorage) buildSubscriptionARN(topicARN string) string {
-	// Extract topic name from ARN.
-	parts := strings.Split(topicARN, ":")
-	topicName := parts[len(parts)-1]
-
-	return fmt.Sprintf("arn:aws:sns:%s:%s:%s:%s",
-		defaultRegion, defaultAccountID, topicName, uuid.New().String())
+// buildSubscriptionARN builds an ARN for a subscription to the topic with the given ARN.
+func buildSubscriptionARN(topicARN string) string {
+	return fmt.Sprintf("%s:%s", topicARN, uuid.New().String())
 }
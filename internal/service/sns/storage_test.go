@@ -0,0 +1,125 @@
+package sns
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPublishRecordsFilteredDeliveries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	storage := NewMemoryStorage("http://localhost:4566")
+
+	topic, err := storage.CreateTopic(ctx, "orders", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	matching, err := storage.Subscribe(ctx, topic.ARN, "http", "http://localhost/matching", map[string]string{
+		attrFilterPolicy: `{"status": ["shipped"]}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filtered, err := storage.Subscribe(ctx, topic.ARN, "http", "http://localhost/filtered", map[string]string{
+		attrFilterPolicy: `{"status": ["cancelled"]}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := storage.Publish(ctx, topic.ARN, &PublishRequest{
+		Message:           "order 1",
+		MessageAttributes: map[string]MessageAttribute{"status": {DataType: "String", StringValue: "shipped"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	deliveries, err := storage.GetDeliveries(ctx, topic.ARN, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statuses := make(map[string]string)
+	for _, d := range deliveries {
+		statuses[d.SubscriptionARN] = d.Status
+	}
+
+	if got := statuses[matching.ARN]; got != DeliveryStatusSuccess {
+		t.Errorf("expected %s for the matching subscription, got %q", DeliveryStatusSuccess, got)
+	}
+
+	if got := statuses[filtered.ARN]; got != DeliveryStatusFiltered {
+		t.Errorf("expected %s for the filtered subscription, got %q", DeliveryStatusFiltered, got)
+	}
+}
+
+func TestSubscribeInvalidFilterPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	storage := NewMemoryStorage("http://localhost:4566")
+
+	topic, err := storage.CreateTopic(ctx, "orders", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := storage.Subscribe(ctx, topic.ARN, "http", "http://localhost", map[string]string{attrFilterPolicy: "{"}); err == nil {
+		t.Error("expected an error for an invalid filter policy")
+	}
+}
+
+func TestDeliveriesCappedPerTopic(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	storage := NewMemoryStorage("http://localhost:4566")
+
+	busy, err := storage.CreateTopic(ctx, "busy", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	quiet, err := storage.CreateTopic(ctx, "quiet", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, topic := range []*Topic{busy, quiet} {
+		if _, err := storage.Subscribe(ctx, topic.ARN, "http", "http://localhost", nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := storage.Publish(ctx, quiet.ARN, &PublishRequest{Message: "quiet"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var last *PublishResponse
+
+	for range maxDeliveriesPerTopic + 5 {
+		if last, err = storage.Publish(ctx, busy.ARN, &PublishRequest{Message: "busy"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deliveries, err := storage.GetDeliveries(ctx, busy.ARN, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(deliveries) != maxDeliveriesPerTopic {
+		t.Fatalf("expected %d deliveries of the busy topic, got %d", maxDeliveriesPerTopic, len(deliveries))
+	}
+
+	if got := deliveries[len(deliveries)-1].MessageID; got != last.MessageID {
+		t.Errorf("expected the latest delivery to be kept, got message %s", got)
+	}
+
+	if deliveries, _ := storage.GetDeliveries(ctx, quiet.ARN, ""); len(deliveries) != 1 {
+		t.Errorf("expected the delivery of the quiet topic to be kept, got %d", len(deliveries))
+	}
+}
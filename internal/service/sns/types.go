@@ -7,12 +7,23 @@ import (
 
 // Topic represents an SNS topic.
 type Topic struct {
-	ARN           string
-	Name          string
-	DisplayName   string
-	CreatedTime   time.Time
-	Attributes    map[string]string
-	Subscriptions map[string]*Subscription
+	ARN                       string
+	Name                      string
+	DisplayName               string
+	CreatedTime               time.Time
+	Attributes                map[string]string
+	Subscriptions             map[string]*Subscription
+	FifoTopic                 bool
+	ContentBasedDeduplication bool
+	SequenceCounter           uint64
+	DeduplicationCache        map[string]DeduplicationEntry `json:"-"` // deduplicationID -> entry (FIFO only)
+}
+
+// DeduplicationEntry holds deduplication information for FIFO topics.
+type DeduplicationEntry struct {
+	MessageID      string
+	SequenceNumber string
+	ExpiresAt      time.Time
 }
 
 // Subscription represents an SNS subscription.
@@ -96,6 +107,31 @@ type PublishResponse struct {
 	SequenceNumber string `json:"SequenceNumber,omitempty"`
 }
 
+// Delivery statuses recorded for each subscription a message is published to.
+// FILTERED messages were not delivered because the subscription's filter policy rejected them.
+const (
+	DeliveryStatusSuccess  = "SUCCESS"
+	DeliveryStatusFailure  = "FAILURE"
+	DeliveryStatusFiltered = "FILTERED"
+)
+
+// Delivery records the outcome of delivering a published message to a subscription.
+type Delivery struct {
+	MessageID       string    `json:"MessageId"`
+	TopicARN        string    `json:"TopicArn"`
+	SubscriptionARN string    `json:"SubscriptionArn"`
+	Protocol        string    `json:"Protocol"`
+	Endpoint        string    `json:"Endpoint"`
+	Status          string    `json:"Status"`
+	Error           string    `json:"Error,omitempty"`
+	DeliveredAt     time.Time `json:"DeliveredAt"`
+}
+
+// GetDeliveriesResponse is the response for the kumo-specific GetDeliveries endpoint.
+type GetDeliveriesResponse struct {
+	Deliveries []Delivery `json:"Deliveries"`
+}
+
 // ListSubscriptionsRequest is the request for ListSubscriptions.
 type ListSubscriptionsRequest struct {
 	NextToken string `json:"NextToken,omitempty"`
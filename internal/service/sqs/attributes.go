@@ -0,0 +1,205 @@
+package sqs
+
+import (
+	"crypto/md5" //nolint:gosec // MD5 is required by SQS spec for message attribute hash
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// maxMessageAttributes is the maximum number of message attributes per message.
+const maxMessageAttributes = 10
+
+// System attribute names returned by ReceiveMessage.
+const (
+	attrAWSTraceHeader         = "AWSTraceHeader"
+	attrSenderID               = "SenderId"
+	attrMessageGroupID         = "MessageGroupId"
+	attrMessageDeduplicationID = "MessageDeduplicationId"
+	attrSequenceNumber         = "SequenceNumber"
+)
+
+// validateMessageAttributes checks the message attributes of a message.
+func validateMessageAttributes(attrs map[string]MessageAttributeValue) *QueueError {
+	if len(attrs) > maxMessageAttributes {
+		return &QueueError{
+			Code:    "InvalidParameterValue",
+			Message: fmt.Sprintf("Number of message attributes [%d] exceeds the allowed maximum [%d].", len(attrs), maxMessageAttributes),
+		}
+	}
+
+	for name, value := range attrs {
+		lower := strings.ToLower(name)
+		if name == "" || strings.HasPrefix(lower, "aws.") || strings.HasPrefix(lower, "amazon.") {
+			return &QueueError{
+				Code:    "InvalidParameterValue",
+				Message: "You can't use message attribute names beginning with AWS. or Amazon. These strings are reserved for internal use.",
+			}
+		}
+
+		if err := validateAttributeValue("user", name, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateMessageSystemAttributes checks the message system attributes of a message.
+// AWSTraceHeader is the only system attribute that can be sent.
+func validateMessageSystemAttributes(attrs map[string]MessageAttributeValue) *QueueError {
+	for name, value := range attrs {
+		if name != attrAWSTraceHeader {
+			return &QueueError{
+				Code:    "InvalidParameterValue",
+				Message: fmt.Sprintf("Message system attribute name '%s' is invalid.", name),
+			}
+		}
+
+		if value.DataType != "String" {
+			return &QueueError{
+				Code:    "InvalidParameterValue",
+				Message: fmt.Sprintf("Message system attribute '%s' must be of type 'String'.", name),
+			}
+		}
+
+		if err := validateAttributeValue("system", name, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateAttributeValue checks the data type and value of a message attribute.
+func validateAttributeValue(kind, name string, value MessageAttributeValue) *QueueError {
+	switch attributeBaseType(value.DataType) {
+	case "String", "Number":
+		if value.StringValue == "" {
+			return &QueueError{
+				Code:    "InvalidParameterValue",
+				Message: fmt.Sprintf("Message (%s) attribute '%s' must contain a non-empty value of type 'String'.", kind, name),
+			}
+		}
+	case "Binary":
+		if len(value.BinaryValue) == 0 {
+			return &QueueError{
+				Code:    "InvalidParameterValue",
+				Message: fmt.Sprintf("Message (%s) attribute '%s' must contain a non-empty value of type 'Binary'.", kind, name),
+			}
+		}
+	default:
+		return &QueueError{
+			Code: "InvalidParameterValue",
+			Message: fmt.Sprintf("The type of message (%s) attribute '%s' is invalid. "+
+				"You must use only the following supported type prefixes: Binary, Number, String.", kind, name),
+		}
+	}
+
+	return nil
+}
+
+// attributeBaseType returns the data type of a message attribute without its custom
+// suffix, e.g. Number for Number.float.
+func attributeBaseType(dataType string) string {
+	base, _, _ := strings.Cut(dataType, ".")
+
+	return base
+}
+
+// md5OfMessageAttributes returns the MD5 digest of message attributes as SQS computes it:
+// for each attribute in name order, the length-prefixed name and data type, a transport
+// type byte (1 for strings, 2 for binary) and the length-prefixed value. It returns ""
+// if there are no attributes.
+func md5OfMessageAttributes(attrs map[string]MessageAttributeValue) string {
+	if len(attrs) == 0 {
+		return ""
+	}
+
+	var buf []byte
+
+	appendLengthPrefixed := func(b []byte) {
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(b))) //nolint:gosec // attribute values are bounded by the maximum message size
+		buf = append(buf, b...)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(attrs)) {
+		value := attrs[name]
+
+		appendLengthPrefixed([]byte(name))
+		appendLengthPrefixed([]byte(value.DataType))
+
+		if attributeBaseType(value.DataType) == "Binary" {
+			buf = append(buf, 2)
+			appendLengthPrefixed(value.BinaryValue)
+		} else {
+			buf = append(buf, 1)
+			appendLengthPrefixed([]byte(value.StringValue))
+		}
+	}
+
+	sum := md5.Sum(buf) //nolint:gosec // MD5 is required by SQS spec
+
+	return hex.EncodeToString(sum[:])
+}
+
+// selectMessageAttributes returns the message attributes matching names, which may
+// contain All or .* for every attribute and prefixes ending in .* such as foo.*.
+func selectMessageAttributes(attrs map[string]MessageAttributeValue, names []string) map[string]MessageAttributeValue {
+	if len(attrs) == 0 || len(names) == 0 {
+		return nil
+	}
+
+	selected := make(map[string]MessageAttributeValue)
+
+	for name, value := range attrs {
+		for _, pattern := range names {
+			if pattern == "All" || pattern == ".*" || pattern == name {
+				selected[name] = value
+
+				break
+			}
+
+			if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasSuffix(prefix, ".") && strings.HasPrefix(name, prefix) {
+				selected[name] = value
+
+				break
+			}
+		}
+	}
+
+	if len(selected) == 0 {
+		return nil
+	}
+
+	return selected
+}
+
+// selectSystemAttributes returns the system attributes matching names, which may contain
+// All for every attribute.
+func selectSystemAttributes(attrs map[string]string, names []string) map[string]string {
+	if len(names) == 0 {
+		return nil
+	}
+
+	selected := make(map[string]string)
+
+	for name, value := range attrs {
+		if value == "" {
+			continue
+		}
+
+		if slices.Contains(names, "All") || slices.Contains(names, name) {
+			selected[name] = value
+		}
+	}
+
+	if len(selected) == 0 {
+		return nil
+	}
+
+	return selected
+}
@@ -0,0 +1,118 @@
+package sqs
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestMD5OfMessageAttributes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		attrs map[string]MessageAttributeValue
+		want  string
+	}{
+		{
+			name:  "no attributes",
+			attrs: nil,
+			want:  "",
+		},
+		{
+			name: "number attribute",
+			attrs: map[string]MessageAttributeValue{
+				"timestamp": {DataType: "Number", StringValue: "1493147359900"},
+			},
+			want: "235c5c510d26fb653d073faed50ae77c",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := md5OfMessageAttributes(tt.attrs); got != tt.want {
+				t.Errorf("md5OfMessageAttributes() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectMessageAttributes(t *testing.T) {
+	t.Parallel()
+
+	attrs := map[string]MessageAttributeValue{
+		"color":      {DataType: "String", StringValue: "red"},
+		"order.id":   {DataType: "Number", StringValue: "1"},
+		"order.note": {DataType: "String", StringValue: "gift"},
+	}
+
+	tests := []struct {
+		name  string
+		names []string
+		want  []string
+	}{
+		{name: "none requested", names: nil, want: nil},
+		{name: "all", names: []string{"All"}, want: []string{"color", "order.id", "order.note"}},
+		{name: "wildcard", names: []string{".*"}, want: []string{"color", "order.id", "order.note"}},
+		{name: "exact name", names: []string{"color"}, want: []string{"color"}},
+		{name: "prefix", names: []string{"order.*"}, want: []string{"order.id", "order.note"}},
+		{name: "unknown name", names: []string{"size"}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := slices.Sorted(maps.Keys(selectMessageAttributes(attrs, tt.names)))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("selectMessageAttributes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateMessageAttributes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		attrs   map[string]MessageAttributeValue
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			attrs: map[string]MessageAttributeValue{
+				"s": {DataType: "String", StringValue: "v"},
+				"n": {DataType: "Number.int", StringValue: "1"},
+				"b": {DataType: "Binary", BinaryValue: []byte{1}},
+			},
+		},
+		{
+			name:    "reserved name",
+			attrs:   map[string]MessageAttributeValue{"AWS.key": {DataType: "String", StringValue: "v"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid data type",
+			attrs:   map[string]MessageAttributeValue{"key": {DataType: "Boolean", StringValue: "true"}},
+			wantErr: true,
+		},
+		{
+			name:    "empty value",
+			attrs:   map[string]MessageAttributeValue{"key": {DataType: "String"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := validateMessageAttributes(tt.attrs); (err != nil) != tt.wantErr {
+				t.Errorf("validateMessageAttributes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
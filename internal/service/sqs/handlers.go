@@ -7,11 +7,24 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
 )
 
+const (
+	// maxBatchEntries is the maximum number of entries in a batch request.
+	maxBatchEntries = 10
+
+	// maxVisibilityTimeout is the maximum visibility timeout of a message, 12 hours.
+	maxVisibilityTimeout = 43200
+)
+
+// batchEntryIDPattern matches valid batch entry IDs.
+var batchEntryIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,80}$`)
+
 // CreateQueue handles the CreateQueue action.
 func (s *Service) CreateQueue(w http.ResponseWriter, r *http.Request) {
 	var req CreateQueueRequest
@@ -249,7 +262,19 @@ func (s *Service) SendMessage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	msg, err := s.storage.SendMessage(r.Context(), req.QueueURL, req.MessageBody, req.DelaySeconds, req.MessageAttributes, req.MessageGroupID, req.MessageDeduplicationID)
+	if qErr := validateMessageAttributes(req.MessageAttributes); qErr != nil {
+		writeSQSError(w, qErr.Code, qErr.Message, http.StatusBadRequest)
+
+		return
+	}
+
+	if qErr := validateMessageSystemAttributes(req.MessageSystemAttributes); qErr != nil {
+		writeSQSError(w, qErr.Code, qErr.Message, http.StatusBadRequest)
+
+		return
+	}
+
+	msg, err := s.storage.SendMessage(r.Context(), req.QueueURL, req.MessageBody, req.DelaySeconds, req.MessageAttributes, req.MessageSystemAttributes, req.MessageGroupID, req.MessageDeduplicationID)
 	if err != nil {
 		var qErr *QueueError
 		if errors.As(err, &qErr) {
@@ -264,9 +289,11 @@ func (s *Service) SendMessage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	writeJSONResponse(w, SendMessageResponse{
-		MessageID:        msg.MessageID,
-		MD5OfMessageBody: msg.MD5OfBody,
-		SequenceNumber:   msg.SequenceNumber,
+		MessageID:                    msg.MessageID,
+		MD5OfMessageBody:             msg.MD5OfBody,
+		MD5OfMessageAttributes:       md5OfMessageAttributes(msg.MessageAttributes),
+		MD5OfMessageSystemAttributes: md5OfMessageAttributes(msg.MessageSystemAttributes),
+		SequenceNumber:               msg.SequenceNumber,
 	})
 }
 
@@ -285,30 +312,17 @@ func (s *Service) SendMessageBatch(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if len(req.Entries) == 0 {
-		writeSQSError(w, "EmptyBatchRequest", "There should be at least one SendMessageBatchRequestEntry in the request", http.StatusBadRequest)
-
-		return
+	ids := make([]string, len(req.Entries))
+	for i, entry := range req.Entries {
+		ids[i] = entry.ID
 	}
 
-	if len(req.Entries) > 10 {
-		writeSQSError(w, "TooManyEntriesInBatchRequest", "Maximum number of entries per request are 10", http.StatusBadRequest)
+	if qErr := validateBatchEntryIDs("SendMessageBatchRequestEntry", ids); qErr != nil {
+		writeSQSError(w, qErr.Code, qErr.Message, http.StatusBadRequest)
 
 		return
 	}
 
-	// Check for duplicate IDs.
-	seen := make(map[string]struct{}, len(req.Entries))
-	for _, entry := range req.Entries {
-		if _, exists := seen[entry.ID]; exists {
-			writeSQSError(w, "BatchEntryIdsNotDistinct", "Two or more batch entries in the request have the same Id", http.StatusBadRequest)
-
-			return
-		}
-
-		seen[entry.ID] = struct{}{}
-	}
-
 	resp := s.processBatchEntries(r.Context(), req.QueueURL, req.Entries)
 
 	writeJSONResponse(w, resp)
@@ -330,7 +344,19 @@ func (s *Service) processBatchEntries(ctx context.Context, queueURL string, entr
 			continue
 		}
 
-		msg, err := s.storage.SendMessage(ctx, queueURL, entry.MessageBody, entry.DelaySeconds, entry.MessageAttributes, entry.MessageGroupID, entry.MessageDeduplicationID)
+		if qErr := validateMessageAttributes(entry.MessageAttributes); qErr != nil {
+			resp.Failed = append(resp.Failed, s.batchEntryError(entry.ID, qErr))
+
+			continue
+		}
+
+		if qErr := validateMessageSystemAttributes(entry.MessageSystemAttributes); qErr != nil {
+			resp.Failed = append(resp.Failed, s.batchEntryError(entry.ID, qErr))
+
+			continue
+		}
+
+		msg, err := s.storage.SendMessage(ctx, queueURL, entry.MessageBody, entry.DelaySeconds, entry.MessageAttributes, entry.MessageSystemAttributes, entry.MessageGroupID, entry.MessageDeduplicationID)
 		if err != nil {
 			resp.Failed = append(resp.Failed, s.batchEntryError(entry.ID, err))
 
@@ -338,10 +364,12 @@ func (s *Service) processBatchEntries(ctx context.Context, queueURL string, entr
 		}
 
 		resp.Successful = append(resp.Successful, SendMessageBatchResultEntry{
-			ID:               entry.ID,
-			MessageID:        msg.MessageID,
-			MD5OfMessageBody: msg.MD5OfBody,
-			SequenceNumber:   msg.SequenceNumber,
+			ID:                           entry.ID,
+			MessageID:                    msg.MessageID,
+			MD5OfMessageBody:             msg.MD5OfBody,
+			MD5OfMessageAttributes:       md5OfMessageAttributes(msg.MessageAttributes),
+			MD5OfMessageSystemAttributes: md5OfMessageAttributes(msg.MessageSystemAttributes),
+			SequenceNumber:               msg.SequenceNumber,
 		})
 	}
 
@@ -406,24 +434,31 @@ func (s *Service) ReceiveMessage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// AttributeNames is the deprecated name of MessageSystemAttributeNames.
+	systemAttributeNames := slices.Concat(req.AttributeNames, req.MessageSystemAttributeNames)
+
 	writeJSONResponse(w, ReceiveMessageResponse{
-		Messages: convertMessagesToResponse(messages),
+		Messages: convertMessagesToResponse(messages, systemAttributeNames, req.MessageAttributeNames),
 	})
 }
 
-// convertMessagesToResponse converts Message slice to MessageResponse slice.
-func convertMessagesToResponse(messages []*Message) []MessageResponse {
+// convertMessagesToResponse converts Message slice to MessageResponse slice, returning
+// the system attributes and message attributes selected by name.
+func convertMessagesToResponse(messages []*Message, systemAttributeNames, messageAttributeNames []string) []MessageResponse {
 	result := make([]MessageResponse, len(messages))
 
 	for i, msg := range messages {
+		messageAttributes := selectMessageAttributes(msg.MessageAttributes, messageAttributeNames)
+
 		result[i] = MessageResponse{
-			MessageID:         msg.MessageID,
-			ReceiptHandle:     msg.ReceiptHandle,
-			MD5OfBody:         msg.MD5OfBody,
-			Body:              msg.Body,
-			Attributes:        msg.Attributes,
-			MessageAttributes: msg.MessageAttributes,
-			SequenceNumber:    msg.SequenceNumber,
+			MessageID:              msg.MessageID,
+			ReceiptHandle:          msg.ReceiptHandle,
+			MD5OfBody:              msg.MD5OfBody,
+			Body:                   msg.Body,
+			Attributes:             selectSystemAttributes(msg.Attributes, systemAttributeNames),
+			MD5OfMessageAttributes: md5OfMessageAttributes(messageAttributes),
+			MessageAttributes:      messageAttributes,
+			SequenceNumber:         msg.SequenceNumber,
 		}
 	}
 
@@ -482,38 +517,135 @@ func (s *Service) DeleteMessageBatch(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if len(req.Entries) == 0 {
-		writeSQSError(w, "EmptyBatchRequest", "There should be at least one DeleteMessageBatchRequestEntry in the request", http.StatusBadRequest)
+	ids := make([]string, len(req.Entries))
+	for i, entry := range req.Entries {
+		ids[i] = entry.ID
+	}
+
+	if qErr := validateBatchEntryIDs("DeleteMessageBatchRequestEntry", ids); qErr != nil {
+		writeSQSError(w, qErr.Code, qErr.Message, http.StatusBadRequest)
+
+		return
+	}
+
+	resp := s.processDeleteBatchEntries(r.Context(), req.QueueURL, req.Entries)
+
+	writeJSONResponse(w, resp)
+}
+
+// processDeleteBatchEntries processes individual entries in a DeleteMessageBatch request.
+func (s *Service) processDeleteBatchEntries(ctx context.Context, queueURL string, entries []DeleteMessageBatchRequestEntry) DeleteMessageBatchResponse {
+	var resp DeleteMessageBatchResponse
+
+	for _, entry := range entries {
+		if entry.ReceiptHandle == "" {
+			resp.Failed = append(resp.Failed, BatchResultErrorEntry{
+				ID:          entry.ID,
+				SenderFault: true,
+				Code:        "MissingParameter",
+				Message:     "The request must contain the parameter ReceiptHandle",
+			})
+
+			continue
+		}
+
+		if err := s.storage.DeleteMessage(ctx, queueURL, entry.ReceiptHandle); err != nil {
+			resp.Failed = append(resp.Failed, s.batchEntryError(entry.ID, err))
+
+			continue
+		}
+
+		resp.Successful = append(resp.Successful, DeleteMessageBatchResultEntry{
+			ID: entry.ID,
+		})
+	}
+
+	return resp
+}
+
+// ChangeMessageVisibility handles the ChangeMessageVisibility action.
+func (s *Service) ChangeMessageVisibility(w http.ResponseWriter, r *http.Request) {
+	var req ChangeMessageVisibilityRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeSQSError(w, "InvalidParameterValue", "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.QueueURL == "" {
+		writeSQSError(w, "MissingParameter", "QueueUrl is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.ReceiptHandle == "" {
+		writeSQSError(w, "MissingParameter", "ReceiptHandle is required", http.StatusBadRequest)
 
 		return
 	}
 
-	if len(req.Entries) > 10 {
-		writeSQSError(w, "TooManyEntriesInBatchRequest", "Maximum number of entries per request are 10", http.StatusBadRequest)
+	if req.VisibilityTimeout == nil {
+		writeSQSError(w, "MissingParameter", "VisibilityTimeout is required", http.StatusBadRequest)
 
 		return
 	}
 
-	// Check for duplicate IDs.
-	seen := make(map[string]struct{}, len(req.Entries))
-	for _, entry := range req.Entries {
-		if _, exists := seen[entry.ID]; exists {
-			writeSQSError(w, "BatchEntryIdsNotDistinct", "Two or more batch entries in the request have the same Id", http.StatusBadRequest)
+	if qErr := validateVisibilityTimeout(*req.VisibilityTimeout); qErr != nil {
+		writeSQSError(w, qErr.Code, qErr.Message, http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.storage.ChangeMessageVisibility(r.Context(), req.QueueURL, req.ReceiptHandle, *req.VisibilityTimeout); err != nil {
+		var qErr *QueueError
+		if errors.As(err, &qErr) {
+			writeSQSError(w, qErr.Code, qErr.Message, http.StatusBadRequest)
 
 			return
 		}
 
-		seen[entry.ID] = struct{}{}
+		writeSQSError(w, "InternalError", "Internal server error", http.StatusInternalServerError)
+
+		return
 	}
 
-	resp := s.processDeleteBatchEntries(r.Context(), req.QueueURL, req.Entries)
+	writeJSONResponse(w, struct{}{})
+}
+
+// ChangeMessageVisibilityBatch handles the ChangeMessageVisibilityBatch action.
+func (s *Service) ChangeMessageVisibilityBatch(w http.ResponseWriter, r *http.Request) {
+	var req ChangeMessageVisibilityBatchRequest
+	if err := readJSONRequest(r, &req); err != nil {
+		writeSQSError(w, "InvalidParameterValue", "Failed to parse request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.QueueURL == "" {
+		writeSQSError(w, "MissingParameter", "QueueUrl is required", http.StatusBadRequest)
+
+		return
+	}
+
+	ids := make([]string, len(req.Entries))
+	for i, entry := range req.Entries {
+		ids[i] = entry.ID
+	}
+
+	if qErr := validateBatchEntryIDs("ChangeMessageVisibilityBatchRequestEntry", ids); qErr != nil {
+		writeSQSError(w, qErr.Code, qErr.Message, http.StatusBadRequest)
+
+		return
+	}
+
+	resp := s.processChangeVisibilityBatchEntries(r.Context(), req.QueueURL, req.Entries)
 
 	writeJSONResponse(w, resp)
 }
 
-// processDeleteBatchEntries processes individual entries in a DeleteMessageBatch request.
-func (s *Service) processDeleteBatchEntries(ctx context.Context, queueURL string, entries []DeleteMessageBatchRequestEntry) DeleteMessageBatchResponse {
-	var resp DeleteMessageBatchResponse
+// processChangeVisibilityBatchEntries processes individual entries in a ChangeMessageVisibilityBatch request.
+func (s *Service) processChangeVisibilityBatchEntries(ctx context.Context, queueURL string, entries []ChangeMessageVisibilityBatchRequestEntry) ChangeMessageVisibilityBatchResponse {
+	var resp ChangeMessageVisibilityBatchResponse
 
 	for _, entry := range entries {
 		if entry.ReceiptHandle == "" {
@@ -527,13 +659,19 @@ func (s *Service) processDeleteBatchEntries(ctx context.Context, queueURL string
 			continue
 		}
 
-		if err := s.storage.DeleteMessage(ctx, queueURL, entry.ReceiptHandle); err != nil {
+		if qErr := validateVisibilityTimeout(entry.VisibilityTimeout); qErr != nil {
+			resp.Failed = append(resp.Failed, s.batchEntryError(entry.ID, qErr))
+
+			continue
+		}
+
+		if err := s.storage.ChangeMessageVisibility(ctx, queueURL, entry.ReceiptHandle, entry.VisibilityTimeout); err != nil {
 			resp.Failed = append(resp.Failed, s.batchEntryError(entry.ID, err))
 
 			continue
 		}
 
-		resp.Successful = append(resp.Successful, DeleteMessageBatchResultEntry{
+		resp.Successful = append(resp.Successful, ChangeMessageVisibilityBatchResultEntry{
 			ID: entry.ID,
 		})
 	}
@@ -541,6 +679,48 @@ func (s *Service) processDeleteBatchEntries(ctx context.Context, queueURL string
 	return resp
 }
 
+// validateBatchEntryIDs checks the number and IDs of the entries in a batch request.
+func validateBatchEntryIDs(entryType string, ids []string) *QueueError {
+	if len(ids) == 0 {
+		return &QueueError{Code: "EmptyBatchRequest", Message: "There should be at least one " + entryType + " in the request"}
+	}
+
+	if len(ids) > maxBatchEntries {
+		return &QueueError{Code: "TooManyEntriesInBatchRequest", Message: fmt.Sprintf("Maximum number of entries per request are %d", maxBatchEntries)}
+	}
+
+	seen := make(map[string]struct{}, len(ids))
+
+	for _, id := range ids {
+		if !batchEntryIDPattern.MatchString(id) {
+			return &QueueError{
+				Code:    "InvalidBatchEntryId",
+				Message: "A batch entry id can only contain alphanumeric characters, hyphens and underscores. It can be at most 80 letters long.",
+			}
+		}
+
+		if _, exists := seen[id]; exists {
+			return &QueueError{Code: "BatchEntryIdsNotDistinct", Message: "Two or more batch entries in the request have the same Id"}
+		}
+
+		seen[id] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateVisibilityTimeout checks that a visibility timeout is within the allowed range.
+func validateVisibilityTimeout(timeout int) *QueueError {
+	if timeout < 0 || timeout > maxVisibilityTimeout {
+		return &QueueError{
+			Code:    "InvalidParameterValue",
+			Message: fmt.Sprintf("Value %d for parameter VisibilityTimeout is invalid. Reason: Must be between 0 and %d.", timeout, maxVisibilityTimeout),
+		}
+	}
+
+	return nil
+}
+
 // PurgeQueue handles the PurgeQueue action.
 func (s *Service) PurgeQueue(w http.ResponseWriter, r *http.Request) {
 	var req PurgeQueueRequest
@@ -559,7 +739,12 @@ func (s *Service) PurgeQueue(w http.ResponseWriter, r *http.Request) {
 	if err := s.storage.PurgeQueue(r.Context(), req.QueueURL); err != nil {
 		var qErr *QueueError
 		if errors.As(err, &qErr) {
-			writeSQSError(w, qErr.Code, qErr.Message, http.StatusBadRequest)
+			status := http.StatusBadRequest
+			if qErr.Code == "PurgeQueueInProgress" {
+				status = http.StatusForbidden
+			}
+
+			writeSQSError(w, qErr.Code, qErr.Message, status)
 
 			return
 		}
@@ -707,6 +892,10 @@ func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
 		s.DeleteMessage(w, r)
 	case "DeleteMessageBatch":
 		s.DeleteMessageBatch(w, r)
+	case "ChangeMessageVisibility":
+		s.ChangeMessageVisibility(w, r)
+	case "ChangeMessageVisibilityBatch":
+		s.ChangeMessageVisibilityBatch(w, r)
 	case "PurgeQueue":
 		s.PurgeQueue(w, r)
 	case "GetQueueAttributes":
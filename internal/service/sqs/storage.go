@@ -16,6 +16,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/sivchari/kumo/internal/service"
 	"github.com/sivchari/kumo/internal/storage"
 )
 
@@ -35,12 +36,14 @@ type Storage interface {
 	ListQueueTags(ctx context.Context, queueURL string) (map[string]string, error)
 	TagQueue(ctx context.Context, queueURL string, tags map[string]string) error
 	UntagQueue(ctx context.Context, queueURL string, tagKeys []string) error
-	SendMessage(ctx context.Context, queueURL, body string, delaySeconds int, messageAttributes map[string]MessageAttributeValue, messageGroupID, messageDeduplicationID string) (*Message, error)
+	SendMessage(ctx context.Context, queueURL, body string, delaySeconds int, messageAttributes, messageSystemAttributes map[string]MessageAttributeValue, messageGroupID, messageDeduplicationID string) (*Message, error)
 	ReceiveMessage(ctx context.Context, queueURL string, maxMessages, visibilityTimeout, waitTimeSeconds int) ([]*Message, error)
 	DeleteMessage(ctx context.Context, queueURL, receiptHandle string) error
+	ChangeMessageVisibility(ctx context.Context, queueURL, receiptHandle string, visibilityTimeout int) error
 	PurgeQueue(ctx context.Context, queueURL string) error
 	GetQueueAttributes(ctx context.Context, queueURL string, attributeNames []string) (map[string]string, error)
 	SetQueueAttributes(ctx context.Context, queueURL string, attributes map[string]string) error
+	Reset(ctx context.Context) error
 }
 
 // QueueError represents an SQS queue error.
@@ -64,6 +67,9 @@ var (
 	ErrMessageNotInflight   = &QueueError{Code: "MessageNotInflight", Message: "The message is not in flight"}
 )
 
+// purgeQueueInterval is how often a queue can be purged.
+const purgeQueueInterval = 60 * time.Second
+
 // Option is a configuration option for MemoryStorage.
 type Option func(*MemoryStorage)
 
@@ -95,6 +101,7 @@ type QueueData struct {
 	Inflight           map[string]*Message           `json:"-"`               // receiptHandle -> message
 	DeduplicationCache map[string]DeduplicationEntry `json:"-"`               // deduplicationID -> entry (FIFO only)
 	SequenceCounter    uint64                        `json:"sequenceCounter"` // Per-queue sequence number (FIFO only)
+	LastPurged         time.Time                     `json:"-"`
 	notify             chan struct{}                 // signals new message arrival for long polling
 }
 
@@ -163,6 +170,16 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Queues = make(map[string]*QueueData)
+
+	return nil
+}
+
 // resolveQueueData finds a queue by URL, tolerating hostname differences.
 // It must be called with s.mu held.
 func (s *MemoryStorage) resolveQueueData(queueURL string) (string, *QueueData, error) {
@@ -193,11 +210,11 @@ func (s *MemoryStorage) resolveQueueData(queueURL string) (string, *QueueData, e
 }
 
 // CreateQueue creates a new queue.
-func (s *MemoryStorage) CreateQueue(_ context.Context, name string, attributes, tags map[string]string) (*Queue, error) {
+func (s *MemoryStorage) CreateQueue(ctx context.Context, name string, attributes, tags map[string]string) (*Queue, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	queueURL := fmt.Sprintf("%s/000000000000/%s", s.baseURL, name)
+	queueURL := fmt.Sprintf("%s/%s/%s", s.baseURL, service.AccountID(ctx), name)
 
 	if qd, exists := s.Queues[queueURL]; exists {
 		return qd.Queue, nil
@@ -216,7 +233,7 @@ func (s *MemoryStorage) CreateQueue(_ context.Context, name string, attributes,
 	queue := &Queue{
 		Name:                      name,
 		URL:                       queueURL,
-		ARN:                       fmt.Sprintf("arn:aws:sqs:us-east-1:000000000000:%s", name),
+		ARN:                       fmt.Sprintf("arn:aws:sqs:%s:%s:%s", service.Region(ctx), service.AccountID(ctx), name),
 		Tags:                      maps.Clone(tags),
 		CreatedTimestamp:          now,
 		LastModifiedTimestamp:     now,
@@ -427,7 +444,7 @@ func (qd *QueueData) updateFIFOCache(dedupID, messageID string) {
 }
 
 // SendMessage sends a message to a queue.
-func (s *MemoryStorage) SendMessage(_ context.Context, queueURL, body string, delaySeconds int, messageAttributes map[string]MessageAttributeValue, messageGroupID, messageDeduplicationID string) (*Message, error) {
+func (s *MemoryStorage) SendMessage(ctx context.Context, queueURL, body string, delaySeconds int, messageAttributes, messageSystemAttributes map[string]MessageAttributeValue, messageGroupID, messageDeduplicationID string) (*Message, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -462,23 +479,33 @@ func (s *MemoryStorage) SendMessage(_ context.Context, queueURL, body string, de
 	// MD5 is required by SQS specification for message body hash.
 	md5Hash := md5.Sum([]byte(body)) //nolint:gosec // MD5 is required by SQS spec
 	msg := &Message{
-		MessageID:              uuid.New().String(),
-		Body:                   body,
-		MD5OfBody:              hex.EncodeToString(md5Hash[:]),
-		MessageAttributes:      messageAttributes,
-		SentTimestamp:          now,
-		VisibleAt:              now.Add(time.Duration(delay) * time.Second),
-		MessageGroupID:         messageGroupID,
-		MessageDeduplicationID: messageDeduplicationID,
-		SequenceNumber:         sequenceNumber,
+		MessageID:               uuid.New().String(),
+		Body:                    body,
+		MD5OfBody:               hex.EncodeToString(md5Hash[:]),
+		MessageAttributes:       messageAttributes,
+		MessageSystemAttributes: messageSystemAttributes,
+		SentTimestamp:           now,
+		VisibleAt:               now.Add(time.Duration(delay) * time.Second),
+		MessageGroupID:          messageGroupID,
+		MessageDeduplicationID:  messageDeduplicationID,
+		SequenceNumber:          sequenceNumber,
 		Attributes: map[string]string{
 			"SentTimestamp":                    fmt.Sprintf("%d", now.UnixMilli()),
 			"ApproximateReceiveCount":          "0",
 			"ApproximateFirstReceiveTimestamp": "",
+			attrSenderID:                       service.AccountID(ctx),
 		},
 	}
 
+	if traceHeader, ok := messageSystemAttributes[attrAWSTraceHeader]; ok {
+		msg.Attributes[attrAWSTraceHeader] = traceHeader.StringValue
+	}
+
 	if qd.Queue.FifoQueue {
+		msg.Attributes[attrMessageGroupID] = messageGroupID
+		msg.Attributes[attrMessageDeduplicationID] = dedupID
+		msg.Attributes[attrSequenceNumber] = sequenceNumber
+
 		qd.updateFIFOCache(dedupID, msg.MessageID)
 	}
 
@@ -545,6 +572,8 @@ func (s *MemoryStorage) receiveMessagesLocked(queueURL string, maxMessages, visi
 	}
 
 	now := time.Now()
+	qd.requeueExpired(now)
+
 	result := make([]*Message, 0, maxMessages)
 	remaining := make([]*Message, 0, len(qd.Messages))
 
@@ -587,6 +616,63 @@ func (s *MemoryStorage) receiveMessagesLocked(queueURL string, maxMessages, visi
 	return result, qd.notify, nil
 }
 
+// requeueExpired returns in-flight messages whose visibility timeout has expired to
+// the queue, in the order they were sent. Their receipt handles become invalid.
+func (qd *QueueData) requeueExpired(now time.Time) {
+	requeued := false
+
+	for handle, msg := range qd.Inflight {
+		if msg.VisibleAt.After(now) {
+			continue
+		}
+
+		delete(qd.Inflight, handle)
+		qd.Messages = append(qd.Messages, msg)
+		requeued = true
+	}
+
+	if requeued {
+		slices.SortStableFunc(qd.Messages, func(a, b *Message) int {
+			return a.SentTimestamp.Compare(b.SentTimestamp)
+		})
+	}
+}
+
+// ChangeMessageVisibility changes the visibility timeout of an in-flight message,
+// counted from now. A timeout of 0 makes the message visible immediately.
+func (s *MemoryStorage) ChangeMessageVisibility(_ context.Context, queueURL, receiptHandle string, visibilityTimeout int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, qd, err := s.resolveQueueData(queueURL)
+	if err != nil {
+		return err
+	}
+
+	msg, exists := qd.Inflight[receiptHandle]
+	if !exists {
+		return ErrReceiptHandleInvalid
+	}
+
+	now := time.Now()
+	if !msg.VisibleAt.After(now) {
+		return ErrMessageNotInflight
+	}
+
+	msg.VisibleAt = now.Add(time.Duration(visibilityTimeout) * time.Second)
+
+	if visibilityTimeout == 0 {
+		qd.requeueExpired(now)
+
+		select {
+		case qd.notify <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
 // DeleteMessage deletes a message from a queue.
 func (s *MemoryStorage) DeleteMessage(_ context.Context, queueURL, receiptHandle string) error {
 	s.mu.Lock()
@@ -616,8 +702,17 @@ func (s *MemoryStorage) PurgeQueue(_ context.Context, queueURL string) error {
 		return err
 	}
 
+	now := time.Now()
+	if now.Sub(qd.LastPurged) < purgeQueueInterval {
+		return &QueueError{
+			Code:    "PurgeQueueInProgress",
+			Message: fmt.Sprintf("Only one PurgeQueue operation on %s is allowed every 60 seconds.", qd.Queue.Name),
+		}
+	}
+
 	qd.Messages = make([]*Message, 0)
 	qd.Inflight = make(map[string]*Message)
+	qd.LastPurged = now
 
 	return nil
 }
@@ -633,6 +728,7 @@ func (s *MemoryStorage) GetQueueAttributes(_ context.Context, queueURL string, a
 	}
 
 	q := qd.Queue
+	counts := qd.countMessages(time.Now())
 	allAttrs := map[string]string{
 		"QueueArn":                              q.ARN,
 		"CreatedTimestamp":                      fmt.Sprintf("%d", q.CreatedTimestamp.Unix()),
@@ -642,8 +738,9 @@ func (s *MemoryStorage) GetQueueAttributes(_ context.Context, queueURL string, a
 		"DelaySeconds":                          fmt.Sprintf("%d", q.DelaySeconds),
 		"MaximumMessageSize":                    fmt.Sprintf("%d", q.MaxMessageSize),
 		"ReceiveMessageWaitTimeSeconds":         fmt.Sprintf("%d", q.ReceiveWaitTimeSeconds),
-		"ApproximateNumberOfMessages":           fmt.Sprintf("%d", len(qd.Messages)),
-		"ApproximateNumberOfMessagesNotVisible": fmt.Sprintf("%d", len(qd.Inflight)),
+		"ApproximateNumberOfMessages":           fmt.Sprintf("%d", counts.visible),
+		"ApproximateNumberOfMessagesNotVisible": fmt.Sprintf("%d", counts.notVisible),
+		"ApproximateNumberOfMessagesDelayed":    fmt.Sprintf("%d", counts.delayed),
 		"FifoQueue":                             fmt.Sprintf("%t", q.FifoQueue),
 		"ContentBasedDeduplication":             fmt.Sprintf("%t", q.ContentBasedDeduplication),
 	}
@@ -652,6 +749,8 @@ func (s *MemoryStorage) GetQueueAttributes(_ context.Context, queueURL string, a
 		allAttrs["RedrivePolicy"] = q.RedrivePolicy
 	}
 
+	maps.Copy(allAttrs, q.OtherAttributes)
+
 	// Check if "All" is requested.
 	if slices.Contains(attributeNames, "All") {
 		return allAttrs, nil
@@ -668,6 +767,38 @@ func (s *MemoryStorage) GetQueueAttributes(_ context.Context, queueURL string, a
 	return result, nil
 }
 
+// messageCounts holds the number of messages of a queue in each state.
+type messageCounts struct {
+	visible    int // available for retrieval
+	notVisible int // received but not yet deleted or returned to the queue
+	delayed    int // sent with a delay that has not passed yet
+}
+
+// countMessages counts the messages of the queue in each state at now. In-flight
+// messages whose visibility timeout has expired count as visible, as they are
+// returned to the queue on the next receive. Must be called under lock.
+func (qd *QueueData) countMessages(now time.Time) messageCounts {
+	var counts messageCounts
+
+	for _, msg := range qd.Messages {
+		if msg.VisibleAt.After(now) {
+			counts.delayed++
+		} else {
+			counts.visible++
+		}
+	}
+
+	for _, msg := range qd.Inflight {
+		if msg.VisibleAt.After(now) {
+			counts.notVisible++
+		} else {
+			counts.visible++
+		}
+	}
+
+	return counts
+}
+
 // SetQueueAttributes sets queue attributes.
 func (s *MemoryStorage) SetQueueAttributes(_ context.Context, queueURL string, attributes map[string]string) error {
 	s.mu.Lock()
@@ -702,6 +833,13 @@ func applyQueueAttributes(q *Queue, attrs map[string]string) {
 		case "RedrivePolicy":
 			q.RedrivePolicy = val
 			parseRedrivePolicy(q, val)
+		case "Policy", "RedriveAllowPolicy", "KmsMasterKeyId", "KmsDataKeyReusePeriodSeconds",
+			"SqsManagedSseEnabled", "DeduplicationScope", "FifoThroughputLimit":
+			if q.OtherAttributes == nil {
+				q.OtherAttributes = make(map[string]string)
+			}
+
+			q.OtherAttributes[key] = val
 		}
 	}
 }
@@ -723,14 +861,15 @@ func (s *MemoryStorage) moveToDeadLetterQueue(dlqArn string, msg *Message) {
 		if qd.Queue.ARN == dlqArn {
 			// Reset message for DLQ.
 			dlqMsg := &Message{
-				MessageID:         msg.MessageID,
-				Body:              msg.Body,
-				MD5OfBody:         msg.MD5OfBody,
-				Attributes:        maps.Clone(msg.Attributes),
-				MessageAttributes: msg.MessageAttributes,
-				SentTimestamp:     msg.SentTimestamp,
-				VisibleAt:         time.Now(),
-				ReceiveCount:      0,
+				MessageID:               msg.MessageID,
+				Body:                    msg.Body,
+				MD5OfBody:               msg.MD5OfBody,
+				Attributes:              maps.Clone(msg.Attributes),
+				MessageAttributes:       msg.MessageAttributes,
+				MessageSystemAttributes: msg.MessageSystemAttributes,
+				SentTimestamp:           msg.SentTimestamp,
+				VisibleAt:               time.Now(),
+				ReceiveCount:            0,
 			}
 
 			qd.Messages = append(qd.Messages, dlqMsg)
@@ -1,6 +1,7 @@
 package sqs
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -44,7 +45,7 @@ func TestMemoryStorage_ResolveQueueData_HostnameMismatch(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			msg, err := s.SendMessage(ctx, tt.queueURL, "hello", 0, nil, "", "")
+			msg, err := s.SendMessage(ctx, tt.queueURL, "hello", 0, nil, nil, "", "")
 			if tt.wantErr {
 				if err == nil {
 					t.Error("expected error, got nil")
@@ -139,3 +140,115 @@ func TestMemoryStorage_TagsLifecycle(t *testing.T) {
 		t.Fatalf("unexpected tags after untag: %#v", tags)
 	}
 }
+
+func TestMemoryStorage_ChangeMessageVisibility(t *testing.T) {
+	t.Parallel()
+
+	s := NewMemoryStorage("http://localhost:4566")
+	ctx := t.Context()
+
+	queue, err := s.CreateQueue(ctx, "visibility-queue", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.SendMessage(ctx, queue.URL, "hello", 0, nil, nil, "", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	received, err := s.ReceiveMessage(ctx, queue.URL, 1, 30, 0)
+	if err != nil || len(received) != 1 {
+		t.Fatalf("ReceiveMessage() = %d messages, error = %v", len(received), err)
+	}
+
+	handle := received[0].ReceiptHandle
+
+	if err := s.ChangeMessageVisibility(ctx, queue.URL, "unknown", 0); !errors.Is(err, ErrReceiptHandleInvalid) {
+		t.Errorf("ChangeMessageVisibility() with unknown handle error = %v, want %v", err, ErrReceiptHandleInvalid)
+	}
+
+	// A timeout of 0 makes the message visible again.
+	if err := s.ChangeMessageVisibility(ctx, queue.URL, handle, 0); err != nil {
+		t.Fatalf("ChangeMessageVisibility() error = %v", err)
+	}
+
+	received, err = s.ReceiveMessage(ctx, queue.URL, 1, 30, 0)
+	if err != nil || len(received) != 1 {
+		t.Fatalf("ReceiveMessage() = %d messages, error = %v", len(received), err)
+	}
+
+	if received[0].ReceiveCount != 2 {
+		t.Errorf("ReceiveCount = %d, want 2", received[0].ReceiveCount)
+	}
+
+	// The receipt handle of the first receive is no longer valid.
+	if err := s.ChangeMessageVisibility(ctx, queue.URL, handle, 10); !errors.Is(err, ErrReceiptHandleInvalid) {
+		t.Errorf("ChangeMessageVisibility() with stale handle error = %v, want %v", err, ErrReceiptHandleInvalid)
+	}
+}
+
+func TestMemoryStorage_PurgeQueue_Throttled(t *testing.T) {
+	t.Parallel()
+
+	s := NewMemoryStorage("http://localhost:4566")
+	ctx := t.Context()
+
+	queue, err := s.CreateQueue(ctx, "purge-queue", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.PurgeQueue(ctx, queue.URL); err != nil {
+		t.Fatalf("PurgeQueue() error = %v", err)
+	}
+
+	var qErr *QueueError
+	if err := s.PurgeQueue(ctx, queue.URL); !errors.As(err, &qErr) || qErr.Code != "PurgeQueueInProgress" {
+		t.Errorf("second PurgeQueue() error = %v, want PurgeQueueInProgress", err)
+	}
+}
+
+func TestMemoryStorage_GetQueueAttributes_MessageCounts(t *testing.T) {
+	t.Parallel()
+
+	s := NewMemoryStorage("http://localhost:4566")
+	ctx := t.Context()
+
+	queue, err := s.CreateQueue(ctx, "counts-queue", map[string]string{"Policy": `{"Version":"2012-10-17"}`}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for range 3 {
+		if _, err := s.SendMessage(ctx, queue.URL, "visible", 0, nil, nil, "", ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := s.SendMessage(ctx, queue.URL, "delayed", 60, nil, nil, "", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.ReceiveMessage(ctx, queue.URL, 1, 30, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	attrs, err := s.GetQueueAttributes(ctx, queue.URL, []string{"All"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"ApproximateNumberOfMessages":           "2",
+		"ApproximateNumberOfMessagesNotVisible": "1",
+		"ApproximateNumberOfMessagesDelayed":    "1",
+		"Policy":                                `{"Version":"2012-10-17"}`,
+		"QueueArn":                              queue.ARN,
+	}
+
+	for name, value := range want {
+		if attrs[name] != value {
+			t.Errorf("%s = %q, want %q", name, attrs[name], value)
+		}
+	}
+}
@@ -20,25 +20,27 @@ type Queue struct {
 	ReceiveWaitTimeSeconds    int
 	FifoQueue                 bool
 	ContentBasedDeduplication bool
-	RedrivePolicy             string // JSON string: {"deadLetterTargetArn":"...","maxReceiveCount":"N"}
-	MaxReceiveCount           int    // Parsed from RedrivePolicy
-	DeadLetterTargetArn       string // Parsed from RedrivePolicy
+	RedrivePolicy             string            // JSON string: {"deadLetterTargetArn":"...","maxReceiveCount":"N"}
+	MaxReceiveCount           int               // Parsed from RedrivePolicy
+	DeadLetterTargetArn       string            // Parsed from RedrivePolicy
+	OtherAttributes           map[string]string // Configured attributes that are stored but not acted on, such as Policy
 }
 
 // Message represents an SQS message.
 type Message struct {
-	MessageID              string
-	ReceiptHandle          string
-	Body                   string
-	MD5OfBody              string
-	Attributes             map[string]string
-	MessageAttributes      map[string]MessageAttributeValue
-	SentTimestamp          time.Time
-	VisibleAt              time.Time
-	ReceiveCount           int
-	MessageGroupID         string
-	MessageDeduplicationID string
-	SequenceNumber         string
+	MessageID               string
+	ReceiptHandle           string
+	Body                    string
+	MD5OfBody               string
+	Attributes              map[string]string
+	MessageAttributes       map[string]MessageAttributeValue
+	MessageSystemAttributes map[string]MessageAttributeValue
+	SentTimestamp           time.Time
+	VisibleAt               time.Time
+	ReceiveCount            int
+	MessageGroupID          string
+	MessageDeduplicationID  string
+	SequenceNumber          string
 }
 
 // MessageAttributeValue represents a message attribute.
@@ -116,12 +118,13 @@ type UntagQueueRequest struct {
 
 // SendMessageRequest is the request for SendMessage.
 type SendMessageRequest struct {
-	QueueURL               string                           `json:"QueueUrl"`
-	MessageBody            string                           `json:"MessageBody"`
-	DelaySeconds           int                              `json:"DelaySeconds,omitempty"`
-	MessageAttributes      map[string]MessageAttributeValue `json:"MessageAttributes,omitempty"`
-	MessageDeduplicationID string                           `json:"MessageDeduplicationId,omitempty"`
-	MessageGroupID         string                           `json:"MessageGroupId,omitempty"`
+	QueueURL                string                           `json:"QueueUrl"`
+	MessageBody             string                           `json:"MessageBody"`
+	DelaySeconds            int                              `json:"DelaySeconds,omitempty"`
+	MessageAttributes       map[string]MessageAttributeValue `json:"MessageAttributes,omitempty"`
+	MessageSystemAttributes map[string]MessageAttributeValue `json:"MessageSystemAttributes,omitempty"`
+	MessageDeduplicationID  string                           `json:"MessageDeduplicationId,omitempty"`
+	MessageGroupID          string                           `json:"MessageGroupId,omitempty"`
 }
 
 // SendMessageResponse is the response for SendMessage.
@@ -141,12 +144,13 @@ type SendMessageBatchRequest struct {
 
 // SendMessageBatchRequestEntry is an individual entry in a SendMessageBatch request.
 type SendMessageBatchRequestEntry struct {
-	ID                     string                           `json:"Id"`
-	MessageBody            string                           `json:"MessageBody"`
-	DelaySeconds           int                              `json:"DelaySeconds,omitempty"`
-	MessageAttributes      map[string]MessageAttributeValue `json:"MessageAttributes,omitempty"`
-	MessageDeduplicationID string                           `json:"MessageDeduplicationId,omitempty"`
-	MessageGroupID         string                           `json:"MessageGroupId,omitempty"`
+	ID                      string                           `json:"Id"`
+	MessageBody             string                           `json:"MessageBody"`
+	DelaySeconds            int                              `json:"DelaySeconds,omitempty"`
+	MessageAttributes       map[string]MessageAttributeValue `json:"MessageAttributes,omitempty"`
+	MessageSystemAttributes map[string]MessageAttributeValue `json:"MessageSystemAttributes,omitempty"`
+	MessageDeduplicationID  string                           `json:"MessageDeduplicationId,omitempty"`
+	MessageGroupID          string                           `json:"MessageGroupId,omitempty"`
 }
 
 // SendMessageBatchResponse is the response for SendMessageBatch.
@@ -157,11 +161,12 @@ type SendMessageBatchResponse struct {
 
 // SendMessageBatchResultEntry is a successful entry in a SendMessageBatch response.
 type SendMessageBatchResultEntry struct {
-	ID                     string `json:"Id"`
-	MessageID              string `json:"MessageId"`
-	MD5OfMessageBody       string `json:"MD5OfMessageBody"`
-	MD5OfMessageAttributes string `json:"MD5OfMessageAttributes,omitempty"`
-	SequenceNumber         string `json:"SequenceNumber,omitempty"`
+	ID                           string `json:"Id"`
+	MessageID                    string `json:"MessageId"`
+	MD5OfMessageBody             string `json:"MD5OfMessageBody"`
+	MD5OfMessageAttributes       string `json:"MD5OfMessageAttributes,omitempty"`
+	MD5OfMessageSystemAttributes string `json:"MD5OfMessageSystemAttributes,omitempty"`
+	SequenceNumber               string `json:"SequenceNumber,omitempty"`
 }
 
 // BatchResultErrorEntry is a failed entry in a batch response.
@@ -174,13 +179,14 @@ type BatchResultErrorEntry struct {
 
 // ReceiveMessageRequest is the request for ReceiveMessage.
 type ReceiveMessageRequest struct {
-	QueueURL                string   `json:"QueueUrl"`
-	AttributeNames          []string `json:"AttributeNames,omitempty"`
-	MaxNumberOfMessages     int      `json:"MaxNumberOfMessages,omitempty"`
-	MessageAttributeNames   []string `json:"MessageAttributeNames,omitempty"`
-	ReceiveRequestAttemptID string   `json:"ReceiveRequestAttemptId,omitempty"`
-	VisibilityTimeout       int      `json:"VisibilityTimeout,omitempty"`
-	WaitTimeSeconds         int      `json:"WaitTimeSeconds,omitempty"`
+	QueueURL                    string   `json:"QueueUrl"`
+	AttributeNames              []string `json:"AttributeNames,omitempty"`
+	MaxNumberOfMessages         int      `json:"MaxNumberOfMessages,omitempty"`
+	MessageAttributeNames       []string `json:"MessageAttributeNames,omitempty"`
+	MessageSystemAttributeNames []string `json:"MessageSystemAttributeNames,omitempty"`
+	ReceiveRequestAttemptID     string   `json:"ReceiveRequestAttemptId,omitempty"`
+	VisibilityTimeout           int      `json:"VisibilityTimeout,omitempty"`
+	WaitTimeSeconds             int      `json:"WaitTimeSeconds,omitempty"`
 }
 
 // ReceiveMessageResponse is the response for ReceiveMessage.
@@ -229,6 +235,37 @@ type DeleteMessageBatchResultEntry struct {
 	ID string `json:"Id"`
 }
 
+// ChangeMessageVisibilityRequest is the request for ChangeMessageVisibility.
+type ChangeMessageVisibilityRequest struct {
+	QueueURL          string `json:"QueueUrl"`
+	ReceiptHandle     string `json:"ReceiptHandle"`
+	VisibilityTimeout *int   `json:"VisibilityTimeout"`
+}
+
+// ChangeMessageVisibilityBatchRequest is the request for ChangeMessageVisibilityBatch.
+type ChangeMessageVisibilityBatchRequest struct {
+	QueueURL string                                     `json:"QueueUrl"`
+	Entries  []ChangeMessageVisibilityBatchRequestEntry `json:"Entries"`
+}
+
+// ChangeMessageVisibilityBatchRequestEntry is an individual entry in a ChangeMessageVisibilityBatch request.
+type ChangeMessageVisibilityBatchRequestEntry struct {
+	ID                string `json:"Id"`
+	ReceiptHandle     string `json:"ReceiptHandle"`
+	VisibilityTimeout int    `json:"VisibilityTimeout,omitempty"`
+}
+
+// ChangeMessageVisibilityBatchResponse is the response for ChangeMessageVisibilityBatch.
+type ChangeMessageVisibilityBatchResponse struct {
+	Successful []ChangeMessageVisibilityBatchResultEntry `json:"Successful,omitempty"`
+	Failed     []BatchResultErrorEntry                   `json:"Failed,omitempty"`
+}
+
+// ChangeMessageVisibilityBatchResultEntry is a successful entry in a ChangeMessageVisibilityBatch response.
+type ChangeMessageVisibilityBatchResultEntry struct {
+	ID string `json:"Id"`
+}
+
 // PurgeQueueRequest is the request for PurgeQueue.
 type PurgeQueueRequest struct {
 	QueueURL string `json:"QueueUrl"`
@@ -0,0 +1,113 @@
+package ssm
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// defaultCommandTransitionDelay is how long a command spends Pending and InProgress before it succeeds.
+const defaultCommandTransitionDelay = 500 * time.Millisecond
+
+// WithCommandTransitionDelay sets how long a command spends Pending and InProgress before it succeeds.
+func WithCommandTransitionDelay(d time.Duration) Option {
+	return func(s *MemoryStorage) {
+		s.transitionDelay = d
+	}
+}
+
+// commandScheduler periodically advances the invocations of sent commands.
+func (s *MemoryStorage) commandScheduler() {
+	ticker := time.NewTicker(max(s.transitionDelay/5, 10*time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopScheduler:
+			return
+		case now := <-ticker.C:
+			s.advanceCommands(now)
+		}
+	}
+}
+
+// advanceCommands moves the invocations of each command from Pending to
+// InProgress, and from InProgress to Success, once they have spent the
+// transition delay in their status.
+func (s *MemoryStorage) advanceCommands(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, cmd := range s.Commands {
+		elapsed := now.Sub(cmd.RequestedDateTime)
+
+		switch {
+		case cmd.Status == CommandStatusPending && elapsed >= s.transitionDelay:
+			for _, inv := range cmd.Invocations {
+				inv.Status = CommandStatusInProgress
+				inv.ExecutionStartDateTime = &now
+			}
+
+			cmd.Status = CommandStatusInProgress
+		case cmd.Status == CommandStatusInProgress && elapsed >= 2*s.transitionDelay:
+			for _, inv := range cmd.Invocations {
+				inv.Status = CommandStatusSuccess
+				inv.ResponseCode = 0
+				inv.ExecutionEndDateTime = &now
+				inv.StandardOutputContent = syntheticOutput(cmd)
+			}
+
+			cmd.Status = CommandStatusSuccess
+		}
+	}
+}
+
+// syntheticOutput returns the standard output of a successful invocation.
+// Commands are not run, so the output lists the commands the document would
+// have run, one per line.
+func syntheticOutput(cmd *Command) string {
+	lines := cmd.Parameters["commands"]
+	if len(lines) == 0 {
+		return ""
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// pluginName returns the name of the plugin that runs a document's steps.
+func pluginName(documentName string) string {
+	switch documentName {
+	case "AWS-RunShellScript":
+		return "aws:runShellScript"
+	case "AWS-RunPowerShellScript":
+		return "aws:runPowerShellScript"
+	default:
+		return "aws:runDocument"
+	}
+}
+
+// clone returns a deep copy of the command, so that it can be read after the
+// storage lock is released while the scheduler advances the original.
+func (c *Command) clone() *Command {
+	cp := *c
+	cp.InstanceIDs = slices.Clone(c.InstanceIDs)
+	cp.Invocations = make([]*CommandInvocation, len(c.Invocations))
+
+	for i, inv := range c.Invocations {
+		invCopy := *inv
+		cp.Invocations[i] = &invCopy
+	}
+
+	return &cp
+}
+
+// invocation returns the invocation of the command on an instance, or nil.
+func (c *Command) invocation(instanceID string) *CommandInvocation {
+	for _, inv := range c.Invocations {
+		if inv.InstanceID == instanceID {
+			return inv
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package ssm
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -225,6 +226,212 @@ func (s *Service) DescribeParameters(w http.ResponseWriter, r *http.Request) {
 	writeJSONResponse(w, resp)
 }
 
+// SendCommand handles the SendCommand API.
+func (s *Service) SendCommand(w http.ResponseWriter, r *http.Request) {
+	var req SendCommandRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeSSMError(w, ErrInvalidParameterValue, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.DocumentName == "" {
+		writeSSMError(w, ErrInvalidParameterValue, "DocumentName is required", http.StatusBadRequest)
+
+		return
+	}
+
+	cmd, err := s.storage.SendCommand(r.Context(), &req)
+	if err != nil {
+		handleSSMError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, &SendCommandResponse{Command: commandToInfo(cmd)})
+}
+
+// ListCommands handles the ListCommands API.
+func (s *Service) ListCommands(w http.ResponseWriter, r *http.Request) {
+	var req ListCommandsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeSSMError(w, ErrInvalidParameterValue, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	cmds, nextToken, err := s.storage.ListCommands(r.Context(), req.CommandID, req.InstanceID, req.MaxResults, req.NextToken)
+	if err != nil {
+		handleSSMError(w, err)
+
+		return
+	}
+
+	resp := &ListCommandsResponse{
+		Commands:  make([]*CommandInfo, 0, len(cmds)),
+		NextToken: nextToken,
+	}
+
+	for _, cmd := range cmds {
+		resp.Commands = append(resp.Commands, commandToInfo(cmd))
+	}
+
+	writeJSONResponse(w, resp)
+}
+
+// ListCommandInvocations handles the ListCommandInvocations API.
+func (s *Service) ListCommandInvocations(w http.ResponseWriter, r *http.Request) {
+	var req ListCommandInvocationsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeSSMError(w, ErrInvalidParameterValue, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	cmds, nextToken, err := s.storage.ListCommandInvocations(r.Context(), req.CommandID, req.InstanceID, req.MaxResults, req.NextToken)
+	if err != nil {
+		handleSSMError(w, err)
+
+		return
+	}
+
+	resp := &ListCommandInvocationsResponse{
+		CommandInvocations: make([]*CommandInvocationInfo, 0, len(cmds)),
+		NextToken:          nextToken,
+	}
+
+	for _, cmd := range cmds {
+		inv := cmd.Invocations[0]
+		info := &CommandInvocationInfo{
+			CommandID:         cmd.CommandID,
+			InstanceID:        inv.InstanceID,
+			Comment:           cmd.Comment,
+			DocumentName:      cmd.DocumentName,
+			DocumentVersion:   cmd.DocumentVersion,
+			RequestedDateTime: toUnixTimestamp(cmd.RequestedDateTime),
+			Status:            inv.Status,
+			StatusDetails:     inv.Status,
+			CommandPlugins:    []*CommandPluginInfo{},
+		}
+
+		if req.Details {
+			info.CommandPlugins = append(info.CommandPlugins, invocationToPlugin(cmd, inv))
+		}
+
+		resp.CommandInvocations = append(resp.CommandInvocations, info)
+	}
+
+	writeJSONResponse(w, resp)
+}
+
+// GetCommandInvocation handles the GetCommandInvocation API.
+func (s *Service) GetCommandInvocation(w http.ResponseWriter, r *http.Request) {
+	var req GetCommandInvocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeSSMError(w, ErrInvalidParameterValue, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.CommandID == "" || req.InstanceID == "" {
+		writeSSMError(w, ErrInvalidParameterValue, "CommandId and InstanceId are required", http.StatusBadRequest)
+
+		return
+	}
+
+	cmd, inv, err := s.storage.GetCommandInvocation(r.Context(), req.CommandID, req.InstanceID)
+	if err != nil {
+		handleSSMError(w, err)
+
+		return
+	}
+
+	plugin := pluginName(cmd.DocumentName)
+	if req.PluginName != "" && req.PluginName != plugin {
+		writeSSMError(w, ErrInvalidPluginName, "Plugin "+req.PluginName+" is not part of the command", http.StatusBadRequest)
+
+		return
+	}
+
+	resp := &GetCommandInvocationResponse{
+		CommandID:             cmd.CommandID,
+		InstanceID:            inv.InstanceID,
+		Comment:               cmd.Comment,
+		DocumentName:          cmd.DocumentName,
+		DocumentVersion:       cmd.DocumentVersion,
+		PluginName:            plugin,
+		ResponseCode:          inv.ResponseCode,
+		Status:                inv.Status,
+		StatusDetails:         inv.Status,
+		StandardOutputContent: inv.StandardOutputContent,
+	}
+
+	if inv.ExecutionStartDateTime != nil {
+		resp.ExecutionStartDateTime = inv.ExecutionStartDateTime.UTC().Format(invocationTimeFormat)
+	}
+
+	if inv.ExecutionStartDateTime != nil && inv.ExecutionEndDateTime != nil {
+		resp.ExecutionEndDateTime = inv.ExecutionEndDateTime.UTC().Format(invocationTimeFormat)
+		resp.ExecutionElapsedTime = fmt.Sprintf("PT%.3fS", inv.ExecutionEndDateTime.Sub(*inv.ExecutionStartDateTime).Seconds())
+	}
+
+	writeJSONResponse(w, resp)
+}
+
+// invocationTimeFormat is the format of the execution times in GetCommandInvocation responses.
+const invocationTimeFormat = "2006-01-02T15:04:05.000Z"
+
+// commandToInfo converts a Command to CommandInfo.
+func commandToInfo(cmd *Command) *CommandInfo {
+	completed := 0
+
+	for _, inv := range cmd.Invocations {
+		if inv.Status == CommandStatusSuccess {
+			completed++
+		}
+	}
+
+	return &CommandInfo{
+		CommandID:         cmd.CommandID,
+		DocumentName:      cmd.DocumentName,
+		DocumentVersion:   cmd.DocumentVersion,
+		Comment:           cmd.Comment,
+		ExpiresAfter:      toUnixTimestamp(cmd.RequestedDateTime.Add(time.Duration(cmd.TimeoutSeconds) * time.Second)),
+		Parameters:        cmd.Parameters,
+		InstanceIDs:       cmd.InstanceIDs,
+		Targets:           []Target{},
+		RequestedDateTime: toUnixTimestamp(cmd.RequestedDateTime),
+		Status:            cmd.Status,
+		StatusDetails:     cmd.Status,
+		TargetCount:       len(cmd.Invocations),
+		CompletedCount:    completed,
+		TimeoutSeconds:    cmd.TimeoutSeconds,
+	}
+}
+
+// invocationToPlugin converts an invocation to the result of its plugin.
+func invocationToPlugin(cmd *Command, inv *CommandInvocation) *CommandPluginInfo {
+	plugin := &CommandPluginInfo{
+		Name:          pluginName(cmd.DocumentName),
+		Status:        inv.Status,
+		StatusDetails: inv.Status,
+		ResponseCode:  inv.ResponseCode,
+		Output:        inv.StandardOutputContent,
+	}
+
+	if inv.ExecutionStartDateTime != nil {
+		start := toUnixTimestamp(*inv.ExecutionStartDateTime)
+		plugin.ResponseStartDateTime = &start
+	}
+
+	if inv.ExecutionEndDateTime != nil {
+		finish := toUnixTimestamp(*inv.ExecutionEndDateTime)
+		plugin.ResponseFinishDateTime = &finish
+	}
+
+	return plugin
+}
+
 // parameterToValue converts a Parameter to ParameterValue.
 // For SecureString parameters, the value is masked when withDecryption is false.
 func parameterToValue(p *Parameter, withDecryption bool) *ParameterValue {
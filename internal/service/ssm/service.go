@@ -1,11 +1,13 @@
 package ssm
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -14,12 +16,18 @@ import (
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"ssm"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
+
+		if delay, err := time.ParseDuration(os.Getenv("KUMO_SSM_COMMAND_TRANSITION_DELAY")); err == nil {
+			opts = append(opts, WithCommandTransitionDelay(delay))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
 
 // Service implements the SSM Parameter Store service.
@@ -75,6 +83,14 @@ func (s *Service) DispatchAction(w http.ResponseWriter, r *http.Request) {
 		s.DeleteParameters(w, r)
 	case "DescribeParameters":
 		s.DescribeParameters(w, r)
+	case "SendCommand":
+		s.SendCommand(w, r)
+	case "ListCommands":
+		s.ListCommands(w, r)
+	case "ListCommandInvocations":
+		s.ListCommandInvocations(w, r)
+	case "GetCommandInvocation":
+		s.GetCommandInvocation(w, r)
 	default:
 		writeSSMError(w, ErrInvalidParameterValue, "The action "+action+" is not valid", http.StatusBadRequest)
 	}
@@ -90,3 +106,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
@@ -4,14 +4,25 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
+
+	"github.com/sivchari/kumo/internal/service"
 	"github.com/sivchari/kumo/internal/storage"
 )
 
+// instanceIDPattern matches EC2 instance IDs and hybrid managed instance IDs.
+var instanceIDPattern = regexp.MustCompile(`^(i-[0-9a-f]{8}([0-9a-f]{9})?|mi-[0-9a-f]{17})$`)
+
+// defaultCommandTimeoutSeconds is how long a command may wait before it runs.
+const defaultCommandTimeoutSeconds = 3600
+
 // Storage defines the SSM Parameter Store storage interface.
 type Storage interface {
 	PutParameter(ctx context.Context, req *PutParameterRequest) (*Parameter, error)
@@ -21,6 +32,11 @@ type Storage interface {
 	DeleteParameter(ctx context.Context, name string) error
 	DeleteParameters(ctx context.Context, names []string) ([]string, []string, error)
 	DescribeParameters(ctx context.Context, maxResults int, nextToken string) ([]*Parameter, string, error)
+	SendCommand(ctx context.Context, req *SendCommandRequest) (*Command, error)
+	ListCommands(ctx context.Context, commandID, instanceID string, maxResults int, nextToken string) ([]*Command, string, error)
+	ListCommandInvocations(ctx context.Context, commandID, instanceID string, maxResults int, nextToken string) ([]*Command, string, error)
+	GetCommandInvocation(ctx context.Context, commandID, instanceID string) (*Command, *CommandInvocation, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -41,19 +57,21 @@ var (
 
 // MemoryStorage implements Storage with in-memory data.
 type MemoryStorage struct {
-	mu         sync.RWMutex          `json:"-"`
-	Parameters map[string]*Parameter `json:"parameters"`
-	region     string
-	accountID  string
-	dataDir    string
+	mu              sync.RWMutex          `json:"-"`
+	Parameters      map[string]*Parameter `json:"parameters"`
+	Commands        map[string]*Command   `json:"commands"`
+	dataDir         string
+	transitionDelay time.Duration
+	stopScheduler   chan struct{}
 }
 
 // NewMemoryStorage creates a new in-memory storage.
 func NewMemoryStorage(opts ...Option) *MemoryStorage {
 	s := &MemoryStorage{
-		Parameters: make(map[string]*Parameter),
-		region:     "us-east-1",
-		accountID:  "000000000000",
+		Parameters:      make(map[string]*Parameter),
+		Commands:        make(map[string]*Command),
+		transitionDelay: defaultCommandTransitionDelay,
+		stopScheduler:   make(chan struct{}),
 	}
 	for _, o := range opts {
 		o(s)
@@ -63,6 +81,8 @@ func NewMemoryStorage(opts ...Option) *MemoryStorage {
 		_ = storage.Load(s.dataDir, "ssm", s)
 	}
 
+	go s.commandScheduler()
+
 	return s
 }
 
@@ -98,11 +118,17 @@ func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
 		s.Parameters = make(map[string]*Parameter)
 	}
 
+	if s.Commands == nil {
+		s.Commands = make(map[string]*Command)
+	}
+
 	return nil
 }
 
 // Close saves the storage state to disk if persistence is enabled.
 func (s *MemoryStorage) Close() error {
+	close(s.stopScheduler)
+
 	if s.dataDir == "" {
 		return nil
 	}
@@ -114,8 +140,19 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Parameters = make(map[string]*Parameter)
+	s.Commands = make(map[string]*Command)
+
+	return nil
+}
+
 // PutParameter creates or updates a parameter.
-func (s *MemoryStorage) PutParameter(_ context.Context, req *PutParameterRequest) (*Parameter, error) {
+func (s *MemoryStorage) PutParameter(ctx context.Context, req *PutParameterRequest) (*Parameter, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -160,7 +197,7 @@ func (s *MemoryStorage) PutParameter(_ context.Context, req *PutParameterRequest
 		Value:            req.Value,
 		Version:          version,
 		LastModifiedDate: time.Now().UTC(),
-		ARN:              fmt.Sprintf("arn:aws:ssm:%s:%s:parameter/%s", s.region, s.accountID, strings.TrimPrefix(req.Name, "/")),
+		ARN:              fmt.Sprintf("arn:aws:ssm:%s:%s:parameter/%s", service.Region(ctx), service.AccountID(ctx), strings.TrimPrefix(req.Name, "/")),
 		DataType:         dataType,
 		Tier:             tier,
 		Description:      req.Description,
@@ -376,3 +413,210 @@ func (s *MemoryStorage) DescribeParameters(_ context.Context, maxResults int, ne
 
 	return result, newNextToken, nil
 }
+
+// SendCommand records a command against its target instances. Its
+// invocations start Pending and are advanced by the command scheduler.
+func (s *MemoryStorage) SendCommand(_ context.Context, req *SendCommandRequest) (*Command, error) {
+	instanceIDs := slices.Clone(req.InstanceIDs)
+
+	for _, target := range req.Targets {
+		if target.Key != "InstanceIds" {
+			return nil, &ParameterError{
+				Type:    ErrInvalidParameterValue,
+				Message: "Target key " + target.Key + " is not supported; use InstanceIds",
+			}
+		}
+
+		instanceIDs = append(instanceIDs, target.Values...)
+	}
+
+	if len(instanceIDs) == 0 {
+		return nil, &ParameterError{
+			Type:    ErrInvalidParameterValue,
+			Message: "InstanceIds or Targets must be specified",
+		}
+	}
+
+	slices.Sort(instanceIDs)
+	instanceIDs = slices.Compact(instanceIDs)
+
+	for _, id := range instanceIDs {
+		if !instanceIDPattern.MatchString(id) {
+			return nil, &ParameterError{
+				Type:    ErrInvalidInstanceID,
+				Message: "Instance Id " + id + " is not valid",
+			}
+		}
+	}
+
+	timeout := req.TimeoutSeconds
+	if timeout == 0 {
+		timeout = defaultCommandTimeoutSeconds
+	}
+
+	cmd := &Command{
+		CommandID:         uuid.New().String(),
+		DocumentName:      req.DocumentName,
+		DocumentVersion:   req.DocumentVersion,
+		Comment:           req.Comment,
+		InstanceIDs:       instanceIDs,
+		Parameters:        req.Parameters,
+		TimeoutSeconds:    timeout,
+		RequestedDateTime: time.Now(),
+		Status:            CommandStatusPending,
+		Invocations:       make([]*CommandInvocation, 0, len(instanceIDs)),
+	}
+
+	for _, id := range instanceIDs {
+		cmd.Invocations = append(cmd.Invocations, &CommandInvocation{
+			InstanceID:   id,
+			Status:       CommandStatusPending,
+			ResponseCode: -1,
+		})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Commands[cmd.CommandID] = cmd
+
+	return cmd.clone(), nil
+}
+
+// ListCommands lists commands, newest first, optionally narrowed to one
+// command or to the commands sent to an instance.
+func (s *MemoryStorage) ListCommands(_ context.Context, commandID, instanceID string, maxResults int, nextToken string) ([]*Command, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cmds, err := s.matchingCommands(commandID, instanceID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	page, newNextToken, err := pageByToken(cmds, func(c *Command) string { return c.CommandID }, maxResults, nextToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	result := make([]*Command, len(page))
+	for i, cmd := range page {
+		result[i] = cmd.clone()
+	}
+
+	return result, newNextToken, nil
+}
+
+// ListCommandInvocations lists command invocations, newest command first.
+// Each returned command holds the single invocation it lists.
+func (s *MemoryStorage) ListCommandInvocations(_ context.Context, commandID, instanceID string, maxResults int, nextToken string) ([]*Command, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cmds, err := s.matchingCommands(commandID, instanceID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var invocations []*Command
+
+	for _, cmd := range cmds {
+		for _, inv := range cmd.Invocations {
+			if instanceID != "" && inv.InstanceID != instanceID {
+				continue
+			}
+
+			entry := cmd.clone()
+			entry.Invocations = []*CommandInvocation{entry.invocation(inv.InstanceID)}
+			invocations = append(invocations, entry)
+		}
+	}
+
+	return pageByToken(invocations, func(c *Command) string {
+		return c.CommandID + ":" + c.Invocations[0].InstanceID
+	}, maxResults, nextToken)
+}
+
+// GetCommandInvocation returns the invocation of a command on an instance.
+func (s *MemoryStorage) GetCommandInvocation(_ context.Context, commandID, instanceID string) (*Command, *CommandInvocation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cmd, ok := s.Commands[commandID]
+	if !ok || cmd.invocation(instanceID) == nil {
+		return nil, nil, &ParameterError{
+			Type:    ErrInvocationDoesNotExist,
+			Message: "An invocation of command " + commandID + " on instance " + instanceID + " does not exist",
+		}
+	}
+
+	cp := cmd.clone()
+
+	return cp, cp.invocation(instanceID), nil
+}
+
+// matchingCommands returns the commands with the given ID or sent to the
+// given instance, newest first. The caller must hold the lock.
+func (s *MemoryStorage) matchingCommands(commandID, instanceID string) ([]*Command, error) {
+	if commandID != "" {
+		if _, ok := s.Commands[commandID]; !ok {
+			return nil, &ParameterError{
+				Type:    ErrInvalidCommandID,
+				Message: "Command " + commandID + " does not exist",
+			}
+		}
+	}
+
+	cmds := make([]*Command, 0, len(s.Commands))
+
+	for _, cmd := range s.Commands {
+		if commandID != "" && cmd.CommandID != commandID {
+			continue
+		}
+
+		if instanceID != "" && !slices.Contains(cmd.InstanceIDs, instanceID) {
+			continue
+		}
+
+		cmds = append(cmds, cmd)
+	}
+
+	sort.Slice(cmds, func(i, j int) bool {
+		if !cmds[i].RequestedDateTime.Equal(cmds[j].RequestedDateTime) {
+			return cmds[i].RequestedDateTime.After(cmds[j].RequestedDateTime)
+		}
+
+		return cmds[i].CommandID < cmds[j].CommandID
+	})
+
+	return cmds, nil
+}
+
+// pageByToken returns a page of items starting at the item whose key is
+// nextToken, and the key of the first item of the next page.
+func pageByToken[T any](items []T, key func(T) string, maxResults int, nextToken string) ([]T, string, error) {
+	if maxResults <= 0 {
+		maxResults = 50
+	}
+
+	start := 0
+
+	if nextToken != "" {
+		start = slices.IndexFunc(items, func(item T) bool { return key(item) == nextToken })
+		if start < 0 {
+			return nil, "", &ParameterError{
+				Type:    ErrInvalidNextToken,
+				Message: "The specified token is not valid",
+			}
+		}
+	}
+
+	end := min(start+maxResults, len(items))
+
+	newNextToken := ""
+	if end < len(items) {
+		newNextToken = key(items[end])
+	}
+
+	return items[start:end], newNextToken, nil
+}
@@ -1,4 +1,4 @@
-// Package ssm provides SSM Parameter Store service emulation for kumo.
+// Package ssm provides SSM Parameter Store and Run Command service emulation for kumo.
 package ssm
 
 import (
@@ -19,6 +19,14 @@ const (
 	ParameterTierIntelligentTier = "Intelligent-Tiering"
 )
 
+// Command statuses. A command has the status of its invocations, which all
+// advance together.
+const (
+	CommandStatusPending    = "Pending"
+	CommandStatusInProgress = "InProgress"
+	CommandStatusSuccess    = "Success"
+)
+
 // Parameter represents an SSM parameter.
 type Parameter struct {
 	Name             string
@@ -137,6 +145,150 @@ type DescribeParametersResponse struct {
 	NextToken  string               `json:"NextToken,omitempty"`
 }
 
+// Command represents a Run Command command sent to managed instances.
+type Command struct {
+	CommandID         string
+	DocumentName      string
+	DocumentVersion   string
+	Comment           string
+	InstanceIDs       []string
+	Parameters        map[string][]string
+	TimeoutSeconds    int32
+	RequestedDateTime time.Time
+	Status            string
+	Invocations       []*CommandInvocation
+}
+
+// CommandInvocation represents the invocation of a command on one instance.
+type CommandInvocation struct {
+	InstanceID             string
+	Status                 string
+	ResponseCode           int32
+	ExecutionStartDateTime *time.Time
+	ExecutionEndDateTime   *time.Time
+	StandardOutputContent  string
+}
+
+// Target selects the instances a command is sent to.
+type Target struct {
+	Key    string   `json:"Key"`
+	Values []string `json:"Values"`
+}
+
+// SendCommandRequest is the request for SendCommand.
+type SendCommandRequest struct {
+	InstanceIDs     []string            `json:"InstanceIds,omitempty"`
+	Targets         []Target            `json:"Targets,omitempty"`
+	DocumentName    string              `json:"DocumentName"`
+	DocumentVersion string              `json:"DocumentVersion,omitempty"`
+	Comment         string              `json:"Comment,omitempty"`
+	Parameters      map[string][]string `json:"Parameters,omitempty"`
+	TimeoutSeconds  int32               `json:"TimeoutSeconds,omitempty"`
+}
+
+// SendCommandResponse is the response for SendCommand.
+type SendCommandResponse struct {
+	Command *CommandInfo `json:"Command"`
+}
+
+// CommandInfo represents a command in responses.
+type CommandInfo struct {
+	CommandID             string              `json:"CommandId"`
+	DocumentName          string              `json:"DocumentName"`
+	DocumentVersion       string              `json:"DocumentVersion,omitempty"`
+	Comment               string              `json:"Comment,omitempty"`
+	ExpiresAfter          float64             `json:"ExpiresAfter"`
+	Parameters            map[string][]string `json:"Parameters,omitempty"`
+	InstanceIDs           []string            `json:"InstanceIds"`
+	Targets               []Target            `json:"Targets"`
+	RequestedDateTime     float64             `json:"RequestedDateTime"`
+	Status                string              `json:"Status"`
+	StatusDetails         string              `json:"StatusDetails"`
+	TargetCount           int                 `json:"TargetCount"`
+	CompletedCount        int                 `json:"CompletedCount"`
+	ErrorCount            int                 `json:"ErrorCount"`
+	DeliveryTimedOutCount int                 `json:"DeliveryTimedOutCount"`
+	TimeoutSeconds        int32               `json:"TimeoutSeconds"`
+}
+
+// ListCommandsRequest is the request for ListCommands.
+type ListCommandsRequest struct {
+	CommandID  string `json:"CommandId,omitempty"`
+	InstanceID string `json:"InstanceId,omitempty"`
+	MaxResults int    `json:"MaxResults,omitempty"`
+	NextToken  string `json:"NextToken,omitempty"`
+}
+
+// ListCommandsResponse is the response for ListCommands.
+type ListCommandsResponse struct {
+	Commands  []*CommandInfo `json:"Commands"`
+	NextToken string         `json:"NextToken,omitempty"`
+}
+
+// ListCommandInvocationsRequest is the request for ListCommandInvocations.
+type ListCommandInvocationsRequest struct {
+	CommandID  string `json:"CommandId,omitempty"`
+	InstanceID string `json:"InstanceId,omitempty"`
+	MaxResults int    `json:"MaxResults,omitempty"`
+	NextToken  string `json:"NextToken,omitempty"`
+	Details    bool   `json:"Details,omitempty"`
+}
+
+// ListCommandInvocationsResponse is the response for ListCommandInvocations.
+type ListCommandInvocationsResponse struct {
+	CommandInvocations []*CommandInvocationInfo `json:"CommandInvocations"`
+	NextToken          string                   `json:"NextToken,omitempty"`
+}
+
+// CommandInvocationInfo represents a command invocation in list responses.
+type CommandInvocationInfo struct {
+	CommandID         string               `json:"CommandId"`
+	InstanceID        string               `json:"InstanceId"`
+	Comment           string               `json:"Comment,omitempty"`
+	DocumentName      string               `json:"DocumentName"`
+	DocumentVersion   string               `json:"DocumentVersion,omitempty"`
+	RequestedDateTime float64              `json:"RequestedDateTime"`
+	Status            string               `json:"Status"`
+	StatusDetails     string               `json:"StatusDetails"`
+	CommandPlugins    []*CommandPluginInfo `json:"CommandPlugins"`
+}
+
+// CommandPluginInfo represents the result of a document plugin in an invocation.
+type CommandPluginInfo struct {
+	Name                   string   `json:"Name"`
+	Status                 string   `json:"Status"`
+	StatusDetails          string   `json:"StatusDetails"`
+	ResponseCode           int32    `json:"ResponseCode"`
+	ResponseStartDateTime  *float64 `json:"ResponseStartDateTime,omitempty"`
+	ResponseFinishDateTime *float64 `json:"ResponseFinishDateTime,omitempty"`
+	Output                 string   `json:"Output"`
+}
+
+// GetCommandInvocationRequest is the request for GetCommandInvocation.
+type GetCommandInvocationRequest struct {
+	CommandID  string `json:"CommandId"`
+	InstanceID string `json:"InstanceId"`
+	PluginName string `json:"PluginName,omitempty"`
+}
+
+// GetCommandInvocationResponse is the response for GetCommandInvocation.
+type GetCommandInvocationResponse struct {
+	CommandID              string `json:"CommandId"`
+	InstanceID             string `json:"InstanceId"`
+	Comment                string `json:"Comment,omitempty"`
+	DocumentName           string `json:"DocumentName"`
+	DocumentVersion        string `json:"DocumentVersion,omitempty"`
+	PluginName             string `json:"PluginName"`
+	ResponseCode           int32  `json:"ResponseCode"`
+	ExecutionStartDateTime string `json:"ExecutionStartDateTime,omitempty"`
+	ExecutionElapsedTime   string `json:"ExecutionElapsedTime,omitempty"`
+	ExecutionEndDateTime   string `json:"ExecutionEndDateTime,omitempty"`
+	Status                 string `json:"Status"`
+	StatusDetails          string `json:"StatusDetails"`
+	StandardOutputContent  string `json:"StandardOutputContent"`
+	StandardErrorContent   string `json:"StandardErrorContent"`
+}
+
 // ParameterError represents an SSM error.
 type ParameterError struct {
 	Type    string `json:"__type"`
@@ -154,4 +306,9 @@ const (
 	ErrParameterAlreadyExists = "ParameterAlreadyExists"
 	ErrInvalidParameterValue  = "ValidationException"
 	ErrServiceException       = "InternalServerError"
+	ErrInvalidInstanceID      = "InvalidInstanceId"
+	ErrInvalidCommandID       = "InvalidCommandId"
+	ErrInvalidNextToken       = "InvalidNextToken"
+	ErrInvalidPluginName      = "InvalidPluginName"
+	ErrInvocationDoesNotExist = "InvocationDoesNotExist"
 )
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// StateStorage is the storage holding the state of a Snapshotter.
+// Its state is its JSON encoding.
+type StateStorage interface {
+	// Reset clears the storage state.
+	Reset(ctx context.Context) error
+}
+
+// Snapshot returns the state of a service as JSON.
+func Snapshot(svc Snapshotter) ([]byte, error) {
+	data, err := json.Marshal(svc.StateStorage())
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot storage: %w", err)
+	}
+
+	return data, nil
+}
+
+// CheckSnapshot reports whether a snapshot can be restored into a service by
+// decoding it into a new storage of the same type, leaving the service untouched.
+func CheckSnapshot(svc Snapshotter, data []byte) error {
+	storage := reflect.New(reflect.TypeOf(svc.StateStorage()).Elem()).Interface()
+	if err := json.Unmarshal(data, storage); err != nil {
+		return fmt.Errorf("invalid snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// Restore replaces the state of a service with a snapshot returned by Snapshot.
+// The storage is reset before the snapshot is loaded, so that resources created
+// after the snapshot was taken do not survive. A snapshot that fails CheckSnapshot
+// is rejected without changing the service.
+func Restore(ctx context.Context, svc Snapshotter, data []byte) error {
+	if err := CheckSnapshot(svc, data); err != nil {
+		return err
+	}
+
+	storage := svc.StateStorage()
+
+	if err := storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	if err := json.Unmarshal(data, storage); err != nil {
+		return fmt.Errorf("failed to restore storage: %w", err)
+	}
+
+	return nil
+}
@@ -28,6 +28,7 @@ type Storage interface {
 	GetCallerIdentity(ctx context.Context) (*CallerIdentity, error)
 	GetSessionToken(ctx context.Context, input *GetSessionTokenInput) (*Credentials, error)
 	GetFederationToken(ctx context.Context, input *GetFederationTokenInput) (*FederationTokenResult, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -111,6 +112,11 @@ func (m *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources. STS keeps no state, so there is nothing to clear.
+func (m *MemoryStorage) Reset(_ context.Context) error {
+	return nil
+}
+
 // AssumeRole generates temporary credentials for an assumed role.
 func (m *MemoryStorage) AssumeRole(_ context.Context, input *AssumeRoleInput) (*AssumeRoleResult, error) {
 	duration := resolveDuration(input.DurationSeconds)
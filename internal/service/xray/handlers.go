@@ -52,7 +52,7 @@ func (s *Service) GetTraceSummaries(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	summaries, err := s.storage.GetTraceSummaries(r.Context(), req.StartTime.ToTime(), req.EndTime.ToTime())
+	summaries, nextToken, err := s.storage.GetTraceSummaries(r.Context(), req.StartTime.ToTime(), req.EndTime.ToTime(), req.TimeRangeType, req.NextToken)
 	if err != nil {
 		handleStorageError(w, err)
 
@@ -64,6 +64,7 @@ func (s *Service) GetTraceSummaries(w http.ResponseWriter, r *http.Request) {
 	for i := range summaries {
 		responses = append(responses, TraceSummaryResponse{
 			ID:                summaries[i].ID,
+			StartTime:         AWSTimestamp{Time: summaries[i].StartTime}.Ptr(),
 			Duration:          summaries[i].Duration,
 			ResponseTime:      summaries[i].ResponseTime,
 			HasFault:          summaries[i].HasFault,
@@ -88,6 +89,7 @@ func (s *Service) GetTraceSummaries(w http.ResponseWriter, r *http.Request) {
 	writeJSONResponse(w, GetTraceSummariesOutput{
 		TraceSummaries:       responses,
 		TracesProcessedCount: int64(len(responses)),
+		NextToken:            nextToken,
 	})
 }
 
@@ -224,6 +226,152 @@ func (s *Service) DeleteGroup(w http.ResponseWriter, r *http.Request) {
 	writeJSONResponse(w, struct{}{})
 }
 
+// CreateSamplingRule handles the CreateSamplingRule operation.
+func (s *Service) CreateSamplingRule(w http.ResponseWriter, r *http.Request) {
+	var req CreateSamplingRuleInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.SamplingRule == nil {
+		writeError(w, errInvalidRequest, "SamplingRule is required", http.StatusBadRequest)
+
+		return
+	}
+
+	record, err := s.storage.CreateSamplingRule(r.Context(), req.SamplingRule)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, CreateSamplingRuleOutput{
+		SamplingRuleRecord: toSamplingRuleRecordResponse(record),
+	})
+}
+
+// GetSamplingRules handles the GetSamplingRules operation.
+func (s *Service) GetSamplingRules(w http.ResponseWriter, r *http.Request) {
+	var req GetSamplingRulesInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	records, nextToken, err := s.storage.GetSamplingRules(r.Context(), req.NextToken)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	responses := make([]SamplingRuleRecordResponse, 0, len(records))
+	for _, record := range records {
+		responses = append(responses, *toSamplingRuleRecordResponse(record))
+	}
+
+	writeJSONResponse(w, GetSamplingRulesOutput{
+		SamplingRuleRecords: responses,
+		NextToken:           nextToken,
+	})
+}
+
+// UpdateSamplingRule handles the UpdateSamplingRule operation.
+func (s *Service) UpdateSamplingRule(w http.ResponseWriter, r *http.Request) {
+	var req UpdateSamplingRuleInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.SamplingRuleUpdate == nil {
+		writeError(w, errInvalidRequest, "SamplingRuleUpdate is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.SamplingRuleUpdate.RuleName == "" && req.SamplingRuleUpdate.RuleARN == "" {
+		writeError(w, errInvalidRequest, "RuleName or RuleARN is required", http.StatusBadRequest)
+
+		return
+	}
+
+	record, err := s.storage.UpdateSamplingRule(r.Context(), req.SamplingRuleUpdate)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, UpdateSamplingRuleOutput{
+		SamplingRuleRecord: toSamplingRuleRecordResponse(record),
+	})
+}
+
+// DeleteSamplingRule handles the DeleteSamplingRule operation.
+func (s *Service) DeleteSamplingRule(w http.ResponseWriter, r *http.Request) {
+	var req DeleteSamplingRuleInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if req.RuleName == "" && req.RuleARN == "" {
+		writeError(w, errInvalidRequest, "RuleName or RuleARN is required", http.StatusBadRequest)
+
+		return
+	}
+
+	record, err := s.storage.DeleteSamplingRule(r.Context(), req.RuleName, req.RuleARN)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, DeleteSamplingRuleOutput{
+		SamplingRuleRecord: toSamplingRuleRecordResponse(record),
+	})
+}
+
+// GetSamplingTargets handles the GetSamplingTargets operation.
+func (s *Service) GetSamplingTargets(w http.ResponseWriter, r *http.Request) {
+	var req GetSamplingTargetsInput
+	if err := readJSONRequest(r, &req); err != nil {
+		writeError(w, errInvalidRequest, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	targets, lastModification, unprocessed, err := s.storage.GetSamplingTargets(r.Context(), req.SamplingStatisticsDocuments)
+	if err != nil {
+		handleStorageError(w, err)
+
+		return
+	}
+
+	writeJSONResponse(w, GetSamplingTargetsOutput{
+		SamplingTargetDocuments: targets,
+		LastRuleModification:    AWSTimestamp{Time: lastModification}.Ptr(),
+		UnprocessedStatistics:   unprocessed,
+	})
+}
+
+// toSamplingRuleRecordResponse converts a stored sampling rule record to its API representation.
+func toSamplingRuleRecordResponse(record *SamplingRuleRecord) *SamplingRuleRecordResponse {
+	return &SamplingRuleRecordResponse{
+		SamplingRule: record.SamplingRule,
+		CreatedAt:    AWSTimestamp{Time: record.CreatedAt}.Ptr(),
+		ModifiedAt:   AWSTimestamp{Time: record.ModifiedAt}.Ptr(),
+	}
+}
+
 // Helper functions.
 
 // readJSONRequest reads and decodes JSON request body.
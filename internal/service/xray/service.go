@@ -1,9 +1,9 @@
 package xray
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"os"
 
 	"github.com/sivchari/kumo/internal/service"
 )
@@ -12,12 +12,14 @@ import (
 var _ io.Closer = (*Service)(nil)
 
 func init() {
-	var opts []Option
-	if dir := os.Getenv("KUMO_DATA_DIR"); dir != "" {
-		opts = append(opts, WithDataDir(dir))
-	}
+	service.RegisterFactory([]string{"xray"}, func(dataDir string) []service.Service {
+		var opts []Option
+		if dataDir != "" {
+			opts = append(opts, WithDataDir(dataDir))
+		}
 
-	service.Register(New(NewMemoryStorage(opts...)))
+		return []service.Service{New(NewMemoryStorage(opts...))}
+	})
 }
 
 // Service implements the X-Ray service.
@@ -46,6 +48,11 @@ func (s *Service) RegisterRoutes(r service.Router) {
 	r.HandleFunc("POST", "/ServiceGraph", s.GetServiceGraph)
 	r.HandleFunc("POST", "/CreateGroup", s.CreateGroup)
 	r.HandleFunc("POST", "/DeleteGroup", s.DeleteGroup)
+	r.HandleFunc("POST", "/CreateSamplingRule", s.CreateSamplingRule)
+	r.HandleFunc("POST", "/GetSamplingRules", s.GetSamplingRules)
+	r.HandleFunc("POST", "/UpdateSamplingRule", s.UpdateSamplingRule)
+	r.HandleFunc("POST", "/DeleteSamplingRule", s.DeleteSamplingRule)
+	r.HandleFunc("POST", "/SamplingTargets", s.GetSamplingTargets)
 }
 
 // Close saves the storage state if persistence is enabled.
@@ -58,3 +65,17 @@ func (s *Service) Close() error {
 
 	return nil
 }
+
+// StateStorage returns the storage holding the service state.
+func (s *Service) StateStorage() service.StateStorage {
+	return s.storage
+}
+
+// Reset clears the storage state.
+func (s *Service) Reset(ctx context.Context) error {
+	if err := s.storage.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset storage: %w", err)
+	}
+
+	return nil
+}
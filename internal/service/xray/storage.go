@@ -2,8 +2,13 @@ package xray
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -18,14 +23,45 @@ const (
 	errNotFound       = "InvalidRequestException"
 )
 
+// traceSummariesPageSize is the number of trace summaries returned per page.
+const traceSummariesPageSize = 100
+
+// samplingRulesPageSize is the number of sampling rules returned per page.
+const samplingRulesPageSize = 100
+
+// Default sampling rule, which exists in every account and cannot be deleted.
+const (
+	defaultSamplingRuleName          = "Default"
+	defaultSamplingRulePriority      = 10000
+	defaultSamplingRuleFixedRate     = 0.05
+	defaultSamplingRuleReservoirSize = 1
+)
+
+// Sampling rule limits.
+const (
+	maxSamplingRuleNameLength = 32
+	minSamplingRulePriority   = 1
+	maxSamplingRulePriority   = 9999
+	samplingRuleVersion       = 1
+)
+
+// samplingTargetInterval is how often, in seconds, clients are told to report statistics.
+const samplingTargetInterval = 10
+
 // Storage defines the interface for X-Ray storage operations.
 type Storage interface {
 	PutTraceSegments(ctx context.Context, documents []string) ([]UnprocessedTraceSegment, error)
-	GetTraceSummaries(ctx context.Context, startTime, endTime time.Time) ([]TraceSummary, error)
+	GetTraceSummaries(ctx context.Context, startTime, endTime time.Time, timeRangeType, nextToken string) ([]TraceSummary, string, error)
 	BatchGetTraces(ctx context.Context, traceIDs []string) ([]*Trace, []string, error)
 	GetServiceGraph(ctx context.Context, startTime, endTime time.Time, groupName string) ([]ServiceNode, error)
 	CreateGroup(ctx context.Context, input *CreateGroupInput) (*Group, error)
 	DeleteGroup(ctx context.Context, groupName, groupARN string) error
+	CreateSamplingRule(ctx context.Context, rule *SamplingRule) (*SamplingRuleRecord, error)
+	GetSamplingRules(ctx context.Context, nextToken string) ([]*SamplingRuleRecord, string, error)
+	UpdateSamplingRule(ctx context.Context, update *SamplingRuleUpdate) (*SamplingRuleRecord, error)
+	DeleteSamplingRule(ctx context.Context, ruleName, ruleARN string) (*SamplingRuleRecord, error)
+	GetSamplingTargets(ctx context.Context, statistics []SamplingStatisticsDocument) ([]SamplingTargetDocument, time.Time, []UnprocessedStatistics, error)
+	Reset(ctx context.Context) error
 }
 
 // Option is a configuration option for MemoryStorage.
@@ -46,19 +82,21 @@ var (
 
 // MemoryStorage implements Storage with in-memory data structures.
 type MemoryStorage struct {
-	mu       sync.RWMutex        `json:"-"`
-	Traces   map[string]*Trace   `json:"traces"`   // key: traceID
-	Segments map[string]*Segment `json:"segments"` // key: segmentID
-	Groups   map[string]*Group   `json:"groups"`   // key: groupName
-	dataDir  string
+	mu            sync.RWMutex                   `json:"-"`
+	Traces        map[string]*Trace              `json:"traces"`        // key: traceID
+	Segments      map[string]*Segment            `json:"segments"`      // key: segmentID
+	Groups        map[string]*Group              `json:"groups"`        // key: groupName
+	SamplingRules map[string]*SamplingRuleRecord `json:"samplingRules"` // key: ruleName
+	dataDir       string
 }
 
 // NewMemoryStorage creates a new in-memory storage.
 func NewMemoryStorage(opts ...Option) *MemoryStorage {
 	s := &MemoryStorage{
-		Traces:   make(map[string]*Trace),
-		Segments: make(map[string]*Segment),
-		Groups:   make(map[string]*Group),
+		Traces:        make(map[string]*Trace),
+		Segments:      make(map[string]*Segment),
+		Groups:        make(map[string]*Group),
+		SamplingRules: newSamplingRules(time.Now()),
 	}
 	for _, o := range opts {
 		o(s)
@@ -111,6 +149,10 @@ func (s *MemoryStorage) UnmarshalJSON(data []byte) error {
 		s.Groups = make(map[string]*Group)
 	}
 
+	if s.SamplingRules == nil {
+		s.SamplingRules = newSamplingRules(time.Now())
+	}
+
 	return nil
 }
 
@@ -127,6 +169,19 @@ func (s *MemoryStorage) Close() error {
 	return nil
 }
 
+// Reset clears all stored resources.
+func (s *MemoryStorage) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Traces = make(map[string]*Trace)
+	s.Segments = make(map[string]*Segment)
+	s.Groups = make(map[string]*Group)
+	s.SamplingRules = newSamplingRules(time.Now())
+
+	return nil
+}
+
 // SegmentDocument represents the structure of a segment document.
 type SegmentDocument struct {
 	ID         string  `json:"id"`
@@ -156,13 +211,17 @@ type SegmentDocument struct {
 	Throttle bool `json:"throttle"`
 }
 
-// PutTraceSegments stores trace segments.
+// PutTraceSegments stores trace segments, grouping them into traces by trace ID.
+// A segment sent again with the same ID, such as an in-progress segment being
+// completed, replaces the previously stored document.
 func (s *MemoryStorage) PutTraceSegments(_ context.Context, documents []string) ([]UnprocessedTraceSegment, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	var unprocessed []UnprocessedTraceSegment
 
+	now := time.Now()
+
 	for _, doc := range documents {
 		var segDoc SegmentDocument
 		if err := json.Unmarshal([]byte(doc), &segDoc); err != nil {
@@ -175,12 +234,14 @@ func (s *MemoryStorage) PutTraceSegments(_ context.Context, documents []string)
 			continue
 		}
 
-		if segDoc.ID == "" {
-			segDoc.ID = uuid.New().String()[:16]
-		}
+		if msg := validateSegmentDocument(&segDoc); msg != "" {
+			unprocessed = append(unprocessed, UnprocessedTraceSegment{
+				ID:        segDoc.ID,
+				ErrorCode: "InvalidSegmentDocument",
+				Message:   msg,
+			})
 
-		if segDoc.TraceID == "" {
-			segDoc.TraceID = fmt.Sprintf("1-%x-%s", time.Now().Unix(), uuid.New().String()[:24])
+			continue
 		}
 
 		segment := &Segment{
@@ -188,8 +249,6 @@ func (s *MemoryStorage) PutTraceSegments(_ context.Context, documents []string)
 			Document: doc,
 		}
 
-		s.Segments[segDoc.ID] = segment
-
 		// Create or update trace.
 		trace, exists := s.Traces[segDoc.TraceID]
 		if !exists {
@@ -200,57 +259,245 @@ func (s *MemoryStorage) PutTraceSegments(_ context.Context, documents []string)
 			s.Traces[segDoc.TraceID] = trace
 		}
 
-		trace.Segments = append(trace.Segments, segment)
+		idx := slices.IndexFunc(trace.Segments, func(seg *Segment) bool {
+			return seg.ID == segDoc.ID
+		})
+		if idx >= 0 {
+			trace.Segments[idx] = segment
+		} else {
+			trace.Segments = append(trace.Segments, segment)
+		}
+
+		s.Segments[segDoc.ID] = segment
+
+		trace.UpdatedAt = now
+		trace.Duration = traceDuration(trace)
+	}
+
+	return unprocessed, nil
+}
+
+// validateSegmentDocument returns a message describing why the document cannot
+// be stored, or an empty string when it is valid.
+func validateSegmentDocument(segDoc *SegmentDocument) string {
+	switch {
+	case segDoc.ID == "":
+		return "Segment document is missing the id field"
+	case segDoc.TraceID == "":
+		return "Segment document is missing the trace_id field"
+	case segDoc.Name == "":
+		return "Segment document is missing the name field"
+	case segDoc.StartTime == 0:
+		return "Segment document is missing the start_time field"
+	case segDoc.EndTime == 0 && !segDoc.InProgress:
+		return "Segment document must have either end_time or in_progress set"
+	}
+
+	return ""
+}
+
+// parseSegments decodes the documents of all segments in a trace, skipping
+// documents that cannot be decoded.
+func parseSegments(trace *Trace) []SegmentDocument {
+	docs := make([]SegmentDocument, 0, len(trace.Segments))
+
+	for _, segment := range trace.Segments {
+		var segDoc SegmentDocument
+		if err := json.Unmarshal([]byte(segment.Document), &segDoc); err != nil {
+			continue
+		}
+
+		docs = append(docs, segDoc)
+	}
 
-		// Calculate duration.
-		if segDoc.EndTime > 0 && segDoc.StartTime > 0 {
-			duration := segDoc.EndTime - segDoc.StartTime
-			if duration > trace.Duration {
-				trace.Duration = duration
+	return docs
+}
+
+// traceBounds returns the earliest start time and latest end time of the segments in a trace.
+func traceBounds(docs []SegmentDocument) (float64, float64) {
+	var start, end float64
+
+	for i := range docs {
+		if start == 0 || docs[i].StartTime < start {
+			start = docs[i].StartTime
+		}
+
+		end = max(end, docs[i].EndTime)
+	}
+
+	return start, end
+}
+
+// traceDuration returns the time elapsed between the first segment starting and
+// the last segment ending.
+func traceDuration(trace *Trace) float64 {
+	start, end := traceBounds(parseSegments(trace))
+	if end < start {
+		return 0
+	}
+
+	return end - start
+}
+
+// traceIDTime returns the time encoded in a trace ID of the form
+// 1-<8 hex digits epoch>-<24 hex digits>.
+func traceIDTime(traceID string) (time.Time, bool) {
+	parts := strings.Split(traceID, "-")
+	if len(parts) != 3 || parts[0] != "1" {
+		return time.Time{}, false
+	}
+
+	epoch, err := strconv.ParseInt(parts[1], 16, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(epoch, 0), true
+}
+
+// traceInRange reports whether a trace falls within the time range, interpreted
+// according to the TimeRangeType of the request.
+func traceInRange(trace *Trace, docs []SegmentDocument, timeRangeType string, startTime, endTime time.Time) bool {
+	inRange := func(t time.Time) bool {
+		return !t.Before(startTime) && !t.After(endTime)
+	}
+
+	switch timeRangeType {
+	case TimeRangeTypeEvent:
+		return inRange(trace.UpdatedAt)
+	case TimeRangeTypeService:
+		for i := range docs {
+			if docs[i].EndTime > 0 && inRange(floatToTime(docs[i].EndTime)) {
+				return true
 			}
 		}
+
+		return false
+	default:
+		if t, ok := traceIDTime(trace.ID); ok {
+			return inRange(t)
+		}
+
+		start, _ := traceBounds(docs)
+
+		return inRange(floatToTime(start))
 	}
+}
 
-	return unprocessed, nil
+// floatToTime converts epoch seconds with a fractional part to a time.Time.
+func floatToTime(f float64) time.Time {
+	sec := int64(f)
+
+	return time.Unix(sec, int64((f-float64(sec))*1e9))
 }
 
-// GetTraceSummaries retrieves trace summaries.
-func (s *MemoryStorage) GetTraceSummaries(_ context.Context, _, _ time.Time) ([]TraceSummary, error) {
+// summarizeTrace builds the summary of a trace from its segment documents.
+// Request details come from the root segment, the one without a parent.
+func summarizeTrace(trace *Trace, docs []SegmentDocument) TraceSummary {
+	summary := TraceSummary{
+		ID:       trace.ID,
+		Duration: trace.Duration,
+	}
+
+	start, _ := traceBounds(docs)
+	if start > 0 {
+		summary.StartTime = floatToTime(start)
+	}
+
+	seen := make(map[string]bool)
+
+	var root *SegmentDocument
+
+	for i := range docs {
+		doc := &docs[i]
+
+		summary.HasFault = summary.HasFault || doc.Fault
+		summary.HasError = summary.HasError || doc.Error
+		summary.HasThrottle = summary.HasThrottle || doc.Throttle
+		summary.IsPartial = summary.IsPartial || doc.InProgress
+
+		if !seen[doc.Name] {
+			seen[doc.Name] = true
+
+			summary.ServiceIDs = append(summary.ServiceIDs, ServiceID{
+				Name:  doc.Name,
+				Names: []string{doc.Name},
+				Type:  doc.Origin,
+			})
+		}
+
+		if root == nil && doc.ParentID == "" {
+			root = doc
+		}
+	}
+
+	if root == nil {
+		return summary
+	}
+
+	if root.EndTime > 0 {
+		summary.ResponseTime = root.EndTime - root.StartTime
+	}
+
+	summary.EntryPoint = &ServiceID{
+		Name:  root.Name,
+		Names: []string{root.Name},
+		Type:  root.Origin,
+	}
+
+	if root.User != "" {
+		summary.Users = []TraceUser{{UserName: root.User}}
+	}
+
+	summary.HTTP = &HTTPInfo{
+		HTTPMethod: root.HTTP.Request.Method,
+		HTTPURL:    root.HTTP.Request.URL,
+		ClientIP:   root.HTTP.Request.ClientIP,
+		//nolint:gosec // G115: HTTP status codes are always in range 100-599, safe for int32.
+		HTTPStatus: int32(root.HTTP.Response.Status),
+	}
+
+	return summary
+}
+
+// GetTraceSummaries retrieves summaries of the traces within the time range,
+// most recent first.
+func (s *MemoryStorage) GetTraceSummaries(_ context.Context, startTime, endTime time.Time, timeRangeType, nextToken string) ([]TraceSummary, string, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	if endTime.Before(startTime) {
+		return nil, "", &Error{
+			Code:    errInvalidRequest,
+			Message: "EndTime must be after StartTime",
+		}
+	}
+
 	summaries := make([]TraceSummary, 0, len(s.Traces))
 
 	for _, trace := range s.Traces {
-		summary := TraceSummary{
-			ID:       trace.ID,
-			Duration: trace.Duration,
+		docs := parseSegments(trace)
+		if !traceInRange(trace, docs, timeRangeType, startTime, endTime) {
+			continue
 		}
 
-		// Parse first segment to get additional info.
-		if len(trace.Segments) > 0 {
-			var segDoc SegmentDocument
-			if err := json.Unmarshal([]byte(trace.Segments[0].Document), &segDoc); err == nil {
-				summary.HasFault = segDoc.Fault
-				summary.HasError = segDoc.Error
-				summary.HasThrottle = segDoc.Throttle
-				summary.HTTP = &HTTPInfo{
-					HTTPMethod: segDoc.HTTP.Request.Method,
-					HTTPURL:    segDoc.HTTP.Request.URL,
-					ClientIP:   segDoc.HTTP.Request.ClientIP,
-					//nolint:gosec // G115: HTTP status codes are always in range 100-599, safe for int32.
-					HTTPStatus: int32(segDoc.HTTP.Response.Status),
-				}
-			}
+		summaries = append(summaries, summarizeTrace(trace, docs))
+	}
+
+	slices.SortFunc(summaries, func(a, b TraceSummary) int {
+		if c := b.StartTime.Compare(a.StartTime); c != 0 {
+			return c
 		}
 
-		summaries = append(summaries, summary)
-	}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	page, next := paginate(summaries, nextToken, traceSummariesPageSize)
 
-	return summaries, nil
+	return page, next, nil
 }
 
-// BatchGetTraces retrieves traces by ID.
+// BatchGetTraces retrieves traces by ID, in the order requested.
 func (s *MemoryStorage) BatchGetTraces(_ context.Context, traceIDs []string) ([]*Trace, []string, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -393,3 +640,311 @@ func (s *MemoryStorage) DeleteGroup(_ context.Context, groupName, groupARN strin
 
 	return nil
 }
+
+// newSamplingRules returns the sampling rules of a new account, which hold only the default rule.
+func newSamplingRules(now time.Time) map[string]*SamplingRuleRecord {
+	return map[string]*SamplingRuleRecord{
+		defaultSamplingRuleName: {
+			SamplingRule: &SamplingRule{
+				RuleName:      defaultSamplingRuleName,
+				RuleARN:       samplingRuleARN(defaultSamplingRuleName),
+				ResourceARN:   "*",
+				Priority:      defaultSamplingRulePriority,
+				FixedRate:     defaultSamplingRuleFixedRate,
+				ReservoirSize: defaultSamplingRuleReservoirSize,
+				ServiceName:   "*",
+				ServiceType:   "*",
+				Host:          "*",
+				HTTPMethod:    "*",
+				URLPath:       "*",
+				Version:       samplingRuleVersion,
+			},
+			CreatedAt:  now,
+			ModifiedAt: now,
+		},
+	}
+}
+
+// samplingRuleARN returns the ARN of the sampling rule with the given name.
+func samplingRuleARN(ruleName string) string {
+	return fmt.Sprintf("arn:aws:xray:us-east-1:000000000000:sampling-rule/%s", ruleName)
+}
+
+// CreateSamplingRule creates a new sampling rule.
+func (s *MemoryStorage) CreateSamplingRule(_ context.Context, rule *SamplingRule) (*SamplingRuleRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	created := *rule
+	created.RuleARN = samplingRuleARN(created.RuleName)
+	created.Attributes = maps.Clone(rule.Attributes)
+
+	if created.Version == 0 {
+		created.Version = samplingRuleVersion
+	}
+
+	if err := validateSamplingRule(&created); err != nil {
+		return nil, err
+	}
+
+	if _, exists := s.SamplingRules[created.RuleName]; exists {
+		return nil, &Error{
+			Code:    errInvalidRequest,
+			Message: fmt.Sprintf("Sampling rule %s already exists", created.RuleName),
+		}
+	}
+
+	now := time.Now()
+	record := &SamplingRuleRecord{
+		SamplingRule: &created,
+		CreatedAt:    now,
+		ModifiedAt:   now,
+	}
+
+	s.SamplingRules[created.RuleName] = record
+
+	return record, nil
+}
+
+// GetSamplingRules lists sampling rules in the order they are evaluated:
+// by ascending priority, then by rule name.
+func (s *MemoryStorage) GetSamplingRules(_ context.Context, nextToken string) ([]*SamplingRuleRecord, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	records := make([]*SamplingRuleRecord, 0, len(s.SamplingRules))
+	for _, record := range s.SamplingRules {
+		records = append(records, record)
+	}
+
+	slices.SortFunc(records, func(a, b *SamplingRuleRecord) int {
+		if a.SamplingRule.Priority != b.SamplingRule.Priority {
+			return int(a.SamplingRule.Priority - b.SamplingRule.Priority)
+		}
+
+		return strings.Compare(a.SamplingRule.RuleName, b.SamplingRule.RuleName)
+	})
+
+	page, next := paginate(records, nextToken, samplingRulesPageSize)
+
+	return page, next, nil
+}
+
+// UpdateSamplingRule modifies the fields of a sampling rule that are set in update.
+func (s *MemoryStorage) UpdateSamplingRule(_ context.Context, update *SamplingRuleUpdate) (*SamplingRuleRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, err := s.findSamplingRule(update.RuleName, update.RuleARN)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := *existing.SamplingRule
+	rule.Attributes = maps.Clone(existing.SamplingRule.Attributes)
+
+	applySamplingRuleUpdate(&rule, update)
+
+	if err := validateSamplingRule(&rule); err != nil {
+		return nil, err
+	}
+
+	// Records are replaced rather than modified so that previously returned
+	// records are not changed underneath their readers.
+	record := &SamplingRuleRecord{
+		SamplingRule: &rule,
+		CreatedAt:    existing.CreatedAt,
+		ModifiedAt:   time.Now(),
+	}
+
+	s.SamplingRules[rule.RuleName] = record
+
+	return record, nil
+}
+
+// DeleteSamplingRule deletes a sampling rule by name or ARN.
+func (s *MemoryStorage) DeleteSamplingRule(_ context.Context, ruleName, ruleARN string) (*SamplingRuleRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, err := s.findSamplingRule(ruleName, ruleARN)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.SamplingRule.RuleName == defaultSamplingRuleName {
+		return nil, &Error{
+			Code:    errInvalidRequest,
+			Message: "The default sampling rule cannot be deleted",
+		}
+	}
+
+	delete(s.SamplingRules, record.SamplingRule.RuleName)
+
+	return record, nil
+}
+
+// GetSamplingTargets assigns sampling targets for the rules that clients
+// report statistics for. Every client receives the full reservoir of a rule.
+func (s *MemoryStorage) GetSamplingTargets(_ context.Context, statistics []SamplingStatisticsDocument) ([]SamplingTargetDocument, time.Time, []UnprocessedStatistics, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := time.Now()
+	quotaTTL := now.Add(samplingTargetInterval * time.Second)
+
+	targets := make([]SamplingTargetDocument, 0, len(statistics))
+	unprocessed := make([]UnprocessedStatistics, 0)
+
+	for _, doc := range statistics {
+		record, exists := s.SamplingRules[doc.RuleName]
+		if !exists {
+			unprocessed = append(unprocessed, UnprocessedStatistics{
+				RuleName:  doc.RuleName,
+				ErrorCode: "400",
+				Message:   fmt.Sprintf("Sampling rule %s not found", doc.RuleName),
+			})
+
+			continue
+		}
+
+		targets = append(targets, SamplingTargetDocument{
+			RuleName:          record.SamplingRule.RuleName,
+			FixedRate:         record.SamplingRule.FixedRate,
+			ReservoirQuota:    record.SamplingRule.ReservoirSize,
+			ReservoirQuotaTTL: AWSTimestamp{Time: quotaTTL}.Ptr(),
+			Interval:          samplingTargetInterval,
+		})
+	}
+
+	var lastModification time.Time
+
+	for _, record := range s.SamplingRules {
+		if record.ModifiedAt.After(lastModification) {
+			lastModification = record.ModifiedAt
+		}
+	}
+
+	return targets, lastModification, unprocessed, nil
+}
+
+// findSamplingRule looks up a sampling rule by name, or by ARN when no name is given.
+// The caller must hold the lock.
+func (s *MemoryStorage) findSamplingRule(ruleName, ruleARN string) (*SamplingRuleRecord, error) {
+	if ruleName != "" {
+		if record, exists := s.SamplingRules[ruleName]; exists {
+			return record, nil
+		}
+	} else {
+		for _, record := range s.SamplingRules {
+			if record.SamplingRule.RuleARN == ruleARN {
+				return record, nil
+			}
+		}
+	}
+
+	return nil, &Error{
+		Code:    errNotFound,
+		Message: "Sampling rule not found",
+	}
+}
+
+// applySamplingRuleUpdate copies the fields set in update onto rule.
+func applySamplingRuleUpdate(rule *SamplingRule, update *SamplingRuleUpdate) {
+	setIfPresent(&rule.ResourceARN, update.ResourceARN)
+	setIfPresent(&rule.Priority, update.Priority)
+	setIfPresent(&rule.FixedRate, update.FixedRate)
+	setIfPresent(&rule.ReservoirSize, update.ReservoirSize)
+	setIfPresent(&rule.ServiceName, update.ServiceName)
+	setIfPresent(&rule.ServiceType, update.ServiceType)
+	setIfPresent(&rule.Host, update.Host)
+	setIfPresent(&rule.HTTPMethod, update.HTTPMethod)
+	setIfPresent(&rule.URLPath, update.URLPath)
+
+	if update.Attributes != nil {
+		rule.Attributes = maps.Clone(update.Attributes)
+	}
+}
+
+// setIfPresent assigns *src to *dst when src is not nil.
+func setIfPresent[T any](dst *T, src *T) {
+	if src != nil {
+		*dst = *src
+	}
+}
+
+// validateSamplingRule checks the fields of a sampling rule against the X-Ray limits.
+func validateSamplingRule(rule *SamplingRule) error {
+	invalid := func(format string, args ...any) error {
+		return &Error{
+			Code:    errInvalidRequest,
+			Message: fmt.Sprintf(format, args...),
+		}
+	}
+
+	if rule.RuleName == "" {
+		return invalid("RuleName is required")
+	}
+
+	if len(rule.RuleName) > maxSamplingRuleNameLength {
+		return invalid("RuleName must be at most %d characters", maxSamplingRuleNameLength)
+	}
+
+	// The default rule is evaluated last and keeps its out-of-range priority.
+	if rule.RuleName != defaultSamplingRuleName &&
+		(rule.Priority < minSamplingRulePriority || rule.Priority > maxSamplingRulePriority) {
+		return invalid("Priority must be between %d and %d", minSamplingRulePriority, maxSamplingRulePriority)
+	}
+
+	if rule.FixedRate < 0 || rule.FixedRate > 1 {
+		return invalid("FixedRate must be between 0 and 1")
+	}
+
+	if rule.ReservoirSize < 0 {
+		return invalid("ReservoirSize must not be negative")
+	}
+
+	if rule.Version != samplingRuleVersion {
+		return invalid("Version must be %d", samplingRuleVersion)
+	}
+
+	required := []struct{ name, value string }{
+		{"ResourceARN", rule.ResourceARN},
+		{"ServiceName", rule.ServiceName},
+		{"ServiceType", rule.ServiceType},
+		{"Host", rule.Host},
+		{"HTTPMethod", rule.HTTPMethod},
+		{"URLPath", rule.URLPath},
+	}
+
+	for _, field := range required {
+		if field.value == "" {
+			return invalid("%s is required", field.name)
+		}
+	}
+
+	return nil
+}
+
+// paginate returns the page of items starting at the offset encoded in nextToken,
+// along with the token for the following page.
+func paginate[T any](items []T, nextToken string, pageSize int) ([]T, string) {
+	start := 0
+
+	if nextToken != "" {
+		if decoded, err := base64.StdEncoding.DecodeString(nextToken); err == nil {
+			if idx, err := strconv.Atoi(string(decoded)); err == nil && idx >= 0 && idx <= len(items) {
+				start = idx
+			}
+		}
+	}
+
+	end := min(start+pageSize, len(items))
+
+	var next string
+	if end < len(items) {
+		next = base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(end)))
+	}
+
+	return items[start:end], next
+}
@@ -55,9 +55,17 @@ type TraceSegment struct {
 	Document string
 }
 
+// Time range types for GetTraceSummaries.
+const (
+	TimeRangeTypeTraceID = "TraceId"
+	TimeRangeTypeEvent   = "Event"
+	TimeRangeTypeService = "Service"
+)
+
 // TraceSummary represents a trace summary.
 type TraceSummary struct {
 	ID                string
+	StartTime         time.Time
 	Duration          float64
 	ResponseTime      float64
 	HasFault          bool
@@ -167,6 +175,7 @@ type Trace struct {
 	Duration      float64
 	LimitExceeded bool
 	Segments      []*Segment
+	UpdatedAt     time.Time
 }
 
 // Segment represents a trace segment.
@@ -299,6 +308,7 @@ type GetTraceSummariesOutput struct {
 // TraceSummaryResponse represents a trace summary in API responses.
 type TraceSummaryResponse struct {
 	ID                string                           `json:"Id,omitempty"`
+	StartTime         *AWSTimestamp                    `json:"StartTime,omitempty"`
 	Duration          float64                          `json:"Duration,omitempty"`
 	ResponseTime      float64                          `json:"ResponseTime,omitempty"`
 	HasFault          bool                             `json:"HasFault,omitempty"`
@@ -391,6 +401,134 @@ type DeleteGroupInput struct {
 	GroupARN  string `json:"GroupARN,omitempty"`
 }
 
+// SamplingRule represents an X-Ray sampling rule.
+type SamplingRule struct {
+	RuleName      string            `json:"RuleName,omitempty"`
+	RuleARN       string            `json:"RuleARN,omitempty"`
+	ResourceARN   string            `json:"ResourceARN"`
+	Priority      int32             `json:"Priority"`
+	FixedRate     float64           `json:"FixedRate"`
+	ReservoirSize int32             `json:"ReservoirSize"`
+	ServiceName   string            `json:"ServiceName"`
+	ServiceType   string            `json:"ServiceType"`
+	Host          string            `json:"Host"`
+	HTTPMethod    string            `json:"HTTPMethod"`
+	URLPath       string            `json:"URLPath"`
+	Version       int32             `json:"Version"`
+	Attributes    map[string]string `json:"Attributes,omitempty"`
+}
+
+// SamplingRuleRecord represents a stored sampling rule with its timestamps.
+type SamplingRuleRecord struct {
+	SamplingRule *SamplingRule
+	CreatedAt    time.Time
+	ModifiedAt   time.Time
+}
+
+// SamplingRuleUpdate represents the fields of a sampling rule to change.
+type SamplingRuleUpdate struct {
+	RuleName      string            `json:"RuleName,omitempty"`
+	RuleARN       string            `json:"RuleARN,omitempty"`
+	ResourceARN   *string           `json:"ResourceARN,omitempty"`
+	Priority      *int32            `json:"Priority,omitempty"`
+	FixedRate     *float64          `json:"FixedRate,omitempty"`
+	ReservoirSize *int32            `json:"ReservoirSize,omitempty"`
+	ServiceName   *string           `json:"ServiceName,omitempty"`
+	ServiceType   *string           `json:"ServiceType,omitempty"`
+	Host          *string           `json:"Host,omitempty"`
+	HTTPMethod    *string           `json:"HTTPMethod,omitempty"`
+	URLPath       *string           `json:"URLPath,omitempty"`
+	Attributes    map[string]string `json:"Attributes,omitempty"`
+}
+
+// SamplingStatisticsDocument represents the sampling statistics a client reports for a rule.
+type SamplingStatisticsDocument struct {
+	RuleName     string        `json:"RuleName"`
+	ClientID     string        `json:"ClientID"`
+	Timestamp    *AWSTimestamp `json:"Timestamp,omitempty"`
+	RequestCount int32         `json:"RequestCount"`
+	SampledCount int32         `json:"SampledCount"`
+	BorrowCount  int32         `json:"BorrowCount,omitempty"`
+}
+
+// SamplingTargetDocument represents the sampling target assigned to a client for a rule.
+type SamplingTargetDocument struct {
+	RuleName          string        `json:"RuleName,omitempty"`
+	FixedRate         float64       `json:"FixedRate"`
+	ReservoirQuota    int32         `json:"ReservoirQuota"`
+	ReservoirQuotaTTL *AWSTimestamp `json:"ReservoirQuotaTTL,omitempty"`
+	Interval          int32         `json:"Interval,omitempty"`
+}
+
+// UnprocessedStatistics represents sampling statistics that could not be processed.
+type UnprocessedStatistics struct {
+	RuleName  string `json:"RuleName,omitempty"`
+	ErrorCode string `json:"ErrorCode,omitempty"`
+	Message   string `json:"Message,omitempty"`
+}
+
+// SamplingRuleRecordResponse represents a sampling rule record in API responses.
+type SamplingRuleRecordResponse struct {
+	SamplingRule *SamplingRule `json:"SamplingRule,omitempty"`
+	CreatedAt    *AWSTimestamp `json:"CreatedAt,omitempty"`
+	ModifiedAt   *AWSTimestamp `json:"ModifiedAt,omitempty"`
+}
+
+// CreateSamplingRuleInput is the request for CreateSamplingRule.
+type CreateSamplingRuleInput struct {
+	SamplingRule *SamplingRule `json:"SamplingRule"`
+	Tags         []Tag         `json:"Tags,omitempty"`
+}
+
+// CreateSamplingRuleOutput is the response for CreateSamplingRule.
+type CreateSamplingRuleOutput struct {
+	SamplingRuleRecord *SamplingRuleRecordResponse `json:"SamplingRuleRecord,omitempty"`
+}
+
+// GetSamplingRulesInput is the request for GetSamplingRules.
+type GetSamplingRulesInput struct {
+	NextToken string `json:"NextToken,omitempty"`
+}
+
+// GetSamplingRulesOutput is the response for GetSamplingRules.
+type GetSamplingRulesOutput struct {
+	SamplingRuleRecords []SamplingRuleRecordResponse `json:"SamplingRuleRecords"`
+	NextToken           string                       `json:"NextToken,omitempty"`
+}
+
+// UpdateSamplingRuleInput is the request for UpdateSamplingRule.
+type UpdateSamplingRuleInput struct {
+	SamplingRuleUpdate *SamplingRuleUpdate `json:"SamplingRuleUpdate"`
+}
+
+// UpdateSamplingRuleOutput is the response for UpdateSamplingRule.
+type UpdateSamplingRuleOutput struct {
+	SamplingRuleRecord *SamplingRuleRecordResponse `json:"SamplingRuleRecord,omitempty"`
+}
+
+// DeleteSamplingRuleInput is the request for DeleteSamplingRule.
+type DeleteSamplingRuleInput struct {
+	RuleName string `json:"RuleName,omitempty"`
+	RuleARN  string `json:"RuleARN,omitempty"`
+}
+
+// DeleteSamplingRuleOutput is the response for DeleteSamplingRule.
+type DeleteSamplingRuleOutput struct {
+	SamplingRuleRecord *SamplingRuleRecordResponse `json:"SamplingRuleRecord,omitempty"`
+}
+
+// GetSamplingTargetsInput is the request for GetSamplingTargets.
+type GetSamplingTargetsInput struct {
+	SamplingStatisticsDocuments []SamplingStatisticsDocument `json:"SamplingStatisticsDocuments"`
+}
+
+// GetSamplingTargetsOutput is the response for GetSamplingTargets.
+type GetSamplingTargetsOutput struct {
+	SamplingTargetDocuments []SamplingTargetDocument `json:"SamplingTargetDocuments"`
+	LastRuleModification    *AWSTimestamp            `json:"LastRuleModification,omitempty"`
+	UnprocessedStatistics   []UnprocessedStatistics  `json:"UnprocessedStatistics"`
+}
+
 // Tag represents a tag.
 type Tag struct {
 	Key   string `json:"Key"`
@@ -32,6 +32,7 @@ import (
 	_ "github.com/sivchari/kumo/internal/service/codeguruprofiler"
 	_ "github.com/sivchari/kumo/internal/service/codegurureviewer"
 	_ "github.com/sivchari/kumo/internal/service/cognito"
+	_ "github.com/sivchari/kumo/internal/service/cognitoidentity"
 	_ "github.com/sivchari/kumo/internal/service/comprehend"
 	_ "github.com/sivchari/kumo/internal/service/configservice"
 	_ "github.com/sivchari/kumo/internal/service/dataexchange"
@@ -101,12 +102,28 @@ type Server struct {
 	httpServer *httptest.Server
 }
 
+// Option configures a Server created by NewServer.
+type Option func(*server.Config)
+
+// WithServices mounts only the named services (e.g. "s3", "sqs", "dynamodb").
+// By default every service is mounted.
+func WithServices(names ...string) Option {
+	return func(c *server.Config) {
+		c.Services = names
+	}
+}
+
 // NewServer creates and starts a new in-process AWS emulator server.
 // The server listens on a random available port on localhost.
 // Use srv.URL as the BaseEndpoint for AWS SDK clients.
-func NewServer() *Server {
+func NewServer(opts ...Option) *Server {
 	cfg := server.DefaultConfig()
 	cfg.LogLevel = 100 // Suppress all logs in test mode.
+
+	for _, o := range opts {
+		o(&cfg)
+	}
+
 	internalSrv := server.New(cfg)
 
 	ts := httptest.NewServer(internalSrv.Handler())
@@ -3,7 +3,19 @@
 package integration
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -296,3 +308,312 @@ func TestACM_GetCertificatePendingValidation(t *testing.T) {
 		t.Fatal("expected error when getting pending certificate")
 	}
 }
+
+func TestACM_CertificateIssuedAfterValidation(t *testing.T) {
+	client := newACMClient(t)
+	ctx := t.Context()
+
+	requestOutput, err := client.RequestCertificate(ctx, &acm.RequestCertificateInput{
+		DomainName:              aws.String("issued.example.com"),
+		SubjectAlternativeNames: []string{"www.issued.example.com"},
+		ValidationMethod:        types.ValidationMethodDns,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	arn := aws.ToString(requestOutput.CertificateArn)
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteCertificate(context.Background(), &acm.DeleteCertificateInput{
+			CertificateArn: aws.String(arn),
+		})
+	})
+
+	waitForACMCertificateStatus(t, client, arn, types.CertificateStatusIssued)
+
+	describeOutput, err := client.DescribeCertificate(ctx, &acm.DescribeCertificateInput{
+		CertificateArn: aws.String(arn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("CertificateArn", "CreatedAt", "IssuedAt", "NotBefore", "NotAfter", "Serial", "Value", "ResultMetadata")).Assert(t.Name()+"_describe", describeOutput)
+
+	getOutput, err := client.GetCertificate(ctx, &acm.GetCertificateInput{
+		CertificateArn: aws.String(arn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(aws.ToString(getOutput.Certificate), "-----BEGIN CERTIFICATE-----") {
+		t.Errorf("expected a PEM certificate, got %q", aws.ToString(getOutput.Certificate))
+	}
+}
+
+func TestACM_IssueCertificateEndpoint(t *testing.T) {
+	client := newACMClient(t)
+	ctx := t.Context()
+
+	requestOutput, err := client.RequestCertificate(ctx, &acm.RequestCertificateInput{
+		DomainName: aws.String("issue-endpoint.example.com"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	arn := aws.ToString(requestOutput.CertificateArn)
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteCertificate(context.Background(), &acm.DeleteCertificateInput{
+			CertificateArn: aws.String(arn),
+		})
+	})
+
+	if status := issueACMCertificate(t, arn); status != http.StatusOK {
+		t.Fatalf("failed to issue certificate: status %d", status)
+	}
+
+	describeOutput, err := client.DescribeCertificate(ctx, &acm.DescribeCertificateInput{
+		CertificateArn: aws.String(arn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := describeOutput.Certificate.Status; got != types.CertificateStatusIssued {
+		t.Errorf("expected status ISSUED, got %s", got)
+	}
+
+	for _, dv := range describeOutput.Certificate.DomainValidationOptions {
+		if dv.ValidationStatus != types.DomainStatusSuccess {
+			t.Errorf("expected validation status SUCCESS for %s, got %s", aws.ToString(dv.DomainName), dv.ValidationStatus)
+		}
+	}
+
+	// A certificate that is already issued cannot be issued again.
+	if status := issueACMCertificate(t, arn); status != http.StatusBadRequest {
+		t.Errorf("expected status %d when issuing an issued certificate, got %d", http.StatusBadRequest, status)
+	}
+}
+
+func TestACM_ListCertificatesPagination(t *testing.T) {
+	client := newACMClient(t)
+	ctx := t.Context()
+
+	arns := make([]string, 0, 3)
+
+	for _, domain := range []string{"page-1.example.com", "page-2.example.com", "page-3.example.com"} {
+		requestOutput, err := client.RequestCertificate(ctx, &acm.RequestCertificateInput{
+			DomainName: aws.String(domain),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		arns = append(arns, aws.ToString(requestOutput.CertificateArn))
+	}
+
+	t.Cleanup(func() {
+		for _, arn := range arns {
+			_, _ = client.DeleteCertificate(context.Background(), &acm.DeleteCertificateInput{
+				CertificateArn: aws.String(arn),
+			})
+		}
+	})
+
+	var listed []string
+
+	paginator := acm.NewListCertificatesPaginator(client, &acm.ListCertificatesInput{
+		MaxItems: aws.Int32(1),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(page.CertificateSummaryList) > 1 {
+			t.Fatalf("expected at most 1 certificate per page, got %d", len(page.CertificateSummaryList))
+		}
+
+		for _, cert := range page.CertificateSummaryList {
+			if slices.Contains(arns, aws.ToString(cert.CertificateArn)) {
+				listed = append(listed, aws.ToString(cert.CertificateArn))
+			}
+		}
+	}
+
+	if !slices.Equal(listed, arns) {
+		t.Errorf("expected certificates %v in request order, got %v", arns, listed)
+	}
+}
+
+func TestACM_ImportCertificatePEM(t *testing.T) {
+	client := newACMClient(t)
+	ctx := t.Context()
+
+	certPEM, keyPEM := generateACMTestCertificate(t, "pem-import.example.com", "www.pem-import.example.com")
+
+	importOutput, err := client.ImportCertificate(ctx, &acm.ImportCertificateInput{
+		Certificate: certPEM,
+		PrivateKey:  keyPEM,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	arn := aws.ToString(importOutput.CertificateArn)
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteCertificate(context.Background(), &acm.DeleteCertificateInput{
+			CertificateArn: aws.String(arn),
+		})
+	})
+
+	describeOutput, err := client.DescribeCertificate(ctx, &acm.DescribeCertificateInput{
+		CertificateArn: aws.String(arn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("CertificateArn", "CreatedAt", "IssuedAt", "ImportedAt", "NotBefore", "NotAfter", "Serial", "ResultMetadata")).Assert(t.Name()+"_describe", describeOutput)
+
+	// Reimporting keeps the ARN and replaces the certificate material.
+	certPEM, keyPEM = generateACMTestCertificate(t, "pem-reimport.example.com")
+
+	reimportOutput, err := client.ImportCertificate(ctx, &acm.ImportCertificateInput{
+		CertificateArn: aws.String(arn),
+		Certificate:    certPEM,
+		PrivateKey:     keyPEM,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(reimportOutput.CertificateArn) != arn {
+		t.Errorf("expected reimport to keep ARN %s, got %s", arn, aws.ToString(reimportOutput.CertificateArn))
+	}
+
+	describeOutput, err = client.DescribeCertificate(ctx, &acm.DescribeCertificateInput{
+		CertificateArn: aws.String(arn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := aws.ToString(describeOutput.Certificate.DomainName); got != "pem-reimport.example.com" {
+		t.Errorf("expected reimported domain pem-reimport.example.com, got %s", got)
+	}
+
+	// Reimporting into an unknown ARN fails.
+	_, err = client.ImportCertificate(ctx, &acm.ImportCertificateInput{
+		CertificateArn: aws.String("arn:aws:acm:us-east-1:000000000000:certificate/non-existent"),
+		Certificate:    certPEM,
+		PrivateKey:     keyPEM,
+	})
+
+	var notFound *types.ResourceNotFoundException
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expected ResourceNotFoundException, got %v", err)
+	}
+
+	// Imported certificates are listed under the ISSUED status filter.
+	found := false
+
+	paginator := acm.NewListCertificatesPaginator(client, &acm.ListCertificatesInput{
+		CertificateStatuses: []types.CertificateStatus{types.CertificateStatusIssued},
+		MaxItems:            aws.Int32(1),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, cert := range page.CertificateSummaryList {
+			if cert.Status != types.CertificateStatusIssued {
+				t.Errorf("expected only ISSUED certificates, got %s", cert.Status)
+			}
+
+			if aws.ToString(cert.CertificateArn) == arn {
+				found = true
+			}
+		}
+	}
+
+	if !found {
+		t.Errorf("expected imported certificate %s in ISSUED listing", arn)
+	}
+}
+
+// generateACMTestCertificate returns a self-signed PEM certificate and private key for the given domains.
+func generateACMTestCertificate(t *testing.T, domains ...string) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: domains[0]},
+		Issuer:       pkix.Name{Organization: []string{"kumo test CA"}},
+		DNSNames:     domains,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	return certPEM, keyPEM
+}
+
+// issueACMCertificate issues the certificate through the kumo-specific endpoint and returns the response status.
+func issueACMCertificate(t *testing.T, arn string) int {
+	t.Helper()
+
+	resp, err := http.Post("http://localhost:4566/kumo/acm/issue-certificate", "application/json", strings.NewReader(`{"CertificateArn": "`+arn+`"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	return resp.StatusCode
+}
+
+// waitForACMCertificateStatus polls the certificate until it reaches the given status.
+func waitForACMCertificateStatus(t *testing.T, client *acm.Client, arn string, status types.CertificateStatus) {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+
+	for {
+		output, err := client.DescribeCertificate(t.Context(), &acm.DescribeCertificateInput{
+			CertificateArn: aws.String(arn),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if output.Certificate.Status == status {
+			return
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("certificate %s did not reach %s, current status %s", arn, status, output.Certificate.Status)
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+}
@@ -0,0 +1,24 @@
+//go:build integration
+
+package integration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/smithy-go"
+)
+
+// assertAPIErrorCode checks that err is an API error with the given code.
+func assertAPIErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected API error %s, got %v", code, err)
+	}
+
+	if apiErr.ErrorCode() != code {
+		t.Errorf("expected error code %s, got %s", code, apiErr.ErrorCode())
+	}
+}
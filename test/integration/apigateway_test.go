@@ -3,6 +3,15 @@
 package integration
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -254,6 +263,366 @@ func TestAPIGateway_CreateDeploymentAndStage(t *testing.T) {
 	golden.New(t, golden.WithIgnoreFields("DeploymentId", "CreatedDate", "LastUpdatedDate", "ResultMetadata")).Assert(t.Name()+"_get_stage", getStageOutput)
 }
 
+func TestAPIGateway_ResourceTreeDeployment(t *testing.T) {
+	client := newAPIGatewayClient(t)
+	ctx := t.Context()
+
+	apiOutput, err := client.CreateRestApi(ctx, &apigateway.CreateRestApiInput{
+		Name: aws.String("test-resource-tree-api"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootResourceID := apiOutput.RootResourceId
+
+	ordersOutput, err := client.CreateResource(ctx, &apigateway.CreateResourceInput{
+		RestApiId: apiOutput.Id,
+		ParentId:  rootResourceID,
+		PathPart:  aws.String("orders"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orderOutput, err := client.CreateResource(ctx, &apigateway.CreateResourceInput{
+		RestApiId: apiOutput.Id,
+		ParentId:  ordersOutput.Id,
+		PathPart:  aws.String("{orderId}"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A sibling with the same path part conflicts.
+	_, err = client.CreateResource(ctx, &apigateway.CreateResourceInput{
+		RestApiId: apiOutput.Id,
+		ParentId:  rootResourceID,
+		PathPart:  aws.String("orders"),
+	})
+
+	var conflict *types.ConflictException
+	if !errors.As(err, &conflict) {
+		t.Errorf("expected ConflictException, got %v", err)
+	}
+
+	_, err = client.PutMethod(ctx, &apigateway.PutMethodInput{
+		RestApiId:         apiOutput.Id,
+		ResourceId:        orderOutput.Id,
+		HttpMethod:        aws.String("GET"),
+		AuthorizationType: aws.String("NONE"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Non-mock integrations require an integration HTTP method and URI.
+	_, err = client.PutIntegration(ctx, &apigateway.PutIntegrationInput{
+		RestApiId:  apiOutput.Id,
+		ResourceId: orderOutput.Id,
+		HttpMethod: aws.String("GET"),
+		Type:       types.IntegrationTypeAwsProxy,
+	})
+	if err == nil {
+		t.Error("expected error for AWS_PROXY integration without URI")
+	}
+
+	_, err = client.PutIntegration(ctx, &apigateway.PutIntegrationInput{
+		RestApiId:             apiOutput.Id,
+		ResourceId:            orderOutput.Id,
+		HttpMethod:            aws.String("GET"),
+		Type:                  types.IntegrationTypeAwsProxy,
+		IntegrationHttpMethod: aws.String("POST"),
+		Uri:                   aws.String("arn:aws:apigateway:us-east-1:lambda:path/2015-03-31/functions/arn:aws:lambda:us-east-1:000000000000:function:get-order/invocations"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deploymentOutput, err := client.CreateDeployment(ctx, &apigateway.CreateDeploymentInput{
+		RestApiId: apiOutput.Id,
+		StageName: aws.String("dev"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("Id", "CreatedDate", "ResultMetadata")).Assert(t.Name()+"_deployment", deploymentOutput)
+
+	stageOutput, err := client.GetStage(ctx, &apigateway.GetStageInput{
+		RestApiId: apiOutput.Id,
+		StageName: aws.String("dev"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(stageOutput.DeploymentId) != aws.ToString(deploymentOutput.Id) {
+		t.Errorf("expected stage to point at deployment %s, got %s", aws.ToString(deploymentOutput.Id), aws.ToString(stageOutput.DeploymentId))
+	}
+
+	// A deployment used by a stage cannot be deleted.
+	_, err = client.DeleteDeployment(ctx, &apigateway.DeleteDeploymentInput{
+		RestApiId:    apiOutput.Id,
+		DeploymentId: deploymentOutput.Id,
+	})
+	if err == nil {
+		t.Error("expected error deleting a deployment with an active stage")
+	}
+
+	// Resources are listed in path order, one page at a time.
+	var paths []string
+
+	paginator := apigateway.NewGetResourcesPaginator(client, &apigateway.GetResourcesInput{
+		RestApiId: apiOutput.Id,
+		Limit:     aws.Int32(2),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, res := range page.Items {
+			paths = append(paths, aws.ToString(res.Path))
+		}
+	}
+
+	if want := []string{"/", "/orders", "/orders/{orderId}"}; !slices.Equal(paths, want) {
+		t.Errorf("expected paths %v, got %v", want, paths)
+	}
+
+	// Deleting a resource deletes its children.
+	_, err = client.DeleteResource(ctx, &apigateway.DeleteResourceInput{
+		RestApiId:  apiOutput.Id,
+		ResourceId: ordersOutput.Id,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetResource(ctx, &apigateway.GetResourceInput{
+		RestApiId:  apiOutput.Id,
+		ResourceId: orderOutput.Id,
+	})
+	if err == nil {
+		t.Error("expected error for resource whose parent was deleted")
+	}
+}
+
+func TestAPIGateway_InvokeLambdaProxy(t *testing.T) {
+	client := newAPIGatewayClient(t)
+	ctx := t.Context()
+
+	// The mock function echoes the parts of the proxy event it received.
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event struct {
+			Resource              string            `json:"resource"`
+			Path                  string            `json:"path"`
+			HTTPMethod            string            `json:"httpMethod"`
+			QueryStringParameters map[string]string `json:"queryStringParameters"`
+			PathParameters        map[string]string `json:"pathParameters"`
+			Body                  *string           `json:"body"`
+			RequestContext        struct {
+				Stage string `json:"stage"`
+			} `json:"requestContext"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		body, _ := json.Marshal(event)
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"statusCode": http.StatusCreated,
+			"headers":    map[string]string{"Content-Type": "application/json", "X-Stage": event.RequestContext.Stage},
+			"body":       string(body),
+		})
+	}))
+	t.Cleanup(mockServer.Close)
+
+	functionName := "test-apigateway-proxy-function"
+	createAPIGatewayProxyFunction(t, functionName, mockServer.URL)
+
+	apiOutput, err := client.CreateRestApi(ctx, &apigateway.CreateRestApiInput{
+		Name: aws.String("test-invoke-api"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteRestApi(context.Background(), &apigateway.DeleteRestApiInput{
+			RestApiId: apiOutput.Id,
+		})
+	})
+
+	functionURI := "arn:aws:apigateway:us-east-1:lambda:path/2015-03-31/functions/arn:aws:lambda:us-east-1:000000000000:function:" + functionName + "/invocations"
+
+	ordersOutput, err := client.CreateResource(ctx, &apigateway.CreateResourceInput{
+		RestApiId: apiOutput.Id,
+		ParentId:  apiOutput.RootResourceId,
+		PathPart:  aws.String("orders"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orderOutput, err := client.CreateResource(ctx, &apigateway.CreateResourceInput{
+		RestApiId: apiOutput.Id,
+		ParentId:  ordersOutput.Id,
+		PathPart:  aws.String("{orderId}"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filesOutput, err := client.CreateResource(ctx, &apigateway.CreateResourceInput{
+		RestApiId: apiOutput.Id,
+		ParentId:  apiOutput.RootResourceId,
+		PathPart:  aws.String("{proxy+}"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, route := range []struct {
+		resourceID *string
+		httpMethod string
+	}{
+		{resourceID: orderOutput.Id, httpMethod: "POST"},
+		{resourceID: filesOutput.Id, httpMethod: "ANY"},
+	} {
+		_, err = client.PutMethod(ctx, &apigateway.PutMethodInput{
+			RestApiId:         apiOutput.Id,
+			ResourceId:        route.resourceID,
+			HttpMethod:        aws.String(route.httpMethod),
+			AuthorizationType: aws.String("NONE"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = client.PutIntegration(ctx, &apigateway.PutIntegrationInput{
+			RestApiId:             apiOutput.Id,
+			ResourceId:            route.resourceID,
+			HttpMethod:            aws.String(route.httpMethod),
+			Type:                  types.IntegrationTypeAwsProxy,
+			IntegrationHttpMethod: aws.String("POST"),
+			Uri:                   aws.String(functionURI),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err = client.CreateDeployment(ctx, &apigateway.CreateDeploymentInput{
+		RestApiId: apiOutput.Id,
+		StageName: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stageURL := "http://localhost:4566/restapis/" + aws.ToString(apiOutput.Id) + "/stages/test"
+
+	resp, body := doAPIGatewayRequest(t, http.MethodPost, stageURL+"/orders/42?expand=items", `{"quantity":3}`)
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d: %s", resp.StatusCode, body)
+	}
+
+	if got := resp.Header.Get("X-Stage"); got != "test" {
+		t.Errorf("expected X-Stage header test, got %q", got)
+	}
+
+	golden.New(t).Assert(t.Name()+"_orders", json.RawMessage(body))
+
+	// Greedy path parameters capture the rest of the path.
+	resp, body = doAPIGatewayRequest(t, http.MethodGet, stageURL+"/files/docs/readme.md", "")
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d: %s", resp.StatusCode, body)
+	}
+
+	golden.New(t).Assert(t.Name()+"_files", json.RawMessage(body))
+
+	// Methods that are not deployed are rejected.
+	resp, _ = doAPIGatewayRequest(t, http.MethodGet, stageURL+"/orders/42", "")
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("expected status 403 for undeployed method, got %d", resp.StatusCode)
+	}
+
+	resp, _ = doAPIGatewayRequest(t, http.MethodGet, "http://localhost:4566/restapis/"+aws.ToString(apiOutput.Id)+"/stages/missing/orders/42", "")
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("expected status 403 for unknown stage, got %d", resp.StatusCode)
+	}
+}
+
+func createAPIGatewayProxyFunction(t *testing.T, functionName, invokeEndpoint string) {
+	t.Helper()
+
+	createBody, _ := json.Marshal(map[string]any{
+		"FunctionName":   functionName,
+		"Runtime":        "python3.12",
+		"Role":           "arn:aws:iam::000000000000:role/test-role",
+		"Handler":        "index.handler",
+		"InvokeEndpoint": invokeEndpoint,
+		"Code": map[string]any{
+			"ZipFile": []byte("fake-zip-content"),
+		},
+	})
+
+	req, _ := http.NewRequestWithContext(t.Context(), http.MethodPost,
+		"http://localhost:4566/lambda/2015-03-31/functions", bytes.NewReader(createBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to create function: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	t.Cleanup(func() {
+		delReq, _ := http.NewRequestWithContext(context.Background(), http.MethodDelete,
+			"http://localhost:4566/lambda/2015-03-31/functions/"+functionName, nil)
+		delResp, _ := http.DefaultClient.Do(delReq)
+		if delResp != nil {
+			delResp.Body.Close()
+		}
+	})
+}
+
+func doAPIGatewayRequest(t *testing.T, method, url, body string) (*http.Response, []byte) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return resp, respBody
+}
+
 func TestAPIGateway_DeleteRestApi(t *testing.T) {
 	client := newAPIGatewayClient(t)
 	ctx := t.Context()
@@ -4,6 +4,7 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -373,6 +374,203 @@ func TestAppSync_StartSchemaCreation(t *testing.T) {
 	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name(), schemaResult)
 }
 
+func TestAppSync_ListDataSources(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createAppSyncClient(t)
+
+	apiResult, err := client.CreateGraphqlApi(ctx, &appsync.CreateGraphqlApiInput{
+		Name:               aws.String("datasources-test-api"),
+		AuthenticationType: types.AuthenticationTypeApiKey,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apiID := apiResult.GraphqlApi.ApiId
+
+	t.Cleanup(func() {
+		_, err := client.DeleteGraphqlApi(context.Background(), &appsync.DeleteGraphqlApiInput{
+			ApiId: apiID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	inputs := []*appsync.CreateDataSourceInput{
+		{
+			ApiId: apiID,
+			Name:  aws.String("lambda_source"),
+			Type:  types.DataSourceTypeAwsLambda,
+			LambdaConfig: &types.LambdaDataSourceConfig{
+				LambdaFunctionArn: aws.String("arn:aws:lambda:us-east-1:000000000000:function:resolver"),
+			},
+		},
+		{
+			ApiId: apiID,
+			Name:  aws.String("dynamodb_source"),
+			Type:  types.DataSourceTypeAmazonDynamodb,
+			DynamodbConfig: &types.DynamodbDataSourceConfig{
+				TableName: aws.String("items"),
+				AwsRegion: aws.String("us-east-1"),
+			},
+		},
+		{
+			ApiId: apiID,
+			Name:  aws.String("none_source"),
+			Type:  types.DataSourceTypeNone,
+		},
+	}
+
+	for _, input := range inputs {
+		if _, err := client.CreateDataSource(ctx, input); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A Lambda data source without a function ARN is rejected.
+	_, err = client.CreateDataSource(ctx, &appsync.CreateDataSourceInput{
+		ApiId: apiID,
+		Name:  aws.String("invalid_source"),
+		Type:  types.DataSourceTypeAwsLambda,
+	})
+	if err == nil {
+		t.Fatal("expected error for Lambda data source without lambdaConfig")
+	}
+
+	listResult, err := client.ListDataSources(ctx, &appsync.ListDataSourcesInput{
+		ApiId: apiID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("DataSourceArn", "ResultMetadata")).Assert(t.Name(), listResult)
+}
+
+func TestAppSync_ResolverLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createAppSyncClient(t)
+
+	apiResult, err := client.CreateGraphqlApi(ctx, &appsync.CreateGraphqlApiInput{
+		Name:               aws.String("resolver-lifecycle-api"),
+		AuthenticationType: types.AuthenticationTypeApiKey,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apiID := apiResult.GraphqlApi.ApiId
+
+	t.Cleanup(func() {
+		_, err := client.DeleteGraphqlApi(context.Background(), &appsync.DeleteGraphqlApiInput{
+			ApiId: apiID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	_, err = client.CreateDataSource(ctx, &appsync.CreateDataSourceInput{
+		ApiId: apiID,
+		Name:  aws.String("items_table"),
+		Type:  types.DataSourceTypeAmazonDynamodb,
+		DynamodbConfig: &types.DynamodbDataSourceConfig{
+			TableName: aws.String("items"),
+			AwsRegion: aws.String("us-east-1"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, fieldName := range []string{"getItem", "listItems"} {
+		_, err := client.CreateResolver(ctx, &appsync.CreateResolverInput{
+			ApiId:                   apiID,
+			TypeName:                aws.String("Query"),
+			FieldName:               aws.String(fieldName),
+			DataSourceName:          aws.String("items_table"),
+			RequestMappingTemplate:  aws.String(`{"version": "2017-02-28", "operation": "GetItem"}`),
+			ResponseMappingTemplate: aws.String("$util.toJson($ctx.result)"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Resolvers pointing at an unknown data source are rejected.
+	_, err = client.CreateResolver(ctx, &appsync.CreateResolverInput{
+		ApiId:          apiID,
+		TypeName:       aws.String("Query"),
+		FieldName:      aws.String("missing"),
+		DataSourceName: aws.String("no_such_source"),
+	})
+	if err == nil {
+		t.Fatal("expected error for unknown data source")
+	}
+
+	updateResult, err := client.UpdateResolver(ctx, &appsync.UpdateResolverInput{
+		ApiId:                   apiID,
+		TypeName:                aws.String("Query"),
+		FieldName:               aws.String("getItem"),
+		DataSourceName:          aws.String("items_table"),
+		RequestMappingTemplate:  aws.String(`{"version": "2018-05-29", "operation": "GetItem"}`),
+		ResponseMappingTemplate: aws.String("$util.toJson($ctx.result.item)"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getResult, err := client.GetResolver(ctx, &appsync.GetResolverInput{
+		ApiId:     apiID,
+		TypeName:  aws.String("Query"),
+		FieldName: aws.String("getItem"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(getResult.Resolver.ResolverArn) != aws.ToString(updateResult.Resolver.ResolverArn) {
+		t.Errorf("expected resolver ARN to be preserved across updates")
+	}
+
+	golden.New(t, golden.WithIgnoreFields("ResolverArn", "ResultMetadata")).Assert(t.Name()+"_get", getResult)
+
+	_, err = client.DeleteResolver(ctx, &appsync.DeleteResolverInput{
+		ApiId:     apiID,
+		TypeName:  aws.String("Query"),
+		FieldName: aws.String("listItems"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listResult, err := client.ListResolvers(ctx, &appsync.ListResolversInput{
+		ApiId:    apiID,
+		TypeName: aws.String("Query"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("ResolverArn", "ResultMetadata")).Assert(t.Name()+"_list", listResult)
+
+	_, err = client.GetResolver(ctx, &appsync.GetResolverInput{
+		ApiId:     apiID,
+		TypeName:  aws.String("Query"),
+		FieldName: aws.String("listItems"),
+	})
+
+	var notFound *types.NotFoundException
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expected NotFoundException, got %v", err)
+	}
+}
+
 func createAppSyncClient(t *testing.T) *appsync.Client {
 	t.Helper()
 
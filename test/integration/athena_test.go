@@ -4,13 +4,19 @@ package integration
 
 import (
 	"context"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/athena"
 	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/sivchari/golden"
 )
 
@@ -66,6 +72,7 @@ func TestAthena_GetQueryExecution(t *testing.T) {
 	}
 
 	queryExecutionID := *startOutput.QueryExecutionId
+	waitForAthenaQueryState(t, client, queryExecutionID, types.QueryExecutionStateSucceeded)
 
 	// Get query execution.
 	getOutput, err := client.GetQueryExecution(ctx, &athena.GetQueryExecutionInput{
@@ -91,6 +98,7 @@ func TestAthena_GetQueryResults(t *testing.T) {
 	}
 
 	queryExecutionID := *startOutput.QueryExecutionId
+	waitForAthenaQueryState(t, client, queryExecutionID, types.QueryExecutionStateSucceeded)
 
 	// Get query results.
 	resultsOutput, err := client.GetQueryResults(ctx, &athena.GetQueryResultsInput{
@@ -174,6 +182,17 @@ func TestAthena_StopQueryExecution(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	getOutput, err := client.GetQueryExecution(ctx, &athena.GetQueryExecutionInput{
+		QueryExecutionId: aws.String(queryExecutionID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if state := getOutput.QueryExecution.Status.State; state != types.QueryExecutionStateCancelled {
+		t.Errorf("expected state CANCELLED, got %s", state)
+	}
 }
 
 func TestAthena_QueryExecutionWithWorkGroup(t *testing.T) {
@@ -205,6 +224,8 @@ func TestAthena_QueryExecutionWithWorkGroup(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	waitForAthenaQueryState(t, client, *startOutput.QueryExecutionId, types.QueryExecutionStateSucceeded)
+
 	// Get query execution and verify workgroup.
 	getOutput, err := client.GetQueryExecution(ctx, &athena.GetQueryExecutionInput{
 		QueryExecutionId: startOutput.QueryExecutionId,
@@ -242,3 +263,337 @@ func TestAthena_WorkGroupNotFound(t *testing.T) {
 		t.Fatal("expected error for non-existent workgroup")
 	}
 }
+
+func TestAthena_QueryResultsWrittenToS3(t *testing.T) {
+	client := newAthenaClient(t)
+	s3Client := newS3Client(t)
+	ctx := t.Context()
+
+	bucketName := "athena-query-results-bucket"
+	query := "SELECT name, age FROM users ORDER BY name"
+
+	_, err := s3Client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		deleteS3Bucket(s3Client, bucketName)
+	})
+
+	// Register the rows returned for the query.
+	stub := `{"QueryString": "` + query + `", "Columns": [{"Name": "name"}, {"Name": "age", "Type": "integer"}], "Rows": [["alice", "30"], ["bob", "25"]]}`
+
+	resp, err := http.Post("http://localhost:4566/kumo/athena/query-results", "application/json", strings.NewReader(stub))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("failed to stub query results: status %d", resp.StatusCode)
+	}
+
+	startOutput, err := client.StartQueryExecution(ctx, &athena.StartQueryExecutionInput{
+		QueryString: aws.String(query),
+		ResultConfiguration: &types.ResultConfiguration{
+			OutputLocation: aws.String("s3://" + bucketName + "/results/"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	queryExecutionID := aws.ToString(startOutput.QueryExecutionId)
+	execution := waitForAthenaQueryState(t, client, queryExecutionID, types.QueryExecutionStateSucceeded)
+
+	outputLocation := aws.ToString(execution.ResultConfiguration.OutputLocation)
+	if want := "s3://" + bucketName + "/results/" + queryExecutionID + ".csv"; outputLocation != want {
+		t.Errorf("expected output location %s, got %s", want, outputLocation)
+	}
+
+	resultsOutput, err := client.GetQueryResults(ctx, &athena.GetQueryResultsInput{
+		QueryExecutionId: aws.String(queryExecutionID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name(), resultsOutput)
+
+	object, err := s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("results/" + queryExecutionID + ".csv"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer object.Body.Close()
+
+	csv, err := io.ReadAll(object.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "\"name\",\"age\"\n\"alice\",\"30\"\n\"bob\",\"25\"\n"; string(csv) != want {
+		t.Errorf("unexpected results CSV:\ngot  %q\nwant %q", csv, want)
+	}
+}
+
+func TestAthena_QueryFailsWithoutOutputBucket(t *testing.T) {
+	client := newAthenaClient(t)
+	ctx := t.Context()
+
+	startOutput, err := client.StartQueryExecution(ctx, &athena.StartQueryExecutionInput{
+		QueryString: aws.String("SELECT 1"),
+		ResultConfiguration: &types.ResultConfiguration{
+			OutputLocation: aws.String("s3://athena-missing-output-bucket/results/"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitForAthenaQueryState(t, client, aws.ToString(startOutput.QueryExecutionId), types.QueryExecutionStateFailed)
+}
+
+func TestAthena_GetAndListWorkGroups(t *testing.T) {
+	client := newAthenaClient(t)
+	ctx := t.Context()
+	workGroupName := "test-workgroup-get-list"
+
+	_, err := client.CreateWorkGroup(ctx, &athena.CreateWorkGroupInput{
+		Name:        aws.String(workGroupName),
+		Description: aws.String("Workgroup with default output location"),
+		Configuration: &types.WorkGroupConfiguration{
+			ResultConfiguration: &types.ResultConfiguration{
+				OutputLocation: aws.String("s3://athena-workgroup-results/output/"),
+			},
+			EnforceWorkGroupConfiguration: aws.Bool(true),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteWorkGroup(context.Background(), &athena.DeleteWorkGroupInput{
+			WorkGroup: aws.String(workGroupName),
+		})
+	})
+
+	getOutput, err := client.GetWorkGroup(ctx, &athena.GetWorkGroupInput{
+		WorkGroup: aws.String(workGroupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("CreationTime", "ResultMetadata")).Assert(t.Name(), getOutput)
+
+	var names []string
+
+	paginator := athena.NewListWorkGroupsPaginator(client, &athena.ListWorkGroupsInput{
+		MaxResults: aws.Int32(1),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(page.WorkGroups) > 1 {
+			t.Fatalf("expected at most 1 workgroup per page, got %d", len(page.WorkGroups))
+		}
+
+		for _, wg := range page.WorkGroups {
+			names = append(names, aws.ToString(wg.Name))
+		}
+	}
+
+	for _, want := range []string{"primary", workGroupName} {
+		if !slices.Contains(names, want) {
+			t.Errorf("expected workgroup %s in %v", want, names)
+		}
+	}
+}
+
+func TestAthena_NamedQueries(t *testing.T) {
+	client := newAthenaClient(t)
+	ctx := t.Context()
+	workGroupName := "test-workgroup-named-queries"
+
+	_, err := client.CreateWorkGroup(ctx, &athena.CreateWorkGroupInput{
+		Name: aws.String(workGroupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteWorkGroup(context.Background(), &athena.DeleteWorkGroupInput{
+			WorkGroup:             aws.String(workGroupName),
+			RecursiveDeleteOption: aws.Bool(true),
+		})
+	})
+
+	ids := make([]string, 0, 3)
+
+	for _, name := range []string{"daily-report", "monthly-report", "weekly-report"} {
+		createOutput, err := client.CreateNamedQuery(ctx, &athena.CreateNamedQueryInput{
+			Name:        aws.String(name),
+			Description: aws.String("Saved " + name + " query"),
+			Database:    aws.String("analytics"),
+			QueryString: aws.String("SELECT * FROM events"),
+			WorkGroup:   aws.String(workGroupName),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ids = append(ids, aws.ToString(createOutput.NamedQueryId))
+	}
+
+	getOutput, err := client.GetNamedQuery(ctx, &athena.GetNamedQueryInput{
+		NamedQueryId: aws.String(ids[0]),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("NamedQueryId", "ResultMetadata")).Assert(t.Name(), getOutput)
+
+	// List named queries two at a time.
+	firstPage, err := client.ListNamedQueries(ctx, &athena.ListNamedQueriesInput{
+		WorkGroup:  aws.String(workGroupName),
+		MaxResults: aws.Int32(2),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(firstPage.NamedQueryIds) != 2 || firstPage.NextToken == nil {
+		t.Fatalf("expected 2 named queries and a next token, got %v", firstPage.NamedQueryIds)
+	}
+
+	secondPage, err := client.ListNamedQueries(ctx, &athena.ListNamedQueriesInput{
+		WorkGroup:  aws.String(workGroupName),
+		MaxResults: aws.Int32(2),
+		NextToken:  firstPage.NextToken,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := append(firstPage.NamedQueryIds, secondPage.NamedQueryIds...); !slices.Equal(got, ids) {
+		t.Errorf("expected named queries %v, got %v", ids, got)
+	}
+
+	if secondPage.NextToken != nil {
+		t.Errorf("expected no next token, got %s", aws.ToString(secondPage.NextToken))
+	}
+
+	_, err = client.DeleteNamedQuery(ctx, &athena.DeleteNamedQueryInput{
+		NamedQueryId: aws.String(ids[0]),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetNamedQuery(ctx, &athena.GetNamedQueryInput{
+		NamedQueryId: aws.String(ids[0]),
+	})
+	if err == nil {
+		t.Error("expected error for deleted named query")
+	}
+}
+
+func TestAthena_WorkGroupDefaultOutputLocation(t *testing.T) {
+	client := newAthenaClient(t)
+	s3Client := newS3Client(t)
+	ctx := t.Context()
+
+	bucketName := "athena-workgroup-default-output"
+	workGroupName := "test-workgroup-default-output"
+
+	_, err := s3Client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		deleteS3Bucket(s3Client, bucketName)
+	})
+
+	_, err = client.CreateWorkGroup(ctx, &athena.CreateWorkGroupInput{
+		Name: aws.String(workGroupName),
+		Configuration: &types.WorkGroupConfiguration{
+			ResultConfiguration: &types.ResultConfiguration{
+				OutputLocation: aws.String("s3://" + bucketName + "/workgroup/"),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteWorkGroup(context.Background(), &athena.DeleteWorkGroupInput{
+			WorkGroup:             aws.String(workGroupName),
+			RecursiveDeleteOption: aws.Bool(true),
+		})
+	})
+
+	startOutput, err := client.StartQueryExecution(ctx, &athena.StartQueryExecutionInput{
+		QueryString: aws.String("SELECT 1"),
+		WorkGroup:   aws.String(workGroupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	queryExecutionID := aws.ToString(startOutput.QueryExecutionId)
+	execution := waitForAthenaQueryState(t, client, queryExecutionID, types.QueryExecutionStateSucceeded)
+
+	outputLocation := aws.ToString(execution.ResultConfiguration.OutputLocation)
+	if want := "s3://" + bucketName + "/workgroup/" + queryExecutionID + ".csv"; outputLocation != want {
+		t.Errorf("expected output location %s, got %s", want, outputLocation)
+	}
+
+	_, err = s3Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("workgroup/" + queryExecutionID + ".csv"),
+	})
+	if err != nil {
+		t.Errorf("expected results object in workgroup output location: %v", err)
+	}
+}
+
+// waitForAthenaQueryState polls the query execution until it reaches the given state.
+func waitForAthenaQueryState(t *testing.T, client *athena.Client, queryExecutionID string, state types.QueryExecutionState) *types.QueryExecution {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+
+	for {
+		output, err := client.GetQueryExecution(t.Context(), &athena.GetQueryExecutionInput{
+			QueryExecutionId: aws.String(queryExecutionID),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if output.QueryExecution.Status.State == state {
+			return output.QueryExecution
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("query %s did not reach %s, current state %s", queryExecutionID, state, output.QueryExecution.Status.State)
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+}
@@ -3,7 +3,11 @@
 package integration
 
 import (
+	"context"
+	"fmt"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -76,6 +80,145 @@ func TestBatch_DescribeComputeEnvironments(t *testing.T) {
 	}
 }
 
+func TestBatch_UpdateComputeEnvironment(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createBatchClient(t)
+
+	ceName := "update-test-ce"
+
+	_, err := client.CreateComputeEnvironment(ctx, &batch.CreateComputeEnvironmentInput{
+		ComputeEnvironmentName: aws.String(ceName),
+		Type:                   types.CETypeManaged,
+		ComputeResources: &types.ComputeResource{
+			Type:     types.CRTypeEc2,
+			MinvCpus: aws.Int32(0),
+			MaxvCpus: aws.Int32(16),
+			Subnets:  []string{"subnet-12345678"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteComputeEnvironment(context.Background(), &batch.DeleteComputeEnvironmentInput{
+			ComputeEnvironment: aws.String(ceName),
+		})
+	})
+
+	// Updates are rejected until the compute environment is VALID.
+	if _, err := client.UpdateComputeEnvironment(ctx, &batch.UpdateComputeEnvironmentInput{
+		ComputeEnvironment: aws.String(ceName),
+		State:              types.CEStateDisabled,
+	}); err == nil {
+		t.Error("expected an error updating a CREATING compute environment")
+	}
+
+	waitForBatchComputeEnvironmentStatus(t, client, ceName, types.CEStatusValid)
+
+	updateResult, err := client.UpdateComputeEnvironment(ctx, &batch.UpdateComputeEnvironmentInput{
+		ComputeEnvironment: aws.String(ceName),
+		State:              types.CEStateDisabled,
+		ServiceRole:        aws.String("arn:aws:iam::000000000000:role/AWSBatchServiceRole"),
+		ComputeResources: &types.ComputeResourceUpdate{
+			MaxvCpus: aws.Int32(32),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(updateResult.ComputeEnvironmentName) != ceName {
+		t.Errorf("unexpected compute environment name: %s", aws.ToString(updateResult.ComputeEnvironmentName))
+	}
+
+	if ce := describeBatchComputeEnvironment(t, client, ceName); ce.Status != types.CEStatusUpdating {
+		t.Errorf("expected UPDATING after UpdateComputeEnvironment, got %s", ce.Status)
+	}
+
+	ce := waitForBatchComputeEnvironmentStatus(t, client, ceName, types.CEStatusValid)
+	golden.New(t, golden.WithIgnoreFields("ComputeEnvironmentArn", "Uuid")).Assert(t.Name(), ce)
+
+	if _, err := client.UpdateComputeEnvironment(ctx, &batch.UpdateComputeEnvironmentInput{
+		ComputeEnvironment: aws.String(ceName),
+		ComputeResources: &types.ComputeResourceUpdate{
+			MinvCpus: aws.Int32(64),
+		},
+	}); err == nil {
+		t.Error("expected an error setting minvCpus above maxvCpus")
+	}
+
+	if _, err := client.DeleteComputeEnvironment(ctx, &batch.DeleteComputeEnvironmentInput{
+		ComputeEnvironment: aws.String(ceName),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if ce := describeBatchComputeEnvironment(t, client, ceName); ce.Status != types.CEStatusDeleting {
+		t.Errorf("expected DELETING after DeleteComputeEnvironment, got %s", ce.Status)
+	}
+
+	waitForBatchComputeEnvironmentStatus(t, client, ceName, types.CEStatusDeleted)
+}
+
+func TestBatch_JobWaitsForEnabledComputeEnvironment(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createBatchClient(t)
+
+	prefix := "disabled-ce-test"
+	jqArn, jdArn := setupBatchJobQueue(t, client, prefix, types.JQStateEnabled)
+
+	ceName := prefix + "-ce"
+	waitForBatchComputeEnvironmentStatus(t, client, ceName, types.CEStatusValid)
+
+	if _, err := client.UpdateComputeEnvironment(ctx, &batch.UpdateComputeEnvironmentInput{
+		ComputeEnvironment: aws.String(ceName),
+		State:              types.CEStateDisabled,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	waitForBatchComputeEnvironmentStatus(t, client, ceName, types.CEStatusValid)
+
+	jobResult, err := client.SubmitJob(ctx, &batch.SubmitJobInput{
+		JobName:       aws.String(prefix + "-job"),
+		JobQueue:      aws.String(jqArn),
+		JobDefinition: aws.String(jdArn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitForBatchJobStatus(t, client, *jobResult.JobId, types.JobStatusPending)
+
+	// Give the scheduler time to advance the job if it were going to.
+	time.Sleep(2 * time.Second)
+
+	describeResult, err := client.DescribeJobs(ctx, &batch.DescribeJobsInput{
+		Jobs: []string{*jobResult.JobId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if status := describeResult.Jobs[0].Status; status != types.JobStatusPending {
+		t.Fatalf("expected job to stay PENDING while the compute environment is disabled, got %s", status)
+	}
+
+	if _, err := client.UpdateComputeEnvironment(ctx, &batch.UpdateComputeEnvironmentInput{
+		ComputeEnvironment: aws.String(ceName),
+		State:              types.CEStateEnabled,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	waitForBatchJobStatus(t, client, *jobResult.JobId, types.JobStatusSucceeded)
+}
+
 func TestBatch_CreateJobQueue(t *testing.T) {
 	t.Parallel()
 
@@ -184,6 +327,162 @@ func TestBatch_DescribeJobQueues(t *testing.T) {
 	}
 }
 
+func TestBatch_UpdateJobQueue(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createBatchClient(t)
+
+	jqArn, _ := setupBatchJobQueue(t, client, "update-jq-test", types.JQStateEnabled)
+
+	if jq := describeBatchJobQueue(t, client, jqArn); jq.Status != types.JQStatusCreating {
+		t.Errorf("expected CREATING after CreateJobQueue, got %s", jq.Status)
+	}
+
+	waitForBatchJobQueueStatus(t, client, jqArn, types.JQStatusValid)
+
+	updateResult, err := client.UpdateJobQueue(ctx, &batch.UpdateJobQueueInput{
+		JobQueue: aws.String(jqArn),
+		State:    types.JQStateDisabled,
+		Priority: aws.Int32(10),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(updateResult.JobQueueArn) != jqArn {
+		t.Errorf("unexpected job queue ARN: %s", aws.ToString(updateResult.JobQueueArn))
+	}
+
+	if jq := describeBatchJobQueue(t, client, jqArn); jq.Status != types.JQStatusUpdating {
+		t.Errorf("expected UPDATING after UpdateJobQueue, got %s", jq.Status)
+	}
+
+	jq := waitForBatchJobQueueStatus(t, client, jqArn, types.JQStatusValid)
+	if jq.State != types.JQStateDisabled || aws.ToInt32(jq.Priority) != 10 {
+		t.Errorf("unexpected job queue after update: state=%s priority=%d", jq.State, aws.ToInt32(jq.Priority))
+	}
+
+	if _, err := client.UpdateJobQueue(ctx, &batch.UpdateJobQueueInput{
+		JobQueue:            aws.String(jqArn),
+		SchedulingPolicyArn: aws.String("arn:aws:batch:us-east-1:000000000000:scheduling-policy/missing"),
+	}); err == nil {
+		t.Error("expected an error referencing a missing scheduling policy")
+	}
+}
+
+func TestBatch_SchedulingPolicies(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createBatchClient(t)
+
+	createResult, err := client.CreateSchedulingPolicy(ctx, &batch.CreateSchedulingPolicyInput{
+		Name: aws.String("fair-share-policy"),
+		FairsharePolicy: &types.FairsharePolicy{
+			ComputeReservation: aws.Int32(10),
+			ShareDecaySeconds:  aws.Int32(3600),
+			ShareDistribution: []types.ShareAttributes{
+				{ShareIdentifier: aws.String("teamA"), WeightFactor: aws.Float32(1)},
+				{ShareIdentifier: aws.String("teamB*"), WeightFactor: aws.Float32(0.5)},
+			},
+		},
+		Tags: map[string]string{"env": "test"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policyArn := aws.ToString(createResult.Arn)
+
+	if _, err := client.UpdateSchedulingPolicy(ctx, &batch.UpdateSchedulingPolicyInput{
+		Arn: aws.String(policyArn),
+		FairsharePolicy: &types.FairsharePolicy{
+			ShareDecaySeconds: aws.Int32(7200),
+			ShareDistribution: []types.ShareAttributes{
+				{ShareIdentifier: aws.String("teamA"), WeightFactor: aws.Float32(2)},
+			},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	describeResult, err := client.DescribeSchedulingPolicies(ctx, &batch.DescribeSchedulingPoliciesInput{
+		Arns: []string{policyArn},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name(), describeResult)
+
+	ceResult, err := client.CreateComputeEnvironment(ctx, &batch.CreateComputeEnvironmentInput{
+		ComputeEnvironmentName: aws.String("fair-share-test-ce"),
+		Type:                   types.CETypeManaged,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteComputeEnvironment(context.Background(), &batch.DeleteComputeEnvironmentInput{
+			ComputeEnvironment: ceResult.ComputeEnvironmentArn,
+		})
+	})
+
+	order := []types.ComputeEnvironmentOrder{{ComputeEnvironment: ceResult.ComputeEnvironmentArn, Order: aws.Int32(1)}}
+
+	if _, err := client.CreateJobQueue(ctx, &batch.CreateJobQueueInput{
+		JobQueueName:            aws.String("missing-policy-test-jq"),
+		Priority:                aws.Int32(1),
+		SchedulingPolicyArn:     aws.String("arn:aws:batch:us-east-1:000000000000:scheduling-policy/missing"),
+		ComputeEnvironmentOrder: order,
+	}); err == nil {
+		t.Error("expected an error creating a job queue with a missing scheduling policy")
+	}
+
+	jqResult, err := client.CreateJobQueue(ctx, &batch.CreateJobQueueInput{
+		JobQueueName:            aws.String("fair-share-test-jq"),
+		Priority:                aws.Int32(1),
+		SchedulingPolicyArn:     aws.String(policyArn),
+		ComputeEnvironmentOrder: order,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A scheduling policy cannot be deleted while a job queue uses it.
+	if _, err := client.DeleteSchedulingPolicy(ctx, &batch.DeleteSchedulingPolicyInput{
+		Arn: aws.String(policyArn),
+	}); err == nil {
+		t.Error("expected an error deleting a scheduling policy in use")
+	}
+
+	if _, err := client.DeleteJobQueue(ctx, &batch.DeleteJobQueueInput{
+		JobQueue: jqResult.JobQueueArn,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	waitForBatchJobQueueStatus(t, client, *jqResult.JobQueueArn, types.JQStatusDeleted)
+
+	if _, err := client.DeleteSchedulingPolicy(ctx, &batch.DeleteSchedulingPolicyInput{
+		Arn: aws.String(policyArn),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	describeResult, err = client.DescribeSchedulingPolicies(ctx, &batch.DescribeSchedulingPoliciesInput{
+		Arns: []string{policyArn},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(describeResult.SchedulingPolicies) != 0 {
+		t.Errorf("expected the scheduling policy to be deleted, got %d", len(describeResult.SchedulingPolicies))
+	}
+}
+
 func TestBatch_RegisterJobDefinition(t *testing.T) {
 	t.Parallel()
 
@@ -208,6 +507,106 @@ func TestBatch_RegisterJobDefinition(t *testing.T) {
 	golden.New(t, golden.WithIgnoreFields("JobDefinitionArn", "Revision", "ResultMetadata")).Assert(t.Name(), result)
 }
 
+func TestBatch_DescribeJobDefinitions(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createBatchClient(t)
+
+	jdName := "describe-jd-test"
+
+	// Revisions keep counting across runs against the same server, so only the ones registered here are checked.
+	var revisions []int32
+
+	for range 3 {
+		result, err := client.RegisterJobDefinition(ctx, &batch.RegisterJobDefinitionInput{
+			JobDefinitionName: aws.String(jdName),
+			Type:              types.JobDefinitionTypeContainer,
+			ContainerProperties: &types.ContainerProperties{
+				Image:  aws.String("busybox"),
+				Vcpus:  aws.Int32(1),
+				Memory: aws.Int32(512),
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		revisions = append(revisions, aws.ToInt32(result.Revision))
+	}
+
+	pinned := func(revision int32) string {
+		return fmt.Sprintf("%s:%d", jdName, revision)
+	}
+
+	if _, err := client.DeregisterJobDefinition(ctx, &batch.DeregisterJobDefinitionInput{
+		JobDefinition: aws.String(pinned(revisions[1])),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Filter by name and status, paging one definition at a time.
+	var active []int32
+
+	paginator := batch.NewDescribeJobDefinitionsPaginator(client, &batch.DescribeJobDefinitionsInput{
+		JobDefinitionName: aws.String(jdName),
+		Status:            aws.String("ACTIVE"),
+		MaxResults:        aws.Int32(1),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(page.JobDefinitions) > 1 {
+			t.Fatalf("expected at most 1 job definition per page, got %d", len(page.JobDefinitions))
+		}
+
+		for _, jd := range page.JobDefinitions {
+			active = append(active, aws.ToInt32(jd.Revision))
+		}
+	}
+
+	if !slices.IsSorted(active) {
+		t.Errorf("expected revisions in ascending order, got %v", active)
+	}
+
+	if !slices.Contains(active, revisions[0]) || slices.Contains(active, revisions[1]) || !slices.Contains(active, revisions[2]) {
+		t.Errorf("expected active revisions to include %d and %d but not %d, got %v", revisions[0], revisions[2], revisions[1], active)
+	}
+
+	// Pin a revision by name:revision and by ARN.
+	describeResult, err := client.DescribeJobDefinitions(ctx, &batch.DescribeJobDefinitionsInput{
+		JobDefinitions: []string{
+			pinned(revisions[1]),
+			"arn:aws:batch:us-east-1:000000000000:job-definition/" + pinned(revisions[2]),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(describeResult.JobDefinitions) != 2 {
+		t.Fatalf("expected 2 job definitions, got %d", len(describeResult.JobDefinitions))
+	}
+
+	if jd := describeResult.JobDefinitions[0]; aws.ToInt32(jd.Revision) != revisions[1] || aws.ToString(jd.Status) != "INACTIVE" {
+		t.Errorf("expected revision %d to be INACTIVE, got revision %d %s", revisions[1], aws.ToInt32(jd.Revision), aws.ToString(jd.Status))
+	}
+
+	if jd := describeResult.JobDefinitions[1]; aws.ToInt32(jd.Revision) != revisions[2] || aws.ToString(jd.Status) != "ACTIVE" {
+		t.Errorf("expected revision %d to be ACTIVE, got revision %d %s", revisions[2], aws.ToInt32(jd.Revision), aws.ToString(jd.Status))
+	}
+
+	if _, err := client.DeregisterJobDefinition(ctx, &batch.DeregisterJobDefinitionInput{
+		JobDefinition: aws.String(jdName + ":0"),
+	}); err == nil {
+		t.Error("expected an error deregistering a missing revision")
+	}
+}
+
 func TestBatch_SubmitJob(t *testing.T) {
 	t.Parallel()
 
@@ -458,6 +857,561 @@ func TestBatch_TerminateJob(t *testing.T) {
 	}
 }
 
+func TestBatch_JobProgression(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createBatchClient(t)
+
+	jqArn, jdArn := setupBatchJobQueue(t, client, "progression-test", types.JQStateEnabled)
+
+	jobResult, err := client.SubmitJob(ctx, &batch.SubmitJobInput{
+		JobName:       aws.String("progression-test-job"),
+		JobQueue:      aws.String(jqArn),
+		JobDefinition: aws.String(jdArn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	job := waitForBatchJobStatus(t, client, *jobResult.JobId, types.JobStatusSucceeded)
+
+	if job.StartedAt == nil || job.StoppedAt == nil || *job.StoppedAt < *job.StartedAt {
+		t.Errorf("unexpected job timestamps: startedAt=%v stoppedAt=%v", job.StartedAt, job.StoppedAt)
+	}
+
+	if len(job.Attempts) != 1 || job.Attempts[0].Container == nil || aws.ToInt32(job.Attempts[0].Container.ExitCode) != 0 {
+		t.Errorf("unexpected job attempts: %+v", job.Attempts)
+	}
+}
+
+func TestBatch_JobInDisabledQueueStaysPending(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createBatchClient(t)
+
+	jqArn, jdArn := setupBatchJobQueue(t, client, "disabled-queue-test", types.JQStateDisabled)
+
+	jobResult, err := client.SubmitJob(ctx, &batch.SubmitJobInput{
+		JobName:       aws.String("disabled-queue-test-job"),
+		JobQueue:      aws.String(jqArn),
+		JobDefinition: aws.String(jdArn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitForBatchJobStatus(t, client, *jobResult.JobId, types.JobStatusPending)
+
+	// Give the scheduler time to advance the job if it were going to.
+	time.Sleep(2 * time.Second)
+
+	describeResult, err := client.DescribeJobs(ctx, &batch.DescribeJobsInput{
+		Jobs: []string{*jobResult.JobId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if status := describeResult.Jobs[0].Status; status != types.JobStatusPending {
+		t.Errorf("expected job to stay PENDING, got %s", status)
+	}
+}
+
+func TestBatch_JobDependsOn(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createBatchClient(t)
+
+	jqArn, jdArn := setupBatchJobQueue(t, client, "depends-on-test", types.JQStateEnabled)
+
+	first, err := client.SubmitJob(ctx, &batch.SubmitJobInput{
+		JobName:       aws.String("depends-on-first"),
+		JobQueue:      aws.String(jqArn),
+		JobDefinition: aws.String(jdArn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := client.SubmitJob(ctx, &batch.SubmitJobInput{
+		JobName:       aws.String("depends-on-second"),
+		JobQueue:      aws.String(jqArn),
+		JobDefinition: aws.String(jdArn),
+		DependsOn:     []types.JobDependency{{JobId: first.JobId}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The dependent job must not start before the first job succeeds.
+	firstJob := waitForBatchJobStatus(t, client, *first.JobId, types.JobStatusSucceeded)
+	secondJob := waitForBatchJobStatus(t, client, *second.JobId, types.JobStatusSucceeded)
+
+	if *secondJob.StartedAt < *firstJob.StoppedAt {
+		t.Errorf("dependent job started at %d before its dependency stopped at %d", *secondJob.StartedAt, *firstJob.StoppedAt)
+	}
+
+	// A job whose dependency fails fails as well.
+	failing, err := client.SubmitJob(ctx, &batch.SubmitJobInput{
+		JobName:       aws.String("depends-on-failing"),
+		JobQueue:      aws.String(jqArn),
+		JobDefinition: aws.String(jdArn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dependent, err := client.SubmitJob(ctx, &batch.SubmitJobInput{
+		JobName:       aws.String("depends-on-dependent"),
+		JobQueue:      aws.String(jqArn),
+		JobDefinition: aws.String(jdArn),
+		DependsOn:     []types.JobDependency{{JobId: failing.JobId}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.TerminateJob(ctx, &batch.TerminateJobInput{
+		JobId:  failing.JobId,
+		Reason: aws.String("Test failure"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dependentJob := waitForBatchJobStatus(t, client, *dependent.JobId, types.JobStatusFailed)
+	if aws.ToString(dependentJob.StatusReason) != "Dependent Job failed" {
+		t.Errorf("unexpected status reason: %s", aws.ToString(dependentJob.StatusReason))
+	}
+
+	// Dependencies must refer to existing jobs.
+	_, err = client.SubmitJob(ctx, &batch.SubmitJobInput{
+		JobName:       aws.String("depends-on-missing"),
+		JobQueue:      aws.String(jqArn),
+		JobDefinition: aws.String(jdArn),
+		DependsOn:     []types.JobDependency{{JobId: aws.String("00000000-0000-0000-0000-000000000000")}},
+	})
+	if err == nil {
+		t.Fatal("expected error for unknown dependency")
+	}
+}
+
+func TestBatch_ArrayJob(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createBatchClient(t)
+
+	jqArn, jdArn := setupBatchJobQueue(t, client, "array-job-test", types.JQStateEnabled)
+
+	jobResult, err := client.SubmitJob(ctx, &batch.SubmitJobInput{
+		JobName:         aws.String("array-test-job"),
+		JobQueue:        aws.String(jqArn),
+		JobDefinition:   aws.String(jdArn),
+		ArrayProperties: &types.ArrayProperties{Size: aws.Int32(3)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parentID := *jobResult.JobId
+
+	describeResult, err := client.DescribeJobs(ctx, &batch.DescribeJobsInput{
+		Jobs: []string{parentID + ":0", parentID + ":2"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(describeResult.Jobs) != 2 {
+		t.Fatalf("expected 2 child jobs, got %d", len(describeResult.Jobs))
+	}
+
+	for i, index := range []int32{0, 2} {
+		props := describeResult.Jobs[i].ArrayProperties
+		if props == nil || aws.ToInt32(props.Index) != index {
+			t.Errorf("unexpected array properties for child %d: %+v", index, props)
+		}
+	}
+
+	parent := waitForBatchJobStatus(t, client, parentID, types.JobStatusSucceeded)
+
+	if parent.ArrayProperties == nil || aws.ToInt32(parent.ArrayProperties.Size) != 3 {
+		t.Fatalf("unexpected parent array properties: %+v", parent.ArrayProperties)
+	}
+
+	if succeeded := parent.ArrayProperties.StatusSummary["SUCCEEDED"]; succeeded != 3 {
+		t.Errorf("expected 3 succeeded children, got %v", parent.ArrayProperties.StatusSummary)
+	}
+
+	// Array size must be at least 2.
+	_, err = client.SubmitJob(ctx, &batch.SubmitJobInput{
+		JobName:         aws.String("array-test-invalid"),
+		JobQueue:        aws.String(jqArn),
+		JobDefinition:   aws.String(jdArn),
+		ArrayProperties: &types.ArrayProperties{Size: aws.Int32(1)},
+	})
+	if err == nil {
+		t.Fatal("expected error for array size 1")
+	}
+}
+
+func TestBatch_CancelJob(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createBatchClient(t)
+
+	jqArn, jdArn := setupBatchJobQueue(t, client, "cancel-job-test", types.JQStateEnabled)
+
+	queued, err := client.SubmitJob(ctx, &batch.SubmitJobInput{
+		JobName:       aws.String("cancel-test-queued"),
+		JobQueue:      aws.String(jqArn),
+		JobDefinition: aws.String(jdArn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.CancelJob(ctx, &batch.CancelJobInput{
+		JobId:  queued.JobId,
+		Reason: aws.String("Test cancellation"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cancelled := waitForBatchJobStatus(t, client, *queued.JobId, types.JobStatusFailed)
+	if !aws.ToBool(cancelled.IsCancelled) || aws.ToString(cancelled.StatusReason) != "Test cancellation" {
+		t.Errorf("unexpected cancelled job: isCancelled=%v statusReason=%s", cancelled.IsCancelled, aws.ToString(cancelled.StatusReason))
+	}
+
+	// Jobs that already started are not affected by CancelJob.
+	running, err := client.SubmitJob(ctx, &batch.SubmitJobInput{
+		JobName:       aws.String("cancel-test-running"),
+		JobQueue:      aws.String(jqArn),
+		JobDefinition: aws.String(jdArn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitForBatchJobStatus(t, client, *running.JobId, types.JobStatusRunning)
+
+	_, err = client.CancelJob(ctx, &batch.CancelJobInput{
+		JobId:  running.JobId,
+		Reason: aws.String("Test cancellation"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitForBatchJobStatus(t, client, *running.JobId, types.JobStatusSucceeded)
+}
+
+func TestBatch_TerminateRunningJob(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createBatchClient(t)
+
+	jqArn, jdArn := setupBatchJobQueue(t, client, "terminate-running-test", types.JQStateEnabled)
+
+	jobResult, err := client.SubmitJob(ctx, &batch.SubmitJobInput{
+		JobName:       aws.String("terminate-running-job"),
+		JobQueue:      aws.String(jqArn),
+		JobDefinition: aws.String(jdArn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitForBatchJobStatus(t, client, *jobResult.JobId, types.JobStatusRunning)
+
+	_, err = client.TerminateJob(ctx, &batch.TerminateJobInput{
+		JobId:  jobResult.JobId,
+		Reason: aws.String("Test termination"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	job := waitForBatchJobStatus(t, client, *jobResult.JobId, types.JobStatusFailed)
+	if !aws.ToBool(job.IsTerminated) || aws.ToString(job.StatusReason) != "Test termination" || job.StoppedAt == nil {
+		t.Errorf("unexpected terminated job: isTerminated=%v statusReason=%s stoppedAt=%v", job.IsTerminated, aws.ToString(job.StatusReason), job.StoppedAt)
+	}
+
+	if len(job.Attempts) != 1 || aws.ToInt32(job.Attempts[0].Container.ExitCode) == 0 {
+		t.Errorf("unexpected attempts: %+v", job.Attempts)
+	}
+}
+
+func TestBatch_ListJobs(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createBatchClient(t)
+
+	jqArn, jdArn := setupBatchJobQueue(t, client, "list-jobs-test", types.JQStateEnabled)
+
+	var jobIDs []string
+
+	for _, name := range []string{"list-jobs-a", "list-jobs-b", "list-jobs-c"} {
+		jobResult, err := client.SubmitJob(ctx, &batch.SubmitJobInput{
+			JobName:       aws.String(name),
+			JobQueue:      aws.String(jqArn),
+			JobDefinition: aws.String(jdArn),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		jobIDs = append(jobIDs, *jobResult.JobId)
+	}
+
+	arrayResult, err := client.SubmitJob(ctx, &batch.SubmitJobInput{
+		JobName:         aws.String("list-jobs-array"),
+		JobQueue:        aws.String(jqArn),
+		JobDefinition:   aws.String(jdArn),
+		ArrayProperties: &types.ArrayProperties{Size: aws.Int32(2)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range jobIDs {
+		waitForBatchJobStatus(t, client, id, types.JobStatusSucceeded)
+	}
+
+	waitForBatchJobStatus(t, client, *arrayResult.JobId, types.JobStatusSucceeded)
+
+	// Page through the succeeded jobs in the queue; array children are not included.
+	var listed []string
+
+	paginator := batch.NewListJobsPaginator(client, &batch.ListJobsInput{
+		JobQueue:   aws.String(jqArn),
+		JobStatus:  types.JobStatusSucceeded,
+		MaxResults: aws.Int32(2),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(page.JobSummaryList) > 2 {
+			t.Errorf("expected at most 2 jobs per page, got %d", len(page.JobSummaryList))
+		}
+
+		for _, summary := range page.JobSummaryList {
+			listed = append(listed, *summary.JobId)
+		}
+	}
+
+	expected := append(slices.Clone(jobIDs), *arrayResult.JobId)
+	slices.Sort(expected)
+	slices.Sort(listed)
+
+	if !slices.Equal(listed, expected) {
+		t.Errorf("expected jobs %v, got %v", expected, listed)
+	}
+
+	// RUNNING is the default status filter.
+	runningResult, err := client.ListJobs(ctx, &batch.ListJobsInput{
+		JobQueue: aws.String(jqArn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(runningResult.JobSummaryList) != 0 {
+		t.Errorf("expected no running jobs, got %d", len(runningResult.JobSummaryList))
+	}
+
+	childrenResult, err := client.ListJobs(ctx, &batch.ListJobsInput{
+		ArrayJobId: arrayResult.JobId,
+		JobStatus:  types.JobStatusSucceeded,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(childrenResult.JobSummaryList) != 2 {
+		t.Fatalf("expected 2 child jobs, got %d", len(childrenResult.JobSummaryList))
+	}
+
+	for i, summary := range childrenResult.JobSummaryList {
+		if summary.ArrayProperties == nil || aws.ToInt32(summary.ArrayProperties.Index) != int32(i) {
+			t.Errorf("unexpected array properties for child %d: %+v", i, summary.ArrayProperties)
+		}
+	}
+}
+
+// setupBatchJobQueue creates a compute environment, a job queue in the given state, and a job definition,
+// all named after prefix, and returns the queue and job definition ARNs.
+func setupBatchJobQueue(t *testing.T, client *batch.Client, prefix string, state types.JQState) (string, string) {
+	t.Helper()
+
+	ctx := t.Context()
+
+	ceResult, err := client.CreateComputeEnvironment(ctx, &batch.CreateComputeEnvironmentInput{
+		ComputeEnvironmentName: aws.String(prefix + "-ce"),
+		Type:                   types.CETypeManaged,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jqResult, err := client.CreateJobQueue(ctx, &batch.CreateJobQueueInput{
+		JobQueueName: aws.String(prefix + "-jq"),
+		Priority:     aws.Int32(1),
+		State:        state,
+		ComputeEnvironmentOrder: []types.ComputeEnvironmentOrder{
+			{
+				ComputeEnvironment: ceResult.ComputeEnvironmentArn,
+				Order:              aws.Int32(1),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jdResult, err := client.RegisterJobDefinition(ctx, &batch.RegisterJobDefinitionInput{
+		JobDefinitionName: aws.String(prefix + "-jd"),
+		Type:              types.JobDefinitionTypeContainer,
+		ContainerProperties: &types.ContainerProperties{
+			Image:  aws.String("busybox"),
+			Vcpus:  aws.Int32(1),
+			Memory: aws.Int32(512),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteJobQueue(context.Background(), &batch.DeleteJobQueueInput{
+			JobQueue: jqResult.JobQueueArn,
+		})
+		_, _ = client.DeleteComputeEnvironment(context.Background(), &batch.DeleteComputeEnvironmentInput{
+			ComputeEnvironment: ceResult.ComputeEnvironmentArn,
+		})
+	})
+
+	return *jqResult.JobQueueArn, *jdResult.JobDefinitionArn
+}
+
+// waitForBatchJobStatus polls DescribeJobs until the job reaches the given status.
+func waitForBatchJobStatus(t *testing.T, client *batch.Client, jobID string, status types.JobStatus) types.JobDetail {
+	t.Helper()
+
+	deadline := time.Now().Add(15 * time.Second)
+
+	for {
+		describeResult, err := client.DescribeJobs(t.Context(), &batch.DescribeJobsInput{
+			Jobs: []string{jobID},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(describeResult.Jobs) != 1 {
+			t.Fatalf("expected 1 job, got %d", len(describeResult.Jobs))
+		}
+
+		job := describeResult.Jobs[0]
+		if job.Status == status {
+			return job
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("job %s did not reach %s, last status %s", jobID, status, job.Status)
+		}
+
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+// describeBatchComputeEnvironment returns the compute environment with the given name.
+func describeBatchComputeEnvironment(t *testing.T, client *batch.Client, name string) types.ComputeEnvironmentDetail {
+	t.Helper()
+
+	describeResult, err := client.DescribeComputeEnvironments(t.Context(), &batch.DescribeComputeEnvironmentsInput{
+		ComputeEnvironments: []string{name},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(describeResult.ComputeEnvironments) != 1 {
+		t.Fatalf("expected 1 compute environment, got %d", len(describeResult.ComputeEnvironments))
+	}
+
+	return describeResult.ComputeEnvironments[0]
+}
+
+// waitForBatchComputeEnvironmentStatus polls DescribeComputeEnvironments until the compute environment reaches the given status.
+func waitForBatchComputeEnvironmentStatus(t *testing.T, client *batch.Client, name string, status types.CEStatus) types.ComputeEnvironmentDetail {
+	t.Helper()
+
+	deadline := time.Now().Add(15 * time.Second)
+
+	for {
+		ce := describeBatchComputeEnvironment(t, client, name)
+		if ce.Status == status {
+			return ce
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("compute environment %s did not reach %s, last status %s", name, status, ce.Status)
+		}
+
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+// describeBatchJobQueue returns the job queue with the given name or ARN.
+func describeBatchJobQueue(t *testing.T, client *batch.Client, jobQueue string) types.JobQueueDetail {
+	t.Helper()
+
+	describeResult, err := client.DescribeJobQueues(t.Context(), &batch.DescribeJobQueuesInput{
+		JobQueues: []string{jobQueue},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(describeResult.JobQueues) != 1 {
+		t.Fatalf("expected 1 job queue, got %d", len(describeResult.JobQueues))
+	}
+
+	return describeResult.JobQueues[0]
+}
+
+// waitForBatchJobQueueStatus polls DescribeJobQueues until the job queue reaches the given status.
+func waitForBatchJobQueueStatus(t *testing.T, client *batch.Client, jobQueue string, status types.JQStatus) types.JobQueueDetail {
+	t.Helper()
+
+	deadline := time.Now().Add(15 * time.Second)
+
+	for {
+		jq := describeBatchJobQueue(t, client, jobQueue)
+		if jq.Status == status {
+			return jq
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("job queue %s did not reach %s, last status %s", jobQueue, status, jq.Status)
+		}
+
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
 func createBatchClient(t *testing.T) *batch.Client {
 	t.Helper()
 
@@ -438,3 +438,317 @@ func TestCloudFront_GetInvalidation(t *testing.T) {
 		"ResultMetadata",
 	)).Assert(t.Name(), getResult)
 }
+
+func TestCloudFront_OriginAccessControl(t *testing.T) {
+	t.Parallel()
+
+	client := newCloudFrontClient(t)
+	ctx := t.Context()
+
+	// Create origin access control.
+	createResult, err := client.CreateOriginAccessControl(ctx, &cloudfront.CreateOriginAccessControlInput{
+		OriginAccessControlConfig: &types.OriginAccessControlConfig{
+			Name:                          aws.String("test-origin-access-control"),
+			Description:                   aws.String("Test origin access control"),
+			SigningProtocol:               types.OriginAccessControlSigningProtocolsSigv4,
+			SigningBehavior:               types.OriginAccessControlSigningBehaviorsAlways,
+			OriginAccessControlOriginType: types.OriginAccessControlOriginTypesS3,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields(
+		"Id",
+		"ETag",
+		"Location",
+		"ResultMetadata",
+	)).Assert(t.Name()+"_create", createResult)
+
+	oacID := createResult.OriginAccessControl.Id
+
+	// A second origin access control with the same name is rejected.
+	_, err = client.CreateOriginAccessControl(ctx, &cloudfront.CreateOriginAccessControlInput{
+		OriginAccessControlConfig: &types.OriginAccessControlConfig{
+			Name:                          aws.String("test-origin-access-control"),
+			SigningProtocol:               types.OriginAccessControlSigningProtocolsSigv4,
+			SigningBehavior:               types.OriginAccessControlSigningBehaviorsAlways,
+			OriginAccessControlOriginType: types.OriginAccessControlOriginTypesS3,
+		},
+	})
+	if err == nil {
+		t.Error("expected error when creating a duplicate origin access control, got nil")
+	}
+
+	// Update origin access control.
+	updateResult, err := client.UpdateOriginAccessControl(ctx, &cloudfront.UpdateOriginAccessControlInput{
+		Id:      oacID,
+		IfMatch: createResult.ETag,
+		OriginAccessControlConfig: &types.OriginAccessControlConfig{
+			Name:                          aws.String("test-origin-access-control"),
+			Description:                   aws.String("Updated origin access control"),
+			SigningProtocol:               types.OriginAccessControlSigningProtocolsSigv4,
+			SigningBehavior:               types.OriginAccessControlSigningBehaviorsNoOverride,
+			OriginAccessControlOriginType: types.OriginAccessControlOriginTypesS3,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getResult, err := client.GetOriginAccessControl(ctx, &cloudfront.GetOriginAccessControlInput{
+		Id: oacID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields(
+		"Id",
+		"ETag",
+		"ResultMetadata",
+	)).Assert(t.Name()+"_get", getResult)
+
+	// List origin access controls.
+	listResult, err := client.ListOriginAccessControls(ctx, &cloudfront.ListOriginAccessControlsInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, summary := range listResult.OriginAccessControlList.Items {
+		if aws.ToString(summary.Id) == aws.ToString(oacID) {
+			found = true
+
+			break
+		}
+	}
+	if !found {
+		t.Error("Origin access control should be in list")
+	}
+
+	// A distribution can only reference an existing origin access control.
+	newConfig := func(callerReference, oacID string) *types.DistributionConfig {
+		return &types.DistributionConfig{
+			CallerReference: aws.String(callerReference),
+			Origins: &types.Origins{
+				Quantity: aws.Int32(1),
+				Items: []types.Origin{
+					{
+						Id:                    aws.String("myS3Origin"),
+						DomainName:            aws.String("mybucket.s3.us-east-1.amazonaws.com"),
+						OriginAccessControlId: aws.String(oacID),
+						S3OriginConfig: &types.S3OriginConfig{
+							OriginAccessIdentity: aws.String(""),
+						},
+					},
+				},
+			},
+			DefaultCacheBehavior: &types.DefaultCacheBehavior{
+				TargetOriginId:       aws.String("myS3Origin"),
+				ViewerProtocolPolicy: types.ViewerProtocolPolicyRedirectToHttps,
+				CachePolicyId:        aws.String("658327ea-f89d-4fab-a63d-7e88639e58f6"),
+			},
+			Comment: aws.String("Test distribution"),
+			Enabled: aws.Bool(true),
+		}
+	}
+
+	_, err = client.CreateDistribution(ctx, &cloudfront.CreateDistributionInput{
+		DistributionConfig: newConfig("test-oac-missing", "EMISSINGOAC"),
+	})
+	if err == nil {
+		t.Error("expected error when referencing a missing origin access control, got nil")
+	}
+
+	distResult, err := client.CreateDistribution(ctx, &cloudfront.CreateDistributionInput{
+		DistributionConfig: newConfig("test-oac-distribution", aws.ToString(oacID)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An origin access control in use cannot be deleted.
+	_, err = client.DeleteOriginAccessControl(ctx, &cloudfront.DeleteOriginAccessControlInput{
+		Id:      oacID,
+		IfMatch: updateResult.ETag,
+	})
+	if err == nil {
+		t.Error("expected error when deleting an origin access control in use, got nil")
+	}
+
+	_, err = client.DeleteDistribution(ctx, &cloudfront.DeleteDistributionInput{
+		Id:      distResult.Distribution.Id,
+		IfMatch: distResult.ETag,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Delete origin access control.
+	_, err = client.DeleteOriginAccessControl(ctx, &cloudfront.DeleteOriginAccessControlInput{
+		Id:      oacID,
+		IfMatch: updateResult.ETag,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetOriginAccessControl(ctx, &cloudfront.GetOriginAccessControlInput{
+		Id: oacID,
+	})
+	if err == nil {
+		t.Error("expected error when getting a deleted origin access control, got nil")
+	}
+}
+
+func newTestDistributionConfig(callerReference string) *types.DistributionConfig {
+	return &types.DistributionConfig{
+		CallerReference: aws.String(callerReference),
+		Origins: &types.Origins{
+			Quantity: aws.Int32(1),
+			Items: []types.Origin{
+				{
+					Id:         aws.String("myS3Origin"),
+					DomainName: aws.String("mybucket.s3.amazonaws.com"),
+					S3OriginConfig: &types.S3OriginConfig{
+						OriginAccessIdentity: aws.String(""),
+					},
+				},
+			},
+		},
+		DefaultCacheBehavior: &types.DefaultCacheBehavior{
+			TargetOriginId:       aws.String("myS3Origin"),
+			ViewerProtocolPolicy: types.ViewerProtocolPolicyAllowAll,
+			CachePolicyId:        aws.String("658327ea-f89d-4fab-a63d-7e88639e58f6"),
+		},
+		Comment: aws.String("Test distribution"),
+		Enabled: aws.Bool(true),
+	}
+}
+
+func TestCloudFront_ListDistributionsPagination(t *testing.T) {
+	t.Parallel()
+
+	client := newCloudFrontClient(t)
+	ctx := t.Context()
+
+	want := make(map[string]bool)
+
+	for _, ref := range []string{"test-paginate-1", "test-paginate-2", "test-paginate-3"} {
+		result, err := client.CreateDistribution(ctx, &cloudfront.CreateDistributionInput{
+			DistributionConfig: newTestDistributionConfig(ref),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want[*result.Distribution.Id] = true
+
+		t.Cleanup(func() {
+			_, _ = client.DeleteDistribution(context.Background(), &cloudfront.DeleteDistributionInput{
+				Id:      result.Distribution.Id,
+				IfMatch: result.ETag,
+			})
+		})
+	}
+
+	seen := make(map[string]bool)
+	pages := 0
+
+	paginator := cloudfront.NewListDistributionsPaginator(client, &cloudfront.ListDistributionsInput{MaxItems: aws.Int32(2)})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pages++
+
+		if n := len(page.DistributionList.Items); n > 2 {
+			t.Errorf("expected at most 2 distributions per page, got %d", n)
+		}
+
+		for _, dist := range page.DistributionList.Items {
+			if seen[*dist.Id] {
+				t.Errorf("distribution %s listed twice", *dist.Id)
+			}
+
+			seen[*dist.Id] = true
+		}
+	}
+
+	if pages < 2 {
+		t.Errorf("expected at least 2 pages, got %d", pages)
+	}
+
+	for id := range want {
+		if !seen[id] {
+			t.Errorf("distribution %s should be listed", id)
+		}
+	}
+}
+
+func TestCloudFront_Tagging(t *testing.T) {
+	t.Parallel()
+
+	client := newCloudFrontClient(t)
+	ctx := t.Context()
+
+	createResult, err := client.CreateDistributionWithTags(ctx, &cloudfront.CreateDistributionWithTagsInput{
+		DistributionConfigWithTags: &types.DistributionConfigWithTags{
+			DistributionConfig: newTestDistributionConfig("test-tagging"),
+			Tags: &types.Tags{
+				Items: []types.Tag{{Key: aws.String("env"), Value: aws.String("test")}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteDistribution(context.Background(), &cloudfront.DeleteDistributionInput{
+			Id:      createResult.Distribution.Id,
+			IfMatch: createResult.ETag,
+		})
+	})
+
+	arn := createResult.Distribution.ARN
+
+	_, err = client.TagResource(ctx, &cloudfront.TagResourceInput{
+		Resource: arn,
+		Tags: &types.Tags{
+			Items: []types.Tag{
+				{Key: aws.String("team"), Value: aws.String("platform")},
+				{Key: aws.String("owner"), Value: aws.String("alice")},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.UntagResource(ctx, &cloudfront.UntagResourceInput{
+		Resource: arn,
+		TagKeys:  &types.TagKeys{Items: []string{"owner"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listResult, err := client.ListTagsForResource(ctx, &cloudfront.ListTagsForResourceInput{Resource: arn})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name(), listResult)
+
+	_, err = client.ListTagsForResource(ctx, &cloudfront.ListTagsForResourceInput{
+		Resource: aws.String("arn:aws:cloudfront::000000000000:distribution/EMISSING"),
+	})
+	if err == nil {
+		t.Error("expected error when listing the tags of a missing distribution, got nil")
+	}
+}
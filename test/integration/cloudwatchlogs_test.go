@@ -4,6 +4,8 @@ package integration
 
 import (
 	"context"
+	"errors"
+	"maps"
 	"testing"
 	"time"
 
@@ -376,3 +378,306 @@ func TestCloudWatchLogs_DescribeLogStreams(t *testing.T) {
 
 	golden.New(t, golden.WithIgnoreFields("Arn", "CreationTime", "FirstEventTimestamp", "LastEventTimestamp", "LastIngestionTime", "UploadSequenceToken", "ResultMetadata")).Assert(t.Name(), descResult)
 }
+
+func TestCloudWatchLogs_TagLogGroup(t *testing.T) {
+	client := newCloudWatchLogsClient(t)
+	ctx := t.Context()
+	logGroupName := "test-tag-log-group"
+
+	_, err := client.CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{
+		LogGroupName: aws.String(logGroupName),
+		Tags:         map[string]string{"env": "test"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteLogGroup(context.Background(), &cloudwatchlogs.DeleteLogGroupInput{
+			LogGroupName: aws.String(logGroupName),
+		})
+	})
+
+	// Tags from CreateLogGroup are returned by name.
+	listOutput, err := client.ListTagsLogGroup(ctx, &cloudwatchlogs.ListTagsLogGroupInput{
+		LogGroupName: aws.String(logGroupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := map[string]string{"env": "test"}; !maps.Equal(listOutput.Tags, want) {
+		t.Errorf("expected tags %v, got %v", want, listOutput.Tags)
+	}
+
+	_, err = client.TagLogGroup(ctx, &cloudwatchlogs.TagLogGroupInput{
+		LogGroupName: aws.String(logGroupName),
+		Tags:         map[string]string{"team": "platform", "env": "prod"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.UntagLogGroup(ctx, &cloudwatchlogs.UntagLogGroupInput{
+		LogGroupName: aws.String(logGroupName),
+		Tags:         []string{"team"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The ARN-based APIs see the same tags, with or without the ":*" suffix.
+	descOutput, err := client.DescribeLogGroups(ctx, &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String(logGroupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(descOutput.LogGroups) != 1 {
+		t.Fatalf("expected 1 log group, got %d", len(descOutput.LogGroups))
+	}
+
+	arn := aws.ToString(descOutput.LogGroups[0].Arn)
+
+	_, err = client.TagResource(ctx, &cloudwatchlogs.TagResourceInput{
+		ResourceArn: aws.String(arn + ":*"),
+		Tags:        map[string]string{"owner": "sre"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tagsOutput, err := client.ListTagsForResource(ctx, &cloudwatchlogs.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := map[string]string{"env": "prod", "owner": "sre"}; !maps.Equal(tagsOutput.Tags, want) {
+		t.Errorf("expected tags %v, got %v", want, tagsOutput.Tags)
+	}
+
+	_, err = client.UntagResource(ctx, &cloudwatchlogs.UntagResourceInput{
+		ResourceArn: aws.String(arn),
+		TagKeys:     []string{"env", "owner"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listOutput, err = client.ListTagsLogGroup(ctx, &cloudwatchlogs.ListTagsLogGroupInput{
+		LogGroupName: aws.String(logGroupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(listOutput.Tags) != 0 {
+		t.Errorf("expected no tags, got %v", listOutput.Tags)
+	}
+
+	// Tagging a missing log group fails.
+	_, err = client.ListTagsForResource(ctx, &cloudwatchlogs.ListTagsForResourceInput{
+		ResourceArn: aws.String("arn:aws:logs:us-east-1:000000000000:log-group:missing-log-group"),
+	})
+
+	var notFound *types.ResourceNotFoundException
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected ResourceNotFoundException, got %v", err)
+	}
+}
+
+func TestCloudWatchLogs_StartQuery(t *testing.T) {
+	client := newCloudWatchLogsClient(t)
+	ctx := t.Context()
+	logGroupName := "test-start-query"
+	logStreamName := "test-stream"
+
+	_, err := client.CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{
+		LogGroupName: aws.String(logGroupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteLogGroup(context.Background(), &cloudwatchlogs.DeleteLogGroupInput{
+			LogGroupName: aws.String(logGroupName),
+		})
+	})
+
+	_, err = client.CreateLogStream(ctx, &cloudwatchlogs.CreateLogStreamInput{
+		LogGroupName:  aws.String(logGroupName),
+		LogStreamName: aws.String(logStreamName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+
+	_, err = client.PutLogEvents(ctx, &cloudwatchlogs.PutLogEventsInput{
+		LogGroupName:  aws.String(logGroupName),
+		LogStreamName: aws.String(logStreamName),
+		LogEvents: []types.InputLogEvent{
+			{Timestamp: aws.Int64(now.Add(-3 * time.Second).UnixMilli()), Message: aws.String(`{"level":"INFO","latency":10}`)},
+			{Timestamp: aws.Int64(now.Add(-2 * time.Second).UnixMilli()), Message: aws.String(`{"level":"ERROR","latency":30}`)},
+			{Timestamp: aws.Int64(now.Add(-1 * time.Second).UnixMilli()), Message: aws.String(`{"level":"ERROR","latency":50}`)},
+			{Timestamp: aws.Int64(now.UnixMilli()), Message: aws.String("plain text message")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runQuery := func(t *testing.T, queryString string) *cloudwatchlogs.GetQueryResultsOutput {
+		t.Helper()
+
+		startOutput, err := client.StartQuery(ctx, &cloudwatchlogs.StartQueryInput{
+			LogGroupNames: []string{logGroupName},
+			StartTime:     aws.Int64(now.Add(-time.Minute).Unix()),
+			EndTime:       aws.Int64(now.Add(time.Minute).Unix()),
+			QueryString:   aws.String(queryString),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The query reports Running until the scheduler completes it.
+		deadline := time.Now().Add(10 * time.Second)
+
+		for {
+			resultsOutput, err := client.GetQueryResults(ctx, &cloudwatchlogs.GetQueryResultsInput{
+				QueryId: startOutput.QueryId,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if resultsOutput.Status == types.QueryStatusComplete {
+				return resultsOutput
+			}
+
+			if resultsOutput.Status != types.QueryStatusRunning {
+				t.Fatalf("expected query to be Running or Complete, got %s", resultsOutput.Status)
+			}
+
+			if time.Now().After(deadline) {
+				t.Fatal("timed out waiting for query to complete")
+			}
+
+			time.Sleep(50 * time.Millisecond)
+		}
+	}
+
+	fieldValue := func(row []types.ResultField, field string) string {
+		for _, f := range row {
+			if aws.ToString(f.Field) == field {
+				return aws.ToString(f.Value)
+			}
+		}
+
+		return ""
+	}
+
+	t.Run("filter and sort", func(t *testing.T) {
+		output := runQuery(t, `fields @timestamp, latency | filter level = "ERROR" | sort latency desc | limit 1`)
+
+		if len(output.Results) != 1 {
+			t.Fatalf("expected 1 row, got %d", len(output.Results))
+		}
+
+		if got := fieldValue(output.Results[0], "latency"); got != "50" {
+			t.Errorf("expected latency 50, got %q", got)
+		}
+
+		if output.Statistics == nil || output.Statistics.RecordsMatched != 2 || output.Statistics.RecordsScanned != 4 {
+			t.Errorf("unexpected statistics: %+v", output.Statistics)
+		}
+	})
+
+	t.Run("stats", func(t *testing.T) {
+		output := runQuery(t, `filter ispresent(level) | stats count(*) as total, avg(latency) by level | sort level`)
+
+		if len(output.Results) != 2 {
+			t.Fatalf("expected 2 rows, got %d", len(output.Results))
+		}
+
+		errorRow := output.Results[0]
+		if fieldValue(errorRow, "level") != "ERROR" || fieldValue(errorRow, "total") != "2" || fieldValue(errorRow, "avg(latency)") != "40" {
+			t.Errorf("unexpected ERROR row: %+v", errorRow)
+		}
+	})
+
+	t.Run("like", func(t *testing.T) {
+		output := runQuery(t, `filter @message like /plain/`)
+
+		if len(output.Results) != 1 {
+			t.Fatalf("expected 1 row, got %d", len(output.Results))
+		}
+
+		if got := fieldValue(output.Results[0], "@message"); got != "plain text message" {
+			t.Errorf("expected plain text message, got %q", got)
+		}
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		startOutput, err := client.StartQuery(ctx, &cloudwatchlogs.StartQueryInput{
+			LogGroupName: aws.String(logGroupName),
+			StartTime:    aws.Int64(now.Add(-time.Minute).Unix()),
+			EndTime:      aws.Int64(now.Add(time.Minute).Unix()),
+			QueryString:  aws.String("fields @message"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		stopOutput, err := client.StopQuery(ctx, &cloudwatchlogs.StopQueryInput{
+			QueryId: startOutput.QueryId,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !stopOutput.Success {
+			t.Error("expected StopQuery to succeed")
+		}
+
+		resultsOutput, err := client.GetQueryResults(ctx, &cloudwatchlogs.GetQueryResultsInput{
+			QueryId: startOutput.QueryId,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if resultsOutput.Status != types.QueryStatusCancelled {
+			t.Errorf("expected Cancelled, got %s", resultsOutput.Status)
+		}
+
+		// A query that is no longer running cannot be stopped.
+		_, err = client.StopQuery(ctx, &cloudwatchlogs.StopQueryInput{
+			QueryId: startOutput.QueryId,
+		})
+
+		var invalidParam *types.InvalidParameterException
+		if !errors.As(err, &invalidParam) {
+			t.Errorf("expected InvalidParameterException, got %v", err)
+		}
+	})
+
+	t.Run("malformed query", func(t *testing.T) {
+		_, err := client.StartQuery(ctx, &cloudwatchlogs.StartQueryInput{
+			LogGroupName: aws.String(logGroupName),
+			StartTime:    aws.Int64(now.Add(-time.Minute).Unix()),
+			EndTime:      aws.Int64(now.Add(time.Minute).Unix()),
+			QueryString:  aws.String("display @message"),
+		})
+
+		var malformed *types.MalformedQueryException
+		if !errors.As(err, &malformed) {
+			t.Errorf("expected MalformedQueryException, got %v", err)
+		}
+	})
+}
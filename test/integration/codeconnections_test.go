@@ -5,6 +5,7 @@ package integration
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -377,3 +378,220 @@ func TestCodeConnections_UntagResource(t *testing.T) {
 
 	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name()+"_list_tags", listTagsOutput)
 }
+
+func TestCodeConnections_ConnectionBecomesAvailable(t *testing.T) {
+	client := newCodeConnectionsClient(t)
+	ctx := t.Context()
+
+	createOutput, err := client.CreateConnection(ctx, &codeconnections.CreateConnectionInput{
+		ConnectionName: aws.String("test-available-connection"),
+		ProviderType:   types.ProviderTypeGitlab,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	connectionArn := aws.ToString(createOutput.ConnectionArn)
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteConnection(context.Background(), &codeconnections.DeleteConnectionInput{
+			ConnectionArn: aws.String(connectionArn),
+		})
+	})
+
+	getOutput, err := client.GetConnection(ctx, &codeconnections.GetConnectionInput{
+		ConnectionArn: aws.String(connectionArn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if getOutput.Connection.ConnectionStatus != types.ConnectionStatusPending {
+		t.Errorf("expected a new connection to be PENDING, got %s", getOutput.Connection.ConnectionStatus)
+	}
+
+	waitForConnectionStatus(t, client, connectionArn, types.ConnectionStatusAvailable)
+
+	// ListConnections reports the same status.
+	listOutput, err := client.ListConnections(ctx, &codeconnections.ListConnectionsInput{
+		ProviderTypeFilter: types.ProviderTypeGitlab,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+
+	for _, conn := range listOutput.Connections {
+		if aws.ToString(conn.ConnectionArn) != connectionArn {
+			continue
+		}
+
+		found = true
+
+		if conn.ConnectionStatus != types.ConnectionStatusAvailable {
+			t.Errorf("expected listed connection to be AVAILABLE, got %s", conn.ConnectionStatus)
+		}
+	}
+
+	if !found {
+		t.Errorf("connection %s not listed", connectionArn)
+	}
+}
+
+func waitForConnectionStatus(t *testing.T, client *codeconnections.Client, arn string, status types.ConnectionStatus) {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+
+	for {
+		output, err := client.GetConnection(t.Context(), &codeconnections.GetConnectionInput{
+			ConnectionArn: aws.String(arn),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if output.Connection.ConnectionStatus == status {
+			return
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("connection %s did not reach %s, current status %s", arn, status, output.Connection.ConnectionStatus)
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func TestCodeConnections_ListConnectionsFilterAndPagination(t *testing.T) {
+	client := newCodeConnectionsClient(t)
+	ctx := t.Context()
+
+	hostOutput, err := client.CreateHost(ctx, &codeconnections.CreateHostInput{
+		Name:             aws.String("test-list-filter-host"),
+		ProviderType:     types.ProviderTypeGithubEnterpriseServer,
+		ProviderEndpoint: aws.String("https://github.example.com"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hostArn := aws.ToString(hostOutput.HostArn)
+
+	// Registered first so it runs after the connections using the host are deleted.
+	t.Cleanup(func() {
+		_, _ = client.DeleteHost(context.Background(), &codeconnections.DeleteHostInput{
+			HostArn: aws.String(hostArn),
+		})
+	})
+
+	createConnection := func(name string, input *codeconnections.CreateConnectionInput) string {
+		t.Helper()
+
+		input.ConnectionName = aws.String(name)
+
+		output, err := client.CreateConnection(ctx, input)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		arn := aws.ToString(output.ConnectionArn)
+
+		t.Cleanup(func() {
+			_, _ = client.DeleteConnection(context.Background(), &codeconnections.DeleteConnectionInput{
+				ConnectionArn: aws.String(arn),
+			})
+		})
+
+		return arn
+	}
+
+	hostConnections := map[string]bool{}
+	for _, name := range []string{"test-host-conn-a", "test-host-conn-b", "test-host-conn-c"} {
+		hostConnections[createConnection(name, &codeconnections.CreateConnectionInput{HostArn: aws.String(hostArn)})] = true
+	}
+
+	bitbucketConnections := map[string]bool{}
+	for _, name := range []string{"test-bitbucket-conn-a", "test-bitbucket-conn-b"} {
+		bitbucketConnections[createConnection(name, &codeconnections.CreateConnectionInput{ProviderType: types.ProviderTypeBitbucket})] = true
+	}
+
+	listAll := func(input *codeconnections.ListConnectionsInput) ([]types.Connection, int) {
+		t.Helper()
+
+		var (
+			all   []types.Connection
+			pages int
+		)
+
+		paginator := codeconnections.NewListConnectionsPaginator(client, input)
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(output.Connections) > int(input.MaxResults) {
+				t.Fatalf("expected at most %d connections per page, got %d", input.MaxResults, len(output.Connections))
+			}
+
+			all = append(all, output.Connections...)
+			pages++
+		}
+
+		return all, pages
+	}
+
+	// The host filter returns exactly the host's connections, two per page.
+	connections, pages := listAll(&codeconnections.ListConnectionsInput{
+		HostArnFilter: aws.String(hostArn),
+		MaxResults:    2,
+	})
+
+	if pages != 2 {
+		t.Errorf("expected 2 pages, got %d", pages)
+	}
+
+	if len(connections) != len(hostConnections) {
+		t.Fatalf("expected %d connections, got %d", len(hostConnections), len(connections))
+	}
+
+	for _, conn := range connections {
+		arn := aws.ToString(conn.ConnectionArn)
+		if !hostConnections[arn] {
+			t.Errorf("unexpected connection %s", arn)
+		}
+
+		delete(hostConnections, arn)
+
+		if conn.ProviderType != types.ProviderTypeGithubEnterpriseServer {
+			t.Errorf("expected host connection to use the host's provider type, got %s", conn.ProviderType)
+		}
+	}
+
+	// The provider filter only returns connections of that provider.
+	connections, _ = listAll(&codeconnections.ListConnectionsInput{
+		ProviderTypeFilter: types.ProviderTypeBitbucket,
+		MaxResults:         1,
+	})
+
+	for _, conn := range connections {
+		if conn.ProviderType != types.ProviderTypeBitbucket {
+			t.Errorf("expected only Bitbucket connections, got %s", conn.ProviderType)
+		}
+
+		delete(bitbucketConnections, aws.ToString(conn.ConnectionArn))
+	}
+
+	if len(bitbucketConnections) != 0 {
+		t.Errorf("Bitbucket connections not listed: %v", bitbucketConnections)
+	}
+
+	// A token that was not issued by ListConnections is rejected.
+	_, err = client.ListConnections(ctx, &codeconnections.ListConnectionsInput{
+		NextToken: aws.String("not-a-token"),
+	})
+
+	assertAPIErrorCode(t, err, "InvalidInputException")
+}
@@ -3,6 +3,16 @@
 package integration
 
 import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -262,13 +272,103 @@ func TestCognito_SignUpAndConfirm(t *testing.T) {
 	_, err = client.ConfirmSignUp(ctx, &cognitoidentityprovider.ConfirmSignUpInput{
 		ClientId:         aws.String(clientID),
 		Username:         aws.String("signupuser"),
-		ConfirmationCode: aws.String("123456"),
+		ConfirmationCode: aws.String(getCognitoVerificationCode(t, userPoolID, "signupuser")),
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 }
 
+func TestCognito_ResendConfirmationCode(t *testing.T) {
+	client := newCognitoClient(t)
+	ctx := t.Context()
+
+	poolOutput, err := client.CreateUserPool(ctx, &cognitoidentityprovider.CreateUserPoolInput{
+		PoolName: aws.String("test-resend-code-pool"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userPoolID := *poolOutput.UserPool.Id
+
+	clientOutput, err := client.CreateUserPoolClient(ctx, &cognitoidentityprovider.CreateUserPoolClientInput{
+		UserPoolId: aws.String(userPoolID),
+		ClientName: aws.String("resend-code-client"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientID := *clientOutput.UserPoolClient.ClientId
+
+	_, err = client.SignUp(ctx, &cognitoidentityprovider.SignUpInput{
+		ClientId: aws.String(clientID),
+		Username: aws.String("resenduser"),
+		Password: aws.String("Password123!"),
+		UserAttributes: []types.AttributeType{
+			{Name: aws.String("email"), Value: aws.String("resend@example.com")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A wrong code is rejected.
+	_, err = client.ConfirmSignUp(ctx, &cognitoidentityprovider.ConfirmSignUpInput{
+		ClientId:         aws.String(clientID),
+		Username:         aws.String("resenduser"),
+		ConfirmationCode: aws.String("not-the-code"),
+	})
+
+	var mismatch *types.CodeMismatchException
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("expected CodeMismatchException, got %v", err)
+	}
+
+	resendOutput, err := client.ResendConfirmationCode(ctx, &cognitoidentityprovider.ResendConfirmationCodeInput{
+		ClientId: aws.String(clientID),
+		Username: aws.String("resenduser"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name()+"_resend", resendOutput)
+
+	_, err = client.ConfirmSignUp(ctx, &cognitoidentityprovider.ConfirmSignUpInput{
+		ClientId:         aws.String(clientID),
+		Username:         aws.String("resenduser"),
+		ConfirmationCode: aws.String(getCognitoVerificationCode(t, userPoolID, "resenduser")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userOutput, err := client.AdminGetUser(ctx, &cognitoidentityprovider.AdminGetUserInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String("resenduser"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if userOutput.UserStatus != types.UserStatusTypeConfirmed {
+		t.Errorf("expected CONFIRMED, got %s", userOutput.UserStatus)
+	}
+
+	// A confirmed user gets no new code.
+	_, err = client.ResendConfirmationCode(ctx, &cognitoidentityprovider.ResendConfirmationCodeInput{
+		ClientId: aws.String(clientID),
+		Username: aws.String("resenduser"),
+	})
+
+	var invalidParameter *types.InvalidParameterException
+	if !errors.As(err, &invalidParameter) {
+		t.Errorf("expected InvalidParameterException, got %v", err)
+	}
+}
+
 func TestCognito_InitiateAuth(t *testing.T) {
 	client := newCognitoClient(t)
 	ctx := t.Context()
@@ -310,7 +410,7 @@ func TestCognito_InitiateAuth(t *testing.T) {
 	_, err = client.ConfirmSignUp(ctx, &cognitoidentityprovider.ConfirmSignUpInput{
 		ClientId:         aws.String(clientID),
 		Username:         aws.String("authuser"),
-		ConfirmationCode: aws.String("123456"),
+		ConfirmationCode: aws.String(getCognitoVerificationCode(t, userPoolID, "authuser")),
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -375,3 +475,1136 @@ func TestCognito_DeleteUserPool(t *testing.T) {
 		t.Fatal("expected error for deleted user pool")
 	}
 }
+
+func TestCognito_InitiateAuthSignedTokens(t *testing.T) {
+	client := newCognitoClient(t)
+	ctx := t.Context()
+
+	poolOutput, err := client.CreateUserPool(ctx, &cognitoidentityprovider.CreateUserPoolInput{
+		PoolName: aws.String("test-jwt-pool"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userPoolID := *poolOutput.UserPool.Id
+
+	clientOutput, err := client.CreateUserPoolClient(ctx, &cognitoidentityprovider.CreateUserPoolClientInput{
+		UserPoolId: aws.String(userPoolID),
+		ClientName: aws.String("jwt-client"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientID := *clientOutput.UserPoolClient.ClientId
+
+	signUpOutput, err := client.SignUp(ctx, &cognitoidentityprovider.SignUpInput{
+		ClientId: aws.String(clientID),
+		Username: aws.String("jwtuser"),
+		Password: aws.String("Password123!"),
+		UserAttributes: []types.AttributeType{
+			{Name: aws.String("email"), Value: aws.String("jwtuser@example.com")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.ConfirmSignUp(ctx, &cognitoidentityprovider.ConfirmSignUpInput{
+		ClientId:         aws.String(clientID),
+		Username:         aws.String("jwtuser"),
+		ConfirmationCode: aws.String(getCognitoVerificationCode(t, userPoolID, "jwtuser")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authOutput, err := client.InitiateAuth(ctx, &cognitoidentityprovider.InitiateAuthInput{
+		AuthFlow: types.AuthFlowTypeUserPasswordAuth,
+		ClientId: aws.String(clientID),
+		AuthParameters: map[string]string{
+			"USERNAME": "jwtuser",
+			"PASSWORD": "Password123!",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer := "http://localhost:4566/kumo/cognito-idp/" + userPoolID
+
+	idClaims := verifyCognitoToken(t, *authOutput.AuthenticationResult.IdToken)
+	if idClaims["token_use"] != "id" || idClaims["aud"] != clientID || idClaims["iss"] != issuer {
+		t.Errorf("unexpected ID token claims: %v", idClaims)
+	}
+
+	if idClaims["sub"] != *signUpOutput.UserSub || idClaims["email"] != "jwtuser@example.com" {
+		t.Errorf("unexpected ID token subject claims: %v", idClaims)
+	}
+
+	accessClaims := verifyCognitoToken(t, *authOutput.AuthenticationResult.AccessToken)
+	if accessClaims["token_use"] != "access" || accessClaims["client_id"] != clientID || accessClaims["iss"] != issuer {
+		t.Errorf("unexpected access token claims: %v", accessClaims)
+	}
+
+	if strings.Count(*authOutput.AuthenticationResult.RefreshToken, ".") != 0 {
+		t.Error("expected opaque refresh token")
+	}
+}
+
+func TestCognito_NewPasswordRequiredChallenge(t *testing.T) {
+	client := newCognitoClient(t)
+	ctx := t.Context()
+
+	poolOutput, err := client.CreateUserPool(ctx, &cognitoidentityprovider.CreateUserPoolInput{
+		PoolName: aws.String("test-new-password-pool"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userPoolID := *poolOutput.UserPool.Id
+
+	clientOutput, err := client.CreateUserPoolClient(ctx, &cognitoidentityprovider.CreateUserPoolClientInput{
+		UserPoolId: aws.String(userPoolID),
+		ClientName: aws.String("new-password-client"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientID := *clientOutput.UserPoolClient.ClientId
+
+	_, err = client.AdminCreateUser(ctx, &cognitoidentityprovider.AdminCreateUserInput{
+		UserPoolId:        aws.String(userPoolID),
+		Username:          aws.String("firstlogin"),
+		TemporaryPassword: aws.String("TempPass123!"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authOutput, err := client.InitiateAuth(ctx, &cognitoidentityprovider.InitiateAuthInput{
+		AuthFlow: types.AuthFlowTypeUserPasswordAuth,
+		ClientId: aws.String(clientID),
+		AuthParameters: map[string]string{
+			"USERNAME": "firstlogin",
+			"PASSWORD": "TempPass123!",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if authOutput.ChallengeName != types.ChallengeNameTypeNewPasswordRequired {
+		t.Fatalf("expected NEW_PASSWORD_REQUIRED challenge, got %q", authOutput.ChallengeName)
+	}
+
+	if authOutput.AuthenticationResult != nil {
+		t.Fatal("expected no tokens before completing the challenge")
+	}
+
+	challengeOutput, err := client.RespondToAuthChallenge(ctx, &cognitoidentityprovider.RespondToAuthChallengeInput{
+		ChallengeName: types.ChallengeNameTypeNewPasswordRequired,
+		ClientId:      aws.String(clientID),
+		Session:       authOutput.Session,
+		ChallengeResponses: map[string]string{
+			"USERNAME":     "firstlogin",
+			"NEW_PASSWORD": "NewPass123!",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if challengeOutput.AuthenticationResult == nil || challengeOutput.AuthenticationResult.IdToken == nil {
+		t.Fatal("expected authentication result after completing the challenge")
+	}
+
+	userOutput, err := client.AdminGetUser(ctx, &cognitoidentityprovider.AdminGetUserInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String("firstlogin"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if userOutput.UserStatus != types.UserStatusTypeConfirmed {
+		t.Errorf("expected CONFIRMED, got %s", userOutput.UserStatus)
+	}
+
+	// The session is single-use.
+	_, err = client.RespondToAuthChallenge(ctx, &cognitoidentityprovider.RespondToAuthChallengeInput{
+		ChallengeName: types.ChallengeNameTypeNewPasswordRequired,
+		ClientId:      aws.String(clientID),
+		Session:       authOutput.Session,
+		ChallengeResponses: map[string]string{
+			"USERNAME":     "firstlogin",
+			"NEW_PASSWORD": "OtherPass123!",
+		},
+	})
+	if err == nil {
+		t.Fatal("expected error when reusing a challenge session")
+	}
+
+	// The new password authenticates directly.
+	_, err = client.InitiateAuth(ctx, &cognitoidentityprovider.InitiateAuthInput{
+		AuthFlow: types.AuthFlowTypeUserPasswordAuth,
+		ClientId: aws.String(clientID),
+		AuthParameters: map[string]string{
+			"USERNAME": "firstlogin",
+			"PASSWORD": "NewPass123!",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+//nolint:funlen // Test function exercises temporary and permanent admin passwords.
+func TestCognito_AdminSetUserPasswordAndAdminAuth(t *testing.T) {
+	client := newCognitoClient(t)
+	ctx := t.Context()
+
+	poolOutput, err := client.CreateUserPool(ctx, &cognitoidentityprovider.CreateUserPoolInput{
+		PoolName: aws.String("test-admin-auth-pool"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userPoolID := *poolOutput.UserPool.Id
+
+	clientOutput, err := client.CreateUserPoolClient(ctx, &cognitoidentityprovider.CreateUserPoolClientInput{
+		UserPoolId: aws.String(userPoolID),
+		ClientName: aws.String("admin-auth-client"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientID := *clientOutput.UserPoolClient.ClientId
+
+	_, err = client.AdminCreateUser(ctx, &cognitoidentityprovider.AdminCreateUserInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String("seeded"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getUserStatus := func() types.UserStatusType {
+		t.Helper()
+
+		userOutput, err := client.AdminGetUser(ctx, &cognitoidentityprovider.AdminGetUserInput{
+			UserPoolId: aws.String(userPoolID),
+			Username:   aws.String("seeded"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return userOutput.UserStatus
+	}
+
+	// A temporary password must be changed at the next sign-in.
+	_, err = client.AdminSetUserPassword(ctx, &cognitoidentityprovider.AdminSetUserPasswordInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String("seeded"),
+		Password:   aws.String("TempPass123!"),
+		Permanent:  false,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if status := getUserStatus(); status != types.UserStatusTypeForceChangePassword {
+		t.Errorf("expected FORCE_CHANGE_PASSWORD, got %s", status)
+	}
+
+	authOutput, err := client.AdminInitiateAuth(ctx, &cognitoidentityprovider.AdminInitiateAuthInput{
+		UserPoolId: aws.String(userPoolID),
+		ClientId:   aws.String(clientID),
+		AuthFlow:   types.AuthFlowTypeAdminUserPasswordAuth,
+		AuthParameters: map[string]string{
+			"USERNAME": "seeded",
+			"PASSWORD": "TempPass123!",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if authOutput.ChallengeName != types.ChallengeNameTypeNewPasswordRequired {
+		t.Fatalf("expected NEW_PASSWORD_REQUIRED challenge, got %q", authOutput.ChallengeName)
+	}
+
+	challengeOutput, err := client.AdminRespondToAuthChallenge(ctx, &cognitoidentityprovider.AdminRespondToAuthChallengeInput{
+		UserPoolId:    aws.String(userPoolID),
+		ClientId:      aws.String(clientID),
+		ChallengeName: types.ChallengeNameTypeNewPasswordRequired,
+		Session:       authOutput.Session,
+		ChallengeResponses: map[string]string{
+			"USERNAME":     "seeded",
+			"NEW_PASSWORD": "ChangedPass123!",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if challengeOutput.AuthenticationResult == nil || challengeOutput.AuthenticationResult.IdToken == nil {
+		t.Fatal("expected authentication result after completing the challenge")
+	}
+
+	// A permanent password confirms the user and authenticates directly.
+	_, err = client.AdminSetUserPassword(ctx, &cognitoidentityprovider.AdminSetUserPasswordInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String("seeded"),
+		Password:   aws.String("SeededPass123!"),
+		Permanent:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if status := getUserStatus(); status != types.UserStatusTypeConfirmed {
+		t.Errorf("expected CONFIRMED, got %s", status)
+	}
+
+	authOutput, err = client.AdminInitiateAuth(ctx, &cognitoidentityprovider.AdminInitiateAuthInput{
+		UserPoolId: aws.String(userPoolID),
+		ClientId:   aws.String(clientID),
+		AuthFlow:   types.AuthFlowTypeAdminUserPasswordAuth,
+		AuthParameters: map[string]string{
+			"USERNAME": "seeded",
+			"PASSWORD": "SeededPass123!",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if authOutput.AuthenticationResult == nil || authOutput.AuthenticationResult.AccessToken == nil {
+		t.Fatal("expected tokens for a permanent password")
+	}
+
+	// The client must belong to the user pool.
+	otherPool, err := client.CreateUserPool(ctx, &cognitoidentityprovider.CreateUserPoolInput{
+		PoolName: aws.String("test-admin-auth-other-pool"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.AdminInitiateAuth(ctx, &cognitoidentityprovider.AdminInitiateAuthInput{
+		UserPoolId: otherPool.UserPool.Id,
+		ClientId:   aws.String(clientID),
+		AuthFlow:   types.AuthFlowTypeAdminUserPasswordAuth,
+		AuthParameters: map[string]string{
+			"USERNAME": "seeded",
+			"PASSWORD": "SeededPass123!",
+		},
+	})
+	if err == nil {
+		t.Error("expected error when the client belongs to another user pool")
+	}
+}
+
+//nolint:funlen // Test function exercises reading, updating and verifying attributes.
+func TestCognito_UserAttributes(t *testing.T) {
+	client := newCognitoClient(t)
+	ctx := t.Context()
+
+	poolOutput, err := client.CreateUserPool(ctx, &cognitoidentityprovider.CreateUserPoolInput{
+		PoolName: aws.String("test-user-attributes-pool"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userPoolID := *poolOutput.UserPool.Id
+
+	clientOutput, err := client.CreateUserPoolClient(ctx, &cognitoidentityprovider.CreateUserPoolClientInput{
+		UserPoolId: aws.String(userPoolID),
+		ClientName: aws.String("user-attributes-client"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.AdminCreateUser(ctx, &cognitoidentityprovider.AdminCreateUserInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String("profile"),
+		UserAttributes: []types.AttributeType{
+			{Name: aws.String("email"), Value: aws.String("old@example.com")},
+			{Name: aws.String("email_verified"), Value: aws.String("true")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.AdminSetUserPassword(ctx, &cognitoidentityprovider.AdminSetUserPasswordInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String("profile"),
+		Password:   aws.String("ProfilePass123!"),
+		Permanent:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authOutput, err := client.AdminInitiateAuth(ctx, &cognitoidentityprovider.AdminInitiateAuthInput{
+		UserPoolId: aws.String(userPoolID),
+		ClientId:   clientOutput.UserPoolClient.ClientId,
+		AuthFlow:   types.AuthFlowTypeAdminUserPasswordAuth,
+		AuthParameters: map[string]string{
+			"USERNAME": "profile",
+			"PASSWORD": "ProfilePass123!",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	accessToken := authOutput.AuthenticationResult.AccessToken
+
+	getAttributes := func() map[string]string {
+		t.Helper()
+
+		userOutput, err := client.GetUser(ctx, &cognitoidentityprovider.GetUserInput{AccessToken: accessToken})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if aws.ToString(userOutput.Username) != "profile" {
+			t.Errorf("expected username profile, got %s", aws.ToString(userOutput.Username))
+		}
+
+		attrs := make(map[string]string, len(userOutput.UserAttributes))
+		for _, attr := range userOutput.UserAttributes {
+			attrs[aws.ToString(attr.Name)] = aws.ToString(attr.Value)
+		}
+
+		return attrs
+	}
+
+	if attrs := getAttributes(); attrs["email"] != "old@example.com" || attrs["email_verified"] != "true" {
+		t.Errorf("unexpected attributes: %v", attrs)
+	}
+
+	// Changing the email unverifies it and sends a verification code.
+	updateOutput, err := client.UpdateUserAttributes(ctx, &cognitoidentityprovider.UpdateUserAttributesInput{
+		AccessToken: accessToken,
+		UserAttributes: []types.AttributeType{
+			{Name: aws.String("email"), Value: aws.String("new@example.com")},
+			{Name: aws.String("name"), Value: aws.String("Profile User")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(updateOutput.CodeDeliveryDetailsList) != 1 || aws.ToString(updateOutput.CodeDeliveryDetailsList[0].AttributeName) != "email" {
+		t.Errorf("expected a code delivered for the email, got %+v", updateOutput.CodeDeliveryDetailsList)
+	}
+
+	if attrs := getAttributes(); attrs["email"] != "new@example.com" || attrs["email_verified"] != "false" || attrs["name"] != "Profile User" {
+		t.Errorf("unexpected attributes after update: %v", attrs)
+	}
+
+	_, err = client.VerifyUserAttribute(ctx, &cognitoidentityprovider.VerifyUserAttributeInput{
+		AccessToken:   accessToken,
+		AttributeName: aws.String("email"),
+		Code:          aws.String("000000"),
+	})
+	if err == nil {
+		t.Error("expected error when verifying with a wrong code")
+	}
+
+	_, err = client.VerifyUserAttribute(ctx, &cognitoidentityprovider.VerifyUserAttributeInput{
+		AccessToken:   accessToken,
+		AttributeName: aws.String("email"),
+		Code:          aws.String(getCognitoVerificationCode(t, userPoolID, "profile")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if attrs := getAttributes(); attrs["email_verified"] != "true" {
+		t.Errorf("expected the email to be verified, got %v", attrs)
+	}
+
+	// A code can be requested again for an attribute the user has.
+	codeOutput, err := client.GetUserAttributeVerificationCode(ctx, &cognitoidentityprovider.GetUserAttributeVerificationCodeInput{
+		AccessToken:   accessToken,
+		AttributeName: aws.String("email"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if codeOutput.CodeDeliveryDetails.DeliveryMedium != types.DeliveryMediumTypeEmail {
+		t.Errorf("expected EMAIL delivery, got %s", codeOutput.CodeDeliveryDetails.DeliveryMedium)
+	}
+
+	// Admins can set attributes, including the verified flags.
+	_, err = client.AdminUpdateUserAttributes(ctx, &cognitoidentityprovider.AdminUpdateUserAttributesInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String("profile"),
+		UserAttributes: []types.AttributeType{
+			{Name: aws.String("phone_number"), Value: aws.String("+15555550100")},
+			{Name: aws.String("phone_number_verified"), Value: aws.String("true")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if attrs := getAttributes(); attrs["phone_number"] != "+15555550100" || attrs["phone_number_verified"] != "true" {
+		t.Errorf("unexpected attributes after admin update: %v", attrs)
+	}
+
+	_, err = client.GetUser(ctx, &cognitoidentityprovider.GetUserInput{AccessToken: aws.String("invalid-token")})
+	if err == nil {
+		t.Error("expected error for an invalid access token")
+	}
+}
+
+func TestCognito_ForgotPassword(t *testing.T) {
+	client := newCognitoClient(t)
+	ctx := t.Context()
+
+	poolOutput, err := client.CreateUserPool(ctx, &cognitoidentityprovider.CreateUserPoolInput{
+		PoolName: aws.String("test-forgot-password-pool"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userPoolID := *poolOutput.UserPool.Id
+
+	clientOutput, err := client.CreateUserPoolClient(ctx, &cognitoidentityprovider.CreateUserPoolClientInput{
+		UserPoolId: aws.String(userPoolID),
+		ClientName: aws.String("forgot-password-client"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientID := *clientOutput.UserPoolClient.ClientId
+
+	_, err = client.SignUp(ctx, &cognitoidentityprovider.SignUpInput{
+		ClientId: aws.String(clientID),
+		Username: aws.String("forgetful"),
+		Password: aws.String("Password123!"),
+		UserAttributes: []types.AttributeType{
+			{Name: aws.String("email"), Value: aws.String("forgetful@example.com")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.ConfirmSignUp(ctx, &cognitoidentityprovider.ConfirmSignUpInput{
+		ClientId:         aws.String(clientID),
+		Username:         aws.String("forgetful"),
+		ConfirmationCode: aws.String(getCognitoVerificationCode(t, userPoolID, "forgetful")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	forgotOutput, err := client.ForgotPassword(ctx, &cognitoidentityprovider.ForgotPasswordInput{
+		ClientId: aws.String(clientID),
+		Username: aws.String("forgetful"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name()+"_forgot", forgotOutput)
+
+	// A wrong code is rejected.
+	_, err = client.ConfirmForgotPassword(ctx, &cognitoidentityprovider.ConfirmForgotPasswordInput{
+		ClientId:         aws.String(clientID),
+		Username:         aws.String("forgetful"),
+		ConfirmationCode: aws.String("not-the-code"),
+		Password:         aws.String("NewPassword123!"),
+	})
+
+	var mismatch *types.CodeMismatchException
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("expected CodeMismatchException, got %v", err)
+	}
+
+	_, err = client.ConfirmForgotPassword(ctx, &cognitoidentityprovider.ConfirmForgotPasswordInput{
+		ClientId:         aws.String(clientID),
+		Username:         aws.String("forgetful"),
+		ConfirmationCode: aws.String(getCognitoVerificationCode(t, userPoolID, "forgetful")),
+		Password:         aws.String("NewPassword123!"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.InitiateAuth(ctx, &cognitoidentityprovider.InitiateAuthInput{
+		AuthFlow: types.AuthFlowTypeUserPasswordAuth,
+		ClientId: aws.String(clientID),
+		AuthParameters: map[string]string{
+			"USERNAME": "forgetful",
+			"PASSWORD": "NewPassword123!",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCognito_AdminResetUserPassword(t *testing.T) {
+	client := newCognitoClient(t)
+	ctx := t.Context()
+
+	poolOutput, err := client.CreateUserPool(ctx, &cognitoidentityprovider.CreateUserPoolInput{
+		PoolName: aws.String("test-reset-password-pool"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userPoolID := *poolOutput.UserPool.Id
+
+	clientOutput, err := client.CreateUserPoolClient(ctx, &cognitoidentityprovider.CreateUserPoolClientInput{
+		UserPoolId: aws.String(userPoolID),
+		ClientName: aws.String("reset-password-client"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientID := *clientOutput.UserPoolClient.ClientId
+
+	_, err = client.AdminCreateUser(ctx, &cognitoidentityprovider.AdminCreateUserInput{
+		UserPoolId:        aws.String(userPoolID),
+		Username:          aws.String("resetuser"),
+		TemporaryPassword: aws.String("TempPass123!"),
+		UserAttributes: []types.AttributeType{
+			{Name: aws.String("email"), Value: aws.String("resetuser@example.com")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.AdminResetUserPassword(ctx, &cognitoidentityprovider.AdminResetUserPasswordInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String("resetuser"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.InitiateAuth(ctx, &cognitoidentityprovider.InitiateAuthInput{
+		AuthFlow: types.AuthFlowTypeUserPasswordAuth,
+		ClientId: aws.String(clientID),
+		AuthParameters: map[string]string{
+			"USERNAME": "resetuser",
+			"PASSWORD": "TempPass123!",
+		},
+	})
+
+	var resetRequired *types.PasswordResetRequiredException
+	if !errors.As(err, &resetRequired) {
+		t.Fatalf("expected PasswordResetRequiredException, got %v", err)
+	}
+
+	_, err = client.ConfirmForgotPassword(ctx, &cognitoidentityprovider.ConfirmForgotPasswordInput{
+		ClientId:         aws.String(clientID),
+		Username:         aws.String("resetuser"),
+		ConfirmationCode: aws.String(getCognitoVerificationCode(t, userPoolID, "resetuser")),
+		Password:         aws.String("NewPassword123!"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userOutput, err := client.AdminGetUser(ctx, &cognitoidentityprovider.AdminGetUserInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String("resetuser"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if userOutput.UserStatus != types.UserStatusTypeConfirmed {
+		t.Errorf("expected CONFIRMED, got %s", userOutput.UserStatus)
+	}
+}
+
+func TestCognito_Groups(t *testing.T) {
+	client := newCognitoClient(t)
+	ctx := t.Context()
+
+	poolOutput, err := client.CreateUserPool(ctx, &cognitoidentityprovider.CreateUserPoolInput{
+		PoolName: aws.String("test-groups-pool"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userPoolID := *poolOutput.UserPool.Id
+
+	clientOutput, err := client.CreateUserPoolClient(ctx, &cognitoidentityprovider.CreateUserPoolClientInput{
+		UserPoolId: aws.String(userPoolID),
+		ClientName: aws.String("groups-client"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientID := *clientOutput.UserPoolClient.ClientId
+
+	createOutput, err := client.CreateGroup(ctx, &cognitoidentityprovider.CreateGroupInput{
+		UserPoolId:  aws.String(userPoolID),
+		GroupName:   aws.String("admins"),
+		Description: aws.String("Administrators"),
+		Precedence:  aws.Int32(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("UserPoolId", "CreationDate", "LastModifiedDate", "ResultMetadata")).Assert(t.Name()+"_create", createOutput)
+
+	_, err = client.CreateGroup(ctx, &cognitoidentityprovider.CreateGroupInput{
+		UserPoolId: aws.String(userPoolID),
+		GroupName:  aws.String("readers"),
+		Precedence: aws.Int32(10),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.CreateGroup(ctx, &cognitoidentityprovider.CreateGroupInput{
+		UserPoolId: aws.String(userPoolID),
+		GroupName:  aws.String("admins"),
+	})
+
+	var exists *types.GroupExistsException
+	if !errors.As(err, &exists) {
+		t.Fatalf("expected GroupExistsException, got %v", err)
+	}
+
+	listOutput, err := client.ListGroups(ctx, &cognitoidentityprovider.ListGroupsInput{
+		UserPoolId: aws.String(userPoolID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("UserPoolId", "CreationDate", "LastModifiedDate", "ResultMetadata")).Assert(t.Name()+"_list", listOutput)
+
+	_, err = client.SignUp(ctx, &cognitoidentityprovider.SignUpInput{
+		ClientId: aws.String(clientID),
+		Username: aws.String("groupuser"),
+		Password: aws.String("Password123!"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.ConfirmSignUp(ctx, &cognitoidentityprovider.ConfirmSignUpInput{
+		ClientId:         aws.String(clientID),
+		Username:         aws.String("groupuser"),
+		ConfirmationCode: aws.String(getCognitoVerificationCode(t, userPoolID, "groupuser")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, group := range []string{"readers", "admins"} {
+		_, err = client.AdminAddUserToGroup(ctx, &cognitoidentityprovider.AdminAddUserToGroupInput{
+			UserPoolId: aws.String(userPoolID),
+			Username:   aws.String("groupuser"),
+			GroupName:  aws.String(group),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	userGroupsOutput, err := client.AdminListGroupsForUser(ctx, &cognitoidentityprovider.AdminListGroupsForUserInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String("groupuser"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(userGroupsOutput.Groups) != 2 {
+		t.Fatalf("expected 2 groups, got %d", len(userGroupsOutput.Groups))
+	}
+
+	authOutput, err := client.InitiateAuth(ctx, &cognitoidentityprovider.InitiateAuthInput{
+		AuthFlow: types.AuthFlowTypeUserPasswordAuth,
+		ClientId: aws.String(clientID),
+		AuthParameters: map[string]string{
+			"USERNAME": "groupuser",
+			"PASSWORD": "Password123!",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims := verifyCognitoToken(t, *authOutput.AuthenticationResult.IdToken)
+
+	groups, _ := claims["cognito:groups"].([]any)
+	if len(groups) != 2 || groups[0] != "admins" || groups[1] != "readers" {
+		t.Errorf("expected cognito:groups [admins readers], got %v", claims["cognito:groups"])
+	}
+
+	_, err = client.AdminRemoveUserFromGroup(ctx, &cognitoidentityprovider.AdminRemoveUserFromGroupInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String("groupuser"),
+		GroupName:  aws.String("readers"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.DeleteGroup(ctx, &cognitoidentityprovider.DeleteGroupInput{
+		UserPoolId: aws.String(userPoolID),
+		GroupName:  aws.String("admins"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userGroupsOutput, err = client.AdminListGroupsForUser(ctx, &cognitoidentityprovider.AdminListGroupsForUserInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String("groupuser"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(userGroupsOutput.Groups) != 0 {
+		t.Errorf("expected no groups, got %d", len(userGroupsOutput.Groups))
+	}
+
+	_, err = client.GetGroup(ctx, &cognitoidentityprovider.GetGroupInput{
+		UserPoolId: aws.String(userPoolID),
+		GroupName:  aws.String("admins"),
+	})
+
+	var notFound *types.ResourceNotFoundException
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expected ResourceNotFoundException, got %v", err)
+	}
+}
+
+func TestCognito_PasswordPolicy(t *testing.T) {
+	client := newCognitoClient(t)
+	ctx := t.Context()
+
+	poolOutput, err := client.CreateUserPool(ctx, &cognitoidentityprovider.CreateUserPoolInput{
+		PoolName:           aws.String("test-password-policy-pool"),
+		UsernameAttributes: []types.UsernameAttributeType{types.UsernameAttributeTypeEmail},
+		Policies: &types.UserPoolPolicyType{
+			PasswordPolicy: &types.PasswordPolicyType{
+				MinimumLength:    aws.Int32(10),
+				RequireUppercase: true,
+				RequireLowercase: true,
+				RequireNumbers:   true,
+				RequireSymbols:   true,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userPoolID := *poolOutput.UserPool.Id
+
+	clientOutput, err := client.CreateUserPoolClient(ctx, &cognitoidentityprovider.CreateUserPoolClientInput{
+		UserPoolId: aws.String(userPoolID),
+		ClientName: aws.String("password-policy-client"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientID := *clientOutput.UserPoolClient.ClientId
+
+	_, err = client.SignUp(ctx, &cognitoidentityprovider.SignUpInput{
+		ClientId: aws.String(clientID),
+		Username: aws.String("policy@example.com"),
+		Password: aws.String("Short1!"),
+	})
+
+	var invalidPassword *types.InvalidPasswordException
+	if !errors.As(err, &invalidPassword) {
+		t.Fatalf("expected InvalidPasswordException, got %v", err)
+	}
+
+	_, err = client.AdminCreateUser(ctx, &cognitoidentityprovider.AdminCreateUserInput{
+		UserPoolId:        aws.String(userPoolID),
+		Username:          aws.String("policy@example.com"),
+		TemporaryPassword: aws.String("nouppercase123!"),
+	})
+	if !errors.As(err, &invalidPassword) {
+		t.Fatalf("expected InvalidPasswordException, got %v", err)
+	}
+
+	_, err = client.SignUp(ctx, &cognitoidentityprovider.SignUpInput{
+		ClientId: aws.String(clientID),
+		Username: aws.String("not-an-email"),
+		Password: aws.String("LongEnough123!"),
+	})
+
+	var invalidParameter *types.InvalidParameterException
+	if !errors.As(err, &invalidParameter) {
+		t.Fatalf("expected InvalidParameterException, got %v", err)
+	}
+
+	_, err = client.SignUp(ctx, &cognitoidentityprovider.SignUpInput{
+		ClientId: aws.String(clientID),
+		Username: aws.String("policy@example.com"),
+		Password: aws.String("LongEnough123!"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCognito_SecretHash(t *testing.T) {
+	client := newCognitoClient(t)
+	ctx := t.Context()
+
+	poolOutput, err := client.CreateUserPool(ctx, &cognitoidentityprovider.CreateUserPoolInput{
+		PoolName: aws.String("test-secret-hash-pool"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userPoolID := *poolOutput.UserPool.Id
+
+	clientOutput, err := client.CreateUserPoolClient(ctx, &cognitoidentityprovider.CreateUserPoolClientInput{
+		UserPoolId:     aws.String(userPoolID),
+		ClientName:     aws.String("secret-client"),
+		GenerateSecret: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientID := *clientOutput.UserPoolClient.ClientId
+	clientSecret := *clientOutput.UserPoolClient.ClientSecret
+
+	var notAuthorized *types.NotAuthorizedException
+
+	// Missing SecretHash is rejected.
+	_, err = client.SignUp(ctx, &cognitoidentityprovider.SignUpInput{
+		ClientId: aws.String(clientID),
+		Username: aws.String("secretuser"),
+		Password: aws.String("Password123!"),
+	})
+	if !errors.As(err, &notAuthorized) {
+		t.Fatalf("expected NotAuthorizedException, got %v", err)
+	}
+
+	// SecretHash computed for a different username is rejected.
+	_, err = client.SignUp(ctx, &cognitoidentityprovider.SignUpInput{
+		ClientId:   aws.String(clientID),
+		Username:   aws.String("secretuser"),
+		Password:   aws.String("Password123!"),
+		SecretHash: aws.String(cognitoSecretHash("otheruser", clientID, clientSecret)),
+	})
+	if !errors.As(err, &notAuthorized) {
+		t.Fatalf("expected NotAuthorizedException, got %v", err)
+	}
+
+	secretHash := cognitoSecretHash("secretuser", clientID, clientSecret)
+
+	_, err = client.SignUp(ctx, &cognitoidentityprovider.SignUpInput{
+		ClientId:   aws.String(clientID),
+		Username:   aws.String("secretuser"),
+		Password:   aws.String("Password123!"),
+		SecretHash: aws.String(secretHash),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.ConfirmSignUp(ctx, &cognitoidentityprovider.ConfirmSignUpInput{
+		ClientId:         aws.String(clientID),
+		Username:         aws.String("secretuser"),
+		ConfirmationCode: aws.String(getCognitoVerificationCode(t, userPoolID, "secretuser")),
+		SecretHash:       aws.String(secretHash),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.InitiateAuth(ctx, &cognitoidentityprovider.InitiateAuthInput{
+		AuthFlow: types.AuthFlowTypeUserPasswordAuth,
+		ClientId: aws.String(clientID),
+		AuthParameters: map[string]string{
+			"USERNAME": "secretuser",
+			"PASSWORD": "Password123!",
+		},
+	})
+	if !errors.As(err, &notAuthorized) {
+		t.Fatalf("expected NotAuthorizedException, got %v", err)
+	}
+
+	authOutput, err := client.InitiateAuth(ctx, &cognitoidentityprovider.InitiateAuthInput{
+		AuthFlow: types.AuthFlowTypeUserPasswordAuth,
+		ClientId: aws.String(clientID),
+		AuthParameters: map[string]string{
+			"USERNAME":    "secretuser",
+			"PASSWORD":    "Password123!",
+			"SECRET_HASH": secretHash,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if authOutput.AuthenticationResult == nil {
+		t.Fatal("expected authentication result")
+	}
+}
+
+// cognitoSecretHash computes the SecretHash for a user pool client with a secret.
+func cognitoSecretHash(username, clientID, clientSecret string) string {
+	mac := hmac.New(sha256.New, []byte(clientSecret))
+	mac.Write([]byte(username + clientID))
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// getCognitoVerificationCode returns the outstanding verification code for a user from the kumo-specific endpoint.
+func getCognitoVerificationCode(t *testing.T, userPoolID, username string) string {
+	t.Helper()
+
+	resp, err := http.Get("http://localhost:4566/kumo/cognito-idp/verification-codes")
+	if err != nil {
+		t.Fatalf("failed to get verification codes: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		VerificationCodes []struct {
+			UserPoolID string `json:"UserPoolId"`
+			Username   string `json:"Username"`
+			Code       string `json:"Code"`
+		} `json:"VerificationCodes"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("failed to decode verification codes: %v", err)
+	}
+
+	for _, code := range result.VerificationCodes {
+		if code.UserPoolID == userPoolID && code.Username == username {
+			return code.Code
+		}
+	}
+
+	t.Fatalf("no verification code found for %s", username)
+
+	return ""
+}
+
+// verifyCognitoToken verifies a token against the JWKS found at its issuer,
+// the way standard JWT verifiers do, and returns its claims.
+func verifyCognitoToken(t *testing.T, token string) map[string]any {
+	t.Helper()
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("expected JWT with 3 parts, got %d", len(parts))
+	}
+
+	var claims map[string]any
+
+	decodeJWTPart(t, parts[1], &claims)
+
+	issuer, _ := claims["iss"].(string)
+
+	resp, err := http.Get(issuer + "/.well-known/jwks.json")
+	if err != nil {
+		t.Fatalf("failed to get JWKS: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var jwks struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&jwks); err != nil {
+		t.Fatalf("failed to decode JWKS: %v", err)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+
+	decodeJWTPart(t, parts[0], &header)
+
+	if header.Alg != "RS256" {
+		t.Fatalf("expected RS256, got %s", header.Alg)
+	}
+
+	var pub *rsa.PublicKey
+
+	for _, key := range jwks.Keys {
+		if key.Kid != header.Kid {
+			continue
+		}
+
+		n, _ := base64.RawURLEncoding.DecodeString(key.N)
+		e, _ := base64.RawURLEncoding.DecodeString(key.E)
+		pub = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+
+	if pub == nil {
+		t.Fatalf("no JWKS key found for kid %s", header.Kid)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatalf("failed to decode signature: %v", err)
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig); err != nil {
+		t.Fatalf("invalid token signature: %v", err)
+	}
+
+	return claims
+}
+
+// decodeJWTPart decodes a base64url-encoded JWT segment into v.
+func decodeJWTPart(t *testing.T, part string, v any) {
+	t.Helper()
+
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		t.Fatalf("failed to decode JWT segment: %v", err)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatalf("failed to unmarshal JWT segment: %v", err)
+	}
+}
@@ -0,0 +1,215 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity/types"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	idptypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/sivchari/golden"
+)
+
+func newCognitoIdentityClient(t *testing.T) *cognitoidentity.Client {
+	t.Helper()
+
+	cfg, err := config.LoadDefaultConfig(t.Context(),
+		config.WithRegion("us-east-1"),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			"test", "test", "",
+		)),
+	)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	return cognitoidentity.NewFromConfig(cfg, func(o *cognitoidentity.Options) {
+		o.BaseEndpoint = aws.String("http://localhost:4566")
+	})
+}
+
+func TestCognitoIdentity_CreateAndDescribeIdentityPool(t *testing.T) {
+	client := newCognitoIdentityClient(t)
+	ctx := t.Context()
+
+	createOutput, err := client.CreateIdentityPool(ctx, &cognitoidentity.CreateIdentityPoolInput{
+		IdentityPoolName:               aws.String("test-identity-pool"),
+		AllowUnauthenticatedIdentities: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	identityPoolID := *createOutput.IdentityPoolId
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteIdentityPool(context.Background(), &cognitoidentity.DeleteIdentityPoolInput{
+			IdentityPoolId: aws.String(identityPoolID),
+		})
+	})
+
+	if !strings.HasPrefix(identityPoolID, "us-east-1:") {
+		t.Errorf("unexpected identity pool ID: %s", identityPoolID)
+	}
+
+	describeOutput, err := client.DescribeIdentityPool(ctx, &cognitoidentity.DescribeIdentityPoolInput{
+		IdentityPoolId: aws.String(identityPoolID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("IdentityPoolId", "ResultMetadata")).Assert(t.Name(), describeOutput)
+}
+
+func TestCognitoIdentity_GetCredentialsForIdentity(t *testing.T) {
+	idp := newCognitoClient(t)
+	client := newCognitoIdentityClient(t)
+	ctx := t.Context()
+
+	// Authenticate a user pool user to obtain an ID token.
+	poolOutput, err := idp.CreateUserPool(ctx, &cognitoidentityprovider.CreateUserPoolInput{
+		PoolName: aws.String("test-federated-pool"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userPoolID := *poolOutput.UserPool.Id
+
+	t.Cleanup(func() {
+		_, _ = idp.DeleteUserPool(context.Background(), &cognitoidentityprovider.DeleteUserPoolInput{
+			UserPoolId: aws.String(userPoolID),
+		})
+	})
+
+	clientOutput, err := idp.CreateUserPoolClient(ctx, &cognitoidentityprovider.CreateUserPoolClientInput{
+		UserPoolId: aws.String(userPoolID),
+		ClientName: aws.String("federated-client"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientID := *clientOutput.UserPoolClient.ClientId
+
+	if _, err := idp.SignUp(ctx, &cognitoidentityprovider.SignUpInput{
+		ClientId: aws.String(clientID),
+		Username: aws.String("federateduser"),
+		Password: aws.String("Password123!"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := idp.ConfirmSignUp(ctx, &cognitoidentityprovider.ConfirmSignUpInput{
+		ClientId:         aws.String(clientID),
+		Username:         aws.String("federateduser"),
+		ConfirmationCode: aws.String(getCognitoVerificationCode(t, userPoolID, "federateduser")),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	authOutput, err := idp.InitiateAuth(ctx, &cognitoidentityprovider.InitiateAuthInput{
+		AuthFlow: idptypes.AuthFlowTypeUserPasswordAuth,
+		ClientId: aws.String(clientID),
+		AuthParameters: map[string]string{
+			"USERNAME": "federateduser",
+			"PASSWORD": "Password123!",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider := "cognito-idp.us-east-1.amazonaws.com/" + userPoolID
+
+	// Create an identity pool federated with the user pool.
+	createOutput, err := client.CreateIdentityPool(ctx, &cognitoidentity.CreateIdentityPoolInput{
+		IdentityPoolName:               aws.String("test-federated-identity-pool"),
+		AllowUnauthenticatedIdentities: false,
+		CognitoIdentityProviders: []types.CognitoIdentityProvider{
+			{ProviderName: aws.String(provider), ClientId: aws.String(clientID)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	identityPoolID := *createOutput.IdentityPoolId
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteIdentityPool(context.Background(), &cognitoidentity.DeleteIdentityPoolInput{
+			IdentityPoolId: aws.String(identityPoolID),
+		})
+	})
+
+	_, err = client.SetIdentityPoolRoles(ctx, &cognitoidentity.SetIdentityPoolRolesInput{
+		IdentityPoolId: aws.String(identityPoolID),
+		Roles: map[string]string{
+			"authenticated": "arn:aws:iam::000000000000:role/authenticated",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Unauthenticated access is disabled for this pool.
+	_, err = client.GetId(ctx, &cognitoidentity.GetIdInput{
+		IdentityPoolId: aws.String(identityPoolID),
+	})
+
+	var notAuthorized *types.NotAuthorizedException
+	if !errors.As(err, &notAuthorized) {
+		t.Fatalf("expected NotAuthorizedException, got %v", err)
+	}
+
+	logins := map[string]string{provider: *authOutput.AuthenticationResult.IdToken}
+
+	getIDOutput, err := client.GetId(ctx, &cognitoidentity.GetIdInput{
+		IdentityPoolId: aws.String(identityPoolID),
+		Logins:         logins,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	identityID := *getIDOutput.IdentityId
+
+	// The same login resolves to the same identity.
+	again, err := client.GetId(ctx, &cognitoidentity.GetIdInput{
+		IdentityPoolId: aws.String(identityPoolID),
+		Logins:         logins,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *again.IdentityId != identityID {
+		t.Errorf("expected stable identity ID %s, got %s", identityID, *again.IdentityId)
+	}
+
+	credsOutput, err := client.GetCredentialsForIdentity(ctx, &cognitoidentity.GetCredentialsForIdentityInput{
+		IdentityId: aws.String(identityID),
+		Logins:     logins,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *credsOutput.IdentityId != identityID {
+		t.Errorf("unexpected identity ID: %s", *credsOutput.IdentityId)
+	}
+
+	creds := credsOutput.Credentials
+	if creds == nil || !strings.HasPrefix(aws.ToString(creds.AccessKeyId), "ASIA") || aws.ToString(creds.SecretKey) == "" || aws.ToString(creds.SessionToken) == "" || creds.Expiration == nil {
+		t.Errorf("unexpected credentials: %+v", creds)
+	}
+}
@@ -0,0 +1,71 @@
+//go:build integration
+
+package integration
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCORS_Preflight(t *testing.T) {
+	req, err := http.NewRequest(http.MethodOptions, "http://localhost:4566/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req.Header.Set("Origin", "http://localhost:3000")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	req.Header.Set("Access-Control-Request-Headers", "authorization,content-type,x-amz-date,x-amz-target")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, resp.StatusCode)
+	}
+
+	if got := resp.Header.Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("expected Access-Control-Allow-Origin *, got %q", got)
+	}
+
+	if got := resp.Header.Get("Access-Control-Allow-Headers"); got != "authorization,content-type,x-amz-date,x-amz-target" {
+		t.Errorf("unexpected Access-Control-Allow-Headers %q", got)
+	}
+
+	if got := resp.Header.Get("Access-Control-Allow-Methods"); !strings.Contains(got, "POST") {
+		t.Errorf("expected POST in Access-Control-Allow-Methods, got %q", got)
+	}
+}
+
+func TestCORS_ExposesAWSHeaders(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "http://localhost:4566/", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req.Header.Set("Origin", "http://localhost:3000")
+	req.Header.Set("Content-Type", "application/x-amz-json-1.0")
+	req.Header.Set("X-Amz-Target", "AmazonSQS.ListQueues")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	if got := resp.Header.Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("expected Access-Control-Allow-Origin *, got %q", got)
+	}
+
+	if got := resp.Header.Get("Access-Control-Expose-Headers"); !strings.Contains(got, "X-Amzn-Requestid") {
+		t.Errorf("expected X-Amzn-Requestid in Access-Control-Expose-Headers, got %q", got)
+	}
+}
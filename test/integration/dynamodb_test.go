@@ -5,7 +5,10 @@ package integration
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -140,6 +143,8 @@ func TestDynamoDB_DescribeTable(t *testing.T) {
 		})
 	})
 
+	waitForDynamoDBTableActive(t, client, tableName)
+
 	// Describe table.
 	descOutput, err := client.DescribeTable(ctx, &dynamodb.DescribeTableInput{
 		TableName: aws.String(tableName),
@@ -150,6 +155,142 @@ func TestDynamoDB_DescribeTable(t *testing.T) {
 	golden.New(t, golden.WithIgnoreFields("TableArn", "TableId", "CreationDateTime", "TableSizeBytes", "ItemCount", "ResultMetadata")).Assert(t.Name(), descOutput)
 }
 
+// waitForDynamoDBTableActive waits with the SDK waiter until the table is ACTIVE.
+func waitForDynamoDBTableActive(t *testing.T, client *dynamodb.Client, tableName string) {
+	t.Helper()
+
+	waiter := dynamodb.NewTableExistsWaiter(client, func(o *dynamodb.TableExistsWaiterOptions) {
+		o.MinDelay = 100 * time.Millisecond
+		o.MaxDelay = 200 * time.Millisecond
+	})
+
+	if err := waiter.Wait(t.Context(), &dynamodb.DescribeTableInput{TableName: aws.String(tableName)}, 10*time.Second); err != nil {
+		t.Fatalf("table %s did not become ACTIVE: %v", tableName, err)
+	}
+}
+
+//nolint:funlen // Test function exercises the whole lifecycle of a GSI.
+func TestDynamoDB_UpdateTable(t *testing.T) {
+	client := newDynamoDBClient(t)
+	ctx := t.Context()
+	tableName := "test-table-update"
+
+	_, err := client.CreateTable(ctx, &dynamodb.CreateTableInput{
+		TableName: aws.String(tableName),
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String("pk"), KeyType: types.KeyTypeHash},
+		},
+		AttributeDefinitions: []types.AttributeDefinition{
+			{AttributeName: aws.String("pk"), AttributeType: types.ScalarAttributeTypeS},
+		},
+		ProvisionedThroughput: &types.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(5),
+			WriteCapacityUnits: aws.Int64(5),
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteTable(context.Background(), &dynamodb.DeleteTableInput{
+			TableName: aws.String(tableName),
+		})
+	})
+
+	waitForDynamoDBTableActive(t, client, tableName)
+
+	// Add a GSI.
+	updateOutput, err := client.UpdateTable(ctx, &dynamodb.UpdateTableInput{
+		TableName: aws.String(tableName),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{AttributeName: aws.String("customer"), AttributeType: types.ScalarAttributeTypeS},
+		},
+		GlobalSecondaryIndexUpdates: []types.GlobalSecondaryIndexUpdate{{
+			Create: &types.CreateGlobalSecondaryIndexAction{
+				IndexName: aws.String("by-customer"),
+				KeySchema: []types.KeySchemaElement{
+					{AttributeName: aws.String("customer"), KeyType: types.KeyTypeHash},
+				},
+				Projection: &types.Projection{ProjectionType: types.ProjectionTypeAll},
+				ProvisionedThroughput: &types.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(1),
+					WriteCapacityUnits: aws.Int64(1),
+				},
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, golden.WithIgnoreFields("TableArn", "TableId", "CreationDateTime", "IndexArn", "ResultMetadata")).Assert(t.Name()+"_create_index", updateOutput)
+
+	waitForDynamoDBTableActive(t, client, tableName)
+
+	if _, err := client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(tableName),
+		Item: map[string]types.AttributeValue{
+			"pk":       &types.AttributeValueMemberS{Value: "order-1"},
+			"customer": &types.AttributeValueMemberS{Value: "alice"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	queryOutput, err := client.Query(ctx, &dynamodb.QueryInput{
+		TableName:                 aws.String(tableName),
+		IndexName:                 aws.String("by-customer"),
+		KeyConditionExpression:    aws.String("customer = :c"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{":c": &types.AttributeValueMemberS{Value: "alice"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if queryOutput.Count != 1 {
+		t.Errorf("expected 1 item in the new index, got %d", queryOutput.Count)
+	}
+
+	// Remove the GSI.
+	updateOutput, err = client.UpdateTable(ctx, &dynamodb.UpdateTableInput{
+		TableName: aws.String(tableName),
+		GlobalSecondaryIndexUpdates: []types.GlobalSecondaryIndexUpdate{{
+			Delete: &types.DeleteGlobalSecondaryIndexAction{IndexName: aws.String("by-customer")},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, golden.WithIgnoreFields("TableArn", "TableId", "CreationDateTime", "IndexArn", "ResultMetadata")).Assert(t.Name()+"_delete_index", updateOutput)
+
+	// The table cannot be updated again until the index is removed.
+	_, err = client.UpdateTable(ctx, &dynamodb.UpdateTableInput{
+		TableName: aws.String(tableName),
+		ProvisionedThroughput: &types.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(10),
+			WriteCapacityUnits: aws.Int64(10),
+		},
+	})
+
+	var inUse *types.ResourceInUseException
+	if !errors.As(err, &inUse) {
+		t.Errorf("expected ResourceInUseException, got %v", err)
+	}
+
+	waitForDynamoDBTableActive(t, client, tableName)
+
+	descOutput, err := client.DescribeTable(ctx, &dynamodb.DescribeTableInput{
+		TableName: aws.String(tableName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := len(descOutput.Table.GlobalSecondaryIndexes); n != 0 {
+		t.Errorf("expected the index to be removed, got %d indexes", n)
+	}
+}
+
 func TestDynamoDB_PutAndGetItem(t *testing.T) {
 	client := newDynamoDBClient(t)
 	ctx := t.Context()
@@ -353,6 +494,134 @@ func TestDynamoDB_UpdateItem(t *testing.T) {
 	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name()+"_get_after_update", getOutput)
 }
 
+func TestDynamoDB_ReturnValuesAndConsumedCapacity(t *testing.T) {
+	client := newDynamoDBClient(t)
+	ctx := t.Context()
+	tableName := "test-table-return-values"
+
+	_, err := client.CreateTable(ctx, &dynamodb.CreateTableInput{
+		TableName: aws.String(tableName),
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String("pk"), KeyType: types.KeyTypeHash},
+		},
+		AttributeDefinitions: []types.AttributeDefinition{
+			{AttributeName: aws.String("pk"), AttributeType: types.ScalarAttributeTypeS},
+		},
+		BillingMode: types.BillingModePayPerRequest,
+	})
+	if err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteTable(context.Background(), &dynamodb.DeleteTableInput{
+			TableName: aws.String(tableName),
+		})
+	})
+
+	key := map[string]types.AttributeValue{
+		"pk": &types.AttributeValueMemberS{Value: "item"},
+	}
+
+	_, err = client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(tableName),
+		Item: map[string]types.AttributeValue{
+			"pk":    &types.AttributeValueMemberS{Value: "item"},
+			"name":  &types.AttributeValueMemberS{Value: "first"},
+			"count": &types.AttributeValueMemberN{Value: "1"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to put item: %v", err)
+	}
+
+	// Overwriting returns the prior item and the consumed capacity.
+	putOutput, err := client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(tableName),
+		Item: map[string]types.AttributeValue{
+			"pk":    &types.AttributeValueMemberS{Value: "item"},
+			"name":  &types.AttributeValueMemberS{Value: "second"},
+			"count": &types.AttributeValueMemberN{Value: "1"},
+		},
+		ReturnValues:           types.ReturnValueAllOld,
+		ReturnConsumedCapacity: types.ReturnConsumedCapacityTotal,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if name, ok := putOutput.Attributes["name"].(*types.AttributeValueMemberS); !ok || name.Value != "first" {
+		t.Errorf("expected the old item back, got %v", putOutput.Attributes)
+	}
+
+	if putOutput.ConsumedCapacity == nil || aws.ToFloat64(putOutput.ConsumedCapacity.CapacityUnits) != 1 ||
+		aws.ToString(putOutput.ConsumedCapacity.TableName) != tableName {
+		t.Errorf("expected 1 consumed capacity unit on %s, got %+v", tableName, putOutput.ConsumedCapacity)
+	}
+
+	// UPDATED_OLD and UPDATED_NEW return only the attributes the update changed.
+	updateOutput, err := client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(tableName),
+		Key:                       key,
+		UpdateExpression:          aws.String("SET #n = :name, #c = :count"),
+		ExpressionAttributeNames:  map[string]string{"#n": "name", "#c": "count"},
+		ExpressionAttributeValues: map[string]types.AttributeValue{":name": &types.AttributeValueMemberS{Value: "third"}, ":count": &types.AttributeValueMemberN{Value: "1"}},
+		ReturnValues:              types.ReturnValueUpdatedOld,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if name, ok := updateOutput.Attributes["name"].(*types.AttributeValueMemberS); !ok || name.Value != "second" || len(updateOutput.Attributes) != 1 {
+		t.Errorf("expected only the old name, got %v", updateOutput.Attributes)
+	}
+
+	updateOutput, err = client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(tableName),
+		Key:                       key,
+		UpdateExpression:          aws.String("ADD #c :one"),
+		ExpressionAttributeNames:  map[string]string{"#c": "count"},
+		ExpressionAttributeValues: map[string]types.AttributeValue{":one": &types.AttributeValueMemberN{Value: "1"}},
+		ReturnValues:              types.ReturnValueUpdatedNew,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count, ok := updateOutput.Attributes["count"].(*types.AttributeValueMemberN); !ok || count.Value != "2" || len(updateOutput.Attributes) != 1 {
+		t.Errorf("expected only the new count, got %v", updateOutput.Attributes)
+	}
+
+	// Deleting returns the removed item.
+	deleteOutput, err := client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName:              aws.String(tableName),
+		Key:                    key,
+		ReturnValues:           types.ReturnValueAllOld,
+		ReturnConsumedCapacity: types.ReturnConsumedCapacityIndexes,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if name, ok := deleteOutput.Attributes["name"].(*types.AttributeValueMemberS); !ok || name.Value != "third" {
+		t.Errorf("expected the deleted item back, got %v", deleteOutput.Attributes)
+	}
+
+	if deleteOutput.ConsumedCapacity == nil || deleteOutput.ConsumedCapacity.Table == nil {
+		t.Errorf("expected table consumed capacity, got %+v", deleteOutput.ConsumedCapacity)
+	}
+
+	// PutItem only accepts NONE and ALL_OLD.
+	_, err = client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:    aws.String(tableName),
+		Item:         key,
+		ReturnValues: types.ReturnValueAllNew,
+	})
+	if err == nil {
+		t.Error("expected ReturnValues ALL_NEW to be rejected on PutItem")
+	}
+}
+
 func TestDynamoDB_Query(t *testing.T) {
 	client := newDynamoDBClient(t)
 	ctx := t.Context()
@@ -489,6 +758,132 @@ func TestDynamoDB_Scan(t *testing.T) {
 	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name(), scanOutput)
 }
 
+func TestDynamoDB_ScanPaginationAndSegments(t *testing.T) {
+	client := newDynamoDBClient(t)
+	ctx := t.Context()
+	tableName := "test-table-scan-pagination"
+
+	_, err := client.CreateTable(ctx, &dynamodb.CreateTableInput{
+		TableName: aws.String(tableName),
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String("pk"), KeyType: types.KeyTypeHash},
+		},
+		AttributeDefinitions: []types.AttributeDefinition{
+			{AttributeName: aws.String("pk"), AttributeType: types.ScalarAttributeTypeS},
+		},
+		BillingMode: types.BillingModePayPerRequest,
+	})
+	if err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteTable(context.Background(), &dynamodb.DeleteTableInput{
+			TableName: aws.String(tableName),
+		})
+	})
+
+	for i := range 20 {
+		_, err = client.PutItem(ctx, &dynamodb.PutItemInput{
+			TableName: aws.String(tableName),
+			Item: map[string]types.AttributeValue{
+				"pk":     &types.AttributeValueMemberS{Value: fmt.Sprintf("item-%02d", i)},
+				"n":      &types.AttributeValueMemberN{Value: strconv.Itoa(i)},
+				"detail": &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{"even": &types.AttributeValueMemberBOOL{Value: i%2 == 0}}},
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to put item: %v", err)
+		}
+	}
+
+	// Page through a filtered, projected scan.
+	paginator := dynamodb.NewScanPaginator(client, &dynamodb.ScanInput{
+		TableName:                aws.String(tableName),
+		FilterExpression:         aws.String("#d.even = :even"),
+		ProjectionExpression:     aws.String("pk, #d.even"),
+		ExpressionAttributeNames: map[string]string{"#d": "detail"},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":even": &types.AttributeValueMemberBOOL{Value: true},
+		},
+		Limit: aws.Int32(6),
+	})
+
+	var (
+		pages int
+		items []map[string]types.AttributeValue
+	)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if page.ScannedCount > 6 {
+			t.Errorf("expected at most 6 scanned items per page, got %d", page.ScannedCount)
+		}
+
+		pages++
+		items = append(items, page.Items...)
+	}
+
+	if pages < 4 || len(items) != 10 {
+		t.Errorf("expected 10 items over at least 4 pages, got %d items over %d pages", len(items), pages)
+	}
+
+	for _, item := range items {
+		if _, ok := item["n"]; ok || len(item) != 2 {
+			t.Errorf("expected only pk and detail.even, got %v", item)
+		}
+	}
+
+	// A parallel scan covers every item exactly once.
+	seen := make(map[string]int)
+
+	for segment := range int32(4) {
+		out, err := client.Scan(ctx, &dynamodb.ScanInput{
+			TableName:     aws.String(tableName),
+			Segment:       aws.Int32(segment),
+			TotalSegments: aws.Int32(4),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, item := range out.Items {
+			seen[item["pk"].(*types.AttributeValueMemberS).Value]++
+		}
+	}
+
+	if len(seen) != 20 {
+		t.Errorf("expected 20 items across segments, got %d", len(seen))
+	}
+
+	// Select COUNT returns only the count.
+	countOutput, err := client.Scan(ctx, &dynamodb.ScanInput{
+		TableName: aws.String(tableName),
+		Select:    types.SelectCount,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if countOutput.Count != 20 || len(countOutput.Items) != 0 {
+		t.Errorf("expected a count of 20 and no items, got %d and %d items", countOutput.Count, len(countOutput.Items))
+	}
+
+	// Segment must be less than TotalSegments.
+	_, err = client.Scan(ctx, &dynamodb.ScanInput{
+		TableName:     aws.String(tableName),
+		Segment:       aws.Int32(4),
+		TotalSegments: aws.Int32(4),
+	})
+	if err == nil {
+		t.Error("expected error for a segment out of range")
+	}
+}
+
 func TestDynamoDB_CompositeKey(t *testing.T) {
 	client := newDynamoDBClient(t)
 	ctx := t.Context()
@@ -1373,3 +1768,176 @@ func TestDynamoDB_UpdateItem_AttributeUpdates(t *testing.T) {
 		t.Errorf("expected Name=updated, got %v", getOutput.Item["Name"])
 	}
 }
+
+func TestDynamoDB_PartiQL(t *testing.T) {
+	client := newDynamoDBClient(t)
+	ctx := t.Context()
+	tableName := "test-table-partiql"
+
+	_, err := client.CreateTable(ctx, &dynamodb.CreateTableInput{
+		TableName: aws.String(tableName),
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String("pk"), KeyType: types.KeyTypeHash},
+		},
+		AttributeDefinitions: []types.AttributeDefinition{
+			{AttributeName: aws.String("pk"), AttributeType: types.ScalarAttributeTypeS},
+		},
+		BillingMode: types.BillingModePayPerRequest,
+	})
+	if err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteTable(context.Background(), &dynamodb.DeleteTableInput{
+			TableName: aws.String(tableName),
+		})
+	})
+
+	for i := range 3 {
+		_, err = client.ExecuteStatement(ctx, &dynamodb.ExecuteStatementInput{
+			Statement: aws.String(fmt.Sprintf(`INSERT INTO "%s" VALUE {'pk': ?, 'n': %d}`, tableName, i)),
+			Parameters: []types.AttributeValue{
+				&types.AttributeValueMemberS{Value: fmt.Sprintf("item-%d", i)},
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to insert item: %v", err)
+		}
+	}
+
+	// Inserting an existing key fails.
+	_, err = client.ExecuteStatement(ctx, &dynamodb.ExecuteStatementInput{
+		Statement: aws.String(fmt.Sprintf(`INSERT INTO "%s" VALUE {'pk': 'item-0'}`, tableName)),
+	})
+
+	var duplicate *types.DuplicateItemException
+	if !errors.As(err, &duplicate) {
+		t.Errorf("expected DuplicateItemException, got %v", err)
+	}
+
+	_, err = client.ExecuteStatement(ctx, &dynamodb.ExecuteStatementInput{
+		Statement: aws.String(fmt.Sprintf(`UPDATE "%s" SET n = n + 10 WHERE pk = 'item-1'`, tableName)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	selectOutput, err := client.ExecuteStatement(ctx, &dynamodb.ExecuteStatementInput{
+		Statement: aws.String(fmt.Sprintf(`SELECT n FROM "%s" WHERE pk = ?`, tableName)),
+		Parameters: []types.AttributeValue{
+			&types.AttributeValueMemberS{Value: "item-1"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(selectOutput.Items) != 1 {
+		t.Fatalf("expected 1 item, got %d", len(selectOutput.Items))
+	}
+
+	if n, ok := selectOutput.Items[0]["n"].(*types.AttributeValueMemberN); !ok || n.Value != "11" || len(selectOutput.Items[0]) != 1 {
+		t.Errorf("expected only n = 11, got %v", selectOutput.Items[0])
+	}
+
+	batchOutput, err := client.BatchExecuteStatement(ctx, &dynamodb.BatchExecuteStatementInput{
+		Statements: []types.BatchStatementRequest{
+			{Statement: aws.String(fmt.Sprintf(`DELETE FROM "%s" WHERE pk = 'item-2'`, tableName))},
+			{Statement: aws.String(fmt.Sprintf(`UPDATE "%s" SET n = 0 WHERE pk = 'missing'`, tableName))},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(batchOutput.Responses) != 2 || batchOutput.Responses[0].Error != nil {
+		t.Fatalf("expected the batch DELETE to succeed, got %+v", batchOutput.Responses)
+	}
+
+	if batchErr := batchOutput.Responses[1].Error; batchErr == nil || batchErr.Code != types.BatchStatementErrorCodeEnumConditionalCheckFailed {
+		t.Errorf("expected the batch UPDATE of a missing item to fail its condition, got %+v", batchErr)
+	}
+
+	scanOutput, err := client.ExecuteStatement(ctx, &dynamodb.ExecuteStatementInput{
+		Statement: aws.String(fmt.Sprintf(`SELECT * FROM "%s"`, tableName)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(scanOutput.Items) != 2 {
+		t.Errorf("expected 2 items after the batch delete, got %d", len(scanOutput.Items))
+	}
+}
+
+func TestDynamoDB_ThrottleFaultRules(t *testing.T) {
+	client := newDynamoDBClient(t)
+	ctx := t.Context()
+	tableName := "test-table-throttle"
+
+	_, err := client.CreateTable(ctx, &dynamodb.CreateTableInput{
+		TableName: aws.String(tableName),
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String("pk"), KeyType: types.KeyTypeHash},
+		},
+		AttributeDefinitions: []types.AttributeDefinition{
+			{AttributeName: aws.String("pk"), AttributeType: types.ScalarAttributeTypeS},
+		},
+		BillingMode: types.BillingModePayPerRequest,
+	})
+	if err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteTable(context.Background(), &dynamodb.DeleteTableInput{
+			TableName: aws.String(tableName),
+		})
+	})
+
+	throttle := `"resource": "` + tableName + `", "statusCode": 400, "errorCode": "ProvisionedThroughputExceededException"`
+
+	putFaultRules(t, `[
+		{"service": "dynamodb", "action": "PutItem", "errorRate": 1, `+throttle+`},
+		{"service": "dynamodb", "action": "GetItem", "requestsPerSecond": 1, `+throttle+`}
+	]`)
+
+	t.Cleanup(func() {
+		deleteFaultRules(t)
+	})
+
+	noRetry := func(o *dynamodb.Options) {
+		o.RetryMaxAttempts = 1
+	}
+
+	item := map[string]types.AttributeValue{"pk": &types.AttributeValueMemberS{Value: "item-1"}}
+
+	_, err = client.PutItem(ctx, &dynamodb.PutItemInput{TableName: aws.String(tableName), Item: item}, noRetry)
+
+	var throttled *types.ProvisionedThroughputExceededException
+	if !errors.As(err, &throttled) {
+		t.Fatalf("expected ProvisionedThroughputExceededException, got %v", err)
+	}
+
+	// Reads within the limit are served; the next read in the same second is throttled.
+	if _, err := client.GetItem(ctx, &dynamodb.GetItemInput{TableName: aws.String(tableName), Key: item}, noRetry); err != nil {
+		t.Fatalf("expected the first read to succeed: %v", err)
+	}
+
+	_, err = client.GetItem(ctx, &dynamodb.GetItemInput{TableName: aws.String(tableName), Key: item}, noRetry)
+	if !errors.As(err, &throttled) {
+		t.Errorf("expected ProvisionedThroughputExceededException, got %v", err)
+	}
+
+	// Other tables are not throttled.
+	_, err = client.GetItem(ctx, &dynamodb.GetItemInput{TableName: aws.String(tableName + "-missing"), Key: item}, noRetry)
+	assertAPIErrorCode(t, err, "ResourceNotFoundException")
+
+	// Removing the rules lets the write through.
+	deleteFaultRules(t)
+
+	if _, err := client.PutItem(ctx, &dynamodb.PutItemInput{TableName: aws.String(tableName), Item: item}, noRetry); err != nil {
+		t.Errorf("expected PutItem to succeed without fault rules: %v", err)
+	}
+}
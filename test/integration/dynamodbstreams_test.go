@@ -0,0 +1,155 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams"
+	streamstypes "github.com/aws/aws-sdk-go-v2/service/dynamodbstreams/types"
+)
+
+func newDynamoDBStreamsClient(t *testing.T) *dynamodbstreams.Client {
+	t.Helper()
+
+	cfg, err := config.LoadDefaultConfig(t.Context(),
+		config.WithRegion("us-east-1"),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			"test", "test", "",
+		)),
+	)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	return dynamodbstreams.NewFromConfig(cfg, func(o *dynamodbstreams.Options) {
+		o.BaseEndpoint = aws.String("http://localhost:4566")
+	})
+}
+
+//nolint:funlen // Integration test covers the full stream lifecycle.
+func TestDynamoDBStreams_GetRecords(t *testing.T) {
+	client := newDynamoDBClient(t)
+	streamsClient := newDynamoDBStreamsClient(t)
+	ctx := t.Context()
+	tableName := "test-table-streams"
+
+	createOutput, err := client.CreateTable(ctx, &dynamodb.CreateTableInput{
+		TableName: aws.String(tableName),
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String("pk"), KeyType: types.KeyTypeHash},
+		},
+		AttributeDefinitions: []types.AttributeDefinition{
+			{AttributeName: aws.String("pk"), AttributeType: types.ScalarAttributeTypeS},
+		},
+		BillingMode: types.BillingModePayPerRequest,
+		StreamSpecification: &types.StreamSpecification{
+			StreamEnabled:  aws.Bool(true),
+			StreamViewType: types.StreamViewTypeNewAndOldImages,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteTable(context.Background(), &dynamodb.DeleteTableInput{TableName: aws.String(tableName)})
+	})
+
+	streamARN := createOutput.TableDescription.LatestStreamArn
+	if streamARN == nil {
+		t.Fatal("expected LatestStreamArn to be set")
+	}
+
+	_, err = client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(tableName),
+		Item: map[string]types.AttributeValue{
+			"pk":    &types.AttributeValueMemberS{Value: "user#1"},
+			"state": &types.AttributeValueMemberS{Value: "new"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to put item: %v", err)
+	}
+
+	_, err = client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(tableName),
+		Key:                       map[string]types.AttributeValue{"pk": &types.AttributeValueMemberS{Value: "user#1"}},
+		UpdateExpression:          aws.String("SET #s = :s"),
+		ExpressionAttributeNames:  map[string]string{"#s": "state"},
+		ExpressionAttributeValues: map[string]types.AttributeValue{":s": &types.AttributeValueMemberS{Value: "active"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to update item: %v", err)
+	}
+
+	_, err = client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(tableName),
+		Key:       map[string]types.AttributeValue{"pk": &types.AttributeValueMemberS{Value: "user#1"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to delete item: %v", err)
+	}
+
+	listOutput, err := streamsClient.ListStreams(ctx, &dynamodbstreams.ListStreamsInput{TableName: aws.String(tableName)})
+	if err != nil {
+		t.Fatalf("failed to list streams: %v", err)
+	}
+
+	if len(listOutput.Streams) != 1 || *listOutput.Streams[0].StreamArn != *streamARN {
+		t.Fatalf("expected stream %s, got %+v", *streamARN, listOutput.Streams)
+	}
+
+	describeOutput, err := streamsClient.DescribeStream(ctx, &dynamodbstreams.DescribeStreamInput{StreamArn: streamARN})
+	if err != nil {
+		t.Fatalf("failed to describe stream: %v", err)
+	}
+
+	desc := describeOutput.StreamDescription
+	if desc.StreamStatus != streamstypes.StreamStatusEnabled || len(desc.Shards) != 1 {
+		t.Fatalf("expected an enabled stream with one shard, got %+v", desc)
+	}
+
+	iteratorOutput, err := streamsClient.GetShardIterator(ctx, &dynamodbstreams.GetShardIteratorInput{
+		StreamArn:         streamARN,
+		ShardId:           desc.Shards[0].ShardId,
+		ShardIteratorType: streamstypes.ShardIteratorTypeTrimHorizon,
+	})
+	if err != nil {
+		t.Fatalf("failed to get shard iterator: %v", err)
+	}
+
+	recordsOutput, err := streamsClient.GetRecords(ctx, &dynamodbstreams.GetRecordsInput{ShardIterator: iteratorOutput.ShardIterator})
+	if err != nil {
+		t.Fatalf("failed to get records: %v", err)
+	}
+
+	want := []streamstypes.OperationType{streamstypes.OperationTypeInsert, streamstypes.OperationTypeModify, streamstypes.OperationTypeRemove}
+	if len(recordsOutput.Records) != len(want) {
+		t.Fatalf("expected %d records, got %d", len(want), len(recordsOutput.Records))
+	}
+
+	for i, record := range recordsOutput.Records {
+		if record.EventName != want[i] {
+			t.Errorf("record %d: expected %s, got %s", i, want[i], record.EventName)
+		}
+	}
+
+	modify := recordsOutput.Records[1].Dynamodb
+	oldState, _ := modify.OldImage["state"].(*streamstypes.AttributeValueMemberS)
+	newState, _ := modify.NewImage["state"].(*streamstypes.AttributeValueMemberS)
+
+	if oldState == nil || oldState.Value != "new" || newState == nil || newState.Value != "active" {
+		t.Errorf("expected state to change from new to active, got %+v", modify)
+	}
+
+	if recordsOutput.NextShardIterator == nil {
+		t.Error("expected a next shard iterator")
+	}
+}
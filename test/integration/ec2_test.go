@@ -5,6 +5,7 @@ package integration
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -264,7 +265,69 @@ func TestEC2_DescribeKeyPairs(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	golden.New(t, golden.WithIgnoreFields("KeyPairId", "KeyFingerprint", "ResultMetadata")).Assert(t.Name(), descResult)
+	golden.New(t, golden.WithIgnoreFields("KeyPairId", "KeyFingerprint", "CreateTime", "ResultMetadata")).Assert(t.Name(), descResult)
+}
+
+// testEC2PublicKey is an ED25519 public key in the OpenSSH authorized_keys format.
+const testEC2PublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOs5KiNXDiSJWamX2KKUxxmYN4w92t0PRNTRsPVJkRzb"
+
+func TestEC2_ImportKeyPair(t *testing.T) {
+	client := newEC2Client(t)
+	ctx := t.Context()
+	keyName := "test-import-key-pair"
+
+	importResult, err := client.ImportKeyPair(ctx, &ec2.ImportKeyPairInput{
+		KeyName:           aws.String(keyName),
+		PublicKeyMaterial: []byte(testEC2PublicKey),
+		TagSpecifications: []types.TagSpecification{{
+			ResourceType: types.ResourceTypeKeyPair,
+			Tags:         []types.Tag{{Key: aws.String("team"), Value: aws.String("platform")}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, golden.WithIgnoreFields("KeyPairId", "ResultMetadata")).Assert(t.Name()+"_import", importResult)
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteKeyPair(context.Background(), &ec2.DeleteKeyPairInput{
+			KeyName: aws.String(keyName),
+		})
+	})
+
+	descResult, err := client.DescribeKeyPairs(ctx, &ec2.DescribeKeyPairsInput{
+		Filters: []types.Filter{
+			{Name: aws.String("key-type"), Values: []string{"ed25519"}},
+			{Name: aws.String("tag:team"), Values: []string{"platform"}},
+		},
+		IncludePublicKey: aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, golden.WithIgnoreFields("KeyPairId", "CreateTime", "ResultMetadata")).Assert(t.Name()+"_describe", descResult)
+
+	// Importing the same name again is rejected.
+	_, err = client.ImportKeyPair(ctx, &ec2.ImportKeyPairInput{
+		KeyName:           aws.String(keyName),
+		PublicKeyMaterial: []byte(testEC2PublicKey),
+	})
+
+	assertAPIErrorCode(t, err, "InvalidKeyPair.Duplicate")
+}
+
+func TestEC2_RunInstancesWithMissingKeyPair(t *testing.T) {
+	client := newEC2Client(t)
+
+	_, err := client.RunInstances(t.Context(), &ec2.RunInstancesInput{
+		ImageId:      aws.String("ami-12345678"),
+		InstanceType: types.InstanceTypeT2Micro,
+		MinCount:     aws.Int32(1),
+		MaxCount:     aws.Int32(1),
+		KeyName:      aws.String("no-such-key-pair"),
+	})
+
+	assertAPIErrorCode(t, err, "InvalidKeyPair.NotFound")
 }
 
 func TestEC2_CreateAndDeleteVpc(t *testing.T) {
@@ -547,6 +610,134 @@ func TestEC2_CreateRoute(t *testing.T) {
 	golden.New(t, golden.WithIgnoreFields("RouteTableId", "VpcId", "OwnerId", "GatewayId", "ResultMetadata")).Assert(t.Name()+"_describe", descResult)
 }
 
+//nolint:funlen // Test function exercises a whole VPC network layout.
+func TestEC2_VpcNetworking(t *testing.T) {
+	client := newEC2Client(t)
+	ctx := t.Context()
+	ignore := golden.WithIgnoreFields("VpcId", "SubnetId", "SubnetArn", "RouteTableId", "RouteTableAssociationId", "OwnerId", "ResultMetadata")
+
+	vpcResult, err := client.CreateVpc(ctx, &ec2.CreateVpcInput{
+		CidrBlock: aws.String("10.1.0.0/16"),
+		TagSpecifications: []types.TagSpecification{{
+			ResourceType: types.ResourceTypeVpc,
+			Tags:         []types.Tag{{Key: aws.String("Name"), Value: aws.String("networking")}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vpcID := *vpcResult.Vpc.VpcId
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteVpc(context.Background(), &ec2.DeleteVpcInput{VpcId: aws.String(vpcID)})
+	})
+
+	// The VPC comes with a main route table routing the VPC CIDR block locally.
+	mainResult, err := client.DescribeRouteTables(ctx, &ec2.DescribeRouteTablesInput{
+		Filters: []types.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpcID}},
+			{Name: aws.String("association.main"), Values: []string{"true"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, ignore).Assert(t.Name()+"_main_route_table", mainResult)
+
+	subnetIDs := make(map[string]string)
+
+	for tier, cidr := range map[string]string{"public": "10.1.1.0/24", "private": "10.1.2.0/24"} {
+		subnetResult, err := client.CreateSubnet(ctx, &ec2.CreateSubnetInput{
+			VpcId:     aws.String(vpcID),
+			CidrBlock: aws.String(cidr),
+			TagSpecifications: []types.TagSpecification{{
+				ResourceType: types.ResourceTypeSubnet,
+				Tags:         []types.Tag{{Key: aws.String("tier"), Value: aws.String(tier)}},
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		subnetIDs[tier] = *subnetResult.Subnet.SubnetId
+	}
+
+	_, err = client.CreateSubnet(ctx, &ec2.CreateSubnetInput{VpcId: aws.String(vpcID), CidrBlock: aws.String("10.2.0.0/24")})
+	assertAPIErrorCode(t, err, "InvalidSubnet.Range")
+
+	_, err = client.CreateSubnet(ctx, &ec2.CreateSubnetInput{VpcId: aws.String(vpcID), CidrBlock: aws.String("10.1.1.128/25")})
+	assertAPIErrorCode(t, err, "InvalidSubnet.Conflict")
+
+	subnetsResult, err := client.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{
+		Filters: []types.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpcID}},
+			{Name: aws.String("tag:tier"), Values: []string{"public"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, ignore).Assert(t.Name()+"_public_subnets", subnetsResult)
+
+	rtResult, err := client.CreateRouteTable(ctx, &ec2.CreateRouteTableInput{
+		VpcId: aws.String(vpcID),
+		TagSpecifications: []types.TagSpecification{{
+			ResourceType: types.ResourceTypeRouteTable,
+			Tags:         []types.Tag{{Key: aws.String("tier"), Value: aws.String("public")}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rtID := *rtResult.RouteTable.RouteTableId
+
+	assocResult, err := client.AssociateRouteTable(ctx, &ec2.AssociateRouteTableInput{
+		RouteTableId: aws.String(rtID),
+		SubnetId:     aws.String(subnetIDs["public"]),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.AssociateRouteTable(ctx, &ec2.AssociateRouteTableInput{
+		RouteTableId: aws.String(rtID),
+		SubnetId:     aws.String(subnetIDs["public"]),
+	})
+	assertAPIErrorCode(t, err, "Resource.AlreadyAssociated")
+
+	associatedResult, err := client.DescribeRouteTables(ctx, &ec2.DescribeRouteTablesInput{
+		Filters: []types.Filter{{Name: aws.String("association.subnet-id"), Values: []string{subnetIDs["public"]}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, ignore).Assert(t.Name()+"_associated_route_table", associatedResult)
+
+	// The VPC cannot be deleted while its subnets and route tables remain.
+	_, err = client.DeleteVpc(ctx, &ec2.DeleteVpcInput{VpcId: aws.String(vpcID)})
+	assertAPIErrorCode(t, err, "DependencyViolation")
+
+	if _, err := client.DisassociateRouteTable(ctx, &ec2.DisassociateRouteTableInput{AssociationId: assocResult.AssociationId}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.DeleteRouteTable(ctx, &ec2.DeleteRouteTableInput{RouteTableId: aws.String(rtID)}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, subnetID := range subnetIDs {
+		if _, err := client.DeleteSubnet(ctx, &ec2.DeleteSubnetInput{SubnetId: aws.String(subnetID)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := client.DeleteVpc(ctx, &ec2.DeleteVpcInput{VpcId: aws.String(vpcID)}); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestEC2_CreateNatGateway(t *testing.T) {
 	client := newEC2Client(t)
 	ctx := t.Context()
@@ -602,3 +793,198 @@ func TestEC2_CreateNatGateway(t *testing.T) {
 	}
 	golden.New(t, golden.WithIgnoreFields("NatGatewayId", "SubnetId", "VpcId", "CreateTime", "ResultMetadata")).Assert(t.Name()+"_describe", descResult)
 }
+
+//nolint:funlen // Test function exercises the whole lifecycle of a volume.
+func TestEC2_VolumeLifecycle(t *testing.T) {
+	client := newEC2Client(t)
+	ctx := t.Context()
+	ignore := golden.WithIgnoreFields("VolumeId", "InstanceId", "CreateTime", "AttachTime", "ResultMetadata")
+
+	createResult, err := client.CreateVolume(ctx, &ec2.CreateVolumeInput{
+		AvailabilityZone: aws.String("us-east-1a"),
+		Size:             aws.Int32(20),
+		VolumeType:       types.VolumeTypeGp3,
+		TagSpecifications: []types.TagSpecification{{
+			ResourceType: types.ResourceTypeVolume,
+			Tags:         []types.Tag{{Key: aws.String("Name"), Value: aws.String("data")}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, ignore).Assert(t.Name()+"_create", createResult)
+
+	volumeID := *createResult.VolumeId
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteVolume(context.Background(), &ec2.DeleteVolumeInput{VolumeId: aws.String(volumeID)})
+	})
+
+	waitOpts := func(o *ec2.VolumeAvailableWaiterOptions) {
+		o.MinDelay = 100 * time.Millisecond
+		o.MaxDelay = 200 * time.Millisecond
+	}
+
+	if err := ec2.NewVolumeAvailableWaiter(client, waitOpts).Wait(ctx, &ec2.DescribeVolumesInput{VolumeIds: []string{volumeID}}, 10*time.Second); err != nil {
+		t.Fatalf("volume did not become available: %v", err)
+	}
+
+	runResult, err := client.RunInstances(ctx, &ec2.RunInstancesInput{
+		ImageId:      aws.String("ami-12345678"),
+		InstanceType: types.InstanceTypeT2Micro,
+		MinCount:     aws.Int32(1),
+		MaxCount:     aws.Int32(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	instanceID := *runResult.Instances[0].InstanceId
+
+	t.Cleanup(func() {
+		_, _ = client.TerminateInstances(context.Background(), &ec2.TerminateInstancesInput{InstanceIds: []string{instanceID}})
+	})
+
+	attachResult, err := client.AttachVolume(ctx, &ec2.AttachVolumeInput{
+		VolumeId:   aws.String(volumeID),
+		InstanceId: aws.String(instanceID),
+		Device:     aws.String("/dev/sdf"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, ignore).Assert(t.Name()+"_attach", attachResult)
+
+	if _, err := client.DeleteVolume(ctx, &ec2.DeleteVolumeInput{VolumeId: aws.String(volumeID)}); err == nil {
+		t.Error("expected an error deleting an in-use volume")
+	}
+
+	describeInput := &ec2.DescribeVolumesInput{
+		Filters: []types.Filter{
+			{Name: aws.String("attachment.instance-id"), Values: []string{instanceID}},
+			{Name: aws.String("attachment.status"), Values: []string{"attached"}},
+		},
+	}
+
+	if err := ec2.NewVolumeInUseWaiter(client, func(o *ec2.VolumeInUseWaiterOptions) {
+		o.MinDelay = 100 * time.Millisecond
+		o.MaxDelay = 200 * time.Millisecond
+	}).Wait(ctx, describeInput, 10*time.Second); err != nil {
+		t.Fatalf("volume did not become attached: %v", err)
+	}
+
+	descResult, err := client.DescribeVolumes(ctx, describeInput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, ignore).Assert(t.Name()+"_describe", descResult)
+
+	detachResult, err := client.DetachVolume(ctx, &ec2.DetachVolumeInput{VolumeId: aws.String(volumeID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, ignore).Assert(t.Name()+"_detach", detachResult)
+
+	if err := ec2.NewVolumeAvailableWaiter(client, waitOpts).Wait(ctx, &ec2.DescribeVolumesInput{VolumeIds: []string{volumeID}}, 10*time.Second); err != nil {
+		t.Fatalf("volume did not become available after detaching: %v", err)
+	}
+
+	if _, err := client.DeleteVolume(ctx, &ec2.DeleteVolumeInput{VolumeId: aws.String(volumeID)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{VolumeIds: []string{volumeID}}); err == nil {
+		t.Error("expected an error describing a deleted volume")
+	}
+}
+
+//nolint:funlen // Test function exercises the whole Elastic IP lifecycle.
+func TestEC2_ElasticIP(t *testing.T) {
+	client := newEC2Client(t)
+	ctx := t.Context()
+	ignore := golden.WithIgnoreFields("PublicIp", "AllocationId", "AssociationId", "InstanceId", "PrivateIpAddress", "ResultMetadata")
+
+	allocResult, err := client.AllocateAddress(ctx, &ec2.AllocateAddressInput{
+		Domain: types.DomainTypeVpc,
+		TagSpecifications: []types.TagSpecification{{
+			ResourceType: types.ResourceTypeElasticIp,
+			Tags:         []types.Tag{{Key: aws.String("Name"), Value: aws.String("web")}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, ignore).Assert(t.Name()+"_allocate", allocResult)
+
+	allocationID := *allocResult.AllocationId
+	publicIP := *allocResult.PublicIp
+
+	t.Cleanup(func() {
+		_, _ = client.ReleaseAddress(context.Background(), &ec2.ReleaseAddressInput{AllocationId: aws.String(allocationID)})
+	})
+
+	runResult, err := client.RunInstances(ctx, &ec2.RunInstancesInput{
+		ImageId:      aws.String("ami-12345678"),
+		InstanceType: types.InstanceTypeT2Micro,
+		MinCount:     aws.Int32(1),
+		MaxCount:     aws.Int32(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	instanceID := *runResult.Instances[0].InstanceId
+
+	t.Cleanup(func() {
+		_, _ = client.TerminateInstances(context.Background(), &ec2.TerminateInstancesInput{InstanceIds: []string{instanceID}})
+	})
+
+	associateResult, err := client.AssociateAddress(ctx, &ec2.AssociateAddressInput{
+		AllocationId: aws.String(allocationID),
+		InstanceId:   aws.String(instanceID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, ignore).Assert(t.Name()+"_associate", associateResult)
+
+	descResult, err := client.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{
+		Filters: []types.Filter{{Name: aws.String("instance-id"), Values: []string{instanceID}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, ignore).Assert(t.Name()+"_describe", descResult)
+
+	instances, err := client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{InstanceIds: []string{instanceID}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := aws.ToString(instances.Reservations[0].Instances[0].PublicIpAddress); got != publicIP {
+		t.Errorf("expected the instance public IP to be %s, got %s", publicIP, got)
+	}
+
+	_, err = client.ReleaseAddress(ctx, &ec2.ReleaseAddressInput{AllocationId: aws.String(allocationID)})
+	assertAPIErrorCode(t, err, "InvalidIPAddress.InUse")
+
+	if _, err := client.DisassociateAddress(ctx, &ec2.DisassociateAddressInput{AssociationId: associateResult.AssociationId}); err != nil {
+		t.Fatal(err)
+	}
+
+	instances, err = client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{InstanceIds: []string{instanceID}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := aws.ToString(instances.Reservations[0].Instances[0].PublicIpAddress); got == publicIP {
+		t.Errorf("expected the instance to lose the Elastic IP %s", publicIP)
+	}
+
+	if _, err := client.ReleaseAddress(ctx, &ec2.ReleaseAddressInput{AllocationId: aws.String(allocationID)}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{AllocationIds: []string{allocationID}})
+	assertAPIErrorCode(t, err, "InvalidAllocationID.NotFound")
+}
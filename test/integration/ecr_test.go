@@ -3,7 +3,17 @@
 package integration
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -229,3 +239,322 @@ func TestECR_RepositoryNotFound(t *testing.T) {
 		t.Fatal("expected error for non-existent repository")
 	}
 }
+
+func TestECR_LifecyclePolicy(t *testing.T) {
+	client := newECRClient(t)
+	ctx := t.Context()
+
+	repoName := "test-lifecycle-repository"
+
+	_, err := client.CreateRepository(ctx, &ecr.CreateRepositoryInput{
+		RepositoryName: aws.String(repoName),
+	})
+	if err != nil {
+		t.Fatalf("failed to create repository: %v", err)
+	}
+
+	// Push three release images and two untagged images, oldest first.
+	images := []struct {
+		manifest string
+		tag      string
+	}{
+		{`{"schemaVersion": 2, "config": {"digest": "sha256:release1"}}`, "release-1"},
+		{`{"schemaVersion": 2, "config": {"digest": "sha256:untagged1"}}`, ""},
+		{`{"schemaVersion": 2, "config": {"digest": "sha256:release2"}}`, "release-2"},
+		{`{"schemaVersion": 2, "config": {"digest": "sha256:untagged2"}}`, ""},
+		{`{"schemaVersion": 2, "config": {"digest": "sha256:release3"}}`, "release-3"},
+	}
+
+	digests := make([]string, len(images))
+
+	for i, img := range images {
+		input := &ecr.PutImageInput{
+			RepositoryName: aws.String(repoName),
+			ImageManifest:  aws.String(img.manifest),
+		}
+		if img.tag != "" {
+			input.ImageTag = aws.String(img.tag)
+		}
+
+		out, err := client.PutImage(ctx, input)
+		if err != nil {
+			t.Fatalf("failed to put image %d: %v", i, err)
+		}
+
+		digests[i] = aws.ToString(out.Image.ImageId.ImageDigest)
+	}
+
+	// An invalid policy is rejected.
+	_, err = client.PutLifecyclePolicy(ctx, &ecr.PutLifecyclePolicyInput{
+		RepositoryName:      aws.String(repoName),
+		LifecyclePolicyText: aws.String(`{"rules":[{"rulePriority":1,"selection":{"tagStatus":"tagged","countType":"imageCountMoreThan","countNumber":2},"action":{"type":"expire"}}]}`),
+	})
+
+	var invalidErr *types.InvalidParameterException
+	if !errors.As(err, &invalidErr) {
+		t.Fatalf("expected InvalidParameterException for a tagged rule without tagPrefixList, got %v", err)
+	}
+
+	policyText := `{"rules":[` +
+		`{"rulePriority":1,"description":"keep two releases","selection":{"tagStatus":"tagged","tagPrefixList":["release"],"countType":"imageCountMoreThan","countNumber":2},"action":{"type":"expire"}},` +
+		`{"rulePriority":2,"description":"keep one untagged image","selection":{"tagStatus":"untagged","countType":"imageCountMoreThan","countNumber":1},"action":{"type":"expire"}}` +
+		`]}`
+
+	putOutput, err := client.PutLifecyclePolicy(ctx, &ecr.PutLifecyclePolicyInput{
+		RepositoryName:      aws.String(repoName),
+		LifecyclePolicyText: aws.String(policyText),
+	})
+	if err != nil {
+		t.Fatalf("failed to put lifecycle policy: %v", err)
+	}
+
+	if aws.ToString(putOutput.LifecyclePolicyText) != policyText {
+		t.Errorf("unexpected policy text in put response: %s", aws.ToString(putOutput.LifecyclePolicyText))
+	}
+
+	getOutput, err := client.GetLifecyclePolicy(ctx, &ecr.GetLifecyclePolicyInput{
+		RepositoryName: aws.String(repoName),
+	})
+	if err != nil {
+		t.Fatalf("failed to get lifecycle policy: %v", err)
+	}
+
+	if aws.ToString(getOutput.LifecyclePolicyText) != policyText {
+		t.Errorf("unexpected policy text: %s", aws.ToString(getOutput.LifecyclePolicyText))
+	}
+
+	// Preview the stored policy.
+	startOutput, err := client.StartLifecyclePolicyPreview(ctx, &ecr.StartLifecyclePolicyPreviewInput{
+		RepositoryName: aws.String(repoName),
+	})
+	if err != nil {
+		t.Fatalf("failed to start lifecycle policy preview: %v", err)
+	}
+
+	if startOutput.Status != types.LifecyclePolicyPreviewStatusInProgress {
+		t.Errorf("expected IN_PROGRESS, got %s", startOutput.Status)
+	}
+
+	previewOutput, err := client.GetLifecyclePolicyPreview(ctx, &ecr.GetLifecyclePolicyPreviewInput{
+		RepositoryName: aws.String(repoName),
+	})
+	if err != nil {
+		t.Fatalf("failed to get lifecycle policy preview: %v", err)
+	}
+
+	if previewOutput.Status != types.LifecyclePolicyPreviewStatusComplete {
+		t.Errorf("expected COMPLETE, got %s", previewOutput.Status)
+	}
+
+	if previewOutput.Summary == nil || aws.ToInt32(previewOutput.Summary.ExpiringImageTotalCount) != 2 {
+		t.Fatalf("expected 2 expiring images, got %+v", previewOutput.Summary)
+	}
+
+	// The oldest release is expired by rule 1 and the older untagged image by rule 2.
+	expected := map[string]int32{digests[0]: 1, digests[1]: 2}
+
+	for _, result := range previewOutput.PreviewResults {
+		priority, ok := expected[aws.ToString(result.ImageDigest)]
+		if !ok {
+			t.Errorf("unexpected image in preview: %s %v", aws.ToString(result.ImageDigest), result.ImageTags)
+
+			continue
+		}
+
+		if aws.ToInt32(result.AppliedRulePriority) != priority {
+			t.Errorf("expected rule %d for %s, got %d", priority, aws.ToString(result.ImageDigest), aws.ToInt32(result.AppliedRulePriority))
+		}
+
+		if result.Action == nil || result.Action.Type != types.ImageActionTypeExpire {
+			t.Errorf("expected EXPIRE action, got %+v", result.Action)
+		}
+	}
+
+	if len(previewOutput.PreviewResults) != len(expected) {
+		t.Errorf("expected %d preview results, got %d", len(expected), len(previewOutput.PreviewResults))
+	}
+
+	// Delete the policy.
+	_, err = client.DeleteLifecyclePolicy(ctx, &ecr.DeleteLifecyclePolicyInput{
+		RepositoryName: aws.String(repoName),
+	})
+	if err != nil {
+		t.Fatalf("failed to delete lifecycle policy: %v", err)
+	}
+
+	_, err = client.GetLifecyclePolicy(ctx, &ecr.GetLifecyclePolicyInput{
+		RepositoryName: aws.String(repoName),
+	})
+
+	var notFoundErr *types.LifecyclePolicyNotFoundException
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected LifecyclePolicyNotFoundException, got %v", err)
+	}
+
+	_, err = client.DeleteRepository(ctx, &ecr.DeleteRepositoryInput{
+		RepositoryName: aws.String(repoName),
+		Force:          true,
+	})
+	if err != nil {
+		t.Fatalf("failed to delete repository: %v", err)
+	}
+}
+
+func TestECR_RegistryPush(t *testing.T) {
+	client := newECRClient(t)
+	ctx := t.Context()
+
+	repoName := "team/registry-push"
+
+	_, err := client.CreateRepository(ctx, &ecr.CreateRepositoryInput{
+		RepositoryName: aws.String(repoName),
+	})
+	if err != nil {
+		t.Fatalf("failed to create repository: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteRepository(context.Background(), &ecr.DeleteRepositoryInput{
+			RepositoryName: aws.String(repoName),
+			Force:          true,
+		})
+	})
+
+	tokenOutput, err := client.GetAuthorizationToken(ctx, &ecr.GetAuthorizationTokenInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authData := tokenOutput.AuthorizationData[0]
+	if authData.ExpiresAt == nil || !authData.ExpiresAt.After(time.Now()) {
+		t.Errorf("expected the token to expire in the future, got %v", authData.ExpiresAt)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(aws.ToString(authData.AuthorizationToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	username, password, ok := strings.Cut(string(decoded), ":")
+	if !ok || username != "AWS" {
+		t.Fatalf("expected an AWS:<password> token, got %q", decoded)
+	}
+
+	endpoint := aws.ToString(authData.ProxyEndpoint)
+
+	registry := func(method, path string, body []byte, user, pass string) *http.Response {
+		t.Helper()
+
+		req, err := http.NewRequestWithContext(ctx, method, endpoint+path, bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if user != "" {
+			req.SetBasicAuth(user, pass)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Cleanup(func() { _ = resp.Body.Close() })
+
+		return resp
+	}
+
+	// docker login pings /v2/ with the token's credentials.
+	if resp := registry(http.MethodGet, "/v2/", nil, "", ""); resp.StatusCode != http.StatusUnauthorized || resp.Header.Get("WWW-Authenticate") == "" {
+		t.Errorf("expected an unauthenticated ping to be challenged, got status %d", resp.StatusCode)
+	}
+
+	if resp := registry(http.MethodGet, "/v2/", nil, "AWS", "wrong"); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected a wrong password to be rejected, got status %d", resp.StatusCode)
+	}
+
+	if resp := registry(http.MethodGet, "/v2/", nil, username, password); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the token to be accepted, got status %d", resp.StatusCode)
+	}
+
+	// Push the config in one request and the layer in chunks.
+	imageConfig := []byte(`{"architecture":"amd64","os":"linux"}`)
+	configDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(imageConfig))
+
+	resp := registry(http.MethodPost, "/v2/"+repoName+"/blobs/uploads/?digest="+configDigest, imageConfig, username, password)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected the config to be created, got status %d", resp.StatusCode)
+	}
+
+	layer := []byte("layer contents")
+	layerDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(layer))
+
+	resp = registry(http.MethodPost, "/v2/"+repoName+"/blobs/uploads/", nil, username, password)
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected the upload to start, got status %d", resp.StatusCode)
+	}
+
+	uploadURL := resp.Header.Get("Location")
+
+	resp = registry(http.MethodPatch, uploadURL, layer[:5], username, password)
+	if resp.StatusCode != http.StatusAccepted || resp.Header.Get("Range") != "0-4" {
+		t.Fatalf("expected the chunk to be accepted, got status %d and range %q", resp.StatusCode, resp.Header.Get("Range"))
+	}
+
+	resp = registry(http.MethodPut, uploadURL+"?digest="+layerDigest, layer[5:], username, password)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected the layer to be created, got status %d", resp.StatusCode)
+	}
+
+	// A manifest is only accepted once the blobs it references are pushed.
+	missing := fmt.Sprintf(`{"schemaVersion":2,"mediaType":"application/vnd.docker.distribution.manifest.v2+json",`+
+		`"config":{"digest":%q},"layers":[{"digest":"sha256:%064d"}]}`, configDigest, 0)
+	if resp := registry(http.MethodPut, "/v2/"+repoName+"/manifests/latest", []byte(missing), username, password); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected a manifest with a missing layer to be rejected, got status %d", resp.StatusCode)
+	}
+
+	manifest := fmt.Sprintf(`{"schemaVersion":2,"mediaType":"application/vnd.docker.distribution.manifest.v2+json",`+
+		`"config":{"digest":%q},"layers":[{"digest":%q}]}`, configDigest, layerDigest)
+	manifestDigest := fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(manifest)))
+
+	resp = registry(http.MethodPut, "/v2/"+repoName+"/manifests/latest", []byte(manifest), username, password)
+	if resp.StatusCode != http.StatusCreated || resp.Header.Get("Docker-Content-Digest") != manifestDigest {
+		t.Fatalf("expected the manifest to be created with digest %s, got status %d and digest %q",
+			manifestDigest, resp.StatusCode, resp.Header.Get("Docker-Content-Digest"))
+	}
+
+	// The pushed image can be pulled and is visible through the ECR API.
+	resp = registry(http.MethodGet, "/v2/"+repoName+"/manifests/latest", nil, username, password)
+
+	pulled, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusOK || string(pulled) != manifest {
+		t.Errorf("expected to pull the pushed manifest, got status %d and %s", resp.StatusCode, pulled)
+	}
+
+	resp = registry(http.MethodGet, "/v2/"+repoName+"/blobs/"+layerDigest, nil, username, password)
+
+	pulled, err = io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(pulled, layer) {
+		t.Errorf("expected to pull the pushed layer, got %q", pulled)
+	}
+
+	imagesOutput, err := client.BatchGetImage(ctx, &ecr.BatchGetImageInput{
+		RepositoryName: aws.String(repoName),
+		ImageIds:       []types.ImageIdentifier{{ImageTag: aws.String("latest")}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(imagesOutput.Images) != 1 || aws.ToString(imagesOutput.Images[0].ImageId.ImageDigest) != manifestDigest {
+		t.Errorf("expected image %s, got %+v", manifestDigest, imagesOutput.Images)
+	}
+}
@@ -4,6 +4,8 @@ package integration
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -508,3 +510,121 @@ func TestECS_TaskDefinitionRevision(t *testing.T) {
 		})
 	}
 }
+
+func TestECS_DescribeAndListTaskDefinitions(t *testing.T) {
+	client := newECSClient(t)
+	ctx := t.Context()
+	family := "test-task-lifecycle"
+
+	register := func(image string) *types.TaskDefinition {
+		t.Helper()
+
+		output, err := client.RegisterTaskDefinition(ctx, &ecs.RegisterTaskDefinitionInput{
+			Family: aws.String(family),
+			ContainerDefinitions: []types.ContainerDefinition{
+				{
+					Name:      aws.String("app"),
+					Image:     aws.String(image),
+					Essential: aws.Bool(true),
+					Memory:    aws.Int32(256),
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return output.TaskDefinition
+	}
+
+	first := register("nginx:1.0")
+	second := register("nginx:2.0")
+
+	if second.Revision != first.Revision+1 {
+		t.Fatalf("expected revision %d, got %d", first.Revision+1, second.Revision)
+	}
+
+	describe := func(taskDefinition string) *types.TaskDefinition {
+		t.Helper()
+
+		output, err := client.DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{
+			TaskDefinition: aws.String(taskDefinition),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return output.TaskDefinition
+	}
+
+	// A bare family resolves to the latest revision; family:revision and ARN pin one.
+	if got := describe(family); got.Revision != second.Revision {
+		t.Errorf("expected family to resolve to revision %d, got %d", second.Revision, got.Revision)
+	}
+
+	firstRef := fmt.Sprintf("%s:%d", family, first.Revision)
+	if got := describe(firstRef); aws.ToString(got.ContainerDefinitions[0].Image) != "nginx:1.0" {
+		t.Errorf("expected %s to describe nginx:1.0, got %s", firstRef, aws.ToString(got.ContainerDefinitions[0].Image))
+	}
+
+	if got := describe(aws.ToString(second.TaskDefinitionArn)); got.Revision != second.Revision {
+		t.Errorf("expected ARN to resolve to revision %d, got %d", second.Revision, got.Revision)
+	}
+
+	// A bare family cannot be deregistered.
+	_, err := client.DeregisterTaskDefinition(ctx, &ecs.DeregisterTaskDefinitionInput{
+		TaskDefinition: aws.String(family),
+	})
+	if err == nil {
+		t.Error("expected an error deregistering a task definition without a revision")
+	}
+
+	deregisterOutput, err := client.DeregisterTaskDefinition(ctx, &ecs.DeregisterTaskDefinitionInput{
+		TaskDefinition: second.TaskDefinitionArn,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if deregisterOutput.TaskDefinition.Status != types.TaskDefinitionStatusInactive || deregisterOutput.TaskDefinition.DeregisteredAt == nil {
+		t.Errorf("expected an INACTIVE task definition with deregisteredAt, got %s", deregisterOutput.TaskDefinition.Status)
+	}
+
+	// The INACTIVE revision stays describable, but the family now resolves to the previous one.
+	if got := describe(aws.ToString(second.TaskDefinitionArn)); got.Status != types.TaskDefinitionStatusInactive {
+		t.Errorf("expected deregistered revision to be INACTIVE, got %s", got.Status)
+	}
+
+	if got := describe(family); got.Revision != first.Revision {
+		t.Errorf("expected family to resolve to revision %d, got %d", first.Revision, got.Revision)
+	}
+
+	activeOutput, err := client.ListTaskDefinitions(ctx, &ecs.ListTaskDefinitionsInput{
+		FamilyPrefix: aws.String(family),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Contains(activeOutput.TaskDefinitionArns, aws.ToString(first.TaskDefinitionArn)) ||
+		slices.Contains(activeOutput.TaskDefinitionArns, aws.ToString(second.TaskDefinitionArn)) {
+		t.Errorf("unexpected ACTIVE task definitions %v", activeOutput.TaskDefinitionArns)
+	}
+
+	inactiveOutput, err := client.ListTaskDefinitions(ctx, &ecs.ListTaskDefinitionsInput{
+		FamilyPrefix: aws.String(family),
+		Status:       types.TaskDefinitionStatusInactive,
+		Sort:         types.SortOrderDesc,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(inactiveOutput.TaskDefinitionArns) == 0 || inactiveOutput.TaskDefinitionArns[0] != aws.ToString(second.TaskDefinitionArn) {
+		t.Errorf("unexpected INACTIVE task definitions %v", inactiveOutput.TaskDefinitionArns)
+	}
+
+	_, _ = client.DeregisterTaskDefinition(ctx, &ecs.DeregisterTaskDefinitionInput{
+		TaskDefinition: first.TaskDefinitionArn,
+	})
+}
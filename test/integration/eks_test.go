@@ -4,7 +4,9 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -50,6 +52,8 @@ func TestEKS_CreateAndDeleteCluster(t *testing.T) {
 	}
 	golden.New(t, golden.WithIgnoreFields("Arn", "Endpoint", "CreatedAt", "ClusterSecurityGroupId", "Issuer", "VpcId", "ResultMetadata")).Assert(t.Name()+"_create", createResult)
 
+	waitForEKSClusterActive(ctx, t, client, clusterName)
+
 	// Delete cluster
 	deleteResult, err := client.DeleteCluster(context.Background(), &eks.DeleteClusterInput{
 		Name: aws.String(clusterName),
@@ -58,6 +62,8 @@ func TestEKS_CreateAndDeleteCluster(t *testing.T) {
 		t.Fatal(err)
 	}
 	golden.New(t, golden.WithIgnoreFields("Arn", "Endpoint", "CreatedAt", "ClusterSecurityGroupId", "Issuer", "VpcId", "ResultMetadata")).Assert(t.Name()+"_delete", deleteResult)
+
+	waitForEKSClusterDeleted(ctx, t, client, clusterName)
 }
 
 func TestEKS_DescribeCluster(t *testing.T) {
@@ -83,6 +89,8 @@ func TestEKS_DescribeCluster(t *testing.T) {
 		})
 	})
 
+	waitForEKSClusterActive(ctx, t, client, clusterName)
+
 	// Describe cluster
 	describeResult, err := client.DescribeCluster(ctx, &eks.DescribeClusterInput{
 		Name: aws.String(clusterName),
@@ -142,16 +150,11 @@ func TestEKS_CreateAndDeleteNodegroup(t *testing.T) {
 	}
 
 	t.Cleanup(func() {
-		// Delete nodegroup first, then cluster
-		_, _ = client.DeleteNodegroup(context.Background(), &eks.DeleteNodegroupInput{
-			ClusterName:   aws.String(clusterName),
-			NodegroupName: aws.String(nodegroupName),
-		})
-		_, _ = client.DeleteCluster(context.Background(), &eks.DeleteClusterInput{
-			Name: aws.String(clusterName),
-		})
+		deleteEKSNodegroupAndCluster(t, client, clusterName, nodegroupName)
 	})
 
+	waitForEKSClusterActive(ctx, t, client, clusterName)
+
 	// Create nodegroup
 	createResult, err := client.CreateNodegroup(ctx, &eks.CreateNodegroupInput{
 		ClusterName:   aws.String(clusterName),
@@ -199,15 +202,11 @@ func TestEKS_DescribeNodegroup(t *testing.T) {
 	}
 
 	t.Cleanup(func() {
-		_, _ = client.DeleteNodegroup(context.Background(), &eks.DeleteNodegroupInput{
-			ClusterName:   aws.String(clusterName),
-			NodegroupName: aws.String(nodegroupName),
-		})
-		_, _ = client.DeleteCluster(context.Background(), &eks.DeleteClusterInput{
-			Name: aws.String(clusterName),
-		})
+		deleteEKSNodegroupAndCluster(t, client, clusterName, nodegroupName)
 	})
 
+	waitForEKSClusterActive(ctx, t, client, clusterName)
+
 	// Create nodegroup
 	_, err = client.CreateNodegroup(ctx, &eks.CreateNodegroupInput{
 		ClusterName:   aws.String(clusterName),
@@ -219,6 +218,8 @@ func TestEKS_DescribeNodegroup(t *testing.T) {
 		t.Fatalf("failed to create nodegroup: %v", err)
 	}
 
+	waitForEKSNodegroupActive(ctx, t, client, clusterName, nodegroupName)
+
 	// Describe nodegroup
 	describeResult, err := client.DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
 		ClusterName:   aws.String(clusterName),
@@ -249,15 +250,11 @@ func TestEKS_ListNodegroups(t *testing.T) {
 	}
 
 	t.Cleanup(func() {
-		_, _ = client.DeleteNodegroup(context.Background(), &eks.DeleteNodegroupInput{
-			ClusterName:   aws.String(clusterName),
-			NodegroupName: aws.String(nodegroupName),
-		})
-		_, _ = client.DeleteCluster(context.Background(), &eks.DeleteClusterInput{
-			Name: aws.String(clusterName),
-		})
+		deleteEKSNodegroupAndCluster(t, client, clusterName, nodegroupName)
 	})
 
+	waitForEKSClusterActive(ctx, t, client, clusterName)
+
 	// Create nodegroup
 	_, err = client.CreateNodegroup(ctx, &eks.CreateNodegroupInput{
 		ClusterName:   aws.String(clusterName),
@@ -323,3 +320,356 @@ func TestEKS_NodegroupNotFound(t *testing.T) {
 		t.Fatal("expected error for non-existent nodegroup")
 	}
 }
+
+func TestEKS_ClusterAndNodegroupTransitions(t *testing.T) {
+	client := newEKSClient(t)
+	ctx := t.Context()
+	clusterName := "test-transitions-cluster"
+	nodegroupName := "test-transitions-nodegroup"
+
+	createResult, err := client.CreateCluster(ctx, &eks.CreateClusterInput{
+		Name:    aws.String(clusterName),
+		RoleArn: aws.String("arn:aws:iam::123456789012:role/eks-cluster-role"),
+		ResourcesVpcConfig: &types.VpcConfigRequest{
+			SubnetIds: []string{"subnet-12345678"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		deleteEKSNodegroupAndCluster(t, client, clusterName, nodegroupName)
+	})
+
+	if createResult.Cluster.Status != types.ClusterStatusCreating {
+		t.Errorf("expected CREATING, got %s", createResult.Cluster.Status)
+	}
+
+	// Node groups cannot be added until the cluster is ACTIVE.
+	nodegroupInput := &eks.CreateNodegroupInput{
+		ClusterName:   aws.String(clusterName),
+		NodegroupName: aws.String(nodegroupName),
+		NodeRole:      aws.String("arn:aws:iam::123456789012:role/eks-nodegroup-role"),
+		Subnets:       []string{"subnet-12345678"},
+	}
+
+	_, err = client.CreateNodegroup(ctx, nodegroupInput)
+
+	var invalidRequest *types.InvalidRequestException
+	if !errors.As(err, &invalidRequest) {
+		t.Errorf("expected InvalidRequestException, got %v", err)
+	}
+
+	waitForEKSClusterActive(ctx, t, client, clusterName)
+
+	describeResult, err := client.DescribeCluster(ctx, &eks.DescribeClusterInput{
+		Name: aws.String(clusterName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cluster := describeResult.Cluster
+	if aws.ToString(cluster.Endpoint) == "" || cluster.CertificateAuthority == nil ||
+		aws.ToString(cluster.CertificateAuthority.Data) == "" ||
+		cluster.Identity == nil || cluster.Identity.Oidc == nil || aws.ToString(cluster.Identity.Oidc.Issuer) == "" {
+		t.Errorf("expected endpoint, certificate authority and OIDC issuer, got %+v", cluster)
+	}
+
+	nodegroupResult, err := client.CreateNodegroup(ctx, nodegroupInput)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if nodegroupResult.Nodegroup.Status != types.NodegroupStatusCreating {
+		t.Errorf("expected CREATING, got %s", nodegroupResult.Nodegroup.Status)
+	}
+
+	waitForEKSNodegroupActive(ctx, t, client, clusterName, nodegroupName)
+
+	// A cluster with node groups cannot be deleted.
+	_, err = client.DeleteCluster(ctx, &eks.DeleteClusterInput{
+		Name: aws.String(clusterName),
+	})
+
+	var inUse *types.ResourceInUseException
+	if !errors.As(err, &inUse) {
+		t.Errorf("expected ResourceInUseException, got %v", err)
+	}
+
+	deleteResult, err := client.DeleteNodegroup(ctx, &eks.DeleteNodegroupInput{
+		ClusterName:   aws.String(clusterName),
+		NodegroupName: aws.String(nodegroupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if deleteResult.Nodegroup.Status != types.NodegroupStatusDeleting {
+		t.Errorf("expected DELETING, got %s", deleteResult.Nodegroup.Status)
+	}
+
+	waitForEKSNodegroupDeleted(ctx, t, client, clusterName, nodegroupName)
+
+	_, err = client.DeleteCluster(ctx, &eks.DeleteClusterInput{
+		Name: aws.String(clusterName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitForEKSClusterDeleted(ctx, t, client, clusterName)
+}
+
+// eksWaitTimeout bounds how long the tests wait for a status transition.
+const eksWaitTimeout = 10 * time.Second
+
+// waitForEKSClusterActive waits with the SDK waiter until the cluster is ACTIVE.
+func waitForEKSClusterActive(ctx context.Context, t *testing.T, client *eks.Client, clusterName string) {
+	t.Helper()
+
+	waiter := eks.NewClusterActiveWaiter(client, func(o *eks.ClusterActiveWaiterOptions) {
+		o.MinDelay = 100 * time.Millisecond
+		o.MaxDelay = 200 * time.Millisecond
+	})
+
+	if err := waiter.Wait(ctx, &eks.DescribeClusterInput{Name: aws.String(clusterName)}, eksWaitTimeout); err != nil {
+		t.Fatalf("cluster %s did not become ACTIVE: %v", clusterName, err)
+	}
+}
+
+// waitForEKSClusterDeleted waits with the SDK waiter until the cluster is gone.
+func waitForEKSClusterDeleted(ctx context.Context, t *testing.T, client *eks.Client, clusterName string) {
+	t.Helper()
+
+	waiter := eks.NewClusterDeletedWaiter(client, func(o *eks.ClusterDeletedWaiterOptions) {
+		o.MinDelay = 100 * time.Millisecond
+		o.MaxDelay = 200 * time.Millisecond
+	})
+
+	if err := waiter.Wait(ctx, &eks.DescribeClusterInput{Name: aws.String(clusterName)}, eksWaitTimeout); err != nil {
+		t.Fatalf("cluster %s was not deleted: %v", clusterName, err)
+	}
+}
+
+// waitForEKSNodegroupActive waits with the SDK waiter until the node group is ACTIVE.
+func waitForEKSNodegroupActive(ctx context.Context, t *testing.T, client *eks.Client, clusterName, nodegroupName string) {
+	t.Helper()
+
+	waiter := eks.NewNodegroupActiveWaiter(client, func(o *eks.NodegroupActiveWaiterOptions) {
+		o.MinDelay = 100 * time.Millisecond
+		o.MaxDelay = 200 * time.Millisecond
+	})
+
+	input := &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(clusterName),
+		NodegroupName: aws.String(nodegroupName),
+	}
+	if err := waiter.Wait(ctx, input, eksWaitTimeout); err != nil {
+		t.Fatalf("nodegroup %s did not become ACTIVE: %v", nodegroupName, err)
+	}
+}
+
+// waitForEKSNodegroupDeleted waits with the SDK waiter until the node group is gone.
+func waitForEKSNodegroupDeleted(ctx context.Context, t *testing.T, client *eks.Client, clusterName, nodegroupName string) {
+	t.Helper()
+
+	waiter := eks.NewNodegroupDeletedWaiter(client, func(o *eks.NodegroupDeletedWaiterOptions) {
+		o.MinDelay = 100 * time.Millisecond
+		o.MaxDelay = 200 * time.Millisecond
+	})
+
+	input := &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(clusterName),
+		NodegroupName: aws.String(nodegroupName),
+	}
+	if err := waiter.Wait(ctx, input, eksWaitTimeout); err != nil {
+		t.Fatalf("nodegroup %s was not deleted: %v", nodegroupName, err)
+	}
+}
+
+// deleteEKSNodegroupAndCluster removes a node group and then its cluster,
+// waiting for the node group to be gone since a cluster with node groups
+// cannot be deleted.
+func deleteEKSNodegroupAndCluster(t *testing.T, client *eks.Client, clusterName, nodegroupName string) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	_, _ = client.DeleteNodegroup(ctx, &eks.DeleteNodegroupInput{
+		ClusterName:   aws.String(clusterName),
+		NodegroupName: aws.String(nodegroupName),
+	})
+
+	waitForEKSNodegroupDeleted(ctx, t, client, clusterName, nodegroupName)
+
+	_, _ = client.DeleteCluster(ctx, &eks.DeleteClusterInput{
+		Name: aws.String(clusterName),
+	})
+}
+
+func TestEKS_FargateProfiles(t *testing.T) {
+	client := newEKSClient(t)
+	ctx := t.Context()
+	clusterName := "test-fargate-cluster"
+	profileName := "test-fargate-profile"
+
+	_, err := client.CreateCluster(ctx, &eks.CreateClusterInput{
+		Name:    aws.String(clusterName),
+		RoleArn: aws.String("arn:aws:iam::123456789012:role/eks-cluster-role"),
+		ResourcesVpcConfig: &types.VpcConfigRequest{
+			SubnetIds: []string{"subnet-12345678"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		cleanupCtx := context.Background()
+
+		_, _ = client.DeleteFargateProfile(cleanupCtx, &eks.DeleteFargateProfileInput{
+			ClusterName:        aws.String(clusterName),
+			FargateProfileName: aws.String(profileName),
+		})
+
+		waitForEKSFargateProfileDeleted(cleanupCtx, t, client, clusterName, profileName)
+
+		_, _ = client.DeleteCluster(cleanupCtx, &eks.DeleteClusterInput{
+			Name: aws.String(clusterName),
+		})
+	})
+
+	waitForEKSClusterActive(ctx, t, client, clusterName)
+
+	selectors := []types.FargateProfileSelector{
+		{Namespace: aws.String("default")},
+		{Namespace: aws.String("kube-system"), Labels: map[string]string{"k8s-app": "kube-dns"}},
+	}
+
+	createResult, err := client.CreateFargateProfile(ctx, &eks.CreateFargateProfileInput{
+		ClusterName:         aws.String(clusterName),
+		FargateProfileName:  aws.String(profileName),
+		PodExecutionRoleArn: aws.String("arn:aws:iam::123456789012:role/eks-fargate-role"),
+		Subnets:             []string{"subnet-12345678"},
+		Selectors:           selectors,
+		Tags:                map[string]string{"env": "test"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if createResult.FargateProfile.Status != types.FargateProfileStatusCreating {
+		t.Errorf("expected CREATING, got %s", createResult.FargateProfile.Status)
+	}
+
+	// Only one Fargate profile per cluster may be CREATING at a time.
+	_, err = client.CreateFargateProfile(ctx, &eks.CreateFargateProfileInput{
+		ClusterName:         aws.String(clusterName),
+		FargateProfileName:  aws.String("test-fargate-profile-2"),
+		PodExecutionRoleArn: aws.String("arn:aws:iam::123456789012:role/eks-fargate-role"),
+		Selectors:           []types.FargateProfileSelector{{Namespace: aws.String("other")}},
+	})
+
+	var invalidRequest *types.InvalidRequestException
+	if !errors.As(err, &invalidRequest) {
+		t.Errorf("expected InvalidRequestException, got %v", err)
+	}
+
+	waitForEKSFargateProfileActive(ctx, t, client, clusterName, profileName)
+
+	describeResult, err := client.DescribeFargateProfile(ctx, &eks.DescribeFargateProfileInput{
+		ClusterName:        aws.String(clusterName),
+		FargateProfileName: aws.String(profileName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	profile := describeResult.FargateProfile
+	if profile.Status != types.FargateProfileStatusActive {
+		t.Errorf("expected ACTIVE, got %s", profile.Status)
+	}
+
+	if len(profile.Selectors) != 2 ||
+		aws.ToString(profile.Selectors[1].Namespace) != "kube-system" ||
+		profile.Selectors[1].Labels["k8s-app"] != "kube-dns" {
+		t.Errorf("selectors did not round-trip: %+v", profile.Selectors)
+	}
+
+	if aws.ToString(profile.PodExecutionRoleArn) != "arn:aws:iam::123456789012:role/eks-fargate-role" || profile.Tags["env"] != "test" {
+		t.Errorf("unexpected Fargate profile %+v", profile)
+	}
+
+	listResult, err := client.ListFargateProfiles(ctx, &eks.ListFargateProfilesInput{
+		ClusterName: aws.String(clusterName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(listResult.FargateProfileNames) != 1 || listResult.FargateProfileNames[0] != profileName {
+		t.Errorf("expected [%s], got %v", profileName, listResult.FargateProfileNames)
+	}
+
+	// A cluster with Fargate profiles cannot be deleted.
+	_, err = client.DeleteCluster(ctx, &eks.DeleteClusterInput{
+		Name: aws.String(clusterName),
+	})
+
+	var inUse *types.ResourceInUseException
+	if !errors.As(err, &inUse) {
+		t.Errorf("expected ResourceInUseException, got %v", err)
+	}
+
+	deleteResult, err := client.DeleteFargateProfile(ctx, &eks.DeleteFargateProfileInput{
+		ClusterName:        aws.String(clusterName),
+		FargateProfileName: aws.String(profileName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if deleteResult.FargateProfile.Status != types.FargateProfileStatusDeleting {
+		t.Errorf("expected DELETING, got %s", deleteResult.FargateProfile.Status)
+	}
+
+	waitForEKSFargateProfileDeleted(ctx, t, client, clusterName, profileName)
+}
+
+// waitForEKSFargateProfileActive waits with the SDK waiter until the Fargate profile is ACTIVE.
+func waitForEKSFargateProfileActive(ctx context.Context, t *testing.T, client *eks.Client, clusterName, profileName string) {
+	t.Helper()
+
+	waiter := eks.NewFargateProfileActiveWaiter(client, func(o *eks.FargateProfileActiveWaiterOptions) {
+		o.MinDelay = 100 * time.Millisecond
+		o.MaxDelay = 200 * time.Millisecond
+	})
+
+	input := &eks.DescribeFargateProfileInput{
+		ClusterName:        aws.String(clusterName),
+		FargateProfileName: aws.String(profileName),
+	}
+	if err := waiter.Wait(ctx, input, eksWaitTimeout); err != nil {
+		t.Fatalf("Fargate profile %s did not become ACTIVE: %v", profileName, err)
+	}
+}
+
+// waitForEKSFargateProfileDeleted waits with the SDK waiter until the Fargate profile is gone.
+func waitForEKSFargateProfileDeleted(ctx context.Context, t *testing.T, client *eks.Client, clusterName, profileName string) {
+	t.Helper()
+
+	waiter := eks.NewFargateProfileDeletedWaiter(client, func(o *eks.FargateProfileDeletedWaiterOptions) {
+		o.MinDelay = 100 * time.Millisecond
+		o.MaxDelay = 200 * time.Millisecond
+	})
+
+	input := &eks.DescribeFargateProfileInput{
+		ClusterName:        aws.String(clusterName),
+		FargateProfileName: aws.String(profileName),
+	}
+	if err := waiter.Wait(ctx, input, eksWaitTimeout); err != nil {
+		t.Fatalf("Fargate profile %s was not deleted: %v", profileName, err)
+	}
+}
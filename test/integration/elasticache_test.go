@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	"github.com/sivchari/golden"
 )
 
@@ -237,3 +238,200 @@ func TestElastiCache_DescribeCacheClusters_All(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestElastiCache_CacheParameterGroup(t *testing.T) {
+	client := newElastiCacheClient(t)
+	ctx := t.Context()
+
+	groupName := "test-cache-parameter-group"
+	clusterID := "test-parameter-group-cache-cluster"
+
+	// Create cache parameter group
+	createResult, err := client.CreateCacheParameterGroup(ctx, &elasticache.CreateCacheParameterGroupInput{
+		CacheParameterGroupName:   aws.String(groupName),
+		CacheParameterGroupFamily: aws.String("redis7"),
+		Description:               aws.String("test parameter group"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteCacheCluster(context.Background(), &elasticache.DeleteCacheClusterInput{
+			CacheClusterId: aws.String(clusterID),
+		})
+		_, _ = client.DeleteCacheParameterGroup(context.Background(), &elasticache.DeleteCacheParameterGroupInput{
+			CacheParameterGroupName: aws.String(groupName),
+		})
+	})
+
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name()+"_create", createResult)
+
+	// Modify cache parameter group
+	modifyResult, err := client.ModifyCacheParameterGroup(ctx, &elasticache.ModifyCacheParameterGroupInput{
+		CacheParameterGroupName: aws.String(groupName),
+		ParameterNameValues: []types.ParameterNameValue{
+			{ParameterName: aws.String("maxmemory-policy"), ParameterValue: aws.String("allkeys-lru")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := aws.ToString(modifyResult.CacheParameterGroupName); got != groupName {
+		t.Errorf("expected %s, got %s", groupName, got)
+	}
+
+	// Describe cache parameter groups
+	descResult, err := client.DescribeCacheParameterGroups(ctx, &elasticache.DescribeCacheParameterGroupsInput{
+		CacheParameterGroupName: aws.String(groupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name()+"_describe", descResult)
+
+	// A cache cluster can only reference an existing parameter group.
+	_, err = client.CreateCacheCluster(ctx, &elasticache.CreateCacheClusterInput{
+		CacheClusterId:          aws.String(clusterID),
+		CacheNodeType:           aws.String("cache.t3.micro"),
+		Engine:                  aws.String("redis"),
+		CacheParameterGroupName: aws.String("missing-parameter-group"),
+	})
+	if err == nil {
+		t.Error("expected error when referencing a missing parameter group, got nil")
+	}
+
+	clusterResult, err := client.CreateCacheCluster(ctx, &elasticache.CreateCacheClusterInput{
+		CacheClusterId:          aws.String(clusterID),
+		CacheNodeType:           aws.String("cache.t3.micro"),
+		Engine:                  aws.String("redis"),
+		CacheParameterGroupName: aws.String(groupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pg := clusterResult.CacheCluster.CacheParameterGroup; pg == nil || aws.ToString(pg.CacheParameterGroupName) != groupName {
+		t.Errorf("expected cache cluster to use %s, got %v", groupName, pg)
+	}
+
+	// A parameter group in use cannot be deleted.
+	_, err = client.DeleteCacheParameterGroup(ctx, &elasticache.DeleteCacheParameterGroupInput{
+		CacheParameterGroupName: aws.String(groupName),
+	})
+	if err == nil {
+		t.Error("expected error when deleting a parameter group in use, got nil")
+	}
+
+	_, err = client.DeleteCacheCluster(ctx, &elasticache.DeleteCacheClusterInput{
+		CacheClusterId: aws.String(clusterID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Delete cache parameter group
+	_, err = client.DeleteCacheParameterGroup(ctx, &elasticache.DeleteCacheParameterGroupInput{
+		CacheParameterGroupName: aws.String(groupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestElastiCache_CacheSubnetGroup(t *testing.T) {
+	client := newElastiCacheClient(t)
+	ctx := t.Context()
+
+	groupName := "test-cache-subnet-group"
+	replicationGroupID := "test-subnet-group-replication-group"
+
+	// Create cache subnet group
+	createResult, err := client.CreateCacheSubnetGroup(ctx, &elasticache.CreateCacheSubnetGroupInput{
+		CacheSubnetGroupName:        aws.String(groupName),
+		CacheSubnetGroupDescription: aws.String("test subnet group"),
+		SubnetIds:                   []string{"subnet-11111111", "subnet-22222222"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteReplicationGroup(context.Background(), &elasticache.DeleteReplicationGroupInput{
+			ReplicationGroupId: aws.String(replicationGroupID),
+		})
+		_, _ = client.DeleteCacheSubnetGroup(context.Background(), &elasticache.DeleteCacheSubnetGroupInput{
+			CacheSubnetGroupName: aws.String(groupName),
+		})
+	})
+
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name()+"_create", createResult)
+
+	// Modify cache subnet group
+	_, err = client.ModifyCacheSubnetGroup(ctx, &elasticache.ModifyCacheSubnetGroupInput{
+		CacheSubnetGroupName:        aws.String(groupName),
+		CacheSubnetGroupDescription: aws.String("modified subnet group"),
+		SubnetIds:                   []string{"subnet-33333333", "subnet-44444444", "subnet-55555555"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Describe cache subnet groups
+	descResult, err := client.DescribeCacheSubnetGroups(ctx, &elasticache.DescribeCacheSubnetGroupsInput{
+		CacheSubnetGroupName: aws.String(groupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name()+"_describe", descResult)
+
+	// A replication group can only reference an existing subnet group.
+	_, err = client.CreateReplicationGroup(ctx, &elasticache.CreateReplicationGroupInput{
+		ReplicationGroupId:          aws.String(replicationGroupID),
+		ReplicationGroupDescription: aws.String("test replication group"),
+		CacheNodeType:               aws.String("cache.t3.micro"),
+		Engine:                      aws.String("redis"),
+		CacheSubnetGroupName:        aws.String("missing-subnet-group"),
+	})
+	if err == nil {
+		t.Error("expected error when referencing a missing subnet group, got nil")
+	}
+
+	_, err = client.CreateReplicationGroup(ctx, &elasticache.CreateReplicationGroupInput{
+		ReplicationGroupId:          aws.String(replicationGroupID),
+		ReplicationGroupDescription: aws.String("test replication group"),
+		CacheNodeType:               aws.String("cache.t3.micro"),
+		Engine:                      aws.String("redis"),
+		CacheSubnetGroupName:        aws.String(groupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A subnet group in use cannot be deleted.
+	_, err = client.DeleteCacheSubnetGroup(ctx, &elasticache.DeleteCacheSubnetGroupInput{
+		CacheSubnetGroupName: aws.String(groupName),
+	})
+	if err == nil {
+		t.Error("expected error when deleting a subnet group in use, got nil")
+	}
+
+	_, err = client.DeleteReplicationGroup(ctx, &elasticache.DeleteReplicationGroupInput{
+		ReplicationGroupId: aws.String(replicationGroupID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Delete cache subnet group
+	_, err = client.DeleteCacheSubnetGroup(ctx, &elasticache.DeleteCacheSubnetGroupInput{
+		CacheSubnetGroupName: aws.String(groupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -626,3 +627,197 @@ func TestEventBridge_EventBusNotFound(t *testing.T) {
 		t.Fatal("expected error for non-existent event bus")
 	}
 }
+
+func TestEventBridge_ArchiveAndReplay(t *testing.T) {
+	client := newEventBridgeClient(t)
+	ctx := t.Context()
+
+	bus, err := client.CreateEventBus(ctx, &eventbridge.CreateEventBusInput{
+		Name: aws.String("replay-test-bus"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.PutRule(ctx, &eventbridge.PutRuleInput{
+		Name:         aws.String("replay-test-rule"),
+		EventBusName: aws.String("replay-test-bus"),
+		EventPattern: aws.String(`{"source": ["replay.service"]}`),
+		State:        types.RuleStateEnabled,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.PutTargets(ctx, &eventbridge.PutTargetsInput{
+		Rule:         aws.String("replay-test-rule"),
+		EventBusName: aws.String("replay-test-bus"),
+		Targets: []types.Target{
+			{
+				Id:  aws.String("replay-target"),
+				Arn: aws.String("arn:aws:sqs:us-east-1:000000000000:replay-queue"),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := client.CreateArchive(ctx, &eventbridge.CreateArchiveInput{
+		ArchiveName:    aws.String("replay-test-archive"),
+		EventSourceArn: bus.EventBusArn,
+		EventPattern:   aws.String(`{"detail-type": ["OrderCreated"]}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if archive.State != types.ArchiveStateEnabled {
+		t.Fatalf("expected archive state ENABLED, got %s", archive.State)
+	}
+
+	// Only the OrderCreated events match the archive's event pattern.
+	eventTime := time.Now().Add(-time.Hour)
+
+	_, err = client.PutEvents(ctx, &eventbridge.PutEventsInput{
+		Entries: []types.PutEventsRequestEntry{
+			{
+				Source:       aws.String("replay.service"),
+				DetailType:   aws.String("OrderCreated"),
+				Detail:       aws.String(`{"orderId": "1"}`),
+				EventBusName: aws.String("replay-test-bus"),
+				Time:         aws.Time(eventTime),
+			},
+			{
+				Source:       aws.String("replay.service"),
+				DetailType:   aws.String("OrderCreated"),
+				Detail:       aws.String(`{"orderId": "2"}`),
+				EventBusName: aws.String("replay-test-bus"),
+				Time:         aws.Time(eventTime.Add(time.Minute)),
+			},
+			{
+				Source:       aws.String("replay.service"),
+				DetailType:   aws.String("OrderShipped"),
+				Detail:       aws.String(`{"orderId": "1"}`),
+				EventBusName: aws.String("replay-test-bus"),
+				Time:         aws.Time(eventTime),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	describeArchive, err := client.DescribeArchive(ctx, &eventbridge.DescribeArchiveInput{
+		ArchiveName: aws.String("replay-test-archive"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if describeArchive.EventCount != 2 {
+		t.Fatalf("expected 2 archived events, got %d", describeArchive.EventCount)
+	}
+
+	archives, err := client.ListArchives(ctx, &eventbridge.ListArchivesInput{
+		EventSourceArn: bus.EventBusArn,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(archives.Archives) != 1 || aws.ToString(archives.Archives[0].ArchiveName) != "replay-test-archive" {
+		t.Fatalf("expected replay-test-archive to be listed, got %+v", archives.Archives)
+	}
+
+	// Replay only the first event.
+	replay, err := client.StartReplay(ctx, &eventbridge.StartReplayInput{
+		ReplayName:     aws.String("replay-test-replay"),
+		EventSourceArn: describeArchive.ArchiveArn,
+		EventStartTime: aws.Time(eventTime.Add(-time.Minute)),
+		EventEndTime:   aws.Time(eventTime.Add(30 * time.Second)),
+		Destination: &types.ReplayDestination{
+			Arn: bus.EventBusArn,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if replay.State != types.ReplayStateCompleted {
+		t.Fatalf("expected replay state COMPLETED, got %s", replay.State)
+	}
+
+	describeReplay, err := client.DescribeReplay(ctx, &eventbridge.DescribeReplayInput{
+		ReplayName: aws.String("replay-test-replay"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(describeReplay.Destination.Arn) != aws.ToString(bus.EventBusArn) {
+		t.Fatalf("expected replay destination %s, got %s", aws.ToString(bus.EventBusArn), aws.ToString(describeReplay.Destination.Arn))
+	}
+
+	if describeReplay.EventLastReplayedTime == nil || describeReplay.EventLastReplayedTime.Unix() != eventTime.Unix() {
+		t.Fatalf("expected last replayed time %v, got %v", eventTime, describeReplay.EventLastReplayedTime)
+	}
+
+	replays, err := client.ListReplays(ctx, &eventbridge.ListReplaysInput{
+		NamePrefix: aws.String("replay-test-"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(replays.Replays) != 1 {
+		t.Fatalf("expected 1 replay, got %d", len(replays.Replays))
+	}
+
+	// Replayed events go through the rule again and are recorded with the replay name.
+	resp, err := http.Get("http://localhost:4566/kumo/eventbridge/delivered-events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var delivered []struct {
+		Detail     string `json:"Detail"`
+		RuleName   string `json:"RuleName"`
+		ReplayName string `json:"ReplayName"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&delivered); err != nil {
+		t.Fatal(err)
+	}
+
+	var replayed []string
+
+	for _, d := range delivered {
+		if d.ReplayName == "replay-test-replay" {
+			if d.RuleName != "replay-test-rule" {
+				t.Fatalf("expected replayed event to match replay-test-rule, got %s", d.RuleName)
+			}
+
+			replayed = append(replayed, d.Detail)
+		}
+	}
+
+	if len(replayed) != 1 || replayed[0] != `{"orderId": "1"}` {
+		t.Fatalf("expected the first OrderCreated event to be replayed, got %v", replayed)
+	}
+
+	// Replays cannot target a different event bus.
+	_, err = client.StartReplay(ctx, &eventbridge.StartReplayInput{
+		ReplayName:     aws.String("replay-test-cross-bus"),
+		EventSourceArn: describeArchive.ArchiveArn,
+		EventStartTime: aws.Time(eventTime.Add(-time.Minute)),
+		EventEndTime:   aws.Time(eventTime.Add(time.Hour)),
+		Destination: &types.ReplayDestination{
+			Arn: aws.String("arn:aws:events:us-east-1:000000000000:event-bus/default"),
+		},
+	})
+	if err == nil {
+		t.Fatal("expected error for replay to a different event bus")
+	}
+}
@@ -0,0 +1,121 @@
+//go:build integration
+
+package integration
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+)
+
+func TestFaultsEndpoint_InjectErrors(t *testing.T) {
+	sqsClient := newSQSClient(t)
+	s3Client := newS3Client(t)
+	ctx := t.Context()
+
+	putFaultRules(t, `[
+		{"service": "sqs", "action": "ListQueues", "errorRate": 1, "statusCode": 400, "errorCode": "AccessDenied"},
+		{"service": "s3", "action": "GET /", "errorRate": 1, "statusCode": 403, "errorCode": "AccessDenied"}
+	]`)
+
+	t.Cleanup(func() {
+		deleteFaultRules(t)
+	})
+
+	_, err := sqsClient.ListQueues(ctx, &sqs.ListQueuesInput{})
+	assertAPIErrorCode(t, err, "AccessDenied")
+
+	_, err = s3Client.ListBuckets(ctx, &s3.ListBucketsInput{})
+	assertAPIErrorCode(t, err, "AccessDenied")
+
+	// Actions without a rule are not affected.
+	_, err = sqsClient.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
+		QueueName: aws.String("faults-missing-queue"),
+	})
+	assertAPIErrorCode(t, err, "AWS.SimpleQueueService.NonExistentQueue")
+
+	deleteFaultRules(t)
+
+	if _, err := sqsClient.ListQueues(ctx, &sqs.ListQueuesInput{}); err != nil {
+		t.Errorf("expected ListQueues to succeed after removing the fault rules: %v", err)
+	}
+}
+
+func TestFaultsEndpoint_InjectLatency(t *testing.T) {
+	client := newSQSClient(t)
+	latency := 300 * time.Millisecond
+
+	putFaultRules(t, `[{"service": "sqs", "action": "ListQueues", "latency": "`+latency.String()+`"}]`)
+
+	t.Cleanup(func() {
+		deleteFaultRules(t)
+	})
+
+	start := time.Now()
+
+	if _, err := client.ListQueues(t.Context(), &sqs.ListQueuesInput{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if elapsed := time.Since(start); elapsed < latency {
+		t.Errorf("expected ListQueues to take at least %s, took %s", latency, elapsed)
+	}
+}
+
+func TestFaultsEndpoint_InvalidRule(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPut, "http://localhost:4566/kumo/faults", strings.NewReader(`[{"service": "sqs", "errorRate": 2}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, resp.StatusCode)
+	}
+}
+
+// putFaultRules replaces the fault rules of the server.
+func putFaultRules(t *testing.T, rules string) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPut, "http://localhost:4566/kumo/faults", strings.NewReader(rules))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("failed to put fault rules: status %d", resp.StatusCode)
+	}
+}
+
+// deleteFaultRules removes every fault rule of the server.
+func deleteFaultRules(t *testing.T) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodDelete, "http://localhost:4566/kumo/faults", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+}
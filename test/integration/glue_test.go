@@ -3,7 +3,11 @@
 package integration
 
 import (
+	"context"
+	"errors"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -398,3 +402,439 @@ func TestGlue_CreateDuplicateDatabase(t *testing.T) {
 		t.Fatal("expected error when creating duplicate database")
 	}
 }
+
+func TestGlue_UpdateTable(t *testing.T) {
+	client := newGlueClient(t)
+	ctx := t.Context()
+
+	dbName := "update_table_database"
+	tableName := "update_test_table"
+
+	_, err := client.CreateDatabase(ctx, &glue.CreateDatabaseInput{
+		DatabaseInput: &types.DatabaseInput{
+			Name: aws.String(dbName),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteDatabase(context.Background(), &glue.DeleteDatabaseInput{
+			Name: aws.String(dbName),
+		})
+	})
+
+	_, err = client.CreateTable(ctx, &glue.CreateTableInput{
+		DatabaseName: aws.String(dbName),
+		TableInput: &types.TableInput{
+			Name: aws.String(tableName),
+			StorageDescriptor: &types.StorageDescriptor{
+				Columns: []types.Column{
+					{Name: aws.String("id"), Type: aws.String("bigint")},
+				},
+				Location: aws.String("s3://bucket/update_test_table/"),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.UpdateTable(ctx, &glue.UpdateTableInput{
+		DatabaseName: aws.String(dbName),
+		TableInput: &types.TableInput{
+			Name:        aws.String(tableName),
+			Description: aws.String("Updated table"),
+			StorageDescriptor: &types.StorageDescriptor{
+				Columns: []types.Column{
+					{Name: aws.String("id"), Type: aws.String("bigint")},
+					{Name: aws.String("name"), Type: aws.String("string")},
+				},
+				Location: aws.String("s3://bucket/update_test_table/"),
+			},
+			PartitionKeys: []types.Column{
+				{Name: aws.String("dt"), Type: aws.String("string")},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getOutput, err := client.GetTable(ctx, &glue.GetTableInput{
+		DatabaseName: aws.String(dbName),
+		Name:         aws.String(tableName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, golden.WithIgnoreFields("CreateTime", "UpdateTime", "ResultMetadata")).Assert(t.Name(), getOutput)
+
+	_, err = client.UpdateTable(ctx, &glue.UpdateTableInput{
+		DatabaseName: aws.String(dbName),
+		TableInput: &types.TableInput{
+			Name: aws.String("missing_table"),
+		},
+	})
+
+	var notFound *types.EntityNotFoundException
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected EntityNotFoundException, got %v", err)
+	}
+}
+
+func TestGlue_GetTablesExpressionAndPagination(t *testing.T) {
+	client := newGlueClient(t)
+	ctx := t.Context()
+
+	dbName := "expression_tables_database"
+
+	_, err := client.CreateDatabase(ctx, &glue.CreateDatabaseInput{
+		DatabaseInput: &types.DatabaseInput{
+			Name: aws.String(dbName),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteDatabase(context.Background(), &glue.DeleteDatabaseInput{
+			Name: aws.String(dbName),
+		})
+	})
+
+	for _, name := range []string{"sales_2023", "sales_2024", "sales_2025", "customers"} {
+		_, err = client.CreateTable(ctx, &glue.CreateTableInput{
+			DatabaseName: aws.String(dbName),
+			TableInput: &types.TableInput{
+				Name: aws.String(name),
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var names []string
+
+	paginator := glue.NewGetTablesPaginator(client, &glue.GetTablesInput{
+		DatabaseName: aws.String(dbName),
+		Expression:   aws.String("sales_*"),
+		MaxResults:   aws.Int32(2),
+	})
+
+	pages := 0
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pages++
+
+		for _, table := range page.TableList {
+			names = append(names, aws.ToString(table.Name))
+		}
+	}
+
+	if want := []string{"sales_2023", "sales_2024", "sales_2025"}; !slices.Equal(names, want) {
+		t.Errorf("expected tables %v, got %v", want, names)
+	}
+
+	if pages != 2 {
+		t.Errorf("expected 2 pages, got %d", pages)
+	}
+
+	output, err := client.GetTables(ctx, &glue.GetTablesInput{
+		DatabaseName: aws.String(dbName),
+		Expression:   aws.String("customers|sales_2024"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(output.TableList) != 2 {
+		t.Errorf("expected 2 tables for alternation expression, got %d", len(output.TableList))
+	}
+}
+
+func TestGlue_Partitions(t *testing.T) {
+	client := newGlueClient(t)
+	ctx := t.Context()
+
+	dbName := "partitions_database"
+	tableName := "partitioned_events"
+
+	_, err := client.CreateDatabase(ctx, &glue.CreateDatabaseInput{
+		DatabaseInput: &types.DatabaseInput{
+			Name: aws.String(dbName),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteDatabase(context.Background(), &glue.DeleteDatabaseInput{
+			Name: aws.String(dbName),
+		})
+	})
+
+	_, err = client.CreateTable(ctx, &glue.CreateTableInput{
+		DatabaseName: aws.String(dbName),
+		TableInput: &types.TableInput{
+			Name: aws.String(tableName),
+			PartitionKeys: []types.Column{
+				{Name: aws.String("year"), Type: aws.String("int")},
+				{Name: aws.String("month"), Type: aws.String("string")},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	partitionInput := func(year, month string) types.PartitionInput {
+		return types.PartitionInput{
+			Values: []string{year, month},
+			StorageDescriptor: &types.StorageDescriptor{
+				Location: aws.String("s3://bucket/events/year=" + year + "/month=" + month + "/"),
+			},
+		}
+	}
+
+	first := partitionInput("2023", "12")
+
+	_, err = client.CreatePartition(ctx, &glue.CreatePartitionInput{
+		DatabaseName:   aws.String(dbName),
+		TableName:      aws.String(tableName),
+		PartitionInput: &first,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	batchOutput, err := client.BatchCreatePartition(ctx, &glue.BatchCreatePartitionInput{
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+		PartitionInputList: []types.PartitionInput{
+			partitionInput("2024", "01"),
+			partitionInput("2024", "02"),
+			partitionInput("2024", "03"),
+			partitionInput("2023", "12"),
+			{Values: []string{"2024"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name()+"_batch", batchOutput)
+
+	getOutput, err := client.GetPartition(ctx, &glue.GetPartitionInput{
+		DatabaseName:    aws.String(dbName),
+		TableName:       aws.String(tableName),
+		PartitionValues: []string{"2024", "02"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, golden.WithIgnoreFields("CreationTime", "ResultMetadata")).Assert(t.Name()+"_get", getOutput)
+
+	var values [][]string
+
+	paginator := glue.NewGetPartitionsPaginator(client, &glue.GetPartitionsInput{
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+		Expression:   aws.String("year = 2024 AND month BETWEEN '02' AND '03' OR month = '12'"),
+		MaxResults:   aws.Int32(2),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, partition := range page.Partitions {
+			values = append(values, partition.Values)
+		}
+	}
+
+	want := [][]string{{"2023", "12"}, {"2024", "02"}, {"2024", "03"}}
+	if !slices.EqualFunc(values, want, slices.Equal) {
+		t.Errorf("expected partitions %v, got %v", want, values)
+	}
+
+	_, err = client.GetPartitions(ctx, &glue.GetPartitionsInput{
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+		Expression:   aws.String("day = '01'"),
+	})
+
+	var invalidInput *types.InvalidInputException
+	if !errors.As(err, &invalidInput) {
+		t.Errorf("expected InvalidInputException for unknown partition key, got %v", err)
+	}
+
+	_, err = client.DeletePartition(ctx, &glue.DeletePartitionInput{
+		DatabaseName:    aws.String(dbName),
+		TableName:       aws.String(tableName),
+		PartitionValues: []string{"2024", "02"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetPartition(ctx, &glue.GetPartitionInput{
+		DatabaseName:    aws.String(dbName),
+		TableName:       aws.String(tableName),
+		PartitionValues: []string{"2024", "02"},
+	})
+
+	var notFound *types.EntityNotFoundException
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected EntityNotFoundException after delete, got %v", err)
+	}
+}
+
+func TestGlue_JobRunLifecycle(t *testing.T) {
+	client := newGlueClient(t)
+	ctx := t.Context()
+
+	jobName := "lifecycle_test_job"
+
+	_, err := client.CreateJob(ctx, &glue.CreateJobInput{
+		Name: aws.String(jobName),
+		Role: aws.String("arn:aws:iam::000000000000:role/GlueRole"),
+		Command: &types.JobCommand{
+			Name:           aws.String("glueetl"),
+			ScriptLocation: aws.String("s3://test-bucket/scripts/etl.py"),
+		},
+		DefaultArguments: map[string]string{"--job-language": "python"},
+		GlueVersion:      aws.String("4.0"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteJob(context.Background(), &glue.DeleteJobInput{JobName: aws.String(jobName)})
+	})
+
+	getJobOutput, err := client.GetJob(ctx, &glue.GetJobInput{JobName: aws.String(jobName)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(getJobOutput.Job.GlueVersion) != "4.0" || getJobOutput.Job.DefaultArguments["--job-language"] != "python" {
+		t.Errorf("unexpected job %+v", getJobOutput.Job)
+	}
+
+	// A started run goes STARTING -> RUNNING -> SUCCEEDED.
+	firstRun, err := client.StartJobRun(ctx, &glue.StartJobRunInput{
+		JobName:   aws.String(jobName),
+		Arguments: map[string]string{"--input": "s3://test-bucket/input/"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runID := aws.ToString(firstRun.JobRunId)
+
+	started := waitForGlueJobRunState(t, client, jobName, runID, types.JobRunStateStarting)
+	if started.CompletedOn != nil {
+		t.Error("expected a starting run to have no completion time")
+	}
+
+	waitForGlueJobRunState(t, client, jobName, runID, types.JobRunStateRunning)
+
+	succeeded := waitForGlueJobRunState(t, client, jobName, runID, types.JobRunStateSucceeded)
+	if succeeded.Arguments["--input"] != "s3://test-bucket/input/" {
+		t.Errorf("expected run arguments to be reported, got %v", succeeded.Arguments)
+	}
+
+	if succeeded.CompletedOn == nil || succeeded.ExecutionTime < 1 {
+		t.Errorf("expected completion time and execution time, got %v and %d", succeeded.CompletedOn, succeeded.ExecutionTime)
+	}
+
+	// Stopping moves an unfinished run to STOPPED and reports the rest as errors.
+	secondRun, err := client.StartJobRun(ctx, &glue.StartJobRunInput{JobName: aws.String(jobName)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stoppedID := aws.ToString(secondRun.JobRunId)
+
+	stopOutput, err := client.BatchStopJobRun(ctx, &glue.BatchStopJobRunInput{
+		JobName:   aws.String(jobName),
+		JobRunIds: []string{stoppedID, runID, "jr_unknown"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stopOutput.SuccessfulSubmissions) != 1 || aws.ToString(stopOutput.SuccessfulSubmissions[0].JobRunId) != stoppedID {
+		t.Errorf("expected only %s to be stopped, got %+v", stoppedID, stopOutput.SuccessfulSubmissions)
+	}
+
+	if len(stopOutput.Errors) != 2 {
+		t.Errorf("expected 2 errors, got %d", len(stopOutput.Errors))
+	}
+
+	stopped := waitForGlueJobRunState(t, client, jobName, stoppedID, types.JobRunStateStopped)
+	if stopped.CompletedOn == nil {
+		t.Error("expected a stopped run to have a completion time")
+	}
+
+	// Runs are listed most recently started first.
+	runsOutput, err := client.GetJobRuns(ctx, &glue.GetJobRunsInput{JobName: aws.String(jobName)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var runIDs []string
+	for _, run := range runsOutput.JobRuns {
+		runIDs = append(runIDs, aws.ToString(run.Id))
+	}
+
+	if !slices.Equal(runIDs, []string{stoppedID, runID}) {
+		t.Errorf("expected runs %v, got %v", []string{stoppedID, runID}, runIDs)
+	}
+
+	_, err = client.GetJobRun(ctx, &glue.GetJobRunInput{JobName: aws.String(jobName), RunId: aws.String("jr_unknown")})
+
+	var notFound *types.EntityNotFoundException
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected EntityNotFoundException, got %v", err)
+	}
+}
+
+// waitForGlueJobRunState polls GetJobRun until the job run reaches the given state.
+func waitForGlueJobRunState(t *testing.T, client *glue.Client, jobName, runID string, state types.JobRunState) *types.JobRun {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+
+	for {
+		output, err := client.GetJobRun(t.Context(), &glue.GetJobRunInput{
+			JobName: aws.String(jobName),
+			RunId:   aws.String(runID),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if output.JobRun.JobRunState == state {
+			return output.JobRun
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("job run %s did not reach %s, last state %s", runID, state, output.JobRun.JobRunState)
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+}
@@ -4,6 +4,7 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -610,3 +611,125 @@ func TestIAM_CreateUserWithTags(t *testing.T) {
 
 	golden.New(t, golden.WithIgnoreFields("ResultMetadata", "UserId", "Arn", "CreateDate")).Assert(t.Name()+"_get", getResult)
 }
+
+func TestIAM_AccessKeyRotation(t *testing.T) {
+	client := newIAMClient(t)
+	ctx := t.Context()
+	userName := "test-rotate-access-keys-user"
+
+	_, err := client.CreateUser(ctx, &iam.CreateUserInput{
+		UserName: aws.String(userName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		listResult, err := client.ListAccessKeys(context.Background(), &iam.ListAccessKeysInput{
+			UserName: aws.String(userName),
+		})
+		if err == nil {
+			for _, key := range listResult.AccessKeyMetadata {
+				_, _ = client.DeleteAccessKey(context.Background(), &iam.DeleteAccessKeyInput{
+					UserName:    aws.String(userName),
+					AccessKeyId: key.AccessKeyId,
+				})
+			}
+		}
+
+		_, _ = client.DeleteUser(context.Background(), &iam.DeleteUserInput{
+			UserName: aws.String(userName),
+		})
+	})
+
+	createKey := func() *types.AccessKey {
+		t.Helper()
+
+		output, err := client.CreateAccessKey(ctx, &iam.CreateAccessKeyInput{
+			UserName: aws.String(userName),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if aws.ToString(output.AccessKey.SecretAccessKey) == "" {
+			t.Error("expected CreateAccessKey to return the secret access key")
+		}
+
+		return output.AccessKey
+	}
+
+	oldKey := createKey()
+	newKey := createKey()
+
+	// A user can have at most two access keys.
+	_, err = client.CreateAccessKey(ctx, &iam.CreateAccessKeyInput{
+		UserName: aws.String(userName),
+	})
+
+	var limitExceeded *types.LimitExceededException
+	if !errors.As(err, &limitExceeded) {
+		t.Errorf("expected LimitExceededException, got %v", err)
+	}
+
+	// Deactivate the old key, then delete it.
+	_, err = client.UpdateAccessKey(ctx, &iam.UpdateAccessKeyInput{
+		UserName:    aws.String(userName),
+		AccessKeyId: oldKey.AccessKeyId,
+		Status:      types.StatusTypeInactive,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listResult, err := client.ListAccessKeys(ctx, &iam.ListAccessKeysInput{
+		UserName: aws.String(userName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(listResult.AccessKeyMetadata) != 2 {
+		t.Fatalf("expected 2 access keys, got %d", len(listResult.AccessKeyMetadata))
+	}
+
+	statuses := map[string]types.StatusType{}
+	for _, key := range listResult.AccessKeyMetadata {
+		statuses[aws.ToString(key.AccessKeyId)] = key.Status
+
+		if key.CreateDate == nil {
+			t.Errorf("expected access key %s to have a CreateDate", aws.ToString(key.AccessKeyId))
+		}
+	}
+
+	if got := statuses[aws.ToString(oldKey.AccessKeyId)]; got != types.StatusTypeInactive {
+		t.Errorf("expected old key to be Inactive, got %s", got)
+	}
+
+	if got := statuses[aws.ToString(newKey.AccessKeyId)]; got != types.StatusTypeActive {
+		t.Errorf("expected new key to be Active, got %s", got)
+	}
+
+	_, err = client.DeleteAccessKey(ctx, &iam.DeleteAccessKeyInput{
+		UserName:    aws.String(userName),
+		AccessKeyId: oldKey.AccessKeyId,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Deleting a key frees a slot for the next rotation.
+	createKey()
+
+	// Updating a deleted key fails.
+	_, err = client.UpdateAccessKey(ctx, &iam.UpdateAccessKeyInput{
+		UserName:    aws.String(userName),
+		AccessKeyId: oldKey.AccessKeyId,
+		Status:      types.StatusTypeActive,
+	})
+
+	var noSuchEntity *types.NoSuchEntityException
+	if !errors.As(err, &noSuchEntity) {
+		t.Errorf("expected NoSuchEntityException, got %v", err)
+	}
+}
@@ -4,6 +4,7 @@ package integration
 
 import (
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -46,6 +47,8 @@ func TestKinesis_CreateAndDescribeStream(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	waitForKinesisStreamActive(t, client, streamName)
+
 	// Describe stream.
 	describeOutput, err := client.DescribeStream(ctx, &kinesis.DescribeStreamInput{
 		StreamName: aws.String(streamName),
@@ -136,6 +139,8 @@ func TestKinesis_PutAndGetRecords(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	waitForKinesisStreamActive(t, client, streamName)
+
 	// Put record.
 	putOutput, err := client.PutRecord(ctx, &kinesis.PutRecordInput{
 		StreamName:   aws.String(streamName),
@@ -185,6 +190,8 @@ func TestKinesis_PutRecords(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	waitForKinesisStreamActive(t, client, streamName)
+
 	// Put multiple records.
 	putOutput, err := client.PutRecords(ctx, &kinesis.PutRecordsInput{
 		StreamName: aws.String(streamName),
@@ -221,6 +228,8 @@ func TestKinesis_DeleteStream(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	waitForKinesisStreamActive(t, client, streamName)
+
 	// Delete stream.
 	_, err = client.DeleteStream(ctx, &kinesis.DeleteStreamInput{
 		StreamName: aws.String(streamName),
@@ -229,6 +238,26 @@ func TestKinesis_DeleteStream(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// The stream stays DELETING until it is removed.
+	summary, err := client.DescribeStreamSummary(ctx, &kinesis.DescribeStreamSummaryInput{
+		StreamName: aws.String(streamName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if summary.StreamDescriptionSummary.StreamStatus != types.StreamStatusDeleting {
+		t.Errorf("expected DELETING, got %s", summary.StreamDescriptionSummary.StreamStatus)
+	}
+
+	waiter := kinesis.NewStreamNotExistsWaiter(client, func(o *kinesis.StreamNotExistsWaiterOptions) {
+		o.MinDelay = 100 * time.Millisecond
+		o.MaxDelay = 200 * time.Millisecond
+	})
+	if err := waiter.Wait(ctx, &kinesis.DescribeStreamInput{StreamName: aws.String(streamName)}, 10*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
 	// Verify deletion.
 	_, err = client.DescribeStream(ctx, &kinesis.DescribeStreamInput{
 		StreamName: aws.String(streamName),
@@ -266,6 +295,8 @@ func TestKinesis_GetShardIteratorTypes(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	waitForKinesisStreamActive(t, client, streamName)
+
 	// Put a record to get shard ID.
 	putOutput, err := client.PutRecord(ctx, &kinesis.PutRecordInput{
 		StreamName:   aws.String(streamName),
@@ -308,3 +339,232 @@ func TestKinesis_GetShardIteratorTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestKinesis_DescribeStreamSummary(t *testing.T) {
+	client := newKinesisClient(t)
+	ctx := t.Context()
+
+	streamName := "test-summary-stream"
+
+	_, err := client.CreateStream(ctx, &kinesis.CreateStreamInput{
+		StreamName: aws.String(streamName),
+		ShardCount: aws.Int32(2),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A new stream is CREATING and does not accept records yet.
+	summary, err := client.DescribeStreamSummary(ctx, &kinesis.DescribeStreamSummaryInput{
+		StreamName: aws.String(streamName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if summary.StreamDescriptionSummary.StreamStatus != types.StreamStatusCreating {
+		t.Errorf("expected CREATING, got %s", summary.StreamDescriptionSummary.StreamStatus)
+	}
+
+	_, err = client.PutRecord(ctx, &kinesis.PutRecordInput{
+		StreamName:   aws.String(streamName),
+		Data:         []byte("early"),
+		PartitionKey: aws.String("key"),
+	})
+
+	assertAPIErrorCode(t, err, "ResourceInUseException")
+
+	waitForKinesisStreamActive(t, client, streamName)
+
+	summary, err = client.DescribeStreamSummary(ctx, &kinesis.DescribeStreamSummaryInput{
+		StreamName: aws.String(streamName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := summary.StreamDescriptionSummary
+	if got.StreamStatus != types.StreamStatusActive {
+		t.Errorf("expected ACTIVE, got %s", got.StreamStatus)
+	}
+
+	if aws.ToInt32(got.RetentionPeriodHours) != 24 {
+		t.Errorf("expected RetentionPeriodHours 24, got %d", aws.ToInt32(got.RetentionPeriodHours))
+	}
+
+	if aws.ToInt32(got.OpenShardCount) != 2 {
+		t.Errorf("expected OpenShardCount 2, got %d", aws.ToInt32(got.OpenShardCount))
+	}
+
+	if aws.ToString(got.StreamName) != streamName || aws.ToString(got.StreamARN) == "" {
+		t.Errorf("unexpected stream identity %s %s", aws.ToString(got.StreamName), aws.ToString(got.StreamARN))
+	}
+}
+
+// waitForKinesisStreamActive waits with the SDK waiter until the stream is ACTIVE.
+func waitForKinesisStreamActive(t *testing.T, client *kinesis.Client, streamName string) {
+	t.Helper()
+
+	waiter := kinesis.NewStreamExistsWaiter(client, func(o *kinesis.StreamExistsWaiterOptions) {
+		o.MinDelay = 100 * time.Millisecond
+		o.MaxDelay = 200 * time.Millisecond
+	})
+
+	if err := waiter.Wait(t.Context(), &kinesis.DescribeStreamInput{StreamName: aws.String(streamName)}, 10*time.Second); err != nil {
+		t.Fatalf("stream %s did not become ACTIVE: %v", streamName, err)
+	}
+}
+
+func TestKinesis_SplitAndMergeShards(t *testing.T) {
+	client := newKinesisClient(t)
+	ctx := t.Context()
+
+	streamName := "test-reshard-stream"
+
+	_, err := client.CreateStream(ctx, &kinesis.CreateStreamInput{
+		StreamName: aws.String(streamName),
+		ShardCount: aws.Int32(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitForKinesisStreamActive(t, client, streamName)
+
+	_, err = client.PutRecord(ctx, &kinesis.PutRecordInput{
+		StreamName:   aws.String(streamName),
+		Data:         []byte("before-split"),
+		PartitionKey: aws.String("key"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Split the only shard in the middle of the hash key space.
+	_, err = client.SplitShard(ctx, &kinesis.SplitShardInput{
+		StreamName:         aws.String(streamName),
+		ShardToSplit:       aws.String("shardId-000000000000"),
+		NewStartingHashKey: aws.String("170141183460469231731687303715884105728"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitForKinesisStreamActive(t, client, streamName)
+
+	shards := listKinesisShards(t, client, streamName)
+	if len(shards) != 3 {
+		t.Fatalf("expected 3 shards after split, got %d", len(shards))
+	}
+
+	if shards[0].SequenceNumberRange.EndingSequenceNumber == nil {
+		t.Error("expected the split parent shard to be closed")
+	}
+
+	for _, child := range shards[1:] {
+		if aws.ToString(child.ParentShardId) != "shardId-000000000000" {
+			t.Errorf("expected %s to have parent shardId-000000000000, got %q", aws.ToString(child.ShardId), aws.ToString(child.ParentShardId))
+		}
+
+		if child.SequenceNumberRange.EndingSequenceNumber != nil {
+			t.Errorf("expected child shard %s to be open", aws.ToString(child.ShardId))
+		}
+	}
+
+	if aws.ToString(shards[1].HashKeyRange.EndingHashKey) != "170141183460469231731687303715884105727" ||
+		aws.ToString(shards[2].HashKeyRange.StartingHashKey) != "170141183460469231731687303715884105728" {
+		t.Errorf("unexpected child hash key ranges %v %v", shards[1].HashKeyRange, shards[2].HashKeyRange)
+	}
+
+	// Reading the closed parent to its end hands the consumer over to the children.
+	iterOutput, err := client.GetShardIterator(ctx, &kinesis.GetShardIteratorInput{
+		StreamName:        aws.String(streamName),
+		ShardId:           aws.String("shardId-000000000000"),
+		ShardIteratorType: types.ShardIteratorTypeTrimHorizon,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getOutput, err := client.GetRecords(ctx, &kinesis.GetRecordsInput{
+		ShardIterator: iterOutput.ShardIterator,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(getOutput.Records) != 1 || string(getOutput.Records[0].Data) != "before-split" {
+		t.Errorf("expected the record written before the split, got %v", getOutput.Records)
+	}
+
+	if getOutput.NextShardIterator != nil {
+		t.Error("expected no next shard iterator at the end of a closed shard")
+	}
+
+	if len(getOutput.ChildShards) != 2 {
+		t.Errorf("expected 2 child shards, got %d", len(getOutput.ChildShards))
+	}
+
+	// Merge the two children back together.
+	_, err = client.MergeShards(ctx, &kinesis.MergeShardsInput{
+		StreamName:           aws.String(streamName),
+		ShardToMerge:         aws.String("shardId-000000000001"),
+		AdjacentShardToMerge: aws.String("shardId-000000000002"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitForKinesisStreamActive(t, client, streamName)
+
+	shards = listKinesisShards(t, client, streamName)
+	if len(shards) != 4 {
+		t.Fatalf("expected 4 shards after merge, got %d", len(shards))
+	}
+
+	merged := shards[3]
+	if aws.ToString(merged.ParentShardId) != "shardId-000000000001" ||
+		aws.ToString(merged.AdjacentParentShardId) != "shardId-000000000002" {
+		t.Errorf("unexpected merged shard parents %q %q", aws.ToString(merged.ParentShardId), aws.ToString(merged.AdjacentParentShardId))
+	}
+
+	if aws.ToString(merged.HashKeyRange.StartingHashKey) != "0" ||
+		aws.ToString(merged.HashKeyRange.EndingHashKey) != "340282366920938463463374607431768211455" {
+		t.Errorf("unexpected merged hash key range %v", merged.HashKeyRange)
+	}
+
+	summary, err := client.DescribeStreamSummary(ctx, &kinesis.DescribeStreamSummaryInput{
+		StreamName: aws.String(streamName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToInt32(summary.StreamDescriptionSummary.OpenShardCount) != 1 {
+		t.Errorf("expected 1 open shard, got %d", aws.ToInt32(summary.StreamDescriptionSummary.OpenShardCount))
+	}
+
+	// Closed shards cannot be resharded again.
+	_, err = client.SplitShard(ctx, &kinesis.SplitShardInput{
+		StreamName:         aws.String(streamName),
+		ShardToSplit:       aws.String("shardId-000000000001"),
+		NewStartingHashKey: aws.String("1"),
+	})
+	if err == nil {
+		t.Error("expected an error splitting a closed shard")
+	}
+}
+
+// listKinesisShards lists the shards of a stream.
+func listKinesisShards(t *testing.T, client *kinesis.Client, streamName string) []types.Shard {
+	t.Helper()
+
+	output, err := client.ListShards(t.Context(), &kinesis.ListShardsInput{
+		StreamName: aws.String(streamName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return output.Shards
+}
@@ -4,6 +4,7 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -396,3 +397,228 @@ func TestKMS_EncryptWithAlias(t *testing.T) {
 		t.Errorf("plaintext mismatch: got %s, want %s", decryptOutput.Plaintext, plaintext)
 	}
 }
+
+func TestKMS_KeyStateEnforcement(t *testing.T) {
+	client := newKMSClient(t)
+	ctx := t.Context()
+
+	createOutput, err := client.CreateKey(ctx, &kms.CreateKeyInput{
+		Description: aws.String("test key state enforcement"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyID := createOutput.KeyMetadata.KeyId
+
+	encryptOutput, err := client.Encrypt(ctx, &kms.EncryptInput{
+		KeyId:     keyID,
+		Plaintext: []byte("secret"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectKeyState := func(state types.KeyState) *types.KeyMetadata {
+		t.Helper()
+
+		output, err := client.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: keyID})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if output.KeyMetadata.KeyState != state {
+			t.Errorf("expected key state %s, got %s", state, output.KeyMetadata.KeyState)
+		}
+
+		return output.KeyMetadata
+	}
+
+	// A disabled key cannot be used.
+	_, err = client.DisableKey(ctx, &kms.DisableKeyInput{KeyId: keyID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectKeyState(types.KeyStateDisabled)
+
+	var disabled *types.DisabledException
+
+	_, err = client.Encrypt(ctx, &kms.EncryptInput{KeyId: keyID, Plaintext: []byte("secret")})
+	if !errors.As(err, &disabled) {
+		t.Errorf("expected DisabledException from Encrypt, got %v", err)
+	}
+
+	_, err = client.GenerateDataKey(ctx, &kms.GenerateDataKeyInput{KeyId: keyID, KeySpec: types.DataKeySpecAes256})
+	if !errors.As(err, &disabled) {
+		t.Errorf("expected DisabledException from GenerateDataKey, got %v", err)
+	}
+
+	_, err = client.Decrypt(ctx, &kms.DecryptInput{CiphertextBlob: encryptOutput.CiphertextBlob})
+	if !errors.As(err, &disabled) {
+		t.Errorf("expected DisabledException from Decrypt, got %v", err)
+	}
+
+	// A key pending deletion cannot be used or enabled.
+	_, err = client.ScheduleKeyDeletion(ctx, &kms.ScheduleKeyDeletionInput{
+		KeyId:               keyID,
+		PendingWindowInDays: aws.Int32(7),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if metadata := expectKeyState(types.KeyStatePendingDeletion); metadata.DeletionDate == nil {
+		t.Error("expected a deletion date for a key pending deletion")
+	}
+
+	var invalidState *types.KMSInvalidStateException
+
+	_, err = client.Encrypt(ctx, &kms.EncryptInput{KeyId: keyID, Plaintext: []byte("secret")})
+	if !errors.As(err, &invalidState) {
+		t.Errorf("expected KMSInvalidStateException from Encrypt, got %v", err)
+	}
+
+	_, err = client.GenerateDataKey(ctx, &kms.GenerateDataKeyInput{KeyId: keyID, KeySpec: types.DataKeySpecAes256})
+	if !errors.As(err, &invalidState) {
+		t.Errorf("expected KMSInvalidStateException from GenerateDataKey, got %v", err)
+	}
+
+	_, err = client.EnableKey(ctx, &kms.EnableKeyInput{KeyId: keyID})
+	if !errors.As(err, &invalidState) {
+		t.Errorf("expected KMSInvalidStateException from EnableKey, got %v", err)
+	}
+
+	// Cancelling the deletion leaves the key disabled until it is enabled.
+	cancelOutput, err := client.CancelKeyDeletion(ctx, &kms.CancelKeyDeletionInput{KeyId: keyID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := aws.ToString(cancelOutput.KeyId), aws.ToString(createOutput.KeyMetadata.Arn); got != want {
+		t.Errorf("expected key ARN %s, got %s", want, got)
+	}
+
+	if metadata := expectKeyState(types.KeyStateDisabled); metadata.DeletionDate != nil {
+		t.Error("expected no deletion date after cancelling the deletion")
+	}
+
+	_, err = client.CancelKeyDeletion(ctx, &kms.CancelKeyDeletionInput{KeyId: keyID})
+	if !errors.As(err, &invalidState) {
+		t.Errorf("expected KMSInvalidStateException from CancelKeyDeletion, got %v", err)
+	}
+
+	_, err = client.EnableKey(ctx, &kms.EnableKeyInput{KeyId: keyID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decryptOutput, err := client.Decrypt(ctx, &kms.DecryptInput{CiphertextBlob: encryptOutput.CiphertextBlob})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(decryptOutput.Plaintext) != "secret" {
+		t.Errorf("expected plaintext %q, got %q", "secret", decryptOutput.Plaintext)
+	}
+}
+
+func TestKMS_Grants(t *testing.T) {
+	client := newKMSClient(t)
+	ctx := t.Context()
+
+	createOutput, err := client.CreateKey(ctx, &kms.CreateKeyInput{
+		Description: aws.String("test grants"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyID := createOutput.KeyMetadata.KeyId
+	grantee := "arn:aws:iam::000000000000:role/grantee"
+
+	createGrant := func(name string, operations ...types.GrantOperation) *kms.CreateGrantOutput {
+		t.Helper()
+
+		output, err := client.CreateGrant(ctx, &kms.CreateGrantInput{
+			KeyId:            keyID,
+			GranteePrincipal: aws.String(grantee),
+			Operations:       operations,
+			Name:             aws.String(name),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if aws.ToString(output.GrantId) == "" || aws.ToString(output.GrantToken) == "" {
+			t.Fatal("expected a grant ID and token")
+		}
+
+		return output
+	}
+
+	decryptGrant := createGrant("decrypt", types.GrantOperationDecrypt)
+	encryptGrant := createGrant("encrypt", types.GrantOperationEncrypt, types.GrantOperationGenerateDataKey)
+
+	// Creating a grant with the same name and parameters returns the existing grant.
+	if again := createGrant("decrypt", types.GrantOperationDecrypt); aws.ToString(again.GrantId) != aws.ToString(decryptGrant.GrantId) {
+		t.Errorf("expected grant %s to be reused, got %s", aws.ToString(decryptGrant.GrantId), aws.ToString(again.GrantId))
+	}
+
+	listGrantIDs := func() []string {
+		t.Helper()
+
+		var ids []string
+
+		paginator := kms.NewListGrantsPaginator(client, &kms.ListGrantsInput{KeyId: keyID, Limit: aws.Int32(1)})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, grant := range page.Grants {
+				if got, want := aws.ToString(grant.KeyId), aws.ToString(createOutput.KeyMetadata.Arn); got != want {
+					t.Errorf("expected grant key %s, got %s", want, got)
+				}
+
+				ids = append(ids, aws.ToString(grant.GrantId))
+			}
+		}
+
+		return ids
+	}
+
+	ids := listGrantIDs()
+	if len(ids) != 2 || ids[0] != aws.ToString(decryptGrant.GrantId) || ids[1] != aws.ToString(encryptGrant.GrantId) {
+		t.Fatalf("expected grants [%s %s], got %v", aws.ToString(decryptGrant.GrantId), aws.ToString(encryptGrant.GrantId), ids)
+	}
+
+	_, err = client.RetireGrant(ctx, &kms.RetireGrantInput{GrantToken: decryptGrant.GrantToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var invalidToken *types.InvalidGrantTokenException
+
+	_, err = client.RetireGrant(ctx, &kms.RetireGrantInput{GrantToken: decryptGrant.GrantToken})
+	if !errors.As(err, &invalidToken) {
+		t.Errorf("expected InvalidGrantTokenException, got %v", err)
+	}
+
+	_, err = client.RevokeGrant(ctx, &kms.RevokeGrantInput{KeyId: keyID, GrantId: encryptGrant.GrantId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var notFound *types.NotFoundException
+
+	_, err = client.RevokeGrant(ctx, &kms.RevokeGrantInput{KeyId: keyID, GrantId: encryptGrant.GrantId})
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected NotFoundException, got %v", err)
+	}
+
+	if ids := listGrantIDs(); len(ids) != 0 {
+		t.Errorf("expected no grants, got %v", ids)
+	}
+}
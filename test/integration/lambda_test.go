@@ -5,17 +5,25 @@ package integration
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/sivchari/golden"
 )
 
@@ -600,3 +608,964 @@ func TestLambda_EventSourceMapping_FunctionNotFound(t *testing.T) {
 		t.Fatal("expected error when creating event source mapping for non-existent function")
 	}
 }
+
+func TestLambda_InvokeAsync_RetryAndOnSuccess(t *testing.T) {
+	var calls atomic.Int32
+
+	// The first attempt fails, so the invocation succeeds on its retry.
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	t.Cleanup(mockServer.Close)
+
+	client := newLambdaClient(t)
+	sqsClient := newSQSClient(t)
+	ctx := t.Context()
+	functionName := "test-function-async-on-success"
+	queueName := "test-lambda-async-on-success"
+
+	createLambdaFunctionWithEndpoint(t, functionName, mockServer.URL)
+
+	queueURL := createLambdaDestinationQueue(t, sqsClient, queueName)
+
+	_, err := client.PutFunctionEventInvokeConfig(ctx, &lambda.PutFunctionEventInvokeConfigInput{
+		FunctionName:         aws.String(functionName),
+		MaximumRetryAttempts: aws.Int32(1),
+		DestinationConfig: &types.DestinationConfig{
+			OnSuccess: &types.OnSuccess{Destination: aws.String("arn:aws:sqs:us-east-1:000000000000:" + queueName)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getOutput, err := client.GetFunctionEventInvokeConfig(ctx, &lambda.GetFunctionEventInvokeConfigInput{
+		FunctionName: aws.String(functionName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToInt32(getOutput.MaximumRetryAttempts) != 1 {
+		t.Errorf("expected MaximumRetryAttempts 1, got %d", aws.ToInt32(getOutput.MaximumRetryAttempts))
+	}
+
+	invokeOutput, err := client.Invoke(ctx, &lambda.InvokeInput{
+		FunctionName:   aws.String(functionName),
+		InvocationType: types.InvocationTypeEvent,
+		Payload:        []byte(`{"order":"1"}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if invokeOutput.StatusCode != http.StatusAccepted {
+		t.Errorf("expected status 202, got %d", invokeOutput.StatusCode)
+	}
+
+	msg := receiveLambdaDestinationMessage(t, sqsClient, queueURL)
+
+	var record struct {
+		RequestContext struct {
+			Condition              string `json:"condition"`
+			ApproximateInvokeCount int    `json:"approximateInvokeCount"`
+		} `json:"requestContext"`
+		RequestPayload  map[string]any `json:"requestPayload"`
+		ResponsePayload map[string]any `json:"responsePayload"`
+	}
+	if err := json.Unmarshal([]byte(aws.ToString(msg.Body)), &record); err != nil {
+		t.Fatalf("failed to parse invocation record: %v", err)
+	}
+
+	if record.RequestContext.Condition != "Success" {
+		t.Errorf("expected condition Success, got %q", record.RequestContext.Condition)
+	}
+
+	if record.RequestContext.ApproximateInvokeCount != 2 {
+		t.Errorf("expected 2 attempts, got %d", record.RequestContext.ApproximateInvokeCount)
+	}
+
+	if record.RequestPayload["order"] != "1" || record.ResponsePayload["ok"] != true {
+		t.Errorf("unexpected record payloads: %v, %v", record.RequestPayload, record.ResponsePayload)
+	}
+
+	_, err = client.DeleteFunctionEventInvokeConfig(ctx, &lambda.DeleteFunctionEventInvokeConfigInput{
+		FunctionName: aws.String(functionName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetFunctionEventInvokeConfig(ctx, &lambda.GetFunctionEventInvokeConfigInput{
+		FunctionName: aws.String(functionName),
+	})
+
+	var notFound *types.ResourceNotFoundException
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected ResourceNotFoundException after delete, got %v", err)
+	}
+}
+
+func TestLambda_InvokeAsync_DeadLetterQueue(t *testing.T) {
+	var calls atomic.Int32
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"errorMessage":"boom"}`))
+	}))
+	t.Cleanup(mockServer.Close)
+
+	client := newLambdaClient(t)
+	sqsClient := newSQSClient(t)
+	ctx := t.Context()
+	functionName := "test-function-async-dlq"
+	queueName := "test-lambda-async-dlq"
+
+	createLambdaFunctionWithEndpoint(t, functionName, mockServer.URL)
+
+	queueURL := createLambdaDestinationQueue(t, sqsClient, queueName)
+
+	updateOutput, err := client.UpdateFunctionConfiguration(ctx, &lambda.UpdateFunctionConfigurationInput{
+		FunctionName:     aws.String(functionName),
+		DeadLetterConfig: &types.DeadLetterConfig{TargetArn: aws.String("arn:aws:sqs:us-east-1:000000000000:" + queueName)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updateOutput.DeadLetterConfig == nil || !strings.HasSuffix(aws.ToString(updateOutput.DeadLetterConfig.TargetArn), queueName) {
+		t.Errorf("unexpected DeadLetterConfig: %+v", updateOutput.DeadLetterConfig)
+	}
+
+	_, err = client.PutFunctionEventInvokeConfig(ctx, &lambda.PutFunctionEventInvokeConfigInput{
+		FunctionName:         aws.String(functionName),
+		MaximumRetryAttempts: aws.Int32(0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invokeOutput, err := client.Invoke(ctx, &lambda.InvokeInput{
+		FunctionName:   aws.String(functionName),
+		InvocationType: types.InvocationTypeEvent,
+		Payload:        []byte(`{"order":"2"}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if invokeOutput.StatusCode != http.StatusAccepted {
+		t.Errorf("expected status 202, got %d", invokeOutput.StatusCode)
+	}
+
+	msg := receiveLambdaDestinationMessage(t, sqsClient, queueURL)
+
+	if aws.ToString(msg.Body) != `{"order":"2"}` {
+		t.Errorf("expected the event in the dead-letter queue, got %q", aws.ToString(msg.Body))
+	}
+
+	if attr, ok := msg.MessageAttributes["RequestID"]; !ok || aws.ToString(attr.StringValue) == "" {
+		t.Errorf("expected RequestID message attribute, got %v", msg.MessageAttributes)
+	}
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("expected 1 attempt with MaximumRetryAttempts 0, got %d", n)
+	}
+}
+
+func createLambdaFunctionWithEndpoint(t *testing.T, functionName, invokeEndpoint string) {
+	t.Helper()
+
+	createBody, _ := json.Marshal(map[string]any{
+		"FunctionName":   functionName,
+		"Runtime":        "python3.12",
+		"Role":           "arn:aws:iam::000000000000:role/test-role",
+		"Handler":        "index.handler",
+		"InvokeEndpoint": invokeEndpoint,
+		"Code": map[string]any{
+			"ZipFile": []byte("fake-zip-content"),
+		},
+	})
+
+	req, _ := http.NewRequestWithContext(t.Context(), http.MethodPost,
+		"http://localhost:4566/lambda/2015-03-31/functions", bytes.NewReader(createBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to create function: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	t.Cleanup(func() {
+		delReq, _ := http.NewRequestWithContext(context.Background(), http.MethodDelete,
+			"http://localhost:4566/lambda/2015-03-31/functions/"+functionName, nil)
+		delResp, _ := http.DefaultClient.Do(delReq)
+		if delResp != nil {
+			delResp.Body.Close()
+		}
+	})
+}
+
+func createLambdaDestinationQueue(t *testing.T, client *sqs.Client, queueName string) string {
+	t.Helper()
+
+	output, err := client.CreateQueue(t.Context(), &sqs.CreateQueueInput{QueueName: aws.String(queueName)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteQueue(context.Background(), &sqs.DeleteQueueInput{QueueUrl: output.QueueUrl})
+	})
+
+	return aws.ToString(output.QueueUrl)
+}
+
+func receiveLambdaDestinationMessage(t *testing.T, client *sqs.Client, queueURL string) sqstypes.Message {
+	t.Helper()
+
+	for range 10 {
+		output, err := client.ReceiveMessage(t.Context(), &sqs.ReceiveMessageInput{
+			QueueUrl:              aws.String(queueURL),
+			WaitTimeSeconds:       1,
+			MessageAttributeNames: []string{"All"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(output.Messages) > 0 {
+			return output.Messages[0]
+		}
+	}
+
+	t.Fatal("expected a message from the asynchronous invocation, but none was received")
+
+	return sqstypes.Message{}
+}
+
+func TestLambda_FunctionURL(t *testing.T) {
+	// Echo the function URL event back in the body of the response.
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&event)
+
+		body, _ := json.Marshal(event)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"statusCode": http.StatusCreated,
+			"headers":    map[string]string{"Content-Type": "application/json", "X-Test": "yes"},
+			"cookies":    []string{"session=abc"},
+			"body":       string(body),
+		})
+	}))
+	t.Cleanup(mockServer.Close)
+
+	client := newLambdaClient(t)
+	ctx := t.Context()
+	functionName := "test-function-url"
+
+	createLambdaFunctionWithEndpoint(t, functionName, mockServer.URL)
+
+	createOutput, err := client.CreateFunctionUrlConfig(ctx, &lambda.CreateFunctionUrlConfigInput{
+		FunctionName: aws.String(functionName),
+		AuthType:     types.FunctionUrlAuthTypeNone,
+		Cors:         &types.Cors{AllowOrigins: []string{"*"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	functionURL := aws.ToString(createOutput.FunctionUrl)
+	if !strings.HasPrefix(functionURL, "http://localhost:4566/lambda-url/") {
+		t.Fatalf("unexpected function URL: %s", functionURL)
+	}
+
+	_, err = client.CreateFunctionUrlConfig(ctx, &lambda.CreateFunctionUrlConfigInput{
+		FunctionName: aws.String(functionName),
+		AuthType:     types.FunctionUrlAuthTypeNone,
+	})
+
+	var conflict *types.ResourceConflictException
+	if !errors.As(err, &conflict) {
+		t.Errorf("expected ResourceConflictException for a second function URL, got %v", err)
+	}
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, functionURL+"orders/42?verbose=true",
+		strings.NewReader(`{"item":"book"}`))
+	req.Header.Set("Cookie", "theme=dark")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	respBody, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d: %s", resp.StatusCode, respBody)
+	}
+
+	if resp.Header.Get("X-Test") != "yes" || resp.Header.Get("Set-Cookie") != "session=abc" {
+		t.Errorf("unexpected response headers: %v", resp.Header)
+	}
+
+	var event struct {
+		Version               string            `json:"version"`
+		RawPath               string            `json:"rawPath"`
+		RawQueryString        string            `json:"rawQueryString"`
+		Cookies               []string          `json:"cookies"`
+		QueryStringParameters map[string]string `json:"queryStringParameters"`
+		Body                  string            `json:"body"`
+		RequestContext        struct {
+			HTTP struct {
+				Method string `json:"method"`
+			} `json:"http"`
+		} `json:"requestContext"`
+	}
+	if err := json.Unmarshal(respBody, &event); err != nil {
+		t.Fatalf("failed to parse echoed event: %v", err)
+	}
+
+	if event.Version != "2.0" || event.RawPath != "/orders/42" || event.RawQueryString != "verbose=true" {
+		t.Errorf("unexpected event: %+v", event)
+	}
+
+	if event.RequestContext.HTTP.Method != http.MethodPost || event.Body != `{"item":"book"}` {
+		t.Errorf("unexpected event request: %+v", event)
+	}
+
+	if len(event.Cookies) != 1 || event.Cookies[0] != "theme=dark" || event.QueryStringParameters["verbose"] != "true" {
+		t.Errorf("unexpected event cookies or query: %+v", event)
+	}
+
+	// AWS_IAM function URLs reject unsigned requests.
+	updateOutput, err := client.UpdateFunctionUrlConfig(ctx, &lambda.UpdateFunctionUrlConfigInput{
+		FunctionName: aws.String(functionName),
+		AuthType:     types.FunctionUrlAuthTypeAwsIam,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updateOutput.AuthType != types.FunctionUrlAuthTypeAwsIam || updateOutput.Cors == nil {
+		t.Errorf("unexpected updated config: %+v", updateOutput)
+	}
+
+	req, _ = http.NewRequestWithContext(ctx, http.MethodGet, functionURL, nil)
+
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("expected status 403 for an unsigned request, got %d", resp.StatusCode)
+	}
+
+	_, err = client.DeleteFunctionUrlConfig(ctx, &lambda.DeleteFunctionUrlConfigInput{
+		FunctionName: aws.String(functionName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetFunctionUrlConfig(ctx, &lambda.GetFunctionUrlConfigInput{
+		FunctionName: aws.String(functionName),
+	})
+
+	var notFound *types.ResourceNotFoundException
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected ResourceNotFoundException after delete, got %v", err)
+	}
+
+	req, _ = http.NewRequestWithContext(ctx, http.MethodGet, functionURL, nil)
+
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status 404 after delete, got %d", resp.StatusCode)
+	}
+}
+
+func TestLambda_VersionsAndAliases(t *testing.T) {
+	// Respond with the path of the endpoint, which differs between versions.
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"endpoint": r.URL.Path})
+	}))
+	t.Cleanup(mockServer.Close)
+
+	client := newLambdaClient(t)
+	ctx := t.Context()
+	functionName := "test-function-versions"
+
+	createLambdaFunctionWithEndpoint(t, functionName, mockServer.URL+"/v1")
+
+	v1, err := client.PublishVersion(ctx, &lambda.PublishVersionInput{
+		FunctionName: aws.String(functionName),
+		Description:  aws.String("first"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(v1.Version) != "1" || !strings.HasSuffix(aws.ToString(v1.FunctionArn), ":"+functionName+":1") {
+		t.Errorf("unexpected first version: %s %s", aws.ToString(v1.Version), aws.ToString(v1.FunctionArn))
+	}
+
+	// Point $LATEST at another endpoint; version 1 keeps the endpoint it was published with.
+	updateBody, _ := json.Marshal(map[string]any{"InvokeEndpoint": mockServer.URL + "/v2"})
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPut,
+		"http://localhost:4566/lambda/2015-03-31/functions/"+functionName+"/configuration", bytes.NewReader(updateBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	v2, err := client.PublishVersion(ctx, &lambda.PublishVersionInput{FunctionName: aws.String(functionName)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(v2.Version) != "2" {
+		t.Errorf("expected version 2, got %s", aws.ToString(v2.Version))
+	}
+
+	// Publishing an unchanged function returns the latest version.
+	again, err := client.PublishVersion(ctx, &lambda.PublishVersionInput{FunctionName: aws.String(functionName)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(again.Version) != "2" {
+		t.Errorf("expected unchanged function to stay at version 2, got %s", aws.ToString(again.Version))
+	}
+
+	versions, err := client.ListVersionsByFunction(ctx, &lambda.ListVersionsByFunctionInput{
+		FunctionName: aws.String(functionName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, v := range versions.Versions {
+		got = append(got, aws.ToString(v.Version))
+	}
+
+	if strings.Join(got, ",") != "$LATEST,1,2" {
+		t.Errorf("unexpected versions: %v", got)
+	}
+
+	alias, err := client.CreateAlias(ctx, &lambda.CreateAliasInput{
+		FunctionName:    aws.String(functionName),
+		Name:            aws.String("live"),
+		FunctionVersion: aws.String("1"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasSuffix(aws.ToString(alias.AliasArn), ":"+functionName+":live") {
+		t.Errorf("unexpected alias ARN: %s", aws.ToString(alias.AliasArn))
+	}
+
+	invokeLambdaQualifier(t, client, functionName, "live", "1", "/v1")
+
+	_, err = client.UpdateAlias(ctx, &lambda.UpdateAliasInput{
+		FunctionName:    aws.String(functionName),
+		Name:            aws.String("live"),
+		FunctionVersion: aws.String("2"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invokeLambdaQualifier(t, client, functionName+":live", "", "2", "/v2")
+	invokeLambdaQualifier(t, client, functionName, "1", "1", "/v1")
+
+	getOutput, err := client.GetFunction(ctx, &lambda.GetFunctionInput{
+		FunctionName: aws.String(functionName),
+		Qualifier:    aws.String("1"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(getOutput.Configuration.Version) != "1" || aws.ToString(getOutput.Configuration.Description) != "first" {
+		t.Errorf("unexpected version configuration: %s %s",
+			aws.ToString(getOutput.Configuration.Version), aws.ToString(getOutput.Configuration.Description))
+	}
+
+	aliases, err := client.ListAliases(ctx, &lambda.ListAliasesInput{FunctionName: aws.String(functionName)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(aliases.Aliases) != 1 || aws.ToString(aliases.Aliases[0].FunctionVersion) != "2" {
+		t.Errorf("unexpected aliases: %+v", aliases.Aliases)
+	}
+
+	_, err = client.CreateAlias(ctx, &lambda.CreateAliasInput{
+		FunctionName:    aws.String(functionName),
+		Name:            aws.String("broken"),
+		FunctionVersion: aws.String("9"),
+	})
+
+	var notFound *types.ResourceNotFoundException
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected ResourceNotFoundException for an alias to a missing version, got %v", err)
+	}
+
+	_, err = client.DeleteAlias(ctx, &lambda.DeleteAliasInput{
+		FunctionName: aws.String(functionName),
+		Name:         aws.String("live"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetAlias(ctx, &lambda.GetAliasInput{
+		FunctionName: aws.String(functionName),
+		Name:         aws.String("live"),
+	})
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected ResourceNotFoundException after delete, got %v", err)
+	}
+}
+
+// invokeLambdaQualifier invokes a version or alias of a function and checks the version
+// that ran and the endpoint it was sent to.
+func invokeLambdaQualifier(t *testing.T, client *lambda.Client, functionName, qualifier, wantVersion, wantEndpoint string) {
+	t.Helper()
+
+	input := &lambda.InvokeInput{FunctionName: aws.String(functionName)}
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := client.Invoke(t.Context(), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(output.ExecutedVersion) != wantVersion {
+		t.Errorf("expected executed version %s, got %s", wantVersion, aws.ToString(output.ExecutedVersion))
+	}
+
+	var result map[string]string
+	if err := json.Unmarshal(output.Payload, &result); err != nil {
+		t.Fatalf("failed to parse payload: %v", err)
+	}
+
+	if result["endpoint"] != wantEndpoint {
+		t.Errorf("expected endpoint %s, got %s", wantEndpoint, result["endpoint"])
+	}
+}
+
+func TestLambda_InvokeRuntimeContext(t *testing.T) {
+	headers := make(chan http.Header, 1)
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers <- r.Header.Clone()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(mockServer.Close)
+
+	client := newLambdaClient(t)
+	ctx := t.Context()
+	functionName := "test-function-runtime-context"
+
+	createLambdaFunctionWithEndpoint(t, functionName, mockServer.URL)
+
+	_, err := client.UpdateFunctionConfiguration(ctx, &lambda.UpdateFunctionConfigurationInput{
+		FunctionName: aws.String(functionName),
+		MemorySize:   aws.Int32(256),
+		Timeout:      aws.Int32(30),
+		Environment:  &types.Environment{Variables: map[string]string{"TABLE_NAME": "orders"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+
+	_, err = client.Invoke(ctx, &lambda.InvokeInput{
+		FunctionName: aws.String(functionName),
+		Payload:      []byte(`{}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := <-headers
+
+	if h.Get("Lambda-Runtime-Aws-Request-Id") == "" {
+		t.Error("expected Lambda-Runtime-Aws-Request-Id header")
+	}
+
+	if arn := h.Get("Lambda-Runtime-Invoked-Function-Arn"); arn != "arn:aws:lambda:us-east-1:000000000000:function:"+functionName {
+		t.Errorf("unexpected invoked function ARN: %s", arn)
+	}
+
+	deadline, err := strconv.ParseInt(h.Get("Lambda-Runtime-Deadline-Ms"), 10, 64)
+	if err != nil || deadline < start.Add(29*time.Second).UnixMilli() {
+		t.Errorf("expected a deadline 30 seconds after the invocation, got %q", h.Get("Lambda-Runtime-Deadline-Ms"))
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(h.Get("X-Kumo-Lambda-Environment"))
+	if err != nil {
+		t.Fatalf("failed to decode environment header: %v", err)
+	}
+
+	var environment map[string]string
+	if err := json.Unmarshal(decoded, &environment); err != nil {
+		t.Fatalf("failed to parse environment header: %v", err)
+	}
+
+	want := map[string]string{
+		"TABLE_NAME":                      "orders",
+		"AWS_LAMBDA_FUNCTION_NAME":        functionName,
+		"AWS_LAMBDA_FUNCTION_VERSION":     "$LATEST",
+		"AWS_LAMBDA_FUNCTION_MEMORY_SIZE": "256",
+		"AWS_REGION":                      "us-east-1",
+	}
+	for name, value := range want {
+		if environment[name] != value {
+			t.Errorf("expected %s=%s in the environment, got %q", name, value, environment[name])
+		}
+	}
+}
+
+func TestLambda_InvokeLogTail(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logs := "hello from the handler\n"
+		if r.URL.Path == "/verbose" {
+			logs = strings.Repeat("0123456789abcdef\n", 512)
+		}
+
+		w.Header().Set("X-Kumo-Lambda-Logs", base64.StdEncoding.EncodeToString([]byte(logs)))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(mockServer.Close)
+
+	client := newLambdaClient(t)
+	ctx := t.Context()
+	functionName := "test-function-log-tail"
+
+	createLambdaFunctionWithEndpoint(t, functionName, mockServer.URL)
+
+	output, err := client.Invoke(ctx, &lambda.InvokeInput{
+		FunctionName: aws.String(functionName),
+		LogType:      types.LogTypeTail,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logs, err := base64.StdEncoding.DecodeString(aws.ToString(output.LogResult))
+	if err != nil {
+		t.Fatalf("failed to decode log result: %v", err)
+	}
+
+	for _, want := range []string{"START RequestId: ", "hello from the handler\n", "END RequestId: ", "REPORT RequestId: "} {
+		if !strings.Contains(string(logs), want) {
+			t.Errorf("expected %q in the log tail, got %q", want, logs)
+		}
+	}
+
+	output, err = client.Invoke(ctx, &lambda.InvokeInput{FunctionName: aws.String(functionName)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if output.LogResult != nil {
+		t.Errorf("expected no log result without LogType Tail, got %q", aws.ToString(output.LogResult))
+	}
+
+	// Only the last 4 KB of a long log is returned.
+	verboseName := functionName + "-verbose"
+	createLambdaFunctionWithEndpoint(t, verboseName, mockServer.URL+"/verbose")
+
+	output, err = client.Invoke(ctx, &lambda.InvokeInput{
+		FunctionName: aws.String(verboseName),
+		LogType:      types.LogTypeTail,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logs, _ = base64.StdEncoding.DecodeString(aws.ToString(output.LogResult))
+	if len(logs) != 4096 || !strings.Contains(string(logs), "REPORT RequestId: ") {
+		t.Errorf("expected the last 4096 bytes of the log, got %d bytes", len(logs))
+	}
+}
+
+func TestLambda_Concurrency(t *testing.T) {
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+
+		<-release
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(mockServer.Close)
+
+	client := newLambdaClient(t)
+	ctx := t.Context()
+	functionName := "test-function-concurrency"
+
+	createLambdaFunctionWithEndpoint(t, functionName, mockServer.URL)
+
+	putOutput, err := client.PutFunctionConcurrency(ctx, &lambda.PutFunctionConcurrencyInput{
+		FunctionName:                 aws.String(functionName),
+		ReservedConcurrentExecutions: aws.Int32(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToInt32(putOutput.ReservedConcurrentExecutions) != 1 {
+		t.Errorf("expected reserved concurrency 1, got %d", aws.ToInt32(putOutput.ReservedConcurrentExecutions))
+	}
+
+	getOutput, err := client.GetFunctionConcurrency(ctx, &lambda.GetFunctionConcurrencyInput{
+		FunctionName: aws.String(functionName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToInt32(getOutput.ReservedConcurrentExecutions) != 1 {
+		t.Errorf("expected reserved concurrency 1, got %d", aws.ToInt32(getOutput.ReservedConcurrentExecutions))
+	}
+
+	fnOutput, err := client.GetFunction(ctx, &lambda.GetFunctionInput{FunctionName: aws.String(functionName)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fnOutput.Concurrency == nil || aws.ToInt32(fnOutput.Concurrency.ReservedConcurrentExecutions) != 1 {
+		t.Errorf("expected GetFunction to return reserved concurrency 1, got %+v", fnOutput.Concurrency)
+	}
+
+	// While one invocation is in flight, another is throttled.
+	done := make(chan error, 1)
+
+	go func() {
+		_, err := client.Invoke(ctx, &lambda.InvokeInput{FunctionName: aws.String(functionName)})
+		done <- err
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the first invocation")
+	}
+
+	_, err = client.Invoke(ctx, &lambda.InvokeInput{FunctionName: aws.String(functionName)},
+		func(o *lambda.Options) { o.RetryMaxAttempts = 1 })
+
+	var throttled *types.TooManyRequestsException
+	if !errors.As(err, &throttled) {
+		t.Fatalf("expected TooManyRequestsException, got %v", err)
+	}
+
+	if throttled.Reason != types.ThrottleReasonReservedFunctionConcurrentInvocationLimitExceeded {
+		t.Errorf("expected reason ReservedFunctionConcurrentInvocationLimitExceeded, got %s", throttled.Reason)
+	}
+
+	close(release)
+
+	if err := <-done; err != nil {
+		t.Fatalf("first invocation failed: %v", err)
+	}
+
+	if _, err := client.Invoke(ctx, &lambda.InvokeInput{FunctionName: aws.String(functionName)}); err != nil {
+		t.Fatalf("invocation after the first one ended failed: %v", err)
+	}
+
+	// Provisioned concurrency applies to published versions and aliases.
+	version, err := client.PublishVersion(ctx, &lambda.PublishVersionInput{FunctionName: aws.String(functionName)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provisioned, err := client.PutProvisionedConcurrencyConfig(ctx, &lambda.PutProvisionedConcurrencyConfigInput{
+		FunctionName:                    aws.String(functionName),
+		Qualifier:                       version.Version,
+		ProvisionedConcurrentExecutions: aws.Int32(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if provisioned.Status != types.ProvisionedConcurrencyStatusEnumReady || aws.ToInt32(provisioned.AllocatedProvisionedConcurrentExecutions) != 1 {
+		t.Errorf("expected 1 ready provisioned execution, got %s with %d", provisioned.Status, aws.ToInt32(provisioned.AllocatedProvisionedConcurrentExecutions))
+	}
+
+	gotProvisioned, err := client.GetProvisionedConcurrencyConfig(ctx, &lambda.GetProvisionedConcurrencyConfigInput{
+		FunctionName: aws.String(functionName),
+		Qualifier:    version.Version,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToInt32(gotProvisioned.RequestedProvisionedConcurrentExecutions) != 1 {
+		t.Errorf("expected 1 requested provisioned execution, got %d", aws.ToInt32(gotProvisioned.RequestedProvisionedConcurrentExecutions))
+	}
+
+	_, err = client.PutProvisionedConcurrencyConfig(ctx, &lambda.PutProvisionedConcurrencyConfigInput{
+		FunctionName:                    aws.String(functionName),
+		Qualifier:                       version.Version,
+		ProvisionedConcurrentExecutions: aws.Int32(2),
+	})
+
+	var invalid *types.InvalidParameterValueException
+	if !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidParameterValueException for provisioned concurrency above the reserved concurrency, got %v", err)
+	}
+
+	_, err = client.PutProvisionedConcurrencyConfig(ctx, &lambda.PutProvisionedConcurrencyConfigInput{
+		FunctionName:                    aws.String(functionName),
+		Qualifier:                       aws.String("$LATEST"),
+		ProvisionedConcurrentExecutions: aws.Int32(1),
+	})
+	if !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidParameterValueException for $LATEST, got %v", err)
+	}
+
+	_, err = client.GetProvisionedConcurrencyConfig(ctx, &lambda.GetProvisionedConcurrencyConfigInput{
+		FunctionName: aws.String(functionName),
+		Qualifier:    aws.String("$LATEST"),
+	})
+
+	var notFound *types.ProvisionedConcurrencyConfigNotFoundException
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected ProvisionedConcurrencyConfigNotFoundException, got %v", err)
+	}
+
+	_, err = client.DeleteFunctionConcurrency(ctx, &lambda.DeleteFunctionConcurrencyInput{
+		FunctionName: aws.String(functionName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getOutput, err = client.GetFunctionConcurrency(ctx, &lambda.GetFunctionConcurrencyInput{
+		FunctionName: aws.String(functionName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if getOutput.ReservedConcurrentExecutions != nil {
+		t.Errorf("expected no reserved concurrency, got %d", aws.ToInt32(getOutput.ReservedConcurrentExecutions))
+	}
+}
+
+//nolint:funlen // Test function exercises the whole tagging lifecycle.
+func TestLambda_Tags(t *testing.T) {
+	client := newLambdaClient(t)
+	ctx := t.Context()
+	functionName := "test-function-tags"
+
+	createOutput, err := client.CreateFunction(ctx, &lambda.CreateFunctionInput{
+		FunctionName: aws.String(functionName),
+		Runtime:      types.RuntimePython312,
+		Role:         aws.String("arn:aws:iam::000000000000:role/test-role"),
+		Handler:      aws.String("index.handler"),
+		Code: &types.FunctionCode{
+			ZipFile: []byte("fake-zip-content"),
+		},
+		Tags: map[string]string{"team": "payments"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteFunction(context.Background(), &lambda.DeleteFunctionInput{
+			FunctionName: aws.String(functionName),
+		})
+	})
+
+	_, err = client.TagResource(ctx, &lambda.TagResourceInput{
+		Resource: createOutput.FunctionArn,
+		Tags:     map[string]string{"env": "test", "team": "billing"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.UntagResource(ctx, &lambda.UntagResourceInput{
+		Resource: createOutput.FunctionArn,
+		TagKeys:  []string{"env"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listOutput, err := client.ListTags(ctx, &lambda.ListTagsInput{
+		Resource: createOutput.FunctionArn,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name()+"_list", listOutput)
+
+	getOutput, err := client.GetFunction(ctx, &lambda.GetFunctionInput{
+		FunctionName: aws.String(functionName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := getOutput.Tags; len(got) != 1 || got["team"] != "billing" {
+		t.Errorf("expected GetFunction to return tags {team: billing}, got %v", got)
+	}
+
+	_, err = client.ListTags(ctx, &lambda.ListTagsInput{
+		Resource: aws.String("arn:aws:lambda:us-east-1:000000000000:function:missing-function"),
+	})
+
+	var notFound *types.ResourceNotFoundException
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected ResourceNotFoundException for an unknown function, got %v", err)
+	}
+}
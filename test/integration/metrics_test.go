@@ -0,0 +1,78 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+)
+
+// actionMetrics is the body of GET /kumo/metrics for an action.
+type actionMetrics struct {
+	Calls  int64 `json:"calls"`
+	Errors int64 `json:"errors"`
+	Faults int64 `json:"faults"`
+}
+
+// getActionMetrics returns the request counts of an action of a service.
+func getActionMetrics(t *testing.T, svc, action string) actionMetrics {
+	t.Helper()
+
+	resp, err := http.Get("http://localhost:4566/kumo/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+
+	var metrics map[string]map[string]actionMetrics
+	if err := json.NewDecoder(resp.Body).Decode(&metrics); err != nil {
+		t.Fatal(err)
+	}
+
+	return metrics[svc][action]
+}
+
+func TestMetricsEndpoint_CountsCalls(t *testing.T) {
+	client := newSQSClient(t)
+	ctx := t.Context()
+
+	createBefore := getActionMetrics(t, "sqs", "CreateQueue")
+	getURLBefore := getActionMetrics(t, "sqs", "GetQueueUrl")
+
+	createOutput, err := client.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName: aws.String("test-queue-metrics"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteQueue(context.Background(), &sqs.DeleteQueueInput{
+			QueueUrl: createOutput.QueueUrl,
+		})
+	})
+
+	_, err = client.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
+		QueueName: aws.String("metrics-missing-queue"),
+	})
+	assertAPIErrorCode(t, err, "AWS.SimpleQueueService.NonExistentQueue")
+
+	create := getActionMetrics(t, "sqs", "CreateQueue")
+	if create.Calls-createBefore.Calls != 1 || create.Errors != createBefore.Errors {
+		t.Errorf("expected one successful CreateQueue call, got %+v then %+v", createBefore, create)
+	}
+
+	getURL := getActionMetrics(t, "sqs", "GetQueueUrl")
+	if getURL.Calls-getURLBefore.Calls != 1 || getURL.Errors-getURLBefore.Errors != 1 {
+		t.Errorf("expected one failed GetQueueUrl call, got %+v then %+v", getURLBefore, getURL)
+	}
+}
@@ -5,11 +5,13 @@ package integration
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/sivchari/golden"
 )
 
@@ -249,6 +251,334 @@ func TestRDS_DeleteDBCluster(t *testing.T) {
 	}
 }
 
+func TestRDS_ModifyDBCluster(t *testing.T) {
+	client := newRDSClient(t)
+	ctx := t.Context()
+
+	clusterID := "test-modify-db-cluster"
+
+	// Create DB cluster
+	createResult, err := client.CreateDBCluster(ctx, &rds.CreateDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+		Engine:              aws.String("aurora-postgresql"),
+		EngineVersion:       aws.String("15.4"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteDBCluster(context.Background(), &rds.DeleteDBClusterInput{
+			DBClusterIdentifier: aws.String(clusterID),
+			SkipFinalSnapshot:   aws.Bool(true),
+		})
+	})
+
+	if got := aws.ToString(createResult.DBCluster.Status); got != "creating" {
+		t.Errorf("expected status creating, got %s", got)
+	}
+
+	// Modifying a cluster that is still creating fails.
+	_, err = client.ModifyDBCluster(ctx, &rds.ModifyDBClusterInput{
+		DBClusterIdentifier:   aws.String(clusterID),
+		BackupRetentionPeriod: aws.Int32(7),
+	})
+	if err == nil {
+		t.Error("expected error when modifying a creating cluster, got nil")
+	}
+
+	waitForDBClusterStatus(t, client, clusterID, "available")
+
+	// Modify DB cluster
+	modifyResult, err := client.ModifyDBCluster(ctx, &rds.ModifyDBClusterInput{
+		DBClusterIdentifier:   aws.String(clusterID),
+		EngineVersion:         aws.String("16.1"),
+		BackupRetentionPeriod: aws.Int32(7),
+		DeletionProtection:    aws.Bool(true),
+		ApplyImmediately:      aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := aws.ToString(modifyResult.DBCluster.Status); got != "upgrading" {
+		t.Errorf("expected status upgrading, got %s", got)
+	}
+
+	cluster := waitForDBClusterStatus(t, client, clusterID, "available")
+
+	if got := aws.ToString(cluster.EngineVersion); got != "16.1" {
+		t.Errorf("expected engine version 16.1, got %s", got)
+	}
+
+	if got := aws.ToInt32(cluster.BackupRetentionPeriod); got != 7 {
+		t.Errorf("expected backup retention period 7, got %d", got)
+	}
+
+	if !aws.ToBool(cluster.DeletionProtection) {
+		t.Error("expected deletion protection to be enabled")
+	}
+
+	// A backup retention period outside 1 to 35 days is rejected.
+	_, err = client.ModifyDBCluster(ctx, &rds.ModifyDBClusterInput{
+		DBClusterIdentifier:   aws.String(clusterID),
+		BackupRetentionPeriod: aws.Int32(36),
+	})
+	if err == nil {
+		t.Error("expected error for backup retention period 36, got nil")
+	}
+}
+
+func TestRDS_DBParameterGroup(t *testing.T) {
+	client := newRDSClient(t)
+	ctx := t.Context()
+
+	groupName := "test-db-parameter-group"
+	instanceID := "test-parameter-group-db-instance"
+
+	// Create DB parameter group
+	createResult, err := client.CreateDBParameterGroup(ctx, &rds.CreateDBParameterGroupInput{
+		DBParameterGroupName:   aws.String(groupName),
+		DBParameterGroupFamily: aws.String("mysql8.0"),
+		Description:            aws.String("test parameter group"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteDBInstance(context.Background(), &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(instanceID),
+			SkipFinalSnapshot:    aws.Bool(true),
+		})
+		_, _ = client.DeleteDBParameterGroup(context.Background(), &rds.DeleteDBParameterGroupInput{
+			DBParameterGroupName: aws.String(groupName),
+		})
+	})
+
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name()+"_create", createResult)
+
+	// Modify DB parameter group
+	_, err = client.ModifyDBParameterGroup(ctx, &rds.ModifyDBParameterGroupInput{
+		DBParameterGroupName: aws.String(groupName),
+		Parameters: []types.Parameter{
+			{
+				ParameterName:  aws.String("max_connections"),
+				ParameterValue: aws.String("200"),
+				ApplyMethod:    types.ApplyMethodImmediate,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paramsResult, err := client.DescribeDBParameters(ctx, &rds.DescribeDBParametersInput{
+		DBParameterGroupName: aws.String(groupName),
+		Source:               aws.String("user"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(paramsResult.Parameters) != 1 || aws.ToString(paramsResult.Parameters[0].ParameterValue) != "200" {
+		t.Errorf("expected max_connections to be 200, got %+v", paramsResult.Parameters)
+	}
+
+	// Describe DB parameter groups
+	descResult, err := client.DescribeDBParameterGroups(ctx, &rds.DescribeDBParameterGroupsInput{
+		DBParameterGroupName: aws.String(groupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name()+"_describe", descResult)
+
+	// A DB instance can only reference an existing parameter group.
+	_, err = client.CreateDBInstance(ctx, &rds.CreateDBInstanceInput{
+		DBInstanceIdentifier: aws.String(instanceID),
+		DBInstanceClass:      aws.String("db.t3.micro"),
+		Engine:               aws.String("mysql"),
+		DBParameterGroupName: aws.String("missing-parameter-group"),
+	})
+	if err == nil {
+		t.Error("expected error when referencing a missing parameter group, got nil")
+	}
+
+	instanceResult, err := client.CreateDBInstance(ctx, &rds.CreateDBInstanceInput{
+		DBInstanceIdentifier: aws.String(instanceID),
+		DBInstanceClass:      aws.String("db.t3.micro"),
+		Engine:               aws.String("mysql"),
+		DBParameterGroupName: aws.String(groupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(instanceResult.DBInstance.DBParameterGroups) != 1 || aws.ToString(instanceResult.DBInstance.DBParameterGroups[0].DBParameterGroupName) != groupName {
+		t.Errorf("expected DB instance to use %s, got %+v", groupName, instanceResult.DBInstance.DBParameterGroups)
+	}
+
+	// A parameter group in use cannot be deleted.
+	_, err = client.DeleteDBParameterGroup(ctx, &rds.DeleteDBParameterGroupInput{
+		DBParameterGroupName: aws.String(groupName),
+	})
+	if err == nil {
+		t.Error("expected error when deleting a parameter group in use, got nil")
+	}
+
+	_, err = client.DeleteDBInstance(ctx, &rds.DeleteDBInstanceInput{
+		DBInstanceIdentifier: aws.String(instanceID),
+		SkipFinalSnapshot:    aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Delete DB parameter group
+	_, err = client.DeleteDBParameterGroup(ctx, &rds.DeleteDBParameterGroupInput{
+		DBParameterGroupName: aws.String(groupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.DescribeDBParameterGroups(ctx, &rds.DescribeDBParameterGroupsInput{
+		DBParameterGroupName: aws.String(groupName),
+	})
+	if err == nil {
+		t.Error("expected error when describing deleted parameter group, got nil")
+	}
+}
+
+func TestRDS_DBSubnetGroup(t *testing.T) {
+	client := newRDSClient(t)
+	ctx := t.Context()
+
+	groupName := "test-db-subnet-group"
+	clusterID := "test-subnet-group-db-cluster"
+
+	// Create DB subnet group
+	createResult, err := client.CreateDBSubnetGroup(ctx, &rds.CreateDBSubnetGroupInput{
+		DBSubnetGroupName:        aws.String(groupName),
+		DBSubnetGroupDescription: aws.String("test subnet group"),
+		SubnetIds:                []string{"subnet-11111111", "subnet-22222222"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteDBCluster(context.Background(), &rds.DeleteDBClusterInput{
+			DBClusterIdentifier: aws.String(clusterID),
+			SkipFinalSnapshot:   aws.Bool(true),
+		})
+		_, _ = client.DeleteDBSubnetGroup(context.Background(), &rds.DeleteDBSubnetGroupInput{
+			DBSubnetGroupName: aws.String(groupName),
+		})
+	})
+
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name()+"_create", createResult)
+
+	// Modify DB subnet group
+	_, err = client.ModifyDBSubnetGroup(ctx, &rds.ModifyDBSubnetGroupInput{
+		DBSubnetGroupName:        aws.String(groupName),
+		DBSubnetGroupDescription: aws.String("modified subnet group"),
+		SubnetIds:                []string{"subnet-33333333", "subnet-44444444", "subnet-55555555"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Describe DB subnet groups
+	descResult, err := client.DescribeDBSubnetGroups(ctx, &rds.DescribeDBSubnetGroupsInput{
+		DBSubnetGroupName: aws.String(groupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name()+"_describe", descResult)
+
+	// A DB cluster can only reference an existing subnet group.
+	_, err = client.CreateDBCluster(ctx, &rds.CreateDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+		Engine:              aws.String("aurora-mysql"),
+		DBSubnetGroupName:   aws.String("missing-subnet-group"),
+	})
+	if err == nil {
+		t.Error("expected error when referencing a missing subnet group, got nil")
+	}
+
+	clusterResult, err := client.CreateDBCluster(ctx, &rds.CreateDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+		Engine:              aws.String("aurora-mysql"),
+		DBSubnetGroupName:   aws.String(groupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := aws.ToString(clusterResult.DBCluster.DBSubnetGroup); got != groupName {
+		t.Errorf("expected DB cluster to use %s, got %s", groupName, got)
+	}
+
+	// A subnet group in use cannot be deleted.
+	_, err = client.DeleteDBSubnetGroup(ctx, &rds.DeleteDBSubnetGroupInput{
+		DBSubnetGroupName: aws.String(groupName),
+	})
+	if err == nil {
+		t.Error("expected error when deleting a subnet group in use, got nil")
+	}
+
+	_, err = client.DeleteDBCluster(ctx, &rds.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+		SkipFinalSnapshot:   aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Delete DB subnet group
+	_, err = client.DeleteDBSubnetGroup(ctx, &rds.DeleteDBSubnetGroupInput{
+		DBSubnetGroupName: aws.String(groupName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func waitForDBClusterStatus(t *testing.T, client *rds.Client, clusterID, status string) *types.DBCluster {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+
+	for {
+		output, err := client.DescribeDBClusters(t.Context(), &rds.DescribeDBClustersInput{
+			DBClusterIdentifier: aws.String(clusterID),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(output.DBClusters) != 1 {
+			t.Fatalf("expected 1 DB cluster, got %d", len(output.DBClusters))
+		}
+
+		cluster := &output.DBClusters[0]
+		if aws.ToString(cluster.Status) == status {
+			return cluster
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("DB cluster %s did not reach %s, last status %s", clusterID, status, aws.ToString(cluster.Status))
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
 func TestRDS_CreateAndDeleteDBSnapshot(t *testing.T) {
 	client := newRDSClient(t)
 	ctx := t.Context()
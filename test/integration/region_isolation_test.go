@@ -0,0 +1,180 @@
+//go:build integration
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+)
+
+// isolatedEndpoint is the endpoint of a kumo server started with KUMO_REGION_ISOLATION=true.
+const isolatedEndpoint = "http://localhost:4567"
+
+// isolatedRegion is a region other than the server's default region, so that
+// its requests are served by the services created for it.
+const isolatedRegion = "eu-west-1"
+
+// newIsolatedConfig returns the configuration of clients of the region-isolated
+// server in isolatedRegion, skipping the test when that server is not running.
+func newIsolatedConfig(t *testing.T) aws.Config {
+	t.Helper()
+
+	resp, err := http.Get(isolatedEndpoint + "/health")
+	if err != nil {
+		t.Skipf("region-isolated kumo is not running at %s: %v", isolatedEndpoint, err)
+	}
+
+	resp.Body.Close()
+
+	cfg, err := config.LoadDefaultConfig(t.Context(),
+		config.WithRegion(isolatedRegion),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			"test", "test", "",
+		)),
+	)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	return cfg
+}
+
+func TestRegionIsolation_SNSToSQSDelivery(t *testing.T) {
+	cfg := newIsolatedConfig(t)
+	ctx := t.Context()
+
+	snsClient := sns.NewFromConfig(cfg, func(o *sns.Options) {
+		o.BaseEndpoint = aws.String(isolatedEndpoint)
+	})
+	sqsClient := sqs.NewFromConfig(cfg, func(o *sqs.Options) {
+		o.BaseEndpoint = aws.String(isolatedEndpoint)
+	})
+
+	queueOutput, err := sqsClient.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName: aws.String("test-isolated-delivery-queue"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = sqsClient.DeleteQueue(context.Background(), &sqs.DeleteQueueInput{
+			QueueUrl: queueOutput.QueueUrl,
+		})
+	})
+
+	topicOutput, err := snsClient.CreateTopic(ctx, &sns.CreateTopicInput{
+		Name: aws.String("test-isolated-delivery-topic"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = snsClient.DeleteTopic(context.Background(), &sns.DeleteTopicInput{
+			TopicArn: topicOutput.TopicArn,
+		})
+	})
+
+	_, err = snsClient.Subscribe(ctx, &sns.SubscribeInput{
+		TopicArn: topicOutput.TopicArn,
+		Protocol: aws.String("sqs"),
+		Endpoint: aws.String("arn:aws:sqs:" + isolatedRegion + ":000000000000:test-isolated-delivery-queue"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = snsClient.Publish(ctx, &sns.PublishInput{
+		TopicArn: topicOutput.TopicArn,
+		Message:  aws.String("Hello from " + isolatedRegion),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The message reaches the queue of the region the topic is in.
+	receiveOutput, err := sqsClient.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:        queueOutput.QueueUrl,
+		WaitTimeSeconds: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(receiveOutput.Messages) != 1 {
+		t.Fatalf("expected 1 message in the %s queue, got %d", isolatedRegion, len(receiveOutput.Messages))
+	}
+}
+
+func TestRegionIsolation_StateEndpoint(t *testing.T) {
+	cfg := newIsolatedConfig(t)
+	ctx := t.Context()
+
+	sqsClient := sqs.NewFromConfig(cfg, func(o *sqs.Options) {
+		o.BaseEndpoint = aws.String(isolatedEndpoint)
+	})
+
+	queueOutput, err := sqsClient.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName: aws.String("test-isolated-state-queue"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = sqsClient.DeleteQueue(context.Background(), &sqs.DeleteQueueInput{
+			QueueUrl: queueOutput.QueueUrl,
+		})
+	})
+
+	resp, err := http.Get(isolatedEndpoint + "/kumo/state?service=sqs")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("failed to save state: status %d: %s", resp.StatusCode, state)
+	}
+
+	_, err = sqsClient.DeleteQueue(ctx, &sqs.DeleteQueueInput{
+		QueueUrl: queueOutput.QueueUrl,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err = http.Post(isolatedEndpoint+"/kumo/state", "application/json", bytes.NewReader(state))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("failed to restore state: status %d", resp.StatusCode)
+	}
+
+	// The queue is restored in the region it was created in.
+	_, err = sqsClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl: queueOutput.QueueUrl,
+	})
+	if err != nil {
+		t.Errorf("expected the %s queue to be restored: %v", isolatedRegion, err)
+	}
+}
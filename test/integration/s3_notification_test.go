@@ -0,0 +1,197 @@
+//go:build integration
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+)
+
+type s3EventRecords struct {
+	Records []struct {
+		EventSource string `json:"eventSource"`
+		EventName   string `json:"eventName"`
+		S3          struct {
+			ConfigurationID string `json:"configurationId"`
+			Bucket          struct {
+				Name string `json:"name"`
+				ARN  string `json:"arn"`
+			} `json:"bucket"`
+			Object struct {
+				Key  string `json:"key"`
+				Size int64  `json:"size"`
+				ETag string `json:"eTag"`
+			} `json:"object"`
+		} `json:"s3"`
+	} `json:"Records"`
+}
+
+func TestS3_EventNotifications(t *testing.T) {
+	s3Client := newS3Client(t)
+	sqsClient := newSQSClient(t)
+	ctx := t.Context()
+
+	bucketName := "s3-event-notification-test"
+	queueName := "s3-event-notification-queue"
+	functionName := "s3-event-notification-function"
+
+	invoked := make(chan []byte, 10)
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		invoked <- body
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(mockServer.Close)
+
+	createLambdaFunctionWithEndpoint(t, functionName, mockServer.URL)
+	queueURL := createLambdaDestinationQueue(t, sqsClient, queueName)
+
+	if _, err := s3Client.CreateBucket(ctx, &s3.CreateBucketInput{Bucket: aws.String(bucketName)}); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		for _, key := range []string{"uploads/data.csv", "uploads/readme.txt", "other/data.csv", "uploads/my data.csv"} {
+			_, _ = s3Client.DeleteObject(context.Background(), &s3.DeleteObjectInput{Bucket: aws.String(bucketName), Key: aws.String(key)})
+		}
+
+		_, _ = s3Client.DeleteBucket(context.Background(), &s3.DeleteBucketInput{Bucket: aws.String(bucketName)})
+	})
+
+	_, err := s3Client.PutBucketNotificationConfiguration(ctx, &s3.PutBucketNotificationConfigurationInput{
+		Bucket: aws.String(bucketName),
+		NotificationConfiguration: &s3types.NotificationConfiguration{
+			QueueConfigurations: []s3types.QueueConfiguration{{
+				Id:       aws.String("csv-uploads"),
+				QueueArn: aws.String("arn:aws:sqs:us-east-1:000000000000:" + queueName),
+				Events:   []s3types.Event{"s3:ObjectCreated:*"},
+				Filter: &s3types.NotificationConfigurationFilter{
+					Key: &s3types.S3KeyFilter{FilterRules: []s3types.FilterRule{
+						{Name: s3types.FilterRuleNamePrefix, Value: aws.String("uploads/")},
+						{Name: s3types.FilterRuleNameSuffix, Value: aws.String(".csv")},
+					}},
+				},
+			}},
+			LambdaFunctionConfigurations: []s3types.LambdaFunctionConfiguration{{
+				LambdaFunctionArn: aws.String("arn:aws:lambda:us-east-1:000000000000:function:" + functionName),
+				Events:            []s3types.Event{"s3:ObjectRemoved:Delete"},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := s3Client.GetBucketNotificationConfiguration(ctx, &s3.GetBucketNotificationConfigurationInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(config.QueueConfigurations) != 1 || aws.ToString(config.QueueConfigurations[0].Id) != "csv-uploads" {
+		t.Fatalf("unexpected queue configurations: %+v", config.QueueConfigurations)
+	}
+
+	if rules := config.QueueConfigurations[0].Filter.Key.FilterRules; len(rules) != 2 {
+		t.Errorf("expected 2 filter rules, got %+v", rules)
+	}
+
+	if len(config.LambdaFunctionConfigurations) != 1 || aws.ToString(config.LambdaFunctionConfigurations[0].Id) == "" {
+		t.Fatalf("expected a Lambda function configuration with a generated ID, got %+v", config.LambdaFunctionConfigurations)
+	}
+
+	// Objects outside the filter are not delivered, so the first message is for the matching key.
+	for _, key := range []string{"uploads/readme.txt", "other/data.csv", "uploads/my data.csv"} {
+		_, err = s3Client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(key),
+			Body:   bytes.NewReader([]byte("a,b,c")),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	record := receiveS3EventRecord(t, sqsClient, queueURL)
+	if record.Records[0].EventName != "ObjectCreated:Put" || record.Records[0].EventSource != "aws:s3" {
+		t.Errorf("unexpected event: %+v", record.Records[0])
+	}
+
+	s3Entity := record.Records[0].S3
+	if s3Entity.ConfigurationID != "csv-uploads" || s3Entity.Bucket.Name != bucketName || s3Entity.Bucket.ARN != "arn:aws:s3:::"+bucketName {
+		t.Errorf("unexpected configuration or bucket: %+v", s3Entity)
+	}
+
+	if s3Entity.Object.Key != "uploads/my+data.csv" || s3Entity.Object.Size != 5 || s3Entity.Object.ETag == "" {
+		t.Errorf("unexpected object: %+v", s3Entity.Object)
+	}
+
+	_, err = s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("other/data.csv"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case body := <-invoked:
+		var event s3EventRecords
+		if err := json.Unmarshal(body, &event); err != nil {
+			t.Fatal(err)
+		}
+
+		if len(event.Records) != 1 || event.Records[0].EventName != "ObjectRemoved:Delete" || event.Records[0].S3.Object.Key != "other/data.csv" {
+			t.Errorf("unexpected Lambda event: %s", body)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the function to be invoked with the delete event")
+	}
+}
+
+func receiveS3EventRecord(t *testing.T, client *sqs.Client, queueURL string) s3EventRecords {
+	t.Helper()
+
+	for range 10 {
+		output, err := client.ReceiveMessage(t.Context(), &sqs.ReceiveMessageInput{
+			QueueUrl:        aws.String(queueURL),
+			WaitTimeSeconds: 1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(output.Messages) == 0 {
+			continue
+		}
+
+		var records s3EventRecords
+		if err := json.Unmarshal([]byte(aws.ToString(output.Messages[0].Body)), &records); err != nil {
+			t.Fatal(err)
+		}
+
+		if len(records.Records) != 1 {
+			t.Fatalf("expected 1 record, got %d", len(records.Records))
+		}
+
+		return records
+	}
+
+	t.Fatal("expected an S3 event notification, but none was received")
+
+	return s3EventRecords{}
+}
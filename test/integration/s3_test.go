@@ -5,6 +5,8 @@ package integration
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	"encoding/base64"
 	"io"
 	"net/http"
 	"strings"
@@ -38,6 +40,27 @@ func newS3Client(t *testing.T) *s3.Client {
 	})
 }
 
+// deleteS3Bucket removes the objects in the bucket and the bucket itself.
+func deleteS3Bucket(client *s3.Client, bucketName string) {
+	ctx := context.Background()
+
+	listOutput, err := client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+	})
+	if err == nil {
+		for _, obj := range listOutput.Contents {
+			_, _ = client.DeleteObject(ctx, &s3.DeleteObjectInput{
+				Bucket: aws.String(bucketName),
+				Key:    obj.Key,
+			})
+		}
+	}
+
+	_, _ = client.DeleteBucket(ctx, &s3.DeleteBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+}
+
 func TestS3_CreateAndDeleteBucket(t *testing.T) {
 	client := newS3Client(t)
 	ctx := t.Context()
@@ -1041,7 +1064,7 @@ func TestS3_MultipartUpload_ListMultipartUploads(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	golden.New(t, golden.WithIgnoreFields("UploadId", "Initiated", "ResultMetadata")).Assert(t.Name(), listResult)
+	golden.New(t, golden.WithIgnoreFields("UploadId", "NextUploadIdMarker", "Initiated", "ResultMetadata")).Assert(t.Name(), listResult)
 
 	// Cleanup
 	_, _ = client.AbortMultipartUpload(context.Background(), &s3.AbortMultipartUploadInput{
@@ -1427,3 +1450,579 @@ func TestS3_PutAndGetObjectTagging(t *testing.T) {
 		t.Errorf("body = %q, want %q", string(body), "original-body")
 	}
 }
+
+func TestS3_ServerSideEncryption(t *testing.T) {
+	client := newS3Client(t)
+	ctx := t.Context()
+	bucketName := "test-sse-bucket"
+
+	_, err := client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	putOutput, err := client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:               aws.String(bucketName),
+		Key:                  aws.String("kms.txt"),
+		Body:                 strings.NewReader("secret"),
+		ServerSideEncryption: types.ServerSideEncryptionAwsKms,
+		SSEKMSKeyId:          aws.String("alias/test-key"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if putOutput.ServerSideEncryption != types.ServerSideEncryptionAwsKms {
+		t.Errorf("PutObject ServerSideEncryption = %q, want %q", putOutput.ServerSideEncryption, types.ServerSideEncryptionAwsKms)
+	}
+
+	getOutput, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("kms.txt"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer getOutput.Body.Close()
+
+	if getOutput.ServerSideEncryption != types.ServerSideEncryptionAwsKms || aws.ToString(getOutput.SSEKMSKeyId) != "alias/test-key" {
+		t.Errorf("GetObject encryption = %q %q, want aws:kms alias/test-key", getOutput.ServerSideEncryption, aws.ToString(getOutput.SSEKMSKeyId))
+	}
+
+	headOutput, err := client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("kms.txt"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if headOutput.ServerSideEncryption != types.ServerSideEncryptionAwsKms || aws.ToString(headOutput.SSEKMSKeyId) != "alias/test-key" {
+		t.Errorf("HeadObject encryption = %q %q, want aws:kms alias/test-key", headOutput.ServerSideEncryption, aws.ToString(headOutput.SSEKMSKeyId))
+	}
+}
+
+func TestS3_ServerSideEncryptionCustomerKey(t *testing.T) {
+	client := newS3Client(t)
+	ctx := t.Context()
+	bucketName := "test-sse-c-bucket"
+
+	_, err := client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	customerKey := func(b byte) (key, keyMD5 string) {
+		raw := bytes.Repeat([]byte{b}, 32)
+		sum := md5.Sum(raw)
+
+		return base64.StdEncoding.EncodeToString(raw), base64.StdEncoding.EncodeToString(sum[:])
+	}
+
+	key, keyMD5 := customerKey('a')
+	otherKey, otherKeyMD5 := customerKey('b')
+
+	_, err = client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:               aws.String(bucketName),
+		Key:                  aws.String("sse-c.txt"),
+		Body:                 strings.NewReader("secret"),
+		SSECustomerAlgorithm: aws.String("AES256"),
+		SSECustomerKey:       aws.String(key),
+		SSECustomerKeyMD5:    aws.String(keyMD5),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getOutput, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket:               aws.String(bucketName),
+		Key:                  aws.String("sse-c.txt"),
+		SSECustomerAlgorithm: aws.String("AES256"),
+		SSECustomerKey:       aws.String(key),
+		SSECustomerKeyMD5:    aws.String(keyMD5),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer getOutput.Body.Close()
+
+	if aws.ToString(getOutput.SSECustomerAlgorithm) != "AES256" || aws.ToString(getOutput.SSECustomerKeyMD5) != keyMD5 {
+		t.Errorf("GetObject SSE-C = %q %q, want AES256 %q", aws.ToString(getOutput.SSECustomerAlgorithm), aws.ToString(getOutput.SSECustomerKeyMD5), keyMD5)
+	}
+
+	_, err = client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket:               aws.String(bucketName),
+		Key:                  aws.String("sse-c.txt"),
+		SSECustomerAlgorithm: aws.String("AES256"),
+		SSECustomerKey:       aws.String(otherKey),
+		SSECustomerKeyMD5:    aws.String(otherKeyMD5),
+	})
+	assertAPIErrorCode(t, err, "AccessDenied")
+
+	_, err = client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("sse-c.txt"),
+	})
+	assertAPIErrorCode(t, err, "InvalidRequest")
+}
+
+func TestS3_BucketPolicy(t *testing.T) {
+	client := newS3Client(t)
+	ctx := t.Context()
+	bucketName := "test-bucket-policy"
+
+	_, err := client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucketName),
+	})
+	assertAPIErrorCode(t, err, "NoSuchBucketPolicy")
+
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::test-bucket-policy/*"}]}`
+
+	_, err = client.PutBucketPolicy(ctx, &s3.PutBucketPolicyInput{
+		Bucket: aws.String(bucketName),
+		Policy: aws.String(policy),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getOutput, err := client.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(getOutput.Policy) != policy {
+		t.Errorf("GetBucketPolicy = %s, want %s", aws.ToString(getOutput.Policy), policy)
+	}
+
+	_, err = client.PutBucketPolicy(ctx, &s3.PutBucketPolicyInput{
+		Bucket: aws.String(bucketName),
+		Policy: aws.String("not json"),
+	})
+	assertAPIErrorCode(t, err, "MalformedPolicy")
+
+	_, err = client.DeleteBucketPolicy(ctx, &s3.DeleteBucketPolicyInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucketName),
+	})
+	assertAPIErrorCode(t, err, "NoSuchBucketPolicy")
+
+	// The bucket itself is still there.
+	_, err = client.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestS3_BucketAndObjectACL(t *testing.T) {
+	client := newS3Client(t)
+	ctx := t.Context()
+	bucketName := "test-acl-bucket"
+
+	_, err := client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucketName),
+		ACL:    types.BucketCannedACLPrivate,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bucketACL, err := client.GetBucketAcl(ctx, &s3.GetBucketAclInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(bucketACL.Grants) != 1 || bucketACL.Grants[0].Permission != types.PermissionFullControl ||
+		bucketACL.Grants[0].Grantee.Type != types.TypeCanonicalUser || aws.ToString(bucketACL.Owner.ID) == "" {
+		t.Errorf("unexpected private bucket ACL: %+v", bucketACL)
+	}
+
+	_, err = client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("public.txt"),
+		Body:   strings.NewReader("hello"),
+		ACL:    types.ObjectCannedACLPublicRead,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	objectACL, err := client.GetObjectAcl(ctx, &s3.GetObjectAclInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("public.txt"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(objectACL.Grants) != 2 || objectACL.Grants[1].Grantee.Type != types.TypeGroup ||
+		aws.ToString(objectACL.Grants[1].Grantee.URI) != "http://acs.amazonaws.com/groups/global/AllUsers" ||
+		objectACL.Grants[1].Permission != types.PermissionRead {
+		t.Errorf("unexpected public-read object ACL: %+v", objectACL.Grants)
+	}
+
+	_, err = client.PutObjectAcl(ctx, &s3.PutObjectAclInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("public.txt"),
+		AccessControlPolicy: &types.AccessControlPolicy{
+			Owner: bucketACL.Owner,
+			Grants: []types.Grant{
+				{
+					Grantee:    &types.Grantee{Type: types.TypeCanonicalUser, ID: bucketACL.Owner.ID},
+					Permission: types.PermissionRead,
+				},
+				{
+					Grantee:    &types.Grantee{Type: types.TypeGroup, URI: aws.String("http://acs.amazonaws.com/groups/global/AuthenticatedUsers")},
+					Permission: types.PermissionReadAcp,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	objectACL, err = client.GetObjectAcl(ctx, &s3.GetObjectAclInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("public.txt"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(objectACL.Grants) != 2 || objectACL.Grants[0].Permission != types.PermissionRead ||
+		objectACL.Grants[1].Grantee.Type != types.TypeGroup || objectACL.Grants[1].Permission != types.PermissionReadAcp {
+		t.Errorf("unexpected object ACL after PutObjectAcl: %+v", objectACL.Grants)
+	}
+
+	_, err = client.PutBucketAcl(ctx, &s3.PutBucketAclInput{
+		Bucket: aws.String(bucketName),
+		ACL:    types.BucketCannedACLPublicRead,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bucketACL, err = client.GetBucketAcl(ctx, &s3.GetBucketAclInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(bucketACL.Grants) != 2 {
+		t.Errorf("expected 2 grants for public-read bucket, got %+v", bucketACL.Grants)
+	}
+
+	_, err = client.GetObjectAcl(ctx, &s3.GetObjectAclInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("missing.txt"),
+	})
+	assertAPIErrorCode(t, err, "NoSuchKey")
+}
+
+func TestS3_BucketWebsite(t *testing.T) {
+	client := newS3Client(t)
+	ctx := t.Context()
+	bucketName := "test-website-bucket"
+
+	_, err := client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetBucketWebsite(ctx, &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	})
+	assertAPIErrorCode(t, err, "NoSuchWebsiteConfiguration")
+
+	_, err = client.PutBucketWebsite(ctx, &s3.PutBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+		WebsiteConfiguration: &types.WebsiteConfiguration{
+			IndexDocument: &types.IndexDocument{Suffix: aws.String("index.html")},
+			ErrorDocument: &types.ErrorDocument{Key: aws.String("error.html")},
+			RoutingRules: []types.RoutingRule{
+				{
+					Condition: &types.Condition{KeyPrefixEquals: aws.String("docs/")},
+					Redirect:  &types.Redirect{ReplaceKeyPrefixWith: aws.String("documents/")},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	website, err := client.GetBucketWebsite(ctx, &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(website.IndexDocument.Suffix) != "index.html" || aws.ToString(website.ErrorDocument.Key) != "error.html" {
+		t.Errorf("unexpected website documents: %+v %+v", website.IndexDocument, website.ErrorDocument)
+	}
+
+	if len(website.RoutingRules) != 1 || aws.ToString(website.RoutingRules[0].Redirect.ReplaceKeyPrefixWith) != "documents/" {
+		t.Errorf("unexpected routing rules: %+v", website.RoutingRules)
+	}
+
+	_, err = client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String("index.html"),
+		Body:        strings.NewReader("<h1>hello</h1>"),
+		ContentType: aws.String("text/html"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get("http://localhost:4566/" + bucketName + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || string(body) != "<h1>hello</h1>" || resp.Header.Get("Content-Type") != "text/html" {
+		t.Errorf("GET / = %d %q %q, want the index document", resp.StatusCode, resp.Header.Get("Content-Type"), body)
+	}
+
+	_, err = client.DeleteBucketWebsite(ctx, &s3.DeleteBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetBucketWebsite(ctx, &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	})
+	assertAPIErrorCode(t, err, "NoSuchWebsiteConfiguration")
+}
+
+func TestS3_GetObjectAttributes(t *testing.T) {
+	client := newS3Client(t)
+	ctx := t.Context()
+	bucketName := "test-object-attributes"
+
+	_, err := client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	putOutput, err := client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("single.txt"),
+		Body:   strings.NewReader("hello"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attrs, err := client.GetObjectAttributes(ctx, &s3.GetObjectAttributesInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("single.txt"),
+		ObjectAttributes: []types.ObjectAttributes{
+			types.ObjectAttributesEtag, types.ObjectAttributesObjectSize, types.ObjectAttributesStorageClass,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(attrs.ETag) != strings.Trim(aws.ToString(putOutput.ETag), `"`) || aws.ToInt64(attrs.ObjectSize) != 5 ||
+		attrs.StorageClass != types.StorageClassStandard || attrs.ObjectParts != nil {
+		t.Errorf("unexpected attributes: ETag=%q ObjectSize=%d StorageClass=%q ObjectParts=%+v",
+			aws.ToString(attrs.ETag), aws.ToInt64(attrs.ObjectSize), attrs.StorageClass, attrs.ObjectParts)
+	}
+
+	createOutput, err := client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("multipart.bin"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var completed []types.CompletedPart
+
+	for i, content := range []string{"aaa", "bb", "c"} {
+		partOutput, err := client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:     aws.String(bucketName),
+			Key:        aws.String("multipart.bin"),
+			UploadId:   createOutput.UploadId,
+			PartNumber: aws.Int32(int32(i + 1)),
+			Body:       strings.NewReader(content),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		completed = append(completed, types.CompletedPart{PartNumber: aws.Int32(int32(i + 1)), ETag: partOutput.ETag})
+	}
+
+	_, err = client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bucketName),
+		Key:             aws.String("multipart.bin"),
+		UploadId:        createOutput.UploadId,
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: completed},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attrs, err = client.GetObjectAttributes(ctx, &s3.GetObjectAttributesInput{
+		Bucket:           aws.String(bucketName),
+		Key:              aws.String("multipart.bin"),
+		ObjectAttributes: []types.ObjectAttributes{types.ObjectAttributesObjectParts, types.ObjectAttributesObjectSize},
+		MaxParts:         aws.Int32(2),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts := attrs.ObjectParts
+	if aws.ToInt64(attrs.ObjectSize) != 6 || parts == nil || aws.ToInt32(parts.TotalPartsCount) != 3 ||
+		!aws.ToBool(parts.IsTruncated) || len(parts.Parts) != 2 || aws.ToString(parts.NextPartNumberMarker) != "2" {
+		t.Fatalf("unexpected first page of parts: ObjectSize=%d %+v", aws.ToInt64(attrs.ObjectSize), parts)
+	}
+
+	attrs, err = client.GetObjectAttributes(ctx, &s3.GetObjectAttributesInput{
+		Bucket:           aws.String(bucketName),
+		Key:              aws.String("multipart.bin"),
+		ObjectAttributes: []types.ObjectAttributes{types.ObjectAttributesObjectParts},
+		MaxParts:         aws.Int32(2),
+		PartNumberMarker: parts.NextPartNumberMarker,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts = attrs.ObjectParts
+	if aws.ToBool(parts.IsTruncated) || len(parts.Parts) != 1 || aws.ToInt32(parts.Parts[0].PartNumber) != 3 || aws.ToInt64(parts.Parts[0].Size) != 1 {
+		t.Errorf("unexpected second page of parts: %+v", parts)
+	}
+}
+
+func TestS3_MultipartUpload_Pagination(t *testing.T) {
+	client := newS3Client(t)
+	ctx := t.Context()
+	bucketName := "test-multipart-pagination"
+
+	_, err := client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two uploads of the same key make the upload ID marker matter.
+	keys := []string{"a.bin", "b.bin", "b.bin", "c.bin", "d.bin"}
+	for _, key := range keys {
+		_, err := client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var (
+		listedKeys []string
+		pages      int
+		uploadID   *string
+	)
+
+	uploads := s3.NewListMultipartUploadsPaginator(client, &s3.ListMultipartUploadsInput{
+		Bucket:     aws.String(bucketName),
+		MaxUploads: aws.Int32(2),
+	})
+	for uploads.HasMorePages() {
+		page, err := uploads.NextPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pages++
+
+		for _, u := range page.Uploads {
+			listedKeys = append(listedKeys, aws.ToString(u.Key))
+			uploadID = u.UploadId
+		}
+	}
+
+	if pages != 3 || strings.Join(listedKeys, ",") != strings.Join(keys, ",") {
+		t.Errorf("listed uploads %v in %d pages, want %v in 3 pages", listedKeys, pages, keys)
+	}
+
+	// Upload parts to the last upload, of d.bin.
+	for i := 1; i <= 5; i++ {
+		_, err := client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:     aws.String(bucketName),
+			Key:        aws.String("d.bin"),
+			UploadId:   uploadID,
+			PartNumber: aws.Int32(int32(i)),
+			Body:       strings.NewReader(strings.Repeat("x", i)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var partNumbers []int32
+
+	pages = 0
+
+	parts := s3.NewListPartsPaginator(client, &s3.ListPartsInput{
+		Bucket:   aws.String(bucketName),
+		Key:      aws.String("d.bin"),
+		UploadId: uploadID,
+		MaxParts: aws.Int32(2),
+	})
+	for parts.HasMorePages() {
+		page, err := parts.NextPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pages++
+
+		for _, p := range page.Parts {
+			partNumbers = append(partNumbers, aws.ToInt32(p.PartNumber))
+		}
+	}
+
+	if pages != 3 || len(partNumbers) != 5 || partNumbers[0] != 1 || partNumbers[4] != 5 {
+		t.Errorf("listed parts %v in %d pages, want 1-5 in 3 pages", partNumbers, pages)
+	}
+}
@@ -4,12 +4,15 @@ package integration
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
 	"github.com/sivchari/golden"
 )
 
@@ -84,7 +87,7 @@ func TestS3Tables_GetTableBucket(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	golden.New(t, golden.WithIgnoreFields("Arn", "CreatedAt", "ResultMetadata")).Assert(t.Name(), getResult)
+	golden.New(t, golden.WithIgnoreFields("Arn", "TableBucketId", "CreatedAt", "ResultMetadata")).Assert(t.Name(), getResult)
 }
 
 func TestS3Tables_ListTableBuckets(t *testing.T) {
@@ -400,7 +403,7 @@ func TestS3Tables_GetTable(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	golden.New(t, golden.WithIgnoreFields("TableBucketARN", "TableARN", "VersionToken", "CreatedAt", "ModifiedAt", "ResultMetadata")).Assert(t.Name(), getResult)
+	golden.New(t, golden.WithIgnoreFields("TableBucketARN", "TableARN", "VersionToken", "WarehouseLocation", "CreatedAt", "ModifiedAt", "ResultMetadata")).Assert(t.Name(), getResult)
 }
 
 func TestS3Tables_ListTables(t *testing.T) {
@@ -566,3 +569,169 @@ func TestS3Tables_TableNotFound(t *testing.T) {
 		t.Fatal("expected error for non-existent table")
 	}
 }
+
+func TestS3Tables_IcebergTable(t *testing.T) {
+	client := newS3TablesClient(t)
+	ctx := t.Context()
+	bucketName := "test-iceberg-table-bucket"
+	namespaceName := "testicebergnamespace"
+	tableNames := []string{"events", "users"}
+
+	createBucketResult, err := client.CreateTableBucket(ctx, &s3tables.CreateTableBucketInput{
+		Name: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	arn := *createBucketResult.Arn
+
+	t.Cleanup(func() {
+		for _, name := range tableNames {
+			_, _ = client.DeleteTable(context.Background(), &s3tables.DeleteTableInput{
+				TableBucketARN: aws.String(arn),
+				Namespace:      aws.String(namespaceName),
+				Name:           aws.String(name),
+			})
+		}
+		_, _ = client.DeleteNamespace(context.Background(), &s3tables.DeleteNamespaceInput{
+			TableBucketARN: aws.String(arn),
+			Namespace:      aws.String(namespaceName),
+		})
+		_, _ = client.DeleteTableBucket(context.Background(), &s3tables.DeleteTableBucketInput{
+			TableBucketARN: aws.String(arn),
+		})
+	})
+
+	_, err = client.CreateNamespace(ctx, &s3tables.CreateNamespaceInput{
+		TableBucketARN: aws.String(arn),
+		Namespace:      []string{namespaceName},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A schema field with an unknown type is rejected.
+	_, err = client.CreateTable(ctx, &s3tables.CreateTableInput{
+		TableBucketARN: aws.String(arn),
+		Namespace:      aws.String(namespaceName),
+		Name:           aws.String("invalid"),
+		Format:         types.OpenTableFormatIceberg,
+		Metadata: &types.TableMetadataMemberIceberg{Value: types.IcebergMetadata{
+			Schema: &types.IcebergSchema{Fields: []types.SchemaField{
+				{Name: aws.String("id"), Type: aws.String("varchar")},
+			}},
+		}},
+	})
+
+	var badRequest *types.BadRequestException
+	if !errors.As(err, &badRequest) {
+		t.Fatalf("expected BadRequestException, got %v", err)
+	}
+
+	createResults := make([]*s3tables.CreateTableOutput, 0, len(tableNames))
+
+	for _, name := range tableNames {
+		createResult, err := client.CreateTable(ctx, &s3tables.CreateTableInput{
+			TableBucketARN: aws.String(arn),
+			Namespace:      aws.String(namespaceName),
+			Name:           aws.String(name),
+			Format:         types.OpenTableFormatIceberg,
+			Metadata: &types.TableMetadataMemberIceberg{Value: types.IcebergMetadata{
+				Schema: &types.IcebergSchema{Fields: []types.SchemaField{
+					{Name: aws.String("id"), Type: aws.String("long"), Required: true},
+					{Name: aws.String("name"), Type: aws.String("string")},
+				}},
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		createResults = append(createResults, createResult)
+	}
+
+	locationResult, err := client.GetTableMetadataLocation(ctx, &s3tables.GetTableMetadataLocationInput{
+		TableBucketARN: aws.String(arn),
+		Namespace:      aws.String(namespaceName),
+		Name:           aws.String(tableNames[0]),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	warehouse := aws.ToString(locationResult.WarehouseLocation)
+	if !strings.HasPrefix(warehouse, "s3://") || !strings.HasSuffix(warehouse, "--table-s3") {
+		t.Errorf("unexpected warehouse location %q", warehouse)
+	}
+
+	if metadata := aws.ToString(locationResult.MetadataLocation); !strings.HasPrefix(metadata, warehouse+"/metadata/") {
+		t.Errorf("expected metadata location under %q, got %q", warehouse, metadata)
+	}
+
+	if aws.ToString(locationResult.VersionToken) != aws.ToString(createResults[0].VersionToken) {
+		t.Errorf("expected version token %q, got %q", aws.ToString(createResults[0].VersionToken), aws.ToString(locationResult.VersionToken))
+	}
+
+	// The table can be looked up by its ARN alone.
+	getResult, err := client.GetTable(ctx, &s3tables.GetTableInput{
+		TableArn: createResults[0].TableARN,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(getResult.Name) != tableNames[0] || aws.ToString(getResult.WarehouseLocation) != warehouse {
+		t.Errorf("unexpected table %q at %q", aws.ToString(getResult.Name), aws.ToString(getResult.WarehouseLocation))
+	}
+
+	// Tables are listed one page at a time.
+	var listed []string
+
+	paginator := s3tables.NewListTablesPaginator(client, &s3tables.ListTablesInput{
+		TableBucketARN: aws.String(arn),
+		Namespace:      aws.String(namespaceName),
+		MaxTables:      aws.Int32(1),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(page.Tables) > 1 {
+			t.Fatalf("expected at most 1 table per page, got %d", len(page.Tables))
+		}
+
+		for _, table := range page.Tables {
+			listed = append(listed, aws.ToString(table.Name))
+		}
+	}
+
+	if strings.Join(listed, ",") != strings.Join(tableNames, ",") {
+		t.Errorf("expected tables %v, got %v", tableNames, listed)
+	}
+
+	// Deleting with a stale version token fails.
+	_, err = client.DeleteTable(ctx, &s3tables.DeleteTableInput{
+		TableBucketARN: aws.String(arn),
+		Namespace:      aws.String(namespaceName),
+		Name:           aws.String(tableNames[0]),
+		VersionToken:   createResults[1].VersionToken,
+	})
+
+	var conflict *types.ConflictException
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected ConflictException, got %v", err)
+	}
+
+	_, err = client.DeleteTable(ctx, &s3tables.DeleteTableInput{
+		TableBucketARN: aws.String(arn),
+		Namespace:      aws.String(namespaceName),
+		Name:           aws.String(tableNames[0]),
+		VersionToken:   createResults[0].VersionToken,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -5,7 +5,12 @@ package integration
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
 	"unicode"
 
@@ -13,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
 	"github.com/sivchari/golden"
 )
 
@@ -453,6 +459,247 @@ func TestSecretsManager_VersionStages(t *testing.T) {
 	}
 }
 
+func TestSecretsManager_RotateSecret(t *testing.T) {
+	client := newSecretsManagerClient(t)
+	ctx := t.Context()
+	secretName := "test-secret-rotation"
+
+	_, err := client.CreateSecret(ctx, &secretsmanager.CreateSecretInput{
+		Name:         aws.String(secretName),
+		SecretString: aws.String("initial"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteSecret(context.Background(), &secretsmanager.DeleteSecretInput{
+			SecretId:                   aws.String(secretName),
+			ForceDeleteWithoutRecovery: aws.Bool(true),
+		})
+	})
+
+	var (
+		mu    sync.Mutex
+		steps []string
+	)
+
+	// The rotation function follows the rotation protocol against kumo.
+	rotationFunction := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event struct {
+			Step               string
+			SecretID           string `json:"SecretId"`
+			ClientRequestToken string
+		}
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		mu.Lock()
+		steps = append(steps, event.Step)
+		mu.Unlock()
+
+		var err error
+
+		switch event.Step {
+		case "createSecret":
+			_, err = client.PutSecretValue(r.Context(), &secretsmanager.PutSecretValueInput{
+				SecretId:           aws.String(event.SecretID),
+				ClientRequestToken: aws.String(event.ClientRequestToken),
+				SecretString:       aws.String("rotated"),
+				VersionStages:      []string{"AWSPENDING"},
+			})
+		case "finishSecret":
+			var current *secretsmanager.GetSecretValueOutput
+
+			current, err = client.GetSecretValue(r.Context(), &secretsmanager.GetSecretValueInput{SecretId: aws.String(event.SecretID)})
+			if err == nil {
+				_, err = client.UpdateSecretVersionStage(r.Context(), &secretsmanager.UpdateSecretVersionStageInput{
+					SecretId:            aws.String(event.SecretID),
+					VersionStage:        aws.String("AWSCURRENT"),
+					MoveToVersionId:     aws.String(event.ClientRequestToken),
+					RemoveFromVersionId: current.VersionId,
+				})
+			}
+		}
+
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		_, _ = w.Write([]byte("null"))
+	}))
+	t.Cleanup(rotationFunction.Close)
+
+	functionName := "test-secret-rotation-function"
+	createLambdaFunctionWithEndpoint(t, functionName, rotationFunction.URL)
+
+	functionARN := "arn:aws:lambda:us-east-1:000000000000:function:" + functionName
+
+	rotateOutput, err := client.RotateSecret(ctx, &secretsmanager.RotateSecretInput{
+		SecretId:          aws.String(secretName),
+		RotationLambdaARN: aws.String(functionARN),
+		RotationRules: &types.RotationRulesType{
+			AutomaticallyAfterDays: aws.Int64(30),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"createSecret", "setSecret", "testSecret", "finishSecret"}; !slices.Equal(steps, want) {
+		t.Errorf("expected rotation steps %v, got %v", want, steps)
+	}
+
+	current, err := client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(secretName)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(current.SecretString) != "rotated" || aws.ToString(current.VersionId) != aws.ToString(rotateOutput.VersionId) {
+		t.Errorf("expected the rotated version %s to be current, got %s (%s)",
+			aws.ToString(rotateOutput.VersionId), aws.ToString(current.VersionId), aws.ToString(current.SecretString))
+	}
+
+	previous, err := client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId:     aws.String(secretName),
+		VersionStage: aws.String("AWSPREVIOUS"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(previous.SecretString) != "initial" {
+		t.Errorf("expected the previous value to be initial, got %s", aws.ToString(previous.SecretString))
+	}
+
+	describeOutput, err := client.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{SecretId: aws.String(secretName)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !aws.ToBool(describeOutput.RotationEnabled) || aws.ToString(describeOutput.RotationLambdaARN) != functionARN {
+		t.Errorf("expected rotation enabled with %s, got %v with %s",
+			functionARN, aws.ToBool(describeOutput.RotationEnabled), aws.ToString(describeOutput.RotationLambdaARN))
+	}
+
+	if describeOutput.RotationRules == nil || aws.ToInt64(describeOutput.RotationRules.AutomaticallyAfterDays) != 30 {
+		t.Errorf("expected rotation after 30 days, got %+v", describeOutput.RotationRules)
+	}
+
+	if describeOutput.LastRotatedDate == nil || describeOutput.NextRotationDate == nil {
+		t.Fatal("expected last and next rotation dates")
+	}
+
+	if days := describeOutput.NextRotationDate.Sub(*describeOutput.LastRotatedDate).Hours() / 24; days != 30 {
+		t.Errorf("expected the next rotation 30 days after the last, got %v days", days)
+	}
+
+	if stages := describeOutput.VersionIdsToStages[aws.ToString(rotateOutput.VersionId)]; !slices.Equal(stages, []string{"AWSCURRENT"}) {
+		t.Errorf("expected the rotated version to be AWSCURRENT only, got %v", stages)
+	}
+}
+
+func TestSecretsManager_ResourcePolicy(t *testing.T) {
+	client := newSecretsManagerClient(t)
+	ctx := t.Context()
+	secretName := "test-secret-resource-policy"
+
+	_, err := client.CreateSecret(ctx, &secretsmanager.CreateSecretInput{
+		Name:         aws.String(secretName),
+		SecretString: aws.String("shared"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteSecret(context.Background(), &secretsmanager.DeleteSecretInput{
+			SecretId:                   aws.String(secretName),
+			ForceDeleteWithoutRecovery: aws.Bool(true),
+		})
+	})
+
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow",` +
+		`"Principal":{"AWS":"arn:aws:iam::111122223333:root"},` +
+		`"Action":"secretsmanager:GetSecretValue","Resource":"*"}]}`
+
+	_, err = client.PutResourcePolicy(ctx, &secretsmanager.PutResourcePolicyInput{
+		SecretId:       aws.String(secretName),
+		ResourcePolicy: aws.String(policy),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getOutput, err := client.GetResourcePolicy(ctx, &secretsmanager.GetResourcePolicyInput{SecretId: aws.String(secretName)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := aws.ToString(getOutput.ResourcePolicy); got != policy {
+		t.Errorf("expected the policy to be returned verbatim, got %s", got)
+	}
+
+	var malformed *types.MalformedPolicyDocumentException
+
+	_, err = client.PutResourcePolicy(ctx, &secretsmanager.PutResourcePolicyInput{
+		SecretId:       aws.String(secretName),
+		ResourcePolicy: aws.String(`{"Statement":`),
+	})
+	if !errors.As(err, &malformed) {
+		t.Errorf("expected MalformedPolicyDocumentException, got %v", err)
+	}
+
+	publicPolicy := `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":"*",` +
+		`"Action":"secretsmanager:GetSecretValue","Resource":"*"}}`
+
+	var public *types.PublicPolicyException
+
+	_, err = client.PutResourcePolicy(ctx, &secretsmanager.PutResourcePolicyInput{
+		SecretId:          aws.String(secretName),
+		ResourcePolicy:    aws.String(publicPolicy),
+		BlockPublicPolicy: aws.Bool(true),
+	})
+	if !errors.As(err, &public) {
+		t.Errorf("expected PublicPolicyException, got %v", err)
+	}
+
+	_, err = client.PutResourcePolicy(ctx, &secretsmanager.PutResourcePolicyInput{
+		SecretId:          aws.String(secretName),
+		ResourcePolicy:    aws.String(publicPolicy),
+		BlockPublicPolicy: aws.Bool(false),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.DeleteResourcePolicy(ctx, &secretsmanager.DeleteResourcePolicyInput{SecretId: aws.String(secretName)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getOutput, err = client.GetResourcePolicy(ctx, &secretsmanager.GetResourcePolicyInput{SecretId: aws.String(secretName)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if getOutput.ResourcePolicy != nil {
+		t.Errorf("expected no policy after deletion, got %s", aws.ToString(getOutput.ResourcePolicy))
+	}
+
+	var notFound *types.ResourceNotFoundException
+
+	_, err = client.GetResourcePolicy(ctx, &secretsmanager.GetResourcePolicyInput{SecretId: aws.String("test-secret-missing-policy")})
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected ResourceNotFoundException, got %v", err)
+	}
+}
+
 func TestSecretsManager_GetSecretValueByPartialARN(t *testing.T) {
 	client := newSecretsManagerClient(t)
 	ctx := t.Context()
@@ -3,7 +3,9 @@
 package integration
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"testing"
@@ -490,3 +492,115 @@ func findSentEmail(t *testing.T, emails []json.RawMessage, from, subject string)
 
 	return nil
 }
+
+func TestSESv2_SuppressedDestinations(t *testing.T) {
+	client := newSESv2Client(t)
+	ctx := t.Context()
+
+	emailAddress := "suppressed-bounce@example.com"
+
+	_, err := client.PutSuppressedDestination(ctx, &sesv2.PutSuppressedDestinationInput{
+		EmailAddress: aws.String(emailAddress),
+		Reason:       types.SuppressionListReasonBounce,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getOutput, err := client.GetSuppressedDestination(ctx, &sesv2.GetSuppressedDestinationInput{
+		EmailAddress: aws.String(emailAddress),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, golden.WithIgnoreFields("LastUpdateTime", "ResultMetadata")).Assert(t.Name()+"_get", getOutput)
+
+	listOutput, err := client.ListSuppressedDestinations(ctx, &sesv2.ListSuppressedDestinationsInput{
+		Reasons: []types.SuppressionListReason{types.SuppressionListReasonBounce},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+
+	for _, summary := range listOutput.SuppressedDestinationSummaries {
+		if aws.ToString(summary.EmailAddress) == emailAddress {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Errorf("%s not found in suppression list", emailAddress)
+	}
+
+	_, err = client.DeleteSuppressedDestination(ctx, &sesv2.DeleteSuppressedDestinationInput{
+		EmailAddress: aws.String(emailAddress),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetSuppressedDestination(ctx, &sesv2.GetSuppressedDestinationInput{
+		EmailAddress: aws.String(emailAddress),
+	})
+
+	var notFound *types.NotFoundException
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected NotFoundException after delete, got %v", err)
+	}
+}
+
+func TestSESv2_SendEmailSkipsSuppressedRecipients(t *testing.T) {
+	client := newSESv2Client(t)
+	ctx := t.Context()
+
+	fromEmail := "suppression-sender@example.com"
+	suppressed := "suppressed-complaint@example.com"
+
+	_, err := client.PutSuppressedDestination(ctx, &sesv2.PutSuppressedDestinationInput{
+		EmailAddress: aws.String(suppressed),
+		Reason:       types.SuppressionListReasonComplaint,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteSuppressedDestination(context.Background(), &sesv2.DeleteSuppressedDestinationInput{
+			EmailAddress: aws.String(suppressed),
+		})
+	})
+
+	_, err = client.SendEmail(ctx, &sesv2.SendEmailInput{
+		FromEmailAddress: aws.String(fromEmail),
+		Destination: &types.Destination{
+			ToAddresses: []string{"delivered@example.com", suppressed},
+		},
+		Content: &types.EmailContent{
+			Simple: &types.Message{
+				Subject: &types.Content{Data: aws.String("Suppression Test")},
+				Body:    &types.Body{Text: &types.Content{Data: aws.String("Test body")}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get("http://localhost:4566/kumo/ses/v2/sent-emails")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		SentEmails []json.RawMessage `json:"SentEmails"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+
+	raw := findSentEmail(t, result.SentEmails, fromEmail, "Suppression Test")
+	golden.New(t, golden.WithIgnoreFields("MessageId", "SentAt")).Assert(t.Name(), raw)
+}
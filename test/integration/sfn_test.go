@@ -3,12 +3,15 @@
 package integration
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	smithymiddleware "github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/sivchari/golden"
 )
 
@@ -27,6 +30,16 @@ func newSFNClient(t *testing.T) *sfn.Client {
 
 	return sfn.NewFromConfig(cfg, func(o *sfn.Options) {
 		o.BaseEndpoint = aws.String("http://localhost:4566")
+		// Disable host prefix (e.g., "sync-") to route requests to localhost.
+		o.APIOptions = append(o.APIOptions, func(stack *smithymiddleware.Stack) error {
+			return stack.Serialize.Add(smithymiddleware.SerializeMiddlewareFunc(
+				"DisableHostPrefix",
+				func(ctx context.Context, in smithymiddleware.SerializeInput, next smithymiddleware.SerializeHandler) (smithymiddleware.SerializeOutput, smithymiddleware.Metadata, error) {
+					ctx = smithyhttp.DisableEndpointHostPrefix(ctx, true)
+					return next.HandleSerialize(ctx, in)
+				},
+			), smithymiddleware.Before)
+		})
 	})
 }
 
@@ -306,3 +319,48 @@ func TestSFN_ExpressStateMachine(t *testing.T) {
 	}
 	golden.New(t, golden.WithIgnoreFields("StateMachineArn", "CreationDate", "RevisionId", "ResultMetadata")).Assert(t.Name(), describeOutput)
 }
+
+func TestSFN_StartSyncExecution(t *testing.T) {
+	client := newSFNClient(t)
+	ctx := t.Context()
+
+	definition := `{"StartAt": "Pass", "States": {"Pass": {"Type": "Pass", "End": true}}}`
+	roleArn := "arn:aws:iam::000000000000:role/test-role"
+
+	createOutput, err := client.CreateStateMachine(ctx, &sfn.CreateStateMachineInput{
+		Name:       aws.String("test-sync-state-machine"),
+		Definition: aws.String(definition),
+		RoleArn:    aws.String(roleArn),
+		Type:       "EXPRESS",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	syncOutput, err := client.StartSyncExecution(ctx, &sfn.StartSyncExecutionInput{
+		StateMachineArn: createOutput.StateMachineArn,
+		Name:            aws.String("test-sync-execution"),
+		Input:           aws.String(`{"key": "value"}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, golden.WithIgnoreFields("ExecutionArn", "StateMachineArn", "StartDate", "StopDate", "ResultMetadata")).Assert(t.Name(), syncOutput)
+
+	// Standard state machines do not support synchronous executions.
+	standardOutput, err := client.CreateStateMachine(ctx, &sfn.CreateStateMachineInput{
+		Name:       aws.String("test-sync-standard-state-machine"),
+		Definition: aws.String(definition),
+		RoleArn:    aws.String(roleArn),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.StartSyncExecution(ctx, &sfn.StartSyncExecutionInput{
+		StateMachineArn: standardOutput.StateMachineArn,
+	})
+	if err == nil {
+		t.Fatal("expected error for synchronous execution of a standard state machine")
+	}
+}
@@ -4,12 +4,19 @@ package integration
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/sivchari/golden"
 )
 
@@ -298,3 +305,299 @@ func TestSNS_CreateTopicIdempotent(t *testing.T) {
 			*createOutput1.TopicArn, *createOutput2.TopicArn)
 	}
 }
+
+func TestSNS_FifoTopic(t *testing.T) {
+	client := newSNSClient(t)
+	sqsClient := newSQSClient(t)
+	ctx := t.Context()
+
+	createOutput, err := client.CreateTopic(ctx, &sns.CreateTopicInput{
+		Name: aws.String("test-topic-fifo.fifo"),
+		Attributes: map[string]string{
+			"FifoTopic": "true",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	topicARN := createOutput.TopicArn
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteTopic(context.Background(), &sns.DeleteTopicInput{
+			TopicArn: topicARN,
+		})
+	})
+
+	queueOutput, err := sqsClient.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName: aws.String("test-sns-fifo-queue.fifo"),
+		Attributes: map[string]string{
+			"FifoQueue": "true",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = sqsClient.DeleteQueue(context.Background(), &sqs.DeleteQueueInput{
+			QueueUrl: queueOutput.QueueUrl,
+		})
+	})
+
+	// A FIFO topic cannot deliver to a standard queue.
+	_, err = client.Subscribe(ctx, &sns.SubscribeInput{
+		TopicArn: topicARN,
+		Protocol: aws.String("sqs"),
+		Endpoint: aws.String("arn:aws:sqs:us-east-1:000000000000:test-sns-standard-queue"),
+	})
+
+	var invalidErr *snstypes.InvalidParameterException
+	if !errors.As(err, &invalidErr) {
+		t.Fatalf("expected InvalidParameterException for a standard queue subscriber, got %v", err)
+	}
+
+	_, err = client.Subscribe(ctx, &sns.SubscribeInput{
+		TopicArn: topicARN,
+		Protocol: aws.String("sqs"),
+		Endpoint: aws.String("arn:aws:sqs:us-east-1:000000000000:test-sns-fifo-queue.fifo"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// MessageGroupId is required.
+	_, err = client.Publish(ctx, &sns.PublishInput{
+		TopicArn:               topicARN,
+		Message:                aws.String("no group"),
+		MessageDeduplicationId: aws.String("no-group"),
+	})
+	if !errors.As(err, &invalidErr) {
+		t.Fatalf("expected InvalidParameterException without MessageGroupId, got %v", err)
+	}
+
+	// MessageDeduplicationId is required without content-based deduplication.
+	_, err = client.Publish(ctx, &sns.PublishInput{
+		TopicArn:       topicARN,
+		Message:        aws.String("no dedup"),
+		MessageGroupId: aws.String("group-a"),
+	})
+	if !errors.As(err, &invalidErr) {
+		t.Fatalf("expected InvalidParameterException without MessageDeduplicationId, got %v", err)
+	}
+
+	// Interleave messages of two groups.
+	var lastSequence string
+
+	for i := range 3 {
+		for _, group := range []string{"group-a", "group-b"} {
+			out, err := client.Publish(ctx, &sns.PublishInput{
+				TopicArn:               topicARN,
+				Message:                aws.String(fmt.Sprintf("%s-%d", group, i)),
+				MessageGroupId:         aws.String(group),
+				MessageDeduplicationId: aws.String(fmt.Sprintf("%s-%d", group, i)),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if aws.ToString(out.SequenceNumber) <= lastSequence {
+				t.Errorf("sequence number %s is not greater than %s", aws.ToString(out.SequenceNumber), lastSequence)
+			}
+
+			lastSequence = aws.ToString(out.SequenceNumber)
+		}
+	}
+
+	// A duplicate is accepted but not delivered again.
+	_, err = client.Publish(ctx, &sns.PublishInput{
+		TopicArn:               topicARN,
+		Message:                aws.String("group-a-0"),
+		MessageGroupId:         aws.String("group-a"),
+		MessageDeduplicationId: aws.String("group-a-0"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := make(map[string][]string)
+
+	for range 10 {
+		recvOutput, err := sqsClient.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+			QueueUrl:            queueOutput.QueueUrl,
+			MaxNumberOfMessages: 10,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(recvOutput.Messages) == 0 {
+			break
+		}
+
+		for _, msg := range recvOutput.Messages {
+			var envelope struct {
+				Message        string
+				SequenceNumber string
+				TopicArn       string
+			}
+			if err := json.Unmarshal([]byte(aws.ToString(msg.Body)), &envelope); err != nil {
+				t.Fatalf("failed to decode notification: %v", err)
+			}
+
+			if envelope.TopicArn != aws.ToString(topicARN) || envelope.SequenceNumber == "" {
+				t.Errorf("unexpected notification: %s", aws.ToString(msg.Body))
+			}
+
+			group := envelope.Message[:len("group-a")]
+			received[group] = append(received[group], envelope.Message)
+
+			_, err = sqsClient.DeleteMessage(ctx, &sqs.DeleteMessageInput{
+				QueueUrl:      queueOutput.QueueUrl,
+				ReceiptHandle: msg.ReceiptHandle,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	for _, group := range []string{"group-a", "group-b"} {
+		want := []string{group + "-0", group + "-1", group + "-2"}
+		if fmt.Sprint(received[group]) != fmt.Sprint(want) {
+			t.Errorf("group %s: expected %v, got %v", group, want, received[group])
+		}
+	}
+}
+
+func TestSNS_Deliveries(t *testing.T) {
+	client := newSNSClient(t)
+	sqsClient := newSQSClient(t)
+	ctx := t.Context()
+
+	createOutput, err := client.CreateTopic(ctx, &sns.CreateTopicInput{
+		Name: aws.String("test-topic-deliveries"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteTopic(context.Background(), &sns.DeleteTopicInput{
+			TopicArn: createOutput.TopicArn,
+		})
+	})
+
+	queueOutput, err := sqsClient.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName: aws.String("test-sns-deliveries-queue"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = sqsClient.DeleteQueue(context.Background(), &sqs.DeleteQueueInput{
+			QueueUrl: queueOutput.QueueUrl,
+		})
+	})
+
+	delivered, err := client.Subscribe(ctx, &sns.SubscribeInput{
+		TopicArn: createOutput.TopicArn,
+		Protocol: aws.String("sqs"),
+		Endpoint: aws.String("arn:aws:sqs:us-east-1:000000000000:test-sns-deliveries-queue"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A subscription to a queue that does not exist fails to deliver.
+	failed, err := client.Subscribe(ctx, &sns.SubscribeInput{
+		TopicArn: createOutput.TopicArn,
+		Protocol: aws.String("sqs"),
+		Endpoint: aws.String("arn:aws:sqs:us-east-1:000000000000:test-sns-deliveries-missing-queue"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A subscription whose filter policy rejects the message does not receive it.
+	filtered, err := client.Subscribe(ctx, &sns.SubscribeInput{
+		TopicArn:   createOutput.TopicArn,
+		Protocol:   aws.String("sqs"),
+		Endpoint:   aws.String("arn:aws:sqs:us-east-1:000000000000:test-sns-deliveries-queue"),
+		Attributes: map[string]string{"FilterPolicy": `{"color": ["blue"], "size": [{"numeric": [">", 10]}]}`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publishOutput, err := client.Publish(ctx, &sns.PublishInput{
+		TopicArn: createOutput.TopicArn,
+		Message:  aws.String("Hello, subscribers!"),
+		MessageAttributes: map[string]snstypes.MessageAttributeValue{
+			"color": {DataType: aws.String("String"), StringValue: aws.String("red")},
+			"size":  {DataType: aws.String("Number"), StringValue: aws.String("42")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statuses := getSNSDeliveryStatuses(t, "topicArn", aws.ToString(createOutput.TopicArn), aws.ToString(publishOutput.MessageId))
+
+	if got := statuses[aws.ToString(delivered.SubscriptionArn)]; got != "SUCCESS" {
+		t.Errorf("expected SUCCESS delivering to the existing queue, got %q", got)
+	}
+
+	if got := statuses[aws.ToString(failed.SubscriptionArn)]; got != "FAILURE" {
+		t.Errorf("expected FAILURE delivering to the missing queue, got %q", got)
+	}
+
+	if got := statuses[aws.ToString(filtered.SubscriptionArn)]; got != "FILTERED" {
+		t.Errorf("expected FILTERED for the subscription whose filter policy rejects the message, got %q", got)
+	}
+
+	// Deliveries can be filtered by subscription.
+	statuses = getSNSDeliveryStatuses(t, "subscriptionArn", aws.ToString(failed.SubscriptionArn), aws.ToString(publishOutput.MessageId))
+	if len(statuses) != 1 || statuses[aws.ToString(failed.SubscriptionArn)] != "FAILURE" {
+		t.Errorf("expected only the failed delivery, got %v", statuses)
+	}
+}
+
+// getSNSDeliveryStatuses returns the delivery status of a message to each
+// subscription, as recorded by the kumo-specific deliveries endpoint filtered
+// by the query parameter.
+func getSNSDeliveryStatuses(t *testing.T, param, value, messageID string) map[string]string {
+	t.Helper()
+
+	resp, err := http.Get("http://localhost:4566/kumo/sns/deliveries?" + url.Values{param: {value}}.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	var result struct {
+		Deliveries []struct {
+			MessageID       string `json:"MessageId"`
+			SubscriptionArn string `json:"SubscriptionArn"`
+			Status          string `json:"Status"`
+		} `json:"Deliveries"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+
+	statuses := make(map[string]string)
+
+	for _, d := range result.Deliveries {
+		if d.MessageID == messageID {
+			statuses[d.SubscriptionArn] = d.Status
+		}
+	}
+
+	return statuses
+}
@@ -4,8 +4,10 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -157,8 +159,9 @@ func TestSQS_SendAndReceiveMessage(t *testing.T) {
 
 	// Receive message.
 	receiveOutput, err := client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
-		QueueUrl:            createOutput.QueueUrl,
-		MaxNumberOfMessages: 1,
+		QueueUrl:                    createOutput.QueueUrl,
+		MaxNumberOfMessages:         1,
+		MessageSystemAttributeNames: []types.MessageSystemAttributeName{types.MessageSystemAttributeNameAll},
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -175,6 +178,114 @@ func TestSQS_SendAndReceiveMessage(t *testing.T) {
 	}
 }
 
+func TestSQS_MessageAttributes(t *testing.T) {
+	client := newSQSClient(t)
+	ctx := t.Context()
+	queueName := "test-queue-message-attributes"
+
+	createOutput, err := client.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName: aws.String(queueName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteQueue(context.Background(), &sqs.DeleteQueueInput{
+			QueueUrl: createOutput.QueueUrl,
+		})
+	})
+
+	messageAttributes := map[string]types.MessageAttributeValue{
+		"timestamp":  {DataType: aws.String("Number"), StringValue: aws.String("1493147359900")},
+		"order.note": {DataType: aws.String("String"), StringValue: aws.String("gift")},
+		"order.data": {DataType: aws.String("Binary"), BinaryValue: []byte{0x01, 0x02}},
+	}
+
+	// Send a message with attributes and a trace header, then one without.
+	sendOutput, err := client.SendMessage(ctx, &sqs.SendMessageInput{
+		QueueUrl:          createOutput.QueueUrl,
+		MessageBody:       aws.String("with attributes"),
+		MessageAttributes: messageAttributes,
+		MessageSystemAttributes: map[string]types.MessageSystemAttributeValue{
+			"AWSTraceHeader": {DataType: aws.String("String"), StringValue: aws.String("Root=1-5759e988-bd862e3fe1be46a994272793")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(sendOutput.MD5OfMessageAttributes) == "" || aws.ToString(sendOutput.MD5OfMessageSystemAttributes) == "" {
+		t.Errorf("expected attribute digests, got %q and %q",
+			aws.ToString(sendOutput.MD5OfMessageAttributes), aws.ToString(sendOutput.MD5OfMessageSystemAttributes))
+	}
+
+	// Receive only the order.* attributes and the trace header.
+	receiveOutput, err := client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:                    createOutput.QueueUrl,
+		MessageAttributeNames:       []string{"order.*"},
+		MessageSystemAttributeNames: []types.MessageSystemAttributeName{types.MessageSystemAttributeNameAWSTraceHeader},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(receiveOutput.Messages) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(receiveOutput.Messages))
+	}
+
+	msg := receiveOutput.Messages[0]
+	if len(msg.MessageAttributes) != 2 || aws.ToString(msg.MessageAttributes["order.note"].StringValue) != "gift" ||
+		string(msg.MessageAttributes["order.data"].BinaryValue) != "\x01\x02" {
+		t.Errorf("unexpected message attributes: %v", msg.MessageAttributes)
+	}
+
+	if aws.ToString(msg.MD5OfMessageAttributes) == "" || aws.ToString(msg.MD5OfMessageAttributes) == aws.ToString(sendOutput.MD5OfMessageAttributes) {
+		t.Errorf("expected the digest of the selected attributes, got %q", aws.ToString(msg.MD5OfMessageAttributes))
+	}
+
+	if len(msg.Attributes) != 1 || msg.Attributes["AWSTraceHeader"] != "Root=1-5759e988-bd862e3fe1be46a994272793" {
+		t.Errorf("unexpected system attributes: %v", msg.Attributes)
+	}
+
+	// Attributes that are not requested are not returned.
+	_, err = client.SendMessage(ctx, &sqs.SendMessageInput{
+		QueueUrl:          createOutput.QueueUrl,
+		MessageBody:       aws.String("unrequested attributes"),
+		MessageAttributes: messageAttributes,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	receiveOutput, err = client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl: createOutput.QueueUrl,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(receiveOutput.Messages) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(receiveOutput.Messages))
+	}
+
+	if msg := receiveOutput.Messages[0]; len(msg.MessageAttributes) != 0 || len(msg.Attributes) != 0 {
+		t.Errorf("expected no attributes, got %v and %v", msg.MessageAttributes, msg.Attributes)
+	}
+
+	// Attribute names beginning with AWS. are reserved.
+	_, err = client.SendMessage(ctx, &sqs.SendMessageInput{
+		QueueUrl:    createOutput.QueueUrl,
+		MessageBody: aws.String("reserved attribute"),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			"AWS.reserved": {DataType: aws.String("String"), StringValue: aws.String("value")},
+		},
+	})
+	if err == nil {
+		t.Error("expected error for reserved attribute name")
+	}
+}
+
 func TestSQS_PurgeQueue(t *testing.T) {
 	client := newSQSClient(t)
 	ctx := t.Context()
@@ -225,6 +336,124 @@ func TestSQS_PurgeQueue(t *testing.T) {
 	if len(receiveOutput.Messages) != 0 {
 		t.Errorf("expected 0 messages after purge, got %d", len(receiveOutput.Messages))
 	}
+
+	// A queue can only be purged once every 60 seconds.
+	_, err = client.PurgeQueue(ctx, &sqs.PurgeQueueInput{
+		QueueUrl: createOutput.QueueUrl,
+	})
+
+	var inProgress *types.PurgeQueueInProgress
+	if !errors.As(err, &inProgress) {
+		t.Errorf("expected PurgeQueueInProgress, got %v", err)
+	}
+}
+
+func TestSQS_ChangeMessageVisibility(t *testing.T) {
+	client := newSQSClient(t)
+	ctx := t.Context()
+	queueName := "test-queue-change-visibility"
+
+	createOutput, err := client.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName: aws.String(queueName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteQueue(context.Background(), &sqs.DeleteQueueInput{
+			QueueUrl: createOutput.QueueUrl,
+		})
+	})
+
+	_, err = client.SendMessage(ctx, &sqs.SendMessageInput{
+		QueueUrl:    createOutput.QueueUrl,
+		MessageBody: aws.String("visibility message"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	receiveOutput, err := client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:          createOutput.QueueUrl,
+		VisibilityTimeout: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(receiveOutput.Messages) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(receiveOutput.Messages))
+	}
+
+	receiptHandle := receiveOutput.Messages[0].ReceiptHandle
+
+	// Extend the visibility timeout beyond the original one.
+	_, err = client.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          createOutput.QueueUrl,
+		ReceiptHandle:     receiptHandle,
+		VisibilityTimeout: 600,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(1500 * time.Millisecond)
+
+	receiveOutput2, err := client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl: createOutput.QueueUrl,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(receiveOutput2.Messages) != 0 {
+		t.Fatalf("expected the message to stay hidden, got %d messages", len(receiveOutput2.Messages))
+	}
+
+	// Shorten the visibility timeout so that the message is visible immediately.
+	_, err = client.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          createOutput.QueueUrl,
+		ReceiptHandle:     receiptHandle,
+		VisibilityTimeout: 0,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	receiveOutput3, err := client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:                    createOutput.QueueUrl,
+		MessageSystemAttributeNames: []types.MessageSystemAttributeName{types.MessageSystemAttributeNameApproximateReceiveCount},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(receiveOutput3.Messages) != 1 || receiveOutput3.Messages[0].Attributes["ApproximateReceiveCount"] != "2" {
+		t.Fatalf("expected the message to be received a second time, got %v", receiveOutput3.Messages)
+	}
+
+	// The receipt handle of the first receive is no longer valid.
+	_, err = client.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          createOutput.QueueUrl,
+		ReceiptHandle:     receiptHandle,
+		VisibilityTimeout: 10,
+	})
+
+	var invalidHandle *types.ReceiptHandleIsInvalid
+	if !errors.As(err, &invalidHandle) {
+		t.Errorf("expected ReceiptHandleIsInvalid, got %v", err)
+	}
+
+	// The visibility timeout can be at most 12 hours.
+	_, err = client.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          createOutput.QueueUrl,
+		ReceiptHandle:     receiveOutput3.Messages[0].ReceiptHandle,
+		VisibilityTimeout: 43201,
+	})
+	if err == nil {
+		t.Error("expected error for a visibility timeout over 12 hours")
+	}
 }
 
 func TestSQS_GetQueueAttributes(t *testing.T) {
@@ -262,6 +491,62 @@ func TestSQS_GetQueueAttributes(t *testing.T) {
 	golden.New(t, golden.WithIgnoreFields("QueueArn", "CreatedTimestamp", "LastModifiedTimestamp", "ResultMetadata")).Assert(t.Name(), getOutput)
 }
 
+func TestSQS_GetQueueAttributes_MessageCounts(t *testing.T) {
+	client := newSQSClient(t)
+	ctx := t.Context()
+	queueName := "test-queue-message-counts"
+
+	createOutput, err := client.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName: aws.String(queueName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteQueue(context.Background(), &sqs.DeleteQueueInput{
+			QueueUrl: createOutput.QueueUrl,
+		})
+	})
+
+	for _, body := range []string{"first", "second", "third"} {
+		if _, err := client.SendMessage(ctx, &sqs.SendMessageInput{
+			QueueUrl:    createOutput.QueueUrl,
+			MessageBody: aws.String(body),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := client.SendMessage(ctx, &sqs.SendMessageInput{
+		QueueUrl:     createOutput.QueueUrl,
+		MessageBody:  aws.String("delayed"),
+		DelaySeconds: 60,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:            createOutput.QueueUrl,
+		MaxNumberOfMessages: 1,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	getOutput, err := client.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl: createOutput.QueueUrl,
+		AttributeNames: []types.QueueAttributeName{
+			types.QueueAttributeNameApproximateNumberOfMessages,
+			types.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+			types.QueueAttributeNameApproximateNumberOfMessagesDelayed,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name(), getOutput)
+}
+
 func TestSQS_SetQueueAttributes(t *testing.T) {
 	client := newSQSClient(t)
 	ctx := t.Context()
@@ -619,6 +904,94 @@ func TestSQS_DeleteMessageBatch(t *testing.T) {
 	}
 }
 
+func TestSQS_ChangeMessageVisibilityBatch(t *testing.T) {
+	client := newSQSClient(t)
+	ctx := t.Context()
+	queueName := "test-queue-change-visibility-batch"
+
+	createOutput, err := client.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName: aws.String(queueName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteQueue(context.Background(), &sqs.DeleteQueueInput{
+			QueueUrl: createOutput.QueueUrl,
+		})
+	})
+
+	for i := range 2 {
+		_, err = client.SendMessage(ctx, &sqs.SendMessageInput{
+			QueueUrl:    createOutput.QueueUrl,
+			MessageBody: aws.String(fmt.Sprintf("visibility batch message %d", i)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	receiveOutput, err := client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:            createOutput.QueueUrl,
+		MaxNumberOfMessages: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(receiveOutput.Messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(receiveOutput.Messages))
+	}
+
+	// Make the first message visible again, keep the second hidden, and fail an unknown handle.
+	batchOutput, err := client.ChangeMessageVisibilityBatch(ctx, &sqs.ChangeMessageVisibilityBatchInput{
+		QueueUrl: createOutput.QueueUrl,
+		Entries: []types.ChangeMessageVisibilityBatchRequestEntry{
+			{Id: aws.String("visible"), ReceiptHandle: receiveOutput.Messages[0].ReceiptHandle, VisibilityTimeout: 0},
+			{Id: aws.String("hidden"), ReceiptHandle: receiveOutput.Messages[1].ReceiptHandle, VisibilityTimeout: 600},
+			{Id: aws.String("unknown"), ReceiptHandle: aws.String("unknown-receipt-handle"), VisibilityTimeout: 0},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(batchOutput.Successful) != 2 || len(batchOutput.Failed) != 1 {
+		t.Fatalf("expected 2 successful and 1 failed entries, got %d and %d", len(batchOutput.Successful), len(batchOutput.Failed))
+	}
+
+	if failed := batchOutput.Failed[0]; aws.ToString(failed.Id) != "unknown" || aws.ToString(failed.Code) != "ReceiptHandleIsInvalid" {
+		t.Errorf("unexpected failed entry: %s %s", aws.ToString(failed.Id), aws.ToString(failed.Code))
+	}
+
+	receiveOutput2, err := client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:            createOutput.QueueUrl,
+		MaxNumberOfMessages: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(receiveOutput2.Messages) != 1 || aws.ToString(receiveOutput2.Messages[0].MessageId) != aws.ToString(receiveOutput.Messages[0].MessageId) {
+		t.Errorf("expected only the first message to be visible again, got %d messages", len(receiveOutput2.Messages))
+	}
+
+	// Batch entry IDs must be distinct.
+	_, err = client.ChangeMessageVisibilityBatch(ctx, &sqs.ChangeMessageVisibilityBatchInput{
+		QueueUrl: createOutput.QueueUrl,
+		Entries: []types.ChangeMessageVisibilityBatchRequestEntry{
+			{Id: aws.String("dup"), ReceiptHandle: receiveOutput2.Messages[0].ReceiptHandle},
+			{Id: aws.String("dup"), ReceiptHandle: receiveOutput2.Messages[0].ReceiptHandle},
+		},
+	})
+
+	var notDistinct *types.BatchEntryIdsNotDistinct
+	if !errors.As(err, &notDistinct) {
+		t.Errorf("expected BatchEntryIdsNotDistinct, got %v", err)
+	}
+}
+
 func TestSQS_FIFOQueue_MissingDeduplicationId(t *testing.T) {
 	client := newSQSClient(t)
 	ctx := t.Context()
@@ -651,3 +1024,45 @@ func TestSQS_FIFOQueue_MissingDeduplicationId(t *testing.T) {
 		t.Error("expected error when sending message without MessageDeduplicationId and ContentBasedDeduplication disabled")
 	}
 }
+
+func TestSQS_QueueInClientRegion(t *testing.T) {
+	cfg, err := config.LoadDefaultConfig(t.Context(),
+		config.WithRegion("eu-west-1"),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			"test", "test", "",
+		)),
+	)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	client := sqs.NewFromConfig(cfg, func(o *sqs.Options) {
+		o.BaseEndpoint = aws.String("http://localhost:4566")
+	})
+	ctx := t.Context()
+
+	createOutput, err := client.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName: aws.String("test-queue-client-region"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = client.DeleteQueue(context.Background(), &sqs.DeleteQueueInput{
+			QueueUrl: createOutput.QueueUrl,
+		})
+	})
+
+	getOutput, err := client.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       createOutput.QueueUrl,
+		AttributeNames: []types.QueueAttributeName{types.QueueAttributeNameQueueArn},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := getOutput.Attributes["QueueArn"], "arn:aws:sqs:eu-west-1:000000000000:test-queue-client-region"; got != want {
+		t.Errorf("QueueArn = %q, want %q", got, want)
+	}
+}
@@ -4,7 +4,9 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -414,3 +416,115 @@ func TestSSM_SecureString_WithDecryptionFalse(t *testing.T) {
 			paramValue, aws.ToString(getOutputDecrypted.Parameter.Value))
 	}
 }
+
+func TestSSM_RunCommand(t *testing.T) {
+	client := newSSMClient(t)
+	ctx := t.Context()
+	instanceIDs := []string{"i-0123456789abcdef0", "i-0fedcba9876543210"}
+
+	sendOutput, err := client.SendCommand(ctx, &ssm.SendCommandInput{
+		DocumentName: aws.String("AWS-RunShellScript"),
+		InstanceIds:  instanceIDs,
+		Comment:      aws.String("test run command"),
+		Parameters: map[string][]string{
+			"commands": {"uptime", "df -h"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commandID := aws.ToString(sendOutput.Command.CommandId)
+
+	if sendOutput.Command.Status != types.CommandStatusPending || sendOutput.Command.TargetCount != 2 {
+		t.Errorf("expected a Pending command with 2 targets, got %s with %d", sendOutput.Command.Status, sendOutput.Command.TargetCount)
+	}
+
+	waitForCommandInvocationStatus(t, client, commandID, instanceIDs[0], types.CommandInvocationStatusInProgress)
+
+	for _, instanceID := range instanceIDs {
+		invocation := waitForCommandInvocationStatus(t, client, commandID, instanceID, types.CommandInvocationStatusSuccess)
+
+		if got := aws.ToString(invocation.StandardOutputContent); got != "uptime\ndf -h\n" {
+			t.Errorf("unexpected output for %s: %q", instanceID, got)
+		}
+
+		if invocation.ResponseCode != 0 || aws.ToString(invocation.PluginName) != "aws:runShellScript" {
+			t.Errorf("unexpected result for %s: response code %d, plugin %s",
+				instanceID, invocation.ResponseCode, aws.ToString(invocation.PluginName))
+		}
+	}
+
+	listOutput, err := client.ListCommands(ctx, &ssm.ListCommandsInput{CommandId: aws.String(commandID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(listOutput.Commands) != 1 || listOutput.Commands[0].Status != types.CommandStatusSuccess || listOutput.Commands[0].CompletedCount != 2 {
+		t.Errorf("expected one completed command, got %+v", listOutput.Commands)
+	}
+
+	invocationsOutput, err := client.ListCommandInvocations(ctx, &ssm.ListCommandInvocationsInput{
+		InstanceId: aws.String(instanceIDs[1]),
+		Details:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(invocationsOutput.CommandInvocations) != 1 {
+		t.Fatalf("expected one invocation on %s, got %d", instanceIDs[1], len(invocationsOutput.CommandInvocations))
+	}
+
+	invocation := invocationsOutput.CommandInvocations[0]
+	if aws.ToString(invocation.CommandId) != commandID || len(invocation.CommandPlugins) != 1 ||
+		aws.ToString(invocation.CommandPlugins[0].Output) != "uptime\ndf -h\n" {
+		t.Errorf("unexpected invocation %+v", invocation)
+	}
+
+	var doesNotExist *types.InvocationDoesNotExist
+
+	_, err = client.GetCommandInvocation(ctx, &ssm.GetCommandInvocationInput{
+		CommandId:  aws.String(commandID),
+		InstanceId: aws.String("i-00000000000000000"),
+	})
+	if !errors.As(err, &doesNotExist) {
+		t.Errorf("expected InvocationDoesNotExist, got %v", err)
+	}
+
+	var invalidInstance *types.InvalidInstanceId
+
+	_, err = client.SendCommand(ctx, &ssm.SendCommandInput{
+		DocumentName: aws.String("AWS-RunShellScript"),
+		InstanceIds:  []string{"not-an-instance"},
+	})
+	if !errors.As(err, &invalidInstance) {
+		t.Errorf("expected InvalidInstanceId, got %v", err)
+	}
+}
+
+func waitForCommandInvocationStatus(t *testing.T, client *ssm.Client, commandID, instanceID string, status types.CommandInvocationStatus) *ssm.GetCommandInvocationOutput {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+
+	for {
+		output, err := client.GetCommandInvocation(t.Context(), &ssm.GetCommandInvocationInput{
+			CommandId:  aws.String(commandID),
+			InstanceId: aws.String(instanceID),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if output.Status == status {
+			return output
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("invocation of %s on %s did not reach %s, current status %s", commandID, instanceID, status, output.Status)
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+}
@@ -0,0 +1,190 @@
+//go:build integration
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+)
+
+func TestStateEndpoint_SaveAndRestore(t *testing.T) {
+	client := newS3Client(t)
+	ctx := t.Context()
+
+	bucketName := "state-endpoint-bucket"
+
+	_, err := client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		deleteS3Bucket(client, bucketName)
+	})
+
+	_, err = client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("saved.txt"),
+		Body:   strings.NewReader("saved state"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get("http://localhost:4566/kumo/state?service=s3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("failed to save state: status %d: %s", resp.StatusCode, state)
+	}
+
+	deleteS3Bucket(client, bucketName)
+
+	laterBucketName := "state-endpoint-later-bucket"
+
+	_, err = client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(laterBucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		deleteS3Bucket(client, laterBucketName)
+	})
+
+	resp, err = http.Post("http://localhost:4566/kumo/state", "application/json", bytes.NewReader(state))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("failed to restore state: status %d", resp.StatusCode)
+	}
+
+	object, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String("saved.txt"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer object.Body.Close()
+
+	body, err := io.ReadAll(object.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(body) != "saved state" {
+		t.Errorf("expected restored body %q, got %q", "saved state", body)
+	}
+
+	_, err = client.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(laterBucketName),
+	})
+	if err == nil {
+		t.Error("expected a bucket created after the state was saved to be removed by restore")
+	}
+}
+
+func TestStateEndpoint_UnknownService(t *testing.T) {
+	resp, err := http.Post("http://localhost:4566/kumo/state", "application/json", strings.NewReader(`{"no-such-service": {}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, resp.StatusCode)
+	}
+}
+
+func TestResetEndpoint_SingleService(t *testing.T) {
+	s3Client := newS3Client(t)
+	sqsClient := newSQSClient(t)
+	ctx := t.Context()
+
+	bucketName := "reset-endpoint-bucket"
+
+	_, err := s3Client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		deleteS3Bucket(s3Client, bucketName)
+	})
+
+	queueOutput, err := sqsClient.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName: aws.String("reset-endpoint-queue"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = sqsClient.DeleteQueue(context.Background(), &sqs.DeleteQueueInput{
+			QueueUrl: queueOutput.QueueUrl,
+		})
+	})
+
+	resp, err := http.Post("http://localhost:4566/kumo/reset?service=s3", "application/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("failed to reset s3: status %d", resp.StatusCode)
+	}
+
+	_, err = s3Client.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err == nil {
+		t.Error("expected bucket to be removed by reset")
+	}
+
+	// Services outside the scope keep their state.
+	_, err = sqsClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl: queueOutput.QueueUrl,
+	})
+	if err != nil {
+		t.Errorf("expected queue to survive an s3 reset: %v", err)
+	}
+}
+
+func TestResetEndpoint_UnknownService(t *testing.T) {
+	resp, err := http.Post("http://localhost:4566/kumo/reset?service=no-such-service", "application/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, resp.StatusCode)
+	}
+}
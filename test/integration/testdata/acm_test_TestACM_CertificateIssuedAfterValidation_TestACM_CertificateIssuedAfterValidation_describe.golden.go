@@ -0,0 +1,61 @@
+{
+  "Certificate": {
+    "CertificateArn": "arn:aws:acm:us-east-1:000000000000:certificate/2b240943-6235-48b2-b9d5-096e15811b39",
+    "CertificateAuthorityArn": null,
+    "CreatedAt": "2026-10-16T20:03:48.455Z",
+    "DomainName": "issued.example.com",
+    "DomainValidationOptions": [
+      {
+        "DomainName": "issued.example.com",
+        "HttpRedirect": null,
+        "ResourceRecord": {
+          "Name": "_acme-challenge.issued.example.com",
+          "Type": "CNAME",
+          "Value": "_2b240943.acm-validations.aws."
+        },
+        "ValidationDomain": "issued.example.com",
+        "ValidationEmails": null,
+        "ValidationMethod": "DNS",
+        "ValidationStatus": "SUCCESS"
+      },
+      {
+        "DomainName": "www.issued.example.com",
+        "HttpRedirect": null,
+        "ResourceRecord": {
+          "Name": "_acme-challenge.www.issued.example.com",
+          "Type": "CNAME",
+          "Value": "_2b240943.acm-validations.aws."
+        },
+        "ValidationDomain": "www.issued.example.com",
+        "ValidationEmails": null,
+        "ValidationMethod": "DNS",
+        "ValidationStatus": "SUCCESS"
+      }
+    ],
+    "ExtendedKeyUsages": null,
+    "FailureReason": "",
+    "ImportedAt": null,
+    "InUseBy": null,
+    "IssuedAt": "2026-10-16T20:03:50.531Z",
+    "Issuer": "Amazon",
+    "KeyAlgorithm": "RSA_2048",
+    "KeyUsages": null,
+    "ManagedBy": "",
+    "NotAfter": "2027-11-15T20:03:50.531Z",
+    "NotBefore": "2026-10-16T20:03:50.531Z",
+    "Options": null,
+    "RenewalEligibility": "ELIGIBLE",
+    "RenewalSummary": null,
+    "RevocationReason": "",
+    "RevokedAt": null,
+    "Serial": "cc1983e0c542c047a50422e5ab08fdf4",
+    "SignatureAlgorithm": "SHA256WITHRSA",
+    "Status": "ISSUED",
+    "Subject": "CN=issued.example.com",
+    "SubjectAlternativeNames": [
+      "www.issued.example.com"
+    ],
+    "Type": "AMAZON_ISSUED"
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,34 @@
+{
+  "Certificate": {
+    "CertificateArn": "arn:aws:acm:us-east-1:000000000000:certificate/5b1c1aae-350b-4ca4-952d-ec58fa9c70d0",
+    "CertificateAuthorityArn": null,
+    "CreatedAt": "2026-10-17T01:31:24.973Z",
+    "DomainName": "pem-import.example.com",
+    "DomainValidationOptions": null,
+    "ExtendedKeyUsages": null,
+    "FailureReason": "",
+    "ImportedAt": "2026-10-17T01:31:24.973Z",
+    "InUseBy": null,
+    "IssuedAt": "2026-10-17T01:31:24.973Z",
+    "Issuer": "pem-import.example.com",
+    "KeyAlgorithm": "RSA_2048",
+    "KeyUsages": null,
+    "ManagedBy": "",
+    "NotAfter": "2026-10-18T01:31:24Z",
+    "NotBefore": "2026-10-17T00:31:24Z",
+    "Options": null,
+    "RenewalEligibility": "INELIGIBLE",
+    "RenewalSummary": null,
+    "RevocationReason": "",
+    "RevokedAt": null,
+    "Serial": "18df2d0709c1ef6c",
+    "SignatureAlgorithm": "SHA256WITHRSA",
+    "Status": "ISSUED",
+    "Subject": "CN=pem-import.example.com",
+    "SubjectAlternativeNames": [
+      "www.pem-import.example.com"
+    ],
+    "Type": "IMPORTED"
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,13 @@
+{
+  "resource": "/{proxy+}",
+  "path": "/files/docs/readme.md",
+  "httpMethod": "GET",
+  "queryStringParameters": null,
+  "pathParameters": {
+    "proxy": "files/docs/readme.md"
+  },
+  "body": null,
+  "requestContext": {
+    "stage": "test"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,15 @@
+{
+  "resource": "/orders/{orderId}",
+  "path": "/orders/42",
+  "httpMethod": "POST",
+  "queryStringParameters": {
+    "expand": "items"
+  },
+  "pathParameters": {
+    "orderId": "42"
+  },
+  "body": "{\"quantity\":3}",
+  "requestContext": {
+    "stage": "test"
+  }
+}
\ No newline at end of file
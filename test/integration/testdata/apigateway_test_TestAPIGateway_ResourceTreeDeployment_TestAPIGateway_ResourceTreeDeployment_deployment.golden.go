@@ -0,0 +1,14 @@
+{
+  "ApiSummary": {
+    "/orders/{orderId}": {
+      "GET": {
+        "ApiKeyRequired": false,
+        "AuthorizationType": "NONE"
+      }
+    }
+  },
+  "CreatedDate": "2026-10-16T19:57:59Z",
+  "Description": null,
+  "Id": "7fa90018-f",
+  "ResultMetadata": {}
+}
\ No newline at end of file
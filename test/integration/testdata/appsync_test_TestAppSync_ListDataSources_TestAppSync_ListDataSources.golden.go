@@ -0,0 +1,59 @@
+{
+  "DataSources": [
+    {
+      "DataSourceArn": "arn:aws:appsync:us-east-1:000000000000:apis/c6621a0f-c7ce-4bf4-99b6-d0ba29aa8a56/datasources/dynamodb_source",
+      "Description": null,
+      "DynamodbConfig": {
+        "AwsRegion": "us-east-1",
+        "TableName": "items",
+        "DeltaSyncConfig": null,
+        "UseCallerCredentials": false,
+        "Versioned": false
+      },
+      "ElasticsearchConfig": null,
+      "EventBridgeConfig": null,
+      "HttpConfig": null,
+      "LambdaConfig": null,
+      "MetricsConfig": "",
+      "Name": "dynamodb_source",
+      "OpenSearchServiceConfig": null,
+      "RelationalDatabaseConfig": null,
+      "ServiceRoleArn": null,
+      "Type": "AMAZON_DYNAMODB"
+    },
+    {
+      "DataSourceArn": "arn:aws:appsync:us-east-1:000000000000:apis/c6621a0f-c7ce-4bf4-99b6-d0ba29aa8a56/datasources/lambda_source",
+      "Description": null,
+      "DynamodbConfig": null,
+      "ElasticsearchConfig": null,
+      "EventBridgeConfig": null,
+      "HttpConfig": null,
+      "LambdaConfig": {
+        "LambdaFunctionArn": "arn:aws:lambda:us-east-1:000000000000:function:resolver"
+      },
+      "MetricsConfig": "",
+      "Name": "lambda_source",
+      "OpenSearchServiceConfig": null,
+      "RelationalDatabaseConfig": null,
+      "ServiceRoleArn": null,
+      "Type": "AWS_LAMBDA"
+    },
+    {
+      "DataSourceArn": "arn:aws:appsync:us-east-1:000000000000:apis/c6621a0f-c7ce-4bf4-99b6-d0ba29aa8a56/datasources/none_source",
+      "Description": null,
+      "DynamodbConfig": null,
+      "ElasticsearchConfig": null,
+      "EventBridgeConfig": null,
+      "HttpConfig": null,
+      "LambdaConfig": null,
+      "MetricsConfig": "",
+      "Name": "none_source",
+      "OpenSearchServiceConfig": null,
+      "RelationalDatabaseConfig": null,
+      "ServiceRoleArn": null,
+      "Type": "NONE"
+    }
+  ],
+  "NextToken": null,
+  "ResultMetadata": {}
+}
\ No newline at end of file
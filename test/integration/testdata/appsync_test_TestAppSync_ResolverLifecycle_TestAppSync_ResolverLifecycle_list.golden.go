@@ -0,0 +1,22 @@
+{
+  "NextToken": null,
+  "Resolvers": [
+    {
+      "CachingConfig": null,
+      "Code": null,
+      "DataSourceName": "items_table",
+      "FieldName": "getItem",
+      "Kind": "",
+      "MaxBatchSize": 0,
+      "MetricsConfig": "",
+      "PipelineConfig": null,
+      "RequestMappingTemplate": "{\"version\": \"2018-05-29\", \"operation\": \"GetItem\"}",
+      "ResolverArn": "arn:aws:appsync:us-east-1:000000000000:apis/48875137-14b7-4080-a520-25f286ffc5d2/types/Query/resolvers/getItem",
+      "ResponseMappingTemplate": "$util.toJson($ctx.result.item)",
+      "Runtime": null,
+      "SyncConfig": null,
+      "TypeName": "Query"
+    }
+  ],
+  "ResultMetadata": {}
+}
\ No newline at end of file
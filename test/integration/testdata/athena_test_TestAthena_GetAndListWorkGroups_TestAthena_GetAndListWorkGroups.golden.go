@@ -0,0 +1,32 @@
+{
+  "WorkGroup": {
+    "Name": "test-workgroup-get-list",
+    "Configuration": {
+      "AdditionalConfiguration": null,
+      "BytesScannedCutoffPerQuery": null,
+      "CustomerContentEncryptionConfiguration": null,
+      "EnableMinimumEncryptionConfiguration": null,
+      "EnforceWorkGroupConfiguration": true,
+      "EngineConfiguration": null,
+      "EngineVersion": null,
+      "ExecutionRole": null,
+      "IdentityCenterConfiguration": null,
+      "ManagedQueryResultsConfiguration": null,
+      "MonitoringConfiguration": null,
+      "PublishCloudWatchMetricsEnabled": false,
+      "QueryResultsS3AccessGrantsConfiguration": null,
+      "RequesterPaysEnabled": false,
+      "ResultConfiguration": {
+        "AclConfiguration": null,
+        "EncryptionConfiguration": null,
+        "ExpectedBucketOwner": null,
+        "OutputLocation": "s3://athena-workgroup-results/output/"
+      }
+    },
+    "CreationTime": "2026-10-16T19:53:35Z",
+    "Description": "Workgroup with default output location",
+    "IdentityCenterApplicationArn": null,
+    "State": "ENABLED"
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
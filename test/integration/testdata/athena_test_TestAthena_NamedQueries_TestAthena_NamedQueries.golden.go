@@ -0,0 +1,11 @@
+{
+  "NamedQuery": {
+    "Database": "analytics",
+    "Name": "daily-report",
+    "QueryString": "SELECT * FROM events",
+    "Description": "Saved daily-report query",
+    "NamedQueryId": "8a6c73ab-b2f5-472d-b871-f6d3a7417bb8",
+    "WorkGroup": "test-workgroup-named-queries"
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,67 @@
+{
+  "NextToken": null,
+  "ResultSet": {
+    "ResultSetMetadata": {
+      "ColumnInfo": [
+        {
+          "Name": "name",
+          "Type": "varchar",
+          "CaseSensitive": false,
+          "CatalogName": null,
+          "Label": "name",
+          "Nullable": "UNKNOWN",
+          "Precision": 0,
+          "Scale": 0,
+          "SchemaName": null,
+          "TableName": null
+        },
+        {
+          "Name": "age",
+          "Type": "integer",
+          "CaseSensitive": false,
+          "CatalogName": null,
+          "Label": "age",
+          "Nullable": "UNKNOWN",
+          "Precision": 0,
+          "Scale": 0,
+          "SchemaName": null,
+          "TableName": null
+        }
+      ]
+    },
+    "Rows": [
+      {
+        "Data": [
+          {
+            "VarCharValue": "name"
+          },
+          {
+            "VarCharValue": "age"
+          }
+        ]
+      },
+      {
+        "Data": [
+          {
+            "VarCharValue": "alice"
+          },
+          {
+            "VarCharValue": "30"
+          }
+        ]
+      },
+      {
+        "Data": [
+          {
+            "VarCharValue": "bob"
+          },
+          {
+            "VarCharValue": "25"
+          }
+        ]
+      }
+    ]
+  },
+  "UpdateCount": null,
+  "ResultMetadata": {}
+}
\ No newline at end of file
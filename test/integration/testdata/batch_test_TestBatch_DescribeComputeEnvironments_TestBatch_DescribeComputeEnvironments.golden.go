@@ -10,13 +10,13 @@
       "EksConfiguration": null,
       "ServiceRole": null,
       "State": "ENABLED",
-      "Status": "VALID",
+      "Status": "CREATING",
       "StatusReason": null,
       "Tags": null,
       "Type": "MANAGED",
       "UnmanagedvCpus": null,
       "UpdatePolicy": null,
-      "Uuid": "454fef61-d9aa-4896-a393-74ed197fb3f2"
+      "Uuid": "2e86a5f3-4280-482f-a72e-ffe50ed92db2"
     }
   ],
   "NextToken": null,
@@ -15,7 +15,7 @@
       "JobStateTimeLimitActions": null,
       "SchedulingPolicyArn": null,
       "ServiceEnvironmentOrder": null,
-      "Status": "VALID",
+      "Status": "CREATING",
       "StatusReason": null,
       "Tags": null
     }
@@ -0,0 +1,22 @@
+{
+  "SchedulingPolicies": [
+    {
+      "Arn": "arn:aws:batch:us-east-1:000000000000:scheduling-policy/fair-share-policy",
+      "Name": "fair-share-policy",
+      "FairsharePolicy": {
+        "ComputeReservation": null,
+        "ShareDecaySeconds": 7200,
+        "ShareDistribution": [
+          {
+            "ShareIdentifier": "teamA",
+            "WeightFactor": 2
+          }
+        ]
+      },
+      "Tags": {
+        "env": "test"
+      }
+    }
+  ],
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,38 @@
+{
+  "ComputeEnvironmentArn": "arn:aws:batch:us-east-1:000000000000:compute-environment/update-test-ce",
+  "ComputeEnvironmentName": "update-test-ce",
+  "ComputeResources": {
+    "MaxvCpus": 32,
+    "Subnets": [
+      "subnet-12345678"
+    ],
+    "Type": "EC2",
+    "AllocationStrategy": "",
+    "BidPercentage": null,
+    "DesiredvCpus": null,
+    "Ec2Configuration": null,
+    "Ec2KeyPair": null,
+    "ImageId": null,
+    "InstanceRole": null,
+    "InstanceTypes": null,
+    "LaunchTemplate": null,
+    "MinvCpus": null,
+    "PlacementGroup": null,
+    "SecurityGroupIds": null,
+    "SpotIamFleetRole": null,
+    "Tags": null
+  },
+  "ContainerOrchestrationType": "",
+  "Context": null,
+  "EcsClusterArn": null,
+  "EksConfiguration": null,
+  "ServiceRole": "arn:aws:iam::000000000000:role/AWSBatchServiceRole",
+  "State": "DISABLED",
+  "Status": "VALID",
+  "StatusReason": "ComputeEnvironment Healthy",
+  "Tags": null,
+  "Type": "MANAGED",
+  "UnmanagedvCpus": null,
+  "UpdatePolicy": null,
+  "Uuid": "775616ca-a128-42d0-87d3-a5479f08530e"
+}
\ No newline at end of file
@@ -0,0 +1,15 @@
+{
+  "ETag": "E6f68b828-851f-47b7-98d4-5ac64c65",
+  "Location": "/2020-05-31/origin-access-control/E8fc87da4-3ced",
+  "OriginAccessControl": {
+    "Id": "E8fc87da4-3ced",
+    "OriginAccessControlConfig": {
+      "Name": "test-origin-access-control",
+      "OriginAccessControlOriginType": "s3",
+      "SigningBehavior": "always",
+      "SigningProtocol": "sigv4",
+      "Description": "Test origin access control"
+    }
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,14 @@
+{
+  "ETag": "E4ab97056-270d-4ddb-882f-4b3f7391",
+  "OriginAccessControl": {
+    "Id": "E8fc87da4-3ced",
+    "OriginAccessControlConfig": {
+      "Name": "test-origin-access-control",
+      "OriginAccessControlOriginType": "s3",
+      "SigningBehavior": "no-override",
+      "SigningProtocol": "sigv4",
+      "Description": "Updated origin access control"
+    }
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,15 @@
+{
+  "Tags": {
+    "Items": [
+      {
+        "Key": "env",
+        "Value": "test"
+      },
+      {
+        "Key": "team",
+        "Value": "platform"
+      }
+    ]
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
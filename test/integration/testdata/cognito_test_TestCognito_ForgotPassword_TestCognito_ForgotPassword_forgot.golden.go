@@ -0,0 +1,8 @@
+{
+  "CodeDeliveryDetails": {
+    "AttributeName": "email",
+    "DeliveryMedium": "EMAIL",
+    "Destination": "f***@e***"
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
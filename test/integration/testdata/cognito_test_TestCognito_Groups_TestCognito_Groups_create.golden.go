@@ -0,0 +1,12 @@
+{
+  "Group": {
+    "CreationDate": "2026-10-16T19:08:33Z",
+    "Description": "Administrators",
+    "GroupName": "admins",
+    "LastModifiedDate": "2026-10-16T19:08:33Z",
+    "Precedence": 1,
+    "RoleArn": null,
+    "UserPoolId": "us-east-1_b4356a53f"
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
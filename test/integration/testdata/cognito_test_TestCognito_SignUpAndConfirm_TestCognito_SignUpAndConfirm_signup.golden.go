@@ -1,7 +1,11 @@
 {
   "UserConfirmed": false,
-  "UserSub": "9343ce46-0a36-4433-bd76-0e7bbbe99980",
-  "CodeDeliveryDetails": null,
+  "UserSub": "ce70df47-c9b5-4e49-8f7d-bd6e55d1a00f",
+  "CodeDeliveryDetails": {
+    "AttributeName": "email",
+    "DeliveryMedium": "EMAIL",
+    "Destination": "s***@e***"
+  },
   "Session": null,
   "ResultMetadata": {}
 }
\ No newline at end of file
@@ -0,0 +1,13 @@
+{
+  "AllowUnauthenticatedIdentities": true,
+  "IdentityPoolId": "us-east-1:8f35b860-d03e-491a-8f32-4aee7675843f",
+  "IdentityPoolName": "test-identity-pool",
+  "AllowClassicFlow": false,
+  "CognitoIdentityProviders": null,
+  "DeveloperProviderName": null,
+  "IdentityPoolTags": null,
+  "OpenIdConnectProviderARNs": null,
+  "SamlProviderARNs": null,
+  "SupportedLoginProviders": null,
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -11,7 +11,7 @@
       "BillingMode": "PAY_PER_REQUEST",
       "LastUpdateToPayPerRequestDateTime": null
     },
-    "CreationDateTime": "2026-03-23T07:45:25Z",
+    "CreationDateTime": "2026-10-17T01:56:50Z",
     "DeletionProtectionEnabled": false,
     "GlobalSecondaryIndexes": null,
     "GlobalTableSettingsReplicationMode": "",
@@ -36,10 +36,10 @@
     "StreamSpecification": null,
     "TableArn": "arn:aws:dynamodb:us-east-1:000000000000:table/test-table-create-delete",
     "TableClassSummary": null,
-    "TableId": "67576723-e3a5-4cd0-8f81-ab9cd0687a2b",
+    "TableId": "6ea94268-3483-451d-beae-b8fc316509ed",
     "TableName": "test-table-create-delete",
     "TableSizeBytes": 0,
-    "TableStatus": "ACTIVE",
+    "TableStatus": "CREATING",
     "WarmThroughput": null
   },
   "ResultMetadata": {}
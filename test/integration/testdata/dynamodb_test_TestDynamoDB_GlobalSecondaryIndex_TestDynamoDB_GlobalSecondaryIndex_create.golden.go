@@ -19,7 +19,7 @@
       "BillingMode": "PAY_PER_REQUEST",
       "LastUpdateToPayPerRequestDateTime": null
     },
-    "CreationDateTime": "2026-04-22T01:08:47Z",
+    "CreationDateTime": "2026-10-17T01:56:51Z",
     "DeletionProtectionEnabled": false,
     "GlobalSecondaryIndexes": [
       {
@@ -27,7 +27,7 @@
         "IndexArn": "arn:aws:dynamodb:us-east-1:000000000000:table/test-table-gsi/index/gsi-index",
         "IndexName": "gsi-index",
         "IndexSizeBytes": 0,
-        "IndexStatus": "ACTIVE",
+        "IndexStatus": "CREATING",
         "ItemCount": 0,
         "KeySchema": [
           {
@@ -70,10 +70,10 @@
     "StreamSpecification": null,
     "TableArn": "arn:aws:dynamodb:us-east-1:000000000000:table/test-table-gsi",
     "TableClassSummary": null,
-    "TableId": "ad896e2b-c27f-45be-a28a-8f444a5560d9",
+    "TableId": "a55ace93-e3b5-437a-abcf-fa600add2e29",
     "TableName": "test-table-gsi",
     "TableSizeBytes": 0,
-    "TableStatus": "ACTIVE",
+    "TableStatus": "CREATING",
     "WarmThroughput": null
   },
   "ResultMetadata": {}
@@ -0,0 +1,84 @@
+{
+  "TableDescription": {
+    "ArchivalSummary": null,
+    "AttributeDefinitions": [
+      {
+        "AttributeName": "pk",
+        "AttributeType": "S"
+      },
+      {
+        "AttributeName": "customer",
+        "AttributeType": "S"
+      }
+    ],
+    "BillingModeSummary": {
+      "BillingMode": "PROVISIONED",
+      "LastUpdateToPayPerRequestDateTime": null
+    },
+    "CreationDateTime": "2026-10-17T01:57:38Z",
+    "DeletionProtectionEnabled": false,
+    "GlobalSecondaryIndexes": [
+      {
+        "Backfilling": null,
+        "IndexArn": "arn:aws:dynamodb:us-east-1:000000000000:table/test-table-update/index/by-customer",
+        "IndexName": "by-customer",
+        "IndexSizeBytes": 0,
+        "IndexStatus": "DELETING",
+        "ItemCount": 0,
+        "KeySchema": [
+          {
+            "AttributeName": "customer",
+            "KeyType": "HASH"
+          }
+        ],
+        "OnDemandThroughput": null,
+        "Projection": {
+          "NonKeyAttributes": null,
+          "ProjectionType": "ALL"
+        },
+        "ProvisionedThroughput": {
+          "LastDecreaseDateTime": null,
+          "LastIncreaseDateTime": null,
+          "NumberOfDecreasesToday": 0,
+          "ReadCapacityUnits": 1,
+          "WriteCapacityUnits": 1
+        },
+        "WarmThroughput": null
+      }
+    ],
+    "GlobalTableSettingsReplicationMode": "",
+    "GlobalTableVersion": null,
+    "GlobalTableWitnesses": null,
+    "ItemCount": 0,
+    "KeySchema": [
+      {
+        "AttributeName": "pk",
+        "KeyType": "HASH"
+      }
+    ],
+    "LatestStreamArn": null,
+    "LatestStreamLabel": null,
+    "LocalSecondaryIndexes": null,
+    "MultiRegionConsistency": "",
+    "OnDemandThroughput": null,
+    "ProvisionedThroughput": {
+      "LastDecreaseDateTime": null,
+      "LastIncreaseDateTime": null,
+      "NumberOfDecreasesToday": 0,
+      "ReadCapacityUnits": 5,
+      "WriteCapacityUnits": 5
+    },
+    "Replicas": null,
+    "RestoreSummary": null,
+    "SSEDescription": null,
+    "StreamSpecification": null,
+    "TableArn": "arn:aws:dynamodb:us-east-1:000000000000:table/test-table-update",
+    "TableClassSummary": null,
+    "TableId": "8d35b5cf-aa9f-4897-941c-00085404f9dc",
+    "TableName": "test-table-update",
+    "TableSizeBytes": 0,
+    "TableStatus": "UPDATING",
+    "WarmThroughput": null
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -1,5 +1,8 @@
 {
-  "AssociationId": "rtbassoc-73a09645-dfb4-484",
-  "AssociationState": null,
+  "AssociationId": "rtbassoc-b9796ddb-a42a-446",
+  "AssociationState": {
+    "State": "associated",
+    "StatusMessage": null
+  },
   "ResultMetadata": {}
 }
\ No newline at end of file
@@ -4,12 +4,12 @@
     "Associations": [],
     "OwnerId": null,
     "PropagatingVgws": null,
-    "RouteTableId": "rtb-938f38fb-3c93-4d7",
+    "RouteTableId": "rtb-cb95f632-1faf-469",
     "Routes": [
       {
         "CarrierGatewayId": null,
         "CoreNetworkArn": null,
-        "DestinationCidrBlock": "local",
+        "DestinationCidrBlock": "10.0.0.0/16",
         "DestinationIpv6CidrBlock": null,
         "DestinationPrefixListId": null,
         "EgressOnlyInternetGatewayId": null,
@@ -21,14 +21,14 @@
         "NatGatewayId": null,
         "NetworkInterfaceId": null,
         "OdbNetworkArn": null,
-        "Origin": "",
+        "Origin": "CreateRouteTable",
         "State": "active",
         "TransitGatewayId": null,
         "VpcPeeringConnectionId": null
       }
     ],
     "Tags": [],
-    "VpcId": "vpc-5f79ef96-24b9-411"
+    "VpcId": "vpc-6b4641ca-9573-4fb"
   },
   "ResultMetadata": {}
 }
\ No newline at end of file
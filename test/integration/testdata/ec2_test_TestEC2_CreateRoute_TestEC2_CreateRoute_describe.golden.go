@@ -5,12 +5,12 @@
       "Associations": [],
       "OwnerId": null,
       "PropagatingVgws": null,
-      "RouteTableId": "rtb-4ef7a004-39c7-4d5",
+      "RouteTableId": "rtb-27aa2383-4880-48a",
       "Routes": [
         {
           "CarrierGatewayId": null,
           "CoreNetworkArn": null,
-          "DestinationCidrBlock": "local",
+          "DestinationCidrBlock": "10.0.0.0/16",
           "DestinationIpv6CidrBlock": null,
           "DestinationPrefixListId": null,
           "EgressOnlyInternetGatewayId": null,
@@ -22,7 +22,7 @@
           "NatGatewayId": null,
           "NetworkInterfaceId": null,
           "OdbNetworkArn": null,
-          "Origin": "",
+          "Origin": "CreateRouteTable",
           "State": "active",
           "TransitGatewayId": null,
           "VpcPeeringConnectionId": null
@@ -34,7 +34,7 @@
           "DestinationIpv6CidrBlock": null,
           "DestinationPrefixListId": null,
           "EgressOnlyInternetGatewayId": null,
-          "GatewayId": "igw-9f932aa5-5de0-494",
+          "GatewayId": "igw-4e74a508-aa58-4f7",
           "InstanceId": null,
           "InstanceOwnerId": null,
           "IpAddress": null,
@@ -42,14 +42,14 @@
           "NatGatewayId": null,
           "NetworkInterfaceId": null,
           "OdbNetworkArn": null,
-          "Origin": "",
+          "Origin": "CreateRoute",
           "State": "active",
           "TransitGatewayId": null,
           "VpcPeeringConnectionId": null
         }
       ],
       "Tags": [],
-      "VpcId": "vpc-044b0d0e-8dfb-4fd"
+      "VpcId": "vpc-e1c20a48-ebf6-45d"
     }
   ],
   "ResultMetadata": {}
@@ -1,13 +1,13 @@
 {
   "KeyPairs": [
     {
-      "CreateTime": null,
-      "KeyFingerprint": "5d5c1f77936fc25f4e6e4c30f8d1fff2f6c4ae41b8d7d9da833bc139f3daab4e",
+      "CreateTime": "2026-10-17T02:13:30.531Z",
+      "KeyFingerprint": "7c:68:11:b1:c8:c5:5d:1e:51:eb:2a:0e:3a:e1:3a:09:a6:ce:d3:35",
       "KeyName": "test-describe-key-pair",
-      "KeyPairId": "key-ee191475-be66-4e8",
-      "KeyType": "",
+      "KeyPairId": "key-1b6dcde2-80ea-4b9",
+      "KeyType": "rsa",
       "PublicKey": null,
-      "Tags": null
+      "Tags": []
     }
   ],
   "ResultMetadata": {}
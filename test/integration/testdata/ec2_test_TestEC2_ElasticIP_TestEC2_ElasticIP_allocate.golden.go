@@ -0,0 +1,11 @@
+{
+  "AllocationId": "eipalloc-f8430095-f98c-436",
+  "CarrierIp": null,
+  "CustomerOwnedIp": null,
+  "CustomerOwnedIpv4Pool": null,
+  "Domain": "vpc",
+  "NetworkBorderGroup": "us-east-1",
+  "PublicIp": "54.152.44.221",
+  "PublicIpv4Pool": "amazon",
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+{
+  "AssociationId": "eipassoc-962f5d89-9dee-410",
+  "ResultMetadata": {}
+}
\ No newline at end of file
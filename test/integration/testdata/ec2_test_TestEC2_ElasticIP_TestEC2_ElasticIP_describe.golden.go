@@ -0,0 +1,28 @@
+{
+  "Addresses": [
+    {
+      "AllocationId": "eipalloc-f8430095-f98c-436",
+      "AssociationId": "eipassoc-962f5d89-9dee-410",
+      "CarrierIp": null,
+      "CustomerOwnedIp": null,
+      "CustomerOwnedIpv4Pool": null,
+      "Domain": "vpc",
+      "InstanceId": "i-412e445a-c6bd-449",
+      "NetworkBorderGroup": "us-east-1",
+      "NetworkInterfaceId": null,
+      "NetworkInterfaceOwnerId": null,
+      "PrivateIpAddress": "10.0.44.40",
+      "PublicIp": "54.152.44.221",
+      "PublicIpv4Pool": "amazon",
+      "ServiceManaged": "",
+      "SubnetId": null,
+      "Tags": [
+        {
+          "Key": "Name",
+          "Value": "web"
+        }
+      ]
+    }
+  ],
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,19 @@
+{
+  "KeyPairs": [
+    {
+      "CreateTime": "2026-10-17T02:13:30.537Z",
+      "KeyFingerprint": "P/Od7OwCMYnGAiOCkkv7GmM8c2FXOywUeJL4cGS93gw=",
+      "KeyName": "test-import-key-pair",
+      "KeyPairId": "key-49c6f3a5-b4be-48c",
+      "KeyType": "ed25519",
+      "PublicKey": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOs5KiNXDiSJWamX2KKUxxmYN4w92t0PRNTRsPVJkRzb test-import-key-pair",
+      "Tags": [
+        {
+          "Key": "team",
+          "Value": "platform"
+        }
+      ]
+    }
+  ],
+  "ResultMetadata": {}
+}
\ No newline at end of file
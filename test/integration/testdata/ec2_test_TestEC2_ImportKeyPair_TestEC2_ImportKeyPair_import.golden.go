@@ -0,0 +1,12 @@
+{
+  "KeyFingerprint": "P/Od7OwCMYnGAiOCkkv7GmM8c2FXOywUeJL4cGS93gw=",
+  "KeyName": "test-import-key-pair",
+  "KeyPairId": "key-49c6f3a5-b4be-48c",
+  "Tags": [
+    {
+      "Key": "team",
+      "Value": "platform"
+    }
+  ],
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,12 @@
+{
+  "AssociatedResource": null,
+  "AttachTime": "2026-10-17T02:10:50.06Z",
+  "DeleteOnTermination": false,
+  "Device": "/dev/sdf",
+  "EbsCardIndex": null,
+  "InstanceId": "i-73a3c805-6f1c-429",
+  "InstanceOwningService": null,
+  "State": "attaching",
+  "VolumeId": "vol-acb58c38-4750-437",
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,29 @@
+{
+  "Attachments": [],
+  "AvailabilityZone": "us-east-1a",
+  "AvailabilityZoneId": null,
+  "CreateTime": "2026-10-17T02:10:49.525Z",
+  "Encrypted": false,
+  "FastRestored": null,
+  "Iops": 3000,
+  "KmsKeyId": null,
+  "MultiAttachEnabled": false,
+  "Operator": null,
+  "OutpostArn": null,
+  "Size": 20,
+  "SnapshotId": "",
+  "SourceVolumeId": null,
+  "SseType": "",
+  "State": "creating",
+  "Tags": [
+    {
+      "Key": "Name",
+      "Value": "data"
+    }
+  ],
+  "Throughput": 125,
+  "VolumeId": "vol-acb58c38-4750-437",
+  "VolumeInitializationRate": null,
+  "VolumeType": "gp3",
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,46 @@
+{
+  "NextToken": null,
+  "Volumes": [
+    {
+      "Attachments": [
+        {
+          "AssociatedResource": null,
+          "AttachTime": "2026-10-17T02:10:50.06Z",
+          "DeleteOnTermination": false,
+          "Device": "/dev/sdf",
+          "EbsCardIndex": null,
+          "InstanceId": "i-73a3c805-6f1c-429",
+          "InstanceOwningService": null,
+          "State": "attached",
+          "VolumeId": "vol-acb58c38-4750-437"
+        }
+      ],
+      "AvailabilityZone": "us-east-1a",
+      "AvailabilityZoneId": null,
+      "CreateTime": "2026-10-17T02:10:49.525Z",
+      "Encrypted": false,
+      "FastRestored": null,
+      "Iops": 3000,
+      "KmsKeyId": null,
+      "MultiAttachEnabled": false,
+      "Operator": null,
+      "OutpostArn": null,
+      "Size": 20,
+      "SnapshotId": "",
+      "SourceVolumeId": null,
+      "SseType": "",
+      "State": "in-use",
+      "Tags": [
+        {
+          "Key": "Name",
+          "Value": "data"
+        }
+      ],
+      "Throughput": 125,
+      "VolumeId": "vol-acb58c38-4750-437",
+      "VolumeInitializationRate": null,
+      "VolumeType": "gp3"
+    }
+  ],
+  "ResultMetadata": {}
+}
\ No newline at end of file
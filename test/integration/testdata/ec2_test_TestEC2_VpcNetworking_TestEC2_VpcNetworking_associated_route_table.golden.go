@@ -0,0 +1,54 @@
+{
+  "NextToken": null,
+  "RouteTables": [
+    {
+      "Associations": [
+        {
+          "AssociationState": {
+            "State": "associated",
+            "StatusMessage": null
+          },
+          "GatewayId": null,
+          "Main": false,
+          "PublicIpv4Pool": null,
+          "RouteTableAssociationId": "rtbassoc-9f33e75a-3f98-4fd",
+          "RouteTableId": "rtb-a8740cb0-61eb-46e",
+          "SubnetId": "subnet-d284adc4-9257-4cd"
+        }
+      ],
+      "OwnerId": null,
+      "PropagatingVgws": null,
+      "RouteTableId": "rtb-a8740cb0-61eb-46e",
+      "Routes": [
+        {
+          "CarrierGatewayId": null,
+          "CoreNetworkArn": null,
+          "DestinationCidrBlock": "10.1.0.0/16",
+          "DestinationIpv6CidrBlock": null,
+          "DestinationPrefixListId": null,
+          "EgressOnlyInternetGatewayId": null,
+          "GatewayId": "local",
+          "InstanceId": null,
+          "InstanceOwnerId": null,
+          "IpAddress": null,
+          "LocalGatewayId": null,
+          "NatGatewayId": null,
+          "NetworkInterfaceId": null,
+          "OdbNetworkArn": null,
+          "Origin": "CreateRouteTable",
+          "State": "active",
+          "TransitGatewayId": null,
+          "VpcPeeringConnectionId": null
+        }
+      ],
+      "Tags": [
+        {
+          "Key": "tier",
+          "Value": "public"
+        }
+      ],
+      "VpcId": "vpc-75c72c97-c563-475"
+    }
+  ],
+  "ResultMetadata": {}
+}
\ No newline at end of file
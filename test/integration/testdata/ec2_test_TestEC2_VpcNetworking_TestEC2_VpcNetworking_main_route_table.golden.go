@@ -0,0 +1,49 @@
+{
+  "NextToken": null,
+  "RouteTables": [
+    {
+      "Associations": [
+        {
+          "AssociationState": {
+            "State": "associated",
+            "StatusMessage": null
+          },
+          "GatewayId": null,
+          "Main": true,
+          "PublicIpv4Pool": null,
+          "RouteTableAssociationId": "rtbassoc-58cd6e49-c210-451",
+          "RouteTableId": "rtb-c1f804d3-8421-4ce",
+          "SubnetId": null
+        }
+      ],
+      "OwnerId": null,
+      "PropagatingVgws": null,
+      "RouteTableId": "rtb-c1f804d3-8421-4ce",
+      "Routes": [
+        {
+          "CarrierGatewayId": null,
+          "CoreNetworkArn": null,
+          "DestinationCidrBlock": "10.1.0.0/16",
+          "DestinationIpv6CidrBlock": null,
+          "DestinationPrefixListId": null,
+          "EgressOnlyInternetGatewayId": null,
+          "GatewayId": "local",
+          "InstanceId": null,
+          "InstanceOwnerId": null,
+          "IpAddress": null,
+          "LocalGatewayId": null,
+          "NatGatewayId": null,
+          "NetworkInterfaceId": null,
+          "OdbNetworkArn": null,
+          "Origin": "CreateRouteTable",
+          "State": "active",
+          "TransitGatewayId": null,
+          "VpcPeeringConnectionId": null
+        }
+      ],
+      "Tags": [],
+      "VpcId": "vpc-75c72c97-c563-475"
+    }
+  ],
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,36 @@
+{
+  "NextToken": null,
+  "Subnets": [
+    {
+      "AssignIpv6AddressOnCreation": null,
+      "AvailabilityZone": "us-east-1a",
+      "AvailabilityZoneId": null,
+      "AvailableIpAddressCount": 251,
+      "BlockPublicAccessStates": null,
+      "CidrBlock": "10.1.1.0/24",
+      "CustomerOwnedIpv4Pool": null,
+      "DefaultForAz": null,
+      "EnableDns64": null,
+      "EnableLniAtDeviceIndex": null,
+      "Ipv6CidrBlockAssociationSet": null,
+      "Ipv6Native": null,
+      "MapCustomerOwnedIpOnLaunch": null,
+      "MapPublicIpOnLaunch": false,
+      "OutpostArn": null,
+      "OwnerId": null,
+      "PrivateDnsNameOptionsOnLaunch": null,
+      "State": "available",
+      "SubnetArn": null,
+      "SubnetId": "subnet-d284adc4-9257-4cd",
+      "Tags": [
+        {
+          "Key": "tier",
+          "Value": "public"
+        }
+      ],
+      "Type": null,
+      "VpcId": "vpc-75c72c97-c563-475"
+    }
+  ],
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -3,7 +3,7 @@
     {
       "AuthorizationToken": "QVdTOnBhc3N3b3Jk",
       "ExpiresAt": "2026-03-23T19:45:25Z",
-      "ProxyEndpoint": "https://000000000000.dkr.ecr.us-east-1.amazonaws.com"
+      "ProxyEndpoint": "http://localhost:4566"
     }
   ],
   "ResultMetadata": {}
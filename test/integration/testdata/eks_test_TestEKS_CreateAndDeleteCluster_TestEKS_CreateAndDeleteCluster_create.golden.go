@@ -48,7 +48,7 @@
       "VpcId": "vpc-56d726f5-3222-4ae"
     },
     "RoleArn": "arn:aws:iam::123456789012:role/eks-cluster-role",
-    "Status": "ACTIVE",
+    "Status": "CREATING",
     "StorageConfig": null,
     "Tags": null,
     "UpgradePolicy": null,
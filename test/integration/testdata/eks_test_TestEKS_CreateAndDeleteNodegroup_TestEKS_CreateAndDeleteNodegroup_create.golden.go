@@ -33,7 +33,7 @@
       "MaxSize": 3,
       "MinSize": 1
     },
-    "Status": "ACTIVE",
+    "Status": "CREATING",
     "Subnets": [
       "subnet-12345678",
       "subnet-87654321"
@@ -0,0 +1,10 @@
+{
+  "CacheParameterGroup": {
+    "ARN": "arn:aws:elasticache:us-east-1:000000000000:parametergroup:test-cache-parameter-group",
+    "CacheParameterGroupFamily": "redis7",
+    "CacheParameterGroupName": "test-cache-parameter-group",
+    "Description": "test parameter group",
+    "IsGlobal": null
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,28 @@
+{
+  "CacheSubnetGroup": {
+    "ARN": "arn:aws:elasticache:us-east-1:000000000000:subnetgroup:test-cache-subnet-group",
+    "CacheSubnetGroupDescription": "test subnet group",
+    "CacheSubnetGroupName": "test-cache-subnet-group",
+    "Subnets": [
+      {
+        "SubnetAvailabilityZone": {
+          "Name": "us-east-1a"
+        },
+        "SubnetIdentifier": "subnet-11111111",
+        "SubnetOutpost": null,
+        "SupportedNetworkTypes": null
+      },
+      {
+        "SubnetAvailabilityZone": {
+          "Name": "us-east-1b"
+        },
+        "SubnetIdentifier": "subnet-22222222",
+        "SubnetOutpost": null,
+        "SupportedNetworkTypes": null
+      }
+    ],
+    "SupportedNetworkTypes": null,
+    "VpcId": null
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
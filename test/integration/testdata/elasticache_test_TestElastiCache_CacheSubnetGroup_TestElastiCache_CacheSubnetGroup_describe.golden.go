@@ -0,0 +1,39 @@
+{
+  "CacheSubnetGroups": [
+    {
+      "ARN": "arn:aws:elasticache:us-east-1:000000000000:subnetgroup:test-cache-subnet-group",
+      "CacheSubnetGroupDescription": "modified subnet group",
+      "CacheSubnetGroupName": "test-cache-subnet-group",
+      "Subnets": [
+        {
+          "SubnetAvailabilityZone": {
+            "Name": "us-east-1a"
+          },
+          "SubnetIdentifier": "subnet-33333333",
+          "SubnetOutpost": null,
+          "SupportedNetworkTypes": null
+        },
+        {
+          "SubnetAvailabilityZone": {
+            "Name": "us-east-1b"
+          },
+          "SubnetIdentifier": "subnet-44444444",
+          "SubnetOutpost": null,
+          "SupportedNetworkTypes": null
+        },
+        {
+          "SubnetAvailabilityZone": {
+            "Name": "us-east-1c"
+          },
+          "SubnetIdentifier": "subnet-55555555",
+          "SubnetOutpost": null,
+          "SupportedNetworkTypes": null
+        }
+      ],
+      "SupportedNetworkTypes": null,
+      "VpcId": null
+    }
+  ],
+  "Marker": null,
+  "ResultMetadata": {}
+}
\ No newline at end of file
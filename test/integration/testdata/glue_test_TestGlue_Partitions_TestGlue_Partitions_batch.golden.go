@@ -0,0 +1,24 @@
+{
+  "Errors": [
+    {
+      "ErrorDetail": {
+        "ErrorCode": "AlreadyExistsException",
+        "ErrorMessage": "Partition already exists."
+      },
+      "PartitionValues": [
+        "2023",
+        "12"
+      ]
+    },
+    {
+      "ErrorDetail": {
+        "ErrorCode": "InvalidInputException",
+        "ErrorMessage": "The number of partition values (1) does not match the number of partition keys (2)"
+      },
+      "PartitionValues": [
+        "2024"
+      ]
+    }
+  ],
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,32 @@
+{
+  "Partition": {
+    "CatalogId": null,
+    "CreationTime": "2026-10-16T19:46:21.807Z",
+    "DatabaseName": "partitions_database",
+    "LastAccessTime": null,
+    "LastAnalyzedTime": null,
+    "Parameters": null,
+    "StorageDescriptor": {
+      "AdditionalLocations": null,
+      "BucketColumns": null,
+      "Columns": null,
+      "Compressed": false,
+      "InputFormat": null,
+      "Location": "s3://bucket/events/year=2024/month=02/",
+      "NumberOfBuckets": 0,
+      "OutputFormat": null,
+      "Parameters": null,
+      "SchemaReference": null,
+      "SerdeInfo": null,
+      "SkewedInfo": null,
+      "SortColumns": null,
+      "StoredAsSubDirectories": false
+    },
+    "TableName": "partitioned_events",
+    "Values": [
+      "2024",
+      "02"
+    ]
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
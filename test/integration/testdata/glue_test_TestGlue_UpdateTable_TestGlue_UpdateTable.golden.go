@@ -0,0 +1,65 @@
+{
+  "Table": {
+    "Name": "update_test_table",
+    "CatalogId": null,
+    "CreateTime": "2026-10-16T19:44:07.346Z",
+    "CreatedBy": null,
+    "DatabaseName": "update_table_database",
+    "Description": "Updated table",
+    "FederatedTable": null,
+    "IsMaterializedView": null,
+    "IsMultiDialectView": null,
+    "IsRegisteredWithLakeFormation": false,
+    "LastAccessTime": null,
+    "LastAnalyzedTime": null,
+    "Owner": null,
+    "Parameters": null,
+    "PartitionKeys": [
+      {
+        "Name": "dt",
+        "Comment": null,
+        "Parameters": null,
+        "Type": "string"
+      }
+    ],
+    "Retention": 0,
+    "Status": null,
+    "StorageDescriptor": {
+      "AdditionalLocations": null,
+      "BucketColumns": null,
+      "Columns": [
+        {
+          "Name": "id",
+          "Comment": null,
+          "Parameters": null,
+          "Type": "bigint"
+        },
+        {
+          "Name": "name",
+          "Comment": null,
+          "Parameters": null,
+          "Type": "string"
+        }
+      ],
+      "Compressed": false,
+      "InputFormat": null,
+      "Location": "s3://bucket/update_test_table/",
+      "NumberOfBuckets": 0,
+      "OutputFormat": null,
+      "Parameters": null,
+      "SchemaReference": null,
+      "SerdeInfo": null,
+      "SkewedInfo": null,
+      "SortColumns": null,
+      "StoredAsSubDirectories": false
+    },
+    "TableType": null,
+    "TargetTable": null,
+    "UpdateTime": "2026-10-16T19:44:07.347Z",
+    "VersionId": null,
+    "ViewDefinition": null,
+    "ViewExpandedText": null,
+    "ViewOriginalText": null
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,6 @@
+{
+  "Tags": {
+    "team": "billing"
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -17,12 +17,12 @@
       "AwsBackupRecoveryPointArn": null,
       "BacktrackConsumedChangeRecords": null,
       "BacktrackWindow": null,
-      "BackupRetentionPeriod": null,
+      "BackupRetentionPeriod": 1,
       "Capacity": null,
       "CertificateDetails": null,
       "CharacterSetName": null,
       "CloneGroupId": null,
-      "ClusterCreateTime": "2026-03-23T07:45:26.216Z",
+      "ClusterCreateTime": "2026-10-17T01:18:38.243Z",
       "ClusterScalabilityType": "",
       "CopyTagsToSnapshot": null,
       "CrossAccountClone": null,
@@ -43,7 +43,7 @@
       "EarliestBacktrackTime": null,
       "EarliestRestorableTime": null,
       "EnabledCloudwatchLogsExports": null,
-      "Endpoint": "test-db-cluster.cluster-5fad135c.us-east-1.rds.amazonaws.com",
+      "Endpoint": "test-db-cluster.cluster-6ad464de.us-east-1.rds.amazonaws.com",
       "Engine": "aurora-mysql",
       "EngineLifecycleSupport": null,
       "EngineMode": null,
@@ -77,12 +77,12 @@
       "PubliclyAccessible": null,
       "RdsCustomClusterConfiguration": null,
       "ReadReplicaIdentifiers": null,
-      "ReaderEndpoint": "test-db-cluster.cluster-ro-431e92c5.us-east-1.rds.amazonaws.com",
+      "ReaderEndpoint": "test-db-cluster.cluster-ro-89af2a53.us-east-1.rds.amazonaws.com",
       "ReplicationSourceIdentifier": null,
       "ScalingConfigurationInfo": null,
       "ServerlessV2PlatformVersion": null,
       "ServerlessV2ScalingConfiguration": null,
-      "Status": "available",
+      "Status": "creating",
       "StatusInfos": null,
       "StorageEncrypted": false,
       "StorageThroughput": null,
@@ -0,0 +1,12 @@
+{
+  "DBParameterGroups": [
+    {
+      "DBParameterGroupArn": "arn:aws:rds:us-east-1:000000000000:pg:test-db-parameter-group",
+      "DBParameterGroupFamily": "mysql8.0",
+      "DBParameterGroupName": "test-db-parameter-group",
+      "Description": "test parameter group"
+    }
+  ],
+  "Marker": null,
+  "ResultMetadata": {}
+}
\ No newline at end of file
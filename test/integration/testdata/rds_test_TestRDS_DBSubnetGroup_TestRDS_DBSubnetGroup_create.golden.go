@@ -0,0 +1,29 @@
+{
+  "DBSubnetGroup": {
+    "DBSubnetGroupArn": "arn:aws:rds:us-east-1:000000000000:subgrp:test-db-subnet-group",
+    "DBSubnetGroupDescription": "test subnet group",
+    "DBSubnetGroupName": "test-db-subnet-group",
+    "SubnetGroupStatus": "Complete",
+    "Subnets": [
+      {
+        "SubnetAvailabilityZone": {
+          "Name": "us-east-1a"
+        },
+        "SubnetIdentifier": "subnet-11111111",
+        "SubnetOutpost": null,
+        "SubnetStatus": "Active"
+      },
+      {
+        "SubnetAvailabilityZone": {
+          "Name": "us-east-1b"
+        },
+        "SubnetIdentifier": "subnet-22222222",
+        "SubnetOutpost": null,
+        "SubnetStatus": "Active"
+      }
+    ],
+    "SupportedNetworkTypes": null,
+    "VpcId": null
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,40 @@
+{
+  "DBSubnetGroups": [
+    {
+      "DBSubnetGroupArn": "arn:aws:rds:us-east-1:000000000000:subgrp:test-db-subnet-group",
+      "DBSubnetGroupDescription": "modified subnet group",
+      "DBSubnetGroupName": "test-db-subnet-group",
+      "SubnetGroupStatus": "Complete",
+      "Subnets": [
+        {
+          "SubnetAvailabilityZone": {
+            "Name": "us-east-1a"
+          },
+          "SubnetIdentifier": "subnet-33333333",
+          "SubnetOutpost": null,
+          "SubnetStatus": "Active"
+        },
+        {
+          "SubnetAvailabilityZone": {
+            "Name": "us-east-1b"
+          },
+          "SubnetIdentifier": "subnet-44444444",
+          "SubnetOutpost": null,
+          "SubnetStatus": "Active"
+        },
+        {
+          "SubnetAvailabilityZone": {
+            "Name": "us-east-1c"
+          },
+          "SubnetIdentifier": "subnet-55555555",
+          "SubnetOutpost": null,
+          "SubnetStatus": "Active"
+        }
+      ],
+      "SupportedNetworkTypes": null,
+      "VpcId": null
+    }
+  ],
+  "Marker": null,
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -16,12 +16,12 @@
     "AwsBackupRecoveryPointArn": null,
     "BacktrackConsumedChangeRecords": null,
     "BacktrackWindow": null,
-    "BackupRetentionPeriod": null,
+    "BackupRetentionPeriod": 1,
     "Capacity": null,
     "CertificateDetails": null,
     "CharacterSetName": null,
     "CloneGroupId": null,
-    "ClusterCreateTime": "2026-03-23T07:45:26.22Z",
+    "ClusterCreateTime": "2026-10-17T01:18:20.799Z",
     "ClusterScalabilityType": "",
     "CopyTagsToSnapshot": null,
     "CrossAccountClone": null,
@@ -42,7 +42,7 @@
     "EarliestBacktrackTime": null,
     "EarliestRestorableTime": null,
     "EnabledCloudwatchLogsExports": null,
-    "Endpoint": "test-delete-db-cluster.cluster-57dd5530.us-east-1.rds.amazonaws.com",
+    "Endpoint": "test-delete-db-cluster.cluster-d26256e3.us-east-1.rds.amazonaws.com",
     "Engine": "aurora-postgresql",
     "EngineLifecycleSupport": null,
     "EngineMode": null,
@@ -76,7 +76,7 @@
     "PubliclyAccessible": null,
     "RdsCustomClusterConfiguration": null,
     "ReadReplicaIdentifiers": null,
-    "ReaderEndpoint": "test-delete-db-cluster.cluster-ro-4c440747.us-east-1.rds.amazonaws.com",
+    "ReaderEndpoint": "test-delete-db-cluster.cluster-ro-3c638e42.us-east-1.rds.amazonaws.com",
     "ReplicationSourceIdentifier": null,
     "ScalingConfigurationInfo": null,
     "ServerlessV2PlatformVersion": null,
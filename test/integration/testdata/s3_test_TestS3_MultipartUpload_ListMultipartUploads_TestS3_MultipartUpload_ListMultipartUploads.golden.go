@@ -6,8 +6,8 @@
   "IsTruncated": false,
   "KeyMarker": null,
   "MaxUploads": 1000,
-  "NextKeyMarker": null,
-  "NextUploadIdMarker": null,
+  "NextKeyMarker": "file2.bin",
+  "NextUploadIdMarker": "7d1eee953ce2b3ad7d24ca7118be8f5f",
   "Prefix": null,
   "RequestCharged": "",
   "UploadIdMarker": null,
@@ -8,7 +8,7 @@
   "IsTruncated": false,
   "Key": "multipart-file.bin",
   "MaxParts": 1000,
-  "NextPartNumberMarker": "0",
+  "NextPartNumberMarker": "2",
   "Owner": null,
   "PartNumberMarker": "0",
   "Parts": [
@@ -1,9 +1,9 @@
 {
   "Arn": "arn:aws:s3tables:us-east-1:000000000000:bucket/test-get-table-bucket",
-  "CreatedAt": "2026-03-23T07:45:28.538319714Z",
+  "CreatedAt": "2026-10-16T23:59:52.542830076Z",
   "Name": "test-get-table-bucket",
   "OwnerAccountId": "000000000000",
-  "TableBucketId": null,
-  "Type": "",
+  "TableBucketId": "e9e964d0-9631-4c26-bf16-c193939e8007",
+  "Type": "customer",
   "ResultMetadata": {}
 }
\ No newline at end of file
@@ -1,8 +1,8 @@
 {
-  "CreatedAt": "2026-03-23T07:45:28.553254839Z",
+  "CreatedAt": "2026-10-16T23:59:52.548449654Z",
   "CreatedBy": "000000000000",
   "Format": "ICEBERG",
-  "ModifiedAt": "2026-03-23T07:45:28.553254839Z",
+  "ModifiedAt": "2026-10-16T23:59:52.548449654Z",
   "ModifiedBy": "000000000000",
   "Name": "testgettable",
   "Namespace": [
@@ -11,8 +11,8 @@
   "OwnerAccountId": "000000000000",
   "TableARN": "arn:aws:s3tables:us-east-1:000000000000:bucket/test-get-table-test-bucket/table/testgettablenamespace/testgettable",
   "Type": "customer",
-  "VersionToken": "32ec5161-6f32-45e4-9d91-d4387a433ef5",
-  "WarehouseLocation": null,
+  "VersionToken": "1785cfe4-eb74-4710-939e-68adc44dc13a",
+  "WarehouseLocation": "s3://a62c4155c40e4fa0828353df6088cf40--table-s3",
   "ManagedByService": null,
   "ManagedTableInformation": null,
   "MetadataLocation": null,
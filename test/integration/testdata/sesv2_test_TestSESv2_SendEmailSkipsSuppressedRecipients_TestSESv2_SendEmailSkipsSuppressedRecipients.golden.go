@@ -0,0 +1,18 @@
+{
+  "MessageId": "ee251d7e-e878-43d1-9cd2-8514a2f4e744",
+  "FromEmailAddress": "suppression-sender@example.com",
+  "Destination": {
+    "ToAddresses": [
+      "delivered@example.com"
+    ]
+  },
+  "Subject": "Suppression Test",
+  "Body": "Test body",
+  "SuppressedRecipients": [
+    {
+      "EmailAddress": "suppressed-complaint@example.com",
+      "Reason": "COMPLAINT"
+    }
+  ],
+  "SentAt": "2026-10-16T19:42:27.675326134Z"
+}
\ No newline at end of file
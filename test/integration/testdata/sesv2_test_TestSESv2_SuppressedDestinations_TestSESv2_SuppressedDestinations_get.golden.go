@@ -0,0 +1,9 @@
+{
+  "SuppressedDestination": {
+    "EmailAddress": "suppressed-bounce@example.com",
+    "LastUpdateTime": "2026-10-16T19:42:27.66Z",
+    "Reason": "BOUNCE",
+    "Attributes": null
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
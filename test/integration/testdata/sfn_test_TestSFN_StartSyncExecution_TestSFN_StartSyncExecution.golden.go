@@ -0,0 +1,24 @@
+{
+  "ExecutionArn": "arn:aws:states:us-east-1:000000000000:express:test-sync-state-machine:test-sync-execution:eb9b7699-873b-48ce-bc83-43260c5db583",
+  "StartDate": "2026-10-17T02:52:32Z",
+  "Status": "SUCCEEDED",
+  "StopDate": "2026-10-17T02:52:32Z",
+  "BillingDetails": {
+    "BilledDurationInMilliseconds": 100,
+    "BilledMemoryUsedInMB": 64
+  },
+  "Cause": null,
+  "Error": null,
+  "Input": "{\"key\": \"value\"}",
+  "InputDetails": {
+    "Included": true
+  },
+  "Name": "test-sync-execution",
+  "Output": "{\"key\": \"value\"}",
+  "OutputDetails": {
+    "Included": true
+  },
+  "StateMachineArn": "arn:aws:states:us-east-1:000000000000:stateMachine:test-sync-state-machine",
+  "TraceHeader": null,
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -1,12 +1,13 @@
 {
   "Attributes": {
     "ApproximateNumberOfMessages": "0",
+    "ApproximateNumberOfMessagesDelayed": "0",
     "ApproximateNumberOfMessagesNotVisible": "0",
     "ContentBasedDeduplication": "true",
-    "CreatedTimestamp": "1774251928",
+    "CreatedTimestamp": "1792202610",
     "DelaySeconds": "0",
     "FifoQueue": "true",
-    "LastModifiedTimestamp": "1774251928",
+    "LastModifiedTimestamp": "1792202610",
     "MaximumMessageSize": "262144",
     "MessageRetentionPeriod": "345600",
     "QueueArn": "arn:aws:sqs:us-east-1:000000000000:test-queue-fifo-attrs.fifo",
@@ -0,0 +1,8 @@
+{
+  "Attributes": {
+    "ApproximateNumberOfMessages": "2",
+    "ApproximateNumberOfMessagesDelayed": "1",
+    "ApproximateNumberOfMessagesNotVisible": "1"
+  },
+  "ResultMetadata": {}
+}
\ No newline at end of file
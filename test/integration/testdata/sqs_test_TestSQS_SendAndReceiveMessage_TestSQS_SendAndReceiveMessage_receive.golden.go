@@ -2,16 +2,17 @@
   "Messages": [
     {
       "Attributes": {
-        "ApproximateFirstReceiveTimestamp": "1774251928723",
+        "ApproximateFirstReceiveTimestamp": "1792184920685",
         "ApproximateReceiveCount": "1",
-        "SentTimestamp": "1774251928722"
+        "SenderId": "000000000000",
+        "SentTimestamp": "1792184920680"
       },
       "Body": "Hello, SQS!",
       "MD5OfBody": "982e00ffc10ba49378a8653ca8fecf47",
       "MD5OfMessageAttributes": null,
       "MessageAttributes": null,
-      "MessageId": "1f4907f3-b8e3-4909-8d0f-4d526d553046",
-      "ReceiptHandle": "6edc3773-3b44-416e-b05a-bbbd986e9e18"
+      "MessageId": "253f9b5d-9895-4341-aea8-c527fa0b07de",
+      "ReceiptHandle": "1d03e7a0-f68e-4164-b5d9-927393200c28"
     }
   ],
   "ResultMetadata": {}
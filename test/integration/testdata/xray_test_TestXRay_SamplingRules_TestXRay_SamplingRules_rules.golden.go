@@ -0,0 +1,66 @@
+{
+  "NextToken": null,
+  "SamplingRuleRecords": [
+    {
+      "CreatedAt": "2026-10-17T01:34:06.273Z",
+      "ModifiedAt": "2026-10-17T01:34:06.273Z",
+      "SamplingRule": {
+        "FixedRate": 0.1,
+        "HTTPMethod": "GET",
+        "Host": "*",
+        "Priority": 100,
+        "ReservoirSize": 5,
+        "ResourceARN": "*",
+        "ServiceName": "orders",
+        "ServiceType": "*",
+        "URLPath": "/orders/*",
+        "Version": 1,
+        "Attributes": null,
+        "RuleARN": "arn:aws:xray:us-east-1:000000000000:sampling-rule/sampling-high",
+        "RuleName": "sampling-high",
+        "SamplingRateBoost": null
+      }
+    },
+    {
+      "CreatedAt": "2026-10-17T01:34:06.272Z",
+      "ModifiedAt": "2026-10-17T01:34:06.275Z",
+      "SamplingRule": {
+        "FixedRate": 0.5,
+        "HTTPMethod": "GET",
+        "Host": "*",
+        "Priority": 200,
+        "ReservoirSize": 5,
+        "ResourceARN": "*",
+        "ServiceName": "orders",
+        "ServiceType": "*",
+        "URLPath": "/orders/*",
+        "Version": 1,
+        "Attributes": null,
+        "RuleARN": "arn:aws:xray:us-east-1:000000000000:sampling-rule/sampling-low",
+        "RuleName": "sampling-low",
+        "SamplingRateBoost": null
+      }
+    },
+    {
+      "CreatedAt": "2026-10-17T01:33:47.633Z",
+      "ModifiedAt": "2026-10-17T01:33:47.633Z",
+      "SamplingRule": {
+        "FixedRate": 0.05,
+        "HTTPMethod": "*",
+        "Host": "*",
+        "Priority": 10000,
+        "ReservoirSize": 1,
+        "ResourceARN": "*",
+        "ServiceName": "*",
+        "ServiceType": "*",
+        "URLPath": "*",
+        "Version": 1,
+        "Attributes": null,
+        "RuleARN": "arn:aws:xray:us-east-1:000000000000:sampling-rule/Default",
+        "RuleName": "Default",
+        "SamplingRateBoost": null
+      }
+    }
+  ],
+  "ResultMetadata": {}
+}
\ No newline at end of file
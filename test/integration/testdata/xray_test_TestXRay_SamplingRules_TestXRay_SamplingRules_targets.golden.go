@@ -0,0 +1,22 @@
+{
+  "LastRuleModification": "2026-10-17T01:34:06.275Z",
+  "SamplingTargetDocuments": [
+    {
+      "FixedRate": 0.1,
+      "Interval": 10,
+      "ReservoirQuota": 5,
+      "ReservoirQuotaTTL": "2026-10-17T01:34:16.281Z",
+      "RuleName": "sampling-high",
+      "SamplingBoost": null
+    }
+  ],
+  "UnprocessedBoostStatistics": null,
+  "UnprocessedStatistics": [
+    {
+      "ErrorCode": "400",
+      "Message": "Sampling rule no-such-rule not found",
+      "RuleName": "no-such-rule"
+    }
+  ],
+  "ResultMetadata": {}
+}
\ No newline at end of file
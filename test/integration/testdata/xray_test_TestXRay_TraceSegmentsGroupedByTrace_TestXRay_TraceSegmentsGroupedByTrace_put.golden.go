@@ -0,0 +1,10 @@
+{
+  "UnprocessedTraceSegments": [
+    {
+      "ErrorCode": "InvalidSegmentDocument",
+      "Id": "a1b2c3d4e5f60003",
+      "Message": "Segment document is missing the name field"
+    }
+  ],
+  "ResultMetadata": {}
+}
\ No newline at end of file
@@ -0,0 +1,53 @@
+{
+  "Annotations": null,
+  "AvailabilityZones": null,
+  "Duration": 2,
+  "EntryPoint": {
+    "AccountId": null,
+    "Name": "frontend",
+    "Names": [
+      "frontend"
+    ],
+    "Type": null
+  },
+  "ErrorRootCauses": null,
+  "FaultRootCauses": null,
+  "HasError": true,
+  "HasFault": null,
+  "HasThrottle": null,
+  "Http": {
+    "ClientIp": null,
+    "HttpMethod": "POST",
+    "HttpStatus": 200,
+    "HttpURL": "https://example.com/orders",
+    "UserAgent": null
+  },
+  "Id": "1-6ad28161-1317a63cafa400ecd277b773",
+  "InstanceIds": null,
+  "IsPartial": null,
+  "MatchedEventTime": null,
+  "ResourceARNs": null,
+  "ResponseTime": 2,
+  "ResponseTimeRootCauses": null,
+  "Revision": 0,
+  "ServiceIds": [
+    {
+      "AccountId": null,
+      "Name": "frontend",
+      "Names": [
+        "frontend"
+      ],
+      "Type": null
+    },
+    {
+      "AccountId": null,
+      "Name": "backend",
+      "Names": [
+        "backend"
+      ],
+      "Type": null
+    }
+  ],
+  "StartTime": "2026-10-16T19:56:15Z",
+  "Users": null
+}
\ No newline at end of file
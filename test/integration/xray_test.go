@@ -4,7 +4,11 @@ package integration
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -67,7 +71,7 @@ func TestXRay_GetTraceSummaries(t *testing.T) {
 	// First, put some trace segments.
 	segmentDoc := map[string]any{
 		"id":          "abcdef1234567890",
-		"trace_id":    "1-5e6b4c2b-abcdef1234567890abcdef12",
+		"trace_id":    newXRayTraceID(),
 		"name":        "summary-test-service",
 		"start_time":  float64(time.Now().Add(-1 * time.Second).Unix()),
 		"end_time":    float64(time.Now().Unix()),
@@ -280,6 +284,325 @@ func TestXRay_DeleteGroup(t *testing.T) {
 	}
 }
 
+func TestXRay_TraceSegmentsGroupedByTrace(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createXRayClient(t, ctx)
+
+	traceID := newXRayTraceID()
+	base := float64(time.Now().Unix())
+
+	rootSegment := map[string]any{
+		"id":          "a1b2c3d4e5f60001",
+		"trace_id":    traceID,
+		"name":        "frontend",
+		"start_time":  base - 2,
+		"in_progress": true,
+		"http": map[string]any{
+			"request": map[string]any{
+				"method": "POST",
+				"url":    "https://example.com/orders",
+			},
+		},
+	}
+	backendSegment := map[string]any{
+		"id":         "a1b2c3d4e5f60002",
+		"trace_id":   traceID,
+		"parent_id":  "a1b2c3d4e5f60001",
+		"name":       "backend",
+		"start_time": base - 1.5,
+		"end_time":   base - 0.5,
+		"error":      true,
+	}
+	invalidSegment := map[string]any{
+		"id":         "a1b2c3d4e5f60003",
+		"trace_id":   traceID,
+		"start_time": base - 1,
+		"end_time":   base,
+	}
+
+	putOutput, err := client.PutTraceSegments(ctx, &xray.PutTraceSegmentsInput{
+		TraceSegmentDocuments: []string{
+			marshalSegmentDocument(t, rootSegment),
+			marshalSegmentDocument(t, backendSegment),
+			marshalSegmentDocument(t, invalidSegment),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("ResultMetadata")).Assert(t.Name()+"_put", putOutput)
+
+	if summary := findTraceSummary(t, client, traceID); summary == nil || !aws.ToBool(summary.IsPartial) {
+		t.Fatalf("expected a partial trace summary for %s, got %+v", traceID, summary)
+	}
+
+	// Complete the root segment.
+	delete(rootSegment, "in_progress")
+	rootSegment["end_time"] = base
+	rootSegment["http"].(map[string]any)["response"] = map[string]any{"status": 200}
+
+	_, err = client.PutTraceSegments(ctx, &xray.PutTraceSegmentsInput{
+		TraceSegmentDocuments: []string{marshalSegmentDocument(t, rootSegment)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	summary := findTraceSummary(t, client, traceID)
+	if summary == nil {
+		t.Fatalf("expected a trace summary for %s", traceID)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("Id", "StartTime")).Assert(t.Name()+"_summary", summary)
+
+	tracesOutput, err := client.BatchGetTraces(ctx, &xray.BatchGetTracesInput{
+		TraceIds: []string{traceID, "1-00000000-000000000000000000000000"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tracesOutput.Traces) != 1 || len(tracesOutput.Traces[0].Segments) != 2 {
+		t.Fatalf("expected 1 trace with 2 segments, got %+v", tracesOutput.Traces)
+	}
+
+	if len(tracesOutput.UnprocessedTraceIds) != 1 {
+		t.Errorf("expected 1 unprocessed trace ID, got %v", tracesOutput.UnprocessedTraceIds)
+	}
+
+	for _, segment := range tracesOutput.Traces[0].Segments {
+		if aws.ToString(segment.Id) == "a1b2c3d4e5f60001" && strings.Contains(aws.ToString(segment.Document), "in_progress") {
+			t.Errorf("expected the completed root segment, got %s", aws.ToString(segment.Document))
+		}
+	}
+}
+
+func TestXRay_GetTraceSummariesTimeRange(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createXRayClient(t, ctx)
+
+	traceID := "1-5e6b4c2d-0123456789abcdef01234567"
+	now := float64(time.Now().Unix())
+
+	_, err := client.PutTraceSegments(ctx, &xray.PutTraceSegmentsInput{
+		TraceSegmentDocuments: []string{marshalSegmentDocument(t, map[string]any{
+			"id":         "0123456789abcdef",
+			"trace_id":   traceID,
+			"name":       "time-range-service",
+			"start_time": now - 1,
+			"end_time":   now,
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The trace ID encodes a time in March 2020.
+	tests := []struct {
+		name          string
+		timeRangeType types.TimeRangeType
+		startTime     time.Time
+		endTime       time.Time
+		want          bool
+	}{
+		{
+			name:      "trace id in range",
+			startTime: time.Date(2020, 3, 13, 0, 0, 0, 0, time.UTC),
+			endTime:   time.Date(2020, 3, 14, 0, 0, 0, 0, time.UTC),
+			want:      true,
+		},
+		{
+			name:      "trace id out of range",
+			startTime: time.Now().Add(-time.Hour),
+			endTime:   time.Now().Add(time.Hour),
+			want:      false,
+		},
+		{
+			name:          "event in range",
+			timeRangeType: types.TimeRangeTypeEvent,
+			startTime:     time.Now().Add(-time.Hour),
+			endTime:       time.Now().Add(time.Hour),
+			want:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		found := false
+
+		paginator := xray.NewGetTraceSummariesPaginator(client, &xray.GetTraceSummariesInput{
+			StartTime:     aws.Time(tt.startTime),
+			EndTime:       aws.Time(tt.endTime),
+			TimeRangeType: tt.timeRangeType,
+		})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, summary := range page.TraceSummaries {
+				found = found || aws.ToString(summary.Id) == traceID
+			}
+		}
+
+		if found != tt.want {
+			t.Errorf("%s: expected found=%v, got %v", tt.name, tt.want, found)
+		}
+	}
+}
+
+// newXRayTraceID returns a trace ID encoding the current time.
+func newXRayTraceID() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+
+	return fmt.Sprintf("1-%08x-%s", time.Now().Unix(), hex.EncodeToString(b))
+}
+
+func marshalSegmentDocument(t *testing.T, doc map[string]any) string {
+	t.Helper()
+
+	docBytes, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(docBytes)
+}
+
+// findTraceSummary returns the summary of the trace updated within the last minute.
+func findTraceSummary(t *testing.T, client *xray.Client, traceID string) *types.TraceSummary {
+	t.Helper()
+
+	paginator := xray.NewGetTraceSummariesPaginator(client, &xray.GetTraceSummariesInput{
+		StartTime:     aws.Time(time.Now().Add(-time.Minute)),
+		EndTime:       aws.Time(time.Now().Add(time.Minute)),
+		TimeRangeType: types.TimeRangeTypeEvent,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := range page.TraceSummaries {
+			if aws.ToString(page.TraceSummaries[i].Id) == traceID {
+				return &page.TraceSummaries[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+func TestXRay_SamplingRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := createXRayClient(t, ctx)
+
+	newRule := func(name string, priority int32) *types.SamplingRule {
+		return &types.SamplingRule{
+			RuleName:      aws.String(name),
+			Priority:      aws.Int32(priority),
+			FixedRate:     0.1,
+			ReservoirSize: 5,
+			ResourceARN:   aws.String("*"),
+			ServiceName:   aws.String("orders"),
+			ServiceType:   aws.String("*"),
+			Host:          aws.String("*"),
+			HTTPMethod:    aws.String("GET"),
+			URLPath:       aws.String("/orders/*"),
+			Version:       aws.Int32(1),
+		}
+	}
+
+	for _, rule := range []*types.SamplingRule{newRule("sampling-low", 200), newRule("sampling-high", 100)} {
+		if _, err := client.CreateSamplingRule(ctx, &xray.CreateSamplingRuleInput{SamplingRule: rule}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Cleanup(func() {
+		for _, name := range []string{"sampling-low", "sampling-high"} {
+			_, _ = client.DeleteSamplingRule(context.Background(), &xray.DeleteSamplingRuleInput{
+				RuleName: aws.String(name),
+			})
+		}
+	})
+
+	// Rule names are unique.
+	if _, err := client.CreateSamplingRule(ctx, &xray.CreateSamplingRuleInput{SamplingRule: newRule("sampling-low", 300)}); err == nil {
+		t.Error("expected error for duplicate sampling rule")
+	}
+
+	updateResult, err := client.UpdateSamplingRule(ctx, &xray.UpdateSamplingRuleInput{
+		SamplingRuleUpdate: &types.SamplingRuleUpdate{
+			RuleName:  aws.String("sampling-low"),
+			FixedRate: aws.Float64(0.5),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := updateResult.SamplingRuleRecord.SamplingRule.FixedRate; got != 0.5 {
+		t.Errorf("expected updated FixedRate 0.5, got %v", got)
+	}
+
+	// Rules are returned in priority order, with the default rule last.
+	rulesResult, err := client.GetSamplingRules(ctx, &xray.GetSamplingRulesInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("CreatedAt", "ModifiedAt", "ResultMetadata")).Assert(t.Name()+"_rules", rulesResult)
+
+	targetsResult, err := client.GetSamplingTargets(ctx, &xray.GetSamplingTargetsInput{
+		SamplingStatisticsDocuments: []types.SamplingStatisticsDocument{
+			{
+				RuleName:     aws.String("sampling-high"),
+				ClientID:     aws.String("0123456789abcdef01234567"),
+				Timestamp:    aws.Time(time.Now()),
+				RequestCount: 10,
+				SampledCount: 1,
+			},
+			{
+				RuleName:     aws.String("no-such-rule"),
+				ClientID:     aws.String("0123456789abcdef01234567"),
+				Timestamp:    aws.Time(time.Now()),
+				RequestCount: 1,
+				SampledCount: 0,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.New(t, golden.WithIgnoreFields("LastRuleModification", "ReservoirQuotaTTL", "ResultMetadata")).Assert(t.Name()+"_targets", targetsResult)
+
+	_, err = client.DeleteSamplingRule(ctx, &xray.DeleteSamplingRuleInput{
+		RuleName: aws.String("sampling-high"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The default rule cannot be deleted.
+	_, err = client.DeleteSamplingRule(ctx, &xray.DeleteSamplingRuleInput{
+		RuleName: aws.String("Default"),
+	})
+	if err == nil {
+		t.Error("expected error when deleting the default sampling rule")
+	}
+}
+
 func createXRayClient(t *testing.T, _ context.Context) *xray.Client {
 	t.Helper()
 